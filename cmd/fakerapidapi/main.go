@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"sheng-go-backend/testutil/fakerapidapi"
+	"time"
+)
+
+// Runs a fake RapidAPI server for local development. Point rapidapi.baseURL
+// at it (e.g. http://localhost:8090) and run cmd/job or the app as usual.
+func main() {
+	addr := flag.String("addr", ":8090", "listen address")
+	apiKey := flag.String("api-key", "", "require this X-RapidAPI-Key (any key when empty)")
+	notFoundRate := flag.Float64("not-found-rate", 0, "share of requests answered with 404")
+	rateLimitRate := flag.Float64("rate-limit-rate", 0, "share of requests answered with 429")
+	serverErrorRate := flag.Float64("error-rate", 0, "share of requests answered with 502")
+	slowRate := flag.Float64("slow-rate", 0, "share of requests answered slowly")
+	retryAfter := flag.Duration("retry-after", time.Second, "Retry-After sent with 429 responses")
+	slowDelay := flag.Duration("slow-delay", 2*time.Second, "delay of slow responses")
+	posts := flag.Int("posts", 12, "posts per synthetic profile")
+	pageSize := flag.Int("page-size", 5, "posts per page")
+	seed := flag.Int64("seed", 1, "seed for fault injection")
+	flag.Parse()
+
+	server := fakerapidapi.New(fakerapidapi.Options{
+		APIKey:          *apiKey,
+		NotFoundRate:    *notFoundRate,
+		RateLimitRate:   *rateLimitRate,
+		ServerErrorRate: *serverErrorRate,
+		SlowRate:        *slowRate,
+		RetryAfter:      *retryAfter,
+		SlowDelay:       *slowDelay,
+		PostsPerProfile: *posts,
+		PostsPageSize:   *pageSize,
+		Seed:            *seed,
+	})
+
+	log.Printf("Fake RapidAPI listening on %s", *addr)
+	log.Printf(
+		"Force faults with identifier prefixes: %q (404), %q (429), %q (502), %q (slow)",
+		fakerapidapi.NotFoundPrefix,
+		fakerapidapi.RateLimitPrefix,
+		fakerapidapi.ServerErrorPrefix,
+		fakerapidapi.SlowPrefix,
+	)
+	if err := http.ListenAndServe(*addr, server); err != nil {
+		log.Fatalf("fake RapidAPI server stopped: %v", err)
+	}
+}
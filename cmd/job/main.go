@@ -6,15 +6,19 @@ import (
 	"log"
 	"os"
 	"sheng-go-backend/config"
+	"sheng-go-backend/pkg/adapter/repository/apicalllogrepository"
+	"sheng-go-backend/pkg/adapter/repository/apiquotabudgetrepository"
 	"sheng-go-backend/pkg/adapter/repository/apiquotatrackerrepository"
 	"sheng-go-backend/pkg/adapter/repository/cronjobconfigrepository"
 	"sheng-go-backend/pkg/adapter/repository/jobexecutionhistoryrepository"
+	"sheng-go-backend/pkg/adapter/repository/profilechangeeventrepository"
 	"sheng-go-backend/pkg/adapter/repository/profileentryrepository"
 	"sheng-go-backend/pkg/adapter/repository/profilerepository"
 	"sheng-go-backend/pkg/infrastructure/datastore"
 	"sheng-go-backend/pkg/infrastructure/email"
-	"sheng-go-backend/pkg/infrastructure/external/rapidapi"
+	"sheng-go-backend/pkg/infrastructure/external"
 	"sheng-go-backend/pkg/infrastructure/storage"
+	"sheng-go-backend/pkg/infrastructure/webhook"
 	"sheng-go-backend/pkg/registry"
 	"sheng-go-backend/pkg/usecase/usecase/apiquota"
 	"sheng-go-backend/pkg/usecase/usecase/profilefetcher"
@@ -35,26 +39,34 @@ func main() {
 		log.Fatalf("failed to initialize S3 service: %v", err)
 	}
 	emailService := email.NewEmailService()
-	linkedinClient := rapidapi.NewLinkedInClient()
 
 	// Repositories
 	profileEntryRepo := profileentryrepository.NewProfileEntryRepository(client)
 	profileRepo := profilerepository.NewProfileRepo(client)
 	quotaTrackerRepo := apiquotatrackerrepository.NewAPIQuotaTrackerRepository(client)
+	quotaBudgetRepo := apiquotabudgetrepository.NewAPIQuotaBudgetRepository(client)
 	cronConfigRepo := cronjobconfigrepository.NewCronJobConfigRepository(client)
 	jobHistoryRepo := jobexecutionhistoryrepository.NewJobExecutionHistoryRepository(client)
+	callLogRepo := apicalllogrepository.NewAPICallLogRepository(client)
+	changeEventRepo := profilechangeeventrepository.NewProfileChangeEventRepository(client)
 
 	// Usecases
-	quotaManager := apiquota.NewQuotaManager(quotaTrackerRepo, emailService)
+	quotaManager := apiquota.NewQuotaManager(quotaTrackerRepo, quotaBudgetRepo, callLogRepo, emailService)
+	profileProvider, err := external.NewProfileProvider(quotaManager, quotaManager)
+	if err != nil {
+		log.Fatalf("failed to initialize profile provider: %v", err)
+	}
 	profileFetcher := profilefetcher.NewProfileFetcher(
 		profileEntryRepo,
 		profileRepo,
 		cronConfigRepo,
 		jobHistoryRepo,
-		linkedinClient,
+		profileProvider,
 		s3Service,
 		emailService,
 		quotaManager,
+		changeEventRepo,
+		webhook.NewClient(),
 	)
 
 	reg := registry.NewWithOptions(client, registry.RegistryOptions{
@@ -44,12 +44,36 @@ type config struct {
 	}
 	RapidAPI struct {
 		APIKey               string
+		APIKeys              []string
+		KeyMonthlyQuota      int
 		BaseURL              string
 		MonthlyQuota         int
+		ReservationTTLMinutes int
+		DailyCap             int
+		HourlyCap            int
+		EvenPacing           bool
+		AlertThresholds      []int
 		TimeoutSeconds       int
 		RateLimitMaxRetries  int
 		RateLimitBackoffMs   int
 		RateLimitBackoffMaxMs int
+		RequestIntervalMs     int
+		FixtureMode           string
+		FixtureDir            string
+		RateLimits            map[string]struct {
+			RequestsPerSecond float64
+			Burst             int
+		}
+	}
+	ProfileProvider struct {
+		Name                 string
+		BreakerWindowSeconds int
+		BreakerMinRequests   int
+		BreakerErrorRate     float64
+		BreakerOpenSeconds   int
+		CacheTTLMinutes      int
+		SchemaDriftMinShare  float64
+		SchemaKnownFields    []string
 	}
 	Email struct {
 		SMTPHost     string
@@ -63,6 +87,25 @@ type config struct {
 		ProfileFetcherSchedule string
 		QuotaResetSchedule     string
 		BatchSize              int
+		Concurrency            int
+		LeaseMinutes           int
+		ReaperSchedule         string
+		ReaperStaleMinutes     int
+		ReaperPolicy           string
+		RetryMaxAttempts       int
+		RetryBackoffMinutes    int
+		RetryBackoffMaxMinutes int
+		RefresherSchedule      string
+		RefreshAfterDays       int
+		RefreshBudget          int
+		ChangeEventSchedule    string
+	}
+	Webhook struct {
+		URL            string
+		Secret         string
+		TimeoutSeconds int
+		MaxAttempts    int
+		BackoffSeconds int
 	}
 }
 
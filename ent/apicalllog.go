@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/jobexecutionhistory"
+	"sheng-go-backend/ent/schema/ulid"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// APICallLog is the model entity for the APICallLog schema.
+type APICallLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID ulid.ID `json:"id,omitempty"`
+	// RapidAPI endpoint: profile, profile_by_url or posts
+	Endpoint string `json:"endpoint,omitempty"`
+	// Label of the RapidAPI key the request was sent with
+	KeyLabel string `json:"key_label,omitempty"`
+	// Caller the request was made for, e.g. its quota budget; empty when unknown
+	Caller string `json:"caller,omitempty"`
+	// Job run the request was made in, until the run's execution history is linked
+	RunID string `json:"run_id,omitempty"`
+	// Profile URN, username or URL requested
+	Urn string `json:"urn,omitempty"`
+	// HTTP status of the response; unset when no response arrived
+	HTTPStatus *int `json:"http_status,omitempty"`
+	// Time from sending the request to reading the whole response
+	LatencyMs int `json:"latency_ms,omitempty"`
+	// Size of the response body
+	Bytes int `json:"bytes,omitempty"`
+	// Whether the request was counted against the key's monthly quota
+	Counted bool `json:"counted,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the APICallLogQuery when eager-loading is set.
+	Edges                           APICallLogEdges `json:"edges"`
+	job_execution_history_api_calls *ulid.ID
+	selectValues                    sql.SelectValues
+}
+
+// APICallLogEdges holds the relations/edges for other nodes in the graph.
+type APICallLogEdges struct {
+	// Job execution the request was made in
+	JobExecution *JobExecutionHistory `json:"job_execution,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// JobExecutionOrErr returns the JobExecution value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e APICallLogEdges) JobExecutionOrErr() (*JobExecutionHistory, error) {
+	if e.JobExecution != nil {
+		return e.JobExecution, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: jobexecutionhistory.Label}
+	}
+	return nil, &NotLoadedError{edge: "job_execution"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*APICallLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case apicalllog.FieldCounted:
+			values[i] = new(sql.NullBool)
+		case apicalllog.FieldHTTPStatus, apicalllog.FieldLatencyMs, apicalllog.FieldBytes:
+			values[i] = new(sql.NullInt64)
+		case apicalllog.FieldEndpoint, apicalllog.FieldKeyLabel, apicalllog.FieldCaller, apicalllog.FieldRunID, apicalllog.FieldUrn:
+			values[i] = new(sql.NullString)
+		case apicalllog.FieldCreatedAt, apicalllog.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case apicalllog.FieldID:
+			values[i] = new(ulid.ID)
+		case apicalllog.ForeignKeys[0]: // job_execution_history_api_calls
+			values[i] = &sql.NullScanner{S: new(ulid.ID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the APICallLog fields.
+func (acl *APICallLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case apicalllog.FieldID:
+			if value, ok := values[i].(*ulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				acl.ID = *value
+			}
+		case apicalllog.FieldEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint", values[i])
+			} else if value.Valid {
+				acl.Endpoint = value.String
+			}
+		case apicalllog.FieldKeyLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_label", values[i])
+			} else if value.Valid {
+				acl.KeyLabel = value.String
+			}
+		case apicalllog.FieldCaller:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caller", values[i])
+			} else if value.Valid {
+				acl.Caller = value.String
+			}
+		case apicalllog.FieldRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				acl.RunID = value.String
+			}
+		case apicalllog.FieldUrn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field urn", values[i])
+			} else if value.Valid {
+				acl.Urn = value.String
+			}
+		case apicalllog.FieldHTTPStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field http_status", values[i])
+			} else if value.Valid {
+				acl.HTTPStatus = new(int)
+				*acl.HTTPStatus = int(value.Int64)
+			}
+		case apicalllog.FieldLatencyMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_ms", values[i])
+			} else if value.Valid {
+				acl.LatencyMs = int(value.Int64)
+			}
+		case apicalllog.FieldBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bytes", values[i])
+			} else if value.Valid {
+				acl.Bytes = int(value.Int64)
+			}
+		case apicalllog.FieldCounted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field counted", values[i])
+			} else if value.Valid {
+				acl.Counted = value.Bool
+			}
+		case apicalllog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				acl.CreatedAt = value.Time
+			}
+		case apicalllog.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				acl.UpdatedAt = value.Time
+			}
+		case apicalllog.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field job_execution_history_api_calls", values[i])
+			} else if value.Valid {
+				acl.job_execution_history_api_calls = new(ulid.ID)
+				*acl.job_execution_history_api_calls = *value.S.(*ulid.ID)
+			}
+		default:
+			acl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the APICallLog.
+// This includes values selected through modifiers, order, etc.
+func (acl *APICallLog) Value(name string) (ent.Value, error) {
+	return acl.selectValues.Get(name)
+}
+
+// QueryJobExecution queries the "job_execution" edge of the APICallLog entity.
+func (acl *APICallLog) QueryJobExecution() *JobExecutionHistoryQuery {
+	return NewAPICallLogClient(acl.config).QueryJobExecution(acl)
+}
+
+// Update returns a builder for updating this APICallLog.
+// Note that you need to call APICallLog.Unwrap() before calling this method if this APICallLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (acl *APICallLog) Update() *APICallLogUpdateOne {
+	return NewAPICallLogClient(acl.config).UpdateOne(acl)
+}
+
+// Unwrap unwraps the APICallLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (acl *APICallLog) Unwrap() *APICallLog {
+	_tx, ok := acl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: APICallLog is not a transactional entity")
+	}
+	acl.config.driver = _tx.drv
+	return acl
+}
+
+// String implements the fmt.Stringer.
+func (acl *APICallLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("APICallLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", acl.ID))
+	builder.WriteString("endpoint=")
+	builder.WriteString(acl.Endpoint)
+	builder.WriteString(", ")
+	builder.WriteString("key_label=")
+	builder.WriteString(acl.KeyLabel)
+	builder.WriteString(", ")
+	builder.WriteString("caller=")
+	builder.WriteString(acl.Caller)
+	builder.WriteString(", ")
+	builder.WriteString("run_id=")
+	builder.WriteString(acl.RunID)
+	builder.WriteString(", ")
+	builder.WriteString("urn=")
+	builder.WriteString(acl.Urn)
+	builder.WriteString(", ")
+	if v := acl.HTTPStatus; v != nil {
+		builder.WriteString("http_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", acl.LatencyMs))
+	builder.WriteString(", ")
+	builder.WriteString("bytes=")
+	builder.WriteString(fmt.Sprintf("%v", acl.Bytes))
+	builder.WriteString(", ")
+	builder.WriteString("counted=")
+	builder.WriteString(fmt.Sprintf("%v", acl.Counted))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(acl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(acl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// APICallLogs is a parsable slice of APICallLog.
+type APICallLogs []*APICallLog
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apicalllog
+
+import (
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the apicalllog type in the database.
+	Label = "api_call_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEndpoint holds the string denoting the endpoint field in the database.
+	FieldEndpoint = "endpoint"
+	// FieldKeyLabel holds the string denoting the key_label field in the database.
+	FieldKeyLabel = "key_label"
+	// FieldCaller holds the string denoting the caller field in the database.
+	FieldCaller = "caller"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// FieldUrn holds the string denoting the urn field in the database.
+	FieldUrn = "urn"
+	// FieldHTTPStatus holds the string denoting the http_status field in the database.
+	FieldHTTPStatus = "http_status"
+	// FieldLatencyMs holds the string denoting the latency_ms field in the database.
+	FieldLatencyMs = "latency_ms"
+	// FieldBytes holds the string denoting the bytes field in the database.
+	FieldBytes = "bytes"
+	// FieldCounted holds the string denoting the counted field in the database.
+	FieldCounted = "counted"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeJobExecution holds the string denoting the job_execution edge name in mutations.
+	EdgeJobExecution = "job_execution"
+	// Table holds the table name of the apicalllog in the database.
+	Table = "api_call_logs"
+	// JobExecutionTable is the table that holds the job_execution relation/edge.
+	JobExecutionTable = "api_call_logs"
+	// JobExecutionInverseTable is the table name for the JobExecutionHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "jobexecutionhistory" package.
+	JobExecutionInverseTable = "job_execution_histories"
+	// JobExecutionColumn is the table column denoting the job_execution relation/edge.
+	JobExecutionColumn = "job_execution_history_api_calls"
+)
+
+// Columns holds all SQL columns for apicalllog fields.
+var Columns = []string{
+	FieldID,
+	FieldEndpoint,
+	FieldKeyLabel,
+	FieldCaller,
+	FieldRunID,
+	FieldUrn,
+	FieldHTTPStatus,
+	FieldLatencyMs,
+	FieldBytes,
+	FieldCounted,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "api_call_logs"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"job_execution_history_api_calls",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EndpointValidator is a validator for the "endpoint" field. It is called by the builders before save.
+	EndpointValidator func(string) error
+	// DefaultCaller holds the default value on creation for the "caller" field.
+	DefaultCaller string
+	// DefaultLatencyMs holds the default value on creation for the "latency_ms" field.
+	DefaultLatencyMs int
+	// LatencyMsValidator is a validator for the "latency_ms" field. It is called by the builders before save.
+	LatencyMsValidator func(int) error
+	// DefaultBytes holds the default value on creation for the "bytes" field.
+	DefaultBytes int
+	// BytesValidator is a validator for the "bytes" field. It is called by the builders before save.
+	BytesValidator func(int) error
+	// DefaultCounted holds the default value on creation for the "counted" field.
+	DefaultCounted bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() ulid.ID
+)
+
+// OrderOption defines the ordering options for the APICallLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEndpoint orders the results by the endpoint field.
+func ByEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndpoint, opts...).ToFunc()
+}
+
+// ByKeyLabel orders the results by the key_label field.
+func ByKeyLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyLabel, opts...).ToFunc()
+}
+
+// ByCaller orders the results by the caller field.
+func ByCaller(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCaller, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByUrn orders the results by the urn field.
+func ByUrn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUrn, opts...).ToFunc()
+}
+
+// ByHTTPStatus orders the results by the http_status field.
+func ByHTTPStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHTTPStatus, opts...).ToFunc()
+}
+
+// ByLatencyMs orders the results by the latency_ms field.
+func ByLatencyMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatencyMs, opts...).ToFunc()
+}
+
+// ByBytes orders the results by the bytes field.
+func ByBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBytes, opts...).ToFunc()
+}
+
+// ByCounted orders the results by the counted field.
+func ByCounted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCounted, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByJobExecutionField orders the results by job_execution field.
+func ByJobExecutionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJobExecutionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newJobExecutionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JobExecutionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, JobExecutionTable, JobExecutionColumn),
+	)
+}
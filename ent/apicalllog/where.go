@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apicalllog
+
+import (
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id ulid.ID) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id ulid.ID) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id ulid.ID) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...ulid.ID) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...ulid.ID) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id ulid.ID) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id ulid.ID) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id ulid.ID) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id ulid.ID) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLTE(FieldID, id))
+}
+
+// Endpoint applies equality check predicate on the "endpoint" field. It's identical to EndpointEQ.
+func Endpoint(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// KeyLabel applies equality check predicate on the "key_label" field. It's identical to KeyLabelEQ.
+func KeyLabel(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldKeyLabel, v))
+}
+
+// Caller applies equality check predicate on the "caller" field. It's identical to CallerEQ.
+func Caller(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldCaller, v))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldRunID, v))
+}
+
+// Urn applies equality check predicate on the "urn" field. It's identical to UrnEQ.
+func Urn(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldUrn, v))
+}
+
+// HTTPStatus applies equality check predicate on the "http_status" field. It's identical to HTTPStatusEQ.
+func HTTPStatus(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldHTTPStatus, v))
+}
+
+// LatencyMs applies equality check predicate on the "latency_ms" field. It's identical to LatencyMsEQ.
+func LatencyMs(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// Bytes applies equality check predicate on the "bytes" field. It's identical to BytesEQ.
+func Bytes(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldBytes, v))
+}
+
+// Counted applies equality check predicate on the "counted" field. It's identical to CountedEQ.
+func Counted(v bool) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldCounted, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EndpointEQ applies the EQ predicate on the "endpoint" field.
+func EndpointEQ(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// EndpointNEQ applies the NEQ predicate on the "endpoint" field.
+func EndpointNEQ(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldEndpoint, v))
+}
+
+// EndpointIn applies the In predicate on the "endpoint" field.
+func EndpointIn(vs ...string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIn(FieldEndpoint, vs...))
+}
+
+// EndpointNotIn applies the NotIn predicate on the "endpoint" field.
+func EndpointNotIn(vs ...string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotIn(FieldEndpoint, vs...))
+}
+
+// EndpointGT applies the GT predicate on the "endpoint" field.
+func EndpointGT(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGT(FieldEndpoint, v))
+}
+
+// EndpointGTE applies the GTE predicate on the "endpoint" field.
+func EndpointGTE(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGTE(FieldEndpoint, v))
+}
+
+// EndpointLT applies the LT predicate on the "endpoint" field.
+func EndpointLT(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLT(FieldEndpoint, v))
+}
+
+// EndpointLTE applies the LTE predicate on the "endpoint" field.
+func EndpointLTE(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLTE(FieldEndpoint, v))
+}
+
+// EndpointContains applies the Contains predicate on the "endpoint" field.
+func EndpointContains(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldContains(FieldEndpoint, v))
+}
+
+// EndpointHasPrefix applies the HasPrefix predicate on the "endpoint" field.
+func EndpointHasPrefix(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldHasPrefix(FieldEndpoint, v))
+}
+
+// EndpointHasSuffix applies the HasSuffix predicate on the "endpoint" field.
+func EndpointHasSuffix(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldHasSuffix(FieldEndpoint, v))
+}
+
+// EndpointEqualFold applies the EqualFold predicate on the "endpoint" field.
+func EndpointEqualFold(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEqualFold(FieldEndpoint, v))
+}
+
+// EndpointContainsFold applies the ContainsFold predicate on the "endpoint" field.
+func EndpointContainsFold(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldContainsFold(FieldEndpoint, v))
+}
+
+// KeyLabelEQ applies the EQ predicate on the "key_label" field.
+func KeyLabelEQ(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldKeyLabel, v))
+}
+
+// KeyLabelNEQ applies the NEQ predicate on the "key_label" field.
+func KeyLabelNEQ(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldKeyLabel, v))
+}
+
+// KeyLabelIn applies the In predicate on the "key_label" field.
+func KeyLabelIn(vs ...string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIn(FieldKeyLabel, vs...))
+}
+
+// KeyLabelNotIn applies the NotIn predicate on the "key_label" field.
+func KeyLabelNotIn(vs ...string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotIn(FieldKeyLabel, vs...))
+}
+
+// KeyLabelGT applies the GT predicate on the "key_label" field.
+func KeyLabelGT(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGT(FieldKeyLabel, v))
+}
+
+// KeyLabelGTE applies the GTE predicate on the "key_label" field.
+func KeyLabelGTE(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGTE(FieldKeyLabel, v))
+}
+
+// KeyLabelLT applies the LT predicate on the "key_label" field.
+func KeyLabelLT(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLT(FieldKeyLabel, v))
+}
+
+// KeyLabelLTE applies the LTE predicate on the "key_label" field.
+func KeyLabelLTE(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLTE(FieldKeyLabel, v))
+}
+
+// KeyLabelContains applies the Contains predicate on the "key_label" field.
+func KeyLabelContains(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldContains(FieldKeyLabel, v))
+}
+
+// KeyLabelHasPrefix applies the HasPrefix predicate on the "key_label" field.
+func KeyLabelHasPrefix(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldHasPrefix(FieldKeyLabel, v))
+}
+
+// KeyLabelHasSuffix applies the HasSuffix predicate on the "key_label" field.
+func KeyLabelHasSuffix(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldHasSuffix(FieldKeyLabel, v))
+}
+
+// KeyLabelEqualFold applies the EqualFold predicate on the "key_label" field.
+func KeyLabelEqualFold(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEqualFold(FieldKeyLabel, v))
+}
+
+// KeyLabelContainsFold applies the ContainsFold predicate on the "key_label" field.
+func KeyLabelContainsFold(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldContainsFold(FieldKeyLabel, v))
+}
+
+// CallerEQ applies the EQ predicate on the "caller" field.
+func CallerEQ(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldCaller, v))
+}
+
+// CallerNEQ applies the NEQ predicate on the "caller" field.
+func CallerNEQ(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldCaller, v))
+}
+
+// CallerIn applies the In predicate on the "caller" field.
+func CallerIn(vs ...string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIn(FieldCaller, vs...))
+}
+
+// CallerNotIn applies the NotIn predicate on the "caller" field.
+func CallerNotIn(vs ...string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotIn(FieldCaller, vs...))
+}
+
+// CallerGT applies the GT predicate on the "caller" field.
+func CallerGT(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGT(FieldCaller, v))
+}
+
+// CallerGTE applies the GTE predicate on the "caller" field.
+func CallerGTE(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGTE(FieldCaller, v))
+}
+
+// CallerLT applies the LT predicate on the "caller" field.
+func CallerLT(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLT(FieldCaller, v))
+}
+
+// CallerLTE applies the LTE predicate on the "caller" field.
+func CallerLTE(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLTE(FieldCaller, v))
+}
+
+// CallerContains applies the Contains predicate on the "caller" field.
+func CallerContains(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldContains(FieldCaller, v))
+}
+
+// CallerHasPrefix applies the HasPrefix predicate on the "caller" field.
+func CallerHasPrefix(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldHasPrefix(FieldCaller, v))
+}
+
+// CallerHasSuffix applies the HasSuffix predicate on the "caller" field.
+func CallerHasSuffix(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldHasSuffix(FieldCaller, v))
+}
+
+// CallerEqualFold applies the EqualFold predicate on the "caller" field.
+func CallerEqualFold(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEqualFold(FieldCaller, v))
+}
+
+// CallerContainsFold applies the ContainsFold predicate on the "caller" field.
+func CallerContainsFold(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldContainsFold(FieldCaller, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// RunIDGT applies the GT predicate on the "run_id" field.
+func RunIDGT(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGT(FieldRunID, v))
+}
+
+// RunIDGTE applies the GTE predicate on the "run_id" field.
+func RunIDGTE(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGTE(FieldRunID, v))
+}
+
+// RunIDLT applies the LT predicate on the "run_id" field.
+func RunIDLT(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLT(FieldRunID, v))
+}
+
+// RunIDLTE applies the LTE predicate on the "run_id" field.
+func RunIDLTE(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLTE(FieldRunID, v))
+}
+
+// RunIDContains applies the Contains predicate on the "run_id" field.
+func RunIDContains(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldContains(FieldRunID, v))
+}
+
+// RunIDHasPrefix applies the HasPrefix predicate on the "run_id" field.
+func RunIDHasPrefix(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldHasPrefix(FieldRunID, v))
+}
+
+// RunIDHasSuffix applies the HasSuffix predicate on the "run_id" field.
+func RunIDHasSuffix(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldHasSuffix(FieldRunID, v))
+}
+
+// RunIDIsNil applies the IsNil predicate on the "run_id" field.
+func RunIDIsNil() predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIsNull(FieldRunID))
+}
+
+// RunIDNotNil applies the NotNil predicate on the "run_id" field.
+func RunIDNotNil() predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotNull(FieldRunID))
+}
+
+// RunIDEqualFold applies the EqualFold predicate on the "run_id" field.
+func RunIDEqualFold(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEqualFold(FieldRunID, v))
+}
+
+// RunIDContainsFold applies the ContainsFold predicate on the "run_id" field.
+func RunIDContainsFold(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldContainsFold(FieldRunID, v))
+}
+
+// UrnEQ applies the EQ predicate on the "urn" field.
+func UrnEQ(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldUrn, v))
+}
+
+// UrnNEQ applies the NEQ predicate on the "urn" field.
+func UrnNEQ(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldUrn, v))
+}
+
+// UrnIn applies the In predicate on the "urn" field.
+func UrnIn(vs ...string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIn(FieldUrn, vs...))
+}
+
+// UrnNotIn applies the NotIn predicate on the "urn" field.
+func UrnNotIn(vs ...string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotIn(FieldUrn, vs...))
+}
+
+// UrnGT applies the GT predicate on the "urn" field.
+func UrnGT(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGT(FieldUrn, v))
+}
+
+// UrnGTE applies the GTE predicate on the "urn" field.
+func UrnGTE(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGTE(FieldUrn, v))
+}
+
+// UrnLT applies the LT predicate on the "urn" field.
+func UrnLT(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLT(FieldUrn, v))
+}
+
+// UrnLTE applies the LTE predicate on the "urn" field.
+func UrnLTE(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLTE(FieldUrn, v))
+}
+
+// UrnContains applies the Contains predicate on the "urn" field.
+func UrnContains(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldContains(FieldUrn, v))
+}
+
+// UrnHasPrefix applies the HasPrefix predicate on the "urn" field.
+func UrnHasPrefix(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldHasPrefix(FieldUrn, v))
+}
+
+// UrnHasSuffix applies the HasSuffix predicate on the "urn" field.
+func UrnHasSuffix(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldHasSuffix(FieldUrn, v))
+}
+
+// UrnIsNil applies the IsNil predicate on the "urn" field.
+func UrnIsNil() predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIsNull(FieldUrn))
+}
+
+// UrnNotNil applies the NotNil predicate on the "urn" field.
+func UrnNotNil() predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotNull(FieldUrn))
+}
+
+// UrnEqualFold applies the EqualFold predicate on the "urn" field.
+func UrnEqualFold(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEqualFold(FieldUrn, v))
+}
+
+// UrnContainsFold applies the ContainsFold predicate on the "urn" field.
+func UrnContainsFold(v string) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldContainsFold(FieldUrn, v))
+}
+
+// HTTPStatusEQ applies the EQ predicate on the "http_status" field.
+func HTTPStatusEQ(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldHTTPStatus, v))
+}
+
+// HTTPStatusNEQ applies the NEQ predicate on the "http_status" field.
+func HTTPStatusNEQ(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldHTTPStatus, v))
+}
+
+// HTTPStatusIn applies the In predicate on the "http_status" field.
+func HTTPStatusIn(vs ...int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIn(FieldHTTPStatus, vs...))
+}
+
+// HTTPStatusNotIn applies the NotIn predicate on the "http_status" field.
+func HTTPStatusNotIn(vs ...int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotIn(FieldHTTPStatus, vs...))
+}
+
+// HTTPStatusGT applies the GT predicate on the "http_status" field.
+func HTTPStatusGT(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGT(FieldHTTPStatus, v))
+}
+
+// HTTPStatusGTE applies the GTE predicate on the "http_status" field.
+func HTTPStatusGTE(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGTE(FieldHTTPStatus, v))
+}
+
+// HTTPStatusLT applies the LT predicate on the "http_status" field.
+func HTTPStatusLT(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLT(FieldHTTPStatus, v))
+}
+
+// HTTPStatusLTE applies the LTE predicate on the "http_status" field.
+func HTTPStatusLTE(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLTE(FieldHTTPStatus, v))
+}
+
+// HTTPStatusIsNil applies the IsNil predicate on the "http_status" field.
+func HTTPStatusIsNil() predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIsNull(FieldHTTPStatus))
+}
+
+// HTTPStatusNotNil applies the NotNil predicate on the "http_status" field.
+func HTTPStatusNotNil() predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotNull(FieldHTTPStatus))
+}
+
+// LatencyMsEQ applies the EQ predicate on the "latency_ms" field.
+func LatencyMsEQ(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsNEQ applies the NEQ predicate on the "latency_ms" field.
+func LatencyMsNEQ(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsIn applies the In predicate on the "latency_ms" field.
+func LatencyMsIn(vs ...int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsNotIn applies the NotIn predicate on the "latency_ms" field.
+func LatencyMsNotIn(vs ...int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsGT applies the GT predicate on the "latency_ms" field.
+func LatencyMsGT(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGT(FieldLatencyMs, v))
+}
+
+// LatencyMsGTE applies the GTE predicate on the "latency_ms" field.
+func LatencyMsGTE(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGTE(FieldLatencyMs, v))
+}
+
+// LatencyMsLT applies the LT predicate on the "latency_ms" field.
+func LatencyMsLT(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLT(FieldLatencyMs, v))
+}
+
+// LatencyMsLTE applies the LTE predicate on the "latency_ms" field.
+func LatencyMsLTE(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLTE(FieldLatencyMs, v))
+}
+
+// BytesEQ applies the EQ predicate on the "bytes" field.
+func BytesEQ(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldBytes, v))
+}
+
+// BytesNEQ applies the NEQ predicate on the "bytes" field.
+func BytesNEQ(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldBytes, v))
+}
+
+// BytesIn applies the In predicate on the "bytes" field.
+func BytesIn(vs ...int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIn(FieldBytes, vs...))
+}
+
+// BytesNotIn applies the NotIn predicate on the "bytes" field.
+func BytesNotIn(vs ...int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotIn(FieldBytes, vs...))
+}
+
+// BytesGT applies the GT predicate on the "bytes" field.
+func BytesGT(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGT(FieldBytes, v))
+}
+
+// BytesGTE applies the GTE predicate on the "bytes" field.
+func BytesGTE(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGTE(FieldBytes, v))
+}
+
+// BytesLT applies the LT predicate on the "bytes" field.
+func BytesLT(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLT(FieldBytes, v))
+}
+
+// BytesLTE applies the LTE predicate on the "bytes" field.
+func BytesLTE(v int) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLTE(FieldBytes, v))
+}
+
+// CountedEQ applies the EQ predicate on the "counted" field.
+func CountedEQ(v bool) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldCounted, v))
+}
+
+// CountedNEQ applies the NEQ predicate on the "counted" field.
+func CountedNEQ(v bool) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldCounted, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.APICallLog {
+	return predicate.APICallLog(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasJobExecution applies the HasEdge predicate on the "job_execution" edge.
+func HasJobExecution() predicate.APICallLog {
+	return predicate.APICallLog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, JobExecutionTable, JobExecutionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJobExecutionWith applies the HasEdge predicate on the "job_execution" edge with a given conditions (other predicates).
+func HasJobExecutionWith(preds ...predicate.JobExecutionHistory) predicate.APICallLog {
+	return predicate.APICallLog(func(s *sql.Selector) {
+		step := newJobExecutionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.APICallLog) predicate.APICallLog {
+	return predicate.APICallLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.APICallLog) predicate.APICallLog {
+	return predicate.APICallLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.APICallLog) predicate.APICallLog {
+	return predicate.APICallLog(sql.NotPredicates(p))
+}
@@ -0,0 +1,1264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/jobexecutionhistory"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APICallLogCreate is the builder for creating a APICallLog entity.
+type APICallLogCreate struct {
+	config
+	mutation *APICallLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (aclc *APICallLogCreate) SetEndpoint(s string) *APICallLogCreate {
+	aclc.mutation.SetEndpoint(s)
+	return aclc
+}
+
+// SetKeyLabel sets the "key_label" field.
+func (aclc *APICallLogCreate) SetKeyLabel(s string) *APICallLogCreate {
+	aclc.mutation.SetKeyLabel(s)
+	return aclc
+}
+
+// SetCaller sets the "caller" field.
+func (aclc *APICallLogCreate) SetCaller(s string) *APICallLogCreate {
+	aclc.mutation.SetCaller(s)
+	return aclc
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (aclc *APICallLogCreate) SetNillableCaller(s *string) *APICallLogCreate {
+	if s != nil {
+		aclc.SetCaller(*s)
+	}
+	return aclc
+}
+
+// SetRunID sets the "run_id" field.
+func (aclc *APICallLogCreate) SetRunID(s string) *APICallLogCreate {
+	aclc.mutation.SetRunID(s)
+	return aclc
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (aclc *APICallLogCreate) SetNillableRunID(s *string) *APICallLogCreate {
+	if s != nil {
+		aclc.SetRunID(*s)
+	}
+	return aclc
+}
+
+// SetUrn sets the "urn" field.
+func (aclc *APICallLogCreate) SetUrn(s string) *APICallLogCreate {
+	aclc.mutation.SetUrn(s)
+	return aclc
+}
+
+// SetNillableUrn sets the "urn" field if the given value is not nil.
+func (aclc *APICallLogCreate) SetNillableUrn(s *string) *APICallLogCreate {
+	if s != nil {
+		aclc.SetUrn(*s)
+	}
+	return aclc
+}
+
+// SetHTTPStatus sets the "http_status" field.
+func (aclc *APICallLogCreate) SetHTTPStatus(i int) *APICallLogCreate {
+	aclc.mutation.SetHTTPStatus(i)
+	return aclc
+}
+
+// SetNillableHTTPStatus sets the "http_status" field if the given value is not nil.
+func (aclc *APICallLogCreate) SetNillableHTTPStatus(i *int) *APICallLogCreate {
+	if i != nil {
+		aclc.SetHTTPStatus(*i)
+	}
+	return aclc
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (aclc *APICallLogCreate) SetLatencyMs(i int) *APICallLogCreate {
+	aclc.mutation.SetLatencyMs(i)
+	return aclc
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (aclc *APICallLogCreate) SetNillableLatencyMs(i *int) *APICallLogCreate {
+	if i != nil {
+		aclc.SetLatencyMs(*i)
+	}
+	return aclc
+}
+
+// SetBytes sets the "bytes" field.
+func (aclc *APICallLogCreate) SetBytes(i int) *APICallLogCreate {
+	aclc.mutation.SetBytes(i)
+	return aclc
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (aclc *APICallLogCreate) SetNillableBytes(i *int) *APICallLogCreate {
+	if i != nil {
+		aclc.SetBytes(*i)
+	}
+	return aclc
+}
+
+// SetCounted sets the "counted" field.
+func (aclc *APICallLogCreate) SetCounted(b bool) *APICallLogCreate {
+	aclc.mutation.SetCounted(b)
+	return aclc
+}
+
+// SetNillableCounted sets the "counted" field if the given value is not nil.
+func (aclc *APICallLogCreate) SetNillableCounted(b *bool) *APICallLogCreate {
+	if b != nil {
+		aclc.SetCounted(*b)
+	}
+	return aclc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aclc *APICallLogCreate) SetCreatedAt(t time.Time) *APICallLogCreate {
+	aclc.mutation.SetCreatedAt(t)
+	return aclc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aclc *APICallLogCreate) SetNillableCreatedAt(t *time.Time) *APICallLogCreate {
+	if t != nil {
+		aclc.SetCreatedAt(*t)
+	}
+	return aclc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aclc *APICallLogCreate) SetUpdatedAt(t time.Time) *APICallLogCreate {
+	aclc.mutation.SetUpdatedAt(t)
+	return aclc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (aclc *APICallLogCreate) SetNillableUpdatedAt(t *time.Time) *APICallLogCreate {
+	if t != nil {
+		aclc.SetUpdatedAt(*t)
+	}
+	return aclc
+}
+
+// SetID sets the "id" field.
+func (aclc *APICallLogCreate) SetID(u ulid.ID) *APICallLogCreate {
+	aclc.mutation.SetID(u)
+	return aclc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (aclc *APICallLogCreate) SetNillableID(u *ulid.ID) *APICallLogCreate {
+	if u != nil {
+		aclc.SetID(*u)
+	}
+	return aclc
+}
+
+// SetJobExecutionID sets the "job_execution" edge to the JobExecutionHistory entity by ID.
+func (aclc *APICallLogCreate) SetJobExecutionID(id ulid.ID) *APICallLogCreate {
+	aclc.mutation.SetJobExecutionID(id)
+	return aclc
+}
+
+// SetNillableJobExecutionID sets the "job_execution" edge to the JobExecutionHistory entity by ID if the given value is not nil.
+func (aclc *APICallLogCreate) SetNillableJobExecutionID(id *ulid.ID) *APICallLogCreate {
+	if id != nil {
+		aclc = aclc.SetJobExecutionID(*id)
+	}
+	return aclc
+}
+
+// SetJobExecution sets the "job_execution" edge to the JobExecutionHistory entity.
+func (aclc *APICallLogCreate) SetJobExecution(j *JobExecutionHistory) *APICallLogCreate {
+	return aclc.SetJobExecutionID(j.ID)
+}
+
+// Mutation returns the APICallLogMutation object of the builder.
+func (aclc *APICallLogCreate) Mutation() *APICallLogMutation {
+	return aclc.mutation
+}
+
+// Save creates the APICallLog in the database.
+func (aclc *APICallLogCreate) Save(ctx context.Context) (*APICallLog, error) {
+	aclc.defaults()
+	return withHooks(ctx, aclc.sqlSave, aclc.mutation, aclc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aclc *APICallLogCreate) SaveX(ctx context.Context) *APICallLog {
+	v, err := aclc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aclc *APICallLogCreate) Exec(ctx context.Context) error {
+	_, err := aclc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aclc *APICallLogCreate) ExecX(ctx context.Context) {
+	if err := aclc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aclc *APICallLogCreate) defaults() {
+	if _, ok := aclc.mutation.Caller(); !ok {
+		v := apicalllog.DefaultCaller
+		aclc.mutation.SetCaller(v)
+	}
+	if _, ok := aclc.mutation.LatencyMs(); !ok {
+		v := apicalllog.DefaultLatencyMs
+		aclc.mutation.SetLatencyMs(v)
+	}
+	if _, ok := aclc.mutation.Bytes(); !ok {
+		v := apicalllog.DefaultBytes
+		aclc.mutation.SetBytes(v)
+	}
+	if _, ok := aclc.mutation.Counted(); !ok {
+		v := apicalllog.DefaultCounted
+		aclc.mutation.SetCounted(v)
+	}
+	if _, ok := aclc.mutation.CreatedAt(); !ok {
+		v := apicalllog.DefaultCreatedAt()
+		aclc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aclc.mutation.UpdatedAt(); !ok {
+		v := apicalllog.DefaultUpdatedAt()
+		aclc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := aclc.mutation.ID(); !ok {
+		v := apicalllog.DefaultID()
+		aclc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aclc *APICallLogCreate) check() error {
+	if _, ok := aclc.mutation.Endpoint(); !ok {
+		return &ValidationError{Name: "endpoint", err: errors.New(`ent: missing required field "APICallLog.endpoint"`)}
+	}
+	if v, ok := aclc.mutation.Endpoint(); ok {
+		if err := apicalllog.EndpointValidator(v); err != nil {
+			return &ValidationError{Name: "endpoint", err: fmt.Errorf(`ent: validator failed for field "APICallLog.endpoint": %w`, err)}
+		}
+	}
+	if _, ok := aclc.mutation.KeyLabel(); !ok {
+		return &ValidationError{Name: "key_label", err: errors.New(`ent: missing required field "APICallLog.key_label"`)}
+	}
+	if _, ok := aclc.mutation.Caller(); !ok {
+		return &ValidationError{Name: "caller", err: errors.New(`ent: missing required field "APICallLog.caller"`)}
+	}
+	if _, ok := aclc.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "APICallLog.latency_ms"`)}
+	}
+	if v, ok := aclc.mutation.LatencyMs(); ok {
+		if err := apicalllog.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "APICallLog.latency_ms": %w`, err)}
+		}
+	}
+	if _, ok := aclc.mutation.Bytes(); !ok {
+		return &ValidationError{Name: "bytes", err: errors.New(`ent: missing required field "APICallLog.bytes"`)}
+	}
+	if v, ok := aclc.mutation.Bytes(); ok {
+		if err := apicalllog.BytesValidator(v); err != nil {
+			return &ValidationError{Name: "bytes", err: fmt.Errorf(`ent: validator failed for field "APICallLog.bytes": %w`, err)}
+		}
+	}
+	if _, ok := aclc.mutation.Counted(); !ok {
+		return &ValidationError{Name: "counted", err: errors.New(`ent: missing required field "APICallLog.counted"`)}
+	}
+	if _, ok := aclc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "APICallLog.created_at"`)}
+	}
+	if _, ok := aclc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "APICallLog.updated_at"`)}
+	}
+	return nil
+}
+
+func (aclc *APICallLogCreate) sqlSave(ctx context.Context) (*APICallLog, error) {
+	if err := aclc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aclc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aclc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*ulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	aclc.mutation.id = &_node.ID
+	aclc.mutation.done = true
+	return _node, nil
+}
+
+func (aclc *APICallLogCreate) createSpec() (*APICallLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &APICallLog{config: aclc.config}
+		_spec = sqlgraph.NewCreateSpec(apicalllog.Table, sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = aclc.conflict
+	if id, ok := aclc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := aclc.mutation.Endpoint(); ok {
+		_spec.SetField(apicalllog.FieldEndpoint, field.TypeString, value)
+		_node.Endpoint = value
+	}
+	if value, ok := aclc.mutation.KeyLabel(); ok {
+		_spec.SetField(apicalllog.FieldKeyLabel, field.TypeString, value)
+		_node.KeyLabel = value
+	}
+	if value, ok := aclc.mutation.Caller(); ok {
+		_spec.SetField(apicalllog.FieldCaller, field.TypeString, value)
+		_node.Caller = value
+	}
+	if value, ok := aclc.mutation.RunID(); ok {
+		_spec.SetField(apicalllog.FieldRunID, field.TypeString, value)
+		_node.RunID = value
+	}
+	if value, ok := aclc.mutation.Urn(); ok {
+		_spec.SetField(apicalllog.FieldUrn, field.TypeString, value)
+		_node.Urn = value
+	}
+	if value, ok := aclc.mutation.HTTPStatus(); ok {
+		_spec.SetField(apicalllog.FieldHTTPStatus, field.TypeInt, value)
+		_node.HTTPStatus = &value
+	}
+	if value, ok := aclc.mutation.LatencyMs(); ok {
+		_spec.SetField(apicalllog.FieldLatencyMs, field.TypeInt, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := aclc.mutation.Bytes(); ok {
+		_spec.SetField(apicalllog.FieldBytes, field.TypeInt, value)
+		_node.Bytes = value
+	}
+	if value, ok := aclc.mutation.Counted(); ok {
+		_spec.SetField(apicalllog.FieldCounted, field.TypeBool, value)
+		_node.Counted = value
+	}
+	if value, ok := aclc.mutation.CreatedAt(); ok {
+		_spec.SetField(apicalllog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := aclc.mutation.UpdatedAt(); ok {
+		_spec.SetField(apicalllog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := aclc.mutation.JobExecutionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apicalllog.JobExecutionTable,
+			Columns: []string{apicalllog.JobExecutionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobexecutionhistory.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.job_execution_history_api_calls = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APICallLog.Create().
+//		SetEndpoint(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APICallLogUpsert) {
+//			SetEndpoint(v+v).
+//		}).
+//		Exec(ctx)
+func (aclc *APICallLogCreate) OnConflict(opts ...sql.ConflictOption) *APICallLogUpsertOne {
+	aclc.conflict = opts
+	return &APICallLogUpsertOne{
+		create: aclc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APICallLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aclc *APICallLogCreate) OnConflictColumns(columns ...string) *APICallLogUpsertOne {
+	aclc.conflict = append(aclc.conflict, sql.ConflictColumns(columns...))
+	return &APICallLogUpsertOne{
+		create: aclc,
+	}
+}
+
+type (
+	// APICallLogUpsertOne is the builder for "upsert"-ing
+	//  one APICallLog node.
+	APICallLogUpsertOne struct {
+		create *APICallLogCreate
+	}
+
+	// APICallLogUpsert is the "OnConflict" setter.
+	APICallLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEndpoint sets the "endpoint" field.
+func (u *APICallLogUpsert) SetEndpoint(v string) *APICallLogUpsert {
+	u.Set(apicalllog.FieldEndpoint, v)
+	return u
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *APICallLogUpsert) UpdateEndpoint() *APICallLogUpsert {
+	u.SetExcluded(apicalllog.FieldEndpoint)
+	return u
+}
+
+// SetKeyLabel sets the "key_label" field.
+func (u *APICallLogUpsert) SetKeyLabel(v string) *APICallLogUpsert {
+	u.Set(apicalllog.FieldKeyLabel, v)
+	return u
+}
+
+// UpdateKeyLabel sets the "key_label" field to the value that was provided on create.
+func (u *APICallLogUpsert) UpdateKeyLabel() *APICallLogUpsert {
+	u.SetExcluded(apicalllog.FieldKeyLabel)
+	return u
+}
+
+// SetCaller sets the "caller" field.
+func (u *APICallLogUpsert) SetCaller(v string) *APICallLogUpsert {
+	u.Set(apicalllog.FieldCaller, v)
+	return u
+}
+
+// UpdateCaller sets the "caller" field to the value that was provided on create.
+func (u *APICallLogUpsert) UpdateCaller() *APICallLogUpsert {
+	u.SetExcluded(apicalllog.FieldCaller)
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *APICallLogUpsert) SetRunID(v string) *APICallLogUpsert {
+	u.Set(apicalllog.FieldRunID, v)
+	return u
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *APICallLogUpsert) UpdateRunID() *APICallLogUpsert {
+	u.SetExcluded(apicalllog.FieldRunID)
+	return u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *APICallLogUpsert) ClearRunID() *APICallLogUpsert {
+	u.SetNull(apicalllog.FieldRunID)
+	return u
+}
+
+// SetUrn sets the "urn" field.
+func (u *APICallLogUpsert) SetUrn(v string) *APICallLogUpsert {
+	u.Set(apicalllog.FieldUrn, v)
+	return u
+}
+
+// UpdateUrn sets the "urn" field to the value that was provided on create.
+func (u *APICallLogUpsert) UpdateUrn() *APICallLogUpsert {
+	u.SetExcluded(apicalllog.FieldUrn)
+	return u
+}
+
+// ClearUrn clears the value of the "urn" field.
+func (u *APICallLogUpsert) ClearUrn() *APICallLogUpsert {
+	u.SetNull(apicalllog.FieldUrn)
+	return u
+}
+
+// SetHTTPStatus sets the "http_status" field.
+func (u *APICallLogUpsert) SetHTTPStatus(v int) *APICallLogUpsert {
+	u.Set(apicalllog.FieldHTTPStatus, v)
+	return u
+}
+
+// UpdateHTTPStatus sets the "http_status" field to the value that was provided on create.
+func (u *APICallLogUpsert) UpdateHTTPStatus() *APICallLogUpsert {
+	u.SetExcluded(apicalllog.FieldHTTPStatus)
+	return u
+}
+
+// AddHTTPStatus adds v to the "http_status" field.
+func (u *APICallLogUpsert) AddHTTPStatus(v int) *APICallLogUpsert {
+	u.Add(apicalllog.FieldHTTPStatus, v)
+	return u
+}
+
+// ClearHTTPStatus clears the value of the "http_status" field.
+func (u *APICallLogUpsert) ClearHTTPStatus() *APICallLogUpsert {
+	u.SetNull(apicalllog.FieldHTTPStatus)
+	return u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *APICallLogUpsert) SetLatencyMs(v int) *APICallLogUpsert {
+	u.Set(apicalllog.FieldLatencyMs, v)
+	return u
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *APICallLogUpsert) UpdateLatencyMs() *APICallLogUpsert {
+	u.SetExcluded(apicalllog.FieldLatencyMs)
+	return u
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *APICallLogUpsert) AddLatencyMs(v int) *APICallLogUpsert {
+	u.Add(apicalllog.FieldLatencyMs, v)
+	return u
+}
+
+// SetBytes sets the "bytes" field.
+func (u *APICallLogUpsert) SetBytes(v int) *APICallLogUpsert {
+	u.Set(apicalllog.FieldBytes, v)
+	return u
+}
+
+// UpdateBytes sets the "bytes" field to the value that was provided on create.
+func (u *APICallLogUpsert) UpdateBytes() *APICallLogUpsert {
+	u.SetExcluded(apicalllog.FieldBytes)
+	return u
+}
+
+// AddBytes adds v to the "bytes" field.
+func (u *APICallLogUpsert) AddBytes(v int) *APICallLogUpsert {
+	u.Add(apicalllog.FieldBytes, v)
+	return u
+}
+
+// SetCounted sets the "counted" field.
+func (u *APICallLogUpsert) SetCounted(v bool) *APICallLogUpsert {
+	u.Set(apicalllog.FieldCounted, v)
+	return u
+}
+
+// UpdateCounted sets the "counted" field to the value that was provided on create.
+func (u *APICallLogUpsert) UpdateCounted() *APICallLogUpsert {
+	u.SetExcluded(apicalllog.FieldCounted)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APICallLogUpsert) SetUpdatedAt(v time.Time) *APICallLogUpsert {
+	u.Set(apicalllog.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APICallLogUpsert) UpdateUpdatedAt() *APICallLogUpsert {
+	u.SetExcluded(apicalllog.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.APICallLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apicalllog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APICallLogUpsertOne) UpdateNewValues() *APICallLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(apicalllog.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(apicalllog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APICallLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *APICallLogUpsertOne) Ignore() *APICallLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APICallLogUpsertOne) DoNothing() *APICallLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APICallLogCreate.OnConflict
+// documentation for more info.
+func (u *APICallLogUpsertOne) Update(set func(*APICallLogUpsert)) *APICallLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APICallLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *APICallLogUpsertOne) SetEndpoint(v string) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetEndpoint(v)
+	})
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *APICallLogUpsertOne) UpdateEndpoint() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateEndpoint()
+	})
+}
+
+// SetKeyLabel sets the "key_label" field.
+func (u *APICallLogUpsertOne) SetKeyLabel(v string) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetKeyLabel(v)
+	})
+}
+
+// UpdateKeyLabel sets the "key_label" field to the value that was provided on create.
+func (u *APICallLogUpsertOne) UpdateKeyLabel() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateKeyLabel()
+	})
+}
+
+// SetCaller sets the "caller" field.
+func (u *APICallLogUpsertOne) SetCaller(v string) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetCaller(v)
+	})
+}
+
+// UpdateCaller sets the "caller" field to the value that was provided on create.
+func (u *APICallLogUpsertOne) UpdateCaller() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateCaller()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *APICallLogUpsertOne) SetRunID(v string) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *APICallLogUpsertOne) UpdateRunID() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *APICallLogUpsertOne) ClearRunID() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetUrn sets the "urn" field.
+func (u *APICallLogUpsertOne) SetUrn(v string) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetUrn(v)
+	})
+}
+
+// UpdateUrn sets the "urn" field to the value that was provided on create.
+func (u *APICallLogUpsertOne) UpdateUrn() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateUrn()
+	})
+}
+
+// ClearUrn clears the value of the "urn" field.
+func (u *APICallLogUpsertOne) ClearUrn() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.ClearUrn()
+	})
+}
+
+// SetHTTPStatus sets the "http_status" field.
+func (u *APICallLogUpsertOne) SetHTTPStatus(v int) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetHTTPStatus(v)
+	})
+}
+
+// AddHTTPStatus adds v to the "http_status" field.
+func (u *APICallLogUpsertOne) AddHTTPStatus(v int) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.AddHTTPStatus(v)
+	})
+}
+
+// UpdateHTTPStatus sets the "http_status" field to the value that was provided on create.
+func (u *APICallLogUpsertOne) UpdateHTTPStatus() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateHTTPStatus()
+	})
+}
+
+// ClearHTTPStatus clears the value of the "http_status" field.
+func (u *APICallLogUpsertOne) ClearHTTPStatus() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.ClearHTTPStatus()
+	})
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *APICallLogUpsertOne) SetLatencyMs(v int) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetLatencyMs(v)
+	})
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *APICallLogUpsertOne) AddLatencyMs(v int) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.AddLatencyMs(v)
+	})
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *APICallLogUpsertOne) UpdateLatencyMs() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateLatencyMs()
+	})
+}
+
+// SetBytes sets the "bytes" field.
+func (u *APICallLogUpsertOne) SetBytes(v int) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetBytes(v)
+	})
+}
+
+// AddBytes adds v to the "bytes" field.
+func (u *APICallLogUpsertOne) AddBytes(v int) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.AddBytes(v)
+	})
+}
+
+// UpdateBytes sets the "bytes" field to the value that was provided on create.
+func (u *APICallLogUpsertOne) UpdateBytes() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateBytes()
+	})
+}
+
+// SetCounted sets the "counted" field.
+func (u *APICallLogUpsertOne) SetCounted(v bool) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetCounted(v)
+	})
+}
+
+// UpdateCounted sets the "counted" field to the value that was provided on create.
+func (u *APICallLogUpsertOne) UpdateCounted() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateCounted()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APICallLogUpsertOne) SetUpdatedAt(v time.Time) *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APICallLogUpsertOne) UpdateUpdatedAt() *APICallLogUpsertOne {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *APICallLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APICallLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APICallLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *APICallLogUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: APICallLogUpsertOne.ID is not supported by MySQL driver. Use APICallLogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *APICallLogUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// APICallLogCreateBulk is the builder for creating many APICallLog entities in bulk.
+type APICallLogCreateBulk struct {
+	config
+	err      error
+	builders []*APICallLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the APICallLog entities in the database.
+func (aclcb *APICallLogCreateBulk) Save(ctx context.Context) ([]*APICallLog, error) {
+	if aclcb.err != nil {
+		return nil, aclcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aclcb.builders))
+	nodes := make([]*APICallLog, len(aclcb.builders))
+	mutators := make([]Mutator, len(aclcb.builders))
+	for i := range aclcb.builders {
+		func(i int, root context.Context) {
+			builder := aclcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*APICallLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aclcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = aclcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aclcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aclcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aclcb *APICallLogCreateBulk) SaveX(ctx context.Context) []*APICallLog {
+	v, err := aclcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aclcb *APICallLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := aclcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aclcb *APICallLogCreateBulk) ExecX(ctx context.Context) {
+	if err := aclcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APICallLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APICallLogUpsert) {
+//			SetEndpoint(v+v).
+//		}).
+//		Exec(ctx)
+func (aclcb *APICallLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *APICallLogUpsertBulk {
+	aclcb.conflict = opts
+	return &APICallLogUpsertBulk{
+		create: aclcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APICallLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aclcb *APICallLogCreateBulk) OnConflictColumns(columns ...string) *APICallLogUpsertBulk {
+	aclcb.conflict = append(aclcb.conflict, sql.ConflictColumns(columns...))
+	return &APICallLogUpsertBulk{
+		create: aclcb,
+	}
+}
+
+// APICallLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of APICallLog nodes.
+type APICallLogUpsertBulk struct {
+	create *APICallLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.APICallLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apicalllog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APICallLogUpsertBulk) UpdateNewValues() *APICallLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(apicalllog.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(apicalllog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APICallLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *APICallLogUpsertBulk) Ignore() *APICallLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APICallLogUpsertBulk) DoNothing() *APICallLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APICallLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *APICallLogUpsertBulk) Update(set func(*APICallLogUpsert)) *APICallLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APICallLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *APICallLogUpsertBulk) SetEndpoint(v string) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetEndpoint(v)
+	})
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *APICallLogUpsertBulk) UpdateEndpoint() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateEndpoint()
+	})
+}
+
+// SetKeyLabel sets the "key_label" field.
+func (u *APICallLogUpsertBulk) SetKeyLabel(v string) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetKeyLabel(v)
+	})
+}
+
+// UpdateKeyLabel sets the "key_label" field to the value that was provided on create.
+func (u *APICallLogUpsertBulk) UpdateKeyLabel() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateKeyLabel()
+	})
+}
+
+// SetCaller sets the "caller" field.
+func (u *APICallLogUpsertBulk) SetCaller(v string) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetCaller(v)
+	})
+}
+
+// UpdateCaller sets the "caller" field to the value that was provided on create.
+func (u *APICallLogUpsertBulk) UpdateCaller() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateCaller()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *APICallLogUpsertBulk) SetRunID(v string) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *APICallLogUpsertBulk) UpdateRunID() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *APICallLogUpsertBulk) ClearRunID() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetUrn sets the "urn" field.
+func (u *APICallLogUpsertBulk) SetUrn(v string) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetUrn(v)
+	})
+}
+
+// UpdateUrn sets the "urn" field to the value that was provided on create.
+func (u *APICallLogUpsertBulk) UpdateUrn() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateUrn()
+	})
+}
+
+// ClearUrn clears the value of the "urn" field.
+func (u *APICallLogUpsertBulk) ClearUrn() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.ClearUrn()
+	})
+}
+
+// SetHTTPStatus sets the "http_status" field.
+func (u *APICallLogUpsertBulk) SetHTTPStatus(v int) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetHTTPStatus(v)
+	})
+}
+
+// AddHTTPStatus adds v to the "http_status" field.
+func (u *APICallLogUpsertBulk) AddHTTPStatus(v int) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.AddHTTPStatus(v)
+	})
+}
+
+// UpdateHTTPStatus sets the "http_status" field to the value that was provided on create.
+func (u *APICallLogUpsertBulk) UpdateHTTPStatus() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateHTTPStatus()
+	})
+}
+
+// ClearHTTPStatus clears the value of the "http_status" field.
+func (u *APICallLogUpsertBulk) ClearHTTPStatus() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.ClearHTTPStatus()
+	})
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *APICallLogUpsertBulk) SetLatencyMs(v int) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetLatencyMs(v)
+	})
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *APICallLogUpsertBulk) AddLatencyMs(v int) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.AddLatencyMs(v)
+	})
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *APICallLogUpsertBulk) UpdateLatencyMs() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateLatencyMs()
+	})
+}
+
+// SetBytes sets the "bytes" field.
+func (u *APICallLogUpsertBulk) SetBytes(v int) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetBytes(v)
+	})
+}
+
+// AddBytes adds v to the "bytes" field.
+func (u *APICallLogUpsertBulk) AddBytes(v int) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.AddBytes(v)
+	})
+}
+
+// UpdateBytes sets the "bytes" field to the value that was provided on create.
+func (u *APICallLogUpsertBulk) UpdateBytes() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateBytes()
+	})
+}
+
+// SetCounted sets the "counted" field.
+func (u *APICallLogUpsertBulk) SetCounted(v bool) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetCounted(v)
+	})
+}
+
+// UpdateCounted sets the "counted" field to the value that was provided on create.
+func (u *APICallLogUpsertBulk) UpdateCounted() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateCounted()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APICallLogUpsertBulk) SetUpdatedAt(v time.Time) *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APICallLogUpsertBulk) UpdateUpdatedAt() *APICallLogUpsertBulk {
+	return u.Update(func(s *APICallLogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *APICallLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the APICallLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APICallLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APICallLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APICallLogDelete is the builder for deleting a APICallLog entity.
+type APICallLogDelete struct {
+	config
+	hooks    []Hook
+	mutation *APICallLogMutation
+}
+
+// Where appends a list predicates to the APICallLogDelete builder.
+func (acld *APICallLogDelete) Where(ps ...predicate.APICallLog) *APICallLogDelete {
+	acld.mutation.Where(ps...)
+	return acld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (acld *APICallLogDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, acld.sqlExec, acld.mutation, acld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acld *APICallLogDelete) ExecX(ctx context.Context) int {
+	n, err := acld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (acld *APICallLogDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(apicalllog.Table, sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString))
+	if ps := acld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, acld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	acld.mutation.done = true
+	return affected, err
+}
+
+// APICallLogDeleteOne is the builder for deleting a single APICallLog entity.
+type APICallLogDeleteOne struct {
+	acld *APICallLogDelete
+}
+
+// Where appends a list predicates to the APICallLogDelete builder.
+func (acldo *APICallLogDeleteOne) Where(ps ...predicate.APICallLog) *APICallLogDeleteOne {
+	acldo.acld.mutation.Where(ps...)
+	return acldo
+}
+
+// Exec executes the deletion query.
+func (acldo *APICallLogDeleteOne) Exec(ctx context.Context) error {
+	n, err := acldo.acld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{apicalllog.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acldo *APICallLogDeleteOne) ExecX(ctx context.Context) {
+	if err := acldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
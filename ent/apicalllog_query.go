@@ -0,0 +1,658 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/jobexecutionhistory"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APICallLogQuery is the builder for querying APICallLog entities.
+type APICallLogQuery struct {
+	config
+	ctx              *QueryContext
+	order            []apicalllog.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.APICallLog
+	withJobExecution *JobExecutionHistoryQuery
+	withFKs          bool
+	loadTotal        []func(context.Context, []*APICallLog) error
+	modifiers        []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the APICallLogQuery builder.
+func (aclq *APICallLogQuery) Where(ps ...predicate.APICallLog) *APICallLogQuery {
+	aclq.predicates = append(aclq.predicates, ps...)
+	return aclq
+}
+
+// Limit the number of records to be returned by this query.
+func (aclq *APICallLogQuery) Limit(limit int) *APICallLogQuery {
+	aclq.ctx.Limit = &limit
+	return aclq
+}
+
+// Offset to start from.
+func (aclq *APICallLogQuery) Offset(offset int) *APICallLogQuery {
+	aclq.ctx.Offset = &offset
+	return aclq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (aclq *APICallLogQuery) Unique(unique bool) *APICallLogQuery {
+	aclq.ctx.Unique = &unique
+	return aclq
+}
+
+// Order specifies how the records should be ordered.
+func (aclq *APICallLogQuery) Order(o ...apicalllog.OrderOption) *APICallLogQuery {
+	aclq.order = append(aclq.order, o...)
+	return aclq
+}
+
+// QueryJobExecution chains the current query on the "job_execution" edge.
+func (aclq *APICallLogQuery) QueryJobExecution() *JobExecutionHistoryQuery {
+	query := (&JobExecutionHistoryClient{config: aclq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aclq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aclq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apicalllog.Table, apicalllog.FieldID, selector),
+			sqlgraph.To(jobexecutionhistory.Table, jobexecutionhistory.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apicalllog.JobExecutionTable, apicalllog.JobExecutionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aclq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first APICallLog entity from the query.
+// Returns a *NotFoundError when no APICallLog was found.
+func (aclq *APICallLogQuery) First(ctx context.Context) (*APICallLog, error) {
+	nodes, err := aclq.Limit(1).All(setContextOp(ctx, aclq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{apicalllog.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (aclq *APICallLogQuery) FirstX(ctx context.Context) *APICallLog {
+	node, err := aclq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first APICallLog ID from the query.
+// Returns a *NotFoundError when no APICallLog ID was found.
+func (aclq *APICallLogQuery) FirstID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = aclq.Limit(1).IDs(setContextOp(ctx, aclq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{apicalllog.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (aclq *APICallLogQuery) FirstIDX(ctx context.Context) ulid.ID {
+	id, err := aclq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single APICallLog entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one APICallLog entity is found.
+// Returns a *NotFoundError when no APICallLog entities are found.
+func (aclq *APICallLogQuery) Only(ctx context.Context) (*APICallLog, error) {
+	nodes, err := aclq.Limit(2).All(setContextOp(ctx, aclq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{apicalllog.Label}
+	default:
+		return nil, &NotSingularError{apicalllog.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (aclq *APICallLogQuery) OnlyX(ctx context.Context) *APICallLog {
+	node, err := aclq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only APICallLog ID in the query.
+// Returns a *NotSingularError when more than one APICallLog ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (aclq *APICallLogQuery) OnlyID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = aclq.Limit(2).IDs(setContextOp(ctx, aclq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{apicalllog.Label}
+	default:
+		err = &NotSingularError{apicalllog.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (aclq *APICallLogQuery) OnlyIDX(ctx context.Context) ulid.ID {
+	id, err := aclq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of APICallLogs.
+func (aclq *APICallLogQuery) All(ctx context.Context) ([]*APICallLog, error) {
+	ctx = setContextOp(ctx, aclq.ctx, ent.OpQueryAll)
+	if err := aclq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*APICallLog, *APICallLogQuery]()
+	return withInterceptors[[]*APICallLog](ctx, aclq, qr, aclq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (aclq *APICallLogQuery) AllX(ctx context.Context) []*APICallLog {
+	nodes, err := aclq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of APICallLog IDs.
+func (aclq *APICallLogQuery) IDs(ctx context.Context) (ids []ulid.ID, err error) {
+	if aclq.ctx.Unique == nil && aclq.path != nil {
+		aclq.Unique(true)
+	}
+	ctx = setContextOp(ctx, aclq.ctx, ent.OpQueryIDs)
+	if err = aclq.Select(apicalllog.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (aclq *APICallLogQuery) IDsX(ctx context.Context) []ulid.ID {
+	ids, err := aclq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (aclq *APICallLogQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, aclq.ctx, ent.OpQueryCount)
+	if err := aclq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, aclq, querierCount[*APICallLogQuery](), aclq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (aclq *APICallLogQuery) CountX(ctx context.Context) int {
+	count, err := aclq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (aclq *APICallLogQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, aclq.ctx, ent.OpQueryExist)
+	switch _, err := aclq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (aclq *APICallLogQuery) ExistX(ctx context.Context) bool {
+	exist, err := aclq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the APICallLogQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (aclq *APICallLogQuery) Clone() *APICallLogQuery {
+	if aclq == nil {
+		return nil
+	}
+	return &APICallLogQuery{
+		config:           aclq.config,
+		ctx:              aclq.ctx.Clone(),
+		order:            append([]apicalllog.OrderOption{}, aclq.order...),
+		inters:           append([]Interceptor{}, aclq.inters...),
+		predicates:       append([]predicate.APICallLog{}, aclq.predicates...),
+		withJobExecution: aclq.withJobExecution.Clone(),
+		// clone intermediate query.
+		sql:  aclq.sql.Clone(),
+		path: aclq.path,
+	}
+}
+
+// WithJobExecution tells the query-builder to eager-load the nodes that are connected to
+// the "job_execution" edge. The optional arguments are used to configure the query builder of the edge.
+func (aclq *APICallLogQuery) WithJobExecution(opts ...func(*JobExecutionHistoryQuery)) *APICallLogQuery {
+	query := (&JobExecutionHistoryClient{config: aclq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aclq.withJobExecution = query
+	return aclq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Endpoint string `json:"endpoint,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.APICallLog.Query().
+//		GroupBy(apicalllog.FieldEndpoint).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (aclq *APICallLogQuery) GroupBy(field string, fields ...string) *APICallLogGroupBy {
+	aclq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &APICallLogGroupBy{build: aclq}
+	grbuild.flds = &aclq.ctx.Fields
+	grbuild.label = apicalllog.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Endpoint string `json:"endpoint,omitempty"`
+//	}
+//
+//	client.APICallLog.Query().
+//		Select(apicalllog.FieldEndpoint).
+//		Scan(ctx, &v)
+func (aclq *APICallLogQuery) Select(fields ...string) *APICallLogSelect {
+	aclq.ctx.Fields = append(aclq.ctx.Fields, fields...)
+	sbuild := &APICallLogSelect{APICallLogQuery: aclq}
+	sbuild.label = apicalllog.Label
+	sbuild.flds, sbuild.scan = &aclq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a APICallLogSelect configured with the given aggregations.
+func (aclq *APICallLogQuery) Aggregate(fns ...AggregateFunc) *APICallLogSelect {
+	return aclq.Select().Aggregate(fns...)
+}
+
+func (aclq *APICallLogQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range aclq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, aclq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range aclq.ctx.Fields {
+		if !apicalllog.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if aclq.path != nil {
+		prev, err := aclq.path(ctx)
+		if err != nil {
+			return err
+		}
+		aclq.sql = prev
+	}
+	return nil
+}
+
+func (aclq *APICallLogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*APICallLog, error) {
+	var (
+		nodes       = []*APICallLog{}
+		withFKs     = aclq.withFKs
+		_spec       = aclq.querySpec()
+		loadedTypes = [1]bool{
+			aclq.withJobExecution != nil,
+		}
+	)
+	if aclq.withJobExecution != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, apicalllog.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*APICallLog).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &APICallLog{config: aclq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(aclq.modifiers) > 0 {
+		_spec.Modifiers = aclq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, aclq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := aclq.withJobExecution; query != nil {
+		if err := aclq.loadJobExecution(ctx, query, nodes, nil,
+			func(n *APICallLog, e *JobExecutionHistory) { n.Edges.JobExecution = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range aclq.loadTotal {
+		if err := aclq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (aclq *APICallLogQuery) loadJobExecution(ctx context.Context, query *JobExecutionHistoryQuery, nodes []*APICallLog, init func(*APICallLog), assign func(*APICallLog, *JobExecutionHistory)) error {
+	ids := make([]ulid.ID, 0, len(nodes))
+	nodeids := make(map[ulid.ID][]*APICallLog)
+	for i := range nodes {
+		if nodes[i].job_execution_history_api_calls == nil {
+			continue
+		}
+		fk := *nodes[i].job_execution_history_api_calls
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(jobexecutionhistory.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "job_execution_history_api_calls" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (aclq *APICallLogQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := aclq.querySpec()
+	if len(aclq.modifiers) > 0 {
+		_spec.Modifiers = aclq.modifiers
+	}
+	_spec.Node.Columns = aclq.ctx.Fields
+	if len(aclq.ctx.Fields) > 0 {
+		_spec.Unique = aclq.ctx.Unique != nil && *aclq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, aclq.driver, _spec)
+}
+
+func (aclq *APICallLogQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(apicalllog.Table, apicalllog.Columns, sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString))
+	_spec.From = aclq.sql
+	if unique := aclq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if aclq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := aclq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apicalllog.FieldID)
+		for i := range fields {
+			if fields[i] != apicalllog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := aclq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := aclq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := aclq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := aclq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (aclq *APICallLogQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(aclq.driver.Dialect())
+	t1 := builder.Table(apicalllog.Table)
+	columns := aclq.ctx.Fields
+	if len(columns) == 0 {
+		columns = apicalllog.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if aclq.sql != nil {
+		selector = aclq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if aclq.ctx.Unique != nil && *aclq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range aclq.modifiers {
+		m(selector)
+	}
+	for _, p := range aclq.predicates {
+		p(selector)
+	}
+	for _, p := range aclq.order {
+		p(selector)
+	}
+	if offset := aclq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := aclq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (aclq *APICallLogQuery) ForUpdate(opts ...sql.LockOption) *APICallLogQuery {
+	if aclq.driver.Dialect() == dialect.Postgres {
+		aclq.Unique(false)
+	}
+	aclq.modifiers = append(aclq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return aclq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (aclq *APICallLogQuery) ForShare(opts ...sql.LockOption) *APICallLogQuery {
+	if aclq.driver.Dialect() == dialect.Postgres {
+		aclq.Unique(false)
+	}
+	aclq.modifiers = append(aclq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return aclq
+}
+
+// APICallLogGroupBy is the group-by builder for APICallLog entities.
+type APICallLogGroupBy struct {
+	selector
+	build *APICallLogQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (aclgb *APICallLogGroupBy) Aggregate(fns ...AggregateFunc) *APICallLogGroupBy {
+	aclgb.fns = append(aclgb.fns, fns...)
+	return aclgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aclgb *APICallLogGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aclgb.build.ctx, ent.OpQueryGroupBy)
+	if err := aclgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*APICallLogQuery, *APICallLogGroupBy](ctx, aclgb.build, aclgb, aclgb.build.inters, v)
+}
+
+func (aclgb *APICallLogGroupBy) sqlScan(ctx context.Context, root *APICallLogQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(aclgb.fns))
+	for _, fn := range aclgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*aclgb.flds)+len(aclgb.fns))
+		for _, f := range *aclgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*aclgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aclgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// APICallLogSelect is the builder for selecting fields of APICallLog entities.
+type APICallLogSelect struct {
+	*APICallLogQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (acls *APICallLogSelect) Aggregate(fns ...AggregateFunc) *APICallLogSelect {
+	acls.fns = append(acls.fns, fns...)
+	return acls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (acls *APICallLogSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, acls.ctx, ent.OpQuerySelect)
+	if err := acls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*APICallLogQuery, *APICallLogSelect](ctx, acls.APICallLogQuery, acls, acls.inters, v)
+}
+
+func (acls *APICallLogSelect) sqlScan(ctx context.Context, root *APICallLogQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(acls.fns))
+	for _, fn := range acls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*acls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := acls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
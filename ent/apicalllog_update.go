@@ -0,0 +1,788 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/jobexecutionhistory"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APICallLogUpdate is the builder for updating APICallLog entities.
+type APICallLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APICallLogMutation
+}
+
+// Where appends a list predicates to the APICallLogUpdate builder.
+func (aclu *APICallLogUpdate) Where(ps ...predicate.APICallLog) *APICallLogUpdate {
+	aclu.mutation.Where(ps...)
+	return aclu
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (aclu *APICallLogUpdate) SetEndpoint(s string) *APICallLogUpdate {
+	aclu.mutation.SetEndpoint(s)
+	return aclu
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (aclu *APICallLogUpdate) SetNillableEndpoint(s *string) *APICallLogUpdate {
+	if s != nil {
+		aclu.SetEndpoint(*s)
+	}
+	return aclu
+}
+
+// SetKeyLabel sets the "key_label" field.
+func (aclu *APICallLogUpdate) SetKeyLabel(s string) *APICallLogUpdate {
+	aclu.mutation.SetKeyLabel(s)
+	return aclu
+}
+
+// SetNillableKeyLabel sets the "key_label" field if the given value is not nil.
+func (aclu *APICallLogUpdate) SetNillableKeyLabel(s *string) *APICallLogUpdate {
+	if s != nil {
+		aclu.SetKeyLabel(*s)
+	}
+	return aclu
+}
+
+// SetCaller sets the "caller" field.
+func (aclu *APICallLogUpdate) SetCaller(s string) *APICallLogUpdate {
+	aclu.mutation.SetCaller(s)
+	return aclu
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (aclu *APICallLogUpdate) SetNillableCaller(s *string) *APICallLogUpdate {
+	if s != nil {
+		aclu.SetCaller(*s)
+	}
+	return aclu
+}
+
+// SetRunID sets the "run_id" field.
+func (aclu *APICallLogUpdate) SetRunID(s string) *APICallLogUpdate {
+	aclu.mutation.SetRunID(s)
+	return aclu
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (aclu *APICallLogUpdate) SetNillableRunID(s *string) *APICallLogUpdate {
+	if s != nil {
+		aclu.SetRunID(*s)
+	}
+	return aclu
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (aclu *APICallLogUpdate) ClearRunID() *APICallLogUpdate {
+	aclu.mutation.ClearRunID()
+	return aclu
+}
+
+// SetUrn sets the "urn" field.
+func (aclu *APICallLogUpdate) SetUrn(s string) *APICallLogUpdate {
+	aclu.mutation.SetUrn(s)
+	return aclu
+}
+
+// SetNillableUrn sets the "urn" field if the given value is not nil.
+func (aclu *APICallLogUpdate) SetNillableUrn(s *string) *APICallLogUpdate {
+	if s != nil {
+		aclu.SetUrn(*s)
+	}
+	return aclu
+}
+
+// ClearUrn clears the value of the "urn" field.
+func (aclu *APICallLogUpdate) ClearUrn() *APICallLogUpdate {
+	aclu.mutation.ClearUrn()
+	return aclu
+}
+
+// SetHTTPStatus sets the "http_status" field.
+func (aclu *APICallLogUpdate) SetHTTPStatus(i int) *APICallLogUpdate {
+	aclu.mutation.ResetHTTPStatus()
+	aclu.mutation.SetHTTPStatus(i)
+	return aclu
+}
+
+// SetNillableHTTPStatus sets the "http_status" field if the given value is not nil.
+func (aclu *APICallLogUpdate) SetNillableHTTPStatus(i *int) *APICallLogUpdate {
+	if i != nil {
+		aclu.SetHTTPStatus(*i)
+	}
+	return aclu
+}
+
+// AddHTTPStatus adds i to the "http_status" field.
+func (aclu *APICallLogUpdate) AddHTTPStatus(i int) *APICallLogUpdate {
+	aclu.mutation.AddHTTPStatus(i)
+	return aclu
+}
+
+// ClearHTTPStatus clears the value of the "http_status" field.
+func (aclu *APICallLogUpdate) ClearHTTPStatus() *APICallLogUpdate {
+	aclu.mutation.ClearHTTPStatus()
+	return aclu
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (aclu *APICallLogUpdate) SetLatencyMs(i int) *APICallLogUpdate {
+	aclu.mutation.ResetLatencyMs()
+	aclu.mutation.SetLatencyMs(i)
+	return aclu
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (aclu *APICallLogUpdate) SetNillableLatencyMs(i *int) *APICallLogUpdate {
+	if i != nil {
+		aclu.SetLatencyMs(*i)
+	}
+	return aclu
+}
+
+// AddLatencyMs adds i to the "latency_ms" field.
+func (aclu *APICallLogUpdate) AddLatencyMs(i int) *APICallLogUpdate {
+	aclu.mutation.AddLatencyMs(i)
+	return aclu
+}
+
+// SetBytes sets the "bytes" field.
+func (aclu *APICallLogUpdate) SetBytes(i int) *APICallLogUpdate {
+	aclu.mutation.ResetBytes()
+	aclu.mutation.SetBytes(i)
+	return aclu
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (aclu *APICallLogUpdate) SetNillableBytes(i *int) *APICallLogUpdate {
+	if i != nil {
+		aclu.SetBytes(*i)
+	}
+	return aclu
+}
+
+// AddBytes adds i to the "bytes" field.
+func (aclu *APICallLogUpdate) AddBytes(i int) *APICallLogUpdate {
+	aclu.mutation.AddBytes(i)
+	return aclu
+}
+
+// SetCounted sets the "counted" field.
+func (aclu *APICallLogUpdate) SetCounted(b bool) *APICallLogUpdate {
+	aclu.mutation.SetCounted(b)
+	return aclu
+}
+
+// SetNillableCounted sets the "counted" field if the given value is not nil.
+func (aclu *APICallLogUpdate) SetNillableCounted(b *bool) *APICallLogUpdate {
+	if b != nil {
+		aclu.SetCounted(*b)
+	}
+	return aclu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aclu *APICallLogUpdate) SetUpdatedAt(t time.Time) *APICallLogUpdate {
+	aclu.mutation.SetUpdatedAt(t)
+	return aclu
+}
+
+// SetJobExecutionID sets the "job_execution" edge to the JobExecutionHistory entity by ID.
+func (aclu *APICallLogUpdate) SetJobExecutionID(id ulid.ID) *APICallLogUpdate {
+	aclu.mutation.SetJobExecutionID(id)
+	return aclu
+}
+
+// SetNillableJobExecutionID sets the "job_execution" edge to the JobExecutionHistory entity by ID if the given value is not nil.
+func (aclu *APICallLogUpdate) SetNillableJobExecutionID(id *ulid.ID) *APICallLogUpdate {
+	if id != nil {
+		aclu = aclu.SetJobExecutionID(*id)
+	}
+	return aclu
+}
+
+// SetJobExecution sets the "job_execution" edge to the JobExecutionHistory entity.
+func (aclu *APICallLogUpdate) SetJobExecution(j *JobExecutionHistory) *APICallLogUpdate {
+	return aclu.SetJobExecutionID(j.ID)
+}
+
+// Mutation returns the APICallLogMutation object of the builder.
+func (aclu *APICallLogUpdate) Mutation() *APICallLogMutation {
+	return aclu.mutation
+}
+
+// ClearJobExecution clears the "job_execution" edge to the JobExecutionHistory entity.
+func (aclu *APICallLogUpdate) ClearJobExecution() *APICallLogUpdate {
+	aclu.mutation.ClearJobExecution()
+	return aclu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aclu *APICallLogUpdate) Save(ctx context.Context) (int, error) {
+	aclu.defaults()
+	return withHooks(ctx, aclu.sqlSave, aclu.mutation, aclu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aclu *APICallLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := aclu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aclu *APICallLogUpdate) Exec(ctx context.Context) error {
+	_, err := aclu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aclu *APICallLogUpdate) ExecX(ctx context.Context) {
+	if err := aclu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aclu *APICallLogUpdate) defaults() {
+	if _, ok := aclu.mutation.UpdatedAt(); !ok {
+		v := apicalllog.UpdateDefaultUpdatedAt()
+		aclu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aclu *APICallLogUpdate) check() error {
+	if v, ok := aclu.mutation.Endpoint(); ok {
+		if err := apicalllog.EndpointValidator(v); err != nil {
+			return &ValidationError{Name: "endpoint", err: fmt.Errorf(`ent: validator failed for field "APICallLog.endpoint": %w`, err)}
+		}
+	}
+	if v, ok := aclu.mutation.LatencyMs(); ok {
+		if err := apicalllog.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "APICallLog.latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := aclu.mutation.Bytes(); ok {
+		if err := apicalllog.BytesValidator(v); err != nil {
+			return &ValidationError{Name: "bytes", err: fmt.Errorf(`ent: validator failed for field "APICallLog.bytes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aclu *APICallLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aclu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apicalllog.Table, apicalllog.Columns, sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString))
+	if ps := aclu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aclu.mutation.Endpoint(); ok {
+		_spec.SetField(apicalllog.FieldEndpoint, field.TypeString, value)
+	}
+	if value, ok := aclu.mutation.KeyLabel(); ok {
+		_spec.SetField(apicalllog.FieldKeyLabel, field.TypeString, value)
+	}
+	if value, ok := aclu.mutation.Caller(); ok {
+		_spec.SetField(apicalllog.FieldCaller, field.TypeString, value)
+	}
+	if value, ok := aclu.mutation.RunID(); ok {
+		_spec.SetField(apicalllog.FieldRunID, field.TypeString, value)
+	}
+	if aclu.mutation.RunIDCleared() {
+		_spec.ClearField(apicalllog.FieldRunID, field.TypeString)
+	}
+	if value, ok := aclu.mutation.Urn(); ok {
+		_spec.SetField(apicalllog.FieldUrn, field.TypeString, value)
+	}
+	if aclu.mutation.UrnCleared() {
+		_spec.ClearField(apicalllog.FieldUrn, field.TypeString)
+	}
+	if value, ok := aclu.mutation.HTTPStatus(); ok {
+		_spec.SetField(apicalllog.FieldHTTPStatus, field.TypeInt, value)
+	}
+	if value, ok := aclu.mutation.AddedHTTPStatus(); ok {
+		_spec.AddField(apicalllog.FieldHTTPStatus, field.TypeInt, value)
+	}
+	if aclu.mutation.HTTPStatusCleared() {
+		_spec.ClearField(apicalllog.FieldHTTPStatus, field.TypeInt)
+	}
+	if value, ok := aclu.mutation.LatencyMs(); ok {
+		_spec.SetField(apicalllog.FieldLatencyMs, field.TypeInt, value)
+	}
+	if value, ok := aclu.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(apicalllog.FieldLatencyMs, field.TypeInt, value)
+	}
+	if value, ok := aclu.mutation.Bytes(); ok {
+		_spec.SetField(apicalllog.FieldBytes, field.TypeInt, value)
+	}
+	if value, ok := aclu.mutation.AddedBytes(); ok {
+		_spec.AddField(apicalllog.FieldBytes, field.TypeInt, value)
+	}
+	if value, ok := aclu.mutation.Counted(); ok {
+		_spec.SetField(apicalllog.FieldCounted, field.TypeBool, value)
+	}
+	if value, ok := aclu.mutation.UpdatedAt(); ok {
+		_spec.SetField(apicalllog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if aclu.mutation.JobExecutionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apicalllog.JobExecutionTable,
+			Columns: []string{apicalllog.JobExecutionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobexecutionhistory.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aclu.mutation.JobExecutionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apicalllog.JobExecutionTable,
+			Columns: []string{apicalllog.JobExecutionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobexecutionhistory.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aclu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apicalllog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aclu.mutation.done = true
+	return n, nil
+}
+
+// APICallLogUpdateOne is the builder for updating a single APICallLog entity.
+type APICallLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *APICallLogMutation
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (acluo *APICallLogUpdateOne) SetEndpoint(s string) *APICallLogUpdateOne {
+	acluo.mutation.SetEndpoint(s)
+	return acluo
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (acluo *APICallLogUpdateOne) SetNillableEndpoint(s *string) *APICallLogUpdateOne {
+	if s != nil {
+		acluo.SetEndpoint(*s)
+	}
+	return acluo
+}
+
+// SetKeyLabel sets the "key_label" field.
+func (acluo *APICallLogUpdateOne) SetKeyLabel(s string) *APICallLogUpdateOne {
+	acluo.mutation.SetKeyLabel(s)
+	return acluo
+}
+
+// SetNillableKeyLabel sets the "key_label" field if the given value is not nil.
+func (acluo *APICallLogUpdateOne) SetNillableKeyLabel(s *string) *APICallLogUpdateOne {
+	if s != nil {
+		acluo.SetKeyLabel(*s)
+	}
+	return acluo
+}
+
+// SetCaller sets the "caller" field.
+func (acluo *APICallLogUpdateOne) SetCaller(s string) *APICallLogUpdateOne {
+	acluo.mutation.SetCaller(s)
+	return acluo
+}
+
+// SetNillableCaller sets the "caller" field if the given value is not nil.
+func (acluo *APICallLogUpdateOne) SetNillableCaller(s *string) *APICallLogUpdateOne {
+	if s != nil {
+		acluo.SetCaller(*s)
+	}
+	return acluo
+}
+
+// SetRunID sets the "run_id" field.
+func (acluo *APICallLogUpdateOne) SetRunID(s string) *APICallLogUpdateOne {
+	acluo.mutation.SetRunID(s)
+	return acluo
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (acluo *APICallLogUpdateOne) SetNillableRunID(s *string) *APICallLogUpdateOne {
+	if s != nil {
+		acluo.SetRunID(*s)
+	}
+	return acluo
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (acluo *APICallLogUpdateOne) ClearRunID() *APICallLogUpdateOne {
+	acluo.mutation.ClearRunID()
+	return acluo
+}
+
+// SetUrn sets the "urn" field.
+func (acluo *APICallLogUpdateOne) SetUrn(s string) *APICallLogUpdateOne {
+	acluo.mutation.SetUrn(s)
+	return acluo
+}
+
+// SetNillableUrn sets the "urn" field if the given value is not nil.
+func (acluo *APICallLogUpdateOne) SetNillableUrn(s *string) *APICallLogUpdateOne {
+	if s != nil {
+		acluo.SetUrn(*s)
+	}
+	return acluo
+}
+
+// ClearUrn clears the value of the "urn" field.
+func (acluo *APICallLogUpdateOne) ClearUrn() *APICallLogUpdateOne {
+	acluo.mutation.ClearUrn()
+	return acluo
+}
+
+// SetHTTPStatus sets the "http_status" field.
+func (acluo *APICallLogUpdateOne) SetHTTPStatus(i int) *APICallLogUpdateOne {
+	acluo.mutation.ResetHTTPStatus()
+	acluo.mutation.SetHTTPStatus(i)
+	return acluo
+}
+
+// SetNillableHTTPStatus sets the "http_status" field if the given value is not nil.
+func (acluo *APICallLogUpdateOne) SetNillableHTTPStatus(i *int) *APICallLogUpdateOne {
+	if i != nil {
+		acluo.SetHTTPStatus(*i)
+	}
+	return acluo
+}
+
+// AddHTTPStatus adds i to the "http_status" field.
+func (acluo *APICallLogUpdateOne) AddHTTPStatus(i int) *APICallLogUpdateOne {
+	acluo.mutation.AddHTTPStatus(i)
+	return acluo
+}
+
+// ClearHTTPStatus clears the value of the "http_status" field.
+func (acluo *APICallLogUpdateOne) ClearHTTPStatus() *APICallLogUpdateOne {
+	acluo.mutation.ClearHTTPStatus()
+	return acluo
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (acluo *APICallLogUpdateOne) SetLatencyMs(i int) *APICallLogUpdateOne {
+	acluo.mutation.ResetLatencyMs()
+	acluo.mutation.SetLatencyMs(i)
+	return acluo
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (acluo *APICallLogUpdateOne) SetNillableLatencyMs(i *int) *APICallLogUpdateOne {
+	if i != nil {
+		acluo.SetLatencyMs(*i)
+	}
+	return acluo
+}
+
+// AddLatencyMs adds i to the "latency_ms" field.
+func (acluo *APICallLogUpdateOne) AddLatencyMs(i int) *APICallLogUpdateOne {
+	acluo.mutation.AddLatencyMs(i)
+	return acluo
+}
+
+// SetBytes sets the "bytes" field.
+func (acluo *APICallLogUpdateOne) SetBytes(i int) *APICallLogUpdateOne {
+	acluo.mutation.ResetBytes()
+	acluo.mutation.SetBytes(i)
+	return acluo
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (acluo *APICallLogUpdateOne) SetNillableBytes(i *int) *APICallLogUpdateOne {
+	if i != nil {
+		acluo.SetBytes(*i)
+	}
+	return acluo
+}
+
+// AddBytes adds i to the "bytes" field.
+func (acluo *APICallLogUpdateOne) AddBytes(i int) *APICallLogUpdateOne {
+	acluo.mutation.AddBytes(i)
+	return acluo
+}
+
+// SetCounted sets the "counted" field.
+func (acluo *APICallLogUpdateOne) SetCounted(b bool) *APICallLogUpdateOne {
+	acluo.mutation.SetCounted(b)
+	return acluo
+}
+
+// SetNillableCounted sets the "counted" field if the given value is not nil.
+func (acluo *APICallLogUpdateOne) SetNillableCounted(b *bool) *APICallLogUpdateOne {
+	if b != nil {
+		acluo.SetCounted(*b)
+	}
+	return acluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (acluo *APICallLogUpdateOne) SetUpdatedAt(t time.Time) *APICallLogUpdateOne {
+	acluo.mutation.SetUpdatedAt(t)
+	return acluo
+}
+
+// SetJobExecutionID sets the "job_execution" edge to the JobExecutionHistory entity by ID.
+func (acluo *APICallLogUpdateOne) SetJobExecutionID(id ulid.ID) *APICallLogUpdateOne {
+	acluo.mutation.SetJobExecutionID(id)
+	return acluo
+}
+
+// SetNillableJobExecutionID sets the "job_execution" edge to the JobExecutionHistory entity by ID if the given value is not nil.
+func (acluo *APICallLogUpdateOne) SetNillableJobExecutionID(id *ulid.ID) *APICallLogUpdateOne {
+	if id != nil {
+		acluo = acluo.SetJobExecutionID(*id)
+	}
+	return acluo
+}
+
+// SetJobExecution sets the "job_execution" edge to the JobExecutionHistory entity.
+func (acluo *APICallLogUpdateOne) SetJobExecution(j *JobExecutionHistory) *APICallLogUpdateOne {
+	return acluo.SetJobExecutionID(j.ID)
+}
+
+// Mutation returns the APICallLogMutation object of the builder.
+func (acluo *APICallLogUpdateOne) Mutation() *APICallLogMutation {
+	return acluo.mutation
+}
+
+// ClearJobExecution clears the "job_execution" edge to the JobExecutionHistory entity.
+func (acluo *APICallLogUpdateOne) ClearJobExecution() *APICallLogUpdateOne {
+	acluo.mutation.ClearJobExecution()
+	return acluo
+}
+
+// Where appends a list predicates to the APICallLogUpdate builder.
+func (acluo *APICallLogUpdateOne) Where(ps ...predicate.APICallLog) *APICallLogUpdateOne {
+	acluo.mutation.Where(ps...)
+	return acluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (acluo *APICallLogUpdateOne) Select(field string, fields ...string) *APICallLogUpdateOne {
+	acluo.fields = append([]string{field}, fields...)
+	return acluo
+}
+
+// Save executes the query and returns the updated APICallLog entity.
+func (acluo *APICallLogUpdateOne) Save(ctx context.Context) (*APICallLog, error) {
+	acluo.defaults()
+	return withHooks(ctx, acluo.sqlSave, acluo.mutation, acluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acluo *APICallLogUpdateOne) SaveX(ctx context.Context) *APICallLog {
+	node, err := acluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (acluo *APICallLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := acluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acluo *APICallLogUpdateOne) ExecX(ctx context.Context) {
+	if err := acluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (acluo *APICallLogUpdateOne) defaults() {
+	if _, ok := acluo.mutation.UpdatedAt(); !ok {
+		v := apicalllog.UpdateDefaultUpdatedAt()
+		acluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acluo *APICallLogUpdateOne) check() error {
+	if v, ok := acluo.mutation.Endpoint(); ok {
+		if err := apicalllog.EndpointValidator(v); err != nil {
+			return &ValidationError{Name: "endpoint", err: fmt.Errorf(`ent: validator failed for field "APICallLog.endpoint": %w`, err)}
+		}
+	}
+	if v, ok := acluo.mutation.LatencyMs(); ok {
+		if err := apicalllog.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "APICallLog.latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := acluo.mutation.Bytes(); ok {
+		if err := apicalllog.BytesValidator(v); err != nil {
+			return &ValidationError{Name: "bytes", err: fmt.Errorf(`ent: validator failed for field "APICallLog.bytes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (acluo *APICallLogUpdateOne) sqlSave(ctx context.Context) (_node *APICallLog, err error) {
+	if err := acluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apicalllog.Table, apicalllog.Columns, sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString))
+	id, ok := acluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APICallLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := acluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apicalllog.FieldID)
+		for _, f := range fields {
+			if !apicalllog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apicalllog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := acluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acluo.mutation.Endpoint(); ok {
+		_spec.SetField(apicalllog.FieldEndpoint, field.TypeString, value)
+	}
+	if value, ok := acluo.mutation.KeyLabel(); ok {
+		_spec.SetField(apicalllog.FieldKeyLabel, field.TypeString, value)
+	}
+	if value, ok := acluo.mutation.Caller(); ok {
+		_spec.SetField(apicalllog.FieldCaller, field.TypeString, value)
+	}
+	if value, ok := acluo.mutation.RunID(); ok {
+		_spec.SetField(apicalllog.FieldRunID, field.TypeString, value)
+	}
+	if acluo.mutation.RunIDCleared() {
+		_spec.ClearField(apicalllog.FieldRunID, field.TypeString)
+	}
+	if value, ok := acluo.mutation.Urn(); ok {
+		_spec.SetField(apicalllog.FieldUrn, field.TypeString, value)
+	}
+	if acluo.mutation.UrnCleared() {
+		_spec.ClearField(apicalllog.FieldUrn, field.TypeString)
+	}
+	if value, ok := acluo.mutation.HTTPStatus(); ok {
+		_spec.SetField(apicalllog.FieldHTTPStatus, field.TypeInt, value)
+	}
+	if value, ok := acluo.mutation.AddedHTTPStatus(); ok {
+		_spec.AddField(apicalllog.FieldHTTPStatus, field.TypeInt, value)
+	}
+	if acluo.mutation.HTTPStatusCleared() {
+		_spec.ClearField(apicalllog.FieldHTTPStatus, field.TypeInt)
+	}
+	if value, ok := acluo.mutation.LatencyMs(); ok {
+		_spec.SetField(apicalllog.FieldLatencyMs, field.TypeInt, value)
+	}
+	if value, ok := acluo.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(apicalllog.FieldLatencyMs, field.TypeInt, value)
+	}
+	if value, ok := acluo.mutation.Bytes(); ok {
+		_spec.SetField(apicalllog.FieldBytes, field.TypeInt, value)
+	}
+	if value, ok := acluo.mutation.AddedBytes(); ok {
+		_spec.AddField(apicalllog.FieldBytes, field.TypeInt, value)
+	}
+	if value, ok := acluo.mutation.Counted(); ok {
+		_spec.SetField(apicalllog.FieldCounted, field.TypeBool, value)
+	}
+	if value, ok := acluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(apicalllog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if acluo.mutation.JobExecutionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apicalllog.JobExecutionTable,
+			Columns: []string{apicalllog.JobExecutionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobexecutionhistory.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acluo.mutation.JobExecutionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apicalllog.JobExecutionTable,
+			Columns: []string{apicalllog.JobExecutionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobexecutionhistory.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &APICallLog{config: acluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, acluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apicalllog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	acluo.mutation.done = true
+	return _node, nil
+}
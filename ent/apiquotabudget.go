@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/schema/ulid"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// APIQuotaBudget is the model entity for the APIQuotaBudget schema.
+type APIQuotaBudget struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID ulid.ID `json:"id,omitempty"`
+	// Budget name callers reserve against, e.g. cron, interactive or posts
+	Name string `json:"name,omitempty"`
+	// Share of the monthly quota limit, in percent
+	Percent float64 `json:"percent,omitempty"`
+	// What the budget is for
+	Description *string `json:"description,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*APIQuotaBudget) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case apiquotabudget.FieldPercent:
+			values[i] = new(sql.NullFloat64)
+		case apiquotabudget.FieldName, apiquotabudget.FieldDescription:
+			values[i] = new(sql.NullString)
+		case apiquotabudget.FieldCreatedAt, apiquotabudget.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case apiquotabudget.FieldID:
+			values[i] = new(ulid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the APIQuotaBudget fields.
+func (aqb *APIQuotaBudget) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case apiquotabudget.FieldID:
+			if value, ok := values[i].(*ulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				aqb.ID = *value
+			}
+		case apiquotabudget.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				aqb.Name = value.String
+			}
+		case apiquotabudget.FieldPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field percent", values[i])
+			} else if value.Valid {
+				aqb.Percent = value.Float64
+			}
+		case apiquotabudget.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				aqb.Description = new(string)
+				*aqb.Description = value.String
+			}
+		case apiquotabudget.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				aqb.CreatedAt = value.Time
+			}
+		case apiquotabudget.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				aqb.UpdatedAt = value.Time
+			}
+		default:
+			aqb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the APIQuotaBudget.
+// This includes values selected through modifiers, order, etc.
+func (aqb *APIQuotaBudget) Value(name string) (ent.Value, error) {
+	return aqb.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this APIQuotaBudget.
+// Note that you need to call APIQuotaBudget.Unwrap() before calling this method if this APIQuotaBudget
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (aqb *APIQuotaBudget) Update() *APIQuotaBudgetUpdateOne {
+	return NewAPIQuotaBudgetClient(aqb.config).UpdateOne(aqb)
+}
+
+// Unwrap unwraps the APIQuotaBudget entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (aqb *APIQuotaBudget) Unwrap() *APIQuotaBudget {
+	_tx, ok := aqb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: APIQuotaBudget is not a transactional entity")
+	}
+	aqb.config.driver = _tx.drv
+	return aqb
+}
+
+// String implements the fmt.Stringer.
+func (aqb *APIQuotaBudget) String() string {
+	var builder strings.Builder
+	builder.WriteString("APIQuotaBudget(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", aqb.ID))
+	builder.WriteString("name=")
+	builder.WriteString(aqb.Name)
+	builder.WriteString(", ")
+	builder.WriteString("percent=")
+	builder.WriteString(fmt.Sprintf("%v", aqb.Percent))
+	builder.WriteString(", ")
+	if v := aqb.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(aqb.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(aqb.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// APIQuotaBudgets is a parsable slice of APIQuotaBudget.
+type APIQuotaBudgets []*APIQuotaBudget
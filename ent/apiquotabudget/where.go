@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apiquotabudget
+
+import (
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id ulid.ID) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id ulid.ID) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id ulid.ID) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...ulid.ID) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...ulid.ID) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id ulid.ID) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id ulid.ID) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id ulid.ID) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id ulid.ID) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldName, v))
+}
+
+// Percent applies equality check predicate on the "percent" field. It's identical to PercentEQ.
+func Percent(v float64) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldPercent, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldContainsFold(FieldName, v))
+}
+
+// PercentEQ applies the EQ predicate on the "percent" field.
+func PercentEQ(v float64) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldPercent, v))
+}
+
+// PercentNEQ applies the NEQ predicate on the "percent" field.
+func PercentNEQ(v float64) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNEQ(FieldPercent, v))
+}
+
+// PercentIn applies the In predicate on the "percent" field.
+func PercentIn(vs ...float64) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldIn(FieldPercent, vs...))
+}
+
+// PercentNotIn applies the NotIn predicate on the "percent" field.
+func PercentNotIn(vs ...float64) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNotIn(FieldPercent, vs...))
+}
+
+// PercentGT applies the GT predicate on the "percent" field.
+func PercentGT(v float64) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGT(FieldPercent, v))
+}
+
+// PercentGTE applies the GTE predicate on the "percent" field.
+func PercentGTE(v float64) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGTE(FieldPercent, v))
+}
+
+// PercentLT applies the LT predicate on the "percent" field.
+func PercentLT(v float64) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLT(FieldPercent, v))
+}
+
+// PercentLTE applies the LTE predicate on the "percent" field.
+func PercentLTE(v float64) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLTE(FieldPercent, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.APIQuotaBudget) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.APIQuotaBudget) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.APIQuotaBudget) predicate.APIQuotaBudget {
+	return predicate.APIQuotaBudget(sql.NotPredicates(p))
+}
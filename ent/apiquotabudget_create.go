@@ -0,0 +1,732 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIQuotaBudgetCreate is the builder for creating a APIQuotaBudget entity.
+type APIQuotaBudgetCreate struct {
+	config
+	mutation *APIQuotaBudgetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (aqbc *APIQuotaBudgetCreate) SetName(s string) *APIQuotaBudgetCreate {
+	aqbc.mutation.SetName(s)
+	return aqbc
+}
+
+// SetPercent sets the "percent" field.
+func (aqbc *APIQuotaBudgetCreate) SetPercent(f float64) *APIQuotaBudgetCreate {
+	aqbc.mutation.SetPercent(f)
+	return aqbc
+}
+
+// SetDescription sets the "description" field.
+func (aqbc *APIQuotaBudgetCreate) SetDescription(s string) *APIQuotaBudgetCreate {
+	aqbc.mutation.SetDescription(s)
+	return aqbc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (aqbc *APIQuotaBudgetCreate) SetNillableDescription(s *string) *APIQuotaBudgetCreate {
+	if s != nil {
+		aqbc.SetDescription(*s)
+	}
+	return aqbc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aqbc *APIQuotaBudgetCreate) SetCreatedAt(t time.Time) *APIQuotaBudgetCreate {
+	aqbc.mutation.SetCreatedAt(t)
+	return aqbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aqbc *APIQuotaBudgetCreate) SetNillableCreatedAt(t *time.Time) *APIQuotaBudgetCreate {
+	if t != nil {
+		aqbc.SetCreatedAt(*t)
+	}
+	return aqbc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aqbc *APIQuotaBudgetCreate) SetUpdatedAt(t time.Time) *APIQuotaBudgetCreate {
+	aqbc.mutation.SetUpdatedAt(t)
+	return aqbc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (aqbc *APIQuotaBudgetCreate) SetNillableUpdatedAt(t *time.Time) *APIQuotaBudgetCreate {
+	if t != nil {
+		aqbc.SetUpdatedAt(*t)
+	}
+	return aqbc
+}
+
+// SetID sets the "id" field.
+func (aqbc *APIQuotaBudgetCreate) SetID(u ulid.ID) *APIQuotaBudgetCreate {
+	aqbc.mutation.SetID(u)
+	return aqbc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (aqbc *APIQuotaBudgetCreate) SetNillableID(u *ulid.ID) *APIQuotaBudgetCreate {
+	if u != nil {
+		aqbc.SetID(*u)
+	}
+	return aqbc
+}
+
+// Mutation returns the APIQuotaBudgetMutation object of the builder.
+func (aqbc *APIQuotaBudgetCreate) Mutation() *APIQuotaBudgetMutation {
+	return aqbc.mutation
+}
+
+// Save creates the APIQuotaBudget in the database.
+func (aqbc *APIQuotaBudgetCreate) Save(ctx context.Context) (*APIQuotaBudget, error) {
+	aqbc.defaults()
+	return withHooks(ctx, aqbc.sqlSave, aqbc.mutation, aqbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aqbc *APIQuotaBudgetCreate) SaveX(ctx context.Context) *APIQuotaBudget {
+	v, err := aqbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aqbc *APIQuotaBudgetCreate) Exec(ctx context.Context) error {
+	_, err := aqbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqbc *APIQuotaBudgetCreate) ExecX(ctx context.Context) {
+	if err := aqbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aqbc *APIQuotaBudgetCreate) defaults() {
+	if _, ok := aqbc.mutation.CreatedAt(); !ok {
+		v := apiquotabudget.DefaultCreatedAt()
+		aqbc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aqbc.mutation.UpdatedAt(); !ok {
+		v := apiquotabudget.DefaultUpdatedAt()
+		aqbc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := aqbc.mutation.ID(); !ok {
+		v := apiquotabudget.DefaultID()
+		aqbc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aqbc *APIQuotaBudgetCreate) check() error {
+	if _, ok := aqbc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "APIQuotaBudget.name"`)}
+	}
+	if v, ok := aqbc.mutation.Name(); ok {
+		if err := apiquotabudget.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIQuotaBudget.name": %w`, err)}
+		}
+	}
+	if _, ok := aqbc.mutation.Percent(); !ok {
+		return &ValidationError{Name: "percent", err: errors.New(`ent: missing required field "APIQuotaBudget.percent"`)}
+	}
+	if v, ok := aqbc.mutation.Percent(); ok {
+		if err := apiquotabudget.PercentValidator(v); err != nil {
+			return &ValidationError{Name: "percent", err: fmt.Errorf(`ent: validator failed for field "APIQuotaBudget.percent": %w`, err)}
+		}
+	}
+	if _, ok := aqbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "APIQuotaBudget.created_at"`)}
+	}
+	if _, ok := aqbc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "APIQuotaBudget.updated_at"`)}
+	}
+	return nil
+}
+
+func (aqbc *APIQuotaBudgetCreate) sqlSave(ctx context.Context) (*APIQuotaBudget, error) {
+	if err := aqbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aqbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aqbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*ulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	aqbc.mutation.id = &_node.ID
+	aqbc.mutation.done = true
+	return _node, nil
+}
+
+func (aqbc *APIQuotaBudgetCreate) createSpec() (*APIQuotaBudget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &APIQuotaBudget{config: aqbc.config}
+		_spec = sqlgraph.NewCreateSpec(apiquotabudget.Table, sqlgraph.NewFieldSpec(apiquotabudget.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = aqbc.conflict
+	if id, ok := aqbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := aqbc.mutation.Name(); ok {
+		_spec.SetField(apiquotabudget.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := aqbc.mutation.Percent(); ok {
+		_spec.SetField(apiquotabudget.FieldPercent, field.TypeFloat64, value)
+		_node.Percent = value
+	}
+	if value, ok := aqbc.mutation.Description(); ok {
+		_spec.SetField(apiquotabudget.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := aqbc.mutation.CreatedAt(); ok {
+		_spec.SetField(apiquotabudget.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := aqbc.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiquotabudget.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APIQuotaBudget.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APIQuotaBudgetUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (aqbc *APIQuotaBudgetCreate) OnConflict(opts ...sql.ConflictOption) *APIQuotaBudgetUpsertOne {
+	aqbc.conflict = opts
+	return &APIQuotaBudgetUpsertOne{
+		create: aqbc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APIQuotaBudget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aqbc *APIQuotaBudgetCreate) OnConflictColumns(columns ...string) *APIQuotaBudgetUpsertOne {
+	aqbc.conflict = append(aqbc.conflict, sql.ConflictColumns(columns...))
+	return &APIQuotaBudgetUpsertOne{
+		create: aqbc,
+	}
+}
+
+type (
+	// APIQuotaBudgetUpsertOne is the builder for "upsert"-ing
+	//  one APIQuotaBudget node.
+	APIQuotaBudgetUpsertOne struct {
+		create *APIQuotaBudgetCreate
+	}
+
+	// APIQuotaBudgetUpsert is the "OnConflict" setter.
+	APIQuotaBudgetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPercent sets the "percent" field.
+func (u *APIQuotaBudgetUpsert) SetPercent(v float64) *APIQuotaBudgetUpsert {
+	u.Set(apiquotabudget.FieldPercent, v)
+	return u
+}
+
+// UpdatePercent sets the "percent" field to the value that was provided on create.
+func (u *APIQuotaBudgetUpsert) UpdatePercent() *APIQuotaBudgetUpsert {
+	u.SetExcluded(apiquotabudget.FieldPercent)
+	return u
+}
+
+// AddPercent adds v to the "percent" field.
+func (u *APIQuotaBudgetUpsert) AddPercent(v float64) *APIQuotaBudgetUpsert {
+	u.Add(apiquotabudget.FieldPercent, v)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *APIQuotaBudgetUpsert) SetDescription(v string) *APIQuotaBudgetUpsert {
+	u.Set(apiquotabudget.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *APIQuotaBudgetUpsert) UpdateDescription() *APIQuotaBudgetUpsert {
+	u.SetExcluded(apiquotabudget.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *APIQuotaBudgetUpsert) ClearDescription() *APIQuotaBudgetUpsert {
+	u.SetNull(apiquotabudget.FieldDescription)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIQuotaBudgetUpsert) SetUpdatedAt(v time.Time) *APIQuotaBudgetUpsert {
+	u.Set(apiquotabudget.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIQuotaBudgetUpsert) UpdateUpdatedAt() *APIQuotaBudgetUpsert {
+	u.SetExcluded(apiquotabudget.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.APIQuotaBudget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apiquotabudget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APIQuotaBudgetUpsertOne) UpdateNewValues() *APIQuotaBudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(apiquotabudget.FieldID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(apiquotabudget.FieldName)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(apiquotabudget.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APIQuotaBudget.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *APIQuotaBudgetUpsertOne) Ignore() *APIQuotaBudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APIQuotaBudgetUpsertOne) DoNothing() *APIQuotaBudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APIQuotaBudgetCreate.OnConflict
+// documentation for more info.
+func (u *APIQuotaBudgetUpsertOne) Update(set func(*APIQuotaBudgetUpsert)) *APIQuotaBudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APIQuotaBudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPercent sets the "percent" field.
+func (u *APIQuotaBudgetUpsertOne) SetPercent(v float64) *APIQuotaBudgetUpsertOne {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.SetPercent(v)
+	})
+}
+
+// AddPercent adds v to the "percent" field.
+func (u *APIQuotaBudgetUpsertOne) AddPercent(v float64) *APIQuotaBudgetUpsertOne {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.AddPercent(v)
+	})
+}
+
+// UpdatePercent sets the "percent" field to the value that was provided on create.
+func (u *APIQuotaBudgetUpsertOne) UpdatePercent() *APIQuotaBudgetUpsertOne {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.UpdatePercent()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *APIQuotaBudgetUpsertOne) SetDescription(v string) *APIQuotaBudgetUpsertOne {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *APIQuotaBudgetUpsertOne) UpdateDescription() *APIQuotaBudgetUpsertOne {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *APIQuotaBudgetUpsertOne) ClearDescription() *APIQuotaBudgetUpsertOne {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIQuotaBudgetUpsertOne) SetUpdatedAt(v time.Time) *APIQuotaBudgetUpsertOne {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIQuotaBudgetUpsertOne) UpdateUpdatedAt() *APIQuotaBudgetUpsertOne {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *APIQuotaBudgetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APIQuotaBudgetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APIQuotaBudgetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *APIQuotaBudgetUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: APIQuotaBudgetUpsertOne.ID is not supported by MySQL driver. Use APIQuotaBudgetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *APIQuotaBudgetUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// APIQuotaBudgetCreateBulk is the builder for creating many APIQuotaBudget entities in bulk.
+type APIQuotaBudgetCreateBulk struct {
+	config
+	err      error
+	builders []*APIQuotaBudgetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the APIQuotaBudget entities in the database.
+func (aqbcb *APIQuotaBudgetCreateBulk) Save(ctx context.Context) ([]*APIQuotaBudget, error) {
+	if aqbcb.err != nil {
+		return nil, aqbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aqbcb.builders))
+	nodes := make([]*APIQuotaBudget, len(aqbcb.builders))
+	mutators := make([]Mutator, len(aqbcb.builders))
+	for i := range aqbcb.builders {
+		func(i int, root context.Context) {
+			builder := aqbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*APIQuotaBudgetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aqbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = aqbcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aqbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aqbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aqbcb *APIQuotaBudgetCreateBulk) SaveX(ctx context.Context) []*APIQuotaBudget {
+	v, err := aqbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aqbcb *APIQuotaBudgetCreateBulk) Exec(ctx context.Context) error {
+	_, err := aqbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqbcb *APIQuotaBudgetCreateBulk) ExecX(ctx context.Context) {
+	if err := aqbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APIQuotaBudget.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APIQuotaBudgetUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (aqbcb *APIQuotaBudgetCreateBulk) OnConflict(opts ...sql.ConflictOption) *APIQuotaBudgetUpsertBulk {
+	aqbcb.conflict = opts
+	return &APIQuotaBudgetUpsertBulk{
+		create: aqbcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APIQuotaBudget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aqbcb *APIQuotaBudgetCreateBulk) OnConflictColumns(columns ...string) *APIQuotaBudgetUpsertBulk {
+	aqbcb.conflict = append(aqbcb.conflict, sql.ConflictColumns(columns...))
+	return &APIQuotaBudgetUpsertBulk{
+		create: aqbcb,
+	}
+}
+
+// APIQuotaBudgetUpsertBulk is the builder for "upsert"-ing
+// a bulk of APIQuotaBudget nodes.
+type APIQuotaBudgetUpsertBulk struct {
+	create *APIQuotaBudgetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.APIQuotaBudget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apiquotabudget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APIQuotaBudgetUpsertBulk) UpdateNewValues() *APIQuotaBudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(apiquotabudget.FieldID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(apiquotabudget.FieldName)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(apiquotabudget.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APIQuotaBudget.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *APIQuotaBudgetUpsertBulk) Ignore() *APIQuotaBudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APIQuotaBudgetUpsertBulk) DoNothing() *APIQuotaBudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APIQuotaBudgetCreateBulk.OnConflict
+// documentation for more info.
+func (u *APIQuotaBudgetUpsertBulk) Update(set func(*APIQuotaBudgetUpsert)) *APIQuotaBudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APIQuotaBudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPercent sets the "percent" field.
+func (u *APIQuotaBudgetUpsertBulk) SetPercent(v float64) *APIQuotaBudgetUpsertBulk {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.SetPercent(v)
+	})
+}
+
+// AddPercent adds v to the "percent" field.
+func (u *APIQuotaBudgetUpsertBulk) AddPercent(v float64) *APIQuotaBudgetUpsertBulk {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.AddPercent(v)
+	})
+}
+
+// UpdatePercent sets the "percent" field to the value that was provided on create.
+func (u *APIQuotaBudgetUpsertBulk) UpdatePercent() *APIQuotaBudgetUpsertBulk {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.UpdatePercent()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *APIQuotaBudgetUpsertBulk) SetDescription(v string) *APIQuotaBudgetUpsertBulk {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *APIQuotaBudgetUpsertBulk) UpdateDescription() *APIQuotaBudgetUpsertBulk {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *APIQuotaBudgetUpsertBulk) ClearDescription() *APIQuotaBudgetUpsertBulk {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIQuotaBudgetUpsertBulk) SetUpdatedAt(v time.Time) *APIQuotaBudgetUpsertBulk {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIQuotaBudgetUpsertBulk) UpdateUpdatedAt() *APIQuotaBudgetUpsertBulk {
+	return u.Update(func(s *APIQuotaBudgetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *APIQuotaBudgetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the APIQuotaBudgetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APIQuotaBudgetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APIQuotaBudgetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIQuotaBudgetDelete is the builder for deleting a APIQuotaBudget entity.
+type APIQuotaBudgetDelete struct {
+	config
+	hooks    []Hook
+	mutation *APIQuotaBudgetMutation
+}
+
+// Where appends a list predicates to the APIQuotaBudgetDelete builder.
+func (aqbd *APIQuotaBudgetDelete) Where(ps ...predicate.APIQuotaBudget) *APIQuotaBudgetDelete {
+	aqbd.mutation.Where(ps...)
+	return aqbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (aqbd *APIQuotaBudgetDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, aqbd.sqlExec, aqbd.mutation, aqbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqbd *APIQuotaBudgetDelete) ExecX(ctx context.Context) int {
+	n, err := aqbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (aqbd *APIQuotaBudgetDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(apiquotabudget.Table, sqlgraph.NewFieldSpec(apiquotabudget.FieldID, field.TypeString))
+	if ps := aqbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, aqbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	aqbd.mutation.done = true
+	return affected, err
+}
+
+// APIQuotaBudgetDeleteOne is the builder for deleting a single APIQuotaBudget entity.
+type APIQuotaBudgetDeleteOne struct {
+	aqbd *APIQuotaBudgetDelete
+}
+
+// Where appends a list predicates to the APIQuotaBudgetDelete builder.
+func (aqbdo *APIQuotaBudgetDeleteOne) Where(ps ...predicate.APIQuotaBudget) *APIQuotaBudgetDeleteOne {
+	aqbdo.aqbd.mutation.Where(ps...)
+	return aqbdo
+}
+
+// Exec executes the deletion query.
+func (aqbdo *APIQuotaBudgetDeleteOne) Exec(ctx context.Context) error {
+	n, err := aqbdo.aqbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{apiquotabudget.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqbdo *APIQuotaBudgetDeleteOne) ExecX(ctx context.Context) {
+	if err := aqbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,571 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIQuotaBudgetQuery is the builder for querying APIQuotaBudget entities.
+type APIQuotaBudgetQuery struct {
+	config
+	ctx        *QueryContext
+	order      []apiquotabudget.OrderOption
+	inters     []Interceptor
+	predicates []predicate.APIQuotaBudget
+	loadTotal  []func(context.Context, []*APIQuotaBudget) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the APIQuotaBudgetQuery builder.
+func (aqbq *APIQuotaBudgetQuery) Where(ps ...predicate.APIQuotaBudget) *APIQuotaBudgetQuery {
+	aqbq.predicates = append(aqbq.predicates, ps...)
+	return aqbq
+}
+
+// Limit the number of records to be returned by this query.
+func (aqbq *APIQuotaBudgetQuery) Limit(limit int) *APIQuotaBudgetQuery {
+	aqbq.ctx.Limit = &limit
+	return aqbq
+}
+
+// Offset to start from.
+func (aqbq *APIQuotaBudgetQuery) Offset(offset int) *APIQuotaBudgetQuery {
+	aqbq.ctx.Offset = &offset
+	return aqbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (aqbq *APIQuotaBudgetQuery) Unique(unique bool) *APIQuotaBudgetQuery {
+	aqbq.ctx.Unique = &unique
+	return aqbq
+}
+
+// Order specifies how the records should be ordered.
+func (aqbq *APIQuotaBudgetQuery) Order(o ...apiquotabudget.OrderOption) *APIQuotaBudgetQuery {
+	aqbq.order = append(aqbq.order, o...)
+	return aqbq
+}
+
+// First returns the first APIQuotaBudget entity from the query.
+// Returns a *NotFoundError when no APIQuotaBudget was found.
+func (aqbq *APIQuotaBudgetQuery) First(ctx context.Context) (*APIQuotaBudget, error) {
+	nodes, err := aqbq.Limit(1).All(setContextOp(ctx, aqbq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{apiquotabudget.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (aqbq *APIQuotaBudgetQuery) FirstX(ctx context.Context) *APIQuotaBudget {
+	node, err := aqbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first APIQuotaBudget ID from the query.
+// Returns a *NotFoundError when no APIQuotaBudget ID was found.
+func (aqbq *APIQuotaBudgetQuery) FirstID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = aqbq.Limit(1).IDs(setContextOp(ctx, aqbq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{apiquotabudget.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (aqbq *APIQuotaBudgetQuery) FirstIDX(ctx context.Context) ulid.ID {
+	id, err := aqbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single APIQuotaBudget entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one APIQuotaBudget entity is found.
+// Returns a *NotFoundError when no APIQuotaBudget entities are found.
+func (aqbq *APIQuotaBudgetQuery) Only(ctx context.Context) (*APIQuotaBudget, error) {
+	nodes, err := aqbq.Limit(2).All(setContextOp(ctx, aqbq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{apiquotabudget.Label}
+	default:
+		return nil, &NotSingularError{apiquotabudget.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (aqbq *APIQuotaBudgetQuery) OnlyX(ctx context.Context) *APIQuotaBudget {
+	node, err := aqbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only APIQuotaBudget ID in the query.
+// Returns a *NotSingularError when more than one APIQuotaBudget ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (aqbq *APIQuotaBudgetQuery) OnlyID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = aqbq.Limit(2).IDs(setContextOp(ctx, aqbq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{apiquotabudget.Label}
+	default:
+		err = &NotSingularError{apiquotabudget.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (aqbq *APIQuotaBudgetQuery) OnlyIDX(ctx context.Context) ulid.ID {
+	id, err := aqbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of APIQuotaBudgets.
+func (aqbq *APIQuotaBudgetQuery) All(ctx context.Context) ([]*APIQuotaBudget, error) {
+	ctx = setContextOp(ctx, aqbq.ctx, ent.OpQueryAll)
+	if err := aqbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*APIQuotaBudget, *APIQuotaBudgetQuery]()
+	return withInterceptors[[]*APIQuotaBudget](ctx, aqbq, qr, aqbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (aqbq *APIQuotaBudgetQuery) AllX(ctx context.Context) []*APIQuotaBudget {
+	nodes, err := aqbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of APIQuotaBudget IDs.
+func (aqbq *APIQuotaBudgetQuery) IDs(ctx context.Context) (ids []ulid.ID, err error) {
+	if aqbq.ctx.Unique == nil && aqbq.path != nil {
+		aqbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, aqbq.ctx, ent.OpQueryIDs)
+	if err = aqbq.Select(apiquotabudget.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (aqbq *APIQuotaBudgetQuery) IDsX(ctx context.Context) []ulid.ID {
+	ids, err := aqbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (aqbq *APIQuotaBudgetQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, aqbq.ctx, ent.OpQueryCount)
+	if err := aqbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, aqbq, querierCount[*APIQuotaBudgetQuery](), aqbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (aqbq *APIQuotaBudgetQuery) CountX(ctx context.Context) int {
+	count, err := aqbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (aqbq *APIQuotaBudgetQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, aqbq.ctx, ent.OpQueryExist)
+	switch _, err := aqbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (aqbq *APIQuotaBudgetQuery) ExistX(ctx context.Context) bool {
+	exist, err := aqbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the APIQuotaBudgetQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (aqbq *APIQuotaBudgetQuery) Clone() *APIQuotaBudgetQuery {
+	if aqbq == nil {
+		return nil
+	}
+	return &APIQuotaBudgetQuery{
+		config:     aqbq.config,
+		ctx:        aqbq.ctx.Clone(),
+		order:      append([]apiquotabudget.OrderOption{}, aqbq.order...),
+		inters:     append([]Interceptor{}, aqbq.inters...),
+		predicates: append([]predicate.APIQuotaBudget{}, aqbq.predicates...),
+		// clone intermediate query.
+		sql:  aqbq.sql.Clone(),
+		path: aqbq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.APIQuotaBudget.Query().
+//		GroupBy(apiquotabudget.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (aqbq *APIQuotaBudgetQuery) GroupBy(field string, fields ...string) *APIQuotaBudgetGroupBy {
+	aqbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &APIQuotaBudgetGroupBy{build: aqbq}
+	grbuild.flds = &aqbq.ctx.Fields
+	grbuild.label = apiquotabudget.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.APIQuotaBudget.Query().
+//		Select(apiquotabudget.FieldName).
+//		Scan(ctx, &v)
+func (aqbq *APIQuotaBudgetQuery) Select(fields ...string) *APIQuotaBudgetSelect {
+	aqbq.ctx.Fields = append(aqbq.ctx.Fields, fields...)
+	sbuild := &APIQuotaBudgetSelect{APIQuotaBudgetQuery: aqbq}
+	sbuild.label = apiquotabudget.Label
+	sbuild.flds, sbuild.scan = &aqbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a APIQuotaBudgetSelect configured with the given aggregations.
+func (aqbq *APIQuotaBudgetQuery) Aggregate(fns ...AggregateFunc) *APIQuotaBudgetSelect {
+	return aqbq.Select().Aggregate(fns...)
+}
+
+func (aqbq *APIQuotaBudgetQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range aqbq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, aqbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range aqbq.ctx.Fields {
+		if !apiquotabudget.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if aqbq.path != nil {
+		prev, err := aqbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		aqbq.sql = prev
+	}
+	return nil
+}
+
+func (aqbq *APIQuotaBudgetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*APIQuotaBudget, error) {
+	var (
+		nodes = []*APIQuotaBudget{}
+		_spec = aqbq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*APIQuotaBudget).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &APIQuotaBudget{config: aqbq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(aqbq.modifiers) > 0 {
+		_spec.Modifiers = aqbq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, aqbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range aqbq.loadTotal {
+		if err := aqbq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (aqbq *APIQuotaBudgetQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := aqbq.querySpec()
+	if len(aqbq.modifiers) > 0 {
+		_spec.Modifiers = aqbq.modifiers
+	}
+	_spec.Node.Columns = aqbq.ctx.Fields
+	if len(aqbq.ctx.Fields) > 0 {
+		_spec.Unique = aqbq.ctx.Unique != nil && *aqbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, aqbq.driver, _spec)
+}
+
+func (aqbq *APIQuotaBudgetQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(apiquotabudget.Table, apiquotabudget.Columns, sqlgraph.NewFieldSpec(apiquotabudget.FieldID, field.TypeString))
+	_spec.From = aqbq.sql
+	if unique := aqbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if aqbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := aqbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apiquotabudget.FieldID)
+		for i := range fields {
+			if fields[i] != apiquotabudget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := aqbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := aqbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := aqbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := aqbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (aqbq *APIQuotaBudgetQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(aqbq.driver.Dialect())
+	t1 := builder.Table(apiquotabudget.Table)
+	columns := aqbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = apiquotabudget.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if aqbq.sql != nil {
+		selector = aqbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if aqbq.ctx.Unique != nil && *aqbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range aqbq.modifiers {
+		m(selector)
+	}
+	for _, p := range aqbq.predicates {
+		p(selector)
+	}
+	for _, p := range aqbq.order {
+		p(selector)
+	}
+	if offset := aqbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := aqbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (aqbq *APIQuotaBudgetQuery) ForUpdate(opts ...sql.LockOption) *APIQuotaBudgetQuery {
+	if aqbq.driver.Dialect() == dialect.Postgres {
+		aqbq.Unique(false)
+	}
+	aqbq.modifiers = append(aqbq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return aqbq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (aqbq *APIQuotaBudgetQuery) ForShare(opts ...sql.LockOption) *APIQuotaBudgetQuery {
+	if aqbq.driver.Dialect() == dialect.Postgres {
+		aqbq.Unique(false)
+	}
+	aqbq.modifiers = append(aqbq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return aqbq
+}
+
+// APIQuotaBudgetGroupBy is the group-by builder for APIQuotaBudget entities.
+type APIQuotaBudgetGroupBy struct {
+	selector
+	build *APIQuotaBudgetQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (aqbgb *APIQuotaBudgetGroupBy) Aggregate(fns ...AggregateFunc) *APIQuotaBudgetGroupBy {
+	aqbgb.fns = append(aqbgb.fns, fns...)
+	return aqbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aqbgb *APIQuotaBudgetGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aqbgb.build.ctx, ent.OpQueryGroupBy)
+	if err := aqbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*APIQuotaBudgetQuery, *APIQuotaBudgetGroupBy](ctx, aqbgb.build, aqbgb, aqbgb.build.inters, v)
+}
+
+func (aqbgb *APIQuotaBudgetGroupBy) sqlScan(ctx context.Context, root *APIQuotaBudgetQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(aqbgb.fns))
+	for _, fn := range aqbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*aqbgb.flds)+len(aqbgb.fns))
+		for _, f := range *aqbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*aqbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aqbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// APIQuotaBudgetSelect is the builder for selecting fields of APIQuotaBudget entities.
+type APIQuotaBudgetSelect struct {
+	*APIQuotaBudgetQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (aqbs *APIQuotaBudgetSelect) Aggregate(fns ...AggregateFunc) *APIQuotaBudgetSelect {
+	aqbs.fns = append(aqbs.fns, fns...)
+	return aqbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aqbs *APIQuotaBudgetSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aqbs.ctx, ent.OpQuerySelect)
+	if err := aqbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*APIQuotaBudgetQuery, *APIQuotaBudgetSelect](ctx, aqbs.APIQuotaBudgetQuery, aqbs, aqbs.inters, v)
+}
+
+func (aqbs *APIQuotaBudgetSelect) sqlScan(ctx context.Context, root *APIQuotaBudgetQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(aqbs.fns))
+	for _, fn := range aqbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*aqbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aqbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
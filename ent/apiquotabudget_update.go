@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIQuotaBudgetUpdate is the builder for updating APIQuotaBudget entities.
+type APIQuotaBudgetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APIQuotaBudgetMutation
+}
+
+// Where appends a list predicates to the APIQuotaBudgetUpdate builder.
+func (aqbu *APIQuotaBudgetUpdate) Where(ps ...predicate.APIQuotaBudget) *APIQuotaBudgetUpdate {
+	aqbu.mutation.Where(ps...)
+	return aqbu
+}
+
+// SetPercent sets the "percent" field.
+func (aqbu *APIQuotaBudgetUpdate) SetPercent(f float64) *APIQuotaBudgetUpdate {
+	aqbu.mutation.ResetPercent()
+	aqbu.mutation.SetPercent(f)
+	return aqbu
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (aqbu *APIQuotaBudgetUpdate) SetNillablePercent(f *float64) *APIQuotaBudgetUpdate {
+	if f != nil {
+		aqbu.SetPercent(*f)
+	}
+	return aqbu
+}
+
+// AddPercent adds f to the "percent" field.
+func (aqbu *APIQuotaBudgetUpdate) AddPercent(f float64) *APIQuotaBudgetUpdate {
+	aqbu.mutation.AddPercent(f)
+	return aqbu
+}
+
+// SetDescription sets the "description" field.
+func (aqbu *APIQuotaBudgetUpdate) SetDescription(s string) *APIQuotaBudgetUpdate {
+	aqbu.mutation.SetDescription(s)
+	return aqbu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (aqbu *APIQuotaBudgetUpdate) SetNillableDescription(s *string) *APIQuotaBudgetUpdate {
+	if s != nil {
+		aqbu.SetDescription(*s)
+	}
+	return aqbu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (aqbu *APIQuotaBudgetUpdate) ClearDescription() *APIQuotaBudgetUpdate {
+	aqbu.mutation.ClearDescription()
+	return aqbu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aqbu *APIQuotaBudgetUpdate) SetUpdatedAt(t time.Time) *APIQuotaBudgetUpdate {
+	aqbu.mutation.SetUpdatedAt(t)
+	return aqbu
+}
+
+// Mutation returns the APIQuotaBudgetMutation object of the builder.
+func (aqbu *APIQuotaBudgetUpdate) Mutation() *APIQuotaBudgetMutation {
+	return aqbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aqbu *APIQuotaBudgetUpdate) Save(ctx context.Context) (int, error) {
+	aqbu.defaults()
+	return withHooks(ctx, aqbu.sqlSave, aqbu.mutation, aqbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aqbu *APIQuotaBudgetUpdate) SaveX(ctx context.Context) int {
+	affected, err := aqbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aqbu *APIQuotaBudgetUpdate) Exec(ctx context.Context) error {
+	_, err := aqbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqbu *APIQuotaBudgetUpdate) ExecX(ctx context.Context) {
+	if err := aqbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aqbu *APIQuotaBudgetUpdate) defaults() {
+	if _, ok := aqbu.mutation.UpdatedAt(); !ok {
+		v := apiquotabudget.UpdateDefaultUpdatedAt()
+		aqbu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aqbu *APIQuotaBudgetUpdate) check() error {
+	if v, ok := aqbu.mutation.Percent(); ok {
+		if err := apiquotabudget.PercentValidator(v); err != nil {
+			return &ValidationError{Name: "percent", err: fmt.Errorf(`ent: validator failed for field "APIQuotaBudget.percent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aqbu *APIQuotaBudgetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aqbu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apiquotabudget.Table, apiquotabudget.Columns, sqlgraph.NewFieldSpec(apiquotabudget.FieldID, field.TypeString))
+	if ps := aqbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aqbu.mutation.Percent(); ok {
+		_spec.SetField(apiquotabudget.FieldPercent, field.TypeFloat64, value)
+	}
+	if value, ok := aqbu.mutation.AddedPercent(); ok {
+		_spec.AddField(apiquotabudget.FieldPercent, field.TypeFloat64, value)
+	}
+	if value, ok := aqbu.mutation.Description(); ok {
+		_spec.SetField(apiquotabudget.FieldDescription, field.TypeString, value)
+	}
+	if aqbu.mutation.DescriptionCleared() {
+		_spec.ClearField(apiquotabudget.FieldDescription, field.TypeString)
+	}
+	if value, ok := aqbu.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiquotabudget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aqbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apiquotabudget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aqbu.mutation.done = true
+	return n, nil
+}
+
+// APIQuotaBudgetUpdateOne is the builder for updating a single APIQuotaBudget entity.
+type APIQuotaBudgetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *APIQuotaBudgetMutation
+}
+
+// SetPercent sets the "percent" field.
+func (aqbuo *APIQuotaBudgetUpdateOne) SetPercent(f float64) *APIQuotaBudgetUpdateOne {
+	aqbuo.mutation.ResetPercent()
+	aqbuo.mutation.SetPercent(f)
+	return aqbuo
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (aqbuo *APIQuotaBudgetUpdateOne) SetNillablePercent(f *float64) *APIQuotaBudgetUpdateOne {
+	if f != nil {
+		aqbuo.SetPercent(*f)
+	}
+	return aqbuo
+}
+
+// AddPercent adds f to the "percent" field.
+func (aqbuo *APIQuotaBudgetUpdateOne) AddPercent(f float64) *APIQuotaBudgetUpdateOne {
+	aqbuo.mutation.AddPercent(f)
+	return aqbuo
+}
+
+// SetDescription sets the "description" field.
+func (aqbuo *APIQuotaBudgetUpdateOne) SetDescription(s string) *APIQuotaBudgetUpdateOne {
+	aqbuo.mutation.SetDescription(s)
+	return aqbuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (aqbuo *APIQuotaBudgetUpdateOne) SetNillableDescription(s *string) *APIQuotaBudgetUpdateOne {
+	if s != nil {
+		aqbuo.SetDescription(*s)
+	}
+	return aqbuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (aqbuo *APIQuotaBudgetUpdateOne) ClearDescription() *APIQuotaBudgetUpdateOne {
+	aqbuo.mutation.ClearDescription()
+	return aqbuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aqbuo *APIQuotaBudgetUpdateOne) SetUpdatedAt(t time.Time) *APIQuotaBudgetUpdateOne {
+	aqbuo.mutation.SetUpdatedAt(t)
+	return aqbuo
+}
+
+// Mutation returns the APIQuotaBudgetMutation object of the builder.
+func (aqbuo *APIQuotaBudgetUpdateOne) Mutation() *APIQuotaBudgetMutation {
+	return aqbuo.mutation
+}
+
+// Where appends a list predicates to the APIQuotaBudgetUpdate builder.
+func (aqbuo *APIQuotaBudgetUpdateOne) Where(ps ...predicate.APIQuotaBudget) *APIQuotaBudgetUpdateOne {
+	aqbuo.mutation.Where(ps...)
+	return aqbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aqbuo *APIQuotaBudgetUpdateOne) Select(field string, fields ...string) *APIQuotaBudgetUpdateOne {
+	aqbuo.fields = append([]string{field}, fields...)
+	return aqbuo
+}
+
+// Save executes the query and returns the updated APIQuotaBudget entity.
+func (aqbuo *APIQuotaBudgetUpdateOne) Save(ctx context.Context) (*APIQuotaBudget, error) {
+	aqbuo.defaults()
+	return withHooks(ctx, aqbuo.sqlSave, aqbuo.mutation, aqbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aqbuo *APIQuotaBudgetUpdateOne) SaveX(ctx context.Context) *APIQuotaBudget {
+	node, err := aqbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aqbuo *APIQuotaBudgetUpdateOne) Exec(ctx context.Context) error {
+	_, err := aqbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqbuo *APIQuotaBudgetUpdateOne) ExecX(ctx context.Context) {
+	if err := aqbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aqbuo *APIQuotaBudgetUpdateOne) defaults() {
+	if _, ok := aqbuo.mutation.UpdatedAt(); !ok {
+		v := apiquotabudget.UpdateDefaultUpdatedAt()
+		aqbuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aqbuo *APIQuotaBudgetUpdateOne) check() error {
+	if v, ok := aqbuo.mutation.Percent(); ok {
+		if err := apiquotabudget.PercentValidator(v); err != nil {
+			return &ValidationError{Name: "percent", err: fmt.Errorf(`ent: validator failed for field "APIQuotaBudget.percent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aqbuo *APIQuotaBudgetUpdateOne) sqlSave(ctx context.Context) (_node *APIQuotaBudget, err error) {
+	if err := aqbuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apiquotabudget.Table, apiquotabudget.Columns, sqlgraph.NewFieldSpec(apiquotabudget.FieldID, field.TypeString))
+	id, ok := aqbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APIQuotaBudget.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aqbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apiquotabudget.FieldID)
+		for _, f := range fields {
+			if !apiquotabudget.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apiquotabudget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aqbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aqbuo.mutation.Percent(); ok {
+		_spec.SetField(apiquotabudget.FieldPercent, field.TypeFloat64, value)
+	}
+	if value, ok := aqbuo.mutation.AddedPercent(); ok {
+		_spec.AddField(apiquotabudget.FieldPercent, field.TypeFloat64, value)
+	}
+	if value, ok := aqbuo.mutation.Description(); ok {
+		_spec.SetField(apiquotabudget.FieldDescription, field.TypeString, value)
+	}
+	if aqbuo.mutation.DescriptionCleared() {
+		_spec.ClearField(apiquotabudget.FieldDescription, field.TypeString)
+	}
+	if value, ok := aqbuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiquotabudget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &APIQuotaBudget{config: aqbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aqbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apiquotabudget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aqbuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sheng-go-backend/ent/apiquotareservation"
+	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/schema/ulid"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// APIQuotaReservation is the model entity for the APIQuotaReservation schema.
+type APIQuotaReservation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID ulid.ID `json:"id,omitempty"`
+	// Number of calls reserved
+	Reserved int `json:"reserved,omitempty"`
+	// Number of reserved calls committed so far
+	Used int `json:"used,omitempty"`
+	// OPEN until settled; COMMITTED or RELEASED once the unused rest is returned, EXPIRED if its holder never settled it
+	Status apiquotareservation.Status `json:"status,omitempty"`
+	// An open reservation past this time is released by the next reservation
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Time the unused rest was returned to the tracker
+	SettledAt *time.Time `json:"settled_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the APIQuotaReservationQuery when eager-loading is set.
+	Edges                                 APIQuotaReservationEdges `json:"edges"`
+	api_quota_tracker_reservations        *ulid.ID
+	api_quota_tracker_budget_reservations *ulid.ID
+	selectValues                          sql.SelectValues
+}
+
+// APIQuotaReservationEdges holds the relations/edges for other nodes in the graph.
+type APIQuotaReservationEdges struct {
+	// Tracker the calls are reserved against
+	Tracker *APIQuotaTracker `json:"tracker,omitempty"`
+	// Budget tracker the calls are also reserved against, if the caller has a budget
+	BudgetTracker *APIQuotaTracker `json:"budget_tracker,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// TrackerOrErr returns the Tracker value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e APIQuotaReservationEdges) TrackerOrErr() (*APIQuotaTracker, error) {
+	if e.Tracker != nil {
+		return e.Tracker, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: apiquotatracker.Label}
+	}
+	return nil, &NotLoadedError{edge: "tracker"}
+}
+
+// BudgetTrackerOrErr returns the BudgetTracker value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e APIQuotaReservationEdges) BudgetTrackerOrErr() (*APIQuotaTracker, error) {
+	if e.BudgetTracker != nil {
+		return e.BudgetTracker, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: apiquotatracker.Label}
+	}
+	return nil, &NotLoadedError{edge: "budget_tracker"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*APIQuotaReservation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case apiquotareservation.FieldReserved, apiquotareservation.FieldUsed:
+			values[i] = new(sql.NullInt64)
+		case apiquotareservation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case apiquotareservation.FieldExpiresAt, apiquotareservation.FieldSettledAt, apiquotareservation.FieldCreatedAt, apiquotareservation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case apiquotareservation.FieldID:
+			values[i] = new(ulid.ID)
+		case apiquotareservation.ForeignKeys[0]: // api_quota_tracker_reservations
+			values[i] = &sql.NullScanner{S: new(ulid.ID)}
+		case apiquotareservation.ForeignKeys[1]: // api_quota_tracker_budget_reservations
+			values[i] = &sql.NullScanner{S: new(ulid.ID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the APIQuotaReservation fields.
+func (aqr *APIQuotaReservation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case apiquotareservation.FieldID:
+			if value, ok := values[i].(*ulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				aqr.ID = *value
+			}
+		case apiquotareservation.FieldReserved:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reserved", values[i])
+			} else if value.Valid {
+				aqr.Reserved = int(value.Int64)
+			}
+		case apiquotareservation.FieldUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field used", values[i])
+			} else if value.Valid {
+				aqr.Used = int(value.Int64)
+			}
+		case apiquotareservation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				aqr.Status = apiquotareservation.Status(value.String)
+			}
+		case apiquotareservation.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				aqr.ExpiresAt = value.Time
+			}
+		case apiquotareservation.FieldSettledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field settled_at", values[i])
+			} else if value.Valid {
+				aqr.SettledAt = new(time.Time)
+				*aqr.SettledAt = value.Time
+			}
+		case apiquotareservation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				aqr.CreatedAt = value.Time
+			}
+		case apiquotareservation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				aqr.UpdatedAt = value.Time
+			}
+		case apiquotareservation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field api_quota_tracker_reservations", values[i])
+			} else if value.Valid {
+				aqr.api_quota_tracker_reservations = new(ulid.ID)
+				*aqr.api_quota_tracker_reservations = *value.S.(*ulid.ID)
+			}
+		case apiquotareservation.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field api_quota_tracker_budget_reservations", values[i])
+			} else if value.Valid {
+				aqr.api_quota_tracker_budget_reservations = new(ulid.ID)
+				*aqr.api_quota_tracker_budget_reservations = *value.S.(*ulid.ID)
+			}
+		default:
+			aqr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the APIQuotaReservation.
+// This includes values selected through modifiers, order, etc.
+func (aqr *APIQuotaReservation) Value(name string) (ent.Value, error) {
+	return aqr.selectValues.Get(name)
+}
+
+// QueryTracker queries the "tracker" edge of the APIQuotaReservation entity.
+func (aqr *APIQuotaReservation) QueryTracker() *APIQuotaTrackerQuery {
+	return NewAPIQuotaReservationClient(aqr.config).QueryTracker(aqr)
+}
+
+// QueryBudgetTracker queries the "budget_tracker" edge of the APIQuotaReservation entity.
+func (aqr *APIQuotaReservation) QueryBudgetTracker() *APIQuotaTrackerQuery {
+	return NewAPIQuotaReservationClient(aqr.config).QueryBudgetTracker(aqr)
+}
+
+// Update returns a builder for updating this APIQuotaReservation.
+// Note that you need to call APIQuotaReservation.Unwrap() before calling this method if this APIQuotaReservation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (aqr *APIQuotaReservation) Update() *APIQuotaReservationUpdateOne {
+	return NewAPIQuotaReservationClient(aqr.config).UpdateOne(aqr)
+}
+
+// Unwrap unwraps the APIQuotaReservation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (aqr *APIQuotaReservation) Unwrap() *APIQuotaReservation {
+	_tx, ok := aqr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: APIQuotaReservation is not a transactional entity")
+	}
+	aqr.config.driver = _tx.drv
+	return aqr
+}
+
+// String implements the fmt.Stringer.
+func (aqr *APIQuotaReservation) String() string {
+	var builder strings.Builder
+	builder.WriteString("APIQuotaReservation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", aqr.ID))
+	builder.WriteString("reserved=")
+	builder.WriteString(fmt.Sprintf("%v", aqr.Reserved))
+	builder.WriteString(", ")
+	builder.WriteString("used=")
+	builder.WriteString(fmt.Sprintf("%v", aqr.Used))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", aqr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(aqr.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := aqr.SettledAt; v != nil {
+		builder.WriteString("settled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(aqr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(aqr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// APIQuotaReservations is a parsable slice of APIQuotaReservation.
+type APIQuotaReservations []*APIQuotaReservation
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apiquotareservation
+
+import (
+	"fmt"
+	"io"
+	"sheng-go-backend/ent/schema/ulid"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the apiquotareservation type in the database.
+	Label = "api_quota_reservation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReserved holds the string denoting the reserved field in the database.
+	FieldReserved = "reserved"
+	// FieldUsed holds the string denoting the used field in the database.
+	FieldUsed = "used"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldSettledAt holds the string denoting the settled_at field in the database.
+	FieldSettledAt = "settled_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeTracker holds the string denoting the tracker edge name in mutations.
+	EdgeTracker = "tracker"
+	// EdgeBudgetTracker holds the string denoting the budget_tracker edge name in mutations.
+	EdgeBudgetTracker = "budget_tracker"
+	// Table holds the table name of the apiquotareservation in the database.
+	Table = "api_quota_reservations"
+	// TrackerTable is the table that holds the tracker relation/edge.
+	TrackerTable = "api_quota_reservations"
+	// TrackerInverseTable is the table name for the APIQuotaTracker entity.
+	// It exists in this package in order to avoid circular dependency with the "apiquotatracker" package.
+	TrackerInverseTable = "api_quota_trackers"
+	// TrackerColumn is the table column denoting the tracker relation/edge.
+	TrackerColumn = "api_quota_tracker_reservations"
+	// BudgetTrackerTable is the table that holds the budget_tracker relation/edge.
+	BudgetTrackerTable = "api_quota_reservations"
+	// BudgetTrackerInverseTable is the table name for the APIQuotaTracker entity.
+	// It exists in this package in order to avoid circular dependency with the "apiquotatracker" package.
+	BudgetTrackerInverseTable = "api_quota_trackers"
+	// BudgetTrackerColumn is the table column denoting the budget_tracker relation/edge.
+	BudgetTrackerColumn = "api_quota_tracker_budget_reservations"
+)
+
+// Columns holds all SQL columns for apiquotareservation fields.
+var Columns = []string{
+	FieldID,
+	FieldReserved,
+	FieldUsed,
+	FieldStatus,
+	FieldExpiresAt,
+	FieldSettledAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "api_quota_reservations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"api_quota_tracker_reservations",
+	"api_quota_tracker_budget_reservations",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReservedValidator is a validator for the "reserved" field. It is called by the builders before save.
+	ReservedValidator func(int) error
+	// DefaultUsed holds the default value on creation for the "used" field.
+	DefaultUsed int
+	// UsedValidator is a validator for the "used" field. It is called by the builders before save.
+	UsedValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() ulid.ID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOpen is the default value of the Status enum.
+const DefaultStatus = StatusOpen
+
+// Status values.
+const (
+	StatusOpen      Status = "OPEN"
+	StatusCommitted Status = "COMMITTED"
+	StatusReleased  Status = "RELEASED"
+	StatusExpired   Status = "EXPIRED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusCommitted, StatusReleased, StatusExpired:
+		return nil
+	default:
+		return fmt.Errorf("apiquotareservation: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the APIQuotaReservation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReserved orders the results by the reserved field.
+func ByReserved(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReserved, opts...).ToFunc()
+}
+
+// ByUsed orders the results by the used field.
+func ByUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsed, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// BySettledAt orders the results by the settled_at field.
+func BySettledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettledAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTrackerField orders the results by tracker field.
+func ByTrackerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTrackerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBudgetTrackerField orders the results by budget_tracker field.
+func ByBudgetTrackerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBudgetTrackerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTrackerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TrackerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TrackerTable, TrackerColumn),
+	)
+}
+func newBudgetTrackerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BudgetTrackerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BudgetTrackerTable, BudgetTrackerColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Status) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Status) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Status(str)
+	if err := StatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Status", str)
+	}
+	return nil
+}
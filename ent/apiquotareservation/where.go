@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apiquotareservation
+
+import (
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id ulid.ID) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id ulid.ID) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id ulid.ID) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...ulid.ID) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...ulid.ID) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id ulid.ID) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id ulid.ID) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id ulid.ID) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id ulid.ID) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLTE(FieldID, id))
+}
+
+// Reserved applies equality check predicate on the "reserved" field. It's identical to ReservedEQ.
+func Reserved(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldReserved, v))
+}
+
+// Used applies equality check predicate on the "used" field. It's identical to UsedEQ.
+func Used(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldUsed, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// SettledAt applies equality check predicate on the "settled_at" field. It's identical to SettledAtEQ.
+func SettledAt(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldSettledAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ReservedEQ applies the EQ predicate on the "reserved" field.
+func ReservedEQ(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldReserved, v))
+}
+
+// ReservedNEQ applies the NEQ predicate on the "reserved" field.
+func ReservedNEQ(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNEQ(FieldReserved, v))
+}
+
+// ReservedIn applies the In predicate on the "reserved" field.
+func ReservedIn(vs ...int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldIn(FieldReserved, vs...))
+}
+
+// ReservedNotIn applies the NotIn predicate on the "reserved" field.
+func ReservedNotIn(vs ...int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNotIn(FieldReserved, vs...))
+}
+
+// ReservedGT applies the GT predicate on the "reserved" field.
+func ReservedGT(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGT(FieldReserved, v))
+}
+
+// ReservedGTE applies the GTE predicate on the "reserved" field.
+func ReservedGTE(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGTE(FieldReserved, v))
+}
+
+// ReservedLT applies the LT predicate on the "reserved" field.
+func ReservedLT(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLT(FieldReserved, v))
+}
+
+// ReservedLTE applies the LTE predicate on the "reserved" field.
+func ReservedLTE(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLTE(FieldReserved, v))
+}
+
+// UsedEQ applies the EQ predicate on the "used" field.
+func UsedEQ(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldUsed, v))
+}
+
+// UsedNEQ applies the NEQ predicate on the "used" field.
+func UsedNEQ(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNEQ(FieldUsed, v))
+}
+
+// UsedIn applies the In predicate on the "used" field.
+func UsedIn(vs ...int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldIn(FieldUsed, vs...))
+}
+
+// UsedNotIn applies the NotIn predicate on the "used" field.
+func UsedNotIn(vs ...int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNotIn(FieldUsed, vs...))
+}
+
+// UsedGT applies the GT predicate on the "used" field.
+func UsedGT(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGT(FieldUsed, v))
+}
+
+// UsedGTE applies the GTE predicate on the "used" field.
+func UsedGTE(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGTE(FieldUsed, v))
+}
+
+// UsedLT applies the LT predicate on the "used" field.
+func UsedLT(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLT(FieldUsed, v))
+}
+
+// UsedLTE applies the LTE predicate on the "used" field.
+func UsedLTE(v int) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLTE(FieldUsed, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// SettledAtEQ applies the EQ predicate on the "settled_at" field.
+func SettledAtEQ(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldSettledAt, v))
+}
+
+// SettledAtNEQ applies the NEQ predicate on the "settled_at" field.
+func SettledAtNEQ(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNEQ(FieldSettledAt, v))
+}
+
+// SettledAtIn applies the In predicate on the "settled_at" field.
+func SettledAtIn(vs ...time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldIn(FieldSettledAt, vs...))
+}
+
+// SettledAtNotIn applies the NotIn predicate on the "settled_at" field.
+func SettledAtNotIn(vs ...time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNotIn(FieldSettledAt, vs...))
+}
+
+// SettledAtGT applies the GT predicate on the "settled_at" field.
+func SettledAtGT(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGT(FieldSettledAt, v))
+}
+
+// SettledAtGTE applies the GTE predicate on the "settled_at" field.
+func SettledAtGTE(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGTE(FieldSettledAt, v))
+}
+
+// SettledAtLT applies the LT predicate on the "settled_at" field.
+func SettledAtLT(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLT(FieldSettledAt, v))
+}
+
+// SettledAtLTE applies the LTE predicate on the "settled_at" field.
+func SettledAtLTE(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLTE(FieldSettledAt, v))
+}
+
+// SettledAtIsNil applies the IsNil predicate on the "settled_at" field.
+func SettledAtIsNil() predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldIsNull(FieldSettledAt))
+}
+
+// SettledAtNotNil applies the NotNil predicate on the "settled_at" field.
+func SettledAtNotNil() predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNotNull(FieldSettledAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTracker applies the HasEdge predicate on the "tracker" edge.
+func HasTracker() predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TrackerTable, TrackerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTrackerWith applies the HasEdge predicate on the "tracker" edge with a given conditions (other predicates).
+func HasTrackerWith(preds ...predicate.APIQuotaTracker) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(func(s *sql.Selector) {
+		step := newTrackerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBudgetTracker applies the HasEdge predicate on the "budget_tracker" edge.
+func HasBudgetTracker() predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BudgetTrackerTable, BudgetTrackerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBudgetTrackerWith applies the HasEdge predicate on the "budget_tracker" edge with a given conditions (other predicates).
+func HasBudgetTrackerWith(preds ...predicate.APIQuotaTracker) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(func(s *sql.Selector) {
+		step := newBudgetTrackerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.APIQuotaReservation) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.APIQuotaReservation) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.APIQuotaReservation) predicate.APIQuotaReservation {
+	return predicate.APIQuotaReservation(sql.NotPredicates(p))
+}
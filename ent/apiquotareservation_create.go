@@ -0,0 +1,935 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/apiquotareservation"
+	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIQuotaReservationCreate is the builder for creating a APIQuotaReservation entity.
+type APIQuotaReservationCreate struct {
+	config
+	mutation *APIQuotaReservationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReserved sets the "reserved" field.
+func (aqrc *APIQuotaReservationCreate) SetReserved(i int) *APIQuotaReservationCreate {
+	aqrc.mutation.SetReserved(i)
+	return aqrc
+}
+
+// SetUsed sets the "used" field.
+func (aqrc *APIQuotaReservationCreate) SetUsed(i int) *APIQuotaReservationCreate {
+	aqrc.mutation.SetUsed(i)
+	return aqrc
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (aqrc *APIQuotaReservationCreate) SetNillableUsed(i *int) *APIQuotaReservationCreate {
+	if i != nil {
+		aqrc.SetUsed(*i)
+	}
+	return aqrc
+}
+
+// SetStatus sets the "status" field.
+func (aqrc *APIQuotaReservationCreate) SetStatus(a apiquotareservation.Status) *APIQuotaReservationCreate {
+	aqrc.mutation.SetStatus(a)
+	return aqrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aqrc *APIQuotaReservationCreate) SetNillableStatus(a *apiquotareservation.Status) *APIQuotaReservationCreate {
+	if a != nil {
+		aqrc.SetStatus(*a)
+	}
+	return aqrc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (aqrc *APIQuotaReservationCreate) SetExpiresAt(t time.Time) *APIQuotaReservationCreate {
+	aqrc.mutation.SetExpiresAt(t)
+	return aqrc
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (aqrc *APIQuotaReservationCreate) SetSettledAt(t time.Time) *APIQuotaReservationCreate {
+	aqrc.mutation.SetSettledAt(t)
+	return aqrc
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (aqrc *APIQuotaReservationCreate) SetNillableSettledAt(t *time.Time) *APIQuotaReservationCreate {
+	if t != nil {
+		aqrc.SetSettledAt(*t)
+	}
+	return aqrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aqrc *APIQuotaReservationCreate) SetCreatedAt(t time.Time) *APIQuotaReservationCreate {
+	aqrc.mutation.SetCreatedAt(t)
+	return aqrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aqrc *APIQuotaReservationCreate) SetNillableCreatedAt(t *time.Time) *APIQuotaReservationCreate {
+	if t != nil {
+		aqrc.SetCreatedAt(*t)
+	}
+	return aqrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aqrc *APIQuotaReservationCreate) SetUpdatedAt(t time.Time) *APIQuotaReservationCreate {
+	aqrc.mutation.SetUpdatedAt(t)
+	return aqrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (aqrc *APIQuotaReservationCreate) SetNillableUpdatedAt(t *time.Time) *APIQuotaReservationCreate {
+	if t != nil {
+		aqrc.SetUpdatedAt(*t)
+	}
+	return aqrc
+}
+
+// SetID sets the "id" field.
+func (aqrc *APIQuotaReservationCreate) SetID(u ulid.ID) *APIQuotaReservationCreate {
+	aqrc.mutation.SetID(u)
+	return aqrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (aqrc *APIQuotaReservationCreate) SetNillableID(u *ulid.ID) *APIQuotaReservationCreate {
+	if u != nil {
+		aqrc.SetID(*u)
+	}
+	return aqrc
+}
+
+// SetTrackerID sets the "tracker" edge to the APIQuotaTracker entity by ID.
+func (aqrc *APIQuotaReservationCreate) SetTrackerID(id ulid.ID) *APIQuotaReservationCreate {
+	aqrc.mutation.SetTrackerID(id)
+	return aqrc
+}
+
+// SetTracker sets the "tracker" edge to the APIQuotaTracker entity.
+func (aqrc *APIQuotaReservationCreate) SetTracker(a *APIQuotaTracker) *APIQuotaReservationCreate {
+	return aqrc.SetTrackerID(a.ID)
+}
+
+// SetBudgetTrackerID sets the "budget_tracker" edge to the APIQuotaTracker entity by ID.
+func (aqrc *APIQuotaReservationCreate) SetBudgetTrackerID(id ulid.ID) *APIQuotaReservationCreate {
+	aqrc.mutation.SetBudgetTrackerID(id)
+	return aqrc
+}
+
+// SetNillableBudgetTrackerID sets the "budget_tracker" edge to the APIQuotaTracker entity by ID if the given value is not nil.
+func (aqrc *APIQuotaReservationCreate) SetNillableBudgetTrackerID(id *ulid.ID) *APIQuotaReservationCreate {
+	if id != nil {
+		aqrc = aqrc.SetBudgetTrackerID(*id)
+	}
+	return aqrc
+}
+
+// SetBudgetTracker sets the "budget_tracker" edge to the APIQuotaTracker entity.
+func (aqrc *APIQuotaReservationCreate) SetBudgetTracker(a *APIQuotaTracker) *APIQuotaReservationCreate {
+	return aqrc.SetBudgetTrackerID(a.ID)
+}
+
+// Mutation returns the APIQuotaReservationMutation object of the builder.
+func (aqrc *APIQuotaReservationCreate) Mutation() *APIQuotaReservationMutation {
+	return aqrc.mutation
+}
+
+// Save creates the APIQuotaReservation in the database.
+func (aqrc *APIQuotaReservationCreate) Save(ctx context.Context) (*APIQuotaReservation, error) {
+	aqrc.defaults()
+	return withHooks(ctx, aqrc.sqlSave, aqrc.mutation, aqrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aqrc *APIQuotaReservationCreate) SaveX(ctx context.Context) *APIQuotaReservation {
+	v, err := aqrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aqrc *APIQuotaReservationCreate) Exec(ctx context.Context) error {
+	_, err := aqrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqrc *APIQuotaReservationCreate) ExecX(ctx context.Context) {
+	if err := aqrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aqrc *APIQuotaReservationCreate) defaults() {
+	if _, ok := aqrc.mutation.Used(); !ok {
+		v := apiquotareservation.DefaultUsed
+		aqrc.mutation.SetUsed(v)
+	}
+	if _, ok := aqrc.mutation.Status(); !ok {
+		v := apiquotareservation.DefaultStatus
+		aqrc.mutation.SetStatus(v)
+	}
+	if _, ok := aqrc.mutation.CreatedAt(); !ok {
+		v := apiquotareservation.DefaultCreatedAt()
+		aqrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aqrc.mutation.UpdatedAt(); !ok {
+		v := apiquotareservation.DefaultUpdatedAt()
+		aqrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := aqrc.mutation.ID(); !ok {
+		v := apiquotareservation.DefaultID()
+		aqrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aqrc *APIQuotaReservationCreate) check() error {
+	if _, ok := aqrc.mutation.Reserved(); !ok {
+		return &ValidationError{Name: "reserved", err: errors.New(`ent: missing required field "APIQuotaReservation.reserved"`)}
+	}
+	if v, ok := aqrc.mutation.Reserved(); ok {
+		if err := apiquotareservation.ReservedValidator(v); err != nil {
+			return &ValidationError{Name: "reserved", err: fmt.Errorf(`ent: validator failed for field "APIQuotaReservation.reserved": %w`, err)}
+		}
+	}
+	if _, ok := aqrc.mutation.Used(); !ok {
+		return &ValidationError{Name: "used", err: errors.New(`ent: missing required field "APIQuotaReservation.used"`)}
+	}
+	if v, ok := aqrc.mutation.Used(); ok {
+		if err := apiquotareservation.UsedValidator(v); err != nil {
+			return &ValidationError{Name: "used", err: fmt.Errorf(`ent: validator failed for field "APIQuotaReservation.used": %w`, err)}
+		}
+	}
+	if _, ok := aqrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "APIQuotaReservation.status"`)}
+	}
+	if v, ok := aqrc.mutation.Status(); ok {
+		if err := apiquotareservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "APIQuotaReservation.status": %w`, err)}
+		}
+	}
+	if _, ok := aqrc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "APIQuotaReservation.expires_at"`)}
+	}
+	if _, ok := aqrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "APIQuotaReservation.created_at"`)}
+	}
+	if _, ok := aqrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "APIQuotaReservation.updated_at"`)}
+	}
+	if len(aqrc.mutation.TrackerIDs()) == 0 {
+		return &ValidationError{Name: "tracker", err: errors.New(`ent: missing required edge "APIQuotaReservation.tracker"`)}
+	}
+	return nil
+}
+
+func (aqrc *APIQuotaReservationCreate) sqlSave(ctx context.Context) (*APIQuotaReservation, error) {
+	if err := aqrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aqrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aqrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*ulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	aqrc.mutation.id = &_node.ID
+	aqrc.mutation.done = true
+	return _node, nil
+}
+
+func (aqrc *APIQuotaReservationCreate) createSpec() (*APIQuotaReservation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &APIQuotaReservation{config: aqrc.config}
+		_spec = sqlgraph.NewCreateSpec(apiquotareservation.Table, sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = aqrc.conflict
+	if id, ok := aqrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := aqrc.mutation.Reserved(); ok {
+		_spec.SetField(apiquotareservation.FieldReserved, field.TypeInt, value)
+		_node.Reserved = value
+	}
+	if value, ok := aqrc.mutation.Used(); ok {
+		_spec.SetField(apiquotareservation.FieldUsed, field.TypeInt, value)
+		_node.Used = value
+	}
+	if value, ok := aqrc.mutation.Status(); ok {
+		_spec.SetField(apiquotareservation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := aqrc.mutation.ExpiresAt(); ok {
+		_spec.SetField(apiquotareservation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := aqrc.mutation.SettledAt(); ok {
+		_spec.SetField(apiquotareservation.FieldSettledAt, field.TypeTime, value)
+		_node.SettledAt = &value
+	}
+	if value, ok := aqrc.mutation.CreatedAt(); ok {
+		_spec.SetField(apiquotareservation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := aqrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiquotareservation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := aqrc.mutation.TrackerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiquotareservation.TrackerTable,
+			Columns: []string{apiquotareservation.TrackerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotatracker.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.api_quota_tracker_reservations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := aqrc.mutation.BudgetTrackerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiquotareservation.BudgetTrackerTable,
+			Columns: []string{apiquotareservation.BudgetTrackerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotatracker.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.api_quota_tracker_budget_reservations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APIQuotaReservation.Create().
+//		SetReserved(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APIQuotaReservationUpsert) {
+//			SetReserved(v+v).
+//		}).
+//		Exec(ctx)
+func (aqrc *APIQuotaReservationCreate) OnConflict(opts ...sql.ConflictOption) *APIQuotaReservationUpsertOne {
+	aqrc.conflict = opts
+	return &APIQuotaReservationUpsertOne{
+		create: aqrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APIQuotaReservation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aqrc *APIQuotaReservationCreate) OnConflictColumns(columns ...string) *APIQuotaReservationUpsertOne {
+	aqrc.conflict = append(aqrc.conflict, sql.ConflictColumns(columns...))
+	return &APIQuotaReservationUpsertOne{
+		create: aqrc,
+	}
+}
+
+type (
+	// APIQuotaReservationUpsertOne is the builder for "upsert"-ing
+	//  one APIQuotaReservation node.
+	APIQuotaReservationUpsertOne struct {
+		create *APIQuotaReservationCreate
+	}
+
+	// APIQuotaReservationUpsert is the "OnConflict" setter.
+	APIQuotaReservationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUsed sets the "used" field.
+func (u *APIQuotaReservationUpsert) SetUsed(v int) *APIQuotaReservationUpsert {
+	u.Set(apiquotareservation.FieldUsed, v)
+	return u
+}
+
+// UpdateUsed sets the "used" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsert) UpdateUsed() *APIQuotaReservationUpsert {
+	u.SetExcluded(apiquotareservation.FieldUsed)
+	return u
+}
+
+// AddUsed adds v to the "used" field.
+func (u *APIQuotaReservationUpsert) AddUsed(v int) *APIQuotaReservationUpsert {
+	u.Add(apiquotareservation.FieldUsed, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *APIQuotaReservationUpsert) SetStatus(v apiquotareservation.Status) *APIQuotaReservationUpsert {
+	u.Set(apiquotareservation.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsert) UpdateStatus() *APIQuotaReservationUpsert {
+	u.SetExcluded(apiquotareservation.FieldStatus)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIQuotaReservationUpsert) SetExpiresAt(v time.Time) *APIQuotaReservationUpsert {
+	u.Set(apiquotareservation.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsert) UpdateExpiresAt() *APIQuotaReservationUpsert {
+	u.SetExcluded(apiquotareservation.FieldExpiresAt)
+	return u
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (u *APIQuotaReservationUpsert) SetSettledAt(v time.Time) *APIQuotaReservationUpsert {
+	u.Set(apiquotareservation.FieldSettledAt, v)
+	return u
+}
+
+// UpdateSettledAt sets the "settled_at" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsert) UpdateSettledAt() *APIQuotaReservationUpsert {
+	u.SetExcluded(apiquotareservation.FieldSettledAt)
+	return u
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (u *APIQuotaReservationUpsert) ClearSettledAt() *APIQuotaReservationUpsert {
+	u.SetNull(apiquotareservation.FieldSettledAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIQuotaReservationUpsert) SetUpdatedAt(v time.Time) *APIQuotaReservationUpsert {
+	u.Set(apiquotareservation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsert) UpdateUpdatedAt() *APIQuotaReservationUpsert {
+	u.SetExcluded(apiquotareservation.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.APIQuotaReservation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apiquotareservation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APIQuotaReservationUpsertOne) UpdateNewValues() *APIQuotaReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(apiquotareservation.FieldID)
+		}
+		if _, exists := u.create.mutation.Reserved(); exists {
+			s.SetIgnore(apiquotareservation.FieldReserved)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(apiquotareservation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APIQuotaReservation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *APIQuotaReservationUpsertOne) Ignore() *APIQuotaReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APIQuotaReservationUpsertOne) DoNothing() *APIQuotaReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APIQuotaReservationCreate.OnConflict
+// documentation for more info.
+func (u *APIQuotaReservationUpsertOne) Update(set func(*APIQuotaReservationUpsert)) *APIQuotaReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APIQuotaReservationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUsed sets the "used" field.
+func (u *APIQuotaReservationUpsertOne) SetUsed(v int) *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.SetUsed(v)
+	})
+}
+
+// AddUsed adds v to the "used" field.
+func (u *APIQuotaReservationUpsertOne) AddUsed(v int) *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.AddUsed(v)
+	})
+}
+
+// UpdateUsed sets the "used" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsertOne) UpdateUsed() *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.UpdateUsed()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *APIQuotaReservationUpsertOne) SetStatus(v apiquotareservation.Status) *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsertOne) UpdateStatus() *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIQuotaReservationUpsertOne) SetExpiresAt(v time.Time) *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsertOne) UpdateExpiresAt() *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (u *APIQuotaReservationUpsertOne) SetSettledAt(v time.Time) *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.SetSettledAt(v)
+	})
+}
+
+// UpdateSettledAt sets the "settled_at" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsertOne) UpdateSettledAt() *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.UpdateSettledAt()
+	})
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (u *APIQuotaReservationUpsertOne) ClearSettledAt() *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.ClearSettledAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIQuotaReservationUpsertOne) SetUpdatedAt(v time.Time) *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsertOne) UpdateUpdatedAt() *APIQuotaReservationUpsertOne {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *APIQuotaReservationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APIQuotaReservationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APIQuotaReservationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *APIQuotaReservationUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: APIQuotaReservationUpsertOne.ID is not supported by MySQL driver. Use APIQuotaReservationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *APIQuotaReservationUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// APIQuotaReservationCreateBulk is the builder for creating many APIQuotaReservation entities in bulk.
+type APIQuotaReservationCreateBulk struct {
+	config
+	err      error
+	builders []*APIQuotaReservationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the APIQuotaReservation entities in the database.
+func (aqrcb *APIQuotaReservationCreateBulk) Save(ctx context.Context) ([]*APIQuotaReservation, error) {
+	if aqrcb.err != nil {
+		return nil, aqrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aqrcb.builders))
+	nodes := make([]*APIQuotaReservation, len(aqrcb.builders))
+	mutators := make([]Mutator, len(aqrcb.builders))
+	for i := range aqrcb.builders {
+		func(i int, root context.Context) {
+			builder := aqrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*APIQuotaReservationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aqrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = aqrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aqrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aqrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aqrcb *APIQuotaReservationCreateBulk) SaveX(ctx context.Context) []*APIQuotaReservation {
+	v, err := aqrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aqrcb *APIQuotaReservationCreateBulk) Exec(ctx context.Context) error {
+	_, err := aqrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqrcb *APIQuotaReservationCreateBulk) ExecX(ctx context.Context) {
+	if err := aqrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APIQuotaReservation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APIQuotaReservationUpsert) {
+//			SetReserved(v+v).
+//		}).
+//		Exec(ctx)
+func (aqrcb *APIQuotaReservationCreateBulk) OnConflict(opts ...sql.ConflictOption) *APIQuotaReservationUpsertBulk {
+	aqrcb.conflict = opts
+	return &APIQuotaReservationUpsertBulk{
+		create: aqrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APIQuotaReservation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aqrcb *APIQuotaReservationCreateBulk) OnConflictColumns(columns ...string) *APIQuotaReservationUpsertBulk {
+	aqrcb.conflict = append(aqrcb.conflict, sql.ConflictColumns(columns...))
+	return &APIQuotaReservationUpsertBulk{
+		create: aqrcb,
+	}
+}
+
+// APIQuotaReservationUpsertBulk is the builder for "upsert"-ing
+// a bulk of APIQuotaReservation nodes.
+type APIQuotaReservationUpsertBulk struct {
+	create *APIQuotaReservationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.APIQuotaReservation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apiquotareservation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APIQuotaReservationUpsertBulk) UpdateNewValues() *APIQuotaReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(apiquotareservation.FieldID)
+			}
+			if _, exists := b.mutation.Reserved(); exists {
+				s.SetIgnore(apiquotareservation.FieldReserved)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(apiquotareservation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APIQuotaReservation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *APIQuotaReservationUpsertBulk) Ignore() *APIQuotaReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APIQuotaReservationUpsertBulk) DoNothing() *APIQuotaReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APIQuotaReservationCreateBulk.OnConflict
+// documentation for more info.
+func (u *APIQuotaReservationUpsertBulk) Update(set func(*APIQuotaReservationUpsert)) *APIQuotaReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APIQuotaReservationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUsed sets the "used" field.
+func (u *APIQuotaReservationUpsertBulk) SetUsed(v int) *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.SetUsed(v)
+	})
+}
+
+// AddUsed adds v to the "used" field.
+func (u *APIQuotaReservationUpsertBulk) AddUsed(v int) *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.AddUsed(v)
+	})
+}
+
+// UpdateUsed sets the "used" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsertBulk) UpdateUsed() *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.UpdateUsed()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *APIQuotaReservationUpsertBulk) SetStatus(v apiquotareservation.Status) *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsertBulk) UpdateStatus() *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIQuotaReservationUpsertBulk) SetExpiresAt(v time.Time) *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsertBulk) UpdateExpiresAt() *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (u *APIQuotaReservationUpsertBulk) SetSettledAt(v time.Time) *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.SetSettledAt(v)
+	})
+}
+
+// UpdateSettledAt sets the "settled_at" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsertBulk) UpdateSettledAt() *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.UpdateSettledAt()
+	})
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (u *APIQuotaReservationUpsertBulk) ClearSettledAt() *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.ClearSettledAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIQuotaReservationUpsertBulk) SetUpdatedAt(v time.Time) *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIQuotaReservationUpsertBulk) UpdateUpdatedAt() *APIQuotaReservationUpsertBulk {
+	return u.Update(func(s *APIQuotaReservationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *APIQuotaReservationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the APIQuotaReservationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APIQuotaReservationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APIQuotaReservationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sheng-go-backend/ent/apiquotareservation"
+	"sheng-go-backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIQuotaReservationDelete is the builder for deleting a APIQuotaReservation entity.
+type APIQuotaReservationDelete struct {
+	config
+	hooks    []Hook
+	mutation *APIQuotaReservationMutation
+}
+
+// Where appends a list predicates to the APIQuotaReservationDelete builder.
+func (aqrd *APIQuotaReservationDelete) Where(ps ...predicate.APIQuotaReservation) *APIQuotaReservationDelete {
+	aqrd.mutation.Where(ps...)
+	return aqrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (aqrd *APIQuotaReservationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, aqrd.sqlExec, aqrd.mutation, aqrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqrd *APIQuotaReservationDelete) ExecX(ctx context.Context) int {
+	n, err := aqrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (aqrd *APIQuotaReservationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(apiquotareservation.Table, sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString))
+	if ps := aqrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, aqrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	aqrd.mutation.done = true
+	return affected, err
+}
+
+// APIQuotaReservationDeleteOne is the builder for deleting a single APIQuotaReservation entity.
+type APIQuotaReservationDeleteOne struct {
+	aqrd *APIQuotaReservationDelete
+}
+
+// Where appends a list predicates to the APIQuotaReservationDelete builder.
+func (aqrdo *APIQuotaReservationDeleteOne) Where(ps ...predicate.APIQuotaReservation) *APIQuotaReservationDeleteOne {
+	aqrdo.aqrd.mutation.Where(ps...)
+	return aqrdo
+}
+
+// Exec executes the deletion query.
+func (aqrdo *APIQuotaReservationDeleteOne) Exec(ctx context.Context) error {
+	n, err := aqrdo.aqrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{apiquotareservation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqrdo *APIQuotaReservationDeleteOne) ExecX(ctx context.Context) {
+	if err := aqrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
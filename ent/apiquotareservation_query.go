@@ -0,0 +1,732 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sheng-go-backend/ent/apiquotareservation"
+	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIQuotaReservationQuery is the builder for querying APIQuotaReservation entities.
+type APIQuotaReservationQuery struct {
+	config
+	ctx               *QueryContext
+	order             []apiquotareservation.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.APIQuotaReservation
+	withTracker       *APIQuotaTrackerQuery
+	withBudgetTracker *APIQuotaTrackerQuery
+	withFKs           bool
+	loadTotal         []func(context.Context, []*APIQuotaReservation) error
+	modifiers         []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the APIQuotaReservationQuery builder.
+func (aqrq *APIQuotaReservationQuery) Where(ps ...predicate.APIQuotaReservation) *APIQuotaReservationQuery {
+	aqrq.predicates = append(aqrq.predicates, ps...)
+	return aqrq
+}
+
+// Limit the number of records to be returned by this query.
+func (aqrq *APIQuotaReservationQuery) Limit(limit int) *APIQuotaReservationQuery {
+	aqrq.ctx.Limit = &limit
+	return aqrq
+}
+
+// Offset to start from.
+func (aqrq *APIQuotaReservationQuery) Offset(offset int) *APIQuotaReservationQuery {
+	aqrq.ctx.Offset = &offset
+	return aqrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (aqrq *APIQuotaReservationQuery) Unique(unique bool) *APIQuotaReservationQuery {
+	aqrq.ctx.Unique = &unique
+	return aqrq
+}
+
+// Order specifies how the records should be ordered.
+func (aqrq *APIQuotaReservationQuery) Order(o ...apiquotareservation.OrderOption) *APIQuotaReservationQuery {
+	aqrq.order = append(aqrq.order, o...)
+	return aqrq
+}
+
+// QueryTracker chains the current query on the "tracker" edge.
+func (aqrq *APIQuotaReservationQuery) QueryTracker() *APIQuotaTrackerQuery {
+	query := (&APIQuotaTrackerClient{config: aqrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aqrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aqrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apiquotareservation.Table, apiquotareservation.FieldID, selector),
+			sqlgraph.To(apiquotatracker.Table, apiquotatracker.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apiquotareservation.TrackerTable, apiquotareservation.TrackerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aqrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBudgetTracker chains the current query on the "budget_tracker" edge.
+func (aqrq *APIQuotaReservationQuery) QueryBudgetTracker() *APIQuotaTrackerQuery {
+	query := (&APIQuotaTrackerClient{config: aqrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aqrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aqrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apiquotareservation.Table, apiquotareservation.FieldID, selector),
+			sqlgraph.To(apiquotatracker.Table, apiquotatracker.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apiquotareservation.BudgetTrackerTable, apiquotareservation.BudgetTrackerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aqrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first APIQuotaReservation entity from the query.
+// Returns a *NotFoundError when no APIQuotaReservation was found.
+func (aqrq *APIQuotaReservationQuery) First(ctx context.Context) (*APIQuotaReservation, error) {
+	nodes, err := aqrq.Limit(1).All(setContextOp(ctx, aqrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{apiquotareservation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (aqrq *APIQuotaReservationQuery) FirstX(ctx context.Context) *APIQuotaReservation {
+	node, err := aqrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first APIQuotaReservation ID from the query.
+// Returns a *NotFoundError when no APIQuotaReservation ID was found.
+func (aqrq *APIQuotaReservationQuery) FirstID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = aqrq.Limit(1).IDs(setContextOp(ctx, aqrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{apiquotareservation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (aqrq *APIQuotaReservationQuery) FirstIDX(ctx context.Context) ulid.ID {
+	id, err := aqrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single APIQuotaReservation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one APIQuotaReservation entity is found.
+// Returns a *NotFoundError when no APIQuotaReservation entities are found.
+func (aqrq *APIQuotaReservationQuery) Only(ctx context.Context) (*APIQuotaReservation, error) {
+	nodes, err := aqrq.Limit(2).All(setContextOp(ctx, aqrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{apiquotareservation.Label}
+	default:
+		return nil, &NotSingularError{apiquotareservation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (aqrq *APIQuotaReservationQuery) OnlyX(ctx context.Context) *APIQuotaReservation {
+	node, err := aqrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only APIQuotaReservation ID in the query.
+// Returns a *NotSingularError when more than one APIQuotaReservation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (aqrq *APIQuotaReservationQuery) OnlyID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = aqrq.Limit(2).IDs(setContextOp(ctx, aqrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{apiquotareservation.Label}
+	default:
+		err = &NotSingularError{apiquotareservation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (aqrq *APIQuotaReservationQuery) OnlyIDX(ctx context.Context) ulid.ID {
+	id, err := aqrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of APIQuotaReservations.
+func (aqrq *APIQuotaReservationQuery) All(ctx context.Context) ([]*APIQuotaReservation, error) {
+	ctx = setContextOp(ctx, aqrq.ctx, ent.OpQueryAll)
+	if err := aqrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*APIQuotaReservation, *APIQuotaReservationQuery]()
+	return withInterceptors[[]*APIQuotaReservation](ctx, aqrq, qr, aqrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (aqrq *APIQuotaReservationQuery) AllX(ctx context.Context) []*APIQuotaReservation {
+	nodes, err := aqrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of APIQuotaReservation IDs.
+func (aqrq *APIQuotaReservationQuery) IDs(ctx context.Context) (ids []ulid.ID, err error) {
+	if aqrq.ctx.Unique == nil && aqrq.path != nil {
+		aqrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, aqrq.ctx, ent.OpQueryIDs)
+	if err = aqrq.Select(apiquotareservation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (aqrq *APIQuotaReservationQuery) IDsX(ctx context.Context) []ulid.ID {
+	ids, err := aqrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (aqrq *APIQuotaReservationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, aqrq.ctx, ent.OpQueryCount)
+	if err := aqrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, aqrq, querierCount[*APIQuotaReservationQuery](), aqrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (aqrq *APIQuotaReservationQuery) CountX(ctx context.Context) int {
+	count, err := aqrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (aqrq *APIQuotaReservationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, aqrq.ctx, ent.OpQueryExist)
+	switch _, err := aqrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (aqrq *APIQuotaReservationQuery) ExistX(ctx context.Context) bool {
+	exist, err := aqrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the APIQuotaReservationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (aqrq *APIQuotaReservationQuery) Clone() *APIQuotaReservationQuery {
+	if aqrq == nil {
+		return nil
+	}
+	return &APIQuotaReservationQuery{
+		config:            aqrq.config,
+		ctx:               aqrq.ctx.Clone(),
+		order:             append([]apiquotareservation.OrderOption{}, aqrq.order...),
+		inters:            append([]Interceptor{}, aqrq.inters...),
+		predicates:        append([]predicate.APIQuotaReservation{}, aqrq.predicates...),
+		withTracker:       aqrq.withTracker.Clone(),
+		withBudgetTracker: aqrq.withBudgetTracker.Clone(),
+		// clone intermediate query.
+		sql:  aqrq.sql.Clone(),
+		path: aqrq.path,
+	}
+}
+
+// WithTracker tells the query-builder to eager-load the nodes that are connected to
+// the "tracker" edge. The optional arguments are used to configure the query builder of the edge.
+func (aqrq *APIQuotaReservationQuery) WithTracker(opts ...func(*APIQuotaTrackerQuery)) *APIQuotaReservationQuery {
+	query := (&APIQuotaTrackerClient{config: aqrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aqrq.withTracker = query
+	return aqrq
+}
+
+// WithBudgetTracker tells the query-builder to eager-load the nodes that are connected to
+// the "budget_tracker" edge. The optional arguments are used to configure the query builder of the edge.
+func (aqrq *APIQuotaReservationQuery) WithBudgetTracker(opts ...func(*APIQuotaTrackerQuery)) *APIQuotaReservationQuery {
+	query := (&APIQuotaTrackerClient{config: aqrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aqrq.withBudgetTracker = query
+	return aqrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Reserved int `json:"reserved,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.APIQuotaReservation.Query().
+//		GroupBy(apiquotareservation.FieldReserved).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (aqrq *APIQuotaReservationQuery) GroupBy(field string, fields ...string) *APIQuotaReservationGroupBy {
+	aqrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &APIQuotaReservationGroupBy{build: aqrq}
+	grbuild.flds = &aqrq.ctx.Fields
+	grbuild.label = apiquotareservation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Reserved int `json:"reserved,omitempty"`
+//	}
+//
+//	client.APIQuotaReservation.Query().
+//		Select(apiquotareservation.FieldReserved).
+//		Scan(ctx, &v)
+func (aqrq *APIQuotaReservationQuery) Select(fields ...string) *APIQuotaReservationSelect {
+	aqrq.ctx.Fields = append(aqrq.ctx.Fields, fields...)
+	sbuild := &APIQuotaReservationSelect{APIQuotaReservationQuery: aqrq}
+	sbuild.label = apiquotareservation.Label
+	sbuild.flds, sbuild.scan = &aqrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a APIQuotaReservationSelect configured with the given aggregations.
+func (aqrq *APIQuotaReservationQuery) Aggregate(fns ...AggregateFunc) *APIQuotaReservationSelect {
+	return aqrq.Select().Aggregate(fns...)
+}
+
+func (aqrq *APIQuotaReservationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range aqrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, aqrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range aqrq.ctx.Fields {
+		if !apiquotareservation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if aqrq.path != nil {
+		prev, err := aqrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		aqrq.sql = prev
+	}
+	return nil
+}
+
+func (aqrq *APIQuotaReservationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*APIQuotaReservation, error) {
+	var (
+		nodes       = []*APIQuotaReservation{}
+		withFKs     = aqrq.withFKs
+		_spec       = aqrq.querySpec()
+		loadedTypes = [2]bool{
+			aqrq.withTracker != nil,
+			aqrq.withBudgetTracker != nil,
+		}
+	)
+	if aqrq.withTracker != nil || aqrq.withBudgetTracker != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, apiquotareservation.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*APIQuotaReservation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &APIQuotaReservation{config: aqrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(aqrq.modifiers) > 0 {
+		_spec.Modifiers = aqrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, aqrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := aqrq.withTracker; query != nil {
+		if err := aqrq.loadTracker(ctx, query, nodes, nil,
+			func(n *APIQuotaReservation, e *APIQuotaTracker) { n.Edges.Tracker = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aqrq.withBudgetTracker; query != nil {
+		if err := aqrq.loadBudgetTracker(ctx, query, nodes, nil,
+			func(n *APIQuotaReservation, e *APIQuotaTracker) { n.Edges.BudgetTracker = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range aqrq.loadTotal {
+		if err := aqrq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (aqrq *APIQuotaReservationQuery) loadTracker(ctx context.Context, query *APIQuotaTrackerQuery, nodes []*APIQuotaReservation, init func(*APIQuotaReservation), assign func(*APIQuotaReservation, *APIQuotaTracker)) error {
+	ids := make([]ulid.ID, 0, len(nodes))
+	nodeids := make(map[ulid.ID][]*APIQuotaReservation)
+	for i := range nodes {
+		if nodes[i].api_quota_tracker_reservations == nil {
+			continue
+		}
+		fk := *nodes[i].api_quota_tracker_reservations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(apiquotatracker.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "api_quota_tracker_reservations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (aqrq *APIQuotaReservationQuery) loadBudgetTracker(ctx context.Context, query *APIQuotaTrackerQuery, nodes []*APIQuotaReservation, init func(*APIQuotaReservation), assign func(*APIQuotaReservation, *APIQuotaTracker)) error {
+	ids := make([]ulid.ID, 0, len(nodes))
+	nodeids := make(map[ulid.ID][]*APIQuotaReservation)
+	for i := range nodes {
+		if nodes[i].api_quota_tracker_budget_reservations == nil {
+			continue
+		}
+		fk := *nodes[i].api_quota_tracker_budget_reservations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(apiquotatracker.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "api_quota_tracker_budget_reservations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (aqrq *APIQuotaReservationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := aqrq.querySpec()
+	if len(aqrq.modifiers) > 0 {
+		_spec.Modifiers = aqrq.modifiers
+	}
+	_spec.Node.Columns = aqrq.ctx.Fields
+	if len(aqrq.ctx.Fields) > 0 {
+		_spec.Unique = aqrq.ctx.Unique != nil && *aqrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, aqrq.driver, _spec)
+}
+
+func (aqrq *APIQuotaReservationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(apiquotareservation.Table, apiquotareservation.Columns, sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString))
+	_spec.From = aqrq.sql
+	if unique := aqrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if aqrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := aqrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apiquotareservation.FieldID)
+		for i := range fields {
+			if fields[i] != apiquotareservation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := aqrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := aqrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := aqrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := aqrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (aqrq *APIQuotaReservationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(aqrq.driver.Dialect())
+	t1 := builder.Table(apiquotareservation.Table)
+	columns := aqrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = apiquotareservation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if aqrq.sql != nil {
+		selector = aqrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if aqrq.ctx.Unique != nil && *aqrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range aqrq.modifiers {
+		m(selector)
+	}
+	for _, p := range aqrq.predicates {
+		p(selector)
+	}
+	for _, p := range aqrq.order {
+		p(selector)
+	}
+	if offset := aqrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := aqrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (aqrq *APIQuotaReservationQuery) ForUpdate(opts ...sql.LockOption) *APIQuotaReservationQuery {
+	if aqrq.driver.Dialect() == dialect.Postgres {
+		aqrq.Unique(false)
+	}
+	aqrq.modifiers = append(aqrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return aqrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (aqrq *APIQuotaReservationQuery) ForShare(opts ...sql.LockOption) *APIQuotaReservationQuery {
+	if aqrq.driver.Dialect() == dialect.Postgres {
+		aqrq.Unique(false)
+	}
+	aqrq.modifiers = append(aqrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return aqrq
+}
+
+// APIQuotaReservationGroupBy is the group-by builder for APIQuotaReservation entities.
+type APIQuotaReservationGroupBy struct {
+	selector
+	build *APIQuotaReservationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (aqrgb *APIQuotaReservationGroupBy) Aggregate(fns ...AggregateFunc) *APIQuotaReservationGroupBy {
+	aqrgb.fns = append(aqrgb.fns, fns...)
+	return aqrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aqrgb *APIQuotaReservationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aqrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := aqrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*APIQuotaReservationQuery, *APIQuotaReservationGroupBy](ctx, aqrgb.build, aqrgb, aqrgb.build.inters, v)
+}
+
+func (aqrgb *APIQuotaReservationGroupBy) sqlScan(ctx context.Context, root *APIQuotaReservationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(aqrgb.fns))
+	for _, fn := range aqrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*aqrgb.flds)+len(aqrgb.fns))
+		for _, f := range *aqrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*aqrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aqrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// APIQuotaReservationSelect is the builder for selecting fields of APIQuotaReservation entities.
+type APIQuotaReservationSelect struct {
+	*APIQuotaReservationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (aqrs *APIQuotaReservationSelect) Aggregate(fns ...AggregateFunc) *APIQuotaReservationSelect {
+	aqrs.fns = append(aqrs.fns, fns...)
+	return aqrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aqrs *APIQuotaReservationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aqrs.ctx, ent.OpQuerySelect)
+	if err := aqrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*APIQuotaReservationQuery, *APIQuotaReservationSelect](ctx, aqrs.APIQuotaReservationQuery, aqrs, aqrs.inters, v)
+}
+
+func (aqrs *APIQuotaReservationSelect) sqlScan(ctx context.Context, root *APIQuotaReservationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(aqrs.fns))
+	for _, fn := range aqrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*aqrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aqrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
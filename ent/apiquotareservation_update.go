@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/apiquotareservation"
+	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIQuotaReservationUpdate is the builder for updating APIQuotaReservation entities.
+type APIQuotaReservationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APIQuotaReservationMutation
+}
+
+// Where appends a list predicates to the APIQuotaReservationUpdate builder.
+func (aqru *APIQuotaReservationUpdate) Where(ps ...predicate.APIQuotaReservation) *APIQuotaReservationUpdate {
+	aqru.mutation.Where(ps...)
+	return aqru
+}
+
+// SetUsed sets the "used" field.
+func (aqru *APIQuotaReservationUpdate) SetUsed(i int) *APIQuotaReservationUpdate {
+	aqru.mutation.ResetUsed()
+	aqru.mutation.SetUsed(i)
+	return aqru
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (aqru *APIQuotaReservationUpdate) SetNillableUsed(i *int) *APIQuotaReservationUpdate {
+	if i != nil {
+		aqru.SetUsed(*i)
+	}
+	return aqru
+}
+
+// AddUsed adds i to the "used" field.
+func (aqru *APIQuotaReservationUpdate) AddUsed(i int) *APIQuotaReservationUpdate {
+	aqru.mutation.AddUsed(i)
+	return aqru
+}
+
+// SetStatus sets the "status" field.
+func (aqru *APIQuotaReservationUpdate) SetStatus(a apiquotareservation.Status) *APIQuotaReservationUpdate {
+	aqru.mutation.SetStatus(a)
+	return aqru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aqru *APIQuotaReservationUpdate) SetNillableStatus(a *apiquotareservation.Status) *APIQuotaReservationUpdate {
+	if a != nil {
+		aqru.SetStatus(*a)
+	}
+	return aqru
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (aqru *APIQuotaReservationUpdate) SetExpiresAt(t time.Time) *APIQuotaReservationUpdate {
+	aqru.mutation.SetExpiresAt(t)
+	return aqru
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (aqru *APIQuotaReservationUpdate) SetNillableExpiresAt(t *time.Time) *APIQuotaReservationUpdate {
+	if t != nil {
+		aqru.SetExpiresAt(*t)
+	}
+	return aqru
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (aqru *APIQuotaReservationUpdate) SetSettledAt(t time.Time) *APIQuotaReservationUpdate {
+	aqru.mutation.SetSettledAt(t)
+	return aqru
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (aqru *APIQuotaReservationUpdate) SetNillableSettledAt(t *time.Time) *APIQuotaReservationUpdate {
+	if t != nil {
+		aqru.SetSettledAt(*t)
+	}
+	return aqru
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (aqru *APIQuotaReservationUpdate) ClearSettledAt() *APIQuotaReservationUpdate {
+	aqru.mutation.ClearSettledAt()
+	return aqru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aqru *APIQuotaReservationUpdate) SetUpdatedAt(t time.Time) *APIQuotaReservationUpdate {
+	aqru.mutation.SetUpdatedAt(t)
+	return aqru
+}
+
+// SetTrackerID sets the "tracker" edge to the APIQuotaTracker entity by ID.
+func (aqru *APIQuotaReservationUpdate) SetTrackerID(id ulid.ID) *APIQuotaReservationUpdate {
+	aqru.mutation.SetTrackerID(id)
+	return aqru
+}
+
+// SetTracker sets the "tracker" edge to the APIQuotaTracker entity.
+func (aqru *APIQuotaReservationUpdate) SetTracker(a *APIQuotaTracker) *APIQuotaReservationUpdate {
+	return aqru.SetTrackerID(a.ID)
+}
+
+// SetBudgetTrackerID sets the "budget_tracker" edge to the APIQuotaTracker entity by ID.
+func (aqru *APIQuotaReservationUpdate) SetBudgetTrackerID(id ulid.ID) *APIQuotaReservationUpdate {
+	aqru.mutation.SetBudgetTrackerID(id)
+	return aqru
+}
+
+// SetNillableBudgetTrackerID sets the "budget_tracker" edge to the APIQuotaTracker entity by ID if the given value is not nil.
+func (aqru *APIQuotaReservationUpdate) SetNillableBudgetTrackerID(id *ulid.ID) *APIQuotaReservationUpdate {
+	if id != nil {
+		aqru = aqru.SetBudgetTrackerID(*id)
+	}
+	return aqru
+}
+
+// SetBudgetTracker sets the "budget_tracker" edge to the APIQuotaTracker entity.
+func (aqru *APIQuotaReservationUpdate) SetBudgetTracker(a *APIQuotaTracker) *APIQuotaReservationUpdate {
+	return aqru.SetBudgetTrackerID(a.ID)
+}
+
+// Mutation returns the APIQuotaReservationMutation object of the builder.
+func (aqru *APIQuotaReservationUpdate) Mutation() *APIQuotaReservationMutation {
+	return aqru.mutation
+}
+
+// ClearTracker clears the "tracker" edge to the APIQuotaTracker entity.
+func (aqru *APIQuotaReservationUpdate) ClearTracker() *APIQuotaReservationUpdate {
+	aqru.mutation.ClearTracker()
+	return aqru
+}
+
+// ClearBudgetTracker clears the "budget_tracker" edge to the APIQuotaTracker entity.
+func (aqru *APIQuotaReservationUpdate) ClearBudgetTracker() *APIQuotaReservationUpdate {
+	aqru.mutation.ClearBudgetTracker()
+	return aqru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aqru *APIQuotaReservationUpdate) Save(ctx context.Context) (int, error) {
+	aqru.defaults()
+	return withHooks(ctx, aqru.sqlSave, aqru.mutation, aqru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aqru *APIQuotaReservationUpdate) SaveX(ctx context.Context) int {
+	affected, err := aqru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aqru *APIQuotaReservationUpdate) Exec(ctx context.Context) error {
+	_, err := aqru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqru *APIQuotaReservationUpdate) ExecX(ctx context.Context) {
+	if err := aqru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aqru *APIQuotaReservationUpdate) defaults() {
+	if _, ok := aqru.mutation.UpdatedAt(); !ok {
+		v := apiquotareservation.UpdateDefaultUpdatedAt()
+		aqru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aqru *APIQuotaReservationUpdate) check() error {
+	if v, ok := aqru.mutation.Used(); ok {
+		if err := apiquotareservation.UsedValidator(v); err != nil {
+			return &ValidationError{Name: "used", err: fmt.Errorf(`ent: validator failed for field "APIQuotaReservation.used": %w`, err)}
+		}
+	}
+	if v, ok := aqru.mutation.Status(); ok {
+		if err := apiquotareservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "APIQuotaReservation.status": %w`, err)}
+		}
+	}
+	if aqru.mutation.TrackerCleared() && len(aqru.mutation.TrackerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "APIQuotaReservation.tracker"`)
+	}
+	return nil
+}
+
+func (aqru *APIQuotaReservationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aqru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apiquotareservation.Table, apiquotareservation.Columns, sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString))
+	if ps := aqru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aqru.mutation.Used(); ok {
+		_spec.SetField(apiquotareservation.FieldUsed, field.TypeInt, value)
+	}
+	if value, ok := aqru.mutation.AddedUsed(); ok {
+		_spec.AddField(apiquotareservation.FieldUsed, field.TypeInt, value)
+	}
+	if value, ok := aqru.mutation.Status(); ok {
+		_spec.SetField(apiquotareservation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := aqru.mutation.ExpiresAt(); ok {
+		_spec.SetField(apiquotareservation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := aqru.mutation.SettledAt(); ok {
+		_spec.SetField(apiquotareservation.FieldSettledAt, field.TypeTime, value)
+	}
+	if aqru.mutation.SettledAtCleared() {
+		_spec.ClearField(apiquotareservation.FieldSettledAt, field.TypeTime)
+	}
+	if value, ok := aqru.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiquotareservation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if aqru.mutation.TrackerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiquotareservation.TrackerTable,
+			Columns: []string{apiquotareservation.TrackerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotatracker.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqru.mutation.TrackerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiquotareservation.TrackerTable,
+			Columns: []string{apiquotareservation.TrackerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotatracker.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aqru.mutation.BudgetTrackerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiquotareservation.BudgetTrackerTable,
+			Columns: []string{apiquotareservation.BudgetTrackerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotatracker.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqru.mutation.BudgetTrackerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiquotareservation.BudgetTrackerTable,
+			Columns: []string{apiquotareservation.BudgetTrackerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotatracker.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aqru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apiquotareservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aqru.mutation.done = true
+	return n, nil
+}
+
+// APIQuotaReservationUpdateOne is the builder for updating a single APIQuotaReservation entity.
+type APIQuotaReservationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *APIQuotaReservationMutation
+}
+
+// SetUsed sets the "used" field.
+func (aqruo *APIQuotaReservationUpdateOne) SetUsed(i int) *APIQuotaReservationUpdateOne {
+	aqruo.mutation.ResetUsed()
+	aqruo.mutation.SetUsed(i)
+	return aqruo
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (aqruo *APIQuotaReservationUpdateOne) SetNillableUsed(i *int) *APIQuotaReservationUpdateOne {
+	if i != nil {
+		aqruo.SetUsed(*i)
+	}
+	return aqruo
+}
+
+// AddUsed adds i to the "used" field.
+func (aqruo *APIQuotaReservationUpdateOne) AddUsed(i int) *APIQuotaReservationUpdateOne {
+	aqruo.mutation.AddUsed(i)
+	return aqruo
+}
+
+// SetStatus sets the "status" field.
+func (aqruo *APIQuotaReservationUpdateOne) SetStatus(a apiquotareservation.Status) *APIQuotaReservationUpdateOne {
+	aqruo.mutation.SetStatus(a)
+	return aqruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aqruo *APIQuotaReservationUpdateOne) SetNillableStatus(a *apiquotareservation.Status) *APIQuotaReservationUpdateOne {
+	if a != nil {
+		aqruo.SetStatus(*a)
+	}
+	return aqruo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (aqruo *APIQuotaReservationUpdateOne) SetExpiresAt(t time.Time) *APIQuotaReservationUpdateOne {
+	aqruo.mutation.SetExpiresAt(t)
+	return aqruo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (aqruo *APIQuotaReservationUpdateOne) SetNillableExpiresAt(t *time.Time) *APIQuotaReservationUpdateOne {
+	if t != nil {
+		aqruo.SetExpiresAt(*t)
+	}
+	return aqruo
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (aqruo *APIQuotaReservationUpdateOne) SetSettledAt(t time.Time) *APIQuotaReservationUpdateOne {
+	aqruo.mutation.SetSettledAt(t)
+	return aqruo
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (aqruo *APIQuotaReservationUpdateOne) SetNillableSettledAt(t *time.Time) *APIQuotaReservationUpdateOne {
+	if t != nil {
+		aqruo.SetSettledAt(*t)
+	}
+	return aqruo
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (aqruo *APIQuotaReservationUpdateOne) ClearSettledAt() *APIQuotaReservationUpdateOne {
+	aqruo.mutation.ClearSettledAt()
+	return aqruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aqruo *APIQuotaReservationUpdateOne) SetUpdatedAt(t time.Time) *APIQuotaReservationUpdateOne {
+	aqruo.mutation.SetUpdatedAt(t)
+	return aqruo
+}
+
+// SetTrackerID sets the "tracker" edge to the APIQuotaTracker entity by ID.
+func (aqruo *APIQuotaReservationUpdateOne) SetTrackerID(id ulid.ID) *APIQuotaReservationUpdateOne {
+	aqruo.mutation.SetTrackerID(id)
+	return aqruo
+}
+
+// SetTracker sets the "tracker" edge to the APIQuotaTracker entity.
+func (aqruo *APIQuotaReservationUpdateOne) SetTracker(a *APIQuotaTracker) *APIQuotaReservationUpdateOne {
+	return aqruo.SetTrackerID(a.ID)
+}
+
+// SetBudgetTrackerID sets the "budget_tracker" edge to the APIQuotaTracker entity by ID.
+func (aqruo *APIQuotaReservationUpdateOne) SetBudgetTrackerID(id ulid.ID) *APIQuotaReservationUpdateOne {
+	aqruo.mutation.SetBudgetTrackerID(id)
+	return aqruo
+}
+
+// SetNillableBudgetTrackerID sets the "budget_tracker" edge to the APIQuotaTracker entity by ID if the given value is not nil.
+func (aqruo *APIQuotaReservationUpdateOne) SetNillableBudgetTrackerID(id *ulid.ID) *APIQuotaReservationUpdateOne {
+	if id != nil {
+		aqruo = aqruo.SetBudgetTrackerID(*id)
+	}
+	return aqruo
+}
+
+// SetBudgetTracker sets the "budget_tracker" edge to the APIQuotaTracker entity.
+func (aqruo *APIQuotaReservationUpdateOne) SetBudgetTracker(a *APIQuotaTracker) *APIQuotaReservationUpdateOne {
+	return aqruo.SetBudgetTrackerID(a.ID)
+}
+
+// Mutation returns the APIQuotaReservationMutation object of the builder.
+func (aqruo *APIQuotaReservationUpdateOne) Mutation() *APIQuotaReservationMutation {
+	return aqruo.mutation
+}
+
+// ClearTracker clears the "tracker" edge to the APIQuotaTracker entity.
+func (aqruo *APIQuotaReservationUpdateOne) ClearTracker() *APIQuotaReservationUpdateOne {
+	aqruo.mutation.ClearTracker()
+	return aqruo
+}
+
+// ClearBudgetTracker clears the "budget_tracker" edge to the APIQuotaTracker entity.
+func (aqruo *APIQuotaReservationUpdateOne) ClearBudgetTracker() *APIQuotaReservationUpdateOne {
+	aqruo.mutation.ClearBudgetTracker()
+	return aqruo
+}
+
+// Where appends a list predicates to the APIQuotaReservationUpdate builder.
+func (aqruo *APIQuotaReservationUpdateOne) Where(ps ...predicate.APIQuotaReservation) *APIQuotaReservationUpdateOne {
+	aqruo.mutation.Where(ps...)
+	return aqruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aqruo *APIQuotaReservationUpdateOne) Select(field string, fields ...string) *APIQuotaReservationUpdateOne {
+	aqruo.fields = append([]string{field}, fields...)
+	return aqruo
+}
+
+// Save executes the query and returns the updated APIQuotaReservation entity.
+func (aqruo *APIQuotaReservationUpdateOne) Save(ctx context.Context) (*APIQuotaReservation, error) {
+	aqruo.defaults()
+	return withHooks(ctx, aqruo.sqlSave, aqruo.mutation, aqruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aqruo *APIQuotaReservationUpdateOne) SaveX(ctx context.Context) *APIQuotaReservation {
+	node, err := aqruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aqruo *APIQuotaReservationUpdateOne) Exec(ctx context.Context) error {
+	_, err := aqruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aqruo *APIQuotaReservationUpdateOne) ExecX(ctx context.Context) {
+	if err := aqruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aqruo *APIQuotaReservationUpdateOne) defaults() {
+	if _, ok := aqruo.mutation.UpdatedAt(); !ok {
+		v := apiquotareservation.UpdateDefaultUpdatedAt()
+		aqruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aqruo *APIQuotaReservationUpdateOne) check() error {
+	if v, ok := aqruo.mutation.Used(); ok {
+		if err := apiquotareservation.UsedValidator(v); err != nil {
+			return &ValidationError{Name: "used", err: fmt.Errorf(`ent: validator failed for field "APIQuotaReservation.used": %w`, err)}
+		}
+	}
+	if v, ok := aqruo.mutation.Status(); ok {
+		if err := apiquotareservation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "APIQuotaReservation.status": %w`, err)}
+		}
+	}
+	if aqruo.mutation.TrackerCleared() && len(aqruo.mutation.TrackerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "APIQuotaReservation.tracker"`)
+	}
+	return nil
+}
+
+func (aqruo *APIQuotaReservationUpdateOne) sqlSave(ctx context.Context) (_node *APIQuotaReservation, err error) {
+	if err := aqruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apiquotareservation.Table, apiquotareservation.Columns, sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString))
+	id, ok := aqruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APIQuotaReservation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aqruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apiquotareservation.FieldID)
+		for _, f := range fields {
+			if !apiquotareservation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apiquotareservation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aqruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aqruo.mutation.Used(); ok {
+		_spec.SetField(apiquotareservation.FieldUsed, field.TypeInt, value)
+	}
+	if value, ok := aqruo.mutation.AddedUsed(); ok {
+		_spec.AddField(apiquotareservation.FieldUsed, field.TypeInt, value)
+	}
+	if value, ok := aqruo.mutation.Status(); ok {
+		_spec.SetField(apiquotareservation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := aqruo.mutation.ExpiresAt(); ok {
+		_spec.SetField(apiquotareservation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := aqruo.mutation.SettledAt(); ok {
+		_spec.SetField(apiquotareservation.FieldSettledAt, field.TypeTime, value)
+	}
+	if aqruo.mutation.SettledAtCleared() {
+		_spec.ClearField(apiquotareservation.FieldSettledAt, field.TypeTime)
+	}
+	if value, ok := aqruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiquotareservation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if aqruo.mutation.TrackerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiquotareservation.TrackerTable,
+			Columns: []string{apiquotareservation.TrackerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotatracker.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqruo.mutation.TrackerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiquotareservation.TrackerTable,
+			Columns: []string{apiquotareservation.TrackerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotatracker.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aqruo.mutation.BudgetTrackerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiquotareservation.BudgetTrackerTable,
+			Columns: []string{apiquotareservation.BudgetTrackerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotatracker.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqruo.mutation.BudgetTrackerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apiquotareservation.BudgetTrackerTable,
+			Columns: []string{apiquotareservation.BudgetTrackerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotatracker.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &APIQuotaReservation{config: aqruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aqruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apiquotareservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aqruo.mutation.done = true
+	return _node, nil
+}
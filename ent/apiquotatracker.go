@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"sheng-go-backend/ent/apiquotatracker"
 	"sheng-go-backend/ent/schema/ulid"
@@ -22,35 +23,92 @@ type APIQuotaTracker struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// RapidAPI key label; empty for the pool-wide tracker
+	KeyLabel string `json:"key_label,omitempty"`
+	// Quota budget name; empty for the pool-wide and per-key trackers
+	Budget string `json:"budget,omitempty"`
 	// Month (1-12)
 	Month int `json:"month,omitempty"`
 	// Year
 	Year int `json:"year,omitempty"`
 	// Number of API calls made this month
 	CallCount int `json:"call_count,omitempty"`
+	// Calls reserved by open reservations and not yet made
+	ReservedCount int `json:"reserved_count,omitempty"`
 	// Monthly API call limit
 	QuotaLimit int `json:"quota_limit,omitempty"`
+	// Max calls in any rolling 24 hours; no cap when unset
+	DailyCap *int `json:"daily_cap,omitempty"`
+	// Max calls in any rolling hour; no cap when unset
+	HourlyCap *int `json:"hourly_cap,omitempty"`
+	// Cap each rolling day at the remaining monthly quota divided by the remaining days
+	EvenPacing bool `json:"even_pacing,omitempty"`
 	// Whether quota has been exceeded
 	QuotaExceeded bool `json:"quota_exceeded,omitempty"`
 	// Admin override to bypass quota limits
 	OverrideEnabled bool `json:"override_enabled,omitempty"`
 	// Whether quota exceeded notification has been sent
 	NotificationSent bool `json:"notification_sent,omitempty"`
+	// Usage thresholds, in percent of quota_limit, already alerted this month
+	AlertedThresholds []int `json:"alerted_thresholds,omitempty"`
 	// Timestamp of last API call
-	LastCallAt   *time.Time `json:"last_call_at,omitempty"`
+	LastCallAt *time.Time `json:"last_call_at,omitempty"`
+	// Key is cooling down after a 429 until this time
+	RateLimitedUntil *time.Time `json:"rate_limited_until,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the APIQuotaTrackerQuery when eager-loading is set.
+	Edges        APIQuotaTrackerEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// APIQuotaTrackerEdges holds the relations/edges for other nodes in the graph.
+type APIQuotaTrackerEdges struct {
+	// Reservation ledger of the tracker
+	Reservations []*APIQuotaReservation `json:"reservations,omitempty"`
+	// Reservations drawn from this budget tracker
+	BudgetReservations []*APIQuotaReservation `json:"budget_reservations,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedReservations       map[string][]*APIQuotaReservation
+	namedBudgetReservations map[string][]*APIQuotaReservation
+}
+
+// ReservationsOrErr returns the Reservations value or an error if the edge
+// was not loaded in eager-loading.
+func (e APIQuotaTrackerEdges) ReservationsOrErr() ([]*APIQuotaReservation, error) {
+	if e.loadedTypes[0] {
+		return e.Reservations, nil
+	}
+	return nil, &NotLoadedError{edge: "reservations"}
+}
+
+// BudgetReservationsOrErr returns the BudgetReservations value or an error if the edge
+// was not loaded in eager-loading.
+func (e APIQuotaTrackerEdges) BudgetReservationsOrErr() ([]*APIQuotaReservation, error) {
+	if e.loadedTypes[1] {
+		return e.BudgetReservations, nil
+	}
+	return nil, &NotLoadedError{edge: "budget_reservations"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*APIQuotaTracker) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case apiquotatracker.FieldQuotaExceeded, apiquotatracker.FieldOverrideEnabled, apiquotatracker.FieldNotificationSent:
+		case apiquotatracker.FieldAlertedThresholds:
+			values[i] = new([]byte)
+		case apiquotatracker.FieldEvenPacing, apiquotatracker.FieldQuotaExceeded, apiquotatracker.FieldOverrideEnabled, apiquotatracker.FieldNotificationSent:
 			values[i] = new(sql.NullBool)
-		case apiquotatracker.FieldMonth, apiquotatracker.FieldYear, apiquotatracker.FieldCallCount, apiquotatracker.FieldQuotaLimit:
+		case apiquotatracker.FieldMonth, apiquotatracker.FieldYear, apiquotatracker.FieldCallCount, apiquotatracker.FieldReservedCount, apiquotatracker.FieldQuotaLimit, apiquotatracker.FieldDailyCap, apiquotatracker.FieldHourlyCap:
 			values[i] = new(sql.NullInt64)
-		case apiquotatracker.FieldCreatedAt, apiquotatracker.FieldUpdatedAt, apiquotatracker.FieldLastCallAt:
+		case apiquotatracker.FieldKeyLabel, apiquotatracker.FieldBudget:
+			values[i] = new(sql.NullString)
+		case apiquotatracker.FieldCreatedAt, apiquotatracker.FieldUpdatedAt, apiquotatracker.FieldLastCallAt, apiquotatracker.FieldRateLimitedUntil:
 			values[i] = new(sql.NullTime)
 		case apiquotatracker.FieldID:
 			values[i] = new(ulid.ID)
@@ -87,6 +145,18 @@ func (aqt *APIQuotaTracker) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				aqt.UpdatedAt = value.Time
 			}
+		case apiquotatracker.FieldKeyLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_label", values[i])
+			} else if value.Valid {
+				aqt.KeyLabel = value.String
+			}
+		case apiquotatracker.FieldBudget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field budget", values[i])
+			} else if value.Valid {
+				aqt.Budget = value.String
+			}
 		case apiquotatracker.FieldMonth:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field month", values[i])
@@ -105,12 +175,38 @@ func (aqt *APIQuotaTracker) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				aqt.CallCount = int(value.Int64)
 			}
+		case apiquotatracker.FieldReservedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reserved_count", values[i])
+			} else if value.Valid {
+				aqt.ReservedCount = int(value.Int64)
+			}
 		case apiquotatracker.FieldQuotaLimit:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field quota_limit", values[i])
 			} else if value.Valid {
 				aqt.QuotaLimit = int(value.Int64)
 			}
+		case apiquotatracker.FieldDailyCap:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field daily_cap", values[i])
+			} else if value.Valid {
+				aqt.DailyCap = new(int)
+				*aqt.DailyCap = int(value.Int64)
+			}
+		case apiquotatracker.FieldHourlyCap:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hourly_cap", values[i])
+			} else if value.Valid {
+				aqt.HourlyCap = new(int)
+				*aqt.HourlyCap = int(value.Int64)
+			}
+		case apiquotatracker.FieldEvenPacing:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field even_pacing", values[i])
+			} else if value.Valid {
+				aqt.EvenPacing = value.Bool
+			}
 		case apiquotatracker.FieldQuotaExceeded:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field quota_exceeded", values[i])
@@ -129,6 +225,14 @@ func (aqt *APIQuotaTracker) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				aqt.NotificationSent = value.Bool
 			}
+		case apiquotatracker.FieldAlertedThresholds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field alerted_thresholds", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &aqt.AlertedThresholds); err != nil {
+					return fmt.Errorf("unmarshal field alerted_thresholds: %w", err)
+				}
+			}
 		case apiquotatracker.FieldLastCallAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_call_at", values[i])
@@ -136,6 +240,13 @@ func (aqt *APIQuotaTracker) assignValues(columns []string, values []any) error {
 				aqt.LastCallAt = new(time.Time)
 				*aqt.LastCallAt = value.Time
 			}
+		case apiquotatracker.FieldRateLimitedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limited_until", values[i])
+			} else if value.Valid {
+				aqt.RateLimitedUntil = new(time.Time)
+				*aqt.RateLimitedUntil = value.Time
+			}
 		default:
 			aqt.selectValues.Set(columns[i], values[i])
 		}
@@ -149,6 +260,16 @@ func (aqt *APIQuotaTracker) Value(name string) (ent.Value, error) {
 	return aqt.selectValues.Get(name)
 }
 
+// QueryReservations queries the "reservations" edge of the APIQuotaTracker entity.
+func (aqt *APIQuotaTracker) QueryReservations() *APIQuotaReservationQuery {
+	return NewAPIQuotaTrackerClient(aqt.config).QueryReservations(aqt)
+}
+
+// QueryBudgetReservations queries the "budget_reservations" edge of the APIQuotaTracker entity.
+func (aqt *APIQuotaTracker) QueryBudgetReservations() *APIQuotaReservationQuery {
+	return NewAPIQuotaTrackerClient(aqt.config).QueryBudgetReservations(aqt)
+}
+
 // Update returns a builder for updating this APIQuotaTracker.
 // Note that you need to call APIQuotaTracker.Unwrap() before calling this method if this APIQuotaTracker
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -178,6 +299,12 @@ func (aqt *APIQuotaTracker) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(aqt.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("key_label=")
+	builder.WriteString(aqt.KeyLabel)
+	builder.WriteString(", ")
+	builder.WriteString("budget=")
+	builder.WriteString(aqt.Budget)
+	builder.WriteString(", ")
 	builder.WriteString("month=")
 	builder.WriteString(fmt.Sprintf("%v", aqt.Month))
 	builder.WriteString(", ")
@@ -187,9 +314,25 @@ func (aqt *APIQuotaTracker) String() string {
 	builder.WriteString("call_count=")
 	builder.WriteString(fmt.Sprintf("%v", aqt.CallCount))
 	builder.WriteString(", ")
+	builder.WriteString("reserved_count=")
+	builder.WriteString(fmt.Sprintf("%v", aqt.ReservedCount))
+	builder.WriteString(", ")
 	builder.WriteString("quota_limit=")
 	builder.WriteString(fmt.Sprintf("%v", aqt.QuotaLimit))
 	builder.WriteString(", ")
+	if v := aqt.DailyCap; v != nil {
+		builder.WriteString("daily_cap=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := aqt.HourlyCap; v != nil {
+		builder.WriteString("hourly_cap=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("even_pacing=")
+	builder.WriteString(fmt.Sprintf("%v", aqt.EvenPacing))
+	builder.WriteString(", ")
 	builder.WriteString("quota_exceeded=")
 	builder.WriteString(fmt.Sprintf("%v", aqt.QuotaExceeded))
 	builder.WriteString(", ")
@@ -199,13 +342,69 @@ func (aqt *APIQuotaTracker) String() string {
 	builder.WriteString("notification_sent=")
 	builder.WriteString(fmt.Sprintf("%v", aqt.NotificationSent))
 	builder.WriteString(", ")
+	builder.WriteString("alerted_thresholds=")
+	builder.WriteString(fmt.Sprintf("%v", aqt.AlertedThresholds))
+	builder.WriteString(", ")
 	if v := aqt.LastCallAt; v != nil {
 		builder.WriteString("last_call_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := aqt.RateLimitedUntil; v != nil {
+		builder.WriteString("rate_limited_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedReservations returns the Reservations named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (aqt *APIQuotaTracker) NamedReservations(name string) ([]*APIQuotaReservation, error) {
+	if aqt.Edges.namedReservations == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := aqt.Edges.namedReservations[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (aqt *APIQuotaTracker) appendNamedReservations(name string, edges ...*APIQuotaReservation) {
+	if aqt.Edges.namedReservations == nil {
+		aqt.Edges.namedReservations = make(map[string][]*APIQuotaReservation)
+	}
+	if len(edges) == 0 {
+		aqt.Edges.namedReservations[name] = []*APIQuotaReservation{}
+	} else {
+		aqt.Edges.namedReservations[name] = append(aqt.Edges.namedReservations[name], edges...)
+	}
+}
+
+// NamedBudgetReservations returns the BudgetReservations named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (aqt *APIQuotaTracker) NamedBudgetReservations(name string) ([]*APIQuotaReservation, error) {
+	if aqt.Edges.namedBudgetReservations == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := aqt.Edges.namedBudgetReservations[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (aqt *APIQuotaTracker) appendNamedBudgetReservations(name string, edges ...*APIQuotaReservation) {
+	if aqt.Edges.namedBudgetReservations == nil {
+		aqt.Edges.namedBudgetReservations = make(map[string][]*APIQuotaReservation)
+	}
+	if len(edges) == 0 {
+		aqt.Edges.namedBudgetReservations[name] = []*APIQuotaReservation{}
+	} else {
+		aqt.Edges.namedBudgetReservations[name] = append(aqt.Edges.namedBudgetReservations[name], edges...)
+	}
+}
+
 // APIQuotaTrackers is a parsable slice of APIQuotaTracker.
 type APIQuotaTrackers []*APIQuotaTracker
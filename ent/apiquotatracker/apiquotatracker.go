@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -18,24 +19,58 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldKeyLabel holds the string denoting the key_label field in the database.
+	FieldKeyLabel = "key_label"
+	// FieldBudget holds the string denoting the budget field in the database.
+	FieldBudget = "budget"
 	// FieldMonth holds the string denoting the month field in the database.
 	FieldMonth = "month"
 	// FieldYear holds the string denoting the year field in the database.
 	FieldYear = "year"
 	// FieldCallCount holds the string denoting the call_count field in the database.
 	FieldCallCount = "call_count"
+	// FieldReservedCount holds the string denoting the reserved_count field in the database.
+	FieldReservedCount = "reserved_count"
 	// FieldQuotaLimit holds the string denoting the quota_limit field in the database.
 	FieldQuotaLimit = "quota_limit"
+	// FieldDailyCap holds the string denoting the daily_cap field in the database.
+	FieldDailyCap = "daily_cap"
+	// FieldHourlyCap holds the string denoting the hourly_cap field in the database.
+	FieldHourlyCap = "hourly_cap"
+	// FieldEvenPacing holds the string denoting the even_pacing field in the database.
+	FieldEvenPacing = "even_pacing"
 	// FieldQuotaExceeded holds the string denoting the quota_exceeded field in the database.
 	FieldQuotaExceeded = "quota_exceeded"
 	// FieldOverrideEnabled holds the string denoting the override_enabled field in the database.
 	FieldOverrideEnabled = "override_enabled"
 	// FieldNotificationSent holds the string denoting the notification_sent field in the database.
 	FieldNotificationSent = "notification_sent"
+	// FieldAlertedThresholds holds the string denoting the alerted_thresholds field in the database.
+	FieldAlertedThresholds = "alerted_thresholds"
 	// FieldLastCallAt holds the string denoting the last_call_at field in the database.
 	FieldLastCallAt = "last_call_at"
+	// FieldRateLimitedUntil holds the string denoting the rate_limited_until field in the database.
+	FieldRateLimitedUntil = "rate_limited_until"
+	// EdgeReservations holds the string denoting the reservations edge name in mutations.
+	EdgeReservations = "reservations"
+	// EdgeBudgetReservations holds the string denoting the budget_reservations edge name in mutations.
+	EdgeBudgetReservations = "budget_reservations"
 	// Table holds the table name of the apiquotatracker in the database.
 	Table = "api_quota_trackers"
+	// ReservationsTable is the table that holds the reservations relation/edge.
+	ReservationsTable = "api_quota_reservations"
+	// ReservationsInverseTable is the table name for the APIQuotaReservation entity.
+	// It exists in this package in order to avoid circular dependency with the "apiquotareservation" package.
+	ReservationsInverseTable = "api_quota_reservations"
+	// ReservationsColumn is the table column denoting the reservations relation/edge.
+	ReservationsColumn = "api_quota_tracker_reservations"
+	// BudgetReservationsTable is the table that holds the budget_reservations relation/edge.
+	BudgetReservationsTable = "api_quota_reservations"
+	// BudgetReservationsInverseTable is the table name for the APIQuotaReservation entity.
+	// It exists in this package in order to avoid circular dependency with the "apiquotareservation" package.
+	BudgetReservationsInverseTable = "api_quota_reservations"
+	// BudgetReservationsColumn is the table column denoting the budget_reservations relation/edge.
+	BudgetReservationsColumn = "api_quota_tracker_budget_reservations"
 )
 
 // Columns holds all SQL columns for apiquotatracker fields.
@@ -43,14 +78,22 @@ var Columns = []string{
 	FieldID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldKeyLabel,
+	FieldBudget,
 	FieldMonth,
 	FieldYear,
 	FieldCallCount,
+	FieldReservedCount,
 	FieldQuotaLimit,
+	FieldDailyCap,
+	FieldHourlyCap,
+	FieldEvenPacing,
 	FieldQuotaExceeded,
 	FieldOverrideEnabled,
 	FieldNotificationSent,
+	FieldAlertedThresholds,
 	FieldLastCallAt,
+	FieldRateLimitedUntil,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -70,6 +113,10 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultKeyLabel holds the default value on creation for the "key_label" field.
+	DefaultKeyLabel string
+	// DefaultBudget holds the default value on creation for the "budget" field.
+	DefaultBudget string
 	// MonthValidator is a validator for the "month" field. It is called by the builders before save.
 	MonthValidator func(int) error
 	// YearValidator is a validator for the "year" field. It is called by the builders before save.
@@ -78,10 +125,20 @@ var (
 	DefaultCallCount int
 	// CallCountValidator is a validator for the "call_count" field. It is called by the builders before save.
 	CallCountValidator func(int) error
+	// DefaultReservedCount holds the default value on creation for the "reserved_count" field.
+	DefaultReservedCount int
+	// ReservedCountValidator is a validator for the "reserved_count" field. It is called by the builders before save.
+	ReservedCountValidator func(int) error
 	// DefaultQuotaLimit holds the default value on creation for the "quota_limit" field.
 	DefaultQuotaLimit int
 	// QuotaLimitValidator is a validator for the "quota_limit" field. It is called by the builders before save.
 	QuotaLimitValidator func(int) error
+	// DailyCapValidator is a validator for the "daily_cap" field. It is called by the builders before save.
+	DailyCapValidator func(int) error
+	// HourlyCapValidator is a validator for the "hourly_cap" field. It is called by the builders before save.
+	HourlyCapValidator func(int) error
+	// DefaultEvenPacing holds the default value on creation for the "even_pacing" field.
+	DefaultEvenPacing bool
 	// DefaultQuotaExceeded holds the default value on creation for the "quota_exceeded" field.
 	DefaultQuotaExceeded bool
 	// DefaultOverrideEnabled holds the default value on creation for the "override_enabled" field.
@@ -110,6 +167,16 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByKeyLabel orders the results by the key_label field.
+func ByKeyLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyLabel, opts...).ToFunc()
+}
+
+// ByBudget orders the results by the budget field.
+func ByBudget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBudget, opts...).ToFunc()
+}
+
 // ByMonth orders the results by the month field.
 func ByMonth(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMonth, opts...).ToFunc()
@@ -125,11 +192,31 @@ func ByCallCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCallCount, opts...).ToFunc()
 }
 
+// ByReservedCount orders the results by the reserved_count field.
+func ByReservedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReservedCount, opts...).ToFunc()
+}
+
 // ByQuotaLimit orders the results by the quota_limit field.
 func ByQuotaLimit(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuotaLimit, opts...).ToFunc()
 }
 
+// ByDailyCap orders the results by the daily_cap field.
+func ByDailyCap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyCap, opts...).ToFunc()
+}
+
+// ByHourlyCap orders the results by the hourly_cap field.
+func ByHourlyCap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHourlyCap, opts...).ToFunc()
+}
+
+// ByEvenPacing orders the results by the even_pacing field.
+func ByEvenPacing(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEvenPacing, opts...).ToFunc()
+}
+
 // ByQuotaExceeded orders the results by the quota_exceeded field.
 func ByQuotaExceeded(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuotaExceeded, opts...).ToFunc()
@@ -149,3 +236,50 @@ func ByNotificationSent(opts ...sql.OrderTermOption) OrderOption {
 func ByLastCallAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastCallAt, opts...).ToFunc()
 }
+
+// ByRateLimitedUntil orders the results by the rate_limited_until field.
+func ByRateLimitedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateLimitedUntil, opts...).ToFunc()
+}
+
+// ByReservationsCount orders the results by reservations count.
+func ByReservationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReservationsStep(), opts...)
+	}
+}
+
+// ByReservations orders the results by reservations terms.
+func ByReservations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReservationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBudgetReservationsCount orders the results by budget_reservations count.
+func ByBudgetReservationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBudgetReservationsStep(), opts...)
+	}
+}
+
+// ByBudgetReservations orders the results by budget_reservations terms.
+func ByBudgetReservations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBudgetReservationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newReservationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReservationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReservationsTable, ReservationsColumn),
+	)
+}
+func newBudgetReservationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BudgetReservationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BudgetReservationsTable, BudgetReservationsColumn),
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -65,6 +66,16 @@ func UpdatedAt(v time.Time) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// KeyLabel applies equality check predicate on the "key_label" field. It's identical to KeyLabelEQ.
+func KeyLabel(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldKeyLabel, v))
+}
+
+// Budget applies equality check predicate on the "budget" field. It's identical to BudgetEQ.
+func Budget(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldBudget, v))
+}
+
 // Month applies equality check predicate on the "month" field. It's identical to MonthEQ.
 func Month(v int) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldEQ(FieldMonth, v))
@@ -80,11 +91,31 @@ func CallCount(v int) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldEQ(FieldCallCount, v))
 }
 
+// ReservedCount applies equality check predicate on the "reserved_count" field. It's identical to ReservedCountEQ.
+func ReservedCount(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldReservedCount, v))
+}
+
 // QuotaLimit applies equality check predicate on the "quota_limit" field. It's identical to QuotaLimitEQ.
 func QuotaLimit(v int) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldEQ(FieldQuotaLimit, v))
 }
 
+// DailyCap applies equality check predicate on the "daily_cap" field. It's identical to DailyCapEQ.
+func DailyCap(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldDailyCap, v))
+}
+
+// HourlyCap applies equality check predicate on the "hourly_cap" field. It's identical to HourlyCapEQ.
+func HourlyCap(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldHourlyCap, v))
+}
+
+// EvenPacing applies equality check predicate on the "even_pacing" field. It's identical to EvenPacingEQ.
+func EvenPacing(v bool) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldEvenPacing, v))
+}
+
 // QuotaExceeded applies equality check predicate on the "quota_exceeded" field. It's identical to QuotaExceededEQ.
 func QuotaExceeded(v bool) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldEQ(FieldQuotaExceeded, v))
@@ -105,6 +136,11 @@ func LastCallAt(v time.Time) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldEQ(FieldLastCallAt, v))
 }
 
+// RateLimitedUntil applies equality check predicate on the "rate_limited_until" field. It's identical to RateLimitedUntilEQ.
+func RateLimitedUntil(v time.Time) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldRateLimitedUntil, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldEQ(FieldCreatedAt, v))
@@ -185,6 +221,136 @@ func UpdatedAtLTE(v time.Time) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// KeyLabelEQ applies the EQ predicate on the "key_label" field.
+func KeyLabelEQ(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldKeyLabel, v))
+}
+
+// KeyLabelNEQ applies the NEQ predicate on the "key_label" field.
+func KeyLabelNEQ(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNEQ(FieldKeyLabel, v))
+}
+
+// KeyLabelIn applies the In predicate on the "key_label" field.
+func KeyLabelIn(vs ...string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldIn(FieldKeyLabel, vs...))
+}
+
+// KeyLabelNotIn applies the NotIn predicate on the "key_label" field.
+func KeyLabelNotIn(vs ...string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNotIn(FieldKeyLabel, vs...))
+}
+
+// KeyLabelGT applies the GT predicate on the "key_label" field.
+func KeyLabelGT(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGT(FieldKeyLabel, v))
+}
+
+// KeyLabelGTE applies the GTE predicate on the "key_label" field.
+func KeyLabelGTE(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGTE(FieldKeyLabel, v))
+}
+
+// KeyLabelLT applies the LT predicate on the "key_label" field.
+func KeyLabelLT(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLT(FieldKeyLabel, v))
+}
+
+// KeyLabelLTE applies the LTE predicate on the "key_label" field.
+func KeyLabelLTE(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLTE(FieldKeyLabel, v))
+}
+
+// KeyLabelContains applies the Contains predicate on the "key_label" field.
+func KeyLabelContains(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldContains(FieldKeyLabel, v))
+}
+
+// KeyLabelHasPrefix applies the HasPrefix predicate on the "key_label" field.
+func KeyLabelHasPrefix(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldHasPrefix(FieldKeyLabel, v))
+}
+
+// KeyLabelHasSuffix applies the HasSuffix predicate on the "key_label" field.
+func KeyLabelHasSuffix(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldHasSuffix(FieldKeyLabel, v))
+}
+
+// KeyLabelEqualFold applies the EqualFold predicate on the "key_label" field.
+func KeyLabelEqualFold(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEqualFold(FieldKeyLabel, v))
+}
+
+// KeyLabelContainsFold applies the ContainsFold predicate on the "key_label" field.
+func KeyLabelContainsFold(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldContainsFold(FieldKeyLabel, v))
+}
+
+// BudgetEQ applies the EQ predicate on the "budget" field.
+func BudgetEQ(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldBudget, v))
+}
+
+// BudgetNEQ applies the NEQ predicate on the "budget" field.
+func BudgetNEQ(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNEQ(FieldBudget, v))
+}
+
+// BudgetIn applies the In predicate on the "budget" field.
+func BudgetIn(vs ...string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldIn(FieldBudget, vs...))
+}
+
+// BudgetNotIn applies the NotIn predicate on the "budget" field.
+func BudgetNotIn(vs ...string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNotIn(FieldBudget, vs...))
+}
+
+// BudgetGT applies the GT predicate on the "budget" field.
+func BudgetGT(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGT(FieldBudget, v))
+}
+
+// BudgetGTE applies the GTE predicate on the "budget" field.
+func BudgetGTE(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGTE(FieldBudget, v))
+}
+
+// BudgetLT applies the LT predicate on the "budget" field.
+func BudgetLT(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLT(FieldBudget, v))
+}
+
+// BudgetLTE applies the LTE predicate on the "budget" field.
+func BudgetLTE(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLTE(FieldBudget, v))
+}
+
+// BudgetContains applies the Contains predicate on the "budget" field.
+func BudgetContains(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldContains(FieldBudget, v))
+}
+
+// BudgetHasPrefix applies the HasPrefix predicate on the "budget" field.
+func BudgetHasPrefix(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldHasPrefix(FieldBudget, v))
+}
+
+// BudgetHasSuffix applies the HasSuffix predicate on the "budget" field.
+func BudgetHasSuffix(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldHasSuffix(FieldBudget, v))
+}
+
+// BudgetEqualFold applies the EqualFold predicate on the "budget" field.
+func BudgetEqualFold(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEqualFold(FieldBudget, v))
+}
+
+// BudgetContainsFold applies the ContainsFold predicate on the "budget" field.
+func BudgetContainsFold(v string) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldContainsFold(FieldBudget, v))
+}
+
 // MonthEQ applies the EQ predicate on the "month" field.
 func MonthEQ(v int) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldEQ(FieldMonth, v))
@@ -305,6 +471,46 @@ func CallCountLTE(v int) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldLTE(FieldCallCount, v))
 }
 
+// ReservedCountEQ applies the EQ predicate on the "reserved_count" field.
+func ReservedCountEQ(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldReservedCount, v))
+}
+
+// ReservedCountNEQ applies the NEQ predicate on the "reserved_count" field.
+func ReservedCountNEQ(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNEQ(FieldReservedCount, v))
+}
+
+// ReservedCountIn applies the In predicate on the "reserved_count" field.
+func ReservedCountIn(vs ...int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldIn(FieldReservedCount, vs...))
+}
+
+// ReservedCountNotIn applies the NotIn predicate on the "reserved_count" field.
+func ReservedCountNotIn(vs ...int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNotIn(FieldReservedCount, vs...))
+}
+
+// ReservedCountGT applies the GT predicate on the "reserved_count" field.
+func ReservedCountGT(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGT(FieldReservedCount, v))
+}
+
+// ReservedCountGTE applies the GTE predicate on the "reserved_count" field.
+func ReservedCountGTE(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGTE(FieldReservedCount, v))
+}
+
+// ReservedCountLT applies the LT predicate on the "reserved_count" field.
+func ReservedCountLT(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLT(FieldReservedCount, v))
+}
+
+// ReservedCountLTE applies the LTE predicate on the "reserved_count" field.
+func ReservedCountLTE(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLTE(FieldReservedCount, v))
+}
+
 // QuotaLimitEQ applies the EQ predicate on the "quota_limit" field.
 func QuotaLimitEQ(v int) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldEQ(FieldQuotaLimit, v))
@@ -345,6 +551,116 @@ func QuotaLimitLTE(v int) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldLTE(FieldQuotaLimit, v))
 }
 
+// DailyCapEQ applies the EQ predicate on the "daily_cap" field.
+func DailyCapEQ(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldDailyCap, v))
+}
+
+// DailyCapNEQ applies the NEQ predicate on the "daily_cap" field.
+func DailyCapNEQ(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNEQ(FieldDailyCap, v))
+}
+
+// DailyCapIn applies the In predicate on the "daily_cap" field.
+func DailyCapIn(vs ...int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldIn(FieldDailyCap, vs...))
+}
+
+// DailyCapNotIn applies the NotIn predicate on the "daily_cap" field.
+func DailyCapNotIn(vs ...int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNotIn(FieldDailyCap, vs...))
+}
+
+// DailyCapGT applies the GT predicate on the "daily_cap" field.
+func DailyCapGT(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGT(FieldDailyCap, v))
+}
+
+// DailyCapGTE applies the GTE predicate on the "daily_cap" field.
+func DailyCapGTE(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGTE(FieldDailyCap, v))
+}
+
+// DailyCapLT applies the LT predicate on the "daily_cap" field.
+func DailyCapLT(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLT(FieldDailyCap, v))
+}
+
+// DailyCapLTE applies the LTE predicate on the "daily_cap" field.
+func DailyCapLTE(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLTE(FieldDailyCap, v))
+}
+
+// DailyCapIsNil applies the IsNil predicate on the "daily_cap" field.
+func DailyCapIsNil() predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldIsNull(FieldDailyCap))
+}
+
+// DailyCapNotNil applies the NotNil predicate on the "daily_cap" field.
+func DailyCapNotNil() predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNotNull(FieldDailyCap))
+}
+
+// HourlyCapEQ applies the EQ predicate on the "hourly_cap" field.
+func HourlyCapEQ(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldHourlyCap, v))
+}
+
+// HourlyCapNEQ applies the NEQ predicate on the "hourly_cap" field.
+func HourlyCapNEQ(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNEQ(FieldHourlyCap, v))
+}
+
+// HourlyCapIn applies the In predicate on the "hourly_cap" field.
+func HourlyCapIn(vs ...int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldIn(FieldHourlyCap, vs...))
+}
+
+// HourlyCapNotIn applies the NotIn predicate on the "hourly_cap" field.
+func HourlyCapNotIn(vs ...int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNotIn(FieldHourlyCap, vs...))
+}
+
+// HourlyCapGT applies the GT predicate on the "hourly_cap" field.
+func HourlyCapGT(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGT(FieldHourlyCap, v))
+}
+
+// HourlyCapGTE applies the GTE predicate on the "hourly_cap" field.
+func HourlyCapGTE(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGTE(FieldHourlyCap, v))
+}
+
+// HourlyCapLT applies the LT predicate on the "hourly_cap" field.
+func HourlyCapLT(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLT(FieldHourlyCap, v))
+}
+
+// HourlyCapLTE applies the LTE predicate on the "hourly_cap" field.
+func HourlyCapLTE(v int) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLTE(FieldHourlyCap, v))
+}
+
+// HourlyCapIsNil applies the IsNil predicate on the "hourly_cap" field.
+func HourlyCapIsNil() predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldIsNull(FieldHourlyCap))
+}
+
+// HourlyCapNotNil applies the NotNil predicate on the "hourly_cap" field.
+func HourlyCapNotNil() predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNotNull(FieldHourlyCap))
+}
+
+// EvenPacingEQ applies the EQ predicate on the "even_pacing" field.
+func EvenPacingEQ(v bool) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldEvenPacing, v))
+}
+
+// EvenPacingNEQ applies the NEQ predicate on the "even_pacing" field.
+func EvenPacingNEQ(v bool) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNEQ(FieldEvenPacing, v))
+}
+
 // QuotaExceededEQ applies the EQ predicate on the "quota_exceeded" field.
 func QuotaExceededEQ(v bool) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldEQ(FieldQuotaExceeded, v))
@@ -375,6 +691,16 @@ func NotificationSentNEQ(v bool) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldNEQ(FieldNotificationSent, v))
 }
 
+// AlertedThresholdsIsNil applies the IsNil predicate on the "alerted_thresholds" field.
+func AlertedThresholdsIsNil() predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldIsNull(FieldAlertedThresholds))
+}
+
+// AlertedThresholdsNotNil applies the NotNil predicate on the "alerted_thresholds" field.
+func AlertedThresholdsNotNil() predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNotNull(FieldAlertedThresholds))
+}
+
 // LastCallAtEQ applies the EQ predicate on the "last_call_at" field.
 func LastCallAtEQ(v time.Time) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldEQ(FieldLastCallAt, v))
@@ -425,6 +751,102 @@ func LastCallAtNotNil() predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.FieldNotNull(FieldLastCallAt))
 }
 
+// RateLimitedUntilEQ applies the EQ predicate on the "rate_limited_until" field.
+func RateLimitedUntilEQ(v time.Time) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldEQ(FieldRateLimitedUntil, v))
+}
+
+// RateLimitedUntilNEQ applies the NEQ predicate on the "rate_limited_until" field.
+func RateLimitedUntilNEQ(v time.Time) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNEQ(FieldRateLimitedUntil, v))
+}
+
+// RateLimitedUntilIn applies the In predicate on the "rate_limited_until" field.
+func RateLimitedUntilIn(vs ...time.Time) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldIn(FieldRateLimitedUntil, vs...))
+}
+
+// RateLimitedUntilNotIn applies the NotIn predicate on the "rate_limited_until" field.
+func RateLimitedUntilNotIn(vs ...time.Time) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNotIn(FieldRateLimitedUntil, vs...))
+}
+
+// RateLimitedUntilGT applies the GT predicate on the "rate_limited_until" field.
+func RateLimitedUntilGT(v time.Time) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGT(FieldRateLimitedUntil, v))
+}
+
+// RateLimitedUntilGTE applies the GTE predicate on the "rate_limited_until" field.
+func RateLimitedUntilGTE(v time.Time) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldGTE(FieldRateLimitedUntil, v))
+}
+
+// RateLimitedUntilLT applies the LT predicate on the "rate_limited_until" field.
+func RateLimitedUntilLT(v time.Time) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLT(FieldRateLimitedUntil, v))
+}
+
+// RateLimitedUntilLTE applies the LTE predicate on the "rate_limited_until" field.
+func RateLimitedUntilLTE(v time.Time) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldLTE(FieldRateLimitedUntil, v))
+}
+
+// RateLimitedUntilIsNil applies the IsNil predicate on the "rate_limited_until" field.
+func RateLimitedUntilIsNil() predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldIsNull(FieldRateLimitedUntil))
+}
+
+// RateLimitedUntilNotNil applies the NotNil predicate on the "rate_limited_until" field.
+func RateLimitedUntilNotNil() predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(sql.FieldNotNull(FieldRateLimitedUntil))
+}
+
+// HasReservations applies the HasEdge predicate on the "reservations" edge.
+func HasReservations() predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReservationsTable, ReservationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReservationsWith applies the HasEdge predicate on the "reservations" edge with a given conditions (other predicates).
+func HasReservationsWith(preds ...predicate.APIQuotaReservation) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(func(s *sql.Selector) {
+		step := newReservationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBudgetReservations applies the HasEdge predicate on the "budget_reservations" edge.
+func HasBudgetReservations() predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BudgetReservationsTable, BudgetReservationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBudgetReservationsWith applies the HasEdge predicate on the "budget_reservations" edge with a given conditions (other predicates).
+func HasBudgetReservationsWith(preds ...predicate.APIQuotaReservation) predicate.APIQuotaTracker {
+	return predicate.APIQuotaTracker(func(s *sql.Selector) {
+		step := newBudgetReservationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.APIQuotaTracker) predicate.APIQuotaTracker {
 	return predicate.APIQuotaTracker(sql.AndPredicates(predicates...))
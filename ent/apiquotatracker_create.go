@@ -6,10 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
 	"sheng-go-backend/ent/schema/ulid"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +22,7 @@ type APIQuotaTrackerCreate struct {
 	config
 	mutation *APIQuotaTrackerMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -49,6 +53,34 @@ func (aqtc *APIQuotaTrackerCreate) SetNillableUpdatedAt(t *time.Time) *APIQuotaT
 	return aqtc
 }
 
+// SetKeyLabel sets the "key_label" field.
+func (aqtc *APIQuotaTrackerCreate) SetKeyLabel(s string) *APIQuotaTrackerCreate {
+	aqtc.mutation.SetKeyLabel(s)
+	return aqtc
+}
+
+// SetNillableKeyLabel sets the "key_label" field if the given value is not nil.
+func (aqtc *APIQuotaTrackerCreate) SetNillableKeyLabel(s *string) *APIQuotaTrackerCreate {
+	if s != nil {
+		aqtc.SetKeyLabel(*s)
+	}
+	return aqtc
+}
+
+// SetBudget sets the "budget" field.
+func (aqtc *APIQuotaTrackerCreate) SetBudget(s string) *APIQuotaTrackerCreate {
+	aqtc.mutation.SetBudget(s)
+	return aqtc
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (aqtc *APIQuotaTrackerCreate) SetNillableBudget(s *string) *APIQuotaTrackerCreate {
+	if s != nil {
+		aqtc.SetBudget(*s)
+	}
+	return aqtc
+}
+
 // SetMonth sets the "month" field.
 func (aqtc *APIQuotaTrackerCreate) SetMonth(i int) *APIQuotaTrackerCreate {
 	aqtc.mutation.SetMonth(i)
@@ -75,6 +107,20 @@ func (aqtc *APIQuotaTrackerCreate) SetNillableCallCount(i *int) *APIQuotaTracker
 	return aqtc
 }
 
+// SetReservedCount sets the "reserved_count" field.
+func (aqtc *APIQuotaTrackerCreate) SetReservedCount(i int) *APIQuotaTrackerCreate {
+	aqtc.mutation.SetReservedCount(i)
+	return aqtc
+}
+
+// SetNillableReservedCount sets the "reserved_count" field if the given value is not nil.
+func (aqtc *APIQuotaTrackerCreate) SetNillableReservedCount(i *int) *APIQuotaTrackerCreate {
+	if i != nil {
+		aqtc.SetReservedCount(*i)
+	}
+	return aqtc
+}
+
 // SetQuotaLimit sets the "quota_limit" field.
 func (aqtc *APIQuotaTrackerCreate) SetQuotaLimit(i int) *APIQuotaTrackerCreate {
 	aqtc.mutation.SetQuotaLimit(i)
@@ -89,6 +135,48 @@ func (aqtc *APIQuotaTrackerCreate) SetNillableQuotaLimit(i *int) *APIQuotaTracke
 	return aqtc
 }
 
+// SetDailyCap sets the "daily_cap" field.
+func (aqtc *APIQuotaTrackerCreate) SetDailyCap(i int) *APIQuotaTrackerCreate {
+	aqtc.mutation.SetDailyCap(i)
+	return aqtc
+}
+
+// SetNillableDailyCap sets the "daily_cap" field if the given value is not nil.
+func (aqtc *APIQuotaTrackerCreate) SetNillableDailyCap(i *int) *APIQuotaTrackerCreate {
+	if i != nil {
+		aqtc.SetDailyCap(*i)
+	}
+	return aqtc
+}
+
+// SetHourlyCap sets the "hourly_cap" field.
+func (aqtc *APIQuotaTrackerCreate) SetHourlyCap(i int) *APIQuotaTrackerCreate {
+	aqtc.mutation.SetHourlyCap(i)
+	return aqtc
+}
+
+// SetNillableHourlyCap sets the "hourly_cap" field if the given value is not nil.
+func (aqtc *APIQuotaTrackerCreate) SetNillableHourlyCap(i *int) *APIQuotaTrackerCreate {
+	if i != nil {
+		aqtc.SetHourlyCap(*i)
+	}
+	return aqtc
+}
+
+// SetEvenPacing sets the "even_pacing" field.
+func (aqtc *APIQuotaTrackerCreate) SetEvenPacing(b bool) *APIQuotaTrackerCreate {
+	aqtc.mutation.SetEvenPacing(b)
+	return aqtc
+}
+
+// SetNillableEvenPacing sets the "even_pacing" field if the given value is not nil.
+func (aqtc *APIQuotaTrackerCreate) SetNillableEvenPacing(b *bool) *APIQuotaTrackerCreate {
+	if b != nil {
+		aqtc.SetEvenPacing(*b)
+	}
+	return aqtc
+}
+
 // SetQuotaExceeded sets the "quota_exceeded" field.
 func (aqtc *APIQuotaTrackerCreate) SetQuotaExceeded(b bool) *APIQuotaTrackerCreate {
 	aqtc.mutation.SetQuotaExceeded(b)
@@ -131,6 +219,12 @@ func (aqtc *APIQuotaTrackerCreate) SetNillableNotificationSent(b *bool) *APIQuot
 	return aqtc
 }
 
+// SetAlertedThresholds sets the "alerted_thresholds" field.
+func (aqtc *APIQuotaTrackerCreate) SetAlertedThresholds(i []int) *APIQuotaTrackerCreate {
+	aqtc.mutation.SetAlertedThresholds(i)
+	return aqtc
+}
+
 // SetLastCallAt sets the "last_call_at" field.
 func (aqtc *APIQuotaTrackerCreate) SetLastCallAt(t time.Time) *APIQuotaTrackerCreate {
 	aqtc.mutation.SetLastCallAt(t)
@@ -145,6 +239,20 @@ func (aqtc *APIQuotaTrackerCreate) SetNillableLastCallAt(t *time.Time) *APIQuota
 	return aqtc
 }
 
+// SetRateLimitedUntil sets the "rate_limited_until" field.
+func (aqtc *APIQuotaTrackerCreate) SetRateLimitedUntil(t time.Time) *APIQuotaTrackerCreate {
+	aqtc.mutation.SetRateLimitedUntil(t)
+	return aqtc
+}
+
+// SetNillableRateLimitedUntil sets the "rate_limited_until" field if the given value is not nil.
+func (aqtc *APIQuotaTrackerCreate) SetNillableRateLimitedUntil(t *time.Time) *APIQuotaTrackerCreate {
+	if t != nil {
+		aqtc.SetRateLimitedUntil(*t)
+	}
+	return aqtc
+}
+
 // SetID sets the "id" field.
 func (aqtc *APIQuotaTrackerCreate) SetID(u ulid.ID) *APIQuotaTrackerCreate {
 	aqtc.mutation.SetID(u)
@@ -159,6 +267,36 @@ func (aqtc *APIQuotaTrackerCreate) SetNillableID(u *ulid.ID) *APIQuotaTrackerCre
 	return aqtc
 }
 
+// AddReservationIDs adds the "reservations" edge to the APIQuotaReservation entity by IDs.
+func (aqtc *APIQuotaTrackerCreate) AddReservationIDs(ids ...ulid.ID) *APIQuotaTrackerCreate {
+	aqtc.mutation.AddReservationIDs(ids...)
+	return aqtc
+}
+
+// AddReservations adds the "reservations" edges to the APIQuotaReservation entity.
+func (aqtc *APIQuotaTrackerCreate) AddReservations(a ...*APIQuotaReservation) *APIQuotaTrackerCreate {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aqtc.AddReservationIDs(ids...)
+}
+
+// AddBudgetReservationIDs adds the "budget_reservations" edge to the APIQuotaReservation entity by IDs.
+func (aqtc *APIQuotaTrackerCreate) AddBudgetReservationIDs(ids ...ulid.ID) *APIQuotaTrackerCreate {
+	aqtc.mutation.AddBudgetReservationIDs(ids...)
+	return aqtc
+}
+
+// AddBudgetReservations adds the "budget_reservations" edges to the APIQuotaReservation entity.
+func (aqtc *APIQuotaTrackerCreate) AddBudgetReservations(a ...*APIQuotaReservation) *APIQuotaTrackerCreate {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aqtc.AddBudgetReservationIDs(ids...)
+}
+
 // Mutation returns the APIQuotaTrackerMutation object of the builder.
 func (aqtc *APIQuotaTrackerCreate) Mutation() *APIQuotaTrackerMutation {
 	return aqtc.mutation
@@ -202,14 +340,30 @@ func (aqtc *APIQuotaTrackerCreate) defaults() {
 		v := apiquotatracker.DefaultUpdatedAt()
 		aqtc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := aqtc.mutation.KeyLabel(); !ok {
+		v := apiquotatracker.DefaultKeyLabel
+		aqtc.mutation.SetKeyLabel(v)
+	}
+	if _, ok := aqtc.mutation.Budget(); !ok {
+		v := apiquotatracker.DefaultBudget
+		aqtc.mutation.SetBudget(v)
+	}
 	if _, ok := aqtc.mutation.CallCount(); !ok {
 		v := apiquotatracker.DefaultCallCount
 		aqtc.mutation.SetCallCount(v)
 	}
+	if _, ok := aqtc.mutation.ReservedCount(); !ok {
+		v := apiquotatracker.DefaultReservedCount
+		aqtc.mutation.SetReservedCount(v)
+	}
 	if _, ok := aqtc.mutation.QuotaLimit(); !ok {
 		v := apiquotatracker.DefaultQuotaLimit
 		aqtc.mutation.SetQuotaLimit(v)
 	}
+	if _, ok := aqtc.mutation.EvenPacing(); !ok {
+		v := apiquotatracker.DefaultEvenPacing
+		aqtc.mutation.SetEvenPacing(v)
+	}
 	if _, ok := aqtc.mutation.QuotaExceeded(); !ok {
 		v := apiquotatracker.DefaultQuotaExceeded
 		aqtc.mutation.SetQuotaExceeded(v)
@@ -236,6 +390,12 @@ func (aqtc *APIQuotaTrackerCreate) check() error {
 	if _, ok := aqtc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "APIQuotaTracker.updated_at"`)}
 	}
+	if _, ok := aqtc.mutation.KeyLabel(); !ok {
+		return &ValidationError{Name: "key_label", err: errors.New(`ent: missing required field "APIQuotaTracker.key_label"`)}
+	}
+	if _, ok := aqtc.mutation.Budget(); !ok {
+		return &ValidationError{Name: "budget", err: errors.New(`ent: missing required field "APIQuotaTracker.budget"`)}
+	}
 	if _, ok := aqtc.mutation.Month(); !ok {
 		return &ValidationError{Name: "month", err: errors.New(`ent: missing required field "APIQuotaTracker.month"`)}
 	}
@@ -260,6 +420,14 @@ func (aqtc *APIQuotaTrackerCreate) check() error {
 			return &ValidationError{Name: "call_count", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.call_count": %w`, err)}
 		}
 	}
+	if _, ok := aqtc.mutation.ReservedCount(); !ok {
+		return &ValidationError{Name: "reserved_count", err: errors.New(`ent: missing required field "APIQuotaTracker.reserved_count"`)}
+	}
+	if v, ok := aqtc.mutation.ReservedCount(); ok {
+		if err := apiquotatracker.ReservedCountValidator(v); err != nil {
+			return &ValidationError{Name: "reserved_count", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.reserved_count": %w`, err)}
+		}
+	}
 	if _, ok := aqtc.mutation.QuotaLimit(); !ok {
 		return &ValidationError{Name: "quota_limit", err: errors.New(`ent: missing required field "APIQuotaTracker.quota_limit"`)}
 	}
@@ -268,6 +436,19 @@ func (aqtc *APIQuotaTrackerCreate) check() error {
 			return &ValidationError{Name: "quota_limit", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.quota_limit": %w`, err)}
 		}
 	}
+	if v, ok := aqtc.mutation.DailyCap(); ok {
+		if err := apiquotatracker.DailyCapValidator(v); err != nil {
+			return &ValidationError{Name: "daily_cap", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.daily_cap": %w`, err)}
+		}
+	}
+	if v, ok := aqtc.mutation.HourlyCap(); ok {
+		if err := apiquotatracker.HourlyCapValidator(v); err != nil {
+			return &ValidationError{Name: "hourly_cap", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.hourly_cap": %w`, err)}
+		}
+	}
+	if _, ok := aqtc.mutation.EvenPacing(); !ok {
+		return &ValidationError{Name: "even_pacing", err: errors.New(`ent: missing required field "APIQuotaTracker.even_pacing"`)}
+	}
 	if _, ok := aqtc.mutation.QuotaExceeded(); !ok {
 		return &ValidationError{Name: "quota_exceeded", err: errors.New(`ent: missing required field "APIQuotaTracker.quota_exceeded"`)}
 	}
@@ -308,6 +489,7 @@ func (aqtc *APIQuotaTrackerCreate) createSpec() (*APIQuotaTracker, *sqlgraph.Cre
 		_node = &APIQuotaTracker{config: aqtc.config}
 		_spec = sqlgraph.NewCreateSpec(apiquotatracker.Table, sqlgraph.NewFieldSpec(apiquotatracker.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = aqtc.conflict
 	if id, ok := aqtc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -320,6 +502,14 @@ func (aqtc *APIQuotaTrackerCreate) createSpec() (*APIQuotaTracker, *sqlgraph.Cre
 		_spec.SetField(apiquotatracker.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := aqtc.mutation.KeyLabel(); ok {
+		_spec.SetField(apiquotatracker.FieldKeyLabel, field.TypeString, value)
+		_node.KeyLabel = value
+	}
+	if value, ok := aqtc.mutation.Budget(); ok {
+		_spec.SetField(apiquotatracker.FieldBudget, field.TypeString, value)
+		_node.Budget = value
+	}
 	if value, ok := aqtc.mutation.Month(); ok {
 		_spec.SetField(apiquotatracker.FieldMonth, field.TypeInt, value)
 		_node.Month = value
@@ -332,10 +522,26 @@ func (aqtc *APIQuotaTrackerCreate) createSpec() (*APIQuotaTracker, *sqlgraph.Cre
 		_spec.SetField(apiquotatracker.FieldCallCount, field.TypeInt, value)
 		_node.CallCount = value
 	}
+	if value, ok := aqtc.mutation.ReservedCount(); ok {
+		_spec.SetField(apiquotatracker.FieldReservedCount, field.TypeInt, value)
+		_node.ReservedCount = value
+	}
 	if value, ok := aqtc.mutation.QuotaLimit(); ok {
 		_spec.SetField(apiquotatracker.FieldQuotaLimit, field.TypeInt, value)
 		_node.QuotaLimit = value
 	}
+	if value, ok := aqtc.mutation.DailyCap(); ok {
+		_spec.SetField(apiquotatracker.FieldDailyCap, field.TypeInt, value)
+		_node.DailyCap = &value
+	}
+	if value, ok := aqtc.mutation.HourlyCap(); ok {
+		_spec.SetField(apiquotatracker.FieldHourlyCap, field.TypeInt, value)
+		_node.HourlyCap = &value
+	}
+	if value, ok := aqtc.mutation.EvenPacing(); ok {
+		_spec.SetField(apiquotatracker.FieldEvenPacing, field.TypeBool, value)
+		_node.EvenPacing = value
+	}
 	if value, ok := aqtc.mutation.QuotaExceeded(); ok {
 		_spec.SetField(apiquotatracker.FieldQuotaExceeded, field.TypeBool, value)
 		_node.QuotaExceeded = value
@@ -348,18 +554,795 @@ func (aqtc *APIQuotaTrackerCreate) createSpec() (*APIQuotaTracker, *sqlgraph.Cre
 		_spec.SetField(apiquotatracker.FieldNotificationSent, field.TypeBool, value)
 		_node.NotificationSent = value
 	}
+	if value, ok := aqtc.mutation.AlertedThresholds(); ok {
+		_spec.SetField(apiquotatracker.FieldAlertedThresholds, field.TypeJSON, value)
+		_node.AlertedThresholds = value
+	}
 	if value, ok := aqtc.mutation.LastCallAt(); ok {
 		_spec.SetField(apiquotatracker.FieldLastCallAt, field.TypeTime, value)
 		_node.LastCallAt = &value
 	}
+	if value, ok := aqtc.mutation.RateLimitedUntil(); ok {
+		_spec.SetField(apiquotatracker.FieldRateLimitedUntil, field.TypeTime, value)
+		_node.RateLimitedUntil = &value
+	}
+	if nodes := aqtc.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.ReservationsTable,
+			Columns: []string{apiquotatracker.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := aqtc.mutation.BudgetReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.BudgetReservationsTable,
+			Columns: []string{apiquotatracker.BudgetReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APIQuotaTracker.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APIQuotaTrackerUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (aqtc *APIQuotaTrackerCreate) OnConflict(opts ...sql.ConflictOption) *APIQuotaTrackerUpsertOne {
+	aqtc.conflict = opts
+	return &APIQuotaTrackerUpsertOne{
+		create: aqtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APIQuotaTracker.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aqtc *APIQuotaTrackerCreate) OnConflictColumns(columns ...string) *APIQuotaTrackerUpsertOne {
+	aqtc.conflict = append(aqtc.conflict, sql.ConflictColumns(columns...))
+	return &APIQuotaTrackerUpsertOne{
+		create: aqtc,
+	}
+}
+
+type (
+	// APIQuotaTrackerUpsertOne is the builder for "upsert"-ing
+	//  one APIQuotaTracker node.
+	APIQuotaTrackerUpsertOne struct {
+		create *APIQuotaTrackerCreate
+	}
+
+	// APIQuotaTrackerUpsert is the "OnConflict" setter.
+	APIQuotaTrackerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIQuotaTrackerUpsert) SetUpdatedAt(v time.Time) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateUpdatedAt() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldUpdatedAt)
+	return u
+}
+
+// SetKeyLabel sets the "key_label" field.
+func (u *APIQuotaTrackerUpsert) SetKeyLabel(v string) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldKeyLabel, v)
+	return u
+}
+
+// UpdateKeyLabel sets the "key_label" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateKeyLabel() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldKeyLabel)
+	return u
+}
+
+// SetBudget sets the "budget" field.
+func (u *APIQuotaTrackerUpsert) SetBudget(v string) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldBudget, v)
+	return u
+}
+
+// UpdateBudget sets the "budget" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateBudget() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldBudget)
+	return u
+}
+
+// SetMonth sets the "month" field.
+func (u *APIQuotaTrackerUpsert) SetMonth(v int) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldMonth, v)
+	return u
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateMonth() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldMonth)
+	return u
+}
+
+// AddMonth adds v to the "month" field.
+func (u *APIQuotaTrackerUpsert) AddMonth(v int) *APIQuotaTrackerUpsert {
+	u.Add(apiquotatracker.FieldMonth, v)
+	return u
+}
+
+// SetYear sets the "year" field.
+func (u *APIQuotaTrackerUpsert) SetYear(v int) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldYear, v)
+	return u
+}
+
+// UpdateYear sets the "year" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateYear() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldYear)
+	return u
+}
+
+// AddYear adds v to the "year" field.
+func (u *APIQuotaTrackerUpsert) AddYear(v int) *APIQuotaTrackerUpsert {
+	u.Add(apiquotatracker.FieldYear, v)
+	return u
+}
+
+// SetCallCount sets the "call_count" field.
+func (u *APIQuotaTrackerUpsert) SetCallCount(v int) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldCallCount, v)
+	return u
+}
+
+// UpdateCallCount sets the "call_count" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateCallCount() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldCallCount)
+	return u
+}
+
+// AddCallCount adds v to the "call_count" field.
+func (u *APIQuotaTrackerUpsert) AddCallCount(v int) *APIQuotaTrackerUpsert {
+	u.Add(apiquotatracker.FieldCallCount, v)
+	return u
+}
+
+// SetReservedCount sets the "reserved_count" field.
+func (u *APIQuotaTrackerUpsert) SetReservedCount(v int) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldReservedCount, v)
+	return u
+}
+
+// UpdateReservedCount sets the "reserved_count" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateReservedCount() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldReservedCount)
+	return u
+}
+
+// AddReservedCount adds v to the "reserved_count" field.
+func (u *APIQuotaTrackerUpsert) AddReservedCount(v int) *APIQuotaTrackerUpsert {
+	u.Add(apiquotatracker.FieldReservedCount, v)
+	return u
+}
+
+// SetQuotaLimit sets the "quota_limit" field.
+func (u *APIQuotaTrackerUpsert) SetQuotaLimit(v int) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldQuotaLimit, v)
+	return u
+}
+
+// UpdateQuotaLimit sets the "quota_limit" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateQuotaLimit() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldQuotaLimit)
+	return u
+}
+
+// AddQuotaLimit adds v to the "quota_limit" field.
+func (u *APIQuotaTrackerUpsert) AddQuotaLimit(v int) *APIQuotaTrackerUpsert {
+	u.Add(apiquotatracker.FieldQuotaLimit, v)
+	return u
+}
+
+// SetDailyCap sets the "daily_cap" field.
+func (u *APIQuotaTrackerUpsert) SetDailyCap(v int) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldDailyCap, v)
+	return u
+}
+
+// UpdateDailyCap sets the "daily_cap" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateDailyCap() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldDailyCap)
+	return u
+}
+
+// AddDailyCap adds v to the "daily_cap" field.
+func (u *APIQuotaTrackerUpsert) AddDailyCap(v int) *APIQuotaTrackerUpsert {
+	u.Add(apiquotatracker.FieldDailyCap, v)
+	return u
+}
+
+// ClearDailyCap clears the value of the "daily_cap" field.
+func (u *APIQuotaTrackerUpsert) ClearDailyCap() *APIQuotaTrackerUpsert {
+	u.SetNull(apiquotatracker.FieldDailyCap)
+	return u
+}
+
+// SetHourlyCap sets the "hourly_cap" field.
+func (u *APIQuotaTrackerUpsert) SetHourlyCap(v int) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldHourlyCap, v)
+	return u
+}
+
+// UpdateHourlyCap sets the "hourly_cap" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateHourlyCap() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldHourlyCap)
+	return u
+}
+
+// AddHourlyCap adds v to the "hourly_cap" field.
+func (u *APIQuotaTrackerUpsert) AddHourlyCap(v int) *APIQuotaTrackerUpsert {
+	u.Add(apiquotatracker.FieldHourlyCap, v)
+	return u
+}
+
+// ClearHourlyCap clears the value of the "hourly_cap" field.
+func (u *APIQuotaTrackerUpsert) ClearHourlyCap() *APIQuotaTrackerUpsert {
+	u.SetNull(apiquotatracker.FieldHourlyCap)
+	return u
+}
+
+// SetEvenPacing sets the "even_pacing" field.
+func (u *APIQuotaTrackerUpsert) SetEvenPacing(v bool) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldEvenPacing, v)
+	return u
+}
+
+// UpdateEvenPacing sets the "even_pacing" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateEvenPacing() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldEvenPacing)
+	return u
+}
+
+// SetQuotaExceeded sets the "quota_exceeded" field.
+func (u *APIQuotaTrackerUpsert) SetQuotaExceeded(v bool) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldQuotaExceeded, v)
+	return u
+}
+
+// UpdateQuotaExceeded sets the "quota_exceeded" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateQuotaExceeded() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldQuotaExceeded)
+	return u
+}
+
+// SetOverrideEnabled sets the "override_enabled" field.
+func (u *APIQuotaTrackerUpsert) SetOverrideEnabled(v bool) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldOverrideEnabled, v)
+	return u
+}
+
+// UpdateOverrideEnabled sets the "override_enabled" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateOverrideEnabled() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldOverrideEnabled)
+	return u
+}
+
+// SetNotificationSent sets the "notification_sent" field.
+func (u *APIQuotaTrackerUpsert) SetNotificationSent(v bool) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldNotificationSent, v)
+	return u
+}
+
+// UpdateNotificationSent sets the "notification_sent" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateNotificationSent() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldNotificationSent)
+	return u
+}
+
+// SetAlertedThresholds sets the "alerted_thresholds" field.
+func (u *APIQuotaTrackerUpsert) SetAlertedThresholds(v []int) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldAlertedThresholds, v)
+	return u
+}
+
+// UpdateAlertedThresholds sets the "alerted_thresholds" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateAlertedThresholds() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldAlertedThresholds)
+	return u
+}
+
+// ClearAlertedThresholds clears the value of the "alerted_thresholds" field.
+func (u *APIQuotaTrackerUpsert) ClearAlertedThresholds() *APIQuotaTrackerUpsert {
+	u.SetNull(apiquotatracker.FieldAlertedThresholds)
+	return u
+}
+
+// SetLastCallAt sets the "last_call_at" field.
+func (u *APIQuotaTrackerUpsert) SetLastCallAt(v time.Time) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldLastCallAt, v)
+	return u
+}
+
+// UpdateLastCallAt sets the "last_call_at" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateLastCallAt() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldLastCallAt)
+	return u
+}
+
+// ClearLastCallAt clears the value of the "last_call_at" field.
+func (u *APIQuotaTrackerUpsert) ClearLastCallAt() *APIQuotaTrackerUpsert {
+	u.SetNull(apiquotatracker.FieldLastCallAt)
+	return u
+}
+
+// SetRateLimitedUntil sets the "rate_limited_until" field.
+func (u *APIQuotaTrackerUpsert) SetRateLimitedUntil(v time.Time) *APIQuotaTrackerUpsert {
+	u.Set(apiquotatracker.FieldRateLimitedUntil, v)
+	return u
+}
+
+// UpdateRateLimitedUntil sets the "rate_limited_until" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsert) UpdateRateLimitedUntil() *APIQuotaTrackerUpsert {
+	u.SetExcluded(apiquotatracker.FieldRateLimitedUntil)
+	return u
+}
+
+// ClearRateLimitedUntil clears the value of the "rate_limited_until" field.
+func (u *APIQuotaTrackerUpsert) ClearRateLimitedUntil() *APIQuotaTrackerUpsert {
+	u.SetNull(apiquotatracker.FieldRateLimitedUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.APIQuotaTracker.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apiquotatracker.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APIQuotaTrackerUpsertOne) UpdateNewValues() *APIQuotaTrackerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(apiquotatracker.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(apiquotatracker.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APIQuotaTracker.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *APIQuotaTrackerUpsertOne) Ignore() *APIQuotaTrackerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APIQuotaTrackerUpsertOne) DoNothing() *APIQuotaTrackerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APIQuotaTrackerCreate.OnConflict
+// documentation for more info.
+func (u *APIQuotaTrackerUpsertOne) Update(set func(*APIQuotaTrackerUpsert)) *APIQuotaTrackerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APIQuotaTrackerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIQuotaTrackerUpsertOne) SetUpdatedAt(v time.Time) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateUpdatedAt() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKeyLabel sets the "key_label" field.
+func (u *APIQuotaTrackerUpsertOne) SetKeyLabel(v string) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetKeyLabel(v)
+	})
+}
+
+// UpdateKeyLabel sets the "key_label" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateKeyLabel() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateKeyLabel()
+	})
+}
+
+// SetBudget sets the "budget" field.
+func (u *APIQuotaTrackerUpsertOne) SetBudget(v string) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetBudget(v)
+	})
+}
+
+// UpdateBudget sets the "budget" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateBudget() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateBudget()
+	})
+}
+
+// SetMonth sets the "month" field.
+func (u *APIQuotaTrackerUpsertOne) SetMonth(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetMonth(v)
+	})
+}
+
+// AddMonth adds v to the "month" field.
+func (u *APIQuotaTrackerUpsertOne) AddMonth(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddMonth(v)
+	})
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateMonth() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateMonth()
+	})
+}
+
+// SetYear sets the "year" field.
+func (u *APIQuotaTrackerUpsertOne) SetYear(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetYear(v)
+	})
+}
+
+// AddYear adds v to the "year" field.
+func (u *APIQuotaTrackerUpsertOne) AddYear(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddYear(v)
+	})
+}
+
+// UpdateYear sets the "year" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateYear() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateYear()
+	})
+}
+
+// SetCallCount sets the "call_count" field.
+func (u *APIQuotaTrackerUpsertOne) SetCallCount(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetCallCount(v)
+	})
+}
+
+// AddCallCount adds v to the "call_count" field.
+func (u *APIQuotaTrackerUpsertOne) AddCallCount(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddCallCount(v)
+	})
+}
+
+// UpdateCallCount sets the "call_count" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateCallCount() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateCallCount()
+	})
+}
+
+// SetReservedCount sets the "reserved_count" field.
+func (u *APIQuotaTrackerUpsertOne) SetReservedCount(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetReservedCount(v)
+	})
+}
+
+// AddReservedCount adds v to the "reserved_count" field.
+func (u *APIQuotaTrackerUpsertOne) AddReservedCount(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddReservedCount(v)
+	})
+}
+
+// UpdateReservedCount sets the "reserved_count" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateReservedCount() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateReservedCount()
+	})
+}
+
+// SetQuotaLimit sets the "quota_limit" field.
+func (u *APIQuotaTrackerUpsertOne) SetQuotaLimit(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetQuotaLimit(v)
+	})
+}
+
+// AddQuotaLimit adds v to the "quota_limit" field.
+func (u *APIQuotaTrackerUpsertOne) AddQuotaLimit(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddQuotaLimit(v)
+	})
+}
+
+// UpdateQuotaLimit sets the "quota_limit" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateQuotaLimit() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateQuotaLimit()
+	})
+}
+
+// SetDailyCap sets the "daily_cap" field.
+func (u *APIQuotaTrackerUpsertOne) SetDailyCap(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetDailyCap(v)
+	})
+}
+
+// AddDailyCap adds v to the "daily_cap" field.
+func (u *APIQuotaTrackerUpsertOne) AddDailyCap(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddDailyCap(v)
+	})
+}
+
+// UpdateDailyCap sets the "daily_cap" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateDailyCap() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateDailyCap()
+	})
+}
+
+// ClearDailyCap clears the value of the "daily_cap" field.
+func (u *APIQuotaTrackerUpsertOne) ClearDailyCap() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.ClearDailyCap()
+	})
+}
+
+// SetHourlyCap sets the "hourly_cap" field.
+func (u *APIQuotaTrackerUpsertOne) SetHourlyCap(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetHourlyCap(v)
+	})
+}
+
+// AddHourlyCap adds v to the "hourly_cap" field.
+func (u *APIQuotaTrackerUpsertOne) AddHourlyCap(v int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddHourlyCap(v)
+	})
+}
+
+// UpdateHourlyCap sets the "hourly_cap" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateHourlyCap() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateHourlyCap()
+	})
+}
+
+// ClearHourlyCap clears the value of the "hourly_cap" field.
+func (u *APIQuotaTrackerUpsertOne) ClearHourlyCap() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.ClearHourlyCap()
+	})
+}
+
+// SetEvenPacing sets the "even_pacing" field.
+func (u *APIQuotaTrackerUpsertOne) SetEvenPacing(v bool) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetEvenPacing(v)
+	})
+}
+
+// UpdateEvenPacing sets the "even_pacing" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateEvenPacing() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateEvenPacing()
+	})
+}
+
+// SetQuotaExceeded sets the "quota_exceeded" field.
+func (u *APIQuotaTrackerUpsertOne) SetQuotaExceeded(v bool) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetQuotaExceeded(v)
+	})
+}
+
+// UpdateQuotaExceeded sets the "quota_exceeded" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateQuotaExceeded() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateQuotaExceeded()
+	})
+}
+
+// SetOverrideEnabled sets the "override_enabled" field.
+func (u *APIQuotaTrackerUpsertOne) SetOverrideEnabled(v bool) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetOverrideEnabled(v)
+	})
+}
+
+// UpdateOverrideEnabled sets the "override_enabled" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateOverrideEnabled() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateOverrideEnabled()
+	})
+}
+
+// SetNotificationSent sets the "notification_sent" field.
+func (u *APIQuotaTrackerUpsertOne) SetNotificationSent(v bool) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetNotificationSent(v)
+	})
+}
+
+// UpdateNotificationSent sets the "notification_sent" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateNotificationSent() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateNotificationSent()
+	})
+}
+
+// SetAlertedThresholds sets the "alerted_thresholds" field.
+func (u *APIQuotaTrackerUpsertOne) SetAlertedThresholds(v []int) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetAlertedThresholds(v)
+	})
+}
+
+// UpdateAlertedThresholds sets the "alerted_thresholds" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateAlertedThresholds() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateAlertedThresholds()
+	})
+}
+
+// ClearAlertedThresholds clears the value of the "alerted_thresholds" field.
+func (u *APIQuotaTrackerUpsertOne) ClearAlertedThresholds() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.ClearAlertedThresholds()
+	})
+}
+
+// SetLastCallAt sets the "last_call_at" field.
+func (u *APIQuotaTrackerUpsertOne) SetLastCallAt(v time.Time) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetLastCallAt(v)
+	})
+}
+
+// UpdateLastCallAt sets the "last_call_at" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateLastCallAt() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateLastCallAt()
+	})
+}
+
+// ClearLastCallAt clears the value of the "last_call_at" field.
+func (u *APIQuotaTrackerUpsertOne) ClearLastCallAt() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.ClearLastCallAt()
+	})
+}
+
+// SetRateLimitedUntil sets the "rate_limited_until" field.
+func (u *APIQuotaTrackerUpsertOne) SetRateLimitedUntil(v time.Time) *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetRateLimitedUntil(v)
+	})
+}
+
+// UpdateRateLimitedUntil sets the "rate_limited_until" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertOne) UpdateRateLimitedUntil() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateRateLimitedUntil()
+	})
+}
+
+// ClearRateLimitedUntil clears the value of the "rate_limited_until" field.
+func (u *APIQuotaTrackerUpsertOne) ClearRateLimitedUntil() *APIQuotaTrackerUpsertOne {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.ClearRateLimitedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *APIQuotaTrackerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APIQuotaTrackerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APIQuotaTrackerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *APIQuotaTrackerUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: APIQuotaTrackerUpsertOne.ID is not supported by MySQL driver. Use APIQuotaTrackerUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *APIQuotaTrackerUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // APIQuotaTrackerCreateBulk is the builder for creating many APIQuotaTracker entities in bulk.
 type APIQuotaTrackerCreateBulk struct {
 	config
 	err      error
 	builders []*APIQuotaTrackerCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the APIQuotaTracker entities in the database.
@@ -389,6 +1372,7 @@ func (aqtcb *APIQuotaTrackerCreateBulk) Save(ctx context.Context) ([]*APIQuotaTr
 					_, err = mutators[i+1].Mutate(root, aqtcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = aqtcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, aqtcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -438,3 +1422,442 @@ func (aqtcb *APIQuotaTrackerCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APIQuotaTracker.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APIQuotaTrackerUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (aqtcb *APIQuotaTrackerCreateBulk) OnConflict(opts ...sql.ConflictOption) *APIQuotaTrackerUpsertBulk {
+	aqtcb.conflict = opts
+	return &APIQuotaTrackerUpsertBulk{
+		create: aqtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APIQuotaTracker.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aqtcb *APIQuotaTrackerCreateBulk) OnConflictColumns(columns ...string) *APIQuotaTrackerUpsertBulk {
+	aqtcb.conflict = append(aqtcb.conflict, sql.ConflictColumns(columns...))
+	return &APIQuotaTrackerUpsertBulk{
+		create: aqtcb,
+	}
+}
+
+// APIQuotaTrackerUpsertBulk is the builder for "upsert"-ing
+// a bulk of APIQuotaTracker nodes.
+type APIQuotaTrackerUpsertBulk struct {
+	create *APIQuotaTrackerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.APIQuotaTracker.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apiquotatracker.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APIQuotaTrackerUpsertBulk) UpdateNewValues() *APIQuotaTrackerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(apiquotatracker.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(apiquotatracker.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APIQuotaTracker.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *APIQuotaTrackerUpsertBulk) Ignore() *APIQuotaTrackerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APIQuotaTrackerUpsertBulk) DoNothing() *APIQuotaTrackerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APIQuotaTrackerCreateBulk.OnConflict
+// documentation for more info.
+func (u *APIQuotaTrackerUpsertBulk) Update(set func(*APIQuotaTrackerUpsert)) *APIQuotaTrackerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APIQuotaTrackerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIQuotaTrackerUpsertBulk) SetUpdatedAt(v time.Time) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateUpdatedAt() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKeyLabel sets the "key_label" field.
+func (u *APIQuotaTrackerUpsertBulk) SetKeyLabel(v string) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetKeyLabel(v)
+	})
+}
+
+// UpdateKeyLabel sets the "key_label" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateKeyLabel() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateKeyLabel()
+	})
+}
+
+// SetBudget sets the "budget" field.
+func (u *APIQuotaTrackerUpsertBulk) SetBudget(v string) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetBudget(v)
+	})
+}
+
+// UpdateBudget sets the "budget" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateBudget() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateBudget()
+	})
+}
+
+// SetMonth sets the "month" field.
+func (u *APIQuotaTrackerUpsertBulk) SetMonth(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetMonth(v)
+	})
+}
+
+// AddMonth adds v to the "month" field.
+func (u *APIQuotaTrackerUpsertBulk) AddMonth(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddMonth(v)
+	})
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateMonth() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateMonth()
+	})
+}
+
+// SetYear sets the "year" field.
+func (u *APIQuotaTrackerUpsertBulk) SetYear(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetYear(v)
+	})
+}
+
+// AddYear adds v to the "year" field.
+func (u *APIQuotaTrackerUpsertBulk) AddYear(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddYear(v)
+	})
+}
+
+// UpdateYear sets the "year" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateYear() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateYear()
+	})
+}
+
+// SetCallCount sets the "call_count" field.
+func (u *APIQuotaTrackerUpsertBulk) SetCallCount(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetCallCount(v)
+	})
+}
+
+// AddCallCount adds v to the "call_count" field.
+func (u *APIQuotaTrackerUpsertBulk) AddCallCount(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddCallCount(v)
+	})
+}
+
+// UpdateCallCount sets the "call_count" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateCallCount() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateCallCount()
+	})
+}
+
+// SetReservedCount sets the "reserved_count" field.
+func (u *APIQuotaTrackerUpsertBulk) SetReservedCount(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetReservedCount(v)
+	})
+}
+
+// AddReservedCount adds v to the "reserved_count" field.
+func (u *APIQuotaTrackerUpsertBulk) AddReservedCount(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddReservedCount(v)
+	})
+}
+
+// UpdateReservedCount sets the "reserved_count" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateReservedCount() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateReservedCount()
+	})
+}
+
+// SetQuotaLimit sets the "quota_limit" field.
+func (u *APIQuotaTrackerUpsertBulk) SetQuotaLimit(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetQuotaLimit(v)
+	})
+}
+
+// AddQuotaLimit adds v to the "quota_limit" field.
+func (u *APIQuotaTrackerUpsertBulk) AddQuotaLimit(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddQuotaLimit(v)
+	})
+}
+
+// UpdateQuotaLimit sets the "quota_limit" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateQuotaLimit() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateQuotaLimit()
+	})
+}
+
+// SetDailyCap sets the "daily_cap" field.
+func (u *APIQuotaTrackerUpsertBulk) SetDailyCap(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetDailyCap(v)
+	})
+}
+
+// AddDailyCap adds v to the "daily_cap" field.
+func (u *APIQuotaTrackerUpsertBulk) AddDailyCap(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddDailyCap(v)
+	})
+}
+
+// UpdateDailyCap sets the "daily_cap" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateDailyCap() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateDailyCap()
+	})
+}
+
+// ClearDailyCap clears the value of the "daily_cap" field.
+func (u *APIQuotaTrackerUpsertBulk) ClearDailyCap() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.ClearDailyCap()
+	})
+}
+
+// SetHourlyCap sets the "hourly_cap" field.
+func (u *APIQuotaTrackerUpsertBulk) SetHourlyCap(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetHourlyCap(v)
+	})
+}
+
+// AddHourlyCap adds v to the "hourly_cap" field.
+func (u *APIQuotaTrackerUpsertBulk) AddHourlyCap(v int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.AddHourlyCap(v)
+	})
+}
+
+// UpdateHourlyCap sets the "hourly_cap" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateHourlyCap() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateHourlyCap()
+	})
+}
+
+// ClearHourlyCap clears the value of the "hourly_cap" field.
+func (u *APIQuotaTrackerUpsertBulk) ClearHourlyCap() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.ClearHourlyCap()
+	})
+}
+
+// SetEvenPacing sets the "even_pacing" field.
+func (u *APIQuotaTrackerUpsertBulk) SetEvenPacing(v bool) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetEvenPacing(v)
+	})
+}
+
+// UpdateEvenPacing sets the "even_pacing" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateEvenPacing() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateEvenPacing()
+	})
+}
+
+// SetQuotaExceeded sets the "quota_exceeded" field.
+func (u *APIQuotaTrackerUpsertBulk) SetQuotaExceeded(v bool) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetQuotaExceeded(v)
+	})
+}
+
+// UpdateQuotaExceeded sets the "quota_exceeded" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateQuotaExceeded() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateQuotaExceeded()
+	})
+}
+
+// SetOverrideEnabled sets the "override_enabled" field.
+func (u *APIQuotaTrackerUpsertBulk) SetOverrideEnabled(v bool) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetOverrideEnabled(v)
+	})
+}
+
+// UpdateOverrideEnabled sets the "override_enabled" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateOverrideEnabled() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateOverrideEnabled()
+	})
+}
+
+// SetNotificationSent sets the "notification_sent" field.
+func (u *APIQuotaTrackerUpsertBulk) SetNotificationSent(v bool) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetNotificationSent(v)
+	})
+}
+
+// UpdateNotificationSent sets the "notification_sent" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateNotificationSent() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateNotificationSent()
+	})
+}
+
+// SetAlertedThresholds sets the "alerted_thresholds" field.
+func (u *APIQuotaTrackerUpsertBulk) SetAlertedThresholds(v []int) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetAlertedThresholds(v)
+	})
+}
+
+// UpdateAlertedThresholds sets the "alerted_thresholds" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateAlertedThresholds() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateAlertedThresholds()
+	})
+}
+
+// ClearAlertedThresholds clears the value of the "alerted_thresholds" field.
+func (u *APIQuotaTrackerUpsertBulk) ClearAlertedThresholds() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.ClearAlertedThresholds()
+	})
+}
+
+// SetLastCallAt sets the "last_call_at" field.
+func (u *APIQuotaTrackerUpsertBulk) SetLastCallAt(v time.Time) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetLastCallAt(v)
+	})
+}
+
+// UpdateLastCallAt sets the "last_call_at" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateLastCallAt() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateLastCallAt()
+	})
+}
+
+// ClearLastCallAt clears the value of the "last_call_at" field.
+func (u *APIQuotaTrackerUpsertBulk) ClearLastCallAt() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.ClearLastCallAt()
+	})
+}
+
+// SetRateLimitedUntil sets the "rate_limited_until" field.
+func (u *APIQuotaTrackerUpsertBulk) SetRateLimitedUntil(v time.Time) *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.SetRateLimitedUntil(v)
+	})
+}
+
+// UpdateRateLimitedUntil sets the "rate_limited_until" field to the value that was provided on create.
+func (u *APIQuotaTrackerUpsertBulk) UpdateRateLimitedUntil() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.UpdateRateLimitedUntil()
+	})
+}
+
+// ClearRateLimitedUntil clears the value of the "rate_limited_until" field.
+func (u *APIQuotaTrackerUpsertBulk) ClearRateLimitedUntil() *APIQuotaTrackerUpsertBulk {
+	return u.Update(func(s *APIQuotaTrackerUpsert) {
+		s.ClearRateLimitedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *APIQuotaTrackerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the APIQuotaTrackerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APIQuotaTrackerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APIQuotaTrackerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
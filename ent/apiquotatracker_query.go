@@ -4,13 +4,16 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
 	"sheng-go-backend/ent/predicate"
 	"sheng-go-backend/ent/schema/ulid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -19,12 +22,16 @@ import (
 // APIQuotaTrackerQuery is the builder for querying APIQuotaTracker entities.
 type APIQuotaTrackerQuery struct {
 	config
-	ctx        *QueryContext
-	order      []apiquotatracker.OrderOption
-	inters     []Interceptor
-	predicates []predicate.APIQuotaTracker
-	modifiers  []func(*sql.Selector)
-	loadTotal  []func(context.Context, []*APIQuotaTracker) error
+	ctx                         *QueryContext
+	order                       []apiquotatracker.OrderOption
+	inters                      []Interceptor
+	predicates                  []predicate.APIQuotaTracker
+	withReservations            *APIQuotaReservationQuery
+	withBudgetReservations      *APIQuotaReservationQuery
+	loadTotal                   []func(context.Context, []*APIQuotaTracker) error
+	modifiers                   []func(*sql.Selector)
+	withNamedReservations       map[string]*APIQuotaReservationQuery
+	withNamedBudgetReservations map[string]*APIQuotaReservationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -61,6 +68,50 @@ func (aqtq *APIQuotaTrackerQuery) Order(o ...apiquotatracker.OrderOption) *APIQu
 	return aqtq
 }
 
+// QueryReservations chains the current query on the "reservations" edge.
+func (aqtq *APIQuotaTrackerQuery) QueryReservations() *APIQuotaReservationQuery {
+	query := (&APIQuotaReservationClient{config: aqtq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aqtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aqtq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apiquotatracker.Table, apiquotatracker.FieldID, selector),
+			sqlgraph.To(apiquotareservation.Table, apiquotareservation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, apiquotatracker.ReservationsTable, apiquotatracker.ReservationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aqtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBudgetReservations chains the current query on the "budget_reservations" edge.
+func (aqtq *APIQuotaTrackerQuery) QueryBudgetReservations() *APIQuotaReservationQuery {
+	query := (&APIQuotaReservationClient{config: aqtq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aqtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aqtq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apiquotatracker.Table, apiquotatracker.FieldID, selector),
+			sqlgraph.To(apiquotareservation.Table, apiquotareservation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, apiquotatracker.BudgetReservationsTable, apiquotatracker.BudgetReservationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aqtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first APIQuotaTracker entity from the query.
 // Returns a *NotFoundError when no APIQuotaTracker was found.
 func (aqtq *APIQuotaTrackerQuery) First(ctx context.Context) (*APIQuotaTracker, error) {
@@ -248,17 +299,41 @@ func (aqtq *APIQuotaTrackerQuery) Clone() *APIQuotaTrackerQuery {
 		return nil
 	}
 	return &APIQuotaTrackerQuery{
-		config:     aqtq.config,
-		ctx:        aqtq.ctx.Clone(),
-		order:      append([]apiquotatracker.OrderOption{}, aqtq.order...),
-		inters:     append([]Interceptor{}, aqtq.inters...),
-		predicates: append([]predicate.APIQuotaTracker{}, aqtq.predicates...),
+		config:                 aqtq.config,
+		ctx:                    aqtq.ctx.Clone(),
+		order:                  append([]apiquotatracker.OrderOption{}, aqtq.order...),
+		inters:                 append([]Interceptor{}, aqtq.inters...),
+		predicates:             append([]predicate.APIQuotaTracker{}, aqtq.predicates...),
+		withReservations:       aqtq.withReservations.Clone(),
+		withBudgetReservations: aqtq.withBudgetReservations.Clone(),
 		// clone intermediate query.
 		sql:  aqtq.sql.Clone(),
 		path: aqtq.path,
 	}
 }
 
+// WithReservations tells the query-builder to eager-load the nodes that are connected to
+// the "reservations" edge. The optional arguments are used to configure the query builder of the edge.
+func (aqtq *APIQuotaTrackerQuery) WithReservations(opts ...func(*APIQuotaReservationQuery)) *APIQuotaTrackerQuery {
+	query := (&APIQuotaReservationClient{config: aqtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aqtq.withReservations = query
+	return aqtq
+}
+
+// WithBudgetReservations tells the query-builder to eager-load the nodes that are connected to
+// the "budget_reservations" edge. The optional arguments are used to configure the query builder of the edge.
+func (aqtq *APIQuotaTrackerQuery) WithBudgetReservations(opts ...func(*APIQuotaReservationQuery)) *APIQuotaTrackerQuery {
+	query := (&APIQuotaReservationClient{config: aqtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aqtq.withBudgetReservations = query
+	return aqtq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -335,8 +410,12 @@ func (aqtq *APIQuotaTrackerQuery) prepareQuery(ctx context.Context) error {
 
 func (aqtq *APIQuotaTrackerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*APIQuotaTracker, error) {
 	var (
-		nodes = []*APIQuotaTracker{}
-		_spec = aqtq.querySpec()
+		nodes       = []*APIQuotaTracker{}
+		_spec       = aqtq.querySpec()
+		loadedTypes = [2]bool{
+			aqtq.withReservations != nil,
+			aqtq.withBudgetReservations != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*APIQuotaTracker).scanValues(nil, columns)
@@ -344,6 +423,7 @@ func (aqtq *APIQuotaTrackerQuery) sqlAll(ctx context.Context, hooks ...queryHook
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &APIQuotaTracker{config: aqtq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if len(aqtq.modifiers) > 0 {
@@ -358,6 +438,38 @@ func (aqtq *APIQuotaTrackerQuery) sqlAll(ctx context.Context, hooks ...queryHook
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := aqtq.withReservations; query != nil {
+		if err := aqtq.loadReservations(ctx, query, nodes,
+			func(n *APIQuotaTracker) { n.Edges.Reservations = []*APIQuotaReservation{} },
+			func(n *APIQuotaTracker, e *APIQuotaReservation) {
+				n.Edges.Reservations = append(n.Edges.Reservations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := aqtq.withBudgetReservations; query != nil {
+		if err := aqtq.loadBudgetReservations(ctx, query, nodes,
+			func(n *APIQuotaTracker) { n.Edges.BudgetReservations = []*APIQuotaReservation{} },
+			func(n *APIQuotaTracker, e *APIQuotaReservation) {
+				n.Edges.BudgetReservations = append(n.Edges.BudgetReservations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range aqtq.withNamedReservations {
+		if err := aqtq.loadReservations(ctx, query, nodes,
+			func(n *APIQuotaTracker) { n.appendNamedReservations(name) },
+			func(n *APIQuotaTracker, e *APIQuotaReservation) { n.appendNamedReservations(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range aqtq.withNamedBudgetReservations {
+		if err := aqtq.loadBudgetReservations(ctx, query, nodes,
+			func(n *APIQuotaTracker) { n.appendNamedBudgetReservations(name) },
+			func(n *APIQuotaTracker, e *APIQuotaReservation) { n.appendNamedBudgetReservations(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range aqtq.loadTotal {
 		if err := aqtq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -366,6 +478,69 @@ func (aqtq *APIQuotaTrackerQuery) sqlAll(ctx context.Context, hooks ...queryHook
 	return nodes, nil
 }
 
+func (aqtq *APIQuotaTrackerQuery) loadReservations(ctx context.Context, query *APIQuotaReservationQuery, nodes []*APIQuotaTracker, init func(*APIQuotaTracker), assign func(*APIQuotaTracker, *APIQuotaReservation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[ulid.ID]*APIQuotaTracker)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.APIQuotaReservation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(apiquotatracker.ReservationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.api_quota_tracker_reservations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "api_quota_tracker_reservations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "api_quota_tracker_reservations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aqtq *APIQuotaTrackerQuery) loadBudgetReservations(ctx context.Context, query *APIQuotaReservationQuery, nodes []*APIQuotaTracker, init func(*APIQuotaTracker), assign func(*APIQuotaTracker, *APIQuotaReservation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[ulid.ID]*APIQuotaTracker)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.APIQuotaReservation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(apiquotatracker.BudgetReservationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.api_quota_tracker_budget_reservations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "api_quota_tracker_budget_reservations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "api_quota_tracker_budget_reservations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (aqtq *APIQuotaTrackerQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aqtq.querySpec()
 	if len(aqtq.modifiers) > 0 {
@@ -433,6 +608,9 @@ func (aqtq *APIQuotaTrackerQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if aqtq.ctx.Unique != nil && *aqtq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range aqtq.modifiers {
+		m(selector)
+	}
 	for _, p := range aqtq.predicates {
 		p(selector)
 	}
@@ -450,6 +628,60 @@ func (aqtq *APIQuotaTrackerQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (aqtq *APIQuotaTrackerQuery) ForUpdate(opts ...sql.LockOption) *APIQuotaTrackerQuery {
+	if aqtq.driver.Dialect() == dialect.Postgres {
+		aqtq.Unique(false)
+	}
+	aqtq.modifiers = append(aqtq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return aqtq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (aqtq *APIQuotaTrackerQuery) ForShare(opts ...sql.LockOption) *APIQuotaTrackerQuery {
+	if aqtq.driver.Dialect() == dialect.Postgres {
+		aqtq.Unique(false)
+	}
+	aqtq.modifiers = append(aqtq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return aqtq
+}
+
+// WithNamedReservations tells the query-builder to eager-load the nodes that are connected to the "reservations"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (aqtq *APIQuotaTrackerQuery) WithNamedReservations(name string, opts ...func(*APIQuotaReservationQuery)) *APIQuotaTrackerQuery {
+	query := (&APIQuotaReservationClient{config: aqtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if aqtq.withNamedReservations == nil {
+		aqtq.withNamedReservations = make(map[string]*APIQuotaReservationQuery)
+	}
+	aqtq.withNamedReservations[name] = query
+	return aqtq
+}
+
+// WithNamedBudgetReservations tells the query-builder to eager-load the nodes that are connected to the "budget_reservations"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (aqtq *APIQuotaTrackerQuery) WithNamedBudgetReservations(name string, opts ...func(*APIQuotaReservationQuery)) *APIQuotaTrackerQuery {
+	query := (&APIQuotaReservationClient{config: aqtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if aqtq.withNamedBudgetReservations == nil {
+		aqtq.withNamedBudgetReservations = make(map[string]*APIQuotaReservationQuery)
+	}
+	aqtq.withNamedBudgetReservations[name] = query
+	return aqtq
+}
+
 // APIQuotaTrackerGroupBy is the group-by builder for APIQuotaTracker entities.
 type APIQuotaTrackerGroupBy struct {
 	selector
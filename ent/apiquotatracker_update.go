@@ -6,12 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
 	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -34,6 +37,34 @@ func (aqtu *APIQuotaTrackerUpdate) SetUpdatedAt(t time.Time) *APIQuotaTrackerUpd
 	return aqtu
 }
 
+// SetKeyLabel sets the "key_label" field.
+func (aqtu *APIQuotaTrackerUpdate) SetKeyLabel(s string) *APIQuotaTrackerUpdate {
+	aqtu.mutation.SetKeyLabel(s)
+	return aqtu
+}
+
+// SetNillableKeyLabel sets the "key_label" field if the given value is not nil.
+func (aqtu *APIQuotaTrackerUpdate) SetNillableKeyLabel(s *string) *APIQuotaTrackerUpdate {
+	if s != nil {
+		aqtu.SetKeyLabel(*s)
+	}
+	return aqtu
+}
+
+// SetBudget sets the "budget" field.
+func (aqtu *APIQuotaTrackerUpdate) SetBudget(s string) *APIQuotaTrackerUpdate {
+	aqtu.mutation.SetBudget(s)
+	return aqtu
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (aqtu *APIQuotaTrackerUpdate) SetNillableBudget(s *string) *APIQuotaTrackerUpdate {
+	if s != nil {
+		aqtu.SetBudget(*s)
+	}
+	return aqtu
+}
+
 // SetMonth sets the "month" field.
 func (aqtu *APIQuotaTrackerUpdate) SetMonth(i int) *APIQuotaTrackerUpdate {
 	aqtu.mutation.ResetMonth()
@@ -97,6 +128,27 @@ func (aqtu *APIQuotaTrackerUpdate) AddCallCount(i int) *APIQuotaTrackerUpdate {
 	return aqtu
 }
 
+// SetReservedCount sets the "reserved_count" field.
+func (aqtu *APIQuotaTrackerUpdate) SetReservedCount(i int) *APIQuotaTrackerUpdate {
+	aqtu.mutation.ResetReservedCount()
+	aqtu.mutation.SetReservedCount(i)
+	return aqtu
+}
+
+// SetNillableReservedCount sets the "reserved_count" field if the given value is not nil.
+func (aqtu *APIQuotaTrackerUpdate) SetNillableReservedCount(i *int) *APIQuotaTrackerUpdate {
+	if i != nil {
+		aqtu.SetReservedCount(*i)
+	}
+	return aqtu
+}
+
+// AddReservedCount adds i to the "reserved_count" field.
+func (aqtu *APIQuotaTrackerUpdate) AddReservedCount(i int) *APIQuotaTrackerUpdate {
+	aqtu.mutation.AddReservedCount(i)
+	return aqtu
+}
+
 // SetQuotaLimit sets the "quota_limit" field.
 func (aqtu *APIQuotaTrackerUpdate) SetQuotaLimit(i int) *APIQuotaTrackerUpdate {
 	aqtu.mutation.ResetQuotaLimit()
@@ -118,6 +170,74 @@ func (aqtu *APIQuotaTrackerUpdate) AddQuotaLimit(i int) *APIQuotaTrackerUpdate {
 	return aqtu
 }
 
+// SetDailyCap sets the "daily_cap" field.
+func (aqtu *APIQuotaTrackerUpdate) SetDailyCap(i int) *APIQuotaTrackerUpdate {
+	aqtu.mutation.ResetDailyCap()
+	aqtu.mutation.SetDailyCap(i)
+	return aqtu
+}
+
+// SetNillableDailyCap sets the "daily_cap" field if the given value is not nil.
+func (aqtu *APIQuotaTrackerUpdate) SetNillableDailyCap(i *int) *APIQuotaTrackerUpdate {
+	if i != nil {
+		aqtu.SetDailyCap(*i)
+	}
+	return aqtu
+}
+
+// AddDailyCap adds i to the "daily_cap" field.
+func (aqtu *APIQuotaTrackerUpdate) AddDailyCap(i int) *APIQuotaTrackerUpdate {
+	aqtu.mutation.AddDailyCap(i)
+	return aqtu
+}
+
+// ClearDailyCap clears the value of the "daily_cap" field.
+func (aqtu *APIQuotaTrackerUpdate) ClearDailyCap() *APIQuotaTrackerUpdate {
+	aqtu.mutation.ClearDailyCap()
+	return aqtu
+}
+
+// SetHourlyCap sets the "hourly_cap" field.
+func (aqtu *APIQuotaTrackerUpdate) SetHourlyCap(i int) *APIQuotaTrackerUpdate {
+	aqtu.mutation.ResetHourlyCap()
+	aqtu.mutation.SetHourlyCap(i)
+	return aqtu
+}
+
+// SetNillableHourlyCap sets the "hourly_cap" field if the given value is not nil.
+func (aqtu *APIQuotaTrackerUpdate) SetNillableHourlyCap(i *int) *APIQuotaTrackerUpdate {
+	if i != nil {
+		aqtu.SetHourlyCap(*i)
+	}
+	return aqtu
+}
+
+// AddHourlyCap adds i to the "hourly_cap" field.
+func (aqtu *APIQuotaTrackerUpdate) AddHourlyCap(i int) *APIQuotaTrackerUpdate {
+	aqtu.mutation.AddHourlyCap(i)
+	return aqtu
+}
+
+// ClearHourlyCap clears the value of the "hourly_cap" field.
+func (aqtu *APIQuotaTrackerUpdate) ClearHourlyCap() *APIQuotaTrackerUpdate {
+	aqtu.mutation.ClearHourlyCap()
+	return aqtu
+}
+
+// SetEvenPacing sets the "even_pacing" field.
+func (aqtu *APIQuotaTrackerUpdate) SetEvenPacing(b bool) *APIQuotaTrackerUpdate {
+	aqtu.mutation.SetEvenPacing(b)
+	return aqtu
+}
+
+// SetNillableEvenPacing sets the "even_pacing" field if the given value is not nil.
+func (aqtu *APIQuotaTrackerUpdate) SetNillableEvenPacing(b *bool) *APIQuotaTrackerUpdate {
+	if b != nil {
+		aqtu.SetEvenPacing(*b)
+	}
+	return aqtu
+}
+
 // SetQuotaExceeded sets the "quota_exceeded" field.
 func (aqtu *APIQuotaTrackerUpdate) SetQuotaExceeded(b bool) *APIQuotaTrackerUpdate {
 	aqtu.mutation.SetQuotaExceeded(b)
@@ -160,6 +280,24 @@ func (aqtu *APIQuotaTrackerUpdate) SetNillableNotificationSent(b *bool) *APIQuot
 	return aqtu
 }
 
+// SetAlertedThresholds sets the "alerted_thresholds" field.
+func (aqtu *APIQuotaTrackerUpdate) SetAlertedThresholds(i []int) *APIQuotaTrackerUpdate {
+	aqtu.mutation.SetAlertedThresholds(i)
+	return aqtu
+}
+
+// AppendAlertedThresholds appends i to the "alerted_thresholds" field.
+func (aqtu *APIQuotaTrackerUpdate) AppendAlertedThresholds(i []int) *APIQuotaTrackerUpdate {
+	aqtu.mutation.AppendAlertedThresholds(i)
+	return aqtu
+}
+
+// ClearAlertedThresholds clears the value of the "alerted_thresholds" field.
+func (aqtu *APIQuotaTrackerUpdate) ClearAlertedThresholds() *APIQuotaTrackerUpdate {
+	aqtu.mutation.ClearAlertedThresholds()
+	return aqtu
+}
+
 // SetLastCallAt sets the "last_call_at" field.
 func (aqtu *APIQuotaTrackerUpdate) SetLastCallAt(t time.Time) *APIQuotaTrackerUpdate {
 	aqtu.mutation.SetLastCallAt(t)
@@ -180,11 +318,103 @@ func (aqtu *APIQuotaTrackerUpdate) ClearLastCallAt() *APIQuotaTrackerUpdate {
 	return aqtu
 }
 
+// SetRateLimitedUntil sets the "rate_limited_until" field.
+func (aqtu *APIQuotaTrackerUpdate) SetRateLimitedUntil(t time.Time) *APIQuotaTrackerUpdate {
+	aqtu.mutation.SetRateLimitedUntil(t)
+	return aqtu
+}
+
+// SetNillableRateLimitedUntil sets the "rate_limited_until" field if the given value is not nil.
+func (aqtu *APIQuotaTrackerUpdate) SetNillableRateLimitedUntil(t *time.Time) *APIQuotaTrackerUpdate {
+	if t != nil {
+		aqtu.SetRateLimitedUntil(*t)
+	}
+	return aqtu
+}
+
+// ClearRateLimitedUntil clears the value of the "rate_limited_until" field.
+func (aqtu *APIQuotaTrackerUpdate) ClearRateLimitedUntil() *APIQuotaTrackerUpdate {
+	aqtu.mutation.ClearRateLimitedUntil()
+	return aqtu
+}
+
+// AddReservationIDs adds the "reservations" edge to the APIQuotaReservation entity by IDs.
+func (aqtu *APIQuotaTrackerUpdate) AddReservationIDs(ids ...ulid.ID) *APIQuotaTrackerUpdate {
+	aqtu.mutation.AddReservationIDs(ids...)
+	return aqtu
+}
+
+// AddReservations adds the "reservations" edges to the APIQuotaReservation entity.
+func (aqtu *APIQuotaTrackerUpdate) AddReservations(a ...*APIQuotaReservation) *APIQuotaTrackerUpdate {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aqtu.AddReservationIDs(ids...)
+}
+
+// AddBudgetReservationIDs adds the "budget_reservations" edge to the APIQuotaReservation entity by IDs.
+func (aqtu *APIQuotaTrackerUpdate) AddBudgetReservationIDs(ids ...ulid.ID) *APIQuotaTrackerUpdate {
+	aqtu.mutation.AddBudgetReservationIDs(ids...)
+	return aqtu
+}
+
+// AddBudgetReservations adds the "budget_reservations" edges to the APIQuotaReservation entity.
+func (aqtu *APIQuotaTrackerUpdate) AddBudgetReservations(a ...*APIQuotaReservation) *APIQuotaTrackerUpdate {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aqtu.AddBudgetReservationIDs(ids...)
+}
+
 // Mutation returns the APIQuotaTrackerMutation object of the builder.
 func (aqtu *APIQuotaTrackerUpdate) Mutation() *APIQuotaTrackerMutation {
 	return aqtu.mutation
 }
 
+// ClearReservations clears all "reservations" edges to the APIQuotaReservation entity.
+func (aqtu *APIQuotaTrackerUpdate) ClearReservations() *APIQuotaTrackerUpdate {
+	aqtu.mutation.ClearReservations()
+	return aqtu
+}
+
+// RemoveReservationIDs removes the "reservations" edge to APIQuotaReservation entities by IDs.
+func (aqtu *APIQuotaTrackerUpdate) RemoveReservationIDs(ids ...ulid.ID) *APIQuotaTrackerUpdate {
+	aqtu.mutation.RemoveReservationIDs(ids...)
+	return aqtu
+}
+
+// RemoveReservations removes "reservations" edges to APIQuotaReservation entities.
+func (aqtu *APIQuotaTrackerUpdate) RemoveReservations(a ...*APIQuotaReservation) *APIQuotaTrackerUpdate {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aqtu.RemoveReservationIDs(ids...)
+}
+
+// ClearBudgetReservations clears all "budget_reservations" edges to the APIQuotaReservation entity.
+func (aqtu *APIQuotaTrackerUpdate) ClearBudgetReservations() *APIQuotaTrackerUpdate {
+	aqtu.mutation.ClearBudgetReservations()
+	return aqtu
+}
+
+// RemoveBudgetReservationIDs removes the "budget_reservations" edge to APIQuotaReservation entities by IDs.
+func (aqtu *APIQuotaTrackerUpdate) RemoveBudgetReservationIDs(ids ...ulid.ID) *APIQuotaTrackerUpdate {
+	aqtu.mutation.RemoveBudgetReservationIDs(ids...)
+	return aqtu
+}
+
+// RemoveBudgetReservations removes "budget_reservations" edges to APIQuotaReservation entities.
+func (aqtu *APIQuotaTrackerUpdate) RemoveBudgetReservations(a ...*APIQuotaReservation) *APIQuotaTrackerUpdate {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aqtu.RemoveBudgetReservationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (aqtu *APIQuotaTrackerUpdate) Save(ctx context.Context) (int, error) {
 	aqtu.defaults()
@@ -238,11 +468,26 @@ func (aqtu *APIQuotaTrackerUpdate) check() error {
 			return &ValidationError{Name: "call_count", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.call_count": %w`, err)}
 		}
 	}
+	if v, ok := aqtu.mutation.ReservedCount(); ok {
+		if err := apiquotatracker.ReservedCountValidator(v); err != nil {
+			return &ValidationError{Name: "reserved_count", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.reserved_count": %w`, err)}
+		}
+	}
 	if v, ok := aqtu.mutation.QuotaLimit(); ok {
 		if err := apiquotatracker.QuotaLimitValidator(v); err != nil {
 			return &ValidationError{Name: "quota_limit", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.quota_limit": %w`, err)}
 		}
 	}
+	if v, ok := aqtu.mutation.DailyCap(); ok {
+		if err := apiquotatracker.DailyCapValidator(v); err != nil {
+			return &ValidationError{Name: "daily_cap", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.daily_cap": %w`, err)}
+		}
+	}
+	if v, ok := aqtu.mutation.HourlyCap(); ok {
+		if err := apiquotatracker.HourlyCapValidator(v); err != nil {
+			return &ValidationError{Name: "hourly_cap", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.hourly_cap": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -261,6 +506,12 @@ func (aqtu *APIQuotaTrackerUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if value, ok := aqtu.mutation.UpdatedAt(); ok {
 		_spec.SetField(apiquotatracker.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := aqtu.mutation.KeyLabel(); ok {
+		_spec.SetField(apiquotatracker.FieldKeyLabel, field.TypeString, value)
+	}
+	if value, ok := aqtu.mutation.Budget(); ok {
+		_spec.SetField(apiquotatracker.FieldBudget, field.TypeString, value)
+	}
 	if value, ok := aqtu.mutation.Month(); ok {
 		_spec.SetField(apiquotatracker.FieldMonth, field.TypeInt, value)
 	}
@@ -279,12 +530,39 @@ func (aqtu *APIQuotaTrackerUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if value, ok := aqtu.mutation.AddedCallCount(); ok {
 		_spec.AddField(apiquotatracker.FieldCallCount, field.TypeInt, value)
 	}
+	if value, ok := aqtu.mutation.ReservedCount(); ok {
+		_spec.SetField(apiquotatracker.FieldReservedCount, field.TypeInt, value)
+	}
+	if value, ok := aqtu.mutation.AddedReservedCount(); ok {
+		_spec.AddField(apiquotatracker.FieldReservedCount, field.TypeInt, value)
+	}
 	if value, ok := aqtu.mutation.QuotaLimit(); ok {
 		_spec.SetField(apiquotatracker.FieldQuotaLimit, field.TypeInt, value)
 	}
 	if value, ok := aqtu.mutation.AddedQuotaLimit(); ok {
 		_spec.AddField(apiquotatracker.FieldQuotaLimit, field.TypeInt, value)
 	}
+	if value, ok := aqtu.mutation.DailyCap(); ok {
+		_spec.SetField(apiquotatracker.FieldDailyCap, field.TypeInt, value)
+	}
+	if value, ok := aqtu.mutation.AddedDailyCap(); ok {
+		_spec.AddField(apiquotatracker.FieldDailyCap, field.TypeInt, value)
+	}
+	if aqtu.mutation.DailyCapCleared() {
+		_spec.ClearField(apiquotatracker.FieldDailyCap, field.TypeInt)
+	}
+	if value, ok := aqtu.mutation.HourlyCap(); ok {
+		_spec.SetField(apiquotatracker.FieldHourlyCap, field.TypeInt, value)
+	}
+	if value, ok := aqtu.mutation.AddedHourlyCap(); ok {
+		_spec.AddField(apiquotatracker.FieldHourlyCap, field.TypeInt, value)
+	}
+	if aqtu.mutation.HourlyCapCleared() {
+		_spec.ClearField(apiquotatracker.FieldHourlyCap, field.TypeInt)
+	}
+	if value, ok := aqtu.mutation.EvenPacing(); ok {
+		_spec.SetField(apiquotatracker.FieldEvenPacing, field.TypeBool, value)
+	}
 	if value, ok := aqtu.mutation.QuotaExceeded(); ok {
 		_spec.SetField(apiquotatracker.FieldQuotaExceeded, field.TypeBool, value)
 	}
@@ -294,12 +572,119 @@ func (aqtu *APIQuotaTrackerUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if value, ok := aqtu.mutation.NotificationSent(); ok {
 		_spec.SetField(apiquotatracker.FieldNotificationSent, field.TypeBool, value)
 	}
+	if value, ok := aqtu.mutation.AlertedThresholds(); ok {
+		_spec.SetField(apiquotatracker.FieldAlertedThresholds, field.TypeJSON, value)
+	}
+	if value, ok := aqtu.mutation.AppendedAlertedThresholds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apiquotatracker.FieldAlertedThresholds, value)
+		})
+	}
+	if aqtu.mutation.AlertedThresholdsCleared() {
+		_spec.ClearField(apiquotatracker.FieldAlertedThresholds, field.TypeJSON)
+	}
 	if value, ok := aqtu.mutation.LastCallAt(); ok {
 		_spec.SetField(apiquotatracker.FieldLastCallAt, field.TypeTime, value)
 	}
 	if aqtu.mutation.LastCallAtCleared() {
 		_spec.ClearField(apiquotatracker.FieldLastCallAt, field.TypeTime)
 	}
+	if value, ok := aqtu.mutation.RateLimitedUntil(); ok {
+		_spec.SetField(apiquotatracker.FieldRateLimitedUntil, field.TypeTime, value)
+	}
+	if aqtu.mutation.RateLimitedUntilCleared() {
+		_spec.ClearField(apiquotatracker.FieldRateLimitedUntil, field.TypeTime)
+	}
+	if aqtu.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.ReservationsTable,
+			Columns: []string{apiquotatracker.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqtu.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !aqtu.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.ReservationsTable,
+			Columns: []string{apiquotatracker.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqtu.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.ReservationsTable,
+			Columns: []string{apiquotatracker.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aqtu.mutation.BudgetReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.BudgetReservationsTable,
+			Columns: []string{apiquotatracker.BudgetReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqtu.mutation.RemovedBudgetReservationsIDs(); len(nodes) > 0 && !aqtu.mutation.BudgetReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.BudgetReservationsTable,
+			Columns: []string{apiquotatracker.BudgetReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqtu.mutation.BudgetReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.BudgetReservationsTable,
+			Columns: []string{apiquotatracker.BudgetReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, aqtu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{apiquotatracker.Label}
@@ -326,6 +711,34 @@ func (aqtuo *APIQuotaTrackerUpdateOne) SetUpdatedAt(t time.Time) *APIQuotaTracke
 	return aqtuo
 }
 
+// SetKeyLabel sets the "key_label" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetKeyLabel(s string) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.SetKeyLabel(s)
+	return aqtuo
+}
+
+// SetNillableKeyLabel sets the "key_label" field if the given value is not nil.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetNillableKeyLabel(s *string) *APIQuotaTrackerUpdateOne {
+	if s != nil {
+		aqtuo.SetKeyLabel(*s)
+	}
+	return aqtuo
+}
+
+// SetBudget sets the "budget" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetBudget(s string) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.SetBudget(s)
+	return aqtuo
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetNillableBudget(s *string) *APIQuotaTrackerUpdateOne {
+	if s != nil {
+		aqtuo.SetBudget(*s)
+	}
+	return aqtuo
+}
+
 // SetMonth sets the "month" field.
 func (aqtuo *APIQuotaTrackerUpdateOne) SetMonth(i int) *APIQuotaTrackerUpdateOne {
 	aqtuo.mutation.ResetMonth()
@@ -389,6 +802,27 @@ func (aqtuo *APIQuotaTrackerUpdateOne) AddCallCount(i int) *APIQuotaTrackerUpdat
 	return aqtuo
 }
 
+// SetReservedCount sets the "reserved_count" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetReservedCount(i int) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.ResetReservedCount()
+	aqtuo.mutation.SetReservedCount(i)
+	return aqtuo
+}
+
+// SetNillableReservedCount sets the "reserved_count" field if the given value is not nil.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetNillableReservedCount(i *int) *APIQuotaTrackerUpdateOne {
+	if i != nil {
+		aqtuo.SetReservedCount(*i)
+	}
+	return aqtuo
+}
+
+// AddReservedCount adds i to the "reserved_count" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) AddReservedCount(i int) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.AddReservedCount(i)
+	return aqtuo
+}
+
 // SetQuotaLimit sets the "quota_limit" field.
 func (aqtuo *APIQuotaTrackerUpdateOne) SetQuotaLimit(i int) *APIQuotaTrackerUpdateOne {
 	aqtuo.mutation.ResetQuotaLimit()
@@ -410,6 +844,74 @@ func (aqtuo *APIQuotaTrackerUpdateOne) AddQuotaLimit(i int) *APIQuotaTrackerUpda
 	return aqtuo
 }
 
+// SetDailyCap sets the "daily_cap" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetDailyCap(i int) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.ResetDailyCap()
+	aqtuo.mutation.SetDailyCap(i)
+	return aqtuo
+}
+
+// SetNillableDailyCap sets the "daily_cap" field if the given value is not nil.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetNillableDailyCap(i *int) *APIQuotaTrackerUpdateOne {
+	if i != nil {
+		aqtuo.SetDailyCap(*i)
+	}
+	return aqtuo
+}
+
+// AddDailyCap adds i to the "daily_cap" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) AddDailyCap(i int) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.AddDailyCap(i)
+	return aqtuo
+}
+
+// ClearDailyCap clears the value of the "daily_cap" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) ClearDailyCap() *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.ClearDailyCap()
+	return aqtuo
+}
+
+// SetHourlyCap sets the "hourly_cap" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetHourlyCap(i int) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.ResetHourlyCap()
+	aqtuo.mutation.SetHourlyCap(i)
+	return aqtuo
+}
+
+// SetNillableHourlyCap sets the "hourly_cap" field if the given value is not nil.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetNillableHourlyCap(i *int) *APIQuotaTrackerUpdateOne {
+	if i != nil {
+		aqtuo.SetHourlyCap(*i)
+	}
+	return aqtuo
+}
+
+// AddHourlyCap adds i to the "hourly_cap" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) AddHourlyCap(i int) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.AddHourlyCap(i)
+	return aqtuo
+}
+
+// ClearHourlyCap clears the value of the "hourly_cap" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) ClearHourlyCap() *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.ClearHourlyCap()
+	return aqtuo
+}
+
+// SetEvenPacing sets the "even_pacing" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetEvenPacing(b bool) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.SetEvenPacing(b)
+	return aqtuo
+}
+
+// SetNillableEvenPacing sets the "even_pacing" field if the given value is not nil.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetNillableEvenPacing(b *bool) *APIQuotaTrackerUpdateOne {
+	if b != nil {
+		aqtuo.SetEvenPacing(*b)
+	}
+	return aqtuo
+}
+
 // SetQuotaExceeded sets the "quota_exceeded" field.
 func (aqtuo *APIQuotaTrackerUpdateOne) SetQuotaExceeded(b bool) *APIQuotaTrackerUpdateOne {
 	aqtuo.mutation.SetQuotaExceeded(b)
@@ -452,6 +954,24 @@ func (aqtuo *APIQuotaTrackerUpdateOne) SetNillableNotificationSent(b *bool) *API
 	return aqtuo
 }
 
+// SetAlertedThresholds sets the "alerted_thresholds" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetAlertedThresholds(i []int) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.SetAlertedThresholds(i)
+	return aqtuo
+}
+
+// AppendAlertedThresholds appends i to the "alerted_thresholds" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) AppendAlertedThresholds(i []int) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.AppendAlertedThresholds(i)
+	return aqtuo
+}
+
+// ClearAlertedThresholds clears the value of the "alerted_thresholds" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) ClearAlertedThresholds() *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.ClearAlertedThresholds()
+	return aqtuo
+}
+
 // SetLastCallAt sets the "last_call_at" field.
 func (aqtuo *APIQuotaTrackerUpdateOne) SetLastCallAt(t time.Time) *APIQuotaTrackerUpdateOne {
 	aqtuo.mutation.SetLastCallAt(t)
@@ -472,11 +992,103 @@ func (aqtuo *APIQuotaTrackerUpdateOne) ClearLastCallAt() *APIQuotaTrackerUpdateO
 	return aqtuo
 }
 
+// SetRateLimitedUntil sets the "rate_limited_until" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetRateLimitedUntil(t time.Time) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.SetRateLimitedUntil(t)
+	return aqtuo
+}
+
+// SetNillableRateLimitedUntil sets the "rate_limited_until" field if the given value is not nil.
+func (aqtuo *APIQuotaTrackerUpdateOne) SetNillableRateLimitedUntil(t *time.Time) *APIQuotaTrackerUpdateOne {
+	if t != nil {
+		aqtuo.SetRateLimitedUntil(*t)
+	}
+	return aqtuo
+}
+
+// ClearRateLimitedUntil clears the value of the "rate_limited_until" field.
+func (aqtuo *APIQuotaTrackerUpdateOne) ClearRateLimitedUntil() *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.ClearRateLimitedUntil()
+	return aqtuo
+}
+
+// AddReservationIDs adds the "reservations" edge to the APIQuotaReservation entity by IDs.
+func (aqtuo *APIQuotaTrackerUpdateOne) AddReservationIDs(ids ...ulid.ID) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.AddReservationIDs(ids...)
+	return aqtuo
+}
+
+// AddReservations adds the "reservations" edges to the APIQuotaReservation entity.
+func (aqtuo *APIQuotaTrackerUpdateOne) AddReservations(a ...*APIQuotaReservation) *APIQuotaTrackerUpdateOne {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aqtuo.AddReservationIDs(ids...)
+}
+
+// AddBudgetReservationIDs adds the "budget_reservations" edge to the APIQuotaReservation entity by IDs.
+func (aqtuo *APIQuotaTrackerUpdateOne) AddBudgetReservationIDs(ids ...ulid.ID) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.AddBudgetReservationIDs(ids...)
+	return aqtuo
+}
+
+// AddBudgetReservations adds the "budget_reservations" edges to the APIQuotaReservation entity.
+func (aqtuo *APIQuotaTrackerUpdateOne) AddBudgetReservations(a ...*APIQuotaReservation) *APIQuotaTrackerUpdateOne {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aqtuo.AddBudgetReservationIDs(ids...)
+}
+
 // Mutation returns the APIQuotaTrackerMutation object of the builder.
 func (aqtuo *APIQuotaTrackerUpdateOne) Mutation() *APIQuotaTrackerMutation {
 	return aqtuo.mutation
 }
 
+// ClearReservations clears all "reservations" edges to the APIQuotaReservation entity.
+func (aqtuo *APIQuotaTrackerUpdateOne) ClearReservations() *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.ClearReservations()
+	return aqtuo
+}
+
+// RemoveReservationIDs removes the "reservations" edge to APIQuotaReservation entities by IDs.
+func (aqtuo *APIQuotaTrackerUpdateOne) RemoveReservationIDs(ids ...ulid.ID) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.RemoveReservationIDs(ids...)
+	return aqtuo
+}
+
+// RemoveReservations removes "reservations" edges to APIQuotaReservation entities.
+func (aqtuo *APIQuotaTrackerUpdateOne) RemoveReservations(a ...*APIQuotaReservation) *APIQuotaTrackerUpdateOne {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aqtuo.RemoveReservationIDs(ids...)
+}
+
+// ClearBudgetReservations clears all "budget_reservations" edges to the APIQuotaReservation entity.
+func (aqtuo *APIQuotaTrackerUpdateOne) ClearBudgetReservations() *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.ClearBudgetReservations()
+	return aqtuo
+}
+
+// RemoveBudgetReservationIDs removes the "budget_reservations" edge to APIQuotaReservation entities by IDs.
+func (aqtuo *APIQuotaTrackerUpdateOne) RemoveBudgetReservationIDs(ids ...ulid.ID) *APIQuotaTrackerUpdateOne {
+	aqtuo.mutation.RemoveBudgetReservationIDs(ids...)
+	return aqtuo
+}
+
+// RemoveBudgetReservations removes "budget_reservations" edges to APIQuotaReservation entities.
+func (aqtuo *APIQuotaTrackerUpdateOne) RemoveBudgetReservations(a ...*APIQuotaReservation) *APIQuotaTrackerUpdateOne {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aqtuo.RemoveBudgetReservationIDs(ids...)
+}
+
 // Where appends a list predicates to the APIQuotaTrackerUpdate builder.
 func (aqtuo *APIQuotaTrackerUpdateOne) Where(ps ...predicate.APIQuotaTracker) *APIQuotaTrackerUpdateOne {
 	aqtuo.mutation.Where(ps...)
@@ -543,11 +1155,26 @@ func (aqtuo *APIQuotaTrackerUpdateOne) check() error {
 			return &ValidationError{Name: "call_count", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.call_count": %w`, err)}
 		}
 	}
+	if v, ok := aqtuo.mutation.ReservedCount(); ok {
+		if err := apiquotatracker.ReservedCountValidator(v); err != nil {
+			return &ValidationError{Name: "reserved_count", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.reserved_count": %w`, err)}
+		}
+	}
 	if v, ok := aqtuo.mutation.QuotaLimit(); ok {
 		if err := apiquotatracker.QuotaLimitValidator(v); err != nil {
 			return &ValidationError{Name: "quota_limit", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.quota_limit": %w`, err)}
 		}
 	}
+	if v, ok := aqtuo.mutation.DailyCap(); ok {
+		if err := apiquotatracker.DailyCapValidator(v); err != nil {
+			return &ValidationError{Name: "daily_cap", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.daily_cap": %w`, err)}
+		}
+	}
+	if v, ok := aqtuo.mutation.HourlyCap(); ok {
+		if err := apiquotatracker.HourlyCapValidator(v); err != nil {
+			return &ValidationError{Name: "hourly_cap", err: fmt.Errorf(`ent: validator failed for field "APIQuotaTracker.hourly_cap": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -583,6 +1210,12 @@ func (aqtuo *APIQuotaTrackerUpdateOne) sqlSave(ctx context.Context) (_node *APIQ
 	if value, ok := aqtuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(apiquotatracker.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := aqtuo.mutation.KeyLabel(); ok {
+		_spec.SetField(apiquotatracker.FieldKeyLabel, field.TypeString, value)
+	}
+	if value, ok := aqtuo.mutation.Budget(); ok {
+		_spec.SetField(apiquotatracker.FieldBudget, field.TypeString, value)
+	}
 	if value, ok := aqtuo.mutation.Month(); ok {
 		_spec.SetField(apiquotatracker.FieldMonth, field.TypeInt, value)
 	}
@@ -601,12 +1234,39 @@ func (aqtuo *APIQuotaTrackerUpdateOne) sqlSave(ctx context.Context) (_node *APIQ
 	if value, ok := aqtuo.mutation.AddedCallCount(); ok {
 		_spec.AddField(apiquotatracker.FieldCallCount, field.TypeInt, value)
 	}
+	if value, ok := aqtuo.mutation.ReservedCount(); ok {
+		_spec.SetField(apiquotatracker.FieldReservedCount, field.TypeInt, value)
+	}
+	if value, ok := aqtuo.mutation.AddedReservedCount(); ok {
+		_spec.AddField(apiquotatracker.FieldReservedCount, field.TypeInt, value)
+	}
 	if value, ok := aqtuo.mutation.QuotaLimit(); ok {
 		_spec.SetField(apiquotatracker.FieldQuotaLimit, field.TypeInt, value)
 	}
 	if value, ok := aqtuo.mutation.AddedQuotaLimit(); ok {
 		_spec.AddField(apiquotatracker.FieldQuotaLimit, field.TypeInt, value)
 	}
+	if value, ok := aqtuo.mutation.DailyCap(); ok {
+		_spec.SetField(apiquotatracker.FieldDailyCap, field.TypeInt, value)
+	}
+	if value, ok := aqtuo.mutation.AddedDailyCap(); ok {
+		_spec.AddField(apiquotatracker.FieldDailyCap, field.TypeInt, value)
+	}
+	if aqtuo.mutation.DailyCapCleared() {
+		_spec.ClearField(apiquotatracker.FieldDailyCap, field.TypeInt)
+	}
+	if value, ok := aqtuo.mutation.HourlyCap(); ok {
+		_spec.SetField(apiquotatracker.FieldHourlyCap, field.TypeInt, value)
+	}
+	if value, ok := aqtuo.mutation.AddedHourlyCap(); ok {
+		_spec.AddField(apiquotatracker.FieldHourlyCap, field.TypeInt, value)
+	}
+	if aqtuo.mutation.HourlyCapCleared() {
+		_spec.ClearField(apiquotatracker.FieldHourlyCap, field.TypeInt)
+	}
+	if value, ok := aqtuo.mutation.EvenPacing(); ok {
+		_spec.SetField(apiquotatracker.FieldEvenPacing, field.TypeBool, value)
+	}
 	if value, ok := aqtuo.mutation.QuotaExceeded(); ok {
 		_spec.SetField(apiquotatracker.FieldQuotaExceeded, field.TypeBool, value)
 	}
@@ -616,12 +1276,119 @@ func (aqtuo *APIQuotaTrackerUpdateOne) sqlSave(ctx context.Context) (_node *APIQ
 	if value, ok := aqtuo.mutation.NotificationSent(); ok {
 		_spec.SetField(apiquotatracker.FieldNotificationSent, field.TypeBool, value)
 	}
+	if value, ok := aqtuo.mutation.AlertedThresholds(); ok {
+		_spec.SetField(apiquotatracker.FieldAlertedThresholds, field.TypeJSON, value)
+	}
+	if value, ok := aqtuo.mutation.AppendedAlertedThresholds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apiquotatracker.FieldAlertedThresholds, value)
+		})
+	}
+	if aqtuo.mutation.AlertedThresholdsCleared() {
+		_spec.ClearField(apiquotatracker.FieldAlertedThresholds, field.TypeJSON)
+	}
 	if value, ok := aqtuo.mutation.LastCallAt(); ok {
 		_spec.SetField(apiquotatracker.FieldLastCallAt, field.TypeTime, value)
 	}
 	if aqtuo.mutation.LastCallAtCleared() {
 		_spec.ClearField(apiquotatracker.FieldLastCallAt, field.TypeTime)
 	}
+	if value, ok := aqtuo.mutation.RateLimitedUntil(); ok {
+		_spec.SetField(apiquotatracker.FieldRateLimitedUntil, field.TypeTime, value)
+	}
+	if aqtuo.mutation.RateLimitedUntilCleared() {
+		_spec.ClearField(apiquotatracker.FieldRateLimitedUntil, field.TypeTime)
+	}
+	if aqtuo.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.ReservationsTable,
+			Columns: []string{apiquotatracker.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqtuo.mutation.RemovedReservationsIDs(); len(nodes) > 0 && !aqtuo.mutation.ReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.ReservationsTable,
+			Columns: []string{apiquotatracker.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqtuo.mutation.ReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.ReservationsTable,
+			Columns: []string{apiquotatracker.ReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aqtuo.mutation.BudgetReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.BudgetReservationsTable,
+			Columns: []string{apiquotatracker.BudgetReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqtuo.mutation.RemovedBudgetReservationsIDs(); len(nodes) > 0 && !aqtuo.mutation.BudgetReservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.BudgetReservationsTable,
+			Columns: []string{apiquotatracker.BudgetReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aqtuo.mutation.BudgetReservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apiquotatracker.BudgetReservationsTable,
+			Columns: []string{apiquotatracker.BudgetReservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apiquotareservation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &APIQuotaTracker{config: aqtuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
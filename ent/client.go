@@ -12,13 +12,22 @@ import (
 	"sheng-go-backend/ent/migrate"
 	"sheng-go-backend/ent/schema/ulid"
 
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/cronjobconfig"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
 	"sheng-go-backend/ent/profilepost"
 	"sheng-go-backend/ent/profilepostitem"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/todo"
 	"sheng-go-backend/ent/user"
 
@@ -33,20 +42,38 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APICallLog is the client for interacting with the APICallLog builders.
+	APICallLog *APICallLogClient
+	// APIQuotaBudget is the client for interacting with the APIQuotaBudget builders.
+	APIQuotaBudget *APIQuotaBudgetClient
+	// APIQuotaReservation is the client for interacting with the APIQuotaReservation builders.
+	APIQuotaReservation *APIQuotaReservationClient
 	// APIQuotaTracker is the client for interacting with the APIQuotaTracker builders.
 	APIQuotaTracker *APIQuotaTrackerClient
+	// Company is the client for interacting with the Company builders.
+	Company *CompanyClient
 	// CronJobConfig is the client for interacting with the CronJobConfig builders.
 	CronJobConfig *CronJobConfigClient
 	// JobExecutionHistory is the client for interacting with the JobExecutionHistory builders.
 	JobExecutionHistory *JobExecutionHistoryClient
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
+	// ProfileChangeEvent is the client for interacting with the ProfileChangeEvent builders.
+	ProfileChangeEvent *ProfileChangeEventClient
+	// ProfileEducation is the client for interacting with the ProfileEducation builders.
+	ProfileEducation *ProfileEducationClient
 	// ProfileEntry is the client for interacting with the ProfileEntry builders.
 	ProfileEntry *ProfileEntryClient
+	// ProfilePosition is the client for interacting with the ProfilePosition builders.
+	ProfilePosition *ProfilePositionClient
 	// ProfilePost is the client for interacting with the ProfilePost builders.
 	ProfilePost *ProfilePostClient
 	// ProfilePostItem is the client for interacting with the ProfilePostItem builders.
 	ProfilePostItem *ProfilePostItemClient
+	// ProfileSkill is the client for interacting with the ProfileSkill builders.
+	ProfileSkill *ProfileSkillClient
+	// ProfileSnapshot is the client for interacting with the ProfileSnapshot builders.
+	ProfileSnapshot *ProfileSnapshotClient
 	// Todo is the client for interacting with the Todo builders.
 	Todo *TodoClient
 	// User is the client for interacting with the User builders.
@@ -62,13 +89,22 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APICallLog = NewAPICallLogClient(c.config)
+	c.APIQuotaBudget = NewAPIQuotaBudgetClient(c.config)
+	c.APIQuotaReservation = NewAPIQuotaReservationClient(c.config)
 	c.APIQuotaTracker = NewAPIQuotaTrackerClient(c.config)
+	c.Company = NewCompanyClient(c.config)
 	c.CronJobConfig = NewCronJobConfigClient(c.config)
 	c.JobExecutionHistory = NewJobExecutionHistoryClient(c.config)
 	c.Profile = NewProfileClient(c.config)
+	c.ProfileChangeEvent = NewProfileChangeEventClient(c.config)
+	c.ProfileEducation = NewProfileEducationClient(c.config)
 	c.ProfileEntry = NewProfileEntryClient(c.config)
+	c.ProfilePosition = NewProfilePositionClient(c.config)
 	c.ProfilePost = NewProfilePostClient(c.config)
 	c.ProfilePostItem = NewProfilePostItemClient(c.config)
+	c.ProfileSkill = NewProfileSkillClient(c.config)
+	c.ProfileSnapshot = NewProfileSnapshotClient(c.config)
 	c.Todo = NewTodoClient(c.config)
 	c.User = NewUserClient(c.config)
 }
@@ -163,13 +199,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                 ctx,
 		config:              cfg,
+		APICallLog:          NewAPICallLogClient(cfg),
+		APIQuotaBudget:      NewAPIQuotaBudgetClient(cfg),
+		APIQuotaReservation: NewAPIQuotaReservationClient(cfg),
 		APIQuotaTracker:     NewAPIQuotaTrackerClient(cfg),
+		Company:             NewCompanyClient(cfg),
 		CronJobConfig:       NewCronJobConfigClient(cfg),
 		JobExecutionHistory: NewJobExecutionHistoryClient(cfg),
 		Profile:             NewProfileClient(cfg),
+		ProfileChangeEvent:  NewProfileChangeEventClient(cfg),
+		ProfileEducation:    NewProfileEducationClient(cfg),
 		ProfileEntry:        NewProfileEntryClient(cfg),
+		ProfilePosition:     NewProfilePositionClient(cfg),
 		ProfilePost:         NewProfilePostClient(cfg),
 		ProfilePostItem:     NewProfilePostItemClient(cfg),
+		ProfileSkill:        NewProfileSkillClient(cfg),
+		ProfileSnapshot:     NewProfileSnapshotClient(cfg),
 		Todo:                NewTodoClient(cfg),
 		User:                NewUserClient(cfg),
 	}, nil
@@ -191,13 +236,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                 ctx,
 		config:              cfg,
+		APICallLog:          NewAPICallLogClient(cfg),
+		APIQuotaBudget:      NewAPIQuotaBudgetClient(cfg),
+		APIQuotaReservation: NewAPIQuotaReservationClient(cfg),
 		APIQuotaTracker:     NewAPIQuotaTrackerClient(cfg),
+		Company:             NewCompanyClient(cfg),
 		CronJobConfig:       NewCronJobConfigClient(cfg),
 		JobExecutionHistory: NewJobExecutionHistoryClient(cfg),
 		Profile:             NewProfileClient(cfg),
+		ProfileChangeEvent:  NewProfileChangeEventClient(cfg),
+		ProfileEducation:    NewProfileEducationClient(cfg),
 		ProfileEntry:        NewProfileEntryClient(cfg),
+		ProfilePosition:     NewProfilePositionClient(cfg),
 		ProfilePost:         NewProfilePostClient(cfg),
 		ProfilePostItem:     NewProfilePostItemClient(cfg),
+		ProfileSkill:        NewProfileSkillClient(cfg),
+		ProfileSnapshot:     NewProfileSnapshotClient(cfg),
 		Todo:                NewTodoClient(cfg),
 		User:                NewUserClient(cfg),
 	}, nil
@@ -206,7 +260,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		APIQuotaTracker.
+//		APICallLog.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -229,8 +283,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.APIQuotaTracker, c.CronJobConfig, c.JobExecutionHistory, c.Profile,
-		c.ProfileEntry, c.ProfilePost, c.ProfilePostItem, c.Todo, c.User,
+		c.APICallLog, c.APIQuotaBudget, c.APIQuotaReservation, c.APIQuotaTracker,
+		c.Company, c.CronJobConfig, c.JobExecutionHistory, c.Profile,
+		c.ProfileChangeEvent, c.ProfileEducation, c.ProfileEntry, c.ProfilePosition,
+		c.ProfilePost, c.ProfilePostItem, c.ProfileSkill, c.ProfileSnapshot, c.Todo,
+		c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -240,8 +297,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.APIQuotaTracker, c.CronJobConfig, c.JobExecutionHistory, c.Profile,
-		c.ProfileEntry, c.ProfilePost, c.ProfilePostItem, c.Todo, c.User,
+		c.APICallLog, c.APIQuotaBudget, c.APIQuotaReservation, c.APIQuotaTracker,
+		c.Company, c.CronJobConfig, c.JobExecutionHistory, c.Profile,
+		c.ProfileChangeEvent, c.ProfileEducation, c.ProfileEntry, c.ProfilePosition,
+		c.ProfilePost, c.ProfilePostItem, c.ProfileSkill, c.ProfileSnapshot, c.Todo,
+		c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -250,20 +310,38 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APICallLogMutation:
+		return c.APICallLog.mutate(ctx, m)
+	case *APIQuotaBudgetMutation:
+		return c.APIQuotaBudget.mutate(ctx, m)
+	case *APIQuotaReservationMutation:
+		return c.APIQuotaReservation.mutate(ctx, m)
 	case *APIQuotaTrackerMutation:
 		return c.APIQuotaTracker.mutate(ctx, m)
+	case *CompanyMutation:
+		return c.Company.mutate(ctx, m)
 	case *CronJobConfigMutation:
 		return c.CronJobConfig.mutate(ctx, m)
 	case *JobExecutionHistoryMutation:
 		return c.JobExecutionHistory.mutate(ctx, m)
 	case *ProfileMutation:
 		return c.Profile.mutate(ctx, m)
+	case *ProfileChangeEventMutation:
+		return c.ProfileChangeEvent.mutate(ctx, m)
+	case *ProfileEducationMutation:
+		return c.ProfileEducation.mutate(ctx, m)
 	case *ProfileEntryMutation:
 		return c.ProfileEntry.mutate(ctx, m)
+	case *ProfilePositionMutation:
+		return c.ProfilePosition.mutate(ctx, m)
 	case *ProfilePostMutation:
 		return c.ProfilePost.mutate(ctx, m)
 	case *ProfilePostItemMutation:
 		return c.ProfilePostItem.mutate(ctx, m)
+	case *ProfileSkillMutation:
+		return c.ProfileSkill.mutate(ctx, m)
+	case *ProfileSnapshotMutation:
+		return c.ProfileSnapshot.mutate(ctx, m)
 	case *TodoMutation:
 		return c.Todo.mutate(ctx, m)
 	case *UserMutation:
@@ -273,107 +351,107 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
-// APIQuotaTrackerClient is a client for the APIQuotaTracker schema.
-type APIQuotaTrackerClient struct {
+// APICallLogClient is a client for the APICallLog schema.
+type APICallLogClient struct {
 	config
 }
 
-// NewAPIQuotaTrackerClient returns a client for the APIQuotaTracker from the given config.
-func NewAPIQuotaTrackerClient(c config) *APIQuotaTrackerClient {
-	return &APIQuotaTrackerClient{config: c}
+// NewAPICallLogClient returns a client for the APICallLog from the given config.
+func NewAPICallLogClient(c config) *APICallLogClient {
+	return &APICallLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `apiquotatracker.Hooks(f(g(h())))`.
-func (c *APIQuotaTrackerClient) Use(hooks ...Hook) {
-	c.hooks.APIQuotaTracker = append(c.hooks.APIQuotaTracker, hooks...)
+// A call to `Use(f, g, h)` equals to `apicalllog.Hooks(f(g(h())))`.
+func (c *APICallLogClient) Use(hooks ...Hook) {
+	c.hooks.APICallLog = append(c.hooks.APICallLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `apiquotatracker.Intercept(f(g(h())))`.
-func (c *APIQuotaTrackerClient) Intercept(interceptors ...Interceptor) {
-	c.inters.APIQuotaTracker = append(c.inters.APIQuotaTracker, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `apicalllog.Intercept(f(g(h())))`.
+func (c *APICallLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APICallLog = append(c.inters.APICallLog, interceptors...)
 }
 
-// Create returns a builder for creating a APIQuotaTracker entity.
-func (c *APIQuotaTrackerClient) Create() *APIQuotaTrackerCreate {
-	mutation := newAPIQuotaTrackerMutation(c.config, OpCreate)
-	return &APIQuotaTrackerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a APICallLog entity.
+func (c *APICallLogClient) Create() *APICallLogCreate {
+	mutation := newAPICallLogMutation(c.config, OpCreate)
+	return &APICallLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of APIQuotaTracker entities.
-func (c *APIQuotaTrackerClient) CreateBulk(builders ...*APIQuotaTrackerCreate) *APIQuotaTrackerCreateBulk {
-	return &APIQuotaTrackerCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of APICallLog entities.
+func (c *APICallLogClient) CreateBulk(builders ...*APICallLogCreate) *APICallLogCreateBulk {
+	return &APICallLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *APIQuotaTrackerClient) MapCreateBulk(slice any, setFunc func(*APIQuotaTrackerCreate, int)) *APIQuotaTrackerCreateBulk {
+func (c *APICallLogClient) MapCreateBulk(slice any, setFunc func(*APICallLogCreate, int)) *APICallLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &APIQuotaTrackerCreateBulk{err: fmt.Errorf("calling to APIQuotaTrackerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &APICallLogCreateBulk{err: fmt.Errorf("calling to APICallLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*APIQuotaTrackerCreate, rv.Len())
+	builders := make([]*APICallLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &APIQuotaTrackerCreateBulk{config: c.config, builders: builders}
+	return &APICallLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for APIQuotaTracker.
-func (c *APIQuotaTrackerClient) Update() *APIQuotaTrackerUpdate {
-	mutation := newAPIQuotaTrackerMutation(c.config, OpUpdate)
-	return &APIQuotaTrackerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for APICallLog.
+func (c *APICallLogClient) Update() *APICallLogUpdate {
+	mutation := newAPICallLogMutation(c.config, OpUpdate)
+	return &APICallLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *APIQuotaTrackerClient) UpdateOne(aqt *APIQuotaTracker) *APIQuotaTrackerUpdateOne {
-	mutation := newAPIQuotaTrackerMutation(c.config, OpUpdateOne, withAPIQuotaTracker(aqt))
-	return &APIQuotaTrackerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APICallLogClient) UpdateOne(acl *APICallLog) *APICallLogUpdateOne {
+	mutation := newAPICallLogMutation(c.config, OpUpdateOne, withAPICallLog(acl))
+	return &APICallLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *APIQuotaTrackerClient) UpdateOneID(id ulid.ID) *APIQuotaTrackerUpdateOne {
-	mutation := newAPIQuotaTrackerMutation(c.config, OpUpdateOne, withAPIQuotaTrackerID(id))
-	return &APIQuotaTrackerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APICallLogClient) UpdateOneID(id ulid.ID) *APICallLogUpdateOne {
+	mutation := newAPICallLogMutation(c.config, OpUpdateOne, withAPICallLogID(id))
+	return &APICallLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for APIQuotaTracker.
-func (c *APIQuotaTrackerClient) Delete() *APIQuotaTrackerDelete {
-	mutation := newAPIQuotaTrackerMutation(c.config, OpDelete)
-	return &APIQuotaTrackerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for APICallLog.
+func (c *APICallLogClient) Delete() *APICallLogDelete {
+	mutation := newAPICallLogMutation(c.config, OpDelete)
+	return &APICallLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *APIQuotaTrackerClient) DeleteOne(aqt *APIQuotaTracker) *APIQuotaTrackerDeleteOne {
-	return c.DeleteOneID(aqt.ID)
+func (c *APICallLogClient) DeleteOne(acl *APICallLog) *APICallLogDeleteOne {
+	return c.DeleteOneID(acl.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *APIQuotaTrackerClient) DeleteOneID(id ulid.ID) *APIQuotaTrackerDeleteOne {
-	builder := c.Delete().Where(apiquotatracker.ID(id))
+func (c *APICallLogClient) DeleteOneID(id ulid.ID) *APICallLogDeleteOne {
+	builder := c.Delete().Where(apicalllog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &APIQuotaTrackerDeleteOne{builder}
+	return &APICallLogDeleteOne{builder}
 }
 
-// Query returns a query builder for APIQuotaTracker.
-func (c *APIQuotaTrackerClient) Query() *APIQuotaTrackerQuery {
-	return &APIQuotaTrackerQuery{
+// Query returns a query builder for APICallLog.
+func (c *APICallLogClient) Query() *APICallLogQuery {
+	return &APICallLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeAPIQuotaTracker},
+		ctx:    &QueryContext{Type: TypeAPICallLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a APIQuotaTracker entity by its id.
-func (c *APIQuotaTrackerClient) Get(ctx context.Context, id ulid.ID) (*APIQuotaTracker, error) {
-	return c.Query().Where(apiquotatracker.ID(id)).Only(ctx)
+// Get returns a APICallLog entity by its id.
+func (c *APICallLogClient) Get(ctx context.Context, id ulid.ID) (*APICallLog, error) {
+	return c.Query().Where(apicalllog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *APIQuotaTrackerClient) GetX(ctx context.Context, id ulid.ID) *APIQuotaTracker {
+func (c *APICallLogClient) GetX(ctx context.Context, id ulid.ID) *APICallLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -381,132 +459,148 @@ func (c *APIQuotaTrackerClient) GetX(ctx context.Context, id ulid.ID) *APIQuotaT
 	return obj
 }
 
+// QueryJobExecution queries the job_execution edge of a APICallLog.
+func (c *APICallLogClient) QueryJobExecution(acl *APICallLog) *JobExecutionHistoryQuery {
+	query := (&JobExecutionHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := acl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apicalllog.Table, apicalllog.FieldID, id),
+			sqlgraph.To(jobexecutionhistory.Table, jobexecutionhistory.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apicalllog.JobExecutionTable, apicalllog.JobExecutionColumn),
+		)
+		fromV = sqlgraph.Neighbors(acl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *APIQuotaTrackerClient) Hooks() []Hook {
-	return c.hooks.APIQuotaTracker
+func (c *APICallLogClient) Hooks() []Hook {
+	return c.hooks.APICallLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *APIQuotaTrackerClient) Interceptors() []Interceptor {
-	return c.inters.APIQuotaTracker
+func (c *APICallLogClient) Interceptors() []Interceptor {
+	return c.inters.APICallLog
 }
 
-func (c *APIQuotaTrackerClient) mutate(ctx context.Context, m *APIQuotaTrackerMutation) (Value, error) {
+func (c *APICallLogClient) mutate(ctx context.Context, m *APICallLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&APIQuotaTrackerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APICallLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&APIQuotaTrackerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APICallLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&APIQuotaTrackerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APICallLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&APIQuotaTrackerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&APICallLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown APIQuotaTracker mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown APICallLog mutation op: %q", m.Op())
 	}
 }
 
-// CronJobConfigClient is a client for the CronJobConfig schema.
-type CronJobConfigClient struct {
+// APIQuotaBudgetClient is a client for the APIQuotaBudget schema.
+type APIQuotaBudgetClient struct {
 	config
 }
 
-// NewCronJobConfigClient returns a client for the CronJobConfig from the given config.
-func NewCronJobConfigClient(c config) *CronJobConfigClient {
-	return &CronJobConfigClient{config: c}
+// NewAPIQuotaBudgetClient returns a client for the APIQuotaBudget from the given config.
+func NewAPIQuotaBudgetClient(c config) *APIQuotaBudgetClient {
+	return &APIQuotaBudgetClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `cronjobconfig.Hooks(f(g(h())))`.
-func (c *CronJobConfigClient) Use(hooks ...Hook) {
-	c.hooks.CronJobConfig = append(c.hooks.CronJobConfig, hooks...)
+// A call to `Use(f, g, h)` equals to `apiquotabudget.Hooks(f(g(h())))`.
+func (c *APIQuotaBudgetClient) Use(hooks ...Hook) {
+	c.hooks.APIQuotaBudget = append(c.hooks.APIQuotaBudget, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `cronjobconfig.Intercept(f(g(h())))`.
-func (c *CronJobConfigClient) Intercept(interceptors ...Interceptor) {
-	c.inters.CronJobConfig = append(c.inters.CronJobConfig, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `apiquotabudget.Intercept(f(g(h())))`.
+func (c *APIQuotaBudgetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIQuotaBudget = append(c.inters.APIQuotaBudget, interceptors...)
 }
 
-// Create returns a builder for creating a CronJobConfig entity.
-func (c *CronJobConfigClient) Create() *CronJobConfigCreate {
-	mutation := newCronJobConfigMutation(c.config, OpCreate)
-	return &CronJobConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a APIQuotaBudget entity.
+func (c *APIQuotaBudgetClient) Create() *APIQuotaBudgetCreate {
+	mutation := newAPIQuotaBudgetMutation(c.config, OpCreate)
+	return &APIQuotaBudgetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of CronJobConfig entities.
-func (c *CronJobConfigClient) CreateBulk(builders ...*CronJobConfigCreate) *CronJobConfigCreateBulk {
-	return &CronJobConfigCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of APIQuotaBudget entities.
+func (c *APIQuotaBudgetClient) CreateBulk(builders ...*APIQuotaBudgetCreate) *APIQuotaBudgetCreateBulk {
+	return &APIQuotaBudgetCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CronJobConfigClient) MapCreateBulk(slice any, setFunc func(*CronJobConfigCreate, int)) *CronJobConfigCreateBulk {
+func (c *APIQuotaBudgetClient) MapCreateBulk(slice any, setFunc func(*APIQuotaBudgetCreate, int)) *APIQuotaBudgetCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CronJobConfigCreateBulk{err: fmt.Errorf("calling to CronJobConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &APIQuotaBudgetCreateBulk{err: fmt.Errorf("calling to APIQuotaBudgetClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CronJobConfigCreate, rv.Len())
+	builders := make([]*APIQuotaBudgetCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CronJobConfigCreateBulk{config: c.config, builders: builders}
+	return &APIQuotaBudgetCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for CronJobConfig.
-func (c *CronJobConfigClient) Update() *CronJobConfigUpdate {
-	mutation := newCronJobConfigMutation(c.config, OpUpdate)
-	return &CronJobConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for APIQuotaBudget.
+func (c *APIQuotaBudgetClient) Update() *APIQuotaBudgetUpdate {
+	mutation := newAPIQuotaBudgetMutation(c.config, OpUpdate)
+	return &APIQuotaBudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CronJobConfigClient) UpdateOne(cjc *CronJobConfig) *CronJobConfigUpdateOne {
-	mutation := newCronJobConfigMutation(c.config, OpUpdateOne, withCronJobConfig(cjc))
-	return &CronJobConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APIQuotaBudgetClient) UpdateOne(aqb *APIQuotaBudget) *APIQuotaBudgetUpdateOne {
+	mutation := newAPIQuotaBudgetMutation(c.config, OpUpdateOne, withAPIQuotaBudget(aqb))
+	return &APIQuotaBudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CronJobConfigClient) UpdateOneID(id ulid.ID) *CronJobConfigUpdateOne {
-	mutation := newCronJobConfigMutation(c.config, OpUpdateOne, withCronJobConfigID(id))
-	return &CronJobConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APIQuotaBudgetClient) UpdateOneID(id ulid.ID) *APIQuotaBudgetUpdateOne {
+	mutation := newAPIQuotaBudgetMutation(c.config, OpUpdateOne, withAPIQuotaBudgetID(id))
+	return &APIQuotaBudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for CronJobConfig.
-func (c *CronJobConfigClient) Delete() *CronJobConfigDelete {
-	mutation := newCronJobConfigMutation(c.config, OpDelete)
-	return &CronJobConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for APIQuotaBudget.
+func (c *APIQuotaBudgetClient) Delete() *APIQuotaBudgetDelete {
+	mutation := newAPIQuotaBudgetMutation(c.config, OpDelete)
+	return &APIQuotaBudgetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CronJobConfigClient) DeleteOne(cjc *CronJobConfig) *CronJobConfigDeleteOne {
-	return c.DeleteOneID(cjc.ID)
+func (c *APIQuotaBudgetClient) DeleteOne(aqb *APIQuotaBudget) *APIQuotaBudgetDeleteOne {
+	return c.DeleteOneID(aqb.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CronJobConfigClient) DeleteOneID(id ulid.ID) *CronJobConfigDeleteOne {
-	builder := c.Delete().Where(cronjobconfig.ID(id))
+func (c *APIQuotaBudgetClient) DeleteOneID(id ulid.ID) *APIQuotaBudgetDeleteOne {
+	builder := c.Delete().Where(apiquotabudget.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CronJobConfigDeleteOne{builder}
+	return &APIQuotaBudgetDeleteOne{builder}
 }
 
-// Query returns a query builder for CronJobConfig.
-func (c *CronJobConfigClient) Query() *CronJobConfigQuery {
-	return &CronJobConfigQuery{
+// Query returns a query builder for APIQuotaBudget.
+func (c *APIQuotaBudgetClient) Query() *APIQuotaBudgetQuery {
+	return &APIQuotaBudgetQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCronJobConfig},
+		ctx:    &QueryContext{Type: TypeAPIQuotaBudget},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a CronJobConfig entity by its id.
-func (c *CronJobConfigClient) Get(ctx context.Context, id ulid.ID) (*CronJobConfig, error) {
-	return c.Query().Where(cronjobconfig.ID(id)).Only(ctx)
+// Get returns a APIQuotaBudget entity by its id.
+func (c *APIQuotaBudgetClient) Get(ctx context.Context, id ulid.ID) (*APIQuotaBudget, error) {
+	return c.Query().Where(apiquotabudget.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CronJobConfigClient) GetX(ctx context.Context, id ulid.ID) *CronJobConfig {
+func (c *APIQuotaBudgetClient) GetX(ctx context.Context, id ulid.ID) *APIQuotaBudget {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -515,131 +609,131 @@ func (c *CronJobConfigClient) GetX(ctx context.Context, id ulid.ID) *CronJobConf
 }
 
 // Hooks returns the client hooks.
-func (c *CronJobConfigClient) Hooks() []Hook {
-	return c.hooks.CronJobConfig
+func (c *APIQuotaBudgetClient) Hooks() []Hook {
+	return c.hooks.APIQuotaBudget
 }
 
 // Interceptors returns the client interceptors.
-func (c *CronJobConfigClient) Interceptors() []Interceptor {
-	return c.inters.CronJobConfig
+func (c *APIQuotaBudgetClient) Interceptors() []Interceptor {
+	return c.inters.APIQuotaBudget
 }
 
-func (c *CronJobConfigClient) mutate(ctx context.Context, m *CronJobConfigMutation) (Value, error) {
+func (c *APIQuotaBudgetClient) mutate(ctx context.Context, m *APIQuotaBudgetMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CronJobConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIQuotaBudgetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CronJobConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIQuotaBudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CronJobConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIQuotaBudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CronJobConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&APIQuotaBudgetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown CronJobConfig mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown APIQuotaBudget mutation op: %q", m.Op())
 	}
 }
 
-// JobExecutionHistoryClient is a client for the JobExecutionHistory schema.
-type JobExecutionHistoryClient struct {
+// APIQuotaReservationClient is a client for the APIQuotaReservation schema.
+type APIQuotaReservationClient struct {
 	config
 }
 
-// NewJobExecutionHistoryClient returns a client for the JobExecutionHistory from the given config.
-func NewJobExecutionHistoryClient(c config) *JobExecutionHistoryClient {
-	return &JobExecutionHistoryClient{config: c}
+// NewAPIQuotaReservationClient returns a client for the APIQuotaReservation from the given config.
+func NewAPIQuotaReservationClient(c config) *APIQuotaReservationClient {
+	return &APIQuotaReservationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `jobexecutionhistory.Hooks(f(g(h())))`.
-func (c *JobExecutionHistoryClient) Use(hooks ...Hook) {
-	c.hooks.JobExecutionHistory = append(c.hooks.JobExecutionHistory, hooks...)
+// A call to `Use(f, g, h)` equals to `apiquotareservation.Hooks(f(g(h())))`.
+func (c *APIQuotaReservationClient) Use(hooks ...Hook) {
+	c.hooks.APIQuotaReservation = append(c.hooks.APIQuotaReservation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `jobexecutionhistory.Intercept(f(g(h())))`.
-func (c *JobExecutionHistoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.JobExecutionHistory = append(c.inters.JobExecutionHistory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `apiquotareservation.Intercept(f(g(h())))`.
+func (c *APIQuotaReservationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIQuotaReservation = append(c.inters.APIQuotaReservation, interceptors...)
 }
 
-// Create returns a builder for creating a JobExecutionHistory entity.
-func (c *JobExecutionHistoryClient) Create() *JobExecutionHistoryCreate {
-	mutation := newJobExecutionHistoryMutation(c.config, OpCreate)
-	return &JobExecutionHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a APIQuotaReservation entity.
+func (c *APIQuotaReservationClient) Create() *APIQuotaReservationCreate {
+	mutation := newAPIQuotaReservationMutation(c.config, OpCreate)
+	return &APIQuotaReservationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of JobExecutionHistory entities.
-func (c *JobExecutionHistoryClient) CreateBulk(builders ...*JobExecutionHistoryCreate) *JobExecutionHistoryCreateBulk {
-	return &JobExecutionHistoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of APIQuotaReservation entities.
+func (c *APIQuotaReservationClient) CreateBulk(builders ...*APIQuotaReservationCreate) *APIQuotaReservationCreateBulk {
+	return &APIQuotaReservationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *JobExecutionHistoryClient) MapCreateBulk(slice any, setFunc func(*JobExecutionHistoryCreate, int)) *JobExecutionHistoryCreateBulk {
+func (c *APIQuotaReservationClient) MapCreateBulk(slice any, setFunc func(*APIQuotaReservationCreate, int)) *APIQuotaReservationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &JobExecutionHistoryCreateBulk{err: fmt.Errorf("calling to JobExecutionHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &APIQuotaReservationCreateBulk{err: fmt.Errorf("calling to APIQuotaReservationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*JobExecutionHistoryCreate, rv.Len())
+	builders := make([]*APIQuotaReservationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &JobExecutionHistoryCreateBulk{config: c.config, builders: builders}
+	return &APIQuotaReservationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for JobExecutionHistory.
-func (c *JobExecutionHistoryClient) Update() *JobExecutionHistoryUpdate {
-	mutation := newJobExecutionHistoryMutation(c.config, OpUpdate)
-	return &JobExecutionHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for APIQuotaReservation.
+func (c *APIQuotaReservationClient) Update() *APIQuotaReservationUpdate {
+	mutation := newAPIQuotaReservationMutation(c.config, OpUpdate)
+	return &APIQuotaReservationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *JobExecutionHistoryClient) UpdateOne(jeh *JobExecutionHistory) *JobExecutionHistoryUpdateOne {
-	mutation := newJobExecutionHistoryMutation(c.config, OpUpdateOne, withJobExecutionHistory(jeh))
-	return &JobExecutionHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APIQuotaReservationClient) UpdateOne(aqr *APIQuotaReservation) *APIQuotaReservationUpdateOne {
+	mutation := newAPIQuotaReservationMutation(c.config, OpUpdateOne, withAPIQuotaReservation(aqr))
+	return &APIQuotaReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *JobExecutionHistoryClient) UpdateOneID(id ulid.ID) *JobExecutionHistoryUpdateOne {
-	mutation := newJobExecutionHistoryMutation(c.config, OpUpdateOne, withJobExecutionHistoryID(id))
-	return &JobExecutionHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APIQuotaReservationClient) UpdateOneID(id ulid.ID) *APIQuotaReservationUpdateOne {
+	mutation := newAPIQuotaReservationMutation(c.config, OpUpdateOne, withAPIQuotaReservationID(id))
+	return &APIQuotaReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for JobExecutionHistory.
-func (c *JobExecutionHistoryClient) Delete() *JobExecutionHistoryDelete {
-	mutation := newJobExecutionHistoryMutation(c.config, OpDelete)
-	return &JobExecutionHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for APIQuotaReservation.
+func (c *APIQuotaReservationClient) Delete() *APIQuotaReservationDelete {
+	mutation := newAPIQuotaReservationMutation(c.config, OpDelete)
+	return &APIQuotaReservationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *JobExecutionHistoryClient) DeleteOne(jeh *JobExecutionHistory) *JobExecutionHistoryDeleteOne {
-	return c.DeleteOneID(jeh.ID)
+func (c *APIQuotaReservationClient) DeleteOne(aqr *APIQuotaReservation) *APIQuotaReservationDeleteOne {
+	return c.DeleteOneID(aqr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *JobExecutionHistoryClient) DeleteOneID(id ulid.ID) *JobExecutionHistoryDeleteOne {
-	builder := c.Delete().Where(jobexecutionhistory.ID(id))
+func (c *APIQuotaReservationClient) DeleteOneID(id ulid.ID) *APIQuotaReservationDeleteOne {
+	builder := c.Delete().Where(apiquotareservation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &JobExecutionHistoryDeleteOne{builder}
+	return &APIQuotaReservationDeleteOne{builder}
 }
 
-// Query returns a query builder for JobExecutionHistory.
-func (c *JobExecutionHistoryClient) Query() *JobExecutionHistoryQuery {
-	return &JobExecutionHistoryQuery{
+// Query returns a query builder for APIQuotaReservation.
+func (c *APIQuotaReservationClient) Query() *APIQuotaReservationQuery {
+	return &APIQuotaReservationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeJobExecutionHistory},
+		ctx:    &QueryContext{Type: TypeAPIQuotaReservation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a JobExecutionHistory entity by its id.
-func (c *JobExecutionHistoryClient) Get(ctx context.Context, id ulid.ID) (*JobExecutionHistory, error) {
-	return c.Query().Where(jobexecutionhistory.ID(id)).Only(ctx)
+// Get returns a APIQuotaReservation entity by its id.
+func (c *APIQuotaReservationClient) Get(ctx context.Context, id ulid.ID) (*APIQuotaReservation, error) {
+	return c.Query().Where(apiquotareservation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *JobExecutionHistoryClient) GetX(ctx context.Context, id ulid.ID) *JobExecutionHistory {
+func (c *APIQuotaReservationClient) GetX(ctx context.Context, id ulid.ID) *APIQuotaReservation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -647,148 +741,1234 @@ func (c *JobExecutionHistoryClient) GetX(ctx context.Context, id ulid.ID) *JobEx
 	return obj
 }
 
-// QueryProfileEntries queries the profile_entries edge of a JobExecutionHistory.
-func (c *JobExecutionHistoryClient) QueryProfileEntries(jeh *JobExecutionHistory) *ProfileEntryQuery {
-	query := (&ProfileEntryClient{config: c.config}).Query()
+// QueryTracker queries the tracker edge of a APIQuotaReservation.
+func (c *APIQuotaReservationClient) QueryTracker(aqr *APIQuotaReservation) *APIQuotaTrackerQuery {
+	query := (&APIQuotaTrackerClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := jeh.ID
+		id := aqr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(jobexecutionhistory.Table, jobexecutionhistory.FieldID, id),
-			sqlgraph.To(profileentry.Table, profileentry.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, jobexecutionhistory.ProfileEntriesTable, jobexecutionhistory.ProfileEntriesPrimaryKey...),
+			sqlgraph.From(apiquotareservation.Table, apiquotareservation.FieldID, id),
+			sqlgraph.To(apiquotatracker.Table, apiquotatracker.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apiquotareservation.TrackerTable, apiquotareservation.TrackerColumn),
 		)
-		fromV = sqlgraph.Neighbors(jeh.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(aqr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBudgetTracker queries the budget_tracker edge of a APIQuotaReservation.
+func (c *APIQuotaReservationClient) QueryBudgetTracker(aqr *APIQuotaReservation) *APIQuotaTrackerQuery {
+	query := (&APIQuotaTrackerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := aqr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apiquotareservation.Table, apiquotareservation.FieldID, id),
+			sqlgraph.To(apiquotatracker.Table, apiquotatracker.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apiquotareservation.BudgetTrackerTable, apiquotareservation.BudgetTrackerColumn),
+		)
+		fromV = sqlgraph.Neighbors(aqr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *JobExecutionHistoryClient) Hooks() []Hook {
-	return c.hooks.JobExecutionHistory
+func (c *APIQuotaReservationClient) Hooks() []Hook {
+	return c.hooks.APIQuotaReservation
 }
 
 // Interceptors returns the client interceptors.
-func (c *JobExecutionHistoryClient) Interceptors() []Interceptor {
-	return c.inters.JobExecutionHistory
+func (c *APIQuotaReservationClient) Interceptors() []Interceptor {
+	return c.inters.APIQuotaReservation
 }
 
-func (c *JobExecutionHistoryClient) mutate(ctx context.Context, m *JobExecutionHistoryMutation) (Value, error) {
+func (c *APIQuotaReservationClient) mutate(ctx context.Context, m *APIQuotaReservationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&JobExecutionHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIQuotaReservationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&JobExecutionHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIQuotaReservationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&JobExecutionHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIQuotaReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&JobExecutionHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&APIQuotaReservationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown JobExecutionHistory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown APIQuotaReservation mutation op: %q", m.Op())
 	}
 }
 
-// ProfileClient is a client for the Profile schema.
-type ProfileClient struct {
+// APIQuotaTrackerClient is a client for the APIQuotaTracker schema.
+type APIQuotaTrackerClient struct {
 	config
 }
 
-// NewProfileClient returns a client for the Profile from the given config.
-func NewProfileClient(c config) *ProfileClient {
-	return &ProfileClient{config: c}
+// NewAPIQuotaTrackerClient returns a client for the APIQuotaTracker from the given config.
+func NewAPIQuotaTrackerClient(c config) *APIQuotaTrackerClient {
+	return &APIQuotaTrackerClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
-func (c *ProfileClient) Use(hooks ...Hook) {
-	c.hooks.Profile = append(c.hooks.Profile, hooks...)
+// A call to `Use(f, g, h)` equals to `apiquotatracker.Hooks(f(g(h())))`.
+func (c *APIQuotaTrackerClient) Use(hooks ...Hook) {
+	c.hooks.APIQuotaTracker = append(c.hooks.APIQuotaTracker, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
-func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Profile = append(c.inters.Profile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `apiquotatracker.Intercept(f(g(h())))`.
+func (c *APIQuotaTrackerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIQuotaTracker = append(c.inters.APIQuotaTracker, interceptors...)
 }
 
-// Create returns a builder for creating a Profile entity.
-func (c *ProfileClient) Create() *ProfileCreate {
-	mutation := newProfileMutation(c.config, OpCreate)
-	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a APIQuotaTracker entity.
+func (c *APIQuotaTrackerClient) Create() *APIQuotaTrackerCreate {
+	mutation := newAPIQuotaTrackerMutation(c.config, OpCreate)
+	return &APIQuotaTrackerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Profile entities.
-func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
-	return &ProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of APIQuotaTracker entities.
+func (c *APIQuotaTrackerClient) CreateBulk(builders ...*APIQuotaTrackerCreate) *APIQuotaTrackerCreateBulk {
+	return &APIQuotaTrackerCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
+func (c *APIQuotaTrackerClient) MapCreateBulk(slice any, setFunc func(*APIQuotaTrackerCreate, int)) *APIQuotaTrackerCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &APIQuotaTrackerCreateBulk{err: fmt.Errorf("calling to APIQuotaTrackerClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProfileCreate, rv.Len())
+	builders := make([]*APIQuotaTrackerCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProfileCreateBulk{config: c.config, builders: builders}
+	return &APIQuotaTrackerCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Profile.
-func (c *ProfileClient) Update() *ProfileUpdate {
-	mutation := newProfileMutation(c.config, OpUpdate)
-	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for APIQuotaTracker.
+func (c *APIQuotaTrackerClient) Update() *APIQuotaTrackerUpdate {
+	mutation := newAPIQuotaTrackerMutation(c.config, OpUpdate)
+	return &APIQuotaTrackerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProfileClient) UpdateOne(pr *Profile) *ProfileUpdateOne {
+func (c *APIQuotaTrackerClient) UpdateOne(aqt *APIQuotaTracker) *APIQuotaTrackerUpdateOne {
+	mutation := newAPIQuotaTrackerMutation(c.config, OpUpdateOne, withAPIQuotaTracker(aqt))
+	return &APIQuotaTrackerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIQuotaTrackerClient) UpdateOneID(id ulid.ID) *APIQuotaTrackerUpdateOne {
+	mutation := newAPIQuotaTrackerMutation(c.config, OpUpdateOne, withAPIQuotaTrackerID(id))
+	return &APIQuotaTrackerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIQuotaTracker.
+func (c *APIQuotaTrackerClient) Delete() *APIQuotaTrackerDelete {
+	mutation := newAPIQuotaTrackerMutation(c.config, OpDelete)
+	return &APIQuotaTrackerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIQuotaTrackerClient) DeleteOne(aqt *APIQuotaTracker) *APIQuotaTrackerDeleteOne {
+	return c.DeleteOneID(aqt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIQuotaTrackerClient) DeleteOneID(id ulid.ID) *APIQuotaTrackerDeleteOne {
+	builder := c.Delete().Where(apiquotatracker.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIQuotaTrackerDeleteOne{builder}
+}
+
+// Query returns a query builder for APIQuotaTracker.
+func (c *APIQuotaTrackerClient) Query() *APIQuotaTrackerQuery {
+	return &APIQuotaTrackerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIQuotaTracker},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIQuotaTracker entity by its id.
+func (c *APIQuotaTrackerClient) Get(ctx context.Context, id ulid.ID) (*APIQuotaTracker, error) {
+	return c.Query().Where(apiquotatracker.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIQuotaTrackerClient) GetX(ctx context.Context, id ulid.ID) *APIQuotaTracker {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReservations queries the reservations edge of a APIQuotaTracker.
+func (c *APIQuotaTrackerClient) QueryReservations(aqt *APIQuotaTracker) *APIQuotaReservationQuery {
+	query := (&APIQuotaReservationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := aqt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apiquotatracker.Table, apiquotatracker.FieldID, id),
+			sqlgraph.To(apiquotareservation.Table, apiquotareservation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, apiquotatracker.ReservationsTable, apiquotatracker.ReservationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(aqt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBudgetReservations queries the budget_reservations edge of a APIQuotaTracker.
+func (c *APIQuotaTrackerClient) QueryBudgetReservations(aqt *APIQuotaTracker) *APIQuotaReservationQuery {
+	query := (&APIQuotaReservationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := aqt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apiquotatracker.Table, apiquotatracker.FieldID, id),
+			sqlgraph.To(apiquotareservation.Table, apiquotareservation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, apiquotatracker.BudgetReservationsTable, apiquotatracker.BudgetReservationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(aqt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *APIQuotaTrackerClient) Hooks() []Hook {
+	return c.hooks.APIQuotaTracker
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIQuotaTrackerClient) Interceptors() []Interceptor {
+	return c.inters.APIQuotaTracker
+}
+
+func (c *APIQuotaTrackerClient) mutate(ctx context.Context, m *APIQuotaTrackerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIQuotaTrackerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIQuotaTrackerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIQuotaTrackerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIQuotaTrackerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIQuotaTracker mutation op: %q", m.Op())
+	}
+}
+
+// CompanyClient is a client for the Company schema.
+type CompanyClient struct {
+	config
+}
+
+// NewCompanyClient returns a client for the Company from the given config.
+func NewCompanyClient(c config) *CompanyClient {
+	return &CompanyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `company.Hooks(f(g(h())))`.
+func (c *CompanyClient) Use(hooks ...Hook) {
+	c.hooks.Company = append(c.hooks.Company, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `company.Intercept(f(g(h())))`.
+func (c *CompanyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Company = append(c.inters.Company, interceptors...)
+}
+
+// Create returns a builder for creating a Company entity.
+func (c *CompanyClient) Create() *CompanyCreate {
+	mutation := newCompanyMutation(c.config, OpCreate)
+	return &CompanyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Company entities.
+func (c *CompanyClient) CreateBulk(builders ...*CompanyCreate) *CompanyCreateBulk {
+	return &CompanyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CompanyClient) MapCreateBulk(slice any, setFunc func(*CompanyCreate, int)) *CompanyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CompanyCreateBulk{err: fmt.Errorf("calling to CompanyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CompanyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CompanyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Company.
+func (c *CompanyClient) Update() *CompanyUpdate {
+	mutation := newCompanyMutation(c.config, OpUpdate)
+	return &CompanyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CompanyClient) UpdateOne(co *Company) *CompanyUpdateOne {
+	mutation := newCompanyMutation(c.config, OpUpdateOne, withCompany(co))
+	return &CompanyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CompanyClient) UpdateOneID(id ulid.ID) *CompanyUpdateOne {
+	mutation := newCompanyMutation(c.config, OpUpdateOne, withCompanyID(id))
+	return &CompanyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Company.
+func (c *CompanyClient) Delete() *CompanyDelete {
+	mutation := newCompanyMutation(c.config, OpDelete)
+	return &CompanyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CompanyClient) DeleteOne(co *Company) *CompanyDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CompanyClient) DeleteOneID(id ulid.ID) *CompanyDeleteOne {
+	builder := c.Delete().Where(company.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CompanyDeleteOne{builder}
+}
+
+// Query returns a query builder for Company.
+func (c *CompanyClient) Query() *CompanyQuery {
+	return &CompanyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCompany},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Company entity by its id.
+func (c *CompanyClient) Get(ctx context.Context, id ulid.ID) (*Company, error) {
+	return c.Query().Where(company.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CompanyClient) GetX(ctx context.Context, id ulid.ID) *Company {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPositions queries the positions edge of a Company.
+func (c *CompanyClient) QueryPositions(co *Company) *ProfilePositionQuery {
+	query := (&ProfilePositionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, id),
+			sqlgraph.To(profileposition.Table, profileposition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, company.PositionsTable, company.PositionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCurrentEmployees queries the current_employees edge of a Company.
+func (c *CompanyClient) QueryCurrentEmployees(co *Company) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, company.CurrentEmployeesTable, company.CurrentEmployeesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAlumni queries the alumni edge of a Company.
+func (c *CompanyClient) QueryAlumni(co *Company) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, company.AlumniTable, company.AlumniPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CompanyClient) Hooks() []Hook {
+	return c.hooks.Company
+}
+
+// Interceptors returns the client interceptors.
+func (c *CompanyClient) Interceptors() []Interceptor {
+	return c.inters.Company
+}
+
+func (c *CompanyClient) mutate(ctx context.Context, m *CompanyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CompanyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CompanyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CompanyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CompanyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Company mutation op: %q", m.Op())
+	}
+}
+
+// CronJobConfigClient is a client for the CronJobConfig schema.
+type CronJobConfigClient struct {
+	config
+}
+
+// NewCronJobConfigClient returns a client for the CronJobConfig from the given config.
+func NewCronJobConfigClient(c config) *CronJobConfigClient {
+	return &CronJobConfigClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `cronjobconfig.Hooks(f(g(h())))`.
+func (c *CronJobConfigClient) Use(hooks ...Hook) {
+	c.hooks.CronJobConfig = append(c.hooks.CronJobConfig, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `cronjobconfig.Intercept(f(g(h())))`.
+func (c *CronJobConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CronJobConfig = append(c.inters.CronJobConfig, interceptors...)
+}
+
+// Create returns a builder for creating a CronJobConfig entity.
+func (c *CronJobConfigClient) Create() *CronJobConfigCreate {
+	mutation := newCronJobConfigMutation(c.config, OpCreate)
+	return &CronJobConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CronJobConfig entities.
+func (c *CronJobConfigClient) CreateBulk(builders ...*CronJobConfigCreate) *CronJobConfigCreateBulk {
+	return &CronJobConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CronJobConfigClient) MapCreateBulk(slice any, setFunc func(*CronJobConfigCreate, int)) *CronJobConfigCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CronJobConfigCreateBulk{err: fmt.Errorf("calling to CronJobConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CronJobConfigCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CronJobConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CronJobConfig.
+func (c *CronJobConfigClient) Update() *CronJobConfigUpdate {
+	mutation := newCronJobConfigMutation(c.config, OpUpdate)
+	return &CronJobConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CronJobConfigClient) UpdateOne(cjc *CronJobConfig) *CronJobConfigUpdateOne {
+	mutation := newCronJobConfigMutation(c.config, OpUpdateOne, withCronJobConfig(cjc))
+	return &CronJobConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CronJobConfigClient) UpdateOneID(id ulid.ID) *CronJobConfigUpdateOne {
+	mutation := newCronJobConfigMutation(c.config, OpUpdateOne, withCronJobConfigID(id))
+	return &CronJobConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CronJobConfig.
+func (c *CronJobConfigClient) Delete() *CronJobConfigDelete {
+	mutation := newCronJobConfigMutation(c.config, OpDelete)
+	return &CronJobConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CronJobConfigClient) DeleteOne(cjc *CronJobConfig) *CronJobConfigDeleteOne {
+	return c.DeleteOneID(cjc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CronJobConfigClient) DeleteOneID(id ulid.ID) *CronJobConfigDeleteOne {
+	builder := c.Delete().Where(cronjobconfig.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CronJobConfigDeleteOne{builder}
+}
+
+// Query returns a query builder for CronJobConfig.
+func (c *CronJobConfigClient) Query() *CronJobConfigQuery {
+	return &CronJobConfigQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCronJobConfig},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CronJobConfig entity by its id.
+func (c *CronJobConfigClient) Get(ctx context.Context, id ulid.ID) (*CronJobConfig, error) {
+	return c.Query().Where(cronjobconfig.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CronJobConfigClient) GetX(ctx context.Context, id ulid.ID) *CronJobConfig {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CronJobConfigClient) Hooks() []Hook {
+	return c.hooks.CronJobConfig
+}
+
+// Interceptors returns the client interceptors.
+func (c *CronJobConfigClient) Interceptors() []Interceptor {
+	return c.inters.CronJobConfig
+}
+
+func (c *CronJobConfigClient) mutate(ctx context.Context, m *CronJobConfigMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CronJobConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CronJobConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CronJobConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CronJobConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CronJobConfig mutation op: %q", m.Op())
+	}
+}
+
+// JobExecutionHistoryClient is a client for the JobExecutionHistory schema.
+type JobExecutionHistoryClient struct {
+	config
+}
+
+// NewJobExecutionHistoryClient returns a client for the JobExecutionHistory from the given config.
+func NewJobExecutionHistoryClient(c config) *JobExecutionHistoryClient {
+	return &JobExecutionHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobexecutionhistory.Hooks(f(g(h())))`.
+func (c *JobExecutionHistoryClient) Use(hooks ...Hook) {
+	c.hooks.JobExecutionHistory = append(c.hooks.JobExecutionHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jobexecutionhistory.Intercept(f(g(h())))`.
+func (c *JobExecutionHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobExecutionHistory = append(c.inters.JobExecutionHistory, interceptors...)
+}
+
+// Create returns a builder for creating a JobExecutionHistory entity.
+func (c *JobExecutionHistoryClient) Create() *JobExecutionHistoryCreate {
+	mutation := newJobExecutionHistoryMutation(c.config, OpCreate)
+	return &JobExecutionHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobExecutionHistory entities.
+func (c *JobExecutionHistoryClient) CreateBulk(builders ...*JobExecutionHistoryCreate) *JobExecutionHistoryCreateBulk {
+	return &JobExecutionHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobExecutionHistoryClient) MapCreateBulk(slice any, setFunc func(*JobExecutionHistoryCreate, int)) *JobExecutionHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobExecutionHistoryCreateBulk{err: fmt.Errorf("calling to JobExecutionHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobExecutionHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobExecutionHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobExecutionHistory.
+func (c *JobExecutionHistoryClient) Update() *JobExecutionHistoryUpdate {
+	mutation := newJobExecutionHistoryMutation(c.config, OpUpdate)
+	return &JobExecutionHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobExecutionHistoryClient) UpdateOne(jeh *JobExecutionHistory) *JobExecutionHistoryUpdateOne {
+	mutation := newJobExecutionHistoryMutation(c.config, OpUpdateOne, withJobExecutionHistory(jeh))
+	return &JobExecutionHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobExecutionHistoryClient) UpdateOneID(id ulid.ID) *JobExecutionHistoryUpdateOne {
+	mutation := newJobExecutionHistoryMutation(c.config, OpUpdateOne, withJobExecutionHistoryID(id))
+	return &JobExecutionHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobExecutionHistory.
+func (c *JobExecutionHistoryClient) Delete() *JobExecutionHistoryDelete {
+	mutation := newJobExecutionHistoryMutation(c.config, OpDelete)
+	return &JobExecutionHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobExecutionHistoryClient) DeleteOne(jeh *JobExecutionHistory) *JobExecutionHistoryDeleteOne {
+	return c.DeleteOneID(jeh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobExecutionHistoryClient) DeleteOneID(id ulid.ID) *JobExecutionHistoryDeleteOne {
+	builder := c.Delete().Where(jobexecutionhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobExecutionHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for JobExecutionHistory.
+func (c *JobExecutionHistoryClient) Query() *JobExecutionHistoryQuery {
+	return &JobExecutionHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobExecutionHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobExecutionHistory entity by its id.
+func (c *JobExecutionHistoryClient) Get(ctx context.Context, id ulid.ID) (*JobExecutionHistory, error) {
+	return c.Query().Where(jobexecutionhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobExecutionHistoryClient) GetX(ctx context.Context, id ulid.ID) *JobExecutionHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfileEntries queries the profile_entries edge of a JobExecutionHistory.
+func (c *JobExecutionHistoryClient) QueryProfileEntries(jeh *JobExecutionHistory) *ProfileEntryQuery {
+	query := (&ProfileEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := jeh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(jobexecutionhistory.Table, jobexecutionhistory.FieldID, id),
+			sqlgraph.To(profileentry.Table, profileentry.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, jobexecutionhistory.ProfileEntriesTable, jobexecutionhistory.ProfileEntriesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(jeh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPICalls queries the api_calls edge of a JobExecutionHistory.
+func (c *JobExecutionHistoryClient) QueryAPICalls(jeh *JobExecutionHistory) *APICallLogQuery {
+	query := (&APICallLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := jeh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(jobexecutionhistory.Table, jobexecutionhistory.FieldID, id),
+			sqlgraph.To(apicalllog.Table, apicalllog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, jobexecutionhistory.APICallsTable, jobexecutionhistory.APICallsColumn),
+		)
+		fromV = sqlgraph.Neighbors(jeh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *JobExecutionHistoryClient) Hooks() []Hook {
+	return c.hooks.JobExecutionHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobExecutionHistoryClient) Interceptors() []Interceptor {
+	return c.inters.JobExecutionHistory
+}
+
+func (c *JobExecutionHistoryClient) mutate(ctx context.Context, m *JobExecutionHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobExecutionHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobExecutionHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobExecutionHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobExecutionHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JobExecutionHistory mutation op: %q", m.Op())
+	}
+}
+
+// ProfileClient is a client for the Profile schema.
+type ProfileClient struct {
+	config
+}
+
+// NewProfileClient returns a client for the Profile from the given config.
+func NewProfileClient(c config) *ProfileClient {
+	return &ProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
+func (c *ProfileClient) Use(hooks ...Hook) {
+	c.hooks.Profile = append(c.hooks.Profile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
+func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Profile = append(c.inters.Profile, interceptors...)
+}
+
+// Create returns a builder for creating a Profile entity.
+func (c *ProfileClient) Create() *ProfileCreate {
+	mutation := newProfileMutation(c.config, OpCreate)
+	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Profile entities.
+func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Profile.
+func (c *ProfileClient) Update() *ProfileUpdate {
+	mutation := newProfileMutation(c.config, OpUpdate)
+	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileClient) UpdateOne(pr *Profile) *ProfileUpdateOne {
 	mutation := newProfileMutation(c.config, OpUpdateOne, withProfile(pr))
 	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProfileClient) UpdateOneID(id ulid.ID) *ProfileUpdateOne {
-	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
-	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProfileClient) UpdateOneID(id ulid.ID) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Profile.
+func (c *ProfileClient) Delete() *ProfileDelete {
+	mutation := newProfileMutation(c.config, OpDelete)
+	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProfileClient) DeleteOne(pr *Profile) *ProfileDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProfileClient) DeleteOneID(id ulid.ID) *ProfileDeleteOne {
+	builder := c.Delete().Where(profile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for Profile.
+func (c *ProfileClient) Query() *ProfileQuery {
+	return &ProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Profile entity by its id.
+func (c *ProfileClient) Get(ctx context.Context, id ulid.ID) (*Profile, error) {
+	return c.Query().Where(profile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProfileClient) GetX(ctx context.Context, id ulid.ID) *Profile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfileEntry queries the profile_entry edge of a Profile.
+func (c *ProfileClient) QueryProfileEntry(pr *Profile) *ProfileEntryQuery {
+	query := (&ProfileEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(profileentry.Table, profileentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, profile.ProfileEntryTable, profile.ProfileEntryColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySnapshots queries the snapshots edge of a Profile.
+func (c *ProfileClient) QuerySnapshots(pr *Profile) *ProfileSnapshotQuery {
+	query := (&ProfileSnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(profilesnapshot.Table, profilesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.SnapshotsTable, profile.SnapshotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChangeEvents queries the change_events edge of a Profile.
+func (c *ProfileClient) QueryChangeEvents(pr *Profile) *ProfileChangeEventQuery {
+	query := (&ProfileChangeEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(profilechangeevent.Table, profilechangeevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ChangeEventsTable, profile.ChangeEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProfilePositions queries the profile_positions edge of a Profile.
+func (c *ProfileClient) QueryProfilePositions(pr *Profile) *ProfilePositionQuery {
+	query := (&ProfilePositionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(profileposition.Table, profileposition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ProfilePositionsTable, profile.ProfilePositionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProfileEducations queries the profile_educations edge of a Profile.
+func (c *ProfileClient) QueryProfileEducations(pr *Profile) *ProfileEducationQuery {
+	query := (&ProfileEducationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(profileeducation.Table, profileeducation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ProfileEducationsTable, profile.ProfileEducationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProfileSkills queries the profile_skills edge of a Profile.
+func (c *ProfileClient) QueryProfileSkills(pr *Profile) *ProfileSkillQuery {
+	query := (&ProfileSkillClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(profileskill.Table, profileskill.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ProfileSkillsTable, profile.ProfileSkillsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCurrentCompanies queries the current_companies edge of a Profile.
+func (c *ProfileClient) QueryCurrentCompanies(pr *Profile) *CompanyQuery {
+	query := (&CompanyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, profile.CurrentCompaniesTable, profile.CurrentCompaniesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPastCompanies queries the past_companies edge of a Profile.
+func (c *ProfileClient) QueryPastCompanies(pr *Profile) *CompanyQuery {
+	query := (&CompanyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, profile.PastCompaniesTable, profile.PastCompaniesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProfileClient) Hooks() []Hook {
+	return c.hooks.Profile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProfileClient) Interceptors() []Interceptor {
+	return c.inters.Profile
+}
+
+func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Profile mutation op: %q", m.Op())
+	}
+}
+
+// ProfileChangeEventClient is a client for the ProfileChangeEvent schema.
+type ProfileChangeEventClient struct {
+	config
+}
+
+// NewProfileChangeEventClient returns a client for the ProfileChangeEvent from the given config.
+func NewProfileChangeEventClient(c config) *ProfileChangeEventClient {
+	return &ProfileChangeEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profilechangeevent.Hooks(f(g(h())))`.
+func (c *ProfileChangeEventClient) Use(hooks ...Hook) {
+	c.hooks.ProfileChangeEvent = append(c.hooks.ProfileChangeEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profilechangeevent.Intercept(f(g(h())))`.
+func (c *ProfileChangeEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProfileChangeEvent = append(c.inters.ProfileChangeEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ProfileChangeEvent entity.
+func (c *ProfileChangeEventClient) Create() *ProfileChangeEventCreate {
+	mutation := newProfileChangeEventMutation(c.config, OpCreate)
+	return &ProfileChangeEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProfileChangeEvent entities.
+func (c *ProfileChangeEventClient) CreateBulk(builders ...*ProfileChangeEventCreate) *ProfileChangeEventCreateBulk {
+	return &ProfileChangeEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileChangeEventClient) MapCreateBulk(slice any, setFunc func(*ProfileChangeEventCreate, int)) *ProfileChangeEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileChangeEventCreateBulk{err: fmt.Errorf("calling to ProfileChangeEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileChangeEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileChangeEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProfileChangeEvent.
+func (c *ProfileChangeEventClient) Update() *ProfileChangeEventUpdate {
+	mutation := newProfileChangeEventMutation(c.config, OpUpdate)
+	return &ProfileChangeEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileChangeEventClient) UpdateOne(pce *ProfileChangeEvent) *ProfileChangeEventUpdateOne {
+	mutation := newProfileChangeEventMutation(c.config, OpUpdateOne, withProfileChangeEvent(pce))
+	return &ProfileChangeEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProfileChangeEventClient) UpdateOneID(id ulid.ID) *ProfileChangeEventUpdateOne {
+	mutation := newProfileChangeEventMutation(c.config, OpUpdateOne, withProfileChangeEventID(id))
+	return &ProfileChangeEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProfileChangeEvent.
+func (c *ProfileChangeEventClient) Delete() *ProfileChangeEventDelete {
+	mutation := newProfileChangeEventMutation(c.config, OpDelete)
+	return &ProfileChangeEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProfileChangeEventClient) DeleteOne(pce *ProfileChangeEvent) *ProfileChangeEventDeleteOne {
+	return c.DeleteOneID(pce.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProfileChangeEventClient) DeleteOneID(id ulid.ID) *ProfileChangeEventDeleteOne {
+	builder := c.Delete().Where(profilechangeevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProfileChangeEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ProfileChangeEvent.
+func (c *ProfileChangeEventClient) Query() *ProfileChangeEventQuery {
+	return &ProfileChangeEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProfileChangeEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProfileChangeEvent entity by its id.
+func (c *ProfileChangeEventClient) Get(ctx context.Context, id ulid.ID) (*ProfileChangeEvent, error) {
+	return c.Query().Where(profilechangeevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProfileChangeEventClient) GetX(ctx context.Context, id ulid.ID) *ProfileChangeEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a ProfileChangeEvent.
+func (c *ProfileChangeEventClient) QueryProfile(pce *ProfileChangeEvent) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pce.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profilechangeevent.Table, profilechangeevent.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profilechangeevent.ProfileTable, profilechangeevent.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(pce.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySnapshot queries the snapshot edge of a ProfileChangeEvent.
+func (c *ProfileChangeEventClient) QuerySnapshot(pce *ProfileChangeEvent) *ProfileSnapshotQuery {
+	query := (&ProfileSnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pce.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profilechangeevent.Table, profilechangeevent.FieldID, id),
+			sqlgraph.To(profilesnapshot.Table, profilesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profilechangeevent.SnapshotTable, profilechangeevent.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(pce.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProfileChangeEventClient) Hooks() []Hook {
+	return c.hooks.ProfileChangeEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProfileChangeEventClient) Interceptors() []Interceptor {
+	return c.inters.ProfileChangeEvent
+}
+
+func (c *ProfileChangeEventClient) mutate(ctx context.Context, m *ProfileChangeEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProfileChangeEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProfileChangeEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProfileChangeEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProfileChangeEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProfileChangeEvent mutation op: %q", m.Op())
+	}
+}
+
+// ProfileEducationClient is a client for the ProfileEducation schema.
+type ProfileEducationClient struct {
+	config
+}
+
+// NewProfileEducationClient returns a client for the ProfileEducation from the given config.
+func NewProfileEducationClient(c config) *ProfileEducationClient {
+	return &ProfileEducationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profileeducation.Hooks(f(g(h())))`.
+func (c *ProfileEducationClient) Use(hooks ...Hook) {
+	c.hooks.ProfileEducation = append(c.hooks.ProfileEducation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profileeducation.Intercept(f(g(h())))`.
+func (c *ProfileEducationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProfileEducation = append(c.inters.ProfileEducation, interceptors...)
+}
+
+// Create returns a builder for creating a ProfileEducation entity.
+func (c *ProfileEducationClient) Create() *ProfileEducationCreate {
+	mutation := newProfileEducationMutation(c.config, OpCreate)
+	return &ProfileEducationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProfileEducation entities.
+func (c *ProfileEducationClient) CreateBulk(builders ...*ProfileEducationCreate) *ProfileEducationCreateBulk {
+	return &ProfileEducationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileEducationClient) MapCreateBulk(slice any, setFunc func(*ProfileEducationCreate, int)) *ProfileEducationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileEducationCreateBulk{err: fmt.Errorf("calling to ProfileEducationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileEducationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileEducationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProfileEducation.
+func (c *ProfileEducationClient) Update() *ProfileEducationUpdate {
+	mutation := newProfileEducationMutation(c.config, OpUpdate)
+	return &ProfileEducationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileEducationClient) UpdateOne(pe *ProfileEducation) *ProfileEducationUpdateOne {
+	mutation := newProfileEducationMutation(c.config, OpUpdateOne, withProfileEducation(pe))
+	return &ProfileEducationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProfileEducationClient) UpdateOneID(id ulid.ID) *ProfileEducationUpdateOne {
+	mutation := newProfileEducationMutation(c.config, OpUpdateOne, withProfileEducationID(id))
+	return &ProfileEducationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Profile.
-func (c *ProfileClient) Delete() *ProfileDelete {
-	mutation := newProfileMutation(c.config, OpDelete)
-	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProfileEducation.
+func (c *ProfileEducationClient) Delete() *ProfileEducationDelete {
+	mutation := newProfileEducationMutation(c.config, OpDelete)
+	return &ProfileEducationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProfileClient) DeleteOne(pr *Profile) *ProfileDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *ProfileEducationClient) DeleteOne(pe *ProfileEducation) *ProfileEducationDeleteOne {
+	return c.DeleteOneID(pe.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProfileClient) DeleteOneID(id ulid.ID) *ProfileDeleteOne {
-	builder := c.Delete().Where(profile.ID(id))
+func (c *ProfileEducationClient) DeleteOneID(id ulid.ID) *ProfileEducationDeleteOne {
+	builder := c.Delete().Where(profileeducation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProfileDeleteOne{builder}
+	return &ProfileEducationDeleteOne{builder}
 }
 
-// Query returns a query builder for Profile.
-func (c *ProfileClient) Query() *ProfileQuery {
-	return &ProfileQuery{
+// Query returns a query builder for ProfileEducation.
+func (c *ProfileEducationClient) Query() *ProfileEducationQuery {
+	return &ProfileEducationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProfile},
+		ctx:    &QueryContext{Type: TypeProfileEducation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Profile entity by its id.
-func (c *ProfileClient) Get(ctx context.Context, id ulid.ID) (*Profile, error) {
-	return c.Query().Where(profile.ID(id)).Only(ctx)
+// Get returns a ProfileEducation entity by its id.
+func (c *ProfileEducationClient) Get(ctx context.Context, id ulid.ID) (*ProfileEducation, error) {
+	return c.Query().Where(profileeducation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProfileClient) GetX(ctx context.Context, id ulid.ID) *Profile {
+func (c *ProfileEducationClient) GetX(ctx context.Context, id ulid.ID) *ProfileEducation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -796,148 +1976,313 @@ func (c *ProfileClient) GetX(ctx context.Context, id ulid.ID) *Profile {
 	return obj
 }
 
-// QueryProfileEntry queries the profile_entry edge of a Profile.
-func (c *ProfileClient) QueryProfileEntry(pr *Profile) *ProfileEntryQuery {
-	query := (&ProfileEntryClient{config: c.config}).Query()
+// QueryProfile queries the profile edge of a ProfileEducation.
+func (c *ProfileEducationClient) QueryProfile(pe *ProfileEducation) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := pe.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(profileentry.Table, profileentry.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, profile.ProfileEntryTable, profile.ProfileEntryColumn),
+			sqlgraph.From(profileeducation.Table, profileeducation.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profileeducation.ProfileTable, profileeducation.ProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProfileClient) Hooks() []Hook {
-	return c.hooks.Profile
+func (c *ProfileEducationClient) Hooks() []Hook {
+	return c.hooks.ProfileEducation
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProfileClient) Interceptors() []Interceptor {
-	return c.inters.Profile
+func (c *ProfileEducationClient) Interceptors() []Interceptor {
+	return c.inters.ProfileEducation
 }
 
-func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
+func (c *ProfileEducationClient) mutate(ctx context.Context, m *ProfileEducationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfileEducationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfileEducationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfileEducationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProfileEducationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProfileEducation mutation op: %q", m.Op())
+	}
+}
+
+// ProfileEntryClient is a client for the ProfileEntry schema.
+type ProfileEntryClient struct {
+	config
+}
+
+// NewProfileEntryClient returns a client for the ProfileEntry from the given config.
+func NewProfileEntryClient(c config) *ProfileEntryClient {
+	return &ProfileEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profileentry.Hooks(f(g(h())))`.
+func (c *ProfileEntryClient) Use(hooks ...Hook) {
+	c.hooks.ProfileEntry = append(c.hooks.ProfileEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profileentry.Intercept(f(g(h())))`.
+func (c *ProfileEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProfileEntry = append(c.inters.ProfileEntry, interceptors...)
+}
+
+// Create returns a builder for creating a ProfileEntry entity.
+func (c *ProfileEntryClient) Create() *ProfileEntryCreate {
+	mutation := newProfileEntryMutation(c.config, OpCreate)
+	return &ProfileEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProfileEntry entities.
+func (c *ProfileEntryClient) CreateBulk(builders ...*ProfileEntryCreate) *ProfileEntryCreateBulk {
+	return &ProfileEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileEntryClient) MapCreateBulk(slice any, setFunc func(*ProfileEntryCreate, int)) *ProfileEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileEntryCreateBulk{err: fmt.Errorf("calling to ProfileEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProfileEntry.
+func (c *ProfileEntryClient) Update() *ProfileEntryUpdate {
+	mutation := newProfileEntryMutation(c.config, OpUpdate)
+	return &ProfileEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileEntryClient) UpdateOne(pe *ProfileEntry) *ProfileEntryUpdateOne {
+	mutation := newProfileEntryMutation(c.config, OpUpdateOne, withProfileEntry(pe))
+	return &ProfileEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProfileEntryClient) UpdateOneID(id ulid.ID) *ProfileEntryUpdateOne {
+	mutation := newProfileEntryMutation(c.config, OpUpdateOne, withProfileEntryID(id))
+	return &ProfileEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProfileEntry.
+func (c *ProfileEntryClient) Delete() *ProfileEntryDelete {
+	mutation := newProfileEntryMutation(c.config, OpDelete)
+	return &ProfileEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProfileEntryClient) DeleteOne(pe *ProfileEntry) *ProfileEntryDeleteOne {
+	return c.DeleteOneID(pe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProfileEntryClient) DeleteOneID(id ulid.ID) *ProfileEntryDeleteOne {
+	builder := c.Delete().Where(profileentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProfileEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for ProfileEntry.
+func (c *ProfileEntryClient) Query() *ProfileEntryQuery {
+	return &ProfileEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProfileEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProfileEntry entity by its id.
+func (c *ProfileEntryClient) Get(ctx context.Context, id ulid.ID) (*ProfileEntry, error) {
+	return c.Query().Where(profileentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProfileEntryClient) GetX(ctx context.Context, id ulid.ID) *ProfileEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a ProfileEntry.
+func (c *ProfileEntryClient) QueryProfile(pe *ProfileEntry) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profileentry.Table, profileentry.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, profileentry.ProfileTable, profileentry.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJobExecutions queries the job_executions edge of a ProfileEntry.
+func (c *ProfileEntryClient) QueryJobExecutions(pe *ProfileEntry) *JobExecutionHistoryQuery {
+	query := (&JobExecutionHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profileentry.Table, profileentry.FieldID, id),
+			sqlgraph.To(jobexecutionhistory.Table, jobexecutionhistory.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, profileentry.JobExecutionsTable, profileentry.JobExecutionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProfileEntryClient) Hooks() []Hook {
+	return c.hooks.ProfileEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProfileEntryClient) Interceptors() []Interceptor {
+	return c.inters.ProfileEntry
+}
+
+func (c *ProfileEntryClient) mutate(ctx context.Context, m *ProfileEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProfileEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProfileEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProfileEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProfileEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Profile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProfileEntry mutation op: %q", m.Op())
 	}
 }
 
-// ProfileEntryClient is a client for the ProfileEntry schema.
-type ProfileEntryClient struct {
+// ProfilePositionClient is a client for the ProfilePosition schema.
+type ProfilePositionClient struct {
 	config
 }
 
-// NewProfileEntryClient returns a client for the ProfileEntry from the given config.
-func NewProfileEntryClient(c config) *ProfileEntryClient {
-	return &ProfileEntryClient{config: c}
+// NewProfilePositionClient returns a client for the ProfilePosition from the given config.
+func NewProfilePositionClient(c config) *ProfilePositionClient {
+	return &ProfilePositionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `profileentry.Hooks(f(g(h())))`.
-func (c *ProfileEntryClient) Use(hooks ...Hook) {
-	c.hooks.ProfileEntry = append(c.hooks.ProfileEntry, hooks...)
+// A call to `Use(f, g, h)` equals to `profileposition.Hooks(f(g(h())))`.
+func (c *ProfilePositionClient) Use(hooks ...Hook) {
+	c.hooks.ProfilePosition = append(c.hooks.ProfilePosition, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `profileentry.Intercept(f(g(h())))`.
-func (c *ProfileEntryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProfileEntry = append(c.inters.ProfileEntry, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `profileposition.Intercept(f(g(h())))`.
+func (c *ProfilePositionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProfilePosition = append(c.inters.ProfilePosition, interceptors...)
 }
 
-// Create returns a builder for creating a ProfileEntry entity.
-func (c *ProfileEntryClient) Create() *ProfileEntryCreate {
-	mutation := newProfileEntryMutation(c.config, OpCreate)
-	return &ProfileEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProfilePosition entity.
+func (c *ProfilePositionClient) Create() *ProfilePositionCreate {
+	mutation := newProfilePositionMutation(c.config, OpCreate)
+	return &ProfilePositionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProfileEntry entities.
-func (c *ProfileEntryClient) CreateBulk(builders ...*ProfileEntryCreate) *ProfileEntryCreateBulk {
-	return &ProfileEntryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProfilePosition entities.
+func (c *ProfilePositionClient) CreateBulk(builders ...*ProfilePositionCreate) *ProfilePositionCreateBulk {
+	return &ProfilePositionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProfileEntryClient) MapCreateBulk(slice any, setFunc func(*ProfileEntryCreate, int)) *ProfileEntryCreateBulk {
+func (c *ProfilePositionClient) MapCreateBulk(slice any, setFunc func(*ProfilePositionCreate, int)) *ProfilePositionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProfileEntryCreateBulk{err: fmt.Errorf("calling to ProfileEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProfilePositionCreateBulk{err: fmt.Errorf("calling to ProfilePositionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProfileEntryCreate, rv.Len())
+	builders := make([]*ProfilePositionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProfileEntryCreateBulk{config: c.config, builders: builders}
+	return &ProfilePositionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProfileEntry.
-func (c *ProfileEntryClient) Update() *ProfileEntryUpdate {
-	mutation := newProfileEntryMutation(c.config, OpUpdate)
-	return &ProfileEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProfilePosition.
+func (c *ProfilePositionClient) Update() *ProfilePositionUpdate {
+	mutation := newProfilePositionMutation(c.config, OpUpdate)
+	return &ProfilePositionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProfileEntryClient) UpdateOne(pe *ProfileEntry) *ProfileEntryUpdateOne {
-	mutation := newProfileEntryMutation(c.config, OpUpdateOne, withProfileEntry(pe))
-	return &ProfileEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProfilePositionClient) UpdateOne(pp *ProfilePosition) *ProfilePositionUpdateOne {
+	mutation := newProfilePositionMutation(c.config, OpUpdateOne, withProfilePosition(pp))
+	return &ProfilePositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProfileEntryClient) UpdateOneID(id ulid.ID) *ProfileEntryUpdateOne {
-	mutation := newProfileEntryMutation(c.config, OpUpdateOne, withProfileEntryID(id))
-	return &ProfileEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProfilePositionClient) UpdateOneID(id ulid.ID) *ProfilePositionUpdateOne {
+	mutation := newProfilePositionMutation(c.config, OpUpdateOne, withProfilePositionID(id))
+	return &ProfilePositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProfileEntry.
-func (c *ProfileEntryClient) Delete() *ProfileEntryDelete {
-	mutation := newProfileEntryMutation(c.config, OpDelete)
-	return &ProfileEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProfilePosition.
+func (c *ProfilePositionClient) Delete() *ProfilePositionDelete {
+	mutation := newProfilePositionMutation(c.config, OpDelete)
+	return &ProfilePositionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProfileEntryClient) DeleteOne(pe *ProfileEntry) *ProfileEntryDeleteOne {
-	return c.DeleteOneID(pe.ID)
+func (c *ProfilePositionClient) DeleteOne(pp *ProfilePosition) *ProfilePositionDeleteOne {
+	return c.DeleteOneID(pp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProfileEntryClient) DeleteOneID(id ulid.ID) *ProfileEntryDeleteOne {
-	builder := c.Delete().Where(profileentry.ID(id))
+func (c *ProfilePositionClient) DeleteOneID(id ulid.ID) *ProfilePositionDeleteOne {
+	builder := c.Delete().Where(profileposition.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProfileEntryDeleteOne{builder}
+	return &ProfilePositionDeleteOne{builder}
 }
 
-// Query returns a query builder for ProfileEntry.
-func (c *ProfileEntryClient) Query() *ProfileEntryQuery {
-	return &ProfileEntryQuery{
+// Query returns a query builder for ProfilePosition.
+func (c *ProfilePositionClient) Query() *ProfilePositionQuery {
+	return &ProfilePositionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProfileEntry},
+		ctx:    &QueryContext{Type: TypeProfilePosition},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProfileEntry entity by its id.
-func (c *ProfileEntryClient) Get(ctx context.Context, id ulid.ID) (*ProfileEntry, error) {
-	return c.Query().Where(profileentry.ID(id)).Only(ctx)
+// Get returns a ProfilePosition entity by its id.
+func (c *ProfilePositionClient) Get(ctx context.Context, id ulid.ID) (*ProfilePosition, error) {
+	return c.Query().Where(profileposition.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProfileEntryClient) GetX(ctx context.Context, id ulid.ID) *ProfileEntry {
+func (c *ProfilePositionClient) GetX(ctx context.Context, id ulid.ID) *ProfilePosition {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -945,60 +2290,60 @@ func (c *ProfileEntryClient) GetX(ctx context.Context, id ulid.ID) *ProfileEntry
 	return obj
 }
 
-// QueryProfile queries the profile edge of a ProfileEntry.
-func (c *ProfileEntryClient) QueryProfile(pe *ProfileEntry) *ProfileQuery {
+// QueryProfile queries the profile edge of a ProfilePosition.
+func (c *ProfilePositionClient) QueryProfile(pp *ProfilePosition) *ProfileQuery {
 	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pe.ID
+		id := pp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(profileentry.Table, profileentry.FieldID, id),
+			sqlgraph.From(profileposition.Table, profileposition.FieldID, id),
 			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, profileentry.ProfileTable, profileentry.ProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, profileposition.ProfileTable, profileposition.ProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryJobExecutions queries the job_executions edge of a ProfileEntry.
-func (c *ProfileEntryClient) QueryJobExecutions(pe *ProfileEntry) *JobExecutionHistoryQuery {
-	query := (&JobExecutionHistoryClient{config: c.config}).Query()
+// QueryCompany queries the company edge of a ProfilePosition.
+func (c *ProfilePositionClient) QueryCompany(pp *ProfilePosition) *CompanyQuery {
+	query := (&CompanyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pe.ID
+		id := pp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(profileentry.Table, profileentry.FieldID, id),
-			sqlgraph.To(jobexecutionhistory.Table, jobexecutionhistory.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, profileentry.JobExecutionsTable, profileentry.JobExecutionsPrimaryKey...),
+			sqlgraph.From(profileposition.Table, profileposition.FieldID, id),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profileposition.CompanyTable, profileposition.CompanyColumn),
 		)
-		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProfileEntryClient) Hooks() []Hook {
-	return c.hooks.ProfileEntry
+func (c *ProfilePositionClient) Hooks() []Hook {
+	return c.hooks.ProfilePosition
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProfileEntryClient) Interceptors() []Interceptor {
-	return c.inters.ProfileEntry
+func (c *ProfilePositionClient) Interceptors() []Interceptor {
+	return c.inters.ProfilePosition
 }
 
-func (c *ProfileEntryClient) mutate(ctx context.Context, m *ProfileEntryMutation) (Value, error) {
+func (c *ProfilePositionClient) mutate(ctx context.Context, m *ProfilePositionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProfileEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfilePositionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProfileEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfilePositionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProfileEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProfilePositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProfileEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProfilePositionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProfileEntry mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProfilePosition mutation op: %q", m.Op())
 	}
 }
 
@@ -1300,6 +2645,320 @@ func (c *ProfilePostItemClient) mutate(ctx context.Context, m *ProfilePostItemMu
 	}
 }
 
+// ProfileSkillClient is a client for the ProfileSkill schema.
+type ProfileSkillClient struct {
+	config
+}
+
+// NewProfileSkillClient returns a client for the ProfileSkill from the given config.
+func NewProfileSkillClient(c config) *ProfileSkillClient {
+	return &ProfileSkillClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profileskill.Hooks(f(g(h())))`.
+func (c *ProfileSkillClient) Use(hooks ...Hook) {
+	c.hooks.ProfileSkill = append(c.hooks.ProfileSkill, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profileskill.Intercept(f(g(h())))`.
+func (c *ProfileSkillClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProfileSkill = append(c.inters.ProfileSkill, interceptors...)
+}
+
+// Create returns a builder for creating a ProfileSkill entity.
+func (c *ProfileSkillClient) Create() *ProfileSkillCreate {
+	mutation := newProfileSkillMutation(c.config, OpCreate)
+	return &ProfileSkillCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProfileSkill entities.
+func (c *ProfileSkillClient) CreateBulk(builders ...*ProfileSkillCreate) *ProfileSkillCreateBulk {
+	return &ProfileSkillCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileSkillClient) MapCreateBulk(slice any, setFunc func(*ProfileSkillCreate, int)) *ProfileSkillCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileSkillCreateBulk{err: fmt.Errorf("calling to ProfileSkillClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileSkillCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileSkillCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProfileSkill.
+func (c *ProfileSkillClient) Update() *ProfileSkillUpdate {
+	mutation := newProfileSkillMutation(c.config, OpUpdate)
+	return &ProfileSkillUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileSkillClient) UpdateOne(ps *ProfileSkill) *ProfileSkillUpdateOne {
+	mutation := newProfileSkillMutation(c.config, OpUpdateOne, withProfileSkill(ps))
+	return &ProfileSkillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProfileSkillClient) UpdateOneID(id ulid.ID) *ProfileSkillUpdateOne {
+	mutation := newProfileSkillMutation(c.config, OpUpdateOne, withProfileSkillID(id))
+	return &ProfileSkillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProfileSkill.
+func (c *ProfileSkillClient) Delete() *ProfileSkillDelete {
+	mutation := newProfileSkillMutation(c.config, OpDelete)
+	return &ProfileSkillDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProfileSkillClient) DeleteOne(ps *ProfileSkill) *ProfileSkillDeleteOne {
+	return c.DeleteOneID(ps.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProfileSkillClient) DeleteOneID(id ulid.ID) *ProfileSkillDeleteOne {
+	builder := c.Delete().Where(profileskill.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProfileSkillDeleteOne{builder}
+}
+
+// Query returns a query builder for ProfileSkill.
+func (c *ProfileSkillClient) Query() *ProfileSkillQuery {
+	return &ProfileSkillQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProfileSkill},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProfileSkill entity by its id.
+func (c *ProfileSkillClient) Get(ctx context.Context, id ulid.ID) (*ProfileSkill, error) {
+	return c.Query().Where(profileskill.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProfileSkillClient) GetX(ctx context.Context, id ulid.ID) *ProfileSkill {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a ProfileSkill.
+func (c *ProfileSkillClient) QueryProfile(ps *ProfileSkill) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ps.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profileskill.Table, profileskill.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profileskill.ProfileTable, profileskill.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(ps.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProfileSkillClient) Hooks() []Hook {
+	return c.hooks.ProfileSkill
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProfileSkillClient) Interceptors() []Interceptor {
+	return c.inters.ProfileSkill
+}
+
+func (c *ProfileSkillClient) mutate(ctx context.Context, m *ProfileSkillMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProfileSkillCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProfileSkillUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProfileSkillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProfileSkillDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProfileSkill mutation op: %q", m.Op())
+	}
+}
+
+// ProfileSnapshotClient is a client for the ProfileSnapshot schema.
+type ProfileSnapshotClient struct {
+	config
+}
+
+// NewProfileSnapshotClient returns a client for the ProfileSnapshot from the given config.
+func NewProfileSnapshotClient(c config) *ProfileSnapshotClient {
+	return &ProfileSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profilesnapshot.Hooks(f(g(h())))`.
+func (c *ProfileSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.ProfileSnapshot = append(c.hooks.ProfileSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profilesnapshot.Intercept(f(g(h())))`.
+func (c *ProfileSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProfileSnapshot = append(c.inters.ProfileSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a ProfileSnapshot entity.
+func (c *ProfileSnapshotClient) Create() *ProfileSnapshotCreate {
+	mutation := newProfileSnapshotMutation(c.config, OpCreate)
+	return &ProfileSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProfileSnapshot entities.
+func (c *ProfileSnapshotClient) CreateBulk(builders ...*ProfileSnapshotCreate) *ProfileSnapshotCreateBulk {
+	return &ProfileSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileSnapshotClient) MapCreateBulk(slice any, setFunc func(*ProfileSnapshotCreate, int)) *ProfileSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileSnapshotCreateBulk{err: fmt.Errorf("calling to ProfileSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProfileSnapshot.
+func (c *ProfileSnapshotClient) Update() *ProfileSnapshotUpdate {
+	mutation := newProfileSnapshotMutation(c.config, OpUpdate)
+	return &ProfileSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileSnapshotClient) UpdateOne(ps *ProfileSnapshot) *ProfileSnapshotUpdateOne {
+	mutation := newProfileSnapshotMutation(c.config, OpUpdateOne, withProfileSnapshot(ps))
+	return &ProfileSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProfileSnapshotClient) UpdateOneID(id ulid.ID) *ProfileSnapshotUpdateOne {
+	mutation := newProfileSnapshotMutation(c.config, OpUpdateOne, withProfileSnapshotID(id))
+	return &ProfileSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProfileSnapshot.
+func (c *ProfileSnapshotClient) Delete() *ProfileSnapshotDelete {
+	mutation := newProfileSnapshotMutation(c.config, OpDelete)
+	return &ProfileSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProfileSnapshotClient) DeleteOne(ps *ProfileSnapshot) *ProfileSnapshotDeleteOne {
+	return c.DeleteOneID(ps.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProfileSnapshotClient) DeleteOneID(id ulid.ID) *ProfileSnapshotDeleteOne {
+	builder := c.Delete().Where(profilesnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProfileSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for ProfileSnapshot.
+func (c *ProfileSnapshotClient) Query() *ProfileSnapshotQuery {
+	return &ProfileSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProfileSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProfileSnapshot entity by its id.
+func (c *ProfileSnapshotClient) Get(ctx context.Context, id ulid.ID) (*ProfileSnapshot, error) {
+	return c.Query().Where(profilesnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProfileSnapshotClient) GetX(ctx context.Context, id ulid.ID) *ProfileSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a ProfileSnapshot.
+func (c *ProfileSnapshotClient) QueryProfile(ps *ProfileSnapshot) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ps.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profilesnapshot.Table, profilesnapshot.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profilesnapshot.ProfileTable, profilesnapshot.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(ps.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChangeEvents queries the change_events edge of a ProfileSnapshot.
+func (c *ProfileSnapshotClient) QueryChangeEvents(ps *ProfileSnapshot) *ProfileChangeEventQuery {
+	query := (&ProfileChangeEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ps.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profilesnapshot.Table, profilesnapshot.FieldID, id),
+			sqlgraph.To(profilechangeevent.Table, profilechangeevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profilesnapshot.ChangeEventsTable, profilesnapshot.ChangeEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ps.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProfileSnapshotClient) Hooks() []Hook {
+	return c.hooks.ProfileSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProfileSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.ProfileSnapshot
+}
+
+func (c *ProfileSnapshotClient) mutate(ctx context.Context, m *ProfileSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProfileSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProfileSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProfileSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProfileSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProfileSnapshot mutation op: %q", m.Op())
+	}
+}
+
 // TodoClient is a client for the Todo schema.
 type TodoClient struct {
 	config
@@ -1601,11 +3260,15 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		APIQuotaTracker, CronJobConfig, JobExecutionHistory, Profile, ProfileEntry,
-		ProfilePost, ProfilePostItem, Todo, User []ent.Hook
+		APICallLog, APIQuotaBudget, APIQuotaReservation, APIQuotaTracker, Company,
+		CronJobConfig, JobExecutionHistory, Profile, ProfileChangeEvent,
+		ProfileEducation, ProfileEntry, ProfilePosition, ProfilePost, ProfilePostItem,
+		ProfileSkill, ProfileSnapshot, Todo, User []ent.Hook
 	}
 	inters struct {
-		APIQuotaTracker, CronJobConfig, JobExecutionHistory, Profile, ProfileEntry,
-		ProfilePost, ProfilePostItem, Todo, User []ent.Interceptor
+		APICallLog, APIQuotaBudget, APIQuotaReservation, APIQuotaTracker, Company,
+		CronJobConfig, JobExecutionHistory, Profile, ProfileChangeEvent,
+		ProfileEducation, ProfileEntry, ProfilePosition, ProfilePost, ProfilePostItem,
+		ProfileSkill, ProfileSnapshot, Todo, User []ent.Interceptor
 	}
 )
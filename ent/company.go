@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sheng-go-backend/ent/company"
+	"sheng-go-backend/ent/schema/ulid"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Company is the model entity for the Company schema.
+type Company struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID ulid.ID `json:"id,omitempty"`
+	// Identity key: urn:<linkedin_urn> or name:<normalized_name>
+	Key string `json:"key,omitempty"`
+	// Company name as last seen in a position
+	Name string `json:"name,omitempty"`
+	// Lowercased, whitespace-collapsed name used for matching
+	NormalizedName string `json:"normalized_name,omitempty"`
+	// LinkedIn company URN or ID, when present
+	LinkedinUrn *string `json:"linkedin_urn,omitempty"`
+	// LinkedIn company slug, when present
+	LinkedinUsername *string `json:"linkedin_username,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CompanyQuery when eager-loading is set.
+	Edges        CompanyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CompanyEdges holds the relations/edges for other nodes in the graph.
+type CompanyEdges struct {
+	// Positions held at this company
+	Positions []*ProfilePosition `json:"positions,omitempty"`
+	// Profiles currently working at this company
+	CurrentEmployees []*Profile `json:"current_employees,omitempty"`
+	// Profiles that used to work at this company
+	Alumni []*Profile `json:"alumni,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [3]map[string]int
+
+	namedPositions        map[string][]*ProfilePosition
+	namedCurrentEmployees map[string][]*Profile
+	namedAlumni           map[string][]*Profile
+}
+
+// PositionsOrErr returns the Positions value or an error if the edge
+// was not loaded in eager-loading.
+func (e CompanyEdges) PositionsOrErr() ([]*ProfilePosition, error) {
+	if e.loadedTypes[0] {
+		return e.Positions, nil
+	}
+	return nil, &NotLoadedError{edge: "positions"}
+}
+
+// CurrentEmployeesOrErr returns the CurrentEmployees value or an error if the edge
+// was not loaded in eager-loading.
+func (e CompanyEdges) CurrentEmployeesOrErr() ([]*Profile, error) {
+	if e.loadedTypes[1] {
+		return e.CurrentEmployees, nil
+	}
+	return nil, &NotLoadedError{edge: "current_employees"}
+}
+
+// AlumniOrErr returns the Alumni value or an error if the edge
+// was not loaded in eager-loading.
+func (e CompanyEdges) AlumniOrErr() ([]*Profile, error) {
+	if e.loadedTypes[2] {
+		return e.Alumni, nil
+	}
+	return nil, &NotLoadedError{edge: "alumni"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Company) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case company.FieldKey, company.FieldName, company.FieldNormalizedName, company.FieldLinkedinUrn, company.FieldLinkedinUsername:
+			values[i] = new(sql.NullString)
+		case company.FieldCreatedAt, company.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case company.FieldID:
+			values[i] = new(ulid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Company fields.
+func (c *Company) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case company.FieldID:
+			if value, ok := values[i].(*ulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case company.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				c.Key = value.String
+			}
+		case company.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case company.FieldNormalizedName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_name", values[i])
+			} else if value.Valid {
+				c.NormalizedName = value.String
+			}
+		case company.FieldLinkedinUrn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field linkedin_urn", values[i])
+			} else if value.Valid {
+				c.LinkedinUrn = new(string)
+				*c.LinkedinUrn = value.String
+			}
+		case company.FieldLinkedinUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field linkedin_username", values[i])
+			} else if value.Valid {
+				c.LinkedinUsername = new(string)
+				*c.LinkedinUsername = value.String
+			}
+		case company.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case company.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Company.
+// This includes values selected through modifiers, order, etc.
+func (c *Company) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryPositions queries the "positions" edge of the Company entity.
+func (c *Company) QueryPositions() *ProfilePositionQuery {
+	return NewCompanyClient(c.config).QueryPositions(c)
+}
+
+// QueryCurrentEmployees queries the "current_employees" edge of the Company entity.
+func (c *Company) QueryCurrentEmployees() *ProfileQuery {
+	return NewCompanyClient(c.config).QueryCurrentEmployees(c)
+}
+
+// QueryAlumni queries the "alumni" edge of the Company entity.
+func (c *Company) QueryAlumni() *ProfileQuery {
+	return NewCompanyClient(c.config).QueryAlumni(c)
+}
+
+// Update returns a builder for updating this Company.
+// Note that you need to call Company.Unwrap() before calling this method if this Company
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Company) Update() *CompanyUpdateOne {
+	return NewCompanyClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Company entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Company) Unwrap() *Company {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Company is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Company) String() string {
+	var builder strings.Builder
+	builder.WriteString("Company(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("key=")
+	builder.WriteString(c.Key)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("normalized_name=")
+	builder.WriteString(c.NormalizedName)
+	builder.WriteString(", ")
+	if v := c.LinkedinUrn; v != nil {
+		builder.WriteString("linkedin_urn=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := c.LinkedinUsername; v != nil {
+		builder.WriteString("linkedin_username=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedPositions returns the Positions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Company) NamedPositions(name string) ([]*ProfilePosition, error) {
+	if c.Edges.namedPositions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedPositions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Company) appendNamedPositions(name string, edges ...*ProfilePosition) {
+	if c.Edges.namedPositions == nil {
+		c.Edges.namedPositions = make(map[string][]*ProfilePosition)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedPositions[name] = []*ProfilePosition{}
+	} else {
+		c.Edges.namedPositions[name] = append(c.Edges.namedPositions[name], edges...)
+	}
+}
+
+// NamedCurrentEmployees returns the CurrentEmployees named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Company) NamedCurrentEmployees(name string) ([]*Profile, error) {
+	if c.Edges.namedCurrentEmployees == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedCurrentEmployees[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Company) appendNamedCurrentEmployees(name string, edges ...*Profile) {
+	if c.Edges.namedCurrentEmployees == nil {
+		c.Edges.namedCurrentEmployees = make(map[string][]*Profile)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedCurrentEmployees[name] = []*Profile{}
+	} else {
+		c.Edges.namedCurrentEmployees[name] = append(c.Edges.namedCurrentEmployees[name], edges...)
+	}
+}
+
+// NamedAlumni returns the Alumni named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Company) NamedAlumni(name string) ([]*Profile, error) {
+	if c.Edges.namedAlumni == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedAlumni[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Company) appendNamedAlumni(name string, edges ...*Profile) {
+	if c.Edges.namedAlumni == nil {
+		c.Edges.namedAlumni = make(map[string][]*Profile)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedAlumni[name] = []*Profile{}
+	} else {
+		c.Edges.namedAlumni[name] = append(c.Edges.namedAlumni[name], edges...)
+	}
+}
+
+// Companies is a parsable slice of Company.
+type Companies []*Company
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package company
+
+import (
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the company type in the database.
+	Label = "company"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldNormalizedName holds the string denoting the normalized_name field in the database.
+	FieldNormalizedName = "normalized_name"
+	// FieldLinkedinUrn holds the string denoting the linkedin_urn field in the database.
+	FieldLinkedinUrn = "linkedin_urn"
+	// FieldLinkedinUsername holds the string denoting the linkedin_username field in the database.
+	FieldLinkedinUsername = "linkedin_username"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgePositions holds the string denoting the positions edge name in mutations.
+	EdgePositions = "positions"
+	// EdgeCurrentEmployees holds the string denoting the current_employees edge name in mutations.
+	EdgeCurrentEmployees = "current_employees"
+	// EdgeAlumni holds the string denoting the alumni edge name in mutations.
+	EdgeAlumni = "alumni"
+	// Table holds the table name of the company in the database.
+	Table = "companies"
+	// PositionsTable is the table that holds the positions relation/edge.
+	PositionsTable = "profile_positions"
+	// PositionsInverseTable is the table name for the ProfilePosition entity.
+	// It exists in this package in order to avoid circular dependency with the "profileposition" package.
+	PositionsInverseTable = "profile_positions"
+	// PositionsColumn is the table column denoting the positions relation/edge.
+	PositionsColumn = "company_positions"
+	// CurrentEmployeesTable is the table that holds the current_employees relation/edge. The primary key declared below.
+	CurrentEmployeesTable = "profile_current_companies"
+	// CurrentEmployeesInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	CurrentEmployeesInverseTable = "profiles"
+	// AlumniTable is the table that holds the alumni relation/edge. The primary key declared below.
+	AlumniTable = "profile_past_companies"
+	// AlumniInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	AlumniInverseTable = "profiles"
+)
+
+// Columns holds all SQL columns for company fields.
+var Columns = []string{
+	FieldID,
+	FieldKey,
+	FieldName,
+	FieldNormalizedName,
+	FieldLinkedinUrn,
+	FieldLinkedinUsername,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+var (
+	// CurrentEmployeesPrimaryKey and CurrentEmployeesColumn2 are the table columns denoting the
+	// primary key for the current_employees relation (M2M).
+	CurrentEmployeesPrimaryKey = []string{"profile_id", "company_id"}
+	// AlumniPrimaryKey and AlumniColumn2 are the table columns denoting the
+	// primary key for the alumni relation (M2M).
+	AlumniPrimaryKey = []string{"profile_id", "company_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// NormalizedNameValidator is a validator for the "normalized_name" field. It is called by the builders before save.
+	NormalizedNameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() ulid.ID
+)
+
+// OrderOption defines the ordering options for the Company queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByNormalizedName orders the results by the normalized_name field.
+func ByNormalizedName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNormalizedName, opts...).ToFunc()
+}
+
+// ByLinkedinUrn orders the results by the linkedin_urn field.
+func ByLinkedinUrn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkedinUrn, opts...).ToFunc()
+}
+
+// ByLinkedinUsername orders the results by the linkedin_username field.
+func ByLinkedinUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkedinUsername, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPositionsCount orders the results by positions count.
+func ByPositionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPositionsStep(), opts...)
+	}
+}
+
+// ByPositions orders the results by positions terms.
+func ByPositions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPositionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCurrentEmployeesCount orders the results by current_employees count.
+func ByCurrentEmployeesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCurrentEmployeesStep(), opts...)
+	}
+}
+
+// ByCurrentEmployees orders the results by current_employees terms.
+func ByCurrentEmployees(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCurrentEmployeesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAlumniCount orders the results by alumni count.
+func ByAlumniCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAlumniStep(), opts...)
+	}
+}
+
+// ByAlumni orders the results by alumni terms.
+func ByAlumni(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAlumniStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPositionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PositionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PositionsTable, PositionsColumn),
+	)
+}
+func newCurrentEmployeesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CurrentEmployeesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, CurrentEmployeesTable, CurrentEmployeesPrimaryKey...),
+	)
+}
+func newAlumniStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AlumniInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, AlumniTable, AlumniPrimaryKey...),
+	)
+}
@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package company
+
+import (
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id ulid.ID) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id ulid.ID) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id ulid.ID) predicate.Company {
+	return predicate.Company(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...ulid.ID) predicate.Company {
+	return predicate.Company(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...ulid.ID) predicate.Company {
+	return predicate.Company(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id ulid.ID) predicate.Company {
+	return predicate.Company(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id ulid.ID) predicate.Company {
+	return predicate.Company(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id ulid.ID) predicate.Company {
+	return predicate.Company(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id ulid.ID) predicate.Company {
+	return predicate.Company(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldKey, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldName, v))
+}
+
+// NormalizedName applies equality check predicate on the "normalized_name" field. It's identical to NormalizedNameEQ.
+func NormalizedName(v string) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldNormalizedName, v))
+}
+
+// LinkedinUrn applies equality check predicate on the "linkedin_urn" field. It's identical to LinkedinUrnEQ.
+func LinkedinUrn(v string) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldLinkedinUrn, v))
+}
+
+// LinkedinUsername applies equality check predicate on the "linkedin_username" field. It's identical to LinkedinUsernameEQ.
+func LinkedinUsername(v string) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldLinkedinUsername, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.Company {
+	return predicate.Company(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.Company {
+	return predicate.Company(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.Company {
+	return predicate.Company(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.Company {
+	return predicate.Company(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.Company {
+	return predicate.Company(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.Company {
+	return predicate.Company(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.Company {
+	return predicate.Company(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.Company {
+	return predicate.Company(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.Company {
+	return predicate.Company(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.Company {
+	return predicate.Company(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.Company {
+	return predicate.Company(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.Company {
+	return predicate.Company(sql.FieldContainsFold(FieldKey, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Company {
+	return predicate.Company(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Company {
+	return predicate.Company(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Company {
+	return predicate.Company(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Company {
+	return predicate.Company(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Company {
+	return predicate.Company(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Company {
+	return predicate.Company(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Company {
+	return predicate.Company(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Company {
+	return predicate.Company(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Company {
+	return predicate.Company(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Company {
+	return predicate.Company(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Company {
+	return predicate.Company(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Company {
+	return predicate.Company(sql.FieldContainsFold(FieldName, v))
+}
+
+// NormalizedNameEQ applies the EQ predicate on the "normalized_name" field.
+func NormalizedNameEQ(v string) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldNormalizedName, v))
+}
+
+// NormalizedNameNEQ applies the NEQ predicate on the "normalized_name" field.
+func NormalizedNameNEQ(v string) predicate.Company {
+	return predicate.Company(sql.FieldNEQ(FieldNormalizedName, v))
+}
+
+// NormalizedNameIn applies the In predicate on the "normalized_name" field.
+func NormalizedNameIn(vs ...string) predicate.Company {
+	return predicate.Company(sql.FieldIn(FieldNormalizedName, vs...))
+}
+
+// NormalizedNameNotIn applies the NotIn predicate on the "normalized_name" field.
+func NormalizedNameNotIn(vs ...string) predicate.Company {
+	return predicate.Company(sql.FieldNotIn(FieldNormalizedName, vs...))
+}
+
+// NormalizedNameGT applies the GT predicate on the "normalized_name" field.
+func NormalizedNameGT(v string) predicate.Company {
+	return predicate.Company(sql.FieldGT(FieldNormalizedName, v))
+}
+
+// NormalizedNameGTE applies the GTE predicate on the "normalized_name" field.
+func NormalizedNameGTE(v string) predicate.Company {
+	return predicate.Company(sql.FieldGTE(FieldNormalizedName, v))
+}
+
+// NormalizedNameLT applies the LT predicate on the "normalized_name" field.
+func NormalizedNameLT(v string) predicate.Company {
+	return predicate.Company(sql.FieldLT(FieldNormalizedName, v))
+}
+
+// NormalizedNameLTE applies the LTE predicate on the "normalized_name" field.
+func NormalizedNameLTE(v string) predicate.Company {
+	return predicate.Company(sql.FieldLTE(FieldNormalizedName, v))
+}
+
+// NormalizedNameContains applies the Contains predicate on the "normalized_name" field.
+func NormalizedNameContains(v string) predicate.Company {
+	return predicate.Company(sql.FieldContains(FieldNormalizedName, v))
+}
+
+// NormalizedNameHasPrefix applies the HasPrefix predicate on the "normalized_name" field.
+func NormalizedNameHasPrefix(v string) predicate.Company {
+	return predicate.Company(sql.FieldHasPrefix(FieldNormalizedName, v))
+}
+
+// NormalizedNameHasSuffix applies the HasSuffix predicate on the "normalized_name" field.
+func NormalizedNameHasSuffix(v string) predicate.Company {
+	return predicate.Company(sql.FieldHasSuffix(FieldNormalizedName, v))
+}
+
+// NormalizedNameEqualFold applies the EqualFold predicate on the "normalized_name" field.
+func NormalizedNameEqualFold(v string) predicate.Company {
+	return predicate.Company(sql.FieldEqualFold(FieldNormalizedName, v))
+}
+
+// NormalizedNameContainsFold applies the ContainsFold predicate on the "normalized_name" field.
+func NormalizedNameContainsFold(v string) predicate.Company {
+	return predicate.Company(sql.FieldContainsFold(FieldNormalizedName, v))
+}
+
+// LinkedinUrnEQ applies the EQ predicate on the "linkedin_urn" field.
+func LinkedinUrnEQ(v string) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldLinkedinUrn, v))
+}
+
+// LinkedinUrnNEQ applies the NEQ predicate on the "linkedin_urn" field.
+func LinkedinUrnNEQ(v string) predicate.Company {
+	return predicate.Company(sql.FieldNEQ(FieldLinkedinUrn, v))
+}
+
+// LinkedinUrnIn applies the In predicate on the "linkedin_urn" field.
+func LinkedinUrnIn(vs ...string) predicate.Company {
+	return predicate.Company(sql.FieldIn(FieldLinkedinUrn, vs...))
+}
+
+// LinkedinUrnNotIn applies the NotIn predicate on the "linkedin_urn" field.
+func LinkedinUrnNotIn(vs ...string) predicate.Company {
+	return predicate.Company(sql.FieldNotIn(FieldLinkedinUrn, vs...))
+}
+
+// LinkedinUrnGT applies the GT predicate on the "linkedin_urn" field.
+func LinkedinUrnGT(v string) predicate.Company {
+	return predicate.Company(sql.FieldGT(FieldLinkedinUrn, v))
+}
+
+// LinkedinUrnGTE applies the GTE predicate on the "linkedin_urn" field.
+func LinkedinUrnGTE(v string) predicate.Company {
+	return predicate.Company(sql.FieldGTE(FieldLinkedinUrn, v))
+}
+
+// LinkedinUrnLT applies the LT predicate on the "linkedin_urn" field.
+func LinkedinUrnLT(v string) predicate.Company {
+	return predicate.Company(sql.FieldLT(FieldLinkedinUrn, v))
+}
+
+// LinkedinUrnLTE applies the LTE predicate on the "linkedin_urn" field.
+func LinkedinUrnLTE(v string) predicate.Company {
+	return predicate.Company(sql.FieldLTE(FieldLinkedinUrn, v))
+}
+
+// LinkedinUrnContains applies the Contains predicate on the "linkedin_urn" field.
+func LinkedinUrnContains(v string) predicate.Company {
+	return predicate.Company(sql.FieldContains(FieldLinkedinUrn, v))
+}
+
+// LinkedinUrnHasPrefix applies the HasPrefix predicate on the "linkedin_urn" field.
+func LinkedinUrnHasPrefix(v string) predicate.Company {
+	return predicate.Company(sql.FieldHasPrefix(FieldLinkedinUrn, v))
+}
+
+// LinkedinUrnHasSuffix applies the HasSuffix predicate on the "linkedin_urn" field.
+func LinkedinUrnHasSuffix(v string) predicate.Company {
+	return predicate.Company(sql.FieldHasSuffix(FieldLinkedinUrn, v))
+}
+
+// LinkedinUrnIsNil applies the IsNil predicate on the "linkedin_urn" field.
+func LinkedinUrnIsNil() predicate.Company {
+	return predicate.Company(sql.FieldIsNull(FieldLinkedinUrn))
+}
+
+// LinkedinUrnNotNil applies the NotNil predicate on the "linkedin_urn" field.
+func LinkedinUrnNotNil() predicate.Company {
+	return predicate.Company(sql.FieldNotNull(FieldLinkedinUrn))
+}
+
+// LinkedinUrnEqualFold applies the EqualFold predicate on the "linkedin_urn" field.
+func LinkedinUrnEqualFold(v string) predicate.Company {
+	return predicate.Company(sql.FieldEqualFold(FieldLinkedinUrn, v))
+}
+
+// LinkedinUrnContainsFold applies the ContainsFold predicate on the "linkedin_urn" field.
+func LinkedinUrnContainsFold(v string) predicate.Company {
+	return predicate.Company(sql.FieldContainsFold(FieldLinkedinUrn, v))
+}
+
+// LinkedinUsernameEQ applies the EQ predicate on the "linkedin_username" field.
+func LinkedinUsernameEQ(v string) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldLinkedinUsername, v))
+}
+
+// LinkedinUsernameNEQ applies the NEQ predicate on the "linkedin_username" field.
+func LinkedinUsernameNEQ(v string) predicate.Company {
+	return predicate.Company(sql.FieldNEQ(FieldLinkedinUsername, v))
+}
+
+// LinkedinUsernameIn applies the In predicate on the "linkedin_username" field.
+func LinkedinUsernameIn(vs ...string) predicate.Company {
+	return predicate.Company(sql.FieldIn(FieldLinkedinUsername, vs...))
+}
+
+// LinkedinUsernameNotIn applies the NotIn predicate on the "linkedin_username" field.
+func LinkedinUsernameNotIn(vs ...string) predicate.Company {
+	return predicate.Company(sql.FieldNotIn(FieldLinkedinUsername, vs...))
+}
+
+// LinkedinUsernameGT applies the GT predicate on the "linkedin_username" field.
+func LinkedinUsernameGT(v string) predicate.Company {
+	return predicate.Company(sql.FieldGT(FieldLinkedinUsername, v))
+}
+
+// LinkedinUsernameGTE applies the GTE predicate on the "linkedin_username" field.
+func LinkedinUsernameGTE(v string) predicate.Company {
+	return predicate.Company(sql.FieldGTE(FieldLinkedinUsername, v))
+}
+
+// LinkedinUsernameLT applies the LT predicate on the "linkedin_username" field.
+func LinkedinUsernameLT(v string) predicate.Company {
+	return predicate.Company(sql.FieldLT(FieldLinkedinUsername, v))
+}
+
+// LinkedinUsernameLTE applies the LTE predicate on the "linkedin_username" field.
+func LinkedinUsernameLTE(v string) predicate.Company {
+	return predicate.Company(sql.FieldLTE(FieldLinkedinUsername, v))
+}
+
+// LinkedinUsernameContains applies the Contains predicate on the "linkedin_username" field.
+func LinkedinUsernameContains(v string) predicate.Company {
+	return predicate.Company(sql.FieldContains(FieldLinkedinUsername, v))
+}
+
+// LinkedinUsernameHasPrefix applies the HasPrefix predicate on the "linkedin_username" field.
+func LinkedinUsernameHasPrefix(v string) predicate.Company {
+	return predicate.Company(sql.FieldHasPrefix(FieldLinkedinUsername, v))
+}
+
+// LinkedinUsernameHasSuffix applies the HasSuffix predicate on the "linkedin_username" field.
+func LinkedinUsernameHasSuffix(v string) predicate.Company {
+	return predicate.Company(sql.FieldHasSuffix(FieldLinkedinUsername, v))
+}
+
+// LinkedinUsernameIsNil applies the IsNil predicate on the "linkedin_username" field.
+func LinkedinUsernameIsNil() predicate.Company {
+	return predicate.Company(sql.FieldIsNull(FieldLinkedinUsername))
+}
+
+// LinkedinUsernameNotNil applies the NotNil predicate on the "linkedin_username" field.
+func LinkedinUsernameNotNil() predicate.Company {
+	return predicate.Company(sql.FieldNotNull(FieldLinkedinUsername))
+}
+
+// LinkedinUsernameEqualFold applies the EqualFold predicate on the "linkedin_username" field.
+func LinkedinUsernameEqualFold(v string) predicate.Company {
+	return predicate.Company(sql.FieldEqualFold(FieldLinkedinUsername, v))
+}
+
+// LinkedinUsernameContainsFold applies the ContainsFold predicate on the "linkedin_username" field.
+func LinkedinUsernameContainsFold(v string) predicate.Company {
+	return predicate.Company(sql.FieldContainsFold(FieldLinkedinUsername, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Company {
+	return predicate.Company(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Company {
+	return predicate.Company(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Company {
+	return predicate.Company(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Company {
+	return predicate.Company(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Company {
+	return predicate.Company(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasPositions applies the HasEdge predicate on the "positions" edge.
+func HasPositions() predicate.Company {
+	return predicate.Company(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PositionsTable, PositionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPositionsWith applies the HasEdge predicate on the "positions" edge with a given conditions (other predicates).
+func HasPositionsWith(preds ...predicate.ProfilePosition) predicate.Company {
+	return predicate.Company(func(s *sql.Selector) {
+		step := newPositionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCurrentEmployees applies the HasEdge predicate on the "current_employees" edge.
+func HasCurrentEmployees() predicate.Company {
+	return predicate.Company(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, CurrentEmployeesTable, CurrentEmployeesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCurrentEmployeesWith applies the HasEdge predicate on the "current_employees" edge with a given conditions (other predicates).
+func HasCurrentEmployeesWith(preds ...predicate.Profile) predicate.Company {
+	return predicate.Company(func(s *sql.Selector) {
+		step := newCurrentEmployeesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAlumni applies the HasEdge predicate on the "alumni" edge.
+func HasAlumni() predicate.Company {
+	return predicate.Company(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, AlumniTable, AlumniPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAlumniWith applies the HasEdge predicate on the "alumni" edge with a given conditions (other predicates).
+func HasAlumniWith(preds ...predicate.Profile) predicate.Company {
+	return predicate.Company(func(s *sql.Selector) {
+		step := newAlumniStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Company) predicate.Company {
+	return predicate.Company(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Company) predicate.Company {
+	return predicate.Company(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Company) predicate.Company {
+	return predicate.Company(sql.NotPredicates(p))
+}
@@ -0,0 +1,943 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/company"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CompanyCreate is the builder for creating a Company entity.
+type CompanyCreate struct {
+	config
+	mutation *CompanyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetKey sets the "key" field.
+func (cc *CompanyCreate) SetKey(s string) *CompanyCreate {
+	cc.mutation.SetKey(s)
+	return cc
+}
+
+// SetName sets the "name" field.
+func (cc *CompanyCreate) SetName(s string) *CompanyCreate {
+	cc.mutation.SetName(s)
+	return cc
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (cc *CompanyCreate) SetNormalizedName(s string) *CompanyCreate {
+	cc.mutation.SetNormalizedName(s)
+	return cc
+}
+
+// SetLinkedinUrn sets the "linkedin_urn" field.
+func (cc *CompanyCreate) SetLinkedinUrn(s string) *CompanyCreate {
+	cc.mutation.SetLinkedinUrn(s)
+	return cc
+}
+
+// SetNillableLinkedinUrn sets the "linkedin_urn" field if the given value is not nil.
+func (cc *CompanyCreate) SetNillableLinkedinUrn(s *string) *CompanyCreate {
+	if s != nil {
+		cc.SetLinkedinUrn(*s)
+	}
+	return cc
+}
+
+// SetLinkedinUsername sets the "linkedin_username" field.
+func (cc *CompanyCreate) SetLinkedinUsername(s string) *CompanyCreate {
+	cc.mutation.SetLinkedinUsername(s)
+	return cc
+}
+
+// SetNillableLinkedinUsername sets the "linkedin_username" field if the given value is not nil.
+func (cc *CompanyCreate) SetNillableLinkedinUsername(s *string) *CompanyCreate {
+	if s != nil {
+		cc.SetLinkedinUsername(*s)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CompanyCreate) SetCreatedAt(t time.Time) *CompanyCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CompanyCreate) SetNillableCreatedAt(t *time.Time) *CompanyCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CompanyCreate) SetUpdatedAt(t time.Time) *CompanyCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CompanyCreate) SetNillableUpdatedAt(t *time.Time) *CompanyCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CompanyCreate) SetID(u ulid.ID) *CompanyCreate {
+	cc.mutation.SetID(u)
+	return cc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cc *CompanyCreate) SetNillableID(u *ulid.ID) *CompanyCreate {
+	if u != nil {
+		cc.SetID(*u)
+	}
+	return cc
+}
+
+// AddPositionIDs adds the "positions" edge to the ProfilePosition entity by IDs.
+func (cc *CompanyCreate) AddPositionIDs(ids ...ulid.ID) *CompanyCreate {
+	cc.mutation.AddPositionIDs(ids...)
+	return cc
+}
+
+// AddPositions adds the "positions" edges to the ProfilePosition entity.
+func (cc *CompanyCreate) AddPositions(p ...*ProfilePosition) *CompanyCreate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cc.AddPositionIDs(ids...)
+}
+
+// AddCurrentEmployeeIDs adds the "current_employees" edge to the Profile entity by IDs.
+func (cc *CompanyCreate) AddCurrentEmployeeIDs(ids ...ulid.ID) *CompanyCreate {
+	cc.mutation.AddCurrentEmployeeIDs(ids...)
+	return cc
+}
+
+// AddCurrentEmployees adds the "current_employees" edges to the Profile entity.
+func (cc *CompanyCreate) AddCurrentEmployees(p ...*Profile) *CompanyCreate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cc.AddCurrentEmployeeIDs(ids...)
+}
+
+// AddAlumniIDs adds the "alumni" edge to the Profile entity by IDs.
+func (cc *CompanyCreate) AddAlumniIDs(ids ...ulid.ID) *CompanyCreate {
+	cc.mutation.AddAlumniIDs(ids...)
+	return cc
+}
+
+// AddAlumni adds the "alumni" edges to the Profile entity.
+func (cc *CompanyCreate) AddAlumni(p ...*Profile) *CompanyCreate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cc.AddAlumniIDs(ids...)
+}
+
+// Mutation returns the CompanyMutation object of the builder.
+func (cc *CompanyCreate) Mutation() *CompanyMutation {
+	return cc.mutation
+}
+
+// Save creates the Company in the database.
+func (cc *CompanyCreate) Save(ctx context.Context) (*Company, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CompanyCreate) SaveX(ctx context.Context) *Company {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CompanyCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CompanyCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CompanyCreate) defaults() {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := company.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := company.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cc.mutation.ID(); !ok {
+		v := company.DefaultID()
+		cc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CompanyCreate) check() error {
+	if _, ok := cc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "Company.key"`)}
+	}
+	if v, ok := cc.mutation.Key(); ok {
+		if err := company.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "Company.key": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Company.name"`)}
+	}
+	if v, ok := cc.mutation.Name(); ok {
+		if err := company.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Company.name": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.NormalizedName(); !ok {
+		return &ValidationError{Name: "normalized_name", err: errors.New(`ent: missing required field "Company.normalized_name"`)}
+	}
+	if v, ok := cc.mutation.NormalizedName(); ok {
+		if err := company.NormalizedNameValidator(v); err != nil {
+			return &ValidationError{Name: "normalized_name", err: fmt.Errorf(`ent: validator failed for field "Company.normalized_name": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Company.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Company.updated_at"`)}
+	}
+	return nil
+}
+
+func (cc *CompanyCreate) sqlSave(ctx context.Context) (*Company, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*ulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CompanyCreate) createSpec() (*Company, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Company{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(company.Table, sqlgraph.NewFieldSpec(company.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = cc.conflict
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cc.mutation.Key(); ok {
+		_spec.SetField(company.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := cc.mutation.Name(); ok {
+		_spec.SetField(company.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cc.mutation.NormalizedName(); ok {
+		_spec.SetField(company.FieldNormalizedName, field.TypeString, value)
+		_node.NormalizedName = value
+	}
+	if value, ok := cc.mutation.LinkedinUrn(); ok {
+		_spec.SetField(company.FieldLinkedinUrn, field.TypeString, value)
+		_node.LinkedinUrn = &value
+	}
+	if value, ok := cc.mutation.LinkedinUsername(); ok {
+		_spec.SetField(company.FieldLinkedinUsername, field.TypeString, value)
+		_node.LinkedinUsername = &value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(company.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(company.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := cc.mutation.PositionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.PositionsTable,
+			Columns: []string{company.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.CurrentEmployeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.CurrentEmployeesTable,
+			Columns: company.CurrentEmployeesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.AlumniIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.AlumniTable,
+			Columns: company.AlumniPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Company.Create().
+//		SetKey(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CompanyUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CompanyCreate) OnConflict(opts ...sql.ConflictOption) *CompanyUpsertOne {
+	cc.conflict = opts
+	return &CompanyUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Company.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CompanyCreate) OnConflictColumns(columns ...string) *CompanyUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CompanyUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CompanyUpsertOne is the builder for "upsert"-ing
+	//  one Company node.
+	CompanyUpsertOne struct {
+		create *CompanyCreate
+	}
+
+	// CompanyUpsert is the "OnConflict" setter.
+	CompanyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *CompanyUpsert) SetName(v string) *CompanyUpsert {
+	u.Set(company.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CompanyUpsert) UpdateName() *CompanyUpsert {
+	u.SetExcluded(company.FieldName)
+	return u
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *CompanyUpsert) SetNormalizedName(v string) *CompanyUpsert {
+	u.Set(company.FieldNormalizedName, v)
+	return u
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *CompanyUpsert) UpdateNormalizedName() *CompanyUpsert {
+	u.SetExcluded(company.FieldNormalizedName)
+	return u
+}
+
+// SetLinkedinUrn sets the "linkedin_urn" field.
+func (u *CompanyUpsert) SetLinkedinUrn(v string) *CompanyUpsert {
+	u.Set(company.FieldLinkedinUrn, v)
+	return u
+}
+
+// UpdateLinkedinUrn sets the "linkedin_urn" field to the value that was provided on create.
+func (u *CompanyUpsert) UpdateLinkedinUrn() *CompanyUpsert {
+	u.SetExcluded(company.FieldLinkedinUrn)
+	return u
+}
+
+// ClearLinkedinUrn clears the value of the "linkedin_urn" field.
+func (u *CompanyUpsert) ClearLinkedinUrn() *CompanyUpsert {
+	u.SetNull(company.FieldLinkedinUrn)
+	return u
+}
+
+// SetLinkedinUsername sets the "linkedin_username" field.
+func (u *CompanyUpsert) SetLinkedinUsername(v string) *CompanyUpsert {
+	u.Set(company.FieldLinkedinUsername, v)
+	return u
+}
+
+// UpdateLinkedinUsername sets the "linkedin_username" field to the value that was provided on create.
+func (u *CompanyUpsert) UpdateLinkedinUsername() *CompanyUpsert {
+	u.SetExcluded(company.FieldLinkedinUsername)
+	return u
+}
+
+// ClearLinkedinUsername clears the value of the "linkedin_username" field.
+func (u *CompanyUpsert) ClearLinkedinUsername() *CompanyUpsert {
+	u.SetNull(company.FieldLinkedinUsername)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CompanyUpsert) SetUpdatedAt(v time.Time) *CompanyUpsert {
+	u.Set(company.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CompanyUpsert) UpdateUpdatedAt() *CompanyUpsert {
+	u.SetExcluded(company.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Company.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(company.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CompanyUpsertOne) UpdateNewValues() *CompanyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(company.FieldID)
+		}
+		if _, exists := u.create.mutation.Key(); exists {
+			s.SetIgnore(company.FieldKey)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(company.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Company.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CompanyUpsertOne) Ignore() *CompanyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CompanyUpsertOne) DoNothing() *CompanyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CompanyCreate.OnConflict
+// documentation for more info.
+func (u *CompanyUpsertOne) Update(set func(*CompanyUpsert)) *CompanyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CompanyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CompanyUpsertOne) SetName(v string) *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CompanyUpsertOne) UpdateName() *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *CompanyUpsertOne) SetNormalizedName(v string) *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.SetNormalizedName(v)
+	})
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *CompanyUpsertOne) UpdateNormalizedName() *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.UpdateNormalizedName()
+	})
+}
+
+// SetLinkedinUrn sets the "linkedin_urn" field.
+func (u *CompanyUpsertOne) SetLinkedinUrn(v string) *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.SetLinkedinUrn(v)
+	})
+}
+
+// UpdateLinkedinUrn sets the "linkedin_urn" field to the value that was provided on create.
+func (u *CompanyUpsertOne) UpdateLinkedinUrn() *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.UpdateLinkedinUrn()
+	})
+}
+
+// ClearLinkedinUrn clears the value of the "linkedin_urn" field.
+func (u *CompanyUpsertOne) ClearLinkedinUrn() *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.ClearLinkedinUrn()
+	})
+}
+
+// SetLinkedinUsername sets the "linkedin_username" field.
+func (u *CompanyUpsertOne) SetLinkedinUsername(v string) *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.SetLinkedinUsername(v)
+	})
+}
+
+// UpdateLinkedinUsername sets the "linkedin_username" field to the value that was provided on create.
+func (u *CompanyUpsertOne) UpdateLinkedinUsername() *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.UpdateLinkedinUsername()
+	})
+}
+
+// ClearLinkedinUsername clears the value of the "linkedin_username" field.
+func (u *CompanyUpsertOne) ClearLinkedinUsername() *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.ClearLinkedinUsername()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CompanyUpsertOne) SetUpdatedAt(v time.Time) *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CompanyUpsertOne) UpdateUpdatedAt() *CompanyUpsertOne {
+	return u.Update(func(s *CompanyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CompanyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CompanyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CompanyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CompanyUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CompanyUpsertOne.ID is not supported by MySQL driver. Use CompanyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CompanyUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CompanyCreateBulk is the builder for creating many Company entities in bulk.
+type CompanyCreateBulk struct {
+	config
+	err      error
+	builders []*CompanyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Company entities in the database.
+func (ccb *CompanyCreateBulk) Save(ctx context.Context) ([]*Company, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Company, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CompanyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CompanyCreateBulk) SaveX(ctx context.Context) []*Company {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CompanyCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CompanyCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Company.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CompanyUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CompanyCreateBulk) OnConflict(opts ...sql.ConflictOption) *CompanyUpsertBulk {
+	ccb.conflict = opts
+	return &CompanyUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Company.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CompanyCreateBulk) OnConflictColumns(columns ...string) *CompanyUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CompanyUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CompanyUpsertBulk is the builder for "upsert"-ing
+// a bulk of Company nodes.
+type CompanyUpsertBulk struct {
+	create *CompanyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Company.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(company.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CompanyUpsertBulk) UpdateNewValues() *CompanyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(company.FieldID)
+			}
+			if _, exists := b.mutation.Key(); exists {
+				s.SetIgnore(company.FieldKey)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(company.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Company.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CompanyUpsertBulk) Ignore() *CompanyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CompanyUpsertBulk) DoNothing() *CompanyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CompanyCreateBulk.OnConflict
+// documentation for more info.
+func (u *CompanyUpsertBulk) Update(set func(*CompanyUpsert)) *CompanyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CompanyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CompanyUpsertBulk) SetName(v string) *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CompanyUpsertBulk) UpdateName() *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *CompanyUpsertBulk) SetNormalizedName(v string) *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.SetNormalizedName(v)
+	})
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *CompanyUpsertBulk) UpdateNormalizedName() *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.UpdateNormalizedName()
+	})
+}
+
+// SetLinkedinUrn sets the "linkedin_urn" field.
+func (u *CompanyUpsertBulk) SetLinkedinUrn(v string) *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.SetLinkedinUrn(v)
+	})
+}
+
+// UpdateLinkedinUrn sets the "linkedin_urn" field to the value that was provided on create.
+func (u *CompanyUpsertBulk) UpdateLinkedinUrn() *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.UpdateLinkedinUrn()
+	})
+}
+
+// ClearLinkedinUrn clears the value of the "linkedin_urn" field.
+func (u *CompanyUpsertBulk) ClearLinkedinUrn() *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.ClearLinkedinUrn()
+	})
+}
+
+// SetLinkedinUsername sets the "linkedin_username" field.
+func (u *CompanyUpsertBulk) SetLinkedinUsername(v string) *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.SetLinkedinUsername(v)
+	})
+}
+
+// UpdateLinkedinUsername sets the "linkedin_username" field to the value that was provided on create.
+func (u *CompanyUpsertBulk) UpdateLinkedinUsername() *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.UpdateLinkedinUsername()
+	})
+}
+
+// ClearLinkedinUsername clears the value of the "linkedin_username" field.
+func (u *CompanyUpsertBulk) ClearLinkedinUsername() *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.ClearLinkedinUsername()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CompanyUpsertBulk) SetUpdatedAt(v time.Time) *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CompanyUpsertBulk) UpdateUpdatedAt() *CompanyUpsertBulk {
+	return u.Update(func(s *CompanyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CompanyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CompanyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CompanyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CompanyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
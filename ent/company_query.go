@@ -0,0 +1,926 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"sheng-go-backend/ent/company"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/ent/schema/ulid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CompanyQuery is the builder for querying Company entities.
+type CompanyQuery struct {
+	config
+	ctx                       *QueryContext
+	order                     []company.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.Company
+	withPositions             *ProfilePositionQuery
+	withCurrentEmployees      *ProfileQuery
+	withAlumni                *ProfileQuery
+	loadTotal                 []func(context.Context, []*Company) error
+	modifiers                 []func(*sql.Selector)
+	withNamedPositions        map[string]*ProfilePositionQuery
+	withNamedCurrentEmployees map[string]*ProfileQuery
+	withNamedAlumni           map[string]*ProfileQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CompanyQuery builder.
+func (cq *CompanyQuery) Where(ps ...predicate.Company) *CompanyQuery {
+	cq.predicates = append(cq.predicates, ps...)
+	return cq
+}
+
+// Limit the number of records to be returned by this query.
+func (cq *CompanyQuery) Limit(limit int) *CompanyQuery {
+	cq.ctx.Limit = &limit
+	return cq
+}
+
+// Offset to start from.
+func (cq *CompanyQuery) Offset(offset int) *CompanyQuery {
+	cq.ctx.Offset = &offset
+	return cq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cq *CompanyQuery) Unique(unique bool) *CompanyQuery {
+	cq.ctx.Unique = &unique
+	return cq
+}
+
+// Order specifies how the records should be ordered.
+func (cq *CompanyQuery) Order(o ...company.OrderOption) *CompanyQuery {
+	cq.order = append(cq.order, o...)
+	return cq
+}
+
+// QueryPositions chains the current query on the "positions" edge.
+func (cq *CompanyQuery) QueryPositions() *ProfilePositionQuery {
+	query := (&ProfilePositionClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, selector),
+			sqlgraph.To(profileposition.Table, profileposition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, company.PositionsTable, company.PositionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCurrentEmployees chains the current query on the "current_employees" edge.
+func (cq *CompanyQuery) QueryCurrentEmployees() *ProfileQuery {
+	query := (&ProfileClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, company.CurrentEmployeesTable, company.CurrentEmployeesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAlumni chains the current query on the "alumni" edge.
+func (cq *CompanyQuery) QueryAlumni() *ProfileQuery {
+	query := (&ProfileClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, company.AlumniTable, company.AlumniPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Company entity from the query.
+// Returns a *NotFoundError when no Company was found.
+func (cq *CompanyQuery) First(ctx context.Context) (*Company, error) {
+	nodes, err := cq.Limit(1).All(setContextOp(ctx, cq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{company.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cq *CompanyQuery) FirstX(ctx context.Context) *Company {
+	node, err := cq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Company ID from the query.
+// Returns a *NotFoundError when no Company ID was found.
+func (cq *CompanyQuery) FirstID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = cq.Limit(1).IDs(setContextOp(ctx, cq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{company.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cq *CompanyQuery) FirstIDX(ctx context.Context) ulid.ID {
+	id, err := cq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Company entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Company entity is found.
+// Returns a *NotFoundError when no Company entities are found.
+func (cq *CompanyQuery) Only(ctx context.Context) (*Company, error) {
+	nodes, err := cq.Limit(2).All(setContextOp(ctx, cq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{company.Label}
+	default:
+		return nil, &NotSingularError{company.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cq *CompanyQuery) OnlyX(ctx context.Context) *Company {
+	node, err := cq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Company ID in the query.
+// Returns a *NotSingularError when more than one Company ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cq *CompanyQuery) OnlyID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = cq.Limit(2).IDs(setContextOp(ctx, cq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{company.Label}
+	default:
+		err = &NotSingularError{company.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cq *CompanyQuery) OnlyIDX(ctx context.Context) ulid.ID {
+	id, err := cq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Companies.
+func (cq *CompanyQuery) All(ctx context.Context) ([]*Company, error) {
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryAll)
+	if err := cq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Company, *CompanyQuery]()
+	return withInterceptors[[]*Company](ctx, cq, qr, cq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cq *CompanyQuery) AllX(ctx context.Context) []*Company {
+	nodes, err := cq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Company IDs.
+func (cq *CompanyQuery) IDs(ctx context.Context) (ids []ulid.ID, err error) {
+	if cq.ctx.Unique == nil && cq.path != nil {
+		cq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryIDs)
+	if err = cq.Select(company.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cq *CompanyQuery) IDsX(ctx context.Context) []ulid.ID {
+	ids, err := cq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cq *CompanyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryCount)
+	if err := cq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cq, querierCount[*CompanyQuery](), cq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cq *CompanyQuery) CountX(ctx context.Context) int {
+	count, err := cq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cq *CompanyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryExist)
+	switch _, err := cq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cq *CompanyQuery) ExistX(ctx context.Context) bool {
+	exist, err := cq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CompanyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cq *CompanyQuery) Clone() *CompanyQuery {
+	if cq == nil {
+		return nil
+	}
+	return &CompanyQuery{
+		config:               cq.config,
+		ctx:                  cq.ctx.Clone(),
+		order:                append([]company.OrderOption{}, cq.order...),
+		inters:               append([]Interceptor{}, cq.inters...),
+		predicates:           append([]predicate.Company{}, cq.predicates...),
+		withPositions:        cq.withPositions.Clone(),
+		withCurrentEmployees: cq.withCurrentEmployees.Clone(),
+		withAlumni:           cq.withAlumni.Clone(),
+		// clone intermediate query.
+		sql:  cq.sql.Clone(),
+		path: cq.path,
+	}
+}
+
+// WithPositions tells the query-builder to eager-load the nodes that are connected to
+// the "positions" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CompanyQuery) WithPositions(opts ...func(*ProfilePositionQuery)) *CompanyQuery {
+	query := (&ProfilePositionClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withPositions = query
+	return cq
+}
+
+// WithCurrentEmployees tells the query-builder to eager-load the nodes that are connected to
+// the "current_employees" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CompanyQuery) WithCurrentEmployees(opts ...func(*ProfileQuery)) *CompanyQuery {
+	query := (&ProfileClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withCurrentEmployees = query
+	return cq
+}
+
+// WithAlumni tells the query-builder to eager-load the nodes that are connected to
+// the "alumni" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CompanyQuery) WithAlumni(opts ...func(*ProfileQuery)) *CompanyQuery {
+	query := (&ProfileClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withAlumni = query
+	return cq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Company.Query().
+//		GroupBy(company.FieldKey).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cq *CompanyQuery) GroupBy(field string, fields ...string) *CompanyGroupBy {
+	cq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CompanyGroupBy{build: cq}
+	grbuild.flds = &cq.ctx.Fields
+	grbuild.label = company.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//	}
+//
+//	client.Company.Query().
+//		Select(company.FieldKey).
+//		Scan(ctx, &v)
+func (cq *CompanyQuery) Select(fields ...string) *CompanySelect {
+	cq.ctx.Fields = append(cq.ctx.Fields, fields...)
+	sbuild := &CompanySelect{CompanyQuery: cq}
+	sbuild.label = company.Label
+	sbuild.flds, sbuild.scan = &cq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CompanySelect configured with the given aggregations.
+func (cq *CompanyQuery) Aggregate(fns ...AggregateFunc) *CompanySelect {
+	return cq.Select().Aggregate(fns...)
+}
+
+func (cq *CompanyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cq.ctx.Fields {
+		if !company.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cq.path != nil {
+		prev, err := cq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cq.sql = prev
+	}
+	return nil
+}
+
+func (cq *CompanyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Company, error) {
+	var (
+		nodes       = []*Company{}
+		_spec       = cq.querySpec()
+		loadedTypes = [3]bool{
+			cq.withPositions != nil,
+			cq.withCurrentEmployees != nil,
+			cq.withAlumni != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Company).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Company{config: cq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cq.withPositions; query != nil {
+		if err := cq.loadPositions(ctx, query, nodes,
+			func(n *Company) { n.Edges.Positions = []*ProfilePosition{} },
+			func(n *Company, e *ProfilePosition) { n.Edges.Positions = append(n.Edges.Positions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withCurrentEmployees; query != nil {
+		if err := cq.loadCurrentEmployees(ctx, query, nodes,
+			func(n *Company) { n.Edges.CurrentEmployees = []*Profile{} },
+			func(n *Company, e *Profile) { n.Edges.CurrentEmployees = append(n.Edges.CurrentEmployees, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withAlumni; query != nil {
+		if err := cq.loadAlumni(ctx, query, nodes,
+			func(n *Company) { n.Edges.Alumni = []*Profile{} },
+			func(n *Company, e *Profile) { n.Edges.Alumni = append(n.Edges.Alumni, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range cq.withNamedPositions {
+		if err := cq.loadPositions(ctx, query, nodes,
+			func(n *Company) { n.appendNamedPositions(name) },
+			func(n *Company, e *ProfilePosition) { n.appendNamedPositions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range cq.withNamedCurrentEmployees {
+		if err := cq.loadCurrentEmployees(ctx, query, nodes,
+			func(n *Company) { n.appendNamedCurrentEmployees(name) },
+			func(n *Company, e *Profile) { n.appendNamedCurrentEmployees(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range cq.withNamedAlumni {
+		if err := cq.loadAlumni(ctx, query, nodes,
+			func(n *Company) { n.appendNamedAlumni(name) },
+			func(n *Company, e *Profile) { n.appendNamedAlumni(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range cq.loadTotal {
+		if err := cq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cq *CompanyQuery) loadPositions(ctx context.Context, query *ProfilePositionQuery, nodes []*Company, init func(*Company), assign func(*Company, *ProfilePosition)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[ulid.ID]*Company)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProfilePosition(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(company.PositionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.company_positions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "company_positions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "company_positions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (cq *CompanyQuery) loadCurrentEmployees(ctx context.Context, query *ProfileQuery, nodes []*Company, init func(*Company), assign func(*Company, *Profile)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[ulid.ID]*Company)
+	nids := make(map[ulid.ID]map[*Company]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(company.CurrentEmployeesTable)
+		s.Join(joinT).On(s.C(profile.FieldID), joinT.C(company.CurrentEmployeesPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(company.CurrentEmployeesPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(company.CurrentEmployeesPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(ulid.ID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*ulid.ID)
+				inValue := *values[1].(*ulid.ID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Company]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Profile](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "current_employees" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (cq *CompanyQuery) loadAlumni(ctx context.Context, query *ProfileQuery, nodes []*Company, init func(*Company), assign func(*Company, *Profile)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[ulid.ID]*Company)
+	nids := make(map[ulid.ID]map[*Company]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(company.AlumniTable)
+		s.Join(joinT).On(s.C(profile.FieldID), joinT.C(company.AlumniPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(company.AlumniPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(company.AlumniPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(ulid.ID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*ulid.ID)
+				inValue := *values[1].(*ulid.ID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Company]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Profile](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "alumni" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (cq *CompanyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cq.querySpec()
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
+	_spec.Node.Columns = cq.ctx.Fields
+	if len(cq.ctx.Fields) > 0 {
+		_spec.Unique = cq.ctx.Unique != nil && *cq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
+}
+
+func (cq *CompanyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(company.Table, company.Columns, sqlgraph.NewFieldSpec(company.FieldID, field.TypeString))
+	_spec.From = cq.sql
+	if unique := cq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, company.FieldID)
+		for i := range fields {
+			if fields[i] != company.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cq *CompanyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cq.driver.Dialect())
+	t1 := builder.Table(company.Table)
+	columns := cq.ctx.Fields
+	if len(columns) == 0 {
+		columns = company.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cq.sql != nil {
+		selector = cq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cq.ctx.Unique != nil && *cq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range cq.modifiers {
+		m(selector)
+	}
+	for _, p := range cq.predicates {
+		p(selector)
+	}
+	for _, p := range cq.order {
+		p(selector)
+	}
+	if offset := cq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cq *CompanyQuery) ForUpdate(opts ...sql.LockOption) *CompanyQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cq *CompanyQuery) ForShare(opts ...sql.LockOption) *CompanyQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cq
+}
+
+// WithNamedPositions tells the query-builder to eager-load the nodes that are connected to the "positions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (cq *CompanyQuery) WithNamedPositions(name string, opts ...func(*ProfilePositionQuery)) *CompanyQuery {
+	query := (&ProfilePositionClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if cq.withNamedPositions == nil {
+		cq.withNamedPositions = make(map[string]*ProfilePositionQuery)
+	}
+	cq.withNamedPositions[name] = query
+	return cq
+}
+
+// WithNamedCurrentEmployees tells the query-builder to eager-load the nodes that are connected to the "current_employees"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (cq *CompanyQuery) WithNamedCurrentEmployees(name string, opts ...func(*ProfileQuery)) *CompanyQuery {
+	query := (&ProfileClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if cq.withNamedCurrentEmployees == nil {
+		cq.withNamedCurrentEmployees = make(map[string]*ProfileQuery)
+	}
+	cq.withNamedCurrentEmployees[name] = query
+	return cq
+}
+
+// WithNamedAlumni tells the query-builder to eager-load the nodes that are connected to the "alumni"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (cq *CompanyQuery) WithNamedAlumni(name string, opts ...func(*ProfileQuery)) *CompanyQuery {
+	query := (&ProfileClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if cq.withNamedAlumni == nil {
+		cq.withNamedAlumni = make(map[string]*ProfileQuery)
+	}
+	cq.withNamedAlumni[name] = query
+	return cq
+}
+
+// CompanyGroupBy is the group-by builder for Company entities.
+type CompanyGroupBy struct {
+	selector
+	build *CompanyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cgb *CompanyGroupBy) Aggregate(fns ...AggregateFunc) *CompanyGroupBy {
+	cgb.fns = append(cgb.fns, fns...)
+	return cgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cgb *CompanyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CompanyQuery, *CompanyGroupBy](ctx, cgb.build, cgb, cgb.build.inters, v)
+}
+
+func (cgb *CompanyGroupBy) sqlScan(ctx context.Context, root *CompanyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cgb.fns))
+	for _, fn := range cgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cgb.flds)+len(cgb.fns))
+		for _, f := range *cgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CompanySelect is the builder for selecting fields of Company entities.
+type CompanySelect struct {
+	*CompanyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cs *CompanySelect) Aggregate(fns ...AggregateFunc) *CompanySelect {
+	cs.fns = append(cs.fns, fns...)
+	return cs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cs *CompanySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cs.ctx, ent.OpQuerySelect)
+	if err := cs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CompanyQuery, *CompanySelect](ctx, cs.CompanyQuery, cs, cs.inters, v)
+}
+
+func (cs *CompanySelect) sqlScan(ctx context.Context, root *CompanyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cs.fns))
+	for _, fn := range cs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
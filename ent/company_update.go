@@ -0,0 +1,909 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/company"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CompanyUpdate is the builder for updating Company entities.
+type CompanyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CompanyMutation
+}
+
+// Where appends a list predicates to the CompanyUpdate builder.
+func (cu *CompanyUpdate) Where(ps ...predicate.Company) *CompanyUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CompanyUpdate) SetName(s string) *CompanyUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CompanyUpdate) SetNillableName(s *string) *CompanyUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (cu *CompanyUpdate) SetNormalizedName(s string) *CompanyUpdate {
+	cu.mutation.SetNormalizedName(s)
+	return cu
+}
+
+// SetNillableNormalizedName sets the "normalized_name" field if the given value is not nil.
+func (cu *CompanyUpdate) SetNillableNormalizedName(s *string) *CompanyUpdate {
+	if s != nil {
+		cu.SetNormalizedName(*s)
+	}
+	return cu
+}
+
+// SetLinkedinUrn sets the "linkedin_urn" field.
+func (cu *CompanyUpdate) SetLinkedinUrn(s string) *CompanyUpdate {
+	cu.mutation.SetLinkedinUrn(s)
+	return cu
+}
+
+// SetNillableLinkedinUrn sets the "linkedin_urn" field if the given value is not nil.
+func (cu *CompanyUpdate) SetNillableLinkedinUrn(s *string) *CompanyUpdate {
+	if s != nil {
+		cu.SetLinkedinUrn(*s)
+	}
+	return cu
+}
+
+// ClearLinkedinUrn clears the value of the "linkedin_urn" field.
+func (cu *CompanyUpdate) ClearLinkedinUrn() *CompanyUpdate {
+	cu.mutation.ClearLinkedinUrn()
+	return cu
+}
+
+// SetLinkedinUsername sets the "linkedin_username" field.
+func (cu *CompanyUpdate) SetLinkedinUsername(s string) *CompanyUpdate {
+	cu.mutation.SetLinkedinUsername(s)
+	return cu
+}
+
+// SetNillableLinkedinUsername sets the "linkedin_username" field if the given value is not nil.
+func (cu *CompanyUpdate) SetNillableLinkedinUsername(s *string) *CompanyUpdate {
+	if s != nil {
+		cu.SetLinkedinUsername(*s)
+	}
+	return cu
+}
+
+// ClearLinkedinUsername clears the value of the "linkedin_username" field.
+func (cu *CompanyUpdate) ClearLinkedinUsername() *CompanyUpdate {
+	cu.mutation.ClearLinkedinUsername()
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CompanyUpdate) SetUpdatedAt(t time.Time) *CompanyUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// AddPositionIDs adds the "positions" edge to the ProfilePosition entity by IDs.
+func (cu *CompanyUpdate) AddPositionIDs(ids ...ulid.ID) *CompanyUpdate {
+	cu.mutation.AddPositionIDs(ids...)
+	return cu
+}
+
+// AddPositions adds the "positions" edges to the ProfilePosition entity.
+func (cu *CompanyUpdate) AddPositions(p ...*ProfilePosition) *CompanyUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.AddPositionIDs(ids...)
+}
+
+// AddCurrentEmployeeIDs adds the "current_employees" edge to the Profile entity by IDs.
+func (cu *CompanyUpdate) AddCurrentEmployeeIDs(ids ...ulid.ID) *CompanyUpdate {
+	cu.mutation.AddCurrentEmployeeIDs(ids...)
+	return cu
+}
+
+// AddCurrentEmployees adds the "current_employees" edges to the Profile entity.
+func (cu *CompanyUpdate) AddCurrentEmployees(p ...*Profile) *CompanyUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.AddCurrentEmployeeIDs(ids...)
+}
+
+// AddAlumniIDs adds the "alumni" edge to the Profile entity by IDs.
+func (cu *CompanyUpdate) AddAlumniIDs(ids ...ulid.ID) *CompanyUpdate {
+	cu.mutation.AddAlumniIDs(ids...)
+	return cu
+}
+
+// AddAlumni adds the "alumni" edges to the Profile entity.
+func (cu *CompanyUpdate) AddAlumni(p ...*Profile) *CompanyUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.AddAlumniIDs(ids...)
+}
+
+// Mutation returns the CompanyMutation object of the builder.
+func (cu *CompanyUpdate) Mutation() *CompanyMutation {
+	return cu.mutation
+}
+
+// ClearPositions clears all "positions" edges to the ProfilePosition entity.
+func (cu *CompanyUpdate) ClearPositions() *CompanyUpdate {
+	cu.mutation.ClearPositions()
+	return cu
+}
+
+// RemovePositionIDs removes the "positions" edge to ProfilePosition entities by IDs.
+func (cu *CompanyUpdate) RemovePositionIDs(ids ...ulid.ID) *CompanyUpdate {
+	cu.mutation.RemovePositionIDs(ids...)
+	return cu
+}
+
+// RemovePositions removes "positions" edges to ProfilePosition entities.
+func (cu *CompanyUpdate) RemovePositions(p ...*ProfilePosition) *CompanyUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.RemovePositionIDs(ids...)
+}
+
+// ClearCurrentEmployees clears all "current_employees" edges to the Profile entity.
+func (cu *CompanyUpdate) ClearCurrentEmployees() *CompanyUpdate {
+	cu.mutation.ClearCurrentEmployees()
+	return cu
+}
+
+// RemoveCurrentEmployeeIDs removes the "current_employees" edge to Profile entities by IDs.
+func (cu *CompanyUpdate) RemoveCurrentEmployeeIDs(ids ...ulid.ID) *CompanyUpdate {
+	cu.mutation.RemoveCurrentEmployeeIDs(ids...)
+	return cu
+}
+
+// RemoveCurrentEmployees removes "current_employees" edges to Profile entities.
+func (cu *CompanyUpdate) RemoveCurrentEmployees(p ...*Profile) *CompanyUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.RemoveCurrentEmployeeIDs(ids...)
+}
+
+// ClearAlumni clears all "alumni" edges to the Profile entity.
+func (cu *CompanyUpdate) ClearAlumni() *CompanyUpdate {
+	cu.mutation.ClearAlumni()
+	return cu
+}
+
+// RemoveAlumniIDs removes the "alumni" edge to Profile entities by IDs.
+func (cu *CompanyUpdate) RemoveAlumniIDs(ids ...ulid.ID) *CompanyUpdate {
+	cu.mutation.RemoveAlumniIDs(ids...)
+	return cu
+}
+
+// RemoveAlumni removes "alumni" edges to Profile entities.
+func (cu *CompanyUpdate) RemoveAlumni(p ...*Profile) *CompanyUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.RemoveAlumniIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CompanyUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CompanyUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CompanyUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CompanyUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CompanyUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := company.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CompanyUpdate) check() error {
+	if v, ok := cu.mutation.Name(); ok {
+		if err := company.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Company.name": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.NormalizedName(); ok {
+		if err := company.NormalizedNameValidator(v); err != nil {
+			return &ValidationError{Name: "normalized_name", err: fmt.Errorf(`ent: validator failed for field "Company.normalized_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cu *CompanyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(company.Table, company.Columns, sqlgraph.NewFieldSpec(company.FieldID, field.TypeString))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(company.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.NormalizedName(); ok {
+		_spec.SetField(company.FieldNormalizedName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.LinkedinUrn(); ok {
+		_spec.SetField(company.FieldLinkedinUrn, field.TypeString, value)
+	}
+	if cu.mutation.LinkedinUrnCleared() {
+		_spec.ClearField(company.FieldLinkedinUrn, field.TypeString)
+	}
+	if value, ok := cu.mutation.LinkedinUsername(); ok {
+		_spec.SetField(company.FieldLinkedinUsername, field.TypeString, value)
+	}
+	if cu.mutation.LinkedinUsernameCleared() {
+		_spec.ClearField(company.FieldLinkedinUsername, field.TypeString)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(company.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cu.mutation.PositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.PositionsTable,
+			Columns: []string{company.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedPositionsIDs(); len(nodes) > 0 && !cu.mutation.PositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.PositionsTable,
+			Columns: []string{company.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.PositionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.PositionsTable,
+			Columns: []string{company.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.CurrentEmployeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.CurrentEmployeesTable,
+			Columns: company.CurrentEmployeesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedCurrentEmployeesIDs(); len(nodes) > 0 && !cu.mutation.CurrentEmployeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.CurrentEmployeesTable,
+			Columns: company.CurrentEmployeesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.CurrentEmployeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.CurrentEmployeesTable,
+			Columns: company.CurrentEmployeesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.AlumniCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.AlumniTable,
+			Columns: company.AlumniPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedAlumniIDs(); len(nodes) > 0 && !cu.mutation.AlumniCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.AlumniTable,
+			Columns: company.AlumniPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.AlumniIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.AlumniTable,
+			Columns: company.AlumniPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{company.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CompanyUpdateOne is the builder for updating a single Company entity.
+type CompanyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CompanyMutation
+}
+
+// SetName sets the "name" field.
+func (cuo *CompanyUpdateOne) SetName(s string) *CompanyUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CompanyUpdateOne) SetNillableName(s *string) *CompanyUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (cuo *CompanyUpdateOne) SetNormalizedName(s string) *CompanyUpdateOne {
+	cuo.mutation.SetNormalizedName(s)
+	return cuo
+}
+
+// SetNillableNormalizedName sets the "normalized_name" field if the given value is not nil.
+func (cuo *CompanyUpdateOne) SetNillableNormalizedName(s *string) *CompanyUpdateOne {
+	if s != nil {
+		cuo.SetNormalizedName(*s)
+	}
+	return cuo
+}
+
+// SetLinkedinUrn sets the "linkedin_urn" field.
+func (cuo *CompanyUpdateOne) SetLinkedinUrn(s string) *CompanyUpdateOne {
+	cuo.mutation.SetLinkedinUrn(s)
+	return cuo
+}
+
+// SetNillableLinkedinUrn sets the "linkedin_urn" field if the given value is not nil.
+func (cuo *CompanyUpdateOne) SetNillableLinkedinUrn(s *string) *CompanyUpdateOne {
+	if s != nil {
+		cuo.SetLinkedinUrn(*s)
+	}
+	return cuo
+}
+
+// ClearLinkedinUrn clears the value of the "linkedin_urn" field.
+func (cuo *CompanyUpdateOne) ClearLinkedinUrn() *CompanyUpdateOne {
+	cuo.mutation.ClearLinkedinUrn()
+	return cuo
+}
+
+// SetLinkedinUsername sets the "linkedin_username" field.
+func (cuo *CompanyUpdateOne) SetLinkedinUsername(s string) *CompanyUpdateOne {
+	cuo.mutation.SetLinkedinUsername(s)
+	return cuo
+}
+
+// SetNillableLinkedinUsername sets the "linkedin_username" field if the given value is not nil.
+func (cuo *CompanyUpdateOne) SetNillableLinkedinUsername(s *string) *CompanyUpdateOne {
+	if s != nil {
+		cuo.SetLinkedinUsername(*s)
+	}
+	return cuo
+}
+
+// ClearLinkedinUsername clears the value of the "linkedin_username" field.
+func (cuo *CompanyUpdateOne) ClearLinkedinUsername() *CompanyUpdateOne {
+	cuo.mutation.ClearLinkedinUsername()
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CompanyUpdateOne) SetUpdatedAt(t time.Time) *CompanyUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// AddPositionIDs adds the "positions" edge to the ProfilePosition entity by IDs.
+func (cuo *CompanyUpdateOne) AddPositionIDs(ids ...ulid.ID) *CompanyUpdateOne {
+	cuo.mutation.AddPositionIDs(ids...)
+	return cuo
+}
+
+// AddPositions adds the "positions" edges to the ProfilePosition entity.
+func (cuo *CompanyUpdateOne) AddPositions(p ...*ProfilePosition) *CompanyUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.AddPositionIDs(ids...)
+}
+
+// AddCurrentEmployeeIDs adds the "current_employees" edge to the Profile entity by IDs.
+func (cuo *CompanyUpdateOne) AddCurrentEmployeeIDs(ids ...ulid.ID) *CompanyUpdateOne {
+	cuo.mutation.AddCurrentEmployeeIDs(ids...)
+	return cuo
+}
+
+// AddCurrentEmployees adds the "current_employees" edges to the Profile entity.
+func (cuo *CompanyUpdateOne) AddCurrentEmployees(p ...*Profile) *CompanyUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.AddCurrentEmployeeIDs(ids...)
+}
+
+// AddAlumniIDs adds the "alumni" edge to the Profile entity by IDs.
+func (cuo *CompanyUpdateOne) AddAlumniIDs(ids ...ulid.ID) *CompanyUpdateOne {
+	cuo.mutation.AddAlumniIDs(ids...)
+	return cuo
+}
+
+// AddAlumni adds the "alumni" edges to the Profile entity.
+func (cuo *CompanyUpdateOne) AddAlumni(p ...*Profile) *CompanyUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.AddAlumniIDs(ids...)
+}
+
+// Mutation returns the CompanyMutation object of the builder.
+func (cuo *CompanyUpdateOne) Mutation() *CompanyMutation {
+	return cuo.mutation
+}
+
+// ClearPositions clears all "positions" edges to the ProfilePosition entity.
+func (cuo *CompanyUpdateOne) ClearPositions() *CompanyUpdateOne {
+	cuo.mutation.ClearPositions()
+	return cuo
+}
+
+// RemovePositionIDs removes the "positions" edge to ProfilePosition entities by IDs.
+func (cuo *CompanyUpdateOne) RemovePositionIDs(ids ...ulid.ID) *CompanyUpdateOne {
+	cuo.mutation.RemovePositionIDs(ids...)
+	return cuo
+}
+
+// RemovePositions removes "positions" edges to ProfilePosition entities.
+func (cuo *CompanyUpdateOne) RemovePositions(p ...*ProfilePosition) *CompanyUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.RemovePositionIDs(ids...)
+}
+
+// ClearCurrentEmployees clears all "current_employees" edges to the Profile entity.
+func (cuo *CompanyUpdateOne) ClearCurrentEmployees() *CompanyUpdateOne {
+	cuo.mutation.ClearCurrentEmployees()
+	return cuo
+}
+
+// RemoveCurrentEmployeeIDs removes the "current_employees" edge to Profile entities by IDs.
+func (cuo *CompanyUpdateOne) RemoveCurrentEmployeeIDs(ids ...ulid.ID) *CompanyUpdateOne {
+	cuo.mutation.RemoveCurrentEmployeeIDs(ids...)
+	return cuo
+}
+
+// RemoveCurrentEmployees removes "current_employees" edges to Profile entities.
+func (cuo *CompanyUpdateOne) RemoveCurrentEmployees(p ...*Profile) *CompanyUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.RemoveCurrentEmployeeIDs(ids...)
+}
+
+// ClearAlumni clears all "alumni" edges to the Profile entity.
+func (cuo *CompanyUpdateOne) ClearAlumni() *CompanyUpdateOne {
+	cuo.mutation.ClearAlumni()
+	return cuo
+}
+
+// RemoveAlumniIDs removes the "alumni" edge to Profile entities by IDs.
+func (cuo *CompanyUpdateOne) RemoveAlumniIDs(ids ...ulid.ID) *CompanyUpdateOne {
+	cuo.mutation.RemoveAlumniIDs(ids...)
+	return cuo
+}
+
+// RemoveAlumni removes "alumni" edges to Profile entities.
+func (cuo *CompanyUpdateOne) RemoveAlumni(p ...*Profile) *CompanyUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.RemoveAlumniIDs(ids...)
+}
+
+// Where appends a list predicates to the CompanyUpdate builder.
+func (cuo *CompanyUpdateOne) Where(ps ...predicate.Company) *CompanyUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CompanyUpdateOne) Select(field string, fields ...string) *CompanyUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Company entity.
+func (cuo *CompanyUpdateOne) Save(ctx context.Context) (*Company, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CompanyUpdateOne) SaveX(ctx context.Context) *Company {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CompanyUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CompanyUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CompanyUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := company.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CompanyUpdateOne) check() error {
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := company.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Company.name": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.NormalizedName(); ok {
+		if err := company.NormalizedNameValidator(v); err != nil {
+			return &ValidationError{Name: "normalized_name", err: fmt.Errorf(`ent: validator failed for field "Company.normalized_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cuo *CompanyUpdateOne) sqlSave(ctx context.Context) (_node *Company, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(company.Table, company.Columns, sqlgraph.NewFieldSpec(company.FieldID, field.TypeString))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Company.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, company.FieldID)
+		for _, f := range fields {
+			if !company.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != company.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(company.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.NormalizedName(); ok {
+		_spec.SetField(company.FieldNormalizedName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.LinkedinUrn(); ok {
+		_spec.SetField(company.FieldLinkedinUrn, field.TypeString, value)
+	}
+	if cuo.mutation.LinkedinUrnCleared() {
+		_spec.ClearField(company.FieldLinkedinUrn, field.TypeString)
+	}
+	if value, ok := cuo.mutation.LinkedinUsername(); ok {
+		_spec.SetField(company.FieldLinkedinUsername, field.TypeString, value)
+	}
+	if cuo.mutation.LinkedinUsernameCleared() {
+		_spec.ClearField(company.FieldLinkedinUsername, field.TypeString)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(company.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.PositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.PositionsTable,
+			Columns: []string{company.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedPositionsIDs(); len(nodes) > 0 && !cuo.mutation.PositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.PositionsTable,
+			Columns: []string{company.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.PositionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.PositionsTable,
+			Columns: []string{company.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.CurrentEmployeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.CurrentEmployeesTable,
+			Columns: company.CurrentEmployeesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedCurrentEmployeesIDs(); len(nodes) > 0 && !cuo.mutation.CurrentEmployeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.CurrentEmployeesTable,
+			Columns: company.CurrentEmployeesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.CurrentEmployeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.CurrentEmployeesTable,
+			Columns: company.CurrentEmployeesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.AlumniCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.AlumniTable,
+			Columns: company.AlumniPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedAlumniIDs(); len(nodes) > 0 && !cuo.mutation.AlumniCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.AlumniTable,
+			Columns: company.AlumniPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.AlumniIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   company.AlumniTable,
+			Columns: company.AlumniPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Company{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{company.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -32,6 +32,8 @@ type CronJobConfig struct {
 	Enabled bool `json:"enabled,omitempty"`
 	// Number of items to process per job run
 	BatchSize int `json:"batch_size,omitempty"`
+	// Number of workers processing items in parallel
+	Concurrency int `json:"concurrency,omitempty"`
 	// Admin email for notifications
 	AdminEmail string `json:"admin_email,omitempty"`
 	// Whether this job should respect API quota limits
@@ -50,7 +52,7 @@ func (*CronJobConfig) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case cronjobconfig.FieldEnabled, cronjobconfig.FieldRespectQuota:
 			values[i] = new(sql.NullBool)
-		case cronjobconfig.FieldBatchSize:
+		case cronjobconfig.FieldBatchSize, cronjobconfig.FieldConcurrency:
 			values[i] = new(sql.NullInt64)
 		case cronjobconfig.FieldJobName, cronjobconfig.FieldJobType, cronjobconfig.FieldSchedule, cronjobconfig.FieldAdminEmail:
 			values[i] = new(sql.NullString)
@@ -121,6 +123,12 @@ func (cjc *CronJobConfig) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cjc.BatchSize = int(value.Int64)
 			}
+		case cronjobconfig.FieldConcurrency:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field concurrency", values[i])
+			} else if value.Valid {
+				cjc.Concurrency = int(value.Int64)
+			}
 		case cronjobconfig.FieldAdminEmail:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field admin_email", values[i])
@@ -204,6 +212,9 @@ func (cjc *CronJobConfig) String() string {
 	builder.WriteString("batch_size=")
 	builder.WriteString(fmt.Sprintf("%v", cjc.BatchSize))
 	builder.WriteString(", ")
+	builder.WriteString("concurrency=")
+	builder.WriteString(fmt.Sprintf("%v", cjc.Concurrency))
+	builder.WriteString(", ")
 	builder.WriteString("admin_email=")
 	builder.WriteString(cjc.AdminEmail)
 	builder.WriteString(", ")
@@ -31,6 +31,8 @@ const (
 	FieldEnabled = "enabled"
 	// FieldBatchSize holds the string denoting the batch_size field in the database.
 	FieldBatchSize = "batch_size"
+	// FieldConcurrency holds the string denoting the concurrency field in the database.
+	FieldConcurrency = "concurrency"
 	// FieldAdminEmail holds the string denoting the admin_email field in the database.
 	FieldAdminEmail = "admin_email"
 	// FieldRespectQuota holds the string denoting the respect_quota field in the database.
@@ -53,6 +55,7 @@ var Columns = []string{
 	FieldSchedule,
 	FieldEnabled,
 	FieldBatchSize,
+	FieldConcurrency,
 	FieldAdminEmail,
 	FieldRespectQuota,
 	FieldLastRunAt,
@@ -86,6 +89,10 @@ var (
 	DefaultBatchSize int
 	// BatchSizeValidator is a validator for the "batch_size" field. It is called by the builders before save.
 	BatchSizeValidator func(int) error
+	// DefaultConcurrency holds the default value on creation for the "concurrency" field.
+	DefaultConcurrency int
+	// ConcurrencyValidator is a validator for the "concurrency" field. It is called by the builders before save.
+	ConcurrencyValidator func(int) error
 	// AdminEmailValidator is a validator for the "admin_email" field. It is called by the builders before save.
 	AdminEmailValidator func(string) error
 	// DefaultRespectQuota holds the default value on creation for the "respect_quota" field.
@@ -99,8 +106,11 @@ type JobType string
 
 // JobType values.
 const (
-	JobTypeProfileFetcher JobType = "PROFILE_FETCHER"
-	JobTypeQuotaReset     JobType = "QUOTA_RESET"
+	JobTypeProfileFetcher     JobType = "PROFILE_FETCHER"
+	JobTypeQuotaReset         JobType = "QUOTA_RESET"
+	JobTypeStaleFetchReaper   JobType = "STALE_FETCH_REAPER"
+	JobTypeProfileRefresher   JobType = "PROFILE_REFRESHER"
+	JobTypeChangeEventWebhook JobType = "CHANGE_EVENT_WEBHOOK"
 )
 
 func (jt JobType) String() string {
@@ -110,7 +120,7 @@ func (jt JobType) String() string {
 // JobTypeValidator is a validator for the "job_type" field enum values. It is called by the builders before save.
 func JobTypeValidator(jt JobType) error {
 	switch jt {
-	case JobTypeProfileFetcher, JobTypeQuotaReset:
+	case JobTypeProfileFetcher, JobTypeQuotaReset, JobTypeStaleFetchReaper, JobTypeProfileRefresher, JobTypeChangeEventWebhook:
 		return nil
 	default:
 		return fmt.Errorf("cronjobconfig: invalid enum value for job_type field: %q", jt)
@@ -160,6 +170,11 @@ func ByBatchSize(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBatchSize, opts...).ToFunc()
 }
 
+// ByConcurrency orders the results by the concurrency field.
+func ByConcurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConcurrency, opts...).ToFunc()
+}
+
 // ByAdminEmail orders the results by the admin_email field.
 func ByAdminEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAdminEmail, opts...).ToFunc()
@@ -85,6 +85,11 @@ func BatchSize(v int) predicate.CronJobConfig {
 	return predicate.CronJobConfig(sql.FieldEQ(FieldBatchSize, v))
 }
 
+// Concurrency applies equality check predicate on the "concurrency" field. It's identical to ConcurrencyEQ.
+func Concurrency(v int) predicate.CronJobConfig {
+	return predicate.CronJobConfig(sql.FieldEQ(FieldConcurrency, v))
+}
+
 // AdminEmail applies equality check predicate on the "admin_email" field. It's identical to AdminEmailEQ.
 func AdminEmail(v string) predicate.CronJobConfig {
 	return predicate.CronJobConfig(sql.FieldEQ(FieldAdminEmail, v))
@@ -385,6 +390,46 @@ func BatchSizeLTE(v int) predicate.CronJobConfig {
 	return predicate.CronJobConfig(sql.FieldLTE(FieldBatchSize, v))
 }
 
+// ConcurrencyEQ applies the EQ predicate on the "concurrency" field.
+func ConcurrencyEQ(v int) predicate.CronJobConfig {
+	return predicate.CronJobConfig(sql.FieldEQ(FieldConcurrency, v))
+}
+
+// ConcurrencyNEQ applies the NEQ predicate on the "concurrency" field.
+func ConcurrencyNEQ(v int) predicate.CronJobConfig {
+	return predicate.CronJobConfig(sql.FieldNEQ(FieldConcurrency, v))
+}
+
+// ConcurrencyIn applies the In predicate on the "concurrency" field.
+func ConcurrencyIn(vs ...int) predicate.CronJobConfig {
+	return predicate.CronJobConfig(sql.FieldIn(FieldConcurrency, vs...))
+}
+
+// ConcurrencyNotIn applies the NotIn predicate on the "concurrency" field.
+func ConcurrencyNotIn(vs ...int) predicate.CronJobConfig {
+	return predicate.CronJobConfig(sql.FieldNotIn(FieldConcurrency, vs...))
+}
+
+// ConcurrencyGT applies the GT predicate on the "concurrency" field.
+func ConcurrencyGT(v int) predicate.CronJobConfig {
+	return predicate.CronJobConfig(sql.FieldGT(FieldConcurrency, v))
+}
+
+// ConcurrencyGTE applies the GTE predicate on the "concurrency" field.
+func ConcurrencyGTE(v int) predicate.CronJobConfig {
+	return predicate.CronJobConfig(sql.FieldGTE(FieldConcurrency, v))
+}
+
+// ConcurrencyLT applies the LT predicate on the "concurrency" field.
+func ConcurrencyLT(v int) predicate.CronJobConfig {
+	return predicate.CronJobConfig(sql.FieldLT(FieldConcurrency, v))
+}
+
+// ConcurrencyLTE applies the LTE predicate on the "concurrency" field.
+func ConcurrencyLTE(v int) predicate.CronJobConfig {
+	return predicate.CronJobConfig(sql.FieldLTE(FieldConcurrency, v))
+}
+
 // AdminEmailEQ applies the EQ predicate on the "admin_email" field.
 func AdminEmailEQ(v string) predicate.CronJobConfig {
 	return predicate.CronJobConfig(sql.FieldEQ(FieldAdminEmail, v))
@@ -10,6 +10,8 @@ import (
 	"sheng-go-backend/ent/schema/ulid"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +21,7 @@ type CronJobConfigCreate struct {
 	config
 	mutation *CronJobConfigMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -95,6 +98,20 @@ func (cjcc *CronJobConfigCreate) SetNillableBatchSize(i *int) *CronJobConfigCrea
 	return cjcc
 }
 
+// SetConcurrency sets the "concurrency" field.
+func (cjcc *CronJobConfigCreate) SetConcurrency(i int) *CronJobConfigCreate {
+	cjcc.mutation.SetConcurrency(i)
+	return cjcc
+}
+
+// SetNillableConcurrency sets the "concurrency" field if the given value is not nil.
+func (cjcc *CronJobConfigCreate) SetNillableConcurrency(i *int) *CronJobConfigCreate {
+	if i != nil {
+		cjcc.SetConcurrency(*i)
+	}
+	return cjcc
+}
+
 // SetAdminEmail sets the "admin_email" field.
 func (cjcc *CronJobConfigCreate) SetAdminEmail(s string) *CronJobConfigCreate {
 	cjcc.mutation.SetAdminEmail(s)
@@ -208,6 +225,10 @@ func (cjcc *CronJobConfigCreate) defaults() {
 		v := cronjobconfig.DefaultBatchSize
 		cjcc.mutation.SetBatchSize(v)
 	}
+	if _, ok := cjcc.mutation.Concurrency(); !ok {
+		v := cronjobconfig.DefaultConcurrency
+		cjcc.mutation.SetConcurrency(v)
+	}
 	if _, ok := cjcc.mutation.RespectQuota(); !ok {
 		v := cronjobconfig.DefaultRespectQuota
 		cjcc.mutation.SetRespectQuota(v)
@@ -261,6 +282,14 @@ func (cjcc *CronJobConfigCreate) check() error {
 			return &ValidationError{Name: "batch_size", err: fmt.Errorf(`ent: validator failed for field "CronJobConfig.batch_size": %w`, err)}
 		}
 	}
+	if _, ok := cjcc.mutation.Concurrency(); !ok {
+		return &ValidationError{Name: "concurrency", err: errors.New(`ent: missing required field "CronJobConfig.concurrency"`)}
+	}
+	if v, ok := cjcc.mutation.Concurrency(); ok {
+		if err := cronjobconfig.ConcurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "concurrency", err: fmt.Errorf(`ent: validator failed for field "CronJobConfig.concurrency": %w`, err)}
+		}
+	}
 	if _, ok := cjcc.mutation.AdminEmail(); !ok {
 		return &ValidationError{Name: "admin_email", err: errors.New(`ent: missing required field "CronJobConfig.admin_email"`)}
 	}
@@ -303,6 +332,7 @@ func (cjcc *CronJobConfigCreate) createSpec() (*CronJobConfig, *sqlgraph.CreateS
 		_node = &CronJobConfig{config: cjcc.config}
 		_spec = sqlgraph.NewCreateSpec(cronjobconfig.Table, sqlgraph.NewFieldSpec(cronjobconfig.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = cjcc.conflict
 	if id, ok := cjcc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -335,6 +365,10 @@ func (cjcc *CronJobConfigCreate) createSpec() (*CronJobConfig, *sqlgraph.CreateS
 		_spec.SetField(cronjobconfig.FieldBatchSize, field.TypeInt, value)
 		_node.BatchSize = value
 	}
+	if value, ok := cjcc.mutation.Concurrency(); ok {
+		_spec.SetField(cronjobconfig.FieldConcurrency, field.TypeInt, value)
+		_node.Concurrency = value
+	}
 	if value, ok := cjcc.mutation.AdminEmail(); ok {
 		_spec.SetField(cronjobconfig.FieldAdminEmail, field.TypeString, value)
 		_node.AdminEmail = value
@@ -354,11 +388,488 @@ func (cjcc *CronJobConfigCreate) createSpec() (*CronJobConfig, *sqlgraph.CreateS
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CronJobConfig.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CronJobConfigUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (cjcc *CronJobConfigCreate) OnConflict(opts ...sql.ConflictOption) *CronJobConfigUpsertOne {
+	cjcc.conflict = opts
+	return &CronJobConfigUpsertOne{
+		create: cjcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CronJobConfig.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cjcc *CronJobConfigCreate) OnConflictColumns(columns ...string) *CronJobConfigUpsertOne {
+	cjcc.conflict = append(cjcc.conflict, sql.ConflictColumns(columns...))
+	return &CronJobConfigUpsertOne{
+		create: cjcc,
+	}
+}
+
+type (
+	// CronJobConfigUpsertOne is the builder for "upsert"-ing
+	//  one CronJobConfig node.
+	CronJobConfigUpsertOne struct {
+		create *CronJobConfigCreate
+	}
+
+	// CronJobConfigUpsert is the "OnConflict" setter.
+	CronJobConfigUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CronJobConfigUpsert) SetUpdatedAt(v time.Time) *CronJobConfigUpsert {
+	u.Set(cronjobconfig.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CronJobConfigUpsert) UpdateUpdatedAt() *CronJobConfigUpsert {
+	u.SetExcluded(cronjobconfig.FieldUpdatedAt)
+	return u
+}
+
+// SetJobName sets the "job_name" field.
+func (u *CronJobConfigUpsert) SetJobName(v string) *CronJobConfigUpsert {
+	u.Set(cronjobconfig.FieldJobName, v)
+	return u
+}
+
+// UpdateJobName sets the "job_name" field to the value that was provided on create.
+func (u *CronJobConfigUpsert) UpdateJobName() *CronJobConfigUpsert {
+	u.SetExcluded(cronjobconfig.FieldJobName)
+	return u
+}
+
+// SetJobType sets the "job_type" field.
+func (u *CronJobConfigUpsert) SetJobType(v cronjobconfig.JobType) *CronJobConfigUpsert {
+	u.Set(cronjobconfig.FieldJobType, v)
+	return u
+}
+
+// UpdateJobType sets the "job_type" field to the value that was provided on create.
+func (u *CronJobConfigUpsert) UpdateJobType() *CronJobConfigUpsert {
+	u.SetExcluded(cronjobconfig.FieldJobType)
+	return u
+}
+
+// SetSchedule sets the "schedule" field.
+func (u *CronJobConfigUpsert) SetSchedule(v string) *CronJobConfigUpsert {
+	u.Set(cronjobconfig.FieldSchedule, v)
+	return u
+}
+
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *CronJobConfigUpsert) UpdateSchedule() *CronJobConfigUpsert {
+	u.SetExcluded(cronjobconfig.FieldSchedule)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CronJobConfigUpsert) SetEnabled(v bool) *CronJobConfigUpsert {
+	u.Set(cronjobconfig.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CronJobConfigUpsert) UpdateEnabled() *CronJobConfigUpsert {
+	u.SetExcluded(cronjobconfig.FieldEnabled)
+	return u
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *CronJobConfigUpsert) SetBatchSize(v int) *CronJobConfigUpsert {
+	u.Set(cronjobconfig.FieldBatchSize, v)
+	return u
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *CronJobConfigUpsert) UpdateBatchSize() *CronJobConfigUpsert {
+	u.SetExcluded(cronjobconfig.FieldBatchSize)
+	return u
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *CronJobConfigUpsert) AddBatchSize(v int) *CronJobConfigUpsert {
+	u.Add(cronjobconfig.FieldBatchSize, v)
+	return u
+}
+
+// SetConcurrency sets the "concurrency" field.
+func (u *CronJobConfigUpsert) SetConcurrency(v int) *CronJobConfigUpsert {
+	u.Set(cronjobconfig.FieldConcurrency, v)
+	return u
+}
+
+// UpdateConcurrency sets the "concurrency" field to the value that was provided on create.
+func (u *CronJobConfigUpsert) UpdateConcurrency() *CronJobConfigUpsert {
+	u.SetExcluded(cronjobconfig.FieldConcurrency)
+	return u
+}
+
+// AddConcurrency adds v to the "concurrency" field.
+func (u *CronJobConfigUpsert) AddConcurrency(v int) *CronJobConfigUpsert {
+	u.Add(cronjobconfig.FieldConcurrency, v)
+	return u
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (u *CronJobConfigUpsert) SetAdminEmail(v string) *CronJobConfigUpsert {
+	u.Set(cronjobconfig.FieldAdminEmail, v)
+	return u
+}
+
+// UpdateAdminEmail sets the "admin_email" field to the value that was provided on create.
+func (u *CronJobConfigUpsert) UpdateAdminEmail() *CronJobConfigUpsert {
+	u.SetExcluded(cronjobconfig.FieldAdminEmail)
+	return u
+}
+
+// SetRespectQuota sets the "respect_quota" field.
+func (u *CronJobConfigUpsert) SetRespectQuota(v bool) *CronJobConfigUpsert {
+	u.Set(cronjobconfig.FieldRespectQuota, v)
+	return u
+}
+
+// UpdateRespectQuota sets the "respect_quota" field to the value that was provided on create.
+func (u *CronJobConfigUpsert) UpdateRespectQuota() *CronJobConfigUpsert {
+	u.SetExcluded(cronjobconfig.FieldRespectQuota)
+	return u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *CronJobConfigUpsert) SetLastRunAt(v time.Time) *CronJobConfigUpsert {
+	u.Set(cronjobconfig.FieldLastRunAt, v)
+	return u
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *CronJobConfigUpsert) UpdateLastRunAt() *CronJobConfigUpsert {
+	u.SetExcluded(cronjobconfig.FieldLastRunAt)
+	return u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *CronJobConfigUpsert) ClearLastRunAt() *CronJobConfigUpsert {
+	u.SetNull(cronjobconfig.FieldLastRunAt)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *CronJobConfigUpsert) SetNextRunAt(v time.Time) *CronJobConfigUpsert {
+	u.Set(cronjobconfig.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *CronJobConfigUpsert) UpdateNextRunAt() *CronJobConfigUpsert {
+	u.SetExcluded(cronjobconfig.FieldNextRunAt)
+	return u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *CronJobConfigUpsert) ClearNextRunAt() *CronJobConfigUpsert {
+	u.SetNull(cronjobconfig.FieldNextRunAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CronJobConfig.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cronjobconfig.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CronJobConfigUpsertOne) UpdateNewValues() *CronJobConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(cronjobconfig.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(cronjobconfig.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CronJobConfig.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CronJobConfigUpsertOne) Ignore() *CronJobConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CronJobConfigUpsertOne) DoNothing() *CronJobConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CronJobConfigCreate.OnConflict
+// documentation for more info.
+func (u *CronJobConfigUpsertOne) Update(set func(*CronJobConfigUpsert)) *CronJobConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CronJobConfigUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CronJobConfigUpsertOne) SetUpdatedAt(v time.Time) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CronJobConfigUpsertOne) UpdateUpdatedAt() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetJobName sets the "job_name" field.
+func (u *CronJobConfigUpsertOne) SetJobName(v string) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetJobName(v)
+	})
+}
+
+// UpdateJobName sets the "job_name" field to the value that was provided on create.
+func (u *CronJobConfigUpsertOne) UpdateJobName() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateJobName()
+	})
+}
+
+// SetJobType sets the "job_type" field.
+func (u *CronJobConfigUpsertOne) SetJobType(v cronjobconfig.JobType) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetJobType(v)
+	})
+}
+
+// UpdateJobType sets the "job_type" field to the value that was provided on create.
+func (u *CronJobConfigUpsertOne) UpdateJobType() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateJobType()
+	})
+}
+
+// SetSchedule sets the "schedule" field.
+func (u *CronJobConfigUpsertOne) SetSchedule(v string) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetSchedule(v)
+	})
+}
+
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *CronJobConfigUpsertOne) UpdateSchedule() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateSchedule()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CronJobConfigUpsertOne) SetEnabled(v bool) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CronJobConfigUpsertOne) UpdateEnabled() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *CronJobConfigUpsertOne) SetBatchSize(v int) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetBatchSize(v)
+	})
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *CronJobConfigUpsertOne) AddBatchSize(v int) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.AddBatchSize(v)
+	})
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *CronJobConfigUpsertOne) UpdateBatchSize() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateBatchSize()
+	})
+}
+
+// SetConcurrency sets the "concurrency" field.
+func (u *CronJobConfigUpsertOne) SetConcurrency(v int) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetConcurrency(v)
+	})
+}
+
+// AddConcurrency adds v to the "concurrency" field.
+func (u *CronJobConfigUpsertOne) AddConcurrency(v int) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.AddConcurrency(v)
+	})
+}
+
+// UpdateConcurrency sets the "concurrency" field to the value that was provided on create.
+func (u *CronJobConfigUpsertOne) UpdateConcurrency() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateConcurrency()
+	})
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (u *CronJobConfigUpsertOne) SetAdminEmail(v string) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetAdminEmail(v)
+	})
+}
+
+// UpdateAdminEmail sets the "admin_email" field to the value that was provided on create.
+func (u *CronJobConfigUpsertOne) UpdateAdminEmail() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateAdminEmail()
+	})
+}
+
+// SetRespectQuota sets the "respect_quota" field.
+func (u *CronJobConfigUpsertOne) SetRespectQuota(v bool) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetRespectQuota(v)
+	})
+}
+
+// UpdateRespectQuota sets the "respect_quota" field to the value that was provided on create.
+func (u *CronJobConfigUpsertOne) UpdateRespectQuota() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateRespectQuota()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *CronJobConfigUpsertOne) SetLastRunAt(v time.Time) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *CronJobConfigUpsertOne) UpdateLastRunAt() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *CronJobConfigUpsertOne) ClearLastRunAt() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *CronJobConfigUpsertOne) SetNextRunAt(v time.Time) *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *CronJobConfigUpsertOne) UpdateNextRunAt() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *CronJobConfigUpsertOne) ClearNextRunAt() *CronJobConfigUpsertOne {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CronJobConfigUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CronJobConfigCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CronJobConfigUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CronJobConfigUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CronJobConfigUpsertOne.ID is not supported by MySQL driver. Use CronJobConfigUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CronJobConfigUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // CronJobConfigCreateBulk is the builder for creating many CronJobConfig entities in bulk.
 type CronJobConfigCreateBulk struct {
 	config
 	err      error
 	builders []*CronJobConfigCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the CronJobConfig entities in the database.
@@ -388,6 +899,7 @@ func (cjccb *CronJobConfigCreateBulk) Save(ctx context.Context) ([]*CronJobConfi
 					_, err = mutators[i+1].Mutate(root, cjccb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cjccb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, cjccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -437,3 +949,302 @@ func (cjccb *CronJobConfigCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CronJobConfig.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CronJobConfigUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (cjccb *CronJobConfigCreateBulk) OnConflict(opts ...sql.ConflictOption) *CronJobConfigUpsertBulk {
+	cjccb.conflict = opts
+	return &CronJobConfigUpsertBulk{
+		create: cjccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CronJobConfig.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cjccb *CronJobConfigCreateBulk) OnConflictColumns(columns ...string) *CronJobConfigUpsertBulk {
+	cjccb.conflict = append(cjccb.conflict, sql.ConflictColumns(columns...))
+	return &CronJobConfigUpsertBulk{
+		create: cjccb,
+	}
+}
+
+// CronJobConfigUpsertBulk is the builder for "upsert"-ing
+// a bulk of CronJobConfig nodes.
+type CronJobConfigUpsertBulk struct {
+	create *CronJobConfigCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CronJobConfig.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cronjobconfig.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CronJobConfigUpsertBulk) UpdateNewValues() *CronJobConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(cronjobconfig.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(cronjobconfig.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CronJobConfig.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CronJobConfigUpsertBulk) Ignore() *CronJobConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CronJobConfigUpsertBulk) DoNothing() *CronJobConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CronJobConfigCreateBulk.OnConflict
+// documentation for more info.
+func (u *CronJobConfigUpsertBulk) Update(set func(*CronJobConfigUpsert)) *CronJobConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CronJobConfigUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CronJobConfigUpsertBulk) SetUpdatedAt(v time.Time) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CronJobConfigUpsertBulk) UpdateUpdatedAt() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetJobName sets the "job_name" field.
+func (u *CronJobConfigUpsertBulk) SetJobName(v string) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetJobName(v)
+	})
+}
+
+// UpdateJobName sets the "job_name" field to the value that was provided on create.
+func (u *CronJobConfigUpsertBulk) UpdateJobName() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateJobName()
+	})
+}
+
+// SetJobType sets the "job_type" field.
+func (u *CronJobConfigUpsertBulk) SetJobType(v cronjobconfig.JobType) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetJobType(v)
+	})
+}
+
+// UpdateJobType sets the "job_type" field to the value that was provided on create.
+func (u *CronJobConfigUpsertBulk) UpdateJobType() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateJobType()
+	})
+}
+
+// SetSchedule sets the "schedule" field.
+func (u *CronJobConfigUpsertBulk) SetSchedule(v string) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetSchedule(v)
+	})
+}
+
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *CronJobConfigUpsertBulk) UpdateSchedule() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateSchedule()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CronJobConfigUpsertBulk) SetEnabled(v bool) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CronJobConfigUpsertBulk) UpdateEnabled() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (u *CronJobConfigUpsertBulk) SetBatchSize(v int) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetBatchSize(v)
+	})
+}
+
+// AddBatchSize adds v to the "batch_size" field.
+func (u *CronJobConfigUpsertBulk) AddBatchSize(v int) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.AddBatchSize(v)
+	})
+}
+
+// UpdateBatchSize sets the "batch_size" field to the value that was provided on create.
+func (u *CronJobConfigUpsertBulk) UpdateBatchSize() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateBatchSize()
+	})
+}
+
+// SetConcurrency sets the "concurrency" field.
+func (u *CronJobConfigUpsertBulk) SetConcurrency(v int) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetConcurrency(v)
+	})
+}
+
+// AddConcurrency adds v to the "concurrency" field.
+func (u *CronJobConfigUpsertBulk) AddConcurrency(v int) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.AddConcurrency(v)
+	})
+}
+
+// UpdateConcurrency sets the "concurrency" field to the value that was provided on create.
+func (u *CronJobConfigUpsertBulk) UpdateConcurrency() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateConcurrency()
+	})
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (u *CronJobConfigUpsertBulk) SetAdminEmail(v string) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetAdminEmail(v)
+	})
+}
+
+// UpdateAdminEmail sets the "admin_email" field to the value that was provided on create.
+func (u *CronJobConfigUpsertBulk) UpdateAdminEmail() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateAdminEmail()
+	})
+}
+
+// SetRespectQuota sets the "respect_quota" field.
+func (u *CronJobConfigUpsertBulk) SetRespectQuota(v bool) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetRespectQuota(v)
+	})
+}
+
+// UpdateRespectQuota sets the "respect_quota" field to the value that was provided on create.
+func (u *CronJobConfigUpsertBulk) UpdateRespectQuota() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateRespectQuota()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *CronJobConfigUpsertBulk) SetLastRunAt(v time.Time) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *CronJobConfigUpsertBulk) UpdateLastRunAt() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *CronJobConfigUpsertBulk) ClearLastRunAt() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *CronJobConfigUpsertBulk) SetNextRunAt(v time.Time) *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *CronJobConfigUpsertBulk) UpdateNextRunAt() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *CronJobConfigUpsertBulk) ClearNextRunAt() *CronJobConfigUpsertBulk {
+	return u.Update(func(s *CronJobConfigUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CronJobConfigUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CronJobConfigCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CronJobConfigCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CronJobConfigUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
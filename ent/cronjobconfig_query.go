@@ -11,6 +11,7 @@ import (
 	"sheng-go-backend/ent/schema/ulid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -23,8 +24,8 @@ type CronJobConfigQuery struct {
 	order      []cronjobconfig.OrderOption
 	inters     []Interceptor
 	predicates []predicate.CronJobConfig
-	modifiers  []func(*sql.Selector)
 	loadTotal  []func(context.Context, []*CronJobConfig) error
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -433,6 +434,9 @@ func (cjcq *CronJobConfigQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if cjcq.ctx.Unique != nil && *cjcq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range cjcq.modifiers {
+		m(selector)
+	}
 	for _, p := range cjcq.predicates {
 		p(selector)
 	}
@@ -450,6 +454,32 @@ func (cjcq *CronJobConfigQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cjcq *CronJobConfigQuery) ForUpdate(opts ...sql.LockOption) *CronJobConfigQuery {
+	if cjcq.driver.Dialect() == dialect.Postgres {
+		cjcq.Unique(false)
+	}
+	cjcq.modifiers = append(cjcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cjcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cjcq *CronJobConfigQuery) ForShare(opts ...sql.LockOption) *CronJobConfigQuery {
+	if cjcq.driver.Dialect() == dialect.Postgres {
+		cjcq.Unique(false)
+	}
+	cjcq.modifiers = append(cjcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cjcq
+}
+
 // CronJobConfigGroupBy is the group-by builder for CronJobConfig entities.
 type CronJobConfigGroupBy struct {
 	selector
@@ -111,6 +111,27 @@ func (cjcu *CronJobConfigUpdate) AddBatchSize(i int) *CronJobConfigUpdate {
 	return cjcu
 }
 
+// SetConcurrency sets the "concurrency" field.
+func (cjcu *CronJobConfigUpdate) SetConcurrency(i int) *CronJobConfigUpdate {
+	cjcu.mutation.ResetConcurrency()
+	cjcu.mutation.SetConcurrency(i)
+	return cjcu
+}
+
+// SetNillableConcurrency sets the "concurrency" field if the given value is not nil.
+func (cjcu *CronJobConfigUpdate) SetNillableConcurrency(i *int) *CronJobConfigUpdate {
+	if i != nil {
+		cjcu.SetConcurrency(*i)
+	}
+	return cjcu
+}
+
+// AddConcurrency adds i to the "concurrency" field.
+func (cjcu *CronJobConfigUpdate) AddConcurrency(i int) *CronJobConfigUpdate {
+	cjcu.mutation.AddConcurrency(i)
+	return cjcu
+}
+
 // SetAdminEmail sets the "admin_email" field.
 func (cjcu *CronJobConfigUpdate) SetAdminEmail(s string) *CronJobConfigUpdate {
 	cjcu.mutation.SetAdminEmail(s)
@@ -242,6 +263,11 @@ func (cjcu *CronJobConfigUpdate) check() error {
 			return &ValidationError{Name: "batch_size", err: fmt.Errorf(`ent: validator failed for field "CronJobConfig.batch_size": %w`, err)}
 		}
 	}
+	if v, ok := cjcu.mutation.Concurrency(); ok {
+		if err := cronjobconfig.ConcurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "concurrency", err: fmt.Errorf(`ent: validator failed for field "CronJobConfig.concurrency": %w`, err)}
+		}
+	}
 	if v, ok := cjcu.mutation.AdminEmail(); ok {
 		if err := cronjobconfig.AdminEmailValidator(v); err != nil {
 			return &ValidationError{Name: "admin_email", err: fmt.Errorf(`ent: validator failed for field "CronJobConfig.admin_email": %w`, err)}
@@ -283,6 +309,12 @@ func (cjcu *CronJobConfigUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := cjcu.mutation.AddedBatchSize(); ok {
 		_spec.AddField(cronjobconfig.FieldBatchSize, field.TypeInt, value)
 	}
+	if value, ok := cjcu.mutation.Concurrency(); ok {
+		_spec.SetField(cronjobconfig.FieldConcurrency, field.TypeInt, value)
+	}
+	if value, ok := cjcu.mutation.AddedConcurrency(); ok {
+		_spec.AddField(cronjobconfig.FieldConcurrency, field.TypeInt, value)
+	}
 	if value, ok := cjcu.mutation.AdminEmail(); ok {
 		_spec.SetField(cronjobconfig.FieldAdminEmail, field.TypeString, value)
 	}
@@ -404,6 +436,27 @@ func (cjcuo *CronJobConfigUpdateOne) AddBatchSize(i int) *CronJobConfigUpdateOne
 	return cjcuo
 }
 
+// SetConcurrency sets the "concurrency" field.
+func (cjcuo *CronJobConfigUpdateOne) SetConcurrency(i int) *CronJobConfigUpdateOne {
+	cjcuo.mutation.ResetConcurrency()
+	cjcuo.mutation.SetConcurrency(i)
+	return cjcuo
+}
+
+// SetNillableConcurrency sets the "concurrency" field if the given value is not nil.
+func (cjcuo *CronJobConfigUpdateOne) SetNillableConcurrency(i *int) *CronJobConfigUpdateOne {
+	if i != nil {
+		cjcuo.SetConcurrency(*i)
+	}
+	return cjcuo
+}
+
+// AddConcurrency adds i to the "concurrency" field.
+func (cjcuo *CronJobConfigUpdateOne) AddConcurrency(i int) *CronJobConfigUpdateOne {
+	cjcuo.mutation.AddConcurrency(i)
+	return cjcuo
+}
+
 // SetAdminEmail sets the "admin_email" field.
 func (cjcuo *CronJobConfigUpdateOne) SetAdminEmail(s string) *CronJobConfigUpdateOne {
 	cjcuo.mutation.SetAdminEmail(s)
@@ -548,6 +601,11 @@ func (cjcuo *CronJobConfigUpdateOne) check() error {
 			return &ValidationError{Name: "batch_size", err: fmt.Errorf(`ent: validator failed for field "CronJobConfig.batch_size": %w`, err)}
 		}
 	}
+	if v, ok := cjcuo.mutation.Concurrency(); ok {
+		if err := cronjobconfig.ConcurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "concurrency", err: fmt.Errorf(`ent: validator failed for field "CronJobConfig.concurrency": %w`, err)}
+		}
+	}
 	if v, ok := cjcuo.mutation.AdminEmail(); ok {
 		if err := cronjobconfig.AdminEmailValidator(v); err != nil {
 			return &ValidationError{Name: "admin_email", err: fmt.Errorf(`ent: validator failed for field "CronJobConfig.admin_email": %w`, err)}
@@ -606,6 +664,12 @@ func (cjcuo *CronJobConfigUpdateOne) sqlSave(ctx context.Context) (_node *CronJo
 	if value, ok := cjcuo.mutation.AddedBatchSize(); ok {
 		_spec.AddField(cronjobconfig.FieldBatchSize, field.TypeInt, value)
 	}
+	if value, ok := cjcuo.mutation.Concurrency(); ok {
+		_spec.SetField(cronjobconfig.FieldConcurrency, field.TypeInt, value)
+	}
+	if value, ok := cjcuo.mutation.AddedConcurrency(); ok {
+		_spec.AddField(cronjobconfig.FieldConcurrency, field.TypeInt, value)
+	}
 	if value, ok := cjcuo.mutation.AdminEmail(); ok {
 		_spec.SetField(cronjobconfig.FieldAdminEmail, field.TypeString, value)
 	}
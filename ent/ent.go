@@ -7,13 +7,22 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/cronjobconfig"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
 	"sheng-go-backend/ent/profilepost"
 	"sheng-go-backend/ent/profilepostitem"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/todo"
 	"sheng-go-backend/ent/user"
 	"sync"
@@ -81,13 +90,22 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			apicalllog.Table:          apicalllog.ValidColumn,
+			apiquotabudget.Table:      apiquotabudget.ValidColumn,
+			apiquotareservation.Table: apiquotareservation.ValidColumn,
 			apiquotatracker.Table:     apiquotatracker.ValidColumn,
+			company.Table:             company.ValidColumn,
 			cronjobconfig.Table:       cronjobconfig.ValidColumn,
 			jobexecutionhistory.Table: jobexecutionhistory.ValidColumn,
 			profile.Table:             profile.ValidColumn,
+			profilechangeevent.Table:  profilechangeevent.ValidColumn,
+			profileeducation.Table:    profileeducation.ValidColumn,
 			profileentry.Table:        profileentry.ValidColumn,
+			profileposition.Table:     profileposition.ValidColumn,
 			profilepost.Table:         profilepost.ValidColumn,
 			profilepostitem.Table:     profilepostitem.ValidColumn,
+			profileskill.Table:        profileskill.ValidColumn,
+			profilesnapshot.Table:     profilesnapshot.ValidColumn,
 			todo.Table:                todo.ValidColumn,
 			user.Table:                user.ValidColumn,
 		})
@@ -24,6 +24,7 @@ func main() {
 	opts := []entc.Option{
 		entc.Extensions(ex),
 		entc.TemplateDir("./template"),
+		entc.FeatureNames("sql/lock", "sql/upsert"),
 	}
 
 	if err := entc.Generate("./schema", &gen.Config{}, opts...); err != nil {
@@ -4,19 +4,362 @@ package ent
 
 import (
 	"context"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/cronjobconfig"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
 	"sheng-go-backend/ent/profilepost"
 	"sheng-go-backend/ent/profilepostitem"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/todo"
 	"sheng-go-backend/ent/user"
 
 	"github.com/99designs/gqlgen/graphql"
 )
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (acl *APICallLogQuery) CollectFields(ctx context.Context, satisfies ...string) (*APICallLogQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return acl, nil
+	}
+	if err := acl.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return acl, nil
+}
+
+func (acl *APICallLogQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(apicalllog.Columns))
+		selectedFields = []string{apicalllog.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "jobExecution":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&JobExecutionHistoryClient{config: acl.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, jobexecutionhistoryImplementors)...); err != nil {
+				return err
+			}
+			acl.withJobExecution = query
+		case "endpoint":
+			if _, ok := fieldSeen[apicalllog.FieldEndpoint]; !ok {
+				selectedFields = append(selectedFields, apicalllog.FieldEndpoint)
+				fieldSeen[apicalllog.FieldEndpoint] = struct{}{}
+			}
+		case "keyLabel":
+			if _, ok := fieldSeen[apicalllog.FieldKeyLabel]; !ok {
+				selectedFields = append(selectedFields, apicalllog.FieldKeyLabel)
+				fieldSeen[apicalllog.FieldKeyLabel] = struct{}{}
+			}
+		case "caller":
+			if _, ok := fieldSeen[apicalllog.FieldCaller]; !ok {
+				selectedFields = append(selectedFields, apicalllog.FieldCaller)
+				fieldSeen[apicalllog.FieldCaller] = struct{}{}
+			}
+		case "runID":
+			if _, ok := fieldSeen[apicalllog.FieldRunID]; !ok {
+				selectedFields = append(selectedFields, apicalllog.FieldRunID)
+				fieldSeen[apicalllog.FieldRunID] = struct{}{}
+			}
+		case "urn":
+			if _, ok := fieldSeen[apicalllog.FieldUrn]; !ok {
+				selectedFields = append(selectedFields, apicalllog.FieldUrn)
+				fieldSeen[apicalllog.FieldUrn] = struct{}{}
+			}
+		case "httpStatus":
+			if _, ok := fieldSeen[apicalllog.FieldHTTPStatus]; !ok {
+				selectedFields = append(selectedFields, apicalllog.FieldHTTPStatus)
+				fieldSeen[apicalllog.FieldHTTPStatus] = struct{}{}
+			}
+		case "latencyMs":
+			if _, ok := fieldSeen[apicalllog.FieldLatencyMs]; !ok {
+				selectedFields = append(selectedFields, apicalllog.FieldLatencyMs)
+				fieldSeen[apicalllog.FieldLatencyMs] = struct{}{}
+			}
+		case "bytes":
+			if _, ok := fieldSeen[apicalllog.FieldBytes]; !ok {
+				selectedFields = append(selectedFields, apicalllog.FieldBytes)
+				fieldSeen[apicalllog.FieldBytes] = struct{}{}
+			}
+		case "counted":
+			if _, ok := fieldSeen[apicalllog.FieldCounted]; !ok {
+				selectedFields = append(selectedFields, apicalllog.FieldCounted)
+				fieldSeen[apicalllog.FieldCounted] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[apicalllog.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, apicalllog.FieldCreatedAt)
+				fieldSeen[apicalllog.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[apicalllog.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, apicalllog.FieldUpdatedAt)
+				fieldSeen[apicalllog.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		acl.Select(selectedFields...)
+	}
+	return nil
+}
+
+type apicalllogPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []APICallLogPaginateOption
+}
+
+func newAPICallLogPaginateArgs(rv map[string]any) *apicalllogPaginateArgs {
+	args := &apicalllogPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*APICallLogWhereInput); ok {
+		args.opts = append(args.opts, WithAPICallLogFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (aqb *APIQuotaBudgetQuery) CollectFields(ctx context.Context, satisfies ...string) (*APIQuotaBudgetQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return aqb, nil
+	}
+	if err := aqb.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return aqb, nil
+}
+
+func (aqb *APIQuotaBudgetQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(apiquotabudget.Columns))
+		selectedFields = []string{apiquotabudget.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "name":
+			if _, ok := fieldSeen[apiquotabudget.FieldName]; !ok {
+				selectedFields = append(selectedFields, apiquotabudget.FieldName)
+				fieldSeen[apiquotabudget.FieldName] = struct{}{}
+			}
+		case "percent":
+			if _, ok := fieldSeen[apiquotabudget.FieldPercent]; !ok {
+				selectedFields = append(selectedFields, apiquotabudget.FieldPercent)
+				fieldSeen[apiquotabudget.FieldPercent] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[apiquotabudget.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, apiquotabudget.FieldDescription)
+				fieldSeen[apiquotabudget.FieldDescription] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[apiquotabudget.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, apiquotabudget.FieldCreatedAt)
+				fieldSeen[apiquotabudget.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[apiquotabudget.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, apiquotabudget.FieldUpdatedAt)
+				fieldSeen[apiquotabudget.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		aqb.Select(selectedFields...)
+	}
+	return nil
+}
+
+type apiquotabudgetPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []APIQuotaBudgetPaginateOption
+}
+
+func newAPIQuotaBudgetPaginateArgs(rv map[string]any) *apiquotabudgetPaginateArgs {
+	args := &apiquotabudgetPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*APIQuotaBudgetWhereInput); ok {
+		args.opts = append(args.opts, WithAPIQuotaBudgetFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (aqr *APIQuotaReservationQuery) CollectFields(ctx context.Context, satisfies ...string) (*APIQuotaReservationQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return aqr, nil
+	}
+	if err := aqr.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return aqr, nil
+}
+
+func (aqr *APIQuotaReservationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(apiquotareservation.Columns))
+		selectedFields = []string{apiquotareservation.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "tracker":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&APIQuotaTrackerClient{config: aqr.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, apiquotatrackerImplementors)...); err != nil {
+				return err
+			}
+			aqr.withTracker = query
+
+		case "budgetTracker":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&APIQuotaTrackerClient{config: aqr.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, apiquotatrackerImplementors)...); err != nil {
+				return err
+			}
+			aqr.withBudgetTracker = query
+		case "reserved":
+			if _, ok := fieldSeen[apiquotareservation.FieldReserved]; !ok {
+				selectedFields = append(selectedFields, apiquotareservation.FieldReserved)
+				fieldSeen[apiquotareservation.FieldReserved] = struct{}{}
+			}
+		case "used":
+			if _, ok := fieldSeen[apiquotareservation.FieldUsed]; !ok {
+				selectedFields = append(selectedFields, apiquotareservation.FieldUsed)
+				fieldSeen[apiquotareservation.FieldUsed] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[apiquotareservation.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, apiquotareservation.FieldStatus)
+				fieldSeen[apiquotareservation.FieldStatus] = struct{}{}
+			}
+		case "expiresAt":
+			if _, ok := fieldSeen[apiquotareservation.FieldExpiresAt]; !ok {
+				selectedFields = append(selectedFields, apiquotareservation.FieldExpiresAt)
+				fieldSeen[apiquotareservation.FieldExpiresAt] = struct{}{}
+			}
+		case "settledAt":
+			if _, ok := fieldSeen[apiquotareservation.FieldSettledAt]; !ok {
+				selectedFields = append(selectedFields, apiquotareservation.FieldSettledAt)
+				fieldSeen[apiquotareservation.FieldSettledAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[apiquotareservation.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, apiquotareservation.FieldCreatedAt)
+				fieldSeen[apiquotareservation.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[apiquotareservation.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, apiquotareservation.FieldUpdatedAt)
+				fieldSeen[apiquotareservation.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		aqr.Select(selectedFields...)
+	}
+	return nil
+}
+
+type apiquotareservationPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []APIQuotaReservationPaginateOption
+}
+
+func newAPIQuotaReservationPaginateArgs(rv map[string]any) *apiquotareservationPaginateArgs {
+	args := &apiquotareservationPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*APIQuotaReservationWhereInput); ok {
+		args.opts = append(args.opts, WithAPIQuotaReservationFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (aqt *APIQuotaTrackerQuery) CollectFields(ctx context.Context, satisfies ...string) (*APIQuotaTrackerQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -38,6 +381,32 @@ func (aqt *APIQuotaTrackerQuery) collectField(ctx context.Context, oneNode bool,
 	)
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
+
+		case "reservations":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&APIQuotaReservationClient{config: aqt.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, apiquotareservationImplementors)...); err != nil {
+				return err
+			}
+			aqt.WithNamedReservations(alias, func(wq *APIQuotaReservationQuery) {
+				*wq = *query
+			})
+
+		case "budgetReservations":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&APIQuotaReservationClient{config: aqt.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, apiquotareservationImplementors)...); err != nil {
+				return err
+			}
+			aqt.WithNamedBudgetReservations(alias, func(wq *APIQuotaReservationQuery) {
+				*wq = *query
+			})
 		case "createdAt":
 			if _, ok := fieldSeen[apiquotatracker.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, apiquotatracker.FieldCreatedAt)
@@ -48,6 +417,16 @@ func (aqt *APIQuotaTrackerQuery) collectField(ctx context.Context, oneNode bool,
 				selectedFields = append(selectedFields, apiquotatracker.FieldUpdatedAt)
 				fieldSeen[apiquotatracker.FieldUpdatedAt] = struct{}{}
 			}
+		case "keyLabel":
+			if _, ok := fieldSeen[apiquotatracker.FieldKeyLabel]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldKeyLabel)
+				fieldSeen[apiquotatracker.FieldKeyLabel] = struct{}{}
+			}
+		case "budget":
+			if _, ok := fieldSeen[apiquotatracker.FieldBudget]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldBudget)
+				fieldSeen[apiquotatracker.FieldBudget] = struct{}{}
+			}
 		case "month":
 			if _, ok := fieldSeen[apiquotatracker.FieldMonth]; !ok {
 				selectedFields = append(selectedFields, apiquotatracker.FieldMonth)
@@ -63,30 +442,196 @@ func (aqt *APIQuotaTrackerQuery) collectField(ctx context.Context, oneNode bool,
 				selectedFields = append(selectedFields, apiquotatracker.FieldCallCount)
 				fieldSeen[apiquotatracker.FieldCallCount] = struct{}{}
 			}
-		case "quotaLimit":
-			if _, ok := fieldSeen[apiquotatracker.FieldQuotaLimit]; !ok {
-				selectedFields = append(selectedFields, apiquotatracker.FieldQuotaLimit)
-				fieldSeen[apiquotatracker.FieldQuotaLimit] = struct{}{}
+		case "reservedCount":
+			if _, ok := fieldSeen[apiquotatracker.FieldReservedCount]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldReservedCount)
+				fieldSeen[apiquotatracker.FieldReservedCount] = struct{}{}
+			}
+		case "quotaLimit":
+			if _, ok := fieldSeen[apiquotatracker.FieldQuotaLimit]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldQuotaLimit)
+				fieldSeen[apiquotatracker.FieldQuotaLimit] = struct{}{}
+			}
+		case "dailyCap":
+			if _, ok := fieldSeen[apiquotatracker.FieldDailyCap]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldDailyCap)
+				fieldSeen[apiquotatracker.FieldDailyCap] = struct{}{}
+			}
+		case "hourlyCap":
+			if _, ok := fieldSeen[apiquotatracker.FieldHourlyCap]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldHourlyCap)
+				fieldSeen[apiquotatracker.FieldHourlyCap] = struct{}{}
+			}
+		case "evenPacing":
+			if _, ok := fieldSeen[apiquotatracker.FieldEvenPacing]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldEvenPacing)
+				fieldSeen[apiquotatracker.FieldEvenPacing] = struct{}{}
+			}
+		case "quotaExceeded":
+			if _, ok := fieldSeen[apiquotatracker.FieldQuotaExceeded]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldQuotaExceeded)
+				fieldSeen[apiquotatracker.FieldQuotaExceeded] = struct{}{}
+			}
+		case "overrideEnabled":
+			if _, ok := fieldSeen[apiquotatracker.FieldOverrideEnabled]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldOverrideEnabled)
+				fieldSeen[apiquotatracker.FieldOverrideEnabled] = struct{}{}
+			}
+		case "notificationSent":
+			if _, ok := fieldSeen[apiquotatracker.FieldNotificationSent]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldNotificationSent)
+				fieldSeen[apiquotatracker.FieldNotificationSent] = struct{}{}
+			}
+		case "alertedThresholds":
+			if _, ok := fieldSeen[apiquotatracker.FieldAlertedThresholds]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldAlertedThresholds)
+				fieldSeen[apiquotatracker.FieldAlertedThresholds] = struct{}{}
+			}
+		case "lastCallAt":
+			if _, ok := fieldSeen[apiquotatracker.FieldLastCallAt]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldLastCallAt)
+				fieldSeen[apiquotatracker.FieldLastCallAt] = struct{}{}
+			}
+		case "rateLimitedUntil":
+			if _, ok := fieldSeen[apiquotatracker.FieldRateLimitedUntil]; !ok {
+				selectedFields = append(selectedFields, apiquotatracker.FieldRateLimitedUntil)
+				fieldSeen[apiquotatracker.FieldRateLimitedUntil] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		aqt.Select(selectedFields...)
+	}
+	return nil
+}
+
+type apiquotatrackerPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []APIQuotaTrackerPaginateOption
+}
+
+func newAPIQuotaTrackerPaginateArgs(rv map[string]any) *apiquotatrackerPaginateArgs {
+	args := &apiquotatrackerPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*APIQuotaTrackerWhereInput); ok {
+		args.opts = append(args.opts, WithAPIQuotaTrackerFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (c *CompanyQuery) CollectFields(ctx context.Context, satisfies ...string) (*CompanyQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return c, nil
+	}
+	if err := c.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *CompanyQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(company.Columns))
+		selectedFields = []string{company.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "positions":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfilePositionClient{config: c.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, profilepositionImplementors)...); err != nil {
+				return err
+			}
+			c.WithNamedPositions(alias, func(wq *ProfilePositionQuery) {
+				*wq = *query
+			})
+
+		case "currentEmployees":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileClient{config: c.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, profileImplementors)...); err != nil {
+				return err
+			}
+			c.WithNamedCurrentEmployees(alias, func(wq *ProfileQuery) {
+				*wq = *query
+			})
+
+		case "alumni":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileClient{config: c.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, profileImplementors)...); err != nil {
+				return err
+			}
+			c.WithNamedAlumni(alias, func(wq *ProfileQuery) {
+				*wq = *query
+			})
+		case "key":
+			if _, ok := fieldSeen[company.FieldKey]; !ok {
+				selectedFields = append(selectedFields, company.FieldKey)
+				fieldSeen[company.FieldKey] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[company.FieldName]; !ok {
+				selectedFields = append(selectedFields, company.FieldName)
+				fieldSeen[company.FieldName] = struct{}{}
+			}
+		case "normalizedName":
+			if _, ok := fieldSeen[company.FieldNormalizedName]; !ok {
+				selectedFields = append(selectedFields, company.FieldNormalizedName)
+				fieldSeen[company.FieldNormalizedName] = struct{}{}
 			}
-		case "quotaExceeded":
-			if _, ok := fieldSeen[apiquotatracker.FieldQuotaExceeded]; !ok {
-				selectedFields = append(selectedFields, apiquotatracker.FieldQuotaExceeded)
-				fieldSeen[apiquotatracker.FieldQuotaExceeded] = struct{}{}
+		case "linkedinUrn":
+			if _, ok := fieldSeen[company.FieldLinkedinUrn]; !ok {
+				selectedFields = append(selectedFields, company.FieldLinkedinUrn)
+				fieldSeen[company.FieldLinkedinUrn] = struct{}{}
 			}
-		case "overrideEnabled":
-			if _, ok := fieldSeen[apiquotatracker.FieldOverrideEnabled]; !ok {
-				selectedFields = append(selectedFields, apiquotatracker.FieldOverrideEnabled)
-				fieldSeen[apiquotatracker.FieldOverrideEnabled] = struct{}{}
+		case "linkedinUsername":
+			if _, ok := fieldSeen[company.FieldLinkedinUsername]; !ok {
+				selectedFields = append(selectedFields, company.FieldLinkedinUsername)
+				fieldSeen[company.FieldLinkedinUsername] = struct{}{}
 			}
-		case "notificationSent":
-			if _, ok := fieldSeen[apiquotatracker.FieldNotificationSent]; !ok {
-				selectedFields = append(selectedFields, apiquotatracker.FieldNotificationSent)
-				fieldSeen[apiquotatracker.FieldNotificationSent] = struct{}{}
+		case "createdAt":
+			if _, ok := fieldSeen[company.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, company.FieldCreatedAt)
+				fieldSeen[company.FieldCreatedAt] = struct{}{}
 			}
-		case "lastCallAt":
-			if _, ok := fieldSeen[apiquotatracker.FieldLastCallAt]; !ok {
-				selectedFields = append(selectedFields, apiquotatracker.FieldLastCallAt)
-				fieldSeen[apiquotatracker.FieldLastCallAt] = struct{}{}
+		case "updatedAt":
+			if _, ok := fieldSeen[company.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, company.FieldUpdatedAt)
+				fieldSeen[company.FieldUpdatedAt] = struct{}{}
 			}
 		case "id":
 		case "__typename":
@@ -95,19 +640,19 @@ func (aqt *APIQuotaTrackerQuery) collectField(ctx context.Context, oneNode bool,
 		}
 	}
 	if !unknownSeen {
-		aqt.Select(selectedFields...)
+		c.Select(selectedFields...)
 	}
 	return nil
 }
 
-type apiquotatrackerPaginateArgs struct {
+type companyPaginateArgs struct {
 	first, last   *int
 	after, before *Cursor
-	opts          []APIQuotaTrackerPaginateOption
+	opts          []CompanyPaginateOption
 }
 
-func newAPIQuotaTrackerPaginateArgs(rv map[string]any) *apiquotatrackerPaginateArgs {
-	args := &apiquotatrackerPaginateArgs{}
+func newCompanyPaginateArgs(rv map[string]any) *companyPaginateArgs {
+	args := &companyPaginateArgs{}
 	if rv == nil {
 		return args
 	}
@@ -123,8 +668,8 @@ func newAPIQuotaTrackerPaginateArgs(rv map[string]any) *apiquotatrackerPaginateA
 	if v := rv[beforeField]; v != nil {
 		args.before = v.(*Cursor)
 	}
-	if v, ok := rv[whereField].(*APIQuotaTrackerWhereInput); ok {
-		args.opts = append(args.opts, WithAPIQuotaTrackerFilter(v.Filter))
+	if v, ok := rv[whereField].(*CompanyWhereInput); ok {
+		args.opts = append(args.opts, WithCompanyFilter(v.Filter))
 	}
 	return args
 }
@@ -185,6 +730,11 @@ func (cjc *CronJobConfigQuery) collectField(ctx context.Context, oneNode bool, o
 				selectedFields = append(selectedFields, cronjobconfig.FieldBatchSize)
 				fieldSeen[cronjobconfig.FieldBatchSize] = struct{}{}
 			}
+		case "concurrency":
+			if _, ok := fieldSeen[cronjobconfig.FieldConcurrency]; !ok {
+				selectedFields = append(selectedFields, cronjobconfig.FieldConcurrency)
+				fieldSeen[cronjobconfig.FieldConcurrency] = struct{}{}
+			}
 		case "adminEmail":
 			if _, ok := fieldSeen[cronjobconfig.FieldAdminEmail]; !ok {
 				selectedFields = append(selectedFields, cronjobconfig.FieldAdminEmail)
@@ -280,6 +830,19 @@ func (jeh *JobExecutionHistoryQuery) collectField(ctx context.Context, oneNode b
 			jeh.WithNamedProfileEntries(alias, func(wq *ProfileEntryQuery) {
 				*wq = *query
 			})
+
+		case "apiCalls":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&APICallLogClient{config: jeh.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, apicalllogImplementors)...); err != nil {
+				return err
+			}
+			jeh.WithNamedAPICalls(alias, func(wq *APICallLogQuery) {
+				*wq = *query
+			})
 		case "createdAt":
 			if _, ok := fieldSeen[jobexecutionhistory.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, jobexecutionhistory.FieldCreatedAt)
@@ -345,6 +908,16 @@ func (jeh *JobExecutionHistoryQuery) collectField(ctx context.Context, oneNode b
 				selectedFields = append(selectedFields, jobexecutionhistory.FieldErrorSummary)
 				fieldSeen[jobexecutionhistory.FieldErrorSummary] = struct{}{}
 			}
+		case "schemaDriftDetected":
+			if _, ok := fieldSeen[jobexecutionhistory.FieldSchemaDriftDetected]; !ok {
+				selectedFields = append(selectedFields, jobexecutionhistory.FieldSchemaDriftDetected)
+				fieldSeen[jobexecutionhistory.FieldSchemaDriftDetected] = struct{}{}
+			}
+		case "schemaDrift":
+			if _, ok := fieldSeen[jobexecutionhistory.FieldSchemaDrift]; !ok {
+				selectedFields = append(selectedFields, jobexecutionhistory.FieldSchemaDrift)
+				fieldSeen[jobexecutionhistory.FieldSchemaDrift] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -418,6 +991,97 @@ func (pr *ProfileQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				return err
 			}
 			pr.withProfileEntry = query
+
+		case "snapshots":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileSnapshotClient{config: pr.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, profilesnapshotImplementors)...); err != nil {
+				return err
+			}
+			pr.WithNamedSnapshots(alias, func(wq *ProfileSnapshotQuery) {
+				*wq = *query
+			})
+
+		case "changeEvents":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileChangeEventClient{config: pr.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, profilechangeeventImplementors)...); err != nil {
+				return err
+			}
+			pr.WithNamedChangeEvents(alias, func(wq *ProfileChangeEventQuery) {
+				*wq = *query
+			})
+
+		case "profilePositions":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfilePositionClient{config: pr.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, profilepositionImplementors)...); err != nil {
+				return err
+			}
+			pr.WithNamedProfilePositions(alias, func(wq *ProfilePositionQuery) {
+				*wq = *query
+			})
+
+		case "profileEducations":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileEducationClient{config: pr.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, profileeducationImplementors)...); err != nil {
+				return err
+			}
+			pr.WithNamedProfileEducations(alias, func(wq *ProfileEducationQuery) {
+				*wq = *query
+			})
+
+		case "profileSkills":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileSkillClient{config: pr.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, profileskillImplementors)...); err != nil {
+				return err
+			}
+			pr.WithNamedProfileSkills(alias, func(wq *ProfileSkillQuery) {
+				*wq = *query
+			})
+
+		case "currentCompanies":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CompanyClient{config: pr.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, companyImplementors)...); err != nil {
+				return err
+			}
+			pr.WithNamedCurrentCompanies(alias, func(wq *CompanyQuery) {
+				*wq = *query
+			})
+
+		case "pastCompanies":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CompanyClient{config: pr.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, companyImplementors)...); err != nil {
+				return err
+			}
+			pr.WithNamedPastCompanies(alias, func(wq *CompanyQuery) {
+				*wq = *query
+			})
 		case "urn":
 			if _, ok := fieldSeen[profile.FieldUrn]; !ok {
 				selectedFields = append(selectedFields, profile.FieldUrn)
@@ -488,20 +1152,277 @@ func (pr *ProfileQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				selectedFields = append(selectedFields, profile.FieldCleanedDataS3Key)
 				fieldSeen[profile.FieldCleanedDataS3Key] = struct{}{}
 			}
-		case "sourceFile":
-			if _, ok := fieldSeen[profile.FieldSourceFile]; !ok {
-				selectedFields = append(selectedFields, profile.FieldSourceFile)
-				fieldSeen[profile.FieldSourceFile] = struct{}{}
+		case "sourceFile":
+			if _, ok := fieldSeen[profile.FieldSourceFile]; !ok {
+				selectedFields = append(selectedFields, profile.FieldSourceFile)
+				fieldSeen[profile.FieldSourceFile] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[profile.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, profile.FieldCreatedAt)
+				fieldSeen[profile.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[profile.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, profile.FieldUpdatedAt)
+				fieldSeen[profile.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		pr.Select(selectedFields...)
+	}
+	return nil
+}
+
+type profilePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ProfilePaginateOption
+}
+
+func newProfilePaginateArgs(rv map[string]any) *profilePaginateArgs {
+	args := &profilePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*ProfileWhereInput); ok {
+		args.opts = append(args.opts, WithProfileFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (pce *ProfileChangeEventQuery) CollectFields(ctx context.Context, satisfies ...string) (*ProfileChangeEventQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return pce, nil
+	}
+	if err := pce.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return pce, nil
+}
+
+func (pce *ProfileChangeEventQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(profilechangeevent.Columns))
+		selectedFields = []string{profilechangeevent.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "profile":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileClient{config: pce.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, profileImplementors)...); err != nil {
+				return err
+			}
+			pce.withProfile = query
+
+		case "snapshot":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileSnapshotClient{config: pce.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, profilesnapshotImplementors)...); err != nil {
+				return err
+			}
+			pce.withSnapshot = query
+		case "type":
+			if _, ok := fieldSeen[profilechangeevent.FieldType]; !ok {
+				selectedFields = append(selectedFields, profilechangeevent.FieldType)
+				fieldSeen[profilechangeevent.FieldType] = struct{}{}
+			}
+		case "oldValue":
+			if _, ok := fieldSeen[profilechangeevent.FieldOldValue]; !ok {
+				selectedFields = append(selectedFields, profilechangeevent.FieldOldValue)
+				fieldSeen[profilechangeevent.FieldOldValue] = struct{}{}
+			}
+		case "newValue":
+			if _, ok := fieldSeen[profilechangeevent.FieldNewValue]; !ok {
+				selectedFields = append(selectedFields, profilechangeevent.FieldNewValue)
+				fieldSeen[profilechangeevent.FieldNewValue] = struct{}{}
+			}
+		case "detectedAt":
+			if _, ok := fieldSeen[profilechangeevent.FieldDetectedAt]; !ok {
+				selectedFields = append(selectedFields, profilechangeevent.FieldDetectedAt)
+				fieldSeen[profilechangeevent.FieldDetectedAt] = struct{}{}
+			}
+		case "deliveryStatus":
+			if _, ok := fieldSeen[profilechangeevent.FieldDeliveryStatus]; !ok {
+				selectedFields = append(selectedFields, profilechangeevent.FieldDeliveryStatus)
+				fieldSeen[profilechangeevent.FieldDeliveryStatus] = struct{}{}
+			}
+		case "deliveryAttempts":
+			if _, ok := fieldSeen[profilechangeevent.FieldDeliveryAttempts]; !ok {
+				selectedFields = append(selectedFields, profilechangeevent.FieldDeliveryAttempts)
+				fieldSeen[profilechangeevent.FieldDeliveryAttempts] = struct{}{}
+			}
+		case "nextDeliveryAt":
+			if _, ok := fieldSeen[profilechangeevent.FieldNextDeliveryAt]; !ok {
+				selectedFields = append(selectedFields, profilechangeevent.FieldNextDeliveryAt)
+				fieldSeen[profilechangeevent.FieldNextDeliveryAt] = struct{}{}
+			}
+		case "deliveredAt":
+			if _, ok := fieldSeen[profilechangeevent.FieldDeliveredAt]; !ok {
+				selectedFields = append(selectedFields, profilechangeevent.FieldDeliveredAt)
+				fieldSeen[profilechangeevent.FieldDeliveredAt] = struct{}{}
+			}
+		case "lastDeliveryError":
+			if _, ok := fieldSeen[profilechangeevent.FieldLastDeliveryError]; !ok {
+				selectedFields = append(selectedFields, profilechangeevent.FieldLastDeliveryError)
+				fieldSeen[profilechangeevent.FieldLastDeliveryError] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[profilechangeevent.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, profilechangeevent.FieldCreatedAt)
+				fieldSeen[profilechangeevent.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[profilechangeevent.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, profilechangeevent.FieldUpdatedAt)
+				fieldSeen[profilechangeevent.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		pce.Select(selectedFields...)
+	}
+	return nil
+}
+
+type profilechangeeventPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ProfileChangeEventPaginateOption
+}
+
+func newProfileChangeEventPaginateArgs(rv map[string]any) *profilechangeeventPaginateArgs {
+	args := &profilechangeeventPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*ProfileChangeEventWhereInput); ok {
+		args.opts = append(args.opts, WithProfileChangeEventFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (pe *ProfileEducationQuery) CollectFields(ctx context.Context, satisfies ...string) (*ProfileEducationQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return pe, nil
+	}
+	if err := pe.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return pe, nil
+}
+
+func (pe *ProfileEducationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(profileeducation.Columns))
+		selectedFields = []string{profileeducation.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "profile":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileClient{config: pe.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, profileImplementors)...); err != nil {
+				return err
+			}
+			pe.withProfile = query
+		case "educationIndex":
+			if _, ok := fieldSeen[profileeducation.FieldEducationIndex]; !ok {
+				selectedFields = append(selectedFields, profileeducation.FieldEducationIndex)
+				fieldSeen[profileeducation.FieldEducationIndex] = struct{}{}
+			}
+		case "schoolName":
+			if _, ok := fieldSeen[profileeducation.FieldSchoolName]; !ok {
+				selectedFields = append(selectedFields, profileeducation.FieldSchoolName)
+				fieldSeen[profileeducation.FieldSchoolName] = struct{}{}
+			}
+		case "schoolID":
+			if _, ok := fieldSeen[profileeducation.FieldSchoolID]; !ok {
+				selectedFields = append(selectedFields, profileeducation.FieldSchoolID)
+				fieldSeen[profileeducation.FieldSchoolID] = struct{}{}
+			}
+		case "degree":
+			if _, ok := fieldSeen[profileeducation.FieldDegree]; !ok {
+				selectedFields = append(selectedFields, profileeducation.FieldDegree)
+				fieldSeen[profileeducation.FieldDegree] = struct{}{}
+			}
+		case "fieldOfStudy":
+			if _, ok := fieldSeen[profileeducation.FieldFieldOfStudy]; !ok {
+				selectedFields = append(selectedFields, profileeducation.FieldFieldOfStudy)
+				fieldSeen[profileeducation.FieldFieldOfStudy] = struct{}{}
+			}
+		case "startDate":
+			if _, ok := fieldSeen[profileeducation.FieldStartDate]; !ok {
+				selectedFields = append(selectedFields, profileeducation.FieldStartDate)
+				fieldSeen[profileeducation.FieldStartDate] = struct{}{}
+			}
+		case "endDate":
+			if _, ok := fieldSeen[profileeducation.FieldEndDate]; !ok {
+				selectedFields = append(selectedFields, profileeducation.FieldEndDate)
+				fieldSeen[profileeducation.FieldEndDate] = struct{}{}
 			}
 		case "createdAt":
-			if _, ok := fieldSeen[profile.FieldCreatedAt]; !ok {
-				selectedFields = append(selectedFields, profile.FieldCreatedAt)
-				fieldSeen[profile.FieldCreatedAt] = struct{}{}
+			if _, ok := fieldSeen[profileeducation.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, profileeducation.FieldCreatedAt)
+				fieldSeen[profileeducation.FieldCreatedAt] = struct{}{}
 			}
 		case "updatedAt":
-			if _, ok := fieldSeen[profile.FieldUpdatedAt]; !ok {
-				selectedFields = append(selectedFields, profile.FieldUpdatedAt)
-				fieldSeen[profile.FieldUpdatedAt] = struct{}{}
+			if _, ok := fieldSeen[profileeducation.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, profileeducation.FieldUpdatedAt)
+				fieldSeen[profileeducation.FieldUpdatedAt] = struct{}{}
 			}
 		case "id":
 		case "__typename":
@@ -510,19 +1431,19 @@ func (pr *ProfileQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 		}
 	}
 	if !unknownSeen {
-		pr.Select(selectedFields...)
+		pe.Select(selectedFields...)
 	}
 	return nil
 }
 
-type profilePaginateArgs struct {
+type profileeducationPaginateArgs struct {
 	first, last   *int
 	after, before *Cursor
-	opts          []ProfilePaginateOption
+	opts          []ProfileEducationPaginateOption
 }
 
-func newProfilePaginateArgs(rv map[string]any) *profilePaginateArgs {
-	args := &profilePaginateArgs{}
+func newProfileEducationPaginateArgs(rv map[string]any) *profileeducationPaginateArgs {
+	args := &profileeducationPaginateArgs{}
 	if rv == nil {
 		return args
 	}
@@ -538,8 +1459,8 @@ func newProfilePaginateArgs(rv map[string]any) *profilePaginateArgs {
 	if v := rv[beforeField]; v != nil {
 		args.before = v.(*Cursor)
 	}
-	if v, ok := rv[whereField].(*ProfileWhereInput); ok {
-		args.opts = append(args.opts, WithProfileFilter(v.Filter))
+	if v, ok := rv[whereField].(*ProfileEducationWhereInput); ok {
+		args.opts = append(args.opts, WithProfileEducationFilter(v.Filter))
 	}
 	return args
 }
@@ -644,6 +1565,26 @@ func (pe *ProfileEntryQuery) collectField(ctx context.Context, oneNode bool, opC
 				selectedFields = append(selectedFields, profileentry.FieldErrorMessage)
 				fieldSeen[profileentry.FieldErrorMessage] = struct{}{}
 			}
+		case "attemptCount":
+			if _, ok := fieldSeen[profileentry.FieldAttemptCount]; !ok {
+				selectedFields = append(selectedFields, profileentry.FieldAttemptCount)
+				fieldSeen[profileentry.FieldAttemptCount] = struct{}{}
+			}
+		case "nextRetryAt":
+			if _, ok := fieldSeen[profileentry.FieldNextRetryAt]; !ok {
+				selectedFields = append(selectedFields, profileentry.FieldNextRetryAt)
+				fieldSeen[profileentry.FieldNextRetryAt] = struct{}{}
+			}
+		case "leaseOwner":
+			if _, ok := fieldSeen[profileentry.FieldLeaseOwner]; !ok {
+				selectedFields = append(selectedFields, profileentry.FieldLeaseOwner)
+				fieldSeen[profileentry.FieldLeaseOwner] = struct{}{}
+			}
+		case "leaseExpiresAt":
+			if _, ok := fieldSeen[profileentry.FieldLeaseExpiresAt]; !ok {
+				selectedFields = append(selectedFields, profileentry.FieldLeaseExpiresAt)
+				fieldSeen[profileentry.FieldLeaseExpiresAt] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -685,6 +1626,155 @@ func newProfileEntryPaginateArgs(rv map[string]any) *profileentryPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (pp *ProfilePositionQuery) CollectFields(ctx context.Context, satisfies ...string) (*ProfilePositionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return pp, nil
+	}
+	if err := pp.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return pp, nil
+}
+
+func (pp *ProfilePositionQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(profileposition.Columns))
+		selectedFields = []string{profileposition.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "profile":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileClient{config: pp.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, profileImplementors)...); err != nil {
+				return err
+			}
+			pp.withProfile = query
+
+		case "company":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CompanyClient{config: pp.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, companyImplementors)...); err != nil {
+				return err
+			}
+			pp.withCompany = query
+		case "positionIndex":
+			if _, ok := fieldSeen[profileposition.FieldPositionIndex]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldPositionIndex)
+				fieldSeen[profileposition.FieldPositionIndex] = struct{}{}
+			}
+		case "companyName":
+			if _, ok := fieldSeen[profileposition.FieldCompanyName]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldCompanyName)
+				fieldSeen[profileposition.FieldCompanyName] = struct{}{}
+			}
+		case "companyUrn":
+			if _, ok := fieldSeen[profileposition.FieldCompanyUrn]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldCompanyUrn)
+				fieldSeen[profileposition.FieldCompanyUrn] = struct{}{}
+			}
+		case "companyUsername":
+			if _, ok := fieldSeen[profileposition.FieldCompanyUsername]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldCompanyUsername)
+				fieldSeen[profileposition.FieldCompanyUsername] = struct{}{}
+			}
+		case "title":
+			if _, ok := fieldSeen[profileposition.FieldTitle]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldTitle)
+				fieldSeen[profileposition.FieldTitle] = struct{}{}
+			}
+		case "location":
+			if _, ok := fieldSeen[profileposition.FieldLocation]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldLocation)
+				fieldSeen[profileposition.FieldLocation] = struct{}{}
+			}
+		case "employmentType":
+			if _, ok := fieldSeen[profileposition.FieldEmploymentType]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldEmploymentType)
+				fieldSeen[profileposition.FieldEmploymentType] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[profileposition.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldDescription)
+				fieldSeen[profileposition.FieldDescription] = struct{}{}
+			}
+		case "startDate":
+			if _, ok := fieldSeen[profileposition.FieldStartDate]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldStartDate)
+				fieldSeen[profileposition.FieldStartDate] = struct{}{}
+			}
+		case "endDate":
+			if _, ok := fieldSeen[profileposition.FieldEndDate]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldEndDate)
+				fieldSeen[profileposition.FieldEndDate] = struct{}{}
+			}
+		case "isCurrent":
+			if _, ok := fieldSeen[profileposition.FieldIsCurrent]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldIsCurrent)
+				fieldSeen[profileposition.FieldIsCurrent] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[profileposition.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldCreatedAt)
+				fieldSeen[profileposition.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[profileposition.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, profileposition.FieldUpdatedAt)
+				fieldSeen[profileposition.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		pp.Select(selectedFields...)
+	}
+	return nil
+}
+
+type profilepositionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ProfilePositionPaginateOption
+}
+
+func newProfilePositionPaginateArgs(rv map[string]any) *profilepositionPaginateArgs {
+	args := &profilepositionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*ProfilePositionWhereInput); ok {
+		args.opts = append(args.opts, WithProfilePositionFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (pp *ProfilePostQuery) CollectFields(ctx context.Context, satisfies ...string) (*ProfilePostQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -953,6 +2043,245 @@ func newProfilePostItemPaginateArgs(rv map[string]any) *profilepostitemPaginateA
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (ps *ProfileSkillQuery) CollectFields(ctx context.Context, satisfies ...string) (*ProfileSkillQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ps, nil
+	}
+	if err := ps.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+func (ps *ProfileSkillQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(profileskill.Columns))
+		selectedFields = []string{profileskill.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "profile":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileClient{config: ps.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, profileImplementors)...); err != nil {
+				return err
+			}
+			ps.withProfile = query
+		case "name":
+			if _, ok := fieldSeen[profileskill.FieldName]; !ok {
+				selectedFields = append(selectedFields, profileskill.FieldName)
+				fieldSeen[profileskill.FieldName] = struct{}{}
+			}
+		case "endorsementCount":
+			if _, ok := fieldSeen[profileskill.FieldEndorsementCount]; !ok {
+				selectedFields = append(selectedFields, profileskill.FieldEndorsementCount)
+				fieldSeen[profileskill.FieldEndorsementCount] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[profileskill.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, profileskill.FieldCreatedAt)
+				fieldSeen[profileskill.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[profileskill.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, profileskill.FieldUpdatedAt)
+				fieldSeen[profileskill.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		ps.Select(selectedFields...)
+	}
+	return nil
+}
+
+type profileskillPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ProfileSkillPaginateOption
+}
+
+func newProfileSkillPaginateArgs(rv map[string]any) *profileskillPaginateArgs {
+	args := &profileskillPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*ProfileSkillWhereInput); ok {
+		args.opts = append(args.opts, WithProfileSkillFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (ps *ProfileSnapshotQuery) CollectFields(ctx context.Context, satisfies ...string) (*ProfileSnapshotQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ps, nil
+	}
+	if err := ps.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+func (ps *ProfileSnapshotQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(profilesnapshot.Columns))
+		selectedFields = []string{profilesnapshot.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "profile":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileClient{config: ps.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, profileImplementors)...); err != nil {
+				return err
+			}
+			ps.withProfile = query
+
+		case "changeEvents":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProfileChangeEventClient{config: ps.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, profilechangeeventImplementors)...); err != nil {
+				return err
+			}
+			ps.WithNamedChangeEvents(alias, func(wq *ProfileChangeEventQuery) {
+				*wq = *query
+			})
+		case "fetchedAt":
+			if _, ok := fieldSeen[profilesnapshot.FieldFetchedAt]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldFetchedAt)
+				fieldSeen[profilesnapshot.FieldFetchedAt] = struct{}{}
+			}
+		case "rawDataS3Key":
+			if _, ok := fieldSeen[profilesnapshot.FieldRawDataS3Key]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldRawDataS3Key)
+				fieldSeen[profilesnapshot.FieldRawDataS3Key] = struct{}{}
+			}
+		case "cleanedDataS3Key":
+			if _, ok := fieldSeen[profilesnapshot.FieldCleanedDataS3Key]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldCleanedDataS3Key)
+				fieldSeen[profilesnapshot.FieldCleanedDataS3Key] = struct{}{}
+			}
+		case "headline":
+			if _, ok := fieldSeen[profilesnapshot.FieldHeadline]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldHeadline)
+				fieldSeen[profilesnapshot.FieldHeadline] = struct{}{}
+			}
+		case "title":
+			if _, ok := fieldSeen[profilesnapshot.FieldTitle]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldTitle)
+				fieldSeen[profilesnapshot.FieldTitle] = struct{}{}
+			}
+		case "country":
+			if _, ok := fieldSeen[profilesnapshot.FieldCountry]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldCountry)
+				fieldSeen[profilesnapshot.FieldCountry] = struct{}{}
+			}
+		case "city":
+			if _, ok := fieldSeen[profilesnapshot.FieldCity]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldCity)
+				fieldSeen[profilesnapshot.FieldCity] = struct{}{}
+			}
+		case "positions":
+			if _, ok := fieldSeen[profilesnapshot.FieldPositions]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldPositions)
+				fieldSeen[profilesnapshot.FieldPositions] = struct{}{}
+			}
+		case "educations":
+			if _, ok := fieldSeen[profilesnapshot.FieldEducations]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldEducations)
+				fieldSeen[profilesnapshot.FieldEducations] = struct{}{}
+			}
+		case "skills":
+			if _, ok := fieldSeen[profilesnapshot.FieldSkills]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldSkills)
+				fieldSeen[profilesnapshot.FieldSkills] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[profilesnapshot.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldCreatedAt)
+				fieldSeen[profilesnapshot.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[profilesnapshot.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, profilesnapshot.FieldUpdatedAt)
+				fieldSeen[profilesnapshot.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		ps.Select(selectedFields...)
+	}
+	return nil
+}
+
+type profilesnapshotPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ProfileSnapshotPaginateOption
+}
+
+func newProfileSnapshotPaginateArgs(rv map[string]any) *profilesnapshotPaginateArgs {
+	args := &profilesnapshotPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*ProfileSnapshotWhereInput); ok {
+		args.opts = append(args.opts, WithProfileSnapshotFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (t *TodoQuery) CollectFields(ctx context.Context, satisfies ...string) (*TodoQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
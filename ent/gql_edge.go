@@ -8,6 +8,90 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 )
 
+func (acl *APICallLog) JobExecution(ctx context.Context) (*JobExecutionHistory, error) {
+	result, err := acl.Edges.JobExecutionOrErr()
+	if IsNotLoaded(err) {
+		result, err = acl.QueryJobExecution().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (aqr *APIQuotaReservation) Tracker(ctx context.Context) (*APIQuotaTracker, error) {
+	result, err := aqr.Edges.TrackerOrErr()
+	if IsNotLoaded(err) {
+		result, err = aqr.QueryTracker().Only(ctx)
+	}
+	return result, err
+}
+
+func (aqr *APIQuotaReservation) BudgetTracker(ctx context.Context) (*APIQuotaTracker, error) {
+	result, err := aqr.Edges.BudgetTrackerOrErr()
+	if IsNotLoaded(err) {
+		result, err = aqr.QueryBudgetTracker().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (aqt *APIQuotaTracker) Reservations(ctx context.Context) (result []*APIQuotaReservation, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = aqt.NamedReservations(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = aqt.Edges.ReservationsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = aqt.QueryReservations().All(ctx)
+	}
+	return result, err
+}
+
+func (aqt *APIQuotaTracker) BudgetReservations(ctx context.Context) (result []*APIQuotaReservation, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = aqt.NamedBudgetReservations(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = aqt.Edges.BudgetReservationsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = aqt.QueryBudgetReservations().All(ctx)
+	}
+	return result, err
+}
+
+func (c *Company) Positions(ctx context.Context) (result []*ProfilePosition, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = c.NamedPositions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = c.Edges.PositionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = c.QueryPositions().All(ctx)
+	}
+	return result, err
+}
+
+func (c *Company) CurrentEmployees(ctx context.Context) (result []*Profile, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = c.NamedCurrentEmployees(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = c.Edges.CurrentEmployeesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = c.QueryCurrentEmployees().All(ctx)
+	}
+	return result, err
+}
+
+func (c *Company) Alumni(ctx context.Context) (result []*Profile, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = c.NamedAlumni(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = c.Edges.AlumniOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = c.QueryAlumni().All(ctx)
+	}
+	return result, err
+}
+
 func (jeh *JobExecutionHistory) ProfileEntries(ctx context.Context) (result []*ProfileEntry, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = jeh.NamedProfileEntries(graphql.GetFieldContext(ctx).Field.Alias)
@@ -20,6 +104,18 @@ func (jeh *JobExecutionHistory) ProfileEntries(ctx context.Context) (result []*P
 	return result, err
 }
 
+func (jeh *JobExecutionHistory) APICalls(ctx context.Context) (result []*APICallLog, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = jeh.NamedAPICalls(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = jeh.Edges.APICallsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = jeh.QueryAPICalls().All(ctx)
+	}
+	return result, err
+}
+
 func (pr *Profile) ProfileEntry(ctx context.Context) (*ProfileEntry, error) {
 	result, err := pr.Edges.ProfileEntryOrErr()
 	if IsNotLoaded(err) {
@@ -28,6 +124,114 @@ func (pr *Profile) ProfileEntry(ctx context.Context) (*ProfileEntry, error) {
 	return result, MaskNotFound(err)
 }
 
+func (pr *Profile) Snapshots(ctx context.Context) (result []*ProfileSnapshot, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = pr.NamedSnapshots(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = pr.Edges.SnapshotsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = pr.QuerySnapshots().All(ctx)
+	}
+	return result, err
+}
+
+func (pr *Profile) ChangeEvents(ctx context.Context) (result []*ProfileChangeEvent, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = pr.NamedChangeEvents(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = pr.Edges.ChangeEventsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = pr.QueryChangeEvents().All(ctx)
+	}
+	return result, err
+}
+
+func (pr *Profile) ProfilePositions(ctx context.Context) (result []*ProfilePosition, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = pr.NamedProfilePositions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = pr.Edges.ProfilePositionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = pr.QueryProfilePositions().All(ctx)
+	}
+	return result, err
+}
+
+func (pr *Profile) ProfileEducations(ctx context.Context) (result []*ProfileEducation, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = pr.NamedProfileEducations(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = pr.Edges.ProfileEducationsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = pr.QueryProfileEducations().All(ctx)
+	}
+	return result, err
+}
+
+func (pr *Profile) ProfileSkills(ctx context.Context) (result []*ProfileSkill, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = pr.NamedProfileSkills(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = pr.Edges.ProfileSkillsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = pr.QueryProfileSkills().All(ctx)
+	}
+	return result, err
+}
+
+func (pr *Profile) CurrentCompanies(ctx context.Context) (result []*Company, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = pr.NamedCurrentCompanies(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = pr.Edges.CurrentCompaniesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = pr.QueryCurrentCompanies().All(ctx)
+	}
+	return result, err
+}
+
+func (pr *Profile) PastCompanies(ctx context.Context) (result []*Company, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = pr.NamedPastCompanies(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = pr.Edges.PastCompaniesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = pr.QueryPastCompanies().All(ctx)
+	}
+	return result, err
+}
+
+func (pce *ProfileChangeEvent) Profile(ctx context.Context) (*Profile, error) {
+	result, err := pce.Edges.ProfileOrErr()
+	if IsNotLoaded(err) {
+		result, err = pce.QueryProfile().Only(ctx)
+	}
+	return result, err
+}
+
+func (pce *ProfileChangeEvent) Snapshot(ctx context.Context) (*ProfileSnapshot, error) {
+	result, err := pce.Edges.SnapshotOrErr()
+	if IsNotLoaded(err) {
+		result, err = pce.QuerySnapshot().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (pe *ProfileEducation) Profile(ctx context.Context) (*Profile, error) {
+	result, err := pe.Edges.ProfileOrErr()
+	if IsNotLoaded(err) {
+		result, err = pe.QueryProfile().Only(ctx)
+	}
+	return result, err
+}
+
 func (pe *ProfileEntry) Profile(ctx context.Context) (*Profile, error) {
 	result, err := pe.Edges.ProfileOrErr()
 	if IsNotLoaded(err) {
@@ -48,6 +252,22 @@ func (pe *ProfileEntry) JobExecutions(ctx context.Context) (result []*JobExecuti
 	return result, err
 }
 
+func (pp *ProfilePosition) Profile(ctx context.Context) (*Profile, error) {
+	result, err := pp.Edges.ProfileOrErr()
+	if IsNotLoaded(err) {
+		result, err = pp.QueryProfile().Only(ctx)
+	}
+	return result, err
+}
+
+func (pp *ProfilePosition) Company(ctx context.Context) (*Company, error) {
+	result, err := pp.Edges.CompanyOrErr()
+	if IsNotLoaded(err) {
+		result, err = pp.QueryCompany().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
 func (pp *ProfilePost) Items(ctx context.Context) (result []*ProfilePostItem, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = pp.NamedItems(graphql.GetFieldContext(ctx).Field.Alias)
@@ -68,6 +288,34 @@ func (ppi *ProfilePostItem) ProfilePost(ctx context.Context) (*ProfilePost, erro
 	return result, MaskNotFound(err)
 }
 
+func (ps *ProfileSkill) Profile(ctx context.Context) (*Profile, error) {
+	result, err := ps.Edges.ProfileOrErr()
+	if IsNotLoaded(err) {
+		result, err = ps.QueryProfile().Only(ctx)
+	}
+	return result, err
+}
+
+func (ps *ProfileSnapshot) Profile(ctx context.Context) (*Profile, error) {
+	result, err := ps.Edges.ProfileOrErr()
+	if IsNotLoaded(err) {
+		result, err = ps.QueryProfile().Only(ctx)
+	}
+	return result, err
+}
+
+func (ps *ProfileSnapshot) ChangeEvents(ctx context.Context) (result []*ProfileChangeEvent, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = ps.NamedChangeEvents(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = ps.Edges.ChangeEventsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = ps.QueryChangeEvents().All(ctx)
+	}
+	return result, err
+}
+
 func (t *Todo) User(ctx context.Context) (*User, error) {
 	result, err := t.Edges.UserOrErr()
 	if IsNotLoaded(err) {
@@ -5,13 +5,22 @@ package ent
 import (
 	"context"
 	"fmt"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/cronjobconfig"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
 	"sheng-go-backend/ent/profilepost"
 	"sheng-go-backend/ent/profilepostitem"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/schema/ulid"
 	"sheng-go-backend/ent/todo"
 	"sheng-go-backend/ent/user"
@@ -26,11 +35,31 @@ type Noder interface {
 	IsNode()
 }
 
+var apicalllogImplementors = []string{"APICallLog", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*APICallLog) IsNode() {}
+
+var apiquotabudgetImplementors = []string{"APIQuotaBudget", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*APIQuotaBudget) IsNode() {}
+
+var apiquotareservationImplementors = []string{"APIQuotaReservation", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*APIQuotaReservation) IsNode() {}
+
 var apiquotatrackerImplementors = []string{"APIQuotaTracker", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
 func (*APIQuotaTracker) IsNode() {}
 
+var companyImplementors = []string{"Company", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Company) IsNode() {}
+
 var cronjobconfigImplementors = []string{"CronJobConfig", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -46,11 +75,26 @@ var profileImplementors = []string{"Profile", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*Profile) IsNode() {}
 
+var profilechangeeventImplementors = []string{"ProfileChangeEvent", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*ProfileChangeEvent) IsNode() {}
+
+var profileeducationImplementors = []string{"ProfileEducation", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*ProfileEducation) IsNode() {}
+
 var profileentryImplementors = []string{"ProfileEntry", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
 func (*ProfileEntry) IsNode() {}
 
+var profilepositionImplementors = []string{"ProfilePosition", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*ProfilePosition) IsNode() {}
+
 var profilepostImplementors = []string{"ProfilePost", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -61,6 +105,16 @@ var profilepostitemImplementors = []string{"ProfilePostItem", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*ProfilePostItem) IsNode() {}
 
+var profileskillImplementors = []string{"ProfileSkill", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*ProfileSkill) IsNode() {}
+
+var profilesnapshotImplementors = []string{"ProfileSnapshot", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*ProfileSnapshot) IsNode() {}
+
 var todoImplementors = []string{"Todo", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -129,6 +183,45 @@ func (c *Client) Noder(ctx context.Context, id ulid.ID, opts ...NodeOption) (_ N
 
 func (c *Client) noder(ctx context.Context, table string, id ulid.ID) (Noder, error) {
 	switch table {
+	case apicalllog.Table:
+		var uid ulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.APICallLog.Query().
+			Where(apicalllog.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, apicalllogImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case apiquotabudget.Table:
+		var uid ulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.APIQuotaBudget.Query().
+			Where(apiquotabudget.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, apiquotabudgetImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case apiquotareservation.Table:
+		var uid ulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.APIQuotaReservation.Query().
+			Where(apiquotareservation.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, apiquotareservationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case apiquotatracker.Table:
 		var uid ulid.ID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -142,6 +235,19 @@ func (c *Client) noder(ctx context.Context, table string, id ulid.ID) (Noder, er
 			}
 		}
 		return query.Only(ctx)
+	case company.Table:
+		var uid ulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.Company.Query().
+			Where(company.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, companyImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case cronjobconfig.Table:
 		var uid ulid.ID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -181,6 +287,32 @@ func (c *Client) noder(ctx context.Context, table string, id ulid.ID) (Noder, er
 			}
 		}
 		return query.Only(ctx)
+	case profilechangeevent.Table:
+		var uid ulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.ProfileChangeEvent.Query().
+			Where(profilechangeevent.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, profilechangeeventImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case profileeducation.Table:
+		var uid ulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.ProfileEducation.Query().
+			Where(profileeducation.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, profileeducationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case profileentry.Table:
 		var uid ulid.ID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -194,6 +326,19 @@ func (c *Client) noder(ctx context.Context, table string, id ulid.ID) (Noder, er
 			}
 		}
 		return query.Only(ctx)
+	case profileposition.Table:
+		var uid ulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.ProfilePosition.Query().
+			Where(profileposition.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, profilepositionImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case profilepost.Table:
 		var uid ulid.ID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -220,6 +365,32 @@ func (c *Client) noder(ctx context.Context, table string, id ulid.ID) (Noder, er
 			}
 		}
 		return query.Only(ctx)
+	case profileskill.Table:
+		var uid ulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.ProfileSkill.Query().
+			Where(profileskill.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, profileskillImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case profilesnapshot.Table:
+		var uid ulid.ID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.ProfileSnapshot.Query().
+			Where(profilesnapshot.ID(uid))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, profilesnapshotImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case todo.Table:
 		var uid ulid.ID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -319,6 +490,54 @@ func (c *Client) noders(ctx context.Context, table string, ids []ulid.ID) ([]Nod
 		idmap[id] = append(idmap[id], &noders[i])
 	}
 	switch table {
+	case apicalllog.Table:
+		query := c.APICallLog.Query().
+			Where(apicalllog.IDIn(ids...))
+		query, err := query.CollectFields(ctx, apicalllogImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case apiquotabudget.Table:
+		query := c.APIQuotaBudget.Query().
+			Where(apiquotabudget.IDIn(ids...))
+		query, err := query.CollectFields(ctx, apiquotabudgetImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case apiquotareservation.Table:
+		query := c.APIQuotaReservation.Query().
+			Where(apiquotareservation.IDIn(ids...))
+		query, err := query.CollectFields(ctx, apiquotareservationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case apiquotatracker.Table:
 		query := c.APIQuotaTracker.Query().
 			Where(apiquotatracker.IDIn(ids...))
@@ -335,6 +554,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []ulid.ID) ([]Nod
 				*noder = node
 			}
 		}
+	case company.Table:
+		query := c.Company.Query().
+			Where(company.IDIn(ids...))
+		query, err := query.CollectFields(ctx, companyImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case cronjobconfig.Table:
 		query := c.CronJobConfig.Query().
 			Where(cronjobconfig.IDIn(ids...))
@@ -383,6 +618,38 @@ func (c *Client) noders(ctx context.Context, table string, ids []ulid.ID) ([]Nod
 				*noder = node
 			}
 		}
+	case profilechangeevent.Table:
+		query := c.ProfileChangeEvent.Query().
+			Where(profilechangeevent.IDIn(ids...))
+		query, err := query.CollectFields(ctx, profilechangeeventImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case profileeducation.Table:
+		query := c.ProfileEducation.Query().
+			Where(profileeducation.IDIn(ids...))
+		query, err := query.CollectFields(ctx, profileeducationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case profileentry.Table:
 		query := c.ProfileEntry.Query().
 			Where(profileentry.IDIn(ids...))
@@ -399,6 +666,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []ulid.ID) ([]Nod
 				*noder = node
 			}
 		}
+	case profileposition.Table:
+		query := c.ProfilePosition.Query().
+			Where(profileposition.IDIn(ids...))
+		query, err := query.CollectFields(ctx, profilepositionImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case profilepost.Table:
 		query := c.ProfilePost.Query().
 			Where(profilepost.IDIn(ids...))
@@ -431,6 +714,38 @@ func (c *Client) noders(ctx context.Context, table string, ids []ulid.ID) ([]Nod
 				*noder = node
 			}
 		}
+	case profileskill.Table:
+		query := c.ProfileSkill.Query().
+			Where(profileskill.IDIn(ids...))
+		query, err := query.CollectFields(ctx, profileskillImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case profilesnapshot.Table:
+		query := c.ProfileSnapshot.Query().
+			Where(profilesnapshot.IDIn(ids...))
+		query, err := query.CollectFields(ctx, profilesnapshotImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case todo.Table:
 		query := c.Todo.Query().
 			Where(todo.IDIn(ids...))
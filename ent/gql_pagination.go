@@ -5,13 +5,22 @@ package ent
 import (
 	"context"
 	"errors"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/cronjobconfig"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
 	"sheng-go-backend/ent/profilepost"
 	"sheng-go-backend/ent/profilepostitem"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/schema/ulid"
 	"sheng-go-backend/ent/todo"
 	"sheng-go-backend/ent/user"
@@ -104,20 +113,20 @@ func paginateLimit(first, last *int) int {
 	return limit
 }
 
-// APIQuotaTrackerEdge is the edge representation of APIQuotaTracker.
-type APIQuotaTrackerEdge struct {
-	Node   *APIQuotaTracker `json:"node"`
-	Cursor Cursor           `json:"cursor"`
+// APICallLogEdge is the edge representation of APICallLog.
+type APICallLogEdge struct {
+	Node   *APICallLog `json:"node"`
+	Cursor Cursor      `json:"cursor"`
 }
 
-// APIQuotaTrackerConnection is the connection containing edges to APIQuotaTracker.
-type APIQuotaTrackerConnection struct {
-	Edges      []*APIQuotaTrackerEdge `json:"edges"`
-	PageInfo   PageInfo               `json:"pageInfo"`
-	TotalCount int                    `json:"totalCount"`
+// APICallLogConnection is the connection containing edges to APICallLog.
+type APICallLogConnection struct {
+	Edges      []*APICallLogEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
 }
 
-func (c *APIQuotaTrackerConnection) build(nodes []*APIQuotaTracker, pager *apiquotatrackerPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *APICallLogConnection) build(nodes []*APICallLog, pager *apicalllogPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -127,21 +136,21 @@ func (c *APIQuotaTrackerConnection) build(nodes []*APIQuotaTracker, pager *apiqu
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *APIQuotaTracker
+	var nodeAt func(int) *APICallLog
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *APIQuotaTracker {
+		nodeAt = func(i int) *APICallLog {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *APIQuotaTracker {
+		nodeAt = func(i int) *APICallLog {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*APIQuotaTrackerEdge, len(nodes))
+	c.Edges = make([]*APICallLogEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &APIQuotaTrackerEdge{
+		c.Edges[i] = &APICallLogEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -155,87 +164,87 @@ func (c *APIQuotaTrackerConnection) build(nodes []*APIQuotaTracker, pager *apiqu
 	}
 }
 
-// APIQuotaTrackerPaginateOption enables pagination customization.
-type APIQuotaTrackerPaginateOption func(*apiquotatrackerPager) error
+// APICallLogPaginateOption enables pagination customization.
+type APICallLogPaginateOption func(*apicalllogPager) error
 
-// WithAPIQuotaTrackerOrder configures pagination ordering.
-func WithAPIQuotaTrackerOrder(order *APIQuotaTrackerOrder) APIQuotaTrackerPaginateOption {
+// WithAPICallLogOrder configures pagination ordering.
+func WithAPICallLogOrder(order *APICallLogOrder) APICallLogPaginateOption {
 	if order == nil {
-		order = DefaultAPIQuotaTrackerOrder
+		order = DefaultAPICallLogOrder
 	}
 	o := *order
-	return func(pager *apiquotatrackerPager) error {
+	return func(pager *apicalllogPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultAPIQuotaTrackerOrder.Field
+			o.Field = DefaultAPICallLogOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithAPIQuotaTrackerFilter configures pagination filter.
-func WithAPIQuotaTrackerFilter(filter func(*APIQuotaTrackerQuery) (*APIQuotaTrackerQuery, error)) APIQuotaTrackerPaginateOption {
-	return func(pager *apiquotatrackerPager) error {
+// WithAPICallLogFilter configures pagination filter.
+func WithAPICallLogFilter(filter func(*APICallLogQuery) (*APICallLogQuery, error)) APICallLogPaginateOption {
+	return func(pager *apicalllogPager) error {
 		if filter == nil {
-			return errors.New("APIQuotaTrackerQuery filter cannot be nil")
+			return errors.New("APICallLogQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type apiquotatrackerPager struct {
+type apicalllogPager struct {
 	reverse bool
-	order   *APIQuotaTrackerOrder
-	filter  func(*APIQuotaTrackerQuery) (*APIQuotaTrackerQuery, error)
+	order   *APICallLogOrder
+	filter  func(*APICallLogQuery) (*APICallLogQuery, error)
 }
 
-func newAPIQuotaTrackerPager(opts []APIQuotaTrackerPaginateOption, reverse bool) (*apiquotatrackerPager, error) {
-	pager := &apiquotatrackerPager{reverse: reverse}
+func newAPICallLogPager(opts []APICallLogPaginateOption, reverse bool) (*apicalllogPager, error) {
+	pager := &apicalllogPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultAPIQuotaTrackerOrder
+		pager.order = DefaultAPICallLogOrder
 	}
 	return pager, nil
 }
 
-func (p *apiquotatrackerPager) applyFilter(query *APIQuotaTrackerQuery) (*APIQuotaTrackerQuery, error) {
+func (p *apicalllogPager) applyFilter(query *APICallLogQuery) (*APICallLogQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *apiquotatrackerPager) toCursor(aqt *APIQuotaTracker) Cursor {
-	return p.order.Field.toCursor(aqt)
+func (p *apicalllogPager) toCursor(acl *APICallLog) Cursor {
+	return p.order.Field.toCursor(acl)
 }
 
-func (p *apiquotatrackerPager) applyCursors(query *APIQuotaTrackerQuery, after, before *Cursor) (*APIQuotaTrackerQuery, error) {
+func (p *apicalllogPager) applyCursors(query *APICallLogQuery, after, before *Cursor) (*APICallLogQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAPIQuotaTrackerOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAPICallLogOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *apiquotatrackerPager) applyOrder(query *APIQuotaTrackerQuery) *APIQuotaTrackerQuery {
+func (p *apicalllogPager) applyOrder(query *APICallLogQuery) *APICallLogQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultAPIQuotaTrackerOrder.Field {
-		query = query.Order(DefaultAPIQuotaTrackerOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAPICallLogOrder.Field {
+		query = query.Order(DefaultAPICallLogOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -243,7 +252,7 @@ func (p *apiquotatrackerPager) applyOrder(query *APIQuotaTrackerQuery) *APIQuota
 	return query
 }
 
-func (p *apiquotatrackerPager) orderExpr(query *APIQuotaTrackerQuery) sql.Querier {
+func (p *apicalllogPager) orderExpr(query *APICallLogQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -253,33 +262,33 @@ func (p *apiquotatrackerPager) orderExpr(query *APIQuotaTrackerQuery) sql.Querie
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultAPIQuotaTrackerOrder.Field {
-			b.Comma().Ident(DefaultAPIQuotaTrackerOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAPICallLogOrder.Field {
+			b.Comma().Ident(DefaultAPICallLogOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to APIQuotaTracker.
-func (aqt *APIQuotaTrackerQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to APICallLog.
+func (acl *APICallLogQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...APIQuotaTrackerPaginateOption,
-) (*APIQuotaTrackerConnection, error) {
+	before *Cursor, last *int, opts ...APICallLogPaginateOption,
+) (*APICallLogConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newAPIQuotaTrackerPager(opts, last != nil)
+	pager, err := newAPICallLogPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if aqt, err = pager.applyFilter(aqt); err != nil {
+	if acl, err = pager.applyFilter(acl); err != nil {
 		return nil, err
 	}
-	conn := &APIQuotaTrackerConnection{Edges: []*APIQuotaTrackerEdge{}}
+	conn := &APICallLogConnection{Edges: []*APICallLogEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := aqt.Clone()
+			c := acl.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -291,20 +300,20 @@ func (aqt *APIQuotaTrackerQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if aqt, err = pager.applyCursors(aqt, after, before); err != nil {
+	if acl, err = pager.applyCursors(acl, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		aqt.Limit(limit)
+		acl.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := aqt.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := acl.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	aqt = pager.applyOrder(aqt)
-	nodes, err := aqt.All(ctx)
+	acl = pager.applyOrder(acl)
+	nodes, err := acl.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -312,61 +321,61 @@ func (aqt *APIQuotaTrackerQuery) Paginate(
 	return conn, nil
 }
 
-// APIQuotaTrackerOrderField defines the ordering field of APIQuotaTracker.
-type APIQuotaTrackerOrderField struct {
-	// Value extracts the ordering value from the given APIQuotaTracker.
-	Value    func(*APIQuotaTracker) (ent.Value, error)
+// APICallLogOrderField defines the ordering field of APICallLog.
+type APICallLogOrderField struct {
+	// Value extracts the ordering value from the given APICallLog.
+	Value    func(*APICallLog) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) apiquotatracker.OrderOption
-	toCursor func(*APIQuotaTracker) Cursor
+	toTerm   func(...sql.OrderTermOption) apicalllog.OrderOption
+	toCursor func(*APICallLog) Cursor
 }
 
-// APIQuotaTrackerOrder defines the ordering of APIQuotaTracker.
-type APIQuotaTrackerOrder struct {
-	Direction OrderDirection             `json:"direction"`
-	Field     *APIQuotaTrackerOrderField `json:"field"`
+// APICallLogOrder defines the ordering of APICallLog.
+type APICallLogOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *APICallLogOrderField `json:"field"`
 }
 
-// DefaultAPIQuotaTrackerOrder is the default ordering of APIQuotaTracker.
-var DefaultAPIQuotaTrackerOrder = &APIQuotaTrackerOrder{
+// DefaultAPICallLogOrder is the default ordering of APICallLog.
+var DefaultAPICallLogOrder = &APICallLogOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &APIQuotaTrackerOrderField{
-		Value: func(aqt *APIQuotaTracker) (ent.Value, error) {
-			return aqt.ID, nil
+	Field: &APICallLogOrderField{
+		Value: func(acl *APICallLog) (ent.Value, error) {
+			return acl.ID, nil
 		},
-		column: apiquotatracker.FieldID,
-		toTerm: apiquotatracker.ByID,
-		toCursor: func(aqt *APIQuotaTracker) Cursor {
-			return Cursor{ID: aqt.ID}
+		column: apicalllog.FieldID,
+		toTerm: apicalllog.ByID,
+		toCursor: func(acl *APICallLog) Cursor {
+			return Cursor{ID: acl.ID}
 		},
 	},
 }
 
-// ToEdge converts APIQuotaTracker into APIQuotaTrackerEdge.
-func (aqt *APIQuotaTracker) ToEdge(order *APIQuotaTrackerOrder) *APIQuotaTrackerEdge {
+// ToEdge converts APICallLog into APICallLogEdge.
+func (acl *APICallLog) ToEdge(order *APICallLogOrder) *APICallLogEdge {
 	if order == nil {
-		order = DefaultAPIQuotaTrackerOrder
+		order = DefaultAPICallLogOrder
 	}
-	return &APIQuotaTrackerEdge{
-		Node:   aqt,
-		Cursor: order.Field.toCursor(aqt),
+	return &APICallLogEdge{
+		Node:   acl,
+		Cursor: order.Field.toCursor(acl),
 	}
 }
 
-// CronJobConfigEdge is the edge representation of CronJobConfig.
-type CronJobConfigEdge struct {
-	Node   *CronJobConfig `json:"node"`
-	Cursor Cursor         `json:"cursor"`
+// APIQuotaBudgetEdge is the edge representation of APIQuotaBudget.
+type APIQuotaBudgetEdge struct {
+	Node   *APIQuotaBudget `json:"node"`
+	Cursor Cursor          `json:"cursor"`
 }
 
-// CronJobConfigConnection is the connection containing edges to CronJobConfig.
-type CronJobConfigConnection struct {
-	Edges      []*CronJobConfigEdge `json:"edges"`
-	PageInfo   PageInfo             `json:"pageInfo"`
-	TotalCount int                  `json:"totalCount"`
+// APIQuotaBudgetConnection is the connection containing edges to APIQuotaBudget.
+type APIQuotaBudgetConnection struct {
+	Edges      []*APIQuotaBudgetEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
 }
 
-func (c *CronJobConfigConnection) build(nodes []*CronJobConfig, pager *cronjobconfigPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *APIQuotaBudgetConnection) build(nodes []*APIQuotaBudget, pager *apiquotabudgetPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -376,21 +385,21 @@ func (c *CronJobConfigConnection) build(nodes []*CronJobConfig, pager *cronjobco
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *CronJobConfig
+	var nodeAt func(int) *APIQuotaBudget
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *CronJobConfig {
+		nodeAt = func(i int) *APIQuotaBudget {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *CronJobConfig {
+		nodeAt = func(i int) *APIQuotaBudget {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*CronJobConfigEdge, len(nodes))
+	c.Edges = make([]*APIQuotaBudgetEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &CronJobConfigEdge{
+		c.Edges[i] = &APIQuotaBudgetEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -404,87 +413,87 @@ func (c *CronJobConfigConnection) build(nodes []*CronJobConfig, pager *cronjobco
 	}
 }
 
-// CronJobConfigPaginateOption enables pagination customization.
-type CronJobConfigPaginateOption func(*cronjobconfigPager) error
+// APIQuotaBudgetPaginateOption enables pagination customization.
+type APIQuotaBudgetPaginateOption func(*apiquotabudgetPager) error
 
-// WithCronJobConfigOrder configures pagination ordering.
-func WithCronJobConfigOrder(order *CronJobConfigOrder) CronJobConfigPaginateOption {
+// WithAPIQuotaBudgetOrder configures pagination ordering.
+func WithAPIQuotaBudgetOrder(order *APIQuotaBudgetOrder) APIQuotaBudgetPaginateOption {
 	if order == nil {
-		order = DefaultCronJobConfigOrder
+		order = DefaultAPIQuotaBudgetOrder
 	}
 	o := *order
-	return func(pager *cronjobconfigPager) error {
+	return func(pager *apiquotabudgetPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultCronJobConfigOrder.Field
+			o.Field = DefaultAPIQuotaBudgetOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithCronJobConfigFilter configures pagination filter.
-func WithCronJobConfigFilter(filter func(*CronJobConfigQuery) (*CronJobConfigQuery, error)) CronJobConfigPaginateOption {
-	return func(pager *cronjobconfigPager) error {
+// WithAPIQuotaBudgetFilter configures pagination filter.
+func WithAPIQuotaBudgetFilter(filter func(*APIQuotaBudgetQuery) (*APIQuotaBudgetQuery, error)) APIQuotaBudgetPaginateOption {
+	return func(pager *apiquotabudgetPager) error {
 		if filter == nil {
-			return errors.New("CronJobConfigQuery filter cannot be nil")
+			return errors.New("APIQuotaBudgetQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type cronjobconfigPager struct {
+type apiquotabudgetPager struct {
 	reverse bool
-	order   *CronJobConfigOrder
-	filter  func(*CronJobConfigQuery) (*CronJobConfigQuery, error)
+	order   *APIQuotaBudgetOrder
+	filter  func(*APIQuotaBudgetQuery) (*APIQuotaBudgetQuery, error)
 }
 
-func newCronJobConfigPager(opts []CronJobConfigPaginateOption, reverse bool) (*cronjobconfigPager, error) {
-	pager := &cronjobconfigPager{reverse: reverse}
+func newAPIQuotaBudgetPager(opts []APIQuotaBudgetPaginateOption, reverse bool) (*apiquotabudgetPager, error) {
+	pager := &apiquotabudgetPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultCronJobConfigOrder
+		pager.order = DefaultAPIQuotaBudgetOrder
 	}
 	return pager, nil
 }
 
-func (p *cronjobconfigPager) applyFilter(query *CronJobConfigQuery) (*CronJobConfigQuery, error) {
+func (p *apiquotabudgetPager) applyFilter(query *APIQuotaBudgetQuery) (*APIQuotaBudgetQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *cronjobconfigPager) toCursor(cjc *CronJobConfig) Cursor {
-	return p.order.Field.toCursor(cjc)
+func (p *apiquotabudgetPager) toCursor(aqb *APIQuotaBudget) Cursor {
+	return p.order.Field.toCursor(aqb)
 }
 
-func (p *cronjobconfigPager) applyCursors(query *CronJobConfigQuery, after, before *Cursor) (*CronJobConfigQuery, error) {
+func (p *apiquotabudgetPager) applyCursors(query *APIQuotaBudgetQuery, after, before *Cursor) (*APIQuotaBudgetQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCronJobConfigOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAPIQuotaBudgetOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *cronjobconfigPager) applyOrder(query *CronJobConfigQuery) *CronJobConfigQuery {
+func (p *apiquotabudgetPager) applyOrder(query *APIQuotaBudgetQuery) *APIQuotaBudgetQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultCronJobConfigOrder.Field {
-		query = query.Order(DefaultCronJobConfigOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAPIQuotaBudgetOrder.Field {
+		query = query.Order(DefaultAPIQuotaBudgetOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -492,7 +501,7 @@ func (p *cronjobconfigPager) applyOrder(query *CronJobConfigQuery) *CronJobConfi
 	return query
 }
 
-func (p *cronjobconfigPager) orderExpr(query *CronJobConfigQuery) sql.Querier {
+func (p *apiquotabudgetPager) orderExpr(query *APIQuotaBudgetQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -502,33 +511,33 @@ func (p *cronjobconfigPager) orderExpr(query *CronJobConfigQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultCronJobConfigOrder.Field {
-			b.Comma().Ident(DefaultCronJobConfigOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAPIQuotaBudgetOrder.Field {
+			b.Comma().Ident(DefaultAPIQuotaBudgetOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to CronJobConfig.
-func (cjc *CronJobConfigQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to APIQuotaBudget.
+func (aqb *APIQuotaBudgetQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...CronJobConfigPaginateOption,
-) (*CronJobConfigConnection, error) {
+	before *Cursor, last *int, opts ...APIQuotaBudgetPaginateOption,
+) (*APIQuotaBudgetConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newCronJobConfigPager(opts, last != nil)
+	pager, err := newAPIQuotaBudgetPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if cjc, err = pager.applyFilter(cjc); err != nil {
+	if aqb, err = pager.applyFilter(aqb); err != nil {
 		return nil, err
 	}
-	conn := &CronJobConfigConnection{Edges: []*CronJobConfigEdge{}}
+	conn := &APIQuotaBudgetConnection{Edges: []*APIQuotaBudgetEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := cjc.Clone()
+			c := aqb.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -540,20 +549,20 @@ func (cjc *CronJobConfigQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if cjc, err = pager.applyCursors(cjc, after, before); err != nil {
+	if aqb, err = pager.applyCursors(aqb, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		cjc.Limit(limit)
+		aqb.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := cjc.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := aqb.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	cjc = pager.applyOrder(cjc)
-	nodes, err := cjc.All(ctx)
+	aqb = pager.applyOrder(aqb)
+	nodes, err := aqb.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -561,61 +570,61 @@ func (cjc *CronJobConfigQuery) Paginate(
 	return conn, nil
 }
 
-// CronJobConfigOrderField defines the ordering field of CronJobConfig.
-type CronJobConfigOrderField struct {
-	// Value extracts the ordering value from the given CronJobConfig.
-	Value    func(*CronJobConfig) (ent.Value, error)
+// APIQuotaBudgetOrderField defines the ordering field of APIQuotaBudget.
+type APIQuotaBudgetOrderField struct {
+	// Value extracts the ordering value from the given APIQuotaBudget.
+	Value    func(*APIQuotaBudget) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) cronjobconfig.OrderOption
-	toCursor func(*CronJobConfig) Cursor
+	toTerm   func(...sql.OrderTermOption) apiquotabudget.OrderOption
+	toCursor func(*APIQuotaBudget) Cursor
 }
 
-// CronJobConfigOrder defines the ordering of CronJobConfig.
-type CronJobConfigOrder struct {
-	Direction OrderDirection           `json:"direction"`
-	Field     *CronJobConfigOrderField `json:"field"`
+// APIQuotaBudgetOrder defines the ordering of APIQuotaBudget.
+type APIQuotaBudgetOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *APIQuotaBudgetOrderField `json:"field"`
 }
 
-// DefaultCronJobConfigOrder is the default ordering of CronJobConfig.
-var DefaultCronJobConfigOrder = &CronJobConfigOrder{
+// DefaultAPIQuotaBudgetOrder is the default ordering of APIQuotaBudget.
+var DefaultAPIQuotaBudgetOrder = &APIQuotaBudgetOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &CronJobConfigOrderField{
-		Value: func(cjc *CronJobConfig) (ent.Value, error) {
-			return cjc.ID, nil
+	Field: &APIQuotaBudgetOrderField{
+		Value: func(aqb *APIQuotaBudget) (ent.Value, error) {
+			return aqb.ID, nil
 		},
-		column: cronjobconfig.FieldID,
-		toTerm: cronjobconfig.ByID,
-		toCursor: func(cjc *CronJobConfig) Cursor {
-			return Cursor{ID: cjc.ID}
+		column: apiquotabudget.FieldID,
+		toTerm: apiquotabudget.ByID,
+		toCursor: func(aqb *APIQuotaBudget) Cursor {
+			return Cursor{ID: aqb.ID}
 		},
 	},
 }
 
-// ToEdge converts CronJobConfig into CronJobConfigEdge.
-func (cjc *CronJobConfig) ToEdge(order *CronJobConfigOrder) *CronJobConfigEdge {
+// ToEdge converts APIQuotaBudget into APIQuotaBudgetEdge.
+func (aqb *APIQuotaBudget) ToEdge(order *APIQuotaBudgetOrder) *APIQuotaBudgetEdge {
 	if order == nil {
-		order = DefaultCronJobConfigOrder
+		order = DefaultAPIQuotaBudgetOrder
 	}
-	return &CronJobConfigEdge{
-		Node:   cjc,
-		Cursor: order.Field.toCursor(cjc),
+	return &APIQuotaBudgetEdge{
+		Node:   aqb,
+		Cursor: order.Field.toCursor(aqb),
 	}
 }
 
-// JobExecutionHistoryEdge is the edge representation of JobExecutionHistory.
-type JobExecutionHistoryEdge struct {
-	Node   *JobExecutionHistory `json:"node"`
+// APIQuotaReservationEdge is the edge representation of APIQuotaReservation.
+type APIQuotaReservationEdge struct {
+	Node   *APIQuotaReservation `json:"node"`
 	Cursor Cursor               `json:"cursor"`
 }
 
-// JobExecutionHistoryConnection is the connection containing edges to JobExecutionHistory.
-type JobExecutionHistoryConnection struct {
-	Edges      []*JobExecutionHistoryEdge `json:"edges"`
+// APIQuotaReservationConnection is the connection containing edges to APIQuotaReservation.
+type APIQuotaReservationConnection struct {
+	Edges      []*APIQuotaReservationEdge `json:"edges"`
 	PageInfo   PageInfo                   `json:"pageInfo"`
 	TotalCount int                        `json:"totalCount"`
 }
 
-func (c *JobExecutionHistoryConnection) build(nodes []*JobExecutionHistory, pager *jobexecutionhistoryPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *APIQuotaReservationConnection) build(nodes []*APIQuotaReservation, pager *apiquotareservationPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -625,21 +634,21 @@ func (c *JobExecutionHistoryConnection) build(nodes []*JobExecutionHistory, page
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *JobExecutionHistory
+	var nodeAt func(int) *APIQuotaReservation
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *JobExecutionHistory {
+		nodeAt = func(i int) *APIQuotaReservation {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *JobExecutionHistory {
+		nodeAt = func(i int) *APIQuotaReservation {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*JobExecutionHistoryEdge, len(nodes))
+	c.Edges = make([]*APIQuotaReservationEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &JobExecutionHistoryEdge{
+		c.Edges[i] = &APIQuotaReservationEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -653,87 +662,87 @@ func (c *JobExecutionHistoryConnection) build(nodes []*JobExecutionHistory, page
 	}
 }
 
-// JobExecutionHistoryPaginateOption enables pagination customization.
-type JobExecutionHistoryPaginateOption func(*jobexecutionhistoryPager) error
+// APIQuotaReservationPaginateOption enables pagination customization.
+type APIQuotaReservationPaginateOption func(*apiquotareservationPager) error
 
-// WithJobExecutionHistoryOrder configures pagination ordering.
-func WithJobExecutionHistoryOrder(order *JobExecutionHistoryOrder) JobExecutionHistoryPaginateOption {
+// WithAPIQuotaReservationOrder configures pagination ordering.
+func WithAPIQuotaReservationOrder(order *APIQuotaReservationOrder) APIQuotaReservationPaginateOption {
 	if order == nil {
-		order = DefaultJobExecutionHistoryOrder
+		order = DefaultAPIQuotaReservationOrder
 	}
 	o := *order
-	return func(pager *jobexecutionhistoryPager) error {
+	return func(pager *apiquotareservationPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultJobExecutionHistoryOrder.Field
+			o.Field = DefaultAPIQuotaReservationOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithJobExecutionHistoryFilter configures pagination filter.
-func WithJobExecutionHistoryFilter(filter func(*JobExecutionHistoryQuery) (*JobExecutionHistoryQuery, error)) JobExecutionHistoryPaginateOption {
-	return func(pager *jobexecutionhistoryPager) error {
+// WithAPIQuotaReservationFilter configures pagination filter.
+func WithAPIQuotaReservationFilter(filter func(*APIQuotaReservationQuery) (*APIQuotaReservationQuery, error)) APIQuotaReservationPaginateOption {
+	return func(pager *apiquotareservationPager) error {
 		if filter == nil {
-			return errors.New("JobExecutionHistoryQuery filter cannot be nil")
+			return errors.New("APIQuotaReservationQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type jobexecutionhistoryPager struct {
+type apiquotareservationPager struct {
 	reverse bool
-	order   *JobExecutionHistoryOrder
-	filter  func(*JobExecutionHistoryQuery) (*JobExecutionHistoryQuery, error)
+	order   *APIQuotaReservationOrder
+	filter  func(*APIQuotaReservationQuery) (*APIQuotaReservationQuery, error)
 }
 
-func newJobExecutionHistoryPager(opts []JobExecutionHistoryPaginateOption, reverse bool) (*jobexecutionhistoryPager, error) {
-	pager := &jobexecutionhistoryPager{reverse: reverse}
+func newAPIQuotaReservationPager(opts []APIQuotaReservationPaginateOption, reverse bool) (*apiquotareservationPager, error) {
+	pager := &apiquotareservationPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultJobExecutionHistoryOrder
+		pager.order = DefaultAPIQuotaReservationOrder
 	}
 	return pager, nil
 }
 
-func (p *jobexecutionhistoryPager) applyFilter(query *JobExecutionHistoryQuery) (*JobExecutionHistoryQuery, error) {
+func (p *apiquotareservationPager) applyFilter(query *APIQuotaReservationQuery) (*APIQuotaReservationQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *jobexecutionhistoryPager) toCursor(jeh *JobExecutionHistory) Cursor {
-	return p.order.Field.toCursor(jeh)
+func (p *apiquotareservationPager) toCursor(aqr *APIQuotaReservation) Cursor {
+	return p.order.Field.toCursor(aqr)
 }
 
-func (p *jobexecutionhistoryPager) applyCursors(query *JobExecutionHistoryQuery, after, before *Cursor) (*JobExecutionHistoryQuery, error) {
+func (p *apiquotareservationPager) applyCursors(query *APIQuotaReservationQuery, after, before *Cursor) (*APIQuotaReservationQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultJobExecutionHistoryOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAPIQuotaReservationOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *jobexecutionhistoryPager) applyOrder(query *JobExecutionHistoryQuery) *JobExecutionHistoryQuery {
+func (p *apiquotareservationPager) applyOrder(query *APIQuotaReservationQuery) *APIQuotaReservationQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultJobExecutionHistoryOrder.Field {
-		query = query.Order(DefaultJobExecutionHistoryOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAPIQuotaReservationOrder.Field {
+		query = query.Order(DefaultAPIQuotaReservationOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -741,7 +750,7 @@ func (p *jobexecutionhistoryPager) applyOrder(query *JobExecutionHistoryQuery) *
 	return query
 }
 
-func (p *jobexecutionhistoryPager) orderExpr(query *JobExecutionHistoryQuery) sql.Querier {
+func (p *apiquotareservationPager) orderExpr(query *APIQuotaReservationQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -751,33 +760,33 @@ func (p *jobexecutionhistoryPager) orderExpr(query *JobExecutionHistoryQuery) sq
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultJobExecutionHistoryOrder.Field {
-			b.Comma().Ident(DefaultJobExecutionHistoryOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAPIQuotaReservationOrder.Field {
+			b.Comma().Ident(DefaultAPIQuotaReservationOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to JobExecutionHistory.
-func (jeh *JobExecutionHistoryQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to APIQuotaReservation.
+func (aqr *APIQuotaReservationQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...JobExecutionHistoryPaginateOption,
-) (*JobExecutionHistoryConnection, error) {
+	before *Cursor, last *int, opts ...APIQuotaReservationPaginateOption,
+) (*APIQuotaReservationConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newJobExecutionHistoryPager(opts, last != nil)
+	pager, err := newAPIQuotaReservationPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if jeh, err = pager.applyFilter(jeh); err != nil {
+	if aqr, err = pager.applyFilter(aqr); err != nil {
 		return nil, err
 	}
-	conn := &JobExecutionHistoryConnection{Edges: []*JobExecutionHistoryEdge{}}
+	conn := &APIQuotaReservationConnection{Edges: []*APIQuotaReservationEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := jeh.Clone()
+			c := aqr.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -789,20 +798,20 @@ func (jeh *JobExecutionHistoryQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if jeh, err = pager.applyCursors(jeh, after, before); err != nil {
+	if aqr, err = pager.applyCursors(aqr, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		jeh.Limit(limit)
+		aqr.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := jeh.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := aqr.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	jeh = pager.applyOrder(jeh)
-	nodes, err := jeh.All(ctx)
+	aqr = pager.applyOrder(aqr)
+	nodes, err := aqr.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -810,62 +819,2303 @@ func (jeh *JobExecutionHistoryQuery) Paginate(
 	return conn, nil
 }
 
-// JobExecutionHistoryOrderField defines the ordering field of JobExecutionHistory.
-type JobExecutionHistoryOrderField struct {
-	// Value extracts the ordering value from the given JobExecutionHistory.
-	Value    func(*JobExecutionHistory) (ent.Value, error)
+// APIQuotaReservationOrderField defines the ordering field of APIQuotaReservation.
+type APIQuotaReservationOrderField struct {
+	// Value extracts the ordering value from the given APIQuotaReservation.
+	Value    func(*APIQuotaReservation) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) jobexecutionhistory.OrderOption
-	toCursor func(*JobExecutionHistory) Cursor
+	toTerm   func(...sql.OrderTermOption) apiquotareservation.OrderOption
+	toCursor func(*APIQuotaReservation) Cursor
 }
 
-// JobExecutionHistoryOrder defines the ordering of JobExecutionHistory.
-type JobExecutionHistoryOrder struct {
+// APIQuotaReservationOrder defines the ordering of APIQuotaReservation.
+type APIQuotaReservationOrder struct {
 	Direction OrderDirection                 `json:"direction"`
-	Field     *JobExecutionHistoryOrderField `json:"field"`
+	Field     *APIQuotaReservationOrderField `json:"field"`
 }
 
-// DefaultJobExecutionHistoryOrder is the default ordering of JobExecutionHistory.
-var DefaultJobExecutionHistoryOrder = &JobExecutionHistoryOrder{
+// DefaultAPIQuotaReservationOrder is the default ordering of APIQuotaReservation.
+var DefaultAPIQuotaReservationOrder = &APIQuotaReservationOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &JobExecutionHistoryOrderField{
-		Value: func(jeh *JobExecutionHistory) (ent.Value, error) {
-			return jeh.ID, nil
+	Field: &APIQuotaReservationOrderField{
+		Value: func(aqr *APIQuotaReservation) (ent.Value, error) {
+			return aqr.ID, nil
 		},
-		column: jobexecutionhistory.FieldID,
-		toTerm: jobexecutionhistory.ByID,
-		toCursor: func(jeh *JobExecutionHistory) Cursor {
-			return Cursor{ID: jeh.ID}
+		column: apiquotareservation.FieldID,
+		toTerm: apiquotareservation.ByID,
+		toCursor: func(aqr *APIQuotaReservation) Cursor {
+			return Cursor{ID: aqr.ID}
 		},
 	},
 }
 
-// ToEdge converts JobExecutionHistory into JobExecutionHistoryEdge.
-func (jeh *JobExecutionHistory) ToEdge(order *JobExecutionHistoryOrder) *JobExecutionHistoryEdge {
+// ToEdge converts APIQuotaReservation into APIQuotaReservationEdge.
+func (aqr *APIQuotaReservation) ToEdge(order *APIQuotaReservationOrder) *APIQuotaReservationEdge {
 	if order == nil {
-		order = DefaultJobExecutionHistoryOrder
+		order = DefaultAPIQuotaReservationOrder
 	}
-	return &JobExecutionHistoryEdge{
-		Node:   jeh,
-		Cursor: order.Field.toCursor(jeh),
+	return &APIQuotaReservationEdge{
+		Node:   aqr,
+		Cursor: order.Field.toCursor(aqr),
 	}
 }
 
-// ProfileEdge is the edge representation of Profile.
-type ProfileEdge struct {
-	Node   *Profile `json:"node"`
-	Cursor Cursor   `json:"cursor"`
-}
-
-// ProfileConnection is the connection containing edges to Profile.
-type ProfileConnection struct {
-	Edges      []*ProfileEdge `json:"edges"`
-	PageInfo   PageInfo       `json:"pageInfo"`
-	TotalCount int            `json:"totalCount"`
+// APIQuotaTrackerEdge is the edge representation of APIQuotaTracker.
+type APIQuotaTrackerEdge struct {
+	Node   *APIQuotaTracker `json:"node"`
+	Cursor Cursor           `json:"cursor"`
 }
 
-func (c *ProfileConnection) build(nodes []*Profile, pager *profilePager, after *Cursor, first *int, before *Cursor, last *int) {
-	c.PageInfo.HasNextPage = before != nil
+// APIQuotaTrackerConnection is the connection containing edges to APIQuotaTracker.
+type APIQuotaTrackerConnection struct {
+	Edges      []*APIQuotaTrackerEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+func (c *APIQuotaTrackerConnection) build(nodes []*APIQuotaTracker, pager *apiquotatrackerPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *APIQuotaTracker
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *APIQuotaTracker {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *APIQuotaTracker {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*APIQuotaTrackerEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &APIQuotaTrackerEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// APIQuotaTrackerPaginateOption enables pagination customization.
+type APIQuotaTrackerPaginateOption func(*apiquotatrackerPager) error
+
+// WithAPIQuotaTrackerOrder configures pagination ordering.
+func WithAPIQuotaTrackerOrder(order *APIQuotaTrackerOrder) APIQuotaTrackerPaginateOption {
+	if order == nil {
+		order = DefaultAPIQuotaTrackerOrder
+	}
+	o := *order
+	return func(pager *apiquotatrackerPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultAPIQuotaTrackerOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithAPIQuotaTrackerFilter configures pagination filter.
+func WithAPIQuotaTrackerFilter(filter func(*APIQuotaTrackerQuery) (*APIQuotaTrackerQuery, error)) APIQuotaTrackerPaginateOption {
+	return func(pager *apiquotatrackerPager) error {
+		if filter == nil {
+			return errors.New("APIQuotaTrackerQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type apiquotatrackerPager struct {
+	reverse bool
+	order   *APIQuotaTrackerOrder
+	filter  func(*APIQuotaTrackerQuery) (*APIQuotaTrackerQuery, error)
+}
+
+func newAPIQuotaTrackerPager(opts []APIQuotaTrackerPaginateOption, reverse bool) (*apiquotatrackerPager, error) {
+	pager := &apiquotatrackerPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultAPIQuotaTrackerOrder
+	}
+	return pager, nil
+}
+
+func (p *apiquotatrackerPager) applyFilter(query *APIQuotaTrackerQuery) (*APIQuotaTrackerQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *apiquotatrackerPager) toCursor(aqt *APIQuotaTracker) Cursor {
+	return p.order.Field.toCursor(aqt)
+}
+
+func (p *apiquotatrackerPager) applyCursors(query *APIQuotaTrackerQuery, after, before *Cursor) (*APIQuotaTrackerQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAPIQuotaTrackerOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *apiquotatrackerPager) applyOrder(query *APIQuotaTrackerQuery) *APIQuotaTrackerQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAPIQuotaTrackerOrder.Field {
+		query = query.Order(DefaultAPIQuotaTrackerOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *apiquotatrackerPager) orderExpr(query *APIQuotaTrackerQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAPIQuotaTrackerOrder.Field {
+			b.Comma().Ident(DefaultAPIQuotaTrackerOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to APIQuotaTracker.
+func (aqt *APIQuotaTrackerQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...APIQuotaTrackerPaginateOption,
+) (*APIQuotaTrackerConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newAPIQuotaTrackerPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if aqt, err = pager.applyFilter(aqt); err != nil {
+		return nil, err
+	}
+	conn := &APIQuotaTrackerConnection{Edges: []*APIQuotaTrackerEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := aqt.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if aqt, err = pager.applyCursors(aqt, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		aqt.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := aqt.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	aqt = pager.applyOrder(aqt)
+	nodes, err := aqt.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// APIQuotaTrackerOrderField defines the ordering field of APIQuotaTracker.
+type APIQuotaTrackerOrderField struct {
+	// Value extracts the ordering value from the given APIQuotaTracker.
+	Value    func(*APIQuotaTracker) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) apiquotatracker.OrderOption
+	toCursor func(*APIQuotaTracker) Cursor
+}
+
+// APIQuotaTrackerOrder defines the ordering of APIQuotaTracker.
+type APIQuotaTrackerOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *APIQuotaTrackerOrderField `json:"field"`
+}
+
+// DefaultAPIQuotaTrackerOrder is the default ordering of APIQuotaTracker.
+var DefaultAPIQuotaTrackerOrder = &APIQuotaTrackerOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &APIQuotaTrackerOrderField{
+		Value: func(aqt *APIQuotaTracker) (ent.Value, error) {
+			return aqt.ID, nil
+		},
+		column: apiquotatracker.FieldID,
+		toTerm: apiquotatracker.ByID,
+		toCursor: func(aqt *APIQuotaTracker) Cursor {
+			return Cursor{ID: aqt.ID}
+		},
+	},
+}
+
+// ToEdge converts APIQuotaTracker into APIQuotaTrackerEdge.
+func (aqt *APIQuotaTracker) ToEdge(order *APIQuotaTrackerOrder) *APIQuotaTrackerEdge {
+	if order == nil {
+		order = DefaultAPIQuotaTrackerOrder
+	}
+	return &APIQuotaTrackerEdge{
+		Node:   aqt,
+		Cursor: order.Field.toCursor(aqt),
+	}
+}
+
+// CompanyEdge is the edge representation of Company.
+type CompanyEdge struct {
+	Node   *Company `json:"node"`
+	Cursor Cursor   `json:"cursor"`
+}
+
+// CompanyConnection is the connection containing edges to Company.
+type CompanyConnection struct {
+	Edges      []*CompanyEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (c *CompanyConnection) build(nodes []*Company, pager *companyPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Company
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Company {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Company {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*CompanyEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &CompanyEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// CompanyPaginateOption enables pagination customization.
+type CompanyPaginateOption func(*companyPager) error
+
+// WithCompanyOrder configures pagination ordering.
+func WithCompanyOrder(order *CompanyOrder) CompanyPaginateOption {
+	if order == nil {
+		order = DefaultCompanyOrder
+	}
+	o := *order
+	return func(pager *companyPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultCompanyOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithCompanyFilter configures pagination filter.
+func WithCompanyFilter(filter func(*CompanyQuery) (*CompanyQuery, error)) CompanyPaginateOption {
+	return func(pager *companyPager) error {
+		if filter == nil {
+			return errors.New("CompanyQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type companyPager struct {
+	reverse bool
+	order   *CompanyOrder
+	filter  func(*CompanyQuery) (*CompanyQuery, error)
+}
+
+func newCompanyPager(opts []CompanyPaginateOption, reverse bool) (*companyPager, error) {
+	pager := &companyPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultCompanyOrder
+	}
+	return pager, nil
+}
+
+func (p *companyPager) applyFilter(query *CompanyQuery) (*CompanyQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *companyPager) toCursor(c *Company) Cursor {
+	return p.order.Field.toCursor(c)
+}
+
+func (p *companyPager) applyCursors(query *CompanyQuery, after, before *Cursor) (*CompanyQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCompanyOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *companyPager) applyOrder(query *CompanyQuery) *CompanyQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultCompanyOrder.Field {
+		query = query.Order(DefaultCompanyOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *companyPager) orderExpr(query *CompanyQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultCompanyOrder.Field {
+			b.Comma().Ident(DefaultCompanyOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Company.
+func (c *CompanyQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...CompanyPaginateOption,
+) (*CompanyConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newCompanyPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c, err = pager.applyFilter(c); err != nil {
+		return nil, err
+	}
+	conn := &CompanyConnection{Edges: []*CompanyEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := c.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if c, err = pager.applyCursors(c, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		c.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := c.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	c = pager.applyOrder(c)
+	nodes, err := c.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// CompanyOrderField defines the ordering field of Company.
+type CompanyOrderField struct {
+	// Value extracts the ordering value from the given Company.
+	Value    func(*Company) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) company.OrderOption
+	toCursor func(*Company) Cursor
+}
+
+// CompanyOrder defines the ordering of Company.
+type CompanyOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *CompanyOrderField `json:"field"`
+}
+
+// DefaultCompanyOrder is the default ordering of Company.
+var DefaultCompanyOrder = &CompanyOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &CompanyOrderField{
+		Value: func(c *Company) (ent.Value, error) {
+			return c.ID, nil
+		},
+		column: company.FieldID,
+		toTerm: company.ByID,
+		toCursor: func(c *Company) Cursor {
+			return Cursor{ID: c.ID}
+		},
+	},
+}
+
+// ToEdge converts Company into CompanyEdge.
+func (c *Company) ToEdge(order *CompanyOrder) *CompanyEdge {
+	if order == nil {
+		order = DefaultCompanyOrder
+	}
+	return &CompanyEdge{
+		Node:   c,
+		Cursor: order.Field.toCursor(c),
+	}
+}
+
+// CronJobConfigEdge is the edge representation of CronJobConfig.
+type CronJobConfigEdge struct {
+	Node   *CronJobConfig `json:"node"`
+	Cursor Cursor         `json:"cursor"`
+}
+
+// CronJobConfigConnection is the connection containing edges to CronJobConfig.
+type CronJobConfigConnection struct {
+	Edges      []*CronJobConfigEdge `json:"edges"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
+}
+
+func (c *CronJobConfigConnection) build(nodes []*CronJobConfig, pager *cronjobconfigPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *CronJobConfig
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *CronJobConfig {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *CronJobConfig {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*CronJobConfigEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &CronJobConfigEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// CronJobConfigPaginateOption enables pagination customization.
+type CronJobConfigPaginateOption func(*cronjobconfigPager) error
+
+// WithCronJobConfigOrder configures pagination ordering.
+func WithCronJobConfigOrder(order *CronJobConfigOrder) CronJobConfigPaginateOption {
+	if order == nil {
+		order = DefaultCronJobConfigOrder
+	}
+	o := *order
+	return func(pager *cronjobconfigPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultCronJobConfigOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithCronJobConfigFilter configures pagination filter.
+func WithCronJobConfigFilter(filter func(*CronJobConfigQuery) (*CronJobConfigQuery, error)) CronJobConfigPaginateOption {
+	return func(pager *cronjobconfigPager) error {
+		if filter == nil {
+			return errors.New("CronJobConfigQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type cronjobconfigPager struct {
+	reverse bool
+	order   *CronJobConfigOrder
+	filter  func(*CronJobConfigQuery) (*CronJobConfigQuery, error)
+}
+
+func newCronJobConfigPager(opts []CronJobConfigPaginateOption, reverse bool) (*cronjobconfigPager, error) {
+	pager := &cronjobconfigPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultCronJobConfigOrder
+	}
+	return pager, nil
+}
+
+func (p *cronjobconfigPager) applyFilter(query *CronJobConfigQuery) (*CronJobConfigQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *cronjobconfigPager) toCursor(cjc *CronJobConfig) Cursor {
+	return p.order.Field.toCursor(cjc)
+}
+
+func (p *cronjobconfigPager) applyCursors(query *CronJobConfigQuery, after, before *Cursor) (*CronJobConfigQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCronJobConfigOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *cronjobconfigPager) applyOrder(query *CronJobConfigQuery) *CronJobConfigQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultCronJobConfigOrder.Field {
+		query = query.Order(DefaultCronJobConfigOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *cronjobconfigPager) orderExpr(query *CronJobConfigQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultCronJobConfigOrder.Field {
+			b.Comma().Ident(DefaultCronJobConfigOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to CronJobConfig.
+func (cjc *CronJobConfigQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...CronJobConfigPaginateOption,
+) (*CronJobConfigConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newCronJobConfigPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if cjc, err = pager.applyFilter(cjc); err != nil {
+		return nil, err
+	}
+	conn := &CronJobConfigConnection{Edges: []*CronJobConfigEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := cjc.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if cjc, err = pager.applyCursors(cjc, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		cjc.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := cjc.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	cjc = pager.applyOrder(cjc)
+	nodes, err := cjc.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// CronJobConfigOrderField defines the ordering field of CronJobConfig.
+type CronJobConfigOrderField struct {
+	// Value extracts the ordering value from the given CronJobConfig.
+	Value    func(*CronJobConfig) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) cronjobconfig.OrderOption
+	toCursor func(*CronJobConfig) Cursor
+}
+
+// CronJobConfigOrder defines the ordering of CronJobConfig.
+type CronJobConfigOrder struct {
+	Direction OrderDirection           `json:"direction"`
+	Field     *CronJobConfigOrderField `json:"field"`
+}
+
+// DefaultCronJobConfigOrder is the default ordering of CronJobConfig.
+var DefaultCronJobConfigOrder = &CronJobConfigOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &CronJobConfigOrderField{
+		Value: func(cjc *CronJobConfig) (ent.Value, error) {
+			return cjc.ID, nil
+		},
+		column: cronjobconfig.FieldID,
+		toTerm: cronjobconfig.ByID,
+		toCursor: func(cjc *CronJobConfig) Cursor {
+			return Cursor{ID: cjc.ID}
+		},
+	},
+}
+
+// ToEdge converts CronJobConfig into CronJobConfigEdge.
+func (cjc *CronJobConfig) ToEdge(order *CronJobConfigOrder) *CronJobConfigEdge {
+	if order == nil {
+		order = DefaultCronJobConfigOrder
+	}
+	return &CronJobConfigEdge{
+		Node:   cjc,
+		Cursor: order.Field.toCursor(cjc),
+	}
+}
+
+// JobExecutionHistoryEdge is the edge representation of JobExecutionHistory.
+type JobExecutionHistoryEdge struct {
+	Node   *JobExecutionHistory `json:"node"`
+	Cursor Cursor               `json:"cursor"`
+}
+
+// JobExecutionHistoryConnection is the connection containing edges to JobExecutionHistory.
+type JobExecutionHistoryConnection struct {
+	Edges      []*JobExecutionHistoryEdge `json:"edges"`
+	PageInfo   PageInfo                   `json:"pageInfo"`
+	TotalCount int                        `json:"totalCount"`
+}
+
+func (c *JobExecutionHistoryConnection) build(nodes []*JobExecutionHistory, pager *jobexecutionhistoryPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *JobExecutionHistory
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *JobExecutionHistory {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *JobExecutionHistory {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*JobExecutionHistoryEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &JobExecutionHistoryEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// JobExecutionHistoryPaginateOption enables pagination customization.
+type JobExecutionHistoryPaginateOption func(*jobexecutionhistoryPager) error
+
+// WithJobExecutionHistoryOrder configures pagination ordering.
+func WithJobExecutionHistoryOrder(order *JobExecutionHistoryOrder) JobExecutionHistoryPaginateOption {
+	if order == nil {
+		order = DefaultJobExecutionHistoryOrder
+	}
+	o := *order
+	return func(pager *jobexecutionhistoryPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultJobExecutionHistoryOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithJobExecutionHistoryFilter configures pagination filter.
+func WithJobExecutionHistoryFilter(filter func(*JobExecutionHistoryQuery) (*JobExecutionHistoryQuery, error)) JobExecutionHistoryPaginateOption {
+	return func(pager *jobexecutionhistoryPager) error {
+		if filter == nil {
+			return errors.New("JobExecutionHistoryQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type jobexecutionhistoryPager struct {
+	reverse bool
+	order   *JobExecutionHistoryOrder
+	filter  func(*JobExecutionHistoryQuery) (*JobExecutionHistoryQuery, error)
+}
+
+func newJobExecutionHistoryPager(opts []JobExecutionHistoryPaginateOption, reverse bool) (*jobexecutionhistoryPager, error) {
+	pager := &jobexecutionhistoryPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultJobExecutionHistoryOrder
+	}
+	return pager, nil
+}
+
+func (p *jobexecutionhistoryPager) applyFilter(query *JobExecutionHistoryQuery) (*JobExecutionHistoryQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *jobexecutionhistoryPager) toCursor(jeh *JobExecutionHistory) Cursor {
+	return p.order.Field.toCursor(jeh)
+}
+
+func (p *jobexecutionhistoryPager) applyCursors(query *JobExecutionHistoryQuery, after, before *Cursor) (*JobExecutionHistoryQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultJobExecutionHistoryOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *jobexecutionhistoryPager) applyOrder(query *JobExecutionHistoryQuery) *JobExecutionHistoryQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultJobExecutionHistoryOrder.Field {
+		query = query.Order(DefaultJobExecutionHistoryOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *jobexecutionhistoryPager) orderExpr(query *JobExecutionHistoryQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultJobExecutionHistoryOrder.Field {
+			b.Comma().Ident(DefaultJobExecutionHistoryOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to JobExecutionHistory.
+func (jeh *JobExecutionHistoryQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...JobExecutionHistoryPaginateOption,
+) (*JobExecutionHistoryConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newJobExecutionHistoryPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if jeh, err = pager.applyFilter(jeh); err != nil {
+		return nil, err
+	}
+	conn := &JobExecutionHistoryConnection{Edges: []*JobExecutionHistoryEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := jeh.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if jeh, err = pager.applyCursors(jeh, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		jeh.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := jeh.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	jeh = pager.applyOrder(jeh)
+	nodes, err := jeh.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// JobExecutionHistoryOrderField defines the ordering field of JobExecutionHistory.
+type JobExecutionHistoryOrderField struct {
+	// Value extracts the ordering value from the given JobExecutionHistory.
+	Value    func(*JobExecutionHistory) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) jobexecutionhistory.OrderOption
+	toCursor func(*JobExecutionHistory) Cursor
+}
+
+// JobExecutionHistoryOrder defines the ordering of JobExecutionHistory.
+type JobExecutionHistoryOrder struct {
+	Direction OrderDirection                 `json:"direction"`
+	Field     *JobExecutionHistoryOrderField `json:"field"`
+}
+
+// DefaultJobExecutionHistoryOrder is the default ordering of JobExecutionHistory.
+var DefaultJobExecutionHistoryOrder = &JobExecutionHistoryOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &JobExecutionHistoryOrderField{
+		Value: func(jeh *JobExecutionHistory) (ent.Value, error) {
+			return jeh.ID, nil
+		},
+		column: jobexecutionhistory.FieldID,
+		toTerm: jobexecutionhistory.ByID,
+		toCursor: func(jeh *JobExecutionHistory) Cursor {
+			return Cursor{ID: jeh.ID}
+		},
+	},
+}
+
+// ToEdge converts JobExecutionHistory into JobExecutionHistoryEdge.
+func (jeh *JobExecutionHistory) ToEdge(order *JobExecutionHistoryOrder) *JobExecutionHistoryEdge {
+	if order == nil {
+		order = DefaultJobExecutionHistoryOrder
+	}
+	return &JobExecutionHistoryEdge{
+		Node:   jeh,
+		Cursor: order.Field.toCursor(jeh),
+	}
+}
+
+// ProfileEdge is the edge representation of Profile.
+type ProfileEdge struct {
+	Node   *Profile `json:"node"`
+	Cursor Cursor   `json:"cursor"`
+}
+
+// ProfileConnection is the connection containing edges to Profile.
+type ProfileConnection struct {
+	Edges      []*ProfileEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (c *ProfileConnection) build(nodes []*Profile, pager *profilePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Profile
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Profile {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Profile {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ProfileEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ProfileEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ProfilePaginateOption enables pagination customization.
+type ProfilePaginateOption func(*profilePager) error
+
+// WithProfileOrder configures pagination ordering.
+func WithProfileOrder(order *ProfileOrder) ProfilePaginateOption {
+	if order == nil {
+		order = DefaultProfileOrder
+	}
+	o := *order
+	return func(pager *profilePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultProfileOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithProfileFilter configures pagination filter.
+func WithProfileFilter(filter func(*ProfileQuery) (*ProfileQuery, error)) ProfilePaginateOption {
+	return func(pager *profilePager) error {
+		if filter == nil {
+			return errors.New("ProfileQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type profilePager struct {
+	reverse bool
+	order   *ProfileOrder
+	filter  func(*ProfileQuery) (*ProfileQuery, error)
+}
+
+func newProfilePager(opts []ProfilePaginateOption, reverse bool) (*profilePager, error) {
+	pager := &profilePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultProfileOrder
+	}
+	return pager, nil
+}
+
+func (p *profilePager) applyFilter(query *ProfileQuery) (*ProfileQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *profilePager) toCursor(pr *Profile) Cursor {
+	return p.order.Field.toCursor(pr)
+}
+
+func (p *profilePager) applyCursors(query *ProfileQuery, after, before *Cursor) (*ProfileQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfileOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *profilePager) applyOrder(query *ProfileQuery) *ProfileQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProfileOrder.Field {
+		query = query.Order(DefaultProfileOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *profilePager) orderExpr(query *ProfileQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProfileOrder.Field {
+			b.Comma().Ident(DefaultProfileOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Profile.
+func (pr *ProfileQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ProfilePaginateOption,
+) (*ProfileConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newProfilePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if pr, err = pager.applyFilter(pr); err != nil {
+		return nil, err
+	}
+	conn := &ProfileConnection{Edges: []*ProfileEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := pr.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if pr, err = pager.applyCursors(pr, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		pr.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := pr.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	pr = pager.applyOrder(pr)
+	nodes, err := pr.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// ProfileOrderField defines the ordering field of Profile.
+type ProfileOrderField struct {
+	// Value extracts the ordering value from the given Profile.
+	Value    func(*Profile) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) profile.OrderOption
+	toCursor func(*Profile) Cursor
+}
+
+// ProfileOrder defines the ordering of Profile.
+type ProfileOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *ProfileOrderField `json:"field"`
+}
+
+// DefaultProfileOrder is the default ordering of Profile.
+var DefaultProfileOrder = &ProfileOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ProfileOrderField{
+		Value: func(pr *Profile) (ent.Value, error) {
+			return pr.ID, nil
+		},
+		column: profile.FieldID,
+		toTerm: profile.ByID,
+		toCursor: func(pr *Profile) Cursor {
+			return Cursor{ID: pr.ID}
+		},
+	},
+}
+
+// ToEdge converts Profile into ProfileEdge.
+func (pr *Profile) ToEdge(order *ProfileOrder) *ProfileEdge {
+	if order == nil {
+		order = DefaultProfileOrder
+	}
+	return &ProfileEdge{
+		Node:   pr,
+		Cursor: order.Field.toCursor(pr),
+	}
+}
+
+// ProfileChangeEventEdge is the edge representation of ProfileChangeEvent.
+type ProfileChangeEventEdge struct {
+	Node   *ProfileChangeEvent `json:"node"`
+	Cursor Cursor              `json:"cursor"`
+}
+
+// ProfileChangeEventConnection is the connection containing edges to ProfileChangeEvent.
+type ProfileChangeEventConnection struct {
+	Edges      []*ProfileChangeEventEdge `json:"edges"`
+	PageInfo   PageInfo                  `json:"pageInfo"`
+	TotalCount int                       `json:"totalCount"`
+}
+
+func (c *ProfileChangeEventConnection) build(nodes []*ProfileChangeEvent, pager *profilechangeeventPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ProfileChangeEvent
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ProfileChangeEvent {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ProfileChangeEvent {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ProfileChangeEventEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ProfileChangeEventEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ProfileChangeEventPaginateOption enables pagination customization.
+type ProfileChangeEventPaginateOption func(*profilechangeeventPager) error
+
+// WithProfileChangeEventOrder configures pagination ordering.
+func WithProfileChangeEventOrder(order *ProfileChangeEventOrder) ProfileChangeEventPaginateOption {
+	if order == nil {
+		order = DefaultProfileChangeEventOrder
+	}
+	o := *order
+	return func(pager *profilechangeeventPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultProfileChangeEventOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithProfileChangeEventFilter configures pagination filter.
+func WithProfileChangeEventFilter(filter func(*ProfileChangeEventQuery) (*ProfileChangeEventQuery, error)) ProfileChangeEventPaginateOption {
+	return func(pager *profilechangeeventPager) error {
+		if filter == nil {
+			return errors.New("ProfileChangeEventQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type profilechangeeventPager struct {
+	reverse bool
+	order   *ProfileChangeEventOrder
+	filter  func(*ProfileChangeEventQuery) (*ProfileChangeEventQuery, error)
+}
+
+func newProfileChangeEventPager(opts []ProfileChangeEventPaginateOption, reverse bool) (*profilechangeeventPager, error) {
+	pager := &profilechangeeventPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultProfileChangeEventOrder
+	}
+	return pager, nil
+}
+
+func (p *profilechangeeventPager) applyFilter(query *ProfileChangeEventQuery) (*ProfileChangeEventQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *profilechangeeventPager) toCursor(pce *ProfileChangeEvent) Cursor {
+	return p.order.Field.toCursor(pce)
+}
+
+func (p *profilechangeeventPager) applyCursors(query *ProfileChangeEventQuery, after, before *Cursor) (*ProfileChangeEventQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfileChangeEventOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *profilechangeeventPager) applyOrder(query *ProfileChangeEventQuery) *ProfileChangeEventQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProfileChangeEventOrder.Field {
+		query = query.Order(DefaultProfileChangeEventOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *profilechangeeventPager) orderExpr(query *ProfileChangeEventQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProfileChangeEventOrder.Field {
+			b.Comma().Ident(DefaultProfileChangeEventOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ProfileChangeEvent.
+func (pce *ProfileChangeEventQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ProfileChangeEventPaginateOption,
+) (*ProfileChangeEventConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newProfileChangeEventPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if pce, err = pager.applyFilter(pce); err != nil {
+		return nil, err
+	}
+	conn := &ProfileChangeEventConnection{Edges: []*ProfileChangeEventEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := pce.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if pce, err = pager.applyCursors(pce, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		pce.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := pce.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	pce = pager.applyOrder(pce)
+	nodes, err := pce.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// ProfileChangeEventOrderField defines the ordering field of ProfileChangeEvent.
+type ProfileChangeEventOrderField struct {
+	// Value extracts the ordering value from the given ProfileChangeEvent.
+	Value    func(*ProfileChangeEvent) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) profilechangeevent.OrderOption
+	toCursor func(*ProfileChangeEvent) Cursor
+}
+
+// ProfileChangeEventOrder defines the ordering of ProfileChangeEvent.
+type ProfileChangeEventOrder struct {
+	Direction OrderDirection                `json:"direction"`
+	Field     *ProfileChangeEventOrderField `json:"field"`
+}
+
+// DefaultProfileChangeEventOrder is the default ordering of ProfileChangeEvent.
+var DefaultProfileChangeEventOrder = &ProfileChangeEventOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ProfileChangeEventOrderField{
+		Value: func(pce *ProfileChangeEvent) (ent.Value, error) {
+			return pce.ID, nil
+		},
+		column: profilechangeevent.FieldID,
+		toTerm: profilechangeevent.ByID,
+		toCursor: func(pce *ProfileChangeEvent) Cursor {
+			return Cursor{ID: pce.ID}
+		},
+	},
+}
+
+// ToEdge converts ProfileChangeEvent into ProfileChangeEventEdge.
+func (pce *ProfileChangeEvent) ToEdge(order *ProfileChangeEventOrder) *ProfileChangeEventEdge {
+	if order == nil {
+		order = DefaultProfileChangeEventOrder
+	}
+	return &ProfileChangeEventEdge{
+		Node:   pce,
+		Cursor: order.Field.toCursor(pce),
+	}
+}
+
+// ProfileEducationEdge is the edge representation of ProfileEducation.
+type ProfileEducationEdge struct {
+	Node   *ProfileEducation `json:"node"`
+	Cursor Cursor            `json:"cursor"`
+}
+
+// ProfileEducationConnection is the connection containing edges to ProfileEducation.
+type ProfileEducationConnection struct {
+	Edges      []*ProfileEducationEdge `json:"edges"`
+	PageInfo   PageInfo                `json:"pageInfo"`
+	TotalCount int                     `json:"totalCount"`
+}
+
+func (c *ProfileEducationConnection) build(nodes []*ProfileEducation, pager *profileeducationPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ProfileEducation
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ProfileEducation {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ProfileEducation {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ProfileEducationEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ProfileEducationEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ProfileEducationPaginateOption enables pagination customization.
+type ProfileEducationPaginateOption func(*profileeducationPager) error
+
+// WithProfileEducationOrder configures pagination ordering.
+func WithProfileEducationOrder(order *ProfileEducationOrder) ProfileEducationPaginateOption {
+	if order == nil {
+		order = DefaultProfileEducationOrder
+	}
+	o := *order
+	return func(pager *profileeducationPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultProfileEducationOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithProfileEducationFilter configures pagination filter.
+func WithProfileEducationFilter(filter func(*ProfileEducationQuery) (*ProfileEducationQuery, error)) ProfileEducationPaginateOption {
+	return func(pager *profileeducationPager) error {
+		if filter == nil {
+			return errors.New("ProfileEducationQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type profileeducationPager struct {
+	reverse bool
+	order   *ProfileEducationOrder
+	filter  func(*ProfileEducationQuery) (*ProfileEducationQuery, error)
+}
+
+func newProfileEducationPager(opts []ProfileEducationPaginateOption, reverse bool) (*profileeducationPager, error) {
+	pager := &profileeducationPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultProfileEducationOrder
+	}
+	return pager, nil
+}
+
+func (p *profileeducationPager) applyFilter(query *ProfileEducationQuery) (*ProfileEducationQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *profileeducationPager) toCursor(pe *ProfileEducation) Cursor {
+	return p.order.Field.toCursor(pe)
+}
+
+func (p *profileeducationPager) applyCursors(query *ProfileEducationQuery, after, before *Cursor) (*ProfileEducationQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfileEducationOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *profileeducationPager) applyOrder(query *ProfileEducationQuery) *ProfileEducationQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProfileEducationOrder.Field {
+		query = query.Order(DefaultProfileEducationOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *profileeducationPager) orderExpr(query *ProfileEducationQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProfileEducationOrder.Field {
+			b.Comma().Ident(DefaultProfileEducationOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ProfileEducation.
+func (pe *ProfileEducationQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ProfileEducationPaginateOption,
+) (*ProfileEducationConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newProfileEducationPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if pe, err = pager.applyFilter(pe); err != nil {
+		return nil, err
+	}
+	conn := &ProfileEducationConnection{Edges: []*ProfileEducationEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := pe.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if pe, err = pager.applyCursors(pe, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		pe.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := pe.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	pe = pager.applyOrder(pe)
+	nodes, err := pe.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// ProfileEducationOrderField defines the ordering field of ProfileEducation.
+type ProfileEducationOrderField struct {
+	// Value extracts the ordering value from the given ProfileEducation.
+	Value    func(*ProfileEducation) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) profileeducation.OrderOption
+	toCursor func(*ProfileEducation) Cursor
+}
+
+// ProfileEducationOrder defines the ordering of ProfileEducation.
+type ProfileEducationOrder struct {
+	Direction OrderDirection              `json:"direction"`
+	Field     *ProfileEducationOrderField `json:"field"`
+}
+
+// DefaultProfileEducationOrder is the default ordering of ProfileEducation.
+var DefaultProfileEducationOrder = &ProfileEducationOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ProfileEducationOrderField{
+		Value: func(pe *ProfileEducation) (ent.Value, error) {
+			return pe.ID, nil
+		},
+		column: profileeducation.FieldID,
+		toTerm: profileeducation.ByID,
+		toCursor: func(pe *ProfileEducation) Cursor {
+			return Cursor{ID: pe.ID}
+		},
+	},
+}
+
+// ToEdge converts ProfileEducation into ProfileEducationEdge.
+func (pe *ProfileEducation) ToEdge(order *ProfileEducationOrder) *ProfileEducationEdge {
+	if order == nil {
+		order = DefaultProfileEducationOrder
+	}
+	return &ProfileEducationEdge{
+		Node:   pe,
+		Cursor: order.Field.toCursor(pe),
+	}
+}
+
+// ProfileEntryEdge is the edge representation of ProfileEntry.
+type ProfileEntryEdge struct {
+	Node   *ProfileEntry `json:"node"`
+	Cursor Cursor        `json:"cursor"`
+}
+
+// ProfileEntryConnection is the connection containing edges to ProfileEntry.
+type ProfileEntryConnection struct {
+	Edges      []*ProfileEntryEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+func (c *ProfileEntryConnection) build(nodes []*ProfileEntry, pager *profileentryPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ProfileEntry
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ProfileEntry {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ProfileEntry {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ProfileEntryEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ProfileEntryEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ProfileEntryPaginateOption enables pagination customization.
+type ProfileEntryPaginateOption func(*profileentryPager) error
+
+// WithProfileEntryOrder configures pagination ordering.
+func WithProfileEntryOrder(order *ProfileEntryOrder) ProfileEntryPaginateOption {
+	if order == nil {
+		order = DefaultProfileEntryOrder
+	}
+	o := *order
+	return func(pager *profileentryPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultProfileEntryOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithProfileEntryFilter configures pagination filter.
+func WithProfileEntryFilter(filter func(*ProfileEntryQuery) (*ProfileEntryQuery, error)) ProfileEntryPaginateOption {
+	return func(pager *profileentryPager) error {
+		if filter == nil {
+			return errors.New("ProfileEntryQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type profileentryPager struct {
+	reverse bool
+	order   *ProfileEntryOrder
+	filter  func(*ProfileEntryQuery) (*ProfileEntryQuery, error)
+}
+
+func newProfileEntryPager(opts []ProfileEntryPaginateOption, reverse bool) (*profileentryPager, error) {
+	pager := &profileentryPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultProfileEntryOrder
+	}
+	return pager, nil
+}
+
+func (p *profileentryPager) applyFilter(query *ProfileEntryQuery) (*ProfileEntryQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *profileentryPager) toCursor(pe *ProfileEntry) Cursor {
+	return p.order.Field.toCursor(pe)
+}
+
+func (p *profileentryPager) applyCursors(query *ProfileEntryQuery, after, before *Cursor) (*ProfileEntryQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfileEntryOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *profileentryPager) applyOrder(query *ProfileEntryQuery) *ProfileEntryQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProfileEntryOrder.Field {
+		query = query.Order(DefaultProfileEntryOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *profileentryPager) orderExpr(query *ProfileEntryQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProfileEntryOrder.Field {
+			b.Comma().Ident(DefaultProfileEntryOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ProfileEntry.
+func (pe *ProfileEntryQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ProfileEntryPaginateOption,
+) (*ProfileEntryConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newProfileEntryPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if pe, err = pager.applyFilter(pe); err != nil {
+		return nil, err
+	}
+	conn := &ProfileEntryConnection{Edges: []*ProfileEntryEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := pe.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if pe, err = pager.applyCursors(pe, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		pe.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := pe.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	pe = pager.applyOrder(pe)
+	nodes, err := pe.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// ProfileEntryOrderField defines the ordering field of ProfileEntry.
+type ProfileEntryOrderField struct {
+	// Value extracts the ordering value from the given ProfileEntry.
+	Value    func(*ProfileEntry) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) profileentry.OrderOption
+	toCursor func(*ProfileEntry) Cursor
+}
+
+// ProfileEntryOrder defines the ordering of ProfileEntry.
+type ProfileEntryOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *ProfileEntryOrderField `json:"field"`
+}
+
+// DefaultProfileEntryOrder is the default ordering of ProfileEntry.
+var DefaultProfileEntryOrder = &ProfileEntryOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ProfileEntryOrderField{
+		Value: func(pe *ProfileEntry) (ent.Value, error) {
+			return pe.ID, nil
+		},
+		column: profileentry.FieldID,
+		toTerm: profileentry.ByID,
+		toCursor: func(pe *ProfileEntry) Cursor {
+			return Cursor{ID: pe.ID}
+		},
+	},
+}
+
+// ToEdge converts ProfileEntry into ProfileEntryEdge.
+func (pe *ProfileEntry) ToEdge(order *ProfileEntryOrder) *ProfileEntryEdge {
+	if order == nil {
+		order = DefaultProfileEntryOrder
+	}
+	return &ProfileEntryEdge{
+		Node:   pe,
+		Cursor: order.Field.toCursor(pe),
+	}
+}
+
+// ProfilePositionEdge is the edge representation of ProfilePosition.
+type ProfilePositionEdge struct {
+	Node   *ProfilePosition `json:"node"`
+	Cursor Cursor           `json:"cursor"`
+}
+
+// ProfilePositionConnection is the connection containing edges to ProfilePosition.
+type ProfilePositionConnection struct {
+	Edges      []*ProfilePositionEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+func (c *ProfilePositionConnection) build(nodes []*ProfilePosition, pager *profilepositionPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ProfilePosition
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ProfilePosition {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ProfilePosition {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ProfilePositionEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ProfilePositionEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ProfilePositionPaginateOption enables pagination customization.
+type ProfilePositionPaginateOption func(*profilepositionPager) error
+
+// WithProfilePositionOrder configures pagination ordering.
+func WithProfilePositionOrder(order *ProfilePositionOrder) ProfilePositionPaginateOption {
+	if order == nil {
+		order = DefaultProfilePositionOrder
+	}
+	o := *order
+	return func(pager *profilepositionPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultProfilePositionOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithProfilePositionFilter configures pagination filter.
+func WithProfilePositionFilter(filter func(*ProfilePositionQuery) (*ProfilePositionQuery, error)) ProfilePositionPaginateOption {
+	return func(pager *profilepositionPager) error {
+		if filter == nil {
+			return errors.New("ProfilePositionQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type profilepositionPager struct {
+	reverse bool
+	order   *ProfilePositionOrder
+	filter  func(*ProfilePositionQuery) (*ProfilePositionQuery, error)
+}
+
+func newProfilePositionPager(opts []ProfilePositionPaginateOption, reverse bool) (*profilepositionPager, error) {
+	pager := &profilepositionPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultProfilePositionOrder
+	}
+	return pager, nil
+}
+
+func (p *profilepositionPager) applyFilter(query *ProfilePositionQuery) (*ProfilePositionQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *profilepositionPager) toCursor(pp *ProfilePosition) Cursor {
+	return p.order.Field.toCursor(pp)
+}
+
+func (p *profilepositionPager) applyCursors(query *ProfilePositionQuery, after, before *Cursor) (*ProfilePositionQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfilePositionOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *profilepositionPager) applyOrder(query *ProfilePositionQuery) *ProfilePositionQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProfilePositionOrder.Field {
+		query = query.Order(DefaultProfilePositionOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *profilepositionPager) orderExpr(query *ProfilePositionQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProfilePositionOrder.Field {
+			b.Comma().Ident(DefaultProfilePositionOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ProfilePosition.
+func (pp *ProfilePositionQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ProfilePositionPaginateOption,
+) (*ProfilePositionConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newProfilePositionPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if pp, err = pager.applyFilter(pp); err != nil {
+		return nil, err
+	}
+	conn := &ProfilePositionConnection{Edges: []*ProfilePositionEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := pp.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if pp, err = pager.applyCursors(pp, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		pp.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := pp.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	pp = pager.applyOrder(pp)
+	nodes, err := pp.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// ProfilePositionOrderField defines the ordering field of ProfilePosition.
+type ProfilePositionOrderField struct {
+	// Value extracts the ordering value from the given ProfilePosition.
+	Value    func(*ProfilePosition) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) profileposition.OrderOption
+	toCursor func(*ProfilePosition) Cursor
+}
+
+// ProfilePositionOrder defines the ordering of ProfilePosition.
+type ProfilePositionOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *ProfilePositionOrderField `json:"field"`
+}
+
+// DefaultProfilePositionOrder is the default ordering of ProfilePosition.
+var DefaultProfilePositionOrder = &ProfilePositionOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ProfilePositionOrderField{
+		Value: func(pp *ProfilePosition) (ent.Value, error) {
+			return pp.ID, nil
+		},
+		column: profileposition.FieldID,
+		toTerm: profileposition.ByID,
+		toCursor: func(pp *ProfilePosition) Cursor {
+			return Cursor{ID: pp.ID}
+		},
+	},
+}
+
+// ToEdge converts ProfilePosition into ProfilePositionEdge.
+func (pp *ProfilePosition) ToEdge(order *ProfilePositionOrder) *ProfilePositionEdge {
+	if order == nil {
+		order = DefaultProfilePositionOrder
+	}
+	return &ProfilePositionEdge{
+		Node:   pp,
+		Cursor: order.Field.toCursor(pp),
+	}
+}
+
+// ProfilePostEdge is the edge representation of ProfilePost.
+type ProfilePostEdge struct {
+	Node   *ProfilePost `json:"node"`
+	Cursor Cursor       `json:"cursor"`
+}
+
+// ProfilePostConnection is the connection containing edges to ProfilePost.
+type ProfilePostConnection struct {
+	Edges      []*ProfilePostEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+func (c *ProfilePostConnection) build(nodes []*ProfilePost, pager *profilepostPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
 		c.PageInfo.HasNextPage = true
@@ -874,21 +3124,21 @@ func (c *ProfileConnection) build(nodes []*Profile, pager *profilePager, after *
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Profile
+	var nodeAt func(int) *ProfilePost
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Profile {
+		nodeAt = func(i int) *ProfilePost {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Profile {
+		nodeAt = func(i int) *ProfilePost {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ProfileEdge, len(nodes))
+	c.Edges = make([]*ProfilePostEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ProfileEdge{
+		c.Edges[i] = &ProfilePostEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -902,87 +3152,87 @@ func (c *ProfileConnection) build(nodes []*Profile, pager *profilePager, after *
 	}
 }
 
-// ProfilePaginateOption enables pagination customization.
-type ProfilePaginateOption func(*profilePager) error
+// ProfilePostPaginateOption enables pagination customization.
+type ProfilePostPaginateOption func(*profilepostPager) error
 
-// WithProfileOrder configures pagination ordering.
-func WithProfileOrder(order *ProfileOrder) ProfilePaginateOption {
+// WithProfilePostOrder configures pagination ordering.
+func WithProfilePostOrder(order *ProfilePostOrder) ProfilePostPaginateOption {
 	if order == nil {
-		order = DefaultProfileOrder
+		order = DefaultProfilePostOrder
 	}
 	o := *order
-	return func(pager *profilePager) error {
+	return func(pager *profilepostPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultProfileOrder.Field
+			o.Field = DefaultProfilePostOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithProfileFilter configures pagination filter.
-func WithProfileFilter(filter func(*ProfileQuery) (*ProfileQuery, error)) ProfilePaginateOption {
-	return func(pager *profilePager) error {
+// WithProfilePostFilter configures pagination filter.
+func WithProfilePostFilter(filter func(*ProfilePostQuery) (*ProfilePostQuery, error)) ProfilePostPaginateOption {
+	return func(pager *profilepostPager) error {
 		if filter == nil {
-			return errors.New("ProfileQuery filter cannot be nil")
+			return errors.New("ProfilePostQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type profilePager struct {
+type profilepostPager struct {
 	reverse bool
-	order   *ProfileOrder
-	filter  func(*ProfileQuery) (*ProfileQuery, error)
+	order   *ProfilePostOrder
+	filter  func(*ProfilePostQuery) (*ProfilePostQuery, error)
 }
 
-func newProfilePager(opts []ProfilePaginateOption, reverse bool) (*profilePager, error) {
-	pager := &profilePager{reverse: reverse}
+func newProfilePostPager(opts []ProfilePostPaginateOption, reverse bool) (*profilepostPager, error) {
+	pager := &profilepostPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultProfileOrder
+		pager.order = DefaultProfilePostOrder
 	}
 	return pager, nil
 }
 
-func (p *profilePager) applyFilter(query *ProfileQuery) (*ProfileQuery, error) {
+func (p *profilepostPager) applyFilter(query *ProfilePostQuery) (*ProfilePostQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *profilePager) toCursor(pr *Profile) Cursor {
-	return p.order.Field.toCursor(pr)
+func (p *profilepostPager) toCursor(pp *ProfilePost) Cursor {
+	return p.order.Field.toCursor(pp)
 }
 
-func (p *profilePager) applyCursors(query *ProfileQuery, after, before *Cursor) (*ProfileQuery, error) {
+func (p *profilepostPager) applyCursors(query *ProfilePostQuery, after, before *Cursor) (*ProfilePostQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfileOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfilePostOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *profilePager) applyOrder(query *ProfileQuery) *ProfileQuery {
+func (p *profilepostPager) applyOrder(query *ProfilePostQuery) *ProfilePostQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultProfileOrder.Field {
-		query = query.Order(DefaultProfileOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProfilePostOrder.Field {
+		query = query.Order(DefaultProfilePostOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -990,7 +3240,7 @@ func (p *profilePager) applyOrder(query *ProfileQuery) *ProfileQuery {
 	return query
 }
 
-func (p *profilePager) orderExpr(query *ProfileQuery) sql.Querier {
+func (p *profilepostPager) orderExpr(query *ProfilePostQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1000,33 +3250,33 @@ func (p *profilePager) orderExpr(query *ProfileQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultProfileOrder.Field {
-			b.Comma().Ident(DefaultProfileOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProfilePostOrder.Field {
+			b.Comma().Ident(DefaultProfilePostOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Profile.
-func (pr *ProfileQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to ProfilePost.
+func (pp *ProfilePostQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ProfilePaginateOption,
-) (*ProfileConnection, error) {
+	before *Cursor, last *int, opts ...ProfilePostPaginateOption,
+) (*ProfilePostConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newProfilePager(opts, last != nil)
+	pager, err := newProfilePostPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if pr, err = pager.applyFilter(pr); err != nil {
+	if pp, err = pager.applyFilter(pp); err != nil {
 		return nil, err
 	}
-	conn := &ProfileConnection{Edges: []*ProfileEdge{}}
+	conn := &ProfilePostConnection{Edges: []*ProfilePostEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := pr.Clone()
+			c := pp.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -1038,20 +3288,20 @@ func (pr *ProfileQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if pr, err = pager.applyCursors(pr, after, before); err != nil {
+	if pp, err = pager.applyCursors(pp, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		pr.Limit(limit)
+		pp.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := pr.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := pp.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	pr = pager.applyOrder(pr)
-	nodes, err := pr.All(ctx)
+	pp = pager.applyOrder(pp)
+	nodes, err := pp.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1059,61 +3309,61 @@ func (pr *ProfileQuery) Paginate(
 	return conn, nil
 }
 
-// ProfileOrderField defines the ordering field of Profile.
-type ProfileOrderField struct {
-	// Value extracts the ordering value from the given Profile.
-	Value    func(*Profile) (ent.Value, error)
+// ProfilePostOrderField defines the ordering field of ProfilePost.
+type ProfilePostOrderField struct {
+	// Value extracts the ordering value from the given ProfilePost.
+	Value    func(*ProfilePost) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) profile.OrderOption
-	toCursor func(*Profile) Cursor
+	toTerm   func(...sql.OrderTermOption) profilepost.OrderOption
+	toCursor func(*ProfilePost) Cursor
 }
 
-// ProfileOrder defines the ordering of Profile.
-type ProfileOrder struct {
-	Direction OrderDirection     `json:"direction"`
-	Field     *ProfileOrderField `json:"field"`
+// ProfilePostOrder defines the ordering of ProfilePost.
+type ProfilePostOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *ProfilePostOrderField `json:"field"`
 }
 
-// DefaultProfileOrder is the default ordering of Profile.
-var DefaultProfileOrder = &ProfileOrder{
+// DefaultProfilePostOrder is the default ordering of ProfilePost.
+var DefaultProfilePostOrder = &ProfilePostOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ProfileOrderField{
-		Value: func(pr *Profile) (ent.Value, error) {
-			return pr.ID, nil
+	Field: &ProfilePostOrderField{
+		Value: func(pp *ProfilePost) (ent.Value, error) {
+			return pp.ID, nil
 		},
-		column: profile.FieldID,
-		toTerm: profile.ByID,
-		toCursor: func(pr *Profile) Cursor {
-			return Cursor{ID: pr.ID}
+		column: profilepost.FieldID,
+		toTerm: profilepost.ByID,
+		toCursor: func(pp *ProfilePost) Cursor {
+			return Cursor{ID: pp.ID}
 		},
 	},
 }
 
-// ToEdge converts Profile into ProfileEdge.
-func (pr *Profile) ToEdge(order *ProfileOrder) *ProfileEdge {
+// ToEdge converts ProfilePost into ProfilePostEdge.
+func (pp *ProfilePost) ToEdge(order *ProfilePostOrder) *ProfilePostEdge {
 	if order == nil {
-		order = DefaultProfileOrder
+		order = DefaultProfilePostOrder
 	}
-	return &ProfileEdge{
-		Node:   pr,
-		Cursor: order.Field.toCursor(pr),
+	return &ProfilePostEdge{
+		Node:   pp,
+		Cursor: order.Field.toCursor(pp),
 	}
 }
 
-// ProfileEntryEdge is the edge representation of ProfileEntry.
-type ProfileEntryEdge struct {
-	Node   *ProfileEntry `json:"node"`
-	Cursor Cursor        `json:"cursor"`
+// ProfilePostItemEdge is the edge representation of ProfilePostItem.
+type ProfilePostItemEdge struct {
+	Node   *ProfilePostItem `json:"node"`
+	Cursor Cursor           `json:"cursor"`
 }
 
-// ProfileEntryConnection is the connection containing edges to ProfileEntry.
-type ProfileEntryConnection struct {
-	Edges      []*ProfileEntryEdge `json:"edges"`
-	PageInfo   PageInfo            `json:"pageInfo"`
-	TotalCount int                 `json:"totalCount"`
+// ProfilePostItemConnection is the connection containing edges to ProfilePostItem.
+type ProfilePostItemConnection struct {
+	Edges      []*ProfilePostItemEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
 }
 
-func (c *ProfileEntryConnection) build(nodes []*ProfileEntry, pager *profileentryPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *ProfilePostItemConnection) build(nodes []*ProfilePostItem, pager *profilepostitemPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1123,21 +3373,21 @@ func (c *ProfileEntryConnection) build(nodes []*ProfileEntry, pager *profileentr
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *ProfileEntry
+	var nodeAt func(int) *ProfilePostItem
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *ProfileEntry {
+		nodeAt = func(i int) *ProfilePostItem {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *ProfileEntry {
+		nodeAt = func(i int) *ProfilePostItem {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ProfileEntryEdge, len(nodes))
+	c.Edges = make([]*ProfilePostItemEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ProfileEntryEdge{
+		c.Edges[i] = &ProfilePostItemEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1151,87 +3401,87 @@ func (c *ProfileEntryConnection) build(nodes []*ProfileEntry, pager *profileentr
 	}
 }
 
-// ProfileEntryPaginateOption enables pagination customization.
-type ProfileEntryPaginateOption func(*profileentryPager) error
+// ProfilePostItemPaginateOption enables pagination customization.
+type ProfilePostItemPaginateOption func(*profilepostitemPager) error
 
-// WithProfileEntryOrder configures pagination ordering.
-func WithProfileEntryOrder(order *ProfileEntryOrder) ProfileEntryPaginateOption {
+// WithProfilePostItemOrder configures pagination ordering.
+func WithProfilePostItemOrder(order *ProfilePostItemOrder) ProfilePostItemPaginateOption {
 	if order == nil {
-		order = DefaultProfileEntryOrder
+		order = DefaultProfilePostItemOrder
 	}
 	o := *order
-	return func(pager *profileentryPager) error {
+	return func(pager *profilepostitemPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultProfileEntryOrder.Field
+			o.Field = DefaultProfilePostItemOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithProfileEntryFilter configures pagination filter.
-func WithProfileEntryFilter(filter func(*ProfileEntryQuery) (*ProfileEntryQuery, error)) ProfileEntryPaginateOption {
-	return func(pager *profileentryPager) error {
+// WithProfilePostItemFilter configures pagination filter.
+func WithProfilePostItemFilter(filter func(*ProfilePostItemQuery) (*ProfilePostItemQuery, error)) ProfilePostItemPaginateOption {
+	return func(pager *profilepostitemPager) error {
 		if filter == nil {
-			return errors.New("ProfileEntryQuery filter cannot be nil")
+			return errors.New("ProfilePostItemQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type profileentryPager struct {
+type profilepostitemPager struct {
 	reverse bool
-	order   *ProfileEntryOrder
-	filter  func(*ProfileEntryQuery) (*ProfileEntryQuery, error)
+	order   *ProfilePostItemOrder
+	filter  func(*ProfilePostItemQuery) (*ProfilePostItemQuery, error)
 }
 
-func newProfileEntryPager(opts []ProfileEntryPaginateOption, reverse bool) (*profileentryPager, error) {
-	pager := &profileentryPager{reverse: reverse}
+func newProfilePostItemPager(opts []ProfilePostItemPaginateOption, reverse bool) (*profilepostitemPager, error) {
+	pager := &profilepostitemPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultProfileEntryOrder
+		pager.order = DefaultProfilePostItemOrder
 	}
 	return pager, nil
 }
 
-func (p *profileentryPager) applyFilter(query *ProfileEntryQuery) (*ProfileEntryQuery, error) {
+func (p *profilepostitemPager) applyFilter(query *ProfilePostItemQuery) (*ProfilePostItemQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *profileentryPager) toCursor(pe *ProfileEntry) Cursor {
-	return p.order.Field.toCursor(pe)
+func (p *profilepostitemPager) toCursor(ppi *ProfilePostItem) Cursor {
+	return p.order.Field.toCursor(ppi)
 }
 
-func (p *profileentryPager) applyCursors(query *ProfileEntryQuery, after, before *Cursor) (*ProfileEntryQuery, error) {
+func (p *profilepostitemPager) applyCursors(query *ProfilePostItemQuery, after, before *Cursor) (*ProfilePostItemQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfileEntryOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfilePostItemOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *profileentryPager) applyOrder(query *ProfileEntryQuery) *ProfileEntryQuery {
+func (p *profilepostitemPager) applyOrder(query *ProfilePostItemQuery) *ProfilePostItemQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultProfileEntryOrder.Field {
-		query = query.Order(DefaultProfileEntryOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProfilePostItemOrder.Field {
+		query = query.Order(DefaultProfilePostItemOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1239,7 +3489,7 @@ func (p *profileentryPager) applyOrder(query *ProfileEntryQuery) *ProfileEntryQu
 	return query
 }
 
-func (p *profileentryPager) orderExpr(query *ProfileEntryQuery) sql.Querier {
+func (p *profilepostitemPager) orderExpr(query *ProfilePostItemQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1249,33 +3499,33 @@ func (p *profileentryPager) orderExpr(query *ProfileEntryQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultProfileEntryOrder.Field {
-			b.Comma().Ident(DefaultProfileEntryOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProfilePostItemOrder.Field {
+			b.Comma().Ident(DefaultProfilePostItemOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to ProfileEntry.
-func (pe *ProfileEntryQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to ProfilePostItem.
+func (ppi *ProfilePostItemQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ProfileEntryPaginateOption,
-) (*ProfileEntryConnection, error) {
+	before *Cursor, last *int, opts ...ProfilePostItemPaginateOption,
+) (*ProfilePostItemConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newProfileEntryPager(opts, last != nil)
+	pager, err := newProfilePostItemPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if pe, err = pager.applyFilter(pe); err != nil {
+	if ppi, err = pager.applyFilter(ppi); err != nil {
 		return nil, err
 	}
-	conn := &ProfileEntryConnection{Edges: []*ProfileEntryEdge{}}
+	conn := &ProfilePostItemConnection{Edges: []*ProfilePostItemEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := pe.Clone()
+			c := ppi.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -1287,20 +3537,20 @@ func (pe *ProfileEntryQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if pe, err = pager.applyCursors(pe, after, before); err != nil {
+	if ppi, err = pager.applyCursors(ppi, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		pe.Limit(limit)
+		ppi.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := pe.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := ppi.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	pe = pager.applyOrder(pe)
-	nodes, err := pe.All(ctx)
+	ppi = pager.applyOrder(ppi)
+	nodes, err := ppi.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1308,61 +3558,61 @@ func (pe *ProfileEntryQuery) Paginate(
 	return conn, nil
 }
 
-// ProfileEntryOrderField defines the ordering field of ProfileEntry.
-type ProfileEntryOrderField struct {
-	// Value extracts the ordering value from the given ProfileEntry.
-	Value    func(*ProfileEntry) (ent.Value, error)
+// ProfilePostItemOrderField defines the ordering field of ProfilePostItem.
+type ProfilePostItemOrderField struct {
+	// Value extracts the ordering value from the given ProfilePostItem.
+	Value    func(*ProfilePostItem) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) profileentry.OrderOption
-	toCursor func(*ProfileEntry) Cursor
+	toTerm   func(...sql.OrderTermOption) profilepostitem.OrderOption
+	toCursor func(*ProfilePostItem) Cursor
 }
 
-// ProfileEntryOrder defines the ordering of ProfileEntry.
-type ProfileEntryOrder struct {
-	Direction OrderDirection          `json:"direction"`
-	Field     *ProfileEntryOrderField `json:"field"`
+// ProfilePostItemOrder defines the ordering of ProfilePostItem.
+type ProfilePostItemOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *ProfilePostItemOrderField `json:"field"`
 }
 
-// DefaultProfileEntryOrder is the default ordering of ProfileEntry.
-var DefaultProfileEntryOrder = &ProfileEntryOrder{
+// DefaultProfilePostItemOrder is the default ordering of ProfilePostItem.
+var DefaultProfilePostItemOrder = &ProfilePostItemOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ProfileEntryOrderField{
-		Value: func(pe *ProfileEntry) (ent.Value, error) {
-			return pe.ID, nil
+	Field: &ProfilePostItemOrderField{
+		Value: func(ppi *ProfilePostItem) (ent.Value, error) {
+			return ppi.ID, nil
 		},
-		column: profileentry.FieldID,
-		toTerm: profileentry.ByID,
-		toCursor: func(pe *ProfileEntry) Cursor {
-			return Cursor{ID: pe.ID}
+		column: profilepostitem.FieldID,
+		toTerm: profilepostitem.ByID,
+		toCursor: func(ppi *ProfilePostItem) Cursor {
+			return Cursor{ID: ppi.ID}
 		},
 	},
 }
 
-// ToEdge converts ProfileEntry into ProfileEntryEdge.
-func (pe *ProfileEntry) ToEdge(order *ProfileEntryOrder) *ProfileEntryEdge {
+// ToEdge converts ProfilePostItem into ProfilePostItemEdge.
+func (ppi *ProfilePostItem) ToEdge(order *ProfilePostItemOrder) *ProfilePostItemEdge {
 	if order == nil {
-		order = DefaultProfileEntryOrder
+		order = DefaultProfilePostItemOrder
 	}
-	return &ProfileEntryEdge{
-		Node:   pe,
-		Cursor: order.Field.toCursor(pe),
+	return &ProfilePostItemEdge{
+		Node:   ppi,
+		Cursor: order.Field.toCursor(ppi),
 	}
 }
 
-// ProfilePostEdge is the edge representation of ProfilePost.
-type ProfilePostEdge struct {
-	Node   *ProfilePost `json:"node"`
-	Cursor Cursor       `json:"cursor"`
+// ProfileSkillEdge is the edge representation of ProfileSkill.
+type ProfileSkillEdge struct {
+	Node   *ProfileSkill `json:"node"`
+	Cursor Cursor        `json:"cursor"`
 }
 
-// ProfilePostConnection is the connection containing edges to ProfilePost.
-type ProfilePostConnection struct {
-	Edges      []*ProfilePostEdge `json:"edges"`
-	PageInfo   PageInfo           `json:"pageInfo"`
-	TotalCount int                `json:"totalCount"`
+// ProfileSkillConnection is the connection containing edges to ProfileSkill.
+type ProfileSkillConnection struct {
+	Edges      []*ProfileSkillEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
 }
 
-func (c *ProfilePostConnection) build(nodes []*ProfilePost, pager *profilepostPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *ProfileSkillConnection) build(nodes []*ProfileSkill, pager *profileskillPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1372,21 +3622,21 @@ func (c *ProfilePostConnection) build(nodes []*ProfilePost, pager *profilepostPa
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *ProfilePost
+	var nodeAt func(int) *ProfileSkill
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *ProfilePost {
+		nodeAt = func(i int) *ProfileSkill {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *ProfilePost {
+		nodeAt = func(i int) *ProfileSkill {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ProfilePostEdge, len(nodes))
+	c.Edges = make([]*ProfileSkillEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ProfilePostEdge{
+		c.Edges[i] = &ProfileSkillEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1400,87 +3650,87 @@ func (c *ProfilePostConnection) build(nodes []*ProfilePost, pager *profilepostPa
 	}
 }
 
-// ProfilePostPaginateOption enables pagination customization.
-type ProfilePostPaginateOption func(*profilepostPager) error
+// ProfileSkillPaginateOption enables pagination customization.
+type ProfileSkillPaginateOption func(*profileskillPager) error
 
-// WithProfilePostOrder configures pagination ordering.
-func WithProfilePostOrder(order *ProfilePostOrder) ProfilePostPaginateOption {
+// WithProfileSkillOrder configures pagination ordering.
+func WithProfileSkillOrder(order *ProfileSkillOrder) ProfileSkillPaginateOption {
 	if order == nil {
-		order = DefaultProfilePostOrder
+		order = DefaultProfileSkillOrder
 	}
 	o := *order
-	return func(pager *profilepostPager) error {
+	return func(pager *profileskillPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultProfilePostOrder.Field
+			o.Field = DefaultProfileSkillOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithProfilePostFilter configures pagination filter.
-func WithProfilePostFilter(filter func(*ProfilePostQuery) (*ProfilePostQuery, error)) ProfilePostPaginateOption {
-	return func(pager *profilepostPager) error {
+// WithProfileSkillFilter configures pagination filter.
+func WithProfileSkillFilter(filter func(*ProfileSkillQuery) (*ProfileSkillQuery, error)) ProfileSkillPaginateOption {
+	return func(pager *profileskillPager) error {
 		if filter == nil {
-			return errors.New("ProfilePostQuery filter cannot be nil")
+			return errors.New("ProfileSkillQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type profilepostPager struct {
+type profileskillPager struct {
 	reverse bool
-	order   *ProfilePostOrder
-	filter  func(*ProfilePostQuery) (*ProfilePostQuery, error)
+	order   *ProfileSkillOrder
+	filter  func(*ProfileSkillQuery) (*ProfileSkillQuery, error)
 }
 
-func newProfilePostPager(opts []ProfilePostPaginateOption, reverse bool) (*profilepostPager, error) {
-	pager := &profilepostPager{reverse: reverse}
+func newProfileSkillPager(opts []ProfileSkillPaginateOption, reverse bool) (*profileskillPager, error) {
+	pager := &profileskillPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultProfilePostOrder
+		pager.order = DefaultProfileSkillOrder
 	}
 	return pager, nil
 }
 
-func (p *profilepostPager) applyFilter(query *ProfilePostQuery) (*ProfilePostQuery, error) {
+func (p *profileskillPager) applyFilter(query *ProfileSkillQuery) (*ProfileSkillQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *profilepostPager) toCursor(pp *ProfilePost) Cursor {
-	return p.order.Field.toCursor(pp)
+func (p *profileskillPager) toCursor(ps *ProfileSkill) Cursor {
+	return p.order.Field.toCursor(ps)
 }
 
-func (p *profilepostPager) applyCursors(query *ProfilePostQuery, after, before *Cursor) (*ProfilePostQuery, error) {
+func (p *profileskillPager) applyCursors(query *ProfileSkillQuery, after, before *Cursor) (*ProfileSkillQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfilePostOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfileSkillOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *profilepostPager) applyOrder(query *ProfilePostQuery) *ProfilePostQuery {
+func (p *profileskillPager) applyOrder(query *ProfileSkillQuery) *ProfileSkillQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultProfilePostOrder.Field {
-		query = query.Order(DefaultProfilePostOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProfileSkillOrder.Field {
+		query = query.Order(DefaultProfileSkillOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1488,7 +3738,7 @@ func (p *profilepostPager) applyOrder(query *ProfilePostQuery) *ProfilePostQuery
 	return query
 }
 
-func (p *profilepostPager) orderExpr(query *ProfilePostQuery) sql.Querier {
+func (p *profileskillPager) orderExpr(query *ProfileSkillQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1498,33 +3748,33 @@ func (p *profilepostPager) orderExpr(query *ProfilePostQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultProfilePostOrder.Field {
-			b.Comma().Ident(DefaultProfilePostOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProfileSkillOrder.Field {
+			b.Comma().Ident(DefaultProfileSkillOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to ProfilePost.
-func (pp *ProfilePostQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to ProfileSkill.
+func (ps *ProfileSkillQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ProfilePostPaginateOption,
-) (*ProfilePostConnection, error) {
+	before *Cursor, last *int, opts ...ProfileSkillPaginateOption,
+) (*ProfileSkillConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newProfilePostPager(opts, last != nil)
+	pager, err := newProfileSkillPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if pp, err = pager.applyFilter(pp); err != nil {
+	if ps, err = pager.applyFilter(ps); err != nil {
 		return nil, err
 	}
-	conn := &ProfilePostConnection{Edges: []*ProfilePostEdge{}}
+	conn := &ProfileSkillConnection{Edges: []*ProfileSkillEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := pp.Clone()
+			c := ps.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -1536,20 +3786,20 @@ func (pp *ProfilePostQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if pp, err = pager.applyCursors(pp, after, before); err != nil {
+	if ps, err = pager.applyCursors(ps, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		pp.Limit(limit)
+		ps.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := pp.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := ps.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	pp = pager.applyOrder(pp)
-	nodes, err := pp.All(ctx)
+	ps = pager.applyOrder(ps)
+	nodes, err := ps.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1557,61 +3807,61 @@ func (pp *ProfilePostQuery) Paginate(
 	return conn, nil
 }
 
-// ProfilePostOrderField defines the ordering field of ProfilePost.
-type ProfilePostOrderField struct {
-	// Value extracts the ordering value from the given ProfilePost.
-	Value    func(*ProfilePost) (ent.Value, error)
+// ProfileSkillOrderField defines the ordering field of ProfileSkill.
+type ProfileSkillOrderField struct {
+	// Value extracts the ordering value from the given ProfileSkill.
+	Value    func(*ProfileSkill) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) profilepost.OrderOption
-	toCursor func(*ProfilePost) Cursor
+	toTerm   func(...sql.OrderTermOption) profileskill.OrderOption
+	toCursor func(*ProfileSkill) Cursor
 }
 
-// ProfilePostOrder defines the ordering of ProfilePost.
-type ProfilePostOrder struct {
-	Direction OrderDirection         `json:"direction"`
-	Field     *ProfilePostOrderField `json:"field"`
+// ProfileSkillOrder defines the ordering of ProfileSkill.
+type ProfileSkillOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *ProfileSkillOrderField `json:"field"`
 }
 
-// DefaultProfilePostOrder is the default ordering of ProfilePost.
-var DefaultProfilePostOrder = &ProfilePostOrder{
+// DefaultProfileSkillOrder is the default ordering of ProfileSkill.
+var DefaultProfileSkillOrder = &ProfileSkillOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ProfilePostOrderField{
-		Value: func(pp *ProfilePost) (ent.Value, error) {
-			return pp.ID, nil
+	Field: &ProfileSkillOrderField{
+		Value: func(ps *ProfileSkill) (ent.Value, error) {
+			return ps.ID, nil
 		},
-		column: profilepost.FieldID,
-		toTerm: profilepost.ByID,
-		toCursor: func(pp *ProfilePost) Cursor {
-			return Cursor{ID: pp.ID}
+		column: profileskill.FieldID,
+		toTerm: profileskill.ByID,
+		toCursor: func(ps *ProfileSkill) Cursor {
+			return Cursor{ID: ps.ID}
 		},
 	},
 }
 
-// ToEdge converts ProfilePost into ProfilePostEdge.
-func (pp *ProfilePost) ToEdge(order *ProfilePostOrder) *ProfilePostEdge {
+// ToEdge converts ProfileSkill into ProfileSkillEdge.
+func (ps *ProfileSkill) ToEdge(order *ProfileSkillOrder) *ProfileSkillEdge {
 	if order == nil {
-		order = DefaultProfilePostOrder
+		order = DefaultProfileSkillOrder
 	}
-	return &ProfilePostEdge{
-		Node:   pp,
-		Cursor: order.Field.toCursor(pp),
+	return &ProfileSkillEdge{
+		Node:   ps,
+		Cursor: order.Field.toCursor(ps),
 	}
 }
 
-// ProfilePostItemEdge is the edge representation of ProfilePostItem.
-type ProfilePostItemEdge struct {
-	Node   *ProfilePostItem `json:"node"`
+// ProfileSnapshotEdge is the edge representation of ProfileSnapshot.
+type ProfileSnapshotEdge struct {
+	Node   *ProfileSnapshot `json:"node"`
 	Cursor Cursor           `json:"cursor"`
 }
 
-// ProfilePostItemConnection is the connection containing edges to ProfilePostItem.
-type ProfilePostItemConnection struct {
-	Edges      []*ProfilePostItemEdge `json:"edges"`
+// ProfileSnapshotConnection is the connection containing edges to ProfileSnapshot.
+type ProfileSnapshotConnection struct {
+	Edges      []*ProfileSnapshotEdge `json:"edges"`
 	PageInfo   PageInfo               `json:"pageInfo"`
 	TotalCount int                    `json:"totalCount"`
 }
 
-func (c *ProfilePostItemConnection) build(nodes []*ProfilePostItem, pager *profilepostitemPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *ProfileSnapshotConnection) build(nodes []*ProfileSnapshot, pager *profilesnapshotPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1621,21 +3871,21 @@ func (c *ProfilePostItemConnection) build(nodes []*ProfilePostItem, pager *profi
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *ProfilePostItem
+	var nodeAt func(int) *ProfileSnapshot
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *ProfilePostItem {
+		nodeAt = func(i int) *ProfileSnapshot {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *ProfilePostItem {
+		nodeAt = func(i int) *ProfileSnapshot {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ProfilePostItemEdge, len(nodes))
+	c.Edges = make([]*ProfileSnapshotEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ProfilePostItemEdge{
+		c.Edges[i] = &ProfileSnapshotEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1649,87 +3899,87 @@ func (c *ProfilePostItemConnection) build(nodes []*ProfilePostItem, pager *profi
 	}
 }
 
-// ProfilePostItemPaginateOption enables pagination customization.
-type ProfilePostItemPaginateOption func(*profilepostitemPager) error
+// ProfileSnapshotPaginateOption enables pagination customization.
+type ProfileSnapshotPaginateOption func(*profilesnapshotPager) error
 
-// WithProfilePostItemOrder configures pagination ordering.
-func WithProfilePostItemOrder(order *ProfilePostItemOrder) ProfilePostItemPaginateOption {
+// WithProfileSnapshotOrder configures pagination ordering.
+func WithProfileSnapshotOrder(order *ProfileSnapshotOrder) ProfileSnapshotPaginateOption {
 	if order == nil {
-		order = DefaultProfilePostItemOrder
+		order = DefaultProfileSnapshotOrder
 	}
 	o := *order
-	return func(pager *profilepostitemPager) error {
+	return func(pager *profilesnapshotPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultProfilePostItemOrder.Field
+			o.Field = DefaultProfileSnapshotOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithProfilePostItemFilter configures pagination filter.
-func WithProfilePostItemFilter(filter func(*ProfilePostItemQuery) (*ProfilePostItemQuery, error)) ProfilePostItemPaginateOption {
-	return func(pager *profilepostitemPager) error {
+// WithProfileSnapshotFilter configures pagination filter.
+func WithProfileSnapshotFilter(filter func(*ProfileSnapshotQuery) (*ProfileSnapshotQuery, error)) ProfileSnapshotPaginateOption {
+	return func(pager *profilesnapshotPager) error {
 		if filter == nil {
-			return errors.New("ProfilePostItemQuery filter cannot be nil")
+			return errors.New("ProfileSnapshotQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type profilepostitemPager struct {
+type profilesnapshotPager struct {
 	reverse bool
-	order   *ProfilePostItemOrder
-	filter  func(*ProfilePostItemQuery) (*ProfilePostItemQuery, error)
+	order   *ProfileSnapshotOrder
+	filter  func(*ProfileSnapshotQuery) (*ProfileSnapshotQuery, error)
 }
 
-func newProfilePostItemPager(opts []ProfilePostItemPaginateOption, reverse bool) (*profilepostitemPager, error) {
-	pager := &profilepostitemPager{reverse: reverse}
+func newProfileSnapshotPager(opts []ProfileSnapshotPaginateOption, reverse bool) (*profilesnapshotPager, error) {
+	pager := &profilesnapshotPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultProfilePostItemOrder
+		pager.order = DefaultProfileSnapshotOrder
 	}
 	return pager, nil
 }
 
-func (p *profilepostitemPager) applyFilter(query *ProfilePostItemQuery) (*ProfilePostItemQuery, error) {
+func (p *profilesnapshotPager) applyFilter(query *ProfileSnapshotQuery) (*ProfileSnapshotQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *profilepostitemPager) toCursor(ppi *ProfilePostItem) Cursor {
-	return p.order.Field.toCursor(ppi)
+func (p *profilesnapshotPager) toCursor(ps *ProfileSnapshot) Cursor {
+	return p.order.Field.toCursor(ps)
 }
 
-func (p *profilepostitemPager) applyCursors(query *ProfilePostItemQuery, after, before *Cursor) (*ProfilePostItemQuery, error) {
+func (p *profilesnapshotPager) applyCursors(query *ProfileSnapshotQuery, after, before *Cursor) (*ProfileSnapshotQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfilePostItemOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProfileSnapshotOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *profilepostitemPager) applyOrder(query *ProfilePostItemQuery) *ProfilePostItemQuery {
+func (p *profilesnapshotPager) applyOrder(query *ProfileSnapshotQuery) *ProfileSnapshotQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultProfilePostItemOrder.Field {
-		query = query.Order(DefaultProfilePostItemOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProfileSnapshotOrder.Field {
+		query = query.Order(DefaultProfileSnapshotOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1737,7 +3987,7 @@ func (p *profilepostitemPager) applyOrder(query *ProfilePostItemQuery) *ProfileP
 	return query
 }
 
-func (p *profilepostitemPager) orderExpr(query *ProfilePostItemQuery) sql.Querier {
+func (p *profilesnapshotPager) orderExpr(query *ProfileSnapshotQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1747,33 +3997,33 @@ func (p *profilepostitemPager) orderExpr(query *ProfilePostItemQuery) sql.Querie
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultProfilePostItemOrder.Field {
-			b.Comma().Ident(DefaultProfilePostItemOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProfileSnapshotOrder.Field {
+			b.Comma().Ident(DefaultProfileSnapshotOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to ProfilePostItem.
-func (ppi *ProfilePostItemQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to ProfileSnapshot.
+func (ps *ProfileSnapshotQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ProfilePostItemPaginateOption,
-) (*ProfilePostItemConnection, error) {
+	before *Cursor, last *int, opts ...ProfileSnapshotPaginateOption,
+) (*ProfileSnapshotConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newProfilePostItemPager(opts, last != nil)
+	pager, err := newProfileSnapshotPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if ppi, err = pager.applyFilter(ppi); err != nil {
+	if ps, err = pager.applyFilter(ps); err != nil {
 		return nil, err
 	}
-	conn := &ProfilePostItemConnection{Edges: []*ProfilePostItemEdge{}}
+	conn := &ProfileSnapshotConnection{Edges: []*ProfileSnapshotEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := ppi.Clone()
+			c := ps.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -1785,20 +4035,20 @@ func (ppi *ProfilePostItemQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if ppi, err = pager.applyCursors(ppi, after, before); err != nil {
+	if ps, err = pager.applyCursors(ps, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		ppi.Limit(limit)
+		ps.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := ppi.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := ps.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	ppi = pager.applyOrder(ppi)
-	nodes, err := ppi.All(ctx)
+	ps = pager.applyOrder(ps)
+	nodes, err := ps.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1806,44 +4056,44 @@ func (ppi *ProfilePostItemQuery) Paginate(
 	return conn, nil
 }
 
-// ProfilePostItemOrderField defines the ordering field of ProfilePostItem.
-type ProfilePostItemOrderField struct {
-	// Value extracts the ordering value from the given ProfilePostItem.
-	Value    func(*ProfilePostItem) (ent.Value, error)
+// ProfileSnapshotOrderField defines the ordering field of ProfileSnapshot.
+type ProfileSnapshotOrderField struct {
+	// Value extracts the ordering value from the given ProfileSnapshot.
+	Value    func(*ProfileSnapshot) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) profilepostitem.OrderOption
-	toCursor func(*ProfilePostItem) Cursor
+	toTerm   func(...sql.OrderTermOption) profilesnapshot.OrderOption
+	toCursor func(*ProfileSnapshot) Cursor
 }
 
-// ProfilePostItemOrder defines the ordering of ProfilePostItem.
-type ProfilePostItemOrder struct {
+// ProfileSnapshotOrder defines the ordering of ProfileSnapshot.
+type ProfileSnapshotOrder struct {
 	Direction OrderDirection             `json:"direction"`
-	Field     *ProfilePostItemOrderField `json:"field"`
+	Field     *ProfileSnapshotOrderField `json:"field"`
 }
 
-// DefaultProfilePostItemOrder is the default ordering of ProfilePostItem.
-var DefaultProfilePostItemOrder = &ProfilePostItemOrder{
+// DefaultProfileSnapshotOrder is the default ordering of ProfileSnapshot.
+var DefaultProfileSnapshotOrder = &ProfileSnapshotOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ProfilePostItemOrderField{
-		Value: func(ppi *ProfilePostItem) (ent.Value, error) {
-			return ppi.ID, nil
+	Field: &ProfileSnapshotOrderField{
+		Value: func(ps *ProfileSnapshot) (ent.Value, error) {
+			return ps.ID, nil
 		},
-		column: profilepostitem.FieldID,
-		toTerm: profilepostitem.ByID,
-		toCursor: func(ppi *ProfilePostItem) Cursor {
-			return Cursor{ID: ppi.ID}
+		column: profilesnapshot.FieldID,
+		toTerm: profilesnapshot.ByID,
+		toCursor: func(ps *ProfileSnapshot) Cursor {
+			return Cursor{ID: ps.ID}
 		},
 	},
 }
 
-// ToEdge converts ProfilePostItem into ProfilePostItemEdge.
-func (ppi *ProfilePostItem) ToEdge(order *ProfilePostItemOrder) *ProfilePostItemEdge {
+// ToEdge converts ProfileSnapshot into ProfileSnapshotEdge.
+func (ps *ProfileSnapshot) ToEdge(order *ProfileSnapshotOrder) *ProfileSnapshotEdge {
 	if order == nil {
-		order = DefaultProfilePostItemOrder
+		order = DefaultProfileSnapshotOrder
 	}
-	return &ProfilePostItemEdge{
-		Node:   ppi,
-		Cursor: order.Field.toCursor(ppi),
+	return &ProfileSnapshotEdge{
+		Node:   ps,
+		Cursor: order.Field.toCursor(ps),
 	}
 }
 
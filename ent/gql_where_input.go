@@ -5,26 +5,35 @@ package ent
 import (
 	"errors"
 	"fmt"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/cronjobconfig"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/predicate"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
 	"sheng-go-backend/ent/profilepost"
 	"sheng-go-backend/ent/profilepostitem"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/schema/ulid"
 	"sheng-go-backend/ent/todo"
 	"sheng-go-backend/ent/user"
 	"time"
 )
 
-// APIQuotaTrackerWhereInput represents a where input for filtering APIQuotaTracker queries.
-type APIQuotaTrackerWhereInput struct {
-	Predicates []predicate.APIQuotaTracker  `json:"-"`
-	Not        *APIQuotaTrackerWhereInput   `json:"not,omitempty"`
-	Or         []*APIQuotaTrackerWhereInput `json:"or,omitempty"`
-	And        []*APIQuotaTrackerWhereInput `json:"and,omitempty"`
+// APICallLogWhereInput represents a where input for filtering APICallLog queries.
+type APICallLogWhereInput struct {
+	Predicates []predicate.APICallLog  `json:"-"`
+	Not        *APICallLogWhereInput   `json:"not,omitempty"`
+	Or         []*APICallLogWhereInput `json:"or,omitempty"`
+	And        []*APICallLogWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *ulid.ID  `json:"id,omitempty"`
@@ -36,6 +45,121 @@ type APIQuotaTrackerWhereInput struct {
 	IDLT    *ulid.ID  `json:"idLT,omitempty"`
 	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
 
+	// "endpoint" field predicates.
+	Endpoint             *string  `json:"endpoint,omitempty"`
+	EndpointNEQ          *string  `json:"endpointNEQ,omitempty"`
+	EndpointIn           []string `json:"endpointIn,omitempty"`
+	EndpointNotIn        []string `json:"endpointNotIn,omitempty"`
+	EndpointGT           *string  `json:"endpointGT,omitempty"`
+	EndpointGTE          *string  `json:"endpointGTE,omitempty"`
+	EndpointLT           *string  `json:"endpointLT,omitempty"`
+	EndpointLTE          *string  `json:"endpointLTE,omitempty"`
+	EndpointContains     *string  `json:"endpointContains,omitempty"`
+	EndpointHasPrefix    *string  `json:"endpointHasPrefix,omitempty"`
+	EndpointHasSuffix    *string  `json:"endpointHasSuffix,omitempty"`
+	EndpointEqualFold    *string  `json:"endpointEqualFold,omitempty"`
+	EndpointContainsFold *string  `json:"endpointContainsFold,omitempty"`
+
+	// "key_label" field predicates.
+	KeyLabel             *string  `json:"keyLabel,omitempty"`
+	KeyLabelNEQ          *string  `json:"keyLabelNEQ,omitempty"`
+	KeyLabelIn           []string `json:"keyLabelIn,omitempty"`
+	KeyLabelNotIn        []string `json:"keyLabelNotIn,omitempty"`
+	KeyLabelGT           *string  `json:"keyLabelGT,omitempty"`
+	KeyLabelGTE          *string  `json:"keyLabelGTE,omitempty"`
+	KeyLabelLT           *string  `json:"keyLabelLT,omitempty"`
+	KeyLabelLTE          *string  `json:"keyLabelLTE,omitempty"`
+	KeyLabelContains     *string  `json:"keyLabelContains,omitempty"`
+	KeyLabelHasPrefix    *string  `json:"keyLabelHasPrefix,omitempty"`
+	KeyLabelHasSuffix    *string  `json:"keyLabelHasSuffix,omitempty"`
+	KeyLabelEqualFold    *string  `json:"keyLabelEqualFold,omitempty"`
+	KeyLabelContainsFold *string  `json:"keyLabelContainsFold,omitempty"`
+
+	// "caller" field predicates.
+	Caller             *string  `json:"caller,omitempty"`
+	CallerNEQ          *string  `json:"callerNEQ,omitempty"`
+	CallerIn           []string `json:"callerIn,omitempty"`
+	CallerNotIn        []string `json:"callerNotIn,omitempty"`
+	CallerGT           *string  `json:"callerGT,omitempty"`
+	CallerGTE          *string  `json:"callerGTE,omitempty"`
+	CallerLT           *string  `json:"callerLT,omitempty"`
+	CallerLTE          *string  `json:"callerLTE,omitempty"`
+	CallerContains     *string  `json:"callerContains,omitempty"`
+	CallerHasPrefix    *string  `json:"callerHasPrefix,omitempty"`
+	CallerHasSuffix    *string  `json:"callerHasSuffix,omitempty"`
+	CallerEqualFold    *string  `json:"callerEqualFold,omitempty"`
+	CallerContainsFold *string  `json:"callerContainsFold,omitempty"`
+
+	// "run_id" field predicates.
+	RunID             *string  `json:"runID,omitempty"`
+	RunIDNEQ          *string  `json:"runIDNEQ,omitempty"`
+	RunIDIn           []string `json:"runIDIn,omitempty"`
+	RunIDNotIn        []string `json:"runIDNotIn,omitempty"`
+	RunIDGT           *string  `json:"runIDGT,omitempty"`
+	RunIDGTE          *string  `json:"runIDGTE,omitempty"`
+	RunIDLT           *string  `json:"runIDLT,omitempty"`
+	RunIDLTE          *string  `json:"runIDLTE,omitempty"`
+	RunIDContains     *string  `json:"runIDContains,omitempty"`
+	RunIDHasPrefix    *string  `json:"runIDHasPrefix,omitempty"`
+	RunIDHasSuffix    *string  `json:"runIDHasSuffix,omitempty"`
+	RunIDIsNil        bool     `json:"runIDIsNil,omitempty"`
+	RunIDNotNil       bool     `json:"runIDNotNil,omitempty"`
+	RunIDEqualFold    *string  `json:"runIDEqualFold,omitempty"`
+	RunIDContainsFold *string  `json:"runIDContainsFold,omitempty"`
+
+	// "urn" field predicates.
+	Urn             *string  `json:"urn,omitempty"`
+	UrnNEQ          *string  `json:"urnNEQ,omitempty"`
+	UrnIn           []string `json:"urnIn,omitempty"`
+	UrnNotIn        []string `json:"urnNotIn,omitempty"`
+	UrnGT           *string  `json:"urnGT,omitempty"`
+	UrnGTE          *string  `json:"urnGTE,omitempty"`
+	UrnLT           *string  `json:"urnLT,omitempty"`
+	UrnLTE          *string  `json:"urnLTE,omitempty"`
+	UrnContains     *string  `json:"urnContains,omitempty"`
+	UrnHasPrefix    *string  `json:"urnHasPrefix,omitempty"`
+	UrnHasSuffix    *string  `json:"urnHasSuffix,omitempty"`
+	UrnIsNil        bool     `json:"urnIsNil,omitempty"`
+	UrnNotNil       bool     `json:"urnNotNil,omitempty"`
+	UrnEqualFold    *string  `json:"urnEqualFold,omitempty"`
+	UrnContainsFold *string  `json:"urnContainsFold,omitempty"`
+
+	// "http_status" field predicates.
+	HTTPStatus       *int  `json:"httpStatus,omitempty"`
+	HTTPStatusNEQ    *int  `json:"httpStatusNEQ,omitempty"`
+	HTTPStatusIn     []int `json:"httpStatusIn,omitempty"`
+	HTTPStatusNotIn  []int `json:"httpStatusNotIn,omitempty"`
+	HTTPStatusGT     *int  `json:"httpStatusGT,omitempty"`
+	HTTPStatusGTE    *int  `json:"httpStatusGTE,omitempty"`
+	HTTPStatusLT     *int  `json:"httpStatusLT,omitempty"`
+	HTTPStatusLTE    *int  `json:"httpStatusLTE,omitempty"`
+	HTTPStatusIsNil  bool  `json:"httpStatusIsNil,omitempty"`
+	HTTPStatusNotNil bool  `json:"httpStatusNotNil,omitempty"`
+
+	// "latency_ms" field predicates.
+	LatencyMs      *int  `json:"latencyMs,omitempty"`
+	LatencyMsNEQ   *int  `json:"latencyMsNEQ,omitempty"`
+	LatencyMsIn    []int `json:"latencyMsIn,omitempty"`
+	LatencyMsNotIn []int `json:"latencyMsNotIn,omitempty"`
+	LatencyMsGT    *int  `json:"latencyMsGT,omitempty"`
+	LatencyMsGTE   *int  `json:"latencyMsGTE,omitempty"`
+	LatencyMsLT    *int  `json:"latencyMsLT,omitempty"`
+	LatencyMsLTE   *int  `json:"latencyMsLTE,omitempty"`
+
+	// "bytes" field predicates.
+	Bytes      *int  `json:"bytes,omitempty"`
+	BytesNEQ   *int  `json:"bytesNEQ,omitempty"`
+	BytesIn    []int `json:"bytesIn,omitempty"`
+	BytesNotIn []int `json:"bytesNotIn,omitempty"`
+	BytesGT    *int  `json:"bytesGT,omitempty"`
+	BytesGTE   *int  `json:"bytesGTE,omitempty"`
+	BytesLT    *int  `json:"bytesLT,omitempty"`
+	BytesLTE   *int  `json:"bytesLTE,omitempty"`
+
+	// "counted" field predicates.
+	Counted    *bool `json:"counted,omitempty"`
+	CountedNEQ *bool `json:"countedNEQ,omitempty"`
+
 	// "created_at" field predicates.
 	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
 	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
@@ -46,84 +170,24 @@ type APIQuotaTrackerWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "month" field predicates.
-	Month      *int  `json:"month,omitempty"`
-	MonthNEQ   *int  `json:"monthNEQ,omitempty"`
-	MonthIn    []int `json:"monthIn,omitempty"`
-	MonthNotIn []int `json:"monthNotIn,omitempty"`
-	MonthGT    *int  `json:"monthGT,omitempty"`
-	MonthGTE   *int  `json:"monthGTE,omitempty"`
-	MonthLT    *int  `json:"monthLT,omitempty"`
-	MonthLTE   *int  `json:"monthLTE,omitempty"`
-
-	// "year" field predicates.
-	Year      *int  `json:"year,omitempty"`
-	YearNEQ   *int  `json:"yearNEQ,omitempty"`
-	YearIn    []int `json:"yearIn,omitempty"`
-	YearNotIn []int `json:"yearNotIn,omitempty"`
-	YearGT    *int  `json:"yearGT,omitempty"`
-	YearGTE   *int  `json:"yearGTE,omitempty"`
-	YearLT    *int  `json:"yearLT,omitempty"`
-	YearLTE   *int  `json:"yearLTE,omitempty"`
-
-	// "call_count" field predicates.
-	CallCount      *int  `json:"callCount,omitempty"`
-	CallCountNEQ   *int  `json:"callCountNEQ,omitempty"`
-	CallCountIn    []int `json:"callCountIn,omitempty"`
-	CallCountNotIn []int `json:"callCountNotIn,omitempty"`
-	CallCountGT    *int  `json:"callCountGT,omitempty"`
-	CallCountGTE   *int  `json:"callCountGTE,omitempty"`
-	CallCountLT    *int  `json:"callCountLT,omitempty"`
-	CallCountLTE   *int  `json:"callCountLTE,omitempty"`
-
-	// "quota_limit" field predicates.
-	QuotaLimit      *int  `json:"quotaLimit,omitempty"`
-	QuotaLimitNEQ   *int  `json:"quotaLimitNEQ,omitempty"`
-	QuotaLimitIn    []int `json:"quotaLimitIn,omitempty"`
-	QuotaLimitNotIn []int `json:"quotaLimitNotIn,omitempty"`
-	QuotaLimitGT    *int  `json:"quotaLimitGT,omitempty"`
-	QuotaLimitGTE   *int  `json:"quotaLimitGTE,omitempty"`
-	QuotaLimitLT    *int  `json:"quotaLimitLT,omitempty"`
-	QuotaLimitLTE   *int  `json:"quotaLimitLTE,omitempty"`
-
-	// "quota_exceeded" field predicates.
-	QuotaExceeded    *bool `json:"quotaExceeded,omitempty"`
-	QuotaExceededNEQ *bool `json:"quotaExceededNEQ,omitempty"`
-
-	// "override_enabled" field predicates.
-	OverrideEnabled    *bool `json:"overrideEnabled,omitempty"`
-	OverrideEnabledNEQ *bool `json:"overrideEnabledNEQ,omitempty"`
-
-	// "notification_sent" field predicates.
-	NotificationSent    *bool `json:"notificationSent,omitempty"`
-	NotificationSentNEQ *bool `json:"notificationSentNEQ,omitempty"`
-
-	// "last_call_at" field predicates.
-	LastCallAt       *time.Time  `json:"lastCallAt,omitempty"`
-	LastCallAtNEQ    *time.Time  `json:"lastCallAtNEQ,omitempty"`
-	LastCallAtIn     []time.Time `json:"lastCallAtIn,omitempty"`
-	LastCallAtNotIn  []time.Time `json:"lastCallAtNotIn,omitempty"`
-	LastCallAtGT     *time.Time  `json:"lastCallAtGT,omitempty"`
-	LastCallAtGTE    *time.Time  `json:"lastCallAtGTE,omitempty"`
-	LastCallAtLT     *time.Time  `json:"lastCallAtLT,omitempty"`
-	LastCallAtLTE    *time.Time  `json:"lastCallAtLTE,omitempty"`
-	LastCallAtIsNil  bool        `json:"lastCallAtIsNil,omitempty"`
-	LastCallAtNotNil bool        `json:"lastCallAtNotNil,omitempty"`
+	// "job_execution" edge predicates.
+	HasJobExecution     *bool                            `json:"hasJobExecution,omitempty"`
+	HasJobExecutionWith []*JobExecutionHistoryWhereInput `json:"hasJobExecutionWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *APIQuotaTrackerWhereInput) AddPredicates(predicates ...predicate.APIQuotaTracker) {
+func (i *APICallLogWhereInput) AddPredicates(predicates ...predicate.APICallLog) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the APIQuotaTrackerWhereInput filter on the APIQuotaTrackerQuery builder.
-func (i *APIQuotaTrackerWhereInput) Filter(q *APIQuotaTrackerQuery) (*APIQuotaTrackerQuery, error) {
+// Filter applies the APICallLogWhereInput filter on the APICallLogQuery builder.
+func (i *APICallLogWhereInput) Filter(q *APICallLogQuery) (*APICallLogQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyAPIQuotaTrackerWhereInput {
+		if err == ErrEmptyAPICallLogWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -131,19 +195,19 @@ func (i *APIQuotaTrackerWhereInput) Filter(q *APIQuotaTrackerQuery) (*APIQuotaTr
 	return q.Where(p), nil
 }
 
-// ErrEmptyAPIQuotaTrackerWhereInput is returned in case the APIQuotaTrackerWhereInput is empty.
-var ErrEmptyAPIQuotaTrackerWhereInput = errors.New("ent: empty predicate APIQuotaTrackerWhereInput")
+// ErrEmptyAPICallLogWhereInput is returned in case the APICallLogWhereInput is empty.
+var ErrEmptyAPICallLogWhereInput = errors.New("ent: empty predicate APICallLogWhereInput")
 
-// P returns a predicate for filtering apiquotatrackers.
+// P returns a predicate for filtering apicalllogs.
 // An error is returned if the input is empty or invalid.
-func (i *APIQuotaTrackerWhereInput) P() (predicate.APIQuotaTracker, error) {
-	var predicates []predicate.APIQuotaTracker
+func (i *APICallLogWhereInput) P() (predicate.APICallLog, error) {
+	var predicates []predicate.APICallLog
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, apiquotatracker.Not(p))
+		predicates = append(predicates, apicalllog.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -153,7 +217,7 @@ func (i *APIQuotaTrackerWhereInput) P() (predicate.APIQuotaTracker, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.APIQuotaTracker, 0, n)
+		or := make([]predicate.APICallLog, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -161,7 +225,7 @@ func (i *APIQuotaTrackerWhereInput) P() (predicate.APIQuotaTracker, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, apiquotatracker.Or(or...))
+		predicates = append(predicates, apicalllog.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -171,7 +235,7 @@ func (i *APIQuotaTrackerWhereInput) P() (predicate.APIQuotaTracker, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.APIQuotaTracker, 0, n)
+		and := make([]predicate.APICallLog, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -179,346 +243,460 @@ func (i *APIQuotaTrackerWhereInput) P() (predicate.APIQuotaTracker, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, apiquotatracker.And(and...))
+		predicates = append(predicates, apicalllog.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, apiquotatracker.IDEQ(*i.ID))
+		predicates = append(predicates, apicalllog.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, apiquotatracker.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, apicalllog.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, apiquotatracker.IDIn(i.IDIn...))
+		predicates = append(predicates, apicalllog.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, apiquotatracker.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, apicalllog.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, apiquotatracker.IDGT(*i.IDGT))
+		predicates = append(predicates, apicalllog.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, apiquotatracker.IDGTE(*i.IDGTE))
+		predicates = append(predicates, apicalllog.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, apiquotatracker.IDLT(*i.IDLT))
+		predicates = append(predicates, apicalllog.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, apiquotatracker.IDLTE(*i.IDLTE))
+		predicates = append(predicates, apicalllog.IDLTE(*i.IDLTE))
 	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, apiquotatracker.CreatedAtEQ(*i.CreatedAt))
+	if i.Endpoint != nil {
+		predicates = append(predicates, apicalllog.EndpointEQ(*i.Endpoint))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, apiquotatracker.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.EndpointNEQ != nil {
+		predicates = append(predicates, apicalllog.EndpointNEQ(*i.EndpointNEQ))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, apiquotatracker.CreatedAtIn(i.CreatedAtIn...))
+	if len(i.EndpointIn) > 0 {
+		predicates = append(predicates, apicalllog.EndpointIn(i.EndpointIn...))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, apiquotatracker.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if len(i.EndpointNotIn) > 0 {
+		predicates = append(predicates, apicalllog.EndpointNotIn(i.EndpointNotIn...))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, apiquotatracker.CreatedAtGT(*i.CreatedAtGT))
+	if i.EndpointGT != nil {
+		predicates = append(predicates, apicalllog.EndpointGT(*i.EndpointGT))
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, apiquotatracker.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.EndpointGTE != nil {
+		predicates = append(predicates, apicalllog.EndpointGTE(*i.EndpointGTE))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, apiquotatracker.CreatedAtLT(*i.CreatedAtLT))
+	if i.EndpointLT != nil {
+		predicates = append(predicates, apicalllog.EndpointLT(*i.EndpointLT))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, apiquotatracker.CreatedAtLTE(*i.CreatedAtLTE))
+	if i.EndpointLTE != nil {
+		predicates = append(predicates, apicalllog.EndpointLTE(*i.EndpointLTE))
 	}
-	if i.Month != nil {
-		predicates = append(predicates, apiquotatracker.MonthEQ(*i.Month))
+	if i.EndpointContains != nil {
+		predicates = append(predicates, apicalllog.EndpointContains(*i.EndpointContains))
 	}
-	if i.MonthNEQ != nil {
-		predicates = append(predicates, apiquotatracker.MonthNEQ(*i.MonthNEQ))
+	if i.EndpointHasPrefix != nil {
+		predicates = append(predicates, apicalllog.EndpointHasPrefix(*i.EndpointHasPrefix))
 	}
-	if len(i.MonthIn) > 0 {
-		predicates = append(predicates, apiquotatracker.MonthIn(i.MonthIn...))
+	if i.EndpointHasSuffix != nil {
+		predicates = append(predicates, apicalllog.EndpointHasSuffix(*i.EndpointHasSuffix))
 	}
-	if len(i.MonthNotIn) > 0 {
-		predicates = append(predicates, apiquotatracker.MonthNotIn(i.MonthNotIn...))
+	if i.EndpointEqualFold != nil {
+		predicates = append(predicates, apicalllog.EndpointEqualFold(*i.EndpointEqualFold))
 	}
-	if i.MonthGT != nil {
-		predicates = append(predicates, apiquotatracker.MonthGT(*i.MonthGT))
+	if i.EndpointContainsFold != nil {
+		predicates = append(predicates, apicalllog.EndpointContainsFold(*i.EndpointContainsFold))
 	}
-	if i.MonthGTE != nil {
-		predicates = append(predicates, apiquotatracker.MonthGTE(*i.MonthGTE))
+	if i.KeyLabel != nil {
+		predicates = append(predicates, apicalllog.KeyLabelEQ(*i.KeyLabel))
 	}
-	if i.MonthLT != nil {
-		predicates = append(predicates, apiquotatracker.MonthLT(*i.MonthLT))
+	if i.KeyLabelNEQ != nil {
+		predicates = append(predicates, apicalllog.KeyLabelNEQ(*i.KeyLabelNEQ))
 	}
-	if i.MonthLTE != nil {
-		predicates = append(predicates, apiquotatracker.MonthLTE(*i.MonthLTE))
+	if len(i.KeyLabelIn) > 0 {
+		predicates = append(predicates, apicalllog.KeyLabelIn(i.KeyLabelIn...))
 	}
-	if i.Year != nil {
-		predicates = append(predicates, apiquotatracker.YearEQ(*i.Year))
+	if len(i.KeyLabelNotIn) > 0 {
+		predicates = append(predicates, apicalllog.KeyLabelNotIn(i.KeyLabelNotIn...))
 	}
-	if i.YearNEQ != nil {
-		predicates = append(predicates, apiquotatracker.YearNEQ(*i.YearNEQ))
+	if i.KeyLabelGT != nil {
+		predicates = append(predicates, apicalllog.KeyLabelGT(*i.KeyLabelGT))
 	}
-	if len(i.YearIn) > 0 {
-		predicates = append(predicates, apiquotatracker.YearIn(i.YearIn...))
+	if i.KeyLabelGTE != nil {
+		predicates = append(predicates, apicalllog.KeyLabelGTE(*i.KeyLabelGTE))
 	}
-	if len(i.YearNotIn) > 0 {
-		predicates = append(predicates, apiquotatracker.YearNotIn(i.YearNotIn...))
+	if i.KeyLabelLT != nil {
+		predicates = append(predicates, apicalllog.KeyLabelLT(*i.KeyLabelLT))
 	}
-	if i.YearGT != nil {
-		predicates = append(predicates, apiquotatracker.YearGT(*i.YearGT))
+	if i.KeyLabelLTE != nil {
+		predicates = append(predicates, apicalllog.KeyLabelLTE(*i.KeyLabelLTE))
 	}
-	if i.YearGTE != nil {
-		predicates = append(predicates, apiquotatracker.YearGTE(*i.YearGTE))
+	if i.KeyLabelContains != nil {
+		predicates = append(predicates, apicalllog.KeyLabelContains(*i.KeyLabelContains))
 	}
-	if i.YearLT != nil {
-		predicates = append(predicates, apiquotatracker.YearLT(*i.YearLT))
+	if i.KeyLabelHasPrefix != nil {
+		predicates = append(predicates, apicalllog.KeyLabelHasPrefix(*i.KeyLabelHasPrefix))
 	}
-	if i.YearLTE != nil {
-		predicates = append(predicates, apiquotatracker.YearLTE(*i.YearLTE))
+	if i.KeyLabelHasSuffix != nil {
+		predicates = append(predicates, apicalllog.KeyLabelHasSuffix(*i.KeyLabelHasSuffix))
 	}
-	if i.CallCount != nil {
-		predicates = append(predicates, apiquotatracker.CallCountEQ(*i.CallCount))
+	if i.KeyLabelEqualFold != nil {
+		predicates = append(predicates, apicalllog.KeyLabelEqualFold(*i.KeyLabelEqualFold))
 	}
-	if i.CallCountNEQ != nil {
-		predicates = append(predicates, apiquotatracker.CallCountNEQ(*i.CallCountNEQ))
+	if i.KeyLabelContainsFold != nil {
+		predicates = append(predicates, apicalllog.KeyLabelContainsFold(*i.KeyLabelContainsFold))
 	}
-	if len(i.CallCountIn) > 0 {
-		predicates = append(predicates, apiquotatracker.CallCountIn(i.CallCountIn...))
+	if i.Caller != nil {
+		predicates = append(predicates, apicalllog.CallerEQ(*i.Caller))
 	}
-	if len(i.CallCountNotIn) > 0 {
-		predicates = append(predicates, apiquotatracker.CallCountNotIn(i.CallCountNotIn...))
+	if i.CallerNEQ != nil {
+		predicates = append(predicates, apicalllog.CallerNEQ(*i.CallerNEQ))
 	}
-	if i.CallCountGT != nil {
-		predicates = append(predicates, apiquotatracker.CallCountGT(*i.CallCountGT))
+	if len(i.CallerIn) > 0 {
+		predicates = append(predicates, apicalllog.CallerIn(i.CallerIn...))
 	}
-	if i.CallCountGTE != nil {
-		predicates = append(predicates, apiquotatracker.CallCountGTE(*i.CallCountGTE))
+	if len(i.CallerNotIn) > 0 {
+		predicates = append(predicates, apicalllog.CallerNotIn(i.CallerNotIn...))
 	}
-	if i.CallCountLT != nil {
-		predicates = append(predicates, apiquotatracker.CallCountLT(*i.CallCountLT))
+	if i.CallerGT != nil {
+		predicates = append(predicates, apicalllog.CallerGT(*i.CallerGT))
 	}
-	if i.CallCountLTE != nil {
-		predicates = append(predicates, apiquotatracker.CallCountLTE(*i.CallCountLTE))
+	if i.CallerGTE != nil {
+		predicates = append(predicates, apicalllog.CallerGTE(*i.CallerGTE))
 	}
-	if i.QuotaLimit != nil {
-		predicates = append(predicates, apiquotatracker.QuotaLimitEQ(*i.QuotaLimit))
+	if i.CallerLT != nil {
+		predicates = append(predicates, apicalllog.CallerLT(*i.CallerLT))
 	}
-	if i.QuotaLimitNEQ != nil {
-		predicates = append(predicates, apiquotatracker.QuotaLimitNEQ(*i.QuotaLimitNEQ))
+	if i.CallerLTE != nil {
+		predicates = append(predicates, apicalllog.CallerLTE(*i.CallerLTE))
 	}
-	if len(i.QuotaLimitIn) > 0 {
-		predicates = append(predicates, apiquotatracker.QuotaLimitIn(i.QuotaLimitIn...))
+	if i.CallerContains != nil {
+		predicates = append(predicates, apicalllog.CallerContains(*i.CallerContains))
 	}
-	if len(i.QuotaLimitNotIn) > 0 {
-		predicates = append(predicates, apiquotatracker.QuotaLimitNotIn(i.QuotaLimitNotIn...))
+	if i.CallerHasPrefix != nil {
+		predicates = append(predicates, apicalllog.CallerHasPrefix(*i.CallerHasPrefix))
 	}
-	if i.QuotaLimitGT != nil {
-		predicates = append(predicates, apiquotatracker.QuotaLimitGT(*i.QuotaLimitGT))
+	if i.CallerHasSuffix != nil {
+		predicates = append(predicates, apicalllog.CallerHasSuffix(*i.CallerHasSuffix))
 	}
-	if i.QuotaLimitGTE != nil {
-		predicates = append(predicates, apiquotatracker.QuotaLimitGTE(*i.QuotaLimitGTE))
+	if i.CallerEqualFold != nil {
+		predicates = append(predicates, apicalllog.CallerEqualFold(*i.CallerEqualFold))
 	}
-	if i.QuotaLimitLT != nil {
-		predicates = append(predicates, apiquotatracker.QuotaLimitLT(*i.QuotaLimitLT))
+	if i.CallerContainsFold != nil {
+		predicates = append(predicates, apicalllog.CallerContainsFold(*i.CallerContainsFold))
 	}
-	if i.QuotaLimitLTE != nil {
-		predicates = append(predicates, apiquotatracker.QuotaLimitLTE(*i.QuotaLimitLTE))
+	if i.RunID != nil {
+		predicates = append(predicates, apicalllog.RunIDEQ(*i.RunID))
 	}
-	if i.QuotaExceeded != nil {
-		predicates = append(predicates, apiquotatracker.QuotaExceededEQ(*i.QuotaExceeded))
+	if i.RunIDNEQ != nil {
+		predicates = append(predicates, apicalllog.RunIDNEQ(*i.RunIDNEQ))
 	}
-	if i.QuotaExceededNEQ != nil {
-		predicates = append(predicates, apiquotatracker.QuotaExceededNEQ(*i.QuotaExceededNEQ))
+	if len(i.RunIDIn) > 0 {
+		predicates = append(predicates, apicalllog.RunIDIn(i.RunIDIn...))
 	}
-	if i.OverrideEnabled != nil {
-		predicates = append(predicates, apiquotatracker.OverrideEnabledEQ(*i.OverrideEnabled))
+	if len(i.RunIDNotIn) > 0 {
+		predicates = append(predicates, apicalllog.RunIDNotIn(i.RunIDNotIn...))
 	}
-	if i.OverrideEnabledNEQ != nil {
-		predicates = append(predicates, apiquotatracker.OverrideEnabledNEQ(*i.OverrideEnabledNEQ))
+	if i.RunIDGT != nil {
+		predicates = append(predicates, apicalllog.RunIDGT(*i.RunIDGT))
 	}
-	if i.NotificationSent != nil {
-		predicates = append(predicates, apiquotatracker.NotificationSentEQ(*i.NotificationSent))
+	if i.RunIDGTE != nil {
+		predicates = append(predicates, apicalllog.RunIDGTE(*i.RunIDGTE))
 	}
-	if i.NotificationSentNEQ != nil {
-		predicates = append(predicates, apiquotatracker.NotificationSentNEQ(*i.NotificationSentNEQ))
+	if i.RunIDLT != nil {
+		predicates = append(predicates, apicalllog.RunIDLT(*i.RunIDLT))
 	}
-	if i.LastCallAt != nil {
-		predicates = append(predicates, apiquotatracker.LastCallAtEQ(*i.LastCallAt))
+	if i.RunIDLTE != nil {
+		predicates = append(predicates, apicalllog.RunIDLTE(*i.RunIDLTE))
 	}
-	if i.LastCallAtNEQ != nil {
-		predicates = append(predicates, apiquotatracker.LastCallAtNEQ(*i.LastCallAtNEQ))
+	if i.RunIDContains != nil {
+		predicates = append(predicates, apicalllog.RunIDContains(*i.RunIDContains))
 	}
-	if len(i.LastCallAtIn) > 0 {
-		predicates = append(predicates, apiquotatracker.LastCallAtIn(i.LastCallAtIn...))
+	if i.RunIDHasPrefix != nil {
+		predicates = append(predicates, apicalllog.RunIDHasPrefix(*i.RunIDHasPrefix))
 	}
-	if len(i.LastCallAtNotIn) > 0 {
-		predicates = append(predicates, apiquotatracker.LastCallAtNotIn(i.LastCallAtNotIn...))
+	if i.RunIDHasSuffix != nil {
+		predicates = append(predicates, apicalllog.RunIDHasSuffix(*i.RunIDHasSuffix))
 	}
-	if i.LastCallAtGT != nil {
-		predicates = append(predicates, apiquotatracker.LastCallAtGT(*i.LastCallAtGT))
+	if i.RunIDIsNil {
+		predicates = append(predicates, apicalllog.RunIDIsNil())
 	}
-	if i.LastCallAtGTE != nil {
-		predicates = append(predicates, apiquotatracker.LastCallAtGTE(*i.LastCallAtGTE))
+	if i.RunIDNotNil {
+		predicates = append(predicates, apicalllog.RunIDNotNil())
 	}
-	if i.LastCallAtLT != nil {
-		predicates = append(predicates, apiquotatracker.LastCallAtLT(*i.LastCallAtLT))
+	if i.RunIDEqualFold != nil {
+		predicates = append(predicates, apicalllog.RunIDEqualFold(*i.RunIDEqualFold))
 	}
-	if i.LastCallAtLTE != nil {
-		predicates = append(predicates, apiquotatracker.LastCallAtLTE(*i.LastCallAtLTE))
+	if i.RunIDContainsFold != nil {
+		predicates = append(predicates, apicalllog.RunIDContainsFold(*i.RunIDContainsFold))
 	}
-	if i.LastCallAtIsNil {
-		predicates = append(predicates, apiquotatracker.LastCallAtIsNil())
+	if i.Urn != nil {
+		predicates = append(predicates, apicalllog.UrnEQ(*i.Urn))
 	}
-	if i.LastCallAtNotNil {
-		predicates = append(predicates, apiquotatracker.LastCallAtNotNil())
+	if i.UrnNEQ != nil {
+		predicates = append(predicates, apicalllog.UrnNEQ(*i.UrnNEQ))
 	}
-
-	switch len(predicates) {
-	case 0:
-		return nil, ErrEmptyAPIQuotaTrackerWhereInput
-	case 1:
-		return predicates[0], nil
-	default:
-		return apiquotatracker.And(predicates...), nil
+	if len(i.UrnIn) > 0 {
+		predicates = append(predicates, apicalllog.UrnIn(i.UrnIn...))
+	}
+	if len(i.UrnNotIn) > 0 {
+		predicates = append(predicates, apicalllog.UrnNotIn(i.UrnNotIn...))
+	}
+	if i.UrnGT != nil {
+		predicates = append(predicates, apicalllog.UrnGT(*i.UrnGT))
+	}
+	if i.UrnGTE != nil {
+		predicates = append(predicates, apicalllog.UrnGTE(*i.UrnGTE))
+	}
+	if i.UrnLT != nil {
+		predicates = append(predicates, apicalllog.UrnLT(*i.UrnLT))
+	}
+	if i.UrnLTE != nil {
+		predicates = append(predicates, apicalllog.UrnLTE(*i.UrnLTE))
+	}
+	if i.UrnContains != nil {
+		predicates = append(predicates, apicalllog.UrnContains(*i.UrnContains))
+	}
+	if i.UrnHasPrefix != nil {
+		predicates = append(predicates, apicalllog.UrnHasPrefix(*i.UrnHasPrefix))
+	}
+	if i.UrnHasSuffix != nil {
+		predicates = append(predicates, apicalllog.UrnHasSuffix(*i.UrnHasSuffix))
+	}
+	if i.UrnIsNil {
+		predicates = append(predicates, apicalllog.UrnIsNil())
+	}
+	if i.UrnNotNil {
+		predicates = append(predicates, apicalllog.UrnNotNil())
+	}
+	if i.UrnEqualFold != nil {
+		predicates = append(predicates, apicalllog.UrnEqualFold(*i.UrnEqualFold))
+	}
+	if i.UrnContainsFold != nil {
+		predicates = append(predicates, apicalllog.UrnContainsFold(*i.UrnContainsFold))
+	}
+	if i.HTTPStatus != nil {
+		predicates = append(predicates, apicalllog.HTTPStatusEQ(*i.HTTPStatus))
+	}
+	if i.HTTPStatusNEQ != nil {
+		predicates = append(predicates, apicalllog.HTTPStatusNEQ(*i.HTTPStatusNEQ))
+	}
+	if len(i.HTTPStatusIn) > 0 {
+		predicates = append(predicates, apicalllog.HTTPStatusIn(i.HTTPStatusIn...))
+	}
+	if len(i.HTTPStatusNotIn) > 0 {
+		predicates = append(predicates, apicalllog.HTTPStatusNotIn(i.HTTPStatusNotIn...))
+	}
+	if i.HTTPStatusGT != nil {
+		predicates = append(predicates, apicalllog.HTTPStatusGT(*i.HTTPStatusGT))
+	}
+	if i.HTTPStatusGTE != nil {
+		predicates = append(predicates, apicalllog.HTTPStatusGTE(*i.HTTPStatusGTE))
+	}
+	if i.HTTPStatusLT != nil {
+		predicates = append(predicates, apicalllog.HTTPStatusLT(*i.HTTPStatusLT))
+	}
+	if i.HTTPStatusLTE != nil {
+		predicates = append(predicates, apicalllog.HTTPStatusLTE(*i.HTTPStatusLTE))
+	}
+	if i.HTTPStatusIsNil {
+		predicates = append(predicates, apicalllog.HTTPStatusIsNil())
+	}
+	if i.HTTPStatusNotNil {
+		predicates = append(predicates, apicalllog.HTTPStatusNotNil())
+	}
+	if i.LatencyMs != nil {
+		predicates = append(predicates, apicalllog.LatencyMsEQ(*i.LatencyMs))
+	}
+	if i.LatencyMsNEQ != nil {
+		predicates = append(predicates, apicalllog.LatencyMsNEQ(*i.LatencyMsNEQ))
+	}
+	if len(i.LatencyMsIn) > 0 {
+		predicates = append(predicates, apicalllog.LatencyMsIn(i.LatencyMsIn...))
+	}
+	if len(i.LatencyMsNotIn) > 0 {
+		predicates = append(predicates, apicalllog.LatencyMsNotIn(i.LatencyMsNotIn...))
+	}
+	if i.LatencyMsGT != nil {
+		predicates = append(predicates, apicalllog.LatencyMsGT(*i.LatencyMsGT))
+	}
+	if i.LatencyMsGTE != nil {
+		predicates = append(predicates, apicalllog.LatencyMsGTE(*i.LatencyMsGTE))
+	}
+	if i.LatencyMsLT != nil {
+		predicates = append(predicates, apicalllog.LatencyMsLT(*i.LatencyMsLT))
+	}
+	if i.LatencyMsLTE != nil {
+		predicates = append(predicates, apicalllog.LatencyMsLTE(*i.LatencyMsLTE))
+	}
+	if i.Bytes != nil {
+		predicates = append(predicates, apicalllog.BytesEQ(*i.Bytes))
+	}
+	if i.BytesNEQ != nil {
+		predicates = append(predicates, apicalllog.BytesNEQ(*i.BytesNEQ))
+	}
+	if len(i.BytesIn) > 0 {
+		predicates = append(predicates, apicalllog.BytesIn(i.BytesIn...))
+	}
+	if len(i.BytesNotIn) > 0 {
+		predicates = append(predicates, apicalllog.BytesNotIn(i.BytesNotIn...))
+	}
+	if i.BytesGT != nil {
+		predicates = append(predicates, apicalllog.BytesGT(*i.BytesGT))
+	}
+	if i.BytesGTE != nil {
+		predicates = append(predicates, apicalllog.BytesGTE(*i.BytesGTE))
+	}
+	if i.BytesLT != nil {
+		predicates = append(predicates, apicalllog.BytesLT(*i.BytesLT))
+	}
+	if i.BytesLTE != nil {
+		predicates = append(predicates, apicalllog.BytesLTE(*i.BytesLTE))
+	}
+	if i.Counted != nil {
+		predicates = append(predicates, apicalllog.CountedEQ(*i.Counted))
+	}
+	if i.CountedNEQ != nil {
+		predicates = append(predicates, apicalllog.CountedNEQ(*i.CountedNEQ))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, apicalllog.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, apicalllog.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, apicalllog.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, apicalllog.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, apicalllog.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, apicalllog.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, apicalllog.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, apicalllog.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-}
-
-// CronJobConfigWhereInput represents a where input for filtering CronJobConfig queries.
-type CronJobConfigWhereInput struct {
-	Predicates []predicate.CronJobConfig  `json:"-"`
-	Not        *CronJobConfigWhereInput   `json:"not,omitempty"`
-	Or         []*CronJobConfigWhereInput `json:"or,omitempty"`
-	And        []*CronJobConfigWhereInput `json:"and,omitempty"`
-
-	// "id" field predicates.
-	ID      *ulid.ID  `json:"id,omitempty"`
-	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
-	IDIn    []ulid.ID `json:"idIn,omitempty"`
-	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
-	IDGT    *ulid.ID  `json:"idGT,omitempty"`
-	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
-	IDLT    *ulid.ID  `json:"idLT,omitempty"`
-	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
-
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
-
-	// "job_name" field predicates.
-	JobName             *string  `json:"jobName,omitempty"`
-	JobNameNEQ          *string  `json:"jobNameNEQ,omitempty"`
-	JobNameIn           []string `json:"jobNameIn,omitempty"`
-	JobNameNotIn        []string `json:"jobNameNotIn,omitempty"`
-	JobNameGT           *string  `json:"jobNameGT,omitempty"`
-	JobNameGTE          *string  `json:"jobNameGTE,omitempty"`
-	JobNameLT           *string  `json:"jobNameLT,omitempty"`
-	JobNameLTE          *string  `json:"jobNameLTE,omitempty"`
-	JobNameContains     *string  `json:"jobNameContains,omitempty"`
-	JobNameHasPrefix    *string  `json:"jobNameHasPrefix,omitempty"`
-	JobNameHasSuffix    *string  `json:"jobNameHasSuffix,omitempty"`
-	JobNameEqualFold    *string  `json:"jobNameEqualFold,omitempty"`
-	JobNameContainsFold *string  `json:"jobNameContainsFold,omitempty"`
-
-	// "job_type" field predicates.
-	JobType      *cronjobconfig.JobType  `json:"jobType,omitempty"`
-	JobTypeNEQ   *cronjobconfig.JobType  `json:"jobTypeNEQ,omitempty"`
-	JobTypeIn    []cronjobconfig.JobType `json:"jobTypeIn,omitempty"`
-	JobTypeNotIn []cronjobconfig.JobType `json:"jobTypeNotIn,omitempty"`
-
-	// "schedule" field predicates.
-	Schedule             *string  `json:"schedule,omitempty"`
-	ScheduleNEQ          *string  `json:"scheduleNEQ,omitempty"`
-	ScheduleIn           []string `json:"scheduleIn,omitempty"`
-	ScheduleNotIn        []string `json:"scheduleNotIn,omitempty"`
-	ScheduleGT           *string  `json:"scheduleGT,omitempty"`
-	ScheduleGTE          *string  `json:"scheduleGTE,omitempty"`
-	ScheduleLT           *string  `json:"scheduleLT,omitempty"`
-	ScheduleLTE          *string  `json:"scheduleLTE,omitempty"`
-	ScheduleContains     *string  `json:"scheduleContains,omitempty"`
-	ScheduleHasPrefix    *string  `json:"scheduleHasPrefix,omitempty"`
-	ScheduleHasSuffix    *string  `json:"scheduleHasSuffix,omitempty"`
-	ScheduleEqualFold    *string  `json:"scheduleEqualFold,omitempty"`
-	ScheduleContainsFold *string  `json:"scheduleContainsFold,omitempty"`
 
-	// "enabled" field predicates.
-	Enabled    *bool `json:"enabled,omitempty"`
-	EnabledNEQ *bool `json:"enabledNEQ,omitempty"`
+	if i.HasJobExecution != nil {
+		p := apicalllog.HasJobExecution()
+		if !*i.HasJobExecution {
+			p = apicalllog.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasJobExecutionWith) > 0 {
+		with := make([]predicate.JobExecutionHistory, 0, len(i.HasJobExecutionWith))
+		for _, w := range i.HasJobExecutionWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasJobExecutionWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, apicalllog.HasJobExecutionWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyAPICallLogWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return apicalllog.And(predicates...), nil
+	}
+}
 
-	// "batch_size" field predicates.
-	BatchSize      *int  `json:"batchSize,omitempty"`
-	BatchSizeNEQ   *int  `json:"batchSizeNEQ,omitempty"`
-	BatchSizeIn    []int `json:"batchSizeIn,omitempty"`
-	BatchSizeNotIn []int `json:"batchSizeNotIn,omitempty"`
-	BatchSizeGT    *int  `json:"batchSizeGT,omitempty"`
-	BatchSizeGTE   *int  `json:"batchSizeGTE,omitempty"`
-	BatchSizeLT    *int  `json:"batchSizeLT,omitempty"`
-	BatchSizeLTE   *int  `json:"batchSizeLTE,omitempty"`
+// APIQuotaBudgetWhereInput represents a where input for filtering APIQuotaBudget queries.
+type APIQuotaBudgetWhereInput struct {
+	Predicates []predicate.APIQuotaBudget  `json:"-"`
+	Not        *APIQuotaBudgetWhereInput   `json:"not,omitempty"`
+	Or         []*APIQuotaBudgetWhereInput `json:"or,omitempty"`
+	And        []*APIQuotaBudgetWhereInput `json:"and,omitempty"`
 
-	// "admin_email" field predicates.
-	AdminEmail             *string  `json:"adminEmail,omitempty"`
-	AdminEmailNEQ          *string  `json:"adminEmailNEQ,omitempty"`
-	AdminEmailIn           []string `json:"adminEmailIn,omitempty"`
-	AdminEmailNotIn        []string `json:"adminEmailNotIn,omitempty"`
-	AdminEmailGT           *string  `json:"adminEmailGT,omitempty"`
-	AdminEmailGTE          *string  `json:"adminEmailGTE,omitempty"`
-	AdminEmailLT           *string  `json:"adminEmailLT,omitempty"`
-	AdminEmailLTE          *string  `json:"adminEmailLTE,omitempty"`
-	AdminEmailContains     *string  `json:"adminEmailContains,omitempty"`
-	AdminEmailHasPrefix    *string  `json:"adminEmailHasPrefix,omitempty"`
-	AdminEmailHasSuffix    *string  `json:"adminEmailHasSuffix,omitempty"`
-	AdminEmailEqualFold    *string  `json:"adminEmailEqualFold,omitempty"`
-	AdminEmailContainsFold *string  `json:"adminEmailContainsFold,omitempty"`
+	// "id" field predicates.
+	ID      *ulid.ID  `json:"id,omitempty"`
+	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []ulid.ID `json:"idIn,omitempty"`
+	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *ulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *ulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
 
-	// "respect_quota" field predicates.
-	RespectQuota    *bool `json:"respectQuota,omitempty"`
-	RespectQuotaNEQ *bool `json:"respectQuotaNEQ,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "last_run_at" field predicates.
-	LastRunAt       *time.Time  `json:"lastRunAt,omitempty"`
-	LastRunAtNEQ    *time.Time  `json:"lastRunAtNEQ,omitempty"`
-	LastRunAtIn     []time.Time `json:"lastRunAtIn,omitempty"`
-	LastRunAtNotIn  []time.Time `json:"lastRunAtNotIn,omitempty"`
-	LastRunAtGT     *time.Time  `json:"lastRunAtGT,omitempty"`
-	LastRunAtGTE    *time.Time  `json:"lastRunAtGTE,omitempty"`
-	LastRunAtLT     *time.Time  `json:"lastRunAtLT,omitempty"`
-	LastRunAtLTE    *time.Time  `json:"lastRunAtLTE,omitempty"`
-	LastRunAtIsNil  bool        `json:"lastRunAtIsNil,omitempty"`
-	LastRunAtNotNil bool        `json:"lastRunAtNotNil,omitempty"`
+	// "percent" field predicates.
+	Percent      *float64  `json:"percent,omitempty"`
+	PercentNEQ   *float64  `json:"percentNEQ,omitempty"`
+	PercentIn    []float64 `json:"percentIn,omitempty"`
+	PercentNotIn []float64 `json:"percentNotIn,omitempty"`
+	PercentGT    *float64  `json:"percentGT,omitempty"`
+	PercentGTE   *float64  `json:"percentGTE,omitempty"`
+	PercentLT    *float64  `json:"percentLT,omitempty"`
+	PercentLTE   *float64  `json:"percentLTE,omitempty"`
+
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
 
-	// "next_run_at" field predicates.
-	NextRunAt       *time.Time  `json:"nextRunAt,omitempty"`
-	NextRunAtNEQ    *time.Time  `json:"nextRunAtNEQ,omitempty"`
-	NextRunAtIn     []time.Time `json:"nextRunAtIn,omitempty"`
-	NextRunAtNotIn  []time.Time `json:"nextRunAtNotIn,omitempty"`
-	NextRunAtGT     *time.Time  `json:"nextRunAtGT,omitempty"`
-	NextRunAtGTE    *time.Time  `json:"nextRunAtGTE,omitempty"`
-	NextRunAtLT     *time.Time  `json:"nextRunAtLT,omitempty"`
-	NextRunAtLTE    *time.Time  `json:"nextRunAtLTE,omitempty"`
-	NextRunAtIsNil  bool        `json:"nextRunAtIsNil,omitempty"`
-	NextRunAtNotNil bool        `json:"nextRunAtNotNil,omitempty"`
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *CronJobConfigWhereInput) AddPredicates(predicates ...predicate.CronJobConfig) {
+func (i *APIQuotaBudgetWhereInput) AddPredicates(predicates ...predicate.APIQuotaBudget) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the CronJobConfigWhereInput filter on the CronJobConfigQuery builder.
-func (i *CronJobConfigWhereInput) Filter(q *CronJobConfigQuery) (*CronJobConfigQuery, error) {
+// Filter applies the APIQuotaBudgetWhereInput filter on the APIQuotaBudgetQuery builder.
+func (i *APIQuotaBudgetWhereInput) Filter(q *APIQuotaBudgetQuery) (*APIQuotaBudgetQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyCronJobConfigWhereInput {
+		if err == ErrEmptyAPIQuotaBudgetWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -526,19 +704,19 @@ func (i *CronJobConfigWhereInput) Filter(q *CronJobConfigQuery) (*CronJobConfigQ
 	return q.Where(p), nil
 }
 
-// ErrEmptyCronJobConfigWhereInput is returned in case the CronJobConfigWhereInput is empty.
-var ErrEmptyCronJobConfigWhereInput = errors.New("ent: empty predicate CronJobConfigWhereInput")
+// ErrEmptyAPIQuotaBudgetWhereInput is returned in case the APIQuotaBudgetWhereInput is empty.
+var ErrEmptyAPIQuotaBudgetWhereInput = errors.New("ent: empty predicate APIQuotaBudgetWhereInput")
 
-// P returns a predicate for filtering cronjobconfigs.
+// P returns a predicate for filtering apiquotabudgets.
 // An error is returned if the input is empty or invalid.
-func (i *CronJobConfigWhereInput) P() (predicate.CronJobConfig, error) {
-	var predicates []predicate.CronJobConfig
+func (i *APIQuotaBudgetWhereInput) P() (predicate.APIQuotaBudget, error) {
+	var predicates []predicate.APIQuotaBudget
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, cronjobconfig.Not(p))
+		predicates = append(predicates, apiquotabudget.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -548,7 +726,7 @@ func (i *CronJobConfigWhereInput) P() (predicate.CronJobConfig, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.CronJobConfig, 0, n)
+		or := make([]predicate.APIQuotaBudget, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -556,7 +734,7 @@ func (i *CronJobConfigWhereInput) P() (predicate.CronJobConfig, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, cronjobconfig.Or(or...))
+		predicates = append(predicates, apiquotabudget.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -566,7 +744,7 @@ func (i *CronJobConfigWhereInput) P() (predicate.CronJobConfig, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.CronJobConfig, 0, n)
+		and := make([]predicate.APIQuotaBudget, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -574,299 +752,546 @@ func (i *CronJobConfigWhereInput) P() (predicate.CronJobConfig, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, cronjobconfig.And(and...))
+		predicates = append(predicates, apiquotabudget.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, cronjobconfig.IDEQ(*i.ID))
+		predicates = append(predicates, apiquotabudget.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, cronjobconfig.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, apiquotabudget.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, cronjobconfig.IDIn(i.IDIn...))
+		predicates = append(predicates, apiquotabudget.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, cronjobconfig.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, apiquotabudget.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, cronjobconfig.IDGT(*i.IDGT))
+		predicates = append(predicates, apiquotabudget.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, cronjobconfig.IDGTE(*i.IDGTE))
+		predicates = append(predicates, apiquotabudget.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, cronjobconfig.IDLT(*i.IDLT))
+		predicates = append(predicates, apiquotabudget.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, cronjobconfig.IDLTE(*i.IDLTE))
+		predicates = append(predicates, apiquotabudget.IDLTE(*i.IDLTE))
 	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, cronjobconfig.CreatedAtEQ(*i.CreatedAt))
+	if i.Name != nil {
+		predicates = append(predicates, apiquotabudget.NameEQ(*i.Name))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, cronjobconfig.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, apiquotabudget.NameNEQ(*i.NameNEQ))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, cronjobconfig.CreatedAtIn(i.CreatedAtIn...))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, apiquotabudget.NameIn(i.NameIn...))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, cronjobconfig.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, apiquotabudget.NameNotIn(i.NameNotIn...))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, cronjobconfig.CreatedAtGT(*i.CreatedAtGT))
+	if i.NameGT != nil {
+		predicates = append(predicates, apiquotabudget.NameGT(*i.NameGT))
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, cronjobconfig.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.NameGTE != nil {
+		predicates = append(predicates, apiquotabudget.NameGTE(*i.NameGTE))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, cronjobconfig.CreatedAtLT(*i.CreatedAtLT))
+	if i.NameLT != nil {
+		predicates = append(predicates, apiquotabudget.NameLT(*i.NameLT))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, cronjobconfig.CreatedAtLTE(*i.CreatedAtLTE))
+	if i.NameLTE != nil {
+		predicates = append(predicates, apiquotabudget.NameLTE(*i.NameLTE))
 	}
-	if i.JobName != nil {
-		predicates = append(predicates, cronjobconfig.JobNameEQ(*i.JobName))
+	if i.NameContains != nil {
+		predicates = append(predicates, apiquotabudget.NameContains(*i.NameContains))
 	}
-	if i.JobNameNEQ != nil {
-		predicates = append(predicates, cronjobconfig.JobNameNEQ(*i.JobNameNEQ))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, apiquotabudget.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if len(i.JobNameIn) > 0 {
-		predicates = append(predicates, cronjobconfig.JobNameIn(i.JobNameIn...))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, apiquotabudget.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if len(i.JobNameNotIn) > 0 {
-		predicates = append(predicates, cronjobconfig.JobNameNotIn(i.JobNameNotIn...))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, apiquotabudget.NameEqualFold(*i.NameEqualFold))
 	}
-	if i.JobNameGT != nil {
-		predicates = append(predicates, cronjobconfig.JobNameGT(*i.JobNameGT))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, apiquotabudget.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.JobNameGTE != nil {
-		predicates = append(predicates, cronjobconfig.JobNameGTE(*i.JobNameGTE))
+	if i.Percent != nil {
+		predicates = append(predicates, apiquotabudget.PercentEQ(*i.Percent))
 	}
-	if i.JobNameLT != nil {
-		predicates = append(predicates, cronjobconfig.JobNameLT(*i.JobNameLT))
+	if i.PercentNEQ != nil {
+		predicates = append(predicates, apiquotabudget.PercentNEQ(*i.PercentNEQ))
 	}
-	if i.JobNameLTE != nil {
-		predicates = append(predicates, cronjobconfig.JobNameLTE(*i.JobNameLTE))
+	if len(i.PercentIn) > 0 {
+		predicates = append(predicates, apiquotabudget.PercentIn(i.PercentIn...))
 	}
-	if i.JobNameContains != nil {
-		predicates = append(predicates, cronjobconfig.JobNameContains(*i.JobNameContains))
+	if len(i.PercentNotIn) > 0 {
+		predicates = append(predicates, apiquotabudget.PercentNotIn(i.PercentNotIn...))
 	}
-	if i.JobNameHasPrefix != nil {
-		predicates = append(predicates, cronjobconfig.JobNameHasPrefix(*i.JobNameHasPrefix))
+	if i.PercentGT != nil {
+		predicates = append(predicates, apiquotabudget.PercentGT(*i.PercentGT))
 	}
-	if i.JobNameHasSuffix != nil {
-		predicates = append(predicates, cronjobconfig.JobNameHasSuffix(*i.JobNameHasSuffix))
+	if i.PercentGTE != nil {
+		predicates = append(predicates, apiquotabudget.PercentGTE(*i.PercentGTE))
 	}
-	if i.JobNameEqualFold != nil {
-		predicates = append(predicates, cronjobconfig.JobNameEqualFold(*i.JobNameEqualFold))
+	if i.PercentLT != nil {
+		predicates = append(predicates, apiquotabudget.PercentLT(*i.PercentLT))
 	}
-	if i.JobNameContainsFold != nil {
-		predicates = append(predicates, cronjobconfig.JobNameContainsFold(*i.JobNameContainsFold))
+	if i.PercentLTE != nil {
+		predicates = append(predicates, apiquotabudget.PercentLTE(*i.PercentLTE))
 	}
-	if i.JobType != nil {
-		predicates = append(predicates, cronjobconfig.JobTypeEQ(*i.JobType))
+	if i.Description != nil {
+		predicates = append(predicates, apiquotabudget.DescriptionEQ(*i.Description))
 	}
-	if i.JobTypeNEQ != nil {
-		predicates = append(predicates, cronjobconfig.JobTypeNEQ(*i.JobTypeNEQ))
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, apiquotabudget.DescriptionNEQ(*i.DescriptionNEQ))
 	}
-	if len(i.JobTypeIn) > 0 {
-		predicates = append(predicates, cronjobconfig.JobTypeIn(i.JobTypeIn...))
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, apiquotabudget.DescriptionIn(i.DescriptionIn...))
 	}
-	if len(i.JobTypeNotIn) > 0 {
-		predicates = append(predicates, cronjobconfig.JobTypeNotIn(i.JobTypeNotIn...))
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, apiquotabudget.DescriptionNotIn(i.DescriptionNotIn...))
 	}
-	if i.Schedule != nil {
-		predicates = append(predicates, cronjobconfig.ScheduleEQ(*i.Schedule))
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, apiquotabudget.DescriptionGT(*i.DescriptionGT))
 	}
-	if i.ScheduleNEQ != nil {
-		predicates = append(predicates, cronjobconfig.ScheduleNEQ(*i.ScheduleNEQ))
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, apiquotabudget.DescriptionGTE(*i.DescriptionGTE))
 	}
-	if len(i.ScheduleIn) > 0 {
-		predicates = append(predicates, cronjobconfig.ScheduleIn(i.ScheduleIn...))
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, apiquotabudget.DescriptionLT(*i.DescriptionLT))
 	}
-	if len(i.ScheduleNotIn) > 0 {
-		predicates = append(predicates, cronjobconfig.ScheduleNotIn(i.ScheduleNotIn...))
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, apiquotabudget.DescriptionLTE(*i.DescriptionLTE))
 	}
-	if i.ScheduleGT != nil {
-		predicates = append(predicates, cronjobconfig.ScheduleGT(*i.ScheduleGT))
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, apiquotabudget.DescriptionContains(*i.DescriptionContains))
 	}
-	if i.ScheduleGTE != nil {
-		predicates = append(predicates, cronjobconfig.ScheduleGTE(*i.ScheduleGTE))
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, apiquotabudget.DescriptionHasPrefix(*i.DescriptionHasPrefix))
 	}
-	if i.ScheduleLT != nil {
-		predicates = append(predicates, cronjobconfig.ScheduleLT(*i.ScheduleLT))
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, apiquotabudget.DescriptionHasSuffix(*i.DescriptionHasSuffix))
 	}
-	if i.ScheduleLTE != nil {
-		predicates = append(predicates, cronjobconfig.ScheduleLTE(*i.ScheduleLTE))
+	if i.DescriptionIsNil {
+		predicates = append(predicates, apiquotabudget.DescriptionIsNil())
 	}
-	if i.ScheduleContains != nil {
-		predicates = append(predicates, cronjobconfig.ScheduleContains(*i.ScheduleContains))
+	if i.DescriptionNotNil {
+		predicates = append(predicates, apiquotabudget.DescriptionNotNil())
 	}
-	if i.ScheduleHasPrefix != nil {
-		predicates = append(predicates, cronjobconfig.ScheduleHasPrefix(*i.ScheduleHasPrefix))
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, apiquotabudget.DescriptionEqualFold(*i.DescriptionEqualFold))
 	}
-	if i.ScheduleHasSuffix != nil {
-		predicates = append(predicates, cronjobconfig.ScheduleHasSuffix(*i.ScheduleHasSuffix))
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, apiquotabudget.DescriptionContainsFold(*i.DescriptionContainsFold))
 	}
-	if i.ScheduleEqualFold != nil {
-		predicates = append(predicates, cronjobconfig.ScheduleEqualFold(*i.ScheduleEqualFold))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, apiquotabudget.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.ScheduleContainsFold != nil {
-		predicates = append(predicates, cronjobconfig.ScheduleContainsFold(*i.ScheduleContainsFold))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, apiquotabudget.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if i.Enabled != nil {
-		predicates = append(predicates, cronjobconfig.EnabledEQ(*i.Enabled))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, apiquotabudget.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.EnabledNEQ != nil {
-		predicates = append(predicates, cronjobconfig.EnabledNEQ(*i.EnabledNEQ))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, apiquotabudget.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.BatchSize != nil {
-		predicates = append(predicates, cronjobconfig.BatchSizeEQ(*i.BatchSize))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, apiquotabudget.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.BatchSizeNEQ != nil {
-		predicates = append(predicates, cronjobconfig.BatchSizeNEQ(*i.BatchSizeNEQ))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, apiquotabudget.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if len(i.BatchSizeIn) > 0 {
-		predicates = append(predicates, cronjobconfig.BatchSizeIn(i.BatchSizeIn...))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, apiquotabudget.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if len(i.BatchSizeNotIn) > 0 {
-		predicates = append(predicates, cronjobconfig.BatchSizeNotIn(i.BatchSizeNotIn...))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, apiquotabudget.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.BatchSizeGT != nil {
-		predicates = append(predicates, cronjobconfig.BatchSizeGT(*i.BatchSizeGT))
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyAPIQuotaBudgetWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return apiquotabudget.And(predicates...), nil
 	}
-	if i.BatchSizeGTE != nil {
-		predicates = append(predicates, cronjobconfig.BatchSizeGTE(*i.BatchSizeGTE))
+}
+
+// APIQuotaReservationWhereInput represents a where input for filtering APIQuotaReservation queries.
+type APIQuotaReservationWhereInput struct {
+	Predicates []predicate.APIQuotaReservation  `json:"-"`
+	Not        *APIQuotaReservationWhereInput   `json:"not,omitempty"`
+	Or         []*APIQuotaReservationWhereInput `json:"or,omitempty"`
+	And        []*APIQuotaReservationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *ulid.ID  `json:"id,omitempty"`
+	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []ulid.ID `json:"idIn,omitempty"`
+	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *ulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *ulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
+
+	// "reserved" field predicates.
+	Reserved      *int  `json:"reserved,omitempty"`
+	ReservedNEQ   *int  `json:"reservedNEQ,omitempty"`
+	ReservedIn    []int `json:"reservedIn,omitempty"`
+	ReservedNotIn []int `json:"reservedNotIn,omitempty"`
+	ReservedGT    *int  `json:"reservedGT,omitempty"`
+	ReservedGTE   *int  `json:"reservedGTE,omitempty"`
+	ReservedLT    *int  `json:"reservedLT,omitempty"`
+	ReservedLTE   *int  `json:"reservedLTE,omitempty"`
+
+	// "used" field predicates.
+	Used      *int  `json:"used,omitempty"`
+	UsedNEQ   *int  `json:"usedNEQ,omitempty"`
+	UsedIn    []int `json:"usedIn,omitempty"`
+	UsedNotIn []int `json:"usedNotIn,omitempty"`
+	UsedGT    *int  `json:"usedGT,omitempty"`
+	UsedGTE   *int  `json:"usedGTE,omitempty"`
+	UsedLT    *int  `json:"usedLT,omitempty"`
+	UsedLTE   *int  `json:"usedLTE,omitempty"`
+
+	// "status" field predicates.
+	Status      *apiquotareservation.Status  `json:"status,omitempty"`
+	StatusNEQ   *apiquotareservation.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []apiquotareservation.Status `json:"statusIn,omitempty"`
+	StatusNotIn []apiquotareservation.Status `json:"statusNotIn,omitempty"`
+
+	// "expires_at" field predicates.
+	ExpiresAt      *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ   *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn    []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT    *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE   *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT    *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE   *time.Time  `json:"expiresAtLTE,omitempty"`
+
+	// "settled_at" field predicates.
+	SettledAt       *time.Time  `json:"settledAt,omitempty"`
+	SettledAtNEQ    *time.Time  `json:"settledAtNEQ,omitempty"`
+	SettledAtIn     []time.Time `json:"settledAtIn,omitempty"`
+	SettledAtNotIn  []time.Time `json:"settledAtNotIn,omitempty"`
+	SettledAtGT     *time.Time  `json:"settledAtGT,omitempty"`
+	SettledAtGTE    *time.Time  `json:"settledAtGTE,omitempty"`
+	SettledAtLT     *time.Time  `json:"settledAtLT,omitempty"`
+	SettledAtLTE    *time.Time  `json:"settledAtLTE,omitempty"`
+	SettledAtIsNil  bool        `json:"settledAtIsNil,omitempty"`
+	SettledAtNotNil bool        `json:"settledAtNotNil,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "tracker" edge predicates.
+	HasTracker     *bool                        `json:"hasTracker,omitempty"`
+	HasTrackerWith []*APIQuotaTrackerWhereInput `json:"hasTrackerWith,omitempty"`
+
+	// "budget_tracker" edge predicates.
+	HasBudgetTracker     *bool                        `json:"hasBudgetTracker,omitempty"`
+	HasBudgetTrackerWith []*APIQuotaTrackerWhereInput `json:"hasBudgetTrackerWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *APIQuotaReservationWhereInput) AddPredicates(predicates ...predicate.APIQuotaReservation) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the APIQuotaReservationWhereInput filter on the APIQuotaReservationQuery builder.
+func (i *APIQuotaReservationWhereInput) Filter(q *APIQuotaReservationQuery) (*APIQuotaReservationQuery, error) {
+	if i == nil {
+		return q, nil
 	}
-	if i.BatchSizeLT != nil {
-		predicates = append(predicates, cronjobconfig.BatchSizeLT(*i.BatchSizeLT))
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyAPIQuotaReservationWhereInput {
+			return q, nil
+		}
+		return nil, err
 	}
-	if i.BatchSizeLTE != nil {
-		predicates = append(predicates, cronjobconfig.BatchSizeLTE(*i.BatchSizeLTE))
+	return q.Where(p), nil
+}
+
+// ErrEmptyAPIQuotaReservationWhereInput is returned in case the APIQuotaReservationWhereInput is empty.
+var ErrEmptyAPIQuotaReservationWhereInput = errors.New("ent: empty predicate APIQuotaReservationWhereInput")
+
+// P returns a predicate for filtering apiquotareservations.
+// An error is returned if the input is empty or invalid.
+func (i *APIQuotaReservationWhereInput) P() (predicate.APIQuotaReservation, error) {
+	var predicates []predicate.APIQuotaReservation
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, apiquotareservation.Not(p))
 	}
-	if i.AdminEmail != nil {
-		predicates = append(predicates, cronjobconfig.AdminEmailEQ(*i.AdminEmail))
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.APIQuotaReservation, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, apiquotareservation.Or(or...))
 	}
-	if i.AdminEmailNEQ != nil {
-		predicates = append(predicates, cronjobconfig.AdminEmailNEQ(*i.AdminEmailNEQ))
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.APIQuotaReservation, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, apiquotareservation.And(and...))
 	}
-	if len(i.AdminEmailIn) > 0 {
-		predicates = append(predicates, cronjobconfig.AdminEmailIn(i.AdminEmailIn...))
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, apiquotareservation.IDEQ(*i.ID))
 	}
-	if len(i.AdminEmailNotIn) > 0 {
-		predicates = append(predicates, cronjobconfig.AdminEmailNotIn(i.AdminEmailNotIn...))
+	if i.IDNEQ != nil {
+		predicates = append(predicates, apiquotareservation.IDNEQ(*i.IDNEQ))
 	}
-	if i.AdminEmailGT != nil {
-		predicates = append(predicates, cronjobconfig.AdminEmailGT(*i.AdminEmailGT))
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, apiquotareservation.IDIn(i.IDIn...))
 	}
-	if i.AdminEmailGTE != nil {
-		predicates = append(predicates, cronjobconfig.AdminEmailGTE(*i.AdminEmailGTE))
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, apiquotareservation.IDNotIn(i.IDNotIn...))
 	}
-	if i.AdminEmailLT != nil {
-		predicates = append(predicates, cronjobconfig.AdminEmailLT(*i.AdminEmailLT))
+	if i.IDGT != nil {
+		predicates = append(predicates, apiquotareservation.IDGT(*i.IDGT))
 	}
-	if i.AdminEmailLTE != nil {
-		predicates = append(predicates, cronjobconfig.AdminEmailLTE(*i.AdminEmailLTE))
+	if i.IDGTE != nil {
+		predicates = append(predicates, apiquotareservation.IDGTE(*i.IDGTE))
 	}
-	if i.AdminEmailContains != nil {
-		predicates = append(predicates, cronjobconfig.AdminEmailContains(*i.AdminEmailContains))
+	if i.IDLT != nil {
+		predicates = append(predicates, apiquotareservation.IDLT(*i.IDLT))
 	}
-	if i.AdminEmailHasPrefix != nil {
-		predicates = append(predicates, cronjobconfig.AdminEmailHasPrefix(*i.AdminEmailHasPrefix))
+	if i.IDLTE != nil {
+		predicates = append(predicates, apiquotareservation.IDLTE(*i.IDLTE))
 	}
-	if i.AdminEmailHasSuffix != nil {
-		predicates = append(predicates, cronjobconfig.AdminEmailHasSuffix(*i.AdminEmailHasSuffix))
+	if i.Reserved != nil {
+		predicates = append(predicates, apiquotareservation.ReservedEQ(*i.Reserved))
 	}
-	if i.AdminEmailEqualFold != nil {
-		predicates = append(predicates, cronjobconfig.AdminEmailEqualFold(*i.AdminEmailEqualFold))
+	if i.ReservedNEQ != nil {
+		predicates = append(predicates, apiquotareservation.ReservedNEQ(*i.ReservedNEQ))
 	}
-	if i.AdminEmailContainsFold != nil {
-		predicates = append(predicates, cronjobconfig.AdminEmailContainsFold(*i.AdminEmailContainsFold))
+	if len(i.ReservedIn) > 0 {
+		predicates = append(predicates, apiquotareservation.ReservedIn(i.ReservedIn...))
 	}
-	if i.RespectQuota != nil {
-		predicates = append(predicates, cronjobconfig.RespectQuotaEQ(*i.RespectQuota))
+	if len(i.ReservedNotIn) > 0 {
+		predicates = append(predicates, apiquotareservation.ReservedNotIn(i.ReservedNotIn...))
 	}
-	if i.RespectQuotaNEQ != nil {
-		predicates = append(predicates, cronjobconfig.RespectQuotaNEQ(*i.RespectQuotaNEQ))
+	if i.ReservedGT != nil {
+		predicates = append(predicates, apiquotareservation.ReservedGT(*i.ReservedGT))
 	}
-	if i.LastRunAt != nil {
-		predicates = append(predicates, cronjobconfig.LastRunAtEQ(*i.LastRunAt))
+	if i.ReservedGTE != nil {
+		predicates = append(predicates, apiquotareservation.ReservedGTE(*i.ReservedGTE))
 	}
-	if i.LastRunAtNEQ != nil {
-		predicates = append(predicates, cronjobconfig.LastRunAtNEQ(*i.LastRunAtNEQ))
+	if i.ReservedLT != nil {
+		predicates = append(predicates, apiquotareservation.ReservedLT(*i.ReservedLT))
 	}
-	if len(i.LastRunAtIn) > 0 {
-		predicates = append(predicates, cronjobconfig.LastRunAtIn(i.LastRunAtIn...))
+	if i.ReservedLTE != nil {
+		predicates = append(predicates, apiquotareservation.ReservedLTE(*i.ReservedLTE))
 	}
-	if len(i.LastRunAtNotIn) > 0 {
-		predicates = append(predicates, cronjobconfig.LastRunAtNotIn(i.LastRunAtNotIn...))
+	if i.Used != nil {
+		predicates = append(predicates, apiquotareservation.UsedEQ(*i.Used))
 	}
-	if i.LastRunAtGT != nil {
-		predicates = append(predicates, cronjobconfig.LastRunAtGT(*i.LastRunAtGT))
+	if i.UsedNEQ != nil {
+		predicates = append(predicates, apiquotareservation.UsedNEQ(*i.UsedNEQ))
 	}
-	if i.LastRunAtGTE != nil {
-		predicates = append(predicates, cronjobconfig.LastRunAtGTE(*i.LastRunAtGTE))
+	if len(i.UsedIn) > 0 {
+		predicates = append(predicates, apiquotareservation.UsedIn(i.UsedIn...))
 	}
-	if i.LastRunAtLT != nil {
-		predicates = append(predicates, cronjobconfig.LastRunAtLT(*i.LastRunAtLT))
+	if len(i.UsedNotIn) > 0 {
+		predicates = append(predicates, apiquotareservation.UsedNotIn(i.UsedNotIn...))
 	}
-	if i.LastRunAtLTE != nil {
-		predicates = append(predicates, cronjobconfig.LastRunAtLTE(*i.LastRunAtLTE))
+	if i.UsedGT != nil {
+		predicates = append(predicates, apiquotareservation.UsedGT(*i.UsedGT))
 	}
-	if i.LastRunAtIsNil {
-		predicates = append(predicates, cronjobconfig.LastRunAtIsNil())
+	if i.UsedGTE != nil {
+		predicates = append(predicates, apiquotareservation.UsedGTE(*i.UsedGTE))
 	}
-	if i.LastRunAtNotNil {
-		predicates = append(predicates, cronjobconfig.LastRunAtNotNil())
+	if i.UsedLT != nil {
+		predicates = append(predicates, apiquotareservation.UsedLT(*i.UsedLT))
 	}
-	if i.NextRunAt != nil {
-		predicates = append(predicates, cronjobconfig.NextRunAtEQ(*i.NextRunAt))
+	if i.UsedLTE != nil {
+		predicates = append(predicates, apiquotareservation.UsedLTE(*i.UsedLTE))
 	}
-	if i.NextRunAtNEQ != nil {
-		predicates = append(predicates, cronjobconfig.NextRunAtNEQ(*i.NextRunAtNEQ))
+	if i.Status != nil {
+		predicates = append(predicates, apiquotareservation.StatusEQ(*i.Status))
 	}
-	if len(i.NextRunAtIn) > 0 {
-		predicates = append(predicates, cronjobconfig.NextRunAtIn(i.NextRunAtIn...))
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, apiquotareservation.StatusNEQ(*i.StatusNEQ))
 	}
-	if len(i.NextRunAtNotIn) > 0 {
-		predicates = append(predicates, cronjobconfig.NextRunAtNotIn(i.NextRunAtNotIn...))
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, apiquotareservation.StatusIn(i.StatusIn...))
 	}
-	if i.NextRunAtGT != nil {
-		predicates = append(predicates, cronjobconfig.NextRunAtGT(*i.NextRunAtGT))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, apiquotareservation.StatusNotIn(i.StatusNotIn...))
 	}
-	if i.NextRunAtGTE != nil {
-		predicates = append(predicates, cronjobconfig.NextRunAtGTE(*i.NextRunAtGTE))
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, apiquotareservation.ExpiresAtEQ(*i.ExpiresAt))
 	}
-	if i.NextRunAtLT != nil {
-		predicates = append(predicates, cronjobconfig.NextRunAtLT(*i.NextRunAtLT))
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, apiquotareservation.ExpiresAtNEQ(*i.ExpiresAtNEQ))
 	}
-	if i.NextRunAtLTE != nil {
-		predicates = append(predicates, cronjobconfig.NextRunAtLTE(*i.NextRunAtLTE))
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, apiquotareservation.ExpiresAtIn(i.ExpiresAtIn...))
 	}
-	if i.NextRunAtIsNil {
-		predicates = append(predicates, cronjobconfig.NextRunAtIsNil())
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, apiquotareservation.ExpiresAtNotIn(i.ExpiresAtNotIn...))
 	}
-	if i.NextRunAtNotNil {
-		predicates = append(predicates, cronjobconfig.NextRunAtNotNil())
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, apiquotareservation.ExpiresAtGT(*i.ExpiresAtGT))
+	}
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, apiquotareservation.ExpiresAtGTE(*i.ExpiresAtGTE))
+	}
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, apiquotareservation.ExpiresAtLT(*i.ExpiresAtLT))
+	}
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, apiquotareservation.ExpiresAtLTE(*i.ExpiresAtLTE))
+	}
+	if i.SettledAt != nil {
+		predicates = append(predicates, apiquotareservation.SettledAtEQ(*i.SettledAt))
+	}
+	if i.SettledAtNEQ != nil {
+		predicates = append(predicates, apiquotareservation.SettledAtNEQ(*i.SettledAtNEQ))
+	}
+	if len(i.SettledAtIn) > 0 {
+		predicates = append(predicates, apiquotareservation.SettledAtIn(i.SettledAtIn...))
+	}
+	if len(i.SettledAtNotIn) > 0 {
+		predicates = append(predicates, apiquotareservation.SettledAtNotIn(i.SettledAtNotIn...))
+	}
+	if i.SettledAtGT != nil {
+		predicates = append(predicates, apiquotareservation.SettledAtGT(*i.SettledAtGT))
+	}
+	if i.SettledAtGTE != nil {
+		predicates = append(predicates, apiquotareservation.SettledAtGTE(*i.SettledAtGTE))
+	}
+	if i.SettledAtLT != nil {
+		predicates = append(predicates, apiquotareservation.SettledAtLT(*i.SettledAtLT))
+	}
+	if i.SettledAtLTE != nil {
+		predicates = append(predicates, apiquotareservation.SettledAtLTE(*i.SettledAtLTE))
+	}
+	if i.SettledAtIsNil {
+		predicates = append(predicates, apiquotareservation.SettledAtIsNil())
+	}
+	if i.SettledAtNotNil {
+		predicates = append(predicates, apiquotareservation.SettledAtNotNil())
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, apiquotareservation.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, apiquotareservation.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, apiquotareservation.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, apiquotareservation.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, apiquotareservation.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, apiquotareservation.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, apiquotareservation.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, apiquotareservation.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 
+	if i.HasTracker != nil {
+		p := apiquotareservation.HasTracker()
+		if !*i.HasTracker {
+			p = apiquotareservation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasTrackerWith) > 0 {
+		with := make([]predicate.APIQuotaTracker, 0, len(i.HasTrackerWith))
+		for _, w := range i.HasTrackerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasTrackerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, apiquotareservation.HasTrackerWith(with...))
+	}
+	if i.HasBudgetTracker != nil {
+		p := apiquotareservation.HasBudgetTracker()
+		if !*i.HasBudgetTracker {
+			p = apiquotareservation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasBudgetTrackerWith) > 0 {
+		with := make([]predicate.APIQuotaTracker, 0, len(i.HasBudgetTrackerWith))
+		for _, w := range i.HasBudgetTrackerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasBudgetTrackerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, apiquotareservation.HasBudgetTrackerWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyCronJobConfigWhereInput
+		return nil, ErrEmptyAPIQuotaReservationWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return cronjobconfig.And(predicates...), nil
+		return apiquotareservation.And(predicates...), nil
 	}
 }
 
-// JobExecutionHistoryWhereInput represents a where input for filtering JobExecutionHistory queries.
-type JobExecutionHistoryWhereInput struct {
-	Predicates []predicate.JobExecutionHistory  `json:"-"`
-	Not        *JobExecutionHistoryWhereInput   `json:"not,omitempty"`
-	Or         []*JobExecutionHistoryWhereInput `json:"or,omitempty"`
-	And        []*JobExecutionHistoryWhereInput `json:"and,omitempty"`
+// APIQuotaTrackerWhereInput represents a where input for filtering APIQuotaTracker queries.
+type APIQuotaTrackerWhereInput struct {
+	Predicates []predicate.APIQuotaTracker  `json:"-"`
+	Not        *APIQuotaTrackerWhereInput   `json:"not,omitempty"`
+	Or         []*APIQuotaTrackerWhereInput `json:"or,omitempty"`
+	And        []*APIQuotaTrackerWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *ulid.ID  `json:"id,omitempty"`
@@ -888,144 +1313,172 @@ type JobExecutionHistoryWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "job_name" field predicates.
-	JobName             *string  `json:"jobName,omitempty"`
-	JobNameNEQ          *string  `json:"jobNameNEQ,omitempty"`
-	JobNameIn           []string `json:"jobNameIn,omitempty"`
-	JobNameNotIn        []string `json:"jobNameNotIn,omitempty"`
-	JobNameGT           *string  `json:"jobNameGT,omitempty"`
-	JobNameGTE          *string  `json:"jobNameGTE,omitempty"`
-	JobNameLT           *string  `json:"jobNameLT,omitempty"`
-	JobNameLTE          *string  `json:"jobNameLTE,omitempty"`
-	JobNameContains     *string  `json:"jobNameContains,omitempty"`
-	JobNameHasPrefix    *string  `json:"jobNameHasPrefix,omitempty"`
-	JobNameHasSuffix    *string  `json:"jobNameHasSuffix,omitempty"`
-	JobNameEqualFold    *string  `json:"jobNameEqualFold,omitempty"`
-	JobNameContainsFold *string  `json:"jobNameContainsFold,omitempty"`
+	// "key_label" field predicates.
+	KeyLabel             *string  `json:"keyLabel,omitempty"`
+	KeyLabelNEQ          *string  `json:"keyLabelNEQ,omitempty"`
+	KeyLabelIn           []string `json:"keyLabelIn,omitempty"`
+	KeyLabelNotIn        []string `json:"keyLabelNotIn,omitempty"`
+	KeyLabelGT           *string  `json:"keyLabelGT,omitempty"`
+	KeyLabelGTE          *string  `json:"keyLabelGTE,omitempty"`
+	KeyLabelLT           *string  `json:"keyLabelLT,omitempty"`
+	KeyLabelLTE          *string  `json:"keyLabelLTE,omitempty"`
+	KeyLabelContains     *string  `json:"keyLabelContains,omitempty"`
+	KeyLabelHasPrefix    *string  `json:"keyLabelHasPrefix,omitempty"`
+	KeyLabelHasSuffix    *string  `json:"keyLabelHasSuffix,omitempty"`
+	KeyLabelEqualFold    *string  `json:"keyLabelEqualFold,omitempty"`
+	KeyLabelContainsFold *string  `json:"keyLabelContainsFold,omitempty"`
+
+	// "budget" field predicates.
+	Budget             *string  `json:"budget,omitempty"`
+	BudgetNEQ          *string  `json:"budgetNEQ,omitempty"`
+	BudgetIn           []string `json:"budgetIn,omitempty"`
+	BudgetNotIn        []string `json:"budgetNotIn,omitempty"`
+	BudgetGT           *string  `json:"budgetGT,omitempty"`
+	BudgetGTE          *string  `json:"budgetGTE,omitempty"`
+	BudgetLT           *string  `json:"budgetLT,omitempty"`
+	BudgetLTE          *string  `json:"budgetLTE,omitempty"`
+	BudgetContains     *string  `json:"budgetContains,omitempty"`
+	BudgetHasPrefix    *string  `json:"budgetHasPrefix,omitempty"`
+	BudgetHasSuffix    *string  `json:"budgetHasSuffix,omitempty"`
+	BudgetEqualFold    *string  `json:"budgetEqualFold,omitempty"`
+	BudgetContainsFold *string  `json:"budgetContainsFold,omitempty"`
 
-	// "status" field predicates.
-	Status      *jobexecutionhistory.Status  `json:"status,omitempty"`
-	StatusNEQ   *jobexecutionhistory.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []jobexecutionhistory.Status `json:"statusIn,omitempty"`
-	StatusNotIn []jobexecutionhistory.Status `json:"statusNotIn,omitempty"`
+	// "month" field predicates.
+	Month      *int  `json:"month,omitempty"`
+	MonthNEQ   *int  `json:"monthNEQ,omitempty"`
+	MonthIn    []int `json:"monthIn,omitempty"`
+	MonthNotIn []int `json:"monthNotIn,omitempty"`
+	MonthGT    *int  `json:"monthGT,omitempty"`
+	MonthGTE   *int  `json:"monthGTE,omitempty"`
+	MonthLT    *int  `json:"monthLT,omitempty"`
+	MonthLTE   *int  `json:"monthLTE,omitempty"`
 
-	// "started_at" field predicates.
-	StartedAt      *time.Time  `json:"startedAt,omitempty"`
-	StartedAtNEQ   *time.Time  `json:"startedAtNEQ,omitempty"`
-	StartedAtIn    []time.Time `json:"startedAtIn,omitempty"`
-	StartedAtNotIn []time.Time `json:"startedAtNotIn,omitempty"`
-	StartedAtGT    *time.Time  `json:"startedAtGT,omitempty"`
-	StartedAtGTE   *time.Time  `json:"startedAtGTE,omitempty"`
-	StartedAtLT    *time.Time  `json:"startedAtLT,omitempty"`
-	StartedAtLTE   *time.Time  `json:"startedAtLTE,omitempty"`
+	// "year" field predicates.
+	Year      *int  `json:"year,omitempty"`
+	YearNEQ   *int  `json:"yearNEQ,omitempty"`
+	YearIn    []int `json:"yearIn,omitempty"`
+	YearNotIn []int `json:"yearNotIn,omitempty"`
+	YearGT    *int  `json:"yearGT,omitempty"`
+	YearGTE   *int  `json:"yearGTE,omitempty"`
+	YearLT    *int  `json:"yearLT,omitempty"`
+	YearLTE   *int  `json:"yearLTE,omitempty"`
 
-	// "completed_at" field predicates.
-	CompletedAt       *time.Time  `json:"completedAt,omitempty"`
-	CompletedAtNEQ    *time.Time  `json:"completedAtNEQ,omitempty"`
-	CompletedAtIn     []time.Time `json:"completedAtIn,omitempty"`
-	CompletedAtNotIn  []time.Time `json:"completedAtNotIn,omitempty"`
-	CompletedAtGT     *time.Time  `json:"completedAtGT,omitempty"`
-	CompletedAtGTE    *time.Time  `json:"completedAtGTE,omitempty"`
-	CompletedAtLT     *time.Time  `json:"completedAtLT,omitempty"`
-	CompletedAtLTE    *time.Time  `json:"completedAtLTE,omitempty"`
-	CompletedAtIsNil  bool        `json:"completedAtIsNil,omitempty"`
-	CompletedAtNotNil bool        `json:"completedAtNotNil,omitempty"`
+	// "call_count" field predicates.
+	CallCount      *int  `json:"callCount,omitempty"`
+	CallCountNEQ   *int  `json:"callCountNEQ,omitempty"`
+	CallCountIn    []int `json:"callCountIn,omitempty"`
+	CallCountNotIn []int `json:"callCountNotIn,omitempty"`
+	CallCountGT    *int  `json:"callCountGT,omitempty"`
+	CallCountGTE   *int  `json:"callCountGTE,omitempty"`
+	CallCountLT    *int  `json:"callCountLT,omitempty"`
+	CallCountLTE   *int  `json:"callCountLTE,omitempty"`
 
-	// "duration_seconds" field predicates.
-	DurationSeconds      *int  `json:"durationSeconds,omitempty"`
-	DurationSecondsNEQ   *int  `json:"durationSecondsNEQ,omitempty"`
-	DurationSecondsIn    []int `json:"durationSecondsIn,omitempty"`
-	DurationSecondsNotIn []int `json:"durationSecondsNotIn,omitempty"`
-	DurationSecondsGT    *int  `json:"durationSecondsGT,omitempty"`
-	DurationSecondsGTE   *int  `json:"durationSecondsGTE,omitempty"`
-	DurationSecondsLT    *int  `json:"durationSecondsLT,omitempty"`
-	DurationSecondsLTE   *int  `json:"durationSecondsLTE,omitempty"`
+	// "reserved_count" field predicates.
+	ReservedCount      *int  `json:"reservedCount,omitempty"`
+	ReservedCountNEQ   *int  `json:"reservedCountNEQ,omitempty"`
+	ReservedCountIn    []int `json:"reservedCountIn,omitempty"`
+	ReservedCountNotIn []int `json:"reservedCountNotIn,omitempty"`
+	ReservedCountGT    *int  `json:"reservedCountGT,omitempty"`
+	ReservedCountGTE   *int  `json:"reservedCountGTE,omitempty"`
+	ReservedCountLT    *int  `json:"reservedCountLT,omitempty"`
+	ReservedCountLTE   *int  `json:"reservedCountLTE,omitempty"`
 
-	// "total_processed" field predicates.
-	TotalProcessed      *int  `json:"totalProcessed,omitempty"`
-	TotalProcessedNEQ   *int  `json:"totalProcessedNEQ,omitempty"`
-	TotalProcessedIn    []int `json:"totalProcessedIn,omitempty"`
-	TotalProcessedNotIn []int `json:"totalProcessedNotIn,omitempty"`
-	TotalProcessedGT    *int  `json:"totalProcessedGT,omitempty"`
-	TotalProcessedGTE   *int  `json:"totalProcessedGTE,omitempty"`
-	TotalProcessedLT    *int  `json:"totalProcessedLT,omitempty"`
-	TotalProcessedLTE   *int  `json:"totalProcessedLTE,omitempty"`
+	// "quota_limit" field predicates.
+	QuotaLimit      *int  `json:"quotaLimit,omitempty"`
+	QuotaLimitNEQ   *int  `json:"quotaLimitNEQ,omitempty"`
+	QuotaLimitIn    []int `json:"quotaLimitIn,omitempty"`
+	QuotaLimitNotIn []int `json:"quotaLimitNotIn,omitempty"`
+	QuotaLimitGT    *int  `json:"quotaLimitGT,omitempty"`
+	QuotaLimitGTE   *int  `json:"quotaLimitGTE,omitempty"`
+	QuotaLimitLT    *int  `json:"quotaLimitLT,omitempty"`
+	QuotaLimitLTE   *int  `json:"quotaLimitLTE,omitempty"`
 
-	// "successful_count" field predicates.
-	SuccessfulCount      *int  `json:"successfulCount,omitempty"`
-	SuccessfulCountNEQ   *int  `json:"successfulCountNEQ,omitempty"`
-	SuccessfulCountIn    []int `json:"successfulCountIn,omitempty"`
-	SuccessfulCountNotIn []int `json:"successfulCountNotIn,omitempty"`
-	SuccessfulCountGT    *int  `json:"successfulCountGT,omitempty"`
-	SuccessfulCountGTE   *int  `json:"successfulCountGTE,omitempty"`
-	SuccessfulCountLT    *int  `json:"successfulCountLT,omitempty"`
-	SuccessfulCountLTE   *int  `json:"successfulCountLTE,omitempty"`
+	// "daily_cap" field predicates.
+	DailyCap       *int  `json:"dailyCap,omitempty"`
+	DailyCapNEQ    *int  `json:"dailyCapNEQ,omitempty"`
+	DailyCapIn     []int `json:"dailyCapIn,omitempty"`
+	DailyCapNotIn  []int `json:"dailyCapNotIn,omitempty"`
+	DailyCapGT     *int  `json:"dailyCapGT,omitempty"`
+	DailyCapGTE    *int  `json:"dailyCapGTE,omitempty"`
+	DailyCapLT     *int  `json:"dailyCapLT,omitempty"`
+	DailyCapLTE    *int  `json:"dailyCapLTE,omitempty"`
+	DailyCapIsNil  bool  `json:"dailyCapIsNil,omitempty"`
+	DailyCapNotNil bool  `json:"dailyCapNotNil,omitempty"`
+
+	// "hourly_cap" field predicates.
+	HourlyCap       *int  `json:"hourlyCap,omitempty"`
+	HourlyCapNEQ    *int  `json:"hourlyCapNEQ,omitempty"`
+	HourlyCapIn     []int `json:"hourlyCapIn,omitempty"`
+	HourlyCapNotIn  []int `json:"hourlyCapNotIn,omitempty"`
+	HourlyCapGT     *int  `json:"hourlyCapGT,omitempty"`
+	HourlyCapGTE    *int  `json:"hourlyCapGTE,omitempty"`
+	HourlyCapLT     *int  `json:"hourlyCapLT,omitempty"`
+	HourlyCapLTE    *int  `json:"hourlyCapLTE,omitempty"`
+	HourlyCapIsNil  bool  `json:"hourlyCapIsNil,omitempty"`
+	HourlyCapNotNil bool  `json:"hourlyCapNotNil,omitempty"`
+
+	// "even_pacing" field predicates.
+	EvenPacing    *bool `json:"evenPacing,omitempty"`
+	EvenPacingNEQ *bool `json:"evenPacingNEQ,omitempty"`
 
-	// "failed_count" field predicates.
-	FailedCount      *int  `json:"failedCount,omitempty"`
-	FailedCountNEQ   *int  `json:"failedCountNEQ,omitempty"`
-	FailedCountIn    []int `json:"failedCountIn,omitempty"`
-	FailedCountNotIn []int `json:"failedCountNotIn,omitempty"`
-	FailedCountGT    *int  `json:"failedCountGT,omitempty"`
-	FailedCountGTE   *int  `json:"failedCountGTE,omitempty"`
-	FailedCountLT    *int  `json:"failedCountLT,omitempty"`
-	FailedCountLTE   *int  `json:"failedCountLTE,omitempty"`
+	// "quota_exceeded" field predicates.
+	QuotaExceeded    *bool `json:"quotaExceeded,omitempty"`
+	QuotaExceededNEQ *bool `json:"quotaExceededNEQ,omitempty"`
 
-	// "api_calls_made" field predicates.
-	APICallsMade      *int  `json:"apiCallsMade,omitempty"`
-	APICallsMadeNEQ   *int  `json:"apiCallsMadeNEQ,omitempty"`
-	APICallsMadeIn    []int `json:"apiCallsMadeIn,omitempty"`
-	APICallsMadeNotIn []int `json:"apiCallsMadeNotIn,omitempty"`
-	APICallsMadeGT    *int  `json:"apiCallsMadeGT,omitempty"`
-	APICallsMadeGTE   *int  `json:"apiCallsMadeGTE,omitempty"`
-	APICallsMadeLT    *int  `json:"apiCallsMadeLT,omitempty"`
-	APICallsMadeLTE   *int  `json:"apiCallsMadeLTE,omitempty"`
+	// "override_enabled" field predicates.
+	OverrideEnabled    *bool `json:"overrideEnabled,omitempty"`
+	OverrideEnabledNEQ *bool `json:"overrideEnabledNEQ,omitempty"`
 
-	// "quota_remaining" field predicates.
-	QuotaRemaining      *int  `json:"quotaRemaining,omitempty"`
-	QuotaRemainingNEQ   *int  `json:"quotaRemainingNEQ,omitempty"`
-	QuotaRemainingIn    []int `json:"quotaRemainingIn,omitempty"`
-	QuotaRemainingNotIn []int `json:"quotaRemainingNotIn,omitempty"`
-	QuotaRemainingGT    *int  `json:"quotaRemainingGT,omitempty"`
-	QuotaRemainingGTE   *int  `json:"quotaRemainingGTE,omitempty"`
-	QuotaRemainingLT    *int  `json:"quotaRemainingLT,omitempty"`
-	QuotaRemainingLTE   *int  `json:"quotaRemainingLTE,omitempty"`
+	// "notification_sent" field predicates.
+	NotificationSent    *bool `json:"notificationSent,omitempty"`
+	NotificationSentNEQ *bool `json:"notificationSentNEQ,omitempty"`
 
-	// "error_summary" field predicates.
-	ErrorSummary             *string  `json:"errorSummary,omitempty"`
-	ErrorSummaryNEQ          *string  `json:"errorSummaryNEQ,omitempty"`
-	ErrorSummaryIn           []string `json:"errorSummaryIn,omitempty"`
-	ErrorSummaryNotIn        []string `json:"errorSummaryNotIn,omitempty"`
-	ErrorSummaryGT           *string  `json:"errorSummaryGT,omitempty"`
-	ErrorSummaryGTE          *string  `json:"errorSummaryGTE,omitempty"`
-	ErrorSummaryLT           *string  `json:"errorSummaryLT,omitempty"`
-	ErrorSummaryLTE          *string  `json:"errorSummaryLTE,omitempty"`
-	ErrorSummaryContains     *string  `json:"errorSummaryContains,omitempty"`
-	ErrorSummaryHasPrefix    *string  `json:"errorSummaryHasPrefix,omitempty"`
-	ErrorSummaryHasSuffix    *string  `json:"errorSummaryHasSuffix,omitempty"`
-	ErrorSummaryIsNil        bool     `json:"errorSummaryIsNil,omitempty"`
-	ErrorSummaryNotNil       bool     `json:"errorSummaryNotNil,omitempty"`
-	ErrorSummaryEqualFold    *string  `json:"errorSummaryEqualFold,omitempty"`
-	ErrorSummaryContainsFold *string  `json:"errorSummaryContainsFold,omitempty"`
+	// "last_call_at" field predicates.
+	LastCallAt       *time.Time  `json:"lastCallAt,omitempty"`
+	LastCallAtNEQ    *time.Time  `json:"lastCallAtNEQ,omitempty"`
+	LastCallAtIn     []time.Time `json:"lastCallAtIn,omitempty"`
+	LastCallAtNotIn  []time.Time `json:"lastCallAtNotIn,omitempty"`
+	LastCallAtGT     *time.Time  `json:"lastCallAtGT,omitempty"`
+	LastCallAtGTE    *time.Time  `json:"lastCallAtGTE,omitempty"`
+	LastCallAtLT     *time.Time  `json:"lastCallAtLT,omitempty"`
+	LastCallAtLTE    *time.Time  `json:"lastCallAtLTE,omitempty"`
+	LastCallAtIsNil  bool        `json:"lastCallAtIsNil,omitempty"`
+	LastCallAtNotNil bool        `json:"lastCallAtNotNil,omitempty"`
 
-	// "profile_entries" edge predicates.
-	HasProfileEntries     *bool                     `json:"hasProfileEntries,omitempty"`
-	HasProfileEntriesWith []*ProfileEntryWhereInput `json:"hasProfileEntriesWith,omitempty"`
+	// "rate_limited_until" field predicates.
+	RateLimitedUntil       *time.Time  `json:"rateLimitedUntil,omitempty"`
+	RateLimitedUntilNEQ    *time.Time  `json:"rateLimitedUntilNEQ,omitempty"`
+	RateLimitedUntilIn     []time.Time `json:"rateLimitedUntilIn,omitempty"`
+	RateLimitedUntilNotIn  []time.Time `json:"rateLimitedUntilNotIn,omitempty"`
+	RateLimitedUntilGT     *time.Time  `json:"rateLimitedUntilGT,omitempty"`
+	RateLimitedUntilGTE    *time.Time  `json:"rateLimitedUntilGTE,omitempty"`
+	RateLimitedUntilLT     *time.Time  `json:"rateLimitedUntilLT,omitempty"`
+	RateLimitedUntilLTE    *time.Time  `json:"rateLimitedUntilLTE,omitempty"`
+	RateLimitedUntilIsNil  bool        `json:"rateLimitedUntilIsNil,omitempty"`
+	RateLimitedUntilNotNil bool        `json:"rateLimitedUntilNotNil,omitempty"`
+
+	// "reservations" edge predicates.
+	HasReservations     *bool                            `json:"hasReservations,omitempty"`
+	HasReservationsWith []*APIQuotaReservationWhereInput `json:"hasReservationsWith,omitempty"`
+
+	// "budget_reservations" edge predicates.
+	HasBudgetReservations     *bool                            `json:"hasBudgetReservations,omitempty"`
+	HasBudgetReservationsWith []*APIQuotaReservationWhereInput `json:"hasBudgetReservationsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *JobExecutionHistoryWhereInput) AddPredicates(predicates ...predicate.JobExecutionHistory) {
+func (i *APIQuotaTrackerWhereInput) AddPredicates(predicates ...predicate.APIQuotaTracker) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the JobExecutionHistoryWhereInput filter on the JobExecutionHistoryQuery builder.
-func (i *JobExecutionHistoryWhereInput) Filter(q *JobExecutionHistoryQuery) (*JobExecutionHistoryQuery, error) {
+// Filter applies the APIQuotaTrackerWhereInput filter on the APIQuotaTrackerQuery builder.
+func (i *APIQuotaTrackerWhereInput) Filter(q *APIQuotaTrackerQuery) (*APIQuotaTrackerQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyJobExecutionHistoryWhereInput {
+		if err == ErrEmptyAPIQuotaTrackerWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1033,19 +1486,19 @@ func (i *JobExecutionHistoryWhereInput) Filter(q *JobExecutionHistoryQuery) (*Jo
 	return q.Where(p), nil
 }
 
-// ErrEmptyJobExecutionHistoryWhereInput is returned in case the JobExecutionHistoryWhereInput is empty.
-var ErrEmptyJobExecutionHistoryWhereInput = errors.New("ent: empty predicate JobExecutionHistoryWhereInput")
+// ErrEmptyAPIQuotaTrackerWhereInput is returned in case the APIQuotaTrackerWhereInput is empty.
+var ErrEmptyAPIQuotaTrackerWhereInput = errors.New("ent: empty predicate APIQuotaTrackerWhereInput")
 
-// P returns a predicate for filtering jobexecutionhistories.
+// P returns a predicate for filtering apiquotatrackers.
 // An error is returned if the input is empty or invalid.
-func (i *JobExecutionHistoryWhereInput) P() (predicate.JobExecutionHistory, error) {
-	var predicates []predicate.JobExecutionHistory
+func (i *APIQuotaTrackerWhereInput) P() (predicate.APIQuotaTracker, error) {
+	var predicates []predicate.APIQuotaTracker
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, jobexecutionhistory.Not(p))
+		predicates = append(predicates, apiquotatracker.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1055,7 +1508,7 @@ func (i *JobExecutionHistoryWhereInput) P() (predicate.JobExecutionHistory, erro
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.JobExecutionHistory, 0, n)
+		or := make([]predicate.APIQuotaTracker, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1063,7 +1516,7 @@ func (i *JobExecutionHistoryWhereInput) P() (predicate.JobExecutionHistory, erro
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, jobexecutionhistory.Or(or...))
+		predicates = append(predicates, apiquotatracker.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1073,7 +1526,7 @@ func (i *JobExecutionHistoryWhereInput) P() (predicate.JobExecutionHistory, erro
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.JobExecutionHistory, 0, n)
+		and := make([]predicate.APIQuotaTracker, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1081,386 +1534,452 @@ func (i *JobExecutionHistoryWhereInput) P() (predicate.JobExecutionHistory, erro
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, jobexecutionhistory.And(and...))
+		predicates = append(predicates, apiquotatracker.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, jobexecutionhistory.IDEQ(*i.ID))
+		predicates = append(predicates, apiquotatracker.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, apiquotatracker.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.IDIn(i.IDIn...))
+		predicates = append(predicates, apiquotatracker.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, apiquotatracker.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, jobexecutionhistory.IDGT(*i.IDGT))
+		predicates = append(predicates, apiquotatracker.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.IDGTE(*i.IDGTE))
+		predicates = append(predicates, apiquotatracker.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, jobexecutionhistory.IDLT(*i.IDLT))
+		predicates = append(predicates, apiquotatracker.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.IDLTE(*i.IDLTE))
+		predicates = append(predicates, apiquotatracker.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, jobexecutionhistory.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, apiquotatracker.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, apiquotatracker.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, apiquotatracker.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, apiquotatracker.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, jobexecutionhistory.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, apiquotatracker.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, apiquotatracker.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, jobexecutionhistory.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, apiquotatracker.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, apiquotatracker.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.JobName != nil {
-		predicates = append(predicates, jobexecutionhistory.JobNameEQ(*i.JobName))
+	if i.KeyLabel != nil {
+		predicates = append(predicates, apiquotatracker.KeyLabelEQ(*i.KeyLabel))
 	}
-	if i.JobNameNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.JobNameNEQ(*i.JobNameNEQ))
+	if i.KeyLabelNEQ != nil {
+		predicates = append(predicates, apiquotatracker.KeyLabelNEQ(*i.KeyLabelNEQ))
 	}
-	if len(i.JobNameIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.JobNameIn(i.JobNameIn...))
+	if len(i.KeyLabelIn) > 0 {
+		predicates = append(predicates, apiquotatracker.KeyLabelIn(i.KeyLabelIn...))
 	}
-	if len(i.JobNameNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.JobNameNotIn(i.JobNameNotIn...))
+	if len(i.KeyLabelNotIn) > 0 {
+		predicates = append(predicates, apiquotatracker.KeyLabelNotIn(i.KeyLabelNotIn...))
 	}
-	if i.JobNameGT != nil {
-		predicates = append(predicates, jobexecutionhistory.JobNameGT(*i.JobNameGT))
+	if i.KeyLabelGT != nil {
+		predicates = append(predicates, apiquotatracker.KeyLabelGT(*i.KeyLabelGT))
 	}
-	if i.JobNameGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.JobNameGTE(*i.JobNameGTE))
+	if i.KeyLabelGTE != nil {
+		predicates = append(predicates, apiquotatracker.KeyLabelGTE(*i.KeyLabelGTE))
 	}
-	if i.JobNameLT != nil {
-		predicates = append(predicates, jobexecutionhistory.JobNameLT(*i.JobNameLT))
+	if i.KeyLabelLT != nil {
+		predicates = append(predicates, apiquotatracker.KeyLabelLT(*i.KeyLabelLT))
 	}
-	if i.JobNameLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.JobNameLTE(*i.JobNameLTE))
+	if i.KeyLabelLTE != nil {
+		predicates = append(predicates, apiquotatracker.KeyLabelLTE(*i.KeyLabelLTE))
 	}
-	if i.JobNameContains != nil {
-		predicates = append(predicates, jobexecutionhistory.JobNameContains(*i.JobNameContains))
+	if i.KeyLabelContains != nil {
+		predicates = append(predicates, apiquotatracker.KeyLabelContains(*i.KeyLabelContains))
 	}
-	if i.JobNameHasPrefix != nil {
-		predicates = append(predicates, jobexecutionhistory.JobNameHasPrefix(*i.JobNameHasPrefix))
+	if i.KeyLabelHasPrefix != nil {
+		predicates = append(predicates, apiquotatracker.KeyLabelHasPrefix(*i.KeyLabelHasPrefix))
 	}
-	if i.JobNameHasSuffix != nil {
-		predicates = append(predicates, jobexecutionhistory.JobNameHasSuffix(*i.JobNameHasSuffix))
+	if i.KeyLabelHasSuffix != nil {
+		predicates = append(predicates, apiquotatracker.KeyLabelHasSuffix(*i.KeyLabelHasSuffix))
 	}
-	if i.JobNameEqualFold != nil {
-		predicates = append(predicates, jobexecutionhistory.JobNameEqualFold(*i.JobNameEqualFold))
+	if i.KeyLabelEqualFold != nil {
+		predicates = append(predicates, apiquotatracker.KeyLabelEqualFold(*i.KeyLabelEqualFold))
 	}
-	if i.JobNameContainsFold != nil {
-		predicates = append(predicates, jobexecutionhistory.JobNameContainsFold(*i.JobNameContainsFold))
+	if i.KeyLabelContainsFold != nil {
+		predicates = append(predicates, apiquotatracker.KeyLabelContainsFold(*i.KeyLabelContainsFold))
 	}
-	if i.Status != nil {
-		predicates = append(predicates, jobexecutionhistory.StatusEQ(*i.Status))
+	if i.Budget != nil {
+		predicates = append(predicates, apiquotatracker.BudgetEQ(*i.Budget))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.StatusNEQ(*i.StatusNEQ))
+	if i.BudgetNEQ != nil {
+		predicates = append(predicates, apiquotatracker.BudgetNEQ(*i.BudgetNEQ))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.StatusIn(i.StatusIn...))
+	if len(i.BudgetIn) > 0 {
+		predicates = append(predicates, apiquotatracker.BudgetIn(i.BudgetIn...))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.StatusNotIn(i.StatusNotIn...))
+	if len(i.BudgetNotIn) > 0 {
+		predicates = append(predicates, apiquotatracker.BudgetNotIn(i.BudgetNotIn...))
 	}
-	if i.StartedAt != nil {
-		predicates = append(predicates, jobexecutionhistory.StartedAtEQ(*i.StartedAt))
+	if i.BudgetGT != nil {
+		predicates = append(predicates, apiquotatracker.BudgetGT(*i.BudgetGT))
 	}
-	if i.StartedAtNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.StartedAtNEQ(*i.StartedAtNEQ))
+	if i.BudgetGTE != nil {
+		predicates = append(predicates, apiquotatracker.BudgetGTE(*i.BudgetGTE))
 	}
-	if len(i.StartedAtIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.StartedAtIn(i.StartedAtIn...))
+	if i.BudgetLT != nil {
+		predicates = append(predicates, apiquotatracker.BudgetLT(*i.BudgetLT))
 	}
-	if len(i.StartedAtNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.StartedAtNotIn(i.StartedAtNotIn...))
+	if i.BudgetLTE != nil {
+		predicates = append(predicates, apiquotatracker.BudgetLTE(*i.BudgetLTE))
 	}
-	if i.StartedAtGT != nil {
-		predicates = append(predicates, jobexecutionhistory.StartedAtGT(*i.StartedAtGT))
+	if i.BudgetContains != nil {
+		predicates = append(predicates, apiquotatracker.BudgetContains(*i.BudgetContains))
 	}
-	if i.StartedAtGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.StartedAtGTE(*i.StartedAtGTE))
+	if i.BudgetHasPrefix != nil {
+		predicates = append(predicates, apiquotatracker.BudgetHasPrefix(*i.BudgetHasPrefix))
 	}
-	if i.StartedAtLT != nil {
-		predicates = append(predicates, jobexecutionhistory.StartedAtLT(*i.StartedAtLT))
+	if i.BudgetHasSuffix != nil {
+		predicates = append(predicates, apiquotatracker.BudgetHasSuffix(*i.BudgetHasSuffix))
 	}
-	if i.StartedAtLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.StartedAtLTE(*i.StartedAtLTE))
+	if i.BudgetEqualFold != nil {
+		predicates = append(predicates, apiquotatracker.BudgetEqualFold(*i.BudgetEqualFold))
 	}
-	if i.CompletedAt != nil {
-		predicates = append(predicates, jobexecutionhistory.CompletedAtEQ(*i.CompletedAt))
+	if i.BudgetContainsFold != nil {
+		predicates = append(predicates, apiquotatracker.BudgetContainsFold(*i.BudgetContainsFold))
 	}
-	if i.CompletedAtNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.CompletedAtNEQ(*i.CompletedAtNEQ))
+	if i.Month != nil {
+		predicates = append(predicates, apiquotatracker.MonthEQ(*i.Month))
 	}
-	if len(i.CompletedAtIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.CompletedAtIn(i.CompletedAtIn...))
+	if i.MonthNEQ != nil {
+		predicates = append(predicates, apiquotatracker.MonthNEQ(*i.MonthNEQ))
 	}
-	if len(i.CompletedAtNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.CompletedAtNotIn(i.CompletedAtNotIn...))
+	if len(i.MonthIn) > 0 {
+		predicates = append(predicates, apiquotatracker.MonthIn(i.MonthIn...))
 	}
-	if i.CompletedAtGT != nil {
-		predicates = append(predicates, jobexecutionhistory.CompletedAtGT(*i.CompletedAtGT))
+	if len(i.MonthNotIn) > 0 {
+		predicates = append(predicates, apiquotatracker.MonthNotIn(i.MonthNotIn...))
 	}
-	if i.CompletedAtGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.CompletedAtGTE(*i.CompletedAtGTE))
+	if i.MonthGT != nil {
+		predicates = append(predicates, apiquotatracker.MonthGT(*i.MonthGT))
 	}
-	if i.CompletedAtLT != nil {
-		predicates = append(predicates, jobexecutionhistory.CompletedAtLT(*i.CompletedAtLT))
+	if i.MonthGTE != nil {
+		predicates = append(predicates, apiquotatracker.MonthGTE(*i.MonthGTE))
 	}
-	if i.CompletedAtLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.CompletedAtLTE(*i.CompletedAtLTE))
+	if i.MonthLT != nil {
+		predicates = append(predicates, apiquotatracker.MonthLT(*i.MonthLT))
 	}
-	if i.CompletedAtIsNil {
-		predicates = append(predicates, jobexecutionhistory.CompletedAtIsNil())
+	if i.MonthLTE != nil {
+		predicates = append(predicates, apiquotatracker.MonthLTE(*i.MonthLTE))
 	}
-	if i.CompletedAtNotNil {
-		predicates = append(predicates, jobexecutionhistory.CompletedAtNotNil())
+	if i.Year != nil {
+		predicates = append(predicates, apiquotatracker.YearEQ(*i.Year))
 	}
-	if i.DurationSeconds != nil {
-		predicates = append(predicates, jobexecutionhistory.DurationSecondsEQ(*i.DurationSeconds))
+	if i.YearNEQ != nil {
+		predicates = append(predicates, apiquotatracker.YearNEQ(*i.YearNEQ))
 	}
-	if i.DurationSecondsNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.DurationSecondsNEQ(*i.DurationSecondsNEQ))
+	if len(i.YearIn) > 0 {
+		predicates = append(predicates, apiquotatracker.YearIn(i.YearIn...))
 	}
-	if len(i.DurationSecondsIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.DurationSecondsIn(i.DurationSecondsIn...))
+	if len(i.YearNotIn) > 0 {
+		predicates = append(predicates, apiquotatracker.YearNotIn(i.YearNotIn...))
 	}
-	if len(i.DurationSecondsNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.DurationSecondsNotIn(i.DurationSecondsNotIn...))
+	if i.YearGT != nil {
+		predicates = append(predicates, apiquotatracker.YearGT(*i.YearGT))
 	}
-	if i.DurationSecondsGT != nil {
-		predicates = append(predicates, jobexecutionhistory.DurationSecondsGT(*i.DurationSecondsGT))
+	if i.YearGTE != nil {
+		predicates = append(predicates, apiquotatracker.YearGTE(*i.YearGTE))
 	}
-	if i.DurationSecondsGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.DurationSecondsGTE(*i.DurationSecondsGTE))
+	if i.YearLT != nil {
+		predicates = append(predicates, apiquotatracker.YearLT(*i.YearLT))
 	}
-	if i.DurationSecondsLT != nil {
-		predicates = append(predicates, jobexecutionhistory.DurationSecondsLT(*i.DurationSecondsLT))
+	if i.YearLTE != nil {
+		predicates = append(predicates, apiquotatracker.YearLTE(*i.YearLTE))
 	}
-	if i.DurationSecondsLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.DurationSecondsLTE(*i.DurationSecondsLTE))
+	if i.CallCount != nil {
+		predicates = append(predicates, apiquotatracker.CallCountEQ(*i.CallCount))
 	}
-	if i.TotalProcessed != nil {
-		predicates = append(predicates, jobexecutionhistory.TotalProcessedEQ(*i.TotalProcessed))
+	if i.CallCountNEQ != nil {
+		predicates = append(predicates, apiquotatracker.CallCountNEQ(*i.CallCountNEQ))
 	}
-	if i.TotalProcessedNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.TotalProcessedNEQ(*i.TotalProcessedNEQ))
+	if len(i.CallCountIn) > 0 {
+		predicates = append(predicates, apiquotatracker.CallCountIn(i.CallCountIn...))
 	}
-	if len(i.TotalProcessedIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.TotalProcessedIn(i.TotalProcessedIn...))
+	if len(i.CallCountNotIn) > 0 {
+		predicates = append(predicates, apiquotatracker.CallCountNotIn(i.CallCountNotIn...))
 	}
-	if len(i.TotalProcessedNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.TotalProcessedNotIn(i.TotalProcessedNotIn...))
+	if i.CallCountGT != nil {
+		predicates = append(predicates, apiquotatracker.CallCountGT(*i.CallCountGT))
 	}
-	if i.TotalProcessedGT != nil {
-		predicates = append(predicates, jobexecutionhistory.TotalProcessedGT(*i.TotalProcessedGT))
+	if i.CallCountGTE != nil {
+		predicates = append(predicates, apiquotatracker.CallCountGTE(*i.CallCountGTE))
 	}
-	if i.TotalProcessedGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.TotalProcessedGTE(*i.TotalProcessedGTE))
+	if i.CallCountLT != nil {
+		predicates = append(predicates, apiquotatracker.CallCountLT(*i.CallCountLT))
 	}
-	if i.TotalProcessedLT != nil {
-		predicates = append(predicates, jobexecutionhistory.TotalProcessedLT(*i.TotalProcessedLT))
+	if i.CallCountLTE != nil {
+		predicates = append(predicates, apiquotatracker.CallCountLTE(*i.CallCountLTE))
 	}
-	if i.TotalProcessedLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.TotalProcessedLTE(*i.TotalProcessedLTE))
+	if i.ReservedCount != nil {
+		predicates = append(predicates, apiquotatracker.ReservedCountEQ(*i.ReservedCount))
 	}
-	if i.SuccessfulCount != nil {
-		predicates = append(predicates, jobexecutionhistory.SuccessfulCountEQ(*i.SuccessfulCount))
+	if i.ReservedCountNEQ != nil {
+		predicates = append(predicates, apiquotatracker.ReservedCountNEQ(*i.ReservedCountNEQ))
 	}
-	if i.SuccessfulCountNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.SuccessfulCountNEQ(*i.SuccessfulCountNEQ))
+	if len(i.ReservedCountIn) > 0 {
+		predicates = append(predicates, apiquotatracker.ReservedCountIn(i.ReservedCountIn...))
 	}
-	if len(i.SuccessfulCountIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.SuccessfulCountIn(i.SuccessfulCountIn...))
+	if len(i.ReservedCountNotIn) > 0 {
+		predicates = append(predicates, apiquotatracker.ReservedCountNotIn(i.ReservedCountNotIn...))
 	}
-	if len(i.SuccessfulCountNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.SuccessfulCountNotIn(i.SuccessfulCountNotIn...))
+	if i.ReservedCountGT != nil {
+		predicates = append(predicates, apiquotatracker.ReservedCountGT(*i.ReservedCountGT))
 	}
-	if i.SuccessfulCountGT != nil {
-		predicates = append(predicates, jobexecutionhistory.SuccessfulCountGT(*i.SuccessfulCountGT))
+	if i.ReservedCountGTE != nil {
+		predicates = append(predicates, apiquotatracker.ReservedCountGTE(*i.ReservedCountGTE))
 	}
-	if i.SuccessfulCountGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.SuccessfulCountGTE(*i.SuccessfulCountGTE))
+	if i.ReservedCountLT != nil {
+		predicates = append(predicates, apiquotatracker.ReservedCountLT(*i.ReservedCountLT))
 	}
-	if i.SuccessfulCountLT != nil {
-		predicates = append(predicates, jobexecutionhistory.SuccessfulCountLT(*i.SuccessfulCountLT))
+	if i.ReservedCountLTE != nil {
+		predicates = append(predicates, apiquotatracker.ReservedCountLTE(*i.ReservedCountLTE))
 	}
-	if i.SuccessfulCountLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.SuccessfulCountLTE(*i.SuccessfulCountLTE))
+	if i.QuotaLimit != nil {
+		predicates = append(predicates, apiquotatracker.QuotaLimitEQ(*i.QuotaLimit))
 	}
-	if i.FailedCount != nil {
-		predicates = append(predicates, jobexecutionhistory.FailedCountEQ(*i.FailedCount))
+	if i.QuotaLimitNEQ != nil {
+		predicates = append(predicates, apiquotatracker.QuotaLimitNEQ(*i.QuotaLimitNEQ))
 	}
-	if i.FailedCountNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.FailedCountNEQ(*i.FailedCountNEQ))
+	if len(i.QuotaLimitIn) > 0 {
+		predicates = append(predicates, apiquotatracker.QuotaLimitIn(i.QuotaLimitIn...))
 	}
-	if len(i.FailedCountIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.FailedCountIn(i.FailedCountIn...))
+	if len(i.QuotaLimitNotIn) > 0 {
+		predicates = append(predicates, apiquotatracker.QuotaLimitNotIn(i.QuotaLimitNotIn...))
 	}
-	if len(i.FailedCountNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.FailedCountNotIn(i.FailedCountNotIn...))
+	if i.QuotaLimitGT != nil {
+		predicates = append(predicates, apiquotatracker.QuotaLimitGT(*i.QuotaLimitGT))
 	}
-	if i.FailedCountGT != nil {
-		predicates = append(predicates, jobexecutionhistory.FailedCountGT(*i.FailedCountGT))
+	if i.QuotaLimitGTE != nil {
+		predicates = append(predicates, apiquotatracker.QuotaLimitGTE(*i.QuotaLimitGTE))
 	}
-	if i.FailedCountGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.FailedCountGTE(*i.FailedCountGTE))
+	if i.QuotaLimitLT != nil {
+		predicates = append(predicates, apiquotatracker.QuotaLimitLT(*i.QuotaLimitLT))
 	}
-	if i.FailedCountLT != nil {
-		predicates = append(predicates, jobexecutionhistory.FailedCountLT(*i.FailedCountLT))
+	if i.QuotaLimitLTE != nil {
+		predicates = append(predicates, apiquotatracker.QuotaLimitLTE(*i.QuotaLimitLTE))
 	}
-	if i.FailedCountLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.FailedCountLTE(*i.FailedCountLTE))
+	if i.DailyCap != nil {
+		predicates = append(predicates, apiquotatracker.DailyCapEQ(*i.DailyCap))
 	}
-	if i.APICallsMade != nil {
-		predicates = append(predicates, jobexecutionhistory.APICallsMadeEQ(*i.APICallsMade))
+	if i.DailyCapNEQ != nil {
+		predicates = append(predicates, apiquotatracker.DailyCapNEQ(*i.DailyCapNEQ))
 	}
-	if i.APICallsMadeNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.APICallsMadeNEQ(*i.APICallsMadeNEQ))
+	if len(i.DailyCapIn) > 0 {
+		predicates = append(predicates, apiquotatracker.DailyCapIn(i.DailyCapIn...))
 	}
-	if len(i.APICallsMadeIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.APICallsMadeIn(i.APICallsMadeIn...))
+	if len(i.DailyCapNotIn) > 0 {
+		predicates = append(predicates, apiquotatracker.DailyCapNotIn(i.DailyCapNotIn...))
 	}
-	if len(i.APICallsMadeNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.APICallsMadeNotIn(i.APICallsMadeNotIn...))
+	if i.DailyCapGT != nil {
+		predicates = append(predicates, apiquotatracker.DailyCapGT(*i.DailyCapGT))
 	}
-	if i.APICallsMadeGT != nil {
-		predicates = append(predicates, jobexecutionhistory.APICallsMadeGT(*i.APICallsMadeGT))
+	if i.DailyCapGTE != nil {
+		predicates = append(predicates, apiquotatracker.DailyCapGTE(*i.DailyCapGTE))
 	}
-	if i.APICallsMadeGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.APICallsMadeGTE(*i.APICallsMadeGTE))
+	if i.DailyCapLT != nil {
+		predicates = append(predicates, apiquotatracker.DailyCapLT(*i.DailyCapLT))
 	}
-	if i.APICallsMadeLT != nil {
-		predicates = append(predicates, jobexecutionhistory.APICallsMadeLT(*i.APICallsMadeLT))
+	if i.DailyCapLTE != nil {
+		predicates = append(predicates, apiquotatracker.DailyCapLTE(*i.DailyCapLTE))
 	}
-	if i.APICallsMadeLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.APICallsMadeLTE(*i.APICallsMadeLTE))
+	if i.DailyCapIsNil {
+		predicates = append(predicates, apiquotatracker.DailyCapIsNil())
 	}
-	if i.QuotaRemaining != nil {
-		predicates = append(predicates, jobexecutionhistory.QuotaRemainingEQ(*i.QuotaRemaining))
+	if i.DailyCapNotNil {
+		predicates = append(predicates, apiquotatracker.DailyCapNotNil())
 	}
-	if i.QuotaRemainingNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.QuotaRemainingNEQ(*i.QuotaRemainingNEQ))
+	if i.HourlyCap != nil {
+		predicates = append(predicates, apiquotatracker.HourlyCapEQ(*i.HourlyCap))
 	}
-	if len(i.QuotaRemainingIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.QuotaRemainingIn(i.QuotaRemainingIn...))
+	if i.HourlyCapNEQ != nil {
+		predicates = append(predicates, apiquotatracker.HourlyCapNEQ(*i.HourlyCapNEQ))
 	}
-	if len(i.QuotaRemainingNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.QuotaRemainingNotIn(i.QuotaRemainingNotIn...))
+	if len(i.HourlyCapIn) > 0 {
+		predicates = append(predicates, apiquotatracker.HourlyCapIn(i.HourlyCapIn...))
 	}
-	if i.QuotaRemainingGT != nil {
-		predicates = append(predicates, jobexecutionhistory.QuotaRemainingGT(*i.QuotaRemainingGT))
+	if len(i.HourlyCapNotIn) > 0 {
+		predicates = append(predicates, apiquotatracker.HourlyCapNotIn(i.HourlyCapNotIn...))
 	}
-	if i.QuotaRemainingGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.QuotaRemainingGTE(*i.QuotaRemainingGTE))
+	if i.HourlyCapGT != nil {
+		predicates = append(predicates, apiquotatracker.HourlyCapGT(*i.HourlyCapGT))
 	}
-	if i.QuotaRemainingLT != nil {
-		predicates = append(predicates, jobexecutionhistory.QuotaRemainingLT(*i.QuotaRemainingLT))
+	if i.HourlyCapGTE != nil {
+		predicates = append(predicates, apiquotatracker.HourlyCapGTE(*i.HourlyCapGTE))
 	}
-	if i.QuotaRemainingLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.QuotaRemainingLTE(*i.QuotaRemainingLTE))
+	if i.HourlyCapLT != nil {
+		predicates = append(predicates, apiquotatracker.HourlyCapLT(*i.HourlyCapLT))
 	}
-	if i.ErrorSummary != nil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryEQ(*i.ErrorSummary))
+	if i.HourlyCapLTE != nil {
+		predicates = append(predicates, apiquotatracker.HourlyCapLTE(*i.HourlyCapLTE))
 	}
-	if i.ErrorSummaryNEQ != nil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryNEQ(*i.ErrorSummaryNEQ))
+	if i.HourlyCapIsNil {
+		predicates = append(predicates, apiquotatracker.HourlyCapIsNil())
 	}
-	if len(i.ErrorSummaryIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryIn(i.ErrorSummaryIn...))
+	if i.HourlyCapNotNil {
+		predicates = append(predicates, apiquotatracker.HourlyCapNotNil())
 	}
-	if len(i.ErrorSummaryNotIn) > 0 {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryNotIn(i.ErrorSummaryNotIn...))
+	if i.EvenPacing != nil {
+		predicates = append(predicates, apiquotatracker.EvenPacingEQ(*i.EvenPacing))
 	}
-	if i.ErrorSummaryGT != nil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryGT(*i.ErrorSummaryGT))
+	if i.EvenPacingNEQ != nil {
+		predicates = append(predicates, apiquotatracker.EvenPacingNEQ(*i.EvenPacingNEQ))
 	}
-	if i.ErrorSummaryGTE != nil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryGTE(*i.ErrorSummaryGTE))
+	if i.QuotaExceeded != nil {
+		predicates = append(predicates, apiquotatracker.QuotaExceededEQ(*i.QuotaExceeded))
 	}
-	if i.ErrorSummaryLT != nil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryLT(*i.ErrorSummaryLT))
+	if i.QuotaExceededNEQ != nil {
+		predicates = append(predicates, apiquotatracker.QuotaExceededNEQ(*i.QuotaExceededNEQ))
 	}
-	if i.ErrorSummaryLTE != nil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryLTE(*i.ErrorSummaryLTE))
+	if i.OverrideEnabled != nil {
+		predicates = append(predicates, apiquotatracker.OverrideEnabledEQ(*i.OverrideEnabled))
 	}
-	if i.ErrorSummaryContains != nil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryContains(*i.ErrorSummaryContains))
+	if i.OverrideEnabledNEQ != nil {
+		predicates = append(predicates, apiquotatracker.OverrideEnabledNEQ(*i.OverrideEnabledNEQ))
 	}
-	if i.ErrorSummaryHasPrefix != nil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryHasPrefix(*i.ErrorSummaryHasPrefix))
+	if i.NotificationSent != nil {
+		predicates = append(predicates, apiquotatracker.NotificationSentEQ(*i.NotificationSent))
 	}
-	if i.ErrorSummaryHasSuffix != nil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryHasSuffix(*i.ErrorSummaryHasSuffix))
+	if i.NotificationSentNEQ != nil {
+		predicates = append(predicates, apiquotatracker.NotificationSentNEQ(*i.NotificationSentNEQ))
 	}
-	if i.ErrorSummaryIsNil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryIsNil())
+	if i.LastCallAt != nil {
+		predicates = append(predicates, apiquotatracker.LastCallAtEQ(*i.LastCallAt))
 	}
-	if i.ErrorSummaryNotNil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryNotNil())
+	if i.LastCallAtNEQ != nil {
+		predicates = append(predicates, apiquotatracker.LastCallAtNEQ(*i.LastCallAtNEQ))
 	}
-	if i.ErrorSummaryEqualFold != nil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryEqualFold(*i.ErrorSummaryEqualFold))
+	if len(i.LastCallAtIn) > 0 {
+		predicates = append(predicates, apiquotatracker.LastCallAtIn(i.LastCallAtIn...))
 	}
-	if i.ErrorSummaryContainsFold != nil {
-		predicates = append(predicates, jobexecutionhistory.ErrorSummaryContainsFold(*i.ErrorSummaryContainsFold))
+	if len(i.LastCallAtNotIn) > 0 {
+		predicates = append(predicates, apiquotatracker.LastCallAtNotIn(i.LastCallAtNotIn...))
+	}
+	if i.LastCallAtGT != nil {
+		predicates = append(predicates, apiquotatracker.LastCallAtGT(*i.LastCallAtGT))
+	}
+	if i.LastCallAtGTE != nil {
+		predicates = append(predicates, apiquotatracker.LastCallAtGTE(*i.LastCallAtGTE))
+	}
+	if i.LastCallAtLT != nil {
+		predicates = append(predicates, apiquotatracker.LastCallAtLT(*i.LastCallAtLT))
+	}
+	if i.LastCallAtLTE != nil {
+		predicates = append(predicates, apiquotatracker.LastCallAtLTE(*i.LastCallAtLTE))
+	}
+	if i.LastCallAtIsNil {
+		predicates = append(predicates, apiquotatracker.LastCallAtIsNil())
+	}
+	if i.LastCallAtNotNil {
+		predicates = append(predicates, apiquotatracker.LastCallAtNotNil())
+	}
+	if i.RateLimitedUntil != nil {
+		predicates = append(predicates, apiquotatracker.RateLimitedUntilEQ(*i.RateLimitedUntil))
+	}
+	if i.RateLimitedUntilNEQ != nil {
+		predicates = append(predicates, apiquotatracker.RateLimitedUntilNEQ(*i.RateLimitedUntilNEQ))
+	}
+	if len(i.RateLimitedUntilIn) > 0 {
+		predicates = append(predicates, apiquotatracker.RateLimitedUntilIn(i.RateLimitedUntilIn...))
+	}
+	if len(i.RateLimitedUntilNotIn) > 0 {
+		predicates = append(predicates, apiquotatracker.RateLimitedUntilNotIn(i.RateLimitedUntilNotIn...))
+	}
+	if i.RateLimitedUntilGT != nil {
+		predicates = append(predicates, apiquotatracker.RateLimitedUntilGT(*i.RateLimitedUntilGT))
+	}
+	if i.RateLimitedUntilGTE != nil {
+		predicates = append(predicates, apiquotatracker.RateLimitedUntilGTE(*i.RateLimitedUntilGTE))
+	}
+	if i.RateLimitedUntilLT != nil {
+		predicates = append(predicates, apiquotatracker.RateLimitedUntilLT(*i.RateLimitedUntilLT))
+	}
+	if i.RateLimitedUntilLTE != nil {
+		predicates = append(predicates, apiquotatracker.RateLimitedUntilLTE(*i.RateLimitedUntilLTE))
+	}
+	if i.RateLimitedUntilIsNil {
+		predicates = append(predicates, apiquotatracker.RateLimitedUntilIsNil())
+	}
+	if i.RateLimitedUntilNotNil {
+		predicates = append(predicates, apiquotatracker.RateLimitedUntilNotNil())
 	}
 
-	if i.HasProfileEntries != nil {
-		p := jobexecutionhistory.HasProfileEntries()
-		if !*i.HasProfileEntries {
-			p = jobexecutionhistory.Not(p)
+	if i.HasReservations != nil {
+		p := apiquotatracker.HasReservations()
+		if !*i.HasReservations {
+			p = apiquotatracker.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasProfileEntriesWith) > 0 {
-		with := make([]predicate.ProfileEntry, 0, len(i.HasProfileEntriesWith))
-		for _, w := range i.HasProfileEntriesWith {
+	if len(i.HasReservationsWith) > 0 {
+		with := make([]predicate.APIQuotaReservation, 0, len(i.HasReservationsWith))
+		for _, w := range i.HasReservationsWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProfileEntriesWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasReservationsWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, jobexecutionhistory.HasProfileEntriesWith(with...))
+		predicates = append(predicates, apiquotatracker.HasReservationsWith(with...))
+	}
+	if i.HasBudgetReservations != nil {
+		p := apiquotatracker.HasBudgetReservations()
+		if !*i.HasBudgetReservations {
+			p = apiquotatracker.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasBudgetReservationsWith) > 0 {
+		with := make([]predicate.APIQuotaReservation, 0, len(i.HasBudgetReservationsWith))
+		for _, w := range i.HasBudgetReservationsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasBudgetReservationsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, apiquotatracker.HasBudgetReservationsWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyJobExecutionHistoryWhereInput
+		return nil, ErrEmptyAPIQuotaTrackerWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return jobexecutionhistory.And(predicates...), nil
+		return apiquotatracker.And(predicates...), nil
 	}
 }
 
-// ProfileWhereInput represents a where input for filtering Profile queries.
-type ProfileWhereInput struct {
-	Predicates []predicate.Profile  `json:"-"`
-	Not        *ProfileWhereInput   `json:"not,omitempty"`
-	Or         []*ProfileWhereInput `json:"or,omitempty"`
-	And        []*ProfileWhereInput `json:"and,omitempty"`
+// CompanyWhereInput represents a where input for filtering Company queries.
+type CompanyWhereInput struct {
+	Predicates []predicate.Company  `json:"-"`
+	Not        *CompanyWhereInput   `json:"not,omitempty"`
+	Or         []*CompanyWhereInput `json:"or,omitempty"`
+	And        []*CompanyWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *ulid.ID  `json:"id,omitempty"`
@@ -1472,219 +1991,121 @@ type ProfileWhereInput struct {
 	IDLT    *ulid.ID  `json:"idLT,omitempty"`
 	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
 
-	// "urn" field predicates.
-	Urn             *string  `json:"urn,omitempty"`
-	UrnNEQ          *string  `json:"urnNEQ,omitempty"`
-	UrnIn           []string `json:"urnIn,omitempty"`
-	UrnNotIn        []string `json:"urnNotIn,omitempty"`
-	UrnGT           *string  `json:"urnGT,omitempty"`
-	UrnGTE          *string  `json:"urnGTE,omitempty"`
-	UrnLT           *string  `json:"urnLT,omitempty"`
-	UrnLTE          *string  `json:"urnLTE,omitempty"`
-	UrnContains     *string  `json:"urnContains,omitempty"`
-	UrnHasPrefix    *string  `json:"urnHasPrefix,omitempty"`
-	UrnHasSuffix    *string  `json:"urnHasSuffix,omitempty"`
-	UrnEqualFold    *string  `json:"urnEqualFold,omitempty"`
-	UrnContainsFold *string  `json:"urnContainsFold,omitempty"`
+	// "key" field predicates.
+	Key             *string  `json:"key,omitempty"`
+	KeyNEQ          *string  `json:"keyNEQ,omitempty"`
+	KeyIn           []string `json:"keyIn,omitempty"`
+	KeyNotIn        []string `json:"keyNotIn,omitempty"`
+	KeyGT           *string  `json:"keyGT,omitempty"`
+	KeyGTE          *string  `json:"keyGTE,omitempty"`
+	KeyLT           *string  `json:"keyLT,omitempty"`
+	KeyLTE          *string  `json:"keyLTE,omitempty"`
+	KeyContains     *string  `json:"keyContains,omitempty"`
+	KeyHasPrefix    *string  `json:"keyHasPrefix,omitempty"`
+	KeyHasSuffix    *string  `json:"keyHasSuffix,omitempty"`
+	KeyEqualFold    *string  `json:"keyEqualFold,omitempty"`
+	KeyContainsFold *string  `json:"keyContainsFold,omitempty"`
 
-	// "username" field predicates.
-	Username             *string  `json:"username,omitempty"`
-	UsernameNEQ          *string  `json:"usernameNEQ,omitempty"`
-	UsernameIn           []string `json:"usernameIn,omitempty"`
-	UsernameNotIn        []string `json:"usernameNotIn,omitempty"`
-	UsernameGT           *string  `json:"usernameGT,omitempty"`
-	UsernameGTE          *string  `json:"usernameGTE,omitempty"`
-	UsernameLT           *string  `json:"usernameLT,omitempty"`
-	UsernameLTE          *string  `json:"usernameLTE,omitempty"`
-	UsernameContains     *string  `json:"usernameContains,omitempty"`
-	UsernameHasPrefix    *string  `json:"usernameHasPrefix,omitempty"`
-	UsernameHasSuffix    *string  `json:"usernameHasSuffix,omitempty"`
-	UsernameIsNil        bool     `json:"usernameIsNil,omitempty"`
-	UsernameNotNil       bool     `json:"usernameNotNil,omitempty"`
-	UsernameEqualFold    *string  `json:"usernameEqualFold,omitempty"`
-	UsernameContainsFold *string  `json:"usernameContainsFold,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "first_name" field predicates.
-	FirstName             *string  `json:"firstName,omitempty"`
-	FirstNameNEQ          *string  `json:"firstNameNEQ,omitempty"`
-	FirstNameIn           []string `json:"firstNameIn,omitempty"`
-	FirstNameNotIn        []string `json:"firstNameNotIn,omitempty"`
-	FirstNameGT           *string  `json:"firstNameGT,omitempty"`
-	FirstNameGTE          *string  `json:"firstNameGTE,omitempty"`
-	FirstNameLT           *string  `json:"firstNameLT,omitempty"`
-	FirstNameLTE          *string  `json:"firstNameLTE,omitempty"`
-	FirstNameContains     *string  `json:"firstNameContains,omitempty"`
-	FirstNameHasPrefix    *string  `json:"firstNameHasPrefix,omitempty"`
-	FirstNameHasSuffix    *string  `json:"firstNameHasSuffix,omitempty"`
-	FirstNameIsNil        bool     `json:"firstNameIsNil,omitempty"`
-	FirstNameNotNil       bool     `json:"firstNameNotNil,omitempty"`
-	FirstNameEqualFold    *string  `json:"firstNameEqualFold,omitempty"`
-	FirstNameContainsFold *string  `json:"firstNameContainsFold,omitempty"`
+	// "normalized_name" field predicates.
+	NormalizedName             *string  `json:"normalizedName,omitempty"`
+	NormalizedNameNEQ          *string  `json:"normalizedNameNEQ,omitempty"`
+	NormalizedNameIn           []string `json:"normalizedNameIn,omitempty"`
+	NormalizedNameNotIn        []string `json:"normalizedNameNotIn,omitempty"`
+	NormalizedNameGT           *string  `json:"normalizedNameGT,omitempty"`
+	NormalizedNameGTE          *string  `json:"normalizedNameGTE,omitempty"`
+	NormalizedNameLT           *string  `json:"normalizedNameLT,omitempty"`
+	NormalizedNameLTE          *string  `json:"normalizedNameLTE,omitempty"`
+	NormalizedNameContains     *string  `json:"normalizedNameContains,omitempty"`
+	NormalizedNameHasPrefix    *string  `json:"normalizedNameHasPrefix,omitempty"`
+	NormalizedNameHasSuffix    *string  `json:"normalizedNameHasSuffix,omitempty"`
+	NormalizedNameEqualFold    *string  `json:"normalizedNameEqualFold,omitempty"`
+	NormalizedNameContainsFold *string  `json:"normalizedNameContainsFold,omitempty"`
 
-	// "last_name" field predicates.
-	LastName             *string  `json:"lastName,omitempty"`
-	LastNameNEQ          *string  `json:"lastNameNEQ,omitempty"`
-	LastNameIn           []string `json:"lastNameIn,omitempty"`
-	LastNameNotIn        []string `json:"lastNameNotIn,omitempty"`
-	LastNameGT           *string  `json:"lastNameGT,omitempty"`
-	LastNameGTE          *string  `json:"lastNameGTE,omitempty"`
-	LastNameLT           *string  `json:"lastNameLT,omitempty"`
-	LastNameLTE          *string  `json:"lastNameLTE,omitempty"`
-	LastNameContains     *string  `json:"lastNameContains,omitempty"`
-	LastNameHasPrefix    *string  `json:"lastNameHasPrefix,omitempty"`
-	LastNameHasSuffix    *string  `json:"lastNameHasSuffix,omitempty"`
-	LastNameIsNil        bool     `json:"lastNameIsNil,omitempty"`
-	LastNameNotNil       bool     `json:"lastNameNotNil,omitempty"`
-	LastNameEqualFold    *string  `json:"lastNameEqualFold,omitempty"`
-	LastNameContainsFold *string  `json:"lastNameContainsFold,omitempty"`
+	// "linkedin_urn" field predicates.
+	LinkedinUrn             *string  `json:"linkedinUrn,omitempty"`
+	LinkedinUrnNEQ          *string  `json:"linkedinUrnNEQ,omitempty"`
+	LinkedinUrnIn           []string `json:"linkedinUrnIn,omitempty"`
+	LinkedinUrnNotIn        []string `json:"linkedinUrnNotIn,omitempty"`
+	LinkedinUrnGT           *string  `json:"linkedinUrnGT,omitempty"`
+	LinkedinUrnGTE          *string  `json:"linkedinUrnGTE,omitempty"`
+	LinkedinUrnLT           *string  `json:"linkedinUrnLT,omitempty"`
+	LinkedinUrnLTE          *string  `json:"linkedinUrnLTE,omitempty"`
+	LinkedinUrnContains     *string  `json:"linkedinUrnContains,omitempty"`
+	LinkedinUrnHasPrefix    *string  `json:"linkedinUrnHasPrefix,omitempty"`
+	LinkedinUrnHasSuffix    *string  `json:"linkedinUrnHasSuffix,omitempty"`
+	LinkedinUrnIsNil        bool     `json:"linkedinUrnIsNil,omitempty"`
+	LinkedinUrnNotNil       bool     `json:"linkedinUrnNotNil,omitempty"`
+	LinkedinUrnEqualFold    *string  `json:"linkedinUrnEqualFold,omitempty"`
+	LinkedinUrnContainsFold *string  `json:"linkedinUrnContainsFold,omitempty"`
 
-	// "headline" field predicates.
-	Headline             *string  `json:"headline,omitempty"`
-	HeadlineNEQ          *string  `json:"headlineNEQ,omitempty"`
-	HeadlineIn           []string `json:"headlineIn,omitempty"`
-	HeadlineNotIn        []string `json:"headlineNotIn,omitempty"`
-	HeadlineGT           *string  `json:"headlineGT,omitempty"`
-	HeadlineGTE          *string  `json:"headlineGTE,omitempty"`
-	HeadlineLT           *string  `json:"headlineLT,omitempty"`
-	HeadlineLTE          *string  `json:"headlineLTE,omitempty"`
-	HeadlineContains     *string  `json:"headlineContains,omitempty"`
-	HeadlineHasPrefix    *string  `json:"headlineHasPrefix,omitempty"`
-	HeadlineHasSuffix    *string  `json:"headlineHasSuffix,omitempty"`
-	HeadlineIsNil        bool     `json:"headlineIsNil,omitempty"`
-	HeadlineNotNil       bool     `json:"headlineNotNil,omitempty"`
-	HeadlineEqualFold    *string  `json:"headlineEqualFold,omitempty"`
-	HeadlineContainsFold *string  `json:"headlineContainsFold,omitempty"`
+	// "linkedin_username" field predicates.
+	LinkedinUsername             *string  `json:"linkedinUsername,omitempty"`
+	LinkedinUsernameNEQ          *string  `json:"linkedinUsernameNEQ,omitempty"`
+	LinkedinUsernameIn           []string `json:"linkedinUsernameIn,omitempty"`
+	LinkedinUsernameNotIn        []string `json:"linkedinUsernameNotIn,omitempty"`
+	LinkedinUsernameGT           *string  `json:"linkedinUsernameGT,omitempty"`
+	LinkedinUsernameGTE          *string  `json:"linkedinUsernameGTE,omitempty"`
+	LinkedinUsernameLT           *string  `json:"linkedinUsernameLT,omitempty"`
+	LinkedinUsernameLTE          *string  `json:"linkedinUsernameLTE,omitempty"`
+	LinkedinUsernameContains     *string  `json:"linkedinUsernameContains,omitempty"`
+	LinkedinUsernameHasPrefix    *string  `json:"linkedinUsernameHasPrefix,omitempty"`
+	LinkedinUsernameHasSuffix    *string  `json:"linkedinUsernameHasSuffix,omitempty"`
+	LinkedinUsernameIsNil        bool     `json:"linkedinUsernameIsNil,omitempty"`
+	LinkedinUsernameNotNil       bool     `json:"linkedinUsernameNotNil,omitempty"`
+	LinkedinUsernameEqualFold    *string  `json:"linkedinUsernameEqualFold,omitempty"`
+	LinkedinUsernameContainsFold *string  `json:"linkedinUsernameContainsFold,omitempty"`
 
-	// "title" field predicates.
-	Title             *string  `json:"title,omitempty"`
-	TitleNEQ          *string  `json:"titleNEQ,omitempty"`
-	TitleIn           []string `json:"titleIn,omitempty"`
-	TitleNotIn        []string `json:"titleNotIn,omitempty"`
-	TitleGT           *string  `json:"titleGT,omitempty"`
-	TitleGTE          *string  `json:"titleGTE,omitempty"`
-	TitleLT           *string  `json:"titleLT,omitempty"`
-	TitleLTE          *string  `json:"titleLTE,omitempty"`
-	TitleContains     *string  `json:"titleContains,omitempty"`
-	TitleHasPrefix    *string  `json:"titleHasPrefix,omitempty"`
-	TitleHasSuffix    *string  `json:"titleHasSuffix,omitempty"`
-	TitleIsNil        bool     `json:"titleIsNil,omitempty"`
-	TitleNotNil       bool     `json:"titleNotNil,omitempty"`
-	TitleEqualFold    *string  `json:"titleEqualFold,omitempty"`
-	TitleContainsFold *string  `json:"titleContainsFold,omitempty"`
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "country" field predicates.
-	Country             *string  `json:"country,omitempty"`
-	CountryNEQ          *string  `json:"countryNEQ,omitempty"`
-	CountryIn           []string `json:"countryIn,omitempty"`
-	CountryNotIn        []string `json:"countryNotIn,omitempty"`
-	CountryGT           *string  `json:"countryGT,omitempty"`
-	CountryGTE          *string  `json:"countryGTE,omitempty"`
-	CountryLT           *string  `json:"countryLT,omitempty"`
-	CountryLTE          *string  `json:"countryLTE,omitempty"`
-	CountryContains     *string  `json:"countryContains,omitempty"`
-	CountryHasPrefix    *string  `json:"countryHasPrefix,omitempty"`
-	CountryHasSuffix    *string  `json:"countryHasSuffix,omitempty"`
-	CountryIsNil        bool     `json:"countryIsNil,omitempty"`
-	CountryNotNil       bool     `json:"countryNotNil,omitempty"`
-	CountryEqualFold    *string  `json:"countryEqualFold,omitempty"`
-	CountryContainsFold *string  `json:"countryContainsFold,omitempty"`
-
-	// "city" field predicates.
-	City             *string  `json:"city,omitempty"`
-	CityNEQ          *string  `json:"cityNEQ,omitempty"`
-	CityIn           []string `json:"cityIn,omitempty"`
-	CityNotIn        []string `json:"cityNotIn,omitempty"`
-	CityGT           *string  `json:"cityGT,omitempty"`
-	CityGTE          *string  `json:"cityGTE,omitempty"`
-	CityLT           *string  `json:"cityLT,omitempty"`
-	CityLTE          *string  `json:"cityLTE,omitempty"`
-	CityContains     *string  `json:"cityContains,omitempty"`
-	CityHasPrefix    *string  `json:"cityHasPrefix,omitempty"`
-	CityHasSuffix    *string  `json:"cityHasSuffix,omitempty"`
-	CityIsNil        bool     `json:"cityIsNil,omitempty"`
-	CityNotNil       bool     `json:"cityNotNil,omitempty"`
-	CityEqualFold    *string  `json:"cityEqualFold,omitempty"`
-	CityContainsFold *string  `json:"cityContainsFold,omitempty"`
-
-	// "raw_data_s3_key" field predicates.
-	RawDataS3Key             *string  `json:"rawDataS3Key,omitempty"`
-	RawDataS3KeyNEQ          *string  `json:"rawDataS3KeyNEQ,omitempty"`
-	RawDataS3KeyIn           []string `json:"rawDataS3KeyIn,omitempty"`
-	RawDataS3KeyNotIn        []string `json:"rawDataS3KeyNotIn,omitempty"`
-	RawDataS3KeyGT           *string  `json:"rawDataS3KeyGT,omitempty"`
-	RawDataS3KeyGTE          *string  `json:"rawDataS3KeyGTE,omitempty"`
-	RawDataS3KeyLT           *string  `json:"rawDataS3KeyLT,omitempty"`
-	RawDataS3KeyLTE          *string  `json:"rawDataS3KeyLTE,omitempty"`
-	RawDataS3KeyContains     *string  `json:"rawDataS3KeyContains,omitempty"`
-	RawDataS3KeyHasPrefix    *string  `json:"rawDataS3KeyHasPrefix,omitempty"`
-	RawDataS3KeyHasSuffix    *string  `json:"rawDataS3KeyHasSuffix,omitempty"`
-	RawDataS3KeyIsNil        bool     `json:"rawDataS3KeyIsNil,omitempty"`
-	RawDataS3KeyNotNil       bool     `json:"rawDataS3KeyNotNil,omitempty"`
-	RawDataS3KeyEqualFold    *string  `json:"rawDataS3KeyEqualFold,omitempty"`
-	RawDataS3KeyContainsFold *string  `json:"rawDataS3KeyContainsFold,omitempty"`
-
-	// "cleaned_data_s3_key" field predicates.
-	CleanedDataS3Key             *string  `json:"cleanedDataS3Key,omitempty"`
-	CleanedDataS3KeyNEQ          *string  `json:"cleanedDataS3KeyNEQ,omitempty"`
-	CleanedDataS3KeyIn           []string `json:"cleanedDataS3KeyIn,omitempty"`
-	CleanedDataS3KeyNotIn        []string `json:"cleanedDataS3KeyNotIn,omitempty"`
-	CleanedDataS3KeyGT           *string  `json:"cleanedDataS3KeyGT,omitempty"`
-	CleanedDataS3KeyGTE          *string  `json:"cleanedDataS3KeyGTE,omitempty"`
-	CleanedDataS3KeyLT           *string  `json:"cleanedDataS3KeyLT,omitempty"`
-	CleanedDataS3KeyLTE          *string  `json:"cleanedDataS3KeyLTE,omitempty"`
-	CleanedDataS3KeyContains     *string  `json:"cleanedDataS3KeyContains,omitempty"`
-	CleanedDataS3KeyHasPrefix    *string  `json:"cleanedDataS3KeyHasPrefix,omitempty"`
-	CleanedDataS3KeyHasSuffix    *string  `json:"cleanedDataS3KeyHasSuffix,omitempty"`
-	CleanedDataS3KeyIsNil        bool     `json:"cleanedDataS3KeyIsNil,omitempty"`
-	CleanedDataS3KeyNotNil       bool     `json:"cleanedDataS3KeyNotNil,omitempty"`
-	CleanedDataS3KeyEqualFold    *string  `json:"cleanedDataS3KeyEqualFold,omitempty"`
-	CleanedDataS3KeyContainsFold *string  `json:"cleanedDataS3KeyContainsFold,omitempty"`
-
-	// "source_file" field predicates.
-	SourceFile             *string  `json:"sourceFile,omitempty"`
-	SourceFileNEQ          *string  `json:"sourceFileNEQ,omitempty"`
-	SourceFileIn           []string `json:"sourceFileIn,omitempty"`
-	SourceFileNotIn        []string `json:"sourceFileNotIn,omitempty"`
-	SourceFileGT           *string  `json:"sourceFileGT,omitempty"`
-	SourceFileGTE          *string  `json:"sourceFileGTE,omitempty"`
-	SourceFileLT           *string  `json:"sourceFileLT,omitempty"`
-	SourceFileLTE          *string  `json:"sourceFileLTE,omitempty"`
-	SourceFileContains     *string  `json:"sourceFileContains,omitempty"`
-	SourceFileHasPrefix    *string  `json:"sourceFileHasPrefix,omitempty"`
-	SourceFileHasSuffix    *string  `json:"sourceFileHasSuffix,omitempty"`
-	SourceFileIsNil        bool     `json:"sourceFileIsNil,omitempty"`
-	SourceFileNotNil       bool     `json:"sourceFileNotNil,omitempty"`
-	SourceFileEqualFold    *string  `json:"sourceFileEqualFold,omitempty"`
-	SourceFileContainsFold *string  `json:"sourceFileContainsFold,omitempty"`
+	// "positions" edge predicates.
+	HasPositions     *bool                        `json:"hasPositions,omitempty"`
+	HasPositionsWith []*ProfilePositionWhereInput `json:"hasPositionsWith,omitempty"`
 
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+	// "current_employees" edge predicates.
+	HasCurrentEmployees     *bool                `json:"hasCurrentEmployees,omitempty"`
+	HasCurrentEmployeesWith []*ProfileWhereInput `json:"hasCurrentEmployeesWith,omitempty"`
 
-	// "profile_entry" edge predicates.
-	HasProfileEntry     *bool                     `json:"hasProfileEntry,omitempty"`
-	HasProfileEntryWith []*ProfileEntryWhereInput `json:"hasProfileEntryWith,omitempty"`
+	// "alumni" edge predicates.
+	HasAlumni     *bool                `json:"hasAlumni,omitempty"`
+	HasAlumniWith []*ProfileWhereInput `json:"hasAlumniWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ProfileWhereInput) AddPredicates(predicates ...predicate.Profile) {
+func (i *CompanyWhereInput) AddPredicates(predicates ...predicate.Company) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ProfileWhereInput filter on the ProfileQuery builder.
-func (i *ProfileWhereInput) Filter(q *ProfileQuery) (*ProfileQuery, error) {
+// Filter applies the CompanyWhereInput filter on the CompanyQuery builder.
+func (i *CompanyWhereInput) Filter(q *CompanyQuery) (*CompanyQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyProfileWhereInput {
+		if err == ErrEmptyCompanyWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1692,19 +2113,19 @@ func (i *ProfileWhereInput) Filter(q *ProfileQuery) (*ProfileQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyProfileWhereInput is returned in case the ProfileWhereInput is empty.
-var ErrEmptyProfileWhereInput = errors.New("ent: empty predicate ProfileWhereInput")
+// ErrEmptyCompanyWhereInput is returned in case the CompanyWhereInput is empty.
+var ErrEmptyCompanyWhereInput = errors.New("ent: empty predicate CompanyWhereInput")
 
-// P returns a predicate for filtering profiles.
+// P returns a predicate for filtering companies.
 // An error is returned if the input is empty or invalid.
-func (i *ProfileWhereInput) P() (predicate.Profile, error) {
-	var predicates []predicate.Profile
+func (i *CompanyWhereInput) P() (predicate.Company, error) {
+	var predicates []predicate.Company
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, profile.Not(p))
+		predicates = append(predicates, company.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1714,7 +2135,7 @@ func (i *ProfileWhereInput) P() (predicate.Profile, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Profile, 0, n)
+		or := make([]predicate.Company, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1722,7 +2143,7 @@ func (i *ProfileWhereInput) P() (predicate.Profile, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, profile.Or(or...))
+		predicates = append(predicates, company.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1732,7 +2153,7 @@ func (i *ProfileWhereInput) P() (predicate.Profile, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Profile, 0, n)
+		and := make([]predicate.Company, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1740,581 +2161,845 @@ func (i *ProfileWhereInput) P() (predicate.Profile, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, profile.And(and...))
+		predicates = append(predicates, company.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, profile.IDEQ(*i.ID))
+		predicates = append(predicates, company.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, profile.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, company.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, profile.IDIn(i.IDIn...))
+		predicates = append(predicates, company.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, profile.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, company.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, profile.IDGT(*i.IDGT))
+		predicates = append(predicates, company.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, profile.IDGTE(*i.IDGTE))
+		predicates = append(predicates, company.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, profile.IDLT(*i.IDLT))
+		predicates = append(predicates, company.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, profile.IDLTE(*i.IDLTE))
+		predicates = append(predicates, company.IDLTE(*i.IDLTE))
 	}
-	if i.Urn != nil {
-		predicates = append(predicates, profile.UrnEQ(*i.Urn))
+	if i.Key != nil {
+		predicates = append(predicates, company.KeyEQ(*i.Key))
 	}
-	if i.UrnNEQ != nil {
-		predicates = append(predicates, profile.UrnNEQ(*i.UrnNEQ))
+	if i.KeyNEQ != nil {
+		predicates = append(predicates, company.KeyNEQ(*i.KeyNEQ))
 	}
-	if len(i.UrnIn) > 0 {
-		predicates = append(predicates, profile.UrnIn(i.UrnIn...))
+	if len(i.KeyIn) > 0 {
+		predicates = append(predicates, company.KeyIn(i.KeyIn...))
 	}
-	if len(i.UrnNotIn) > 0 {
-		predicates = append(predicates, profile.UrnNotIn(i.UrnNotIn...))
+	if len(i.KeyNotIn) > 0 {
+		predicates = append(predicates, company.KeyNotIn(i.KeyNotIn...))
 	}
-	if i.UrnGT != nil {
-		predicates = append(predicates, profile.UrnGT(*i.UrnGT))
+	if i.KeyGT != nil {
+		predicates = append(predicates, company.KeyGT(*i.KeyGT))
 	}
-	if i.UrnGTE != nil {
-		predicates = append(predicates, profile.UrnGTE(*i.UrnGTE))
+	if i.KeyGTE != nil {
+		predicates = append(predicates, company.KeyGTE(*i.KeyGTE))
 	}
-	if i.UrnLT != nil {
-		predicates = append(predicates, profile.UrnLT(*i.UrnLT))
+	if i.KeyLT != nil {
+		predicates = append(predicates, company.KeyLT(*i.KeyLT))
 	}
-	if i.UrnLTE != nil {
-		predicates = append(predicates, profile.UrnLTE(*i.UrnLTE))
+	if i.KeyLTE != nil {
+		predicates = append(predicates, company.KeyLTE(*i.KeyLTE))
 	}
-	if i.UrnContains != nil {
-		predicates = append(predicates, profile.UrnContains(*i.UrnContains))
+	if i.KeyContains != nil {
+		predicates = append(predicates, company.KeyContains(*i.KeyContains))
 	}
-	if i.UrnHasPrefix != nil {
-		predicates = append(predicates, profile.UrnHasPrefix(*i.UrnHasPrefix))
+	if i.KeyHasPrefix != nil {
+		predicates = append(predicates, company.KeyHasPrefix(*i.KeyHasPrefix))
 	}
-	if i.UrnHasSuffix != nil {
-		predicates = append(predicates, profile.UrnHasSuffix(*i.UrnHasSuffix))
+	if i.KeyHasSuffix != nil {
+		predicates = append(predicates, company.KeyHasSuffix(*i.KeyHasSuffix))
 	}
-	if i.UrnEqualFold != nil {
-		predicates = append(predicates, profile.UrnEqualFold(*i.UrnEqualFold))
+	if i.KeyEqualFold != nil {
+		predicates = append(predicates, company.KeyEqualFold(*i.KeyEqualFold))
 	}
-	if i.UrnContainsFold != nil {
-		predicates = append(predicates, profile.UrnContainsFold(*i.UrnContainsFold))
+	if i.KeyContainsFold != nil {
+		predicates = append(predicates, company.KeyContainsFold(*i.KeyContainsFold))
 	}
-	if i.Username != nil {
-		predicates = append(predicates, profile.UsernameEQ(*i.Username))
+	if i.Name != nil {
+		predicates = append(predicates, company.NameEQ(*i.Name))
 	}
-	if i.UsernameNEQ != nil {
-		predicates = append(predicates, profile.UsernameNEQ(*i.UsernameNEQ))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, company.NameNEQ(*i.NameNEQ))
 	}
-	if len(i.UsernameIn) > 0 {
-		predicates = append(predicates, profile.UsernameIn(i.UsernameIn...))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, company.NameIn(i.NameIn...))
 	}
-	if len(i.UsernameNotIn) > 0 {
-		predicates = append(predicates, profile.UsernameNotIn(i.UsernameNotIn...))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, company.NameNotIn(i.NameNotIn...))
 	}
-	if i.UsernameGT != nil {
-		predicates = append(predicates, profile.UsernameGT(*i.UsernameGT))
+	if i.NameGT != nil {
+		predicates = append(predicates, company.NameGT(*i.NameGT))
 	}
-	if i.UsernameGTE != nil {
-		predicates = append(predicates, profile.UsernameGTE(*i.UsernameGTE))
+	if i.NameGTE != nil {
+		predicates = append(predicates, company.NameGTE(*i.NameGTE))
 	}
-	if i.UsernameLT != nil {
-		predicates = append(predicates, profile.UsernameLT(*i.UsernameLT))
+	if i.NameLT != nil {
+		predicates = append(predicates, company.NameLT(*i.NameLT))
 	}
-	if i.UsernameLTE != nil {
-		predicates = append(predicates, profile.UsernameLTE(*i.UsernameLTE))
+	if i.NameLTE != nil {
+		predicates = append(predicates, company.NameLTE(*i.NameLTE))
 	}
-	if i.UsernameContains != nil {
-		predicates = append(predicates, profile.UsernameContains(*i.UsernameContains))
+	if i.NameContains != nil {
+		predicates = append(predicates, company.NameContains(*i.NameContains))
 	}
-	if i.UsernameHasPrefix != nil {
-		predicates = append(predicates, profile.UsernameHasPrefix(*i.UsernameHasPrefix))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, company.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.UsernameHasSuffix != nil {
-		predicates = append(predicates, profile.UsernameHasSuffix(*i.UsernameHasSuffix))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, company.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.UsernameIsNil {
-		predicates = append(predicates, profile.UsernameIsNil())
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, company.NameEqualFold(*i.NameEqualFold))
 	}
-	if i.UsernameNotNil {
-		predicates = append(predicates, profile.UsernameNotNil())
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, company.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.UsernameEqualFold != nil {
-		predicates = append(predicates, profile.UsernameEqualFold(*i.UsernameEqualFold))
+	if i.NormalizedName != nil {
+		predicates = append(predicates, company.NormalizedNameEQ(*i.NormalizedName))
 	}
-	if i.UsernameContainsFold != nil {
-		predicates = append(predicates, profile.UsernameContainsFold(*i.UsernameContainsFold))
+	if i.NormalizedNameNEQ != nil {
+		predicates = append(predicates, company.NormalizedNameNEQ(*i.NormalizedNameNEQ))
 	}
-	if i.FirstName != nil {
-		predicates = append(predicates, profile.FirstNameEQ(*i.FirstName))
+	if len(i.NormalizedNameIn) > 0 {
+		predicates = append(predicates, company.NormalizedNameIn(i.NormalizedNameIn...))
 	}
-	if i.FirstNameNEQ != nil {
-		predicates = append(predicates, profile.FirstNameNEQ(*i.FirstNameNEQ))
+	if len(i.NormalizedNameNotIn) > 0 {
+		predicates = append(predicates, company.NormalizedNameNotIn(i.NormalizedNameNotIn...))
 	}
-	if len(i.FirstNameIn) > 0 {
-		predicates = append(predicates, profile.FirstNameIn(i.FirstNameIn...))
+	if i.NormalizedNameGT != nil {
+		predicates = append(predicates, company.NormalizedNameGT(*i.NormalizedNameGT))
 	}
-	if len(i.FirstNameNotIn) > 0 {
-		predicates = append(predicates, profile.FirstNameNotIn(i.FirstNameNotIn...))
+	if i.NormalizedNameGTE != nil {
+		predicates = append(predicates, company.NormalizedNameGTE(*i.NormalizedNameGTE))
 	}
-	if i.FirstNameGT != nil {
-		predicates = append(predicates, profile.FirstNameGT(*i.FirstNameGT))
+	if i.NormalizedNameLT != nil {
+		predicates = append(predicates, company.NormalizedNameLT(*i.NormalizedNameLT))
 	}
-	if i.FirstNameGTE != nil {
-		predicates = append(predicates, profile.FirstNameGTE(*i.FirstNameGTE))
+	if i.NormalizedNameLTE != nil {
+		predicates = append(predicates, company.NormalizedNameLTE(*i.NormalizedNameLTE))
 	}
-	if i.FirstNameLT != nil {
-		predicates = append(predicates, profile.FirstNameLT(*i.FirstNameLT))
+	if i.NormalizedNameContains != nil {
+		predicates = append(predicates, company.NormalizedNameContains(*i.NormalizedNameContains))
 	}
-	if i.FirstNameLTE != nil {
-		predicates = append(predicates, profile.FirstNameLTE(*i.FirstNameLTE))
+	if i.NormalizedNameHasPrefix != nil {
+		predicates = append(predicates, company.NormalizedNameHasPrefix(*i.NormalizedNameHasPrefix))
 	}
-	if i.FirstNameContains != nil {
-		predicates = append(predicates, profile.FirstNameContains(*i.FirstNameContains))
+	if i.NormalizedNameHasSuffix != nil {
+		predicates = append(predicates, company.NormalizedNameHasSuffix(*i.NormalizedNameHasSuffix))
 	}
-	if i.FirstNameHasPrefix != nil {
-		predicates = append(predicates, profile.FirstNameHasPrefix(*i.FirstNameHasPrefix))
+	if i.NormalizedNameEqualFold != nil {
+		predicates = append(predicates, company.NormalizedNameEqualFold(*i.NormalizedNameEqualFold))
 	}
-	if i.FirstNameHasSuffix != nil {
-		predicates = append(predicates, profile.FirstNameHasSuffix(*i.FirstNameHasSuffix))
+	if i.NormalizedNameContainsFold != nil {
+		predicates = append(predicates, company.NormalizedNameContainsFold(*i.NormalizedNameContainsFold))
 	}
-	if i.FirstNameIsNil {
-		predicates = append(predicates, profile.FirstNameIsNil())
+	if i.LinkedinUrn != nil {
+		predicates = append(predicates, company.LinkedinUrnEQ(*i.LinkedinUrn))
 	}
-	if i.FirstNameNotNil {
-		predicates = append(predicates, profile.FirstNameNotNil())
+	if i.LinkedinUrnNEQ != nil {
+		predicates = append(predicates, company.LinkedinUrnNEQ(*i.LinkedinUrnNEQ))
 	}
-	if i.FirstNameEqualFold != nil {
-		predicates = append(predicates, profile.FirstNameEqualFold(*i.FirstNameEqualFold))
+	if len(i.LinkedinUrnIn) > 0 {
+		predicates = append(predicates, company.LinkedinUrnIn(i.LinkedinUrnIn...))
 	}
-	if i.FirstNameContainsFold != nil {
-		predicates = append(predicates, profile.FirstNameContainsFold(*i.FirstNameContainsFold))
+	if len(i.LinkedinUrnNotIn) > 0 {
+		predicates = append(predicates, company.LinkedinUrnNotIn(i.LinkedinUrnNotIn...))
 	}
-	if i.LastName != nil {
-		predicates = append(predicates, profile.LastNameEQ(*i.LastName))
+	if i.LinkedinUrnGT != nil {
+		predicates = append(predicates, company.LinkedinUrnGT(*i.LinkedinUrnGT))
 	}
-	if i.LastNameNEQ != nil {
-		predicates = append(predicates, profile.LastNameNEQ(*i.LastNameNEQ))
+	if i.LinkedinUrnGTE != nil {
+		predicates = append(predicates, company.LinkedinUrnGTE(*i.LinkedinUrnGTE))
 	}
-	if len(i.LastNameIn) > 0 {
-		predicates = append(predicates, profile.LastNameIn(i.LastNameIn...))
+	if i.LinkedinUrnLT != nil {
+		predicates = append(predicates, company.LinkedinUrnLT(*i.LinkedinUrnLT))
 	}
-	if len(i.LastNameNotIn) > 0 {
-		predicates = append(predicates, profile.LastNameNotIn(i.LastNameNotIn...))
+	if i.LinkedinUrnLTE != nil {
+		predicates = append(predicates, company.LinkedinUrnLTE(*i.LinkedinUrnLTE))
 	}
-	if i.LastNameGT != nil {
-		predicates = append(predicates, profile.LastNameGT(*i.LastNameGT))
+	if i.LinkedinUrnContains != nil {
+		predicates = append(predicates, company.LinkedinUrnContains(*i.LinkedinUrnContains))
 	}
-	if i.LastNameGTE != nil {
-		predicates = append(predicates, profile.LastNameGTE(*i.LastNameGTE))
+	if i.LinkedinUrnHasPrefix != nil {
+		predicates = append(predicates, company.LinkedinUrnHasPrefix(*i.LinkedinUrnHasPrefix))
 	}
-	if i.LastNameLT != nil {
-		predicates = append(predicates, profile.LastNameLT(*i.LastNameLT))
+	if i.LinkedinUrnHasSuffix != nil {
+		predicates = append(predicates, company.LinkedinUrnHasSuffix(*i.LinkedinUrnHasSuffix))
 	}
-	if i.LastNameLTE != nil {
-		predicates = append(predicates, profile.LastNameLTE(*i.LastNameLTE))
+	if i.LinkedinUrnIsNil {
+		predicates = append(predicates, company.LinkedinUrnIsNil())
 	}
-	if i.LastNameContains != nil {
-		predicates = append(predicates, profile.LastNameContains(*i.LastNameContains))
+	if i.LinkedinUrnNotNil {
+		predicates = append(predicates, company.LinkedinUrnNotNil())
 	}
-	if i.LastNameHasPrefix != nil {
-		predicates = append(predicates, profile.LastNameHasPrefix(*i.LastNameHasPrefix))
+	if i.LinkedinUrnEqualFold != nil {
+		predicates = append(predicates, company.LinkedinUrnEqualFold(*i.LinkedinUrnEqualFold))
 	}
-	if i.LastNameHasSuffix != nil {
-		predicates = append(predicates, profile.LastNameHasSuffix(*i.LastNameHasSuffix))
+	if i.LinkedinUrnContainsFold != nil {
+		predicates = append(predicates, company.LinkedinUrnContainsFold(*i.LinkedinUrnContainsFold))
 	}
-	if i.LastNameIsNil {
-		predicates = append(predicates, profile.LastNameIsNil())
+	if i.LinkedinUsername != nil {
+		predicates = append(predicates, company.LinkedinUsernameEQ(*i.LinkedinUsername))
 	}
-	if i.LastNameNotNil {
-		predicates = append(predicates, profile.LastNameNotNil())
+	if i.LinkedinUsernameNEQ != nil {
+		predicates = append(predicates, company.LinkedinUsernameNEQ(*i.LinkedinUsernameNEQ))
 	}
-	if i.LastNameEqualFold != nil {
-		predicates = append(predicates, profile.LastNameEqualFold(*i.LastNameEqualFold))
+	if len(i.LinkedinUsernameIn) > 0 {
+		predicates = append(predicates, company.LinkedinUsernameIn(i.LinkedinUsernameIn...))
 	}
-	if i.LastNameContainsFold != nil {
-		predicates = append(predicates, profile.LastNameContainsFold(*i.LastNameContainsFold))
+	if len(i.LinkedinUsernameNotIn) > 0 {
+		predicates = append(predicates, company.LinkedinUsernameNotIn(i.LinkedinUsernameNotIn...))
 	}
-	if i.Headline != nil {
-		predicates = append(predicates, profile.HeadlineEQ(*i.Headline))
+	if i.LinkedinUsernameGT != nil {
+		predicates = append(predicates, company.LinkedinUsernameGT(*i.LinkedinUsernameGT))
 	}
-	if i.HeadlineNEQ != nil {
-		predicates = append(predicates, profile.HeadlineNEQ(*i.HeadlineNEQ))
+	if i.LinkedinUsernameGTE != nil {
+		predicates = append(predicates, company.LinkedinUsernameGTE(*i.LinkedinUsernameGTE))
 	}
-	if len(i.HeadlineIn) > 0 {
-		predicates = append(predicates, profile.HeadlineIn(i.HeadlineIn...))
+	if i.LinkedinUsernameLT != nil {
+		predicates = append(predicates, company.LinkedinUsernameLT(*i.LinkedinUsernameLT))
 	}
-	if len(i.HeadlineNotIn) > 0 {
-		predicates = append(predicates, profile.HeadlineNotIn(i.HeadlineNotIn...))
+	if i.LinkedinUsernameLTE != nil {
+		predicates = append(predicates, company.LinkedinUsernameLTE(*i.LinkedinUsernameLTE))
 	}
-	if i.HeadlineGT != nil {
-		predicates = append(predicates, profile.HeadlineGT(*i.HeadlineGT))
-	}
-	if i.HeadlineGTE != nil {
-		predicates = append(predicates, profile.HeadlineGTE(*i.HeadlineGTE))
-	}
-	if i.HeadlineLT != nil {
-		predicates = append(predicates, profile.HeadlineLT(*i.HeadlineLT))
-	}
-	if i.HeadlineLTE != nil {
-		predicates = append(predicates, profile.HeadlineLTE(*i.HeadlineLTE))
-	}
-	if i.HeadlineContains != nil {
-		predicates = append(predicates, profile.HeadlineContains(*i.HeadlineContains))
-	}
-	if i.HeadlineHasPrefix != nil {
-		predicates = append(predicates, profile.HeadlineHasPrefix(*i.HeadlineHasPrefix))
+	if i.LinkedinUsernameContains != nil {
+		predicates = append(predicates, company.LinkedinUsernameContains(*i.LinkedinUsernameContains))
 	}
-	if i.HeadlineHasSuffix != nil {
-		predicates = append(predicates, profile.HeadlineHasSuffix(*i.HeadlineHasSuffix))
+	if i.LinkedinUsernameHasPrefix != nil {
+		predicates = append(predicates, company.LinkedinUsernameHasPrefix(*i.LinkedinUsernameHasPrefix))
 	}
-	if i.HeadlineIsNil {
-		predicates = append(predicates, profile.HeadlineIsNil())
+	if i.LinkedinUsernameHasSuffix != nil {
+		predicates = append(predicates, company.LinkedinUsernameHasSuffix(*i.LinkedinUsernameHasSuffix))
 	}
-	if i.HeadlineNotNil {
-		predicates = append(predicates, profile.HeadlineNotNil())
+	if i.LinkedinUsernameIsNil {
+		predicates = append(predicates, company.LinkedinUsernameIsNil())
 	}
-	if i.HeadlineEqualFold != nil {
-		predicates = append(predicates, profile.HeadlineEqualFold(*i.HeadlineEqualFold))
+	if i.LinkedinUsernameNotNil {
+		predicates = append(predicates, company.LinkedinUsernameNotNil())
 	}
-	if i.HeadlineContainsFold != nil {
-		predicates = append(predicates, profile.HeadlineContainsFold(*i.HeadlineContainsFold))
+	if i.LinkedinUsernameEqualFold != nil {
+		predicates = append(predicates, company.LinkedinUsernameEqualFold(*i.LinkedinUsernameEqualFold))
 	}
-	if i.Title != nil {
-		predicates = append(predicates, profile.TitleEQ(*i.Title))
+	if i.LinkedinUsernameContainsFold != nil {
+		predicates = append(predicates, company.LinkedinUsernameContainsFold(*i.LinkedinUsernameContainsFold))
 	}
-	if i.TitleNEQ != nil {
-		predicates = append(predicates, profile.TitleNEQ(*i.TitleNEQ))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, company.CreatedAtEQ(*i.CreatedAt))
 	}
-	if len(i.TitleIn) > 0 {
-		predicates = append(predicates, profile.TitleIn(i.TitleIn...))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, company.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if len(i.TitleNotIn) > 0 {
-		predicates = append(predicates, profile.TitleNotIn(i.TitleNotIn...))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, company.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.TitleGT != nil {
-		predicates = append(predicates, profile.TitleGT(*i.TitleGT))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, company.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.TitleGTE != nil {
-		predicates = append(predicates, profile.TitleGTE(*i.TitleGTE))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, company.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.TitleLT != nil {
-		predicates = append(predicates, profile.TitleLT(*i.TitleLT))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, company.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.TitleLTE != nil {
-		predicates = append(predicates, profile.TitleLTE(*i.TitleLTE))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, company.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.TitleContains != nil {
-		predicates = append(predicates, profile.TitleContains(*i.TitleContains))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, company.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.TitleHasPrefix != nil {
-		predicates = append(predicates, profile.TitleHasPrefix(*i.TitleHasPrefix))
+
+	if i.HasPositions != nil {
+		p := company.HasPositions()
+		if !*i.HasPositions {
+			p = company.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.TitleHasSuffix != nil {
-		predicates = append(predicates, profile.TitleHasSuffix(*i.TitleHasSuffix))
+	if len(i.HasPositionsWith) > 0 {
+		with := make([]predicate.ProfilePosition, 0, len(i.HasPositionsWith))
+		for _, w := range i.HasPositionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasPositionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, company.HasPositionsWith(with...))
 	}
-	if i.TitleIsNil {
-		predicates = append(predicates, profile.TitleIsNil())
+	if i.HasCurrentEmployees != nil {
+		p := company.HasCurrentEmployees()
+		if !*i.HasCurrentEmployees {
+			p = company.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.TitleNotNil {
-		predicates = append(predicates, profile.TitleNotNil())
+	if len(i.HasCurrentEmployeesWith) > 0 {
+		with := make([]predicate.Profile, 0, len(i.HasCurrentEmployeesWith))
+		for _, w := range i.HasCurrentEmployeesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasCurrentEmployeesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, company.HasCurrentEmployeesWith(with...))
 	}
-	if i.TitleEqualFold != nil {
-		predicates = append(predicates, profile.TitleEqualFold(*i.TitleEqualFold))
+	if i.HasAlumni != nil {
+		p := company.HasAlumni()
+		if !*i.HasAlumni {
+			p = company.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.TitleContainsFold != nil {
-		predicates = append(predicates, profile.TitleContainsFold(*i.TitleContainsFold))
+	if len(i.HasAlumniWith) > 0 {
+		with := make([]predicate.Profile, 0, len(i.HasAlumniWith))
+		for _, w := range i.HasAlumniWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAlumniWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, company.HasAlumniWith(with...))
 	}
-	if i.Country != nil {
-		predicates = append(predicates, profile.CountryEQ(*i.Country))
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyCompanyWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return company.And(predicates...), nil
 	}
-	if i.CountryNEQ != nil {
-		predicates = append(predicates, profile.CountryNEQ(*i.CountryNEQ))
+}
+
+// CronJobConfigWhereInput represents a where input for filtering CronJobConfig queries.
+type CronJobConfigWhereInput struct {
+	Predicates []predicate.CronJobConfig  `json:"-"`
+	Not        *CronJobConfigWhereInput   `json:"not,omitempty"`
+	Or         []*CronJobConfigWhereInput `json:"or,omitempty"`
+	And        []*CronJobConfigWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *ulid.ID  `json:"id,omitempty"`
+	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []ulid.ID `json:"idIn,omitempty"`
+	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *ulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *ulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "job_name" field predicates.
+	JobName             *string  `json:"jobName,omitempty"`
+	JobNameNEQ          *string  `json:"jobNameNEQ,omitempty"`
+	JobNameIn           []string `json:"jobNameIn,omitempty"`
+	JobNameNotIn        []string `json:"jobNameNotIn,omitempty"`
+	JobNameGT           *string  `json:"jobNameGT,omitempty"`
+	JobNameGTE          *string  `json:"jobNameGTE,omitempty"`
+	JobNameLT           *string  `json:"jobNameLT,omitempty"`
+	JobNameLTE          *string  `json:"jobNameLTE,omitempty"`
+	JobNameContains     *string  `json:"jobNameContains,omitempty"`
+	JobNameHasPrefix    *string  `json:"jobNameHasPrefix,omitempty"`
+	JobNameHasSuffix    *string  `json:"jobNameHasSuffix,omitempty"`
+	JobNameEqualFold    *string  `json:"jobNameEqualFold,omitempty"`
+	JobNameContainsFold *string  `json:"jobNameContainsFold,omitempty"`
+
+	// "job_type" field predicates.
+	JobType      *cronjobconfig.JobType  `json:"jobType,omitempty"`
+	JobTypeNEQ   *cronjobconfig.JobType  `json:"jobTypeNEQ,omitempty"`
+	JobTypeIn    []cronjobconfig.JobType `json:"jobTypeIn,omitempty"`
+	JobTypeNotIn []cronjobconfig.JobType `json:"jobTypeNotIn,omitempty"`
+
+	// "schedule" field predicates.
+	Schedule             *string  `json:"schedule,omitempty"`
+	ScheduleNEQ          *string  `json:"scheduleNEQ,omitempty"`
+	ScheduleIn           []string `json:"scheduleIn,omitempty"`
+	ScheduleNotIn        []string `json:"scheduleNotIn,omitempty"`
+	ScheduleGT           *string  `json:"scheduleGT,omitempty"`
+	ScheduleGTE          *string  `json:"scheduleGTE,omitempty"`
+	ScheduleLT           *string  `json:"scheduleLT,omitempty"`
+	ScheduleLTE          *string  `json:"scheduleLTE,omitempty"`
+	ScheduleContains     *string  `json:"scheduleContains,omitempty"`
+	ScheduleHasPrefix    *string  `json:"scheduleHasPrefix,omitempty"`
+	ScheduleHasSuffix    *string  `json:"scheduleHasSuffix,omitempty"`
+	ScheduleEqualFold    *string  `json:"scheduleEqualFold,omitempty"`
+	ScheduleContainsFold *string  `json:"scheduleContainsFold,omitempty"`
+
+	// "enabled" field predicates.
+	Enabled    *bool `json:"enabled,omitempty"`
+	EnabledNEQ *bool `json:"enabledNEQ,omitempty"`
+
+	// "batch_size" field predicates.
+	BatchSize      *int  `json:"batchSize,omitempty"`
+	BatchSizeNEQ   *int  `json:"batchSizeNEQ,omitempty"`
+	BatchSizeIn    []int `json:"batchSizeIn,omitempty"`
+	BatchSizeNotIn []int `json:"batchSizeNotIn,omitempty"`
+	BatchSizeGT    *int  `json:"batchSizeGT,omitempty"`
+	BatchSizeGTE   *int  `json:"batchSizeGTE,omitempty"`
+	BatchSizeLT    *int  `json:"batchSizeLT,omitempty"`
+	BatchSizeLTE   *int  `json:"batchSizeLTE,omitempty"`
+
+	// "concurrency" field predicates.
+	Concurrency      *int  `json:"concurrency,omitempty"`
+	ConcurrencyNEQ   *int  `json:"concurrencyNEQ,omitempty"`
+	ConcurrencyIn    []int `json:"concurrencyIn,omitempty"`
+	ConcurrencyNotIn []int `json:"concurrencyNotIn,omitempty"`
+	ConcurrencyGT    *int  `json:"concurrencyGT,omitempty"`
+	ConcurrencyGTE   *int  `json:"concurrencyGTE,omitempty"`
+	ConcurrencyLT    *int  `json:"concurrencyLT,omitempty"`
+	ConcurrencyLTE   *int  `json:"concurrencyLTE,omitempty"`
+
+	// "admin_email" field predicates.
+	AdminEmail             *string  `json:"adminEmail,omitempty"`
+	AdminEmailNEQ          *string  `json:"adminEmailNEQ,omitempty"`
+	AdminEmailIn           []string `json:"adminEmailIn,omitempty"`
+	AdminEmailNotIn        []string `json:"adminEmailNotIn,omitempty"`
+	AdminEmailGT           *string  `json:"adminEmailGT,omitempty"`
+	AdminEmailGTE          *string  `json:"adminEmailGTE,omitempty"`
+	AdminEmailLT           *string  `json:"adminEmailLT,omitempty"`
+	AdminEmailLTE          *string  `json:"adminEmailLTE,omitempty"`
+	AdminEmailContains     *string  `json:"adminEmailContains,omitempty"`
+	AdminEmailHasPrefix    *string  `json:"adminEmailHasPrefix,omitempty"`
+	AdminEmailHasSuffix    *string  `json:"adminEmailHasSuffix,omitempty"`
+	AdminEmailEqualFold    *string  `json:"adminEmailEqualFold,omitempty"`
+	AdminEmailContainsFold *string  `json:"adminEmailContainsFold,omitempty"`
+
+	// "respect_quota" field predicates.
+	RespectQuota    *bool `json:"respectQuota,omitempty"`
+	RespectQuotaNEQ *bool `json:"respectQuotaNEQ,omitempty"`
+
+	// "last_run_at" field predicates.
+	LastRunAt       *time.Time  `json:"lastRunAt,omitempty"`
+	LastRunAtNEQ    *time.Time  `json:"lastRunAtNEQ,omitempty"`
+	LastRunAtIn     []time.Time `json:"lastRunAtIn,omitempty"`
+	LastRunAtNotIn  []time.Time `json:"lastRunAtNotIn,omitempty"`
+	LastRunAtGT     *time.Time  `json:"lastRunAtGT,omitempty"`
+	LastRunAtGTE    *time.Time  `json:"lastRunAtGTE,omitempty"`
+	LastRunAtLT     *time.Time  `json:"lastRunAtLT,omitempty"`
+	LastRunAtLTE    *time.Time  `json:"lastRunAtLTE,omitempty"`
+	LastRunAtIsNil  bool        `json:"lastRunAtIsNil,omitempty"`
+	LastRunAtNotNil bool        `json:"lastRunAtNotNil,omitempty"`
+
+	// "next_run_at" field predicates.
+	NextRunAt       *time.Time  `json:"nextRunAt,omitempty"`
+	NextRunAtNEQ    *time.Time  `json:"nextRunAtNEQ,omitempty"`
+	NextRunAtIn     []time.Time `json:"nextRunAtIn,omitempty"`
+	NextRunAtNotIn  []time.Time `json:"nextRunAtNotIn,omitempty"`
+	NextRunAtGT     *time.Time  `json:"nextRunAtGT,omitempty"`
+	NextRunAtGTE    *time.Time  `json:"nextRunAtGTE,omitempty"`
+	NextRunAtLT     *time.Time  `json:"nextRunAtLT,omitempty"`
+	NextRunAtLTE    *time.Time  `json:"nextRunAtLTE,omitempty"`
+	NextRunAtIsNil  bool        `json:"nextRunAtIsNil,omitempty"`
+	NextRunAtNotNil bool        `json:"nextRunAtNotNil,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *CronJobConfigWhereInput) AddPredicates(predicates ...predicate.CronJobConfig) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the CronJobConfigWhereInput filter on the CronJobConfigQuery builder.
+func (i *CronJobConfigWhereInput) Filter(q *CronJobConfigQuery) (*CronJobConfigQuery, error) {
+	if i == nil {
+		return q, nil
 	}
-	if len(i.CountryIn) > 0 {
-		predicates = append(predicates, profile.CountryIn(i.CountryIn...))
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyCronJobConfigWhereInput {
+			return q, nil
+		}
+		return nil, err
 	}
-	if len(i.CountryNotIn) > 0 {
-		predicates = append(predicates, profile.CountryNotIn(i.CountryNotIn...))
+	return q.Where(p), nil
+}
+
+// ErrEmptyCronJobConfigWhereInput is returned in case the CronJobConfigWhereInput is empty.
+var ErrEmptyCronJobConfigWhereInput = errors.New("ent: empty predicate CronJobConfigWhereInput")
+
+// P returns a predicate for filtering cronjobconfigs.
+// An error is returned if the input is empty or invalid.
+func (i *CronJobConfigWhereInput) P() (predicate.CronJobConfig, error) {
+	var predicates []predicate.CronJobConfig
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, cronjobconfig.Not(p))
 	}
-	if i.CountryGT != nil {
-		predicates = append(predicates, profile.CountryGT(*i.CountryGT))
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.CronJobConfig, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, cronjobconfig.Or(or...))
 	}
-	if i.CountryGTE != nil {
-		predicates = append(predicates, profile.CountryGTE(*i.CountryGTE))
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.CronJobConfig, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, cronjobconfig.And(and...))
 	}
-	if i.CountryLT != nil {
-		predicates = append(predicates, profile.CountryLT(*i.CountryLT))
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, cronjobconfig.IDEQ(*i.ID))
 	}
-	if i.CountryLTE != nil {
-		predicates = append(predicates, profile.CountryLTE(*i.CountryLTE))
+	if i.IDNEQ != nil {
+		predicates = append(predicates, cronjobconfig.IDNEQ(*i.IDNEQ))
 	}
-	if i.CountryContains != nil {
-		predicates = append(predicates, profile.CountryContains(*i.CountryContains))
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, cronjobconfig.IDIn(i.IDIn...))
 	}
-	if i.CountryHasPrefix != nil {
-		predicates = append(predicates, profile.CountryHasPrefix(*i.CountryHasPrefix))
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, cronjobconfig.IDNotIn(i.IDNotIn...))
 	}
-	if i.CountryHasSuffix != nil {
-		predicates = append(predicates, profile.CountryHasSuffix(*i.CountryHasSuffix))
+	if i.IDGT != nil {
+		predicates = append(predicates, cronjobconfig.IDGT(*i.IDGT))
 	}
-	if i.CountryIsNil {
-		predicates = append(predicates, profile.CountryIsNil())
+	if i.IDGTE != nil {
+		predicates = append(predicates, cronjobconfig.IDGTE(*i.IDGTE))
 	}
-	if i.CountryNotNil {
-		predicates = append(predicates, profile.CountryNotNil())
+	if i.IDLT != nil {
+		predicates = append(predicates, cronjobconfig.IDLT(*i.IDLT))
 	}
-	if i.CountryEqualFold != nil {
-		predicates = append(predicates, profile.CountryEqualFold(*i.CountryEqualFold))
+	if i.IDLTE != nil {
+		predicates = append(predicates, cronjobconfig.IDLTE(*i.IDLTE))
 	}
-	if i.CountryContainsFold != nil {
-		predicates = append(predicates, profile.CountryContainsFold(*i.CountryContainsFold))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, cronjobconfig.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.City != nil {
-		predicates = append(predicates, profile.CityEQ(*i.City))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, cronjobconfig.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if i.CityNEQ != nil {
-		predicates = append(predicates, profile.CityNEQ(*i.CityNEQ))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, cronjobconfig.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if len(i.CityIn) > 0 {
-		predicates = append(predicates, profile.CityIn(i.CityIn...))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, cronjobconfig.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if len(i.CityNotIn) > 0 {
-		predicates = append(predicates, profile.CityNotIn(i.CityNotIn...))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, cronjobconfig.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.CityGT != nil {
-		predicates = append(predicates, profile.CityGT(*i.CityGT))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, cronjobconfig.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.CityGTE != nil {
-		predicates = append(predicates, profile.CityGTE(*i.CityGTE))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, cronjobconfig.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.CityLT != nil {
-		predicates = append(predicates, profile.CityLT(*i.CityLT))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, cronjobconfig.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.CityLTE != nil {
-		predicates = append(predicates, profile.CityLTE(*i.CityLTE))
+	if i.JobName != nil {
+		predicates = append(predicates, cronjobconfig.JobNameEQ(*i.JobName))
 	}
-	if i.CityContains != nil {
-		predicates = append(predicates, profile.CityContains(*i.CityContains))
+	if i.JobNameNEQ != nil {
+		predicates = append(predicates, cronjobconfig.JobNameNEQ(*i.JobNameNEQ))
 	}
-	if i.CityHasPrefix != nil {
-		predicates = append(predicates, profile.CityHasPrefix(*i.CityHasPrefix))
+	if len(i.JobNameIn) > 0 {
+		predicates = append(predicates, cronjobconfig.JobNameIn(i.JobNameIn...))
 	}
-	if i.CityHasSuffix != nil {
-		predicates = append(predicates, profile.CityHasSuffix(*i.CityHasSuffix))
+	if len(i.JobNameNotIn) > 0 {
+		predicates = append(predicates, cronjobconfig.JobNameNotIn(i.JobNameNotIn...))
 	}
-	if i.CityIsNil {
-		predicates = append(predicates, profile.CityIsNil())
+	if i.JobNameGT != nil {
+		predicates = append(predicates, cronjobconfig.JobNameGT(*i.JobNameGT))
 	}
-	if i.CityNotNil {
-		predicates = append(predicates, profile.CityNotNil())
+	if i.JobNameGTE != nil {
+		predicates = append(predicates, cronjobconfig.JobNameGTE(*i.JobNameGTE))
 	}
-	if i.CityEqualFold != nil {
-		predicates = append(predicates, profile.CityEqualFold(*i.CityEqualFold))
+	if i.JobNameLT != nil {
+		predicates = append(predicates, cronjobconfig.JobNameLT(*i.JobNameLT))
 	}
-	if i.CityContainsFold != nil {
-		predicates = append(predicates, profile.CityContainsFold(*i.CityContainsFold))
+	if i.JobNameLTE != nil {
+		predicates = append(predicates, cronjobconfig.JobNameLTE(*i.JobNameLTE))
 	}
-	if i.RawDataS3Key != nil {
-		predicates = append(predicates, profile.RawDataS3KeyEQ(*i.RawDataS3Key))
+	if i.JobNameContains != nil {
+		predicates = append(predicates, cronjobconfig.JobNameContains(*i.JobNameContains))
 	}
-	if i.RawDataS3KeyNEQ != nil {
-		predicates = append(predicates, profile.RawDataS3KeyNEQ(*i.RawDataS3KeyNEQ))
+	if i.JobNameHasPrefix != nil {
+		predicates = append(predicates, cronjobconfig.JobNameHasPrefix(*i.JobNameHasPrefix))
 	}
-	if len(i.RawDataS3KeyIn) > 0 {
-		predicates = append(predicates, profile.RawDataS3KeyIn(i.RawDataS3KeyIn...))
+	if i.JobNameHasSuffix != nil {
+		predicates = append(predicates, cronjobconfig.JobNameHasSuffix(*i.JobNameHasSuffix))
 	}
-	if len(i.RawDataS3KeyNotIn) > 0 {
-		predicates = append(predicates, profile.RawDataS3KeyNotIn(i.RawDataS3KeyNotIn...))
+	if i.JobNameEqualFold != nil {
+		predicates = append(predicates, cronjobconfig.JobNameEqualFold(*i.JobNameEqualFold))
 	}
-	if i.RawDataS3KeyGT != nil {
-		predicates = append(predicates, profile.RawDataS3KeyGT(*i.RawDataS3KeyGT))
+	if i.JobNameContainsFold != nil {
+		predicates = append(predicates, cronjobconfig.JobNameContainsFold(*i.JobNameContainsFold))
 	}
-	if i.RawDataS3KeyGTE != nil {
-		predicates = append(predicates, profile.RawDataS3KeyGTE(*i.RawDataS3KeyGTE))
+	if i.JobType != nil {
+		predicates = append(predicates, cronjobconfig.JobTypeEQ(*i.JobType))
 	}
-	if i.RawDataS3KeyLT != nil {
-		predicates = append(predicates, profile.RawDataS3KeyLT(*i.RawDataS3KeyLT))
+	if i.JobTypeNEQ != nil {
+		predicates = append(predicates, cronjobconfig.JobTypeNEQ(*i.JobTypeNEQ))
 	}
-	if i.RawDataS3KeyLTE != nil {
-		predicates = append(predicates, profile.RawDataS3KeyLTE(*i.RawDataS3KeyLTE))
+	if len(i.JobTypeIn) > 0 {
+		predicates = append(predicates, cronjobconfig.JobTypeIn(i.JobTypeIn...))
 	}
-	if i.RawDataS3KeyContains != nil {
-		predicates = append(predicates, profile.RawDataS3KeyContains(*i.RawDataS3KeyContains))
+	if len(i.JobTypeNotIn) > 0 {
+		predicates = append(predicates, cronjobconfig.JobTypeNotIn(i.JobTypeNotIn...))
 	}
-	if i.RawDataS3KeyHasPrefix != nil {
-		predicates = append(predicates, profile.RawDataS3KeyHasPrefix(*i.RawDataS3KeyHasPrefix))
+	if i.Schedule != nil {
+		predicates = append(predicates, cronjobconfig.ScheduleEQ(*i.Schedule))
 	}
-	if i.RawDataS3KeyHasSuffix != nil {
-		predicates = append(predicates, profile.RawDataS3KeyHasSuffix(*i.RawDataS3KeyHasSuffix))
+	if i.ScheduleNEQ != nil {
+		predicates = append(predicates, cronjobconfig.ScheduleNEQ(*i.ScheduleNEQ))
 	}
-	if i.RawDataS3KeyIsNil {
-		predicates = append(predicates, profile.RawDataS3KeyIsNil())
+	if len(i.ScheduleIn) > 0 {
+		predicates = append(predicates, cronjobconfig.ScheduleIn(i.ScheduleIn...))
 	}
-	if i.RawDataS3KeyNotNil {
-		predicates = append(predicates, profile.RawDataS3KeyNotNil())
+	if len(i.ScheduleNotIn) > 0 {
+		predicates = append(predicates, cronjobconfig.ScheduleNotIn(i.ScheduleNotIn...))
 	}
-	if i.RawDataS3KeyEqualFold != nil {
-		predicates = append(predicates, profile.RawDataS3KeyEqualFold(*i.RawDataS3KeyEqualFold))
+	if i.ScheduleGT != nil {
+		predicates = append(predicates, cronjobconfig.ScheduleGT(*i.ScheduleGT))
 	}
-	if i.RawDataS3KeyContainsFold != nil {
-		predicates = append(predicates, profile.RawDataS3KeyContainsFold(*i.RawDataS3KeyContainsFold))
+	if i.ScheduleGTE != nil {
+		predicates = append(predicates, cronjobconfig.ScheduleGTE(*i.ScheduleGTE))
 	}
-	if i.CleanedDataS3Key != nil {
-		predicates = append(predicates, profile.CleanedDataS3KeyEQ(*i.CleanedDataS3Key))
+	if i.ScheduleLT != nil {
+		predicates = append(predicates, cronjobconfig.ScheduleLT(*i.ScheduleLT))
 	}
-	if i.CleanedDataS3KeyNEQ != nil {
-		predicates = append(predicates, profile.CleanedDataS3KeyNEQ(*i.CleanedDataS3KeyNEQ))
+	if i.ScheduleLTE != nil {
+		predicates = append(predicates, cronjobconfig.ScheduleLTE(*i.ScheduleLTE))
 	}
-	if len(i.CleanedDataS3KeyIn) > 0 {
-		predicates = append(predicates, profile.CleanedDataS3KeyIn(i.CleanedDataS3KeyIn...))
+	if i.ScheduleContains != nil {
+		predicates = append(predicates, cronjobconfig.ScheduleContains(*i.ScheduleContains))
 	}
-	if len(i.CleanedDataS3KeyNotIn) > 0 {
-		predicates = append(predicates, profile.CleanedDataS3KeyNotIn(i.CleanedDataS3KeyNotIn...))
+	if i.ScheduleHasPrefix != nil {
+		predicates = append(predicates, cronjobconfig.ScheduleHasPrefix(*i.ScheduleHasPrefix))
 	}
-	if i.CleanedDataS3KeyGT != nil {
-		predicates = append(predicates, profile.CleanedDataS3KeyGT(*i.CleanedDataS3KeyGT))
+	if i.ScheduleHasSuffix != nil {
+		predicates = append(predicates, cronjobconfig.ScheduleHasSuffix(*i.ScheduleHasSuffix))
 	}
-	if i.CleanedDataS3KeyGTE != nil {
-		predicates = append(predicates, profile.CleanedDataS3KeyGTE(*i.CleanedDataS3KeyGTE))
+	if i.ScheduleEqualFold != nil {
+		predicates = append(predicates, cronjobconfig.ScheduleEqualFold(*i.ScheduleEqualFold))
 	}
-	if i.CleanedDataS3KeyLT != nil {
-		predicates = append(predicates, profile.CleanedDataS3KeyLT(*i.CleanedDataS3KeyLT))
+	if i.ScheduleContainsFold != nil {
+		predicates = append(predicates, cronjobconfig.ScheduleContainsFold(*i.ScheduleContainsFold))
 	}
-	if i.CleanedDataS3KeyLTE != nil {
-		predicates = append(predicates, profile.CleanedDataS3KeyLTE(*i.CleanedDataS3KeyLTE))
+	if i.Enabled != nil {
+		predicates = append(predicates, cronjobconfig.EnabledEQ(*i.Enabled))
 	}
-	if i.CleanedDataS3KeyContains != nil {
-		predicates = append(predicates, profile.CleanedDataS3KeyContains(*i.CleanedDataS3KeyContains))
+	if i.EnabledNEQ != nil {
+		predicates = append(predicates, cronjobconfig.EnabledNEQ(*i.EnabledNEQ))
 	}
-	if i.CleanedDataS3KeyHasPrefix != nil {
-		predicates = append(predicates, profile.CleanedDataS3KeyHasPrefix(*i.CleanedDataS3KeyHasPrefix))
+	if i.BatchSize != nil {
+		predicates = append(predicates, cronjobconfig.BatchSizeEQ(*i.BatchSize))
 	}
-	if i.CleanedDataS3KeyHasSuffix != nil {
-		predicates = append(predicates, profile.CleanedDataS3KeyHasSuffix(*i.CleanedDataS3KeyHasSuffix))
+	if i.BatchSizeNEQ != nil {
+		predicates = append(predicates, cronjobconfig.BatchSizeNEQ(*i.BatchSizeNEQ))
 	}
-	if i.CleanedDataS3KeyIsNil {
-		predicates = append(predicates, profile.CleanedDataS3KeyIsNil())
+	if len(i.BatchSizeIn) > 0 {
+		predicates = append(predicates, cronjobconfig.BatchSizeIn(i.BatchSizeIn...))
 	}
-	if i.CleanedDataS3KeyNotNil {
-		predicates = append(predicates, profile.CleanedDataS3KeyNotNil())
+	if len(i.BatchSizeNotIn) > 0 {
+		predicates = append(predicates, cronjobconfig.BatchSizeNotIn(i.BatchSizeNotIn...))
 	}
-	if i.CleanedDataS3KeyEqualFold != nil {
-		predicates = append(predicates, profile.CleanedDataS3KeyEqualFold(*i.CleanedDataS3KeyEqualFold))
+	if i.BatchSizeGT != nil {
+		predicates = append(predicates, cronjobconfig.BatchSizeGT(*i.BatchSizeGT))
 	}
-	if i.CleanedDataS3KeyContainsFold != nil {
-		predicates = append(predicates, profile.CleanedDataS3KeyContainsFold(*i.CleanedDataS3KeyContainsFold))
+	if i.BatchSizeGTE != nil {
+		predicates = append(predicates, cronjobconfig.BatchSizeGTE(*i.BatchSizeGTE))
 	}
-	if i.SourceFile != nil {
-		predicates = append(predicates, profile.SourceFileEQ(*i.SourceFile))
+	if i.BatchSizeLT != nil {
+		predicates = append(predicates, cronjobconfig.BatchSizeLT(*i.BatchSizeLT))
 	}
-	if i.SourceFileNEQ != nil {
-		predicates = append(predicates, profile.SourceFileNEQ(*i.SourceFileNEQ))
+	if i.BatchSizeLTE != nil {
+		predicates = append(predicates, cronjobconfig.BatchSizeLTE(*i.BatchSizeLTE))
 	}
-	if len(i.SourceFileIn) > 0 {
-		predicates = append(predicates, profile.SourceFileIn(i.SourceFileIn...))
+	if i.Concurrency != nil {
+		predicates = append(predicates, cronjobconfig.ConcurrencyEQ(*i.Concurrency))
 	}
-	if len(i.SourceFileNotIn) > 0 {
-		predicates = append(predicates, profile.SourceFileNotIn(i.SourceFileNotIn...))
+	if i.ConcurrencyNEQ != nil {
+		predicates = append(predicates, cronjobconfig.ConcurrencyNEQ(*i.ConcurrencyNEQ))
 	}
-	if i.SourceFileGT != nil {
-		predicates = append(predicates, profile.SourceFileGT(*i.SourceFileGT))
+	if len(i.ConcurrencyIn) > 0 {
+		predicates = append(predicates, cronjobconfig.ConcurrencyIn(i.ConcurrencyIn...))
 	}
-	if i.SourceFileGTE != nil {
-		predicates = append(predicates, profile.SourceFileGTE(*i.SourceFileGTE))
+	if len(i.ConcurrencyNotIn) > 0 {
+		predicates = append(predicates, cronjobconfig.ConcurrencyNotIn(i.ConcurrencyNotIn...))
 	}
-	if i.SourceFileLT != nil {
-		predicates = append(predicates, profile.SourceFileLT(*i.SourceFileLT))
+	if i.ConcurrencyGT != nil {
+		predicates = append(predicates, cronjobconfig.ConcurrencyGT(*i.ConcurrencyGT))
 	}
-	if i.SourceFileLTE != nil {
-		predicates = append(predicates, profile.SourceFileLTE(*i.SourceFileLTE))
+	if i.ConcurrencyGTE != nil {
+		predicates = append(predicates, cronjobconfig.ConcurrencyGTE(*i.ConcurrencyGTE))
 	}
-	if i.SourceFileContains != nil {
-		predicates = append(predicates, profile.SourceFileContains(*i.SourceFileContains))
+	if i.ConcurrencyLT != nil {
+		predicates = append(predicates, cronjobconfig.ConcurrencyLT(*i.ConcurrencyLT))
 	}
-	if i.SourceFileHasPrefix != nil {
-		predicates = append(predicates, profile.SourceFileHasPrefix(*i.SourceFileHasPrefix))
+	if i.ConcurrencyLTE != nil {
+		predicates = append(predicates, cronjobconfig.ConcurrencyLTE(*i.ConcurrencyLTE))
 	}
-	if i.SourceFileHasSuffix != nil {
-		predicates = append(predicates, profile.SourceFileHasSuffix(*i.SourceFileHasSuffix))
+	if i.AdminEmail != nil {
+		predicates = append(predicates, cronjobconfig.AdminEmailEQ(*i.AdminEmail))
 	}
-	if i.SourceFileIsNil {
-		predicates = append(predicates, profile.SourceFileIsNil())
+	if i.AdminEmailNEQ != nil {
+		predicates = append(predicates, cronjobconfig.AdminEmailNEQ(*i.AdminEmailNEQ))
 	}
-	if i.SourceFileNotNil {
-		predicates = append(predicates, profile.SourceFileNotNil())
+	if len(i.AdminEmailIn) > 0 {
+		predicates = append(predicates, cronjobconfig.AdminEmailIn(i.AdminEmailIn...))
 	}
-	if i.SourceFileEqualFold != nil {
-		predicates = append(predicates, profile.SourceFileEqualFold(*i.SourceFileEqualFold))
+	if len(i.AdminEmailNotIn) > 0 {
+		predicates = append(predicates, cronjobconfig.AdminEmailNotIn(i.AdminEmailNotIn...))
 	}
-	if i.SourceFileContainsFold != nil {
-		predicates = append(predicates, profile.SourceFileContainsFold(*i.SourceFileContainsFold))
+	if i.AdminEmailGT != nil {
+		predicates = append(predicates, cronjobconfig.AdminEmailGT(*i.AdminEmailGT))
 	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, profile.CreatedAtEQ(*i.CreatedAt))
+	if i.AdminEmailGTE != nil {
+		predicates = append(predicates, cronjobconfig.AdminEmailGTE(*i.AdminEmailGTE))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, profile.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.AdminEmailLT != nil {
+		predicates = append(predicates, cronjobconfig.AdminEmailLT(*i.AdminEmailLT))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, profile.CreatedAtIn(i.CreatedAtIn...))
+	if i.AdminEmailLTE != nil {
+		predicates = append(predicates, cronjobconfig.AdminEmailLTE(*i.AdminEmailLTE))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, profile.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if i.AdminEmailContains != nil {
+		predicates = append(predicates, cronjobconfig.AdminEmailContains(*i.AdminEmailContains))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, profile.CreatedAtGT(*i.CreatedAtGT))
+	if i.AdminEmailHasPrefix != nil {
+		predicates = append(predicates, cronjobconfig.AdminEmailHasPrefix(*i.AdminEmailHasPrefix))
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, profile.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.AdminEmailHasSuffix != nil {
+		predicates = append(predicates, cronjobconfig.AdminEmailHasSuffix(*i.AdminEmailHasSuffix))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, profile.CreatedAtLT(*i.CreatedAtLT))
+	if i.AdminEmailEqualFold != nil {
+		predicates = append(predicates, cronjobconfig.AdminEmailEqualFold(*i.AdminEmailEqualFold))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, profile.CreatedAtLTE(*i.CreatedAtLTE))
+	if i.AdminEmailContainsFold != nil {
+		predicates = append(predicates, cronjobconfig.AdminEmailContainsFold(*i.AdminEmailContainsFold))
 	}
-
-	if i.HasProfileEntry != nil {
-		p := profile.HasProfileEntry()
-		if !*i.HasProfileEntry {
-			p = profile.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.RespectQuota != nil {
+		predicates = append(predicates, cronjobconfig.RespectQuotaEQ(*i.RespectQuota))
 	}
-	if len(i.HasProfileEntryWith) > 0 {
-		with := make([]predicate.ProfileEntry, 0, len(i.HasProfileEntryWith))
-		for _, w := range i.HasProfileEntryWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProfileEntryWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, profile.HasProfileEntryWith(with...))
+	if i.RespectQuotaNEQ != nil {
+		predicates = append(predicates, cronjobconfig.RespectQuotaNEQ(*i.RespectQuotaNEQ))
+	}
+	if i.LastRunAt != nil {
+		predicates = append(predicates, cronjobconfig.LastRunAtEQ(*i.LastRunAt))
+	}
+	if i.LastRunAtNEQ != nil {
+		predicates = append(predicates, cronjobconfig.LastRunAtNEQ(*i.LastRunAtNEQ))
+	}
+	if len(i.LastRunAtIn) > 0 {
+		predicates = append(predicates, cronjobconfig.LastRunAtIn(i.LastRunAtIn...))
+	}
+	if len(i.LastRunAtNotIn) > 0 {
+		predicates = append(predicates, cronjobconfig.LastRunAtNotIn(i.LastRunAtNotIn...))
+	}
+	if i.LastRunAtGT != nil {
+		predicates = append(predicates, cronjobconfig.LastRunAtGT(*i.LastRunAtGT))
+	}
+	if i.LastRunAtGTE != nil {
+		predicates = append(predicates, cronjobconfig.LastRunAtGTE(*i.LastRunAtGTE))
+	}
+	if i.LastRunAtLT != nil {
+		predicates = append(predicates, cronjobconfig.LastRunAtLT(*i.LastRunAtLT))
+	}
+	if i.LastRunAtLTE != nil {
+		predicates = append(predicates, cronjobconfig.LastRunAtLTE(*i.LastRunAtLTE))
+	}
+	if i.LastRunAtIsNil {
+		predicates = append(predicates, cronjobconfig.LastRunAtIsNil())
+	}
+	if i.LastRunAtNotNil {
+		predicates = append(predicates, cronjobconfig.LastRunAtNotNil())
+	}
+	if i.NextRunAt != nil {
+		predicates = append(predicates, cronjobconfig.NextRunAtEQ(*i.NextRunAt))
 	}
+	if i.NextRunAtNEQ != nil {
+		predicates = append(predicates, cronjobconfig.NextRunAtNEQ(*i.NextRunAtNEQ))
+	}
+	if len(i.NextRunAtIn) > 0 {
+		predicates = append(predicates, cronjobconfig.NextRunAtIn(i.NextRunAtIn...))
+	}
+	if len(i.NextRunAtNotIn) > 0 {
+		predicates = append(predicates, cronjobconfig.NextRunAtNotIn(i.NextRunAtNotIn...))
+	}
+	if i.NextRunAtGT != nil {
+		predicates = append(predicates, cronjobconfig.NextRunAtGT(*i.NextRunAtGT))
+	}
+	if i.NextRunAtGTE != nil {
+		predicates = append(predicates, cronjobconfig.NextRunAtGTE(*i.NextRunAtGTE))
+	}
+	if i.NextRunAtLT != nil {
+		predicates = append(predicates, cronjobconfig.NextRunAtLT(*i.NextRunAtLT))
+	}
+	if i.NextRunAtLTE != nil {
+		predicates = append(predicates, cronjobconfig.NextRunAtLTE(*i.NextRunAtLTE))
+	}
+	if i.NextRunAtIsNil {
+		predicates = append(predicates, cronjobconfig.NextRunAtIsNil())
+	}
+	if i.NextRunAtNotNil {
+		predicates = append(predicates, cronjobconfig.NextRunAtNotNil())
+	}
+
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyProfileWhereInput
+		return nil, ErrEmptyCronJobConfigWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return profile.And(predicates...), nil
+		return cronjobconfig.And(predicates...), nil
 	}
 }
 
-// ProfileEntryWhereInput represents a where input for filtering ProfileEntry queries.
-type ProfileEntryWhereInput struct {
-	Predicates []predicate.ProfileEntry  `json:"-"`
-	Not        *ProfileEntryWhereInput   `json:"not,omitempty"`
-	Or         []*ProfileEntryWhereInput `json:"or,omitempty"`
-	And        []*ProfileEntryWhereInput `json:"and,omitempty"`
+// JobExecutionHistoryWhereInput represents a where input for filtering JobExecutionHistory queries.
+type JobExecutionHistoryWhereInput struct {
+	Predicates []predicate.JobExecutionHistory  `json:"-"`
+	Not        *JobExecutionHistoryWhereInput   `json:"not,omitempty"`
+	Or         []*JobExecutionHistoryWhereInput `json:"or,omitempty"`
+	And        []*JobExecutionHistoryWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *ulid.ID  `json:"id,omitempty"`
@@ -2336,139 +3021,152 @@ type ProfileEntryWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "linkedin_urn" field predicates.
-	LinkedinUrn             *string  `json:"linkedinUrn,omitempty"`
-	LinkedinUrnNEQ          *string  `json:"linkedinUrnNEQ,omitempty"`
-	LinkedinUrnIn           []string `json:"linkedinUrnIn,omitempty"`
-	LinkedinUrnNotIn        []string `json:"linkedinUrnNotIn,omitempty"`
-	LinkedinUrnGT           *string  `json:"linkedinUrnGT,omitempty"`
-	LinkedinUrnGTE          *string  `json:"linkedinUrnGTE,omitempty"`
-	LinkedinUrnLT           *string  `json:"linkedinUrnLT,omitempty"`
-	LinkedinUrnLTE          *string  `json:"linkedinUrnLTE,omitempty"`
-	LinkedinUrnContains     *string  `json:"linkedinUrnContains,omitempty"`
-	LinkedinUrnHasPrefix    *string  `json:"linkedinUrnHasPrefix,omitempty"`
-	LinkedinUrnHasSuffix    *string  `json:"linkedinUrnHasSuffix,omitempty"`
-	LinkedinUrnEqualFold    *string  `json:"linkedinUrnEqualFold,omitempty"`
-	LinkedinUrnContainsFold *string  `json:"linkedinUrnContainsFold,omitempty"`
-
-	// "gender" field predicates.
-	Gender             *string  `json:"gender,omitempty"`
-	GenderNEQ          *string  `json:"genderNEQ,omitempty"`
-	GenderIn           []string `json:"genderIn,omitempty"`
-	GenderNotIn        []string `json:"genderNotIn,omitempty"`
-	GenderGT           *string  `json:"genderGT,omitempty"`
-	GenderGTE          *string  `json:"genderGTE,omitempty"`
-	GenderLT           *string  `json:"genderLT,omitempty"`
-	GenderLTE          *string  `json:"genderLTE,omitempty"`
-	GenderContains     *string  `json:"genderContains,omitempty"`
-	GenderHasPrefix    *string  `json:"genderHasPrefix,omitempty"`
-	GenderHasSuffix    *string  `json:"genderHasSuffix,omitempty"`
-	GenderIsNil        bool     `json:"genderIsNil,omitempty"`
-	GenderNotNil       bool     `json:"genderNotNil,omitempty"`
-	GenderEqualFold    *string  `json:"genderEqualFold,omitempty"`
-	GenderContainsFold *string  `json:"genderContainsFold,omitempty"`
+	// "job_name" field predicates.
+	JobName             *string  `json:"jobName,omitempty"`
+	JobNameNEQ          *string  `json:"jobNameNEQ,omitempty"`
+	JobNameIn           []string `json:"jobNameIn,omitempty"`
+	JobNameNotIn        []string `json:"jobNameNotIn,omitempty"`
+	JobNameGT           *string  `json:"jobNameGT,omitempty"`
+	JobNameGTE          *string  `json:"jobNameGTE,omitempty"`
+	JobNameLT           *string  `json:"jobNameLT,omitempty"`
+	JobNameLTE          *string  `json:"jobNameLTE,omitempty"`
+	JobNameContains     *string  `json:"jobNameContains,omitempty"`
+	JobNameHasPrefix    *string  `json:"jobNameHasPrefix,omitempty"`
+	JobNameHasSuffix    *string  `json:"jobNameHasSuffix,omitempty"`
+	JobNameEqualFold    *string  `json:"jobNameEqualFold,omitempty"`
+	JobNameContainsFold *string  `json:"jobNameContainsFold,omitempty"`
 
 	// "status" field predicates.
-	Status      *profileentry.Status  `json:"status,omitempty"`
-	StatusNEQ   *profileentry.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []profileentry.Status `json:"statusIn,omitempty"`
-	StatusNotIn []profileentry.Status `json:"statusNotIn,omitempty"`
+	Status      *jobexecutionhistory.Status  `json:"status,omitempty"`
+	StatusNEQ   *jobexecutionhistory.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []jobexecutionhistory.Status `json:"statusIn,omitempty"`
+	StatusNotIn []jobexecutionhistory.Status `json:"statusNotIn,omitempty"`
 
-	// "template_json_s3_key" field predicates.
-	TemplateJSONS3Key             *string  `json:"templateJSONS3Key,omitempty"`
-	TemplateJSONS3KeyNEQ          *string  `json:"templateJSONS3KeyNEQ,omitempty"`
-	TemplateJSONS3KeyIn           []string `json:"templateJSONS3KeyIn,omitempty"`
-	TemplateJSONS3KeyNotIn        []string `json:"templateJSONS3KeyNotIn,omitempty"`
-	TemplateJSONS3KeyGT           *string  `json:"templateJSONS3KeyGT,omitempty"`
-	TemplateJSONS3KeyGTE          *string  `json:"templateJSONS3KeyGTE,omitempty"`
-	TemplateJSONS3KeyLT           *string  `json:"templateJSONS3KeyLT,omitempty"`
-	TemplateJSONS3KeyLTE          *string  `json:"templateJSONS3KeyLTE,omitempty"`
-	TemplateJSONS3KeyContains     *string  `json:"templateJSONS3KeyContains,omitempty"`
-	TemplateJSONS3KeyHasPrefix    *string  `json:"templateJSONS3KeyHasPrefix,omitempty"`
-	TemplateJSONS3KeyHasSuffix    *string  `json:"templateJSONS3KeyHasSuffix,omitempty"`
-	TemplateJSONS3KeyIsNil        bool     `json:"templateJSONS3KeyIsNil,omitempty"`
-	TemplateJSONS3KeyNotNil       bool     `json:"templateJSONS3KeyNotNil,omitempty"`
-	TemplateJSONS3KeyEqualFold    *string  `json:"templateJSONS3KeyEqualFold,omitempty"`
-	TemplateJSONS3KeyContainsFold *string  `json:"templateJSONS3KeyContainsFold,omitempty"`
+	// "started_at" field predicates.
+	StartedAt      *time.Time  `json:"startedAt,omitempty"`
+	StartedAtNEQ   *time.Time  `json:"startedAtNEQ,omitempty"`
+	StartedAtIn    []time.Time `json:"startedAtIn,omitempty"`
+	StartedAtNotIn []time.Time `json:"startedAtNotIn,omitempty"`
+	StartedAtGT    *time.Time  `json:"startedAtGT,omitempty"`
+	StartedAtGTE   *time.Time  `json:"startedAtGTE,omitempty"`
+	StartedAtLT    *time.Time  `json:"startedAtLT,omitempty"`
+	StartedAtLTE   *time.Time  `json:"startedAtLTE,omitempty"`
 
-	// "raw_response_s3_key" field predicates.
-	RawResponseS3Key             *string  `json:"rawResponseS3Key,omitempty"`
-	RawResponseS3KeyNEQ          *string  `json:"rawResponseS3KeyNEQ,omitempty"`
-	RawResponseS3KeyIn           []string `json:"rawResponseS3KeyIn,omitempty"`
-	RawResponseS3KeyNotIn        []string `json:"rawResponseS3KeyNotIn,omitempty"`
-	RawResponseS3KeyGT           *string  `json:"rawResponseS3KeyGT,omitempty"`
-	RawResponseS3KeyGTE          *string  `json:"rawResponseS3KeyGTE,omitempty"`
-	RawResponseS3KeyLT           *string  `json:"rawResponseS3KeyLT,omitempty"`
-	RawResponseS3KeyLTE          *string  `json:"rawResponseS3KeyLTE,omitempty"`
-	RawResponseS3KeyContains     *string  `json:"rawResponseS3KeyContains,omitempty"`
-	RawResponseS3KeyHasPrefix    *string  `json:"rawResponseS3KeyHasPrefix,omitempty"`
-	RawResponseS3KeyHasSuffix    *string  `json:"rawResponseS3KeyHasSuffix,omitempty"`
-	RawResponseS3KeyIsNil        bool     `json:"rawResponseS3KeyIsNil,omitempty"`
-	RawResponseS3KeyNotNil       bool     `json:"rawResponseS3KeyNotNil,omitempty"`
-	RawResponseS3KeyEqualFold    *string  `json:"rawResponseS3KeyEqualFold,omitempty"`
-	RawResponseS3KeyContainsFold *string  `json:"rawResponseS3KeyContainsFold,omitempty"`
+	// "completed_at" field predicates.
+	CompletedAt       *time.Time  `json:"completedAt,omitempty"`
+	CompletedAtNEQ    *time.Time  `json:"completedAtNEQ,omitempty"`
+	CompletedAtIn     []time.Time `json:"completedAtIn,omitempty"`
+	CompletedAtNotIn  []time.Time `json:"completedAtNotIn,omitempty"`
+	CompletedAtGT     *time.Time  `json:"completedAtGT,omitempty"`
+	CompletedAtGTE    *time.Time  `json:"completedAtGTE,omitempty"`
+	CompletedAtLT     *time.Time  `json:"completedAtLT,omitempty"`
+	CompletedAtLTE    *time.Time  `json:"completedAtLTE,omitempty"`
+	CompletedAtIsNil  bool        `json:"completedAtIsNil,omitempty"`
+	CompletedAtNotNil bool        `json:"completedAtNotNil,omitempty"`
 
-	// "fetch_count" field predicates.
-	FetchCount      *int  `json:"fetchCount,omitempty"`
-	FetchCountNEQ   *int  `json:"fetchCountNEQ,omitempty"`
-	FetchCountIn    []int `json:"fetchCountIn,omitempty"`
-	FetchCountNotIn []int `json:"fetchCountNotIn,omitempty"`
-	FetchCountGT    *int  `json:"fetchCountGT,omitempty"`
-	FetchCountGTE   *int  `json:"fetchCountGTE,omitempty"`
-	FetchCountLT    *int  `json:"fetchCountLT,omitempty"`
-	FetchCountLTE   *int  `json:"fetchCountLTE,omitempty"`
+	// "duration_seconds" field predicates.
+	DurationSeconds      *int  `json:"durationSeconds,omitempty"`
+	DurationSecondsNEQ   *int  `json:"durationSecondsNEQ,omitempty"`
+	DurationSecondsIn    []int `json:"durationSecondsIn,omitempty"`
+	DurationSecondsNotIn []int `json:"durationSecondsNotIn,omitempty"`
+	DurationSecondsGT    *int  `json:"durationSecondsGT,omitempty"`
+	DurationSecondsGTE   *int  `json:"durationSecondsGTE,omitempty"`
+	DurationSecondsLT    *int  `json:"durationSecondsLT,omitempty"`
+	DurationSecondsLTE   *int  `json:"durationSecondsLTE,omitempty"`
 
-	// "last_fetched_at" field predicates.
-	LastFetchedAt       *time.Time  `json:"lastFetchedAt,omitempty"`
-	LastFetchedAtNEQ    *time.Time  `json:"lastFetchedAtNEQ,omitempty"`
-	LastFetchedAtIn     []time.Time `json:"lastFetchedAtIn,omitempty"`
-	LastFetchedAtNotIn  []time.Time `json:"lastFetchedAtNotIn,omitempty"`
-	LastFetchedAtGT     *time.Time  `json:"lastFetchedAtGT,omitempty"`
-	LastFetchedAtGTE    *time.Time  `json:"lastFetchedAtGTE,omitempty"`
-	LastFetchedAtLT     *time.Time  `json:"lastFetchedAtLT,omitempty"`
-	LastFetchedAtLTE    *time.Time  `json:"lastFetchedAtLTE,omitempty"`
-	LastFetchedAtIsNil  bool        `json:"lastFetchedAtIsNil,omitempty"`
-	LastFetchedAtNotNil bool        `json:"lastFetchedAtNotNil,omitempty"`
+	// "total_processed" field predicates.
+	TotalProcessed      *int  `json:"totalProcessed,omitempty"`
+	TotalProcessedNEQ   *int  `json:"totalProcessedNEQ,omitempty"`
+	TotalProcessedIn    []int `json:"totalProcessedIn,omitempty"`
+	TotalProcessedNotIn []int `json:"totalProcessedNotIn,omitempty"`
+	TotalProcessedGT    *int  `json:"totalProcessedGT,omitempty"`
+	TotalProcessedGTE   *int  `json:"totalProcessedGTE,omitempty"`
+	TotalProcessedLT    *int  `json:"totalProcessedLT,omitempty"`
+	TotalProcessedLTE   *int  `json:"totalProcessedLTE,omitempty"`
 
-	// "error_message" field predicates.
-	ErrorMessage             *string  `json:"errorMessage,omitempty"`
-	ErrorMessageNEQ          *string  `json:"errorMessageNEQ,omitempty"`
-	ErrorMessageIn           []string `json:"errorMessageIn,omitempty"`
-	ErrorMessageNotIn        []string `json:"errorMessageNotIn,omitempty"`
-	ErrorMessageGT           *string  `json:"errorMessageGT,omitempty"`
-	ErrorMessageGTE          *string  `json:"errorMessageGTE,omitempty"`
-	ErrorMessageLT           *string  `json:"errorMessageLT,omitempty"`
-	ErrorMessageLTE          *string  `json:"errorMessageLTE,omitempty"`
-	ErrorMessageContains     *string  `json:"errorMessageContains,omitempty"`
-	ErrorMessageHasPrefix    *string  `json:"errorMessageHasPrefix,omitempty"`
-	ErrorMessageHasSuffix    *string  `json:"errorMessageHasSuffix,omitempty"`
-	ErrorMessageIsNil        bool     `json:"errorMessageIsNil,omitempty"`
-	ErrorMessageNotNil       bool     `json:"errorMessageNotNil,omitempty"`
-	ErrorMessageEqualFold    *string  `json:"errorMessageEqualFold,omitempty"`
-	ErrorMessageContainsFold *string  `json:"errorMessageContainsFold,omitempty"`
+	// "successful_count" field predicates.
+	SuccessfulCount      *int  `json:"successfulCount,omitempty"`
+	SuccessfulCountNEQ   *int  `json:"successfulCountNEQ,omitempty"`
+	SuccessfulCountIn    []int `json:"successfulCountIn,omitempty"`
+	SuccessfulCountNotIn []int `json:"successfulCountNotIn,omitempty"`
+	SuccessfulCountGT    *int  `json:"successfulCountGT,omitempty"`
+	SuccessfulCountGTE   *int  `json:"successfulCountGTE,omitempty"`
+	SuccessfulCountLT    *int  `json:"successfulCountLT,omitempty"`
+	SuccessfulCountLTE   *int  `json:"successfulCountLTE,omitempty"`
 
-	// "profile" edge predicates.
-	HasProfile     *bool                `json:"hasProfile,omitempty"`
-	HasProfileWith []*ProfileWhereInput `json:"hasProfileWith,omitempty"`
+	// "failed_count" field predicates.
+	FailedCount      *int  `json:"failedCount,omitempty"`
+	FailedCountNEQ   *int  `json:"failedCountNEQ,omitempty"`
+	FailedCountIn    []int `json:"failedCountIn,omitempty"`
+	FailedCountNotIn []int `json:"failedCountNotIn,omitempty"`
+	FailedCountGT    *int  `json:"failedCountGT,omitempty"`
+	FailedCountGTE   *int  `json:"failedCountGTE,omitempty"`
+	FailedCountLT    *int  `json:"failedCountLT,omitempty"`
+	FailedCountLTE   *int  `json:"failedCountLTE,omitempty"`
 
-	// "job_executions" edge predicates.
-	HasJobExecutions     *bool                            `json:"hasJobExecutions,omitempty"`
-	HasJobExecutionsWith []*JobExecutionHistoryWhereInput `json:"hasJobExecutionsWith,omitempty"`
+	// "api_calls_made" field predicates.
+	APICallsMade      *int  `json:"apiCallsMade,omitempty"`
+	APICallsMadeNEQ   *int  `json:"apiCallsMadeNEQ,omitempty"`
+	APICallsMadeIn    []int `json:"apiCallsMadeIn,omitempty"`
+	APICallsMadeNotIn []int `json:"apiCallsMadeNotIn,omitempty"`
+	APICallsMadeGT    *int  `json:"apiCallsMadeGT,omitempty"`
+	APICallsMadeGTE   *int  `json:"apiCallsMadeGTE,omitempty"`
+	APICallsMadeLT    *int  `json:"apiCallsMadeLT,omitempty"`
+	APICallsMadeLTE   *int  `json:"apiCallsMadeLTE,omitempty"`
+
+	// "quota_remaining" field predicates.
+	QuotaRemaining      *int  `json:"quotaRemaining,omitempty"`
+	QuotaRemainingNEQ   *int  `json:"quotaRemainingNEQ,omitempty"`
+	QuotaRemainingIn    []int `json:"quotaRemainingIn,omitempty"`
+	QuotaRemainingNotIn []int `json:"quotaRemainingNotIn,omitempty"`
+	QuotaRemainingGT    *int  `json:"quotaRemainingGT,omitempty"`
+	QuotaRemainingGTE   *int  `json:"quotaRemainingGTE,omitempty"`
+	QuotaRemainingLT    *int  `json:"quotaRemainingLT,omitempty"`
+	QuotaRemainingLTE   *int  `json:"quotaRemainingLTE,omitempty"`
+
+	// "error_summary" field predicates.
+	ErrorSummary             *string  `json:"errorSummary,omitempty"`
+	ErrorSummaryNEQ          *string  `json:"errorSummaryNEQ,omitempty"`
+	ErrorSummaryIn           []string `json:"errorSummaryIn,omitempty"`
+	ErrorSummaryNotIn        []string `json:"errorSummaryNotIn,omitempty"`
+	ErrorSummaryGT           *string  `json:"errorSummaryGT,omitempty"`
+	ErrorSummaryGTE          *string  `json:"errorSummaryGTE,omitempty"`
+	ErrorSummaryLT           *string  `json:"errorSummaryLT,omitempty"`
+	ErrorSummaryLTE          *string  `json:"errorSummaryLTE,omitempty"`
+	ErrorSummaryContains     *string  `json:"errorSummaryContains,omitempty"`
+	ErrorSummaryHasPrefix    *string  `json:"errorSummaryHasPrefix,omitempty"`
+	ErrorSummaryHasSuffix    *string  `json:"errorSummaryHasSuffix,omitempty"`
+	ErrorSummaryIsNil        bool     `json:"errorSummaryIsNil,omitempty"`
+	ErrorSummaryNotNil       bool     `json:"errorSummaryNotNil,omitempty"`
+	ErrorSummaryEqualFold    *string  `json:"errorSummaryEqualFold,omitempty"`
+	ErrorSummaryContainsFold *string  `json:"errorSummaryContainsFold,omitempty"`
+
+	// "schema_drift_detected" field predicates.
+	SchemaDriftDetected    *bool `json:"schemaDriftDetected,omitempty"`
+	SchemaDriftDetectedNEQ *bool `json:"schemaDriftDetectedNEQ,omitempty"`
+
+	// "profile_entries" edge predicates.
+	HasProfileEntries     *bool                     `json:"hasProfileEntries,omitempty"`
+	HasProfileEntriesWith []*ProfileEntryWhereInput `json:"hasProfileEntriesWith,omitempty"`
+
+	// "api_calls" edge predicates.
+	HasAPICalls     *bool                   `json:"hasAPICalls,omitempty"`
+	HasAPICallsWith []*APICallLogWhereInput `json:"hasAPICallsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ProfileEntryWhereInput) AddPredicates(predicates ...predicate.ProfileEntry) {
+func (i *JobExecutionHistoryWhereInput) AddPredicates(predicates ...predicate.JobExecutionHistory) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ProfileEntryWhereInput filter on the ProfileEntryQuery builder.
-func (i *ProfileEntryWhereInput) Filter(q *ProfileEntryQuery) (*ProfileEntryQuery, error) {
+// Filter applies the JobExecutionHistoryWhereInput filter on the JobExecutionHistoryQuery builder.
+func (i *JobExecutionHistoryWhereInput) Filter(q *JobExecutionHistoryQuery) (*JobExecutionHistoryQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyProfileEntryWhereInput {
+		if err == ErrEmptyJobExecutionHistoryWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -2476,19 +3174,19 @@ func (i *ProfileEntryWhereInput) Filter(q *ProfileEntryQuery) (*ProfileEntryQuer
 	return q.Where(p), nil
 }
 
-// ErrEmptyProfileEntryWhereInput is returned in case the ProfileEntryWhereInput is empty.
-var ErrEmptyProfileEntryWhereInput = errors.New("ent: empty predicate ProfileEntryWhereInput")
+// ErrEmptyJobExecutionHistoryWhereInput is returned in case the JobExecutionHistoryWhereInput is empty.
+var ErrEmptyJobExecutionHistoryWhereInput = errors.New("ent: empty predicate JobExecutionHistoryWhereInput")
 
-// P returns a predicate for filtering profileentries.
+// P returns a predicate for filtering jobexecutionhistories.
 // An error is returned if the input is empty or invalid.
-func (i *ProfileEntryWhereInput) P() (predicate.ProfileEntry, error) {
-	var predicates []predicate.ProfileEntry
+func (i *JobExecutionHistoryWhereInput) P() (predicate.JobExecutionHistory, error) {
+	var predicates []predicate.JobExecutionHistory
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, profileentry.Not(p))
+		predicates = append(predicates, jobexecutionhistory.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -2498,7 +3196,7 @@ func (i *ProfileEntryWhereInput) P() (predicate.ProfileEntry, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.ProfileEntry, 0, n)
+		or := make([]predicate.JobExecutionHistory, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -2506,7 +3204,7 @@ func (i *ProfileEntryWhereInput) P() (predicate.ProfileEntry, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, profileentry.Or(or...))
+		predicates = append(predicates, jobexecutionhistory.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -2516,7 +3214,7 @@ func (i *ProfileEntryWhereInput) P() (predicate.ProfileEntry, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.ProfileEntry, 0, n)
+		and := make([]predicate.JobExecutionHistory, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2524,395 +3222,410 @@ func (i *ProfileEntryWhereInput) P() (predicate.ProfileEntry, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, profileentry.And(and...))
+		predicates = append(predicates, jobexecutionhistory.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, profileentry.IDEQ(*i.ID))
+		predicates = append(predicates, jobexecutionhistory.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, profileentry.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, jobexecutionhistory.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, profileentry.IDIn(i.IDIn...))
+		predicates = append(predicates, jobexecutionhistory.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, profileentry.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, jobexecutionhistory.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, profileentry.IDGT(*i.IDGT))
+		predicates = append(predicates, jobexecutionhistory.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, profileentry.IDGTE(*i.IDGTE))
+		predicates = append(predicates, jobexecutionhistory.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, profileentry.IDLT(*i.IDLT))
+		predicates = append(predicates, jobexecutionhistory.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, profileentry.IDLTE(*i.IDLTE))
+		predicates = append(predicates, jobexecutionhistory.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, profileentry.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, jobexecutionhistory.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, profileentry.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, jobexecutionhistory.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, profileentry.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, jobexecutionhistory.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, profileentry.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, jobexecutionhistory.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, profileentry.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, jobexecutionhistory.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, profileentry.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, jobexecutionhistory.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, profileentry.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, jobexecutionhistory.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, profileentry.CreatedAtLTE(*i.CreatedAtLTE))
-	}
-	if i.LinkedinUrn != nil {
-		predicates = append(predicates, profileentry.LinkedinUrnEQ(*i.LinkedinUrn))
+		predicates = append(predicates, jobexecutionhistory.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.LinkedinUrnNEQ != nil {
-		predicates = append(predicates, profileentry.LinkedinUrnNEQ(*i.LinkedinUrnNEQ))
+	if i.JobName != nil {
+		predicates = append(predicates, jobexecutionhistory.JobNameEQ(*i.JobName))
 	}
-	if len(i.LinkedinUrnIn) > 0 {
-		predicates = append(predicates, profileentry.LinkedinUrnIn(i.LinkedinUrnIn...))
+	if i.JobNameNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.JobNameNEQ(*i.JobNameNEQ))
 	}
-	if len(i.LinkedinUrnNotIn) > 0 {
-		predicates = append(predicates, profileentry.LinkedinUrnNotIn(i.LinkedinUrnNotIn...))
+	if len(i.JobNameIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.JobNameIn(i.JobNameIn...))
 	}
-	if i.LinkedinUrnGT != nil {
-		predicates = append(predicates, profileentry.LinkedinUrnGT(*i.LinkedinUrnGT))
+	if len(i.JobNameNotIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.JobNameNotIn(i.JobNameNotIn...))
 	}
-	if i.LinkedinUrnGTE != nil {
-		predicates = append(predicates, profileentry.LinkedinUrnGTE(*i.LinkedinUrnGTE))
+	if i.JobNameGT != nil {
+		predicates = append(predicates, jobexecutionhistory.JobNameGT(*i.JobNameGT))
 	}
-	if i.LinkedinUrnLT != nil {
-		predicates = append(predicates, profileentry.LinkedinUrnLT(*i.LinkedinUrnLT))
+	if i.JobNameGTE != nil {
+		predicates = append(predicates, jobexecutionhistory.JobNameGTE(*i.JobNameGTE))
 	}
-	if i.LinkedinUrnLTE != nil {
-		predicates = append(predicates, profileentry.LinkedinUrnLTE(*i.LinkedinUrnLTE))
+	if i.JobNameLT != nil {
+		predicates = append(predicates, jobexecutionhistory.JobNameLT(*i.JobNameLT))
 	}
-	if i.LinkedinUrnContains != nil {
-		predicates = append(predicates, profileentry.LinkedinUrnContains(*i.LinkedinUrnContains))
+	if i.JobNameLTE != nil {
+		predicates = append(predicates, jobexecutionhistory.JobNameLTE(*i.JobNameLTE))
 	}
-	if i.LinkedinUrnHasPrefix != nil {
-		predicates = append(predicates, profileentry.LinkedinUrnHasPrefix(*i.LinkedinUrnHasPrefix))
+	if i.JobNameContains != nil {
+		predicates = append(predicates, jobexecutionhistory.JobNameContains(*i.JobNameContains))
 	}
-	if i.LinkedinUrnHasSuffix != nil {
-		predicates = append(predicates, profileentry.LinkedinUrnHasSuffix(*i.LinkedinUrnHasSuffix))
+	if i.JobNameHasPrefix != nil {
+		predicates = append(predicates, jobexecutionhistory.JobNameHasPrefix(*i.JobNameHasPrefix))
 	}
-	if i.LinkedinUrnEqualFold != nil {
-		predicates = append(predicates, profileentry.LinkedinUrnEqualFold(*i.LinkedinUrnEqualFold))
+	if i.JobNameHasSuffix != nil {
+		predicates = append(predicates, jobexecutionhistory.JobNameHasSuffix(*i.JobNameHasSuffix))
 	}
-	if i.LinkedinUrnContainsFold != nil {
-		predicates = append(predicates, profileentry.LinkedinUrnContainsFold(*i.LinkedinUrnContainsFold))
+	if i.JobNameEqualFold != nil {
+		predicates = append(predicates, jobexecutionhistory.JobNameEqualFold(*i.JobNameEqualFold))
 	}
-	if i.Gender != nil {
-		predicates = append(predicates, profileentry.GenderEQ(*i.Gender))
+	if i.JobNameContainsFold != nil {
+		predicates = append(predicates, jobexecutionhistory.JobNameContainsFold(*i.JobNameContainsFold))
 	}
-	if i.GenderNEQ != nil {
-		predicates = append(predicates, profileentry.GenderNEQ(*i.GenderNEQ))
+	if i.Status != nil {
+		predicates = append(predicates, jobexecutionhistory.StatusEQ(*i.Status))
 	}
-	if len(i.GenderIn) > 0 {
-		predicates = append(predicates, profileentry.GenderIn(i.GenderIn...))
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.StatusNEQ(*i.StatusNEQ))
 	}
-	if len(i.GenderNotIn) > 0 {
-		predicates = append(predicates, profileentry.GenderNotIn(i.GenderNotIn...))
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.StatusIn(i.StatusIn...))
 	}
-	if i.GenderGT != nil {
-		predicates = append(predicates, profileentry.GenderGT(*i.GenderGT))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.StatusNotIn(i.StatusNotIn...))
 	}
-	if i.GenderGTE != nil {
-		predicates = append(predicates, profileentry.GenderGTE(*i.GenderGTE))
+	if i.StartedAt != nil {
+		predicates = append(predicates, jobexecutionhistory.StartedAtEQ(*i.StartedAt))
 	}
-	if i.GenderLT != nil {
-		predicates = append(predicates, profileentry.GenderLT(*i.GenderLT))
+	if i.StartedAtNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.StartedAtNEQ(*i.StartedAtNEQ))
 	}
-	if i.GenderLTE != nil {
-		predicates = append(predicates, profileentry.GenderLTE(*i.GenderLTE))
+	if len(i.StartedAtIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.StartedAtIn(i.StartedAtIn...))
 	}
-	if i.GenderContains != nil {
-		predicates = append(predicates, profileentry.GenderContains(*i.GenderContains))
+	if len(i.StartedAtNotIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.StartedAtNotIn(i.StartedAtNotIn...))
 	}
-	if i.GenderHasPrefix != nil {
-		predicates = append(predicates, profileentry.GenderHasPrefix(*i.GenderHasPrefix))
+	if i.StartedAtGT != nil {
+		predicates = append(predicates, jobexecutionhistory.StartedAtGT(*i.StartedAtGT))
 	}
-	if i.GenderHasSuffix != nil {
-		predicates = append(predicates, profileentry.GenderHasSuffix(*i.GenderHasSuffix))
+	if i.StartedAtGTE != nil {
+		predicates = append(predicates, jobexecutionhistory.StartedAtGTE(*i.StartedAtGTE))
 	}
-	if i.GenderIsNil {
-		predicates = append(predicates, profileentry.GenderIsNil())
+	if i.StartedAtLT != nil {
+		predicates = append(predicates, jobexecutionhistory.StartedAtLT(*i.StartedAtLT))
 	}
-	if i.GenderNotNil {
-		predicates = append(predicates, profileentry.GenderNotNil())
+	if i.StartedAtLTE != nil {
+		predicates = append(predicates, jobexecutionhistory.StartedAtLTE(*i.StartedAtLTE))
 	}
-	if i.GenderEqualFold != nil {
-		predicates = append(predicates, profileentry.GenderEqualFold(*i.GenderEqualFold))
+	if i.CompletedAt != nil {
+		predicates = append(predicates, jobexecutionhistory.CompletedAtEQ(*i.CompletedAt))
 	}
-	if i.GenderContainsFold != nil {
-		predicates = append(predicates, profileentry.GenderContainsFold(*i.GenderContainsFold))
+	if i.CompletedAtNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.CompletedAtNEQ(*i.CompletedAtNEQ))
 	}
-	if i.Status != nil {
-		predicates = append(predicates, profileentry.StatusEQ(*i.Status))
+	if len(i.CompletedAtIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.CompletedAtIn(i.CompletedAtIn...))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, profileentry.StatusNEQ(*i.StatusNEQ))
+	if len(i.CompletedAtNotIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.CompletedAtNotIn(i.CompletedAtNotIn...))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, profileentry.StatusIn(i.StatusIn...))
+	if i.CompletedAtGT != nil {
+		predicates = append(predicates, jobexecutionhistory.CompletedAtGT(*i.CompletedAtGT))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, profileentry.StatusNotIn(i.StatusNotIn...))
+	if i.CompletedAtGTE != nil {
+		predicates = append(predicates, jobexecutionhistory.CompletedAtGTE(*i.CompletedAtGTE))
 	}
-	if i.TemplateJSONS3Key != nil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyEQ(*i.TemplateJSONS3Key))
+	if i.CompletedAtLT != nil {
+		predicates = append(predicates, jobexecutionhistory.CompletedAtLT(*i.CompletedAtLT))
 	}
-	if i.TemplateJSONS3KeyNEQ != nil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyNEQ(*i.TemplateJSONS3KeyNEQ))
+	if i.CompletedAtLTE != nil {
+		predicates = append(predicates, jobexecutionhistory.CompletedAtLTE(*i.CompletedAtLTE))
 	}
-	if len(i.TemplateJSONS3KeyIn) > 0 {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyIn(i.TemplateJSONS3KeyIn...))
+	if i.CompletedAtIsNil {
+		predicates = append(predicates, jobexecutionhistory.CompletedAtIsNil())
 	}
-	if len(i.TemplateJSONS3KeyNotIn) > 0 {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyNotIn(i.TemplateJSONS3KeyNotIn...))
+	if i.CompletedAtNotNil {
+		predicates = append(predicates, jobexecutionhistory.CompletedAtNotNil())
 	}
-	if i.TemplateJSONS3KeyGT != nil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyGT(*i.TemplateJSONS3KeyGT))
+	if i.DurationSeconds != nil {
+		predicates = append(predicates, jobexecutionhistory.DurationSecondsEQ(*i.DurationSeconds))
 	}
-	if i.TemplateJSONS3KeyGTE != nil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyGTE(*i.TemplateJSONS3KeyGTE))
+	if i.DurationSecondsNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.DurationSecondsNEQ(*i.DurationSecondsNEQ))
 	}
-	if i.TemplateJSONS3KeyLT != nil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyLT(*i.TemplateJSONS3KeyLT))
+	if len(i.DurationSecondsIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.DurationSecondsIn(i.DurationSecondsIn...))
 	}
-	if i.TemplateJSONS3KeyLTE != nil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyLTE(*i.TemplateJSONS3KeyLTE))
+	if len(i.DurationSecondsNotIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.DurationSecondsNotIn(i.DurationSecondsNotIn...))
 	}
-	if i.TemplateJSONS3KeyContains != nil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyContains(*i.TemplateJSONS3KeyContains))
+	if i.DurationSecondsGT != nil {
+		predicates = append(predicates, jobexecutionhistory.DurationSecondsGT(*i.DurationSecondsGT))
 	}
-	if i.TemplateJSONS3KeyHasPrefix != nil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyHasPrefix(*i.TemplateJSONS3KeyHasPrefix))
+	if i.DurationSecondsGTE != nil {
+		predicates = append(predicates, jobexecutionhistory.DurationSecondsGTE(*i.DurationSecondsGTE))
 	}
-	if i.TemplateJSONS3KeyHasSuffix != nil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyHasSuffix(*i.TemplateJSONS3KeyHasSuffix))
+	if i.DurationSecondsLT != nil {
+		predicates = append(predicates, jobexecutionhistory.DurationSecondsLT(*i.DurationSecondsLT))
 	}
-	if i.TemplateJSONS3KeyIsNil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyIsNil())
+	if i.DurationSecondsLTE != nil {
+		predicates = append(predicates, jobexecutionhistory.DurationSecondsLTE(*i.DurationSecondsLTE))
 	}
-	if i.TemplateJSONS3KeyNotNil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyNotNil())
+	if i.TotalProcessed != nil {
+		predicates = append(predicates, jobexecutionhistory.TotalProcessedEQ(*i.TotalProcessed))
 	}
-	if i.TemplateJSONS3KeyEqualFold != nil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyEqualFold(*i.TemplateJSONS3KeyEqualFold))
+	if i.TotalProcessedNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.TotalProcessedNEQ(*i.TotalProcessedNEQ))
 	}
-	if i.TemplateJSONS3KeyContainsFold != nil {
-		predicates = append(predicates, profileentry.TemplateJSONS3KeyContainsFold(*i.TemplateJSONS3KeyContainsFold))
+	if len(i.TotalProcessedIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.TotalProcessedIn(i.TotalProcessedIn...))
 	}
-	if i.RawResponseS3Key != nil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyEQ(*i.RawResponseS3Key))
+	if len(i.TotalProcessedNotIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.TotalProcessedNotIn(i.TotalProcessedNotIn...))
 	}
-	if i.RawResponseS3KeyNEQ != nil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyNEQ(*i.RawResponseS3KeyNEQ))
+	if i.TotalProcessedGT != nil {
+		predicates = append(predicates, jobexecutionhistory.TotalProcessedGT(*i.TotalProcessedGT))
 	}
-	if len(i.RawResponseS3KeyIn) > 0 {
-		predicates = append(predicates, profileentry.RawResponseS3KeyIn(i.RawResponseS3KeyIn...))
+	if i.TotalProcessedGTE != nil {
+		predicates = append(predicates, jobexecutionhistory.TotalProcessedGTE(*i.TotalProcessedGTE))
 	}
-	if len(i.RawResponseS3KeyNotIn) > 0 {
-		predicates = append(predicates, profileentry.RawResponseS3KeyNotIn(i.RawResponseS3KeyNotIn...))
+	if i.TotalProcessedLT != nil {
+		predicates = append(predicates, jobexecutionhistory.TotalProcessedLT(*i.TotalProcessedLT))
 	}
-	if i.RawResponseS3KeyGT != nil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyGT(*i.RawResponseS3KeyGT))
+	if i.TotalProcessedLTE != nil {
+		predicates = append(predicates, jobexecutionhistory.TotalProcessedLTE(*i.TotalProcessedLTE))
 	}
-	if i.RawResponseS3KeyGTE != nil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyGTE(*i.RawResponseS3KeyGTE))
+	if i.SuccessfulCount != nil {
+		predicates = append(predicates, jobexecutionhistory.SuccessfulCountEQ(*i.SuccessfulCount))
 	}
-	if i.RawResponseS3KeyLT != nil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyLT(*i.RawResponseS3KeyLT))
+	if i.SuccessfulCountNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.SuccessfulCountNEQ(*i.SuccessfulCountNEQ))
 	}
-	if i.RawResponseS3KeyLTE != nil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyLTE(*i.RawResponseS3KeyLTE))
+	if len(i.SuccessfulCountIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.SuccessfulCountIn(i.SuccessfulCountIn...))
 	}
-	if i.RawResponseS3KeyContains != nil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyContains(*i.RawResponseS3KeyContains))
+	if len(i.SuccessfulCountNotIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.SuccessfulCountNotIn(i.SuccessfulCountNotIn...))
 	}
-	if i.RawResponseS3KeyHasPrefix != nil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyHasPrefix(*i.RawResponseS3KeyHasPrefix))
+	if i.SuccessfulCountGT != nil {
+		predicates = append(predicates, jobexecutionhistory.SuccessfulCountGT(*i.SuccessfulCountGT))
 	}
-	if i.RawResponseS3KeyHasSuffix != nil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyHasSuffix(*i.RawResponseS3KeyHasSuffix))
+	if i.SuccessfulCountGTE != nil {
+		predicates = append(predicates, jobexecutionhistory.SuccessfulCountGTE(*i.SuccessfulCountGTE))
 	}
-	if i.RawResponseS3KeyIsNil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyIsNil())
+	if i.SuccessfulCountLT != nil {
+		predicates = append(predicates, jobexecutionhistory.SuccessfulCountLT(*i.SuccessfulCountLT))
 	}
-	if i.RawResponseS3KeyNotNil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyNotNil())
+	if i.SuccessfulCountLTE != nil {
+		predicates = append(predicates, jobexecutionhistory.SuccessfulCountLTE(*i.SuccessfulCountLTE))
 	}
-	if i.RawResponseS3KeyEqualFold != nil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyEqualFold(*i.RawResponseS3KeyEqualFold))
+	if i.FailedCount != nil {
+		predicates = append(predicates, jobexecutionhistory.FailedCountEQ(*i.FailedCount))
 	}
-	if i.RawResponseS3KeyContainsFold != nil {
-		predicates = append(predicates, profileentry.RawResponseS3KeyContainsFold(*i.RawResponseS3KeyContainsFold))
+	if i.FailedCountNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.FailedCountNEQ(*i.FailedCountNEQ))
 	}
-	if i.FetchCount != nil {
-		predicates = append(predicates, profileentry.FetchCountEQ(*i.FetchCount))
+	if len(i.FailedCountIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.FailedCountIn(i.FailedCountIn...))
 	}
-	if i.FetchCountNEQ != nil {
-		predicates = append(predicates, profileentry.FetchCountNEQ(*i.FetchCountNEQ))
+	if len(i.FailedCountNotIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.FailedCountNotIn(i.FailedCountNotIn...))
 	}
-	if len(i.FetchCountIn) > 0 {
-		predicates = append(predicates, profileentry.FetchCountIn(i.FetchCountIn...))
+	if i.FailedCountGT != nil {
+		predicates = append(predicates, jobexecutionhistory.FailedCountGT(*i.FailedCountGT))
 	}
-	if len(i.FetchCountNotIn) > 0 {
-		predicates = append(predicates, profileentry.FetchCountNotIn(i.FetchCountNotIn...))
+	if i.FailedCountGTE != nil {
+		predicates = append(predicates, jobexecutionhistory.FailedCountGTE(*i.FailedCountGTE))
 	}
-	if i.FetchCountGT != nil {
-		predicates = append(predicates, profileentry.FetchCountGT(*i.FetchCountGT))
+	if i.FailedCountLT != nil {
+		predicates = append(predicates, jobexecutionhistory.FailedCountLT(*i.FailedCountLT))
 	}
-	if i.FetchCountGTE != nil {
-		predicates = append(predicates, profileentry.FetchCountGTE(*i.FetchCountGTE))
+	if i.FailedCountLTE != nil {
+		predicates = append(predicates, jobexecutionhistory.FailedCountLTE(*i.FailedCountLTE))
 	}
-	if i.FetchCountLT != nil {
-		predicates = append(predicates, profileentry.FetchCountLT(*i.FetchCountLT))
+	if i.APICallsMade != nil {
+		predicates = append(predicates, jobexecutionhistory.APICallsMadeEQ(*i.APICallsMade))
 	}
-	if i.FetchCountLTE != nil {
-		predicates = append(predicates, profileentry.FetchCountLTE(*i.FetchCountLTE))
+	if i.APICallsMadeNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.APICallsMadeNEQ(*i.APICallsMadeNEQ))
 	}
-	if i.LastFetchedAt != nil {
-		predicates = append(predicates, profileentry.LastFetchedAtEQ(*i.LastFetchedAt))
+	if len(i.APICallsMadeIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.APICallsMadeIn(i.APICallsMadeIn...))
 	}
-	if i.LastFetchedAtNEQ != nil {
-		predicates = append(predicates, profileentry.LastFetchedAtNEQ(*i.LastFetchedAtNEQ))
+	if len(i.APICallsMadeNotIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.APICallsMadeNotIn(i.APICallsMadeNotIn...))
 	}
-	if len(i.LastFetchedAtIn) > 0 {
-		predicates = append(predicates, profileentry.LastFetchedAtIn(i.LastFetchedAtIn...))
+	if i.APICallsMadeGT != nil {
+		predicates = append(predicates, jobexecutionhistory.APICallsMadeGT(*i.APICallsMadeGT))
 	}
-	if len(i.LastFetchedAtNotIn) > 0 {
-		predicates = append(predicates, profileentry.LastFetchedAtNotIn(i.LastFetchedAtNotIn...))
+	if i.APICallsMadeGTE != nil {
+		predicates = append(predicates, jobexecutionhistory.APICallsMadeGTE(*i.APICallsMadeGTE))
 	}
-	if i.LastFetchedAtGT != nil {
-		predicates = append(predicates, profileentry.LastFetchedAtGT(*i.LastFetchedAtGT))
+	if i.APICallsMadeLT != nil {
+		predicates = append(predicates, jobexecutionhistory.APICallsMadeLT(*i.APICallsMadeLT))
 	}
-	if i.LastFetchedAtGTE != nil {
-		predicates = append(predicates, profileentry.LastFetchedAtGTE(*i.LastFetchedAtGTE))
+	if i.APICallsMadeLTE != nil {
+		predicates = append(predicates, jobexecutionhistory.APICallsMadeLTE(*i.APICallsMadeLTE))
 	}
-	if i.LastFetchedAtLT != nil {
-		predicates = append(predicates, profileentry.LastFetchedAtLT(*i.LastFetchedAtLT))
+	if i.QuotaRemaining != nil {
+		predicates = append(predicates, jobexecutionhistory.QuotaRemainingEQ(*i.QuotaRemaining))
 	}
-	if i.LastFetchedAtLTE != nil {
-		predicates = append(predicates, profileentry.LastFetchedAtLTE(*i.LastFetchedAtLTE))
+	if i.QuotaRemainingNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.QuotaRemainingNEQ(*i.QuotaRemainingNEQ))
 	}
-	if i.LastFetchedAtIsNil {
-		predicates = append(predicates, profileentry.LastFetchedAtIsNil())
+	if len(i.QuotaRemainingIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.QuotaRemainingIn(i.QuotaRemainingIn...))
 	}
-	if i.LastFetchedAtNotNil {
-		predicates = append(predicates, profileentry.LastFetchedAtNotNil())
+	if len(i.QuotaRemainingNotIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.QuotaRemainingNotIn(i.QuotaRemainingNotIn...))
 	}
-	if i.ErrorMessage != nil {
-		predicates = append(predicates, profileentry.ErrorMessageEQ(*i.ErrorMessage))
+	if i.QuotaRemainingGT != nil {
+		predicates = append(predicates, jobexecutionhistory.QuotaRemainingGT(*i.QuotaRemainingGT))
 	}
-	if i.ErrorMessageNEQ != nil {
-		predicates = append(predicates, profileentry.ErrorMessageNEQ(*i.ErrorMessageNEQ))
+	if i.QuotaRemainingGTE != nil {
+		predicates = append(predicates, jobexecutionhistory.QuotaRemainingGTE(*i.QuotaRemainingGTE))
 	}
-	if len(i.ErrorMessageIn) > 0 {
-		predicates = append(predicates, profileentry.ErrorMessageIn(i.ErrorMessageIn...))
+	if i.QuotaRemainingLT != nil {
+		predicates = append(predicates, jobexecutionhistory.QuotaRemainingLT(*i.QuotaRemainingLT))
 	}
-	if len(i.ErrorMessageNotIn) > 0 {
-		predicates = append(predicates, profileentry.ErrorMessageNotIn(i.ErrorMessageNotIn...))
+	if i.QuotaRemainingLTE != nil {
+		predicates = append(predicates, jobexecutionhistory.QuotaRemainingLTE(*i.QuotaRemainingLTE))
 	}
-	if i.ErrorMessageGT != nil {
-		predicates = append(predicates, profileentry.ErrorMessageGT(*i.ErrorMessageGT))
+	if i.ErrorSummary != nil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryEQ(*i.ErrorSummary))
 	}
-	if i.ErrorMessageGTE != nil {
-		predicates = append(predicates, profileentry.ErrorMessageGTE(*i.ErrorMessageGTE))
+	if i.ErrorSummaryNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryNEQ(*i.ErrorSummaryNEQ))
 	}
-	if i.ErrorMessageLT != nil {
-		predicates = append(predicates, profileentry.ErrorMessageLT(*i.ErrorMessageLT))
+	if len(i.ErrorSummaryIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryIn(i.ErrorSummaryIn...))
 	}
-	if i.ErrorMessageLTE != nil {
-		predicates = append(predicates, profileentry.ErrorMessageLTE(*i.ErrorMessageLTE))
+	if len(i.ErrorSummaryNotIn) > 0 {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryNotIn(i.ErrorSummaryNotIn...))
 	}
-	if i.ErrorMessageContains != nil {
-		predicates = append(predicates, profileentry.ErrorMessageContains(*i.ErrorMessageContains))
+	if i.ErrorSummaryGT != nil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryGT(*i.ErrorSummaryGT))
 	}
-	if i.ErrorMessageHasPrefix != nil {
-		predicates = append(predicates, profileentry.ErrorMessageHasPrefix(*i.ErrorMessageHasPrefix))
+	if i.ErrorSummaryGTE != nil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryGTE(*i.ErrorSummaryGTE))
 	}
-	if i.ErrorMessageHasSuffix != nil {
-		predicates = append(predicates, profileentry.ErrorMessageHasSuffix(*i.ErrorMessageHasSuffix))
+	if i.ErrorSummaryLT != nil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryLT(*i.ErrorSummaryLT))
 	}
-	if i.ErrorMessageIsNil {
-		predicates = append(predicates, profileentry.ErrorMessageIsNil())
+	if i.ErrorSummaryLTE != nil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryLTE(*i.ErrorSummaryLTE))
 	}
-	if i.ErrorMessageNotNil {
-		predicates = append(predicates, profileentry.ErrorMessageNotNil())
+	if i.ErrorSummaryContains != nil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryContains(*i.ErrorSummaryContains))
 	}
-	if i.ErrorMessageEqualFold != nil {
-		predicates = append(predicates, profileentry.ErrorMessageEqualFold(*i.ErrorMessageEqualFold))
+	if i.ErrorSummaryHasPrefix != nil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryHasPrefix(*i.ErrorSummaryHasPrefix))
 	}
-	if i.ErrorMessageContainsFold != nil {
-		predicates = append(predicates, profileentry.ErrorMessageContainsFold(*i.ErrorMessageContainsFold))
+	if i.ErrorSummaryHasSuffix != nil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryHasSuffix(*i.ErrorSummaryHasSuffix))
+	}
+	if i.ErrorSummaryIsNil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryIsNil())
+	}
+	if i.ErrorSummaryNotNil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryNotNil())
+	}
+	if i.ErrorSummaryEqualFold != nil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryEqualFold(*i.ErrorSummaryEqualFold))
+	}
+	if i.ErrorSummaryContainsFold != nil {
+		predicates = append(predicates, jobexecutionhistory.ErrorSummaryContainsFold(*i.ErrorSummaryContainsFold))
+	}
+	if i.SchemaDriftDetected != nil {
+		predicates = append(predicates, jobexecutionhistory.SchemaDriftDetectedEQ(*i.SchemaDriftDetected))
+	}
+	if i.SchemaDriftDetectedNEQ != nil {
+		predicates = append(predicates, jobexecutionhistory.SchemaDriftDetectedNEQ(*i.SchemaDriftDetectedNEQ))
 	}
 
-	if i.HasProfile != nil {
-		p := profileentry.HasProfile()
-		if !*i.HasProfile {
-			p = profileentry.Not(p)
+	if i.HasProfileEntries != nil {
+		p := jobexecutionhistory.HasProfileEntries()
+		if !*i.HasProfileEntries {
+			p = jobexecutionhistory.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasProfileWith) > 0 {
-		with := make([]predicate.Profile, 0, len(i.HasProfileWith))
-		for _, w := range i.HasProfileWith {
+	if len(i.HasProfileEntriesWith) > 0 {
+		with := make([]predicate.ProfileEntry, 0, len(i.HasProfileEntriesWith))
+		for _, w := range i.HasProfileEntriesWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProfileWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasProfileEntriesWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, profileentry.HasProfileWith(with...))
+		predicates = append(predicates, jobexecutionhistory.HasProfileEntriesWith(with...))
 	}
-	if i.HasJobExecutions != nil {
-		p := profileentry.HasJobExecutions()
-		if !*i.HasJobExecutions {
-			p = profileentry.Not(p)
+	if i.HasAPICalls != nil {
+		p := jobexecutionhistory.HasAPICalls()
+		if !*i.HasAPICalls {
+			p = jobexecutionhistory.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasJobExecutionsWith) > 0 {
-		with := make([]predicate.JobExecutionHistory, 0, len(i.HasJobExecutionsWith))
-		for _, w := range i.HasJobExecutionsWith {
+	if len(i.HasAPICallsWith) > 0 {
+		with := make([]predicate.APICallLog, 0, len(i.HasAPICallsWith))
+		for _, w := range i.HasAPICallsWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasJobExecutionsWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasAPICallsWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, profileentry.HasJobExecutionsWith(with...))
+		predicates = append(predicates, jobexecutionhistory.HasAPICallsWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyProfileEntryWhereInput
+		return nil, ErrEmptyJobExecutionHistoryWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return profileentry.And(predicates...), nil
+		return jobexecutionhistory.And(predicates...), nil
 	}
 }
 
-// ProfilePostWhereInput represents a where input for filtering ProfilePost queries.
-type ProfilePostWhereInput struct {
-	Predicates []predicate.ProfilePost  `json:"-"`
-	Not        *ProfilePostWhereInput   `json:"not,omitempty"`
-	Or         []*ProfilePostWhereInput `json:"or,omitempty"`
-	And        []*ProfilePostWhereInput `json:"and,omitempty"`
+// ProfileWhereInput represents a where input for filtering Profile queries.
+type ProfileWhereInput struct {
+	Predicates []predicate.Profile  `json:"-"`
+	Not        *ProfileWhereInput   `json:"not,omitempty"`
+	Or         []*ProfileWhereInput `json:"or,omitempty"`
+	And        []*ProfileWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *ulid.ID  `json:"id,omitempty"`
@@ -2924,89 +3637,247 @@ type ProfilePostWhereInput struct {
 	IDLT    *ulid.ID  `json:"idLT,omitempty"`
 	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
 
-	// "profile_username" field predicates.
-	ProfileUsername             *string  `json:"profileUsername,omitempty"`
-	ProfileUsernameNEQ          *string  `json:"profileUsernameNEQ,omitempty"`
-	ProfileUsernameIn           []string `json:"profileUsernameIn,omitempty"`
-	ProfileUsernameNotIn        []string `json:"profileUsernameNotIn,omitempty"`
-	ProfileUsernameGT           *string  `json:"profileUsernameGT,omitempty"`
-	ProfileUsernameGTE          *string  `json:"profileUsernameGTE,omitempty"`
-	ProfileUsernameLT           *string  `json:"profileUsernameLT,omitempty"`
-	ProfileUsernameLTE          *string  `json:"profileUsernameLTE,omitempty"`
-	ProfileUsernameContains     *string  `json:"profileUsernameContains,omitempty"`
-	ProfileUsernameHasPrefix    *string  `json:"profileUsernameHasPrefix,omitempty"`
-	ProfileUsernameHasSuffix    *string  `json:"profileUsernameHasSuffix,omitempty"`
-	ProfileUsernameEqualFold    *string  `json:"profileUsernameEqualFold,omitempty"`
-	ProfileUsernameContainsFold *string  `json:"profileUsernameContainsFold,omitempty"`
-
-	// "fetch_status" field predicates.
-	FetchStatus      *profilepost.FetchStatus  `json:"fetchStatus,omitempty"`
-	FetchStatusNEQ   *profilepost.FetchStatus  `json:"fetchStatusNEQ,omitempty"`
-	FetchStatusIn    []profilepost.FetchStatus `json:"fetchStatusIn,omitempty"`
-	FetchStatusNotIn []profilepost.FetchStatus `json:"fetchStatusNotIn,omitempty"`
-
-	// "s3_key" field predicates.
-	S3Key             *string  `json:"s3Key,omitempty"`
-	S3KeyNEQ          *string  `json:"s3KeyNEQ,omitempty"`
-	S3KeyIn           []string `json:"s3KeyIn,omitempty"`
-	S3KeyNotIn        []string `json:"s3KeyNotIn,omitempty"`
-	S3KeyGT           *string  `json:"s3KeyGT,omitempty"`
-	S3KeyGTE          *string  `json:"s3KeyGTE,omitempty"`
-	S3KeyLT           *string  `json:"s3KeyLT,omitempty"`
-	S3KeyLTE          *string  `json:"s3KeyLTE,omitempty"`
-	S3KeyContains     *string  `json:"s3KeyContains,omitempty"`
-	S3KeyHasPrefix    *string  `json:"s3KeyHasPrefix,omitempty"`
-	S3KeyHasSuffix    *string  `json:"s3KeyHasSuffix,omitempty"`
-	S3KeyIsNil        bool     `json:"s3KeyIsNil,omitempty"`
-	S3KeyNotNil       bool     `json:"s3KeyNotNil,omitempty"`
-	S3KeyEqualFold    *string  `json:"s3KeyEqualFold,omitempty"`
-	S3KeyContainsFold *string  `json:"s3KeyContainsFold,omitempty"`
+	// "urn" field predicates.
+	Urn             *string  `json:"urn,omitempty"`
+	UrnNEQ          *string  `json:"urnNEQ,omitempty"`
+	UrnIn           []string `json:"urnIn,omitempty"`
+	UrnNotIn        []string `json:"urnNotIn,omitempty"`
+	UrnGT           *string  `json:"urnGT,omitempty"`
+	UrnGTE          *string  `json:"urnGTE,omitempty"`
+	UrnLT           *string  `json:"urnLT,omitempty"`
+	UrnLTE          *string  `json:"urnLTE,omitempty"`
+	UrnContains     *string  `json:"urnContains,omitempty"`
+	UrnHasPrefix    *string  `json:"urnHasPrefix,omitempty"`
+	UrnHasSuffix    *string  `json:"urnHasSuffix,omitempty"`
+	UrnEqualFold    *string  `json:"urnEqualFold,omitempty"`
+	UrnContainsFold *string  `json:"urnContainsFold,omitempty"`
 
-	// "error_message" field predicates.
-	ErrorMessage             *string  `json:"errorMessage,omitempty"`
-	ErrorMessageNEQ          *string  `json:"errorMessageNEQ,omitempty"`
-	ErrorMessageIn           []string `json:"errorMessageIn,omitempty"`
-	ErrorMessageNotIn        []string `json:"errorMessageNotIn,omitempty"`
-	ErrorMessageGT           *string  `json:"errorMessageGT,omitempty"`
-	ErrorMessageGTE          *string  `json:"errorMessageGTE,omitempty"`
-	ErrorMessageLT           *string  `json:"errorMessageLT,omitempty"`
-	ErrorMessageLTE          *string  `json:"errorMessageLTE,omitempty"`
-	ErrorMessageContains     *string  `json:"errorMessageContains,omitempty"`
-	ErrorMessageHasPrefix    *string  `json:"errorMessageHasPrefix,omitempty"`
-	ErrorMessageHasSuffix    *string  `json:"errorMessageHasSuffix,omitempty"`
-	ErrorMessageIsNil        bool     `json:"errorMessageIsNil,omitempty"`
-	ErrorMessageNotNil       bool     `json:"errorMessageNotNil,omitempty"`
-	ErrorMessageEqualFold    *string  `json:"errorMessageEqualFold,omitempty"`
-	ErrorMessageContainsFold *string  `json:"errorMessageContainsFold,omitempty"`
+	// "username" field predicates.
+	Username             *string  `json:"username,omitempty"`
+	UsernameNEQ          *string  `json:"usernameNEQ,omitempty"`
+	UsernameIn           []string `json:"usernameIn,omitempty"`
+	UsernameNotIn        []string `json:"usernameNotIn,omitempty"`
+	UsernameGT           *string  `json:"usernameGT,omitempty"`
+	UsernameGTE          *string  `json:"usernameGTE,omitempty"`
+	UsernameLT           *string  `json:"usernameLT,omitempty"`
+	UsernameLTE          *string  `json:"usernameLTE,omitempty"`
+	UsernameContains     *string  `json:"usernameContains,omitempty"`
+	UsernameHasPrefix    *string  `json:"usernameHasPrefix,omitempty"`
+	UsernameHasSuffix    *string  `json:"usernameHasSuffix,omitempty"`
+	UsernameIsNil        bool     `json:"usernameIsNil,omitempty"`
+	UsernameNotNil       bool     `json:"usernameNotNil,omitempty"`
+	UsernameEqualFold    *string  `json:"usernameEqualFold,omitempty"`
+	UsernameContainsFold *string  `json:"usernameContainsFold,omitempty"`
 
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+	// "first_name" field predicates.
+	FirstName             *string  `json:"firstName,omitempty"`
+	FirstNameNEQ          *string  `json:"firstNameNEQ,omitempty"`
+	FirstNameIn           []string `json:"firstNameIn,omitempty"`
+	FirstNameNotIn        []string `json:"firstNameNotIn,omitempty"`
+	FirstNameGT           *string  `json:"firstNameGT,omitempty"`
+	FirstNameGTE          *string  `json:"firstNameGTE,omitempty"`
+	FirstNameLT           *string  `json:"firstNameLT,omitempty"`
+	FirstNameLTE          *string  `json:"firstNameLTE,omitempty"`
+	FirstNameContains     *string  `json:"firstNameContains,omitempty"`
+	FirstNameHasPrefix    *string  `json:"firstNameHasPrefix,omitempty"`
+	FirstNameHasSuffix    *string  `json:"firstNameHasSuffix,omitempty"`
+	FirstNameIsNil        bool     `json:"firstNameIsNil,omitempty"`
+	FirstNameNotNil       bool     `json:"firstNameNotNil,omitempty"`
+	FirstNameEqualFold    *string  `json:"firstNameEqualFold,omitempty"`
+	FirstNameContainsFold *string  `json:"firstNameContainsFold,omitempty"`
 
-	// "items" edge predicates.
-	HasItems     *bool                        `json:"hasItems,omitempty"`
-	HasItemsWith []*ProfilePostItemWhereInput `json:"hasItemsWith,omitempty"`
-}
+	// "last_name" field predicates.
+	LastName             *string  `json:"lastName,omitempty"`
+	LastNameNEQ          *string  `json:"lastNameNEQ,omitempty"`
+	LastNameIn           []string `json:"lastNameIn,omitempty"`
+	LastNameNotIn        []string `json:"lastNameNotIn,omitempty"`
+	LastNameGT           *string  `json:"lastNameGT,omitempty"`
+	LastNameGTE          *string  `json:"lastNameGTE,omitempty"`
+	LastNameLT           *string  `json:"lastNameLT,omitempty"`
+	LastNameLTE          *string  `json:"lastNameLTE,omitempty"`
+	LastNameContains     *string  `json:"lastNameContains,omitempty"`
+	LastNameHasPrefix    *string  `json:"lastNameHasPrefix,omitempty"`
+	LastNameHasSuffix    *string  `json:"lastNameHasSuffix,omitempty"`
+	LastNameIsNil        bool     `json:"lastNameIsNil,omitempty"`
+	LastNameNotNil       bool     `json:"lastNameNotNil,omitempty"`
+	LastNameEqualFold    *string  `json:"lastNameEqualFold,omitempty"`
+	LastNameContainsFold *string  `json:"lastNameContainsFold,omitempty"`
 
-// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ProfilePostWhereInput) AddPredicates(predicates ...predicate.ProfilePost) {
+	// "headline" field predicates.
+	Headline             *string  `json:"headline,omitempty"`
+	HeadlineNEQ          *string  `json:"headlineNEQ,omitempty"`
+	HeadlineIn           []string `json:"headlineIn,omitempty"`
+	HeadlineNotIn        []string `json:"headlineNotIn,omitempty"`
+	HeadlineGT           *string  `json:"headlineGT,omitempty"`
+	HeadlineGTE          *string  `json:"headlineGTE,omitempty"`
+	HeadlineLT           *string  `json:"headlineLT,omitempty"`
+	HeadlineLTE          *string  `json:"headlineLTE,omitempty"`
+	HeadlineContains     *string  `json:"headlineContains,omitempty"`
+	HeadlineHasPrefix    *string  `json:"headlineHasPrefix,omitempty"`
+	HeadlineHasSuffix    *string  `json:"headlineHasSuffix,omitempty"`
+	HeadlineIsNil        bool     `json:"headlineIsNil,omitempty"`
+	HeadlineNotNil       bool     `json:"headlineNotNil,omitempty"`
+	HeadlineEqualFold    *string  `json:"headlineEqualFold,omitempty"`
+	HeadlineContainsFold *string  `json:"headlineContainsFold,omitempty"`
+
+	// "title" field predicates.
+	Title             *string  `json:"title,omitempty"`
+	TitleNEQ          *string  `json:"titleNEQ,omitempty"`
+	TitleIn           []string `json:"titleIn,omitempty"`
+	TitleNotIn        []string `json:"titleNotIn,omitempty"`
+	TitleGT           *string  `json:"titleGT,omitempty"`
+	TitleGTE          *string  `json:"titleGTE,omitempty"`
+	TitleLT           *string  `json:"titleLT,omitempty"`
+	TitleLTE          *string  `json:"titleLTE,omitempty"`
+	TitleContains     *string  `json:"titleContains,omitempty"`
+	TitleHasPrefix    *string  `json:"titleHasPrefix,omitempty"`
+	TitleHasSuffix    *string  `json:"titleHasSuffix,omitempty"`
+	TitleIsNil        bool     `json:"titleIsNil,omitempty"`
+	TitleNotNil       bool     `json:"titleNotNil,omitempty"`
+	TitleEqualFold    *string  `json:"titleEqualFold,omitempty"`
+	TitleContainsFold *string  `json:"titleContainsFold,omitempty"`
+
+	// "country" field predicates.
+	Country             *string  `json:"country,omitempty"`
+	CountryNEQ          *string  `json:"countryNEQ,omitempty"`
+	CountryIn           []string `json:"countryIn,omitempty"`
+	CountryNotIn        []string `json:"countryNotIn,omitempty"`
+	CountryGT           *string  `json:"countryGT,omitempty"`
+	CountryGTE          *string  `json:"countryGTE,omitempty"`
+	CountryLT           *string  `json:"countryLT,omitempty"`
+	CountryLTE          *string  `json:"countryLTE,omitempty"`
+	CountryContains     *string  `json:"countryContains,omitempty"`
+	CountryHasPrefix    *string  `json:"countryHasPrefix,omitempty"`
+	CountryHasSuffix    *string  `json:"countryHasSuffix,omitempty"`
+	CountryIsNil        bool     `json:"countryIsNil,omitempty"`
+	CountryNotNil       bool     `json:"countryNotNil,omitempty"`
+	CountryEqualFold    *string  `json:"countryEqualFold,omitempty"`
+	CountryContainsFold *string  `json:"countryContainsFold,omitempty"`
+
+	// "city" field predicates.
+	City             *string  `json:"city,omitempty"`
+	CityNEQ          *string  `json:"cityNEQ,omitempty"`
+	CityIn           []string `json:"cityIn,omitempty"`
+	CityNotIn        []string `json:"cityNotIn,omitempty"`
+	CityGT           *string  `json:"cityGT,omitempty"`
+	CityGTE          *string  `json:"cityGTE,omitempty"`
+	CityLT           *string  `json:"cityLT,omitempty"`
+	CityLTE          *string  `json:"cityLTE,omitempty"`
+	CityContains     *string  `json:"cityContains,omitempty"`
+	CityHasPrefix    *string  `json:"cityHasPrefix,omitempty"`
+	CityHasSuffix    *string  `json:"cityHasSuffix,omitempty"`
+	CityIsNil        bool     `json:"cityIsNil,omitempty"`
+	CityNotNil       bool     `json:"cityNotNil,omitempty"`
+	CityEqualFold    *string  `json:"cityEqualFold,omitempty"`
+	CityContainsFold *string  `json:"cityContainsFold,omitempty"`
+
+	// "raw_data_s3_key" field predicates.
+	RawDataS3Key             *string  `json:"rawDataS3Key,omitempty"`
+	RawDataS3KeyNEQ          *string  `json:"rawDataS3KeyNEQ,omitempty"`
+	RawDataS3KeyIn           []string `json:"rawDataS3KeyIn,omitempty"`
+	RawDataS3KeyNotIn        []string `json:"rawDataS3KeyNotIn,omitempty"`
+	RawDataS3KeyGT           *string  `json:"rawDataS3KeyGT,omitempty"`
+	RawDataS3KeyGTE          *string  `json:"rawDataS3KeyGTE,omitempty"`
+	RawDataS3KeyLT           *string  `json:"rawDataS3KeyLT,omitempty"`
+	RawDataS3KeyLTE          *string  `json:"rawDataS3KeyLTE,omitempty"`
+	RawDataS3KeyContains     *string  `json:"rawDataS3KeyContains,omitempty"`
+	RawDataS3KeyHasPrefix    *string  `json:"rawDataS3KeyHasPrefix,omitempty"`
+	RawDataS3KeyHasSuffix    *string  `json:"rawDataS3KeyHasSuffix,omitempty"`
+	RawDataS3KeyIsNil        bool     `json:"rawDataS3KeyIsNil,omitempty"`
+	RawDataS3KeyNotNil       bool     `json:"rawDataS3KeyNotNil,omitempty"`
+	RawDataS3KeyEqualFold    *string  `json:"rawDataS3KeyEqualFold,omitempty"`
+	RawDataS3KeyContainsFold *string  `json:"rawDataS3KeyContainsFold,omitempty"`
+
+	// "cleaned_data_s3_key" field predicates.
+	CleanedDataS3Key             *string  `json:"cleanedDataS3Key,omitempty"`
+	CleanedDataS3KeyNEQ          *string  `json:"cleanedDataS3KeyNEQ,omitempty"`
+	CleanedDataS3KeyIn           []string `json:"cleanedDataS3KeyIn,omitempty"`
+	CleanedDataS3KeyNotIn        []string `json:"cleanedDataS3KeyNotIn,omitempty"`
+	CleanedDataS3KeyGT           *string  `json:"cleanedDataS3KeyGT,omitempty"`
+	CleanedDataS3KeyGTE          *string  `json:"cleanedDataS3KeyGTE,omitempty"`
+	CleanedDataS3KeyLT           *string  `json:"cleanedDataS3KeyLT,omitempty"`
+	CleanedDataS3KeyLTE          *string  `json:"cleanedDataS3KeyLTE,omitempty"`
+	CleanedDataS3KeyContains     *string  `json:"cleanedDataS3KeyContains,omitempty"`
+	CleanedDataS3KeyHasPrefix    *string  `json:"cleanedDataS3KeyHasPrefix,omitempty"`
+	CleanedDataS3KeyHasSuffix    *string  `json:"cleanedDataS3KeyHasSuffix,omitempty"`
+	CleanedDataS3KeyIsNil        bool     `json:"cleanedDataS3KeyIsNil,omitempty"`
+	CleanedDataS3KeyNotNil       bool     `json:"cleanedDataS3KeyNotNil,omitempty"`
+	CleanedDataS3KeyEqualFold    *string  `json:"cleanedDataS3KeyEqualFold,omitempty"`
+	CleanedDataS3KeyContainsFold *string  `json:"cleanedDataS3KeyContainsFold,omitempty"`
+
+	// "source_file" field predicates.
+	SourceFile             *string  `json:"sourceFile,omitempty"`
+	SourceFileNEQ          *string  `json:"sourceFileNEQ,omitempty"`
+	SourceFileIn           []string `json:"sourceFileIn,omitempty"`
+	SourceFileNotIn        []string `json:"sourceFileNotIn,omitempty"`
+	SourceFileGT           *string  `json:"sourceFileGT,omitempty"`
+	SourceFileGTE          *string  `json:"sourceFileGTE,omitempty"`
+	SourceFileLT           *string  `json:"sourceFileLT,omitempty"`
+	SourceFileLTE          *string  `json:"sourceFileLTE,omitempty"`
+	SourceFileContains     *string  `json:"sourceFileContains,omitempty"`
+	SourceFileHasPrefix    *string  `json:"sourceFileHasPrefix,omitempty"`
+	SourceFileHasSuffix    *string  `json:"sourceFileHasSuffix,omitempty"`
+	SourceFileIsNil        bool     `json:"sourceFileIsNil,omitempty"`
+	SourceFileNotNil       bool     `json:"sourceFileNotNil,omitempty"`
+	SourceFileEqualFold    *string  `json:"sourceFileEqualFold,omitempty"`
+	SourceFileContainsFold *string  `json:"sourceFileContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "profile_entry" edge predicates.
+	HasProfileEntry     *bool                     `json:"hasProfileEntry,omitempty"`
+	HasProfileEntryWith []*ProfileEntryWhereInput `json:"hasProfileEntryWith,omitempty"`
+
+	// "snapshots" edge predicates.
+	HasSnapshots     *bool                        `json:"hasSnapshots,omitempty"`
+	HasSnapshotsWith []*ProfileSnapshotWhereInput `json:"hasSnapshotsWith,omitempty"`
+
+	// "change_events" edge predicates.
+	HasChangeEvents     *bool                           `json:"hasChangeEvents,omitempty"`
+	HasChangeEventsWith []*ProfileChangeEventWhereInput `json:"hasChangeEventsWith,omitempty"`
+
+	// "profile_positions" edge predicates.
+	HasProfilePositions     *bool                        `json:"hasProfilePositions,omitempty"`
+	HasProfilePositionsWith []*ProfilePositionWhereInput `json:"hasProfilePositionsWith,omitempty"`
+
+	// "profile_educations" edge predicates.
+	HasProfileEducations     *bool                         `json:"hasProfileEducations,omitempty"`
+	HasProfileEducationsWith []*ProfileEducationWhereInput `json:"hasProfileEducationsWith,omitempty"`
+
+	// "profile_skills" edge predicates.
+	HasProfileSkills     *bool                     `json:"hasProfileSkills,omitempty"`
+	HasProfileSkillsWith []*ProfileSkillWhereInput `json:"hasProfileSkillsWith,omitempty"`
+
+	// "current_companies" edge predicates.
+	HasCurrentCompanies     *bool                `json:"hasCurrentCompanies,omitempty"`
+	HasCurrentCompaniesWith []*CompanyWhereInput `json:"hasCurrentCompaniesWith,omitempty"`
+
+	// "past_companies" edge predicates.
+	HasPastCompanies     *bool                `json:"hasPastCompanies,omitempty"`
+	HasPastCompaniesWith []*CompanyWhereInput `json:"hasPastCompaniesWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ProfileWhereInput) AddPredicates(predicates ...predicate.Profile) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ProfilePostWhereInput filter on the ProfilePostQuery builder.
-func (i *ProfilePostWhereInput) Filter(q *ProfilePostQuery) (*ProfilePostQuery, error) {
+// Filter applies the ProfileWhereInput filter on the ProfileQuery builder.
+func (i *ProfileWhereInput) Filter(q *ProfileQuery) (*ProfileQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyProfilePostWhereInput {
+		if err == ErrEmptyProfileWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -3014,19 +3885,19 @@ func (i *ProfilePostWhereInput) Filter(q *ProfilePostQuery) (*ProfilePostQuery,
 	return q.Where(p), nil
 }
 
-// ErrEmptyProfilePostWhereInput is returned in case the ProfilePostWhereInput is empty.
-var ErrEmptyProfilePostWhereInput = errors.New("ent: empty predicate ProfilePostWhereInput")
+// ErrEmptyProfileWhereInput is returned in case the ProfileWhereInput is empty.
+var ErrEmptyProfileWhereInput = errors.New("ent: empty predicate ProfileWhereInput")
 
-// P returns a predicate for filtering profileposts.
+// P returns a predicate for filtering profiles.
 // An error is returned if the input is empty or invalid.
-func (i *ProfilePostWhereInput) P() (predicate.ProfilePost, error) {
-	var predicates []predicate.ProfilePost
+func (i *ProfileWhereInput) P() (predicate.Profile, error) {
+	var predicates []predicate.Profile
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, profilepost.Not(p))
+		predicates = append(predicates, profile.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -3036,7 +3907,7 @@ func (i *ProfilePostWhereInput) P() (predicate.ProfilePost, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.ProfilePost, 0, n)
+		or := make([]predicate.Profile, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -3044,7 +3915,7 @@ func (i *ProfilePostWhereInput) P() (predicate.ProfilePost, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, profilepost.Or(or...))
+		predicates = append(predicates, profile.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -3054,7 +3925,7 @@ func (i *ProfilePostWhereInput) P() (predicate.ProfilePost, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.ProfilePost, 0, n)
+		and := make([]predicate.Profile, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -3062,303 +3933,3809 @@ func (i *ProfilePostWhereInput) P() (predicate.ProfilePost, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, profilepost.And(and...))
+		predicates = append(predicates, profile.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, profilepost.IDEQ(*i.ID))
+		predicates = append(predicates, profile.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, profilepost.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, profile.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, profilepost.IDIn(i.IDIn...))
+		predicates = append(predicates, profile.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, profilepost.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, profile.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, profilepost.IDGT(*i.IDGT))
+		predicates = append(predicates, profile.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, profilepost.IDGTE(*i.IDGTE))
+		predicates = append(predicates, profile.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, profilepost.IDLT(*i.IDLT))
+		predicates = append(predicates, profile.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, profilepost.IDLTE(*i.IDLTE))
-	}
-	if i.ProfileUsername != nil {
-		predicates = append(predicates, profilepost.ProfileUsernameEQ(*i.ProfileUsername))
+		predicates = append(predicates, profile.IDLTE(*i.IDLTE))
 	}
-	if i.ProfileUsernameNEQ != nil {
-		predicates = append(predicates, profilepost.ProfileUsernameNEQ(*i.ProfileUsernameNEQ))
+	if i.Urn != nil {
+		predicates = append(predicates, profile.UrnEQ(*i.Urn))
 	}
-	if len(i.ProfileUsernameIn) > 0 {
-		predicates = append(predicates, profilepost.ProfileUsernameIn(i.ProfileUsernameIn...))
+	if i.UrnNEQ != nil {
+		predicates = append(predicates, profile.UrnNEQ(*i.UrnNEQ))
 	}
-	if len(i.ProfileUsernameNotIn) > 0 {
-		predicates = append(predicates, profilepost.ProfileUsernameNotIn(i.ProfileUsernameNotIn...))
+	if len(i.UrnIn) > 0 {
+		predicates = append(predicates, profile.UrnIn(i.UrnIn...))
 	}
-	if i.ProfileUsernameGT != nil {
-		predicates = append(predicates, profilepost.ProfileUsernameGT(*i.ProfileUsernameGT))
+	if len(i.UrnNotIn) > 0 {
+		predicates = append(predicates, profile.UrnNotIn(i.UrnNotIn...))
 	}
-	if i.ProfileUsernameGTE != nil {
-		predicates = append(predicates, profilepost.ProfileUsernameGTE(*i.ProfileUsernameGTE))
+	if i.UrnGT != nil {
+		predicates = append(predicates, profile.UrnGT(*i.UrnGT))
 	}
-	if i.ProfileUsernameLT != nil {
-		predicates = append(predicates, profilepost.ProfileUsernameLT(*i.ProfileUsernameLT))
+	if i.UrnGTE != nil {
+		predicates = append(predicates, profile.UrnGTE(*i.UrnGTE))
 	}
-	if i.ProfileUsernameLTE != nil {
-		predicates = append(predicates, profilepost.ProfileUsernameLTE(*i.ProfileUsernameLTE))
+	if i.UrnLT != nil {
+		predicates = append(predicates, profile.UrnLT(*i.UrnLT))
 	}
-	if i.ProfileUsernameContains != nil {
-		predicates = append(predicates, profilepost.ProfileUsernameContains(*i.ProfileUsernameContains))
+	if i.UrnLTE != nil {
+		predicates = append(predicates, profile.UrnLTE(*i.UrnLTE))
 	}
-	if i.ProfileUsernameHasPrefix != nil {
-		predicates = append(predicates, profilepost.ProfileUsernameHasPrefix(*i.ProfileUsernameHasPrefix))
+	if i.UrnContains != nil {
+		predicates = append(predicates, profile.UrnContains(*i.UrnContains))
 	}
-	if i.ProfileUsernameHasSuffix != nil {
-		predicates = append(predicates, profilepost.ProfileUsernameHasSuffix(*i.ProfileUsernameHasSuffix))
+	if i.UrnHasPrefix != nil {
+		predicates = append(predicates, profile.UrnHasPrefix(*i.UrnHasPrefix))
 	}
-	if i.ProfileUsernameEqualFold != nil {
-		predicates = append(predicates, profilepost.ProfileUsernameEqualFold(*i.ProfileUsernameEqualFold))
+	if i.UrnHasSuffix != nil {
+		predicates = append(predicates, profile.UrnHasSuffix(*i.UrnHasSuffix))
 	}
-	if i.ProfileUsernameContainsFold != nil {
-		predicates = append(predicates, profilepost.ProfileUsernameContainsFold(*i.ProfileUsernameContainsFold))
+	if i.UrnEqualFold != nil {
+		predicates = append(predicates, profile.UrnEqualFold(*i.UrnEqualFold))
 	}
-	if i.FetchStatus != nil {
-		predicates = append(predicates, profilepost.FetchStatusEQ(*i.FetchStatus))
+	if i.UrnContainsFold != nil {
+		predicates = append(predicates, profile.UrnContainsFold(*i.UrnContainsFold))
 	}
-	if i.FetchStatusNEQ != nil {
-		predicates = append(predicates, profilepost.FetchStatusNEQ(*i.FetchStatusNEQ))
+	if i.Username != nil {
+		predicates = append(predicates, profile.UsernameEQ(*i.Username))
 	}
-	if len(i.FetchStatusIn) > 0 {
-		predicates = append(predicates, profilepost.FetchStatusIn(i.FetchStatusIn...))
+	if i.UsernameNEQ != nil {
+		predicates = append(predicates, profile.UsernameNEQ(*i.UsernameNEQ))
 	}
-	if len(i.FetchStatusNotIn) > 0 {
-		predicates = append(predicates, profilepost.FetchStatusNotIn(i.FetchStatusNotIn...))
+	if len(i.UsernameIn) > 0 {
+		predicates = append(predicates, profile.UsernameIn(i.UsernameIn...))
 	}
-	if i.S3Key != nil {
-		predicates = append(predicates, profilepost.S3KeyEQ(*i.S3Key))
+	if len(i.UsernameNotIn) > 0 {
+		predicates = append(predicates, profile.UsernameNotIn(i.UsernameNotIn...))
 	}
-	if i.S3KeyNEQ != nil {
-		predicates = append(predicates, profilepost.S3KeyNEQ(*i.S3KeyNEQ))
+	if i.UsernameGT != nil {
+		predicates = append(predicates, profile.UsernameGT(*i.UsernameGT))
 	}
-	if len(i.S3KeyIn) > 0 {
-		predicates = append(predicates, profilepost.S3KeyIn(i.S3KeyIn...))
+	if i.UsernameGTE != nil {
+		predicates = append(predicates, profile.UsernameGTE(*i.UsernameGTE))
 	}
-	if len(i.S3KeyNotIn) > 0 {
-		predicates = append(predicates, profilepost.S3KeyNotIn(i.S3KeyNotIn...))
+	if i.UsernameLT != nil {
+		predicates = append(predicates, profile.UsernameLT(*i.UsernameLT))
 	}
-	if i.S3KeyGT != nil {
-		predicates = append(predicates, profilepost.S3KeyGT(*i.S3KeyGT))
+	if i.UsernameLTE != nil {
+		predicates = append(predicates, profile.UsernameLTE(*i.UsernameLTE))
 	}
-	if i.S3KeyGTE != nil {
-		predicates = append(predicates, profilepost.S3KeyGTE(*i.S3KeyGTE))
+	if i.UsernameContains != nil {
+		predicates = append(predicates, profile.UsernameContains(*i.UsernameContains))
 	}
-	if i.S3KeyLT != nil {
-		predicates = append(predicates, profilepost.S3KeyLT(*i.S3KeyLT))
+	if i.UsernameHasPrefix != nil {
+		predicates = append(predicates, profile.UsernameHasPrefix(*i.UsernameHasPrefix))
 	}
-	if i.S3KeyLTE != nil {
-		predicates = append(predicates, profilepost.S3KeyLTE(*i.S3KeyLTE))
+	if i.UsernameHasSuffix != nil {
+		predicates = append(predicates, profile.UsernameHasSuffix(*i.UsernameHasSuffix))
 	}
-	if i.S3KeyContains != nil {
-		predicates = append(predicates, profilepost.S3KeyContains(*i.S3KeyContains))
+	if i.UsernameIsNil {
+		predicates = append(predicates, profile.UsernameIsNil())
 	}
-	if i.S3KeyHasPrefix != nil {
-		predicates = append(predicates, profilepost.S3KeyHasPrefix(*i.S3KeyHasPrefix))
+	if i.UsernameNotNil {
+		predicates = append(predicates, profile.UsernameNotNil())
 	}
-	if i.S3KeyHasSuffix != nil {
-		predicates = append(predicates, profilepost.S3KeyHasSuffix(*i.S3KeyHasSuffix))
+	if i.UsernameEqualFold != nil {
+		predicates = append(predicates, profile.UsernameEqualFold(*i.UsernameEqualFold))
 	}
-	if i.S3KeyIsNil {
-		predicates = append(predicates, profilepost.S3KeyIsNil())
+	if i.UsernameContainsFold != nil {
+		predicates = append(predicates, profile.UsernameContainsFold(*i.UsernameContainsFold))
 	}
-	if i.S3KeyNotNil {
-		predicates = append(predicates, profilepost.S3KeyNotNil())
+	if i.FirstName != nil {
+		predicates = append(predicates, profile.FirstNameEQ(*i.FirstName))
 	}
-	if i.S3KeyEqualFold != nil {
-		predicates = append(predicates, profilepost.S3KeyEqualFold(*i.S3KeyEqualFold))
+	if i.FirstNameNEQ != nil {
+		predicates = append(predicates, profile.FirstNameNEQ(*i.FirstNameNEQ))
 	}
-	if i.S3KeyContainsFold != nil {
-		predicates = append(predicates, profilepost.S3KeyContainsFold(*i.S3KeyContainsFold))
+	if len(i.FirstNameIn) > 0 {
+		predicates = append(predicates, profile.FirstNameIn(i.FirstNameIn...))
 	}
-	if i.ErrorMessage != nil {
-		predicates = append(predicates, profilepost.ErrorMessageEQ(*i.ErrorMessage))
+	if len(i.FirstNameNotIn) > 0 {
+		predicates = append(predicates, profile.FirstNameNotIn(i.FirstNameNotIn...))
 	}
-	if i.ErrorMessageNEQ != nil {
-		predicates = append(predicates, profilepost.ErrorMessageNEQ(*i.ErrorMessageNEQ))
+	if i.FirstNameGT != nil {
+		predicates = append(predicates, profile.FirstNameGT(*i.FirstNameGT))
 	}
-	if len(i.ErrorMessageIn) > 0 {
-		predicates = append(predicates, profilepost.ErrorMessageIn(i.ErrorMessageIn...))
+	if i.FirstNameGTE != nil {
+		predicates = append(predicates, profile.FirstNameGTE(*i.FirstNameGTE))
 	}
-	if len(i.ErrorMessageNotIn) > 0 {
-		predicates = append(predicates, profilepost.ErrorMessageNotIn(i.ErrorMessageNotIn...))
+	if i.FirstNameLT != nil {
+		predicates = append(predicates, profile.FirstNameLT(*i.FirstNameLT))
 	}
-	if i.ErrorMessageGT != nil {
-		predicates = append(predicates, profilepost.ErrorMessageGT(*i.ErrorMessageGT))
+	if i.FirstNameLTE != nil {
+		predicates = append(predicates, profile.FirstNameLTE(*i.FirstNameLTE))
 	}
-	if i.ErrorMessageGTE != nil {
-		predicates = append(predicates, profilepost.ErrorMessageGTE(*i.ErrorMessageGTE))
+	if i.FirstNameContains != nil {
+		predicates = append(predicates, profile.FirstNameContains(*i.FirstNameContains))
 	}
-	if i.ErrorMessageLT != nil {
-		predicates = append(predicates, profilepost.ErrorMessageLT(*i.ErrorMessageLT))
+	if i.FirstNameHasPrefix != nil {
+		predicates = append(predicates, profile.FirstNameHasPrefix(*i.FirstNameHasPrefix))
 	}
-	if i.ErrorMessageLTE != nil {
-		predicates = append(predicates, profilepost.ErrorMessageLTE(*i.ErrorMessageLTE))
+	if i.FirstNameHasSuffix != nil {
+		predicates = append(predicates, profile.FirstNameHasSuffix(*i.FirstNameHasSuffix))
 	}
-	if i.ErrorMessageContains != nil {
-		predicates = append(predicates, profilepost.ErrorMessageContains(*i.ErrorMessageContains))
+	if i.FirstNameIsNil {
+		predicates = append(predicates, profile.FirstNameIsNil())
 	}
-	if i.ErrorMessageHasPrefix != nil {
-		predicates = append(predicates, profilepost.ErrorMessageHasPrefix(*i.ErrorMessageHasPrefix))
+	if i.FirstNameNotNil {
+		predicates = append(predicates, profile.FirstNameNotNil())
 	}
-	if i.ErrorMessageHasSuffix != nil {
-		predicates = append(predicates, profilepost.ErrorMessageHasSuffix(*i.ErrorMessageHasSuffix))
+	if i.FirstNameEqualFold != nil {
+		predicates = append(predicates, profile.FirstNameEqualFold(*i.FirstNameEqualFold))
 	}
-	if i.ErrorMessageIsNil {
-		predicates = append(predicates, profilepost.ErrorMessageIsNil())
+	if i.FirstNameContainsFold != nil {
+		predicates = append(predicates, profile.FirstNameContainsFold(*i.FirstNameContainsFold))
 	}
-	if i.ErrorMessageNotNil {
-		predicates = append(predicates, profilepost.ErrorMessageNotNil())
+	if i.LastName != nil {
+		predicates = append(predicates, profile.LastNameEQ(*i.LastName))
 	}
-	if i.ErrorMessageEqualFold != nil {
-		predicates = append(predicates, profilepost.ErrorMessageEqualFold(*i.ErrorMessageEqualFold))
+	if i.LastNameNEQ != nil {
+		predicates = append(predicates, profile.LastNameNEQ(*i.LastNameNEQ))
 	}
-	if i.ErrorMessageContainsFold != nil {
-		predicates = append(predicates, profilepost.ErrorMessageContainsFold(*i.ErrorMessageContainsFold))
+	if len(i.LastNameIn) > 0 {
+		predicates = append(predicates, profile.LastNameIn(i.LastNameIn...))
 	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, profilepost.CreatedAtEQ(*i.CreatedAt))
+	if len(i.LastNameNotIn) > 0 {
+		predicates = append(predicates, profile.LastNameNotIn(i.LastNameNotIn...))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, profilepost.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.LastNameGT != nil {
+		predicates = append(predicates, profile.LastNameGT(*i.LastNameGT))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, profilepost.CreatedAtIn(i.CreatedAtIn...))
+	if i.LastNameGTE != nil {
+		predicates = append(predicates, profile.LastNameGTE(*i.LastNameGTE))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, profilepost.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if i.LastNameLT != nil {
+		predicates = append(predicates, profile.LastNameLT(*i.LastNameLT))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, profilepost.CreatedAtGT(*i.CreatedAtGT))
+	if i.LastNameLTE != nil {
+		predicates = append(predicates, profile.LastNameLTE(*i.LastNameLTE))
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, profilepost.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.LastNameContains != nil {
+		predicates = append(predicates, profile.LastNameContains(*i.LastNameContains))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, profilepost.CreatedAtLT(*i.CreatedAtLT))
+	if i.LastNameHasPrefix != nil {
+		predicates = append(predicates, profile.LastNameHasPrefix(*i.LastNameHasPrefix))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, profilepost.CreatedAtLTE(*i.CreatedAtLTE))
+	if i.LastNameHasSuffix != nil {
+		predicates = append(predicates, profile.LastNameHasSuffix(*i.LastNameHasSuffix))
 	}
-
-	if i.HasItems != nil {
-		p := profilepost.HasItems()
-		if !*i.HasItems {
-			p = profilepost.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.LastNameIsNil {
+		predicates = append(predicates, profile.LastNameIsNil())
 	}
-	if len(i.HasItemsWith) > 0 {
-		with := make([]predicate.ProfilePostItem, 0, len(i.HasItemsWith))
-		for _, w := range i.HasItemsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasItemsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, profilepost.HasItemsWith(with...))
+	if i.LastNameNotNil {
+		predicates = append(predicates, profile.LastNameNotNil())
 	}
-	switch len(predicates) {
-	case 0:
-		return nil, ErrEmptyProfilePostWhereInput
-	case 1:
-		return predicates[0], nil
-	default:
-		return profilepost.And(predicates...), nil
+	if i.LastNameEqualFold != nil {
+		predicates = append(predicates, profile.LastNameEqualFold(*i.LastNameEqualFold))
 	}
-}
-
-// ProfilePostItemWhereInput represents a where input for filtering ProfilePostItem queries.
-type ProfilePostItemWhereInput struct {
-	Predicates []predicate.ProfilePostItem  `json:"-"`
-	Not        *ProfilePostItemWhereInput   `json:"not,omitempty"`
-	Or         []*ProfilePostItemWhereInput `json:"or,omitempty"`
-	And        []*ProfilePostItemWhereInput `json:"and,omitempty"`
-
-	// "id" field predicates.
-	ID      *ulid.ID  `json:"id,omitempty"`
-	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
-	IDIn    []ulid.ID `json:"idIn,omitempty"`
-	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
-	IDGT    *ulid.ID  `json:"idGT,omitempty"`
-	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
-	IDLT    *ulid.ID  `json:"idLT,omitempty"`
-	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
-
-	// "profile_username" field predicates.
-	ProfileUsername             *string  `json:"profileUsername,omitempty"`
-	ProfileUsernameNEQ          *string  `json:"profileUsernameNEQ,omitempty"`
-	ProfileUsernameIn           []string `json:"profileUsernameIn,omitempty"`
-	ProfileUsernameNotIn        []string `json:"profileUsernameNotIn,omitempty"`
-	ProfileUsernameGT           *string  `json:"profileUsernameGT,omitempty"`
-	ProfileUsernameGTE          *string  `json:"profileUsernameGTE,omitempty"`
-	ProfileUsernameLT           *string  `json:"profileUsernameLT,omitempty"`
-	ProfileUsernameLTE          *string  `json:"profileUsernameLTE,omitempty"`
-	ProfileUsernameContains     *string  `json:"profileUsernameContains,omitempty"`
-	ProfileUsernameHasPrefix    *string  `json:"profileUsernameHasPrefix,omitempty"`
-	ProfileUsernameHasSuffix    *string  `json:"profileUsernameHasSuffix,omitempty"`
-	ProfileUsernameEqualFold    *string  `json:"profileUsernameEqualFold,omitempty"`
-	ProfileUsernameContainsFold *string  `json:"profileUsernameContainsFold,omitempty"`
-
-	// "post_urn" field predicates.
-	PostUrn             *string  `json:"postUrn,omitempty"`
-	PostUrnNEQ          *string  `json:"postUrnNEQ,omitempty"`
-	PostUrnIn           []string `json:"postUrnIn,omitempty"`
-	PostUrnNotIn        []string `json:"postUrnNotIn,omitempty"`
-	PostUrnGT           *string  `json:"postUrnGT,omitempty"`
-	PostUrnGTE          *string  `json:"postUrnGTE,omitempty"`
-	PostUrnLT           *string  `json:"postUrnLT,omitempty"`
-	PostUrnLTE          *string  `json:"postUrnLTE,omitempty"`
-	PostUrnContains     *string  `json:"postUrnContains,omitempty"`
-	PostUrnHasPrefix    *string  `json:"postUrnHasPrefix,omitempty"`
-	PostUrnHasSuffix    *string  `json:"postUrnHasSuffix,omitempty"`
-	PostUrnIsNil        bool     `json:"postUrnIsNil,omitempty"`
-	PostUrnNotNil       bool     `json:"postUrnNotNil,omitempty"`
-	PostUrnEqualFold    *string  `json:"postUrnEqualFold,omitempty"`
-	PostUrnContainsFold *string  `json:"postUrnContainsFold,omitempty"`
-
-	// "post_url" field predicates.
-	PostURL             *string  `json:"postURL,omitempty"`
-	PostURLNEQ          *string  `json:"postURLNEQ,omitempty"`
-	PostURLIn           []string `json:"postURLIn,omitempty"`
-	PostURLNotIn        []string `json:"postURLNotIn,omitempty"`
-	PostURLGT           *string  `json:"postURLGT,omitempty"`
-	PostURLGTE          *string  `json:"postURLGTE,omitempty"`
-	PostURLLT           *string  `json:"postURLLT,omitempty"`
-	PostURLLTE          *string  `json:"postURLLTE,omitempty"`
-	PostURLContains     *string  `json:"postURLContains,omitempty"`
-	PostURLHasPrefix    *string  `json:"postURLHasPrefix,omitempty"`
-	PostURLHasSuffix    *string  `json:"postURLHasSuffix,omitempty"`
-	PostURLIsNil        bool     `json:"postURLIsNil,omitempty"`
-	PostURLNotNil       bool     `json:"postURLNotNil,omitempty"`
-	PostURLEqualFold    *string  `json:"postURLEqualFold,omitempty"`
-	PostURLContainsFold *string  `json:"postURLContainsFold,omitempty"`
-
-	// "text" field predicates.
-	Text             *string  `json:"text,omitempty"`
-	TextNEQ          *string  `json:"textNEQ,omitempty"`
-	TextIn           []string `json:"textIn,omitempty"`
-	TextNotIn        []string `json:"textNotIn,omitempty"`
-	TextGT           *string  `json:"textGT,omitempty"`
-	TextGTE          *string  `json:"textGTE,omitempty"`
-	TextLT           *string  `json:"textLT,omitempty"`
-	TextLTE          *string  `json:"textLTE,omitempty"`
-	TextContains     *string  `json:"textContains,omitempty"`
+	if i.LastNameContainsFold != nil {
+		predicates = append(predicates, profile.LastNameContainsFold(*i.LastNameContainsFold))
+	}
+	if i.Headline != nil {
+		predicates = append(predicates, profile.HeadlineEQ(*i.Headline))
+	}
+	if i.HeadlineNEQ != nil {
+		predicates = append(predicates, profile.HeadlineNEQ(*i.HeadlineNEQ))
+	}
+	if len(i.HeadlineIn) > 0 {
+		predicates = append(predicates, profile.HeadlineIn(i.HeadlineIn...))
+	}
+	if len(i.HeadlineNotIn) > 0 {
+		predicates = append(predicates, profile.HeadlineNotIn(i.HeadlineNotIn...))
+	}
+	if i.HeadlineGT != nil {
+		predicates = append(predicates, profile.HeadlineGT(*i.HeadlineGT))
+	}
+	if i.HeadlineGTE != nil {
+		predicates = append(predicates, profile.HeadlineGTE(*i.HeadlineGTE))
+	}
+	if i.HeadlineLT != nil {
+		predicates = append(predicates, profile.HeadlineLT(*i.HeadlineLT))
+	}
+	if i.HeadlineLTE != nil {
+		predicates = append(predicates, profile.HeadlineLTE(*i.HeadlineLTE))
+	}
+	if i.HeadlineContains != nil {
+		predicates = append(predicates, profile.HeadlineContains(*i.HeadlineContains))
+	}
+	if i.HeadlineHasPrefix != nil {
+		predicates = append(predicates, profile.HeadlineHasPrefix(*i.HeadlineHasPrefix))
+	}
+	if i.HeadlineHasSuffix != nil {
+		predicates = append(predicates, profile.HeadlineHasSuffix(*i.HeadlineHasSuffix))
+	}
+	if i.HeadlineIsNil {
+		predicates = append(predicates, profile.HeadlineIsNil())
+	}
+	if i.HeadlineNotNil {
+		predicates = append(predicates, profile.HeadlineNotNil())
+	}
+	if i.HeadlineEqualFold != nil {
+		predicates = append(predicates, profile.HeadlineEqualFold(*i.HeadlineEqualFold))
+	}
+	if i.HeadlineContainsFold != nil {
+		predicates = append(predicates, profile.HeadlineContainsFold(*i.HeadlineContainsFold))
+	}
+	if i.Title != nil {
+		predicates = append(predicates, profile.TitleEQ(*i.Title))
+	}
+	if i.TitleNEQ != nil {
+		predicates = append(predicates, profile.TitleNEQ(*i.TitleNEQ))
+	}
+	if len(i.TitleIn) > 0 {
+		predicates = append(predicates, profile.TitleIn(i.TitleIn...))
+	}
+	if len(i.TitleNotIn) > 0 {
+		predicates = append(predicates, profile.TitleNotIn(i.TitleNotIn...))
+	}
+	if i.TitleGT != nil {
+		predicates = append(predicates, profile.TitleGT(*i.TitleGT))
+	}
+	if i.TitleGTE != nil {
+		predicates = append(predicates, profile.TitleGTE(*i.TitleGTE))
+	}
+	if i.TitleLT != nil {
+		predicates = append(predicates, profile.TitleLT(*i.TitleLT))
+	}
+	if i.TitleLTE != nil {
+		predicates = append(predicates, profile.TitleLTE(*i.TitleLTE))
+	}
+	if i.TitleContains != nil {
+		predicates = append(predicates, profile.TitleContains(*i.TitleContains))
+	}
+	if i.TitleHasPrefix != nil {
+		predicates = append(predicates, profile.TitleHasPrefix(*i.TitleHasPrefix))
+	}
+	if i.TitleHasSuffix != nil {
+		predicates = append(predicates, profile.TitleHasSuffix(*i.TitleHasSuffix))
+	}
+	if i.TitleIsNil {
+		predicates = append(predicates, profile.TitleIsNil())
+	}
+	if i.TitleNotNil {
+		predicates = append(predicates, profile.TitleNotNil())
+	}
+	if i.TitleEqualFold != nil {
+		predicates = append(predicates, profile.TitleEqualFold(*i.TitleEqualFold))
+	}
+	if i.TitleContainsFold != nil {
+		predicates = append(predicates, profile.TitleContainsFold(*i.TitleContainsFold))
+	}
+	if i.Country != nil {
+		predicates = append(predicates, profile.CountryEQ(*i.Country))
+	}
+	if i.CountryNEQ != nil {
+		predicates = append(predicates, profile.CountryNEQ(*i.CountryNEQ))
+	}
+	if len(i.CountryIn) > 0 {
+		predicates = append(predicates, profile.CountryIn(i.CountryIn...))
+	}
+	if len(i.CountryNotIn) > 0 {
+		predicates = append(predicates, profile.CountryNotIn(i.CountryNotIn...))
+	}
+	if i.CountryGT != nil {
+		predicates = append(predicates, profile.CountryGT(*i.CountryGT))
+	}
+	if i.CountryGTE != nil {
+		predicates = append(predicates, profile.CountryGTE(*i.CountryGTE))
+	}
+	if i.CountryLT != nil {
+		predicates = append(predicates, profile.CountryLT(*i.CountryLT))
+	}
+	if i.CountryLTE != nil {
+		predicates = append(predicates, profile.CountryLTE(*i.CountryLTE))
+	}
+	if i.CountryContains != nil {
+		predicates = append(predicates, profile.CountryContains(*i.CountryContains))
+	}
+	if i.CountryHasPrefix != nil {
+		predicates = append(predicates, profile.CountryHasPrefix(*i.CountryHasPrefix))
+	}
+	if i.CountryHasSuffix != nil {
+		predicates = append(predicates, profile.CountryHasSuffix(*i.CountryHasSuffix))
+	}
+	if i.CountryIsNil {
+		predicates = append(predicates, profile.CountryIsNil())
+	}
+	if i.CountryNotNil {
+		predicates = append(predicates, profile.CountryNotNil())
+	}
+	if i.CountryEqualFold != nil {
+		predicates = append(predicates, profile.CountryEqualFold(*i.CountryEqualFold))
+	}
+	if i.CountryContainsFold != nil {
+		predicates = append(predicates, profile.CountryContainsFold(*i.CountryContainsFold))
+	}
+	if i.City != nil {
+		predicates = append(predicates, profile.CityEQ(*i.City))
+	}
+	if i.CityNEQ != nil {
+		predicates = append(predicates, profile.CityNEQ(*i.CityNEQ))
+	}
+	if len(i.CityIn) > 0 {
+		predicates = append(predicates, profile.CityIn(i.CityIn...))
+	}
+	if len(i.CityNotIn) > 0 {
+		predicates = append(predicates, profile.CityNotIn(i.CityNotIn...))
+	}
+	if i.CityGT != nil {
+		predicates = append(predicates, profile.CityGT(*i.CityGT))
+	}
+	if i.CityGTE != nil {
+		predicates = append(predicates, profile.CityGTE(*i.CityGTE))
+	}
+	if i.CityLT != nil {
+		predicates = append(predicates, profile.CityLT(*i.CityLT))
+	}
+	if i.CityLTE != nil {
+		predicates = append(predicates, profile.CityLTE(*i.CityLTE))
+	}
+	if i.CityContains != nil {
+		predicates = append(predicates, profile.CityContains(*i.CityContains))
+	}
+	if i.CityHasPrefix != nil {
+		predicates = append(predicates, profile.CityHasPrefix(*i.CityHasPrefix))
+	}
+	if i.CityHasSuffix != nil {
+		predicates = append(predicates, profile.CityHasSuffix(*i.CityHasSuffix))
+	}
+	if i.CityIsNil {
+		predicates = append(predicates, profile.CityIsNil())
+	}
+	if i.CityNotNil {
+		predicates = append(predicates, profile.CityNotNil())
+	}
+	if i.CityEqualFold != nil {
+		predicates = append(predicates, profile.CityEqualFold(*i.CityEqualFold))
+	}
+	if i.CityContainsFold != nil {
+		predicates = append(predicates, profile.CityContainsFold(*i.CityContainsFold))
+	}
+	if i.RawDataS3Key != nil {
+		predicates = append(predicates, profile.RawDataS3KeyEQ(*i.RawDataS3Key))
+	}
+	if i.RawDataS3KeyNEQ != nil {
+		predicates = append(predicates, profile.RawDataS3KeyNEQ(*i.RawDataS3KeyNEQ))
+	}
+	if len(i.RawDataS3KeyIn) > 0 {
+		predicates = append(predicates, profile.RawDataS3KeyIn(i.RawDataS3KeyIn...))
+	}
+	if len(i.RawDataS3KeyNotIn) > 0 {
+		predicates = append(predicates, profile.RawDataS3KeyNotIn(i.RawDataS3KeyNotIn...))
+	}
+	if i.RawDataS3KeyGT != nil {
+		predicates = append(predicates, profile.RawDataS3KeyGT(*i.RawDataS3KeyGT))
+	}
+	if i.RawDataS3KeyGTE != nil {
+		predicates = append(predicates, profile.RawDataS3KeyGTE(*i.RawDataS3KeyGTE))
+	}
+	if i.RawDataS3KeyLT != nil {
+		predicates = append(predicates, profile.RawDataS3KeyLT(*i.RawDataS3KeyLT))
+	}
+	if i.RawDataS3KeyLTE != nil {
+		predicates = append(predicates, profile.RawDataS3KeyLTE(*i.RawDataS3KeyLTE))
+	}
+	if i.RawDataS3KeyContains != nil {
+		predicates = append(predicates, profile.RawDataS3KeyContains(*i.RawDataS3KeyContains))
+	}
+	if i.RawDataS3KeyHasPrefix != nil {
+		predicates = append(predicates, profile.RawDataS3KeyHasPrefix(*i.RawDataS3KeyHasPrefix))
+	}
+	if i.RawDataS3KeyHasSuffix != nil {
+		predicates = append(predicates, profile.RawDataS3KeyHasSuffix(*i.RawDataS3KeyHasSuffix))
+	}
+	if i.RawDataS3KeyIsNil {
+		predicates = append(predicates, profile.RawDataS3KeyIsNil())
+	}
+	if i.RawDataS3KeyNotNil {
+		predicates = append(predicates, profile.RawDataS3KeyNotNil())
+	}
+	if i.RawDataS3KeyEqualFold != nil {
+		predicates = append(predicates, profile.RawDataS3KeyEqualFold(*i.RawDataS3KeyEqualFold))
+	}
+	if i.RawDataS3KeyContainsFold != nil {
+		predicates = append(predicates, profile.RawDataS3KeyContainsFold(*i.RawDataS3KeyContainsFold))
+	}
+	if i.CleanedDataS3Key != nil {
+		predicates = append(predicates, profile.CleanedDataS3KeyEQ(*i.CleanedDataS3Key))
+	}
+	if i.CleanedDataS3KeyNEQ != nil {
+		predicates = append(predicates, profile.CleanedDataS3KeyNEQ(*i.CleanedDataS3KeyNEQ))
+	}
+	if len(i.CleanedDataS3KeyIn) > 0 {
+		predicates = append(predicates, profile.CleanedDataS3KeyIn(i.CleanedDataS3KeyIn...))
+	}
+	if len(i.CleanedDataS3KeyNotIn) > 0 {
+		predicates = append(predicates, profile.CleanedDataS3KeyNotIn(i.CleanedDataS3KeyNotIn...))
+	}
+	if i.CleanedDataS3KeyGT != nil {
+		predicates = append(predicates, profile.CleanedDataS3KeyGT(*i.CleanedDataS3KeyGT))
+	}
+	if i.CleanedDataS3KeyGTE != nil {
+		predicates = append(predicates, profile.CleanedDataS3KeyGTE(*i.CleanedDataS3KeyGTE))
+	}
+	if i.CleanedDataS3KeyLT != nil {
+		predicates = append(predicates, profile.CleanedDataS3KeyLT(*i.CleanedDataS3KeyLT))
+	}
+	if i.CleanedDataS3KeyLTE != nil {
+		predicates = append(predicates, profile.CleanedDataS3KeyLTE(*i.CleanedDataS3KeyLTE))
+	}
+	if i.CleanedDataS3KeyContains != nil {
+		predicates = append(predicates, profile.CleanedDataS3KeyContains(*i.CleanedDataS3KeyContains))
+	}
+	if i.CleanedDataS3KeyHasPrefix != nil {
+		predicates = append(predicates, profile.CleanedDataS3KeyHasPrefix(*i.CleanedDataS3KeyHasPrefix))
+	}
+	if i.CleanedDataS3KeyHasSuffix != nil {
+		predicates = append(predicates, profile.CleanedDataS3KeyHasSuffix(*i.CleanedDataS3KeyHasSuffix))
+	}
+	if i.CleanedDataS3KeyIsNil {
+		predicates = append(predicates, profile.CleanedDataS3KeyIsNil())
+	}
+	if i.CleanedDataS3KeyNotNil {
+		predicates = append(predicates, profile.CleanedDataS3KeyNotNil())
+	}
+	if i.CleanedDataS3KeyEqualFold != nil {
+		predicates = append(predicates, profile.CleanedDataS3KeyEqualFold(*i.CleanedDataS3KeyEqualFold))
+	}
+	if i.CleanedDataS3KeyContainsFold != nil {
+		predicates = append(predicates, profile.CleanedDataS3KeyContainsFold(*i.CleanedDataS3KeyContainsFold))
+	}
+	if i.SourceFile != nil {
+		predicates = append(predicates, profile.SourceFileEQ(*i.SourceFile))
+	}
+	if i.SourceFileNEQ != nil {
+		predicates = append(predicates, profile.SourceFileNEQ(*i.SourceFileNEQ))
+	}
+	if len(i.SourceFileIn) > 0 {
+		predicates = append(predicates, profile.SourceFileIn(i.SourceFileIn...))
+	}
+	if len(i.SourceFileNotIn) > 0 {
+		predicates = append(predicates, profile.SourceFileNotIn(i.SourceFileNotIn...))
+	}
+	if i.SourceFileGT != nil {
+		predicates = append(predicates, profile.SourceFileGT(*i.SourceFileGT))
+	}
+	if i.SourceFileGTE != nil {
+		predicates = append(predicates, profile.SourceFileGTE(*i.SourceFileGTE))
+	}
+	if i.SourceFileLT != nil {
+		predicates = append(predicates, profile.SourceFileLT(*i.SourceFileLT))
+	}
+	if i.SourceFileLTE != nil {
+		predicates = append(predicates, profile.SourceFileLTE(*i.SourceFileLTE))
+	}
+	if i.SourceFileContains != nil {
+		predicates = append(predicates, profile.SourceFileContains(*i.SourceFileContains))
+	}
+	if i.SourceFileHasPrefix != nil {
+		predicates = append(predicates, profile.SourceFileHasPrefix(*i.SourceFileHasPrefix))
+	}
+	if i.SourceFileHasSuffix != nil {
+		predicates = append(predicates, profile.SourceFileHasSuffix(*i.SourceFileHasSuffix))
+	}
+	if i.SourceFileIsNil {
+		predicates = append(predicates, profile.SourceFileIsNil())
+	}
+	if i.SourceFileNotNil {
+		predicates = append(predicates, profile.SourceFileNotNil())
+	}
+	if i.SourceFileEqualFold != nil {
+		predicates = append(predicates, profile.SourceFileEqualFold(*i.SourceFileEqualFold))
+	}
+	if i.SourceFileContainsFold != nil {
+		predicates = append(predicates, profile.SourceFileContainsFold(*i.SourceFileContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, profile.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, profile.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, profile.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, profile.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, profile.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, profile.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, profile.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, profile.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+
+	if i.HasProfileEntry != nil {
+		p := profile.HasProfileEntry()
+		if !*i.HasProfileEntry {
+			p = profile.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProfileEntryWith) > 0 {
+		with := make([]predicate.ProfileEntry, 0, len(i.HasProfileEntryWith))
+		for _, w := range i.HasProfileEntryWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProfileEntryWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profile.HasProfileEntryWith(with...))
+	}
+	if i.HasSnapshots != nil {
+		p := profile.HasSnapshots()
+		if !*i.HasSnapshots {
+			p = profile.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSnapshotsWith) > 0 {
+		with := make([]predicate.ProfileSnapshot, 0, len(i.HasSnapshotsWith))
+		for _, w := range i.HasSnapshotsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSnapshotsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profile.HasSnapshotsWith(with...))
+	}
+	if i.HasChangeEvents != nil {
+		p := profile.HasChangeEvents()
+		if !*i.HasChangeEvents {
+			p = profile.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasChangeEventsWith) > 0 {
+		with := make([]predicate.ProfileChangeEvent, 0, len(i.HasChangeEventsWith))
+		for _, w := range i.HasChangeEventsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChangeEventsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profile.HasChangeEventsWith(with...))
+	}
+	if i.HasProfilePositions != nil {
+		p := profile.HasProfilePositions()
+		if !*i.HasProfilePositions {
+			p = profile.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProfilePositionsWith) > 0 {
+		with := make([]predicate.ProfilePosition, 0, len(i.HasProfilePositionsWith))
+		for _, w := range i.HasProfilePositionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProfilePositionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profile.HasProfilePositionsWith(with...))
+	}
+	if i.HasProfileEducations != nil {
+		p := profile.HasProfileEducations()
+		if !*i.HasProfileEducations {
+			p = profile.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProfileEducationsWith) > 0 {
+		with := make([]predicate.ProfileEducation, 0, len(i.HasProfileEducationsWith))
+		for _, w := range i.HasProfileEducationsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProfileEducationsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profile.HasProfileEducationsWith(with...))
+	}
+	if i.HasProfileSkills != nil {
+		p := profile.HasProfileSkills()
+		if !*i.HasProfileSkills {
+			p = profile.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProfileSkillsWith) > 0 {
+		with := make([]predicate.ProfileSkill, 0, len(i.HasProfileSkillsWith))
+		for _, w := range i.HasProfileSkillsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProfileSkillsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profile.HasProfileSkillsWith(with...))
+	}
+	if i.HasCurrentCompanies != nil {
+		p := profile.HasCurrentCompanies()
+		if !*i.HasCurrentCompanies {
+			p = profile.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasCurrentCompaniesWith) > 0 {
+		with := make([]predicate.Company, 0, len(i.HasCurrentCompaniesWith))
+		for _, w := range i.HasCurrentCompaniesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasCurrentCompaniesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profile.HasCurrentCompaniesWith(with...))
+	}
+	if i.HasPastCompanies != nil {
+		p := profile.HasPastCompanies()
+		if !*i.HasPastCompanies {
+			p = profile.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasPastCompaniesWith) > 0 {
+		with := make([]predicate.Company, 0, len(i.HasPastCompaniesWith))
+		for _, w := range i.HasPastCompaniesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasPastCompaniesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profile.HasPastCompaniesWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyProfileWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return profile.And(predicates...), nil
+	}
+}
+
+// ProfileChangeEventWhereInput represents a where input for filtering ProfileChangeEvent queries.
+type ProfileChangeEventWhereInput struct {
+	Predicates []predicate.ProfileChangeEvent  `json:"-"`
+	Not        *ProfileChangeEventWhereInput   `json:"not,omitempty"`
+	Or         []*ProfileChangeEventWhereInput `json:"or,omitempty"`
+	And        []*ProfileChangeEventWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *ulid.ID  `json:"id,omitempty"`
+	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []ulid.ID `json:"idIn,omitempty"`
+	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *ulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *ulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
+
+	// "type" field predicates.
+	Type      *profilechangeevent.Type  `json:"type,omitempty"`
+	TypeNEQ   *profilechangeevent.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []profilechangeevent.Type `json:"typeIn,omitempty"`
+	TypeNotIn []profilechangeevent.Type `json:"typeNotIn,omitempty"`
+
+	// "old_value" field predicates.
+	OldValue             *string  `json:"oldValue,omitempty"`
+	OldValueNEQ          *string  `json:"oldValueNEQ,omitempty"`
+	OldValueIn           []string `json:"oldValueIn,omitempty"`
+	OldValueNotIn        []string `json:"oldValueNotIn,omitempty"`
+	OldValueGT           *string  `json:"oldValueGT,omitempty"`
+	OldValueGTE          *string  `json:"oldValueGTE,omitempty"`
+	OldValueLT           *string  `json:"oldValueLT,omitempty"`
+	OldValueLTE          *string  `json:"oldValueLTE,omitempty"`
+	OldValueContains     *string  `json:"oldValueContains,omitempty"`
+	OldValueHasPrefix    *string  `json:"oldValueHasPrefix,omitempty"`
+	OldValueHasSuffix    *string  `json:"oldValueHasSuffix,omitempty"`
+	OldValueIsNil        bool     `json:"oldValueIsNil,omitempty"`
+	OldValueNotNil       bool     `json:"oldValueNotNil,omitempty"`
+	OldValueEqualFold    *string  `json:"oldValueEqualFold,omitempty"`
+	OldValueContainsFold *string  `json:"oldValueContainsFold,omitempty"`
+
+	// "new_value" field predicates.
+	NewValue             *string  `json:"newValue,omitempty"`
+	NewValueNEQ          *string  `json:"newValueNEQ,omitempty"`
+	NewValueIn           []string `json:"newValueIn,omitempty"`
+	NewValueNotIn        []string `json:"newValueNotIn,omitempty"`
+	NewValueGT           *string  `json:"newValueGT,omitempty"`
+	NewValueGTE          *string  `json:"newValueGTE,omitempty"`
+	NewValueLT           *string  `json:"newValueLT,omitempty"`
+	NewValueLTE          *string  `json:"newValueLTE,omitempty"`
+	NewValueContains     *string  `json:"newValueContains,omitempty"`
+	NewValueHasPrefix    *string  `json:"newValueHasPrefix,omitempty"`
+	NewValueHasSuffix    *string  `json:"newValueHasSuffix,omitempty"`
+	NewValueIsNil        bool     `json:"newValueIsNil,omitempty"`
+	NewValueNotNil       bool     `json:"newValueNotNil,omitempty"`
+	NewValueEqualFold    *string  `json:"newValueEqualFold,omitempty"`
+	NewValueContainsFold *string  `json:"newValueContainsFold,omitempty"`
+
+	// "detected_at" field predicates.
+	DetectedAt      *time.Time  `json:"detectedAt,omitempty"`
+	DetectedAtNEQ   *time.Time  `json:"detectedAtNEQ,omitempty"`
+	DetectedAtIn    []time.Time `json:"detectedAtIn,omitempty"`
+	DetectedAtNotIn []time.Time `json:"detectedAtNotIn,omitempty"`
+	DetectedAtGT    *time.Time  `json:"detectedAtGT,omitempty"`
+	DetectedAtGTE   *time.Time  `json:"detectedAtGTE,omitempty"`
+	DetectedAtLT    *time.Time  `json:"detectedAtLT,omitempty"`
+	DetectedAtLTE   *time.Time  `json:"detectedAtLTE,omitempty"`
+
+	// "delivery_status" field predicates.
+	DeliveryStatus      *profilechangeevent.DeliveryStatus  `json:"deliveryStatus,omitempty"`
+	DeliveryStatusNEQ   *profilechangeevent.DeliveryStatus  `json:"deliveryStatusNEQ,omitempty"`
+	DeliveryStatusIn    []profilechangeevent.DeliveryStatus `json:"deliveryStatusIn,omitempty"`
+	DeliveryStatusNotIn []profilechangeevent.DeliveryStatus `json:"deliveryStatusNotIn,omitempty"`
+
+	// "delivery_attempts" field predicates.
+	DeliveryAttempts      *int  `json:"deliveryAttempts,omitempty"`
+	DeliveryAttemptsNEQ   *int  `json:"deliveryAttemptsNEQ,omitempty"`
+	DeliveryAttemptsIn    []int `json:"deliveryAttemptsIn,omitempty"`
+	DeliveryAttemptsNotIn []int `json:"deliveryAttemptsNotIn,omitempty"`
+	DeliveryAttemptsGT    *int  `json:"deliveryAttemptsGT,omitempty"`
+	DeliveryAttemptsGTE   *int  `json:"deliveryAttemptsGTE,omitempty"`
+	DeliveryAttemptsLT    *int  `json:"deliveryAttemptsLT,omitempty"`
+	DeliveryAttemptsLTE   *int  `json:"deliveryAttemptsLTE,omitempty"`
+
+	// "next_delivery_at" field predicates.
+	NextDeliveryAt       *time.Time  `json:"nextDeliveryAt,omitempty"`
+	NextDeliveryAtNEQ    *time.Time  `json:"nextDeliveryAtNEQ,omitempty"`
+	NextDeliveryAtIn     []time.Time `json:"nextDeliveryAtIn,omitempty"`
+	NextDeliveryAtNotIn  []time.Time `json:"nextDeliveryAtNotIn,omitempty"`
+	NextDeliveryAtGT     *time.Time  `json:"nextDeliveryAtGT,omitempty"`
+	NextDeliveryAtGTE    *time.Time  `json:"nextDeliveryAtGTE,omitempty"`
+	NextDeliveryAtLT     *time.Time  `json:"nextDeliveryAtLT,omitempty"`
+	NextDeliveryAtLTE    *time.Time  `json:"nextDeliveryAtLTE,omitempty"`
+	NextDeliveryAtIsNil  bool        `json:"nextDeliveryAtIsNil,omitempty"`
+	NextDeliveryAtNotNil bool        `json:"nextDeliveryAtNotNil,omitempty"`
+
+	// "delivered_at" field predicates.
+	DeliveredAt       *time.Time  `json:"deliveredAt,omitempty"`
+	DeliveredAtNEQ    *time.Time  `json:"deliveredAtNEQ,omitempty"`
+	DeliveredAtIn     []time.Time `json:"deliveredAtIn,omitempty"`
+	DeliveredAtNotIn  []time.Time `json:"deliveredAtNotIn,omitempty"`
+	DeliveredAtGT     *time.Time  `json:"deliveredAtGT,omitempty"`
+	DeliveredAtGTE    *time.Time  `json:"deliveredAtGTE,omitempty"`
+	DeliveredAtLT     *time.Time  `json:"deliveredAtLT,omitempty"`
+	DeliveredAtLTE    *time.Time  `json:"deliveredAtLTE,omitempty"`
+	DeliveredAtIsNil  bool        `json:"deliveredAtIsNil,omitempty"`
+	DeliveredAtNotNil bool        `json:"deliveredAtNotNil,omitempty"`
+
+	// "last_delivery_error" field predicates.
+	LastDeliveryError             *string  `json:"lastDeliveryError,omitempty"`
+	LastDeliveryErrorNEQ          *string  `json:"lastDeliveryErrorNEQ,omitempty"`
+	LastDeliveryErrorIn           []string `json:"lastDeliveryErrorIn,omitempty"`
+	LastDeliveryErrorNotIn        []string `json:"lastDeliveryErrorNotIn,omitempty"`
+	LastDeliveryErrorGT           *string  `json:"lastDeliveryErrorGT,omitempty"`
+	LastDeliveryErrorGTE          *string  `json:"lastDeliveryErrorGTE,omitempty"`
+	LastDeliveryErrorLT           *string  `json:"lastDeliveryErrorLT,omitempty"`
+	LastDeliveryErrorLTE          *string  `json:"lastDeliveryErrorLTE,omitempty"`
+	LastDeliveryErrorContains     *string  `json:"lastDeliveryErrorContains,omitempty"`
+	LastDeliveryErrorHasPrefix    *string  `json:"lastDeliveryErrorHasPrefix,omitempty"`
+	LastDeliveryErrorHasSuffix    *string  `json:"lastDeliveryErrorHasSuffix,omitempty"`
+	LastDeliveryErrorIsNil        bool     `json:"lastDeliveryErrorIsNil,omitempty"`
+	LastDeliveryErrorNotNil       bool     `json:"lastDeliveryErrorNotNil,omitempty"`
+	LastDeliveryErrorEqualFold    *string  `json:"lastDeliveryErrorEqualFold,omitempty"`
+	LastDeliveryErrorContainsFold *string  `json:"lastDeliveryErrorContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "profile" edge predicates.
+	HasProfile     *bool                `json:"hasProfile,omitempty"`
+	HasProfileWith []*ProfileWhereInput `json:"hasProfileWith,omitempty"`
+
+	// "snapshot" edge predicates.
+	HasSnapshot     *bool                        `json:"hasSnapshot,omitempty"`
+	HasSnapshotWith []*ProfileSnapshotWhereInput `json:"hasSnapshotWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ProfileChangeEventWhereInput) AddPredicates(predicates ...predicate.ProfileChangeEvent) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the ProfileChangeEventWhereInput filter on the ProfileChangeEventQuery builder.
+func (i *ProfileChangeEventWhereInput) Filter(q *ProfileChangeEventQuery) (*ProfileChangeEventQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyProfileChangeEventWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyProfileChangeEventWhereInput is returned in case the ProfileChangeEventWhereInput is empty.
+var ErrEmptyProfileChangeEventWhereInput = errors.New("ent: empty predicate ProfileChangeEventWhereInput")
+
+// P returns a predicate for filtering profilechangeevents.
+// An error is returned if the input is empty or invalid.
+func (i *ProfileChangeEventWhereInput) P() (predicate.ProfileChangeEvent, error) {
+	var predicates []predicate.ProfileChangeEvent
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, profilechangeevent.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.ProfileChangeEvent, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, profilechangeevent.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.ProfileChangeEvent, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, profilechangeevent.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, profilechangeevent.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, profilechangeevent.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, profilechangeevent.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, profilechangeevent.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, profilechangeevent.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, profilechangeevent.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, profilechangeevent.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, profilechangeevent.IDLTE(*i.IDLTE))
+	}
+	if i.Type != nil {
+		predicates = append(predicates, profilechangeevent.TypeEQ(*i.Type))
+	}
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, profilechangeevent.TypeNEQ(*i.TypeNEQ))
+	}
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, profilechangeevent.TypeIn(i.TypeIn...))
+	}
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, profilechangeevent.TypeNotIn(i.TypeNotIn...))
+	}
+	if i.OldValue != nil {
+		predicates = append(predicates, profilechangeevent.OldValueEQ(*i.OldValue))
+	}
+	if i.OldValueNEQ != nil {
+		predicates = append(predicates, profilechangeevent.OldValueNEQ(*i.OldValueNEQ))
+	}
+	if len(i.OldValueIn) > 0 {
+		predicates = append(predicates, profilechangeevent.OldValueIn(i.OldValueIn...))
+	}
+	if len(i.OldValueNotIn) > 0 {
+		predicates = append(predicates, profilechangeevent.OldValueNotIn(i.OldValueNotIn...))
+	}
+	if i.OldValueGT != nil {
+		predicates = append(predicates, profilechangeevent.OldValueGT(*i.OldValueGT))
+	}
+	if i.OldValueGTE != nil {
+		predicates = append(predicates, profilechangeevent.OldValueGTE(*i.OldValueGTE))
+	}
+	if i.OldValueLT != nil {
+		predicates = append(predicates, profilechangeevent.OldValueLT(*i.OldValueLT))
+	}
+	if i.OldValueLTE != nil {
+		predicates = append(predicates, profilechangeevent.OldValueLTE(*i.OldValueLTE))
+	}
+	if i.OldValueContains != nil {
+		predicates = append(predicates, profilechangeevent.OldValueContains(*i.OldValueContains))
+	}
+	if i.OldValueHasPrefix != nil {
+		predicates = append(predicates, profilechangeevent.OldValueHasPrefix(*i.OldValueHasPrefix))
+	}
+	if i.OldValueHasSuffix != nil {
+		predicates = append(predicates, profilechangeevent.OldValueHasSuffix(*i.OldValueHasSuffix))
+	}
+	if i.OldValueIsNil {
+		predicates = append(predicates, profilechangeevent.OldValueIsNil())
+	}
+	if i.OldValueNotNil {
+		predicates = append(predicates, profilechangeevent.OldValueNotNil())
+	}
+	if i.OldValueEqualFold != nil {
+		predicates = append(predicates, profilechangeevent.OldValueEqualFold(*i.OldValueEqualFold))
+	}
+	if i.OldValueContainsFold != nil {
+		predicates = append(predicates, profilechangeevent.OldValueContainsFold(*i.OldValueContainsFold))
+	}
+	if i.NewValue != nil {
+		predicates = append(predicates, profilechangeevent.NewValueEQ(*i.NewValue))
+	}
+	if i.NewValueNEQ != nil {
+		predicates = append(predicates, profilechangeevent.NewValueNEQ(*i.NewValueNEQ))
+	}
+	if len(i.NewValueIn) > 0 {
+		predicates = append(predicates, profilechangeevent.NewValueIn(i.NewValueIn...))
+	}
+	if len(i.NewValueNotIn) > 0 {
+		predicates = append(predicates, profilechangeevent.NewValueNotIn(i.NewValueNotIn...))
+	}
+	if i.NewValueGT != nil {
+		predicates = append(predicates, profilechangeevent.NewValueGT(*i.NewValueGT))
+	}
+	if i.NewValueGTE != nil {
+		predicates = append(predicates, profilechangeevent.NewValueGTE(*i.NewValueGTE))
+	}
+	if i.NewValueLT != nil {
+		predicates = append(predicates, profilechangeevent.NewValueLT(*i.NewValueLT))
+	}
+	if i.NewValueLTE != nil {
+		predicates = append(predicates, profilechangeevent.NewValueLTE(*i.NewValueLTE))
+	}
+	if i.NewValueContains != nil {
+		predicates = append(predicates, profilechangeevent.NewValueContains(*i.NewValueContains))
+	}
+	if i.NewValueHasPrefix != nil {
+		predicates = append(predicates, profilechangeevent.NewValueHasPrefix(*i.NewValueHasPrefix))
+	}
+	if i.NewValueHasSuffix != nil {
+		predicates = append(predicates, profilechangeevent.NewValueHasSuffix(*i.NewValueHasSuffix))
+	}
+	if i.NewValueIsNil {
+		predicates = append(predicates, profilechangeevent.NewValueIsNil())
+	}
+	if i.NewValueNotNil {
+		predicates = append(predicates, profilechangeevent.NewValueNotNil())
+	}
+	if i.NewValueEqualFold != nil {
+		predicates = append(predicates, profilechangeevent.NewValueEqualFold(*i.NewValueEqualFold))
+	}
+	if i.NewValueContainsFold != nil {
+		predicates = append(predicates, profilechangeevent.NewValueContainsFold(*i.NewValueContainsFold))
+	}
+	if i.DetectedAt != nil {
+		predicates = append(predicates, profilechangeevent.DetectedAtEQ(*i.DetectedAt))
+	}
+	if i.DetectedAtNEQ != nil {
+		predicates = append(predicates, profilechangeevent.DetectedAtNEQ(*i.DetectedAtNEQ))
+	}
+	if len(i.DetectedAtIn) > 0 {
+		predicates = append(predicates, profilechangeevent.DetectedAtIn(i.DetectedAtIn...))
+	}
+	if len(i.DetectedAtNotIn) > 0 {
+		predicates = append(predicates, profilechangeevent.DetectedAtNotIn(i.DetectedAtNotIn...))
+	}
+	if i.DetectedAtGT != nil {
+		predicates = append(predicates, profilechangeevent.DetectedAtGT(*i.DetectedAtGT))
+	}
+	if i.DetectedAtGTE != nil {
+		predicates = append(predicates, profilechangeevent.DetectedAtGTE(*i.DetectedAtGTE))
+	}
+	if i.DetectedAtLT != nil {
+		predicates = append(predicates, profilechangeevent.DetectedAtLT(*i.DetectedAtLT))
+	}
+	if i.DetectedAtLTE != nil {
+		predicates = append(predicates, profilechangeevent.DetectedAtLTE(*i.DetectedAtLTE))
+	}
+	if i.DeliveryStatus != nil {
+		predicates = append(predicates, profilechangeevent.DeliveryStatusEQ(*i.DeliveryStatus))
+	}
+	if i.DeliveryStatusNEQ != nil {
+		predicates = append(predicates, profilechangeevent.DeliveryStatusNEQ(*i.DeliveryStatusNEQ))
+	}
+	if len(i.DeliveryStatusIn) > 0 {
+		predicates = append(predicates, profilechangeevent.DeliveryStatusIn(i.DeliveryStatusIn...))
+	}
+	if len(i.DeliveryStatusNotIn) > 0 {
+		predicates = append(predicates, profilechangeevent.DeliveryStatusNotIn(i.DeliveryStatusNotIn...))
+	}
+	if i.DeliveryAttempts != nil {
+		predicates = append(predicates, profilechangeevent.DeliveryAttemptsEQ(*i.DeliveryAttempts))
+	}
+	if i.DeliveryAttemptsNEQ != nil {
+		predicates = append(predicates, profilechangeevent.DeliveryAttemptsNEQ(*i.DeliveryAttemptsNEQ))
+	}
+	if len(i.DeliveryAttemptsIn) > 0 {
+		predicates = append(predicates, profilechangeevent.DeliveryAttemptsIn(i.DeliveryAttemptsIn...))
+	}
+	if len(i.DeliveryAttemptsNotIn) > 0 {
+		predicates = append(predicates, profilechangeevent.DeliveryAttemptsNotIn(i.DeliveryAttemptsNotIn...))
+	}
+	if i.DeliveryAttemptsGT != nil {
+		predicates = append(predicates, profilechangeevent.DeliveryAttemptsGT(*i.DeliveryAttemptsGT))
+	}
+	if i.DeliveryAttemptsGTE != nil {
+		predicates = append(predicates, profilechangeevent.DeliveryAttemptsGTE(*i.DeliveryAttemptsGTE))
+	}
+	if i.DeliveryAttemptsLT != nil {
+		predicates = append(predicates, profilechangeevent.DeliveryAttemptsLT(*i.DeliveryAttemptsLT))
+	}
+	if i.DeliveryAttemptsLTE != nil {
+		predicates = append(predicates, profilechangeevent.DeliveryAttemptsLTE(*i.DeliveryAttemptsLTE))
+	}
+	if i.NextDeliveryAt != nil {
+		predicates = append(predicates, profilechangeevent.NextDeliveryAtEQ(*i.NextDeliveryAt))
+	}
+	if i.NextDeliveryAtNEQ != nil {
+		predicates = append(predicates, profilechangeevent.NextDeliveryAtNEQ(*i.NextDeliveryAtNEQ))
+	}
+	if len(i.NextDeliveryAtIn) > 0 {
+		predicates = append(predicates, profilechangeevent.NextDeliveryAtIn(i.NextDeliveryAtIn...))
+	}
+	if len(i.NextDeliveryAtNotIn) > 0 {
+		predicates = append(predicates, profilechangeevent.NextDeliveryAtNotIn(i.NextDeliveryAtNotIn...))
+	}
+	if i.NextDeliveryAtGT != nil {
+		predicates = append(predicates, profilechangeevent.NextDeliveryAtGT(*i.NextDeliveryAtGT))
+	}
+	if i.NextDeliveryAtGTE != nil {
+		predicates = append(predicates, profilechangeevent.NextDeliveryAtGTE(*i.NextDeliveryAtGTE))
+	}
+	if i.NextDeliveryAtLT != nil {
+		predicates = append(predicates, profilechangeevent.NextDeliveryAtLT(*i.NextDeliveryAtLT))
+	}
+	if i.NextDeliveryAtLTE != nil {
+		predicates = append(predicates, profilechangeevent.NextDeliveryAtLTE(*i.NextDeliveryAtLTE))
+	}
+	if i.NextDeliveryAtIsNil {
+		predicates = append(predicates, profilechangeevent.NextDeliveryAtIsNil())
+	}
+	if i.NextDeliveryAtNotNil {
+		predicates = append(predicates, profilechangeevent.NextDeliveryAtNotNil())
+	}
+	if i.DeliveredAt != nil {
+		predicates = append(predicates, profilechangeevent.DeliveredAtEQ(*i.DeliveredAt))
+	}
+	if i.DeliveredAtNEQ != nil {
+		predicates = append(predicates, profilechangeevent.DeliveredAtNEQ(*i.DeliveredAtNEQ))
+	}
+	if len(i.DeliveredAtIn) > 0 {
+		predicates = append(predicates, profilechangeevent.DeliveredAtIn(i.DeliveredAtIn...))
+	}
+	if len(i.DeliveredAtNotIn) > 0 {
+		predicates = append(predicates, profilechangeevent.DeliveredAtNotIn(i.DeliveredAtNotIn...))
+	}
+	if i.DeliveredAtGT != nil {
+		predicates = append(predicates, profilechangeevent.DeliveredAtGT(*i.DeliveredAtGT))
+	}
+	if i.DeliveredAtGTE != nil {
+		predicates = append(predicates, profilechangeevent.DeliveredAtGTE(*i.DeliveredAtGTE))
+	}
+	if i.DeliveredAtLT != nil {
+		predicates = append(predicates, profilechangeevent.DeliveredAtLT(*i.DeliveredAtLT))
+	}
+	if i.DeliveredAtLTE != nil {
+		predicates = append(predicates, profilechangeevent.DeliveredAtLTE(*i.DeliveredAtLTE))
+	}
+	if i.DeliveredAtIsNil {
+		predicates = append(predicates, profilechangeevent.DeliveredAtIsNil())
+	}
+	if i.DeliveredAtNotNil {
+		predicates = append(predicates, profilechangeevent.DeliveredAtNotNil())
+	}
+	if i.LastDeliveryError != nil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorEQ(*i.LastDeliveryError))
+	}
+	if i.LastDeliveryErrorNEQ != nil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorNEQ(*i.LastDeliveryErrorNEQ))
+	}
+	if len(i.LastDeliveryErrorIn) > 0 {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorIn(i.LastDeliveryErrorIn...))
+	}
+	if len(i.LastDeliveryErrorNotIn) > 0 {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorNotIn(i.LastDeliveryErrorNotIn...))
+	}
+	if i.LastDeliveryErrorGT != nil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorGT(*i.LastDeliveryErrorGT))
+	}
+	if i.LastDeliveryErrorGTE != nil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorGTE(*i.LastDeliveryErrorGTE))
+	}
+	if i.LastDeliveryErrorLT != nil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorLT(*i.LastDeliveryErrorLT))
+	}
+	if i.LastDeliveryErrorLTE != nil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorLTE(*i.LastDeliveryErrorLTE))
+	}
+	if i.LastDeliveryErrorContains != nil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorContains(*i.LastDeliveryErrorContains))
+	}
+	if i.LastDeliveryErrorHasPrefix != nil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorHasPrefix(*i.LastDeliveryErrorHasPrefix))
+	}
+	if i.LastDeliveryErrorHasSuffix != nil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorHasSuffix(*i.LastDeliveryErrorHasSuffix))
+	}
+	if i.LastDeliveryErrorIsNil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorIsNil())
+	}
+	if i.LastDeliveryErrorNotNil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorNotNil())
+	}
+	if i.LastDeliveryErrorEqualFold != nil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorEqualFold(*i.LastDeliveryErrorEqualFold))
+	}
+	if i.LastDeliveryErrorContainsFold != nil {
+		predicates = append(predicates, profilechangeevent.LastDeliveryErrorContainsFold(*i.LastDeliveryErrorContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, profilechangeevent.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, profilechangeevent.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, profilechangeevent.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, profilechangeevent.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, profilechangeevent.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, profilechangeevent.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, profilechangeevent.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, profilechangeevent.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+
+	if i.HasProfile != nil {
+		p := profilechangeevent.HasProfile()
+		if !*i.HasProfile {
+			p = profilechangeevent.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProfileWith) > 0 {
+		with := make([]predicate.Profile, 0, len(i.HasProfileWith))
+		for _, w := range i.HasProfileWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProfileWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profilechangeevent.HasProfileWith(with...))
+	}
+	if i.HasSnapshot != nil {
+		p := profilechangeevent.HasSnapshot()
+		if !*i.HasSnapshot {
+			p = profilechangeevent.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSnapshotWith) > 0 {
+		with := make([]predicate.ProfileSnapshot, 0, len(i.HasSnapshotWith))
+		for _, w := range i.HasSnapshotWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSnapshotWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profilechangeevent.HasSnapshotWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyProfileChangeEventWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return profilechangeevent.And(predicates...), nil
+	}
+}
+
+// ProfileEducationWhereInput represents a where input for filtering ProfileEducation queries.
+type ProfileEducationWhereInput struct {
+	Predicates []predicate.ProfileEducation  `json:"-"`
+	Not        *ProfileEducationWhereInput   `json:"not,omitempty"`
+	Or         []*ProfileEducationWhereInput `json:"or,omitempty"`
+	And        []*ProfileEducationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *ulid.ID  `json:"id,omitempty"`
+	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []ulid.ID `json:"idIn,omitempty"`
+	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *ulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *ulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
+
+	// "education_index" field predicates.
+	EducationIndex      *int  `json:"educationIndex,omitempty"`
+	EducationIndexNEQ   *int  `json:"educationIndexNEQ,omitempty"`
+	EducationIndexIn    []int `json:"educationIndexIn,omitempty"`
+	EducationIndexNotIn []int `json:"educationIndexNotIn,omitempty"`
+	EducationIndexGT    *int  `json:"educationIndexGT,omitempty"`
+	EducationIndexGTE   *int  `json:"educationIndexGTE,omitempty"`
+	EducationIndexLT    *int  `json:"educationIndexLT,omitempty"`
+	EducationIndexLTE   *int  `json:"educationIndexLTE,omitempty"`
+
+	// "school_name" field predicates.
+	SchoolName             *string  `json:"schoolName,omitempty"`
+	SchoolNameNEQ          *string  `json:"schoolNameNEQ,omitempty"`
+	SchoolNameIn           []string `json:"schoolNameIn,omitempty"`
+	SchoolNameNotIn        []string `json:"schoolNameNotIn,omitempty"`
+	SchoolNameGT           *string  `json:"schoolNameGT,omitempty"`
+	SchoolNameGTE          *string  `json:"schoolNameGTE,omitempty"`
+	SchoolNameLT           *string  `json:"schoolNameLT,omitempty"`
+	SchoolNameLTE          *string  `json:"schoolNameLTE,omitempty"`
+	SchoolNameContains     *string  `json:"schoolNameContains,omitempty"`
+	SchoolNameHasPrefix    *string  `json:"schoolNameHasPrefix,omitempty"`
+	SchoolNameHasSuffix    *string  `json:"schoolNameHasSuffix,omitempty"`
+	SchoolNameEqualFold    *string  `json:"schoolNameEqualFold,omitempty"`
+	SchoolNameContainsFold *string  `json:"schoolNameContainsFold,omitempty"`
+
+	// "school_id" field predicates.
+	SchoolID             *string  `json:"schoolID,omitempty"`
+	SchoolIDNEQ          *string  `json:"schoolIDNEQ,omitempty"`
+	SchoolIDIn           []string `json:"schoolIDIn,omitempty"`
+	SchoolIDNotIn        []string `json:"schoolIDNotIn,omitempty"`
+	SchoolIDGT           *string  `json:"schoolIDGT,omitempty"`
+	SchoolIDGTE          *string  `json:"schoolIDGTE,omitempty"`
+	SchoolIDLT           *string  `json:"schoolIDLT,omitempty"`
+	SchoolIDLTE          *string  `json:"schoolIDLTE,omitempty"`
+	SchoolIDContains     *string  `json:"schoolIDContains,omitempty"`
+	SchoolIDHasPrefix    *string  `json:"schoolIDHasPrefix,omitempty"`
+	SchoolIDHasSuffix    *string  `json:"schoolIDHasSuffix,omitempty"`
+	SchoolIDIsNil        bool     `json:"schoolIDIsNil,omitempty"`
+	SchoolIDNotNil       bool     `json:"schoolIDNotNil,omitempty"`
+	SchoolIDEqualFold    *string  `json:"schoolIDEqualFold,omitempty"`
+	SchoolIDContainsFold *string  `json:"schoolIDContainsFold,omitempty"`
+
+	// "degree" field predicates.
+	Degree             *string  `json:"degree,omitempty"`
+	DegreeNEQ          *string  `json:"degreeNEQ,omitempty"`
+	DegreeIn           []string `json:"degreeIn,omitempty"`
+	DegreeNotIn        []string `json:"degreeNotIn,omitempty"`
+	DegreeGT           *string  `json:"degreeGT,omitempty"`
+	DegreeGTE          *string  `json:"degreeGTE,omitempty"`
+	DegreeLT           *string  `json:"degreeLT,omitempty"`
+	DegreeLTE          *string  `json:"degreeLTE,omitempty"`
+	DegreeContains     *string  `json:"degreeContains,omitempty"`
+	DegreeHasPrefix    *string  `json:"degreeHasPrefix,omitempty"`
+	DegreeHasSuffix    *string  `json:"degreeHasSuffix,omitempty"`
+	DegreeIsNil        bool     `json:"degreeIsNil,omitempty"`
+	DegreeNotNil       bool     `json:"degreeNotNil,omitempty"`
+	DegreeEqualFold    *string  `json:"degreeEqualFold,omitempty"`
+	DegreeContainsFold *string  `json:"degreeContainsFold,omitempty"`
+
+	// "field_of_study" field predicates.
+	FieldOfStudy             *string  `json:"fieldOfStudy,omitempty"`
+	FieldOfStudyNEQ          *string  `json:"fieldOfStudyNEQ,omitempty"`
+	FieldOfStudyIn           []string `json:"fieldOfStudyIn,omitempty"`
+	FieldOfStudyNotIn        []string `json:"fieldOfStudyNotIn,omitempty"`
+	FieldOfStudyGT           *string  `json:"fieldOfStudyGT,omitempty"`
+	FieldOfStudyGTE          *string  `json:"fieldOfStudyGTE,omitempty"`
+	FieldOfStudyLT           *string  `json:"fieldOfStudyLT,omitempty"`
+	FieldOfStudyLTE          *string  `json:"fieldOfStudyLTE,omitempty"`
+	FieldOfStudyContains     *string  `json:"fieldOfStudyContains,omitempty"`
+	FieldOfStudyHasPrefix    *string  `json:"fieldOfStudyHasPrefix,omitempty"`
+	FieldOfStudyHasSuffix    *string  `json:"fieldOfStudyHasSuffix,omitempty"`
+	FieldOfStudyIsNil        bool     `json:"fieldOfStudyIsNil,omitempty"`
+	FieldOfStudyNotNil       bool     `json:"fieldOfStudyNotNil,omitempty"`
+	FieldOfStudyEqualFold    *string  `json:"fieldOfStudyEqualFold,omitempty"`
+	FieldOfStudyContainsFold *string  `json:"fieldOfStudyContainsFold,omitempty"`
+
+	// "start_date" field predicates.
+	StartDate       *time.Time  `json:"startDate,omitempty"`
+	StartDateNEQ    *time.Time  `json:"startDateNEQ,omitempty"`
+	StartDateIn     []time.Time `json:"startDateIn,omitempty"`
+	StartDateNotIn  []time.Time `json:"startDateNotIn,omitempty"`
+	StartDateGT     *time.Time  `json:"startDateGT,omitempty"`
+	StartDateGTE    *time.Time  `json:"startDateGTE,omitempty"`
+	StartDateLT     *time.Time  `json:"startDateLT,omitempty"`
+	StartDateLTE    *time.Time  `json:"startDateLTE,omitempty"`
+	StartDateIsNil  bool        `json:"startDateIsNil,omitempty"`
+	StartDateNotNil bool        `json:"startDateNotNil,omitempty"`
+
+	// "end_date" field predicates.
+	EndDate       *time.Time  `json:"endDate,omitempty"`
+	EndDateNEQ    *time.Time  `json:"endDateNEQ,omitempty"`
+	EndDateIn     []time.Time `json:"endDateIn,omitempty"`
+	EndDateNotIn  []time.Time `json:"endDateNotIn,omitempty"`
+	EndDateGT     *time.Time  `json:"endDateGT,omitempty"`
+	EndDateGTE    *time.Time  `json:"endDateGTE,omitempty"`
+	EndDateLT     *time.Time  `json:"endDateLT,omitempty"`
+	EndDateLTE    *time.Time  `json:"endDateLTE,omitempty"`
+	EndDateIsNil  bool        `json:"endDateIsNil,omitempty"`
+	EndDateNotNil bool        `json:"endDateNotNil,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "profile" edge predicates.
+	HasProfile     *bool                `json:"hasProfile,omitempty"`
+	HasProfileWith []*ProfileWhereInput `json:"hasProfileWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ProfileEducationWhereInput) AddPredicates(predicates ...predicate.ProfileEducation) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the ProfileEducationWhereInput filter on the ProfileEducationQuery builder.
+func (i *ProfileEducationWhereInput) Filter(q *ProfileEducationQuery) (*ProfileEducationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyProfileEducationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyProfileEducationWhereInput is returned in case the ProfileEducationWhereInput is empty.
+var ErrEmptyProfileEducationWhereInput = errors.New("ent: empty predicate ProfileEducationWhereInput")
+
+// P returns a predicate for filtering profileeducations.
+// An error is returned if the input is empty or invalid.
+func (i *ProfileEducationWhereInput) P() (predicate.ProfileEducation, error) {
+	var predicates []predicate.ProfileEducation
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, profileeducation.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.ProfileEducation, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, profileeducation.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.ProfileEducation, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, profileeducation.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, profileeducation.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, profileeducation.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, profileeducation.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, profileeducation.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, profileeducation.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, profileeducation.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, profileeducation.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, profileeducation.IDLTE(*i.IDLTE))
+	}
+	if i.EducationIndex != nil {
+		predicates = append(predicates, profileeducation.EducationIndexEQ(*i.EducationIndex))
+	}
+	if i.EducationIndexNEQ != nil {
+		predicates = append(predicates, profileeducation.EducationIndexNEQ(*i.EducationIndexNEQ))
+	}
+	if len(i.EducationIndexIn) > 0 {
+		predicates = append(predicates, profileeducation.EducationIndexIn(i.EducationIndexIn...))
+	}
+	if len(i.EducationIndexNotIn) > 0 {
+		predicates = append(predicates, profileeducation.EducationIndexNotIn(i.EducationIndexNotIn...))
+	}
+	if i.EducationIndexGT != nil {
+		predicates = append(predicates, profileeducation.EducationIndexGT(*i.EducationIndexGT))
+	}
+	if i.EducationIndexGTE != nil {
+		predicates = append(predicates, profileeducation.EducationIndexGTE(*i.EducationIndexGTE))
+	}
+	if i.EducationIndexLT != nil {
+		predicates = append(predicates, profileeducation.EducationIndexLT(*i.EducationIndexLT))
+	}
+	if i.EducationIndexLTE != nil {
+		predicates = append(predicates, profileeducation.EducationIndexLTE(*i.EducationIndexLTE))
+	}
+	if i.SchoolName != nil {
+		predicates = append(predicates, profileeducation.SchoolNameEQ(*i.SchoolName))
+	}
+	if i.SchoolNameNEQ != nil {
+		predicates = append(predicates, profileeducation.SchoolNameNEQ(*i.SchoolNameNEQ))
+	}
+	if len(i.SchoolNameIn) > 0 {
+		predicates = append(predicates, profileeducation.SchoolNameIn(i.SchoolNameIn...))
+	}
+	if len(i.SchoolNameNotIn) > 0 {
+		predicates = append(predicates, profileeducation.SchoolNameNotIn(i.SchoolNameNotIn...))
+	}
+	if i.SchoolNameGT != nil {
+		predicates = append(predicates, profileeducation.SchoolNameGT(*i.SchoolNameGT))
+	}
+	if i.SchoolNameGTE != nil {
+		predicates = append(predicates, profileeducation.SchoolNameGTE(*i.SchoolNameGTE))
+	}
+	if i.SchoolNameLT != nil {
+		predicates = append(predicates, profileeducation.SchoolNameLT(*i.SchoolNameLT))
+	}
+	if i.SchoolNameLTE != nil {
+		predicates = append(predicates, profileeducation.SchoolNameLTE(*i.SchoolNameLTE))
+	}
+	if i.SchoolNameContains != nil {
+		predicates = append(predicates, profileeducation.SchoolNameContains(*i.SchoolNameContains))
+	}
+	if i.SchoolNameHasPrefix != nil {
+		predicates = append(predicates, profileeducation.SchoolNameHasPrefix(*i.SchoolNameHasPrefix))
+	}
+	if i.SchoolNameHasSuffix != nil {
+		predicates = append(predicates, profileeducation.SchoolNameHasSuffix(*i.SchoolNameHasSuffix))
+	}
+	if i.SchoolNameEqualFold != nil {
+		predicates = append(predicates, profileeducation.SchoolNameEqualFold(*i.SchoolNameEqualFold))
+	}
+	if i.SchoolNameContainsFold != nil {
+		predicates = append(predicates, profileeducation.SchoolNameContainsFold(*i.SchoolNameContainsFold))
+	}
+	if i.SchoolID != nil {
+		predicates = append(predicates, profileeducation.SchoolIDEQ(*i.SchoolID))
+	}
+	if i.SchoolIDNEQ != nil {
+		predicates = append(predicates, profileeducation.SchoolIDNEQ(*i.SchoolIDNEQ))
+	}
+	if len(i.SchoolIDIn) > 0 {
+		predicates = append(predicates, profileeducation.SchoolIDIn(i.SchoolIDIn...))
+	}
+	if len(i.SchoolIDNotIn) > 0 {
+		predicates = append(predicates, profileeducation.SchoolIDNotIn(i.SchoolIDNotIn...))
+	}
+	if i.SchoolIDGT != nil {
+		predicates = append(predicates, profileeducation.SchoolIDGT(*i.SchoolIDGT))
+	}
+	if i.SchoolIDGTE != nil {
+		predicates = append(predicates, profileeducation.SchoolIDGTE(*i.SchoolIDGTE))
+	}
+	if i.SchoolIDLT != nil {
+		predicates = append(predicates, profileeducation.SchoolIDLT(*i.SchoolIDLT))
+	}
+	if i.SchoolIDLTE != nil {
+		predicates = append(predicates, profileeducation.SchoolIDLTE(*i.SchoolIDLTE))
+	}
+	if i.SchoolIDContains != nil {
+		predicates = append(predicates, profileeducation.SchoolIDContains(*i.SchoolIDContains))
+	}
+	if i.SchoolIDHasPrefix != nil {
+		predicates = append(predicates, profileeducation.SchoolIDHasPrefix(*i.SchoolIDHasPrefix))
+	}
+	if i.SchoolIDHasSuffix != nil {
+		predicates = append(predicates, profileeducation.SchoolIDHasSuffix(*i.SchoolIDHasSuffix))
+	}
+	if i.SchoolIDIsNil {
+		predicates = append(predicates, profileeducation.SchoolIDIsNil())
+	}
+	if i.SchoolIDNotNil {
+		predicates = append(predicates, profileeducation.SchoolIDNotNil())
+	}
+	if i.SchoolIDEqualFold != nil {
+		predicates = append(predicates, profileeducation.SchoolIDEqualFold(*i.SchoolIDEqualFold))
+	}
+	if i.SchoolIDContainsFold != nil {
+		predicates = append(predicates, profileeducation.SchoolIDContainsFold(*i.SchoolIDContainsFold))
+	}
+	if i.Degree != nil {
+		predicates = append(predicates, profileeducation.DegreeEQ(*i.Degree))
+	}
+	if i.DegreeNEQ != nil {
+		predicates = append(predicates, profileeducation.DegreeNEQ(*i.DegreeNEQ))
+	}
+	if len(i.DegreeIn) > 0 {
+		predicates = append(predicates, profileeducation.DegreeIn(i.DegreeIn...))
+	}
+	if len(i.DegreeNotIn) > 0 {
+		predicates = append(predicates, profileeducation.DegreeNotIn(i.DegreeNotIn...))
+	}
+	if i.DegreeGT != nil {
+		predicates = append(predicates, profileeducation.DegreeGT(*i.DegreeGT))
+	}
+	if i.DegreeGTE != nil {
+		predicates = append(predicates, profileeducation.DegreeGTE(*i.DegreeGTE))
+	}
+	if i.DegreeLT != nil {
+		predicates = append(predicates, profileeducation.DegreeLT(*i.DegreeLT))
+	}
+	if i.DegreeLTE != nil {
+		predicates = append(predicates, profileeducation.DegreeLTE(*i.DegreeLTE))
+	}
+	if i.DegreeContains != nil {
+		predicates = append(predicates, profileeducation.DegreeContains(*i.DegreeContains))
+	}
+	if i.DegreeHasPrefix != nil {
+		predicates = append(predicates, profileeducation.DegreeHasPrefix(*i.DegreeHasPrefix))
+	}
+	if i.DegreeHasSuffix != nil {
+		predicates = append(predicates, profileeducation.DegreeHasSuffix(*i.DegreeHasSuffix))
+	}
+	if i.DegreeIsNil {
+		predicates = append(predicates, profileeducation.DegreeIsNil())
+	}
+	if i.DegreeNotNil {
+		predicates = append(predicates, profileeducation.DegreeNotNil())
+	}
+	if i.DegreeEqualFold != nil {
+		predicates = append(predicates, profileeducation.DegreeEqualFold(*i.DegreeEqualFold))
+	}
+	if i.DegreeContainsFold != nil {
+		predicates = append(predicates, profileeducation.DegreeContainsFold(*i.DegreeContainsFold))
+	}
+	if i.FieldOfStudy != nil {
+		predicates = append(predicates, profileeducation.FieldOfStudyEQ(*i.FieldOfStudy))
+	}
+	if i.FieldOfStudyNEQ != nil {
+		predicates = append(predicates, profileeducation.FieldOfStudyNEQ(*i.FieldOfStudyNEQ))
+	}
+	if len(i.FieldOfStudyIn) > 0 {
+		predicates = append(predicates, profileeducation.FieldOfStudyIn(i.FieldOfStudyIn...))
+	}
+	if len(i.FieldOfStudyNotIn) > 0 {
+		predicates = append(predicates, profileeducation.FieldOfStudyNotIn(i.FieldOfStudyNotIn...))
+	}
+	if i.FieldOfStudyGT != nil {
+		predicates = append(predicates, profileeducation.FieldOfStudyGT(*i.FieldOfStudyGT))
+	}
+	if i.FieldOfStudyGTE != nil {
+		predicates = append(predicates, profileeducation.FieldOfStudyGTE(*i.FieldOfStudyGTE))
+	}
+	if i.FieldOfStudyLT != nil {
+		predicates = append(predicates, profileeducation.FieldOfStudyLT(*i.FieldOfStudyLT))
+	}
+	if i.FieldOfStudyLTE != nil {
+		predicates = append(predicates, profileeducation.FieldOfStudyLTE(*i.FieldOfStudyLTE))
+	}
+	if i.FieldOfStudyContains != nil {
+		predicates = append(predicates, profileeducation.FieldOfStudyContains(*i.FieldOfStudyContains))
+	}
+	if i.FieldOfStudyHasPrefix != nil {
+		predicates = append(predicates, profileeducation.FieldOfStudyHasPrefix(*i.FieldOfStudyHasPrefix))
+	}
+	if i.FieldOfStudyHasSuffix != nil {
+		predicates = append(predicates, profileeducation.FieldOfStudyHasSuffix(*i.FieldOfStudyHasSuffix))
+	}
+	if i.FieldOfStudyIsNil {
+		predicates = append(predicates, profileeducation.FieldOfStudyIsNil())
+	}
+	if i.FieldOfStudyNotNil {
+		predicates = append(predicates, profileeducation.FieldOfStudyNotNil())
+	}
+	if i.FieldOfStudyEqualFold != nil {
+		predicates = append(predicates, profileeducation.FieldOfStudyEqualFold(*i.FieldOfStudyEqualFold))
+	}
+	if i.FieldOfStudyContainsFold != nil {
+		predicates = append(predicates, profileeducation.FieldOfStudyContainsFold(*i.FieldOfStudyContainsFold))
+	}
+	if i.StartDate != nil {
+		predicates = append(predicates, profileeducation.StartDateEQ(*i.StartDate))
+	}
+	if i.StartDateNEQ != nil {
+		predicates = append(predicates, profileeducation.StartDateNEQ(*i.StartDateNEQ))
+	}
+	if len(i.StartDateIn) > 0 {
+		predicates = append(predicates, profileeducation.StartDateIn(i.StartDateIn...))
+	}
+	if len(i.StartDateNotIn) > 0 {
+		predicates = append(predicates, profileeducation.StartDateNotIn(i.StartDateNotIn...))
+	}
+	if i.StartDateGT != nil {
+		predicates = append(predicates, profileeducation.StartDateGT(*i.StartDateGT))
+	}
+	if i.StartDateGTE != nil {
+		predicates = append(predicates, profileeducation.StartDateGTE(*i.StartDateGTE))
+	}
+	if i.StartDateLT != nil {
+		predicates = append(predicates, profileeducation.StartDateLT(*i.StartDateLT))
+	}
+	if i.StartDateLTE != nil {
+		predicates = append(predicates, profileeducation.StartDateLTE(*i.StartDateLTE))
+	}
+	if i.StartDateIsNil {
+		predicates = append(predicates, profileeducation.StartDateIsNil())
+	}
+	if i.StartDateNotNil {
+		predicates = append(predicates, profileeducation.StartDateNotNil())
+	}
+	if i.EndDate != nil {
+		predicates = append(predicates, profileeducation.EndDateEQ(*i.EndDate))
+	}
+	if i.EndDateNEQ != nil {
+		predicates = append(predicates, profileeducation.EndDateNEQ(*i.EndDateNEQ))
+	}
+	if len(i.EndDateIn) > 0 {
+		predicates = append(predicates, profileeducation.EndDateIn(i.EndDateIn...))
+	}
+	if len(i.EndDateNotIn) > 0 {
+		predicates = append(predicates, profileeducation.EndDateNotIn(i.EndDateNotIn...))
+	}
+	if i.EndDateGT != nil {
+		predicates = append(predicates, profileeducation.EndDateGT(*i.EndDateGT))
+	}
+	if i.EndDateGTE != nil {
+		predicates = append(predicates, profileeducation.EndDateGTE(*i.EndDateGTE))
+	}
+	if i.EndDateLT != nil {
+		predicates = append(predicates, profileeducation.EndDateLT(*i.EndDateLT))
+	}
+	if i.EndDateLTE != nil {
+		predicates = append(predicates, profileeducation.EndDateLTE(*i.EndDateLTE))
+	}
+	if i.EndDateIsNil {
+		predicates = append(predicates, profileeducation.EndDateIsNil())
+	}
+	if i.EndDateNotNil {
+		predicates = append(predicates, profileeducation.EndDateNotNil())
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, profileeducation.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, profileeducation.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, profileeducation.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, profileeducation.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, profileeducation.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, profileeducation.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, profileeducation.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, profileeducation.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+
+	if i.HasProfile != nil {
+		p := profileeducation.HasProfile()
+		if !*i.HasProfile {
+			p = profileeducation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProfileWith) > 0 {
+		with := make([]predicate.Profile, 0, len(i.HasProfileWith))
+		for _, w := range i.HasProfileWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProfileWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profileeducation.HasProfileWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyProfileEducationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return profileeducation.And(predicates...), nil
+	}
+}
+
+// ProfileEntryWhereInput represents a where input for filtering ProfileEntry queries.
+type ProfileEntryWhereInput struct {
+	Predicates []predicate.ProfileEntry  `json:"-"`
+	Not        *ProfileEntryWhereInput   `json:"not,omitempty"`
+	Or         []*ProfileEntryWhereInput `json:"or,omitempty"`
+	And        []*ProfileEntryWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *ulid.ID  `json:"id,omitempty"`
+	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []ulid.ID `json:"idIn,omitempty"`
+	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *ulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *ulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "linkedin_urn" field predicates.
+	LinkedinUrn             *string  `json:"linkedinUrn,omitempty"`
+	LinkedinUrnNEQ          *string  `json:"linkedinUrnNEQ,omitempty"`
+	LinkedinUrnIn           []string `json:"linkedinUrnIn,omitempty"`
+	LinkedinUrnNotIn        []string `json:"linkedinUrnNotIn,omitempty"`
+	LinkedinUrnGT           *string  `json:"linkedinUrnGT,omitempty"`
+	LinkedinUrnGTE          *string  `json:"linkedinUrnGTE,omitempty"`
+	LinkedinUrnLT           *string  `json:"linkedinUrnLT,omitempty"`
+	LinkedinUrnLTE          *string  `json:"linkedinUrnLTE,omitempty"`
+	LinkedinUrnContains     *string  `json:"linkedinUrnContains,omitempty"`
+	LinkedinUrnHasPrefix    *string  `json:"linkedinUrnHasPrefix,omitempty"`
+	LinkedinUrnHasSuffix    *string  `json:"linkedinUrnHasSuffix,omitempty"`
+	LinkedinUrnEqualFold    *string  `json:"linkedinUrnEqualFold,omitempty"`
+	LinkedinUrnContainsFold *string  `json:"linkedinUrnContainsFold,omitempty"`
+
+	// "gender" field predicates.
+	Gender             *string  `json:"gender,omitempty"`
+	GenderNEQ          *string  `json:"genderNEQ,omitempty"`
+	GenderIn           []string `json:"genderIn,omitempty"`
+	GenderNotIn        []string `json:"genderNotIn,omitempty"`
+	GenderGT           *string  `json:"genderGT,omitempty"`
+	GenderGTE          *string  `json:"genderGTE,omitempty"`
+	GenderLT           *string  `json:"genderLT,omitempty"`
+	GenderLTE          *string  `json:"genderLTE,omitempty"`
+	GenderContains     *string  `json:"genderContains,omitempty"`
+	GenderHasPrefix    *string  `json:"genderHasPrefix,omitempty"`
+	GenderHasSuffix    *string  `json:"genderHasSuffix,omitempty"`
+	GenderIsNil        bool     `json:"genderIsNil,omitempty"`
+	GenderNotNil       bool     `json:"genderNotNil,omitempty"`
+	GenderEqualFold    *string  `json:"genderEqualFold,omitempty"`
+	GenderContainsFold *string  `json:"genderContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *profileentry.Status  `json:"status,omitempty"`
+	StatusNEQ   *profileentry.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []profileentry.Status `json:"statusIn,omitempty"`
+	StatusNotIn []profileentry.Status `json:"statusNotIn,omitempty"`
+
+	// "template_json_s3_key" field predicates.
+	TemplateJSONS3Key             *string  `json:"templateJSONS3Key,omitempty"`
+	TemplateJSONS3KeyNEQ          *string  `json:"templateJSONS3KeyNEQ,omitempty"`
+	TemplateJSONS3KeyIn           []string `json:"templateJSONS3KeyIn,omitempty"`
+	TemplateJSONS3KeyNotIn        []string `json:"templateJSONS3KeyNotIn,omitempty"`
+	TemplateJSONS3KeyGT           *string  `json:"templateJSONS3KeyGT,omitempty"`
+	TemplateJSONS3KeyGTE          *string  `json:"templateJSONS3KeyGTE,omitempty"`
+	TemplateJSONS3KeyLT           *string  `json:"templateJSONS3KeyLT,omitempty"`
+	TemplateJSONS3KeyLTE          *string  `json:"templateJSONS3KeyLTE,omitempty"`
+	TemplateJSONS3KeyContains     *string  `json:"templateJSONS3KeyContains,omitempty"`
+	TemplateJSONS3KeyHasPrefix    *string  `json:"templateJSONS3KeyHasPrefix,omitempty"`
+	TemplateJSONS3KeyHasSuffix    *string  `json:"templateJSONS3KeyHasSuffix,omitempty"`
+	TemplateJSONS3KeyIsNil        bool     `json:"templateJSONS3KeyIsNil,omitempty"`
+	TemplateJSONS3KeyNotNil       bool     `json:"templateJSONS3KeyNotNil,omitempty"`
+	TemplateJSONS3KeyEqualFold    *string  `json:"templateJSONS3KeyEqualFold,omitempty"`
+	TemplateJSONS3KeyContainsFold *string  `json:"templateJSONS3KeyContainsFold,omitempty"`
+
+	// "raw_response_s3_key" field predicates.
+	RawResponseS3Key             *string  `json:"rawResponseS3Key,omitempty"`
+	RawResponseS3KeyNEQ          *string  `json:"rawResponseS3KeyNEQ,omitempty"`
+	RawResponseS3KeyIn           []string `json:"rawResponseS3KeyIn,omitempty"`
+	RawResponseS3KeyNotIn        []string `json:"rawResponseS3KeyNotIn,omitempty"`
+	RawResponseS3KeyGT           *string  `json:"rawResponseS3KeyGT,omitempty"`
+	RawResponseS3KeyGTE          *string  `json:"rawResponseS3KeyGTE,omitempty"`
+	RawResponseS3KeyLT           *string  `json:"rawResponseS3KeyLT,omitempty"`
+	RawResponseS3KeyLTE          *string  `json:"rawResponseS3KeyLTE,omitempty"`
+	RawResponseS3KeyContains     *string  `json:"rawResponseS3KeyContains,omitempty"`
+	RawResponseS3KeyHasPrefix    *string  `json:"rawResponseS3KeyHasPrefix,omitempty"`
+	RawResponseS3KeyHasSuffix    *string  `json:"rawResponseS3KeyHasSuffix,omitempty"`
+	RawResponseS3KeyIsNil        bool     `json:"rawResponseS3KeyIsNil,omitempty"`
+	RawResponseS3KeyNotNil       bool     `json:"rawResponseS3KeyNotNil,omitempty"`
+	RawResponseS3KeyEqualFold    *string  `json:"rawResponseS3KeyEqualFold,omitempty"`
+	RawResponseS3KeyContainsFold *string  `json:"rawResponseS3KeyContainsFold,omitempty"`
+
+	// "fetch_count" field predicates.
+	FetchCount      *int  `json:"fetchCount,omitempty"`
+	FetchCountNEQ   *int  `json:"fetchCountNEQ,omitempty"`
+	FetchCountIn    []int `json:"fetchCountIn,omitempty"`
+	FetchCountNotIn []int `json:"fetchCountNotIn,omitempty"`
+	FetchCountGT    *int  `json:"fetchCountGT,omitempty"`
+	FetchCountGTE   *int  `json:"fetchCountGTE,omitempty"`
+	FetchCountLT    *int  `json:"fetchCountLT,omitempty"`
+	FetchCountLTE   *int  `json:"fetchCountLTE,omitempty"`
+
+	// "last_fetched_at" field predicates.
+	LastFetchedAt       *time.Time  `json:"lastFetchedAt,omitempty"`
+	LastFetchedAtNEQ    *time.Time  `json:"lastFetchedAtNEQ,omitempty"`
+	LastFetchedAtIn     []time.Time `json:"lastFetchedAtIn,omitempty"`
+	LastFetchedAtNotIn  []time.Time `json:"lastFetchedAtNotIn,omitempty"`
+	LastFetchedAtGT     *time.Time  `json:"lastFetchedAtGT,omitempty"`
+	LastFetchedAtGTE    *time.Time  `json:"lastFetchedAtGTE,omitempty"`
+	LastFetchedAtLT     *time.Time  `json:"lastFetchedAtLT,omitempty"`
+	LastFetchedAtLTE    *time.Time  `json:"lastFetchedAtLTE,omitempty"`
+	LastFetchedAtIsNil  bool        `json:"lastFetchedAtIsNil,omitempty"`
+	LastFetchedAtNotNil bool        `json:"lastFetchedAtNotNil,omitempty"`
+
+	// "error_message" field predicates.
+	ErrorMessage             *string  `json:"errorMessage,omitempty"`
+	ErrorMessageNEQ          *string  `json:"errorMessageNEQ,omitempty"`
+	ErrorMessageIn           []string `json:"errorMessageIn,omitempty"`
+	ErrorMessageNotIn        []string `json:"errorMessageNotIn,omitempty"`
+	ErrorMessageGT           *string  `json:"errorMessageGT,omitempty"`
+	ErrorMessageGTE          *string  `json:"errorMessageGTE,omitempty"`
+	ErrorMessageLT           *string  `json:"errorMessageLT,omitempty"`
+	ErrorMessageLTE          *string  `json:"errorMessageLTE,omitempty"`
+	ErrorMessageContains     *string  `json:"errorMessageContains,omitempty"`
+	ErrorMessageHasPrefix    *string  `json:"errorMessageHasPrefix,omitempty"`
+	ErrorMessageHasSuffix    *string  `json:"errorMessageHasSuffix,omitempty"`
+	ErrorMessageIsNil        bool     `json:"errorMessageIsNil,omitempty"`
+	ErrorMessageNotNil       bool     `json:"errorMessageNotNil,omitempty"`
+	ErrorMessageEqualFold    *string  `json:"errorMessageEqualFold,omitempty"`
+	ErrorMessageContainsFold *string  `json:"errorMessageContainsFold,omitempty"`
+
+	// "attempt_count" field predicates.
+	AttemptCount      *int  `json:"attemptCount,omitempty"`
+	AttemptCountNEQ   *int  `json:"attemptCountNEQ,omitempty"`
+	AttemptCountIn    []int `json:"attemptCountIn,omitempty"`
+	AttemptCountNotIn []int `json:"attemptCountNotIn,omitempty"`
+	AttemptCountGT    *int  `json:"attemptCountGT,omitempty"`
+	AttemptCountGTE   *int  `json:"attemptCountGTE,omitempty"`
+	AttemptCountLT    *int  `json:"attemptCountLT,omitempty"`
+	AttemptCountLTE   *int  `json:"attemptCountLTE,omitempty"`
+
+	// "next_retry_at" field predicates.
+	NextRetryAt       *time.Time  `json:"nextRetryAt,omitempty"`
+	NextRetryAtNEQ    *time.Time  `json:"nextRetryAtNEQ,omitempty"`
+	NextRetryAtIn     []time.Time `json:"nextRetryAtIn,omitempty"`
+	NextRetryAtNotIn  []time.Time `json:"nextRetryAtNotIn,omitempty"`
+	NextRetryAtGT     *time.Time  `json:"nextRetryAtGT,omitempty"`
+	NextRetryAtGTE    *time.Time  `json:"nextRetryAtGTE,omitempty"`
+	NextRetryAtLT     *time.Time  `json:"nextRetryAtLT,omitempty"`
+	NextRetryAtLTE    *time.Time  `json:"nextRetryAtLTE,omitempty"`
+	NextRetryAtIsNil  bool        `json:"nextRetryAtIsNil,omitempty"`
+	NextRetryAtNotNil bool        `json:"nextRetryAtNotNil,omitempty"`
+
+	// "lease_owner" field predicates.
+	LeaseOwner             *string  `json:"leaseOwner,omitempty"`
+	LeaseOwnerNEQ          *string  `json:"leaseOwnerNEQ,omitempty"`
+	LeaseOwnerIn           []string `json:"leaseOwnerIn,omitempty"`
+	LeaseOwnerNotIn        []string `json:"leaseOwnerNotIn,omitempty"`
+	LeaseOwnerGT           *string  `json:"leaseOwnerGT,omitempty"`
+	LeaseOwnerGTE          *string  `json:"leaseOwnerGTE,omitempty"`
+	LeaseOwnerLT           *string  `json:"leaseOwnerLT,omitempty"`
+	LeaseOwnerLTE          *string  `json:"leaseOwnerLTE,omitempty"`
+	LeaseOwnerContains     *string  `json:"leaseOwnerContains,omitempty"`
+	LeaseOwnerHasPrefix    *string  `json:"leaseOwnerHasPrefix,omitempty"`
+	LeaseOwnerHasSuffix    *string  `json:"leaseOwnerHasSuffix,omitempty"`
+	LeaseOwnerIsNil        bool     `json:"leaseOwnerIsNil,omitempty"`
+	LeaseOwnerNotNil       bool     `json:"leaseOwnerNotNil,omitempty"`
+	LeaseOwnerEqualFold    *string  `json:"leaseOwnerEqualFold,omitempty"`
+	LeaseOwnerContainsFold *string  `json:"leaseOwnerContainsFold,omitempty"`
+
+	// "lease_expires_at" field predicates.
+	LeaseExpiresAt       *time.Time  `json:"leaseExpiresAt,omitempty"`
+	LeaseExpiresAtNEQ    *time.Time  `json:"leaseExpiresAtNEQ,omitempty"`
+	LeaseExpiresAtIn     []time.Time `json:"leaseExpiresAtIn,omitempty"`
+	LeaseExpiresAtNotIn  []time.Time `json:"leaseExpiresAtNotIn,omitempty"`
+	LeaseExpiresAtGT     *time.Time  `json:"leaseExpiresAtGT,omitempty"`
+	LeaseExpiresAtGTE    *time.Time  `json:"leaseExpiresAtGTE,omitempty"`
+	LeaseExpiresAtLT     *time.Time  `json:"leaseExpiresAtLT,omitempty"`
+	LeaseExpiresAtLTE    *time.Time  `json:"leaseExpiresAtLTE,omitempty"`
+	LeaseExpiresAtIsNil  bool        `json:"leaseExpiresAtIsNil,omitempty"`
+	LeaseExpiresAtNotNil bool        `json:"leaseExpiresAtNotNil,omitempty"`
+
+	// "profile" edge predicates.
+	HasProfile     *bool                `json:"hasProfile,omitempty"`
+	HasProfileWith []*ProfileWhereInput `json:"hasProfileWith,omitempty"`
+
+	// "job_executions" edge predicates.
+	HasJobExecutions     *bool                            `json:"hasJobExecutions,omitempty"`
+	HasJobExecutionsWith []*JobExecutionHistoryWhereInput `json:"hasJobExecutionsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ProfileEntryWhereInput) AddPredicates(predicates ...predicate.ProfileEntry) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the ProfileEntryWhereInput filter on the ProfileEntryQuery builder.
+func (i *ProfileEntryWhereInput) Filter(q *ProfileEntryQuery) (*ProfileEntryQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyProfileEntryWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyProfileEntryWhereInput is returned in case the ProfileEntryWhereInput is empty.
+var ErrEmptyProfileEntryWhereInput = errors.New("ent: empty predicate ProfileEntryWhereInput")
+
+// P returns a predicate for filtering profileentries.
+// An error is returned if the input is empty or invalid.
+func (i *ProfileEntryWhereInput) P() (predicate.ProfileEntry, error) {
+	var predicates []predicate.ProfileEntry
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, profileentry.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.ProfileEntry, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, profileentry.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.ProfileEntry, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, profileentry.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, profileentry.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, profileentry.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, profileentry.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, profileentry.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, profileentry.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, profileentry.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, profileentry.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, profileentry.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, profileentry.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, profileentry.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, profileentry.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, profileentry.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, profileentry.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, profileentry.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, profileentry.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, profileentry.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.LinkedinUrn != nil {
+		predicates = append(predicates, profileentry.LinkedinUrnEQ(*i.LinkedinUrn))
+	}
+	if i.LinkedinUrnNEQ != nil {
+		predicates = append(predicates, profileentry.LinkedinUrnNEQ(*i.LinkedinUrnNEQ))
+	}
+	if len(i.LinkedinUrnIn) > 0 {
+		predicates = append(predicates, profileentry.LinkedinUrnIn(i.LinkedinUrnIn...))
+	}
+	if len(i.LinkedinUrnNotIn) > 0 {
+		predicates = append(predicates, profileentry.LinkedinUrnNotIn(i.LinkedinUrnNotIn...))
+	}
+	if i.LinkedinUrnGT != nil {
+		predicates = append(predicates, profileentry.LinkedinUrnGT(*i.LinkedinUrnGT))
+	}
+	if i.LinkedinUrnGTE != nil {
+		predicates = append(predicates, profileentry.LinkedinUrnGTE(*i.LinkedinUrnGTE))
+	}
+	if i.LinkedinUrnLT != nil {
+		predicates = append(predicates, profileentry.LinkedinUrnLT(*i.LinkedinUrnLT))
+	}
+	if i.LinkedinUrnLTE != nil {
+		predicates = append(predicates, profileentry.LinkedinUrnLTE(*i.LinkedinUrnLTE))
+	}
+	if i.LinkedinUrnContains != nil {
+		predicates = append(predicates, profileentry.LinkedinUrnContains(*i.LinkedinUrnContains))
+	}
+	if i.LinkedinUrnHasPrefix != nil {
+		predicates = append(predicates, profileentry.LinkedinUrnHasPrefix(*i.LinkedinUrnHasPrefix))
+	}
+	if i.LinkedinUrnHasSuffix != nil {
+		predicates = append(predicates, profileentry.LinkedinUrnHasSuffix(*i.LinkedinUrnHasSuffix))
+	}
+	if i.LinkedinUrnEqualFold != nil {
+		predicates = append(predicates, profileentry.LinkedinUrnEqualFold(*i.LinkedinUrnEqualFold))
+	}
+	if i.LinkedinUrnContainsFold != nil {
+		predicates = append(predicates, profileentry.LinkedinUrnContainsFold(*i.LinkedinUrnContainsFold))
+	}
+	if i.Gender != nil {
+		predicates = append(predicates, profileentry.GenderEQ(*i.Gender))
+	}
+	if i.GenderNEQ != nil {
+		predicates = append(predicates, profileentry.GenderNEQ(*i.GenderNEQ))
+	}
+	if len(i.GenderIn) > 0 {
+		predicates = append(predicates, profileentry.GenderIn(i.GenderIn...))
+	}
+	if len(i.GenderNotIn) > 0 {
+		predicates = append(predicates, profileentry.GenderNotIn(i.GenderNotIn...))
+	}
+	if i.GenderGT != nil {
+		predicates = append(predicates, profileentry.GenderGT(*i.GenderGT))
+	}
+	if i.GenderGTE != nil {
+		predicates = append(predicates, profileentry.GenderGTE(*i.GenderGTE))
+	}
+	if i.GenderLT != nil {
+		predicates = append(predicates, profileentry.GenderLT(*i.GenderLT))
+	}
+	if i.GenderLTE != nil {
+		predicates = append(predicates, profileentry.GenderLTE(*i.GenderLTE))
+	}
+	if i.GenderContains != nil {
+		predicates = append(predicates, profileentry.GenderContains(*i.GenderContains))
+	}
+	if i.GenderHasPrefix != nil {
+		predicates = append(predicates, profileentry.GenderHasPrefix(*i.GenderHasPrefix))
+	}
+	if i.GenderHasSuffix != nil {
+		predicates = append(predicates, profileentry.GenderHasSuffix(*i.GenderHasSuffix))
+	}
+	if i.GenderIsNil {
+		predicates = append(predicates, profileentry.GenderIsNil())
+	}
+	if i.GenderNotNil {
+		predicates = append(predicates, profileentry.GenderNotNil())
+	}
+	if i.GenderEqualFold != nil {
+		predicates = append(predicates, profileentry.GenderEqualFold(*i.GenderEqualFold))
+	}
+	if i.GenderContainsFold != nil {
+		predicates = append(predicates, profileentry.GenderContainsFold(*i.GenderContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, profileentry.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, profileentry.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, profileentry.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, profileentry.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.TemplateJSONS3Key != nil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyEQ(*i.TemplateJSONS3Key))
+	}
+	if i.TemplateJSONS3KeyNEQ != nil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyNEQ(*i.TemplateJSONS3KeyNEQ))
+	}
+	if len(i.TemplateJSONS3KeyIn) > 0 {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyIn(i.TemplateJSONS3KeyIn...))
+	}
+	if len(i.TemplateJSONS3KeyNotIn) > 0 {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyNotIn(i.TemplateJSONS3KeyNotIn...))
+	}
+	if i.TemplateJSONS3KeyGT != nil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyGT(*i.TemplateJSONS3KeyGT))
+	}
+	if i.TemplateJSONS3KeyGTE != nil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyGTE(*i.TemplateJSONS3KeyGTE))
+	}
+	if i.TemplateJSONS3KeyLT != nil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyLT(*i.TemplateJSONS3KeyLT))
+	}
+	if i.TemplateJSONS3KeyLTE != nil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyLTE(*i.TemplateJSONS3KeyLTE))
+	}
+	if i.TemplateJSONS3KeyContains != nil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyContains(*i.TemplateJSONS3KeyContains))
+	}
+	if i.TemplateJSONS3KeyHasPrefix != nil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyHasPrefix(*i.TemplateJSONS3KeyHasPrefix))
+	}
+	if i.TemplateJSONS3KeyHasSuffix != nil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyHasSuffix(*i.TemplateJSONS3KeyHasSuffix))
+	}
+	if i.TemplateJSONS3KeyIsNil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyIsNil())
+	}
+	if i.TemplateJSONS3KeyNotNil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyNotNil())
+	}
+	if i.TemplateJSONS3KeyEqualFold != nil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyEqualFold(*i.TemplateJSONS3KeyEqualFold))
+	}
+	if i.TemplateJSONS3KeyContainsFold != nil {
+		predicates = append(predicates, profileentry.TemplateJSONS3KeyContainsFold(*i.TemplateJSONS3KeyContainsFold))
+	}
+	if i.RawResponseS3Key != nil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyEQ(*i.RawResponseS3Key))
+	}
+	if i.RawResponseS3KeyNEQ != nil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyNEQ(*i.RawResponseS3KeyNEQ))
+	}
+	if len(i.RawResponseS3KeyIn) > 0 {
+		predicates = append(predicates, profileentry.RawResponseS3KeyIn(i.RawResponseS3KeyIn...))
+	}
+	if len(i.RawResponseS3KeyNotIn) > 0 {
+		predicates = append(predicates, profileentry.RawResponseS3KeyNotIn(i.RawResponseS3KeyNotIn...))
+	}
+	if i.RawResponseS3KeyGT != nil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyGT(*i.RawResponseS3KeyGT))
+	}
+	if i.RawResponseS3KeyGTE != nil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyGTE(*i.RawResponseS3KeyGTE))
+	}
+	if i.RawResponseS3KeyLT != nil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyLT(*i.RawResponseS3KeyLT))
+	}
+	if i.RawResponseS3KeyLTE != nil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyLTE(*i.RawResponseS3KeyLTE))
+	}
+	if i.RawResponseS3KeyContains != nil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyContains(*i.RawResponseS3KeyContains))
+	}
+	if i.RawResponseS3KeyHasPrefix != nil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyHasPrefix(*i.RawResponseS3KeyHasPrefix))
+	}
+	if i.RawResponseS3KeyHasSuffix != nil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyHasSuffix(*i.RawResponseS3KeyHasSuffix))
+	}
+	if i.RawResponseS3KeyIsNil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyIsNil())
+	}
+	if i.RawResponseS3KeyNotNil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyNotNil())
+	}
+	if i.RawResponseS3KeyEqualFold != nil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyEqualFold(*i.RawResponseS3KeyEqualFold))
+	}
+	if i.RawResponseS3KeyContainsFold != nil {
+		predicates = append(predicates, profileentry.RawResponseS3KeyContainsFold(*i.RawResponseS3KeyContainsFold))
+	}
+	if i.FetchCount != nil {
+		predicates = append(predicates, profileentry.FetchCountEQ(*i.FetchCount))
+	}
+	if i.FetchCountNEQ != nil {
+		predicates = append(predicates, profileentry.FetchCountNEQ(*i.FetchCountNEQ))
+	}
+	if len(i.FetchCountIn) > 0 {
+		predicates = append(predicates, profileentry.FetchCountIn(i.FetchCountIn...))
+	}
+	if len(i.FetchCountNotIn) > 0 {
+		predicates = append(predicates, profileentry.FetchCountNotIn(i.FetchCountNotIn...))
+	}
+	if i.FetchCountGT != nil {
+		predicates = append(predicates, profileentry.FetchCountGT(*i.FetchCountGT))
+	}
+	if i.FetchCountGTE != nil {
+		predicates = append(predicates, profileentry.FetchCountGTE(*i.FetchCountGTE))
+	}
+	if i.FetchCountLT != nil {
+		predicates = append(predicates, profileentry.FetchCountLT(*i.FetchCountLT))
+	}
+	if i.FetchCountLTE != nil {
+		predicates = append(predicates, profileentry.FetchCountLTE(*i.FetchCountLTE))
+	}
+	if i.LastFetchedAt != nil {
+		predicates = append(predicates, profileentry.LastFetchedAtEQ(*i.LastFetchedAt))
+	}
+	if i.LastFetchedAtNEQ != nil {
+		predicates = append(predicates, profileentry.LastFetchedAtNEQ(*i.LastFetchedAtNEQ))
+	}
+	if len(i.LastFetchedAtIn) > 0 {
+		predicates = append(predicates, profileentry.LastFetchedAtIn(i.LastFetchedAtIn...))
+	}
+	if len(i.LastFetchedAtNotIn) > 0 {
+		predicates = append(predicates, profileentry.LastFetchedAtNotIn(i.LastFetchedAtNotIn...))
+	}
+	if i.LastFetchedAtGT != nil {
+		predicates = append(predicates, profileentry.LastFetchedAtGT(*i.LastFetchedAtGT))
+	}
+	if i.LastFetchedAtGTE != nil {
+		predicates = append(predicates, profileentry.LastFetchedAtGTE(*i.LastFetchedAtGTE))
+	}
+	if i.LastFetchedAtLT != nil {
+		predicates = append(predicates, profileentry.LastFetchedAtLT(*i.LastFetchedAtLT))
+	}
+	if i.LastFetchedAtLTE != nil {
+		predicates = append(predicates, profileentry.LastFetchedAtLTE(*i.LastFetchedAtLTE))
+	}
+	if i.LastFetchedAtIsNil {
+		predicates = append(predicates, profileentry.LastFetchedAtIsNil())
+	}
+	if i.LastFetchedAtNotNil {
+		predicates = append(predicates, profileentry.LastFetchedAtNotNil())
+	}
+	if i.ErrorMessage != nil {
+		predicates = append(predicates, profileentry.ErrorMessageEQ(*i.ErrorMessage))
+	}
+	if i.ErrorMessageNEQ != nil {
+		predicates = append(predicates, profileentry.ErrorMessageNEQ(*i.ErrorMessageNEQ))
+	}
+	if len(i.ErrorMessageIn) > 0 {
+		predicates = append(predicates, profileentry.ErrorMessageIn(i.ErrorMessageIn...))
+	}
+	if len(i.ErrorMessageNotIn) > 0 {
+		predicates = append(predicates, profileentry.ErrorMessageNotIn(i.ErrorMessageNotIn...))
+	}
+	if i.ErrorMessageGT != nil {
+		predicates = append(predicates, profileentry.ErrorMessageGT(*i.ErrorMessageGT))
+	}
+	if i.ErrorMessageGTE != nil {
+		predicates = append(predicates, profileentry.ErrorMessageGTE(*i.ErrorMessageGTE))
+	}
+	if i.ErrorMessageLT != nil {
+		predicates = append(predicates, profileentry.ErrorMessageLT(*i.ErrorMessageLT))
+	}
+	if i.ErrorMessageLTE != nil {
+		predicates = append(predicates, profileentry.ErrorMessageLTE(*i.ErrorMessageLTE))
+	}
+	if i.ErrorMessageContains != nil {
+		predicates = append(predicates, profileentry.ErrorMessageContains(*i.ErrorMessageContains))
+	}
+	if i.ErrorMessageHasPrefix != nil {
+		predicates = append(predicates, profileentry.ErrorMessageHasPrefix(*i.ErrorMessageHasPrefix))
+	}
+	if i.ErrorMessageHasSuffix != nil {
+		predicates = append(predicates, profileentry.ErrorMessageHasSuffix(*i.ErrorMessageHasSuffix))
+	}
+	if i.ErrorMessageIsNil {
+		predicates = append(predicates, profileentry.ErrorMessageIsNil())
+	}
+	if i.ErrorMessageNotNil {
+		predicates = append(predicates, profileentry.ErrorMessageNotNil())
+	}
+	if i.ErrorMessageEqualFold != nil {
+		predicates = append(predicates, profileentry.ErrorMessageEqualFold(*i.ErrorMessageEqualFold))
+	}
+	if i.ErrorMessageContainsFold != nil {
+		predicates = append(predicates, profileentry.ErrorMessageContainsFold(*i.ErrorMessageContainsFold))
+	}
+	if i.AttemptCount != nil {
+		predicates = append(predicates, profileentry.AttemptCountEQ(*i.AttemptCount))
+	}
+	if i.AttemptCountNEQ != nil {
+		predicates = append(predicates, profileentry.AttemptCountNEQ(*i.AttemptCountNEQ))
+	}
+	if len(i.AttemptCountIn) > 0 {
+		predicates = append(predicates, profileentry.AttemptCountIn(i.AttemptCountIn...))
+	}
+	if len(i.AttemptCountNotIn) > 0 {
+		predicates = append(predicates, profileentry.AttemptCountNotIn(i.AttemptCountNotIn...))
+	}
+	if i.AttemptCountGT != nil {
+		predicates = append(predicates, profileentry.AttemptCountGT(*i.AttemptCountGT))
+	}
+	if i.AttemptCountGTE != nil {
+		predicates = append(predicates, profileentry.AttemptCountGTE(*i.AttemptCountGTE))
+	}
+	if i.AttemptCountLT != nil {
+		predicates = append(predicates, profileentry.AttemptCountLT(*i.AttemptCountLT))
+	}
+	if i.AttemptCountLTE != nil {
+		predicates = append(predicates, profileentry.AttemptCountLTE(*i.AttemptCountLTE))
+	}
+	if i.NextRetryAt != nil {
+		predicates = append(predicates, profileentry.NextRetryAtEQ(*i.NextRetryAt))
+	}
+	if i.NextRetryAtNEQ != nil {
+		predicates = append(predicates, profileentry.NextRetryAtNEQ(*i.NextRetryAtNEQ))
+	}
+	if len(i.NextRetryAtIn) > 0 {
+		predicates = append(predicates, profileentry.NextRetryAtIn(i.NextRetryAtIn...))
+	}
+	if len(i.NextRetryAtNotIn) > 0 {
+		predicates = append(predicates, profileentry.NextRetryAtNotIn(i.NextRetryAtNotIn...))
+	}
+	if i.NextRetryAtGT != nil {
+		predicates = append(predicates, profileentry.NextRetryAtGT(*i.NextRetryAtGT))
+	}
+	if i.NextRetryAtGTE != nil {
+		predicates = append(predicates, profileentry.NextRetryAtGTE(*i.NextRetryAtGTE))
+	}
+	if i.NextRetryAtLT != nil {
+		predicates = append(predicates, profileentry.NextRetryAtLT(*i.NextRetryAtLT))
+	}
+	if i.NextRetryAtLTE != nil {
+		predicates = append(predicates, profileentry.NextRetryAtLTE(*i.NextRetryAtLTE))
+	}
+	if i.NextRetryAtIsNil {
+		predicates = append(predicates, profileentry.NextRetryAtIsNil())
+	}
+	if i.NextRetryAtNotNil {
+		predicates = append(predicates, profileentry.NextRetryAtNotNil())
+	}
+	if i.LeaseOwner != nil {
+		predicates = append(predicates, profileentry.LeaseOwnerEQ(*i.LeaseOwner))
+	}
+	if i.LeaseOwnerNEQ != nil {
+		predicates = append(predicates, profileentry.LeaseOwnerNEQ(*i.LeaseOwnerNEQ))
+	}
+	if len(i.LeaseOwnerIn) > 0 {
+		predicates = append(predicates, profileentry.LeaseOwnerIn(i.LeaseOwnerIn...))
+	}
+	if len(i.LeaseOwnerNotIn) > 0 {
+		predicates = append(predicates, profileentry.LeaseOwnerNotIn(i.LeaseOwnerNotIn...))
+	}
+	if i.LeaseOwnerGT != nil {
+		predicates = append(predicates, profileentry.LeaseOwnerGT(*i.LeaseOwnerGT))
+	}
+	if i.LeaseOwnerGTE != nil {
+		predicates = append(predicates, profileentry.LeaseOwnerGTE(*i.LeaseOwnerGTE))
+	}
+	if i.LeaseOwnerLT != nil {
+		predicates = append(predicates, profileentry.LeaseOwnerLT(*i.LeaseOwnerLT))
+	}
+	if i.LeaseOwnerLTE != nil {
+		predicates = append(predicates, profileentry.LeaseOwnerLTE(*i.LeaseOwnerLTE))
+	}
+	if i.LeaseOwnerContains != nil {
+		predicates = append(predicates, profileentry.LeaseOwnerContains(*i.LeaseOwnerContains))
+	}
+	if i.LeaseOwnerHasPrefix != nil {
+		predicates = append(predicates, profileentry.LeaseOwnerHasPrefix(*i.LeaseOwnerHasPrefix))
+	}
+	if i.LeaseOwnerHasSuffix != nil {
+		predicates = append(predicates, profileentry.LeaseOwnerHasSuffix(*i.LeaseOwnerHasSuffix))
+	}
+	if i.LeaseOwnerIsNil {
+		predicates = append(predicates, profileentry.LeaseOwnerIsNil())
+	}
+	if i.LeaseOwnerNotNil {
+		predicates = append(predicates, profileentry.LeaseOwnerNotNil())
+	}
+	if i.LeaseOwnerEqualFold != nil {
+		predicates = append(predicates, profileentry.LeaseOwnerEqualFold(*i.LeaseOwnerEqualFold))
+	}
+	if i.LeaseOwnerContainsFold != nil {
+		predicates = append(predicates, profileentry.LeaseOwnerContainsFold(*i.LeaseOwnerContainsFold))
+	}
+	if i.LeaseExpiresAt != nil {
+		predicates = append(predicates, profileentry.LeaseExpiresAtEQ(*i.LeaseExpiresAt))
+	}
+	if i.LeaseExpiresAtNEQ != nil {
+		predicates = append(predicates, profileentry.LeaseExpiresAtNEQ(*i.LeaseExpiresAtNEQ))
+	}
+	if len(i.LeaseExpiresAtIn) > 0 {
+		predicates = append(predicates, profileentry.LeaseExpiresAtIn(i.LeaseExpiresAtIn...))
+	}
+	if len(i.LeaseExpiresAtNotIn) > 0 {
+		predicates = append(predicates, profileentry.LeaseExpiresAtNotIn(i.LeaseExpiresAtNotIn...))
+	}
+	if i.LeaseExpiresAtGT != nil {
+		predicates = append(predicates, profileentry.LeaseExpiresAtGT(*i.LeaseExpiresAtGT))
+	}
+	if i.LeaseExpiresAtGTE != nil {
+		predicates = append(predicates, profileentry.LeaseExpiresAtGTE(*i.LeaseExpiresAtGTE))
+	}
+	if i.LeaseExpiresAtLT != nil {
+		predicates = append(predicates, profileentry.LeaseExpiresAtLT(*i.LeaseExpiresAtLT))
+	}
+	if i.LeaseExpiresAtLTE != nil {
+		predicates = append(predicates, profileentry.LeaseExpiresAtLTE(*i.LeaseExpiresAtLTE))
+	}
+	if i.LeaseExpiresAtIsNil {
+		predicates = append(predicates, profileentry.LeaseExpiresAtIsNil())
+	}
+	if i.LeaseExpiresAtNotNil {
+		predicates = append(predicates, profileentry.LeaseExpiresAtNotNil())
+	}
+
+	if i.HasProfile != nil {
+		p := profileentry.HasProfile()
+		if !*i.HasProfile {
+			p = profileentry.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProfileWith) > 0 {
+		with := make([]predicate.Profile, 0, len(i.HasProfileWith))
+		for _, w := range i.HasProfileWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProfileWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profileentry.HasProfileWith(with...))
+	}
+	if i.HasJobExecutions != nil {
+		p := profileentry.HasJobExecutions()
+		if !*i.HasJobExecutions {
+			p = profileentry.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasJobExecutionsWith) > 0 {
+		with := make([]predicate.JobExecutionHistory, 0, len(i.HasJobExecutionsWith))
+		for _, w := range i.HasJobExecutionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasJobExecutionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profileentry.HasJobExecutionsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyProfileEntryWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return profileentry.And(predicates...), nil
+	}
+}
+
+// ProfilePositionWhereInput represents a where input for filtering ProfilePosition queries.
+type ProfilePositionWhereInput struct {
+	Predicates []predicate.ProfilePosition  `json:"-"`
+	Not        *ProfilePositionWhereInput   `json:"not,omitempty"`
+	Or         []*ProfilePositionWhereInput `json:"or,omitempty"`
+	And        []*ProfilePositionWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *ulid.ID  `json:"id,omitempty"`
+	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []ulid.ID `json:"idIn,omitempty"`
+	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *ulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *ulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
+
+	// "position_index" field predicates.
+	PositionIndex      *int  `json:"positionIndex,omitempty"`
+	PositionIndexNEQ   *int  `json:"positionIndexNEQ,omitempty"`
+	PositionIndexIn    []int `json:"positionIndexIn,omitempty"`
+	PositionIndexNotIn []int `json:"positionIndexNotIn,omitempty"`
+	PositionIndexGT    *int  `json:"positionIndexGT,omitempty"`
+	PositionIndexGTE   *int  `json:"positionIndexGTE,omitempty"`
+	PositionIndexLT    *int  `json:"positionIndexLT,omitempty"`
+	PositionIndexLTE   *int  `json:"positionIndexLTE,omitempty"`
+
+	// "company_name" field predicates.
+	CompanyName             *string  `json:"companyName,omitempty"`
+	CompanyNameNEQ          *string  `json:"companyNameNEQ,omitempty"`
+	CompanyNameIn           []string `json:"companyNameIn,omitempty"`
+	CompanyNameNotIn        []string `json:"companyNameNotIn,omitempty"`
+	CompanyNameGT           *string  `json:"companyNameGT,omitempty"`
+	CompanyNameGTE          *string  `json:"companyNameGTE,omitempty"`
+	CompanyNameLT           *string  `json:"companyNameLT,omitempty"`
+	CompanyNameLTE          *string  `json:"companyNameLTE,omitempty"`
+	CompanyNameContains     *string  `json:"companyNameContains,omitempty"`
+	CompanyNameHasPrefix    *string  `json:"companyNameHasPrefix,omitempty"`
+	CompanyNameHasSuffix    *string  `json:"companyNameHasSuffix,omitempty"`
+	CompanyNameIsNil        bool     `json:"companyNameIsNil,omitempty"`
+	CompanyNameNotNil       bool     `json:"companyNameNotNil,omitempty"`
+	CompanyNameEqualFold    *string  `json:"companyNameEqualFold,omitempty"`
+	CompanyNameContainsFold *string  `json:"companyNameContainsFold,omitempty"`
+
+	// "company_urn" field predicates.
+	CompanyUrn             *string  `json:"companyUrn,omitempty"`
+	CompanyUrnNEQ          *string  `json:"companyUrnNEQ,omitempty"`
+	CompanyUrnIn           []string `json:"companyUrnIn,omitempty"`
+	CompanyUrnNotIn        []string `json:"companyUrnNotIn,omitempty"`
+	CompanyUrnGT           *string  `json:"companyUrnGT,omitempty"`
+	CompanyUrnGTE          *string  `json:"companyUrnGTE,omitempty"`
+	CompanyUrnLT           *string  `json:"companyUrnLT,omitempty"`
+	CompanyUrnLTE          *string  `json:"companyUrnLTE,omitempty"`
+	CompanyUrnContains     *string  `json:"companyUrnContains,omitempty"`
+	CompanyUrnHasPrefix    *string  `json:"companyUrnHasPrefix,omitempty"`
+	CompanyUrnHasSuffix    *string  `json:"companyUrnHasSuffix,omitempty"`
+	CompanyUrnIsNil        bool     `json:"companyUrnIsNil,omitempty"`
+	CompanyUrnNotNil       bool     `json:"companyUrnNotNil,omitempty"`
+	CompanyUrnEqualFold    *string  `json:"companyUrnEqualFold,omitempty"`
+	CompanyUrnContainsFold *string  `json:"companyUrnContainsFold,omitempty"`
+
+	// "company_username" field predicates.
+	CompanyUsername             *string  `json:"companyUsername,omitempty"`
+	CompanyUsernameNEQ          *string  `json:"companyUsernameNEQ,omitempty"`
+	CompanyUsernameIn           []string `json:"companyUsernameIn,omitempty"`
+	CompanyUsernameNotIn        []string `json:"companyUsernameNotIn,omitempty"`
+	CompanyUsernameGT           *string  `json:"companyUsernameGT,omitempty"`
+	CompanyUsernameGTE          *string  `json:"companyUsernameGTE,omitempty"`
+	CompanyUsernameLT           *string  `json:"companyUsernameLT,omitempty"`
+	CompanyUsernameLTE          *string  `json:"companyUsernameLTE,omitempty"`
+	CompanyUsernameContains     *string  `json:"companyUsernameContains,omitempty"`
+	CompanyUsernameHasPrefix    *string  `json:"companyUsernameHasPrefix,omitempty"`
+	CompanyUsernameHasSuffix    *string  `json:"companyUsernameHasSuffix,omitempty"`
+	CompanyUsernameIsNil        bool     `json:"companyUsernameIsNil,omitempty"`
+	CompanyUsernameNotNil       bool     `json:"companyUsernameNotNil,omitempty"`
+	CompanyUsernameEqualFold    *string  `json:"companyUsernameEqualFold,omitempty"`
+	CompanyUsernameContainsFold *string  `json:"companyUsernameContainsFold,omitempty"`
+
+	// "title" field predicates.
+	Title             *string  `json:"title,omitempty"`
+	TitleNEQ          *string  `json:"titleNEQ,omitempty"`
+	TitleIn           []string `json:"titleIn,omitempty"`
+	TitleNotIn        []string `json:"titleNotIn,omitempty"`
+	TitleGT           *string  `json:"titleGT,omitempty"`
+	TitleGTE          *string  `json:"titleGTE,omitempty"`
+	TitleLT           *string  `json:"titleLT,omitempty"`
+	TitleLTE          *string  `json:"titleLTE,omitempty"`
+	TitleContains     *string  `json:"titleContains,omitempty"`
+	TitleHasPrefix    *string  `json:"titleHasPrefix,omitempty"`
+	TitleHasSuffix    *string  `json:"titleHasSuffix,omitempty"`
+	TitleIsNil        bool     `json:"titleIsNil,omitempty"`
+	TitleNotNil       bool     `json:"titleNotNil,omitempty"`
+	TitleEqualFold    *string  `json:"titleEqualFold,omitempty"`
+	TitleContainsFold *string  `json:"titleContainsFold,omitempty"`
+
+	// "location" field predicates.
+	Location             *string  `json:"location,omitempty"`
+	LocationNEQ          *string  `json:"locationNEQ,omitempty"`
+	LocationIn           []string `json:"locationIn,omitempty"`
+	LocationNotIn        []string `json:"locationNotIn,omitempty"`
+	LocationGT           *string  `json:"locationGT,omitempty"`
+	LocationGTE          *string  `json:"locationGTE,omitempty"`
+	LocationLT           *string  `json:"locationLT,omitempty"`
+	LocationLTE          *string  `json:"locationLTE,omitempty"`
+	LocationContains     *string  `json:"locationContains,omitempty"`
+	LocationHasPrefix    *string  `json:"locationHasPrefix,omitempty"`
+	LocationHasSuffix    *string  `json:"locationHasSuffix,omitempty"`
+	LocationIsNil        bool     `json:"locationIsNil,omitempty"`
+	LocationNotNil       bool     `json:"locationNotNil,omitempty"`
+	LocationEqualFold    *string  `json:"locationEqualFold,omitempty"`
+	LocationContainsFold *string  `json:"locationContainsFold,omitempty"`
+
+	// "employment_type" field predicates.
+	EmploymentType             *string  `json:"employmentType,omitempty"`
+	EmploymentTypeNEQ          *string  `json:"employmentTypeNEQ,omitempty"`
+	EmploymentTypeIn           []string `json:"employmentTypeIn,omitempty"`
+	EmploymentTypeNotIn        []string `json:"employmentTypeNotIn,omitempty"`
+	EmploymentTypeGT           *string  `json:"employmentTypeGT,omitempty"`
+	EmploymentTypeGTE          *string  `json:"employmentTypeGTE,omitempty"`
+	EmploymentTypeLT           *string  `json:"employmentTypeLT,omitempty"`
+	EmploymentTypeLTE          *string  `json:"employmentTypeLTE,omitempty"`
+	EmploymentTypeContains     *string  `json:"employmentTypeContains,omitempty"`
+	EmploymentTypeHasPrefix    *string  `json:"employmentTypeHasPrefix,omitempty"`
+	EmploymentTypeHasSuffix    *string  `json:"employmentTypeHasSuffix,omitempty"`
+	EmploymentTypeIsNil        bool     `json:"employmentTypeIsNil,omitempty"`
+	EmploymentTypeNotNil       bool     `json:"employmentTypeNotNil,omitempty"`
+	EmploymentTypeEqualFold    *string  `json:"employmentTypeEqualFold,omitempty"`
+	EmploymentTypeContainsFold *string  `json:"employmentTypeContainsFold,omitempty"`
+
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+
+	// "start_date" field predicates.
+	StartDate       *time.Time  `json:"startDate,omitempty"`
+	StartDateNEQ    *time.Time  `json:"startDateNEQ,omitempty"`
+	StartDateIn     []time.Time `json:"startDateIn,omitempty"`
+	StartDateNotIn  []time.Time `json:"startDateNotIn,omitempty"`
+	StartDateGT     *time.Time  `json:"startDateGT,omitempty"`
+	StartDateGTE    *time.Time  `json:"startDateGTE,omitempty"`
+	StartDateLT     *time.Time  `json:"startDateLT,omitempty"`
+	StartDateLTE    *time.Time  `json:"startDateLTE,omitempty"`
+	StartDateIsNil  bool        `json:"startDateIsNil,omitempty"`
+	StartDateNotNil bool        `json:"startDateNotNil,omitempty"`
+
+	// "end_date" field predicates.
+	EndDate       *time.Time  `json:"endDate,omitempty"`
+	EndDateNEQ    *time.Time  `json:"endDateNEQ,omitempty"`
+	EndDateIn     []time.Time `json:"endDateIn,omitempty"`
+	EndDateNotIn  []time.Time `json:"endDateNotIn,omitempty"`
+	EndDateGT     *time.Time  `json:"endDateGT,omitempty"`
+	EndDateGTE    *time.Time  `json:"endDateGTE,omitempty"`
+	EndDateLT     *time.Time  `json:"endDateLT,omitempty"`
+	EndDateLTE    *time.Time  `json:"endDateLTE,omitempty"`
+	EndDateIsNil  bool        `json:"endDateIsNil,omitempty"`
+	EndDateNotNil bool        `json:"endDateNotNil,omitempty"`
+
+	// "is_current" field predicates.
+	IsCurrent    *bool `json:"isCurrent,omitempty"`
+	IsCurrentNEQ *bool `json:"isCurrentNEQ,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "profile" edge predicates.
+	HasProfile     *bool                `json:"hasProfile,omitempty"`
+	HasProfileWith []*ProfileWhereInput `json:"hasProfileWith,omitempty"`
+
+	// "company" edge predicates.
+	HasCompany     *bool                `json:"hasCompany,omitempty"`
+	HasCompanyWith []*CompanyWhereInput `json:"hasCompanyWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ProfilePositionWhereInput) AddPredicates(predicates ...predicate.ProfilePosition) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the ProfilePositionWhereInput filter on the ProfilePositionQuery builder.
+func (i *ProfilePositionWhereInput) Filter(q *ProfilePositionQuery) (*ProfilePositionQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyProfilePositionWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyProfilePositionWhereInput is returned in case the ProfilePositionWhereInput is empty.
+var ErrEmptyProfilePositionWhereInput = errors.New("ent: empty predicate ProfilePositionWhereInput")
+
+// P returns a predicate for filtering profilepositions.
+// An error is returned if the input is empty or invalid.
+func (i *ProfilePositionWhereInput) P() (predicate.ProfilePosition, error) {
+	var predicates []predicate.ProfilePosition
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, profileposition.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.ProfilePosition, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, profileposition.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.ProfilePosition, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, profileposition.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, profileposition.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, profileposition.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, profileposition.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, profileposition.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, profileposition.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, profileposition.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, profileposition.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, profileposition.IDLTE(*i.IDLTE))
+	}
+	if i.PositionIndex != nil {
+		predicates = append(predicates, profileposition.PositionIndexEQ(*i.PositionIndex))
+	}
+	if i.PositionIndexNEQ != nil {
+		predicates = append(predicates, profileposition.PositionIndexNEQ(*i.PositionIndexNEQ))
+	}
+	if len(i.PositionIndexIn) > 0 {
+		predicates = append(predicates, profileposition.PositionIndexIn(i.PositionIndexIn...))
+	}
+	if len(i.PositionIndexNotIn) > 0 {
+		predicates = append(predicates, profileposition.PositionIndexNotIn(i.PositionIndexNotIn...))
+	}
+	if i.PositionIndexGT != nil {
+		predicates = append(predicates, profileposition.PositionIndexGT(*i.PositionIndexGT))
+	}
+	if i.PositionIndexGTE != nil {
+		predicates = append(predicates, profileposition.PositionIndexGTE(*i.PositionIndexGTE))
+	}
+	if i.PositionIndexLT != nil {
+		predicates = append(predicates, profileposition.PositionIndexLT(*i.PositionIndexLT))
+	}
+	if i.PositionIndexLTE != nil {
+		predicates = append(predicates, profileposition.PositionIndexLTE(*i.PositionIndexLTE))
+	}
+	if i.CompanyName != nil {
+		predicates = append(predicates, profileposition.CompanyNameEQ(*i.CompanyName))
+	}
+	if i.CompanyNameNEQ != nil {
+		predicates = append(predicates, profileposition.CompanyNameNEQ(*i.CompanyNameNEQ))
+	}
+	if len(i.CompanyNameIn) > 0 {
+		predicates = append(predicates, profileposition.CompanyNameIn(i.CompanyNameIn...))
+	}
+	if len(i.CompanyNameNotIn) > 0 {
+		predicates = append(predicates, profileposition.CompanyNameNotIn(i.CompanyNameNotIn...))
+	}
+	if i.CompanyNameGT != nil {
+		predicates = append(predicates, profileposition.CompanyNameGT(*i.CompanyNameGT))
+	}
+	if i.CompanyNameGTE != nil {
+		predicates = append(predicates, profileposition.CompanyNameGTE(*i.CompanyNameGTE))
+	}
+	if i.CompanyNameLT != nil {
+		predicates = append(predicates, profileposition.CompanyNameLT(*i.CompanyNameLT))
+	}
+	if i.CompanyNameLTE != nil {
+		predicates = append(predicates, profileposition.CompanyNameLTE(*i.CompanyNameLTE))
+	}
+	if i.CompanyNameContains != nil {
+		predicates = append(predicates, profileposition.CompanyNameContains(*i.CompanyNameContains))
+	}
+	if i.CompanyNameHasPrefix != nil {
+		predicates = append(predicates, profileposition.CompanyNameHasPrefix(*i.CompanyNameHasPrefix))
+	}
+	if i.CompanyNameHasSuffix != nil {
+		predicates = append(predicates, profileposition.CompanyNameHasSuffix(*i.CompanyNameHasSuffix))
+	}
+	if i.CompanyNameIsNil {
+		predicates = append(predicates, profileposition.CompanyNameIsNil())
+	}
+	if i.CompanyNameNotNil {
+		predicates = append(predicates, profileposition.CompanyNameNotNil())
+	}
+	if i.CompanyNameEqualFold != nil {
+		predicates = append(predicates, profileposition.CompanyNameEqualFold(*i.CompanyNameEqualFold))
+	}
+	if i.CompanyNameContainsFold != nil {
+		predicates = append(predicates, profileposition.CompanyNameContainsFold(*i.CompanyNameContainsFold))
+	}
+	if i.CompanyUrn != nil {
+		predicates = append(predicates, profileposition.CompanyUrnEQ(*i.CompanyUrn))
+	}
+	if i.CompanyUrnNEQ != nil {
+		predicates = append(predicates, profileposition.CompanyUrnNEQ(*i.CompanyUrnNEQ))
+	}
+	if len(i.CompanyUrnIn) > 0 {
+		predicates = append(predicates, profileposition.CompanyUrnIn(i.CompanyUrnIn...))
+	}
+	if len(i.CompanyUrnNotIn) > 0 {
+		predicates = append(predicates, profileposition.CompanyUrnNotIn(i.CompanyUrnNotIn...))
+	}
+	if i.CompanyUrnGT != nil {
+		predicates = append(predicates, profileposition.CompanyUrnGT(*i.CompanyUrnGT))
+	}
+	if i.CompanyUrnGTE != nil {
+		predicates = append(predicates, profileposition.CompanyUrnGTE(*i.CompanyUrnGTE))
+	}
+	if i.CompanyUrnLT != nil {
+		predicates = append(predicates, profileposition.CompanyUrnLT(*i.CompanyUrnLT))
+	}
+	if i.CompanyUrnLTE != nil {
+		predicates = append(predicates, profileposition.CompanyUrnLTE(*i.CompanyUrnLTE))
+	}
+	if i.CompanyUrnContains != nil {
+		predicates = append(predicates, profileposition.CompanyUrnContains(*i.CompanyUrnContains))
+	}
+	if i.CompanyUrnHasPrefix != nil {
+		predicates = append(predicates, profileposition.CompanyUrnHasPrefix(*i.CompanyUrnHasPrefix))
+	}
+	if i.CompanyUrnHasSuffix != nil {
+		predicates = append(predicates, profileposition.CompanyUrnHasSuffix(*i.CompanyUrnHasSuffix))
+	}
+	if i.CompanyUrnIsNil {
+		predicates = append(predicates, profileposition.CompanyUrnIsNil())
+	}
+	if i.CompanyUrnNotNil {
+		predicates = append(predicates, profileposition.CompanyUrnNotNil())
+	}
+	if i.CompanyUrnEqualFold != nil {
+		predicates = append(predicates, profileposition.CompanyUrnEqualFold(*i.CompanyUrnEqualFold))
+	}
+	if i.CompanyUrnContainsFold != nil {
+		predicates = append(predicates, profileposition.CompanyUrnContainsFold(*i.CompanyUrnContainsFold))
+	}
+	if i.CompanyUsername != nil {
+		predicates = append(predicates, profileposition.CompanyUsernameEQ(*i.CompanyUsername))
+	}
+	if i.CompanyUsernameNEQ != nil {
+		predicates = append(predicates, profileposition.CompanyUsernameNEQ(*i.CompanyUsernameNEQ))
+	}
+	if len(i.CompanyUsernameIn) > 0 {
+		predicates = append(predicates, profileposition.CompanyUsernameIn(i.CompanyUsernameIn...))
+	}
+	if len(i.CompanyUsernameNotIn) > 0 {
+		predicates = append(predicates, profileposition.CompanyUsernameNotIn(i.CompanyUsernameNotIn...))
+	}
+	if i.CompanyUsernameGT != nil {
+		predicates = append(predicates, profileposition.CompanyUsernameGT(*i.CompanyUsernameGT))
+	}
+	if i.CompanyUsernameGTE != nil {
+		predicates = append(predicates, profileposition.CompanyUsernameGTE(*i.CompanyUsernameGTE))
+	}
+	if i.CompanyUsernameLT != nil {
+		predicates = append(predicates, profileposition.CompanyUsernameLT(*i.CompanyUsernameLT))
+	}
+	if i.CompanyUsernameLTE != nil {
+		predicates = append(predicates, profileposition.CompanyUsernameLTE(*i.CompanyUsernameLTE))
+	}
+	if i.CompanyUsernameContains != nil {
+		predicates = append(predicates, profileposition.CompanyUsernameContains(*i.CompanyUsernameContains))
+	}
+	if i.CompanyUsernameHasPrefix != nil {
+		predicates = append(predicates, profileposition.CompanyUsernameHasPrefix(*i.CompanyUsernameHasPrefix))
+	}
+	if i.CompanyUsernameHasSuffix != nil {
+		predicates = append(predicates, profileposition.CompanyUsernameHasSuffix(*i.CompanyUsernameHasSuffix))
+	}
+	if i.CompanyUsernameIsNil {
+		predicates = append(predicates, profileposition.CompanyUsernameIsNil())
+	}
+	if i.CompanyUsernameNotNil {
+		predicates = append(predicates, profileposition.CompanyUsernameNotNil())
+	}
+	if i.CompanyUsernameEqualFold != nil {
+		predicates = append(predicates, profileposition.CompanyUsernameEqualFold(*i.CompanyUsernameEqualFold))
+	}
+	if i.CompanyUsernameContainsFold != nil {
+		predicates = append(predicates, profileposition.CompanyUsernameContainsFold(*i.CompanyUsernameContainsFold))
+	}
+	if i.Title != nil {
+		predicates = append(predicates, profileposition.TitleEQ(*i.Title))
+	}
+	if i.TitleNEQ != nil {
+		predicates = append(predicates, profileposition.TitleNEQ(*i.TitleNEQ))
+	}
+	if len(i.TitleIn) > 0 {
+		predicates = append(predicates, profileposition.TitleIn(i.TitleIn...))
+	}
+	if len(i.TitleNotIn) > 0 {
+		predicates = append(predicates, profileposition.TitleNotIn(i.TitleNotIn...))
+	}
+	if i.TitleGT != nil {
+		predicates = append(predicates, profileposition.TitleGT(*i.TitleGT))
+	}
+	if i.TitleGTE != nil {
+		predicates = append(predicates, profileposition.TitleGTE(*i.TitleGTE))
+	}
+	if i.TitleLT != nil {
+		predicates = append(predicates, profileposition.TitleLT(*i.TitleLT))
+	}
+	if i.TitleLTE != nil {
+		predicates = append(predicates, profileposition.TitleLTE(*i.TitleLTE))
+	}
+	if i.TitleContains != nil {
+		predicates = append(predicates, profileposition.TitleContains(*i.TitleContains))
+	}
+	if i.TitleHasPrefix != nil {
+		predicates = append(predicates, profileposition.TitleHasPrefix(*i.TitleHasPrefix))
+	}
+	if i.TitleHasSuffix != nil {
+		predicates = append(predicates, profileposition.TitleHasSuffix(*i.TitleHasSuffix))
+	}
+	if i.TitleIsNil {
+		predicates = append(predicates, profileposition.TitleIsNil())
+	}
+	if i.TitleNotNil {
+		predicates = append(predicates, profileposition.TitleNotNil())
+	}
+	if i.TitleEqualFold != nil {
+		predicates = append(predicates, profileposition.TitleEqualFold(*i.TitleEqualFold))
+	}
+	if i.TitleContainsFold != nil {
+		predicates = append(predicates, profileposition.TitleContainsFold(*i.TitleContainsFold))
+	}
+	if i.Location != nil {
+		predicates = append(predicates, profileposition.LocationEQ(*i.Location))
+	}
+	if i.LocationNEQ != nil {
+		predicates = append(predicates, profileposition.LocationNEQ(*i.LocationNEQ))
+	}
+	if len(i.LocationIn) > 0 {
+		predicates = append(predicates, profileposition.LocationIn(i.LocationIn...))
+	}
+	if len(i.LocationNotIn) > 0 {
+		predicates = append(predicates, profileposition.LocationNotIn(i.LocationNotIn...))
+	}
+	if i.LocationGT != nil {
+		predicates = append(predicates, profileposition.LocationGT(*i.LocationGT))
+	}
+	if i.LocationGTE != nil {
+		predicates = append(predicates, profileposition.LocationGTE(*i.LocationGTE))
+	}
+	if i.LocationLT != nil {
+		predicates = append(predicates, profileposition.LocationLT(*i.LocationLT))
+	}
+	if i.LocationLTE != nil {
+		predicates = append(predicates, profileposition.LocationLTE(*i.LocationLTE))
+	}
+	if i.LocationContains != nil {
+		predicates = append(predicates, profileposition.LocationContains(*i.LocationContains))
+	}
+	if i.LocationHasPrefix != nil {
+		predicates = append(predicates, profileposition.LocationHasPrefix(*i.LocationHasPrefix))
+	}
+	if i.LocationHasSuffix != nil {
+		predicates = append(predicates, profileposition.LocationHasSuffix(*i.LocationHasSuffix))
+	}
+	if i.LocationIsNil {
+		predicates = append(predicates, profileposition.LocationIsNil())
+	}
+	if i.LocationNotNil {
+		predicates = append(predicates, profileposition.LocationNotNil())
+	}
+	if i.LocationEqualFold != nil {
+		predicates = append(predicates, profileposition.LocationEqualFold(*i.LocationEqualFold))
+	}
+	if i.LocationContainsFold != nil {
+		predicates = append(predicates, profileposition.LocationContainsFold(*i.LocationContainsFold))
+	}
+	if i.EmploymentType != nil {
+		predicates = append(predicates, profileposition.EmploymentTypeEQ(*i.EmploymentType))
+	}
+	if i.EmploymentTypeNEQ != nil {
+		predicates = append(predicates, profileposition.EmploymentTypeNEQ(*i.EmploymentTypeNEQ))
+	}
+	if len(i.EmploymentTypeIn) > 0 {
+		predicates = append(predicates, profileposition.EmploymentTypeIn(i.EmploymentTypeIn...))
+	}
+	if len(i.EmploymentTypeNotIn) > 0 {
+		predicates = append(predicates, profileposition.EmploymentTypeNotIn(i.EmploymentTypeNotIn...))
+	}
+	if i.EmploymentTypeGT != nil {
+		predicates = append(predicates, profileposition.EmploymentTypeGT(*i.EmploymentTypeGT))
+	}
+	if i.EmploymentTypeGTE != nil {
+		predicates = append(predicates, profileposition.EmploymentTypeGTE(*i.EmploymentTypeGTE))
+	}
+	if i.EmploymentTypeLT != nil {
+		predicates = append(predicates, profileposition.EmploymentTypeLT(*i.EmploymentTypeLT))
+	}
+	if i.EmploymentTypeLTE != nil {
+		predicates = append(predicates, profileposition.EmploymentTypeLTE(*i.EmploymentTypeLTE))
+	}
+	if i.EmploymentTypeContains != nil {
+		predicates = append(predicates, profileposition.EmploymentTypeContains(*i.EmploymentTypeContains))
+	}
+	if i.EmploymentTypeHasPrefix != nil {
+		predicates = append(predicates, profileposition.EmploymentTypeHasPrefix(*i.EmploymentTypeHasPrefix))
+	}
+	if i.EmploymentTypeHasSuffix != nil {
+		predicates = append(predicates, profileposition.EmploymentTypeHasSuffix(*i.EmploymentTypeHasSuffix))
+	}
+	if i.EmploymentTypeIsNil {
+		predicates = append(predicates, profileposition.EmploymentTypeIsNil())
+	}
+	if i.EmploymentTypeNotNil {
+		predicates = append(predicates, profileposition.EmploymentTypeNotNil())
+	}
+	if i.EmploymentTypeEqualFold != nil {
+		predicates = append(predicates, profileposition.EmploymentTypeEqualFold(*i.EmploymentTypeEqualFold))
+	}
+	if i.EmploymentTypeContainsFold != nil {
+		predicates = append(predicates, profileposition.EmploymentTypeContainsFold(*i.EmploymentTypeContainsFold))
+	}
+	if i.Description != nil {
+		predicates = append(predicates, profileposition.DescriptionEQ(*i.Description))
+	}
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, profileposition.DescriptionNEQ(*i.DescriptionNEQ))
+	}
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, profileposition.DescriptionIn(i.DescriptionIn...))
+	}
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, profileposition.DescriptionNotIn(i.DescriptionNotIn...))
+	}
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, profileposition.DescriptionGT(*i.DescriptionGT))
+	}
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, profileposition.DescriptionGTE(*i.DescriptionGTE))
+	}
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, profileposition.DescriptionLT(*i.DescriptionLT))
+	}
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, profileposition.DescriptionLTE(*i.DescriptionLTE))
+	}
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, profileposition.DescriptionContains(*i.DescriptionContains))
+	}
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, profileposition.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	}
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, profileposition.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	}
+	if i.DescriptionIsNil {
+		predicates = append(predicates, profileposition.DescriptionIsNil())
+	}
+	if i.DescriptionNotNil {
+		predicates = append(predicates, profileposition.DescriptionNotNil())
+	}
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, profileposition.DescriptionEqualFold(*i.DescriptionEqualFold))
+	}
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, profileposition.DescriptionContainsFold(*i.DescriptionContainsFold))
+	}
+	if i.StartDate != nil {
+		predicates = append(predicates, profileposition.StartDateEQ(*i.StartDate))
+	}
+	if i.StartDateNEQ != nil {
+		predicates = append(predicates, profileposition.StartDateNEQ(*i.StartDateNEQ))
+	}
+	if len(i.StartDateIn) > 0 {
+		predicates = append(predicates, profileposition.StartDateIn(i.StartDateIn...))
+	}
+	if len(i.StartDateNotIn) > 0 {
+		predicates = append(predicates, profileposition.StartDateNotIn(i.StartDateNotIn...))
+	}
+	if i.StartDateGT != nil {
+		predicates = append(predicates, profileposition.StartDateGT(*i.StartDateGT))
+	}
+	if i.StartDateGTE != nil {
+		predicates = append(predicates, profileposition.StartDateGTE(*i.StartDateGTE))
+	}
+	if i.StartDateLT != nil {
+		predicates = append(predicates, profileposition.StartDateLT(*i.StartDateLT))
+	}
+	if i.StartDateLTE != nil {
+		predicates = append(predicates, profileposition.StartDateLTE(*i.StartDateLTE))
+	}
+	if i.StartDateIsNil {
+		predicates = append(predicates, profileposition.StartDateIsNil())
+	}
+	if i.StartDateNotNil {
+		predicates = append(predicates, profileposition.StartDateNotNil())
+	}
+	if i.EndDate != nil {
+		predicates = append(predicates, profileposition.EndDateEQ(*i.EndDate))
+	}
+	if i.EndDateNEQ != nil {
+		predicates = append(predicates, profileposition.EndDateNEQ(*i.EndDateNEQ))
+	}
+	if len(i.EndDateIn) > 0 {
+		predicates = append(predicates, profileposition.EndDateIn(i.EndDateIn...))
+	}
+	if len(i.EndDateNotIn) > 0 {
+		predicates = append(predicates, profileposition.EndDateNotIn(i.EndDateNotIn...))
+	}
+	if i.EndDateGT != nil {
+		predicates = append(predicates, profileposition.EndDateGT(*i.EndDateGT))
+	}
+	if i.EndDateGTE != nil {
+		predicates = append(predicates, profileposition.EndDateGTE(*i.EndDateGTE))
+	}
+	if i.EndDateLT != nil {
+		predicates = append(predicates, profileposition.EndDateLT(*i.EndDateLT))
+	}
+	if i.EndDateLTE != nil {
+		predicates = append(predicates, profileposition.EndDateLTE(*i.EndDateLTE))
+	}
+	if i.EndDateIsNil {
+		predicates = append(predicates, profileposition.EndDateIsNil())
+	}
+	if i.EndDateNotNil {
+		predicates = append(predicates, profileposition.EndDateNotNil())
+	}
+	if i.IsCurrent != nil {
+		predicates = append(predicates, profileposition.IsCurrentEQ(*i.IsCurrent))
+	}
+	if i.IsCurrentNEQ != nil {
+		predicates = append(predicates, profileposition.IsCurrentNEQ(*i.IsCurrentNEQ))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, profileposition.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, profileposition.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, profileposition.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, profileposition.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, profileposition.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, profileposition.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, profileposition.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, profileposition.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+
+	if i.HasProfile != nil {
+		p := profileposition.HasProfile()
+		if !*i.HasProfile {
+			p = profileposition.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProfileWith) > 0 {
+		with := make([]predicate.Profile, 0, len(i.HasProfileWith))
+		for _, w := range i.HasProfileWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProfileWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profileposition.HasProfileWith(with...))
+	}
+	if i.HasCompany != nil {
+		p := profileposition.HasCompany()
+		if !*i.HasCompany {
+			p = profileposition.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasCompanyWith) > 0 {
+		with := make([]predicate.Company, 0, len(i.HasCompanyWith))
+		for _, w := range i.HasCompanyWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasCompanyWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profileposition.HasCompanyWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyProfilePositionWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return profileposition.And(predicates...), nil
+	}
+}
+
+// ProfilePostWhereInput represents a where input for filtering ProfilePost queries.
+type ProfilePostWhereInput struct {
+	Predicates []predicate.ProfilePost  `json:"-"`
+	Not        *ProfilePostWhereInput   `json:"not,omitempty"`
+	Or         []*ProfilePostWhereInput `json:"or,omitempty"`
+	And        []*ProfilePostWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *ulid.ID  `json:"id,omitempty"`
+	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []ulid.ID `json:"idIn,omitempty"`
+	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *ulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *ulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
+
+	// "profile_username" field predicates.
+	ProfileUsername             *string  `json:"profileUsername,omitempty"`
+	ProfileUsernameNEQ          *string  `json:"profileUsernameNEQ,omitempty"`
+	ProfileUsernameIn           []string `json:"profileUsernameIn,omitempty"`
+	ProfileUsernameNotIn        []string `json:"profileUsernameNotIn,omitempty"`
+	ProfileUsernameGT           *string  `json:"profileUsernameGT,omitempty"`
+	ProfileUsernameGTE          *string  `json:"profileUsernameGTE,omitempty"`
+	ProfileUsernameLT           *string  `json:"profileUsernameLT,omitempty"`
+	ProfileUsernameLTE          *string  `json:"profileUsernameLTE,omitempty"`
+	ProfileUsernameContains     *string  `json:"profileUsernameContains,omitempty"`
+	ProfileUsernameHasPrefix    *string  `json:"profileUsernameHasPrefix,omitempty"`
+	ProfileUsernameHasSuffix    *string  `json:"profileUsernameHasSuffix,omitempty"`
+	ProfileUsernameEqualFold    *string  `json:"profileUsernameEqualFold,omitempty"`
+	ProfileUsernameContainsFold *string  `json:"profileUsernameContainsFold,omitempty"`
+
+	// "fetch_status" field predicates.
+	FetchStatus      *profilepost.FetchStatus  `json:"fetchStatus,omitempty"`
+	FetchStatusNEQ   *profilepost.FetchStatus  `json:"fetchStatusNEQ,omitempty"`
+	FetchStatusIn    []profilepost.FetchStatus `json:"fetchStatusIn,omitempty"`
+	FetchStatusNotIn []profilepost.FetchStatus `json:"fetchStatusNotIn,omitempty"`
+
+	// "s3_key" field predicates.
+	S3Key             *string  `json:"s3Key,omitempty"`
+	S3KeyNEQ          *string  `json:"s3KeyNEQ,omitempty"`
+	S3KeyIn           []string `json:"s3KeyIn,omitempty"`
+	S3KeyNotIn        []string `json:"s3KeyNotIn,omitempty"`
+	S3KeyGT           *string  `json:"s3KeyGT,omitempty"`
+	S3KeyGTE          *string  `json:"s3KeyGTE,omitempty"`
+	S3KeyLT           *string  `json:"s3KeyLT,omitempty"`
+	S3KeyLTE          *string  `json:"s3KeyLTE,omitempty"`
+	S3KeyContains     *string  `json:"s3KeyContains,omitempty"`
+	S3KeyHasPrefix    *string  `json:"s3KeyHasPrefix,omitempty"`
+	S3KeyHasSuffix    *string  `json:"s3KeyHasSuffix,omitempty"`
+	S3KeyIsNil        bool     `json:"s3KeyIsNil,omitempty"`
+	S3KeyNotNil       bool     `json:"s3KeyNotNil,omitempty"`
+	S3KeyEqualFold    *string  `json:"s3KeyEqualFold,omitempty"`
+	S3KeyContainsFold *string  `json:"s3KeyContainsFold,omitempty"`
+
+	// "error_message" field predicates.
+	ErrorMessage             *string  `json:"errorMessage,omitempty"`
+	ErrorMessageNEQ          *string  `json:"errorMessageNEQ,omitempty"`
+	ErrorMessageIn           []string `json:"errorMessageIn,omitempty"`
+	ErrorMessageNotIn        []string `json:"errorMessageNotIn,omitempty"`
+	ErrorMessageGT           *string  `json:"errorMessageGT,omitempty"`
+	ErrorMessageGTE          *string  `json:"errorMessageGTE,omitempty"`
+	ErrorMessageLT           *string  `json:"errorMessageLT,omitempty"`
+	ErrorMessageLTE          *string  `json:"errorMessageLTE,omitempty"`
+	ErrorMessageContains     *string  `json:"errorMessageContains,omitempty"`
+	ErrorMessageHasPrefix    *string  `json:"errorMessageHasPrefix,omitempty"`
+	ErrorMessageHasSuffix    *string  `json:"errorMessageHasSuffix,omitempty"`
+	ErrorMessageIsNil        bool     `json:"errorMessageIsNil,omitempty"`
+	ErrorMessageNotNil       bool     `json:"errorMessageNotNil,omitempty"`
+	ErrorMessageEqualFold    *string  `json:"errorMessageEqualFold,omitempty"`
+	ErrorMessageContainsFold *string  `json:"errorMessageContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "items" edge predicates.
+	HasItems     *bool                        `json:"hasItems,omitempty"`
+	HasItemsWith []*ProfilePostItemWhereInput `json:"hasItemsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ProfilePostWhereInput) AddPredicates(predicates ...predicate.ProfilePost) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the ProfilePostWhereInput filter on the ProfilePostQuery builder.
+func (i *ProfilePostWhereInput) Filter(q *ProfilePostQuery) (*ProfilePostQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyProfilePostWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyProfilePostWhereInput is returned in case the ProfilePostWhereInput is empty.
+var ErrEmptyProfilePostWhereInput = errors.New("ent: empty predicate ProfilePostWhereInput")
+
+// P returns a predicate for filtering profileposts.
+// An error is returned if the input is empty or invalid.
+func (i *ProfilePostWhereInput) P() (predicate.ProfilePost, error) {
+	var predicates []predicate.ProfilePost
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, profilepost.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.ProfilePost, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, profilepost.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.ProfilePost, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, profilepost.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, profilepost.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, profilepost.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, profilepost.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, profilepost.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, profilepost.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, profilepost.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, profilepost.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, profilepost.IDLTE(*i.IDLTE))
+	}
+	if i.ProfileUsername != nil {
+		predicates = append(predicates, profilepost.ProfileUsernameEQ(*i.ProfileUsername))
+	}
+	if i.ProfileUsernameNEQ != nil {
+		predicates = append(predicates, profilepost.ProfileUsernameNEQ(*i.ProfileUsernameNEQ))
+	}
+	if len(i.ProfileUsernameIn) > 0 {
+		predicates = append(predicates, profilepost.ProfileUsernameIn(i.ProfileUsernameIn...))
+	}
+	if len(i.ProfileUsernameNotIn) > 0 {
+		predicates = append(predicates, profilepost.ProfileUsernameNotIn(i.ProfileUsernameNotIn...))
+	}
+	if i.ProfileUsernameGT != nil {
+		predicates = append(predicates, profilepost.ProfileUsernameGT(*i.ProfileUsernameGT))
+	}
+	if i.ProfileUsernameGTE != nil {
+		predicates = append(predicates, profilepost.ProfileUsernameGTE(*i.ProfileUsernameGTE))
+	}
+	if i.ProfileUsernameLT != nil {
+		predicates = append(predicates, profilepost.ProfileUsernameLT(*i.ProfileUsernameLT))
+	}
+	if i.ProfileUsernameLTE != nil {
+		predicates = append(predicates, profilepost.ProfileUsernameLTE(*i.ProfileUsernameLTE))
+	}
+	if i.ProfileUsernameContains != nil {
+		predicates = append(predicates, profilepost.ProfileUsernameContains(*i.ProfileUsernameContains))
+	}
+	if i.ProfileUsernameHasPrefix != nil {
+		predicates = append(predicates, profilepost.ProfileUsernameHasPrefix(*i.ProfileUsernameHasPrefix))
+	}
+	if i.ProfileUsernameHasSuffix != nil {
+		predicates = append(predicates, profilepost.ProfileUsernameHasSuffix(*i.ProfileUsernameHasSuffix))
+	}
+	if i.ProfileUsernameEqualFold != nil {
+		predicates = append(predicates, profilepost.ProfileUsernameEqualFold(*i.ProfileUsernameEqualFold))
+	}
+	if i.ProfileUsernameContainsFold != nil {
+		predicates = append(predicates, profilepost.ProfileUsernameContainsFold(*i.ProfileUsernameContainsFold))
+	}
+	if i.FetchStatus != nil {
+		predicates = append(predicates, profilepost.FetchStatusEQ(*i.FetchStatus))
+	}
+	if i.FetchStatusNEQ != nil {
+		predicates = append(predicates, profilepost.FetchStatusNEQ(*i.FetchStatusNEQ))
+	}
+	if len(i.FetchStatusIn) > 0 {
+		predicates = append(predicates, profilepost.FetchStatusIn(i.FetchStatusIn...))
+	}
+	if len(i.FetchStatusNotIn) > 0 {
+		predicates = append(predicates, profilepost.FetchStatusNotIn(i.FetchStatusNotIn...))
+	}
+	if i.S3Key != nil {
+		predicates = append(predicates, profilepost.S3KeyEQ(*i.S3Key))
+	}
+	if i.S3KeyNEQ != nil {
+		predicates = append(predicates, profilepost.S3KeyNEQ(*i.S3KeyNEQ))
+	}
+	if len(i.S3KeyIn) > 0 {
+		predicates = append(predicates, profilepost.S3KeyIn(i.S3KeyIn...))
+	}
+	if len(i.S3KeyNotIn) > 0 {
+		predicates = append(predicates, profilepost.S3KeyNotIn(i.S3KeyNotIn...))
+	}
+	if i.S3KeyGT != nil {
+		predicates = append(predicates, profilepost.S3KeyGT(*i.S3KeyGT))
+	}
+	if i.S3KeyGTE != nil {
+		predicates = append(predicates, profilepost.S3KeyGTE(*i.S3KeyGTE))
+	}
+	if i.S3KeyLT != nil {
+		predicates = append(predicates, profilepost.S3KeyLT(*i.S3KeyLT))
+	}
+	if i.S3KeyLTE != nil {
+		predicates = append(predicates, profilepost.S3KeyLTE(*i.S3KeyLTE))
+	}
+	if i.S3KeyContains != nil {
+		predicates = append(predicates, profilepost.S3KeyContains(*i.S3KeyContains))
+	}
+	if i.S3KeyHasPrefix != nil {
+		predicates = append(predicates, profilepost.S3KeyHasPrefix(*i.S3KeyHasPrefix))
+	}
+	if i.S3KeyHasSuffix != nil {
+		predicates = append(predicates, profilepost.S3KeyHasSuffix(*i.S3KeyHasSuffix))
+	}
+	if i.S3KeyIsNil {
+		predicates = append(predicates, profilepost.S3KeyIsNil())
+	}
+	if i.S3KeyNotNil {
+		predicates = append(predicates, profilepost.S3KeyNotNil())
+	}
+	if i.S3KeyEqualFold != nil {
+		predicates = append(predicates, profilepost.S3KeyEqualFold(*i.S3KeyEqualFold))
+	}
+	if i.S3KeyContainsFold != nil {
+		predicates = append(predicates, profilepost.S3KeyContainsFold(*i.S3KeyContainsFold))
+	}
+	if i.ErrorMessage != nil {
+		predicates = append(predicates, profilepost.ErrorMessageEQ(*i.ErrorMessage))
+	}
+	if i.ErrorMessageNEQ != nil {
+		predicates = append(predicates, profilepost.ErrorMessageNEQ(*i.ErrorMessageNEQ))
+	}
+	if len(i.ErrorMessageIn) > 0 {
+		predicates = append(predicates, profilepost.ErrorMessageIn(i.ErrorMessageIn...))
+	}
+	if len(i.ErrorMessageNotIn) > 0 {
+		predicates = append(predicates, profilepost.ErrorMessageNotIn(i.ErrorMessageNotIn...))
+	}
+	if i.ErrorMessageGT != nil {
+		predicates = append(predicates, profilepost.ErrorMessageGT(*i.ErrorMessageGT))
+	}
+	if i.ErrorMessageGTE != nil {
+		predicates = append(predicates, profilepost.ErrorMessageGTE(*i.ErrorMessageGTE))
+	}
+	if i.ErrorMessageLT != nil {
+		predicates = append(predicates, profilepost.ErrorMessageLT(*i.ErrorMessageLT))
+	}
+	if i.ErrorMessageLTE != nil {
+		predicates = append(predicates, profilepost.ErrorMessageLTE(*i.ErrorMessageLTE))
+	}
+	if i.ErrorMessageContains != nil {
+		predicates = append(predicates, profilepost.ErrorMessageContains(*i.ErrorMessageContains))
+	}
+	if i.ErrorMessageHasPrefix != nil {
+		predicates = append(predicates, profilepost.ErrorMessageHasPrefix(*i.ErrorMessageHasPrefix))
+	}
+	if i.ErrorMessageHasSuffix != nil {
+		predicates = append(predicates, profilepost.ErrorMessageHasSuffix(*i.ErrorMessageHasSuffix))
+	}
+	if i.ErrorMessageIsNil {
+		predicates = append(predicates, profilepost.ErrorMessageIsNil())
+	}
+	if i.ErrorMessageNotNil {
+		predicates = append(predicates, profilepost.ErrorMessageNotNil())
+	}
+	if i.ErrorMessageEqualFold != nil {
+		predicates = append(predicates, profilepost.ErrorMessageEqualFold(*i.ErrorMessageEqualFold))
+	}
+	if i.ErrorMessageContainsFold != nil {
+		predicates = append(predicates, profilepost.ErrorMessageContainsFold(*i.ErrorMessageContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, profilepost.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, profilepost.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, profilepost.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, profilepost.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, profilepost.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, profilepost.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, profilepost.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, profilepost.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+
+	if i.HasItems != nil {
+		p := profilepost.HasItems()
+		if !*i.HasItems {
+			p = profilepost.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasItemsWith) > 0 {
+		with := make([]predicate.ProfilePostItem, 0, len(i.HasItemsWith))
+		for _, w := range i.HasItemsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasItemsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profilepost.HasItemsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyProfilePostWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return profilepost.And(predicates...), nil
+	}
+}
+
+// ProfilePostItemWhereInput represents a where input for filtering ProfilePostItem queries.
+type ProfilePostItemWhereInput struct {
+	Predicates []predicate.ProfilePostItem  `json:"-"`
+	Not        *ProfilePostItemWhereInput   `json:"not,omitempty"`
+	Or         []*ProfilePostItemWhereInput `json:"or,omitempty"`
+	And        []*ProfilePostItemWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *ulid.ID  `json:"id,omitempty"`
+	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []ulid.ID `json:"idIn,omitempty"`
+	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *ulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *ulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
+
+	// "profile_username" field predicates.
+	ProfileUsername             *string  `json:"profileUsername,omitempty"`
+	ProfileUsernameNEQ          *string  `json:"profileUsernameNEQ,omitempty"`
+	ProfileUsernameIn           []string `json:"profileUsernameIn,omitempty"`
+	ProfileUsernameNotIn        []string `json:"profileUsernameNotIn,omitempty"`
+	ProfileUsernameGT           *string  `json:"profileUsernameGT,omitempty"`
+	ProfileUsernameGTE          *string  `json:"profileUsernameGTE,omitempty"`
+	ProfileUsernameLT           *string  `json:"profileUsernameLT,omitempty"`
+	ProfileUsernameLTE          *string  `json:"profileUsernameLTE,omitempty"`
+	ProfileUsernameContains     *string  `json:"profileUsernameContains,omitempty"`
+	ProfileUsernameHasPrefix    *string  `json:"profileUsernameHasPrefix,omitempty"`
+	ProfileUsernameHasSuffix    *string  `json:"profileUsernameHasSuffix,omitempty"`
+	ProfileUsernameEqualFold    *string  `json:"profileUsernameEqualFold,omitempty"`
+	ProfileUsernameContainsFold *string  `json:"profileUsernameContainsFold,omitempty"`
+
+	// "post_urn" field predicates.
+	PostUrn             *string  `json:"postUrn,omitempty"`
+	PostUrnNEQ          *string  `json:"postUrnNEQ,omitempty"`
+	PostUrnIn           []string `json:"postUrnIn,omitempty"`
+	PostUrnNotIn        []string `json:"postUrnNotIn,omitempty"`
+	PostUrnGT           *string  `json:"postUrnGT,omitempty"`
+	PostUrnGTE          *string  `json:"postUrnGTE,omitempty"`
+	PostUrnLT           *string  `json:"postUrnLT,omitempty"`
+	PostUrnLTE          *string  `json:"postUrnLTE,omitempty"`
+	PostUrnContains     *string  `json:"postUrnContains,omitempty"`
+	PostUrnHasPrefix    *string  `json:"postUrnHasPrefix,omitempty"`
+	PostUrnHasSuffix    *string  `json:"postUrnHasSuffix,omitempty"`
+	PostUrnIsNil        bool     `json:"postUrnIsNil,omitempty"`
+	PostUrnNotNil       bool     `json:"postUrnNotNil,omitempty"`
+	PostUrnEqualFold    *string  `json:"postUrnEqualFold,omitempty"`
+	PostUrnContainsFold *string  `json:"postUrnContainsFold,omitempty"`
+
+	// "post_url" field predicates.
+	PostURL             *string  `json:"postURL,omitempty"`
+	PostURLNEQ          *string  `json:"postURLNEQ,omitempty"`
+	PostURLIn           []string `json:"postURLIn,omitempty"`
+	PostURLNotIn        []string `json:"postURLNotIn,omitempty"`
+	PostURLGT           *string  `json:"postURLGT,omitempty"`
+	PostURLGTE          *string  `json:"postURLGTE,omitempty"`
+	PostURLLT           *string  `json:"postURLLT,omitempty"`
+	PostURLLTE          *string  `json:"postURLLTE,omitempty"`
+	PostURLContains     *string  `json:"postURLContains,omitempty"`
+	PostURLHasPrefix    *string  `json:"postURLHasPrefix,omitempty"`
+	PostURLHasSuffix    *string  `json:"postURLHasSuffix,omitempty"`
+	PostURLIsNil        bool     `json:"postURLIsNil,omitempty"`
+	PostURLNotNil       bool     `json:"postURLNotNil,omitempty"`
+	PostURLEqualFold    *string  `json:"postURLEqualFold,omitempty"`
+	PostURLContainsFold *string  `json:"postURLContainsFold,omitempty"`
+
+	// "text" field predicates.
+	Text             *string  `json:"text,omitempty"`
+	TextNEQ          *string  `json:"textNEQ,omitempty"`
+	TextIn           []string `json:"textIn,omitempty"`
+	TextNotIn        []string `json:"textNotIn,omitempty"`
+	TextGT           *string  `json:"textGT,omitempty"`
+	TextGTE          *string  `json:"textGTE,omitempty"`
+	TextLT           *string  `json:"textLT,omitempty"`
+	TextLTE          *string  `json:"textLTE,omitempty"`
+	TextContains     *string  `json:"textContains,omitempty"`
 	TextHasPrefix    *string  `json:"textHasPrefix,omitempty"`
 	TextHasSuffix    *string  `json:"textHasSuffix,omitempty"`
 	TextIsNil        bool     `json:"textIsNil,omitempty"`
@@ -3366,118 +7743,1125 @@ type ProfilePostItemWhereInput struct {
 	TextEqualFold    *string  `json:"textEqualFold,omitempty"`
 	TextContainsFold *string  `json:"textContainsFold,omitempty"`
 
-	// "content_type" field predicates.
-	ContentType             *string  `json:"contentType,omitempty"`
-	ContentTypeNEQ          *string  `json:"contentTypeNEQ,omitempty"`
-	ContentTypeIn           []string `json:"contentTypeIn,omitempty"`
-	ContentTypeNotIn        []string `json:"contentTypeNotIn,omitempty"`
-	ContentTypeGT           *string  `json:"contentTypeGT,omitempty"`
-	ContentTypeGTE          *string  `json:"contentTypeGTE,omitempty"`
-	ContentTypeLT           *string  `json:"contentTypeLT,omitempty"`
-	ContentTypeLTE          *string  `json:"contentTypeLTE,omitempty"`
-	ContentTypeContains     *string  `json:"contentTypeContains,omitempty"`
-	ContentTypeHasPrefix    *string  `json:"contentTypeHasPrefix,omitempty"`
-	ContentTypeHasSuffix    *string  `json:"contentTypeHasSuffix,omitempty"`
-	ContentTypeIsNil        bool     `json:"contentTypeIsNil,omitempty"`
-	ContentTypeNotNil       bool     `json:"contentTypeNotNil,omitempty"`
-	ContentTypeEqualFold    *string  `json:"contentTypeEqualFold,omitempty"`
-	ContentTypeContainsFold *string  `json:"contentTypeContainsFold,omitempty"`
+	// "content_type" field predicates.
+	ContentType             *string  `json:"contentType,omitempty"`
+	ContentTypeNEQ          *string  `json:"contentTypeNEQ,omitempty"`
+	ContentTypeIn           []string `json:"contentTypeIn,omitempty"`
+	ContentTypeNotIn        []string `json:"contentTypeNotIn,omitempty"`
+	ContentTypeGT           *string  `json:"contentTypeGT,omitempty"`
+	ContentTypeGTE          *string  `json:"contentTypeGTE,omitempty"`
+	ContentTypeLT           *string  `json:"contentTypeLT,omitempty"`
+	ContentTypeLTE          *string  `json:"contentTypeLTE,omitempty"`
+	ContentTypeContains     *string  `json:"contentTypeContains,omitempty"`
+	ContentTypeHasPrefix    *string  `json:"contentTypeHasPrefix,omitempty"`
+	ContentTypeHasSuffix    *string  `json:"contentTypeHasSuffix,omitempty"`
+	ContentTypeIsNil        bool     `json:"contentTypeIsNil,omitempty"`
+	ContentTypeNotNil       bool     `json:"contentTypeNotNil,omitempty"`
+	ContentTypeEqualFold    *string  `json:"contentTypeEqualFold,omitempty"`
+	ContentTypeContainsFold *string  `json:"contentTypeContainsFold,omitempty"`
+
+	// "is_repost" field predicates.
+	IsRepost    *bool `json:"isRepost,omitempty"`
+	IsRepostNEQ *bool `json:"isRepostNEQ,omitempty"`
+
+	// "total_reactions" field predicates.
+	TotalReactions      *int  `json:"totalReactions,omitempty"`
+	TotalReactionsNEQ   *int  `json:"totalReactionsNEQ,omitempty"`
+	TotalReactionsIn    []int `json:"totalReactionsIn,omitempty"`
+	TotalReactionsNotIn []int `json:"totalReactionsNotIn,omitempty"`
+	TotalReactionsGT    *int  `json:"totalReactionsGT,omitempty"`
+	TotalReactionsGTE   *int  `json:"totalReactionsGTE,omitempty"`
+	TotalReactionsLT    *int  `json:"totalReactionsLT,omitempty"`
+	TotalReactionsLTE   *int  `json:"totalReactionsLTE,omitempty"`
+
+	// "like_count" field predicates.
+	LikeCount      *int  `json:"likeCount,omitempty"`
+	LikeCountNEQ   *int  `json:"likeCountNEQ,omitempty"`
+	LikeCountIn    []int `json:"likeCountIn,omitempty"`
+	LikeCountNotIn []int `json:"likeCountNotIn,omitempty"`
+	LikeCountGT    *int  `json:"likeCountGT,omitempty"`
+	LikeCountGTE   *int  `json:"likeCountGTE,omitempty"`
+	LikeCountLT    *int  `json:"likeCountLT,omitempty"`
+	LikeCountLTE   *int  `json:"likeCountLTE,omitempty"`
+
+	// "comments_count" field predicates.
+	CommentsCount      *int  `json:"commentsCount,omitempty"`
+	CommentsCountNEQ   *int  `json:"commentsCountNEQ,omitempty"`
+	CommentsCountIn    []int `json:"commentsCountIn,omitempty"`
+	CommentsCountNotIn []int `json:"commentsCountNotIn,omitempty"`
+	CommentsCountGT    *int  `json:"commentsCountGT,omitempty"`
+	CommentsCountGTE   *int  `json:"commentsCountGTE,omitempty"`
+	CommentsCountLT    *int  `json:"commentsCountLT,omitempty"`
+	CommentsCountLTE   *int  `json:"commentsCountLTE,omitempty"`
+
+	// "reposts_count" field predicates.
+	RepostsCount      *int  `json:"repostsCount,omitempty"`
+	RepostsCountNEQ   *int  `json:"repostsCountNEQ,omitempty"`
+	RepostsCountIn    []int `json:"repostsCountIn,omitempty"`
+	RepostsCountNotIn []int `json:"repostsCountNotIn,omitempty"`
+	RepostsCountGT    *int  `json:"repostsCountGT,omitempty"`
+	RepostsCountGTE   *int  `json:"repostsCountGTE,omitempty"`
+	RepostsCountLT    *int  `json:"repostsCountLT,omitempty"`
+	RepostsCountLTE   *int  `json:"repostsCountLTE,omitempty"`
+
+	// "empathy_count" field predicates.
+	EmpathyCount      *int  `json:"empathyCount,omitempty"`
+	EmpathyCountNEQ   *int  `json:"empathyCountNEQ,omitempty"`
+	EmpathyCountIn    []int `json:"empathyCountIn,omitempty"`
+	EmpathyCountNotIn []int `json:"empathyCountNotIn,omitempty"`
+	EmpathyCountGT    *int  `json:"empathyCountGT,omitempty"`
+	EmpathyCountGTE   *int  `json:"empathyCountGTE,omitempty"`
+	EmpathyCountLT    *int  `json:"empathyCountLT,omitempty"`
+	EmpathyCountLTE   *int  `json:"empathyCountLTE,omitempty"`
+
+	// "praise_count" field predicates.
+	PraiseCount      *int  `json:"praiseCount,omitempty"`
+	PraiseCountNEQ   *int  `json:"praiseCountNEQ,omitempty"`
+	PraiseCountIn    []int `json:"praiseCountIn,omitempty"`
+	PraiseCountNotIn []int `json:"praiseCountNotIn,omitempty"`
+	PraiseCountGT    *int  `json:"praiseCountGT,omitempty"`
+	PraiseCountGTE   *int  `json:"praiseCountGTE,omitempty"`
+	PraiseCountLT    *int  `json:"praiseCountLT,omitempty"`
+	PraiseCountLTE   *int  `json:"praiseCountLTE,omitempty"`
+
+	// "funny_count" field predicates.
+	FunnyCount      *int  `json:"funnyCount,omitempty"`
+	FunnyCountNEQ   *int  `json:"funnyCountNEQ,omitempty"`
+	FunnyCountIn    []int `json:"funnyCountIn,omitempty"`
+	FunnyCountNotIn []int `json:"funnyCountNotIn,omitempty"`
+	FunnyCountGT    *int  `json:"funnyCountGT,omitempty"`
+	FunnyCountGTE   *int  `json:"funnyCountGTE,omitempty"`
+	FunnyCountLT    *int  `json:"funnyCountLT,omitempty"`
+	FunnyCountLTE   *int  `json:"funnyCountLTE,omitempty"`
+
+	// "interest_count" field predicates.
+	InterestCount      *int  `json:"interestCount,omitempty"`
+	InterestCountNEQ   *int  `json:"interestCountNEQ,omitempty"`
+	InterestCountIn    []int `json:"interestCountIn,omitempty"`
+	InterestCountNotIn []int `json:"interestCountNotIn,omitempty"`
+	InterestCountGT    *int  `json:"interestCountGT,omitempty"`
+	InterestCountGTE   *int  `json:"interestCountGTE,omitempty"`
+	InterestCountLT    *int  `json:"interestCountLT,omitempty"`
+	InterestCountLTE   *int  `json:"interestCountLTE,omitempty"`
+
+	// "posted_at" field predicates.
+	PostedAt       *time.Time  `json:"postedAt,omitempty"`
+	PostedAtNEQ    *time.Time  `json:"postedAtNEQ,omitempty"`
+	PostedAtIn     []time.Time `json:"postedAtIn,omitempty"`
+	PostedAtNotIn  []time.Time `json:"postedAtNotIn,omitempty"`
+	PostedAtGT     *time.Time  `json:"postedAtGT,omitempty"`
+	PostedAtGTE    *time.Time  `json:"postedAtGTE,omitempty"`
+	PostedAtLT     *time.Time  `json:"postedAtLT,omitempty"`
+	PostedAtLTE    *time.Time  `json:"postedAtLTE,omitempty"`
+	PostedAtIsNil  bool        `json:"postedAtIsNil,omitempty"`
+	PostedAtNotNil bool        `json:"postedAtNotNil,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "profile_post" edge predicates.
+	HasProfilePost     *bool                    `json:"hasProfilePost,omitempty"`
+	HasProfilePostWith []*ProfilePostWhereInput `json:"hasProfilePostWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ProfilePostItemWhereInput) AddPredicates(predicates ...predicate.ProfilePostItem) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the ProfilePostItemWhereInput filter on the ProfilePostItemQuery builder.
+func (i *ProfilePostItemWhereInput) Filter(q *ProfilePostItemQuery) (*ProfilePostItemQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyProfilePostItemWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyProfilePostItemWhereInput is returned in case the ProfilePostItemWhereInput is empty.
+var ErrEmptyProfilePostItemWhereInput = errors.New("ent: empty predicate ProfilePostItemWhereInput")
+
+// P returns a predicate for filtering profilepostitems.
+// An error is returned if the input is empty or invalid.
+func (i *ProfilePostItemWhereInput) P() (predicate.ProfilePostItem, error) {
+	var predicates []predicate.ProfilePostItem
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, profilepostitem.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.ProfilePostItem, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, profilepostitem.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.ProfilePostItem, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, profilepostitem.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, profilepostitem.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, profilepostitem.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, profilepostitem.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, profilepostitem.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, profilepostitem.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, profilepostitem.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, profilepostitem.IDLTE(*i.IDLTE))
+	}
+	if i.ProfileUsername != nil {
+		predicates = append(predicates, profilepostitem.ProfileUsernameEQ(*i.ProfileUsername))
+	}
+	if i.ProfileUsernameNEQ != nil {
+		predicates = append(predicates, profilepostitem.ProfileUsernameNEQ(*i.ProfileUsernameNEQ))
+	}
+	if len(i.ProfileUsernameIn) > 0 {
+		predicates = append(predicates, profilepostitem.ProfileUsernameIn(i.ProfileUsernameIn...))
+	}
+	if len(i.ProfileUsernameNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.ProfileUsernameNotIn(i.ProfileUsernameNotIn...))
+	}
+	if i.ProfileUsernameGT != nil {
+		predicates = append(predicates, profilepostitem.ProfileUsernameGT(*i.ProfileUsernameGT))
+	}
+	if i.ProfileUsernameGTE != nil {
+		predicates = append(predicates, profilepostitem.ProfileUsernameGTE(*i.ProfileUsernameGTE))
+	}
+	if i.ProfileUsernameLT != nil {
+		predicates = append(predicates, profilepostitem.ProfileUsernameLT(*i.ProfileUsernameLT))
+	}
+	if i.ProfileUsernameLTE != nil {
+		predicates = append(predicates, profilepostitem.ProfileUsernameLTE(*i.ProfileUsernameLTE))
+	}
+	if i.ProfileUsernameContains != nil {
+		predicates = append(predicates, profilepostitem.ProfileUsernameContains(*i.ProfileUsernameContains))
+	}
+	if i.ProfileUsernameHasPrefix != nil {
+		predicates = append(predicates, profilepostitem.ProfileUsernameHasPrefix(*i.ProfileUsernameHasPrefix))
+	}
+	if i.ProfileUsernameHasSuffix != nil {
+		predicates = append(predicates, profilepostitem.ProfileUsernameHasSuffix(*i.ProfileUsernameHasSuffix))
+	}
+	if i.ProfileUsernameEqualFold != nil {
+		predicates = append(predicates, profilepostitem.ProfileUsernameEqualFold(*i.ProfileUsernameEqualFold))
+	}
+	if i.ProfileUsernameContainsFold != nil {
+		predicates = append(predicates, profilepostitem.ProfileUsernameContainsFold(*i.ProfileUsernameContainsFold))
+	}
+	if i.PostUrn != nil {
+		predicates = append(predicates, profilepostitem.PostUrnEQ(*i.PostUrn))
+	}
+	if i.PostUrnNEQ != nil {
+		predicates = append(predicates, profilepostitem.PostUrnNEQ(*i.PostUrnNEQ))
+	}
+	if len(i.PostUrnIn) > 0 {
+		predicates = append(predicates, profilepostitem.PostUrnIn(i.PostUrnIn...))
+	}
+	if len(i.PostUrnNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.PostUrnNotIn(i.PostUrnNotIn...))
+	}
+	if i.PostUrnGT != nil {
+		predicates = append(predicates, profilepostitem.PostUrnGT(*i.PostUrnGT))
+	}
+	if i.PostUrnGTE != nil {
+		predicates = append(predicates, profilepostitem.PostUrnGTE(*i.PostUrnGTE))
+	}
+	if i.PostUrnLT != nil {
+		predicates = append(predicates, profilepostitem.PostUrnLT(*i.PostUrnLT))
+	}
+	if i.PostUrnLTE != nil {
+		predicates = append(predicates, profilepostitem.PostUrnLTE(*i.PostUrnLTE))
+	}
+	if i.PostUrnContains != nil {
+		predicates = append(predicates, profilepostitem.PostUrnContains(*i.PostUrnContains))
+	}
+	if i.PostUrnHasPrefix != nil {
+		predicates = append(predicates, profilepostitem.PostUrnHasPrefix(*i.PostUrnHasPrefix))
+	}
+	if i.PostUrnHasSuffix != nil {
+		predicates = append(predicates, profilepostitem.PostUrnHasSuffix(*i.PostUrnHasSuffix))
+	}
+	if i.PostUrnIsNil {
+		predicates = append(predicates, profilepostitem.PostUrnIsNil())
+	}
+	if i.PostUrnNotNil {
+		predicates = append(predicates, profilepostitem.PostUrnNotNil())
+	}
+	if i.PostUrnEqualFold != nil {
+		predicates = append(predicates, profilepostitem.PostUrnEqualFold(*i.PostUrnEqualFold))
+	}
+	if i.PostUrnContainsFold != nil {
+		predicates = append(predicates, profilepostitem.PostUrnContainsFold(*i.PostUrnContainsFold))
+	}
+	if i.PostURL != nil {
+		predicates = append(predicates, profilepostitem.PostURLEQ(*i.PostURL))
+	}
+	if i.PostURLNEQ != nil {
+		predicates = append(predicates, profilepostitem.PostURLNEQ(*i.PostURLNEQ))
+	}
+	if len(i.PostURLIn) > 0 {
+		predicates = append(predicates, profilepostitem.PostURLIn(i.PostURLIn...))
+	}
+	if len(i.PostURLNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.PostURLNotIn(i.PostURLNotIn...))
+	}
+	if i.PostURLGT != nil {
+		predicates = append(predicates, profilepostitem.PostURLGT(*i.PostURLGT))
+	}
+	if i.PostURLGTE != nil {
+		predicates = append(predicates, profilepostitem.PostURLGTE(*i.PostURLGTE))
+	}
+	if i.PostURLLT != nil {
+		predicates = append(predicates, profilepostitem.PostURLLT(*i.PostURLLT))
+	}
+	if i.PostURLLTE != nil {
+		predicates = append(predicates, profilepostitem.PostURLLTE(*i.PostURLLTE))
+	}
+	if i.PostURLContains != nil {
+		predicates = append(predicates, profilepostitem.PostURLContains(*i.PostURLContains))
+	}
+	if i.PostURLHasPrefix != nil {
+		predicates = append(predicates, profilepostitem.PostURLHasPrefix(*i.PostURLHasPrefix))
+	}
+	if i.PostURLHasSuffix != nil {
+		predicates = append(predicates, profilepostitem.PostURLHasSuffix(*i.PostURLHasSuffix))
+	}
+	if i.PostURLIsNil {
+		predicates = append(predicates, profilepostitem.PostURLIsNil())
+	}
+	if i.PostURLNotNil {
+		predicates = append(predicates, profilepostitem.PostURLNotNil())
+	}
+	if i.PostURLEqualFold != nil {
+		predicates = append(predicates, profilepostitem.PostURLEqualFold(*i.PostURLEqualFold))
+	}
+	if i.PostURLContainsFold != nil {
+		predicates = append(predicates, profilepostitem.PostURLContainsFold(*i.PostURLContainsFold))
+	}
+	if i.Text != nil {
+		predicates = append(predicates, profilepostitem.TextEQ(*i.Text))
+	}
+	if i.TextNEQ != nil {
+		predicates = append(predicates, profilepostitem.TextNEQ(*i.TextNEQ))
+	}
+	if len(i.TextIn) > 0 {
+		predicates = append(predicates, profilepostitem.TextIn(i.TextIn...))
+	}
+	if len(i.TextNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.TextNotIn(i.TextNotIn...))
+	}
+	if i.TextGT != nil {
+		predicates = append(predicates, profilepostitem.TextGT(*i.TextGT))
+	}
+	if i.TextGTE != nil {
+		predicates = append(predicates, profilepostitem.TextGTE(*i.TextGTE))
+	}
+	if i.TextLT != nil {
+		predicates = append(predicates, profilepostitem.TextLT(*i.TextLT))
+	}
+	if i.TextLTE != nil {
+		predicates = append(predicates, profilepostitem.TextLTE(*i.TextLTE))
+	}
+	if i.TextContains != nil {
+		predicates = append(predicates, profilepostitem.TextContains(*i.TextContains))
+	}
+	if i.TextHasPrefix != nil {
+		predicates = append(predicates, profilepostitem.TextHasPrefix(*i.TextHasPrefix))
+	}
+	if i.TextHasSuffix != nil {
+		predicates = append(predicates, profilepostitem.TextHasSuffix(*i.TextHasSuffix))
+	}
+	if i.TextIsNil {
+		predicates = append(predicates, profilepostitem.TextIsNil())
+	}
+	if i.TextNotNil {
+		predicates = append(predicates, profilepostitem.TextNotNil())
+	}
+	if i.TextEqualFold != nil {
+		predicates = append(predicates, profilepostitem.TextEqualFold(*i.TextEqualFold))
+	}
+	if i.TextContainsFold != nil {
+		predicates = append(predicates, profilepostitem.TextContainsFold(*i.TextContainsFold))
+	}
+	if i.ContentType != nil {
+		predicates = append(predicates, profilepostitem.ContentTypeEQ(*i.ContentType))
+	}
+	if i.ContentTypeNEQ != nil {
+		predicates = append(predicates, profilepostitem.ContentTypeNEQ(*i.ContentTypeNEQ))
+	}
+	if len(i.ContentTypeIn) > 0 {
+		predicates = append(predicates, profilepostitem.ContentTypeIn(i.ContentTypeIn...))
+	}
+	if len(i.ContentTypeNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.ContentTypeNotIn(i.ContentTypeNotIn...))
+	}
+	if i.ContentTypeGT != nil {
+		predicates = append(predicates, profilepostitem.ContentTypeGT(*i.ContentTypeGT))
+	}
+	if i.ContentTypeGTE != nil {
+		predicates = append(predicates, profilepostitem.ContentTypeGTE(*i.ContentTypeGTE))
+	}
+	if i.ContentTypeLT != nil {
+		predicates = append(predicates, profilepostitem.ContentTypeLT(*i.ContentTypeLT))
+	}
+	if i.ContentTypeLTE != nil {
+		predicates = append(predicates, profilepostitem.ContentTypeLTE(*i.ContentTypeLTE))
+	}
+	if i.ContentTypeContains != nil {
+		predicates = append(predicates, profilepostitem.ContentTypeContains(*i.ContentTypeContains))
+	}
+	if i.ContentTypeHasPrefix != nil {
+		predicates = append(predicates, profilepostitem.ContentTypeHasPrefix(*i.ContentTypeHasPrefix))
+	}
+	if i.ContentTypeHasSuffix != nil {
+		predicates = append(predicates, profilepostitem.ContentTypeHasSuffix(*i.ContentTypeHasSuffix))
+	}
+	if i.ContentTypeIsNil {
+		predicates = append(predicates, profilepostitem.ContentTypeIsNil())
+	}
+	if i.ContentTypeNotNil {
+		predicates = append(predicates, profilepostitem.ContentTypeNotNil())
+	}
+	if i.ContentTypeEqualFold != nil {
+		predicates = append(predicates, profilepostitem.ContentTypeEqualFold(*i.ContentTypeEqualFold))
+	}
+	if i.ContentTypeContainsFold != nil {
+		predicates = append(predicates, profilepostitem.ContentTypeContainsFold(*i.ContentTypeContainsFold))
+	}
+	if i.IsRepost != nil {
+		predicates = append(predicates, profilepostitem.IsRepostEQ(*i.IsRepost))
+	}
+	if i.IsRepostNEQ != nil {
+		predicates = append(predicates, profilepostitem.IsRepostNEQ(*i.IsRepostNEQ))
+	}
+	if i.TotalReactions != nil {
+		predicates = append(predicates, profilepostitem.TotalReactionsEQ(*i.TotalReactions))
+	}
+	if i.TotalReactionsNEQ != nil {
+		predicates = append(predicates, profilepostitem.TotalReactionsNEQ(*i.TotalReactionsNEQ))
+	}
+	if len(i.TotalReactionsIn) > 0 {
+		predicates = append(predicates, profilepostitem.TotalReactionsIn(i.TotalReactionsIn...))
+	}
+	if len(i.TotalReactionsNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.TotalReactionsNotIn(i.TotalReactionsNotIn...))
+	}
+	if i.TotalReactionsGT != nil {
+		predicates = append(predicates, profilepostitem.TotalReactionsGT(*i.TotalReactionsGT))
+	}
+	if i.TotalReactionsGTE != nil {
+		predicates = append(predicates, profilepostitem.TotalReactionsGTE(*i.TotalReactionsGTE))
+	}
+	if i.TotalReactionsLT != nil {
+		predicates = append(predicates, profilepostitem.TotalReactionsLT(*i.TotalReactionsLT))
+	}
+	if i.TotalReactionsLTE != nil {
+		predicates = append(predicates, profilepostitem.TotalReactionsLTE(*i.TotalReactionsLTE))
+	}
+	if i.LikeCount != nil {
+		predicates = append(predicates, profilepostitem.LikeCountEQ(*i.LikeCount))
+	}
+	if i.LikeCountNEQ != nil {
+		predicates = append(predicates, profilepostitem.LikeCountNEQ(*i.LikeCountNEQ))
+	}
+	if len(i.LikeCountIn) > 0 {
+		predicates = append(predicates, profilepostitem.LikeCountIn(i.LikeCountIn...))
+	}
+	if len(i.LikeCountNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.LikeCountNotIn(i.LikeCountNotIn...))
+	}
+	if i.LikeCountGT != nil {
+		predicates = append(predicates, profilepostitem.LikeCountGT(*i.LikeCountGT))
+	}
+	if i.LikeCountGTE != nil {
+		predicates = append(predicates, profilepostitem.LikeCountGTE(*i.LikeCountGTE))
+	}
+	if i.LikeCountLT != nil {
+		predicates = append(predicates, profilepostitem.LikeCountLT(*i.LikeCountLT))
+	}
+	if i.LikeCountLTE != nil {
+		predicates = append(predicates, profilepostitem.LikeCountLTE(*i.LikeCountLTE))
+	}
+	if i.CommentsCount != nil {
+		predicates = append(predicates, profilepostitem.CommentsCountEQ(*i.CommentsCount))
+	}
+	if i.CommentsCountNEQ != nil {
+		predicates = append(predicates, profilepostitem.CommentsCountNEQ(*i.CommentsCountNEQ))
+	}
+	if len(i.CommentsCountIn) > 0 {
+		predicates = append(predicates, profilepostitem.CommentsCountIn(i.CommentsCountIn...))
+	}
+	if len(i.CommentsCountNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.CommentsCountNotIn(i.CommentsCountNotIn...))
+	}
+	if i.CommentsCountGT != nil {
+		predicates = append(predicates, profilepostitem.CommentsCountGT(*i.CommentsCountGT))
+	}
+	if i.CommentsCountGTE != nil {
+		predicates = append(predicates, profilepostitem.CommentsCountGTE(*i.CommentsCountGTE))
+	}
+	if i.CommentsCountLT != nil {
+		predicates = append(predicates, profilepostitem.CommentsCountLT(*i.CommentsCountLT))
+	}
+	if i.CommentsCountLTE != nil {
+		predicates = append(predicates, profilepostitem.CommentsCountLTE(*i.CommentsCountLTE))
+	}
+	if i.RepostsCount != nil {
+		predicates = append(predicates, profilepostitem.RepostsCountEQ(*i.RepostsCount))
+	}
+	if i.RepostsCountNEQ != nil {
+		predicates = append(predicates, profilepostitem.RepostsCountNEQ(*i.RepostsCountNEQ))
+	}
+	if len(i.RepostsCountIn) > 0 {
+		predicates = append(predicates, profilepostitem.RepostsCountIn(i.RepostsCountIn...))
+	}
+	if len(i.RepostsCountNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.RepostsCountNotIn(i.RepostsCountNotIn...))
+	}
+	if i.RepostsCountGT != nil {
+		predicates = append(predicates, profilepostitem.RepostsCountGT(*i.RepostsCountGT))
+	}
+	if i.RepostsCountGTE != nil {
+		predicates = append(predicates, profilepostitem.RepostsCountGTE(*i.RepostsCountGTE))
+	}
+	if i.RepostsCountLT != nil {
+		predicates = append(predicates, profilepostitem.RepostsCountLT(*i.RepostsCountLT))
+	}
+	if i.RepostsCountLTE != nil {
+		predicates = append(predicates, profilepostitem.RepostsCountLTE(*i.RepostsCountLTE))
+	}
+	if i.EmpathyCount != nil {
+		predicates = append(predicates, profilepostitem.EmpathyCountEQ(*i.EmpathyCount))
+	}
+	if i.EmpathyCountNEQ != nil {
+		predicates = append(predicates, profilepostitem.EmpathyCountNEQ(*i.EmpathyCountNEQ))
+	}
+	if len(i.EmpathyCountIn) > 0 {
+		predicates = append(predicates, profilepostitem.EmpathyCountIn(i.EmpathyCountIn...))
+	}
+	if len(i.EmpathyCountNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.EmpathyCountNotIn(i.EmpathyCountNotIn...))
+	}
+	if i.EmpathyCountGT != nil {
+		predicates = append(predicates, profilepostitem.EmpathyCountGT(*i.EmpathyCountGT))
+	}
+	if i.EmpathyCountGTE != nil {
+		predicates = append(predicates, profilepostitem.EmpathyCountGTE(*i.EmpathyCountGTE))
+	}
+	if i.EmpathyCountLT != nil {
+		predicates = append(predicates, profilepostitem.EmpathyCountLT(*i.EmpathyCountLT))
+	}
+	if i.EmpathyCountLTE != nil {
+		predicates = append(predicates, profilepostitem.EmpathyCountLTE(*i.EmpathyCountLTE))
+	}
+	if i.PraiseCount != nil {
+		predicates = append(predicates, profilepostitem.PraiseCountEQ(*i.PraiseCount))
+	}
+	if i.PraiseCountNEQ != nil {
+		predicates = append(predicates, profilepostitem.PraiseCountNEQ(*i.PraiseCountNEQ))
+	}
+	if len(i.PraiseCountIn) > 0 {
+		predicates = append(predicates, profilepostitem.PraiseCountIn(i.PraiseCountIn...))
+	}
+	if len(i.PraiseCountNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.PraiseCountNotIn(i.PraiseCountNotIn...))
+	}
+	if i.PraiseCountGT != nil {
+		predicates = append(predicates, profilepostitem.PraiseCountGT(*i.PraiseCountGT))
+	}
+	if i.PraiseCountGTE != nil {
+		predicates = append(predicates, profilepostitem.PraiseCountGTE(*i.PraiseCountGTE))
+	}
+	if i.PraiseCountLT != nil {
+		predicates = append(predicates, profilepostitem.PraiseCountLT(*i.PraiseCountLT))
+	}
+	if i.PraiseCountLTE != nil {
+		predicates = append(predicates, profilepostitem.PraiseCountLTE(*i.PraiseCountLTE))
+	}
+	if i.FunnyCount != nil {
+		predicates = append(predicates, profilepostitem.FunnyCountEQ(*i.FunnyCount))
+	}
+	if i.FunnyCountNEQ != nil {
+		predicates = append(predicates, profilepostitem.FunnyCountNEQ(*i.FunnyCountNEQ))
+	}
+	if len(i.FunnyCountIn) > 0 {
+		predicates = append(predicates, profilepostitem.FunnyCountIn(i.FunnyCountIn...))
+	}
+	if len(i.FunnyCountNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.FunnyCountNotIn(i.FunnyCountNotIn...))
+	}
+	if i.FunnyCountGT != nil {
+		predicates = append(predicates, profilepostitem.FunnyCountGT(*i.FunnyCountGT))
+	}
+	if i.FunnyCountGTE != nil {
+		predicates = append(predicates, profilepostitem.FunnyCountGTE(*i.FunnyCountGTE))
+	}
+	if i.FunnyCountLT != nil {
+		predicates = append(predicates, profilepostitem.FunnyCountLT(*i.FunnyCountLT))
+	}
+	if i.FunnyCountLTE != nil {
+		predicates = append(predicates, profilepostitem.FunnyCountLTE(*i.FunnyCountLTE))
+	}
+	if i.InterestCount != nil {
+		predicates = append(predicates, profilepostitem.InterestCountEQ(*i.InterestCount))
+	}
+	if i.InterestCountNEQ != nil {
+		predicates = append(predicates, profilepostitem.InterestCountNEQ(*i.InterestCountNEQ))
+	}
+	if len(i.InterestCountIn) > 0 {
+		predicates = append(predicates, profilepostitem.InterestCountIn(i.InterestCountIn...))
+	}
+	if len(i.InterestCountNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.InterestCountNotIn(i.InterestCountNotIn...))
+	}
+	if i.InterestCountGT != nil {
+		predicates = append(predicates, profilepostitem.InterestCountGT(*i.InterestCountGT))
+	}
+	if i.InterestCountGTE != nil {
+		predicates = append(predicates, profilepostitem.InterestCountGTE(*i.InterestCountGTE))
+	}
+	if i.InterestCountLT != nil {
+		predicates = append(predicates, profilepostitem.InterestCountLT(*i.InterestCountLT))
+	}
+	if i.InterestCountLTE != nil {
+		predicates = append(predicates, profilepostitem.InterestCountLTE(*i.InterestCountLTE))
+	}
+	if i.PostedAt != nil {
+		predicates = append(predicates, profilepostitem.PostedAtEQ(*i.PostedAt))
+	}
+	if i.PostedAtNEQ != nil {
+		predicates = append(predicates, profilepostitem.PostedAtNEQ(*i.PostedAtNEQ))
+	}
+	if len(i.PostedAtIn) > 0 {
+		predicates = append(predicates, profilepostitem.PostedAtIn(i.PostedAtIn...))
+	}
+	if len(i.PostedAtNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.PostedAtNotIn(i.PostedAtNotIn...))
+	}
+	if i.PostedAtGT != nil {
+		predicates = append(predicates, profilepostitem.PostedAtGT(*i.PostedAtGT))
+	}
+	if i.PostedAtGTE != nil {
+		predicates = append(predicates, profilepostitem.PostedAtGTE(*i.PostedAtGTE))
+	}
+	if i.PostedAtLT != nil {
+		predicates = append(predicates, profilepostitem.PostedAtLT(*i.PostedAtLT))
+	}
+	if i.PostedAtLTE != nil {
+		predicates = append(predicates, profilepostitem.PostedAtLTE(*i.PostedAtLTE))
+	}
+	if i.PostedAtIsNil {
+		predicates = append(predicates, profilepostitem.PostedAtIsNil())
+	}
+	if i.PostedAtNotNil {
+		predicates = append(predicates, profilepostitem.PostedAtNotNil())
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, profilepostitem.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, profilepostitem.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, profilepostitem.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, profilepostitem.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, profilepostitem.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, profilepostitem.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, profilepostitem.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, profilepostitem.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+
+	if i.HasProfilePost != nil {
+		p := profilepostitem.HasProfilePost()
+		if !*i.HasProfilePost {
+			p = profilepostitem.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProfilePostWith) > 0 {
+		with := make([]predicate.ProfilePost, 0, len(i.HasProfilePostWith))
+		for _, w := range i.HasProfilePostWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProfilePostWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profilepostitem.HasProfilePostWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyProfilePostItemWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return profilepostitem.And(predicates...), nil
+	}
+}
+
+// ProfileSkillWhereInput represents a where input for filtering ProfileSkill queries.
+type ProfileSkillWhereInput struct {
+	Predicates []predicate.ProfileSkill  `json:"-"`
+	Not        *ProfileSkillWhereInput   `json:"not,omitempty"`
+	Or         []*ProfileSkillWhereInput `json:"or,omitempty"`
+	And        []*ProfileSkillWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *ulid.ID  `json:"id,omitempty"`
+	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []ulid.ID `json:"idIn,omitempty"`
+	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *ulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *ulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "endorsement_count" field predicates.
+	EndorsementCount      *int  `json:"endorsementCount,omitempty"`
+	EndorsementCountNEQ   *int  `json:"endorsementCountNEQ,omitempty"`
+	EndorsementCountIn    []int `json:"endorsementCountIn,omitempty"`
+	EndorsementCountNotIn []int `json:"endorsementCountNotIn,omitempty"`
+	EndorsementCountGT    *int  `json:"endorsementCountGT,omitempty"`
+	EndorsementCountGTE   *int  `json:"endorsementCountGTE,omitempty"`
+	EndorsementCountLT    *int  `json:"endorsementCountLT,omitempty"`
+	EndorsementCountLTE   *int  `json:"endorsementCountLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "profile" edge predicates.
+	HasProfile     *bool                `json:"hasProfile,omitempty"`
+	HasProfileWith []*ProfileWhereInput `json:"hasProfileWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ProfileSkillWhereInput) AddPredicates(predicates ...predicate.ProfileSkill) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the ProfileSkillWhereInput filter on the ProfileSkillQuery builder.
+func (i *ProfileSkillWhereInput) Filter(q *ProfileSkillQuery) (*ProfileSkillQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyProfileSkillWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyProfileSkillWhereInput is returned in case the ProfileSkillWhereInput is empty.
+var ErrEmptyProfileSkillWhereInput = errors.New("ent: empty predicate ProfileSkillWhereInput")
+
+// P returns a predicate for filtering profileskills.
+// An error is returned if the input is empty or invalid.
+func (i *ProfileSkillWhereInput) P() (predicate.ProfileSkill, error) {
+	var predicates []predicate.ProfileSkill
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, profileskill.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.ProfileSkill, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, profileskill.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.ProfileSkill, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, profileskill.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, profileskill.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, profileskill.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, profileskill.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, profileskill.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, profileskill.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, profileskill.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, profileskill.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, profileskill.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, profileskill.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, profileskill.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, profileskill.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, profileskill.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, profileskill.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, profileskill.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, profileskill.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, profileskill.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, profileskill.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, profileskill.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, profileskill.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, profileskill.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, profileskill.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.EndorsementCount != nil {
+		predicates = append(predicates, profileskill.EndorsementCountEQ(*i.EndorsementCount))
+	}
+	if i.EndorsementCountNEQ != nil {
+		predicates = append(predicates, profileskill.EndorsementCountNEQ(*i.EndorsementCountNEQ))
+	}
+	if len(i.EndorsementCountIn) > 0 {
+		predicates = append(predicates, profileskill.EndorsementCountIn(i.EndorsementCountIn...))
+	}
+	if len(i.EndorsementCountNotIn) > 0 {
+		predicates = append(predicates, profileskill.EndorsementCountNotIn(i.EndorsementCountNotIn...))
+	}
+	if i.EndorsementCountGT != nil {
+		predicates = append(predicates, profileskill.EndorsementCountGT(*i.EndorsementCountGT))
+	}
+	if i.EndorsementCountGTE != nil {
+		predicates = append(predicates, profileskill.EndorsementCountGTE(*i.EndorsementCountGTE))
+	}
+	if i.EndorsementCountLT != nil {
+		predicates = append(predicates, profileskill.EndorsementCountLT(*i.EndorsementCountLT))
+	}
+	if i.EndorsementCountLTE != nil {
+		predicates = append(predicates, profileskill.EndorsementCountLTE(*i.EndorsementCountLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, profileskill.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, profileskill.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, profileskill.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, profileskill.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, profileskill.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, profileskill.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, profileskill.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, profileskill.CreatedAtLTE(*i.CreatedAtLTE))
+	}
 
-	// "is_repost" field predicates.
-	IsRepost    *bool `json:"isRepost,omitempty"`
-	IsRepostNEQ *bool `json:"isRepostNEQ,omitempty"`
+	if i.HasProfile != nil {
+		p := profileskill.HasProfile()
+		if !*i.HasProfile {
+			p = profileskill.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProfileWith) > 0 {
+		with := make([]predicate.Profile, 0, len(i.HasProfileWith))
+		for _, w := range i.HasProfileWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProfileWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profileskill.HasProfileWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyProfileSkillWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return profileskill.And(predicates...), nil
+	}
+}
 
-	// "total_reactions" field predicates.
-	TotalReactions      *int  `json:"totalReactions,omitempty"`
-	TotalReactionsNEQ   *int  `json:"totalReactionsNEQ,omitempty"`
-	TotalReactionsIn    []int `json:"totalReactionsIn,omitempty"`
-	TotalReactionsNotIn []int `json:"totalReactionsNotIn,omitempty"`
-	TotalReactionsGT    *int  `json:"totalReactionsGT,omitempty"`
-	TotalReactionsGTE   *int  `json:"totalReactionsGTE,omitempty"`
-	TotalReactionsLT    *int  `json:"totalReactionsLT,omitempty"`
-	TotalReactionsLTE   *int  `json:"totalReactionsLTE,omitempty"`
+// ProfileSnapshotWhereInput represents a where input for filtering ProfileSnapshot queries.
+type ProfileSnapshotWhereInput struct {
+	Predicates []predicate.ProfileSnapshot  `json:"-"`
+	Not        *ProfileSnapshotWhereInput   `json:"not,omitempty"`
+	Or         []*ProfileSnapshotWhereInput `json:"or,omitempty"`
+	And        []*ProfileSnapshotWhereInput `json:"and,omitempty"`
 
-	// "like_count" field predicates.
-	LikeCount      *int  `json:"likeCount,omitempty"`
-	LikeCountNEQ   *int  `json:"likeCountNEQ,omitempty"`
-	LikeCountIn    []int `json:"likeCountIn,omitempty"`
-	LikeCountNotIn []int `json:"likeCountNotIn,omitempty"`
-	LikeCountGT    *int  `json:"likeCountGT,omitempty"`
-	LikeCountGTE   *int  `json:"likeCountGTE,omitempty"`
-	LikeCountLT    *int  `json:"likeCountLT,omitempty"`
-	LikeCountLTE   *int  `json:"likeCountLTE,omitempty"`
+	// "id" field predicates.
+	ID      *ulid.ID  `json:"id,omitempty"`
+	IDNEQ   *ulid.ID  `json:"idNEQ,omitempty"`
+	IDIn    []ulid.ID `json:"idIn,omitempty"`
+	IDNotIn []ulid.ID `json:"idNotIn,omitempty"`
+	IDGT    *ulid.ID  `json:"idGT,omitempty"`
+	IDGTE   *ulid.ID  `json:"idGTE,omitempty"`
+	IDLT    *ulid.ID  `json:"idLT,omitempty"`
+	IDLTE   *ulid.ID  `json:"idLTE,omitempty"`
 
-	// "comments_count" field predicates.
-	CommentsCount      *int  `json:"commentsCount,omitempty"`
-	CommentsCountNEQ   *int  `json:"commentsCountNEQ,omitempty"`
-	CommentsCountIn    []int `json:"commentsCountIn,omitempty"`
-	CommentsCountNotIn []int `json:"commentsCountNotIn,omitempty"`
-	CommentsCountGT    *int  `json:"commentsCountGT,omitempty"`
-	CommentsCountGTE   *int  `json:"commentsCountGTE,omitempty"`
-	CommentsCountLT    *int  `json:"commentsCountLT,omitempty"`
-	CommentsCountLTE   *int  `json:"commentsCountLTE,omitempty"`
+	// "fetched_at" field predicates.
+	FetchedAt      *time.Time  `json:"fetchedAt,omitempty"`
+	FetchedAtNEQ   *time.Time  `json:"fetchedAtNEQ,omitempty"`
+	FetchedAtIn    []time.Time `json:"fetchedAtIn,omitempty"`
+	FetchedAtNotIn []time.Time `json:"fetchedAtNotIn,omitempty"`
+	FetchedAtGT    *time.Time  `json:"fetchedAtGT,omitempty"`
+	FetchedAtGTE   *time.Time  `json:"fetchedAtGTE,omitempty"`
+	FetchedAtLT    *time.Time  `json:"fetchedAtLT,omitempty"`
+	FetchedAtLTE   *time.Time  `json:"fetchedAtLTE,omitempty"`
 
-	// "reposts_count" field predicates.
-	RepostsCount      *int  `json:"repostsCount,omitempty"`
-	RepostsCountNEQ   *int  `json:"repostsCountNEQ,omitempty"`
-	RepostsCountIn    []int `json:"repostsCountIn,omitempty"`
-	RepostsCountNotIn []int `json:"repostsCountNotIn,omitempty"`
-	RepostsCountGT    *int  `json:"repostsCountGT,omitempty"`
-	RepostsCountGTE   *int  `json:"repostsCountGTE,omitempty"`
-	RepostsCountLT    *int  `json:"repostsCountLT,omitempty"`
-	RepostsCountLTE   *int  `json:"repostsCountLTE,omitempty"`
+	// "raw_data_s3_key" field predicates.
+	RawDataS3Key             *string  `json:"rawDataS3Key,omitempty"`
+	RawDataS3KeyNEQ          *string  `json:"rawDataS3KeyNEQ,omitempty"`
+	RawDataS3KeyIn           []string `json:"rawDataS3KeyIn,omitempty"`
+	RawDataS3KeyNotIn        []string `json:"rawDataS3KeyNotIn,omitempty"`
+	RawDataS3KeyGT           *string  `json:"rawDataS3KeyGT,omitempty"`
+	RawDataS3KeyGTE          *string  `json:"rawDataS3KeyGTE,omitempty"`
+	RawDataS3KeyLT           *string  `json:"rawDataS3KeyLT,omitempty"`
+	RawDataS3KeyLTE          *string  `json:"rawDataS3KeyLTE,omitempty"`
+	RawDataS3KeyContains     *string  `json:"rawDataS3KeyContains,omitempty"`
+	RawDataS3KeyHasPrefix    *string  `json:"rawDataS3KeyHasPrefix,omitempty"`
+	RawDataS3KeyHasSuffix    *string  `json:"rawDataS3KeyHasSuffix,omitempty"`
+	RawDataS3KeyIsNil        bool     `json:"rawDataS3KeyIsNil,omitempty"`
+	RawDataS3KeyNotNil       bool     `json:"rawDataS3KeyNotNil,omitempty"`
+	RawDataS3KeyEqualFold    *string  `json:"rawDataS3KeyEqualFold,omitempty"`
+	RawDataS3KeyContainsFold *string  `json:"rawDataS3KeyContainsFold,omitempty"`
 
-	// "empathy_count" field predicates.
-	EmpathyCount      *int  `json:"empathyCount,omitempty"`
-	EmpathyCountNEQ   *int  `json:"empathyCountNEQ,omitempty"`
-	EmpathyCountIn    []int `json:"empathyCountIn,omitempty"`
-	EmpathyCountNotIn []int `json:"empathyCountNotIn,omitempty"`
-	EmpathyCountGT    *int  `json:"empathyCountGT,omitempty"`
-	EmpathyCountGTE   *int  `json:"empathyCountGTE,omitempty"`
-	EmpathyCountLT    *int  `json:"empathyCountLT,omitempty"`
-	EmpathyCountLTE   *int  `json:"empathyCountLTE,omitempty"`
+	// "cleaned_data_s3_key" field predicates.
+	CleanedDataS3Key             *string  `json:"cleanedDataS3Key,omitempty"`
+	CleanedDataS3KeyNEQ          *string  `json:"cleanedDataS3KeyNEQ,omitempty"`
+	CleanedDataS3KeyIn           []string `json:"cleanedDataS3KeyIn,omitempty"`
+	CleanedDataS3KeyNotIn        []string `json:"cleanedDataS3KeyNotIn,omitempty"`
+	CleanedDataS3KeyGT           *string  `json:"cleanedDataS3KeyGT,omitempty"`
+	CleanedDataS3KeyGTE          *string  `json:"cleanedDataS3KeyGTE,omitempty"`
+	CleanedDataS3KeyLT           *string  `json:"cleanedDataS3KeyLT,omitempty"`
+	CleanedDataS3KeyLTE          *string  `json:"cleanedDataS3KeyLTE,omitempty"`
+	CleanedDataS3KeyContains     *string  `json:"cleanedDataS3KeyContains,omitempty"`
+	CleanedDataS3KeyHasPrefix    *string  `json:"cleanedDataS3KeyHasPrefix,omitempty"`
+	CleanedDataS3KeyHasSuffix    *string  `json:"cleanedDataS3KeyHasSuffix,omitempty"`
+	CleanedDataS3KeyIsNil        bool     `json:"cleanedDataS3KeyIsNil,omitempty"`
+	CleanedDataS3KeyNotNil       bool     `json:"cleanedDataS3KeyNotNil,omitempty"`
+	CleanedDataS3KeyEqualFold    *string  `json:"cleanedDataS3KeyEqualFold,omitempty"`
+	CleanedDataS3KeyContainsFold *string  `json:"cleanedDataS3KeyContainsFold,omitempty"`
 
-	// "praise_count" field predicates.
-	PraiseCount      *int  `json:"praiseCount,omitempty"`
-	PraiseCountNEQ   *int  `json:"praiseCountNEQ,omitempty"`
-	PraiseCountIn    []int `json:"praiseCountIn,omitempty"`
-	PraiseCountNotIn []int `json:"praiseCountNotIn,omitempty"`
-	PraiseCountGT    *int  `json:"praiseCountGT,omitempty"`
-	PraiseCountGTE   *int  `json:"praiseCountGTE,omitempty"`
-	PraiseCountLT    *int  `json:"praiseCountLT,omitempty"`
-	PraiseCountLTE   *int  `json:"praiseCountLTE,omitempty"`
+	// "headline" field predicates.
+	Headline             *string  `json:"headline,omitempty"`
+	HeadlineNEQ          *string  `json:"headlineNEQ,omitempty"`
+	HeadlineIn           []string `json:"headlineIn,omitempty"`
+	HeadlineNotIn        []string `json:"headlineNotIn,omitempty"`
+	HeadlineGT           *string  `json:"headlineGT,omitempty"`
+	HeadlineGTE          *string  `json:"headlineGTE,omitempty"`
+	HeadlineLT           *string  `json:"headlineLT,omitempty"`
+	HeadlineLTE          *string  `json:"headlineLTE,omitempty"`
+	HeadlineContains     *string  `json:"headlineContains,omitempty"`
+	HeadlineHasPrefix    *string  `json:"headlineHasPrefix,omitempty"`
+	HeadlineHasSuffix    *string  `json:"headlineHasSuffix,omitempty"`
+	HeadlineIsNil        bool     `json:"headlineIsNil,omitempty"`
+	HeadlineNotNil       bool     `json:"headlineNotNil,omitempty"`
+	HeadlineEqualFold    *string  `json:"headlineEqualFold,omitempty"`
+	HeadlineContainsFold *string  `json:"headlineContainsFold,omitempty"`
 
-	// "funny_count" field predicates.
-	FunnyCount      *int  `json:"funnyCount,omitempty"`
-	FunnyCountNEQ   *int  `json:"funnyCountNEQ,omitempty"`
-	FunnyCountIn    []int `json:"funnyCountIn,omitempty"`
-	FunnyCountNotIn []int `json:"funnyCountNotIn,omitempty"`
-	FunnyCountGT    *int  `json:"funnyCountGT,omitempty"`
-	FunnyCountGTE   *int  `json:"funnyCountGTE,omitempty"`
-	FunnyCountLT    *int  `json:"funnyCountLT,omitempty"`
-	FunnyCountLTE   *int  `json:"funnyCountLTE,omitempty"`
+	// "title" field predicates.
+	Title             *string  `json:"title,omitempty"`
+	TitleNEQ          *string  `json:"titleNEQ,omitempty"`
+	TitleIn           []string `json:"titleIn,omitempty"`
+	TitleNotIn        []string `json:"titleNotIn,omitempty"`
+	TitleGT           *string  `json:"titleGT,omitempty"`
+	TitleGTE          *string  `json:"titleGTE,omitempty"`
+	TitleLT           *string  `json:"titleLT,omitempty"`
+	TitleLTE          *string  `json:"titleLTE,omitempty"`
+	TitleContains     *string  `json:"titleContains,omitempty"`
+	TitleHasPrefix    *string  `json:"titleHasPrefix,omitempty"`
+	TitleHasSuffix    *string  `json:"titleHasSuffix,omitempty"`
+	TitleIsNil        bool     `json:"titleIsNil,omitempty"`
+	TitleNotNil       bool     `json:"titleNotNil,omitempty"`
+	TitleEqualFold    *string  `json:"titleEqualFold,omitempty"`
+	TitleContainsFold *string  `json:"titleContainsFold,omitempty"`
 
-	// "interest_count" field predicates.
-	InterestCount      *int  `json:"interestCount,omitempty"`
-	InterestCountNEQ   *int  `json:"interestCountNEQ,omitempty"`
-	InterestCountIn    []int `json:"interestCountIn,omitempty"`
-	InterestCountNotIn []int `json:"interestCountNotIn,omitempty"`
-	InterestCountGT    *int  `json:"interestCountGT,omitempty"`
-	InterestCountGTE   *int  `json:"interestCountGTE,omitempty"`
-	InterestCountLT    *int  `json:"interestCountLT,omitempty"`
-	InterestCountLTE   *int  `json:"interestCountLTE,omitempty"`
+	// "country" field predicates.
+	Country             *string  `json:"country,omitempty"`
+	CountryNEQ          *string  `json:"countryNEQ,omitempty"`
+	CountryIn           []string `json:"countryIn,omitempty"`
+	CountryNotIn        []string `json:"countryNotIn,omitempty"`
+	CountryGT           *string  `json:"countryGT,omitempty"`
+	CountryGTE          *string  `json:"countryGTE,omitempty"`
+	CountryLT           *string  `json:"countryLT,omitempty"`
+	CountryLTE          *string  `json:"countryLTE,omitempty"`
+	CountryContains     *string  `json:"countryContains,omitempty"`
+	CountryHasPrefix    *string  `json:"countryHasPrefix,omitempty"`
+	CountryHasSuffix    *string  `json:"countryHasSuffix,omitempty"`
+	CountryIsNil        bool     `json:"countryIsNil,omitempty"`
+	CountryNotNil       bool     `json:"countryNotNil,omitempty"`
+	CountryEqualFold    *string  `json:"countryEqualFold,omitempty"`
+	CountryContainsFold *string  `json:"countryContainsFold,omitempty"`
 
-	// "posted_at" field predicates.
-	PostedAt       *time.Time  `json:"postedAt,omitempty"`
-	PostedAtNEQ    *time.Time  `json:"postedAtNEQ,omitempty"`
-	PostedAtIn     []time.Time `json:"postedAtIn,omitempty"`
-	PostedAtNotIn  []time.Time `json:"postedAtNotIn,omitempty"`
-	PostedAtGT     *time.Time  `json:"postedAtGT,omitempty"`
-	PostedAtGTE    *time.Time  `json:"postedAtGTE,omitempty"`
-	PostedAtLT     *time.Time  `json:"postedAtLT,omitempty"`
-	PostedAtLTE    *time.Time  `json:"postedAtLTE,omitempty"`
-	PostedAtIsNil  bool        `json:"postedAtIsNil,omitempty"`
-	PostedAtNotNil bool        `json:"postedAtNotNil,omitempty"`
+	// "city" field predicates.
+	City             *string  `json:"city,omitempty"`
+	CityNEQ          *string  `json:"cityNEQ,omitempty"`
+	CityIn           []string `json:"cityIn,omitempty"`
+	CityNotIn        []string `json:"cityNotIn,omitempty"`
+	CityGT           *string  `json:"cityGT,omitempty"`
+	CityGTE          *string  `json:"cityGTE,omitempty"`
+	CityLT           *string  `json:"cityLT,omitempty"`
+	CityLTE          *string  `json:"cityLTE,omitempty"`
+	CityContains     *string  `json:"cityContains,omitempty"`
+	CityHasPrefix    *string  `json:"cityHasPrefix,omitempty"`
+	CityHasSuffix    *string  `json:"cityHasSuffix,omitempty"`
+	CityIsNil        bool     `json:"cityIsNil,omitempty"`
+	CityNotNil       bool     `json:"cityNotNil,omitempty"`
+	CityEqualFold    *string  `json:"cityEqualFold,omitempty"`
+	CityContainsFold *string  `json:"cityContainsFold,omitempty"`
 
 	// "created_at" field predicates.
 	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
@@ -3489,24 +8873,28 @@ type ProfilePostItemWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "profile_post" edge predicates.
-	HasProfilePost     *bool                    `json:"hasProfilePost,omitempty"`
-	HasProfilePostWith []*ProfilePostWhereInput `json:"hasProfilePostWith,omitempty"`
+	// "profile" edge predicates.
+	HasProfile     *bool                `json:"hasProfile,omitempty"`
+	HasProfileWith []*ProfileWhereInput `json:"hasProfileWith,omitempty"`
+
+	// "change_events" edge predicates.
+	HasChangeEvents     *bool                           `json:"hasChangeEvents,omitempty"`
+	HasChangeEventsWith []*ProfileChangeEventWhereInput `json:"hasChangeEventsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ProfilePostItemWhereInput) AddPredicates(predicates ...predicate.ProfilePostItem) {
+func (i *ProfileSnapshotWhereInput) AddPredicates(predicates ...predicate.ProfileSnapshot) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ProfilePostItemWhereInput filter on the ProfilePostItemQuery builder.
-func (i *ProfilePostItemWhereInput) Filter(q *ProfilePostItemQuery) (*ProfilePostItemQuery, error) {
+// Filter applies the ProfileSnapshotWhereInput filter on the ProfileSnapshotQuery builder.
+func (i *ProfileSnapshotWhereInput) Filter(q *ProfileSnapshotQuery) (*ProfileSnapshotQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyProfilePostItemWhereInput {
+		if err == ErrEmptyProfileSnapshotWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -3514,19 +8902,19 @@ func (i *ProfilePostItemWhereInput) Filter(q *ProfilePostItemQuery) (*ProfilePos
 	return q.Where(p), nil
 }
 
-// ErrEmptyProfilePostItemWhereInput is returned in case the ProfilePostItemWhereInput is empty.
-var ErrEmptyProfilePostItemWhereInput = errors.New("ent: empty predicate ProfilePostItemWhereInput")
+// ErrEmptyProfileSnapshotWhereInput is returned in case the ProfileSnapshotWhereInput is empty.
+var ErrEmptyProfileSnapshotWhereInput = errors.New("ent: empty predicate ProfileSnapshotWhereInput")
 
-// P returns a predicate for filtering profilepostitems.
+// P returns a predicate for filtering profilesnapshots.
 // An error is returned if the input is empty or invalid.
-func (i *ProfilePostItemWhereInput) P() (predicate.ProfilePostItem, error) {
-	var predicates []predicate.ProfilePostItem
+func (i *ProfileSnapshotWhereInput) P() (predicate.ProfileSnapshot, error) {
+	var predicates []predicate.ProfileSnapshot
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, profilepostitem.Not(p))
+		predicates = append(predicates, profilesnapshot.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -3536,7 +8924,7 @@ func (i *ProfilePostItemWhereInput) P() (predicate.ProfilePostItem, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.ProfilePostItem, 0, n)
+		or := make([]predicate.ProfileSnapshot, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -3544,7 +8932,7 @@ func (i *ProfilePostItemWhereInput) P() (predicate.ProfilePostItem, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, profilepostitem.Or(or...))
+		predicates = append(predicates, profilesnapshot.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -3554,7 +8942,7 @@ func (i *ProfilePostItemWhereInput) P() (predicate.ProfilePostItem, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.ProfilePostItem, 0, n)
+		and := make([]predicate.ProfileSnapshot, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -3562,530 +8950,395 @@ func (i *ProfilePostItemWhereInput) P() (predicate.ProfilePostItem, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, profilepostitem.And(and...))
+		predicates = append(predicates, profilesnapshot.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, profilepostitem.IDEQ(*i.ID))
+		predicates = append(predicates, profilesnapshot.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, profilepostitem.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, profilesnapshot.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, profilepostitem.IDIn(i.IDIn...))
+		predicates = append(predicates, profilesnapshot.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, profilesnapshot.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, profilepostitem.IDGT(*i.IDGT))
+		predicates = append(predicates, profilesnapshot.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, profilepostitem.IDGTE(*i.IDGTE))
+		predicates = append(predicates, profilesnapshot.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, profilepostitem.IDLT(*i.IDLT))
+		predicates = append(predicates, profilesnapshot.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, profilepostitem.IDLTE(*i.IDLTE))
-	}
-	if i.ProfileUsername != nil {
-		predicates = append(predicates, profilepostitem.ProfileUsernameEQ(*i.ProfileUsername))
-	}
-	if i.ProfileUsernameNEQ != nil {
-		predicates = append(predicates, profilepostitem.ProfileUsernameNEQ(*i.ProfileUsernameNEQ))
-	}
-	if len(i.ProfileUsernameIn) > 0 {
-		predicates = append(predicates, profilepostitem.ProfileUsernameIn(i.ProfileUsernameIn...))
-	}
-	if len(i.ProfileUsernameNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.ProfileUsernameNotIn(i.ProfileUsernameNotIn...))
-	}
-	if i.ProfileUsernameGT != nil {
-		predicates = append(predicates, profilepostitem.ProfileUsernameGT(*i.ProfileUsernameGT))
-	}
-	if i.ProfileUsernameGTE != nil {
-		predicates = append(predicates, profilepostitem.ProfileUsernameGTE(*i.ProfileUsernameGTE))
-	}
-	if i.ProfileUsernameLT != nil {
-		predicates = append(predicates, profilepostitem.ProfileUsernameLT(*i.ProfileUsernameLT))
-	}
-	if i.ProfileUsernameLTE != nil {
-		predicates = append(predicates, profilepostitem.ProfileUsernameLTE(*i.ProfileUsernameLTE))
-	}
-	if i.ProfileUsernameContains != nil {
-		predicates = append(predicates, profilepostitem.ProfileUsernameContains(*i.ProfileUsernameContains))
-	}
-	if i.ProfileUsernameHasPrefix != nil {
-		predicates = append(predicates, profilepostitem.ProfileUsernameHasPrefix(*i.ProfileUsernameHasPrefix))
-	}
-	if i.ProfileUsernameHasSuffix != nil {
-		predicates = append(predicates, profilepostitem.ProfileUsernameHasSuffix(*i.ProfileUsernameHasSuffix))
-	}
-	if i.ProfileUsernameEqualFold != nil {
-		predicates = append(predicates, profilepostitem.ProfileUsernameEqualFold(*i.ProfileUsernameEqualFold))
-	}
-	if i.ProfileUsernameContainsFold != nil {
-		predicates = append(predicates, profilepostitem.ProfileUsernameContainsFold(*i.ProfileUsernameContainsFold))
-	}
-	if i.PostUrn != nil {
-		predicates = append(predicates, profilepostitem.PostUrnEQ(*i.PostUrn))
-	}
-	if i.PostUrnNEQ != nil {
-		predicates = append(predicates, profilepostitem.PostUrnNEQ(*i.PostUrnNEQ))
-	}
-	if len(i.PostUrnIn) > 0 {
-		predicates = append(predicates, profilepostitem.PostUrnIn(i.PostUrnIn...))
-	}
-	if len(i.PostUrnNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.PostUrnNotIn(i.PostUrnNotIn...))
-	}
-	if i.PostUrnGT != nil {
-		predicates = append(predicates, profilepostitem.PostUrnGT(*i.PostUrnGT))
-	}
-	if i.PostUrnGTE != nil {
-		predicates = append(predicates, profilepostitem.PostUrnGTE(*i.PostUrnGTE))
-	}
-	if i.PostUrnLT != nil {
-		predicates = append(predicates, profilepostitem.PostUrnLT(*i.PostUrnLT))
-	}
-	if i.PostUrnLTE != nil {
-		predicates = append(predicates, profilepostitem.PostUrnLTE(*i.PostUrnLTE))
-	}
-	if i.PostUrnContains != nil {
-		predicates = append(predicates, profilepostitem.PostUrnContains(*i.PostUrnContains))
-	}
-	if i.PostUrnHasPrefix != nil {
-		predicates = append(predicates, profilepostitem.PostUrnHasPrefix(*i.PostUrnHasPrefix))
-	}
-	if i.PostUrnHasSuffix != nil {
-		predicates = append(predicates, profilepostitem.PostUrnHasSuffix(*i.PostUrnHasSuffix))
-	}
-	if i.PostUrnIsNil {
-		predicates = append(predicates, profilepostitem.PostUrnIsNil())
-	}
-	if i.PostUrnNotNil {
-		predicates = append(predicates, profilepostitem.PostUrnNotNil())
-	}
-	if i.PostUrnEqualFold != nil {
-		predicates = append(predicates, profilepostitem.PostUrnEqualFold(*i.PostUrnEqualFold))
-	}
-	if i.PostUrnContainsFold != nil {
-		predicates = append(predicates, profilepostitem.PostUrnContainsFold(*i.PostUrnContainsFold))
-	}
-	if i.PostURL != nil {
-		predicates = append(predicates, profilepostitem.PostURLEQ(*i.PostURL))
-	}
-	if i.PostURLNEQ != nil {
-		predicates = append(predicates, profilepostitem.PostURLNEQ(*i.PostURLNEQ))
-	}
-	if len(i.PostURLIn) > 0 {
-		predicates = append(predicates, profilepostitem.PostURLIn(i.PostURLIn...))
-	}
-	if len(i.PostURLNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.PostURLNotIn(i.PostURLNotIn...))
-	}
-	if i.PostURLGT != nil {
-		predicates = append(predicates, profilepostitem.PostURLGT(*i.PostURLGT))
-	}
-	if i.PostURLGTE != nil {
-		predicates = append(predicates, profilepostitem.PostURLGTE(*i.PostURLGTE))
-	}
-	if i.PostURLLT != nil {
-		predicates = append(predicates, profilepostitem.PostURLLT(*i.PostURLLT))
-	}
-	if i.PostURLLTE != nil {
-		predicates = append(predicates, profilepostitem.PostURLLTE(*i.PostURLLTE))
-	}
-	if i.PostURLContains != nil {
-		predicates = append(predicates, profilepostitem.PostURLContains(*i.PostURLContains))
-	}
-	if i.PostURLHasPrefix != nil {
-		predicates = append(predicates, profilepostitem.PostURLHasPrefix(*i.PostURLHasPrefix))
-	}
-	if i.PostURLHasSuffix != nil {
-		predicates = append(predicates, profilepostitem.PostURLHasSuffix(*i.PostURLHasSuffix))
-	}
-	if i.PostURLIsNil {
-		predicates = append(predicates, profilepostitem.PostURLIsNil())
-	}
-	if i.PostURLNotNil {
-		predicates = append(predicates, profilepostitem.PostURLNotNil())
-	}
-	if i.PostURLEqualFold != nil {
-		predicates = append(predicates, profilepostitem.PostURLEqualFold(*i.PostURLEqualFold))
-	}
-	if i.PostURLContainsFold != nil {
-		predicates = append(predicates, profilepostitem.PostURLContainsFold(*i.PostURLContainsFold))
-	}
-	if i.Text != nil {
-		predicates = append(predicates, profilepostitem.TextEQ(*i.Text))
-	}
-	if i.TextNEQ != nil {
-		predicates = append(predicates, profilepostitem.TextNEQ(*i.TextNEQ))
-	}
-	if len(i.TextIn) > 0 {
-		predicates = append(predicates, profilepostitem.TextIn(i.TextIn...))
-	}
-	if len(i.TextNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.TextNotIn(i.TextNotIn...))
-	}
-	if i.TextGT != nil {
-		predicates = append(predicates, profilepostitem.TextGT(*i.TextGT))
-	}
-	if i.TextGTE != nil {
-		predicates = append(predicates, profilepostitem.TextGTE(*i.TextGTE))
-	}
-	if i.TextLT != nil {
-		predicates = append(predicates, profilepostitem.TextLT(*i.TextLT))
-	}
-	if i.TextLTE != nil {
-		predicates = append(predicates, profilepostitem.TextLTE(*i.TextLTE))
+		predicates = append(predicates, profilesnapshot.IDLTE(*i.IDLTE))
 	}
-	if i.TextContains != nil {
-		predicates = append(predicates, profilepostitem.TextContains(*i.TextContains))
+	if i.FetchedAt != nil {
+		predicates = append(predicates, profilesnapshot.FetchedAtEQ(*i.FetchedAt))
 	}
-	if i.TextHasPrefix != nil {
-		predicates = append(predicates, profilepostitem.TextHasPrefix(*i.TextHasPrefix))
+	if i.FetchedAtNEQ != nil {
+		predicates = append(predicates, profilesnapshot.FetchedAtNEQ(*i.FetchedAtNEQ))
 	}
-	if i.TextHasSuffix != nil {
-		predicates = append(predicates, profilepostitem.TextHasSuffix(*i.TextHasSuffix))
+	if len(i.FetchedAtIn) > 0 {
+		predicates = append(predicates, profilesnapshot.FetchedAtIn(i.FetchedAtIn...))
 	}
-	if i.TextIsNil {
-		predicates = append(predicates, profilepostitem.TextIsNil())
+	if len(i.FetchedAtNotIn) > 0 {
+		predicates = append(predicates, profilesnapshot.FetchedAtNotIn(i.FetchedAtNotIn...))
 	}
-	if i.TextNotNil {
-		predicates = append(predicates, profilepostitem.TextNotNil())
+	if i.FetchedAtGT != nil {
+		predicates = append(predicates, profilesnapshot.FetchedAtGT(*i.FetchedAtGT))
 	}
-	if i.TextEqualFold != nil {
-		predicates = append(predicates, profilepostitem.TextEqualFold(*i.TextEqualFold))
+	if i.FetchedAtGTE != nil {
+		predicates = append(predicates, profilesnapshot.FetchedAtGTE(*i.FetchedAtGTE))
 	}
-	if i.TextContainsFold != nil {
-		predicates = append(predicates, profilepostitem.TextContainsFold(*i.TextContainsFold))
+	if i.FetchedAtLT != nil {
+		predicates = append(predicates, profilesnapshot.FetchedAtLT(*i.FetchedAtLT))
 	}
-	if i.ContentType != nil {
-		predicates = append(predicates, profilepostitem.ContentTypeEQ(*i.ContentType))
+	if i.FetchedAtLTE != nil {
+		predicates = append(predicates, profilesnapshot.FetchedAtLTE(*i.FetchedAtLTE))
 	}
-	if i.ContentTypeNEQ != nil {
-		predicates = append(predicates, profilepostitem.ContentTypeNEQ(*i.ContentTypeNEQ))
+	if i.RawDataS3Key != nil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyEQ(*i.RawDataS3Key))
 	}
-	if len(i.ContentTypeIn) > 0 {
-		predicates = append(predicates, profilepostitem.ContentTypeIn(i.ContentTypeIn...))
+	if i.RawDataS3KeyNEQ != nil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyNEQ(*i.RawDataS3KeyNEQ))
 	}
-	if len(i.ContentTypeNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.ContentTypeNotIn(i.ContentTypeNotIn...))
+	if len(i.RawDataS3KeyIn) > 0 {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyIn(i.RawDataS3KeyIn...))
 	}
-	if i.ContentTypeGT != nil {
-		predicates = append(predicates, profilepostitem.ContentTypeGT(*i.ContentTypeGT))
+	if len(i.RawDataS3KeyNotIn) > 0 {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyNotIn(i.RawDataS3KeyNotIn...))
 	}
-	if i.ContentTypeGTE != nil {
-		predicates = append(predicates, profilepostitem.ContentTypeGTE(*i.ContentTypeGTE))
+	if i.RawDataS3KeyGT != nil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyGT(*i.RawDataS3KeyGT))
 	}
-	if i.ContentTypeLT != nil {
-		predicates = append(predicates, profilepostitem.ContentTypeLT(*i.ContentTypeLT))
+	if i.RawDataS3KeyGTE != nil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyGTE(*i.RawDataS3KeyGTE))
 	}
-	if i.ContentTypeLTE != nil {
-		predicates = append(predicates, profilepostitem.ContentTypeLTE(*i.ContentTypeLTE))
+	if i.RawDataS3KeyLT != nil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyLT(*i.RawDataS3KeyLT))
 	}
-	if i.ContentTypeContains != nil {
-		predicates = append(predicates, profilepostitem.ContentTypeContains(*i.ContentTypeContains))
+	if i.RawDataS3KeyLTE != nil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyLTE(*i.RawDataS3KeyLTE))
 	}
-	if i.ContentTypeHasPrefix != nil {
-		predicates = append(predicates, profilepostitem.ContentTypeHasPrefix(*i.ContentTypeHasPrefix))
+	if i.RawDataS3KeyContains != nil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyContains(*i.RawDataS3KeyContains))
 	}
-	if i.ContentTypeHasSuffix != nil {
-		predicates = append(predicates, profilepostitem.ContentTypeHasSuffix(*i.ContentTypeHasSuffix))
+	if i.RawDataS3KeyHasPrefix != nil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyHasPrefix(*i.RawDataS3KeyHasPrefix))
 	}
-	if i.ContentTypeIsNil {
-		predicates = append(predicates, profilepostitem.ContentTypeIsNil())
+	if i.RawDataS3KeyHasSuffix != nil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyHasSuffix(*i.RawDataS3KeyHasSuffix))
 	}
-	if i.ContentTypeNotNil {
-		predicates = append(predicates, profilepostitem.ContentTypeNotNil())
+	if i.RawDataS3KeyIsNil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyIsNil())
 	}
-	if i.ContentTypeEqualFold != nil {
-		predicates = append(predicates, profilepostitem.ContentTypeEqualFold(*i.ContentTypeEqualFold))
+	if i.RawDataS3KeyNotNil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyNotNil())
 	}
-	if i.ContentTypeContainsFold != nil {
-		predicates = append(predicates, profilepostitem.ContentTypeContainsFold(*i.ContentTypeContainsFold))
+	if i.RawDataS3KeyEqualFold != nil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyEqualFold(*i.RawDataS3KeyEqualFold))
 	}
-	if i.IsRepost != nil {
-		predicates = append(predicates, profilepostitem.IsRepostEQ(*i.IsRepost))
+	if i.RawDataS3KeyContainsFold != nil {
+		predicates = append(predicates, profilesnapshot.RawDataS3KeyContainsFold(*i.RawDataS3KeyContainsFold))
 	}
-	if i.IsRepostNEQ != nil {
-		predicates = append(predicates, profilepostitem.IsRepostNEQ(*i.IsRepostNEQ))
+	if i.CleanedDataS3Key != nil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyEQ(*i.CleanedDataS3Key))
 	}
-	if i.TotalReactions != nil {
-		predicates = append(predicates, profilepostitem.TotalReactionsEQ(*i.TotalReactions))
+	if i.CleanedDataS3KeyNEQ != nil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyNEQ(*i.CleanedDataS3KeyNEQ))
 	}
-	if i.TotalReactionsNEQ != nil {
-		predicates = append(predicates, profilepostitem.TotalReactionsNEQ(*i.TotalReactionsNEQ))
+	if len(i.CleanedDataS3KeyIn) > 0 {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyIn(i.CleanedDataS3KeyIn...))
 	}
-	if len(i.TotalReactionsIn) > 0 {
-		predicates = append(predicates, profilepostitem.TotalReactionsIn(i.TotalReactionsIn...))
+	if len(i.CleanedDataS3KeyNotIn) > 0 {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyNotIn(i.CleanedDataS3KeyNotIn...))
 	}
-	if len(i.TotalReactionsNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.TotalReactionsNotIn(i.TotalReactionsNotIn...))
+	if i.CleanedDataS3KeyGT != nil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyGT(*i.CleanedDataS3KeyGT))
 	}
-	if i.TotalReactionsGT != nil {
-		predicates = append(predicates, profilepostitem.TotalReactionsGT(*i.TotalReactionsGT))
+	if i.CleanedDataS3KeyGTE != nil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyGTE(*i.CleanedDataS3KeyGTE))
 	}
-	if i.TotalReactionsGTE != nil {
-		predicates = append(predicates, profilepostitem.TotalReactionsGTE(*i.TotalReactionsGTE))
+	if i.CleanedDataS3KeyLT != nil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyLT(*i.CleanedDataS3KeyLT))
 	}
-	if i.TotalReactionsLT != nil {
-		predicates = append(predicates, profilepostitem.TotalReactionsLT(*i.TotalReactionsLT))
+	if i.CleanedDataS3KeyLTE != nil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyLTE(*i.CleanedDataS3KeyLTE))
 	}
-	if i.TotalReactionsLTE != nil {
-		predicates = append(predicates, profilepostitem.TotalReactionsLTE(*i.TotalReactionsLTE))
+	if i.CleanedDataS3KeyContains != nil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyContains(*i.CleanedDataS3KeyContains))
 	}
-	if i.LikeCount != nil {
-		predicates = append(predicates, profilepostitem.LikeCountEQ(*i.LikeCount))
+	if i.CleanedDataS3KeyHasPrefix != nil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyHasPrefix(*i.CleanedDataS3KeyHasPrefix))
 	}
-	if i.LikeCountNEQ != nil {
-		predicates = append(predicates, profilepostitem.LikeCountNEQ(*i.LikeCountNEQ))
+	if i.CleanedDataS3KeyHasSuffix != nil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyHasSuffix(*i.CleanedDataS3KeyHasSuffix))
 	}
-	if len(i.LikeCountIn) > 0 {
-		predicates = append(predicates, profilepostitem.LikeCountIn(i.LikeCountIn...))
+	if i.CleanedDataS3KeyIsNil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyIsNil())
 	}
-	if len(i.LikeCountNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.LikeCountNotIn(i.LikeCountNotIn...))
+	if i.CleanedDataS3KeyNotNil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyNotNil())
 	}
-	if i.LikeCountGT != nil {
-		predicates = append(predicates, profilepostitem.LikeCountGT(*i.LikeCountGT))
+	if i.CleanedDataS3KeyEqualFold != nil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyEqualFold(*i.CleanedDataS3KeyEqualFold))
 	}
-	if i.LikeCountGTE != nil {
-		predicates = append(predicates, profilepostitem.LikeCountGTE(*i.LikeCountGTE))
+	if i.CleanedDataS3KeyContainsFold != nil {
+		predicates = append(predicates, profilesnapshot.CleanedDataS3KeyContainsFold(*i.CleanedDataS3KeyContainsFold))
 	}
-	if i.LikeCountLT != nil {
-		predicates = append(predicates, profilepostitem.LikeCountLT(*i.LikeCountLT))
+	if i.Headline != nil {
+		predicates = append(predicates, profilesnapshot.HeadlineEQ(*i.Headline))
 	}
-	if i.LikeCountLTE != nil {
-		predicates = append(predicates, profilepostitem.LikeCountLTE(*i.LikeCountLTE))
+	if i.HeadlineNEQ != nil {
+		predicates = append(predicates, profilesnapshot.HeadlineNEQ(*i.HeadlineNEQ))
 	}
-	if i.CommentsCount != nil {
-		predicates = append(predicates, profilepostitem.CommentsCountEQ(*i.CommentsCount))
+	if len(i.HeadlineIn) > 0 {
+		predicates = append(predicates, profilesnapshot.HeadlineIn(i.HeadlineIn...))
 	}
-	if i.CommentsCountNEQ != nil {
-		predicates = append(predicates, profilepostitem.CommentsCountNEQ(*i.CommentsCountNEQ))
+	if len(i.HeadlineNotIn) > 0 {
+		predicates = append(predicates, profilesnapshot.HeadlineNotIn(i.HeadlineNotIn...))
 	}
-	if len(i.CommentsCountIn) > 0 {
-		predicates = append(predicates, profilepostitem.CommentsCountIn(i.CommentsCountIn...))
+	if i.HeadlineGT != nil {
+		predicates = append(predicates, profilesnapshot.HeadlineGT(*i.HeadlineGT))
 	}
-	if len(i.CommentsCountNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.CommentsCountNotIn(i.CommentsCountNotIn...))
+	if i.HeadlineGTE != nil {
+		predicates = append(predicates, profilesnapshot.HeadlineGTE(*i.HeadlineGTE))
 	}
-	if i.CommentsCountGT != nil {
-		predicates = append(predicates, profilepostitem.CommentsCountGT(*i.CommentsCountGT))
+	if i.HeadlineLT != nil {
+		predicates = append(predicates, profilesnapshot.HeadlineLT(*i.HeadlineLT))
 	}
-	if i.CommentsCountGTE != nil {
-		predicates = append(predicates, profilepostitem.CommentsCountGTE(*i.CommentsCountGTE))
+	if i.HeadlineLTE != nil {
+		predicates = append(predicates, profilesnapshot.HeadlineLTE(*i.HeadlineLTE))
 	}
-	if i.CommentsCountLT != nil {
-		predicates = append(predicates, profilepostitem.CommentsCountLT(*i.CommentsCountLT))
+	if i.HeadlineContains != nil {
+		predicates = append(predicates, profilesnapshot.HeadlineContains(*i.HeadlineContains))
 	}
-	if i.CommentsCountLTE != nil {
-		predicates = append(predicates, profilepostitem.CommentsCountLTE(*i.CommentsCountLTE))
+	if i.HeadlineHasPrefix != nil {
+		predicates = append(predicates, profilesnapshot.HeadlineHasPrefix(*i.HeadlineHasPrefix))
 	}
-	if i.RepostsCount != nil {
-		predicates = append(predicates, profilepostitem.RepostsCountEQ(*i.RepostsCount))
+	if i.HeadlineHasSuffix != nil {
+		predicates = append(predicates, profilesnapshot.HeadlineHasSuffix(*i.HeadlineHasSuffix))
 	}
-	if i.RepostsCountNEQ != nil {
-		predicates = append(predicates, profilepostitem.RepostsCountNEQ(*i.RepostsCountNEQ))
+	if i.HeadlineIsNil {
+		predicates = append(predicates, profilesnapshot.HeadlineIsNil())
 	}
-	if len(i.RepostsCountIn) > 0 {
-		predicates = append(predicates, profilepostitem.RepostsCountIn(i.RepostsCountIn...))
+	if i.HeadlineNotNil {
+		predicates = append(predicates, profilesnapshot.HeadlineNotNil())
 	}
-	if len(i.RepostsCountNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.RepostsCountNotIn(i.RepostsCountNotIn...))
+	if i.HeadlineEqualFold != nil {
+		predicates = append(predicates, profilesnapshot.HeadlineEqualFold(*i.HeadlineEqualFold))
 	}
-	if i.RepostsCountGT != nil {
-		predicates = append(predicates, profilepostitem.RepostsCountGT(*i.RepostsCountGT))
+	if i.HeadlineContainsFold != nil {
+		predicates = append(predicates, profilesnapshot.HeadlineContainsFold(*i.HeadlineContainsFold))
 	}
-	if i.RepostsCountGTE != nil {
-		predicates = append(predicates, profilepostitem.RepostsCountGTE(*i.RepostsCountGTE))
+	if i.Title != nil {
+		predicates = append(predicates, profilesnapshot.TitleEQ(*i.Title))
 	}
-	if i.RepostsCountLT != nil {
-		predicates = append(predicates, profilepostitem.RepostsCountLT(*i.RepostsCountLT))
+	if i.TitleNEQ != nil {
+		predicates = append(predicates, profilesnapshot.TitleNEQ(*i.TitleNEQ))
 	}
-	if i.RepostsCountLTE != nil {
-		predicates = append(predicates, profilepostitem.RepostsCountLTE(*i.RepostsCountLTE))
+	if len(i.TitleIn) > 0 {
+		predicates = append(predicates, profilesnapshot.TitleIn(i.TitleIn...))
 	}
-	if i.EmpathyCount != nil {
-		predicates = append(predicates, profilepostitem.EmpathyCountEQ(*i.EmpathyCount))
+	if len(i.TitleNotIn) > 0 {
+		predicates = append(predicates, profilesnapshot.TitleNotIn(i.TitleNotIn...))
 	}
-	if i.EmpathyCountNEQ != nil {
-		predicates = append(predicates, profilepostitem.EmpathyCountNEQ(*i.EmpathyCountNEQ))
+	if i.TitleGT != nil {
+		predicates = append(predicates, profilesnapshot.TitleGT(*i.TitleGT))
 	}
-	if len(i.EmpathyCountIn) > 0 {
-		predicates = append(predicates, profilepostitem.EmpathyCountIn(i.EmpathyCountIn...))
+	if i.TitleGTE != nil {
+		predicates = append(predicates, profilesnapshot.TitleGTE(*i.TitleGTE))
 	}
-	if len(i.EmpathyCountNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.EmpathyCountNotIn(i.EmpathyCountNotIn...))
+	if i.TitleLT != nil {
+		predicates = append(predicates, profilesnapshot.TitleLT(*i.TitleLT))
 	}
-	if i.EmpathyCountGT != nil {
-		predicates = append(predicates, profilepostitem.EmpathyCountGT(*i.EmpathyCountGT))
+	if i.TitleLTE != nil {
+		predicates = append(predicates, profilesnapshot.TitleLTE(*i.TitleLTE))
 	}
-	if i.EmpathyCountGTE != nil {
-		predicates = append(predicates, profilepostitem.EmpathyCountGTE(*i.EmpathyCountGTE))
+	if i.TitleContains != nil {
+		predicates = append(predicates, profilesnapshot.TitleContains(*i.TitleContains))
 	}
-	if i.EmpathyCountLT != nil {
-		predicates = append(predicates, profilepostitem.EmpathyCountLT(*i.EmpathyCountLT))
+	if i.TitleHasPrefix != nil {
+		predicates = append(predicates, profilesnapshot.TitleHasPrefix(*i.TitleHasPrefix))
 	}
-	if i.EmpathyCountLTE != nil {
-		predicates = append(predicates, profilepostitem.EmpathyCountLTE(*i.EmpathyCountLTE))
+	if i.TitleHasSuffix != nil {
+		predicates = append(predicates, profilesnapshot.TitleHasSuffix(*i.TitleHasSuffix))
 	}
-	if i.PraiseCount != nil {
-		predicates = append(predicates, profilepostitem.PraiseCountEQ(*i.PraiseCount))
+	if i.TitleIsNil {
+		predicates = append(predicates, profilesnapshot.TitleIsNil())
 	}
-	if i.PraiseCountNEQ != nil {
-		predicates = append(predicates, profilepostitem.PraiseCountNEQ(*i.PraiseCountNEQ))
+	if i.TitleNotNil {
+		predicates = append(predicates, profilesnapshot.TitleNotNil())
 	}
-	if len(i.PraiseCountIn) > 0 {
-		predicates = append(predicates, profilepostitem.PraiseCountIn(i.PraiseCountIn...))
+	if i.TitleEqualFold != nil {
+		predicates = append(predicates, profilesnapshot.TitleEqualFold(*i.TitleEqualFold))
 	}
-	if len(i.PraiseCountNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.PraiseCountNotIn(i.PraiseCountNotIn...))
+	if i.TitleContainsFold != nil {
+		predicates = append(predicates, profilesnapshot.TitleContainsFold(*i.TitleContainsFold))
 	}
-	if i.PraiseCountGT != nil {
-		predicates = append(predicates, profilepostitem.PraiseCountGT(*i.PraiseCountGT))
+	if i.Country != nil {
+		predicates = append(predicates, profilesnapshot.CountryEQ(*i.Country))
 	}
-	if i.PraiseCountGTE != nil {
-		predicates = append(predicates, profilepostitem.PraiseCountGTE(*i.PraiseCountGTE))
+	if i.CountryNEQ != nil {
+		predicates = append(predicates, profilesnapshot.CountryNEQ(*i.CountryNEQ))
 	}
-	if i.PraiseCountLT != nil {
-		predicates = append(predicates, profilepostitem.PraiseCountLT(*i.PraiseCountLT))
+	if len(i.CountryIn) > 0 {
+		predicates = append(predicates, profilesnapshot.CountryIn(i.CountryIn...))
 	}
-	if i.PraiseCountLTE != nil {
-		predicates = append(predicates, profilepostitem.PraiseCountLTE(*i.PraiseCountLTE))
+	if len(i.CountryNotIn) > 0 {
+		predicates = append(predicates, profilesnapshot.CountryNotIn(i.CountryNotIn...))
 	}
-	if i.FunnyCount != nil {
-		predicates = append(predicates, profilepostitem.FunnyCountEQ(*i.FunnyCount))
+	if i.CountryGT != nil {
+		predicates = append(predicates, profilesnapshot.CountryGT(*i.CountryGT))
 	}
-	if i.FunnyCountNEQ != nil {
-		predicates = append(predicates, profilepostitem.FunnyCountNEQ(*i.FunnyCountNEQ))
+	if i.CountryGTE != nil {
+		predicates = append(predicates, profilesnapshot.CountryGTE(*i.CountryGTE))
 	}
-	if len(i.FunnyCountIn) > 0 {
-		predicates = append(predicates, profilepostitem.FunnyCountIn(i.FunnyCountIn...))
+	if i.CountryLT != nil {
+		predicates = append(predicates, profilesnapshot.CountryLT(*i.CountryLT))
 	}
-	if len(i.FunnyCountNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.FunnyCountNotIn(i.FunnyCountNotIn...))
+	if i.CountryLTE != nil {
+		predicates = append(predicates, profilesnapshot.CountryLTE(*i.CountryLTE))
 	}
-	if i.FunnyCountGT != nil {
-		predicates = append(predicates, profilepostitem.FunnyCountGT(*i.FunnyCountGT))
+	if i.CountryContains != nil {
+		predicates = append(predicates, profilesnapshot.CountryContains(*i.CountryContains))
 	}
-	if i.FunnyCountGTE != nil {
-		predicates = append(predicates, profilepostitem.FunnyCountGTE(*i.FunnyCountGTE))
+	if i.CountryHasPrefix != nil {
+		predicates = append(predicates, profilesnapshot.CountryHasPrefix(*i.CountryHasPrefix))
 	}
-	if i.FunnyCountLT != nil {
-		predicates = append(predicates, profilepostitem.FunnyCountLT(*i.FunnyCountLT))
+	if i.CountryHasSuffix != nil {
+		predicates = append(predicates, profilesnapshot.CountryHasSuffix(*i.CountryHasSuffix))
 	}
-	if i.FunnyCountLTE != nil {
-		predicates = append(predicates, profilepostitem.FunnyCountLTE(*i.FunnyCountLTE))
+	if i.CountryIsNil {
+		predicates = append(predicates, profilesnapshot.CountryIsNil())
 	}
-	if i.InterestCount != nil {
-		predicates = append(predicates, profilepostitem.InterestCountEQ(*i.InterestCount))
+	if i.CountryNotNil {
+		predicates = append(predicates, profilesnapshot.CountryNotNil())
 	}
-	if i.InterestCountNEQ != nil {
-		predicates = append(predicates, profilepostitem.InterestCountNEQ(*i.InterestCountNEQ))
+	if i.CountryEqualFold != nil {
+		predicates = append(predicates, profilesnapshot.CountryEqualFold(*i.CountryEqualFold))
 	}
-	if len(i.InterestCountIn) > 0 {
-		predicates = append(predicates, profilepostitem.InterestCountIn(i.InterestCountIn...))
+	if i.CountryContainsFold != nil {
+		predicates = append(predicates, profilesnapshot.CountryContainsFold(*i.CountryContainsFold))
 	}
-	if len(i.InterestCountNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.InterestCountNotIn(i.InterestCountNotIn...))
+	if i.City != nil {
+		predicates = append(predicates, profilesnapshot.CityEQ(*i.City))
 	}
-	if i.InterestCountGT != nil {
-		predicates = append(predicates, profilepostitem.InterestCountGT(*i.InterestCountGT))
+	if i.CityNEQ != nil {
+		predicates = append(predicates, profilesnapshot.CityNEQ(*i.CityNEQ))
 	}
-	if i.InterestCountGTE != nil {
-		predicates = append(predicates, profilepostitem.InterestCountGTE(*i.InterestCountGTE))
+	if len(i.CityIn) > 0 {
+		predicates = append(predicates, profilesnapshot.CityIn(i.CityIn...))
 	}
-	if i.InterestCountLT != nil {
-		predicates = append(predicates, profilepostitem.InterestCountLT(*i.InterestCountLT))
+	if len(i.CityNotIn) > 0 {
+		predicates = append(predicates, profilesnapshot.CityNotIn(i.CityNotIn...))
 	}
-	if i.InterestCountLTE != nil {
-		predicates = append(predicates, profilepostitem.InterestCountLTE(*i.InterestCountLTE))
+	if i.CityGT != nil {
+		predicates = append(predicates, profilesnapshot.CityGT(*i.CityGT))
 	}
-	if i.PostedAt != nil {
-		predicates = append(predicates, profilepostitem.PostedAtEQ(*i.PostedAt))
+	if i.CityGTE != nil {
+		predicates = append(predicates, profilesnapshot.CityGTE(*i.CityGTE))
 	}
-	if i.PostedAtNEQ != nil {
-		predicates = append(predicates, profilepostitem.PostedAtNEQ(*i.PostedAtNEQ))
+	if i.CityLT != nil {
+		predicates = append(predicates, profilesnapshot.CityLT(*i.CityLT))
 	}
-	if len(i.PostedAtIn) > 0 {
-		predicates = append(predicates, profilepostitem.PostedAtIn(i.PostedAtIn...))
+	if i.CityLTE != nil {
+		predicates = append(predicates, profilesnapshot.CityLTE(*i.CityLTE))
 	}
-	if len(i.PostedAtNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.PostedAtNotIn(i.PostedAtNotIn...))
+	if i.CityContains != nil {
+		predicates = append(predicates, profilesnapshot.CityContains(*i.CityContains))
 	}
-	if i.PostedAtGT != nil {
-		predicates = append(predicates, profilepostitem.PostedAtGT(*i.PostedAtGT))
+	if i.CityHasPrefix != nil {
+		predicates = append(predicates, profilesnapshot.CityHasPrefix(*i.CityHasPrefix))
 	}
-	if i.PostedAtGTE != nil {
-		predicates = append(predicates, profilepostitem.PostedAtGTE(*i.PostedAtGTE))
+	if i.CityHasSuffix != nil {
+		predicates = append(predicates, profilesnapshot.CityHasSuffix(*i.CityHasSuffix))
 	}
-	if i.PostedAtLT != nil {
-		predicates = append(predicates, profilepostitem.PostedAtLT(*i.PostedAtLT))
+	if i.CityIsNil {
+		predicates = append(predicates, profilesnapshot.CityIsNil())
 	}
-	if i.PostedAtLTE != nil {
-		predicates = append(predicates, profilepostitem.PostedAtLTE(*i.PostedAtLTE))
+	if i.CityNotNil {
+		predicates = append(predicates, profilesnapshot.CityNotNil())
 	}
-	if i.PostedAtIsNil {
-		predicates = append(predicates, profilepostitem.PostedAtIsNil())
+	if i.CityEqualFold != nil {
+		predicates = append(predicates, profilesnapshot.CityEqualFold(*i.CityEqualFold))
 	}
-	if i.PostedAtNotNil {
-		predicates = append(predicates, profilepostitem.PostedAtNotNil())
+	if i.CityContainsFold != nil {
+		predicates = append(predicates, profilesnapshot.CityContainsFold(*i.CityContainsFold))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, profilepostitem.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, profilesnapshot.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, profilepostitem.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, profilesnapshot.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, profilepostitem.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, profilesnapshot.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, profilepostitem.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, profilesnapshot.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, profilepostitem.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, profilesnapshot.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, profilepostitem.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, profilesnapshot.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, profilepostitem.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, profilesnapshot.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, profilepostitem.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, profilesnapshot.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 
-	if i.HasProfilePost != nil {
-		p := profilepostitem.HasProfilePost()
-		if !*i.HasProfilePost {
-			p = profilepostitem.Not(p)
+	if i.HasProfile != nil {
+		p := profilesnapshot.HasProfile()
+		if !*i.HasProfile {
+			p = profilesnapshot.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasProfilePostWith) > 0 {
-		with := make([]predicate.ProfilePost, 0, len(i.HasProfilePostWith))
-		for _, w := range i.HasProfilePostWith {
+	if len(i.HasProfileWith) > 0 {
+		with := make([]predicate.Profile, 0, len(i.HasProfileWith))
+		for _, w := range i.HasProfileWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProfilePostWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasProfileWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, profilepostitem.HasProfilePostWith(with...))
+		predicates = append(predicates, profilesnapshot.HasProfileWith(with...))
+	}
+	if i.HasChangeEvents != nil {
+		p := profilesnapshot.HasChangeEvents()
+		if !*i.HasChangeEvents {
+			p = profilesnapshot.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasChangeEventsWith) > 0 {
+		with := make([]predicate.ProfileChangeEvent, 0, len(i.HasChangeEventsWith))
+		for _, w := range i.HasChangeEventsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChangeEventsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, profilesnapshot.HasChangeEventsWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyProfilePostItemWhereInput
+		return nil, ErrEmptyProfileSnapshotWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return profilepostitem.And(predicates...), nil
+		return profilesnapshot.And(predicates...), nil
 	}
 }
 
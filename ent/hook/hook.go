@@ -8,6 +8,42 @@ import (
 	"sheng-go-backend/ent"
 )
 
+// The APICallLogFunc type is an adapter to allow the use of ordinary
+// function as APICallLog mutator.
+type APICallLogFunc func(context.Context, *ent.APICallLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APICallLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APICallLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APICallLogMutation", m)
+}
+
+// The APIQuotaBudgetFunc type is an adapter to allow the use of ordinary
+// function as APIQuotaBudget mutator.
+type APIQuotaBudgetFunc func(context.Context, *ent.APIQuotaBudgetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIQuotaBudgetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APIQuotaBudgetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIQuotaBudgetMutation", m)
+}
+
+// The APIQuotaReservationFunc type is an adapter to allow the use of ordinary
+// function as APIQuotaReservation mutator.
+type APIQuotaReservationFunc func(context.Context, *ent.APIQuotaReservationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIQuotaReservationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APIQuotaReservationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIQuotaReservationMutation", m)
+}
+
 // The APIQuotaTrackerFunc type is an adapter to allow the use of ordinary
 // function as APIQuotaTracker mutator.
 type APIQuotaTrackerFunc func(context.Context, *ent.APIQuotaTrackerMutation) (ent.Value, error)
@@ -20,6 +56,18 @@ func (f APIQuotaTrackerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIQuotaTrackerMutation", m)
 }
 
+// The CompanyFunc type is an adapter to allow the use of ordinary
+// function as Company mutator.
+type CompanyFunc func(context.Context, *ent.CompanyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CompanyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CompanyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CompanyMutation", m)
+}
+
 // The CronJobConfigFunc type is an adapter to allow the use of ordinary
 // function as CronJobConfig mutator.
 type CronJobConfigFunc func(context.Context, *ent.CronJobConfigMutation) (ent.Value, error)
@@ -56,6 +104,30 @@ func (f ProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfileMutation", m)
 }
 
+// The ProfileChangeEventFunc type is an adapter to allow the use of ordinary
+// function as ProfileChangeEvent mutator.
+type ProfileChangeEventFunc func(context.Context, *ent.ProfileChangeEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProfileChangeEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProfileChangeEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfileChangeEventMutation", m)
+}
+
+// The ProfileEducationFunc type is an adapter to allow the use of ordinary
+// function as ProfileEducation mutator.
+type ProfileEducationFunc func(context.Context, *ent.ProfileEducationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProfileEducationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProfileEducationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfileEducationMutation", m)
+}
+
 // The ProfileEntryFunc type is an adapter to allow the use of ordinary
 // function as ProfileEntry mutator.
 type ProfileEntryFunc func(context.Context, *ent.ProfileEntryMutation) (ent.Value, error)
@@ -68,6 +140,18 @@ func (f ProfileEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfileEntryMutation", m)
 }
 
+// The ProfilePositionFunc type is an adapter to allow the use of ordinary
+// function as ProfilePosition mutator.
+type ProfilePositionFunc func(context.Context, *ent.ProfilePositionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProfilePositionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProfilePositionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfilePositionMutation", m)
+}
+
 // The ProfilePostFunc type is an adapter to allow the use of ordinary
 // function as ProfilePost mutator.
 type ProfilePostFunc func(context.Context, *ent.ProfilePostMutation) (ent.Value, error)
@@ -92,6 +176,30 @@ func (f ProfilePostItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfilePostItemMutation", m)
 }
 
+// The ProfileSkillFunc type is an adapter to allow the use of ordinary
+// function as ProfileSkill mutator.
+type ProfileSkillFunc func(context.Context, *ent.ProfileSkillMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProfileSkillFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProfileSkillMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfileSkillMutation", m)
+}
+
+// The ProfileSnapshotFunc type is an adapter to allow the use of ordinary
+// function as ProfileSnapshot mutator.
+type ProfileSnapshotFunc func(context.Context, *ent.ProfileSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProfileSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProfileSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfileSnapshotMutation", m)
+}
+
 // The TodoFunc type is an adapter to allow the use of ordinary
 // function as Todo mutator.
 type TodoFunc func(context.Context, *ent.TodoMutation) (ent.Value, error)
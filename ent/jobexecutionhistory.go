@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/schema/ulid"
@@ -44,6 +45,10 @@ type JobExecutionHistory struct {
 	QuotaRemaining int `json:"quota_remaining,omitempty"`
 	// Summary of errors encountered
 	ErrorSummary *string `json:"error_summary,omitempty"`
+	// Whether provider payloads drifted from the expected field inventory
+	SchemaDriftDetected bool `json:"schema_drift_detected,omitempty"`
+	// Payloads checked and unknown/missing field counts
+	SchemaDrift map[string]interface{} `json:"schema_drift,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the JobExecutionHistoryQuery when eager-loading is set.
 	Edges        JobExecutionHistoryEdges `json:"edges"`
@@ -54,13 +59,16 @@ type JobExecutionHistory struct {
 type JobExecutionHistoryEdges struct {
 	// Profile entries processed in this job execution
 	ProfileEntries []*ProfileEntry `json:"profile_entries,omitempty"`
+	// RapidAPI requests made in this job execution
+	APICalls []*APICallLog `json:"api_calls,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 	// totalCount holds the count of the edges above.
-	totalCount [1]map[string]int
+	totalCount [2]map[string]int
 
 	namedProfileEntries map[string][]*ProfileEntry
+	namedAPICalls       map[string][]*APICallLog
 }
 
 // ProfileEntriesOrErr returns the ProfileEntries value or an error if the edge
@@ -72,11 +80,24 @@ func (e JobExecutionHistoryEdges) ProfileEntriesOrErr() ([]*ProfileEntry, error)
 	return nil, &NotLoadedError{edge: "profile_entries"}
 }
 
+// APICallsOrErr returns the APICalls value or an error if the edge
+// was not loaded in eager-loading.
+func (e JobExecutionHistoryEdges) APICallsOrErr() ([]*APICallLog, error) {
+	if e.loadedTypes[1] {
+		return e.APICalls, nil
+	}
+	return nil, &NotLoadedError{edge: "api_calls"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*JobExecutionHistory) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case jobexecutionhistory.FieldSchemaDrift:
+			values[i] = new([]byte)
+		case jobexecutionhistory.FieldSchemaDriftDetected:
+			values[i] = new(sql.NullBool)
 		case jobexecutionhistory.FieldDurationSeconds, jobexecutionhistory.FieldTotalProcessed, jobexecutionhistory.FieldSuccessfulCount, jobexecutionhistory.FieldFailedCount, jobexecutionhistory.FieldAPICallsMade, jobexecutionhistory.FieldQuotaRemaining:
 			values[i] = new(sql.NullInt64)
 		case jobexecutionhistory.FieldJobName, jobexecutionhistory.FieldStatus, jobexecutionhistory.FieldErrorSummary:
@@ -186,6 +207,20 @@ func (jeh *JobExecutionHistory) assignValues(columns []string, values []any) err
 				jeh.ErrorSummary = new(string)
 				*jeh.ErrorSummary = value.String
 			}
+		case jobexecutionhistory.FieldSchemaDriftDetected:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field schema_drift_detected", values[i])
+			} else if value.Valid {
+				jeh.SchemaDriftDetected = value.Bool
+			}
+		case jobexecutionhistory.FieldSchemaDrift:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field schema_drift", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &jeh.SchemaDrift); err != nil {
+					return fmt.Errorf("unmarshal field schema_drift: %w", err)
+				}
+			}
 		default:
 			jeh.selectValues.Set(columns[i], values[i])
 		}
@@ -204,6 +239,11 @@ func (jeh *JobExecutionHistory) QueryProfileEntries() *ProfileEntryQuery {
 	return NewJobExecutionHistoryClient(jeh.config).QueryProfileEntries(jeh)
 }
 
+// QueryAPICalls queries the "api_calls" edge of the JobExecutionHistory entity.
+func (jeh *JobExecutionHistory) QueryAPICalls() *APICallLogQuery {
+	return NewJobExecutionHistoryClient(jeh.config).QueryAPICalls(jeh)
+}
+
 // Update returns a builder for updating this JobExecutionHistory.
 // Note that you need to call JobExecutionHistory.Unwrap() before calling this method if this JobExecutionHistory
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -269,6 +309,12 @@ func (jeh *JobExecutionHistory) String() string {
 		builder.WriteString("error_summary=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	builder.WriteString("schema_drift_detected=")
+	builder.WriteString(fmt.Sprintf("%v", jeh.SchemaDriftDetected))
+	builder.WriteString(", ")
+	builder.WriteString("schema_drift=")
+	builder.WriteString(fmt.Sprintf("%v", jeh.SchemaDrift))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -297,5 +343,29 @@ func (jeh *JobExecutionHistory) appendNamedProfileEntries(name string, edges ...
 	}
 }
 
+// NamedAPICalls returns the APICalls named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (jeh *JobExecutionHistory) NamedAPICalls(name string) ([]*APICallLog, error) {
+	if jeh.Edges.namedAPICalls == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := jeh.Edges.namedAPICalls[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (jeh *JobExecutionHistory) appendNamedAPICalls(name string, edges ...*APICallLog) {
+	if jeh.Edges.namedAPICalls == nil {
+		jeh.Edges.namedAPICalls = make(map[string][]*APICallLog)
+	}
+	if len(edges) == 0 {
+		jeh.Edges.namedAPICalls[name] = []*APICallLog{}
+	} else {
+		jeh.Edges.namedAPICalls[name] = append(jeh.Edges.namedAPICalls[name], edges...)
+	}
+}
+
 // JobExecutionHistories is a parsable slice of JobExecutionHistory.
 type JobExecutionHistories []*JobExecutionHistory
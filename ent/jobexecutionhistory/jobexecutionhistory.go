@@ -44,8 +44,14 @@ const (
 	FieldQuotaRemaining = "quota_remaining"
 	// FieldErrorSummary holds the string denoting the error_summary field in the database.
 	FieldErrorSummary = "error_summary"
+	// FieldSchemaDriftDetected holds the string denoting the schema_drift_detected field in the database.
+	FieldSchemaDriftDetected = "schema_drift_detected"
+	// FieldSchemaDrift holds the string denoting the schema_drift field in the database.
+	FieldSchemaDrift = "schema_drift"
 	// EdgeProfileEntries holds the string denoting the profile_entries edge name in mutations.
 	EdgeProfileEntries = "profile_entries"
+	// EdgeAPICalls holds the string denoting the api_calls edge name in mutations.
+	EdgeAPICalls = "api_calls"
 	// Table holds the table name of the jobexecutionhistory in the database.
 	Table = "job_execution_histories"
 	// ProfileEntriesTable is the table that holds the profile_entries relation/edge. The primary key declared below.
@@ -53,6 +59,13 @@ const (
 	// ProfileEntriesInverseTable is the table name for the ProfileEntry entity.
 	// It exists in this package in order to avoid circular dependency with the "profileentry" package.
 	ProfileEntriesInverseTable = "profile_entries"
+	// APICallsTable is the table that holds the api_calls relation/edge.
+	APICallsTable = "api_call_logs"
+	// APICallsInverseTable is the table name for the APICallLog entity.
+	// It exists in this package in order to avoid circular dependency with the "apicalllog" package.
+	APICallsInverseTable = "api_call_logs"
+	// APICallsColumn is the table column denoting the api_calls relation/edge.
+	APICallsColumn = "job_execution_history_api_calls"
 )
 
 // Columns holds all SQL columns for jobexecutionhistory fields.
@@ -71,6 +84,8 @@ var Columns = []string{
 	FieldAPICallsMade,
 	FieldQuotaRemaining,
 	FieldErrorSummary,
+	FieldSchemaDriftDetected,
+	FieldSchemaDrift,
 }
 
 var (
@@ -122,6 +137,8 @@ var (
 	DefaultQuotaRemaining int
 	// QuotaRemainingValidator is a validator for the "quota_remaining" field. It is called by the builders before save.
 	QuotaRemainingValidator func(int) error
+	// DefaultSchemaDriftDetected holds the default value on creation for the "schema_drift_detected" field.
+	DefaultSchemaDriftDetected bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() ulid.ID
 )
@@ -224,6 +241,11 @@ func ByErrorSummary(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorSummary, opts...).ToFunc()
 }
 
+// BySchemaDriftDetected orders the results by the schema_drift_detected field.
+func BySchemaDriftDetected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchemaDriftDetected, opts...).ToFunc()
+}
+
 // ByProfileEntriesCount orders the results by profile_entries count.
 func ByProfileEntriesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -237,6 +259,20 @@ func ByProfileEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newProfileEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAPICallsCount orders the results by api_calls count.
+func ByAPICallsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPICallsStep(), opts...)
+	}
+}
+
+// ByAPICalls orders the results by api_calls terms.
+func ByAPICalls(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPICallsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProfileEntriesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -244,6 +280,13 @@ func newProfileEntriesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, ProfileEntriesTable, ProfileEntriesPrimaryKey...),
 	)
 }
+func newAPICallsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(APICallsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APICallsTable, APICallsColumn),
+	)
+}
 
 // MarshalGQL implements graphql.Marshaler interface.
 func (e Status) MarshalGQL(w io.Writer) {
@@ -116,6 +116,11 @@ func ErrorSummary(v string) predicate.JobExecutionHistory {
 	return predicate.JobExecutionHistory(sql.FieldEQ(FieldErrorSummary, v))
 }
 
+// SchemaDriftDetected applies equality check predicate on the "schema_drift_detected" field. It's identical to SchemaDriftDetectedEQ.
+func SchemaDriftDetected(v bool) predicate.JobExecutionHistory {
+	return predicate.JobExecutionHistory(sql.FieldEQ(FieldSchemaDriftDetected, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.JobExecutionHistory {
 	return predicate.JobExecutionHistory(sql.FieldEQ(FieldCreatedAt, v))
@@ -686,6 +691,26 @@ func ErrorSummaryContainsFold(v string) predicate.JobExecutionHistory {
 	return predicate.JobExecutionHistory(sql.FieldContainsFold(FieldErrorSummary, v))
 }
 
+// SchemaDriftDetectedEQ applies the EQ predicate on the "schema_drift_detected" field.
+func SchemaDriftDetectedEQ(v bool) predicate.JobExecutionHistory {
+	return predicate.JobExecutionHistory(sql.FieldEQ(FieldSchemaDriftDetected, v))
+}
+
+// SchemaDriftDetectedNEQ applies the NEQ predicate on the "schema_drift_detected" field.
+func SchemaDriftDetectedNEQ(v bool) predicate.JobExecutionHistory {
+	return predicate.JobExecutionHistory(sql.FieldNEQ(FieldSchemaDriftDetected, v))
+}
+
+// SchemaDriftIsNil applies the IsNil predicate on the "schema_drift" field.
+func SchemaDriftIsNil() predicate.JobExecutionHistory {
+	return predicate.JobExecutionHistory(sql.FieldIsNull(FieldSchemaDrift))
+}
+
+// SchemaDriftNotNil applies the NotNil predicate on the "schema_drift" field.
+func SchemaDriftNotNil() predicate.JobExecutionHistory {
+	return predicate.JobExecutionHistory(sql.FieldNotNull(FieldSchemaDrift))
+}
+
 // HasProfileEntries applies the HasEdge predicate on the "profile_entries" edge.
 func HasProfileEntries() predicate.JobExecutionHistory {
 	return predicate.JobExecutionHistory(func(s *sql.Selector) {
@@ -709,6 +734,29 @@ func HasProfileEntriesWith(preds ...predicate.ProfileEntry) predicate.JobExecuti
 	})
 }
 
+// HasAPICalls applies the HasEdge predicate on the "api_calls" edge.
+func HasAPICalls() predicate.JobExecutionHistory {
+	return predicate.JobExecutionHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, APICallsTable, APICallsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAPICallsWith applies the HasEdge predicate on the "api_calls" edge with a given conditions (other predicates).
+func HasAPICallsWith(preds ...predicate.APICallLog) predicate.JobExecutionHistory {
+	return predicate.JobExecutionHistory(func(s *sql.Selector) {
+		step := newAPICallsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.JobExecutionHistory) predicate.JobExecutionHistory {
 	return predicate.JobExecutionHistory(sql.AndPredicates(predicates...))
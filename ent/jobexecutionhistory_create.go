@@ -6,11 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sheng-go-backend/ent/apicalllog"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/profileentry"
 	"sheng-go-backend/ent/schema/ulid"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +23,7 @@ type JobExecutionHistoryCreate struct {
 	config
 	mutation *JobExecutionHistoryMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -180,6 +184,26 @@ func (jehc *JobExecutionHistoryCreate) SetNillableErrorSummary(s *string) *JobEx
 	return jehc
 }
 
+// SetSchemaDriftDetected sets the "schema_drift_detected" field.
+func (jehc *JobExecutionHistoryCreate) SetSchemaDriftDetected(b bool) *JobExecutionHistoryCreate {
+	jehc.mutation.SetSchemaDriftDetected(b)
+	return jehc
+}
+
+// SetNillableSchemaDriftDetected sets the "schema_drift_detected" field if the given value is not nil.
+func (jehc *JobExecutionHistoryCreate) SetNillableSchemaDriftDetected(b *bool) *JobExecutionHistoryCreate {
+	if b != nil {
+		jehc.SetSchemaDriftDetected(*b)
+	}
+	return jehc
+}
+
+// SetSchemaDrift sets the "schema_drift" field.
+func (jehc *JobExecutionHistoryCreate) SetSchemaDrift(m map[string]interface{}) *JobExecutionHistoryCreate {
+	jehc.mutation.SetSchemaDrift(m)
+	return jehc
+}
+
 // SetID sets the "id" field.
 func (jehc *JobExecutionHistoryCreate) SetID(u ulid.ID) *JobExecutionHistoryCreate {
 	jehc.mutation.SetID(u)
@@ -209,6 +233,21 @@ func (jehc *JobExecutionHistoryCreate) AddProfileEntries(p ...*ProfileEntry) *Jo
 	return jehc.AddProfileEntryIDs(ids...)
 }
 
+// AddAPICallIDs adds the "api_calls" edge to the APICallLog entity by IDs.
+func (jehc *JobExecutionHistoryCreate) AddAPICallIDs(ids ...ulid.ID) *JobExecutionHistoryCreate {
+	jehc.mutation.AddAPICallIDs(ids...)
+	return jehc
+}
+
+// AddAPICalls adds the "api_calls" edges to the APICallLog entity.
+func (jehc *JobExecutionHistoryCreate) AddAPICalls(a ...*APICallLog) *JobExecutionHistoryCreate {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return jehc.AddAPICallIDs(ids...)
+}
+
 // Mutation returns the JobExecutionHistoryMutation object of the builder.
 func (jehc *JobExecutionHistoryCreate) Mutation() *JobExecutionHistoryMutation {
 	return jehc.mutation
@@ -276,6 +315,10 @@ func (jehc *JobExecutionHistoryCreate) defaults() {
 		v := jobexecutionhistory.DefaultQuotaRemaining
 		jehc.mutation.SetQuotaRemaining(v)
 	}
+	if _, ok := jehc.mutation.SchemaDriftDetected(); !ok {
+		v := jobexecutionhistory.DefaultSchemaDriftDetected
+		jehc.mutation.SetSchemaDriftDetected(v)
+	}
 	if _, ok := jehc.mutation.ID(); !ok {
 		v := jobexecutionhistory.DefaultID()
 		jehc.mutation.SetID(v)
@@ -357,6 +400,9 @@ func (jehc *JobExecutionHistoryCreate) check() error {
 			return &ValidationError{Name: "quota_remaining", err: fmt.Errorf(`ent: validator failed for field "JobExecutionHistory.quota_remaining": %w`, err)}
 		}
 	}
+	if _, ok := jehc.mutation.SchemaDriftDetected(); !ok {
+		return &ValidationError{Name: "schema_drift_detected", err: errors.New(`ent: missing required field "JobExecutionHistory.schema_drift_detected"`)}
+	}
 	return nil
 }
 
@@ -388,6 +434,7 @@ func (jehc *JobExecutionHistoryCreate) createSpec() (*JobExecutionHistory, *sqlg
 		_node = &JobExecutionHistory{config: jehc.config}
 		_spec = sqlgraph.NewCreateSpec(jobexecutionhistory.Table, sqlgraph.NewFieldSpec(jobexecutionhistory.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = jehc.conflict
 	if id, ok := jehc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -444,6 +491,14 @@ func (jehc *JobExecutionHistoryCreate) createSpec() (*JobExecutionHistory, *sqlg
 		_spec.SetField(jobexecutionhistory.FieldErrorSummary, field.TypeString, value)
 		_node.ErrorSummary = &value
 	}
+	if value, ok := jehc.mutation.SchemaDriftDetected(); ok {
+		_spec.SetField(jobexecutionhistory.FieldSchemaDriftDetected, field.TypeBool, value)
+		_node.SchemaDriftDetected = value
+	}
+	if value, ok := jehc.mutation.SchemaDrift(); ok {
+		_spec.SetField(jobexecutionhistory.FieldSchemaDrift, field.TypeJSON, value)
+		_node.SchemaDrift = value
+	}
 	if nodes := jehc.mutation.ProfileEntriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -460,14 +515,650 @@ func (jehc *JobExecutionHistoryCreate) createSpec() (*JobExecutionHistory, *sqlg
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := jehc.mutation.APICallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobexecutionhistory.APICallsTable,
+			Columns: []string{jobexecutionhistory.APICallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobExecutionHistory.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobExecutionHistoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (jehc *JobExecutionHistoryCreate) OnConflict(opts ...sql.ConflictOption) *JobExecutionHistoryUpsertOne {
+	jehc.conflict = opts
+	return &JobExecutionHistoryUpsertOne{
+		create: jehc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobExecutionHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jehc *JobExecutionHistoryCreate) OnConflictColumns(columns ...string) *JobExecutionHistoryUpsertOne {
+	jehc.conflict = append(jehc.conflict, sql.ConflictColumns(columns...))
+	return &JobExecutionHistoryUpsertOne{
+		create: jehc,
+	}
+}
+
+type (
+	// JobExecutionHistoryUpsertOne is the builder for "upsert"-ing
+	//  one JobExecutionHistory node.
+	JobExecutionHistoryUpsertOne struct {
+		create *JobExecutionHistoryCreate
+	}
+
+	// JobExecutionHistoryUpsert is the "OnConflict" setter.
+	JobExecutionHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobExecutionHistoryUpsert) SetUpdatedAt(v time.Time) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateUpdatedAt() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldUpdatedAt)
+	return u
+}
+
+// SetJobName sets the "job_name" field.
+func (u *JobExecutionHistoryUpsert) SetJobName(v string) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldJobName, v)
+	return u
+}
+
+// UpdateJobName sets the "job_name" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateJobName() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldJobName)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *JobExecutionHistoryUpsert) SetStatus(v jobexecutionhistory.Status) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateStatus() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldStatus)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobExecutionHistoryUpsert) SetStartedAt(v time.Time) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateStartedAt() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldStartedAt)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *JobExecutionHistoryUpsert) SetCompletedAt(v time.Time) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateCompletedAt() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *JobExecutionHistoryUpsert) ClearCompletedAt() *JobExecutionHistoryUpsert {
+	u.SetNull(jobexecutionhistory.FieldCompletedAt)
+	return u
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (u *JobExecutionHistoryUpsert) SetDurationSeconds(v int) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldDurationSeconds, v)
+	return u
+}
+
+// UpdateDurationSeconds sets the "duration_seconds" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateDurationSeconds() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldDurationSeconds)
+	return u
+}
+
+// AddDurationSeconds adds v to the "duration_seconds" field.
+func (u *JobExecutionHistoryUpsert) AddDurationSeconds(v int) *JobExecutionHistoryUpsert {
+	u.Add(jobexecutionhistory.FieldDurationSeconds, v)
+	return u
+}
+
+// SetTotalProcessed sets the "total_processed" field.
+func (u *JobExecutionHistoryUpsert) SetTotalProcessed(v int) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldTotalProcessed, v)
+	return u
+}
+
+// UpdateTotalProcessed sets the "total_processed" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateTotalProcessed() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldTotalProcessed)
+	return u
+}
+
+// AddTotalProcessed adds v to the "total_processed" field.
+func (u *JobExecutionHistoryUpsert) AddTotalProcessed(v int) *JobExecutionHistoryUpsert {
+	u.Add(jobexecutionhistory.FieldTotalProcessed, v)
+	return u
+}
+
+// SetSuccessfulCount sets the "successful_count" field.
+func (u *JobExecutionHistoryUpsert) SetSuccessfulCount(v int) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldSuccessfulCount, v)
+	return u
+}
+
+// UpdateSuccessfulCount sets the "successful_count" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateSuccessfulCount() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldSuccessfulCount)
+	return u
+}
+
+// AddSuccessfulCount adds v to the "successful_count" field.
+func (u *JobExecutionHistoryUpsert) AddSuccessfulCount(v int) *JobExecutionHistoryUpsert {
+	u.Add(jobexecutionhistory.FieldSuccessfulCount, v)
+	return u
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (u *JobExecutionHistoryUpsert) SetFailedCount(v int) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldFailedCount, v)
+	return u
+}
+
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateFailedCount() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldFailedCount)
+	return u
+}
+
+// AddFailedCount adds v to the "failed_count" field.
+func (u *JobExecutionHistoryUpsert) AddFailedCount(v int) *JobExecutionHistoryUpsert {
+	u.Add(jobexecutionhistory.FieldFailedCount, v)
+	return u
+}
+
+// SetAPICallsMade sets the "api_calls_made" field.
+func (u *JobExecutionHistoryUpsert) SetAPICallsMade(v int) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldAPICallsMade, v)
+	return u
+}
+
+// UpdateAPICallsMade sets the "api_calls_made" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateAPICallsMade() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldAPICallsMade)
+	return u
+}
+
+// AddAPICallsMade adds v to the "api_calls_made" field.
+func (u *JobExecutionHistoryUpsert) AddAPICallsMade(v int) *JobExecutionHistoryUpsert {
+	u.Add(jobexecutionhistory.FieldAPICallsMade, v)
+	return u
+}
+
+// SetQuotaRemaining sets the "quota_remaining" field.
+func (u *JobExecutionHistoryUpsert) SetQuotaRemaining(v int) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldQuotaRemaining, v)
+	return u
+}
+
+// UpdateQuotaRemaining sets the "quota_remaining" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateQuotaRemaining() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldQuotaRemaining)
+	return u
+}
+
+// AddQuotaRemaining adds v to the "quota_remaining" field.
+func (u *JobExecutionHistoryUpsert) AddQuotaRemaining(v int) *JobExecutionHistoryUpsert {
+	u.Add(jobexecutionhistory.FieldQuotaRemaining, v)
+	return u
+}
+
+// SetErrorSummary sets the "error_summary" field.
+func (u *JobExecutionHistoryUpsert) SetErrorSummary(v string) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldErrorSummary, v)
+	return u
+}
+
+// UpdateErrorSummary sets the "error_summary" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateErrorSummary() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldErrorSummary)
+	return u
+}
+
+// ClearErrorSummary clears the value of the "error_summary" field.
+func (u *JobExecutionHistoryUpsert) ClearErrorSummary() *JobExecutionHistoryUpsert {
+	u.SetNull(jobexecutionhistory.FieldErrorSummary)
+	return u
+}
+
+// SetSchemaDriftDetected sets the "schema_drift_detected" field.
+func (u *JobExecutionHistoryUpsert) SetSchemaDriftDetected(v bool) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldSchemaDriftDetected, v)
+	return u
+}
+
+// UpdateSchemaDriftDetected sets the "schema_drift_detected" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateSchemaDriftDetected() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldSchemaDriftDetected)
+	return u
+}
+
+// SetSchemaDrift sets the "schema_drift" field.
+func (u *JobExecutionHistoryUpsert) SetSchemaDrift(v map[string]interface{}) *JobExecutionHistoryUpsert {
+	u.Set(jobexecutionhistory.FieldSchemaDrift, v)
+	return u
+}
+
+// UpdateSchemaDrift sets the "schema_drift" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsert) UpdateSchemaDrift() *JobExecutionHistoryUpsert {
+	u.SetExcluded(jobexecutionhistory.FieldSchemaDrift)
+	return u
+}
+
+// ClearSchemaDrift clears the value of the "schema_drift" field.
+func (u *JobExecutionHistoryUpsert) ClearSchemaDrift() *JobExecutionHistoryUpsert {
+	u.SetNull(jobexecutionhistory.FieldSchemaDrift)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JobExecutionHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobexecutionhistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobExecutionHistoryUpsertOne) UpdateNewValues() *JobExecutionHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(jobexecutionhistory.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(jobexecutionhistory.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobExecutionHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobExecutionHistoryUpsertOne) Ignore() *JobExecutionHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobExecutionHistoryUpsertOne) DoNothing() *JobExecutionHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobExecutionHistoryCreate.OnConflict
+// documentation for more info.
+func (u *JobExecutionHistoryUpsertOne) Update(set func(*JobExecutionHistoryUpsert)) *JobExecutionHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobExecutionHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobExecutionHistoryUpsertOne) SetUpdatedAt(v time.Time) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateUpdatedAt() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetJobName sets the "job_name" field.
+func (u *JobExecutionHistoryUpsertOne) SetJobName(v string) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetJobName(v)
+	})
+}
+
+// UpdateJobName sets the "job_name" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateJobName() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateJobName()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobExecutionHistoryUpsertOne) SetStatus(v jobexecutionhistory.Status) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateStatus() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobExecutionHistoryUpsertOne) SetStartedAt(v time.Time) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateStartedAt() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *JobExecutionHistoryUpsertOne) SetCompletedAt(v time.Time) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateCompletedAt() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *JobExecutionHistoryUpsertOne) ClearCompletedAt() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (u *JobExecutionHistoryUpsertOne) SetDurationSeconds(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetDurationSeconds(v)
+	})
+}
+
+// AddDurationSeconds adds v to the "duration_seconds" field.
+func (u *JobExecutionHistoryUpsertOne) AddDurationSeconds(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddDurationSeconds(v)
+	})
+}
+
+// UpdateDurationSeconds sets the "duration_seconds" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateDurationSeconds() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateDurationSeconds()
+	})
+}
+
+// SetTotalProcessed sets the "total_processed" field.
+func (u *JobExecutionHistoryUpsertOne) SetTotalProcessed(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetTotalProcessed(v)
+	})
+}
+
+// AddTotalProcessed adds v to the "total_processed" field.
+func (u *JobExecutionHistoryUpsertOne) AddTotalProcessed(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddTotalProcessed(v)
+	})
+}
+
+// UpdateTotalProcessed sets the "total_processed" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateTotalProcessed() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateTotalProcessed()
+	})
+}
+
+// SetSuccessfulCount sets the "successful_count" field.
+func (u *JobExecutionHistoryUpsertOne) SetSuccessfulCount(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetSuccessfulCount(v)
+	})
+}
+
+// AddSuccessfulCount adds v to the "successful_count" field.
+func (u *JobExecutionHistoryUpsertOne) AddSuccessfulCount(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddSuccessfulCount(v)
+	})
+}
+
+// UpdateSuccessfulCount sets the "successful_count" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateSuccessfulCount() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateSuccessfulCount()
+	})
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (u *JobExecutionHistoryUpsertOne) SetFailedCount(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetFailedCount(v)
+	})
+}
+
+// AddFailedCount adds v to the "failed_count" field.
+func (u *JobExecutionHistoryUpsertOne) AddFailedCount(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddFailedCount(v)
+	})
+}
+
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateFailedCount() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateFailedCount()
+	})
+}
+
+// SetAPICallsMade sets the "api_calls_made" field.
+func (u *JobExecutionHistoryUpsertOne) SetAPICallsMade(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetAPICallsMade(v)
+	})
+}
+
+// AddAPICallsMade adds v to the "api_calls_made" field.
+func (u *JobExecutionHistoryUpsertOne) AddAPICallsMade(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddAPICallsMade(v)
+	})
+}
+
+// UpdateAPICallsMade sets the "api_calls_made" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateAPICallsMade() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateAPICallsMade()
+	})
+}
+
+// SetQuotaRemaining sets the "quota_remaining" field.
+func (u *JobExecutionHistoryUpsertOne) SetQuotaRemaining(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetQuotaRemaining(v)
+	})
+}
+
+// AddQuotaRemaining adds v to the "quota_remaining" field.
+func (u *JobExecutionHistoryUpsertOne) AddQuotaRemaining(v int) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddQuotaRemaining(v)
+	})
+}
+
+// UpdateQuotaRemaining sets the "quota_remaining" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateQuotaRemaining() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateQuotaRemaining()
+	})
+}
+
+// SetErrorSummary sets the "error_summary" field.
+func (u *JobExecutionHistoryUpsertOne) SetErrorSummary(v string) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetErrorSummary(v)
+	})
+}
+
+// UpdateErrorSummary sets the "error_summary" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateErrorSummary() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateErrorSummary()
+	})
+}
+
+// ClearErrorSummary clears the value of the "error_summary" field.
+func (u *JobExecutionHistoryUpsertOne) ClearErrorSummary() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.ClearErrorSummary()
+	})
+}
+
+// SetSchemaDriftDetected sets the "schema_drift_detected" field.
+func (u *JobExecutionHistoryUpsertOne) SetSchemaDriftDetected(v bool) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetSchemaDriftDetected(v)
+	})
+}
+
+// UpdateSchemaDriftDetected sets the "schema_drift_detected" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateSchemaDriftDetected() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateSchemaDriftDetected()
+	})
+}
+
+// SetSchemaDrift sets the "schema_drift" field.
+func (u *JobExecutionHistoryUpsertOne) SetSchemaDrift(v map[string]interface{}) *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetSchemaDrift(v)
+	})
+}
+
+// UpdateSchemaDrift sets the "schema_drift" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertOne) UpdateSchemaDrift() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateSchemaDrift()
+	})
+}
+
+// ClearSchemaDrift clears the value of the "schema_drift" field.
+func (u *JobExecutionHistoryUpsertOne) ClearSchemaDrift() *JobExecutionHistoryUpsertOne {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.ClearSchemaDrift()
+	})
+}
+
+// Exec executes the query.
+func (u *JobExecutionHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobExecutionHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobExecutionHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobExecutionHistoryUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: JobExecutionHistoryUpsertOne.ID is not supported by MySQL driver. Use JobExecutionHistoryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobExecutionHistoryUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // JobExecutionHistoryCreateBulk is the builder for creating many JobExecutionHistory entities in bulk.
 type JobExecutionHistoryCreateBulk struct {
 	config
 	err      error
 	builders []*JobExecutionHistoryCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the JobExecutionHistory entities in the database.
@@ -497,6 +1188,7 @@ func (jehcb *JobExecutionHistoryCreateBulk) Save(ctx context.Context) ([]*JobExe
 					_, err = mutators[i+1].Mutate(root, jehcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jehcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, jehcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -546,3 +1238,379 @@ func (jehcb *JobExecutionHistoryCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobExecutionHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobExecutionHistoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (jehcb *JobExecutionHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobExecutionHistoryUpsertBulk {
+	jehcb.conflict = opts
+	return &JobExecutionHistoryUpsertBulk{
+		create: jehcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobExecutionHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jehcb *JobExecutionHistoryCreateBulk) OnConflictColumns(columns ...string) *JobExecutionHistoryUpsertBulk {
+	jehcb.conflict = append(jehcb.conflict, sql.ConflictColumns(columns...))
+	return &JobExecutionHistoryUpsertBulk{
+		create: jehcb,
+	}
+}
+
+// JobExecutionHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of JobExecutionHistory nodes.
+type JobExecutionHistoryUpsertBulk struct {
+	create *JobExecutionHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JobExecutionHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobexecutionhistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobExecutionHistoryUpsertBulk) UpdateNewValues() *JobExecutionHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(jobexecutionhistory.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(jobexecutionhistory.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobExecutionHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobExecutionHistoryUpsertBulk) Ignore() *JobExecutionHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobExecutionHistoryUpsertBulk) DoNothing() *JobExecutionHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobExecutionHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobExecutionHistoryUpsertBulk) Update(set func(*JobExecutionHistoryUpsert)) *JobExecutionHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobExecutionHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobExecutionHistoryUpsertBulk) SetUpdatedAt(v time.Time) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateUpdatedAt() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetJobName sets the "job_name" field.
+func (u *JobExecutionHistoryUpsertBulk) SetJobName(v string) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetJobName(v)
+	})
+}
+
+// UpdateJobName sets the "job_name" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateJobName() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateJobName()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobExecutionHistoryUpsertBulk) SetStatus(v jobexecutionhistory.Status) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateStatus() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobExecutionHistoryUpsertBulk) SetStartedAt(v time.Time) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateStartedAt() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *JobExecutionHistoryUpsertBulk) SetCompletedAt(v time.Time) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateCompletedAt() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *JobExecutionHistoryUpsertBulk) ClearCompletedAt() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (u *JobExecutionHistoryUpsertBulk) SetDurationSeconds(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetDurationSeconds(v)
+	})
+}
+
+// AddDurationSeconds adds v to the "duration_seconds" field.
+func (u *JobExecutionHistoryUpsertBulk) AddDurationSeconds(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddDurationSeconds(v)
+	})
+}
+
+// UpdateDurationSeconds sets the "duration_seconds" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateDurationSeconds() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateDurationSeconds()
+	})
+}
+
+// SetTotalProcessed sets the "total_processed" field.
+func (u *JobExecutionHistoryUpsertBulk) SetTotalProcessed(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetTotalProcessed(v)
+	})
+}
+
+// AddTotalProcessed adds v to the "total_processed" field.
+func (u *JobExecutionHistoryUpsertBulk) AddTotalProcessed(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddTotalProcessed(v)
+	})
+}
+
+// UpdateTotalProcessed sets the "total_processed" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateTotalProcessed() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateTotalProcessed()
+	})
+}
+
+// SetSuccessfulCount sets the "successful_count" field.
+func (u *JobExecutionHistoryUpsertBulk) SetSuccessfulCount(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetSuccessfulCount(v)
+	})
+}
+
+// AddSuccessfulCount adds v to the "successful_count" field.
+func (u *JobExecutionHistoryUpsertBulk) AddSuccessfulCount(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddSuccessfulCount(v)
+	})
+}
+
+// UpdateSuccessfulCount sets the "successful_count" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateSuccessfulCount() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateSuccessfulCount()
+	})
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (u *JobExecutionHistoryUpsertBulk) SetFailedCount(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetFailedCount(v)
+	})
+}
+
+// AddFailedCount adds v to the "failed_count" field.
+func (u *JobExecutionHistoryUpsertBulk) AddFailedCount(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddFailedCount(v)
+	})
+}
+
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateFailedCount() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateFailedCount()
+	})
+}
+
+// SetAPICallsMade sets the "api_calls_made" field.
+func (u *JobExecutionHistoryUpsertBulk) SetAPICallsMade(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetAPICallsMade(v)
+	})
+}
+
+// AddAPICallsMade adds v to the "api_calls_made" field.
+func (u *JobExecutionHistoryUpsertBulk) AddAPICallsMade(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddAPICallsMade(v)
+	})
+}
+
+// UpdateAPICallsMade sets the "api_calls_made" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateAPICallsMade() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateAPICallsMade()
+	})
+}
+
+// SetQuotaRemaining sets the "quota_remaining" field.
+func (u *JobExecutionHistoryUpsertBulk) SetQuotaRemaining(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetQuotaRemaining(v)
+	})
+}
+
+// AddQuotaRemaining adds v to the "quota_remaining" field.
+func (u *JobExecutionHistoryUpsertBulk) AddQuotaRemaining(v int) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.AddQuotaRemaining(v)
+	})
+}
+
+// UpdateQuotaRemaining sets the "quota_remaining" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateQuotaRemaining() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateQuotaRemaining()
+	})
+}
+
+// SetErrorSummary sets the "error_summary" field.
+func (u *JobExecutionHistoryUpsertBulk) SetErrorSummary(v string) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetErrorSummary(v)
+	})
+}
+
+// UpdateErrorSummary sets the "error_summary" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateErrorSummary() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateErrorSummary()
+	})
+}
+
+// ClearErrorSummary clears the value of the "error_summary" field.
+func (u *JobExecutionHistoryUpsertBulk) ClearErrorSummary() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.ClearErrorSummary()
+	})
+}
+
+// SetSchemaDriftDetected sets the "schema_drift_detected" field.
+func (u *JobExecutionHistoryUpsertBulk) SetSchemaDriftDetected(v bool) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetSchemaDriftDetected(v)
+	})
+}
+
+// UpdateSchemaDriftDetected sets the "schema_drift_detected" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateSchemaDriftDetected() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateSchemaDriftDetected()
+	})
+}
+
+// SetSchemaDrift sets the "schema_drift" field.
+func (u *JobExecutionHistoryUpsertBulk) SetSchemaDrift(v map[string]interface{}) *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.SetSchemaDrift(v)
+	})
+}
+
+// UpdateSchemaDrift sets the "schema_drift" field to the value that was provided on create.
+func (u *JobExecutionHistoryUpsertBulk) UpdateSchemaDrift() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.UpdateSchemaDrift()
+	})
+}
+
+// ClearSchemaDrift clears the value of the "schema_drift" field.
+func (u *JobExecutionHistoryUpsertBulk) ClearSchemaDrift() *JobExecutionHistoryUpsertBulk {
+	return u.Update(func(s *JobExecutionHistoryUpsert) {
+		s.ClearSchemaDrift()
+	})
+}
+
+// Exec executes the query.
+func (u *JobExecutionHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JobExecutionHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobExecutionHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobExecutionHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
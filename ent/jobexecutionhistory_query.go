@@ -7,12 +7,14 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"sheng-go-backend/ent/apicalllog"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/predicate"
 	"sheng-go-backend/ent/profileentry"
 	"sheng-go-backend/ent/schema/ulid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,9 +28,11 @@ type JobExecutionHistoryQuery struct {
 	inters                  []Interceptor
 	predicates              []predicate.JobExecutionHistory
 	withProfileEntries      *ProfileEntryQuery
-	modifiers               []func(*sql.Selector)
+	withAPICalls            *APICallLogQuery
 	loadTotal               []func(context.Context, []*JobExecutionHistory) error
+	modifiers               []func(*sql.Selector)
 	withNamedProfileEntries map[string]*ProfileEntryQuery
+	withNamedAPICalls       map[string]*APICallLogQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,6 +91,28 @@ func (jehq *JobExecutionHistoryQuery) QueryProfileEntries() *ProfileEntryQuery {
 	return query
 }
 
+// QueryAPICalls chains the current query on the "api_calls" edge.
+func (jehq *JobExecutionHistoryQuery) QueryAPICalls() *APICallLogQuery {
+	query := (&APICallLogClient{config: jehq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := jehq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := jehq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(jobexecutionhistory.Table, jobexecutionhistory.FieldID, selector),
+			sqlgraph.To(apicalllog.Table, apicalllog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, jobexecutionhistory.APICallsTable, jobexecutionhistory.APICallsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(jehq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first JobExecutionHistory entity from the query.
 // Returns a *NotFoundError when no JobExecutionHistory was found.
 func (jehq *JobExecutionHistoryQuery) First(ctx context.Context) (*JobExecutionHistory, error) {
@@ -280,6 +306,7 @@ func (jehq *JobExecutionHistoryQuery) Clone() *JobExecutionHistoryQuery {
 		inters:             append([]Interceptor{}, jehq.inters...),
 		predicates:         append([]predicate.JobExecutionHistory{}, jehq.predicates...),
 		withProfileEntries: jehq.withProfileEntries.Clone(),
+		withAPICalls:       jehq.withAPICalls.Clone(),
 		// clone intermediate query.
 		sql:  jehq.sql.Clone(),
 		path: jehq.path,
@@ -297,6 +324,17 @@ func (jehq *JobExecutionHistoryQuery) WithProfileEntries(opts ...func(*ProfileEn
 	return jehq
 }
 
+// WithAPICalls tells the query-builder to eager-load the nodes that are connected to
+// the "api_calls" edge. The optional arguments are used to configure the query builder of the edge.
+func (jehq *JobExecutionHistoryQuery) WithAPICalls(opts ...func(*APICallLogQuery)) *JobExecutionHistoryQuery {
+	query := (&APICallLogClient{config: jehq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	jehq.withAPICalls = query
+	return jehq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -375,8 +413,9 @@ func (jehq *JobExecutionHistoryQuery) sqlAll(ctx context.Context, hooks ...query
 	var (
 		nodes       = []*JobExecutionHistory{}
 		_spec       = jehq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			jehq.withProfileEntries != nil,
+			jehq.withAPICalls != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -409,6 +448,13 @@ func (jehq *JobExecutionHistoryQuery) sqlAll(ctx context.Context, hooks ...query
 			return nil, err
 		}
 	}
+	if query := jehq.withAPICalls; query != nil {
+		if err := jehq.loadAPICalls(ctx, query, nodes,
+			func(n *JobExecutionHistory) { n.Edges.APICalls = []*APICallLog{} },
+			func(n *JobExecutionHistory, e *APICallLog) { n.Edges.APICalls = append(n.Edges.APICalls, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range jehq.withNamedProfileEntries {
 		if err := jehq.loadProfileEntries(ctx, query, nodes,
 			func(n *JobExecutionHistory) { n.appendNamedProfileEntries(name) },
@@ -416,6 +462,13 @@ func (jehq *JobExecutionHistoryQuery) sqlAll(ctx context.Context, hooks ...query
 			return nil, err
 		}
 	}
+	for name, query := range jehq.withNamedAPICalls {
+		if err := jehq.loadAPICalls(ctx, query, nodes,
+			func(n *JobExecutionHistory) { n.appendNamedAPICalls(name) },
+			func(n *JobExecutionHistory, e *APICallLog) { n.appendNamedAPICalls(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range jehq.loadTotal {
 		if err := jehq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -485,6 +538,37 @@ func (jehq *JobExecutionHistoryQuery) loadProfileEntries(ctx context.Context, qu
 	}
 	return nil
 }
+func (jehq *JobExecutionHistoryQuery) loadAPICalls(ctx context.Context, query *APICallLogQuery, nodes []*JobExecutionHistory, init func(*JobExecutionHistory), assign func(*JobExecutionHistory, *APICallLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[ulid.ID]*JobExecutionHistory)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.APICallLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(jobexecutionhistory.APICallsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.job_execution_history_api_calls
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "job_execution_history_api_calls" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "job_execution_history_api_calls" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (jehq *JobExecutionHistoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := jehq.querySpec()
@@ -553,6 +637,9 @@ func (jehq *JobExecutionHistoryQuery) sqlQuery(ctx context.Context) *sql.Selecto
 	if jehq.ctx.Unique != nil && *jehq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range jehq.modifiers {
+		m(selector)
+	}
 	for _, p := range jehq.predicates {
 		p(selector)
 	}
@@ -570,6 +657,32 @@ func (jehq *JobExecutionHistoryQuery) sqlQuery(ctx context.Context) *sql.Selecto
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (jehq *JobExecutionHistoryQuery) ForUpdate(opts ...sql.LockOption) *JobExecutionHistoryQuery {
+	if jehq.driver.Dialect() == dialect.Postgres {
+		jehq.Unique(false)
+	}
+	jehq.modifiers = append(jehq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return jehq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (jehq *JobExecutionHistoryQuery) ForShare(opts ...sql.LockOption) *JobExecutionHistoryQuery {
+	if jehq.driver.Dialect() == dialect.Postgres {
+		jehq.Unique(false)
+	}
+	jehq.modifiers = append(jehq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return jehq
+}
+
 // WithNamedProfileEntries tells the query-builder to eager-load the nodes that are connected to the "profile_entries"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (jehq *JobExecutionHistoryQuery) WithNamedProfileEntries(name string, opts ...func(*ProfileEntryQuery)) *JobExecutionHistoryQuery {
@@ -584,6 +697,20 @@ func (jehq *JobExecutionHistoryQuery) WithNamedProfileEntries(name string, opts
 	return jehq
 }
 
+// WithNamedAPICalls tells the query-builder to eager-load the nodes that are connected to the "api_calls"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (jehq *JobExecutionHistoryQuery) WithNamedAPICalls(name string, opts ...func(*APICallLogQuery)) *JobExecutionHistoryQuery {
+	query := (&APICallLogClient{config: jehq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if jehq.withNamedAPICalls == nil {
+		jehq.withNamedAPICalls = make(map[string]*APICallLogQuery)
+	}
+	jehq.withNamedAPICalls[name] = query
+	return jehq
+}
+
 // JobExecutionHistoryGroupBy is the group-by builder for JobExecutionHistory entities.
 type JobExecutionHistoryGroupBy struct {
 	selector
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sheng-go-backend/ent/apicalllog"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/predicate"
 	"sheng-go-backend/ent/profileentry"
@@ -244,6 +245,32 @@ func (jehu *JobExecutionHistoryUpdate) ClearErrorSummary() *JobExecutionHistoryU
 	return jehu
 }
 
+// SetSchemaDriftDetected sets the "schema_drift_detected" field.
+func (jehu *JobExecutionHistoryUpdate) SetSchemaDriftDetected(b bool) *JobExecutionHistoryUpdate {
+	jehu.mutation.SetSchemaDriftDetected(b)
+	return jehu
+}
+
+// SetNillableSchemaDriftDetected sets the "schema_drift_detected" field if the given value is not nil.
+func (jehu *JobExecutionHistoryUpdate) SetNillableSchemaDriftDetected(b *bool) *JobExecutionHistoryUpdate {
+	if b != nil {
+		jehu.SetSchemaDriftDetected(*b)
+	}
+	return jehu
+}
+
+// SetSchemaDrift sets the "schema_drift" field.
+func (jehu *JobExecutionHistoryUpdate) SetSchemaDrift(m map[string]interface{}) *JobExecutionHistoryUpdate {
+	jehu.mutation.SetSchemaDrift(m)
+	return jehu
+}
+
+// ClearSchemaDrift clears the value of the "schema_drift" field.
+func (jehu *JobExecutionHistoryUpdate) ClearSchemaDrift() *JobExecutionHistoryUpdate {
+	jehu.mutation.ClearSchemaDrift()
+	return jehu
+}
+
 // AddProfileEntryIDs adds the "profile_entries" edge to the ProfileEntry entity by IDs.
 func (jehu *JobExecutionHistoryUpdate) AddProfileEntryIDs(ids ...ulid.ID) *JobExecutionHistoryUpdate {
 	jehu.mutation.AddProfileEntryIDs(ids...)
@@ -259,6 +286,21 @@ func (jehu *JobExecutionHistoryUpdate) AddProfileEntries(p ...*ProfileEntry) *Jo
 	return jehu.AddProfileEntryIDs(ids...)
 }
 
+// AddAPICallIDs adds the "api_calls" edge to the APICallLog entity by IDs.
+func (jehu *JobExecutionHistoryUpdate) AddAPICallIDs(ids ...ulid.ID) *JobExecutionHistoryUpdate {
+	jehu.mutation.AddAPICallIDs(ids...)
+	return jehu
+}
+
+// AddAPICalls adds the "api_calls" edges to the APICallLog entity.
+func (jehu *JobExecutionHistoryUpdate) AddAPICalls(a ...*APICallLog) *JobExecutionHistoryUpdate {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return jehu.AddAPICallIDs(ids...)
+}
+
 // Mutation returns the JobExecutionHistoryMutation object of the builder.
 func (jehu *JobExecutionHistoryUpdate) Mutation() *JobExecutionHistoryMutation {
 	return jehu.mutation
@@ -285,6 +327,27 @@ func (jehu *JobExecutionHistoryUpdate) RemoveProfileEntries(p ...*ProfileEntry)
 	return jehu.RemoveProfileEntryIDs(ids...)
 }
 
+// ClearAPICalls clears all "api_calls" edges to the APICallLog entity.
+func (jehu *JobExecutionHistoryUpdate) ClearAPICalls() *JobExecutionHistoryUpdate {
+	jehu.mutation.ClearAPICalls()
+	return jehu
+}
+
+// RemoveAPICallIDs removes the "api_calls" edge to APICallLog entities by IDs.
+func (jehu *JobExecutionHistoryUpdate) RemoveAPICallIDs(ids ...ulid.ID) *JobExecutionHistoryUpdate {
+	jehu.mutation.RemoveAPICallIDs(ids...)
+	return jehu
+}
+
+// RemoveAPICalls removes "api_calls" edges to APICallLog entities.
+func (jehu *JobExecutionHistoryUpdate) RemoveAPICalls(a ...*APICallLog) *JobExecutionHistoryUpdate {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return jehu.RemoveAPICallIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (jehu *JobExecutionHistoryUpdate) Save(ctx context.Context) (int, error) {
 	jehu.defaults()
@@ -438,6 +501,15 @@ func (jehu *JobExecutionHistoryUpdate) sqlSave(ctx context.Context) (n int, err
 	if jehu.mutation.ErrorSummaryCleared() {
 		_spec.ClearField(jobexecutionhistory.FieldErrorSummary, field.TypeString)
 	}
+	if value, ok := jehu.mutation.SchemaDriftDetected(); ok {
+		_spec.SetField(jobexecutionhistory.FieldSchemaDriftDetected, field.TypeBool, value)
+	}
+	if value, ok := jehu.mutation.SchemaDrift(); ok {
+		_spec.SetField(jobexecutionhistory.FieldSchemaDrift, field.TypeJSON, value)
+	}
+	if jehu.mutation.SchemaDriftCleared() {
+		_spec.ClearField(jobexecutionhistory.FieldSchemaDrift, field.TypeJSON)
+	}
 	if jehu.mutation.ProfileEntriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -483,6 +555,51 @@ func (jehu *JobExecutionHistoryUpdate) sqlSave(ctx context.Context) (n int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if jehu.mutation.APICallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobexecutionhistory.APICallsTable,
+			Columns: []string{jobexecutionhistory.APICallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jehu.mutation.RemovedAPICallsIDs(); len(nodes) > 0 && !jehu.mutation.APICallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobexecutionhistory.APICallsTable,
+			Columns: []string{jobexecutionhistory.APICallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jehu.mutation.APICallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobexecutionhistory.APICallsTable,
+			Columns: []string{jobexecutionhistory.APICallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, jehu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{jobexecutionhistory.Label}
@@ -717,6 +834,32 @@ func (jehuo *JobExecutionHistoryUpdateOne) ClearErrorSummary() *JobExecutionHist
 	return jehuo
 }
 
+// SetSchemaDriftDetected sets the "schema_drift_detected" field.
+func (jehuo *JobExecutionHistoryUpdateOne) SetSchemaDriftDetected(b bool) *JobExecutionHistoryUpdateOne {
+	jehuo.mutation.SetSchemaDriftDetected(b)
+	return jehuo
+}
+
+// SetNillableSchemaDriftDetected sets the "schema_drift_detected" field if the given value is not nil.
+func (jehuo *JobExecutionHistoryUpdateOne) SetNillableSchemaDriftDetected(b *bool) *JobExecutionHistoryUpdateOne {
+	if b != nil {
+		jehuo.SetSchemaDriftDetected(*b)
+	}
+	return jehuo
+}
+
+// SetSchemaDrift sets the "schema_drift" field.
+func (jehuo *JobExecutionHistoryUpdateOne) SetSchemaDrift(m map[string]interface{}) *JobExecutionHistoryUpdateOne {
+	jehuo.mutation.SetSchemaDrift(m)
+	return jehuo
+}
+
+// ClearSchemaDrift clears the value of the "schema_drift" field.
+func (jehuo *JobExecutionHistoryUpdateOne) ClearSchemaDrift() *JobExecutionHistoryUpdateOne {
+	jehuo.mutation.ClearSchemaDrift()
+	return jehuo
+}
+
 // AddProfileEntryIDs adds the "profile_entries" edge to the ProfileEntry entity by IDs.
 func (jehuo *JobExecutionHistoryUpdateOne) AddProfileEntryIDs(ids ...ulid.ID) *JobExecutionHistoryUpdateOne {
 	jehuo.mutation.AddProfileEntryIDs(ids...)
@@ -732,6 +875,21 @@ func (jehuo *JobExecutionHistoryUpdateOne) AddProfileEntries(p ...*ProfileEntry)
 	return jehuo.AddProfileEntryIDs(ids...)
 }
 
+// AddAPICallIDs adds the "api_calls" edge to the APICallLog entity by IDs.
+func (jehuo *JobExecutionHistoryUpdateOne) AddAPICallIDs(ids ...ulid.ID) *JobExecutionHistoryUpdateOne {
+	jehuo.mutation.AddAPICallIDs(ids...)
+	return jehuo
+}
+
+// AddAPICalls adds the "api_calls" edges to the APICallLog entity.
+func (jehuo *JobExecutionHistoryUpdateOne) AddAPICalls(a ...*APICallLog) *JobExecutionHistoryUpdateOne {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return jehuo.AddAPICallIDs(ids...)
+}
+
 // Mutation returns the JobExecutionHistoryMutation object of the builder.
 func (jehuo *JobExecutionHistoryUpdateOne) Mutation() *JobExecutionHistoryMutation {
 	return jehuo.mutation
@@ -758,6 +916,27 @@ func (jehuo *JobExecutionHistoryUpdateOne) RemoveProfileEntries(p ...*ProfileEnt
 	return jehuo.RemoveProfileEntryIDs(ids...)
 }
 
+// ClearAPICalls clears all "api_calls" edges to the APICallLog entity.
+func (jehuo *JobExecutionHistoryUpdateOne) ClearAPICalls() *JobExecutionHistoryUpdateOne {
+	jehuo.mutation.ClearAPICalls()
+	return jehuo
+}
+
+// RemoveAPICallIDs removes the "api_calls" edge to APICallLog entities by IDs.
+func (jehuo *JobExecutionHistoryUpdateOne) RemoveAPICallIDs(ids ...ulid.ID) *JobExecutionHistoryUpdateOne {
+	jehuo.mutation.RemoveAPICallIDs(ids...)
+	return jehuo
+}
+
+// RemoveAPICalls removes "api_calls" edges to APICallLog entities.
+func (jehuo *JobExecutionHistoryUpdateOne) RemoveAPICalls(a ...*APICallLog) *JobExecutionHistoryUpdateOne {
+	ids := make([]ulid.ID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return jehuo.RemoveAPICallIDs(ids...)
+}
+
 // Where appends a list predicates to the JobExecutionHistoryUpdate builder.
 func (jehuo *JobExecutionHistoryUpdateOne) Where(ps ...predicate.JobExecutionHistory) *JobExecutionHistoryUpdateOne {
 	jehuo.mutation.Where(ps...)
@@ -941,6 +1120,15 @@ func (jehuo *JobExecutionHistoryUpdateOne) sqlSave(ctx context.Context) (_node *
 	if jehuo.mutation.ErrorSummaryCleared() {
 		_spec.ClearField(jobexecutionhistory.FieldErrorSummary, field.TypeString)
 	}
+	if value, ok := jehuo.mutation.SchemaDriftDetected(); ok {
+		_spec.SetField(jobexecutionhistory.FieldSchemaDriftDetected, field.TypeBool, value)
+	}
+	if value, ok := jehuo.mutation.SchemaDrift(); ok {
+		_spec.SetField(jobexecutionhistory.FieldSchemaDrift, field.TypeJSON, value)
+	}
+	if jehuo.mutation.SchemaDriftCleared() {
+		_spec.ClearField(jobexecutionhistory.FieldSchemaDrift, field.TypeJSON)
+	}
 	if jehuo.mutation.ProfileEntriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -986,6 +1174,51 @@ func (jehuo *JobExecutionHistoryUpdateOne) sqlSave(ctx context.Context) (_node *
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if jehuo.mutation.APICallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobexecutionhistory.APICallsTable,
+			Columns: []string{jobexecutionhistory.APICallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jehuo.mutation.RemovedAPICallsIDs(); len(nodes) > 0 && !jehuo.mutation.APICallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobexecutionhistory.APICallsTable,
+			Columns: []string{jobexecutionhistory.APICallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jehuo.mutation.APICallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   jobexecutionhistory.APICallsTable,
+			Columns: []string{jobexecutionhistory.APICallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apicalllog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &JobExecutionHistory{config: jehuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -8,19 +8,124 @@ import (
 )
 
 var (
+	// APICallLogsColumns holds the columns for the "api_call_logs" table.
+	APICallLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "endpoint", Type: field.TypeString},
+		{Name: "key_label", Type: field.TypeString},
+		{Name: "caller", Type: field.TypeString, Default: ""},
+		{Name: "run_id", Type: field.TypeString, Nullable: true},
+		{Name: "urn", Type: field.TypeString, Nullable: true},
+		{Name: "http_status", Type: field.TypeInt, Nullable: true},
+		{Name: "latency_ms", Type: field.TypeInt, Default: 0},
+		{Name: "bytes", Type: field.TypeInt, Default: 0},
+		{Name: "counted", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "job_execution_history_api_calls", Type: field.TypeString, Nullable: true},
+	}
+	// APICallLogsTable holds the schema information for the "api_call_logs" table.
+	APICallLogsTable = &schema.Table{
+		Name:       "api_call_logs",
+		Columns:    APICallLogsColumns,
+		PrimaryKey: []*schema.Column{APICallLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_call_logs_job_execution_histories_api_calls",
+				Columns:    []*schema.Column{APICallLogsColumns[12]},
+				RefColumns: []*schema.Column{JobExecutionHistoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apicalllog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{APICallLogsColumns[10]},
+			},
+			{
+				Name:    "apicalllog_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{APICallLogsColumns[4]},
+			},
+		},
+	}
+	// APIQuotaBudgetsColumns holds the columns for the "api_quota_budgets" table.
+	APIQuotaBudgetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "percent", Type: field.TypeFloat64},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// APIQuotaBudgetsTable holds the schema information for the "api_quota_budgets" table.
+	APIQuotaBudgetsTable = &schema.Table{
+		Name:       "api_quota_budgets",
+		Columns:    APIQuotaBudgetsColumns,
+		PrimaryKey: []*schema.Column{APIQuotaBudgetsColumns[0]},
+	}
+	// APIQuotaReservationsColumns holds the columns for the "api_quota_reservations" table.
+	APIQuotaReservationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "reserved", Type: field.TypeInt},
+		{Name: "used", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"OPEN", "COMMITTED", "RELEASED", "EXPIRED"}, Default: "OPEN"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "settled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "api_quota_tracker_reservations", Type: field.TypeString},
+		{Name: "api_quota_tracker_budget_reservations", Type: field.TypeString, Nullable: true},
+	}
+	// APIQuotaReservationsTable holds the schema information for the "api_quota_reservations" table.
+	APIQuotaReservationsTable = &schema.Table{
+		Name:       "api_quota_reservations",
+		Columns:    APIQuotaReservationsColumns,
+		PrimaryKey: []*schema.Column{APIQuotaReservationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_quota_reservations_api_quota_trackers_reservations",
+				Columns:    []*schema.Column{APIQuotaReservationsColumns[8]},
+				RefColumns: []*schema.Column{APIQuotaTrackersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "api_quota_reservations_api_quota_trackers_budget_reservations",
+				Columns:    []*schema.Column{APIQuotaReservationsColumns[9]},
+				RefColumns: []*schema.Column{APIQuotaTrackersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apiquotareservation_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{APIQuotaReservationsColumns[3], APIQuotaReservationsColumns[4]},
+			},
+		},
+	}
 	// APIQuotaTrackersColumns holds the columns for the "api_quota_trackers" table.
 	APIQuotaTrackersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
 		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "key_label", Type: field.TypeString, Default: ""},
+		{Name: "budget", Type: field.TypeString, Default: ""},
 		{Name: "month", Type: field.TypeInt},
 		{Name: "year", Type: field.TypeInt},
 		{Name: "call_count", Type: field.TypeInt, Default: 0},
+		{Name: "reserved_count", Type: field.TypeInt, Default: 0},
 		{Name: "quota_limit", Type: field.TypeInt, Default: 50000},
+		{Name: "daily_cap", Type: field.TypeInt, Nullable: true},
+		{Name: "hourly_cap", Type: field.TypeInt, Nullable: true},
+		{Name: "even_pacing", Type: field.TypeBool, Default: false},
 		{Name: "quota_exceeded", Type: field.TypeBool, Default: false},
 		{Name: "override_enabled", Type: field.TypeBool, Default: false},
 		{Name: "notification_sent", Type: field.TypeBool, Default: false},
+		{Name: "alerted_thresholds", Type: field.TypeJSON, Nullable: true},
 		{Name: "last_call_at", Type: field.TypeTime, Nullable: true},
+		{Name: "rate_limited_until", Type: field.TypeTime, Nullable: true},
 	}
 	// APIQuotaTrackersTable holds the schema information for the "api_quota_trackers" table.
 	APIQuotaTrackersTable = &schema.Table{
@@ -29,14 +134,43 @@ var (
 		PrimaryKey: []*schema.Column{APIQuotaTrackersColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "apiquotatracker_month_year",
+				Name:    "apiquotatracker_key_label_budget_month_year",
 				Unique:  true,
-				Columns: []*schema.Column{APIQuotaTrackersColumns[3], APIQuotaTrackersColumns[4]},
+				Columns: []*schema.Column{APIQuotaTrackersColumns[3], APIQuotaTrackersColumns[4], APIQuotaTrackersColumns[5], APIQuotaTrackersColumns[6]},
 			},
 			{
 				Name:    "apiquotatracker_year_month",
 				Unique:  false,
-				Columns: []*schema.Column{APIQuotaTrackersColumns[4], APIQuotaTrackersColumns[3]},
+				Columns: []*schema.Column{APIQuotaTrackersColumns[6], APIQuotaTrackersColumns[5]},
+			},
+		},
+	}
+	// CompaniesColumns holds the columns for the "companies" table.
+	CompaniesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "normalized_name", Type: field.TypeString},
+		{Name: "linkedin_urn", Type: field.TypeString, Nullable: true},
+		{Name: "linkedin_username", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// CompaniesTable holds the schema information for the "companies" table.
+	CompaniesTable = &schema.Table{
+		Name:       "companies",
+		Columns:    CompaniesColumns,
+		PrimaryKey: []*schema.Column{CompaniesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "company_normalized_name",
+				Unique:  false,
+				Columns: []*schema.Column{CompaniesColumns[3]},
+			},
+			{
+				Name:    "company_linkedin_urn",
+				Unique:  false,
+				Columns: []*schema.Column{CompaniesColumns[4]},
 			},
 		},
 	}
@@ -46,10 +180,11 @@ var (
 		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
 		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
 		{Name: "job_name", Type: field.TypeString, Unique: true, Size: 100},
-		{Name: "job_type", Type: field.TypeEnum, Enums: []string{"PROFILE_FETCHER", "QUOTA_RESET"}},
+		{Name: "job_type", Type: field.TypeEnum, Enums: []string{"PROFILE_FETCHER", "QUOTA_RESET", "STALE_FETCH_REAPER", "PROFILE_REFRESHER", "CHANGE_EVENT_WEBHOOK"}},
 		{Name: "schedule", Type: field.TypeString},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
 		{Name: "batch_size", Type: field.TypeInt, Default: 10},
+		{Name: "concurrency", Type: field.TypeInt, Default: 1},
 		{Name: "admin_email", Type: field.TypeString},
 		{Name: "respect_quota", Type: field.TypeBool, Default: true},
 		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
@@ -94,6 +229,8 @@ var (
 		{Name: "api_calls_made", Type: field.TypeInt, Default: 0},
 		{Name: "quota_remaining", Type: field.TypeInt, Default: 0},
 		{Name: "error_summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "schema_drift_detected", Type: field.TypeBool, Default: false},
+		{Name: "schema_drift", Type: field.TypeJSON, Nullable: true},
 	}
 	// JobExecutionHistoriesTable holds the schema information for the "job_execution_histories" table.
 	JobExecutionHistoriesTable = &schema.Table{
@@ -196,6 +333,100 @@ var (
 			},
 		},
 	}
+	// ProfileChangeEventsColumns holds the columns for the "profile_change_events" table.
+	ProfileChangeEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"JOB_CHANGE", "TITLE_CHANGE", "LOCATION_CHANGE", "NEW_SKILL"}},
+		{Name: "old_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "new_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "detected_at", Type: field.TypeTime},
+		{Name: "delivery_status", Type: field.TypeEnum, Enums: []string{"PENDING", "DELIVERED", "FAILED", "SKIPPED"}, Default: "PENDING"},
+		{Name: "delivery_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_delivery_at", Type: field.TypeTime, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_delivery_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "profile_change_events", Type: field.TypeString},
+		{Name: "profile_snapshot_change_events", Type: field.TypeString, Nullable: true},
+	}
+	// ProfileChangeEventsTable holds the schema information for the "profile_change_events" table.
+	ProfileChangeEventsTable = &schema.Table{
+		Name:       "profile_change_events",
+		Columns:    ProfileChangeEventsColumns,
+		PrimaryKey: []*schema.Column{ProfileChangeEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "profile_change_events_profiles_change_events",
+				Columns:    []*schema.Column{ProfileChangeEventsColumns[12]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "profile_change_events_profile_snapshots_change_events",
+				Columns:    []*schema.Column{ProfileChangeEventsColumns[13]},
+				RefColumns: []*schema.Column{ProfileSnapshotsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "profilechangeevent_delivery_status_next_delivery_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProfileChangeEventsColumns[5], ProfileChangeEventsColumns[7]},
+			},
+			{
+				Name:    "profilechangeevent_detected_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProfileChangeEventsColumns[4]},
+			},
+			{
+				Name:    "profilechangeevent_type",
+				Unique:  false,
+				Columns: []*schema.Column{ProfileChangeEventsColumns[1]},
+			},
+		},
+	}
+	// ProfileEducationsColumns holds the columns for the "profile_educations" table.
+	ProfileEducationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "education_index", Type: field.TypeInt},
+		{Name: "school_name", Type: field.TypeString},
+		{Name: "school_id", Type: field.TypeString, Nullable: true},
+		{Name: "degree", Type: field.TypeString, Nullable: true},
+		{Name: "field_of_study", Type: field.TypeString, Nullable: true},
+		{Name: "start_date", Type: field.TypeTime, Nullable: true},
+		{Name: "end_date", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "profile_profile_educations", Type: field.TypeString},
+	}
+	// ProfileEducationsTable holds the schema information for the "profile_educations" table.
+	ProfileEducationsTable = &schema.Table{
+		Name:       "profile_educations",
+		Columns:    ProfileEducationsColumns,
+		PrimaryKey: []*schema.Column{ProfileEducationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "profile_educations_profiles_profile_educations",
+				Columns:    []*schema.Column{ProfileEducationsColumns[10]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "profileeducation_school_name",
+				Unique:  false,
+				Columns: []*schema.Column{ProfileEducationsColumns[2]},
+			},
+			{
+				Name:    "profileeducation_degree",
+				Unique:  false,
+				Columns: []*schema.Column{ProfileEducationsColumns[4]},
+			},
+		},
+	}
 	// ProfileEntriesColumns holds the columns for the "profile_entries" table.
 	ProfileEntriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -210,6 +441,10 @@ var (
 		{Name: "fetch_count", Type: field.TypeInt, Default: 0},
 		{Name: "last_fetched_at", Type: field.TypeTime, Nullable: true},
 		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "attempt_count", Type: field.TypeInt, Default: 0},
+		{Name: "next_retry_at", Type: field.TypeTime, Nullable: true},
+		{Name: "lease_owner", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "lease_expires_at", Type: field.TypeTime, Nullable: true},
 	}
 	// ProfileEntriesTable holds the schema information for the "profile_entries" table.
 	ProfileEntriesTable = &schema.Table{
@@ -237,6 +472,77 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{ProfileEntriesColumns[5], ProfileEntriesColumns[1]},
 			},
+			{
+				Name:    "profileentry_status_next_retry_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProfileEntriesColumns[5], ProfileEntriesColumns[13]},
+			},
+			{
+				Name:    "profileentry_status_lease_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProfileEntriesColumns[5], ProfileEntriesColumns[15]},
+			},
+		},
+	}
+	// ProfilePositionsColumns holds the columns for the "profile_positions" table.
+	ProfilePositionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "position_index", Type: field.TypeInt},
+		{Name: "company_name", Type: field.TypeString, Nullable: true},
+		{Name: "company_urn", Type: field.TypeString, Nullable: true},
+		{Name: "company_username", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "location", Type: field.TypeString, Nullable: true},
+		{Name: "employment_type", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "start_date", Type: field.TypeTime, Nullable: true},
+		{Name: "end_date", Type: field.TypeTime, Nullable: true},
+		{Name: "is_current", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "company_positions", Type: field.TypeString, Nullable: true},
+		{Name: "profile_profile_positions", Type: field.TypeString},
+	}
+	// ProfilePositionsTable holds the schema information for the "profile_positions" table.
+	ProfilePositionsTable = &schema.Table{
+		Name:       "profile_positions",
+		Columns:    ProfilePositionsColumns,
+		PrimaryKey: []*schema.Column{ProfilePositionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "profile_positions_companies_positions",
+				Columns:    []*schema.Column{ProfilePositionsColumns[14]},
+				RefColumns: []*schema.Column{CompaniesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "profile_positions_profiles_profile_positions",
+				Columns:    []*schema.Column{ProfilePositionsColumns[15]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "profileposition_company_name",
+				Unique:  false,
+				Columns: []*schema.Column{ProfilePositionsColumns[2]},
+			},
+			{
+				Name:    "profileposition_company_urn",
+				Unique:  false,
+				Columns: []*schema.Column{ProfilePositionsColumns[3]},
+			},
+			{
+				Name:    "profileposition_title",
+				Unique:  false,
+				Columns: []*schema.Column{ProfilePositionsColumns[5]},
+			},
+			{
+				Name:    "profileposition_is_current",
+				Unique:  false,
+				Columns: []*schema.Column{ProfilePositionsColumns[11]},
+			},
 		},
 	}
 	// ProfilePostsColumns holds the columns for the "profile_posts" table.
@@ -321,6 +627,74 @@ var (
 			},
 		},
 	}
+	// ProfileSkillsColumns holds the columns for the "profile_skills" table.
+	ProfileSkillsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "endorsement_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "profile_profile_skills", Type: field.TypeString},
+	}
+	// ProfileSkillsTable holds the schema information for the "profile_skills" table.
+	ProfileSkillsTable = &schema.Table{
+		Name:       "profile_skills",
+		Columns:    ProfileSkillsColumns,
+		PrimaryKey: []*schema.Column{ProfileSkillsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "profile_skills_profiles_profile_skills",
+				Columns:    []*schema.Column{ProfileSkillsColumns[5]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "profileskill_name",
+				Unique:  false,
+				Columns: []*schema.Column{ProfileSkillsColumns[1]},
+			},
+		},
+	}
+	// ProfileSnapshotsColumns holds the columns for the "profile_snapshots" table.
+	ProfileSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "fetched_at", Type: field.TypeTime},
+		{Name: "raw_data_s3_key", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "cleaned_data_s3_key", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "headline", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "country", Type: field.TypeString, Nullable: true},
+		{Name: "city", Type: field.TypeString, Nullable: true},
+		{Name: "positions", Type: field.TypeJSON, Nullable: true},
+		{Name: "educations", Type: field.TypeJSON, Nullable: true},
+		{Name: "skills", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "profile_snapshots", Type: field.TypeString},
+	}
+	// ProfileSnapshotsTable holds the schema information for the "profile_snapshots" table.
+	ProfileSnapshotsTable = &schema.Table{
+		Name:       "profile_snapshots",
+		Columns:    ProfileSnapshotsColumns,
+		PrimaryKey: []*schema.Column{ProfileSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "profile_snapshots_profiles_snapshots",
+				Columns:    []*schema.Column{ProfileSnapshotsColumns[13]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "profilesnapshot_fetched_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProfileSnapshotsColumns[1]},
+			},
+		},
+	}
 	// TodosColumns holds the columns for the "todos" table.
 	TodosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -386,25 +760,100 @@ var (
 			},
 		},
 	}
+	// ProfileCurrentCompaniesColumns holds the columns for the "profile_current_companies" table.
+	ProfileCurrentCompaniesColumns = []*schema.Column{
+		{Name: "profile_id", Type: field.TypeString},
+		{Name: "company_id", Type: field.TypeString},
+	}
+	// ProfileCurrentCompaniesTable holds the schema information for the "profile_current_companies" table.
+	ProfileCurrentCompaniesTable = &schema.Table{
+		Name:       "profile_current_companies",
+		Columns:    ProfileCurrentCompaniesColumns,
+		PrimaryKey: []*schema.Column{ProfileCurrentCompaniesColumns[0], ProfileCurrentCompaniesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "profile_current_companies_profile_id",
+				Columns:    []*schema.Column{ProfileCurrentCompaniesColumns[0]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "profile_current_companies_company_id",
+				Columns:    []*schema.Column{ProfileCurrentCompaniesColumns[1]},
+				RefColumns: []*schema.Column{CompaniesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ProfilePastCompaniesColumns holds the columns for the "profile_past_companies" table.
+	ProfilePastCompaniesColumns = []*schema.Column{
+		{Name: "profile_id", Type: field.TypeString},
+		{Name: "company_id", Type: field.TypeString},
+	}
+	// ProfilePastCompaniesTable holds the schema information for the "profile_past_companies" table.
+	ProfilePastCompaniesTable = &schema.Table{
+		Name:       "profile_past_companies",
+		Columns:    ProfilePastCompaniesColumns,
+		PrimaryKey: []*schema.Column{ProfilePastCompaniesColumns[0], ProfilePastCompaniesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "profile_past_companies_profile_id",
+				Columns:    []*schema.Column{ProfilePastCompaniesColumns[0]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "profile_past_companies_company_id",
+				Columns:    []*schema.Column{ProfilePastCompaniesColumns[1]},
+				RefColumns: []*schema.Column{CompaniesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APICallLogsTable,
+		APIQuotaBudgetsTable,
+		APIQuotaReservationsTable,
 		APIQuotaTrackersTable,
+		CompaniesTable,
 		CronJobConfigsTable,
 		JobExecutionHistoriesTable,
 		ProfilesTable,
+		ProfileChangeEventsTable,
+		ProfileEducationsTable,
 		ProfileEntriesTable,
+		ProfilePositionsTable,
 		ProfilePostsTable,
 		ProfilePostItemsTable,
+		ProfileSkillsTable,
+		ProfileSnapshotsTable,
 		TodosTable,
 		UsersTable,
 		JobExecutionHistoryProfileEntriesTable,
+		ProfileCurrentCompaniesTable,
+		ProfilePastCompaniesTable,
 	}
 )
 
 func init() {
+	APICallLogsTable.ForeignKeys[0].RefTable = JobExecutionHistoriesTable
+	APIQuotaReservationsTable.ForeignKeys[0].RefTable = APIQuotaTrackersTable
+	APIQuotaReservationsTable.ForeignKeys[1].RefTable = APIQuotaTrackersTable
 	ProfilesTable.ForeignKeys[0].RefTable = ProfileEntriesTable
+	ProfileChangeEventsTable.ForeignKeys[0].RefTable = ProfilesTable
+	ProfileChangeEventsTable.ForeignKeys[1].RefTable = ProfileSnapshotsTable
+	ProfileEducationsTable.ForeignKeys[0].RefTable = ProfilesTable
+	ProfilePositionsTable.ForeignKeys[0].RefTable = CompaniesTable
+	ProfilePositionsTable.ForeignKeys[1].RefTable = ProfilesTable
 	ProfilePostItemsTable.ForeignKeys[0].RefTable = ProfilePostsTable
+	ProfileSkillsTable.ForeignKeys[0].RefTable = ProfilesTable
+	ProfileSnapshotsTable.ForeignKeys[0].RefTable = ProfilesTable
 	TodosTable.ForeignKeys[0].RefTable = UsersTable
 	JobExecutionHistoryProfileEntriesTable.ForeignKeys[0].RefTable = JobExecutionHistoriesTable
 	JobExecutionHistoryProfileEntriesTable.ForeignKeys[1].RefTable = ProfileEntriesTable
+	ProfileCurrentCompaniesTable.ForeignKeys[0].RefTable = ProfilesTable
+	ProfileCurrentCompaniesTable.ForeignKeys[1].RefTable = CompaniesTable
+	ProfilePastCompaniesTable.ForeignKeys[0].RefTable = ProfilesTable
+	ProfilePastCompaniesTable.ForeignKeys[1].RefTable = CompaniesTable
 }
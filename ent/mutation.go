@@ -6,14 +6,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/cronjobconfig"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/predicate"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
 	"sheng-go-backend/ent/profilepost"
 	"sheng-go-backend/ent/profilepostitem"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/schema/ulid"
 	"sheng-go-backend/ent/todo"
 	"sheng-go-backend/ent/user"
@@ -33,54 +42,65 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeAPICallLog          = "APICallLog"
+	TypeAPIQuotaBudget      = "APIQuotaBudget"
+	TypeAPIQuotaReservation = "APIQuotaReservation"
 	TypeAPIQuotaTracker     = "APIQuotaTracker"
+	TypeCompany             = "Company"
 	TypeCronJobConfig       = "CronJobConfig"
 	TypeJobExecutionHistory = "JobExecutionHistory"
 	TypeProfile             = "Profile"
+	TypeProfileChangeEvent  = "ProfileChangeEvent"
+	TypeProfileEducation    = "ProfileEducation"
 	TypeProfileEntry        = "ProfileEntry"
+	TypeProfilePosition     = "ProfilePosition"
 	TypeProfilePost         = "ProfilePost"
 	TypeProfilePostItem     = "ProfilePostItem"
+	TypeProfileSkill        = "ProfileSkill"
+	TypeProfileSnapshot     = "ProfileSnapshot"
 	TypeTodo                = "Todo"
 	TypeUser                = "User"
 )
 
-// APIQuotaTrackerMutation represents an operation that mutates the APIQuotaTracker nodes in the graph.
-type APIQuotaTrackerMutation struct {
+// APICallLogMutation represents an operation that mutates the APICallLog nodes in the graph.
+type APICallLogMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *ulid.ID
-	created_at        *time.Time
-	updated_at        *time.Time
-	month             *int
-	addmonth          *int
-	year              *int
-	addyear           *int
-	call_count        *int
-	addcall_count     *int
-	quota_limit       *int
-	addquota_limit    *int
-	quota_exceeded    *bool
-	override_enabled  *bool
-	notification_sent *bool
-	last_call_at      *time.Time
-	clearedFields     map[string]struct{}
-	done              bool
-	oldValue          func(context.Context) (*APIQuotaTracker, error)
-	predicates        []predicate.APIQuotaTracker
+	op                   Op
+	typ                  string
+	id                   *ulid.ID
+	endpoint             *string
+	key_label            *string
+	caller               *string
+	run_id               *string
+	urn                  *string
+	http_status          *int
+	addhttp_status       *int
+	latency_ms           *int
+	addlatency_ms        *int
+	bytes                *int
+	addbytes             *int
+	counted              *bool
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	job_execution        *ulid.ID
+	clearedjob_execution bool
+	done                 bool
+	oldValue             func(context.Context) (*APICallLog, error)
+	predicates           []predicate.APICallLog
 }
 
-var _ ent.Mutation = (*APIQuotaTrackerMutation)(nil)
+var _ ent.Mutation = (*APICallLogMutation)(nil)
 
-// apiquotatrackerOption allows management of the mutation configuration using functional options.
-type apiquotatrackerOption func(*APIQuotaTrackerMutation)
+// apicalllogOption allows management of the mutation configuration using functional options.
+type apicalllogOption func(*APICallLogMutation)
 
-// newAPIQuotaTrackerMutation creates new mutation for the APIQuotaTracker entity.
-func newAPIQuotaTrackerMutation(c config, op Op, opts ...apiquotatrackerOption) *APIQuotaTrackerMutation {
-	m := &APIQuotaTrackerMutation{
+// newAPICallLogMutation creates new mutation for the APICallLog entity.
+func newAPICallLogMutation(c config, op Op, opts ...apicalllogOption) *APICallLogMutation {
+	m := &APICallLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAPIQuotaTracker,
+		typ:           TypeAPICallLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -89,20 +109,20 @@ func newAPIQuotaTrackerMutation(c config, op Op, opts ...apiquotatrackerOption)
 	return m
 }
 
-// withAPIQuotaTrackerID sets the ID field of the mutation.
-func withAPIQuotaTrackerID(id ulid.ID) apiquotatrackerOption {
-	return func(m *APIQuotaTrackerMutation) {
+// withAPICallLogID sets the ID field of the mutation.
+func withAPICallLogID(id ulid.ID) apicalllogOption {
+	return func(m *APICallLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *APIQuotaTracker
+			value *APICallLog
 		)
-		m.oldValue = func(ctx context.Context) (*APIQuotaTracker, error) {
+		m.oldValue = func(ctx context.Context) (*APICallLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().APIQuotaTracker.Get(ctx, id)
+					value, err = m.Client().APICallLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -111,10 +131,10 @@ func withAPIQuotaTrackerID(id ulid.ID) apiquotatrackerOption {
 	}
 }
 
-// withAPIQuotaTracker sets the old APIQuotaTracker of the mutation.
-func withAPIQuotaTracker(node *APIQuotaTracker) apiquotatrackerOption {
-	return func(m *APIQuotaTrackerMutation) {
-		m.oldValue = func(context.Context) (*APIQuotaTracker, error) {
+// withAPICallLog sets the old APICallLog of the mutation.
+func withAPICallLog(node *APICallLog) apicalllogOption {
+	return func(m *APICallLogMutation) {
+		m.oldValue = func(context.Context) (*APICallLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -123,7 +143,7 @@ func withAPIQuotaTracker(node *APIQuotaTracker) apiquotatrackerOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m APIQuotaTrackerMutation) Client() *Client {
+func (m APICallLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -131,7 +151,7 @@ func (m APIQuotaTrackerMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m APIQuotaTrackerMutation) Tx() (*Tx, error) {
+func (m APICallLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -141,14 +161,14 @@ func (m APIQuotaTrackerMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of APIQuotaTracker entities.
-func (m *APIQuotaTrackerMutation) SetID(id ulid.ID) {
+// operation is only accepted on creation of APICallLog entities.
+func (m *APICallLogMutation) SetID(id ulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *APIQuotaTrackerMutation) ID() (id ulid.ID, exists bool) {
+func (m *APICallLogMutation) ID() (id ulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -159,7 +179,7 @@ func (m *APIQuotaTrackerMutation) ID() (id ulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *APIQuotaTrackerMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+func (m *APICallLogMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -168,474 +188,556 @@ func (m *APIQuotaTrackerMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().APIQuotaTracker.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APICallLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *APIQuotaTrackerMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetEndpoint sets the "endpoint" field.
+func (m *APICallLogMutation) SetEndpoint(s string) {
+	m.endpoint = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *APIQuotaTrackerMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *APICallLogMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the APIQuotaTracker entity.
-// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpoint returns the old "endpoint" field's value of the APICallLog entity.
+// If the APICallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *APIQuotaTrackerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APICallLogMutation) OldEndpoint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Endpoint, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *APIQuotaTrackerMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *APICallLogMutation) ResetEndpoint() {
+	m.endpoint = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *APIQuotaTrackerMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetKeyLabel sets the "key_label" field.
+func (m *APICallLogMutation) SetKeyLabel(s string) {
+	m.key_label = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *APIQuotaTrackerMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// KeyLabel returns the value of the "key_label" field in the mutation.
+func (m *APICallLogMutation) KeyLabel() (r string, exists bool) {
+	v := m.key_label
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the APIQuotaTracker entity.
-// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyLabel returns the old "key_label" field's value of the APICallLog entity.
+// If the APICallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *APIQuotaTrackerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APICallLogMutation) OldKeyLabel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyLabel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldKeyLabel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyLabel: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.KeyLabel, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *APIQuotaTrackerMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetKeyLabel resets all changes to the "key_label" field.
+func (m *APICallLogMutation) ResetKeyLabel() {
+	m.key_label = nil
 }
 
-// SetMonth sets the "month" field.
-func (m *APIQuotaTrackerMutation) SetMonth(i int) {
-	m.month = &i
-	m.addmonth = nil
+// SetCaller sets the "caller" field.
+func (m *APICallLogMutation) SetCaller(s string) {
+	m.caller = &s
 }
 
-// Month returns the value of the "month" field in the mutation.
-func (m *APIQuotaTrackerMutation) Month() (r int, exists bool) {
-	v := m.month
+// Caller returns the value of the "caller" field in the mutation.
+func (m *APICallLogMutation) Caller() (r string, exists bool) {
+	v := m.caller
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMonth returns the old "month" field's value of the APIQuotaTracker entity.
-// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// OldCaller returns the old "caller" field's value of the APICallLog entity.
+// If the APICallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *APIQuotaTrackerMutation) OldMonth(ctx context.Context) (v int, err error) {
+func (m *APICallLogMutation) OldCaller(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMonth is only allowed on UpdateOne operations")
+		return v, errors.New("OldCaller is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMonth requires an ID field in the mutation")
+		return v, errors.New("OldCaller requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMonth: %w", err)
+		return v, fmt.Errorf("querying old value for OldCaller: %w", err)
 	}
-	return oldValue.Month, nil
+	return oldValue.Caller, nil
 }
 
-// AddMonth adds i to the "month" field.
-func (m *APIQuotaTrackerMutation) AddMonth(i int) {
-	if m.addmonth != nil {
-		*m.addmonth += i
-	} else {
-		m.addmonth = &i
-	}
+// ResetCaller resets all changes to the "caller" field.
+func (m *APICallLogMutation) ResetCaller() {
+	m.caller = nil
 }
 
-// AddedMonth returns the value that was added to the "month" field in this mutation.
-func (m *APIQuotaTrackerMutation) AddedMonth() (r int, exists bool) {
-	v := m.addmonth
+// SetRunID sets the "run_id" field.
+func (m *APICallLogMutation) SetRunID(s string) {
+	m.run_id = &s
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *APICallLogMutation) RunID() (r string, exists bool) {
+	v := m.run_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMonth resets all changes to the "month" field.
-func (m *APIQuotaTrackerMutation) ResetMonth() {
-	m.month = nil
-	m.addmonth = nil
+// OldRunID returns the old "run_id" field's value of the APICallLog entity.
+// If the APICallLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APICallLogMutation) OldRunID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
 }
 
-// SetYear sets the "year" field.
-func (m *APIQuotaTrackerMutation) SetYear(i int) {
-	m.year = &i
-	m.addyear = nil
+// ClearRunID clears the value of the "run_id" field.
+func (m *APICallLogMutation) ClearRunID() {
+	m.run_id = nil
+	m.clearedFields[apicalllog.FieldRunID] = struct{}{}
 }
 
-// Year returns the value of the "year" field in the mutation.
-func (m *APIQuotaTrackerMutation) Year() (r int, exists bool) {
-	v := m.year
+// RunIDCleared returns if the "run_id" field was cleared in this mutation.
+func (m *APICallLogMutation) RunIDCleared() bool {
+	_, ok := m.clearedFields[apicalllog.FieldRunID]
+	return ok
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *APICallLogMutation) ResetRunID() {
+	m.run_id = nil
+	delete(m.clearedFields, apicalllog.FieldRunID)
+}
+
+// SetUrn sets the "urn" field.
+func (m *APICallLogMutation) SetUrn(s string) {
+	m.urn = &s
+}
+
+// Urn returns the value of the "urn" field in the mutation.
+func (m *APICallLogMutation) Urn() (r string, exists bool) {
+	v := m.urn
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldYear returns the old "year" field's value of the APIQuotaTracker entity.
-// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// OldUrn returns the old "urn" field's value of the APICallLog entity.
+// If the APICallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *APIQuotaTrackerMutation) OldYear(ctx context.Context) (v int, err error) {
+func (m *APICallLogMutation) OldUrn(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldYear is only allowed on UpdateOne operations")
+		return v, errors.New("OldUrn is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldYear requires an ID field in the mutation")
+		return v, errors.New("OldUrn requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldYear: %w", err)
+		return v, fmt.Errorf("querying old value for OldUrn: %w", err)
 	}
-	return oldValue.Year, nil
+	return oldValue.Urn, nil
 }
 
-// AddYear adds i to the "year" field.
-func (m *APIQuotaTrackerMutation) AddYear(i int) {
-	if m.addyear != nil {
-		*m.addyear += i
-	} else {
-		m.addyear = &i
-	}
+// ClearUrn clears the value of the "urn" field.
+func (m *APICallLogMutation) ClearUrn() {
+	m.urn = nil
+	m.clearedFields[apicalllog.FieldUrn] = struct{}{}
 }
 
-// AddedYear returns the value that was added to the "year" field in this mutation.
-func (m *APIQuotaTrackerMutation) AddedYear() (r int, exists bool) {
-	v := m.addyear
-	if v == nil {
-		return
-	}
-	return *v, true
+// UrnCleared returns if the "urn" field was cleared in this mutation.
+func (m *APICallLogMutation) UrnCleared() bool {
+	_, ok := m.clearedFields[apicalllog.FieldUrn]
+	return ok
 }
 
-// ResetYear resets all changes to the "year" field.
-func (m *APIQuotaTrackerMutation) ResetYear() {
-	m.year = nil
-	m.addyear = nil
+// ResetUrn resets all changes to the "urn" field.
+func (m *APICallLogMutation) ResetUrn() {
+	m.urn = nil
+	delete(m.clearedFields, apicalllog.FieldUrn)
 }
 
-// SetCallCount sets the "call_count" field.
-func (m *APIQuotaTrackerMutation) SetCallCount(i int) {
-	m.call_count = &i
-	m.addcall_count = nil
+// SetHTTPStatus sets the "http_status" field.
+func (m *APICallLogMutation) SetHTTPStatus(i int) {
+	m.http_status = &i
+	m.addhttp_status = nil
 }
 
-// CallCount returns the value of the "call_count" field in the mutation.
-func (m *APIQuotaTrackerMutation) CallCount() (r int, exists bool) {
-	v := m.call_count
+// HTTPStatus returns the value of the "http_status" field in the mutation.
+func (m *APICallLogMutation) HTTPStatus() (r int, exists bool) {
+	v := m.http_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCallCount returns the old "call_count" field's value of the APIQuotaTracker entity.
-// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// OldHTTPStatus returns the old "http_status" field's value of the APICallLog entity.
+// If the APICallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *APIQuotaTrackerMutation) OldCallCount(ctx context.Context) (v int, err error) {
+func (m *APICallLogMutation) OldHTTPStatus(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCallCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldHTTPStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCallCount requires an ID field in the mutation")
+		return v, errors.New("OldHTTPStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCallCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldHTTPStatus: %w", err)
 	}
-	return oldValue.CallCount, nil
+	return oldValue.HTTPStatus, nil
 }
 
-// AddCallCount adds i to the "call_count" field.
-func (m *APIQuotaTrackerMutation) AddCallCount(i int) {
-	if m.addcall_count != nil {
-		*m.addcall_count += i
+// AddHTTPStatus adds i to the "http_status" field.
+func (m *APICallLogMutation) AddHTTPStatus(i int) {
+	if m.addhttp_status != nil {
+		*m.addhttp_status += i
 	} else {
-		m.addcall_count = &i
+		m.addhttp_status = &i
 	}
 }
 
-// AddedCallCount returns the value that was added to the "call_count" field in this mutation.
-func (m *APIQuotaTrackerMutation) AddedCallCount() (r int, exists bool) {
-	v := m.addcall_count
+// AddedHTTPStatus returns the value that was added to the "http_status" field in this mutation.
+func (m *APICallLogMutation) AddedHTTPStatus() (r int, exists bool) {
+	v := m.addhttp_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCallCount resets all changes to the "call_count" field.
-func (m *APIQuotaTrackerMutation) ResetCallCount() {
-	m.call_count = nil
-	m.addcall_count = nil
+// ClearHTTPStatus clears the value of the "http_status" field.
+func (m *APICallLogMutation) ClearHTTPStatus() {
+	m.http_status = nil
+	m.addhttp_status = nil
+	m.clearedFields[apicalllog.FieldHTTPStatus] = struct{}{}
 }
 
-// SetQuotaLimit sets the "quota_limit" field.
-func (m *APIQuotaTrackerMutation) SetQuotaLimit(i int) {
-	m.quota_limit = &i
-	m.addquota_limit = nil
+// HTTPStatusCleared returns if the "http_status" field was cleared in this mutation.
+func (m *APICallLogMutation) HTTPStatusCleared() bool {
+	_, ok := m.clearedFields[apicalllog.FieldHTTPStatus]
+	return ok
 }
 
-// QuotaLimit returns the value of the "quota_limit" field in the mutation.
-func (m *APIQuotaTrackerMutation) QuotaLimit() (r int, exists bool) {
-	v := m.quota_limit
+// ResetHTTPStatus resets all changes to the "http_status" field.
+func (m *APICallLogMutation) ResetHTTPStatus() {
+	m.http_status = nil
+	m.addhttp_status = nil
+	delete(m.clearedFields, apicalllog.FieldHTTPStatus)
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (m *APICallLogMutation) SetLatencyMs(i int) {
+	m.latency_ms = &i
+	m.addlatency_ms = nil
+}
+
+// LatencyMs returns the value of the "latency_ms" field in the mutation.
+func (m *APICallLogMutation) LatencyMs() (r int, exists bool) {
+	v := m.latency_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuotaLimit returns the old "quota_limit" field's value of the APIQuotaTracker entity.
-// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// OldLatencyMs returns the old "latency_ms" field's value of the APICallLog entity.
+// If the APICallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *APIQuotaTrackerMutation) OldQuotaLimit(ctx context.Context) (v int, err error) {
+func (m *APICallLogMutation) OldLatencyMs(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuotaLimit is only allowed on UpdateOne operations")
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuotaLimit requires an ID field in the mutation")
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuotaLimit: %w", err)
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
 	}
-	return oldValue.QuotaLimit, nil
+	return oldValue.LatencyMs, nil
 }
 
-// AddQuotaLimit adds i to the "quota_limit" field.
-func (m *APIQuotaTrackerMutation) AddQuotaLimit(i int) {
-	if m.addquota_limit != nil {
-		*m.addquota_limit += i
+// AddLatencyMs adds i to the "latency_ms" field.
+func (m *APICallLogMutation) AddLatencyMs(i int) {
+	if m.addlatency_ms != nil {
+		*m.addlatency_ms += i
 	} else {
-		m.addquota_limit = &i
+		m.addlatency_ms = &i
 	}
 }
 
-// AddedQuotaLimit returns the value that was added to the "quota_limit" field in this mutation.
-func (m *APIQuotaTrackerMutation) AddedQuotaLimit() (r int, exists bool) {
-	v := m.addquota_limit
+// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
+func (m *APICallLogMutation) AddedLatencyMs() (r int, exists bool) {
+	v := m.addlatency_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetQuotaLimit resets all changes to the "quota_limit" field.
-func (m *APIQuotaTrackerMutation) ResetQuotaLimit() {
-	m.quota_limit = nil
-	m.addquota_limit = nil
+// ResetLatencyMs resets all changes to the "latency_ms" field.
+func (m *APICallLogMutation) ResetLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
 }
 
-// SetQuotaExceeded sets the "quota_exceeded" field.
-func (m *APIQuotaTrackerMutation) SetQuotaExceeded(b bool) {
-	m.quota_exceeded = &b
+// SetBytes sets the "bytes" field.
+func (m *APICallLogMutation) SetBytes(i int) {
+	m.bytes = &i
+	m.addbytes = nil
 }
 
-// QuotaExceeded returns the value of the "quota_exceeded" field in the mutation.
-func (m *APIQuotaTrackerMutation) QuotaExceeded() (r bool, exists bool) {
-	v := m.quota_exceeded
+// Bytes returns the value of the "bytes" field in the mutation.
+func (m *APICallLogMutation) Bytes() (r int, exists bool) {
+	v := m.bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuotaExceeded returns the old "quota_exceeded" field's value of the APIQuotaTracker entity.
-// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// OldBytes returns the old "bytes" field's value of the APICallLog entity.
+// If the APICallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *APIQuotaTrackerMutation) OldQuotaExceeded(ctx context.Context) (v bool, err error) {
+func (m *APICallLogMutation) OldBytes(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuotaExceeded is only allowed on UpdateOne operations")
+		return v, errors.New("OldBytes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuotaExceeded requires an ID field in the mutation")
+		return v, errors.New("OldBytes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuotaExceeded: %w", err)
+		return v, fmt.Errorf("querying old value for OldBytes: %w", err)
 	}
-	return oldValue.QuotaExceeded, nil
+	return oldValue.Bytes, nil
 }
 
-// ResetQuotaExceeded resets all changes to the "quota_exceeded" field.
-func (m *APIQuotaTrackerMutation) ResetQuotaExceeded() {
-	m.quota_exceeded = nil
+// AddBytes adds i to the "bytes" field.
+func (m *APICallLogMutation) AddBytes(i int) {
+	if m.addbytes != nil {
+		*m.addbytes += i
+	} else {
+		m.addbytes = &i
+	}
 }
 
-// SetOverrideEnabled sets the "override_enabled" field.
-func (m *APIQuotaTrackerMutation) SetOverrideEnabled(b bool) {
-	m.override_enabled = &b
+// AddedBytes returns the value that was added to the "bytes" field in this mutation.
+func (m *APICallLogMutation) AddedBytes() (r int, exists bool) {
+	v := m.addbytes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// OverrideEnabled returns the value of the "override_enabled" field in the mutation.
-func (m *APIQuotaTrackerMutation) OverrideEnabled() (r bool, exists bool) {
-	v := m.override_enabled
+// ResetBytes resets all changes to the "bytes" field.
+func (m *APICallLogMutation) ResetBytes() {
+	m.bytes = nil
+	m.addbytes = nil
+}
+
+// SetCounted sets the "counted" field.
+func (m *APICallLogMutation) SetCounted(b bool) {
+	m.counted = &b
+}
+
+// Counted returns the value of the "counted" field in the mutation.
+func (m *APICallLogMutation) Counted() (r bool, exists bool) {
+	v := m.counted
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOverrideEnabled returns the old "override_enabled" field's value of the APIQuotaTracker entity.
-// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// OldCounted returns the old "counted" field's value of the APICallLog entity.
+// If the APICallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *APIQuotaTrackerMutation) OldOverrideEnabled(ctx context.Context) (v bool, err error) {
+func (m *APICallLogMutation) OldCounted(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOverrideEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldCounted is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOverrideEnabled requires an ID field in the mutation")
+		return v, errors.New("OldCounted requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOverrideEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldCounted: %w", err)
 	}
-	return oldValue.OverrideEnabled, nil
+	return oldValue.Counted, nil
 }
 
-// ResetOverrideEnabled resets all changes to the "override_enabled" field.
-func (m *APIQuotaTrackerMutation) ResetOverrideEnabled() {
-	m.override_enabled = nil
+// ResetCounted resets all changes to the "counted" field.
+func (m *APICallLogMutation) ResetCounted() {
+	m.counted = nil
 }
 
-// SetNotificationSent sets the "notification_sent" field.
-func (m *APIQuotaTrackerMutation) SetNotificationSent(b bool) {
-	m.notification_sent = &b
+// SetCreatedAt sets the "created_at" field.
+func (m *APICallLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// NotificationSent returns the value of the "notification_sent" field in the mutation.
-func (m *APIQuotaTrackerMutation) NotificationSent() (r bool, exists bool) {
-	v := m.notification_sent
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APICallLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotificationSent returns the old "notification_sent" field's value of the APIQuotaTracker entity.
-// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APICallLog entity.
+// If the APICallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *APIQuotaTrackerMutation) OldNotificationSent(ctx context.Context) (v bool, err error) {
+func (m *APICallLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotificationSent is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotificationSent requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotificationSent: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.NotificationSent, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetNotificationSent resets all changes to the "notification_sent" field.
-func (m *APIQuotaTrackerMutation) ResetNotificationSent() {
-	m.notification_sent = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APICallLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetLastCallAt sets the "last_call_at" field.
-func (m *APIQuotaTrackerMutation) SetLastCallAt(t time.Time) {
-	m.last_call_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *APICallLogMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// LastCallAt returns the value of the "last_call_at" field in the mutation.
-func (m *APIQuotaTrackerMutation) LastCallAt() (r time.Time, exists bool) {
-	v := m.last_call_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *APICallLogMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastCallAt returns the old "last_call_at" field's value of the APIQuotaTracker entity.
-// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the APICallLog entity.
+// If the APICallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *APIQuotaTrackerMutation) OldLastCallAt(ctx context.Context) (v *time.Time, err error) {
+func (m *APICallLogMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastCallAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastCallAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastCallAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.LastCallAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearLastCallAt clears the value of the "last_call_at" field.
-func (m *APIQuotaTrackerMutation) ClearLastCallAt() {
-	m.last_call_at = nil
-	m.clearedFields[apiquotatracker.FieldLastCallAt] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *APICallLogMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// LastCallAtCleared returns if the "last_call_at" field was cleared in this mutation.
-func (m *APIQuotaTrackerMutation) LastCallAtCleared() bool {
-	_, ok := m.clearedFields[apiquotatracker.FieldLastCallAt]
-	return ok
+// SetJobExecutionID sets the "job_execution" edge to the JobExecutionHistory entity by id.
+func (m *APICallLogMutation) SetJobExecutionID(id ulid.ID) {
+	m.job_execution = &id
 }
 
-// ResetLastCallAt resets all changes to the "last_call_at" field.
-func (m *APIQuotaTrackerMutation) ResetLastCallAt() {
-	m.last_call_at = nil
-	delete(m.clearedFields, apiquotatracker.FieldLastCallAt)
+// ClearJobExecution clears the "job_execution" edge to the JobExecutionHistory entity.
+func (m *APICallLogMutation) ClearJobExecution() {
+	m.clearedjob_execution = true
 }
 
-// Where appends a list predicates to the APIQuotaTrackerMutation builder.
-func (m *APIQuotaTrackerMutation) Where(ps ...predicate.APIQuotaTracker) {
+// JobExecutionCleared reports if the "job_execution" edge to the JobExecutionHistory entity was cleared.
+func (m *APICallLogMutation) JobExecutionCleared() bool {
+	return m.clearedjob_execution
+}
+
+// JobExecutionID returns the "job_execution" edge ID in the mutation.
+func (m *APICallLogMutation) JobExecutionID() (id ulid.ID, exists bool) {
+	if m.job_execution != nil {
+		return *m.job_execution, true
+	}
+	return
+}
+
+// JobExecutionIDs returns the "job_execution" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// JobExecutionID instead. It exists only for internal usage by the builders.
+func (m *APICallLogMutation) JobExecutionIDs() (ids []ulid.ID) {
+	if id := m.job_execution; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetJobExecution resets all changes to the "job_execution" edge.
+func (m *APICallLogMutation) ResetJobExecution() {
+	m.job_execution = nil
+	m.clearedjob_execution = false
+}
+
+// Where appends a list predicates to the APICallLogMutation builder.
+func (m *APICallLogMutation) Where(ps ...predicate.APICallLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the APIQuotaTrackerMutation builder. Using this method,
+// WhereP appends storage-level predicates to the APICallLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *APIQuotaTrackerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.APIQuotaTracker, len(ps))
+func (m *APICallLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APICallLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -643,54 +745,57 @@ func (m *APIQuotaTrackerMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *APIQuotaTrackerMutation) Op() Op {
+func (m *APICallLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *APIQuotaTrackerMutation) SetOp(op Op) {
+func (m *APICallLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (APIQuotaTracker).
-func (m *APIQuotaTrackerMutation) Type() string {
+// Type returns the node type of this mutation (APICallLog).
+func (m *APICallLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *APIQuotaTrackerMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.created_at != nil {
-		fields = append(fields, apiquotatracker.FieldCreatedAt)
+func (m *APICallLogMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.endpoint != nil {
+		fields = append(fields, apicalllog.FieldEndpoint)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, apiquotatracker.FieldUpdatedAt)
+	if m.key_label != nil {
+		fields = append(fields, apicalllog.FieldKeyLabel)
 	}
-	if m.month != nil {
-		fields = append(fields, apiquotatracker.FieldMonth)
+	if m.caller != nil {
+		fields = append(fields, apicalllog.FieldCaller)
 	}
-	if m.year != nil {
-		fields = append(fields, apiquotatracker.FieldYear)
+	if m.run_id != nil {
+		fields = append(fields, apicalllog.FieldRunID)
 	}
-	if m.call_count != nil {
-		fields = append(fields, apiquotatracker.FieldCallCount)
+	if m.urn != nil {
+		fields = append(fields, apicalllog.FieldUrn)
 	}
-	if m.quota_limit != nil {
-		fields = append(fields, apiquotatracker.FieldQuotaLimit)
+	if m.http_status != nil {
+		fields = append(fields, apicalllog.FieldHTTPStatus)
 	}
-	if m.quota_exceeded != nil {
-		fields = append(fields, apiquotatracker.FieldQuotaExceeded)
+	if m.latency_ms != nil {
+		fields = append(fields, apicalllog.FieldLatencyMs)
 	}
-	if m.override_enabled != nil {
-		fields = append(fields, apiquotatracker.FieldOverrideEnabled)
+	if m.bytes != nil {
+		fields = append(fields, apicalllog.FieldBytes)
 	}
-	if m.notification_sent != nil {
-		fields = append(fields, apiquotatracker.FieldNotificationSent)
+	if m.counted != nil {
+		fields = append(fields, apicalllog.FieldCounted)
 	}
-	if m.last_call_at != nil {
-		fields = append(fields, apiquotatracker.FieldLastCallAt)
+	if m.created_at != nil {
+		fields = append(fields, apicalllog.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, apicalllog.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -698,28 +803,30 @@ func (m *APIQuotaTrackerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *APIQuotaTrackerMutation) Field(name string) (ent.Value, bool) {
+func (m *APICallLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case apiquotatracker.FieldCreatedAt:
+	case apicalllog.FieldEndpoint:
+		return m.Endpoint()
+	case apicalllog.FieldKeyLabel:
+		return m.KeyLabel()
+	case apicalllog.FieldCaller:
+		return m.Caller()
+	case apicalllog.FieldRunID:
+		return m.RunID()
+	case apicalllog.FieldUrn:
+		return m.Urn()
+	case apicalllog.FieldHTTPStatus:
+		return m.HTTPStatus()
+	case apicalllog.FieldLatencyMs:
+		return m.LatencyMs()
+	case apicalllog.FieldBytes:
+		return m.Bytes()
+	case apicalllog.FieldCounted:
+		return m.Counted()
+	case apicalllog.FieldCreatedAt:
 		return m.CreatedAt()
-	case apiquotatracker.FieldUpdatedAt:
+	case apicalllog.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case apiquotatracker.FieldMonth:
-		return m.Month()
-	case apiquotatracker.FieldYear:
-		return m.Year()
-	case apiquotatracker.FieldCallCount:
-		return m.CallCount()
-	case apiquotatracker.FieldQuotaLimit:
-		return m.QuotaLimit()
-	case apiquotatracker.FieldQuotaExceeded:
-		return m.QuotaExceeded()
-	case apiquotatracker.FieldOverrideEnabled:
-		return m.OverrideEnabled()
-	case apiquotatracker.FieldNotificationSent:
-		return m.NotificationSent()
-	case apiquotatracker.FieldLastCallAt:
-		return m.LastCallAt()
 	}
 	return nil, false
 }
@@ -727,126 +834,132 @@ func (m *APIQuotaTrackerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *APIQuotaTrackerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APICallLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case apiquotatracker.FieldCreatedAt:
+	case apicalllog.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case apicalllog.FieldKeyLabel:
+		return m.OldKeyLabel(ctx)
+	case apicalllog.FieldCaller:
+		return m.OldCaller(ctx)
+	case apicalllog.FieldRunID:
+		return m.OldRunID(ctx)
+	case apicalllog.FieldUrn:
+		return m.OldUrn(ctx)
+	case apicalllog.FieldHTTPStatus:
+		return m.OldHTTPStatus(ctx)
+	case apicalllog.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case apicalllog.FieldBytes:
+		return m.OldBytes(ctx)
+	case apicalllog.FieldCounted:
+		return m.OldCounted(ctx)
+	case apicalllog.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case apiquotatracker.FieldUpdatedAt:
+	case apicalllog.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case apiquotatracker.FieldMonth:
-		return m.OldMonth(ctx)
-	case apiquotatracker.FieldYear:
-		return m.OldYear(ctx)
-	case apiquotatracker.FieldCallCount:
-		return m.OldCallCount(ctx)
-	case apiquotatracker.FieldQuotaLimit:
-		return m.OldQuotaLimit(ctx)
-	case apiquotatracker.FieldQuotaExceeded:
-		return m.OldQuotaExceeded(ctx)
-	case apiquotatracker.FieldOverrideEnabled:
-		return m.OldOverrideEnabled(ctx)
-	case apiquotatracker.FieldNotificationSent:
-		return m.OldNotificationSent(ctx)
-	case apiquotatracker.FieldLastCallAt:
-		return m.OldLastCallAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown APIQuotaTracker field %s", name)
+	return nil, fmt.Errorf("unknown APICallLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *APIQuotaTrackerMutation) SetField(name string, value ent.Value) error {
+func (m *APICallLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case apiquotatracker.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case apicalllog.FieldEndpoint:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetEndpoint(v)
 		return nil
-	case apiquotatracker.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case apicalllog.FieldKeyLabel:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetKeyLabel(v)
 		return nil
-	case apiquotatracker.FieldMonth:
-		v, ok := value.(int)
+	case apicalllog.FieldCaller:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMonth(v)
+		m.SetCaller(v)
 		return nil
-	case apiquotatracker.FieldYear:
-		v, ok := value.(int)
+	case apicalllog.FieldRunID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetYear(v)
+		m.SetRunID(v)
 		return nil
-	case apiquotatracker.FieldCallCount:
+	case apicalllog.FieldUrn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUrn(v)
+		return nil
+	case apicalllog.FieldHTTPStatus:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCallCount(v)
+		m.SetHTTPStatus(v)
 		return nil
-	case apiquotatracker.FieldQuotaLimit:
+	case apicalllog.FieldLatencyMs:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuotaLimit(v)
+		m.SetLatencyMs(v)
 		return nil
-	case apiquotatracker.FieldQuotaExceeded:
-		v, ok := value.(bool)
+	case apicalllog.FieldBytes:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuotaExceeded(v)
+		m.SetBytes(v)
 		return nil
-	case apiquotatracker.FieldOverrideEnabled:
+	case apicalllog.FieldCounted:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOverrideEnabled(v)
+		m.SetCounted(v)
 		return nil
-	case apiquotatracker.FieldNotificationSent:
-		v, ok := value.(bool)
+	case apicalllog.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNotificationSent(v)
+		m.SetCreatedAt(v)
 		return nil
-	case apiquotatracker.FieldLastCallAt:
+	case apicalllog.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastCallAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown APIQuotaTracker field %s", name)
+	return fmt.Errorf("unknown APICallLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *APIQuotaTrackerMutation) AddedFields() []string {
+func (m *APICallLogMutation) AddedFields() []string {
 	var fields []string
-	if m.addmonth != nil {
-		fields = append(fields, apiquotatracker.FieldMonth)
-	}
-	if m.addyear != nil {
-		fields = append(fields, apiquotatracker.FieldYear)
+	if m.addhttp_status != nil {
+		fields = append(fields, apicalllog.FieldHTTPStatus)
 	}
-	if m.addcall_count != nil {
-		fields = append(fields, apiquotatracker.FieldCallCount)
+	if m.addlatency_ms != nil {
+		fields = append(fields, apicalllog.FieldLatencyMs)
 	}
-	if m.addquota_limit != nil {
-		fields = append(fields, apiquotatracker.FieldQuotaLimit)
+	if m.addbytes != nil {
+		fields = append(fields, apicalllog.FieldBytes)
 	}
 	return fields
 }
@@ -854,16 +967,14 @@ func (m *APIQuotaTrackerMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *APIQuotaTrackerMutation) AddedField(name string) (ent.Value, bool) {
+func (m *APICallLogMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case apiquotatracker.FieldMonth:
-		return m.AddedMonth()
-	case apiquotatracker.FieldYear:
-		return m.AddedYear()
-	case apiquotatracker.FieldCallCount:
-		return m.AddedCallCount()
-	case apiquotatracker.FieldQuotaLimit:
-		return m.AddedQuotaLimit()
+	case apicalllog.FieldHTTPStatus:
+		return m.AddedHTTPStatus()
+	case apicalllog.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	case apicalllog.FieldBytes:
+		return m.AddedBytes()
 	}
 	return nil, false
 }
@@ -871,189 +982,217 @@ func (m *APIQuotaTrackerMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *APIQuotaTrackerMutation) AddField(name string, value ent.Value) error {
+func (m *APICallLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case apiquotatracker.FieldMonth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMonth(v)
-		return nil
-	case apiquotatracker.FieldYear:
+	case apicalllog.FieldHTTPStatus:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddYear(v)
+		m.AddHTTPStatus(v)
 		return nil
-	case apiquotatracker.FieldCallCount:
+	case apicalllog.FieldLatencyMs:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCallCount(v)
+		m.AddLatencyMs(v)
 		return nil
-	case apiquotatracker.FieldQuotaLimit:
+	case apicalllog.FieldBytes:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuotaLimit(v)
+		m.AddBytes(v)
 		return nil
 	}
-	return fmt.Errorf("unknown APIQuotaTracker numeric field %s", name)
+	return fmt.Errorf("unknown APICallLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *APIQuotaTrackerMutation) ClearedFields() []string {
+func (m *APICallLogMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(apiquotatracker.FieldLastCallAt) {
-		fields = append(fields, apiquotatracker.FieldLastCallAt)
+	if m.FieldCleared(apicalllog.FieldRunID) {
+		fields = append(fields, apicalllog.FieldRunID)
+	}
+	if m.FieldCleared(apicalllog.FieldUrn) {
+		fields = append(fields, apicalllog.FieldUrn)
+	}
+	if m.FieldCleared(apicalllog.FieldHTTPStatus) {
+		fields = append(fields, apicalllog.FieldHTTPStatus)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *APIQuotaTrackerMutation) FieldCleared(name string) bool {
+func (m *APICallLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *APIQuotaTrackerMutation) ClearField(name string) error {
+func (m *APICallLogMutation) ClearField(name string) error {
 	switch name {
-	case apiquotatracker.FieldLastCallAt:
-		m.ClearLastCallAt()
+	case apicalllog.FieldRunID:
+		m.ClearRunID()
+		return nil
+	case apicalllog.FieldUrn:
+		m.ClearUrn()
+		return nil
+	case apicalllog.FieldHTTPStatus:
+		m.ClearHTTPStatus()
 		return nil
 	}
-	return fmt.Errorf("unknown APIQuotaTracker nullable field %s", name)
+	return fmt.Errorf("unknown APICallLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *APIQuotaTrackerMutation) ResetField(name string) error {
+func (m *APICallLogMutation) ResetField(name string) error {
 	switch name {
-	case apiquotatracker.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case apicalllog.FieldEndpoint:
+		m.ResetEndpoint()
 		return nil
-	case apiquotatracker.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case apicalllog.FieldKeyLabel:
+		m.ResetKeyLabel()
 		return nil
-	case apiquotatracker.FieldMonth:
-		m.ResetMonth()
+	case apicalllog.FieldCaller:
+		m.ResetCaller()
 		return nil
-	case apiquotatracker.FieldYear:
-		m.ResetYear()
+	case apicalllog.FieldRunID:
+		m.ResetRunID()
 		return nil
-	case apiquotatracker.FieldCallCount:
-		m.ResetCallCount()
+	case apicalllog.FieldUrn:
+		m.ResetUrn()
 		return nil
-	case apiquotatracker.FieldQuotaLimit:
-		m.ResetQuotaLimit()
+	case apicalllog.FieldHTTPStatus:
+		m.ResetHTTPStatus()
 		return nil
-	case apiquotatracker.FieldQuotaExceeded:
-		m.ResetQuotaExceeded()
+	case apicalllog.FieldLatencyMs:
+		m.ResetLatencyMs()
 		return nil
-	case apiquotatracker.FieldOverrideEnabled:
-		m.ResetOverrideEnabled()
+	case apicalllog.FieldBytes:
+		m.ResetBytes()
 		return nil
-	case apiquotatracker.FieldNotificationSent:
-		m.ResetNotificationSent()
+	case apicalllog.FieldCounted:
+		m.ResetCounted()
 		return nil
-	case apiquotatracker.FieldLastCallAt:
-		m.ResetLastCallAt()
+	case apicalllog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case apicalllog.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown APIQuotaTracker field %s", name)
+	return fmt.Errorf("unknown APICallLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *APIQuotaTrackerMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *APICallLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.job_execution != nil {
+		edges = append(edges, apicalllog.EdgeJobExecution)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *APIQuotaTrackerMutation) AddedIDs(name string) []ent.Value {
+func (m *APICallLogMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case apicalllog.EdgeJobExecution:
+		if id := m.job_execution; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *APIQuotaTrackerMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *APICallLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *APIQuotaTrackerMutation) RemovedIDs(name string) []ent.Value {
+func (m *APICallLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *APIQuotaTrackerMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *APICallLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedjob_execution {
+		edges = append(edges, apicalllog.EdgeJobExecution)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *APIQuotaTrackerMutation) EdgeCleared(name string) bool {
+func (m *APICallLogMutation) EdgeCleared(name string) bool {
+	switch name {
+	case apicalllog.EdgeJobExecution:
+		return m.clearedjob_execution
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *APIQuotaTrackerMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown APIQuotaTracker unique edge %s", name)
+func (m *APICallLogMutation) ClearEdge(name string) error {
+	switch name {
+	case apicalllog.EdgeJobExecution:
+		m.ClearJobExecution()
+		return nil
+	}
+	return fmt.Errorf("unknown APICallLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *APIQuotaTrackerMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown APIQuotaTracker edge %s", name)
+func (m *APICallLogMutation) ResetEdge(name string) error {
+	switch name {
+	case apicalllog.EdgeJobExecution:
+		m.ResetJobExecution()
+		return nil
+	}
+	return fmt.Errorf("unknown APICallLog edge %s", name)
 }
 
-// CronJobConfigMutation represents an operation that mutates the CronJobConfig nodes in the graph.
-type CronJobConfigMutation struct {
+// APIQuotaBudgetMutation represents an operation that mutates the APIQuotaBudget nodes in the graph.
+type APIQuotaBudgetMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *ulid.ID
+	name          *string
+	percent       *float64
+	addpercent    *float64
+	description   *string
 	created_at    *time.Time
 	updated_at    *time.Time
-	job_name      *string
-	job_type      *cronjobconfig.JobType
-	schedule      *string
-	enabled       *bool
-	batch_size    *int
-	addbatch_size *int
-	admin_email   *string
-	respect_quota *bool
-	last_run_at   *time.Time
-	next_run_at   *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*CronJobConfig, error)
-	predicates    []predicate.CronJobConfig
+	oldValue      func(context.Context) (*APIQuotaBudget, error)
+	predicates    []predicate.APIQuotaBudget
 }
 
-var _ ent.Mutation = (*CronJobConfigMutation)(nil)
+var _ ent.Mutation = (*APIQuotaBudgetMutation)(nil)
 
-// cronjobconfigOption allows management of the mutation configuration using functional options.
-type cronjobconfigOption func(*CronJobConfigMutation)
+// apiquotabudgetOption allows management of the mutation configuration using functional options.
+type apiquotabudgetOption func(*APIQuotaBudgetMutation)
 
-// newCronJobConfigMutation creates new mutation for the CronJobConfig entity.
-func newCronJobConfigMutation(c config, op Op, opts ...cronjobconfigOption) *CronJobConfigMutation {
-	m := &CronJobConfigMutation{
+// newAPIQuotaBudgetMutation creates new mutation for the APIQuotaBudget entity.
+func newAPIQuotaBudgetMutation(c config, op Op, opts ...apiquotabudgetOption) *APIQuotaBudgetMutation {
+	m := &APIQuotaBudgetMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCronJobConfig,
+		typ:           TypeAPIQuotaBudget,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1062,20 +1201,20 @@ func newCronJobConfigMutation(c config, op Op, opts ...cronjobconfigOption) *Cro
 	return m
 }
 
-// withCronJobConfigID sets the ID field of the mutation.
-func withCronJobConfigID(id ulid.ID) cronjobconfigOption {
-	return func(m *CronJobConfigMutation) {
+// withAPIQuotaBudgetID sets the ID field of the mutation.
+func withAPIQuotaBudgetID(id ulid.ID) apiquotabudgetOption {
+	return func(m *APIQuotaBudgetMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CronJobConfig
+			value *APIQuotaBudget
 		)
-		m.oldValue = func(ctx context.Context) (*CronJobConfig, error) {
+		m.oldValue = func(ctx context.Context) (*APIQuotaBudget, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CronJobConfig.Get(ctx, id)
+					value, err = m.Client().APIQuotaBudget.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1084,10 +1223,10 @@ func withCronJobConfigID(id ulid.ID) cronjobconfigOption {
 	}
 }
 
-// withCronJobConfig sets the old CronJobConfig of the mutation.
-func withCronJobConfig(node *CronJobConfig) cronjobconfigOption {
-	return func(m *CronJobConfigMutation) {
-		m.oldValue = func(context.Context) (*CronJobConfig, error) {
+// withAPIQuotaBudget sets the old APIQuotaBudget of the mutation.
+func withAPIQuotaBudget(node *APIQuotaBudget) apiquotabudgetOption {
+	return func(m *APIQuotaBudgetMutation) {
+		m.oldValue = func(context.Context) (*APIQuotaBudget, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1096,7 +1235,7 @@ func withCronJobConfig(node *CronJobConfig) cronjobconfigOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CronJobConfigMutation) Client() *Client {
+func (m APIQuotaBudgetMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1104,7 +1243,7 @@ func (m CronJobConfigMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CronJobConfigMutation) Tx() (*Tx, error) {
+func (m APIQuotaBudgetMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1114,14 +1253,14 @@ func (m CronJobConfigMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of CronJobConfig entities.
-func (m *CronJobConfigMutation) SetID(id ulid.ID) {
+// operation is only accepted on creation of APIQuotaBudget entities.
+func (m *APIQuotaBudgetMutation) SetID(id ulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CronJobConfigMutation) ID() (id ulid.ID, exists bool) {
+func (m *APIQuotaBudgetMutation) ID() (id ulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1132,7 +1271,7 @@ func (m *CronJobConfigMutation) ID() (id ulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CronJobConfigMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+func (m *APIQuotaBudgetMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1141,3063 +1280,3491 @@ func (m *CronJobConfigMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CronJobConfig.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIQuotaBudget.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CronJobConfigMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetName sets the "name" field.
+func (m *APIQuotaBudgetMutation) SetName(s string) {
+	m.name = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CronJobConfigMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Name returns the value of the "name" field in the mutation.
+func (m *APIQuotaBudgetMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the CronJobConfig entity.
-// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIQuotaBudget entity.
+// If the APIQuotaBudget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobConfigMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIQuotaBudgetMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Name, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CronJobConfigMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetName resets all changes to the "name" field.
+func (m *APIQuotaBudgetMutation) ResetName() {
+	m.name = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CronJobConfigMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetPercent sets the "percent" field.
+func (m *APIQuotaBudgetMutation) SetPercent(f float64) {
+	m.percent = &f
+	m.addpercent = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CronJobConfigMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Percent returns the value of the "percent" field in the mutation.
+func (m *APIQuotaBudgetMutation) Percent() (r float64, exists bool) {
+	v := m.percent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the CronJobConfig entity.
-// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldPercent returns the old "percent" field's value of the APIQuotaBudget entity.
+// If the APIQuotaBudget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobConfigMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIQuotaBudgetMutation) OldPercent(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPercent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPercent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPercent: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CronJobConfigMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.Percent, nil
 }
 
-// SetJobName sets the "job_name" field.
-func (m *CronJobConfigMutation) SetJobName(s string) {
-	m.job_name = &s
+// AddPercent adds f to the "percent" field.
+func (m *APIQuotaBudgetMutation) AddPercent(f float64) {
+	if m.addpercent != nil {
+		*m.addpercent += f
+	} else {
+		m.addpercent = &f
+	}
 }
 
-// JobName returns the value of the "job_name" field in the mutation.
-func (m *CronJobConfigMutation) JobName() (r string, exists bool) {
-	v := m.job_name
+// AddedPercent returns the value that was added to the "percent" field in this mutation.
+func (m *APIQuotaBudgetMutation) AddedPercent() (r float64, exists bool) {
+	v := m.addpercent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJobName returns the old "job_name" field's value of the CronJobConfig entity.
-// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobConfigMutation) OldJobName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
-	}
-	return oldValue.JobName, nil
-}
-
-// ResetJobName resets all changes to the "job_name" field.
-func (m *CronJobConfigMutation) ResetJobName() {
-	m.job_name = nil
+// ResetPercent resets all changes to the "percent" field.
+func (m *APIQuotaBudgetMutation) ResetPercent() {
+	m.percent = nil
+	m.addpercent = nil
 }
 
-// SetJobType sets the "job_type" field.
-func (m *CronJobConfigMutation) SetJobType(ct cronjobconfig.JobType) {
-	m.job_type = &ct
+// SetDescription sets the "description" field.
+func (m *APIQuotaBudgetMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// JobType returns the value of the "job_type" field in the mutation.
-func (m *CronJobConfigMutation) JobType() (r cronjobconfig.JobType, exists bool) {
-	v := m.job_type
+// Description returns the value of the "description" field in the mutation.
+func (m *APIQuotaBudgetMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJobType returns the old "job_type" field's value of the CronJobConfig entity.
-// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the APIQuotaBudget entity.
+// If the APIQuotaBudget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobConfigMutation) OldJobType(ctx context.Context) (v cronjobconfig.JobType, err error) {
+func (m *APIQuotaBudgetMutation) OldDescription(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobType is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobType requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobType: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.JobType, nil
+	return oldValue.Description, nil
 }
 
-// ResetJobType resets all changes to the "job_type" field.
-func (m *CronJobConfigMutation) ResetJobType() {
-	m.job_type = nil
+// ClearDescription clears the value of the "description" field.
+func (m *APIQuotaBudgetMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[apiquotabudget.FieldDescription] = struct{}{}
 }
 
-// SetSchedule sets the "schedule" field.
-func (m *CronJobConfigMutation) SetSchedule(s string) {
-	m.schedule = &s
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *APIQuotaBudgetMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[apiquotabudget.FieldDescription]
+	return ok
 }
 
-// Schedule returns the value of the "schedule" field in the mutation.
-func (m *CronJobConfigMutation) Schedule() (r string, exists bool) {
-	v := m.schedule
+// ResetDescription resets all changes to the "description" field.
+func (m *APIQuotaBudgetMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, apiquotabudget.FieldDescription)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *APIQuotaBudgetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APIQuotaBudgetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSchedule returns the old "schedule" field's value of the CronJobConfig entity.
-// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIQuotaBudget entity.
+// If the APIQuotaBudget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobConfigMutation) OldSchedule(ctx context.Context) (v string, err error) {
+func (m *APIQuotaBudgetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSchedule requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Schedule, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSchedule resets all changes to the "schedule" field.
-func (m *CronJobConfigMutation) ResetSchedule() {
-	m.schedule = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APIQuotaBudgetMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *CronJobConfigMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *APIQuotaBudgetMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *CronJobConfigMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *APIQuotaBudgetMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the CronJobConfig entity.
-// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the APIQuotaBudget entity.
+// If the APIQuotaBudget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobConfigMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *APIQuotaBudgetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *CronJobConfigMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *APIQuotaBudgetMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetBatchSize sets the "batch_size" field.
-func (m *CronJobConfigMutation) SetBatchSize(i int) {
-	m.batch_size = &i
-	m.addbatch_size = nil
+// Where appends a list predicates to the APIQuotaBudgetMutation builder.
+func (m *APIQuotaBudgetMutation) Where(ps ...predicate.APIQuotaBudget) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// BatchSize returns the value of the "batch_size" field in the mutation.
-func (m *CronJobConfigMutation) BatchSize() (r int, exists bool) {
-	v := m.batch_size
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the APIQuotaBudgetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APIQuotaBudgetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIQuotaBudget, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldBatchSize returns the old "batch_size" field's value of the CronJobConfig entity.
-// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobConfigMutation) OldBatchSize(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBatchSize is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBatchSize requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBatchSize: %w", err)
-	}
-	return oldValue.BatchSize, nil
+// Op returns the operation name.
+func (m *APIQuotaBudgetMutation) Op() Op {
+	return m.op
 }
 
-// AddBatchSize adds i to the "batch_size" field.
-func (m *CronJobConfigMutation) AddBatchSize(i int) {
-	if m.addbatch_size != nil {
-		*m.addbatch_size += i
-	} else {
-		m.addbatch_size = &i
-	}
-}
-
-// AddedBatchSize returns the value that was added to the "batch_size" field in this mutation.
-func (m *CronJobConfigMutation) AddedBatchSize() (r int, exists bool) {
-	v := m.addbatch_size
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetBatchSize resets all changes to the "batch_size" field.
-func (m *CronJobConfigMutation) ResetBatchSize() {
-	m.batch_size = nil
-	m.addbatch_size = nil
+// SetOp allows setting the mutation operation.
+func (m *APIQuotaBudgetMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetAdminEmail sets the "admin_email" field.
-func (m *CronJobConfigMutation) SetAdminEmail(s string) {
-	m.admin_email = &s
+// Type returns the node type of this mutation (APIQuotaBudget).
+func (m *APIQuotaBudgetMutation) Type() string {
+	return m.typ
 }
 
-// AdminEmail returns the value of the "admin_email" field in the mutation.
-func (m *CronJobConfigMutation) AdminEmail() (r string, exists bool) {
-	v := m.admin_email
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *APIQuotaBudgetMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, apiquotabudget.FieldName)
 	}
-	return *v, true
-}
-
-// OldAdminEmail returns the old "admin_email" field's value of the CronJobConfig entity.
-// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobConfigMutation) OldAdminEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAdminEmail is only allowed on UpdateOne operations")
+	if m.percent != nil {
+		fields = append(fields, apiquotabudget.FieldPercent)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAdminEmail requires an ID field in the mutation")
+	if m.description != nil {
+		fields = append(fields, apiquotabudget.FieldDescription)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAdminEmail: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, apiquotabudget.FieldCreatedAt)
 	}
-	return oldValue.AdminEmail, nil
+	if m.updated_at != nil {
+		fields = append(fields, apiquotabudget.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ResetAdminEmail resets all changes to the "admin_email" field.
-func (m *CronJobConfigMutation) ResetAdminEmail() {
-	m.admin_email = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *APIQuotaBudgetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case apiquotabudget.FieldName:
+		return m.Name()
+	case apiquotabudget.FieldPercent:
+		return m.Percent()
+	case apiquotabudget.FieldDescription:
+		return m.Description()
+	case apiquotabudget.FieldCreatedAt:
+		return m.CreatedAt()
+	case apiquotabudget.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// SetRespectQuota sets the "respect_quota" field.
-func (m *CronJobConfigMutation) SetRespectQuota(b bool) {
-	m.respect_quota = &b
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *APIQuotaBudgetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case apiquotabudget.FieldName:
+		return m.OldName(ctx)
+	case apiquotabudget.FieldPercent:
+		return m.OldPercent(ctx)
+	case apiquotabudget.FieldDescription:
+		return m.OldDescription(ctx)
+	case apiquotabudget.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case apiquotabudget.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown APIQuotaBudget field %s", name)
 }
 
-// RespectQuota returns the value of the "respect_quota" field in the mutation.
-func (m *CronJobConfigMutation) RespectQuota() (r bool, exists bool) {
-	v := m.respect_quota
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIQuotaBudgetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case apiquotabudget.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case apiquotabudget.FieldPercent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPercent(v)
+		return nil
+	case apiquotabudget.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case apiquotabudget.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case apiquotabudget.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown APIQuotaBudget field %s", name)
 }
 
-// OldRespectQuota returns the old "respect_quota" field's value of the CronJobConfig entity.
-// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobConfigMutation) OldRespectQuota(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRespectQuota is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *APIQuotaBudgetMutation) AddedFields() []string {
+	var fields []string
+	if m.addpercent != nil {
+		fields = append(fields, apiquotabudget.FieldPercent)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRespectQuota requires an ID field in the mutation")
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *APIQuotaBudgetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case apiquotabudget.FieldPercent:
+		return m.AddedPercent()
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRespectQuota: %w", err)
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIQuotaBudgetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case apiquotabudget.FieldPercent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPercent(v)
+		return nil
 	}
-	return oldValue.RespectQuota, nil
+	return fmt.Errorf("unknown APIQuotaBudget numeric field %s", name)
 }
 
-// ResetRespectQuota resets all changes to the "respect_quota" field.
-func (m *CronJobConfigMutation) ResetRespectQuota() {
-	m.respect_quota = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *APIQuotaBudgetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(apiquotabudget.FieldDescription) {
+		fields = append(fields, apiquotabudget.FieldDescription)
+	}
+	return fields
 }
 
-// SetLastRunAt sets the "last_run_at" field.
-func (m *CronJobConfigMutation) SetLastRunAt(t time.Time) {
-	m.last_run_at = &t
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *APIQuotaBudgetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// LastRunAt returns the value of the "last_run_at" field in the mutation.
-func (m *CronJobConfigMutation) LastRunAt() (r time.Time, exists bool) {
-	v := m.last_run_at
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *APIQuotaBudgetMutation) ClearField(name string) error {
+	switch name {
+	case apiquotabudget.FieldDescription:
+		m.ClearDescription()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown APIQuotaBudget nullable field %s", name)
 }
 
-// OldLastRunAt returns the old "last_run_at" field's value of the CronJobConfig entity.
-// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobConfigMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *APIQuotaBudgetMutation) ResetField(name string) error {
+	switch name {
+	case apiquotabudget.FieldName:
+		m.ResetName()
+		return nil
+	case apiquotabudget.FieldPercent:
+		m.ResetPercent()
+		return nil
+	case apiquotabudget.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case apiquotabudget.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case apiquotabudget.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.LastRunAt, nil
+	return fmt.Errorf("unknown APIQuotaBudget field %s", name)
 }
 
-// ClearLastRunAt clears the value of the "last_run_at" field.
-func (m *CronJobConfigMutation) ClearLastRunAt() {
-	m.last_run_at = nil
-	m.clearedFields[cronjobconfig.FieldLastRunAt] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *APIQuotaBudgetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
-func (m *CronJobConfigMutation) LastRunAtCleared() bool {
-	_, ok := m.clearedFields[cronjobconfig.FieldLastRunAt]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *APIQuotaBudgetMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetLastRunAt resets all changes to the "last_run_at" field.
-func (m *CronJobConfigMutation) ResetLastRunAt() {
-	m.last_run_at = nil
-	delete(m.clearedFields, cronjobconfig.FieldLastRunAt)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *APIQuotaBudgetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetNextRunAt sets the "next_run_at" field.
-func (m *CronJobConfigMutation) SetNextRunAt(t time.Time) {
-	m.next_run_at = &t
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *APIQuotaBudgetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// NextRunAt returns the value of the "next_run_at" field in the mutation.
-func (m *CronJobConfigMutation) NextRunAt() (r time.Time, exists bool) {
-	v := m.next_run_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *APIQuotaBudgetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldNextRunAt returns the old "next_run_at" field's value of the CronJobConfig entity.
-// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobConfigMutation) OldNextRunAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
-	}
-	return oldValue.NextRunAt, nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *APIQuotaBudgetMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ClearNextRunAt clears the value of the "next_run_at" field.
-func (m *CronJobConfigMutation) ClearNextRunAt() {
-	m.next_run_at = nil
-	m.clearedFields[cronjobconfig.FieldNextRunAt] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *APIQuotaBudgetMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown APIQuotaBudget unique edge %s", name)
 }
 
-// NextRunAtCleared returns if the "next_run_at" field was cleared in this mutation.
-func (m *CronJobConfigMutation) NextRunAtCleared() bool {
-	_, ok := m.clearedFields[cronjobconfig.FieldNextRunAt]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *APIQuotaBudgetMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown APIQuotaBudget edge %s", name)
 }
 
-// ResetNextRunAt resets all changes to the "next_run_at" field.
-func (m *CronJobConfigMutation) ResetNextRunAt() {
-	m.next_run_at = nil
-	delete(m.clearedFields, cronjobconfig.FieldNextRunAt)
+// APIQuotaReservationMutation represents an operation that mutates the APIQuotaReservation nodes in the graph.
+type APIQuotaReservationMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *ulid.ID
+	reserved              *int
+	addreserved           *int
+	used                  *int
+	addused               *int
+	status                *apiquotareservation.Status
+	expires_at            *time.Time
+	settled_at            *time.Time
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	tracker               *ulid.ID
+	clearedtracker        bool
+	budget_tracker        *ulid.ID
+	clearedbudget_tracker bool
+	done                  bool
+	oldValue              func(context.Context) (*APIQuotaReservation, error)
+	predicates            []predicate.APIQuotaReservation
 }
 
-// Where appends a list predicates to the CronJobConfigMutation builder.
-func (m *CronJobConfigMutation) Where(ps ...predicate.CronJobConfig) {
-	m.predicates = append(m.predicates, ps...)
+var _ ent.Mutation = (*APIQuotaReservationMutation)(nil)
+
+// apiquotareservationOption allows management of the mutation configuration using functional options.
+type apiquotareservationOption func(*APIQuotaReservationMutation)
+
+// newAPIQuotaReservationMutation creates new mutation for the APIQuotaReservation entity.
+func newAPIQuotaReservationMutation(c config, op Op, opts ...apiquotareservationOption) *APIQuotaReservationMutation {
+	m := &APIQuotaReservationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAPIQuotaReservation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// WhereP appends storage-level predicates to the CronJobConfigMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CronJobConfigMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CronJobConfig, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// withAPIQuotaReservationID sets the ID field of the mutation.
+func withAPIQuotaReservationID(id ulid.ID) apiquotareservationOption {
+	return func(m *APIQuotaReservationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *APIQuotaReservation
+		)
+		m.oldValue = func(ctx context.Context) (*APIQuotaReservation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().APIQuotaReservation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *CronJobConfigMutation) Op() Op {
-	return m.op
+// withAPIQuotaReservation sets the old APIQuotaReservation of the mutation.
+func withAPIQuotaReservation(node *APIQuotaReservation) apiquotareservationOption {
+	return func(m *APIQuotaReservationMutation) {
+		m.oldValue = func(context.Context) (*APIQuotaReservation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CronJobConfigMutation) SetOp(op Op) {
-	m.op = op
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m APIQuotaReservationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Type returns the node type of this mutation (CronJobConfig).
-func (m *CronJobConfigMutation) Type() string {
-	return m.typ
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m APIQuotaReservationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CronJobConfigMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.created_at != nil {
-		fields = append(fields, cronjobconfig.FieldCreatedAt)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of APIQuotaReservation entities.
+func (m *APIQuotaReservationMutation) SetID(id ulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *APIQuotaReservationMutation) ID() (id ulid.ID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	if m.updated_at != nil {
-		fields = append(fields, cronjobconfig.FieldUpdatedAt)
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *APIQuotaReservationMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []ulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().APIQuotaReservation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	if m.job_name != nil {
-		fields = append(fields, cronjobconfig.FieldJobName)
+}
+
+// SetReserved sets the "reserved" field.
+func (m *APIQuotaReservationMutation) SetReserved(i int) {
+	m.reserved = &i
+	m.addreserved = nil
+}
+
+// Reserved returns the value of the "reserved" field in the mutation.
+func (m *APIQuotaReservationMutation) Reserved() (r int, exists bool) {
+	v := m.reserved
+	if v == nil {
+		return
 	}
-	if m.job_type != nil {
-		fields = append(fields, cronjobconfig.FieldJobType)
+	return *v, true
+}
+
+// OldReserved returns the old "reserved" field's value of the APIQuotaReservation entity.
+// If the APIQuotaReservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaReservationMutation) OldReserved(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReserved is only allowed on UpdateOne operations")
 	}
-	if m.schedule != nil {
-		fields = append(fields, cronjobconfig.FieldSchedule)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReserved requires an ID field in the mutation")
 	}
-	if m.enabled != nil {
-		fields = append(fields, cronjobconfig.FieldEnabled)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReserved: %w", err)
 	}
-	if m.batch_size != nil {
-		fields = append(fields, cronjobconfig.FieldBatchSize)
+	return oldValue.Reserved, nil
+}
+
+// AddReserved adds i to the "reserved" field.
+func (m *APIQuotaReservationMutation) AddReserved(i int) {
+	if m.addreserved != nil {
+		*m.addreserved += i
+	} else {
+		m.addreserved = &i
 	}
-	if m.admin_email != nil {
-		fields = append(fields, cronjobconfig.FieldAdminEmail)
+}
+
+// AddedReserved returns the value that was added to the "reserved" field in this mutation.
+func (m *APIQuotaReservationMutation) AddedReserved() (r int, exists bool) {
+	v := m.addreserved
+	if v == nil {
+		return
 	}
-	if m.respect_quota != nil {
-		fields = append(fields, cronjobconfig.FieldRespectQuota)
+	return *v, true
+}
+
+// ResetReserved resets all changes to the "reserved" field.
+func (m *APIQuotaReservationMutation) ResetReserved() {
+	m.reserved = nil
+	m.addreserved = nil
+}
+
+// SetUsed sets the "used" field.
+func (m *APIQuotaReservationMutation) SetUsed(i int) {
+	m.used = &i
+	m.addused = nil
+}
+
+// Used returns the value of the "used" field in the mutation.
+func (m *APIQuotaReservationMutation) Used() (r int, exists bool) {
+	v := m.used
+	if v == nil {
+		return
 	}
-	if m.last_run_at != nil {
-		fields = append(fields, cronjobconfig.FieldLastRunAt)
+	return *v, true
+}
+
+// OldUsed returns the old "used" field's value of the APIQuotaReservation entity.
+// If the APIQuotaReservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaReservationMutation) OldUsed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsed is only allowed on UpdateOne operations")
 	}
-	if m.next_run_at != nil {
-		fields = append(fields, cronjobconfig.FieldNextRunAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsed requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsed: %w", err)
+	}
+	return oldValue.Used, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CronJobConfigMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case cronjobconfig.FieldCreatedAt:
-		return m.CreatedAt()
-	case cronjobconfig.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case cronjobconfig.FieldJobName:
-		return m.JobName()
-	case cronjobconfig.FieldJobType:
-		return m.JobType()
-	case cronjobconfig.FieldSchedule:
-		return m.Schedule()
-	case cronjobconfig.FieldEnabled:
-		return m.Enabled()
-	case cronjobconfig.FieldBatchSize:
-		return m.BatchSize()
-	case cronjobconfig.FieldAdminEmail:
-		return m.AdminEmail()
-	case cronjobconfig.FieldRespectQuota:
-		return m.RespectQuota()
-	case cronjobconfig.FieldLastRunAt:
-		return m.LastRunAt()
-	case cronjobconfig.FieldNextRunAt:
-		return m.NextRunAt()
+// AddUsed adds i to the "used" field.
+func (m *APIQuotaReservationMutation) AddUsed(i int) {
+	if m.addused != nil {
+		*m.addused += i
+	} else {
+		m.addused = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CronJobConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case cronjobconfig.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case cronjobconfig.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case cronjobconfig.FieldJobName:
-		return m.OldJobName(ctx)
-	case cronjobconfig.FieldJobType:
-		return m.OldJobType(ctx)
-	case cronjobconfig.FieldSchedule:
-		return m.OldSchedule(ctx)
-	case cronjobconfig.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case cronjobconfig.FieldBatchSize:
-		return m.OldBatchSize(ctx)
-	case cronjobconfig.FieldAdminEmail:
-		return m.OldAdminEmail(ctx)
-	case cronjobconfig.FieldRespectQuota:
-		return m.OldRespectQuota(ctx)
-	case cronjobconfig.FieldLastRunAt:
-		return m.OldLastRunAt(ctx)
-	case cronjobconfig.FieldNextRunAt:
-		return m.OldNextRunAt(ctx)
+// AddedUsed returns the value that was added to the "used" field in this mutation.
+func (m *APIQuotaReservationMutation) AddedUsed() (r int, exists bool) {
+	v := m.addused
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown CronJobConfig field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CronJobConfigMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case cronjobconfig.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case cronjobconfig.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case cronjobconfig.FieldJobName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetJobName(v)
-		return nil
-	case cronjobconfig.FieldJobType:
-		v, ok := value.(cronjobconfig.JobType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetJobType(v)
-		return nil
-	case cronjobconfig.FieldSchedule:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSchedule(v)
-		return nil
-	case cronjobconfig.FieldEnabled:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnabled(v)
-		return nil
-	case cronjobconfig.FieldBatchSize:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBatchSize(v)
-		return nil
-	case cronjobconfig.FieldAdminEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAdminEmail(v)
-		return nil
-	case cronjobconfig.FieldRespectQuota:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRespectQuota(v)
-		return nil
-	case cronjobconfig.FieldLastRunAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastRunAt(v)
-		return nil
-	case cronjobconfig.FieldNextRunAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNextRunAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown CronJobConfig field %s", name)
+// ResetUsed resets all changes to the "used" field.
+func (m *APIQuotaReservationMutation) ResetUsed() {
+	m.used = nil
+	m.addused = nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *CronJobConfigMutation) AddedFields() []string {
-	var fields []string
-	if m.addbatch_size != nil {
-		fields = append(fields, cronjobconfig.FieldBatchSize)
+// SetStatus sets the "status" field.
+func (m *APIQuotaReservationMutation) SetStatus(a apiquotareservation.Status) {
+	m.status = &a
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *APIQuotaReservationMutation) Status() (r apiquotareservation.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *CronJobConfigMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case cronjobconfig.FieldBatchSize:
-		return m.AddedBatchSize()
+// OldStatus returns the old "status" field's value of the APIQuotaReservation entity.
+// If the APIQuotaReservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaReservationMutation) OldStatus(ctx context.Context) (v apiquotareservation.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CronJobConfigMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case cronjobconfig.FieldBatchSize:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBatchSize(v)
-		return nil
+// ResetStatus resets all changes to the "status" field.
+func (m *APIQuotaReservationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *APIQuotaReservationMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *APIQuotaReservationMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown CronJobConfig numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *CronJobConfigMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(cronjobconfig.FieldLastRunAt) {
-		fields = append(fields, cronjobconfig.FieldLastRunAt)
+// OldExpiresAt returns the old "expires_at" field's value of the APIQuotaReservation entity.
+// If the APIQuotaReservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaReservationMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(cronjobconfig.FieldNextRunAt) {
-		fields = append(fields, cronjobconfig.FieldNextRunAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *CronJobConfigMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *APIQuotaReservationMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *CronJobConfigMutation) ClearField(name string) error {
-	switch name {
-	case cronjobconfig.FieldLastRunAt:
-		m.ClearLastRunAt()
-		return nil
-	case cronjobconfig.FieldNextRunAt:
-		m.ClearNextRunAt()
-		return nil
+// SetSettledAt sets the "settled_at" field.
+func (m *APIQuotaReservationMutation) SetSettledAt(t time.Time) {
+	m.settled_at = &t
+}
+
+// SettledAt returns the value of the "settled_at" field in the mutation.
+func (m *APIQuotaReservationMutation) SettledAt() (r time.Time, exists bool) {
+	v := m.settled_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown CronJobConfig nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *CronJobConfigMutation) ResetField(name string) error {
-	switch name {
-	case cronjobconfig.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case cronjobconfig.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case cronjobconfig.FieldJobName:
-		m.ResetJobName()
-		return nil
-	case cronjobconfig.FieldJobType:
-		m.ResetJobType()
-		return nil
-	case cronjobconfig.FieldSchedule:
-		m.ResetSchedule()
-		return nil
-	case cronjobconfig.FieldEnabled:
-		m.ResetEnabled()
-		return nil
-	case cronjobconfig.FieldBatchSize:
-		m.ResetBatchSize()
-		return nil
-	case cronjobconfig.FieldAdminEmail:
-		m.ResetAdminEmail()
-		return nil
-	case cronjobconfig.FieldRespectQuota:
-		m.ResetRespectQuota()
-		return nil
-	case cronjobconfig.FieldLastRunAt:
-		m.ResetLastRunAt()
-		return nil
-	case cronjobconfig.FieldNextRunAt:
-		m.ResetNextRunAt()
-		return nil
+// OldSettledAt returns the old "settled_at" field's value of the APIQuotaReservation entity.
+// If the APIQuotaReservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaReservationMutation) OldSettledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettledAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown CronJobConfig field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettledAt: %w", err)
+	}
+	return oldValue.SettledAt, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CronJobConfigMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearSettledAt clears the value of the "settled_at" field.
+func (m *APIQuotaReservationMutation) ClearSettledAt() {
+	m.settled_at = nil
+	m.clearedFields[apiquotareservation.FieldSettledAt] = struct{}{}
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *CronJobConfigMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// SettledAtCleared returns if the "settled_at" field was cleared in this mutation.
+func (m *APIQuotaReservationMutation) SettledAtCleared() bool {
+	_, ok := m.clearedFields[apiquotareservation.FieldSettledAt]
+	return ok
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CronJobConfigMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetSettledAt resets all changes to the "settled_at" field.
+func (m *APIQuotaReservationMutation) ResetSettledAt() {
+	m.settled_at = nil
+	delete(m.clearedFields, apiquotareservation.FieldSettledAt)
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *CronJobConfigMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetCreatedAt sets the "created_at" field.
+func (m *APIQuotaReservationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CronJobConfigMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APIQuotaReservationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *CronJobConfigMutation) EdgeCleared(name string) bool {
-	return false
+// OldCreatedAt returns the old "created_at" field's value of the APIQuotaReservation entity.
+// If the APIQuotaReservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaReservationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *CronJobConfigMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown CronJobConfig unique edge %s", name)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APIQuotaReservationMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *CronJobConfigMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown CronJobConfig edge %s", name)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *APIQuotaReservationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// JobExecutionHistoryMutation represents an operation that mutates the JobExecutionHistory nodes in the graph.
-type JobExecutionHistoryMutation struct {
-	config
-	op                     Op
-	typ                    string
-	id                     *ulid.ID
-	created_at             *time.Time
-	updated_at             *time.Time
-	job_name               *string
-	status                 *jobexecutionhistory.Status
-	started_at             *time.Time
-	completed_at           *time.Time
-	duration_seconds       *int
-	addduration_seconds    *int
-	total_processed        *int
-	addtotal_processed     *int
-	successful_count       *int
-	addsuccessful_count    *int
-	failed_count           *int
-	addfailed_count        *int
-	api_calls_made         *int
-	addapi_calls_made      *int
-	quota_remaining        *int
-	addquota_remaining     *int
-	error_summary          *string
-	clearedFields          map[string]struct{}
-	profile_entries        map[ulid.ID]struct{}
-	removedprofile_entries map[ulid.ID]struct{}
-	clearedprofile_entries bool
-	done                   bool
-	oldValue               func(context.Context) (*JobExecutionHistory, error)
-	predicates             []predicate.JobExecutionHistory
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *APIQuotaReservationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-var _ ent.Mutation = (*JobExecutionHistoryMutation)(nil)
-
-// jobexecutionhistoryOption allows management of the mutation configuration using functional options.
-type jobexecutionhistoryOption func(*JobExecutionHistoryMutation)
-
-// newJobExecutionHistoryMutation creates new mutation for the JobExecutionHistory entity.
-func newJobExecutionHistoryMutation(c config, op Op, opts ...jobexecutionhistoryOption) *JobExecutionHistoryMutation {
-	m := &JobExecutionHistoryMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeJobExecutionHistory,
-		clearedFields: make(map[string]struct{}),
+// OldUpdatedAt returns the old "updated_at" field's value of the APIQuotaReservation entity.
+// If the APIQuotaReservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaReservationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return m
-}
-
-// withJobExecutionHistoryID sets the ID field of the mutation.
-func withJobExecutionHistoryID(id ulid.ID) jobexecutionhistoryOption {
-	return func(m *JobExecutionHistoryMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *JobExecutionHistory
-		)
-		m.oldValue = func(ctx context.Context) (*JobExecutionHistory, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().JobExecutionHistory.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
+	return oldValue.UpdatedAt, nil
 }
 
-// withJobExecutionHistory sets the old JobExecutionHistory of the mutation.
-func withJobExecutionHistory(node *JobExecutionHistory) jobexecutionhistoryOption {
-	return func(m *JobExecutionHistoryMutation) {
-		m.oldValue = func(context.Context) (*JobExecutionHistory, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *APIQuotaReservationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m JobExecutionHistoryMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// SetTrackerID sets the "tracker" edge to the APIQuotaTracker entity by id.
+func (m *APIQuotaReservationMutation) SetTrackerID(id ulid.ID) {
+	m.tracker = &id
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m JobExecutionHistoryMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearTracker clears the "tracker" edge to the APIQuotaTracker entity.
+func (m *APIQuotaReservationMutation) ClearTracker() {
+	m.clearedtracker = true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of JobExecutionHistory entities.
-func (m *JobExecutionHistoryMutation) SetID(id ulid.ID) {
-	m.id = &id
+// TrackerCleared reports if the "tracker" edge to the APIQuotaTracker entity was cleared.
+func (m *APIQuotaReservationMutation) TrackerCleared() bool {
+	return m.clearedtracker
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *JobExecutionHistoryMutation) ID() (id ulid.ID, exists bool) {
-	if m.id == nil {
-		return
+// TrackerID returns the "tracker" edge ID in the mutation.
+func (m *APIQuotaReservationMutation) TrackerID() (id ulid.ID, exists bool) {
+	if m.tracker != nil {
+		return *m.tracker, true
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *JobExecutionHistoryMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []ulid.ID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().JobExecutionHistory.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// TrackerIDs returns the "tracker" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TrackerID instead. It exists only for internal usage by the builders.
+func (m *APIQuotaReservationMutation) TrackerIDs() (ids []ulid.ID) {
+	if id := m.tracker; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *JobExecutionHistoryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *JobExecutionHistoryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetTracker resets all changes to the "tracker" edge.
+func (m *APIQuotaReservationMutation) ResetTracker() {
+	m.tracker = nil
+	m.clearedtracker = false
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
+// SetBudgetTrackerID sets the "budget_tracker" edge to the APIQuotaTracker entity by id.
+func (m *APIQuotaReservationMutation) SetBudgetTrackerID(id ulid.ID) {
+	m.budget_tracker = &id
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *JobExecutionHistoryMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearBudgetTracker clears the "budget_tracker" edge to the APIQuotaTracker entity.
+func (m *APIQuotaReservationMutation) ClearBudgetTracker() {
+	m.clearedbudget_tracker = true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *JobExecutionHistoryMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// BudgetTrackerCleared reports if the "budget_tracker" edge to the APIQuotaTracker entity was cleared.
+func (m *APIQuotaReservationMutation) BudgetTrackerCleared() bool {
+	return m.clearedbudget_tracker
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *JobExecutionHistoryMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// BudgetTrackerID returns the "budget_tracker" edge ID in the mutation.
+func (m *APIQuotaReservationMutation) BudgetTrackerID() (id ulid.ID, exists bool) {
+	if m.budget_tracker != nil {
+		return *m.budget_tracker, true
 	}
-	return *v, true
+	return
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// BudgetTrackerIDs returns the "budget_tracker" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BudgetTrackerID instead. It exists only for internal usage by the builders.
+func (m *APIQuotaReservationMutation) BudgetTrackerIDs() (ids []ulid.ID) {
+	if id := m.budget_tracker; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *JobExecutionHistoryMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetBudgetTracker resets all changes to the "budget_tracker" edge.
+func (m *APIQuotaReservationMutation) ResetBudgetTracker() {
+	m.budget_tracker = nil
+	m.clearedbudget_tracker = false
 }
 
-// SetJobName sets the "job_name" field.
-func (m *JobExecutionHistoryMutation) SetJobName(s string) {
-	m.job_name = &s
+// Where appends a list predicates to the APIQuotaReservationMutation builder.
+func (m *APIQuotaReservationMutation) Where(ps ...predicate.APIQuotaReservation) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// JobName returns the value of the "job_name" field in the mutation.
-func (m *JobExecutionHistoryMutation) JobName() (r string, exists bool) {
-	v := m.job_name
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the APIQuotaReservationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APIQuotaReservationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIQuotaReservation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldJobName returns the old "job_name" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldJobName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
-	}
-	return oldValue.JobName, nil
+// Op returns the operation name.
+func (m *APIQuotaReservationMutation) Op() Op {
+	return m.op
 }
 
-// ResetJobName resets all changes to the "job_name" field.
-func (m *JobExecutionHistoryMutation) ResetJobName() {
-	m.job_name = nil
+// SetOp allows setting the mutation operation.
+func (m *APIQuotaReservationMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetStatus sets the "status" field.
-func (m *JobExecutionHistoryMutation) SetStatus(j jobexecutionhistory.Status) {
-	m.status = &j
+// Type returns the node type of this mutation (APIQuotaReservation).
+func (m *APIQuotaReservationMutation) Type() string {
+	return m.typ
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *JobExecutionHistoryMutation) Status() (r jobexecutionhistory.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *APIQuotaReservationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.reserved != nil {
+		fields = append(fields, apiquotareservation.FieldReserved)
 	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldStatus(ctx context.Context) (v jobexecutionhistory.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	if m.used != nil {
+		fields = append(fields, apiquotareservation.FieldUsed)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+	if m.status != nil {
+		fields = append(fields, apiquotareservation.FieldStatus)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	if m.expires_at != nil {
+		fields = append(fields, apiquotareservation.FieldExpiresAt)
 	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *JobExecutionHistoryMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetStartedAt sets the "started_at" field.
-func (m *JobExecutionHistoryMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
-}
-
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *JobExecutionHistoryMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStartedAt returns the old "started_at" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	if m.settled_at != nil {
+		fields = append(fields, apiquotareservation.FieldSettledAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	if m.created_at != nil {
+		fields = append(fields, apiquotareservation.FieldCreatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	if m.updated_at != nil {
+		fields = append(fields, apiquotareservation.FieldUpdatedAt)
 	}
-	return oldValue.StartedAt, nil
+	return fields
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *JobExecutionHistoryMutation) ResetStartedAt() {
-	m.started_at = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *APIQuotaReservationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case apiquotareservation.FieldReserved:
+		return m.Reserved()
+	case apiquotareservation.FieldUsed:
+		return m.Used()
+	case apiquotareservation.FieldStatus:
+		return m.Status()
+	case apiquotareservation.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apiquotareservation.FieldSettledAt:
+		return m.SettledAt()
+	case apiquotareservation.FieldCreatedAt:
+		return m.CreatedAt()
+	case apiquotareservation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *JobExecutionHistoryMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *APIQuotaReservationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case apiquotareservation.FieldReserved:
+		return m.OldReserved(ctx)
+	case apiquotareservation.FieldUsed:
+		return m.OldUsed(ctx)
+	case apiquotareservation.FieldStatus:
+		return m.OldStatus(ctx)
+	case apiquotareservation.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apiquotareservation.FieldSettledAt:
+		return m.OldSettledAt(ctx)
+	case apiquotareservation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case apiquotareservation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown APIQuotaReservation field %s", name)
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *JobExecutionHistoryMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIQuotaReservationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case apiquotareservation.FieldReserved:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReserved(v)
+		return nil
+	case apiquotareservation.FieldUsed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsed(v)
+		return nil
+	case apiquotareservation.FieldStatus:
+		v, ok := value.(apiquotareservation.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case apiquotareservation.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case apiquotareservation.FieldSettledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettledAt(v)
+		return nil
+	case apiquotareservation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case apiquotareservation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown APIQuotaReservation field %s", name)
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *APIQuotaReservationMutation) AddedFields() []string {
+	var fields []string
+	if m.addreserved != nil {
+		fields = append(fields, apiquotareservation.FieldReserved)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	if m.addused != nil {
+		fields = append(fields, apiquotareservation.FieldUsed)
 	}
-	return oldValue.CompletedAt, nil
+	return fields
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *JobExecutionHistoryMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[jobexecutionhistory.FieldCompletedAt] = struct{}{}
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *APIQuotaReservationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case apiquotareservation.FieldReserved:
+		return m.AddedReserved()
+	case apiquotareservation.FieldUsed:
+		return m.AddedUsed()
+	}
+	return nil, false
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *JobExecutionHistoryMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[jobexecutionhistory.FieldCompletedAt]
-	return ok
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIQuotaReservationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case apiquotareservation.FieldReserved:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReserved(v)
+		return nil
+	case apiquotareservation.FieldUsed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUsed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown APIQuotaReservation numeric field %s", name)
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *JobExecutionHistoryMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, jobexecutionhistory.FieldCompletedAt)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *APIQuotaReservationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(apiquotareservation.FieldSettledAt) {
+		fields = append(fields, apiquotareservation.FieldSettledAt)
+	}
+	return fields
 }
 
-// SetDurationSeconds sets the "duration_seconds" field.
-func (m *JobExecutionHistoryMutation) SetDurationSeconds(i int) {
-	m.duration_seconds = &i
-	m.addduration_seconds = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *APIQuotaReservationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// DurationSeconds returns the value of the "duration_seconds" field in the mutation.
-func (m *JobExecutionHistoryMutation) DurationSeconds() (r int, exists bool) {
-	v := m.duration_seconds
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *APIQuotaReservationMutation) ClearField(name string) error {
+	switch name {
+	case apiquotareservation.FieldSettledAt:
+		m.ClearSettledAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown APIQuotaReservation nullable field %s", name)
 }
 
-// OldDurationSeconds returns the old "duration_seconds" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldDurationSeconds(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDurationSeconds is only allowed on UpdateOne operations")
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *APIQuotaReservationMutation) ResetField(name string) error {
+	switch name {
+	case apiquotareservation.FieldReserved:
+		m.ResetReserved()
+		return nil
+	case apiquotareservation.FieldUsed:
+		m.ResetUsed()
+		return nil
+	case apiquotareservation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case apiquotareservation.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case apiquotareservation.FieldSettledAt:
+		m.ResetSettledAt()
+		return nil
+	case apiquotareservation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case apiquotareservation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDurationSeconds requires an ID field in the mutation")
+	return fmt.Errorf("unknown APIQuotaReservation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *APIQuotaReservationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tracker != nil {
+		edges = append(edges, apiquotareservation.EdgeTracker)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDurationSeconds: %w", err)
+	if m.budget_tracker != nil {
+		edges = append(edges, apiquotareservation.EdgeBudgetTracker)
 	}
-	return oldValue.DurationSeconds, nil
+	return edges
 }
 
-// AddDurationSeconds adds i to the "duration_seconds" field.
-func (m *JobExecutionHistoryMutation) AddDurationSeconds(i int) {
-	if m.addduration_seconds != nil {
-		*m.addduration_seconds += i
-	} else {
-		m.addduration_seconds = &i
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *APIQuotaReservationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case apiquotareservation.EdgeTracker:
+		if id := m.tracker; id != nil {
+			return []ent.Value{*id}
+		}
+	case apiquotareservation.EdgeBudgetTracker:
+		if id := m.budget_tracker; id != nil {
+			return []ent.Value{*id}
+		}
 	}
+	return nil
 }
 
-// AddedDurationSeconds returns the value that was added to the "duration_seconds" field in this mutation.
-func (m *JobExecutionHistoryMutation) AddedDurationSeconds() (r int, exists bool) {
-	v := m.addduration_seconds
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *APIQuotaReservationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// ResetDurationSeconds resets all changes to the "duration_seconds" field.
-func (m *JobExecutionHistoryMutation) ResetDurationSeconds() {
-	m.duration_seconds = nil
-	m.addduration_seconds = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *APIQuotaReservationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetTotalProcessed sets the "total_processed" field.
-func (m *JobExecutionHistoryMutation) SetTotalProcessed(i int) {
-	m.total_processed = &i
-	m.addtotal_processed = nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *APIQuotaReservationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtracker {
+		edges = append(edges, apiquotareservation.EdgeTracker)
+	}
+	if m.clearedbudget_tracker {
+		edges = append(edges, apiquotareservation.EdgeBudgetTracker)
+	}
+	return edges
 }
 
-// TotalProcessed returns the value of the "total_processed" field in the mutation.
-func (m *JobExecutionHistoryMutation) TotalProcessed() (r int, exists bool) {
-	v := m.total_processed
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *APIQuotaReservationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case apiquotareservation.EdgeTracker:
+		return m.clearedtracker
+	case apiquotareservation.EdgeBudgetTracker:
+		return m.clearedbudget_tracker
 	}
-	return *v, true
+	return false
 }
 
-// OldTotalProcessed returns the old "total_processed" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldTotalProcessed(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalProcessed is only allowed on UpdateOne operations")
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *APIQuotaReservationMutation) ClearEdge(name string) error {
+	switch name {
+	case apiquotareservation.EdgeTracker:
+		m.ClearTracker()
+		return nil
+	case apiquotareservation.EdgeBudgetTracker:
+		m.ClearBudgetTracker()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalProcessed requires an ID field in the mutation")
+	return fmt.Errorf("unknown APIQuotaReservation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *APIQuotaReservationMutation) ResetEdge(name string) error {
+	switch name {
+	case apiquotareservation.EdgeTracker:
+		m.ResetTracker()
+		return nil
+	case apiquotareservation.EdgeBudgetTracker:
+		m.ResetBudgetTracker()
+		return nil
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalProcessed: %w", err)
+	return fmt.Errorf("unknown APIQuotaReservation edge %s", name)
+}
+
+// APIQuotaTrackerMutation represents an operation that mutates the APIQuotaTracker nodes in the graph.
+type APIQuotaTrackerMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *ulid.ID
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	key_label                  *string
+	budget                     *string
+	month                      *int
+	addmonth                   *int
+	year                       *int
+	addyear                    *int
+	call_count                 *int
+	addcall_count              *int
+	reserved_count             *int
+	addreserved_count          *int
+	quota_limit                *int
+	addquota_limit             *int
+	daily_cap                  *int
+	adddaily_cap               *int
+	hourly_cap                 *int
+	addhourly_cap              *int
+	even_pacing                *bool
+	quota_exceeded             *bool
+	override_enabled           *bool
+	notification_sent          *bool
+	alerted_thresholds         *[]int
+	appendalerted_thresholds   []int
+	last_call_at               *time.Time
+	rate_limited_until         *time.Time
+	clearedFields              map[string]struct{}
+	reservations               map[ulid.ID]struct{}
+	removedreservations        map[ulid.ID]struct{}
+	clearedreservations        bool
+	budget_reservations        map[ulid.ID]struct{}
+	removedbudget_reservations map[ulid.ID]struct{}
+	clearedbudget_reservations bool
+	done                       bool
+	oldValue                   func(context.Context) (*APIQuotaTracker, error)
+	predicates                 []predicate.APIQuotaTracker
+}
+
+var _ ent.Mutation = (*APIQuotaTrackerMutation)(nil)
+
+// apiquotatrackerOption allows management of the mutation configuration using functional options.
+type apiquotatrackerOption func(*APIQuotaTrackerMutation)
+
+// newAPIQuotaTrackerMutation creates new mutation for the APIQuotaTracker entity.
+func newAPIQuotaTrackerMutation(c config, op Op, opts ...apiquotatrackerOption) *APIQuotaTrackerMutation {
+	m := &APIQuotaTrackerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAPIQuotaTracker,
+		clearedFields: make(map[string]struct{}),
 	}
-	return oldValue.TotalProcessed, nil
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// AddTotalProcessed adds i to the "total_processed" field.
-func (m *JobExecutionHistoryMutation) AddTotalProcessed(i int) {
-	if m.addtotal_processed != nil {
-		*m.addtotal_processed += i
-	} else {
-		m.addtotal_processed = &i
+// withAPIQuotaTrackerID sets the ID field of the mutation.
+func withAPIQuotaTrackerID(id ulid.ID) apiquotatrackerOption {
+	return func(m *APIQuotaTrackerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *APIQuotaTracker
+		)
+		m.oldValue = func(ctx context.Context) (*APIQuotaTracker, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().APIQuotaTracker.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedTotalProcessed returns the value that was added to the "total_processed" field in this mutation.
-func (m *JobExecutionHistoryMutation) AddedTotalProcessed() (r int, exists bool) {
-	v := m.addtotal_processed
-	if v == nil {
+// withAPIQuotaTracker sets the old APIQuotaTracker of the mutation.
+func withAPIQuotaTracker(node *APIQuotaTracker) apiquotatrackerOption {
+	return func(m *APIQuotaTrackerMutation) {
+		m.oldValue = func(context.Context) (*APIQuotaTracker, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m APIQuotaTrackerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m APIQuotaTrackerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of APIQuotaTracker entities.
+func (m *APIQuotaTrackerMutation) SetID(id ulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *APIQuotaTrackerMutation) ID() (id ulid.ID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetTotalProcessed resets all changes to the "total_processed" field.
-func (m *JobExecutionHistoryMutation) ResetTotalProcessed() {
-	m.total_processed = nil
-	m.addtotal_processed = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *APIQuotaTrackerMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []ulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().APIQuotaTracker.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetSuccessfulCount sets the "successful_count" field.
-func (m *JobExecutionHistoryMutation) SetSuccessfulCount(i int) {
-	m.successful_count = &i
-	m.addsuccessful_count = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *APIQuotaTrackerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// SuccessfulCount returns the value of the "successful_count" field in the mutation.
-func (m *JobExecutionHistoryMutation) SuccessfulCount() (r int, exists bool) {
-	v := m.successful_count
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APIQuotaTrackerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuccessfulCount returns the old "successful_count" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldSuccessfulCount(ctx context.Context) (v int, err error) {
+func (m *APIQuotaTrackerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuccessfulCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuccessfulCount requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuccessfulCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.SuccessfulCount, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddSuccessfulCount adds i to the "successful_count" field.
-func (m *JobExecutionHistoryMutation) AddSuccessfulCount(i int) {
-	if m.addsuccessful_count != nil {
-		*m.addsuccessful_count += i
-	} else {
-		m.addsuccessful_count = &i
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APIQuotaTrackerMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedSuccessfulCount returns the value that was added to the "successful_count" field in this mutation.
-func (m *JobExecutionHistoryMutation) AddedSuccessfulCount() (r int, exists bool) {
-	v := m.addsuccessful_count
+// SetUpdatedAt sets the "updated_at" field.
+func (m *APIQuotaTrackerMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *APIQuotaTrackerMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSuccessfulCount resets all changes to the "successful_count" field.
-func (m *JobExecutionHistoryMutation) ResetSuccessfulCount() {
-	m.successful_count = nil
-	m.addsuccessful_count = nil
+// OldUpdatedAt returns the old "updated_at" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// SetFailedCount sets the "failed_count" field.
-func (m *JobExecutionHistoryMutation) SetFailedCount(i int) {
-	m.failed_count = &i
-	m.addfailed_count = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *APIQuotaTrackerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// FailedCount returns the value of the "failed_count" field in the mutation.
-func (m *JobExecutionHistoryMutation) FailedCount() (r int, exists bool) {
-	v := m.failed_count
+// SetKeyLabel sets the "key_label" field.
+func (m *APIQuotaTrackerMutation) SetKeyLabel(s string) {
+	m.key_label = &s
+}
+
+// KeyLabel returns the value of the "key_label" field in the mutation.
+func (m *APIQuotaTrackerMutation) KeyLabel() (r string, exists bool) {
+	v := m.key_label
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFailedCount returns the old "failed_count" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyLabel returns the old "key_label" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldFailedCount(ctx context.Context) (v int, err error) {
+func (m *APIQuotaTrackerMutation) OldKeyLabel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFailedCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyLabel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFailedCount requires an ID field in the mutation")
+		return v, errors.New("OldKeyLabel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFailedCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyLabel: %w", err)
 	}
-	return oldValue.FailedCount, nil
+	return oldValue.KeyLabel, nil
 }
 
-// AddFailedCount adds i to the "failed_count" field.
-func (m *JobExecutionHistoryMutation) AddFailedCount(i int) {
-	if m.addfailed_count != nil {
-		*m.addfailed_count += i
-	} else {
-		m.addfailed_count = &i
-	}
+// ResetKeyLabel resets all changes to the "key_label" field.
+func (m *APIQuotaTrackerMutation) ResetKeyLabel() {
+	m.key_label = nil
 }
 
-// AddedFailedCount returns the value that was added to the "failed_count" field in this mutation.
-func (m *JobExecutionHistoryMutation) AddedFailedCount() (r int, exists bool) {
-	v := m.addfailed_count
+// SetBudget sets the "budget" field.
+func (m *APIQuotaTrackerMutation) SetBudget(s string) {
+	m.budget = &s
+}
+
+// Budget returns the value of the "budget" field in the mutation.
+func (m *APIQuotaTrackerMutation) Budget() (r string, exists bool) {
+	v := m.budget
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFailedCount resets all changes to the "failed_count" field.
-func (m *JobExecutionHistoryMutation) ResetFailedCount() {
-	m.failed_count = nil
-	m.addfailed_count = nil
+// OldBudget returns the old "budget" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldBudget(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBudget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBudget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBudget: %w", err)
+	}
+	return oldValue.Budget, nil
 }
 
-// SetAPICallsMade sets the "api_calls_made" field.
-func (m *JobExecutionHistoryMutation) SetAPICallsMade(i int) {
-	m.api_calls_made = &i
-	m.addapi_calls_made = nil
+// ResetBudget resets all changes to the "budget" field.
+func (m *APIQuotaTrackerMutation) ResetBudget() {
+	m.budget = nil
 }
 
-// APICallsMade returns the value of the "api_calls_made" field in the mutation.
-func (m *JobExecutionHistoryMutation) APICallsMade() (r int, exists bool) {
-	v := m.api_calls_made
+// SetMonth sets the "month" field.
+func (m *APIQuotaTrackerMutation) SetMonth(i int) {
+	m.month = &i
+	m.addmonth = nil
+}
+
+// Month returns the value of the "month" field in the mutation.
+func (m *APIQuotaTrackerMutation) Month() (r int, exists bool) {
+	v := m.month
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPICallsMade returns the old "api_calls_made" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldMonth returns the old "month" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldAPICallsMade(ctx context.Context) (v int, err error) {
+func (m *APIQuotaTrackerMutation) OldMonth(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPICallsMade is only allowed on UpdateOne operations")
+		return v, errors.New("OldMonth is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPICallsMade requires an ID field in the mutation")
+		return v, errors.New("OldMonth requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPICallsMade: %w", err)
+		return v, fmt.Errorf("querying old value for OldMonth: %w", err)
 	}
-	return oldValue.APICallsMade, nil
+	return oldValue.Month, nil
 }
 
-// AddAPICallsMade adds i to the "api_calls_made" field.
-func (m *JobExecutionHistoryMutation) AddAPICallsMade(i int) {
-	if m.addapi_calls_made != nil {
-		*m.addapi_calls_made += i
+// AddMonth adds i to the "month" field.
+func (m *APIQuotaTrackerMutation) AddMonth(i int) {
+	if m.addmonth != nil {
+		*m.addmonth += i
 	} else {
-		m.addapi_calls_made = &i
+		m.addmonth = &i
 	}
 }
 
-// AddedAPICallsMade returns the value that was added to the "api_calls_made" field in this mutation.
-func (m *JobExecutionHistoryMutation) AddedAPICallsMade() (r int, exists bool) {
-	v := m.addapi_calls_made
+// AddedMonth returns the value that was added to the "month" field in this mutation.
+func (m *APIQuotaTrackerMutation) AddedMonth() (r int, exists bool) {
+	v := m.addmonth
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAPICallsMade resets all changes to the "api_calls_made" field.
-func (m *JobExecutionHistoryMutation) ResetAPICallsMade() {
-	m.api_calls_made = nil
-	m.addapi_calls_made = nil
+// ResetMonth resets all changes to the "month" field.
+func (m *APIQuotaTrackerMutation) ResetMonth() {
+	m.month = nil
+	m.addmonth = nil
 }
 
-// SetQuotaRemaining sets the "quota_remaining" field.
-func (m *JobExecutionHistoryMutation) SetQuotaRemaining(i int) {
-	m.quota_remaining = &i
-	m.addquota_remaining = nil
+// SetYear sets the "year" field.
+func (m *APIQuotaTrackerMutation) SetYear(i int) {
+	m.year = &i
+	m.addyear = nil
 }
 
-// QuotaRemaining returns the value of the "quota_remaining" field in the mutation.
-func (m *JobExecutionHistoryMutation) QuotaRemaining() (r int, exists bool) {
-	v := m.quota_remaining
+// Year returns the value of the "year" field in the mutation.
+func (m *APIQuotaTrackerMutation) Year() (r int, exists bool) {
+	v := m.year
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuotaRemaining returns the old "quota_remaining" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldYear returns the old "year" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldQuotaRemaining(ctx context.Context) (v int, err error) {
+func (m *APIQuotaTrackerMutation) OldYear(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuotaRemaining is only allowed on UpdateOne operations")
+		return v, errors.New("OldYear is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuotaRemaining requires an ID field in the mutation")
+		return v, errors.New("OldYear requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuotaRemaining: %w", err)
+		return v, fmt.Errorf("querying old value for OldYear: %w", err)
 	}
-	return oldValue.QuotaRemaining, nil
+	return oldValue.Year, nil
 }
 
-// AddQuotaRemaining adds i to the "quota_remaining" field.
-func (m *JobExecutionHistoryMutation) AddQuotaRemaining(i int) {
-	if m.addquota_remaining != nil {
-		*m.addquota_remaining += i
+// AddYear adds i to the "year" field.
+func (m *APIQuotaTrackerMutation) AddYear(i int) {
+	if m.addyear != nil {
+		*m.addyear += i
 	} else {
-		m.addquota_remaining = &i
+		m.addyear = &i
 	}
 }
 
-// AddedQuotaRemaining returns the value that was added to the "quota_remaining" field in this mutation.
-func (m *JobExecutionHistoryMutation) AddedQuotaRemaining() (r int, exists bool) {
-	v := m.addquota_remaining
+// AddedYear returns the value that was added to the "year" field in this mutation.
+func (m *APIQuotaTrackerMutation) AddedYear() (r int, exists bool) {
+	v := m.addyear
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetQuotaRemaining resets all changes to the "quota_remaining" field.
-func (m *JobExecutionHistoryMutation) ResetQuotaRemaining() {
-	m.quota_remaining = nil
-	m.addquota_remaining = nil
+// ResetYear resets all changes to the "year" field.
+func (m *APIQuotaTrackerMutation) ResetYear() {
+	m.year = nil
+	m.addyear = nil
 }
 
-// SetErrorSummary sets the "error_summary" field.
-func (m *JobExecutionHistoryMutation) SetErrorSummary(s string) {
-	m.error_summary = &s
+// SetCallCount sets the "call_count" field.
+func (m *APIQuotaTrackerMutation) SetCallCount(i int) {
+	m.call_count = &i
+	m.addcall_count = nil
 }
 
-// ErrorSummary returns the value of the "error_summary" field in the mutation.
-func (m *JobExecutionHistoryMutation) ErrorSummary() (r string, exists bool) {
-	v := m.error_summary
+// CallCount returns the value of the "call_count" field in the mutation.
+func (m *APIQuotaTrackerMutation) CallCount() (r int, exists bool) {
+	v := m.call_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorSummary returns the old "error_summary" field's value of the JobExecutionHistory entity.
-// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldCallCount returns the old "call_count" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobExecutionHistoryMutation) OldErrorSummary(ctx context.Context) (v *string, err error) {
+func (m *APIQuotaTrackerMutation) OldCallCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorSummary is only allowed on UpdateOne operations")
+		return v, errors.New("OldCallCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorSummary requires an ID field in the mutation")
+		return v, errors.New("OldCallCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorSummary: %w", err)
+		return v, fmt.Errorf("querying old value for OldCallCount: %w", err)
 	}
-	return oldValue.ErrorSummary, nil
-}
-
-// ClearErrorSummary clears the value of the "error_summary" field.
-func (m *JobExecutionHistoryMutation) ClearErrorSummary() {
-	m.error_summary = nil
-	m.clearedFields[jobexecutionhistory.FieldErrorSummary] = struct{}{}
+	return oldValue.CallCount, nil
 }
 
-// ErrorSummaryCleared returns if the "error_summary" field was cleared in this mutation.
-func (m *JobExecutionHistoryMutation) ErrorSummaryCleared() bool {
-	_, ok := m.clearedFields[jobexecutionhistory.FieldErrorSummary]
-	return ok
+// AddCallCount adds i to the "call_count" field.
+func (m *APIQuotaTrackerMutation) AddCallCount(i int) {
+	if m.addcall_count != nil {
+		*m.addcall_count += i
+	} else {
+		m.addcall_count = &i
+	}
 }
 
-// ResetErrorSummary resets all changes to the "error_summary" field.
-func (m *JobExecutionHistoryMutation) ResetErrorSummary() {
-	m.error_summary = nil
-	delete(m.clearedFields, jobexecutionhistory.FieldErrorSummary)
+// AddedCallCount returns the value that was added to the "call_count" field in this mutation.
+func (m *APIQuotaTrackerMutation) AddedCallCount() (r int, exists bool) {
+	v := m.addcall_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddProfileEntryIDs adds the "profile_entries" edge to the ProfileEntry entity by ids.
-func (m *JobExecutionHistoryMutation) AddProfileEntryIDs(ids ...ulid.ID) {
-	if m.profile_entries == nil {
-		m.profile_entries = make(map[ulid.ID]struct{})
-	}
-	for i := range ids {
-		m.profile_entries[ids[i]] = struct{}{}
-	}
+// ResetCallCount resets all changes to the "call_count" field.
+func (m *APIQuotaTrackerMutation) ResetCallCount() {
+	m.call_count = nil
+	m.addcall_count = nil
 }
 
-// ClearProfileEntries clears the "profile_entries" edge to the ProfileEntry entity.
-func (m *JobExecutionHistoryMutation) ClearProfileEntries() {
-	m.clearedprofile_entries = true
+// SetReservedCount sets the "reserved_count" field.
+func (m *APIQuotaTrackerMutation) SetReservedCount(i int) {
+	m.reserved_count = &i
+	m.addreserved_count = nil
 }
 
-// ProfileEntriesCleared reports if the "profile_entries" edge to the ProfileEntry entity was cleared.
-func (m *JobExecutionHistoryMutation) ProfileEntriesCleared() bool {
-	return m.clearedprofile_entries
+// ReservedCount returns the value of the "reserved_count" field in the mutation.
+func (m *APIQuotaTrackerMutation) ReservedCount() (r int, exists bool) {
+	v := m.reserved_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveProfileEntryIDs removes the "profile_entries" edge to the ProfileEntry entity by IDs.
-func (m *JobExecutionHistoryMutation) RemoveProfileEntryIDs(ids ...ulid.ID) {
-	if m.removedprofile_entries == nil {
-		m.removedprofile_entries = make(map[ulid.ID]struct{})
+// OldReservedCount returns the old "reserved_count" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldReservedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReservedCount is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.profile_entries, ids[i])
-		m.removedprofile_entries[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReservedCount requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReservedCount: %w", err)
+	}
+	return oldValue.ReservedCount, nil
 }
 
-// RemovedProfileEntries returns the removed IDs of the "profile_entries" edge to the ProfileEntry entity.
-func (m *JobExecutionHistoryMutation) RemovedProfileEntriesIDs() (ids []ulid.ID) {
-	for id := range m.removedprofile_entries {
-		ids = append(ids, id)
+// AddReservedCount adds i to the "reserved_count" field.
+func (m *APIQuotaTrackerMutation) AddReservedCount(i int) {
+	if m.addreserved_count != nil {
+		*m.addreserved_count += i
+	} else {
+		m.addreserved_count = &i
 	}
-	return
 }
 
-// ProfileEntriesIDs returns the "profile_entries" edge IDs in the mutation.
-func (m *JobExecutionHistoryMutation) ProfileEntriesIDs() (ids []ulid.ID) {
-	for id := range m.profile_entries {
-		ids = append(ids, id)
+// AddedReservedCount returns the value that was added to the "reserved_count" field in this mutation.
+func (m *APIQuotaTrackerMutation) AddedReservedCount() (r int, exists bool) {
+	v := m.addreserved_count
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetProfileEntries resets all changes to the "profile_entries" edge.
-func (m *JobExecutionHistoryMutation) ResetProfileEntries() {
-	m.profile_entries = nil
-	m.clearedprofile_entries = false
-	m.removedprofile_entries = nil
+// ResetReservedCount resets all changes to the "reserved_count" field.
+func (m *APIQuotaTrackerMutation) ResetReservedCount() {
+	m.reserved_count = nil
+	m.addreserved_count = nil
 }
 
-// Where appends a list predicates to the JobExecutionHistoryMutation builder.
-func (m *JobExecutionHistoryMutation) Where(ps ...predicate.JobExecutionHistory) {
-	m.predicates = append(m.predicates, ps...)
+// SetQuotaLimit sets the "quota_limit" field.
+func (m *APIQuotaTrackerMutation) SetQuotaLimit(i int) {
+	m.quota_limit = &i
+	m.addquota_limit = nil
 }
 
-// WhereP appends storage-level predicates to the JobExecutionHistoryMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JobExecutionHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.JobExecutionHistory, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// QuotaLimit returns the value of the "quota_limit" field in the mutation.
+func (m *APIQuotaTrackerMutation) QuotaLimit() (r int, exists bool) {
+	v := m.quota_limit
+	if v == nil {
+		return
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *JobExecutionHistoryMutation) Op() Op {
-	return m.op
+	return *v, true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *JobExecutionHistoryMutation) SetOp(op Op) {
-	m.op = op
+// OldQuotaLimit returns the old "quota_limit" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldQuotaLimit(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuotaLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuotaLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuotaLimit: %w", err)
+	}
+	return oldValue.QuotaLimit, nil
 }
 
-// Type returns the node type of this mutation (JobExecutionHistory).
-func (m *JobExecutionHistoryMutation) Type() string {
-	return m.typ
+// AddQuotaLimit adds i to the "quota_limit" field.
+func (m *APIQuotaTrackerMutation) AddQuotaLimit(i int) {
+	if m.addquota_limit != nil {
+		*m.addquota_limit += i
+	} else {
+		m.addquota_limit = &i
+	}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *JobExecutionHistoryMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.created_at != nil {
-		fields = append(fields, jobexecutionhistory.FieldCreatedAt)
+// AddedQuotaLimit returns the value that was added to the "quota_limit" field in this mutation.
+func (m *APIQuotaTrackerMutation) AddedQuotaLimit() (r int, exists bool) {
+	v := m.addquota_limit
+	if v == nil {
+		return
 	}
-	if m.updated_at != nil {
-		fields = append(fields, jobexecutionhistory.FieldUpdatedAt)
+	return *v, true
+}
+
+// ResetQuotaLimit resets all changes to the "quota_limit" field.
+func (m *APIQuotaTrackerMutation) ResetQuotaLimit() {
+	m.quota_limit = nil
+	m.addquota_limit = nil
+}
+
+// SetDailyCap sets the "daily_cap" field.
+func (m *APIQuotaTrackerMutation) SetDailyCap(i int) {
+	m.daily_cap = &i
+	m.adddaily_cap = nil
+}
+
+// DailyCap returns the value of the "daily_cap" field in the mutation.
+func (m *APIQuotaTrackerMutation) DailyCap() (r int, exists bool) {
+	v := m.daily_cap
+	if v == nil {
+		return
 	}
-	if m.job_name != nil {
-		fields = append(fields, jobexecutionhistory.FieldJobName)
+	return *v, true
+}
+
+// OldDailyCap returns the old "daily_cap" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldDailyCap(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDailyCap is only allowed on UpdateOne operations")
 	}
-	if m.status != nil {
-		fields = append(fields, jobexecutionhistory.FieldStatus)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDailyCap requires an ID field in the mutation")
 	}
-	if m.started_at != nil {
-		fields = append(fields, jobexecutionhistory.FieldStartedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDailyCap: %w", err)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, jobexecutionhistory.FieldCompletedAt)
+	return oldValue.DailyCap, nil
+}
+
+// AddDailyCap adds i to the "daily_cap" field.
+func (m *APIQuotaTrackerMutation) AddDailyCap(i int) {
+	if m.adddaily_cap != nil {
+		*m.adddaily_cap += i
+	} else {
+		m.adddaily_cap = &i
 	}
-	if m.duration_seconds != nil {
-		fields = append(fields, jobexecutionhistory.FieldDurationSeconds)
+}
+
+// AddedDailyCap returns the value that was added to the "daily_cap" field in this mutation.
+func (m *APIQuotaTrackerMutation) AddedDailyCap() (r int, exists bool) {
+	v := m.adddaily_cap
+	if v == nil {
+		return
 	}
-	if m.total_processed != nil {
-		fields = append(fields, jobexecutionhistory.FieldTotalProcessed)
+	return *v, true
+}
+
+// ClearDailyCap clears the value of the "daily_cap" field.
+func (m *APIQuotaTrackerMutation) ClearDailyCap() {
+	m.daily_cap = nil
+	m.adddaily_cap = nil
+	m.clearedFields[apiquotatracker.FieldDailyCap] = struct{}{}
+}
+
+// DailyCapCleared returns if the "daily_cap" field was cleared in this mutation.
+func (m *APIQuotaTrackerMutation) DailyCapCleared() bool {
+	_, ok := m.clearedFields[apiquotatracker.FieldDailyCap]
+	return ok
+}
+
+// ResetDailyCap resets all changes to the "daily_cap" field.
+func (m *APIQuotaTrackerMutation) ResetDailyCap() {
+	m.daily_cap = nil
+	m.adddaily_cap = nil
+	delete(m.clearedFields, apiquotatracker.FieldDailyCap)
+}
+
+// SetHourlyCap sets the "hourly_cap" field.
+func (m *APIQuotaTrackerMutation) SetHourlyCap(i int) {
+	m.hourly_cap = &i
+	m.addhourly_cap = nil
+}
+
+// HourlyCap returns the value of the "hourly_cap" field in the mutation.
+func (m *APIQuotaTrackerMutation) HourlyCap() (r int, exists bool) {
+	v := m.hourly_cap
+	if v == nil {
+		return
 	}
-	if m.successful_count != nil {
-		fields = append(fields, jobexecutionhistory.FieldSuccessfulCount)
+	return *v, true
+}
+
+// OldHourlyCap returns the old "hourly_cap" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldHourlyCap(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHourlyCap is only allowed on UpdateOne operations")
 	}
-	if m.failed_count != nil {
-		fields = append(fields, jobexecutionhistory.FieldFailedCount)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHourlyCap requires an ID field in the mutation")
 	}
-	if m.api_calls_made != nil {
-		fields = append(fields, jobexecutionhistory.FieldAPICallsMade)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHourlyCap: %w", err)
 	}
-	if m.quota_remaining != nil {
-		fields = append(fields, jobexecutionhistory.FieldQuotaRemaining)
+	return oldValue.HourlyCap, nil
+}
+
+// AddHourlyCap adds i to the "hourly_cap" field.
+func (m *APIQuotaTrackerMutation) AddHourlyCap(i int) {
+	if m.addhourly_cap != nil {
+		*m.addhourly_cap += i
+	} else {
+		m.addhourly_cap = &i
 	}
-	if m.error_summary != nil {
-		fields = append(fields, jobexecutionhistory.FieldErrorSummary)
+}
+
+// AddedHourlyCap returns the value that was added to the "hourly_cap" field in this mutation.
+func (m *APIQuotaTrackerMutation) AddedHourlyCap() (r int, exists bool) {
+	v := m.addhourly_cap
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *JobExecutionHistoryMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case jobexecutionhistory.FieldCreatedAt:
-		return m.CreatedAt()
-	case jobexecutionhistory.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case jobexecutionhistory.FieldJobName:
-		return m.JobName()
-	case jobexecutionhistory.FieldStatus:
-		return m.Status()
-	case jobexecutionhistory.FieldStartedAt:
-		return m.StartedAt()
-	case jobexecutionhistory.FieldCompletedAt:
-		return m.CompletedAt()
-	case jobexecutionhistory.FieldDurationSeconds:
-		return m.DurationSeconds()
-	case jobexecutionhistory.FieldTotalProcessed:
-		return m.TotalProcessed()
-	case jobexecutionhistory.FieldSuccessfulCount:
-		return m.SuccessfulCount()
-	case jobexecutionhistory.FieldFailedCount:
-		return m.FailedCount()
-	case jobexecutionhistory.FieldAPICallsMade:
-		return m.APICallsMade()
-	case jobexecutionhistory.FieldQuotaRemaining:
-		return m.QuotaRemaining()
-	case jobexecutionhistory.FieldErrorSummary:
-		return m.ErrorSummary()
+// ClearHourlyCap clears the value of the "hourly_cap" field.
+func (m *APIQuotaTrackerMutation) ClearHourlyCap() {
+	m.hourly_cap = nil
+	m.addhourly_cap = nil
+	m.clearedFields[apiquotatracker.FieldHourlyCap] = struct{}{}
+}
+
+// HourlyCapCleared returns if the "hourly_cap" field was cleared in this mutation.
+func (m *APIQuotaTrackerMutation) HourlyCapCleared() bool {
+	_, ok := m.clearedFields[apiquotatracker.FieldHourlyCap]
+	return ok
+}
+
+// ResetHourlyCap resets all changes to the "hourly_cap" field.
+func (m *APIQuotaTrackerMutation) ResetHourlyCap() {
+	m.hourly_cap = nil
+	m.addhourly_cap = nil
+	delete(m.clearedFields, apiquotatracker.FieldHourlyCap)
+}
+
+// SetEvenPacing sets the "even_pacing" field.
+func (m *APIQuotaTrackerMutation) SetEvenPacing(b bool) {
+	m.even_pacing = &b
+}
+
+// EvenPacing returns the value of the "even_pacing" field in the mutation.
+func (m *APIQuotaTrackerMutation) EvenPacing() (r bool, exists bool) {
+	v := m.even_pacing
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *JobExecutionHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case jobexecutionhistory.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case jobexecutionhistory.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case jobexecutionhistory.FieldJobName:
-		return m.OldJobName(ctx)
-	case jobexecutionhistory.FieldStatus:
-		return m.OldStatus(ctx)
-	case jobexecutionhistory.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case jobexecutionhistory.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case jobexecutionhistory.FieldDurationSeconds:
-		return m.OldDurationSeconds(ctx)
-	case jobexecutionhistory.FieldTotalProcessed:
-		return m.OldTotalProcessed(ctx)
-	case jobexecutionhistory.FieldSuccessfulCount:
-		return m.OldSuccessfulCount(ctx)
-	case jobexecutionhistory.FieldFailedCount:
-		return m.OldFailedCount(ctx)
-	case jobexecutionhistory.FieldAPICallsMade:
-		return m.OldAPICallsMade(ctx)
-	case jobexecutionhistory.FieldQuotaRemaining:
-		return m.OldQuotaRemaining(ctx)
-	case jobexecutionhistory.FieldErrorSummary:
-		return m.OldErrorSummary(ctx)
+// OldEvenPacing returns the old "even_pacing" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldEvenPacing(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvenPacing is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown JobExecutionHistory field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvenPacing requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvenPacing: %w", err)
+	}
+	return oldValue.EvenPacing, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *JobExecutionHistoryMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case jobexecutionhistory.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case jobexecutionhistory.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case jobexecutionhistory.FieldJobName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetJobName(v)
-		return nil
-	case jobexecutionhistory.FieldStatus:
-		v, ok := value.(jobexecutionhistory.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case jobexecutionhistory.FieldStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartedAt(v)
-		return nil
-	case jobexecutionhistory.FieldCompletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletedAt(v)
-		return nil
-	case jobexecutionhistory.FieldDurationSeconds:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDurationSeconds(v)
-		return nil
-	case jobexecutionhistory.FieldTotalProcessed:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalProcessed(v)
-		return nil
-	case jobexecutionhistory.FieldSuccessfulCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuccessfulCount(v)
-		return nil
-	case jobexecutionhistory.FieldFailedCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFailedCount(v)
-		return nil
-	case jobexecutionhistory.FieldAPICallsMade:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPICallsMade(v)
-		return nil
-	case jobexecutionhistory.FieldQuotaRemaining:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuotaRemaining(v)
-		return nil
-	case jobexecutionhistory.FieldErrorSummary:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorSummary(v)
-		return nil
+// ResetEvenPacing resets all changes to the "even_pacing" field.
+func (m *APIQuotaTrackerMutation) ResetEvenPacing() {
+	m.even_pacing = nil
+}
+
+// SetQuotaExceeded sets the "quota_exceeded" field.
+func (m *APIQuotaTrackerMutation) SetQuotaExceeded(b bool) {
+	m.quota_exceeded = &b
+}
+
+// QuotaExceeded returns the value of the "quota_exceeded" field in the mutation.
+func (m *APIQuotaTrackerMutation) QuotaExceeded() (r bool, exists bool) {
+	v := m.quota_exceeded
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown JobExecutionHistory field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *JobExecutionHistoryMutation) AddedFields() []string {
-	var fields []string
-	if m.addduration_seconds != nil {
-		fields = append(fields, jobexecutionhistory.FieldDurationSeconds)
+// OldQuotaExceeded returns the old "quota_exceeded" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldQuotaExceeded(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuotaExceeded is only allowed on UpdateOne operations")
 	}
-	if m.addtotal_processed != nil {
-		fields = append(fields, jobexecutionhistory.FieldTotalProcessed)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuotaExceeded requires an ID field in the mutation")
 	}
-	if m.addsuccessful_count != nil {
-		fields = append(fields, jobexecutionhistory.FieldSuccessfulCount)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuotaExceeded: %w", err)
 	}
-	if m.addfailed_count != nil {
-		fields = append(fields, jobexecutionhistory.FieldFailedCount)
+	return oldValue.QuotaExceeded, nil
+}
+
+// ResetQuotaExceeded resets all changes to the "quota_exceeded" field.
+func (m *APIQuotaTrackerMutation) ResetQuotaExceeded() {
+	m.quota_exceeded = nil
+}
+
+// SetOverrideEnabled sets the "override_enabled" field.
+func (m *APIQuotaTrackerMutation) SetOverrideEnabled(b bool) {
+	m.override_enabled = &b
+}
+
+// OverrideEnabled returns the value of the "override_enabled" field in the mutation.
+func (m *APIQuotaTrackerMutation) OverrideEnabled() (r bool, exists bool) {
+	v := m.override_enabled
+	if v == nil {
+		return
 	}
-	if m.addapi_calls_made != nil {
-		fields = append(fields, jobexecutionhistory.FieldAPICallsMade)
+	return *v, true
+}
+
+// OldOverrideEnabled returns the old "override_enabled" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldOverrideEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOverrideEnabled is only allowed on UpdateOne operations")
 	}
-	if m.addquota_remaining != nil {
-		fields = append(fields, jobexecutionhistory.FieldQuotaRemaining)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOverrideEnabled requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOverrideEnabled: %w", err)
+	}
+	return oldValue.OverrideEnabled, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *JobExecutionHistoryMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case jobexecutionhistory.FieldDurationSeconds:
-		return m.AddedDurationSeconds()
-	case jobexecutionhistory.FieldTotalProcessed:
-		return m.AddedTotalProcessed()
-	case jobexecutionhistory.FieldSuccessfulCount:
-		return m.AddedSuccessfulCount()
-	case jobexecutionhistory.FieldFailedCount:
-		return m.AddedFailedCount()
-	case jobexecutionhistory.FieldAPICallsMade:
-		return m.AddedAPICallsMade()
-	case jobexecutionhistory.FieldQuotaRemaining:
-		return m.AddedQuotaRemaining()
+// ResetOverrideEnabled resets all changes to the "override_enabled" field.
+func (m *APIQuotaTrackerMutation) ResetOverrideEnabled() {
+	m.override_enabled = nil
+}
+
+// SetNotificationSent sets the "notification_sent" field.
+func (m *APIQuotaTrackerMutation) SetNotificationSent(b bool) {
+	m.notification_sent = &b
+}
+
+// NotificationSent returns the value of the "notification_sent" field in the mutation.
+func (m *APIQuotaTrackerMutation) NotificationSent() (r bool, exists bool) {
+	v := m.notification_sent
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *JobExecutionHistoryMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case jobexecutionhistory.FieldDurationSeconds:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDurationSeconds(v)
-		return nil
-	case jobexecutionhistory.FieldTotalProcessed:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalProcessed(v)
-		return nil
-	case jobexecutionhistory.FieldSuccessfulCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSuccessfulCount(v)
-		return nil
-	case jobexecutionhistory.FieldFailedCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFailedCount(v)
-		return nil
-	case jobexecutionhistory.FieldAPICallsMade:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAPICallsMade(v)
-		return nil
-	case jobexecutionhistory.FieldQuotaRemaining:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddQuotaRemaining(v)
-		return nil
+// OldNotificationSent returns the old "notification_sent" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldNotificationSent(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotificationSent is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown JobExecutionHistory numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotificationSent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotificationSent: %w", err)
+	}
+	return oldValue.NotificationSent, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *JobExecutionHistoryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(jobexecutionhistory.FieldCompletedAt) {
-		fields = append(fields, jobexecutionhistory.FieldCompletedAt)
+// ResetNotificationSent resets all changes to the "notification_sent" field.
+func (m *APIQuotaTrackerMutation) ResetNotificationSent() {
+	m.notification_sent = nil
+}
+
+// SetAlertedThresholds sets the "alerted_thresholds" field.
+func (m *APIQuotaTrackerMutation) SetAlertedThresholds(i []int) {
+	m.alerted_thresholds = &i
+	m.appendalerted_thresholds = nil
+}
+
+// AlertedThresholds returns the value of the "alerted_thresholds" field in the mutation.
+func (m *APIQuotaTrackerMutation) AlertedThresholds() (r []int, exists bool) {
+	v := m.alerted_thresholds
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(jobexecutionhistory.FieldErrorSummary) {
-		fields = append(fields, jobexecutionhistory.FieldErrorSummary)
+	return *v, true
+}
+
+// OldAlertedThresholds returns the old "alerted_thresholds" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldAlertedThresholds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlertedThresholds is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlertedThresholds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlertedThresholds: %w", err)
+	}
+	return oldValue.AlertedThresholds, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *JobExecutionHistoryMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AppendAlertedThresholds adds i to the "alerted_thresholds" field.
+func (m *APIQuotaTrackerMutation) AppendAlertedThresholds(i []int) {
+	m.appendalerted_thresholds = append(m.appendalerted_thresholds, i...)
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *JobExecutionHistoryMutation) ClearField(name string) error {
-	switch name {
-	case jobexecutionhistory.FieldCompletedAt:
-		m.ClearCompletedAt()
-		return nil
-	case jobexecutionhistory.FieldErrorSummary:
-		m.ClearErrorSummary()
-		return nil
+// AppendedAlertedThresholds returns the list of values that were appended to the "alerted_thresholds" field in this mutation.
+func (m *APIQuotaTrackerMutation) AppendedAlertedThresholds() ([]int, bool) {
+	if len(m.appendalerted_thresholds) == 0 {
+		return nil, false
 	}
-	return fmt.Errorf("unknown JobExecutionHistory nullable field %s", name)
+	return m.appendalerted_thresholds, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *JobExecutionHistoryMutation) ResetField(name string) error {
-	switch name {
-	case jobexecutionhistory.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case jobexecutionhistory.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case jobexecutionhistory.FieldJobName:
-		m.ResetJobName()
-		return nil
-	case jobexecutionhistory.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case jobexecutionhistory.FieldStartedAt:
-		m.ResetStartedAt()
-		return nil
-	case jobexecutionhistory.FieldCompletedAt:
-		m.ResetCompletedAt()
-		return nil
-	case jobexecutionhistory.FieldDurationSeconds:
-		m.ResetDurationSeconds()
-		return nil
-	case jobexecutionhistory.FieldTotalProcessed:
-		m.ResetTotalProcessed()
-		return nil
-	case jobexecutionhistory.FieldSuccessfulCount:
-		m.ResetSuccessfulCount()
-		return nil
-	case jobexecutionhistory.FieldFailedCount:
-		m.ResetFailedCount()
-		return nil
-	case jobexecutionhistory.FieldAPICallsMade:
-		m.ResetAPICallsMade()
-		return nil
-	case jobexecutionhistory.FieldQuotaRemaining:
-		m.ResetQuotaRemaining()
-		return nil
-	case jobexecutionhistory.FieldErrorSummary:
-		m.ResetErrorSummary()
-		return nil
-	}
-	return fmt.Errorf("unknown JobExecutionHistory field %s", name)
+// ClearAlertedThresholds clears the value of the "alerted_thresholds" field.
+func (m *APIQuotaTrackerMutation) ClearAlertedThresholds() {
+	m.alerted_thresholds = nil
+	m.appendalerted_thresholds = nil
+	m.clearedFields[apiquotatracker.FieldAlertedThresholds] = struct{}{}
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JobExecutionHistoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.profile_entries != nil {
-		edges = append(edges, jobexecutionhistory.EdgeProfileEntries)
-	}
-	return edges
+// AlertedThresholdsCleared returns if the "alerted_thresholds" field was cleared in this mutation.
+func (m *APIQuotaTrackerMutation) AlertedThresholdsCleared() bool {
+	_, ok := m.clearedFields[apiquotatracker.FieldAlertedThresholds]
+	return ok
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *JobExecutionHistoryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case jobexecutionhistory.EdgeProfileEntries:
-		ids := make([]ent.Value, 0, len(m.profile_entries))
-		for id := range m.profile_entries {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetAlertedThresholds resets all changes to the "alerted_thresholds" field.
+func (m *APIQuotaTrackerMutation) ResetAlertedThresholds() {
+	m.alerted_thresholds = nil
+	m.appendalerted_thresholds = nil
+	delete(m.clearedFields, apiquotatracker.FieldAlertedThresholds)
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JobExecutionHistoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedprofile_entries != nil {
-		edges = append(edges, jobexecutionhistory.EdgeProfileEntries)
-	}
-	return edges
+// SetLastCallAt sets the "last_call_at" field.
+func (m *APIQuotaTrackerMutation) SetLastCallAt(t time.Time) {
+	m.last_call_at = &t
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *JobExecutionHistoryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case jobexecutionhistory.EdgeProfileEntries:
-		ids := make([]ent.Value, 0, len(m.removedprofile_entries))
-		for id := range m.removedprofile_entries {
-			ids = append(ids, id)
-		}
-		return ids
+// LastCallAt returns the value of the "last_call_at" field in the mutation.
+func (m *APIQuotaTrackerMutation) LastCallAt() (r time.Time, exists bool) {
+	v := m.last_call_at
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JobExecutionHistoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedprofile_entries {
-		edges = append(edges, jobexecutionhistory.EdgeProfileEntries)
+// OldLastCallAt returns the old "last_call_at" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldLastCallAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastCallAt is only allowed on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastCallAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastCallAt: %w", err)
+	}
+	return oldValue.LastCallAt, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *JobExecutionHistoryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case jobexecutionhistory.EdgeProfileEntries:
-		return m.clearedprofile_entries
-	}
-	return false
+// ClearLastCallAt clears the value of the "last_call_at" field.
+func (m *APIQuotaTrackerMutation) ClearLastCallAt() {
+	m.last_call_at = nil
+	m.clearedFields[apiquotatracker.FieldLastCallAt] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *JobExecutionHistoryMutation) ClearEdge(name string) error {
-	switch name {
+// LastCallAtCleared returns if the "last_call_at" field was cleared in this mutation.
+func (m *APIQuotaTrackerMutation) LastCallAtCleared() bool {
+	_, ok := m.clearedFields[apiquotatracker.FieldLastCallAt]
+	return ok
+}
+
+// ResetLastCallAt resets all changes to the "last_call_at" field.
+func (m *APIQuotaTrackerMutation) ResetLastCallAt() {
+	m.last_call_at = nil
+	delete(m.clearedFields, apiquotatracker.FieldLastCallAt)
+}
+
+// SetRateLimitedUntil sets the "rate_limited_until" field.
+func (m *APIQuotaTrackerMutation) SetRateLimitedUntil(t time.Time) {
+	m.rate_limited_until = &t
+}
+
+// RateLimitedUntil returns the value of the "rate_limited_until" field in the mutation.
+func (m *APIQuotaTrackerMutation) RateLimitedUntil() (r time.Time, exists bool) {
+	v := m.rate_limited_until
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown JobExecutionHistory unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *JobExecutionHistoryMutation) ResetEdge(name string) error {
-	switch name {
-	case jobexecutionhistory.EdgeProfileEntries:
-		m.ResetProfileEntries()
-		return nil
+// OldRateLimitedUntil returns the old "rate_limited_until" field's value of the APIQuotaTracker entity.
+// If the APIQuotaTracker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIQuotaTrackerMutation) OldRateLimitedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRateLimitedUntil is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown JobExecutionHistory edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRateLimitedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRateLimitedUntil: %w", err)
+	}
+	return oldValue.RateLimitedUntil, nil
 }
 
-// ProfileMutation represents an operation that mutates the Profile nodes in the graph.
-type ProfileMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *ulid.ID
-	urn                  *string
-	username             *string
-	first_name           *string
-	last_name            *string
-	headline             *string
-	title                *string
-	country              *string
-	city                 *string
-	educations           *[]map[string]interface{}
-	appendeducations     []map[string]interface{}
-	positions            *[]map[string]interface{}
-	appendpositions      []map[string]interface{}
-	skills               *[]map[string]interface{}
-	appendskills         []map[string]interface{}
-	geo_data             *map[string]interface{}
-	raw_data_s3_key      *string
-	cleaned_data_s3_key  *string
-	source_file          *string
-	created_at           *time.Time
-	updated_at           *time.Time
-	clearedFields        map[string]struct{}
-	profile_entry        *ulid.ID
-	clearedprofile_entry bool
-	done                 bool
-	oldValue             func(context.Context) (*Profile, error)
-	predicates           []predicate.Profile
+// ClearRateLimitedUntil clears the value of the "rate_limited_until" field.
+func (m *APIQuotaTrackerMutation) ClearRateLimitedUntil() {
+	m.rate_limited_until = nil
+	m.clearedFields[apiquotatracker.FieldRateLimitedUntil] = struct{}{}
 }
 
-var _ ent.Mutation = (*ProfileMutation)(nil)
+// RateLimitedUntilCleared returns if the "rate_limited_until" field was cleared in this mutation.
+func (m *APIQuotaTrackerMutation) RateLimitedUntilCleared() bool {
+	_, ok := m.clearedFields[apiquotatracker.FieldRateLimitedUntil]
+	return ok
+}
 
-// profileOption allows management of the mutation configuration using functional options.
-type profileOption func(*ProfileMutation)
+// ResetRateLimitedUntil resets all changes to the "rate_limited_until" field.
+func (m *APIQuotaTrackerMutation) ResetRateLimitedUntil() {
+	m.rate_limited_until = nil
+	delete(m.clearedFields, apiquotatracker.FieldRateLimitedUntil)
+}
 
-// newProfileMutation creates new mutation for the Profile entity.
-func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation {
-	m := &ProfileMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeProfile,
-		clearedFields: make(map[string]struct{}),
+// AddReservationIDs adds the "reservations" edge to the APIQuotaReservation entity by ids.
+func (m *APIQuotaTrackerMutation) AddReservationIDs(ids ...ulid.ID) {
+	if m.reservations == nil {
+		m.reservations = make(map[ulid.ID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		m.reservations[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withProfileID sets the ID field of the mutation.
-func withProfileID(id ulid.ID) profileOption {
-	return func(m *ProfileMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Profile
-		)
-		m.oldValue = func(ctx context.Context) (*Profile, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Profile.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ClearReservations clears the "reservations" edge to the APIQuotaReservation entity.
+func (m *APIQuotaTrackerMutation) ClearReservations() {
+	m.clearedreservations = true
 }
 
-// withProfile sets the old Profile of the mutation.
-func withProfile(node *Profile) profileOption {
-	return func(m *ProfileMutation) {
-		m.oldValue = func(context.Context) (*Profile, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// ReservationsCleared reports if the "reservations" edge to the APIQuotaReservation entity was cleared.
+func (m *APIQuotaTrackerMutation) ReservationsCleared() bool {
+	return m.clearedreservations
+}
+
+// RemoveReservationIDs removes the "reservations" edge to the APIQuotaReservation entity by IDs.
+func (m *APIQuotaTrackerMutation) RemoveReservationIDs(ids ...ulid.ID) {
+	if m.removedreservations == nil {
+		m.removedreservations = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.reservations, ids[i])
+		m.removedreservations[ids[i]] = struct{}{}
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProfileMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// RemovedReservations returns the removed IDs of the "reservations" edge to the APIQuotaReservation entity.
+func (m *APIQuotaTrackerMutation) RemovedReservationsIDs() (ids []ulid.ID) {
+	for id := range m.removedreservations {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ProfileMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// ReservationsIDs returns the "reservations" edge IDs in the mutation.
+func (m *APIQuotaTrackerMutation) ReservationsIDs() (ids []ulid.ID) {
+	for id := range m.reservations {
+		ids = append(ids, id)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Profile entities.
-func (m *ProfileMutation) SetID(id ulid.ID) {
-	m.id = &id
+// ResetReservations resets all changes to the "reservations" edge.
+func (m *APIQuotaTrackerMutation) ResetReservations() {
+	m.reservations = nil
+	m.clearedreservations = false
+	m.removedreservations = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ProfileMutation) ID() (id ulid.ID, exists bool) {
-	if m.id == nil {
-		return
+// AddBudgetReservationIDs adds the "budget_reservations" edge to the APIQuotaReservation entity by ids.
+func (m *APIQuotaTrackerMutation) AddBudgetReservationIDs(ids ...ulid.ID) {
+	if m.budget_reservations == nil {
+		m.budget_reservations = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.budget_reservations[ids[i]] = struct{}{}
 	}
-	return *m.id, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ProfileMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []ulid.ID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Profile.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ClearBudgetReservations clears the "budget_reservations" edge to the APIQuotaReservation entity.
+func (m *APIQuotaTrackerMutation) ClearBudgetReservations() {
+	m.clearedbudget_reservations = true
 }
 
-// SetUrn sets the "urn" field.
-func (m *ProfileMutation) SetUrn(s string) {
-	m.urn = &s
+// BudgetReservationsCleared reports if the "budget_reservations" edge to the APIQuotaReservation entity was cleared.
+func (m *APIQuotaTrackerMutation) BudgetReservationsCleared() bool {
+	return m.clearedbudget_reservations
 }
 
-// Urn returns the value of the "urn" field in the mutation.
-func (m *ProfileMutation) Urn() (r string, exists bool) {
-	v := m.urn
-	if v == nil {
-		return
+// RemoveBudgetReservationIDs removes the "budget_reservations" edge to the APIQuotaReservation entity by IDs.
+func (m *APIQuotaTrackerMutation) RemoveBudgetReservationIDs(ids ...ulid.ID) {
+	if m.removedbudget_reservations == nil {
+		m.removedbudget_reservations = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.budget_reservations, ids[i])
+		m.removedbudget_reservations[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUrn returns the old "urn" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldUrn(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUrn is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUrn requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUrn: %w", err)
+// RemovedBudgetReservations returns the removed IDs of the "budget_reservations" edge to the APIQuotaReservation entity.
+func (m *APIQuotaTrackerMutation) RemovedBudgetReservationsIDs() (ids []ulid.ID) {
+	for id := range m.removedbudget_reservations {
+		ids = append(ids, id)
 	}
-	return oldValue.Urn, nil
+	return
 }
 
-// ResetUrn resets all changes to the "urn" field.
-func (m *ProfileMutation) ResetUrn() {
-	m.urn = nil
+// BudgetReservationsIDs returns the "budget_reservations" edge IDs in the mutation.
+func (m *APIQuotaTrackerMutation) BudgetReservationsIDs() (ids []ulid.ID) {
+	for id := range m.budget_reservations {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUsername sets the "username" field.
-func (m *ProfileMutation) SetUsername(s string) {
-	m.username = &s
+// ResetBudgetReservations resets all changes to the "budget_reservations" edge.
+func (m *APIQuotaTrackerMutation) ResetBudgetReservations() {
+	m.budget_reservations = nil
+	m.clearedbudget_reservations = false
+	m.removedbudget_reservations = nil
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *ProfileMutation) Username() (r string, exists bool) {
-	v := m.username
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the APIQuotaTrackerMutation builder.
+func (m *APIQuotaTrackerMutation) Where(ps ...predicate.APIQuotaTracker) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldUsername returns the old "username" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldUsername(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+// WhereP appends storage-level predicates to the APIQuotaTrackerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APIQuotaTrackerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIQuotaTracker, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.Username, nil
-}
-
-// ClearUsername clears the value of the "username" field.
-func (m *ProfileMutation) ClearUsername() {
-	m.username = nil
-	m.clearedFields[profile.FieldUsername] = struct{}{}
+	m.Where(p...)
 }
 
-// UsernameCleared returns if the "username" field was cleared in this mutation.
-func (m *ProfileMutation) UsernameCleared() bool {
-	_, ok := m.clearedFields[profile.FieldUsername]
-	return ok
+// Op returns the operation name.
+func (m *APIQuotaTrackerMutation) Op() Op {
+	return m.op
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *ProfileMutation) ResetUsername() {
-	m.username = nil
-	delete(m.clearedFields, profile.FieldUsername)
+// SetOp allows setting the mutation operation.
+func (m *APIQuotaTrackerMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetFirstName sets the "first_name" field.
-func (m *ProfileMutation) SetFirstName(s string) {
-	m.first_name = &s
+// Type returns the node type of this mutation (APIQuotaTracker).
+func (m *APIQuotaTrackerMutation) Type() string {
+	return m.typ
 }
 
-// FirstName returns the value of the "first_name" field in the mutation.
-func (m *ProfileMutation) FirstName() (r string, exists bool) {
-	v := m.first_name
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *APIQuotaTrackerMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.created_at != nil {
+		fields = append(fields, apiquotatracker.FieldCreatedAt)
 	}
-	return *v, true
-}
-
-// OldFirstName returns the old "first_name" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldFirstName(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
+	if m.updated_at != nil {
+		fields = append(fields, apiquotatracker.FieldUpdatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstName requires an ID field in the mutation")
+	if m.key_label != nil {
+		fields = append(fields, apiquotatracker.FieldKeyLabel)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+	if m.budget != nil {
+		fields = append(fields, apiquotatracker.FieldBudget)
 	}
-	return oldValue.FirstName, nil
-}
-
-// ClearFirstName clears the value of the "first_name" field.
-func (m *ProfileMutation) ClearFirstName() {
-	m.first_name = nil
-	m.clearedFields[profile.FieldFirstName] = struct{}{}
-}
-
-// FirstNameCleared returns if the "first_name" field was cleared in this mutation.
-func (m *ProfileMutation) FirstNameCleared() bool {
-	_, ok := m.clearedFields[profile.FieldFirstName]
-	return ok
-}
-
-// ResetFirstName resets all changes to the "first_name" field.
-func (m *ProfileMutation) ResetFirstName() {
-	m.first_name = nil
-	delete(m.clearedFields, profile.FieldFirstName)
-}
-
-// SetLastName sets the "last_name" field.
-func (m *ProfileMutation) SetLastName(s string) {
-	m.last_name = &s
-}
-
-// LastName returns the value of the "last_name" field in the mutation.
-func (m *ProfileMutation) LastName() (r string, exists bool) {
-	v := m.last_name
-	if v == nil {
-		return
+	if m.month != nil {
+		fields = append(fields, apiquotatracker.FieldMonth)
 	}
-	return *v, true
-}
-
-// OldLastName returns the old "last_name" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldLastName(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+	if m.year != nil {
+		fields = append(fields, apiquotatracker.FieldYear)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastName requires an ID field in the mutation")
+	if m.call_count != nil {
+		fields = append(fields, apiquotatracker.FieldCallCount)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+	if m.reserved_count != nil {
+		fields = append(fields, apiquotatracker.FieldReservedCount)
 	}
-	return oldValue.LastName, nil
-}
-
-// ClearLastName clears the value of the "last_name" field.
-func (m *ProfileMutation) ClearLastName() {
-	m.last_name = nil
-	m.clearedFields[profile.FieldLastName] = struct{}{}
-}
-
-// LastNameCleared returns if the "last_name" field was cleared in this mutation.
-func (m *ProfileMutation) LastNameCleared() bool {
-	_, ok := m.clearedFields[profile.FieldLastName]
-	return ok
-}
-
-// ResetLastName resets all changes to the "last_name" field.
-func (m *ProfileMutation) ResetLastName() {
-	m.last_name = nil
-	delete(m.clearedFields, profile.FieldLastName)
-}
-
-// SetHeadline sets the "headline" field.
-func (m *ProfileMutation) SetHeadline(s string) {
-	m.headline = &s
-}
-
-// Headline returns the value of the "headline" field in the mutation.
-func (m *ProfileMutation) Headline() (r string, exists bool) {
-	v := m.headline
-	if v == nil {
-		return
+	if m.quota_limit != nil {
+		fields = append(fields, apiquotatracker.FieldQuotaLimit)
 	}
-	return *v, true
-}
-
-// OldHeadline returns the old "headline" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldHeadline(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHeadline is only allowed on UpdateOne operations")
+	if m.daily_cap != nil {
+		fields = append(fields, apiquotatracker.FieldDailyCap)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHeadline requires an ID field in the mutation")
+	if m.hourly_cap != nil {
+		fields = append(fields, apiquotatracker.FieldHourlyCap)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHeadline: %w", err)
+	if m.even_pacing != nil {
+		fields = append(fields, apiquotatracker.FieldEvenPacing)
 	}
-	return oldValue.Headline, nil
-}
-
-// ClearHeadline clears the value of the "headline" field.
-func (m *ProfileMutation) ClearHeadline() {
-	m.headline = nil
-	m.clearedFields[profile.FieldHeadline] = struct{}{}
+	if m.quota_exceeded != nil {
+		fields = append(fields, apiquotatracker.FieldQuotaExceeded)
+	}
+	if m.override_enabled != nil {
+		fields = append(fields, apiquotatracker.FieldOverrideEnabled)
+	}
+	if m.notification_sent != nil {
+		fields = append(fields, apiquotatracker.FieldNotificationSent)
+	}
+	if m.alerted_thresholds != nil {
+		fields = append(fields, apiquotatracker.FieldAlertedThresholds)
+	}
+	if m.last_call_at != nil {
+		fields = append(fields, apiquotatracker.FieldLastCallAt)
+	}
+	if m.rate_limited_until != nil {
+		fields = append(fields, apiquotatracker.FieldRateLimitedUntil)
+	}
+	return fields
 }
 
-// HeadlineCleared returns if the "headline" field was cleared in this mutation.
-func (m *ProfileMutation) HeadlineCleared() bool {
-	_, ok := m.clearedFields[profile.FieldHeadline]
-	return ok
-}
-
-// ResetHeadline resets all changes to the "headline" field.
-func (m *ProfileMutation) ResetHeadline() {
-	m.headline = nil
-	delete(m.clearedFields, profile.FieldHeadline)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *APIQuotaTrackerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case apiquotatracker.FieldCreatedAt:
+		return m.CreatedAt()
+	case apiquotatracker.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case apiquotatracker.FieldKeyLabel:
+		return m.KeyLabel()
+	case apiquotatracker.FieldBudget:
+		return m.Budget()
+	case apiquotatracker.FieldMonth:
+		return m.Month()
+	case apiquotatracker.FieldYear:
+		return m.Year()
+	case apiquotatracker.FieldCallCount:
+		return m.CallCount()
+	case apiquotatracker.FieldReservedCount:
+		return m.ReservedCount()
+	case apiquotatracker.FieldQuotaLimit:
+		return m.QuotaLimit()
+	case apiquotatracker.FieldDailyCap:
+		return m.DailyCap()
+	case apiquotatracker.FieldHourlyCap:
+		return m.HourlyCap()
+	case apiquotatracker.FieldEvenPacing:
+		return m.EvenPacing()
+	case apiquotatracker.FieldQuotaExceeded:
+		return m.QuotaExceeded()
+	case apiquotatracker.FieldOverrideEnabled:
+		return m.OverrideEnabled()
+	case apiquotatracker.FieldNotificationSent:
+		return m.NotificationSent()
+	case apiquotatracker.FieldAlertedThresholds:
+		return m.AlertedThresholds()
+	case apiquotatracker.FieldLastCallAt:
+		return m.LastCallAt()
+	case apiquotatracker.FieldRateLimitedUntil:
+		return m.RateLimitedUntil()
+	}
+	return nil, false
 }
 
-// SetTitle sets the "title" field.
-func (m *ProfileMutation) SetTitle(s string) {
-	m.title = &s
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *APIQuotaTrackerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case apiquotatracker.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case apiquotatracker.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case apiquotatracker.FieldKeyLabel:
+		return m.OldKeyLabel(ctx)
+	case apiquotatracker.FieldBudget:
+		return m.OldBudget(ctx)
+	case apiquotatracker.FieldMonth:
+		return m.OldMonth(ctx)
+	case apiquotatracker.FieldYear:
+		return m.OldYear(ctx)
+	case apiquotatracker.FieldCallCount:
+		return m.OldCallCount(ctx)
+	case apiquotatracker.FieldReservedCount:
+		return m.OldReservedCount(ctx)
+	case apiquotatracker.FieldQuotaLimit:
+		return m.OldQuotaLimit(ctx)
+	case apiquotatracker.FieldDailyCap:
+		return m.OldDailyCap(ctx)
+	case apiquotatracker.FieldHourlyCap:
+		return m.OldHourlyCap(ctx)
+	case apiquotatracker.FieldEvenPacing:
+		return m.OldEvenPacing(ctx)
+	case apiquotatracker.FieldQuotaExceeded:
+		return m.OldQuotaExceeded(ctx)
+	case apiquotatracker.FieldOverrideEnabled:
+		return m.OldOverrideEnabled(ctx)
+	case apiquotatracker.FieldNotificationSent:
+		return m.OldNotificationSent(ctx)
+	case apiquotatracker.FieldAlertedThresholds:
+		return m.OldAlertedThresholds(ctx)
+	case apiquotatracker.FieldLastCallAt:
+		return m.OldLastCallAt(ctx)
+	case apiquotatracker.FieldRateLimitedUntil:
+		return m.OldRateLimitedUntil(ctx)
+	}
+	return nil, fmt.Errorf("unknown APIQuotaTracker field %s", name)
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ProfileMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIQuotaTrackerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case apiquotatracker.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case apiquotatracker.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case apiquotatracker.FieldKeyLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyLabel(v)
+		return nil
+	case apiquotatracker.FieldBudget:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBudget(v)
+		return nil
+	case apiquotatracker.FieldMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonth(v)
+		return nil
+	case apiquotatracker.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetYear(v)
+		return nil
+	case apiquotatracker.FieldCallCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCallCount(v)
+		return nil
+	case apiquotatracker.FieldReservedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReservedCount(v)
+		return nil
+	case apiquotatracker.FieldQuotaLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuotaLimit(v)
+		return nil
+	case apiquotatracker.FieldDailyCap:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDailyCap(v)
+		return nil
+	case apiquotatracker.FieldHourlyCap:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHourlyCap(v)
+		return nil
+	case apiquotatracker.FieldEvenPacing:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvenPacing(v)
+		return nil
+	case apiquotatracker.FieldQuotaExceeded:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuotaExceeded(v)
+		return nil
+	case apiquotatracker.FieldOverrideEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverrideEnabled(v)
+		return nil
+	case apiquotatracker.FieldNotificationSent:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotificationSent(v)
+		return nil
+	case apiquotatracker.FieldAlertedThresholds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlertedThresholds(v)
+		return nil
+	case apiquotatracker.FieldLastCallAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastCallAt(v)
+		return nil
+	case apiquotatracker.FieldRateLimitedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRateLimitedUntil(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown APIQuotaTracker field %s", name)
 }
 
-// OldTitle returns the old "title" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldTitle(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *APIQuotaTrackerMutation) AddedFields() []string {
+	var fields []string
+	if m.addmonth != nil {
+		fields = append(fields, apiquotatracker.FieldMonth)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+	if m.addyear != nil {
+		fields = append(fields, apiquotatracker.FieldYear)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	if m.addcall_count != nil {
+		fields = append(fields, apiquotatracker.FieldCallCount)
 	}
-	return oldValue.Title, nil
-}
-
-// ClearTitle clears the value of the "title" field.
-func (m *ProfileMutation) ClearTitle() {
-	m.title = nil
-	m.clearedFields[profile.FieldTitle] = struct{}{}
-}
-
-// TitleCleared returns if the "title" field was cleared in this mutation.
-func (m *ProfileMutation) TitleCleared() bool {
-	_, ok := m.clearedFields[profile.FieldTitle]
-	return ok
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *ProfileMutation) ResetTitle() {
-	m.title = nil
-	delete(m.clearedFields, profile.FieldTitle)
-}
-
-// SetCountry sets the "country" field.
-func (m *ProfileMutation) SetCountry(s string) {
-	m.country = &s
-}
-
-// Country returns the value of the "country" field in the mutation.
-func (m *ProfileMutation) Country() (r string, exists bool) {
-	v := m.country
-	if v == nil {
-		return
+	if m.addreserved_count != nil {
+		fields = append(fields, apiquotatracker.FieldReservedCount)
 	}
-	return *v, true
-}
-
-// OldCountry returns the old "country" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldCountry(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	if m.addquota_limit != nil {
+		fields = append(fields, apiquotatracker.FieldQuotaLimit)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCountry requires an ID field in the mutation")
+	if m.adddaily_cap != nil {
+		fields = append(fields, apiquotatracker.FieldDailyCap)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	if m.addhourly_cap != nil {
+		fields = append(fields, apiquotatracker.FieldHourlyCap)
 	}
-	return oldValue.Country, nil
-}
-
-// ClearCountry clears the value of the "country" field.
-func (m *ProfileMutation) ClearCountry() {
-	m.country = nil
-	m.clearedFields[profile.FieldCountry] = struct{}{}
-}
-
-// CountryCleared returns if the "country" field was cleared in this mutation.
-func (m *ProfileMutation) CountryCleared() bool {
-	_, ok := m.clearedFields[profile.FieldCountry]
-	return ok
-}
-
-// ResetCountry resets all changes to the "country" field.
-func (m *ProfileMutation) ResetCountry() {
-	m.country = nil
-	delete(m.clearedFields, profile.FieldCountry)
+	return fields
 }
 
-// SetCity sets the "city" field.
-func (m *ProfileMutation) SetCity(s string) {
-	m.city = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *APIQuotaTrackerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case apiquotatracker.FieldMonth:
+		return m.AddedMonth()
+	case apiquotatracker.FieldYear:
+		return m.AddedYear()
+	case apiquotatracker.FieldCallCount:
+		return m.AddedCallCount()
+	case apiquotatracker.FieldReservedCount:
+		return m.AddedReservedCount()
+	case apiquotatracker.FieldQuotaLimit:
+		return m.AddedQuotaLimit()
+	case apiquotatracker.FieldDailyCap:
+		return m.AddedDailyCap()
+	case apiquotatracker.FieldHourlyCap:
+		return m.AddedHourlyCap()
+	}
+	return nil, false
 }
 
-// City returns the value of the "city" field in the mutation.
-func (m *ProfileMutation) City() (r string, exists bool) {
-	v := m.city
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIQuotaTrackerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case apiquotatracker.FieldMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonth(v)
+		return nil
+	case apiquotatracker.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYear(v)
+		return nil
+	case apiquotatracker.FieldCallCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCallCount(v)
+		return nil
+	case apiquotatracker.FieldReservedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReservedCount(v)
+		return nil
+	case apiquotatracker.FieldQuotaLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuotaLimit(v)
+		return nil
+	case apiquotatracker.FieldDailyCap:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDailyCap(v)
+		return nil
+	case apiquotatracker.FieldHourlyCap:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHourlyCap(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown APIQuotaTracker numeric field %s", name)
 }
 
-// OldCity returns the old "city" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldCity(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCity is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *APIQuotaTrackerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(apiquotatracker.FieldDailyCap) {
+		fields = append(fields, apiquotatracker.FieldDailyCap)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCity requires an ID field in the mutation")
+	if m.FieldCleared(apiquotatracker.FieldHourlyCap) {
+		fields = append(fields, apiquotatracker.FieldHourlyCap)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCity: %w", err)
+	if m.FieldCleared(apiquotatracker.FieldAlertedThresholds) {
+		fields = append(fields, apiquotatracker.FieldAlertedThresholds)
 	}
-	return oldValue.City, nil
-}
-
-// ClearCity clears the value of the "city" field.
-func (m *ProfileMutation) ClearCity() {
-	m.city = nil
-	m.clearedFields[profile.FieldCity] = struct{}{}
+	if m.FieldCleared(apiquotatracker.FieldLastCallAt) {
+		fields = append(fields, apiquotatracker.FieldLastCallAt)
+	}
+	if m.FieldCleared(apiquotatracker.FieldRateLimitedUntil) {
+		fields = append(fields, apiquotatracker.FieldRateLimitedUntil)
+	}
+	return fields
 }
 
-// CityCleared returns if the "city" field was cleared in this mutation.
-func (m *ProfileMutation) CityCleared() bool {
-	_, ok := m.clearedFields[profile.FieldCity]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *APIQuotaTrackerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetCity resets all changes to the "city" field.
-func (m *ProfileMutation) ResetCity() {
-	m.city = nil
-	delete(m.clearedFields, profile.FieldCity)
-}
-
-// SetEducations sets the "educations" field.
-func (m *ProfileMutation) SetEducations(value []map[string]interface{}) {
-	m.educations = &value
-	m.appendeducations = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *APIQuotaTrackerMutation) ClearField(name string) error {
+	switch name {
+	case apiquotatracker.FieldDailyCap:
+		m.ClearDailyCap()
+		return nil
+	case apiquotatracker.FieldHourlyCap:
+		m.ClearHourlyCap()
+		return nil
+	case apiquotatracker.FieldAlertedThresholds:
+		m.ClearAlertedThresholds()
+		return nil
+	case apiquotatracker.FieldLastCallAt:
+		m.ClearLastCallAt()
+		return nil
+	case apiquotatracker.FieldRateLimitedUntil:
+		m.ClearRateLimitedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown APIQuotaTracker nullable field %s", name)
 }
 
-// Educations returns the value of the "educations" field in the mutation.
-func (m *ProfileMutation) Educations() (r []map[string]interface{}, exists bool) {
-	v := m.educations
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *APIQuotaTrackerMutation) ResetField(name string) error {
+	switch name {
+	case apiquotatracker.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case apiquotatracker.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case apiquotatracker.FieldKeyLabel:
+		m.ResetKeyLabel()
+		return nil
+	case apiquotatracker.FieldBudget:
+		m.ResetBudget()
+		return nil
+	case apiquotatracker.FieldMonth:
+		m.ResetMonth()
+		return nil
+	case apiquotatracker.FieldYear:
+		m.ResetYear()
+		return nil
+	case apiquotatracker.FieldCallCount:
+		m.ResetCallCount()
+		return nil
+	case apiquotatracker.FieldReservedCount:
+		m.ResetReservedCount()
+		return nil
+	case apiquotatracker.FieldQuotaLimit:
+		m.ResetQuotaLimit()
+		return nil
+	case apiquotatracker.FieldDailyCap:
+		m.ResetDailyCap()
+		return nil
+	case apiquotatracker.FieldHourlyCap:
+		m.ResetHourlyCap()
+		return nil
+	case apiquotatracker.FieldEvenPacing:
+		m.ResetEvenPacing()
+		return nil
+	case apiquotatracker.FieldQuotaExceeded:
+		m.ResetQuotaExceeded()
+		return nil
+	case apiquotatracker.FieldOverrideEnabled:
+		m.ResetOverrideEnabled()
+		return nil
+	case apiquotatracker.FieldNotificationSent:
+		m.ResetNotificationSent()
+		return nil
+	case apiquotatracker.FieldAlertedThresholds:
+		m.ResetAlertedThresholds()
+		return nil
+	case apiquotatracker.FieldLastCallAt:
+		m.ResetLastCallAt()
+		return nil
+	case apiquotatracker.FieldRateLimitedUntil:
+		m.ResetRateLimitedUntil()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown APIQuotaTracker field %s", name)
 }
 
-// OldEducations returns the old "educations" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldEducations(ctx context.Context) (v []map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEducations is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *APIQuotaTrackerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.reservations != nil {
+		edges = append(edges, apiquotatracker.EdgeReservations)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEducations requires an ID field in the mutation")
+	if m.budget_reservations != nil {
+		edges = append(edges, apiquotatracker.EdgeBudgetReservations)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEducations: %w", err)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *APIQuotaTrackerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case apiquotatracker.EdgeReservations:
+		ids := make([]ent.Value, 0, len(m.reservations))
+		for id := range m.reservations {
+			ids = append(ids, id)
+		}
+		return ids
+	case apiquotatracker.EdgeBudgetReservations:
+		ids := make([]ent.Value, 0, len(m.budget_reservations))
+		for id := range m.budget_reservations {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.Educations, nil
+	return nil
 }
 
-// AppendEducations adds value to the "educations" field.
-func (m *ProfileMutation) AppendEducations(value []map[string]interface{}) {
-	m.appendeducations = append(m.appendeducations, value...)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *APIQuotaTrackerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedreservations != nil {
+		edges = append(edges, apiquotatracker.EdgeReservations)
+	}
+	if m.removedbudget_reservations != nil {
+		edges = append(edges, apiquotatracker.EdgeBudgetReservations)
+	}
+	return edges
 }
 
-// AppendedEducations returns the list of values that were appended to the "educations" field in this mutation.
-func (m *ProfileMutation) AppendedEducations() ([]map[string]interface{}, bool) {
-	if len(m.appendeducations) == 0 {
-		return nil, false
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *APIQuotaTrackerMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case apiquotatracker.EdgeReservations:
+		ids := make([]ent.Value, 0, len(m.removedreservations))
+		for id := range m.removedreservations {
+			ids = append(ids, id)
+		}
+		return ids
+	case apiquotatracker.EdgeBudgetReservations:
+		ids := make([]ent.Value, 0, len(m.removedbudget_reservations))
+		for id := range m.removedbudget_reservations {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return m.appendeducations, true
+	return nil
 }
 
-// ClearEducations clears the value of the "educations" field.
-func (m *ProfileMutation) ClearEducations() {
-	m.educations = nil
-	m.appendeducations = nil
-	m.clearedFields[profile.FieldEducations] = struct{}{}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *APIQuotaTrackerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedreservations {
+		edges = append(edges, apiquotatracker.EdgeReservations)
+	}
+	if m.clearedbudget_reservations {
+		edges = append(edges, apiquotatracker.EdgeBudgetReservations)
+	}
+	return edges
 }
 
-// EducationsCleared returns if the "educations" field was cleared in this mutation.
-func (m *ProfileMutation) EducationsCleared() bool {
-	_, ok := m.clearedFields[profile.FieldEducations]
-	return ok
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *APIQuotaTrackerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case apiquotatracker.EdgeReservations:
+		return m.clearedreservations
+	case apiquotatracker.EdgeBudgetReservations:
+		return m.clearedbudget_reservations
+	}
+	return false
 }
 
-// ResetEducations resets all changes to the "educations" field.
-func (m *ProfileMutation) ResetEducations() {
-	m.educations = nil
-	m.appendeducations = nil
-	delete(m.clearedFields, profile.FieldEducations)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *APIQuotaTrackerMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown APIQuotaTracker unique edge %s", name)
 }
 
-// SetPositions sets the "positions" field.
-func (m *ProfileMutation) SetPositions(value []map[string]interface{}) {
-	m.positions = &value
-	m.appendpositions = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *APIQuotaTrackerMutation) ResetEdge(name string) error {
+	switch name {
+	case apiquotatracker.EdgeReservations:
+		m.ResetReservations()
+		return nil
+	case apiquotatracker.EdgeBudgetReservations:
+		m.ResetBudgetReservations()
+		return nil
+	}
+	return fmt.Errorf("unknown APIQuotaTracker edge %s", name)
 }
 
-// Positions returns the value of the "positions" field in the mutation.
-func (m *ProfileMutation) Positions() (r []map[string]interface{}, exists bool) {
-	v := m.positions
-	if v == nil {
-		return
+// CompanyMutation represents an operation that mutates the Company nodes in the graph.
+type CompanyMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *ulid.ID
+	key                      *string
+	name                     *string
+	normalized_name          *string
+	linkedin_urn             *string
+	linkedin_username        *string
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	positions                map[ulid.ID]struct{}
+	removedpositions         map[ulid.ID]struct{}
+	clearedpositions         bool
+	current_employees        map[ulid.ID]struct{}
+	removedcurrent_employees map[ulid.ID]struct{}
+	clearedcurrent_employees bool
+	alumni                   map[ulid.ID]struct{}
+	removedalumni            map[ulid.ID]struct{}
+	clearedalumni            bool
+	done                     bool
+	oldValue                 func(context.Context) (*Company, error)
+	predicates               []predicate.Company
+}
+
+var _ ent.Mutation = (*CompanyMutation)(nil)
+
+// companyOption allows management of the mutation configuration using functional options.
+type companyOption func(*CompanyMutation)
+
+// newCompanyMutation creates new mutation for the Company entity.
+func newCompanyMutation(c config, op Op, opts ...companyOption) *CompanyMutation {
+	m := &CompanyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCompany,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldPositions returns the old "positions" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldPositions(ctx context.Context) (v []map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPositions is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPositions requires an ID field in the mutation")
+// withCompanyID sets the ID field of the mutation.
+func withCompanyID(id ulid.ID) companyOption {
+	return func(m *CompanyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Company
+		)
+		m.oldValue = func(ctx context.Context) (*Company, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Company.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPositions: %w", err)
+}
+
+// withCompany sets the old Company of the mutation.
+func withCompany(node *Company) companyOption {
+	return func(m *CompanyMutation) {
+		m.oldValue = func(context.Context) (*Company, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.Positions, nil
 }
 
-// AppendPositions adds value to the "positions" field.
-func (m *ProfileMutation) AppendPositions(value []map[string]interface{}) {
-	m.appendpositions = append(m.appendpositions, value...)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CompanyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AppendedPositions returns the list of values that were appended to the "positions" field in this mutation.
-func (m *ProfileMutation) AppendedPositions() ([]map[string]interface{}, bool) {
-	if len(m.appendpositions) == 0 {
-		return nil, false
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CompanyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return m.appendpositions, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearPositions clears the value of the "positions" field.
-func (m *ProfileMutation) ClearPositions() {
-	m.positions = nil
-	m.appendpositions = nil
-	m.clearedFields[profile.FieldPositions] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Company entities.
+func (m *CompanyMutation) SetID(id ulid.ID) {
+	m.id = &id
 }
 
-// PositionsCleared returns if the "positions" field was cleared in this mutation.
-func (m *ProfileMutation) PositionsCleared() bool {
-	_, ok := m.clearedFields[profile.FieldPositions]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CompanyMutation) ID() (id ulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetPositions resets all changes to the "positions" field.
-func (m *ProfileMutation) ResetPositions() {
-	m.positions = nil
-	m.appendpositions = nil
-	delete(m.clearedFields, profile.FieldPositions)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CompanyMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []ulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Company.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetSkills sets the "skills" field.
-func (m *ProfileMutation) SetSkills(value []map[string]interface{}) {
-	m.skills = &value
-	m.appendskills = nil
+// SetKey sets the "key" field.
+func (m *CompanyMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// Skills returns the value of the "skills" field in the mutation.
-func (m *ProfileMutation) Skills() (r []map[string]interface{}, exists bool) {
-	v := m.skills
+// Key returns the value of the "key" field in the mutation.
+func (m *CompanyMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSkills returns the old "skills" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldSkills(ctx context.Context) (v []map[string]interface{}, err error) {
+func (m *CompanyMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkills is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkills requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkills: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.Skills, nil
+	return oldValue.Key, nil
 }
 
-// AppendSkills adds value to the "skills" field.
-func (m *ProfileMutation) AppendSkills(value []map[string]interface{}) {
-	m.appendskills = append(m.appendskills, value...)
+// ResetKey resets all changes to the "key" field.
+func (m *CompanyMutation) ResetKey() {
+	m.key = nil
 }
 
-// AppendedSkills returns the list of values that were appended to the "skills" field in this mutation.
-func (m *ProfileMutation) AppendedSkills() ([]map[string]interface{}, bool) {
-	if len(m.appendskills) == 0 {
-		return nil, false
-	}
-	return m.appendskills, true
+// SetName sets the "name" field.
+func (m *CompanyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ClearSkills clears the value of the "skills" field.
-func (m *ProfileMutation) ClearSkills() {
-	m.skills = nil
-	m.appendskills = nil
-	m.clearedFields[profile.FieldSkills] = struct{}{}
+// Name returns the value of the "name" field in the mutation.
+func (m *CompanyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SkillsCleared returns if the "skills" field was cleared in this mutation.
-func (m *ProfileMutation) SkillsCleared() bool {
-	_, ok := m.clearedFields[profile.FieldSkills]
-	return ok
+// OldName returns the old "name" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompanyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// ResetSkills resets all changes to the "skills" field.
-func (m *ProfileMutation) ResetSkills() {
-	m.skills = nil
-	m.appendskills = nil
-	delete(m.clearedFields, profile.FieldSkills)
+// ResetName resets all changes to the "name" field.
+func (m *CompanyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetGeoData sets the "geo_data" field.
-func (m *ProfileMutation) SetGeoData(value map[string]interface{}) {
-	m.geo_data = &value
+// SetNormalizedName sets the "normalized_name" field.
+func (m *CompanyMutation) SetNormalizedName(s string) {
+	m.normalized_name = &s
 }
 
-// GeoData returns the value of the "geo_data" field in the mutation.
-func (m *ProfileMutation) GeoData() (r map[string]interface{}, exists bool) {
-	v := m.geo_data
+// NormalizedName returns the value of the "normalized_name" field in the mutation.
+func (m *CompanyMutation) NormalizedName() (r string, exists bool) {
+	v := m.normalized_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGeoData returns the old "geo_data" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldNormalizedName returns the old "normalized_name" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldGeoData(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *CompanyMutation) OldNormalizedName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGeoData is only allowed on UpdateOne operations")
+		return v, errors.New("OldNormalizedName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGeoData requires an ID field in the mutation")
+		return v, errors.New("OldNormalizedName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGeoData: %w", err)
+		return v, fmt.Errorf("querying old value for OldNormalizedName: %w", err)
 	}
-	return oldValue.GeoData, nil
-}
-
-// ClearGeoData clears the value of the "geo_data" field.
-func (m *ProfileMutation) ClearGeoData() {
-	m.geo_data = nil
-	m.clearedFields[profile.FieldGeoData] = struct{}{}
-}
-
-// GeoDataCleared returns if the "geo_data" field was cleared in this mutation.
-func (m *ProfileMutation) GeoDataCleared() bool {
-	_, ok := m.clearedFields[profile.FieldGeoData]
-	return ok
+	return oldValue.NormalizedName, nil
 }
 
-// ResetGeoData resets all changes to the "geo_data" field.
-func (m *ProfileMutation) ResetGeoData() {
-	m.geo_data = nil
-	delete(m.clearedFields, profile.FieldGeoData)
+// ResetNormalizedName resets all changes to the "normalized_name" field.
+func (m *CompanyMutation) ResetNormalizedName() {
+	m.normalized_name = nil
 }
 
-// SetRawDataS3Key sets the "raw_data_s3_key" field.
-func (m *ProfileMutation) SetRawDataS3Key(s string) {
-	m.raw_data_s3_key = &s
+// SetLinkedinUrn sets the "linkedin_urn" field.
+func (m *CompanyMutation) SetLinkedinUrn(s string) {
+	m.linkedin_urn = &s
 }
 
-// RawDataS3Key returns the value of the "raw_data_s3_key" field in the mutation.
-func (m *ProfileMutation) RawDataS3Key() (r string, exists bool) {
-	v := m.raw_data_s3_key
+// LinkedinUrn returns the value of the "linkedin_urn" field in the mutation.
+func (m *CompanyMutation) LinkedinUrn() (r string, exists bool) {
+	v := m.linkedin_urn
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRawDataS3Key returns the old "raw_data_s3_key" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldLinkedinUrn returns the old "linkedin_urn" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldRawDataS3Key(ctx context.Context) (v *string, err error) {
+func (m *CompanyMutation) OldLinkedinUrn(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRawDataS3Key is only allowed on UpdateOne operations")
+		return v, errors.New("OldLinkedinUrn is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRawDataS3Key requires an ID field in the mutation")
+		return v, errors.New("OldLinkedinUrn requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRawDataS3Key: %w", err)
+		return v, fmt.Errorf("querying old value for OldLinkedinUrn: %w", err)
 	}
-	return oldValue.RawDataS3Key, nil
+	return oldValue.LinkedinUrn, nil
 }
 
-// ClearRawDataS3Key clears the value of the "raw_data_s3_key" field.
-func (m *ProfileMutation) ClearRawDataS3Key() {
-	m.raw_data_s3_key = nil
-	m.clearedFields[profile.FieldRawDataS3Key] = struct{}{}
-}
-
-// RawDataS3KeyCleared returns if the "raw_data_s3_key" field was cleared in this mutation.
-func (m *ProfileMutation) RawDataS3KeyCleared() bool {
-	_, ok := m.clearedFields[profile.FieldRawDataS3Key]
-	return ok
-}
-
-// ResetRawDataS3Key resets all changes to the "raw_data_s3_key" field.
-func (m *ProfileMutation) ResetRawDataS3Key() {
-	m.raw_data_s3_key = nil
-	delete(m.clearedFields, profile.FieldRawDataS3Key)
-}
-
-// SetCleanedDataS3Key sets the "cleaned_data_s3_key" field.
-func (m *ProfileMutation) SetCleanedDataS3Key(s string) {
-	m.cleaned_data_s3_key = &s
-}
-
-// CleanedDataS3Key returns the value of the "cleaned_data_s3_key" field in the mutation.
-func (m *ProfileMutation) CleanedDataS3Key() (r string, exists bool) {
-	v := m.cleaned_data_s3_key
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCleanedDataS3Key returns the old "cleaned_data_s3_key" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldCleanedDataS3Key(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCleanedDataS3Key is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCleanedDataS3Key requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCleanedDataS3Key: %w", err)
-	}
-	return oldValue.CleanedDataS3Key, nil
-}
-
-// ClearCleanedDataS3Key clears the value of the "cleaned_data_s3_key" field.
-func (m *ProfileMutation) ClearCleanedDataS3Key() {
-	m.cleaned_data_s3_key = nil
-	m.clearedFields[profile.FieldCleanedDataS3Key] = struct{}{}
+// ClearLinkedinUrn clears the value of the "linkedin_urn" field.
+func (m *CompanyMutation) ClearLinkedinUrn() {
+	m.linkedin_urn = nil
+	m.clearedFields[company.FieldLinkedinUrn] = struct{}{}
 }
 
-// CleanedDataS3KeyCleared returns if the "cleaned_data_s3_key" field was cleared in this mutation.
-func (m *ProfileMutation) CleanedDataS3KeyCleared() bool {
-	_, ok := m.clearedFields[profile.FieldCleanedDataS3Key]
+// LinkedinUrnCleared returns if the "linkedin_urn" field was cleared in this mutation.
+func (m *CompanyMutation) LinkedinUrnCleared() bool {
+	_, ok := m.clearedFields[company.FieldLinkedinUrn]
 	return ok
 }
 
-// ResetCleanedDataS3Key resets all changes to the "cleaned_data_s3_key" field.
-func (m *ProfileMutation) ResetCleanedDataS3Key() {
-	m.cleaned_data_s3_key = nil
-	delete(m.clearedFields, profile.FieldCleanedDataS3Key)
+// ResetLinkedinUrn resets all changes to the "linkedin_urn" field.
+func (m *CompanyMutation) ResetLinkedinUrn() {
+	m.linkedin_urn = nil
+	delete(m.clearedFields, company.FieldLinkedinUrn)
 }
 
-// SetSourceFile sets the "source_file" field.
-func (m *ProfileMutation) SetSourceFile(s string) {
-	m.source_file = &s
+// SetLinkedinUsername sets the "linkedin_username" field.
+func (m *CompanyMutation) SetLinkedinUsername(s string) {
+	m.linkedin_username = &s
 }
 
-// SourceFile returns the value of the "source_file" field in the mutation.
-func (m *ProfileMutation) SourceFile() (r string, exists bool) {
-	v := m.source_file
+// LinkedinUsername returns the value of the "linkedin_username" field in the mutation.
+func (m *CompanyMutation) LinkedinUsername() (r string, exists bool) {
+	v := m.linkedin_username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceFile returns the old "source_file" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldLinkedinUsername returns the old "linkedin_username" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldSourceFile(ctx context.Context) (v *string, err error) {
+func (m *CompanyMutation) OldLinkedinUsername(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceFile is only allowed on UpdateOne operations")
+		return v, errors.New("OldLinkedinUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceFile requires an ID field in the mutation")
+		return v, errors.New("OldLinkedinUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceFile: %w", err)
+		return v, fmt.Errorf("querying old value for OldLinkedinUsername: %w", err)
 	}
-	return oldValue.SourceFile, nil
+	return oldValue.LinkedinUsername, nil
 }
 
-// ClearSourceFile clears the value of the "source_file" field.
-func (m *ProfileMutation) ClearSourceFile() {
-	m.source_file = nil
-	m.clearedFields[profile.FieldSourceFile] = struct{}{}
+// ClearLinkedinUsername clears the value of the "linkedin_username" field.
+func (m *CompanyMutation) ClearLinkedinUsername() {
+	m.linkedin_username = nil
+	m.clearedFields[company.FieldLinkedinUsername] = struct{}{}
 }
 
-// SourceFileCleared returns if the "source_file" field was cleared in this mutation.
-func (m *ProfileMutation) SourceFileCleared() bool {
-	_, ok := m.clearedFields[profile.FieldSourceFile]
+// LinkedinUsernameCleared returns if the "linkedin_username" field was cleared in this mutation.
+func (m *CompanyMutation) LinkedinUsernameCleared() bool {
+	_, ok := m.clearedFields[company.FieldLinkedinUsername]
 	return ok
 }
 
-// ResetSourceFile resets all changes to the "source_file" field.
-func (m *ProfileMutation) ResetSourceFile() {
-	m.source_file = nil
-	delete(m.clearedFields, profile.FieldSourceFile)
+// ResetLinkedinUsername resets all changes to the "linkedin_username" field.
+func (m *CompanyMutation) ResetLinkedinUsername() {
+	m.linkedin_username = nil
+	delete(m.clearedFields, company.FieldLinkedinUsername)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ProfileMutation) SetCreatedAt(t time.Time) {
+func (m *CompanyMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProfileMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CompanyMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4205,10 +4772,10 @@ func (m *ProfileMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CompanyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4223,17 +4790,17 @@ func (m *ProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProfileMutation) ResetCreatedAt() {
+func (m *CompanyMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ProfileMutation) SetUpdatedAt(t time.Time) {
+func (m *CompanyMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProfileMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *CompanyMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4241,10 +4808,10 @@ func (m *ProfileMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CompanyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4259,58 +4826,181 @@ func (m *ProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProfileMutation) ResetUpdatedAt() {
+func (m *CompanyMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetProfileEntryID sets the "profile_entry" edge to the ProfileEntry entity by id.
-func (m *ProfileMutation) SetProfileEntryID(id ulid.ID) {
-	m.profile_entry = &id
+// AddPositionIDs adds the "positions" edge to the ProfilePosition entity by ids.
+func (m *CompanyMutation) AddPositionIDs(ids ...ulid.ID) {
+	if m.positions == nil {
+		m.positions = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.positions[ids[i]] = struct{}{}
+	}
 }
 
-// ClearProfileEntry clears the "profile_entry" edge to the ProfileEntry entity.
-func (m *ProfileMutation) ClearProfileEntry() {
-	m.clearedprofile_entry = true
+// ClearPositions clears the "positions" edge to the ProfilePosition entity.
+func (m *CompanyMutation) ClearPositions() {
+	m.clearedpositions = true
 }
 
-// ProfileEntryCleared reports if the "profile_entry" edge to the ProfileEntry entity was cleared.
-func (m *ProfileMutation) ProfileEntryCleared() bool {
-	return m.clearedprofile_entry
+// PositionsCleared reports if the "positions" edge to the ProfilePosition entity was cleared.
+func (m *CompanyMutation) PositionsCleared() bool {
+	return m.clearedpositions
 }
 
-// ProfileEntryID returns the "profile_entry" edge ID in the mutation.
-func (m *ProfileMutation) ProfileEntryID() (id ulid.ID, exists bool) {
-	if m.profile_entry != nil {
-		return *m.profile_entry, true
+// RemovePositionIDs removes the "positions" edge to the ProfilePosition entity by IDs.
+func (m *CompanyMutation) RemovePositionIDs(ids ...ulid.ID) {
+	if m.removedpositions == nil {
+		m.removedpositions = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.positions, ids[i])
+		m.removedpositions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPositions returns the removed IDs of the "positions" edge to the ProfilePosition entity.
+func (m *CompanyMutation) RemovedPositionsIDs() (ids []ulid.ID) {
+	for id := range m.removedpositions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ProfileEntryIDs returns the "profile_entry" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProfileEntryID instead. It exists only for internal usage by the builders.
-func (m *ProfileMutation) ProfileEntryIDs() (ids []ulid.ID) {
-	if id := m.profile_entry; id != nil {
-		ids = append(ids, *id)
+// PositionsIDs returns the "positions" edge IDs in the mutation.
+func (m *CompanyMutation) PositionsIDs() (ids []ulid.ID) {
+	for id := range m.positions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetProfileEntry resets all changes to the "profile_entry" edge.
-func (m *ProfileMutation) ResetProfileEntry() {
-	m.profile_entry = nil
-	m.clearedprofile_entry = false
+// ResetPositions resets all changes to the "positions" edge.
+func (m *CompanyMutation) ResetPositions() {
+	m.positions = nil
+	m.clearedpositions = false
+	m.removedpositions = nil
 }
 
-// Where appends a list predicates to the ProfileMutation builder.
-func (m *ProfileMutation) Where(ps ...predicate.Profile) {
+// AddCurrentEmployeeIDs adds the "current_employees" edge to the Profile entity by ids.
+func (m *CompanyMutation) AddCurrentEmployeeIDs(ids ...ulid.ID) {
+	if m.current_employees == nil {
+		m.current_employees = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.current_employees[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCurrentEmployees clears the "current_employees" edge to the Profile entity.
+func (m *CompanyMutation) ClearCurrentEmployees() {
+	m.clearedcurrent_employees = true
+}
+
+// CurrentEmployeesCleared reports if the "current_employees" edge to the Profile entity was cleared.
+func (m *CompanyMutation) CurrentEmployeesCleared() bool {
+	return m.clearedcurrent_employees
+}
+
+// RemoveCurrentEmployeeIDs removes the "current_employees" edge to the Profile entity by IDs.
+func (m *CompanyMutation) RemoveCurrentEmployeeIDs(ids ...ulid.ID) {
+	if m.removedcurrent_employees == nil {
+		m.removedcurrent_employees = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.current_employees, ids[i])
+		m.removedcurrent_employees[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCurrentEmployees returns the removed IDs of the "current_employees" edge to the Profile entity.
+func (m *CompanyMutation) RemovedCurrentEmployeesIDs() (ids []ulid.ID) {
+	for id := range m.removedcurrent_employees {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CurrentEmployeesIDs returns the "current_employees" edge IDs in the mutation.
+func (m *CompanyMutation) CurrentEmployeesIDs() (ids []ulid.ID) {
+	for id := range m.current_employees {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCurrentEmployees resets all changes to the "current_employees" edge.
+func (m *CompanyMutation) ResetCurrentEmployees() {
+	m.current_employees = nil
+	m.clearedcurrent_employees = false
+	m.removedcurrent_employees = nil
+}
+
+// AddAlumniIDs adds the "alumni" edge to the Profile entity by ids.
+func (m *CompanyMutation) AddAlumniIDs(ids ...ulid.ID) {
+	if m.alumni == nil {
+		m.alumni = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.alumni[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAlumni clears the "alumni" edge to the Profile entity.
+func (m *CompanyMutation) ClearAlumni() {
+	m.clearedalumni = true
+}
+
+// AlumniCleared reports if the "alumni" edge to the Profile entity was cleared.
+func (m *CompanyMutation) AlumniCleared() bool {
+	return m.clearedalumni
+}
+
+// RemoveAlumniIDs removes the "alumni" edge to the Profile entity by IDs.
+func (m *CompanyMutation) RemoveAlumniIDs(ids ...ulid.ID) {
+	if m.removedalumni == nil {
+		m.removedalumni = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.alumni, ids[i])
+		m.removedalumni[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAlumni returns the removed IDs of the "alumni" edge to the Profile entity.
+func (m *CompanyMutation) RemovedAlumniIDs() (ids []ulid.ID) {
+	for id := range m.removedalumni {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AlumniIDs returns the "alumni" edge IDs in the mutation.
+func (m *CompanyMutation) AlumniIDs() (ids []ulid.ID) {
+	for id := range m.alumni {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAlumni resets all changes to the "alumni" edge.
+func (m *CompanyMutation) ResetAlumni() {
+	m.alumni = nil
+	m.clearedalumni = false
+	m.removedalumni = nil
+}
+
+// Where appends a list predicates to the CompanyMutation builder.
+func (m *CompanyMutation) Where(ps ...predicate.Company) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProfileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CompanyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Profile, len(ps))
+func (m *CompanyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Company, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4318,75 +5008,45 @@ func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProfileMutation) Op() Op {
+func (m *CompanyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProfileMutation) SetOp(op Op) {
+func (m *CompanyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Profile).
-func (m *ProfileMutation) Type() string {
+// Type returns the node type of this mutation (Company).
+func (m *CompanyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProfileMutation) Fields() []string {
-	fields := make([]string, 0, 17)
-	if m.urn != nil {
-		fields = append(fields, profile.FieldUrn)
-	}
-	if m.username != nil {
-		fields = append(fields, profile.FieldUsername)
-	}
-	if m.first_name != nil {
-		fields = append(fields, profile.FieldFirstName)
-	}
-	if m.last_name != nil {
-		fields = append(fields, profile.FieldLastName)
-	}
-	if m.headline != nil {
-		fields = append(fields, profile.FieldHeadline)
-	}
-	if m.title != nil {
-		fields = append(fields, profile.FieldTitle)
-	}
-	if m.country != nil {
-		fields = append(fields, profile.FieldCountry)
-	}
-	if m.city != nil {
-		fields = append(fields, profile.FieldCity)
-	}
-	if m.educations != nil {
-		fields = append(fields, profile.FieldEducations)
-	}
-	if m.positions != nil {
-		fields = append(fields, profile.FieldPositions)
-	}
-	if m.skills != nil {
-		fields = append(fields, profile.FieldSkills)
+func (m *CompanyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.key != nil {
+		fields = append(fields, company.FieldKey)
 	}
-	if m.geo_data != nil {
-		fields = append(fields, profile.FieldGeoData)
+	if m.name != nil {
+		fields = append(fields, company.FieldName)
 	}
-	if m.raw_data_s3_key != nil {
-		fields = append(fields, profile.FieldRawDataS3Key)
+	if m.normalized_name != nil {
+		fields = append(fields, company.FieldNormalizedName)
 	}
-	if m.cleaned_data_s3_key != nil {
-		fields = append(fields, profile.FieldCleanedDataS3Key)
+	if m.linkedin_urn != nil {
+		fields = append(fields, company.FieldLinkedinUrn)
 	}
-	if m.source_file != nil {
-		fields = append(fields, profile.FieldSourceFile)
+	if m.linkedin_username != nil {
+		fields = append(fields, company.FieldLinkedinUsername)
 	}
 	if m.created_at != nil {
-		fields = append(fields, profile.FieldCreatedAt)
+		fields = append(fields, company.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, profile.FieldUpdatedAt)
+		fields = append(fields, company.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4394,41 +5054,21 @@ func (m *ProfileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
+func (m *CompanyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case profile.FieldUrn:
-		return m.Urn()
-	case profile.FieldUsername:
-		return m.Username()
-	case profile.FieldFirstName:
-		return m.FirstName()
-	case profile.FieldLastName:
-		return m.LastName()
-	case profile.FieldHeadline:
-		return m.Headline()
-	case profile.FieldTitle:
-		return m.Title()
-	case profile.FieldCountry:
-		return m.Country()
-	case profile.FieldCity:
-		return m.City()
-	case profile.FieldEducations:
-		return m.Educations()
-	case profile.FieldPositions:
-		return m.Positions()
-	case profile.FieldSkills:
-		return m.Skills()
-	case profile.FieldGeoData:
-		return m.GeoData()
-	case profile.FieldRawDataS3Key:
-		return m.RawDataS3Key()
-	case profile.FieldCleanedDataS3Key:
-		return m.CleanedDataS3Key()
-	case profile.FieldSourceFile:
-		return m.SourceFile()
-	case profile.FieldCreatedAt:
+	case company.FieldKey:
+		return m.Key()
+	case company.FieldName:
+		return m.Name()
+	case company.FieldNormalizedName:
+		return m.NormalizedName()
+	case company.FieldLinkedinUrn:
+		return m.LinkedinUrn()
+	case company.FieldLinkedinUsername:
+		return m.LinkedinUsername()
+	case company.FieldCreatedAt:
 		return m.CreatedAt()
-	case profile.FieldUpdatedAt:
+	case company.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -4437,164 +5077,74 @@ func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CompanyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case profile.FieldUrn:
-		return m.OldUrn(ctx)
-	case profile.FieldUsername:
-		return m.OldUsername(ctx)
-	case profile.FieldFirstName:
-		return m.OldFirstName(ctx)
-	case profile.FieldLastName:
-		return m.OldLastName(ctx)
-	case profile.FieldHeadline:
-		return m.OldHeadline(ctx)
-	case profile.FieldTitle:
-		return m.OldTitle(ctx)
-	case profile.FieldCountry:
-		return m.OldCountry(ctx)
-	case profile.FieldCity:
-		return m.OldCity(ctx)
-	case profile.FieldEducations:
-		return m.OldEducations(ctx)
-	case profile.FieldPositions:
-		return m.OldPositions(ctx)
-	case profile.FieldSkills:
-		return m.OldSkills(ctx)
-	case profile.FieldGeoData:
-		return m.OldGeoData(ctx)
-	case profile.FieldRawDataS3Key:
-		return m.OldRawDataS3Key(ctx)
-	case profile.FieldCleanedDataS3Key:
-		return m.OldCleanedDataS3Key(ctx)
-	case profile.FieldSourceFile:
-		return m.OldSourceFile(ctx)
-	case profile.FieldCreatedAt:
+	case company.FieldKey:
+		return m.OldKey(ctx)
+	case company.FieldName:
+		return m.OldName(ctx)
+	case company.FieldNormalizedName:
+		return m.OldNormalizedName(ctx)
+	case company.FieldLinkedinUrn:
+		return m.OldLinkedinUrn(ctx)
+	case company.FieldLinkedinUsername:
+		return m.OldLinkedinUsername(ctx)
+	case company.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case profile.FieldUpdatedAt:
+	case company.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Profile field %s", name)
+	return nil, fmt.Errorf("unknown Company field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProfileMutation) SetField(name string, value ent.Value) error {
+func (m *CompanyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case profile.FieldUrn:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUrn(v)
-		return nil
-	case profile.FieldUsername:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsername(v)
-		return nil
-	case profile.FieldFirstName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFirstName(v)
-		return nil
-	case profile.FieldLastName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastName(v)
-		return nil
-	case profile.FieldHeadline:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHeadline(v)
-		return nil
-	case profile.FieldTitle:
+	case company.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
-		return nil
-	case profile.FieldCountry:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCountry(v)
+		m.SetKey(v)
 		return nil
-	case profile.FieldCity:
+	case company.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCity(v)
-		return nil
-	case profile.FieldEducations:
-		v, ok := value.([]map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEducations(v)
-		return nil
-	case profile.FieldPositions:
-		v, ok := value.([]map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPositions(v)
-		return nil
-	case profile.FieldSkills:
-		v, ok := value.([]map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSkills(v)
-		return nil
-	case profile.FieldGeoData:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGeoData(v)
+		m.SetName(v)
 		return nil
-	case profile.FieldRawDataS3Key:
+	case company.FieldNormalizedName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRawDataS3Key(v)
+		m.SetNormalizedName(v)
 		return nil
-	case profile.FieldCleanedDataS3Key:
+	case company.FieldLinkedinUrn:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCleanedDataS3Key(v)
+		m.SetLinkedinUrn(v)
 		return nil
-	case profile.FieldSourceFile:
+	case company.FieldLinkedinUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceFile(v)
+		m.SetLinkedinUsername(v)
 		return nil
-	case profile.FieldCreatedAt:
+	case company.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case profile.FieldUpdatedAt:
+	case company.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4602,310 +5152,267 @@ func (m *ProfileMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Profile field %s", name)
+	return fmt.Errorf("unknown Company field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProfileMutation) AddedFields() []string {
+func (m *CompanyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProfileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CompanyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProfileMutation) AddField(name string, value ent.Value) error {
+func (m *CompanyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Profile numeric field %s", name)
+	return fmt.Errorf("unknown Company numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProfileMutation) ClearedFields() []string {
+func (m *CompanyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(profile.FieldUsername) {
-		fields = append(fields, profile.FieldUsername)
-	}
-	if m.FieldCleared(profile.FieldFirstName) {
-		fields = append(fields, profile.FieldFirstName)
-	}
-	if m.FieldCleared(profile.FieldLastName) {
-		fields = append(fields, profile.FieldLastName)
-	}
-	if m.FieldCleared(profile.FieldHeadline) {
-		fields = append(fields, profile.FieldHeadline)
-	}
-	if m.FieldCleared(profile.FieldTitle) {
-		fields = append(fields, profile.FieldTitle)
-	}
-	if m.FieldCleared(profile.FieldCountry) {
-		fields = append(fields, profile.FieldCountry)
-	}
-	if m.FieldCleared(profile.FieldCity) {
-		fields = append(fields, profile.FieldCity)
-	}
-	if m.FieldCleared(profile.FieldEducations) {
-		fields = append(fields, profile.FieldEducations)
-	}
-	if m.FieldCleared(profile.FieldPositions) {
-		fields = append(fields, profile.FieldPositions)
-	}
-	if m.FieldCleared(profile.FieldSkills) {
-		fields = append(fields, profile.FieldSkills)
-	}
-	if m.FieldCleared(profile.FieldGeoData) {
-		fields = append(fields, profile.FieldGeoData)
-	}
-	if m.FieldCleared(profile.FieldRawDataS3Key) {
-		fields = append(fields, profile.FieldRawDataS3Key)
-	}
-	if m.FieldCleared(profile.FieldCleanedDataS3Key) {
-		fields = append(fields, profile.FieldCleanedDataS3Key)
+	if m.FieldCleared(company.FieldLinkedinUrn) {
+		fields = append(fields, company.FieldLinkedinUrn)
 	}
-	if m.FieldCleared(profile.FieldSourceFile) {
-		fields = append(fields, profile.FieldSourceFile)
+	if m.FieldCleared(company.FieldLinkedinUsername) {
+		fields = append(fields, company.FieldLinkedinUsername)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProfileMutation) FieldCleared(name string) bool {
+func (m *CompanyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProfileMutation) ClearField(name string) error {
+func (m *CompanyMutation) ClearField(name string) error {
 	switch name {
-	case profile.FieldUsername:
-		m.ClearUsername()
-		return nil
-	case profile.FieldFirstName:
-		m.ClearFirstName()
-		return nil
-	case profile.FieldLastName:
-		m.ClearLastName()
+	case company.FieldLinkedinUrn:
+		m.ClearLinkedinUrn()
 		return nil
-	case profile.FieldHeadline:
-		m.ClearHeadline()
-		return nil
-	case profile.FieldTitle:
-		m.ClearTitle()
-		return nil
-	case profile.FieldCountry:
-		m.ClearCountry()
-		return nil
-	case profile.FieldCity:
-		m.ClearCity()
-		return nil
-	case profile.FieldEducations:
-		m.ClearEducations()
-		return nil
-	case profile.FieldPositions:
-		m.ClearPositions()
-		return nil
-	case profile.FieldSkills:
-		m.ClearSkills()
-		return nil
-	case profile.FieldGeoData:
-		m.ClearGeoData()
-		return nil
-	case profile.FieldRawDataS3Key:
-		m.ClearRawDataS3Key()
-		return nil
-	case profile.FieldCleanedDataS3Key:
-		m.ClearCleanedDataS3Key()
-		return nil
-	case profile.FieldSourceFile:
-		m.ClearSourceFile()
+	case company.FieldLinkedinUsername:
+		m.ClearLinkedinUsername()
 		return nil
 	}
-	return fmt.Errorf("unknown Profile nullable field %s", name)
+	return fmt.Errorf("unknown Company nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProfileMutation) ResetField(name string) error {
+func (m *CompanyMutation) ResetField(name string) error {
 	switch name {
-	case profile.FieldUrn:
-		m.ResetUrn()
-		return nil
-	case profile.FieldUsername:
-		m.ResetUsername()
-		return nil
-	case profile.FieldFirstName:
-		m.ResetFirstName()
-		return nil
-	case profile.FieldLastName:
-		m.ResetLastName()
-		return nil
-	case profile.FieldHeadline:
-		m.ResetHeadline()
+	case company.FieldKey:
+		m.ResetKey()
 		return nil
-	case profile.FieldTitle:
-		m.ResetTitle()
+	case company.FieldName:
+		m.ResetName()
 		return nil
-	case profile.FieldCountry:
-		m.ResetCountry()
+	case company.FieldNormalizedName:
+		m.ResetNormalizedName()
 		return nil
-	case profile.FieldCity:
-		m.ResetCity()
+	case company.FieldLinkedinUrn:
+		m.ResetLinkedinUrn()
 		return nil
-	case profile.FieldEducations:
-		m.ResetEducations()
+	case company.FieldLinkedinUsername:
+		m.ResetLinkedinUsername()
 		return nil
-	case profile.FieldPositions:
-		m.ResetPositions()
+	case company.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case profile.FieldSkills:
-		m.ResetSkills()
-		return nil
-	case profile.FieldGeoData:
-		m.ResetGeoData()
-		return nil
-	case profile.FieldRawDataS3Key:
-		m.ResetRawDataS3Key()
-		return nil
-	case profile.FieldCleanedDataS3Key:
-		m.ResetCleanedDataS3Key()
-		return nil
-	case profile.FieldSourceFile:
-		m.ResetSourceFile()
-		return nil
-	case profile.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case profile.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case company.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Profile field %s", name)
+	return fmt.Errorf("unknown Company field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProfileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.profile_entry != nil {
-		edges = append(edges, profile.EdgeProfileEntry)
+func (m *CompanyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.positions != nil {
+		edges = append(edges, company.EdgePositions)
+	}
+	if m.current_employees != nil {
+		edges = append(edges, company.EdgeCurrentEmployees)
+	}
+	if m.alumni != nil {
+		edges = append(edges, company.EdgeAlumni)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProfileMutation) AddedIDs(name string) []ent.Value {
+func (m *CompanyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case profile.EdgeProfileEntry:
-		if id := m.profile_entry; id != nil {
-			return []ent.Value{*id}
+	case company.EdgePositions:
+		ids := make([]ent.Value, 0, len(m.positions))
+		for id := range m.positions {
+			ids = append(ids, id)
+		}
+		return ids
+	case company.EdgeCurrentEmployees:
+		ids := make([]ent.Value, 0, len(m.current_employees))
+		for id := range m.current_employees {
+			ids = append(ids, id)
+		}
+		return ids
+	case company.EdgeAlumni:
+		ids := make([]ent.Value, 0, len(m.alumni))
+		for id := range m.alumni {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProfileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *CompanyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedpositions != nil {
+		edges = append(edges, company.EdgePositions)
+	}
+	if m.removedcurrent_employees != nil {
+		edges = append(edges, company.EdgeCurrentEmployees)
+	}
+	if m.removedalumni != nil {
+		edges = append(edges, company.EdgeAlumni)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProfileMutation) RemovedIDs(name string) []ent.Value {
+func (m *CompanyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case company.EdgePositions:
+		ids := make([]ent.Value, 0, len(m.removedpositions))
+		for id := range m.removedpositions {
+			ids = append(ids, id)
+		}
+		return ids
+	case company.EdgeCurrentEmployees:
+		ids := make([]ent.Value, 0, len(m.removedcurrent_employees))
+		for id := range m.removedcurrent_employees {
+			ids = append(ids, id)
+		}
+		return ids
+	case company.EdgeAlumni:
+		ids := make([]ent.Value, 0, len(m.removedalumni))
+		for id := range m.removedalumni {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProfileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedprofile_entry {
-		edges = append(edges, profile.EdgeProfileEntry)
+func (m *CompanyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedpositions {
+		edges = append(edges, company.EdgePositions)
+	}
+	if m.clearedcurrent_employees {
+		edges = append(edges, company.EdgeCurrentEmployees)
+	}
+	if m.clearedalumni {
+		edges = append(edges, company.EdgeAlumni)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProfileMutation) EdgeCleared(name string) bool {
+func (m *CompanyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case profile.EdgeProfileEntry:
-		return m.clearedprofile_entry
+	case company.EdgePositions:
+		return m.clearedpositions
+	case company.EdgeCurrentEmployees:
+		return m.clearedcurrent_employees
+	case company.EdgeAlumni:
+		return m.clearedalumni
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProfileMutation) ClearEdge(name string) error {
+func (m *CompanyMutation) ClearEdge(name string) error {
 	switch name {
-	case profile.EdgeProfileEntry:
-		m.ClearProfileEntry()
-		return nil
 	}
-	return fmt.Errorf("unknown Profile unique edge %s", name)
+	return fmt.Errorf("unknown Company unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProfileMutation) ResetEdge(name string) error {
+func (m *CompanyMutation) ResetEdge(name string) error {
 	switch name {
-	case profile.EdgeProfileEntry:
-		m.ResetProfileEntry()
+	case company.EdgePositions:
+		m.ResetPositions()
+		return nil
+	case company.EdgeCurrentEmployees:
+		m.ResetCurrentEmployees()
+		return nil
+	case company.EdgeAlumni:
+		m.ResetAlumni()
 		return nil
 	}
-	return fmt.Errorf("unknown Profile edge %s", name)
+	return fmt.Errorf("unknown Company edge %s", name)
 }
 
-// ProfileEntryMutation represents an operation that mutates the ProfileEntry nodes in the graph.
-type ProfileEntryMutation struct {
+// CronJobConfigMutation represents an operation that mutates the CronJobConfig nodes in the graph.
+type CronJobConfigMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *ulid.ID
-	created_at            *time.Time
-	updated_at            *time.Time
-	linkedin_urn          *string
-	gender                *string
-	status                *profileentry.Status
-	profile_data          *map[string]interface{}
-	template_json_s3_key  *string
-	raw_response_s3_key   *string
-	fetch_count           *int
-	addfetch_count        *int
-	last_fetched_at       *time.Time
-	error_message         *string
-	clearedFields         map[string]struct{}
-	profile               *ulid.ID
-	clearedprofile        bool
-	job_executions        map[ulid.ID]struct{}
-	removedjob_executions map[ulid.ID]struct{}
-	clearedjob_executions bool
-	done                  bool
-	oldValue              func(context.Context) (*ProfileEntry, error)
-	predicates            []predicate.ProfileEntry
+	op             Op
+	typ            string
+	id             *ulid.ID
+	created_at     *time.Time
+	updated_at     *time.Time
+	job_name       *string
+	job_type       *cronjobconfig.JobType
+	schedule       *string
+	enabled        *bool
+	batch_size     *int
+	addbatch_size  *int
+	concurrency    *int
+	addconcurrency *int
+	admin_email    *string
+	respect_quota  *bool
+	last_run_at    *time.Time
+	next_run_at    *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*CronJobConfig, error)
+	predicates     []predicate.CronJobConfig
 }
 
-var _ ent.Mutation = (*ProfileEntryMutation)(nil)
+var _ ent.Mutation = (*CronJobConfigMutation)(nil)
 
-// profileentryOption allows management of the mutation configuration using functional options.
-type profileentryOption func(*ProfileEntryMutation)
+// cronjobconfigOption allows management of the mutation configuration using functional options.
+type cronjobconfigOption func(*CronJobConfigMutation)
 
-// newProfileEntryMutation creates new mutation for the ProfileEntry entity.
-func newProfileEntryMutation(c config, op Op, opts ...profileentryOption) *ProfileEntryMutation {
-	m := &ProfileEntryMutation{
+// newCronJobConfigMutation creates new mutation for the CronJobConfig entity.
+func newCronJobConfigMutation(c config, op Op, opts ...cronjobconfigOption) *CronJobConfigMutation {
+	m := &CronJobConfigMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProfileEntry,
+		typ:           TypeCronJobConfig,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4914,20 +5421,20 @@ func newProfileEntryMutation(c config, op Op, opts ...profileentryOption) *Profi
 	return m
 }
 
-// withProfileEntryID sets the ID field of the mutation.
-func withProfileEntryID(id ulid.ID) profileentryOption {
-	return func(m *ProfileEntryMutation) {
+// withCronJobConfigID sets the ID field of the mutation.
+func withCronJobConfigID(id ulid.ID) cronjobconfigOption {
+	return func(m *CronJobConfigMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ProfileEntry
+			value *CronJobConfig
 		)
-		m.oldValue = func(ctx context.Context) (*ProfileEntry, error) {
+		m.oldValue = func(ctx context.Context) (*CronJobConfig, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ProfileEntry.Get(ctx, id)
+					value, err = m.Client().CronJobConfig.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4936,10 +5443,10 @@ func withProfileEntryID(id ulid.ID) profileentryOption {
 	}
 }
 
-// withProfileEntry sets the old ProfileEntry of the mutation.
-func withProfileEntry(node *ProfileEntry) profileentryOption {
-	return func(m *ProfileEntryMutation) {
-		m.oldValue = func(context.Context) (*ProfileEntry, error) {
+// withCronJobConfig sets the old CronJobConfig of the mutation.
+func withCronJobConfig(node *CronJobConfig) cronjobconfigOption {
+	return func(m *CronJobConfigMutation) {
+		m.oldValue = func(context.Context) (*CronJobConfig, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4948,7 +5455,7 @@ func withProfileEntry(node *ProfileEntry) profileentryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProfileEntryMutation) Client() *Client {
+func (m CronJobConfigMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4956,7 +5463,7 @@ func (m ProfileEntryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProfileEntryMutation) Tx() (*Tx, error) {
+func (m CronJobConfigMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4966,14 +5473,14 @@ func (m ProfileEntryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ProfileEntry entities.
-func (m *ProfileEntryMutation) SetID(id ulid.ID) {
+// operation is only accepted on creation of CronJobConfig entities.
+func (m *CronJobConfigMutation) SetID(id ulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProfileEntryMutation) ID() (id ulid.ID, exists bool) {
+func (m *CronJobConfigMutation) ID() (id ulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4984,7 +5491,7 @@ func (m *ProfileEntryMutation) ID() (id ulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProfileEntryMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+func (m *CronJobConfigMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4993,19 +5500,19 @@ func (m *ProfileEntryMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProfileEntry.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CronJobConfig.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ProfileEntryMutation) SetCreatedAt(t time.Time) {
+func (m *CronJobConfigMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProfileEntryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CronJobConfigMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5013,10 +5520,10 @@ func (m *ProfileEntryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ProfileEntry entity.
-// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CronJobConfigMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5031,17 +5538,17 @@ func (m *ProfileEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProfileEntryMutation) ResetCreatedAt() {
+func (m *CronJobConfigMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ProfileEntryMutation) SetUpdatedAt(t time.Time) {
+func (m *CronJobConfigMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProfileEntryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *CronJobConfigMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5049,10 +5556,10 @@ func (m *ProfileEntryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ProfileEntry entity.
-// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CronJobConfigMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5067,534 +5574,445 @@ func (m *ProfileEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProfileEntryMutation) ResetUpdatedAt() {
+func (m *CronJobConfigMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetLinkedinUrn sets the "linkedin_urn" field.
-func (m *ProfileEntryMutation) SetLinkedinUrn(s string) {
-	m.linkedin_urn = &s
+// SetJobName sets the "job_name" field.
+func (m *CronJobConfigMutation) SetJobName(s string) {
+	m.job_name = &s
 }
 
-// LinkedinUrn returns the value of the "linkedin_urn" field in the mutation.
-func (m *ProfileEntryMutation) LinkedinUrn() (r string, exists bool) {
-	v := m.linkedin_urn
+// JobName returns the value of the "job_name" field in the mutation.
+func (m *CronJobConfigMutation) JobName() (r string, exists bool) {
+	v := m.job_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLinkedinUrn returns the old "linkedin_urn" field's value of the ProfileEntry entity.
-// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldJobName returns the old "job_name" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileEntryMutation) OldLinkedinUrn(ctx context.Context) (v string, err error) {
+func (m *CronJobConfigMutation) OldJobName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLinkedinUrn is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLinkedinUrn requires an ID field in the mutation")
+		return v, errors.New("OldJobName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLinkedinUrn: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
 	}
-	return oldValue.LinkedinUrn, nil
+	return oldValue.JobName, nil
 }
 
-// ResetLinkedinUrn resets all changes to the "linkedin_urn" field.
-func (m *ProfileEntryMutation) ResetLinkedinUrn() {
-	m.linkedin_urn = nil
+// ResetJobName resets all changes to the "job_name" field.
+func (m *CronJobConfigMutation) ResetJobName() {
+	m.job_name = nil
 }
 
-// SetGender sets the "gender" field.
-func (m *ProfileEntryMutation) SetGender(s string) {
-	m.gender = &s
+// SetJobType sets the "job_type" field.
+func (m *CronJobConfigMutation) SetJobType(ct cronjobconfig.JobType) {
+	m.job_type = &ct
 }
 
-// Gender returns the value of the "gender" field in the mutation.
-func (m *ProfileEntryMutation) Gender() (r string, exists bool) {
-	v := m.gender
+// JobType returns the value of the "job_type" field in the mutation.
+func (m *CronJobConfigMutation) JobType() (r cronjobconfig.JobType, exists bool) {
+	v := m.job_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGender returns the old "gender" field's value of the ProfileEntry entity.
-// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldJobType returns the old "job_type" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileEntryMutation) OldGender(ctx context.Context) (v *string, err error) {
+func (m *CronJobConfigMutation) OldJobType(ctx context.Context) (v cronjobconfig.JobType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGender is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGender requires an ID field in the mutation")
+		return v, errors.New("OldJobType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGender: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobType: %w", err)
 	}
-	return oldValue.Gender, nil
-}
-
-// ClearGender clears the value of the "gender" field.
-func (m *ProfileEntryMutation) ClearGender() {
-	m.gender = nil
-	m.clearedFields[profileentry.FieldGender] = struct{}{}
+	return oldValue.JobType, nil
 }
 
-// GenderCleared returns if the "gender" field was cleared in this mutation.
-func (m *ProfileEntryMutation) GenderCleared() bool {
-	_, ok := m.clearedFields[profileentry.FieldGender]
-	return ok
+// ResetJobType resets all changes to the "job_type" field.
+func (m *CronJobConfigMutation) ResetJobType() {
+	m.job_type = nil
 }
 
-// ResetGender resets all changes to the "gender" field.
-func (m *ProfileEntryMutation) ResetGender() {
-	m.gender = nil
-	delete(m.clearedFields, profileentry.FieldGender)
+// SetSchedule sets the "schedule" field.
+func (m *CronJobConfigMutation) SetSchedule(s string) {
+	m.schedule = &s
 }
 
-// SetStatus sets the "status" field.
-func (m *ProfileEntryMutation) SetStatus(pr profileentry.Status) {
-	m.status = &pr
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *ProfileEntryMutation) Status() (r profileentry.Status, exists bool) {
-	v := m.status
+// Schedule returns the value of the "schedule" field in the mutation.
+func (m *CronJobConfigMutation) Schedule() (r string, exists bool) {
+	v := m.schedule
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the ProfileEntry entity.
-// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldSchedule returns the old "schedule" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileEntryMutation) OldStatus(ctx context.Context) (v profileentry.Status, err error) {
+func (m *CronJobConfigMutation) OldSchedule(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldSchedule requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Schedule, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ProfileEntryMutation) ResetStatus() {
-	m.status = nil
+// ResetSchedule resets all changes to the "schedule" field.
+func (m *CronJobConfigMutation) ResetSchedule() {
+	m.schedule = nil
 }
 
-// SetProfileData sets the "profile_data" field.
-func (m *ProfileEntryMutation) SetProfileData(value map[string]interface{}) {
-	m.profile_data = &value
+// SetEnabled sets the "enabled" field.
+func (m *CronJobConfigMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// ProfileData returns the value of the "profile_data" field in the mutation.
-func (m *ProfileEntryMutation) ProfileData() (r map[string]interface{}, exists bool) {
-	v := m.profile_data
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *CronJobConfigMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProfileData returns the old "profile_data" field's value of the ProfileEntry entity.
-// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileEntryMutation) OldProfileData(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *CronJobConfigMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfileData is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfileData requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfileData: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.ProfileData, nil
-}
-
-// ClearProfileData clears the value of the "profile_data" field.
-func (m *ProfileEntryMutation) ClearProfileData() {
-	m.profile_data = nil
-	m.clearedFields[profileentry.FieldProfileData] = struct{}{}
-}
-
-// ProfileDataCleared returns if the "profile_data" field was cleared in this mutation.
-func (m *ProfileEntryMutation) ProfileDataCleared() bool {
-	_, ok := m.clearedFields[profileentry.FieldProfileData]
-	return ok
+	return oldValue.Enabled, nil
 }
 
-// ResetProfileData resets all changes to the "profile_data" field.
-func (m *ProfileEntryMutation) ResetProfileData() {
-	m.profile_data = nil
-	delete(m.clearedFields, profileentry.FieldProfileData)
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *CronJobConfigMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// SetTemplateJSONS3Key sets the "template_json_s3_key" field.
-func (m *ProfileEntryMutation) SetTemplateJSONS3Key(s string) {
-	m.template_json_s3_key = &s
+// SetBatchSize sets the "batch_size" field.
+func (m *CronJobConfigMutation) SetBatchSize(i int) {
+	m.batch_size = &i
+	m.addbatch_size = nil
 }
 
-// TemplateJSONS3Key returns the value of the "template_json_s3_key" field in the mutation.
-func (m *ProfileEntryMutation) TemplateJSONS3Key() (r string, exists bool) {
-	v := m.template_json_s3_key
+// BatchSize returns the value of the "batch_size" field in the mutation.
+func (m *CronJobConfigMutation) BatchSize() (r int, exists bool) {
+	v := m.batch_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTemplateJSONS3Key returns the old "template_json_s3_key" field's value of the ProfileEntry entity.
-// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldBatchSize returns the old "batch_size" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileEntryMutation) OldTemplateJSONS3Key(ctx context.Context) (v *string, err error) {
+func (m *CronJobConfigMutation) OldBatchSize(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTemplateJSONS3Key is only allowed on UpdateOne operations")
+		return v, errors.New("OldBatchSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTemplateJSONS3Key requires an ID field in the mutation")
+		return v, errors.New("OldBatchSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTemplateJSONS3Key: %w", err)
+		return v, fmt.Errorf("querying old value for OldBatchSize: %w", err)
 	}
-	return oldValue.TemplateJSONS3Key, nil
+	return oldValue.BatchSize, nil
 }
 
-// ClearTemplateJSONS3Key clears the value of the "template_json_s3_key" field.
-func (m *ProfileEntryMutation) ClearTemplateJSONS3Key() {
-	m.template_json_s3_key = nil
-	m.clearedFields[profileentry.FieldTemplateJSONS3Key] = struct{}{}
+// AddBatchSize adds i to the "batch_size" field.
+func (m *CronJobConfigMutation) AddBatchSize(i int) {
+	if m.addbatch_size != nil {
+		*m.addbatch_size += i
+	} else {
+		m.addbatch_size = &i
+	}
 }
 
-// TemplateJSONS3KeyCleared returns if the "template_json_s3_key" field was cleared in this mutation.
-func (m *ProfileEntryMutation) TemplateJSONS3KeyCleared() bool {
-	_, ok := m.clearedFields[profileentry.FieldTemplateJSONS3Key]
-	return ok
+// AddedBatchSize returns the value that was added to the "batch_size" field in this mutation.
+func (m *CronJobConfigMutation) AddedBatchSize() (r int, exists bool) {
+	v := m.addbatch_size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetTemplateJSONS3Key resets all changes to the "template_json_s3_key" field.
-func (m *ProfileEntryMutation) ResetTemplateJSONS3Key() {
-	m.template_json_s3_key = nil
-	delete(m.clearedFields, profileentry.FieldTemplateJSONS3Key)
+// ResetBatchSize resets all changes to the "batch_size" field.
+func (m *CronJobConfigMutation) ResetBatchSize() {
+	m.batch_size = nil
+	m.addbatch_size = nil
 }
 
-// SetRawResponseS3Key sets the "raw_response_s3_key" field.
-func (m *ProfileEntryMutation) SetRawResponseS3Key(s string) {
-	m.raw_response_s3_key = &s
+// SetConcurrency sets the "concurrency" field.
+func (m *CronJobConfigMutation) SetConcurrency(i int) {
+	m.concurrency = &i
+	m.addconcurrency = nil
 }
 
-// RawResponseS3Key returns the value of the "raw_response_s3_key" field in the mutation.
-func (m *ProfileEntryMutation) RawResponseS3Key() (r string, exists bool) {
-	v := m.raw_response_s3_key
+// Concurrency returns the value of the "concurrency" field in the mutation.
+func (m *CronJobConfigMutation) Concurrency() (r int, exists bool) {
+	v := m.concurrency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRawResponseS3Key returns the old "raw_response_s3_key" field's value of the ProfileEntry entity.
-// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldConcurrency returns the old "concurrency" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileEntryMutation) OldRawResponseS3Key(ctx context.Context) (v *string, err error) {
+func (m *CronJobConfigMutation) OldConcurrency(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRawResponseS3Key is only allowed on UpdateOne operations")
+		return v, errors.New("OldConcurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRawResponseS3Key requires an ID field in the mutation")
+		return v, errors.New("OldConcurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRawResponseS3Key: %w", err)
+		return v, fmt.Errorf("querying old value for OldConcurrency: %w", err)
 	}
-	return oldValue.RawResponseS3Key, nil
+	return oldValue.Concurrency, nil
 }
 
-// ClearRawResponseS3Key clears the value of the "raw_response_s3_key" field.
-func (m *ProfileEntryMutation) ClearRawResponseS3Key() {
-	m.raw_response_s3_key = nil
-	m.clearedFields[profileentry.FieldRawResponseS3Key] = struct{}{}
+// AddConcurrency adds i to the "concurrency" field.
+func (m *CronJobConfigMutation) AddConcurrency(i int) {
+	if m.addconcurrency != nil {
+		*m.addconcurrency += i
+	} else {
+		m.addconcurrency = &i
+	}
 }
 
-// RawResponseS3KeyCleared returns if the "raw_response_s3_key" field was cleared in this mutation.
-func (m *ProfileEntryMutation) RawResponseS3KeyCleared() bool {
-	_, ok := m.clearedFields[profileentry.FieldRawResponseS3Key]
-	return ok
+// AddedConcurrency returns the value that was added to the "concurrency" field in this mutation.
+func (m *CronJobConfigMutation) AddedConcurrency() (r int, exists bool) {
+	v := m.addconcurrency
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetRawResponseS3Key resets all changes to the "raw_response_s3_key" field.
-func (m *ProfileEntryMutation) ResetRawResponseS3Key() {
-	m.raw_response_s3_key = nil
-	delete(m.clearedFields, profileentry.FieldRawResponseS3Key)
+// ResetConcurrency resets all changes to the "concurrency" field.
+func (m *CronJobConfigMutation) ResetConcurrency() {
+	m.concurrency = nil
+	m.addconcurrency = nil
 }
 
-// SetFetchCount sets the "fetch_count" field.
-func (m *ProfileEntryMutation) SetFetchCount(i int) {
-	m.fetch_count = &i
-	m.addfetch_count = nil
+// SetAdminEmail sets the "admin_email" field.
+func (m *CronJobConfigMutation) SetAdminEmail(s string) {
+	m.admin_email = &s
 }
 
-// FetchCount returns the value of the "fetch_count" field in the mutation.
-func (m *ProfileEntryMutation) FetchCount() (r int, exists bool) {
-	v := m.fetch_count
+// AdminEmail returns the value of the "admin_email" field in the mutation.
+func (m *CronJobConfigMutation) AdminEmail() (r string, exists bool) {
+	v := m.admin_email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFetchCount returns the old "fetch_count" field's value of the ProfileEntry entity.
-// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldAdminEmail returns the old "admin_email" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileEntryMutation) OldFetchCount(ctx context.Context) (v int, err error) {
+func (m *CronJobConfigMutation) OldAdminEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFetchCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdminEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFetchCount requires an ID field in the mutation")
+		return v, errors.New("OldAdminEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFetchCount: %w", err)
-	}
-	return oldValue.FetchCount, nil
-}
-
-// AddFetchCount adds i to the "fetch_count" field.
-func (m *ProfileEntryMutation) AddFetchCount(i int) {
-	if m.addfetch_count != nil {
-		*m.addfetch_count += i
-	} else {
-		m.addfetch_count = &i
-	}
-}
-
-// AddedFetchCount returns the value that was added to the "fetch_count" field in this mutation.
-func (m *ProfileEntryMutation) AddedFetchCount() (r int, exists bool) {
-	v := m.addfetch_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAdminEmail: %w", err)
 	}
-	return *v, true
+	return oldValue.AdminEmail, nil
 }
 
-// ResetFetchCount resets all changes to the "fetch_count" field.
-func (m *ProfileEntryMutation) ResetFetchCount() {
-	m.fetch_count = nil
-	m.addfetch_count = nil
+// ResetAdminEmail resets all changes to the "admin_email" field.
+func (m *CronJobConfigMutation) ResetAdminEmail() {
+	m.admin_email = nil
 }
 
-// SetLastFetchedAt sets the "last_fetched_at" field.
-func (m *ProfileEntryMutation) SetLastFetchedAt(t time.Time) {
-	m.last_fetched_at = &t
+// SetRespectQuota sets the "respect_quota" field.
+func (m *CronJobConfigMutation) SetRespectQuota(b bool) {
+	m.respect_quota = &b
 }
 
-// LastFetchedAt returns the value of the "last_fetched_at" field in the mutation.
-func (m *ProfileEntryMutation) LastFetchedAt() (r time.Time, exists bool) {
-	v := m.last_fetched_at
+// RespectQuota returns the value of the "respect_quota" field in the mutation.
+func (m *CronJobConfigMutation) RespectQuota() (r bool, exists bool) {
+	v := m.respect_quota
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastFetchedAt returns the old "last_fetched_at" field's value of the ProfileEntry entity.
-// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldRespectQuota returns the old "respect_quota" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileEntryMutation) OldLastFetchedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *CronJobConfigMutation) OldRespectQuota(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastFetchedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRespectQuota is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastFetchedAt requires an ID field in the mutation")
+		return v, errors.New("OldRespectQuota requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastFetchedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRespectQuota: %w", err)
 	}
-	return oldValue.LastFetchedAt, nil
+	return oldValue.RespectQuota, nil
 }
 
-// ClearLastFetchedAt clears the value of the "last_fetched_at" field.
-func (m *ProfileEntryMutation) ClearLastFetchedAt() {
-	m.last_fetched_at = nil
-	m.clearedFields[profileentry.FieldLastFetchedAt] = struct{}{}
+// ResetRespectQuota resets all changes to the "respect_quota" field.
+func (m *CronJobConfigMutation) ResetRespectQuota() {
+	m.respect_quota = nil
 }
 
-// LastFetchedAtCleared returns if the "last_fetched_at" field was cleared in this mutation.
-func (m *ProfileEntryMutation) LastFetchedAtCleared() bool {
-	_, ok := m.clearedFields[profileentry.FieldLastFetchedAt]
-	return ok
+// SetLastRunAt sets the "last_run_at" field.
+func (m *CronJobConfigMutation) SetLastRunAt(t time.Time) {
+	m.last_run_at = &t
 }
 
-// ResetLastFetchedAt resets all changes to the "last_fetched_at" field.
-func (m *ProfileEntryMutation) ResetLastFetchedAt() {
-	m.last_fetched_at = nil
-	delete(m.clearedFields, profileentry.FieldLastFetchedAt)
-}
-
-// SetErrorMessage sets the "error_message" field.
-func (m *ProfileEntryMutation) SetErrorMessage(s string) {
-	m.error_message = &s
-}
-
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *ProfileEntryMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// LastRunAt returns the value of the "last_run_at" field in the mutation.
+func (m *CronJobConfigMutation) LastRunAt() (r time.Time, exists bool) {
+	v := m.last_run_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the ProfileEntry entity.
-// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldLastRunAt returns the old "last_run_at" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileEntryMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+func (m *CronJobConfigMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
+	return oldValue.LastRunAt, nil
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *ProfileEntryMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[profileentry.FieldErrorMessage] = struct{}{}
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (m *CronJobConfigMutation) ClearLastRunAt() {
+	m.last_run_at = nil
+	m.clearedFields[cronjobconfig.FieldLastRunAt] = struct{}{}
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *ProfileEntryMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[profileentry.FieldErrorMessage]
+// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
+func (m *CronJobConfigMutation) LastRunAtCleared() bool {
+	_, ok := m.clearedFields[cronjobconfig.FieldLastRunAt]
 	return ok
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *ProfileEntryMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, profileentry.FieldErrorMessage)
-}
-
-// SetProfileID sets the "profile" edge to the Profile entity by id.
-func (m *ProfileEntryMutation) SetProfileID(id ulid.ID) {
-	m.profile = &id
-}
-
-// ClearProfile clears the "profile" edge to the Profile entity.
-func (m *ProfileEntryMutation) ClearProfile() {
-	m.clearedprofile = true
-}
-
-// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
-func (m *ProfileEntryMutation) ProfileCleared() bool {
-	return m.clearedprofile
+// ResetLastRunAt resets all changes to the "last_run_at" field.
+func (m *CronJobConfigMutation) ResetLastRunAt() {
+	m.last_run_at = nil
+	delete(m.clearedFields, cronjobconfig.FieldLastRunAt)
 }
 
-// ProfileID returns the "profile" edge ID in the mutation.
-func (m *ProfileEntryMutation) ProfileID() (id ulid.ID, exists bool) {
-	if m.profile != nil {
-		return *m.profile, true
-	}
-	return
+// SetNextRunAt sets the "next_run_at" field.
+func (m *CronJobConfigMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
 }
 
-// ProfileIDs returns the "profile" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProfileID instead. It exists only for internal usage by the builders.
-func (m *ProfileEntryMutation) ProfileIDs() (ids []ulid.ID) {
-	if id := m.profile; id != nil {
-		ids = append(ids, *id)
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *CronJobConfigMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetProfile resets all changes to the "profile" edge.
-func (m *ProfileEntryMutation) ResetProfile() {
-	m.profile = nil
-	m.clearedprofile = false
+	return *v, true
 }
 
-// AddJobExecutionIDs adds the "job_executions" edge to the JobExecutionHistory entity by ids.
-func (m *ProfileEntryMutation) AddJobExecutionIDs(ids ...ulid.ID) {
-	if m.job_executions == nil {
-		m.job_executions = make(map[ulid.ID]struct{})
-	}
-	for i := range ids {
-		m.job_executions[ids[i]] = struct{}{}
+// OldNextRunAt returns the old "next_run_at" field's value of the CronJobConfig entity.
+// If the CronJobConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobConfigMutation) OldNextRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
 	}
-}
-
-// ClearJobExecutions clears the "job_executions" edge to the JobExecutionHistory entity.
-func (m *ProfileEntryMutation) ClearJobExecutions() {
-	m.clearedjob_executions = true
-}
-
-// JobExecutionsCleared reports if the "job_executions" edge to the JobExecutionHistory entity was cleared.
-func (m *ProfileEntryMutation) JobExecutionsCleared() bool {
-	return m.clearedjob_executions
-}
-
-// RemoveJobExecutionIDs removes the "job_executions" edge to the JobExecutionHistory entity by IDs.
-func (m *ProfileEntryMutation) RemoveJobExecutionIDs(ids ...ulid.ID) {
-	if m.removedjob_executions == nil {
-		m.removedjob_executions = make(map[ulid.ID]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
 	}
-	for i := range ids {
-		delete(m.job_executions, ids[i])
-		m.removedjob_executions[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
 	}
+	return oldValue.NextRunAt, nil
 }
 
-// RemovedJobExecutions returns the removed IDs of the "job_executions" edge to the JobExecutionHistory entity.
-func (m *ProfileEntryMutation) RemovedJobExecutionsIDs() (ids []ulid.ID) {
-	for id := range m.removedjob_executions {
-		ids = append(ids, id)
-	}
-	return
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (m *CronJobConfigMutation) ClearNextRunAt() {
+	m.next_run_at = nil
+	m.clearedFields[cronjobconfig.FieldNextRunAt] = struct{}{}
 }
 
-// JobExecutionsIDs returns the "job_executions" edge IDs in the mutation.
-func (m *ProfileEntryMutation) JobExecutionsIDs() (ids []ulid.ID) {
-	for id := range m.job_executions {
-		ids = append(ids, id)
-	}
-	return
+// NextRunAtCleared returns if the "next_run_at" field was cleared in this mutation.
+func (m *CronJobConfigMutation) NextRunAtCleared() bool {
+	_, ok := m.clearedFields[cronjobconfig.FieldNextRunAt]
+	return ok
 }
 
-// ResetJobExecutions resets all changes to the "job_executions" edge.
-func (m *ProfileEntryMutation) ResetJobExecutions() {
-	m.job_executions = nil
-	m.clearedjob_executions = false
-	m.removedjob_executions = nil
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *CronJobConfigMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+	delete(m.clearedFields, cronjobconfig.FieldNextRunAt)
 }
 
-// Where appends a list predicates to the ProfileEntryMutation builder.
-func (m *ProfileEntryMutation) Where(ps ...predicate.ProfileEntry) {
+// Where appends a list predicates to the CronJobConfigMutation builder.
+func (m *CronJobConfigMutation) Where(ps ...predicate.CronJobConfig) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProfileEntryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CronJobConfigMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProfileEntryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProfileEntry, len(ps))
+func (m *CronJobConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CronJobConfig, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5602,57 +6020,60 @@ func (m *ProfileEntryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProfileEntryMutation) Op() Op {
+func (m *CronJobConfigMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProfileEntryMutation) SetOp(op Op) {
+func (m *CronJobConfigMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ProfileEntry).
-func (m *ProfileEntryMutation) Type() string {
+// Type returns the node type of this mutation (CronJobConfig).
+func (m *CronJobConfigMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProfileEntryMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *CronJobConfigMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.created_at != nil {
-		fields = append(fields, profileentry.FieldCreatedAt)
+		fields = append(fields, cronjobconfig.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, profileentry.FieldUpdatedAt)
+		fields = append(fields, cronjobconfig.FieldUpdatedAt)
 	}
-	if m.linkedin_urn != nil {
-		fields = append(fields, profileentry.FieldLinkedinUrn)
+	if m.job_name != nil {
+		fields = append(fields, cronjobconfig.FieldJobName)
 	}
-	if m.gender != nil {
-		fields = append(fields, profileentry.FieldGender)
+	if m.job_type != nil {
+		fields = append(fields, cronjobconfig.FieldJobType)
 	}
-	if m.status != nil {
-		fields = append(fields, profileentry.FieldStatus)
+	if m.schedule != nil {
+		fields = append(fields, cronjobconfig.FieldSchedule)
 	}
-	if m.profile_data != nil {
-		fields = append(fields, profileentry.FieldProfileData)
+	if m.enabled != nil {
+		fields = append(fields, cronjobconfig.FieldEnabled)
 	}
-	if m.template_json_s3_key != nil {
-		fields = append(fields, profileentry.FieldTemplateJSONS3Key)
+	if m.batch_size != nil {
+		fields = append(fields, cronjobconfig.FieldBatchSize)
 	}
-	if m.raw_response_s3_key != nil {
-		fields = append(fields, profileentry.FieldRawResponseS3Key)
+	if m.concurrency != nil {
+		fields = append(fields, cronjobconfig.FieldConcurrency)
 	}
-	if m.fetch_count != nil {
-		fields = append(fields, profileentry.FieldFetchCount)
+	if m.admin_email != nil {
+		fields = append(fields, cronjobconfig.FieldAdminEmail)
 	}
-	if m.last_fetched_at != nil {
-		fields = append(fields, profileentry.FieldLastFetchedAt)
+	if m.respect_quota != nil {
+		fields = append(fields, cronjobconfig.FieldRespectQuota)
 	}
-	if m.error_message != nil {
-		fields = append(fields, profileentry.FieldErrorMessage)
+	if m.last_run_at != nil {
+		fields = append(fields, cronjobconfig.FieldLastRunAt)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, cronjobconfig.FieldNextRunAt)
 	}
 	return fields
 }
@@ -5660,157 +6081,171 @@ func (m *ProfileEntryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProfileEntryMutation) Field(name string) (ent.Value, bool) {
+func (m *CronJobConfigMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case profileentry.FieldCreatedAt:
+	case cronjobconfig.FieldCreatedAt:
 		return m.CreatedAt()
-	case profileentry.FieldUpdatedAt:
+	case cronjobconfig.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case profileentry.FieldLinkedinUrn:
-		return m.LinkedinUrn()
-	case profileentry.FieldGender:
-		return m.Gender()
-	case profileentry.FieldStatus:
-		return m.Status()
-	case profileentry.FieldProfileData:
-		return m.ProfileData()
-	case profileentry.FieldTemplateJSONS3Key:
-		return m.TemplateJSONS3Key()
-	case profileentry.FieldRawResponseS3Key:
-		return m.RawResponseS3Key()
-	case profileentry.FieldFetchCount:
-		return m.FetchCount()
-	case profileentry.FieldLastFetchedAt:
-		return m.LastFetchedAt()
-	case profileentry.FieldErrorMessage:
-		return m.ErrorMessage()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ProfileEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	case cronjobconfig.FieldJobName:
+		return m.JobName()
+	case cronjobconfig.FieldJobType:
+		return m.JobType()
+	case cronjobconfig.FieldSchedule:
+		return m.Schedule()
+	case cronjobconfig.FieldEnabled:
+		return m.Enabled()
+	case cronjobconfig.FieldBatchSize:
+		return m.BatchSize()
+	case cronjobconfig.FieldConcurrency:
+		return m.Concurrency()
+	case cronjobconfig.FieldAdminEmail:
+		return m.AdminEmail()
+	case cronjobconfig.FieldRespectQuota:
+		return m.RespectQuota()
+	case cronjobconfig.FieldLastRunAt:
+		return m.LastRunAt()
+	case cronjobconfig.FieldNextRunAt:
+		return m.NextRunAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CronJobConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case profileentry.FieldCreatedAt:
+	case cronjobconfig.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case profileentry.FieldUpdatedAt:
+	case cronjobconfig.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case profileentry.FieldLinkedinUrn:
-		return m.OldLinkedinUrn(ctx)
-	case profileentry.FieldGender:
-		return m.OldGender(ctx)
-	case profileentry.FieldStatus:
-		return m.OldStatus(ctx)
-	case profileentry.FieldProfileData:
-		return m.OldProfileData(ctx)
-	case profileentry.FieldTemplateJSONS3Key:
-		return m.OldTemplateJSONS3Key(ctx)
-	case profileentry.FieldRawResponseS3Key:
-		return m.OldRawResponseS3Key(ctx)
-	case profileentry.FieldFetchCount:
-		return m.OldFetchCount(ctx)
-	case profileentry.FieldLastFetchedAt:
-		return m.OldLastFetchedAt(ctx)
-	case profileentry.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
+	case cronjobconfig.FieldJobName:
+		return m.OldJobName(ctx)
+	case cronjobconfig.FieldJobType:
+		return m.OldJobType(ctx)
+	case cronjobconfig.FieldSchedule:
+		return m.OldSchedule(ctx)
+	case cronjobconfig.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case cronjobconfig.FieldBatchSize:
+		return m.OldBatchSize(ctx)
+	case cronjobconfig.FieldConcurrency:
+		return m.OldConcurrency(ctx)
+	case cronjobconfig.FieldAdminEmail:
+		return m.OldAdminEmail(ctx)
+	case cronjobconfig.FieldRespectQuota:
+		return m.OldRespectQuota(ctx)
+	case cronjobconfig.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
+	case cronjobconfig.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ProfileEntry field %s", name)
+	return nil, fmt.Errorf("unknown CronJobConfig field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProfileEntryMutation) SetField(name string, value ent.Value) error {
+func (m *CronJobConfigMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case profileentry.FieldCreatedAt:
+	case cronjobconfig.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case profileentry.FieldUpdatedAt:
+	case cronjobconfig.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case profileentry.FieldLinkedinUrn:
+	case cronjobconfig.FieldJobName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLinkedinUrn(v)
+		m.SetJobName(v)
 		return nil
-	case profileentry.FieldGender:
+	case cronjobconfig.FieldJobType:
+		v, ok := value.(cronjobconfig.JobType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobType(v)
+		return nil
+	case cronjobconfig.FieldSchedule:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGender(v)
+		m.SetSchedule(v)
 		return nil
-	case profileentry.FieldStatus:
-		v, ok := value.(profileentry.Status)
+	case cronjobconfig.FieldEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetEnabled(v)
 		return nil
-	case profileentry.FieldProfileData:
-		v, ok := value.(map[string]interface{})
+	case cronjobconfig.FieldBatchSize:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProfileData(v)
+		m.SetBatchSize(v)
 		return nil
-	case profileentry.FieldTemplateJSONS3Key:
-		v, ok := value.(string)
+	case cronjobconfig.FieldConcurrency:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTemplateJSONS3Key(v)
+		m.SetConcurrency(v)
 		return nil
-	case profileentry.FieldRawResponseS3Key:
+	case cronjobconfig.FieldAdminEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRawResponseS3Key(v)
+		m.SetAdminEmail(v)
 		return nil
-	case profileentry.FieldFetchCount:
-		v, ok := value.(int)
+	case cronjobconfig.FieldRespectQuota:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFetchCount(v)
+		m.SetRespectQuota(v)
 		return nil
-	case profileentry.FieldLastFetchedAt:
+	case cronjobconfig.FieldLastRunAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastFetchedAt(v)
+		m.SetLastRunAt(v)
 		return nil
-	case profileentry.FieldErrorMessage:
-		v, ok := value.(string)
+	case cronjobconfig.FieldNextRunAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetNextRunAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProfileEntry field %s", name)
+	return fmt.Errorf("unknown CronJobConfig field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProfileEntryMutation) AddedFields() []string {
+func (m *CronJobConfigMutation) AddedFields() []string {
 	var fields []string
-	if m.addfetch_count != nil {
-		fields = append(fields, profileentry.FieldFetchCount)
+	if m.addbatch_size != nil {
+		fields = append(fields, cronjobconfig.FieldBatchSize)
+	}
+	if m.addconcurrency != nil {
+		fields = append(fields, cronjobconfig.FieldConcurrency)
 	}
 	return fields
 }
@@ -5818,10 +6253,12 @@ func (m *ProfileEntryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProfileEntryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CronJobConfigMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case profileentry.FieldFetchCount:
-		return m.AddedFetchCount()
+	case cronjobconfig.FieldBatchSize:
+		return m.AddedBatchSize()
+	case cronjobconfig.FieldConcurrency:
+		return m.AddedConcurrency()
 	}
 	return nil, false
 }
@@ -5829,252 +6266,202 @@ func (m *ProfileEntryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProfileEntryMutation) AddField(name string, value ent.Value) error {
+func (m *CronJobConfigMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case profileentry.FieldFetchCount:
+	case cronjobconfig.FieldBatchSize:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFetchCount(v)
+		m.AddBatchSize(v)
+		return nil
+	case cronjobconfig.FieldConcurrency:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConcurrency(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProfileEntry numeric field %s", name)
+	return fmt.Errorf("unknown CronJobConfig numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProfileEntryMutation) ClearedFields() []string {
+func (m *CronJobConfigMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(profileentry.FieldGender) {
-		fields = append(fields, profileentry.FieldGender)
-	}
-	if m.FieldCleared(profileentry.FieldProfileData) {
-		fields = append(fields, profileentry.FieldProfileData)
-	}
-	if m.FieldCleared(profileentry.FieldTemplateJSONS3Key) {
-		fields = append(fields, profileentry.FieldTemplateJSONS3Key)
-	}
-	if m.FieldCleared(profileentry.FieldRawResponseS3Key) {
-		fields = append(fields, profileentry.FieldRawResponseS3Key)
-	}
-	if m.FieldCleared(profileentry.FieldLastFetchedAt) {
-		fields = append(fields, profileentry.FieldLastFetchedAt)
+	if m.FieldCleared(cronjobconfig.FieldLastRunAt) {
+		fields = append(fields, cronjobconfig.FieldLastRunAt)
 	}
-	if m.FieldCleared(profileentry.FieldErrorMessage) {
-		fields = append(fields, profileentry.FieldErrorMessage)
+	if m.FieldCleared(cronjobconfig.FieldNextRunAt) {
+		fields = append(fields, cronjobconfig.FieldNextRunAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProfileEntryMutation) FieldCleared(name string) bool {
+func (m *CronJobConfigMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProfileEntryMutation) ClearField(name string) error {
+func (m *CronJobConfigMutation) ClearField(name string) error {
 	switch name {
-	case profileentry.FieldGender:
-		m.ClearGender()
-		return nil
-	case profileentry.FieldProfileData:
-		m.ClearProfileData()
-		return nil
-	case profileentry.FieldTemplateJSONS3Key:
-		m.ClearTemplateJSONS3Key()
-		return nil
-	case profileentry.FieldRawResponseS3Key:
-		m.ClearRawResponseS3Key()
-		return nil
-	case profileentry.FieldLastFetchedAt:
-		m.ClearLastFetchedAt()
+	case cronjobconfig.FieldLastRunAt:
+		m.ClearLastRunAt()
 		return nil
-	case profileentry.FieldErrorMessage:
-		m.ClearErrorMessage()
+	case cronjobconfig.FieldNextRunAt:
+		m.ClearNextRunAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ProfileEntry nullable field %s", name)
+	return fmt.Errorf("unknown CronJobConfig nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProfileEntryMutation) ResetField(name string) error {
+func (m *CronJobConfigMutation) ResetField(name string) error {
 	switch name {
-	case profileentry.FieldCreatedAt:
+	case cronjobconfig.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case profileentry.FieldUpdatedAt:
+	case cronjobconfig.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case profileentry.FieldLinkedinUrn:
-		m.ResetLinkedinUrn()
+	case cronjobconfig.FieldJobName:
+		m.ResetJobName()
 		return nil
-	case profileentry.FieldGender:
-		m.ResetGender()
+	case cronjobconfig.FieldJobType:
+		m.ResetJobType()
 		return nil
-	case profileentry.FieldStatus:
-		m.ResetStatus()
+	case cronjobconfig.FieldSchedule:
+		m.ResetSchedule()
 		return nil
-	case profileentry.FieldProfileData:
-		m.ResetProfileData()
+	case cronjobconfig.FieldEnabled:
+		m.ResetEnabled()
 		return nil
-	case profileentry.FieldTemplateJSONS3Key:
-		m.ResetTemplateJSONS3Key()
+	case cronjobconfig.FieldBatchSize:
+		m.ResetBatchSize()
 		return nil
-	case profileentry.FieldRawResponseS3Key:
-		m.ResetRawResponseS3Key()
+	case cronjobconfig.FieldConcurrency:
+		m.ResetConcurrency()
 		return nil
-	case profileentry.FieldFetchCount:
-		m.ResetFetchCount()
+	case cronjobconfig.FieldAdminEmail:
+		m.ResetAdminEmail()
 		return nil
-	case profileentry.FieldLastFetchedAt:
-		m.ResetLastFetchedAt()
+	case cronjobconfig.FieldRespectQuota:
+		m.ResetRespectQuota()
 		return nil
-	case profileentry.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case cronjobconfig.FieldLastRunAt:
+		m.ResetLastRunAt()
+		return nil
+	case cronjobconfig.FieldNextRunAt:
+		m.ResetNextRunAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ProfileEntry field %s", name)
+	return fmt.Errorf("unknown CronJobConfig field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProfileEntryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.profile != nil {
-		edges = append(edges, profileentry.EdgeProfile)
-	}
-	if m.job_executions != nil {
-		edges = append(edges, profileentry.EdgeJobExecutions)
-	}
+func (m *CronJobConfigMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProfileEntryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case profileentry.EdgeProfile:
-		if id := m.profile; id != nil {
-			return []ent.Value{*id}
-		}
-	case profileentry.EdgeJobExecutions:
-		ids := make([]ent.Value, 0, len(m.job_executions))
-		for id := range m.job_executions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CronJobConfigMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProfileEntryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedjob_executions != nil {
-		edges = append(edges, profileentry.EdgeJobExecutions)
-	}
+func (m *CronJobConfigMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProfileEntryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case profileentry.EdgeJobExecutions:
-		ids := make([]ent.Value, 0, len(m.removedjob_executions))
-		for id := range m.removedjob_executions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CronJobConfigMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProfileEntryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedprofile {
-		edges = append(edges, profileentry.EdgeProfile)
-	}
-	if m.clearedjob_executions {
-		edges = append(edges, profileentry.EdgeJobExecutions)
-	}
+func (m *CronJobConfigMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProfileEntryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case profileentry.EdgeProfile:
-		return m.clearedprofile
-	case profileentry.EdgeJobExecutions:
-		return m.clearedjob_executions
-	}
+func (m *CronJobConfigMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProfileEntryMutation) ClearEdge(name string) error {
-	switch name {
-	case profileentry.EdgeProfile:
-		m.ClearProfile()
-		return nil
-	}
-	return fmt.Errorf("unknown ProfileEntry unique edge %s", name)
+func (m *CronJobConfigMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CronJobConfig unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProfileEntryMutation) ResetEdge(name string) error {
-	switch name {
-	case profileentry.EdgeProfile:
-		m.ResetProfile()
-		return nil
-	case profileentry.EdgeJobExecutions:
-		m.ResetJobExecutions()
-		return nil
-	}
-	return fmt.Errorf("unknown ProfileEntry edge %s", name)
+func (m *CronJobConfigMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CronJobConfig edge %s", name)
 }
 
-// ProfilePostMutation represents an operation that mutates the ProfilePost nodes in the graph.
-type ProfilePostMutation struct {
+// JobExecutionHistoryMutation represents an operation that mutates the JobExecutionHistory nodes in the graph.
+type JobExecutionHistoryMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *ulid.ID
-	profile_username *string
-	fetch_status     *profilepost.FetchStatus
-	s3_key           *string
-	error_message    *string
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	items            map[ulid.ID]struct{}
-	removeditems     map[ulid.ID]struct{}
-	cleareditems     bool
-	done             bool
-	oldValue         func(context.Context) (*ProfilePost, error)
-	predicates       []predicate.ProfilePost
+	op                     Op
+	typ                    string
+	id                     *ulid.ID
+	created_at             *time.Time
+	updated_at             *time.Time
+	job_name               *string
+	status                 *jobexecutionhistory.Status
+	started_at             *time.Time
+	completed_at           *time.Time
+	duration_seconds       *int
+	addduration_seconds    *int
+	total_processed        *int
+	addtotal_processed     *int
+	successful_count       *int
+	addsuccessful_count    *int
+	failed_count           *int
+	addfailed_count        *int
+	api_calls_made         *int
+	addapi_calls_made      *int
+	quota_remaining        *int
+	addquota_remaining     *int
+	error_summary          *string
+	schema_drift_detected  *bool
+	schema_drift           *map[string]interface{}
+	clearedFields          map[string]struct{}
+	profile_entries        map[ulid.ID]struct{}
+	removedprofile_entries map[ulid.ID]struct{}
+	clearedprofile_entries bool
+	api_calls              map[ulid.ID]struct{}
+	removedapi_calls       map[ulid.ID]struct{}
+	clearedapi_calls       bool
+	done                   bool
+	oldValue               func(context.Context) (*JobExecutionHistory, error)
+	predicates             []predicate.JobExecutionHistory
 }
 
-var _ ent.Mutation = (*ProfilePostMutation)(nil)
+var _ ent.Mutation = (*JobExecutionHistoryMutation)(nil)
 
-// profilepostOption allows management of the mutation configuration using functional options.
-type profilepostOption func(*ProfilePostMutation)
+// jobexecutionhistoryOption allows management of the mutation configuration using functional options.
+type jobexecutionhistoryOption func(*JobExecutionHistoryMutation)
 
-// newProfilePostMutation creates new mutation for the ProfilePost entity.
-func newProfilePostMutation(c config, op Op, opts ...profilepostOption) *ProfilePostMutation {
-	m := &ProfilePostMutation{
+// newJobExecutionHistoryMutation creates new mutation for the JobExecutionHistory entity.
+func newJobExecutionHistoryMutation(c config, op Op, opts ...jobexecutionhistoryOption) *JobExecutionHistoryMutation {
+	m := &JobExecutionHistoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProfilePost,
+		typ:           TypeJobExecutionHistory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6083,20 +6470,20 @@ func newProfilePostMutation(c config, op Op, opts ...profilepostOption) *Profile
 	return m
 }
 
-// withProfilePostID sets the ID field of the mutation.
-func withProfilePostID(id ulid.ID) profilepostOption {
-	return func(m *ProfilePostMutation) {
+// withJobExecutionHistoryID sets the ID field of the mutation.
+func withJobExecutionHistoryID(id ulid.ID) jobexecutionhistoryOption {
+	return func(m *JobExecutionHistoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ProfilePost
+			value *JobExecutionHistory
 		)
-		m.oldValue = func(ctx context.Context) (*ProfilePost, error) {
+		m.oldValue = func(ctx context.Context) (*JobExecutionHistory, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ProfilePost.Get(ctx, id)
+					value, err = m.Client().JobExecutionHistory.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6105,10 +6492,10 @@ func withProfilePostID(id ulid.ID) profilepostOption {
 	}
 }
 
-// withProfilePost sets the old ProfilePost of the mutation.
-func withProfilePost(node *ProfilePost) profilepostOption {
-	return func(m *ProfilePostMutation) {
-		m.oldValue = func(context.Context) (*ProfilePost, error) {
+// withJobExecutionHistory sets the old JobExecutionHistory of the mutation.
+func withJobExecutionHistory(node *JobExecutionHistory) jobexecutionhistoryOption {
+	return func(m *JobExecutionHistoryMutation) {
+		m.oldValue = func(context.Context) (*JobExecutionHistory, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6117,7 +6504,7 @@ func withProfilePost(node *ProfilePost) profilepostOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProfilePostMutation) Client() *Client {
+func (m JobExecutionHistoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6125,7 +6512,7 @@ func (m ProfilePostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProfilePostMutation) Tx() (*Tx, error) {
+func (m JobExecutionHistoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6135,14 +6522,14 @@ func (m ProfilePostMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ProfilePost entities.
-func (m *ProfilePostMutation) SetID(id ulid.ID) {
+// operation is only accepted on creation of JobExecutionHistory entities.
+func (m *JobExecutionHistoryMutation) SetID(id ulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProfilePostMutation) ID() (id ulid.ID, exists bool) {
+func (m *JobExecutionHistoryMutation) ID() (id ulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6153,7 +6540,7 @@ func (m *ProfilePostMutation) ID() (id ulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProfilePostMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+func (m *JobExecutionHistoryMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6162,674 +6549,10923 @@ func (m *ProfilePostMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProfilePost.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().JobExecutionHistory.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetProfileUsername sets the "profile_username" field.
-func (m *ProfilePostMutation) SetProfileUsername(s string) {
-	m.profile_username = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *JobExecutionHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ProfileUsername returns the value of the "profile_username" field in the mutation.
-func (m *ProfilePostMutation) ProfileUsername() (r string, exists bool) {
-	v := m.profile_username
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobExecutionHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProfileUsername returns the old "profile_username" field's value of the ProfilePost entity.
-// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostMutation) OldProfileUsername(ctx context.Context) (v string, err error) {
+func (m *JobExecutionHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfileUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfileUsername requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfileUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ProfileUsername, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetProfileUsername resets all changes to the "profile_username" field.
-func (m *ProfilePostMutation) ResetProfileUsername() {
-	m.profile_username = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobExecutionHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetFetchStatus sets the "fetch_status" field.
-func (m *ProfilePostMutation) SetFetchStatus(ps profilepost.FetchStatus) {
-	m.fetch_status = &ps
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JobExecutionHistoryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// FetchStatus returns the value of the "fetch_status" field in the mutation.
-func (m *ProfilePostMutation) FetchStatus() (r profilepost.FetchStatus, exists bool) {
-	v := m.fetch_status
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JobExecutionHistoryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFetchStatus returns the old "fetch_status" field's value of the ProfilePost entity.
-// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostMutation) OldFetchStatus(ctx context.Context) (v profilepost.FetchStatus, err error) {
+func (m *JobExecutionHistoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFetchStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFetchStatus requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFetchStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.FetchStatus, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetFetchStatus resets all changes to the "fetch_status" field.
-func (m *ProfilePostMutation) ResetFetchStatus() {
-	m.fetch_status = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JobExecutionHistoryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetS3Key sets the "s3_key" field.
-func (m *ProfilePostMutation) SetS3Key(s string) {
-	m.s3_key = &s
+// SetJobName sets the "job_name" field.
+func (m *JobExecutionHistoryMutation) SetJobName(s string) {
+	m.job_name = &s
 }
 
-// S3Key returns the value of the "s3_key" field in the mutation.
-func (m *ProfilePostMutation) S3Key() (r string, exists bool) {
-	v := m.s3_key
+// JobName returns the value of the "job_name" field in the mutation.
+func (m *JobExecutionHistoryMutation) JobName() (r string, exists bool) {
+	v := m.job_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldS3Key returns the old "s3_key" field's value of the ProfilePost entity.
-// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// OldJobName returns the old "job_name" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostMutation) OldS3Key(ctx context.Context) (v *string, err error) {
+func (m *JobExecutionHistoryMutation) OldJobName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldS3Key is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldS3Key requires an ID field in the mutation")
+		return v, errors.New("OldJobName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldS3Key: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
 	}
-	return oldValue.S3Key, nil
-}
-
-// ClearS3Key clears the value of the "s3_key" field.
-func (m *ProfilePostMutation) ClearS3Key() {
-	m.s3_key = nil
-	m.clearedFields[profilepost.FieldS3Key] = struct{}{}
-}
-
-// S3KeyCleared returns if the "s3_key" field was cleared in this mutation.
-func (m *ProfilePostMutation) S3KeyCleared() bool {
-	_, ok := m.clearedFields[profilepost.FieldS3Key]
-	return ok
+	return oldValue.JobName, nil
 }
 
-// ResetS3Key resets all changes to the "s3_key" field.
-func (m *ProfilePostMutation) ResetS3Key() {
-	m.s3_key = nil
-	delete(m.clearedFields, profilepost.FieldS3Key)
+// ResetJobName resets all changes to the "job_name" field.
+func (m *JobExecutionHistoryMutation) ResetJobName() {
+	m.job_name = nil
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *ProfilePostMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetStatus sets the "status" field.
+func (m *JobExecutionHistoryMutation) SetStatus(j jobexecutionhistory.Status) {
+	m.status = &j
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *ProfilePostMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// Status returns the value of the "status" field in the mutation.
+func (m *JobExecutionHistoryMutation) Status() (r jobexecutionhistory.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the ProfilePost entity.
-// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+func (m *JobExecutionHistoryMutation) OldStatus(ctx context.Context) (v jobexecutionhistory.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
-}
-
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *ProfilePostMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[profilepost.FieldErrorMessage] = struct{}{}
-}
-
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *ProfilePostMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[profilepost.FieldErrorMessage]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *ProfilePostMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, profilepost.FieldErrorMessage)
+// ResetStatus resets all changes to the "status" field.
+func (m *JobExecutionHistoryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ProfilePostMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetStartedAt sets the "started_at" field.
+func (m *JobExecutionHistoryMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProfilePostMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *JobExecutionHistoryMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ProfilePost entity.
-// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JobExecutionHistoryMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProfilePostMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *JobExecutionHistoryMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ProfilePostMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetCompletedAt sets the "completed_at" field.
+func (m *JobExecutionHistoryMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProfilePostMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *JobExecutionHistoryMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ProfilePost entity.
-// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletedAt returns the old "completed_at" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JobExecutionHistoryMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CompletedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProfilePostMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *JobExecutionHistoryMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[jobexecutionhistory.FieldCompletedAt] = struct{}{}
 }
 
-// AddItemIDs adds the "items" edge to the ProfilePostItem entity by ids.
-func (m *ProfilePostMutation) AddItemIDs(ids ...ulid.ID) {
-	if m.items == nil {
-		m.items = make(map[ulid.ID]struct{})
-	}
-	for i := range ids {
-		m.items[ids[i]] = struct{}{}
-	}
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *JobExecutionHistoryMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[jobexecutionhistory.FieldCompletedAt]
+	return ok
 }
 
-// ClearItems clears the "items" edge to the ProfilePostItem entity.
-func (m *ProfilePostMutation) ClearItems() {
-	m.cleareditems = true
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *JobExecutionHistoryMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, jobexecutionhistory.FieldCompletedAt)
 }
 
-// ItemsCleared reports if the "items" edge to the ProfilePostItem entity was cleared.
-func (m *ProfilePostMutation) ItemsCleared() bool {
-	return m.cleareditems
+// SetDurationSeconds sets the "duration_seconds" field.
+func (m *JobExecutionHistoryMutation) SetDurationSeconds(i int) {
+	m.duration_seconds = &i
+	m.addduration_seconds = nil
 }
 
-// RemoveItemIDs removes the "items" edge to the ProfilePostItem entity by IDs.
-func (m *ProfilePostMutation) RemoveItemIDs(ids ...ulid.ID) {
-	if m.removeditems == nil {
-		m.removeditems = make(map[ulid.ID]struct{})
+// DurationSeconds returns the value of the "duration_seconds" field in the mutation.
+func (m *JobExecutionHistoryMutation) DurationSeconds() (r int, exists bool) {
+	v := m.duration_seconds
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.items, ids[i])
-		m.removeditems[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldDurationSeconds returns the old "duration_seconds" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobExecutionHistoryMutation) OldDurationSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationSeconds: %w", err)
 	}
+	return oldValue.DurationSeconds, nil
 }
 
-// RemovedItems returns the removed IDs of the "items" edge to the ProfilePostItem entity.
-func (m *ProfilePostMutation) RemovedItemsIDs() (ids []ulid.ID) {
-	for id := range m.removeditems {
-		ids = append(ids, id)
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (m *JobExecutionHistoryMutation) AddDurationSeconds(i int) {
+	if m.addduration_seconds != nil {
+		*m.addduration_seconds += i
+	} else {
+		m.addduration_seconds = &i
 	}
-	return
 }
 
-// ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *ProfilePostMutation) ItemsIDs() (ids []ulid.ID) {
-	for id := range m.items {
-		ids = append(ids, id)
+// AddedDurationSeconds returns the value that was added to the "duration_seconds" field in this mutation.
+func (m *JobExecutionHistoryMutation) AddedDurationSeconds() (r int, exists bool) {
+	v := m.addduration_seconds
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetItems resets all changes to the "items" edge.
-func (m *ProfilePostMutation) ResetItems() {
-	m.items = nil
-	m.cleareditems = false
-	m.removeditems = nil
+// ResetDurationSeconds resets all changes to the "duration_seconds" field.
+func (m *JobExecutionHistoryMutation) ResetDurationSeconds() {
+	m.duration_seconds = nil
+	m.addduration_seconds = nil
 }
 
-// Where appends a list predicates to the ProfilePostMutation builder.
-func (m *ProfilePostMutation) Where(ps ...predicate.ProfilePost) {
-	m.predicates = append(m.predicates, ps...)
+// SetTotalProcessed sets the "total_processed" field.
+func (m *JobExecutionHistoryMutation) SetTotalProcessed(i int) {
+	m.total_processed = &i
+	m.addtotal_processed = nil
 }
 
-// WhereP appends storage-level predicates to the ProfilePostMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProfilePostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProfilePost, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// TotalProcessed returns the value of the "total_processed" field in the mutation.
+func (m *JobExecutionHistoryMutation) TotalProcessed() (r int, exists bool) {
+	v := m.total_processed
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ProfilePostMutation) Op() Op {
-	return m.op
+// OldTotalProcessed returns the old "total_processed" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobExecutionHistoryMutation) OldTotalProcessed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalProcessed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalProcessed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalProcessed: %w", err)
+	}
+	return oldValue.TotalProcessed, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ProfilePostMutation) SetOp(op Op) {
-	m.op = op
+// AddTotalProcessed adds i to the "total_processed" field.
+func (m *JobExecutionHistoryMutation) AddTotalProcessed(i int) {
+	if m.addtotal_processed != nil {
+		*m.addtotal_processed += i
+	} else {
+		m.addtotal_processed = &i
+	}
 }
 
-// Type returns the node type of this mutation (ProfilePost).
-func (m *ProfilePostMutation) Type() string {
-	return m.typ
+// AddedTotalProcessed returns the value that was added to the "total_processed" field in this mutation.
+func (m *JobExecutionHistoryMutation) AddedTotalProcessed() (r int, exists bool) {
+	v := m.addtotal_processed
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ProfilePostMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.profile_username != nil {
-		fields = append(fields, profilepost.FieldProfileUsername)
+// ResetTotalProcessed resets all changes to the "total_processed" field.
+func (m *JobExecutionHistoryMutation) ResetTotalProcessed() {
+	m.total_processed = nil
+	m.addtotal_processed = nil
+}
+
+// SetSuccessfulCount sets the "successful_count" field.
+func (m *JobExecutionHistoryMutation) SetSuccessfulCount(i int) {
+	m.successful_count = &i
+	m.addsuccessful_count = nil
+}
+
+// SuccessfulCount returns the value of the "successful_count" field in the mutation.
+func (m *JobExecutionHistoryMutation) SuccessfulCount() (r int, exists bool) {
+	v := m.successful_count
+	if v == nil {
+		return
 	}
-	if m.fetch_status != nil {
-		fields = append(fields, profilepost.FieldFetchStatus)
+	return *v, true
+}
+
+// OldSuccessfulCount returns the old "successful_count" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobExecutionHistoryMutation) OldSuccessfulCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccessfulCount is only allowed on UpdateOne operations")
 	}
-	if m.s3_key != nil {
-		fields = append(fields, profilepost.FieldS3Key)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccessfulCount requires an ID field in the mutation")
 	}
-	if m.error_message != nil {
-		fields = append(fields, profilepost.FieldErrorMessage)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccessfulCount: %w", err)
 	}
-	if m.created_at != nil {
-		fields = append(fields, profilepost.FieldCreatedAt)
+	return oldValue.SuccessfulCount, nil
+}
+
+// AddSuccessfulCount adds i to the "successful_count" field.
+func (m *JobExecutionHistoryMutation) AddSuccessfulCount(i int) {
+	if m.addsuccessful_count != nil {
+		*m.addsuccessful_count += i
+	} else {
+		m.addsuccessful_count = &i
 	}
-	if m.updated_at != nil {
-		fields = append(fields, profilepost.FieldUpdatedAt)
+}
+
+// AddedSuccessfulCount returns the value that was added to the "successful_count" field in this mutation.
+func (m *JobExecutionHistoryMutation) AddedSuccessfulCount() (r int, exists bool) {
+	v := m.addsuccessful_count
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ProfilePostMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case profilepost.FieldProfileUsername:
-		return m.ProfileUsername()
-	case profilepost.FieldFetchStatus:
-		return m.FetchStatus()
-	case profilepost.FieldS3Key:
-		return m.S3Key()
-	case profilepost.FieldErrorMessage:
-		return m.ErrorMessage()
-	case profilepost.FieldCreatedAt:
-		return m.CreatedAt()
-	case profilepost.FieldUpdatedAt:
-		return m.UpdatedAt()
+// ResetSuccessfulCount resets all changes to the "successful_count" field.
+func (m *JobExecutionHistoryMutation) ResetSuccessfulCount() {
+	m.successful_count = nil
+	m.addsuccessful_count = nil
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (m *JobExecutionHistoryMutation) SetFailedCount(i int) {
+	m.failed_count = &i
+	m.addfailed_count = nil
+}
+
+// FailedCount returns the value of the "failed_count" field in the mutation.
+func (m *JobExecutionHistoryMutation) FailedCount() (r int, exists bool) {
+	v := m.failed_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedCount returns the old "failed_count" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobExecutionHistoryMutation) OldFailedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedCount: %w", err)
+	}
+	return oldValue.FailedCount, nil
+}
+
+// AddFailedCount adds i to the "failed_count" field.
+func (m *JobExecutionHistoryMutation) AddFailedCount(i int) {
+	if m.addfailed_count != nil {
+		*m.addfailed_count += i
+	} else {
+		m.addfailed_count = &i
+	}
+}
+
+// AddedFailedCount returns the value that was added to the "failed_count" field in this mutation.
+func (m *JobExecutionHistoryMutation) AddedFailedCount() (r int, exists bool) {
+	v := m.addfailed_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailedCount resets all changes to the "failed_count" field.
+func (m *JobExecutionHistoryMutation) ResetFailedCount() {
+	m.failed_count = nil
+	m.addfailed_count = nil
+}
+
+// SetAPICallsMade sets the "api_calls_made" field.
+func (m *JobExecutionHistoryMutation) SetAPICallsMade(i int) {
+	m.api_calls_made = &i
+	m.addapi_calls_made = nil
+}
+
+// APICallsMade returns the value of the "api_calls_made" field in the mutation.
+func (m *JobExecutionHistoryMutation) APICallsMade() (r int, exists bool) {
+	v := m.api_calls_made
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICallsMade returns the old "api_calls_made" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobExecutionHistoryMutation) OldAPICallsMade(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICallsMade is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICallsMade requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICallsMade: %w", err)
+	}
+	return oldValue.APICallsMade, nil
+}
+
+// AddAPICallsMade adds i to the "api_calls_made" field.
+func (m *JobExecutionHistoryMutation) AddAPICallsMade(i int) {
+	if m.addapi_calls_made != nil {
+		*m.addapi_calls_made += i
+	} else {
+		m.addapi_calls_made = &i
+	}
+}
+
+// AddedAPICallsMade returns the value that was added to the "api_calls_made" field in this mutation.
+func (m *JobExecutionHistoryMutation) AddedAPICallsMade() (r int, exists bool) {
+	v := m.addapi_calls_made
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAPICallsMade resets all changes to the "api_calls_made" field.
+func (m *JobExecutionHistoryMutation) ResetAPICallsMade() {
+	m.api_calls_made = nil
+	m.addapi_calls_made = nil
+}
+
+// SetQuotaRemaining sets the "quota_remaining" field.
+func (m *JobExecutionHistoryMutation) SetQuotaRemaining(i int) {
+	m.quota_remaining = &i
+	m.addquota_remaining = nil
+}
+
+// QuotaRemaining returns the value of the "quota_remaining" field in the mutation.
+func (m *JobExecutionHistoryMutation) QuotaRemaining() (r int, exists bool) {
+	v := m.quota_remaining
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuotaRemaining returns the old "quota_remaining" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobExecutionHistoryMutation) OldQuotaRemaining(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuotaRemaining is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuotaRemaining requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuotaRemaining: %w", err)
+	}
+	return oldValue.QuotaRemaining, nil
+}
+
+// AddQuotaRemaining adds i to the "quota_remaining" field.
+func (m *JobExecutionHistoryMutation) AddQuotaRemaining(i int) {
+	if m.addquota_remaining != nil {
+		*m.addquota_remaining += i
+	} else {
+		m.addquota_remaining = &i
+	}
+}
+
+// AddedQuotaRemaining returns the value that was added to the "quota_remaining" field in this mutation.
+func (m *JobExecutionHistoryMutation) AddedQuotaRemaining() (r int, exists bool) {
+	v := m.addquota_remaining
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuotaRemaining resets all changes to the "quota_remaining" field.
+func (m *JobExecutionHistoryMutation) ResetQuotaRemaining() {
+	m.quota_remaining = nil
+	m.addquota_remaining = nil
+}
+
+// SetErrorSummary sets the "error_summary" field.
+func (m *JobExecutionHistoryMutation) SetErrorSummary(s string) {
+	m.error_summary = &s
+}
+
+// ErrorSummary returns the value of the "error_summary" field in the mutation.
+func (m *JobExecutionHistoryMutation) ErrorSummary() (r string, exists bool) {
+	v := m.error_summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorSummary returns the old "error_summary" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobExecutionHistoryMutation) OldErrorSummary(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorSummary: %w", err)
+	}
+	return oldValue.ErrorSummary, nil
+}
+
+// ClearErrorSummary clears the value of the "error_summary" field.
+func (m *JobExecutionHistoryMutation) ClearErrorSummary() {
+	m.error_summary = nil
+	m.clearedFields[jobexecutionhistory.FieldErrorSummary] = struct{}{}
+}
+
+// ErrorSummaryCleared returns if the "error_summary" field was cleared in this mutation.
+func (m *JobExecutionHistoryMutation) ErrorSummaryCleared() bool {
+	_, ok := m.clearedFields[jobexecutionhistory.FieldErrorSummary]
+	return ok
+}
+
+// ResetErrorSummary resets all changes to the "error_summary" field.
+func (m *JobExecutionHistoryMutation) ResetErrorSummary() {
+	m.error_summary = nil
+	delete(m.clearedFields, jobexecutionhistory.FieldErrorSummary)
+}
+
+// SetSchemaDriftDetected sets the "schema_drift_detected" field.
+func (m *JobExecutionHistoryMutation) SetSchemaDriftDetected(b bool) {
+	m.schema_drift_detected = &b
+}
+
+// SchemaDriftDetected returns the value of the "schema_drift_detected" field in the mutation.
+func (m *JobExecutionHistoryMutation) SchemaDriftDetected() (r bool, exists bool) {
+	v := m.schema_drift_detected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchemaDriftDetected returns the old "schema_drift_detected" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobExecutionHistoryMutation) OldSchemaDriftDetected(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchemaDriftDetected is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchemaDriftDetected requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchemaDriftDetected: %w", err)
+	}
+	return oldValue.SchemaDriftDetected, nil
+}
+
+// ResetSchemaDriftDetected resets all changes to the "schema_drift_detected" field.
+func (m *JobExecutionHistoryMutation) ResetSchemaDriftDetected() {
+	m.schema_drift_detected = nil
+}
+
+// SetSchemaDrift sets the "schema_drift" field.
+func (m *JobExecutionHistoryMutation) SetSchemaDrift(value map[string]interface{}) {
+	m.schema_drift = &value
+}
+
+// SchemaDrift returns the value of the "schema_drift" field in the mutation.
+func (m *JobExecutionHistoryMutation) SchemaDrift() (r map[string]interface{}, exists bool) {
+	v := m.schema_drift
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchemaDrift returns the old "schema_drift" field's value of the JobExecutionHistory entity.
+// If the JobExecutionHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobExecutionHistoryMutation) OldSchemaDrift(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchemaDrift is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchemaDrift requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchemaDrift: %w", err)
+	}
+	return oldValue.SchemaDrift, nil
+}
+
+// ClearSchemaDrift clears the value of the "schema_drift" field.
+func (m *JobExecutionHistoryMutation) ClearSchemaDrift() {
+	m.schema_drift = nil
+	m.clearedFields[jobexecutionhistory.FieldSchemaDrift] = struct{}{}
+}
+
+// SchemaDriftCleared returns if the "schema_drift" field was cleared in this mutation.
+func (m *JobExecutionHistoryMutation) SchemaDriftCleared() bool {
+	_, ok := m.clearedFields[jobexecutionhistory.FieldSchemaDrift]
+	return ok
+}
+
+// ResetSchemaDrift resets all changes to the "schema_drift" field.
+func (m *JobExecutionHistoryMutation) ResetSchemaDrift() {
+	m.schema_drift = nil
+	delete(m.clearedFields, jobexecutionhistory.FieldSchemaDrift)
+}
+
+// AddProfileEntryIDs adds the "profile_entries" edge to the ProfileEntry entity by ids.
+func (m *JobExecutionHistoryMutation) AddProfileEntryIDs(ids ...ulid.ID) {
+	if m.profile_entries == nil {
+		m.profile_entries = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.profile_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProfileEntries clears the "profile_entries" edge to the ProfileEntry entity.
+func (m *JobExecutionHistoryMutation) ClearProfileEntries() {
+	m.clearedprofile_entries = true
+}
+
+// ProfileEntriesCleared reports if the "profile_entries" edge to the ProfileEntry entity was cleared.
+func (m *JobExecutionHistoryMutation) ProfileEntriesCleared() bool {
+	return m.clearedprofile_entries
+}
+
+// RemoveProfileEntryIDs removes the "profile_entries" edge to the ProfileEntry entity by IDs.
+func (m *JobExecutionHistoryMutation) RemoveProfileEntryIDs(ids ...ulid.ID) {
+	if m.removedprofile_entries == nil {
+		m.removedprofile_entries = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.profile_entries, ids[i])
+		m.removedprofile_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProfileEntries returns the removed IDs of the "profile_entries" edge to the ProfileEntry entity.
+func (m *JobExecutionHistoryMutation) RemovedProfileEntriesIDs() (ids []ulid.ID) {
+	for id := range m.removedprofile_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProfileEntriesIDs returns the "profile_entries" edge IDs in the mutation.
+func (m *JobExecutionHistoryMutation) ProfileEntriesIDs() (ids []ulid.ID) {
+	for id := range m.profile_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProfileEntries resets all changes to the "profile_entries" edge.
+func (m *JobExecutionHistoryMutation) ResetProfileEntries() {
+	m.profile_entries = nil
+	m.clearedprofile_entries = false
+	m.removedprofile_entries = nil
+}
+
+// AddAPICallIDs adds the "api_calls" edge to the APICallLog entity by ids.
+func (m *JobExecutionHistoryMutation) AddAPICallIDs(ids ...ulid.ID) {
+	if m.api_calls == nil {
+		m.api_calls = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.api_calls[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAPICalls clears the "api_calls" edge to the APICallLog entity.
+func (m *JobExecutionHistoryMutation) ClearAPICalls() {
+	m.clearedapi_calls = true
+}
+
+// APICallsCleared reports if the "api_calls" edge to the APICallLog entity was cleared.
+func (m *JobExecutionHistoryMutation) APICallsCleared() bool {
+	return m.clearedapi_calls
+}
+
+// RemoveAPICallIDs removes the "api_calls" edge to the APICallLog entity by IDs.
+func (m *JobExecutionHistoryMutation) RemoveAPICallIDs(ids ...ulid.ID) {
+	if m.removedapi_calls == nil {
+		m.removedapi_calls = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.api_calls, ids[i])
+		m.removedapi_calls[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAPICalls returns the removed IDs of the "api_calls" edge to the APICallLog entity.
+func (m *JobExecutionHistoryMutation) RemovedAPICallsIDs() (ids []ulid.ID) {
+	for id := range m.removedapi_calls {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// APICallsIDs returns the "api_calls" edge IDs in the mutation.
+func (m *JobExecutionHistoryMutation) APICallsIDs() (ids []ulid.ID) {
+	for id := range m.api_calls {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPICalls resets all changes to the "api_calls" edge.
+func (m *JobExecutionHistoryMutation) ResetAPICalls() {
+	m.api_calls = nil
+	m.clearedapi_calls = false
+	m.removedapi_calls = nil
+}
+
+// Where appends a list predicates to the JobExecutionHistoryMutation builder.
+func (m *JobExecutionHistoryMutation) Where(ps ...predicate.JobExecutionHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobExecutionHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobExecutionHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JobExecutionHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobExecutionHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobExecutionHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JobExecutionHistory).
+func (m *JobExecutionHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobExecutionHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.created_at != nil {
+		fields = append(fields, jobexecutionhistory.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, jobexecutionhistory.FieldUpdatedAt)
+	}
+	if m.job_name != nil {
+		fields = append(fields, jobexecutionhistory.FieldJobName)
+	}
+	if m.status != nil {
+		fields = append(fields, jobexecutionhistory.FieldStatus)
+	}
+	if m.started_at != nil {
+		fields = append(fields, jobexecutionhistory.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, jobexecutionhistory.FieldCompletedAt)
+	}
+	if m.duration_seconds != nil {
+		fields = append(fields, jobexecutionhistory.FieldDurationSeconds)
+	}
+	if m.total_processed != nil {
+		fields = append(fields, jobexecutionhistory.FieldTotalProcessed)
+	}
+	if m.successful_count != nil {
+		fields = append(fields, jobexecutionhistory.FieldSuccessfulCount)
+	}
+	if m.failed_count != nil {
+		fields = append(fields, jobexecutionhistory.FieldFailedCount)
+	}
+	if m.api_calls_made != nil {
+		fields = append(fields, jobexecutionhistory.FieldAPICallsMade)
+	}
+	if m.quota_remaining != nil {
+		fields = append(fields, jobexecutionhistory.FieldQuotaRemaining)
+	}
+	if m.error_summary != nil {
+		fields = append(fields, jobexecutionhistory.FieldErrorSummary)
+	}
+	if m.schema_drift_detected != nil {
+		fields = append(fields, jobexecutionhistory.FieldSchemaDriftDetected)
+	}
+	if m.schema_drift != nil {
+		fields = append(fields, jobexecutionhistory.FieldSchemaDrift)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobExecutionHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case jobexecutionhistory.FieldCreatedAt:
+		return m.CreatedAt()
+	case jobexecutionhistory.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case jobexecutionhistory.FieldJobName:
+		return m.JobName()
+	case jobexecutionhistory.FieldStatus:
+		return m.Status()
+	case jobexecutionhistory.FieldStartedAt:
+		return m.StartedAt()
+	case jobexecutionhistory.FieldCompletedAt:
+		return m.CompletedAt()
+	case jobexecutionhistory.FieldDurationSeconds:
+		return m.DurationSeconds()
+	case jobexecutionhistory.FieldTotalProcessed:
+		return m.TotalProcessed()
+	case jobexecutionhistory.FieldSuccessfulCount:
+		return m.SuccessfulCount()
+	case jobexecutionhistory.FieldFailedCount:
+		return m.FailedCount()
+	case jobexecutionhistory.FieldAPICallsMade:
+		return m.APICallsMade()
+	case jobexecutionhistory.FieldQuotaRemaining:
+		return m.QuotaRemaining()
+	case jobexecutionhistory.FieldErrorSummary:
+		return m.ErrorSummary()
+	case jobexecutionhistory.FieldSchemaDriftDetected:
+		return m.SchemaDriftDetected()
+	case jobexecutionhistory.FieldSchemaDrift:
+		return m.SchemaDrift()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobExecutionHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case jobexecutionhistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case jobexecutionhistory.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case jobexecutionhistory.FieldJobName:
+		return m.OldJobName(ctx)
+	case jobexecutionhistory.FieldStatus:
+		return m.OldStatus(ctx)
+	case jobexecutionhistory.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case jobexecutionhistory.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case jobexecutionhistory.FieldDurationSeconds:
+		return m.OldDurationSeconds(ctx)
+	case jobexecutionhistory.FieldTotalProcessed:
+		return m.OldTotalProcessed(ctx)
+	case jobexecutionhistory.FieldSuccessfulCount:
+		return m.OldSuccessfulCount(ctx)
+	case jobexecutionhistory.FieldFailedCount:
+		return m.OldFailedCount(ctx)
+	case jobexecutionhistory.FieldAPICallsMade:
+		return m.OldAPICallsMade(ctx)
+	case jobexecutionhistory.FieldQuotaRemaining:
+		return m.OldQuotaRemaining(ctx)
+	case jobexecutionhistory.FieldErrorSummary:
+		return m.OldErrorSummary(ctx)
+	case jobexecutionhistory.FieldSchemaDriftDetected:
+		return m.OldSchemaDriftDetected(ctx)
+	case jobexecutionhistory.FieldSchemaDrift:
+		return m.OldSchemaDrift(ctx)
+	}
+	return nil, fmt.Errorf("unknown JobExecutionHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobExecutionHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case jobexecutionhistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case jobexecutionhistory.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case jobexecutionhistory.FieldJobName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobName(v)
+		return nil
+	case jobexecutionhistory.FieldStatus:
+		v, ok := value.(jobexecutionhistory.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case jobexecutionhistory.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case jobexecutionhistory.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case jobexecutionhistory.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationSeconds(v)
+		return nil
+	case jobexecutionhistory.FieldTotalProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalProcessed(v)
+		return nil
+	case jobexecutionhistory.FieldSuccessfulCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccessfulCount(v)
+		return nil
+	case jobexecutionhistory.FieldFailedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedCount(v)
+		return nil
+	case jobexecutionhistory.FieldAPICallsMade:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICallsMade(v)
+		return nil
+	case jobexecutionhistory.FieldQuotaRemaining:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuotaRemaining(v)
+		return nil
+	case jobexecutionhistory.FieldErrorSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorSummary(v)
+		return nil
+	case jobexecutionhistory.FieldSchemaDriftDetected:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchemaDriftDetected(v)
+		return nil
+	case jobexecutionhistory.FieldSchemaDrift:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchemaDrift(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobExecutionHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobExecutionHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_seconds != nil {
+		fields = append(fields, jobexecutionhistory.FieldDurationSeconds)
+	}
+	if m.addtotal_processed != nil {
+		fields = append(fields, jobexecutionhistory.FieldTotalProcessed)
+	}
+	if m.addsuccessful_count != nil {
+		fields = append(fields, jobexecutionhistory.FieldSuccessfulCount)
+	}
+	if m.addfailed_count != nil {
+		fields = append(fields, jobexecutionhistory.FieldFailedCount)
+	}
+	if m.addapi_calls_made != nil {
+		fields = append(fields, jobexecutionhistory.FieldAPICallsMade)
+	}
+	if m.addquota_remaining != nil {
+		fields = append(fields, jobexecutionhistory.FieldQuotaRemaining)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobExecutionHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case jobexecutionhistory.FieldDurationSeconds:
+		return m.AddedDurationSeconds()
+	case jobexecutionhistory.FieldTotalProcessed:
+		return m.AddedTotalProcessed()
+	case jobexecutionhistory.FieldSuccessfulCount:
+		return m.AddedSuccessfulCount()
+	case jobexecutionhistory.FieldFailedCount:
+		return m.AddedFailedCount()
+	case jobexecutionhistory.FieldAPICallsMade:
+		return m.AddedAPICallsMade()
+	case jobexecutionhistory.FieldQuotaRemaining:
+		return m.AddedQuotaRemaining()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobExecutionHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case jobexecutionhistory.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationSeconds(v)
+		return nil
+	case jobexecutionhistory.FieldTotalProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalProcessed(v)
+		return nil
+	case jobexecutionhistory.FieldSuccessfulCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSuccessfulCount(v)
+		return nil
+	case jobexecutionhistory.FieldFailedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedCount(v)
+		return nil
+	case jobexecutionhistory.FieldAPICallsMade:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAPICallsMade(v)
+		return nil
+	case jobexecutionhistory.FieldQuotaRemaining:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuotaRemaining(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobExecutionHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobExecutionHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(jobexecutionhistory.FieldCompletedAt) {
+		fields = append(fields, jobexecutionhistory.FieldCompletedAt)
+	}
+	if m.FieldCleared(jobexecutionhistory.FieldErrorSummary) {
+		fields = append(fields, jobexecutionhistory.FieldErrorSummary)
+	}
+	if m.FieldCleared(jobexecutionhistory.FieldSchemaDrift) {
+		fields = append(fields, jobexecutionhistory.FieldSchemaDrift)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobExecutionHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobExecutionHistoryMutation) ClearField(name string) error {
+	switch name {
+	case jobexecutionhistory.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case jobexecutionhistory.FieldErrorSummary:
+		m.ClearErrorSummary()
+		return nil
+	case jobexecutionhistory.FieldSchemaDrift:
+		m.ClearSchemaDrift()
+		return nil
+	}
+	return fmt.Errorf("unknown JobExecutionHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobExecutionHistoryMutation) ResetField(name string) error {
+	switch name {
+	case jobexecutionhistory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case jobexecutionhistory.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case jobexecutionhistory.FieldJobName:
+		m.ResetJobName()
+		return nil
+	case jobexecutionhistory.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case jobexecutionhistory.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case jobexecutionhistory.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case jobexecutionhistory.FieldDurationSeconds:
+		m.ResetDurationSeconds()
+		return nil
+	case jobexecutionhistory.FieldTotalProcessed:
+		m.ResetTotalProcessed()
+		return nil
+	case jobexecutionhistory.FieldSuccessfulCount:
+		m.ResetSuccessfulCount()
+		return nil
+	case jobexecutionhistory.FieldFailedCount:
+		m.ResetFailedCount()
+		return nil
+	case jobexecutionhistory.FieldAPICallsMade:
+		m.ResetAPICallsMade()
+		return nil
+	case jobexecutionhistory.FieldQuotaRemaining:
+		m.ResetQuotaRemaining()
+		return nil
+	case jobexecutionhistory.FieldErrorSummary:
+		m.ResetErrorSummary()
+		return nil
+	case jobexecutionhistory.FieldSchemaDriftDetected:
+		m.ResetSchemaDriftDetected()
+		return nil
+	case jobexecutionhistory.FieldSchemaDrift:
+		m.ResetSchemaDrift()
+		return nil
+	}
+	return fmt.Errorf("unknown JobExecutionHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobExecutionHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.profile_entries != nil {
+		edges = append(edges, jobexecutionhistory.EdgeProfileEntries)
+	}
+	if m.api_calls != nil {
+		edges = append(edges, jobexecutionhistory.EdgeAPICalls)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobExecutionHistoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case jobexecutionhistory.EdgeProfileEntries:
+		ids := make([]ent.Value, 0, len(m.profile_entries))
+		for id := range m.profile_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case jobexecutionhistory.EdgeAPICalls:
+		ids := make([]ent.Value, 0, len(m.api_calls))
+		for id := range m.api_calls {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobExecutionHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedprofile_entries != nil {
+		edges = append(edges, jobexecutionhistory.EdgeProfileEntries)
+	}
+	if m.removedapi_calls != nil {
+		edges = append(edges, jobexecutionhistory.EdgeAPICalls)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobExecutionHistoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case jobexecutionhistory.EdgeProfileEntries:
+		ids := make([]ent.Value, 0, len(m.removedprofile_entries))
+		for id := range m.removedprofile_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case jobexecutionhistory.EdgeAPICalls:
+		ids := make([]ent.Value, 0, len(m.removedapi_calls))
+		for id := range m.removedapi_calls {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobExecutionHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedprofile_entries {
+		edges = append(edges, jobexecutionhistory.EdgeProfileEntries)
+	}
+	if m.clearedapi_calls {
+		edges = append(edges, jobexecutionhistory.EdgeAPICalls)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobExecutionHistoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case jobexecutionhistory.EdgeProfileEntries:
+		return m.clearedprofile_entries
+	case jobexecutionhistory.EdgeAPICalls:
+		return m.clearedapi_calls
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobExecutionHistoryMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown JobExecutionHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobExecutionHistoryMutation) ResetEdge(name string) error {
+	switch name {
+	case jobexecutionhistory.EdgeProfileEntries:
+		m.ResetProfileEntries()
+		return nil
+	case jobexecutionhistory.EdgeAPICalls:
+		m.ResetAPICalls()
+		return nil
+	}
+	return fmt.Errorf("unknown JobExecutionHistory edge %s", name)
+}
+
+// ProfileMutation represents an operation that mutates the Profile nodes in the graph.
+type ProfileMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *ulid.ID
+	urn                       *string
+	username                  *string
+	first_name                *string
+	last_name                 *string
+	headline                  *string
+	title                     *string
+	country                   *string
+	city                      *string
+	educations                *[]map[string]interface{}
+	appendeducations          []map[string]interface{}
+	positions                 *[]map[string]interface{}
+	appendpositions           []map[string]interface{}
+	skills                    *[]map[string]interface{}
+	appendskills              []map[string]interface{}
+	geo_data                  *map[string]interface{}
+	raw_data_s3_key           *string
+	cleaned_data_s3_key       *string
+	source_file               *string
+	created_at                *time.Time
+	updated_at                *time.Time
+	clearedFields             map[string]struct{}
+	profile_entry             *ulid.ID
+	clearedprofile_entry      bool
+	snapshots                 map[ulid.ID]struct{}
+	removedsnapshots          map[ulid.ID]struct{}
+	clearedsnapshots          bool
+	change_events             map[ulid.ID]struct{}
+	removedchange_events      map[ulid.ID]struct{}
+	clearedchange_events      bool
+	profile_positions         map[ulid.ID]struct{}
+	removedprofile_positions  map[ulid.ID]struct{}
+	clearedprofile_positions  bool
+	profile_educations        map[ulid.ID]struct{}
+	removedprofile_educations map[ulid.ID]struct{}
+	clearedprofile_educations bool
+	profile_skills            map[ulid.ID]struct{}
+	removedprofile_skills     map[ulid.ID]struct{}
+	clearedprofile_skills     bool
+	current_companies         map[ulid.ID]struct{}
+	removedcurrent_companies  map[ulid.ID]struct{}
+	clearedcurrent_companies  bool
+	past_companies            map[ulid.ID]struct{}
+	removedpast_companies     map[ulid.ID]struct{}
+	clearedpast_companies     bool
+	done                      bool
+	oldValue                  func(context.Context) (*Profile, error)
+	predicates                []predicate.Profile
+}
+
+var _ ent.Mutation = (*ProfileMutation)(nil)
+
+// profileOption allows management of the mutation configuration using functional options.
+type profileOption func(*ProfileMutation)
+
+// newProfileMutation creates new mutation for the Profile entity.
+func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation {
+	m := &ProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProfileID sets the ID field of the mutation.
+func withProfileID(id ulid.ID) profileOption {
+	return func(m *ProfileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Profile
+		)
+		m.oldValue = func(ctx context.Context) (*Profile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Profile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProfile sets the old Profile of the mutation.
+func withProfile(node *Profile) profileOption {
+	return func(m *ProfileMutation) {
+		m.oldValue = func(context.Context) (*Profile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Profile entities.
+func (m *ProfileMutation) SetID(id ulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfileMutation) ID() (id ulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfileMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []ulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Profile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUrn sets the "urn" field.
+func (m *ProfileMutation) SetUrn(s string) {
+	m.urn = &s
+}
+
+// Urn returns the value of the "urn" field in the mutation.
+func (m *ProfileMutation) Urn() (r string, exists bool) {
+	v := m.urn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUrn returns the old "urn" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldUrn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUrn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUrn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUrn: %w", err)
+	}
+	return oldValue.Urn, nil
+}
+
+// ResetUrn resets all changes to the "urn" field.
+func (m *ProfileMutation) ResetUrn() {
+	m.urn = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *ProfileMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *ProfileMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldUsername(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ClearUsername clears the value of the "username" field.
+func (m *ProfileMutation) ClearUsername() {
+	m.username = nil
+	m.clearedFields[profile.FieldUsername] = struct{}{}
+}
+
+// UsernameCleared returns if the "username" field was cleared in this mutation.
+func (m *ProfileMutation) UsernameCleared() bool {
+	_, ok := m.clearedFields[profile.FieldUsername]
+	return ok
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *ProfileMutation) ResetUsername() {
+	m.username = nil
+	delete(m.clearedFields, profile.FieldUsername)
+}
+
+// SetFirstName sets the "first_name" field.
+func (m *ProfileMutation) SetFirstName(s string) {
+	m.first_name = &s
+}
+
+// FirstName returns the value of the "first_name" field in the mutation.
+func (m *ProfileMutation) FirstName() (r string, exists bool) {
+	v := m.first_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstName returns the old "first_name" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldFirstName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+	}
+	return oldValue.FirstName, nil
+}
+
+// ClearFirstName clears the value of the "first_name" field.
+func (m *ProfileMutation) ClearFirstName() {
+	m.first_name = nil
+	m.clearedFields[profile.FieldFirstName] = struct{}{}
+}
+
+// FirstNameCleared returns if the "first_name" field was cleared in this mutation.
+func (m *ProfileMutation) FirstNameCleared() bool {
+	_, ok := m.clearedFields[profile.FieldFirstName]
+	return ok
+}
+
+// ResetFirstName resets all changes to the "first_name" field.
+func (m *ProfileMutation) ResetFirstName() {
+	m.first_name = nil
+	delete(m.clearedFields, profile.FieldFirstName)
+}
+
+// SetLastName sets the "last_name" field.
+func (m *ProfileMutation) SetLastName(s string) {
+	m.last_name = &s
+}
+
+// LastName returns the value of the "last_name" field in the mutation.
+func (m *ProfileMutation) LastName() (r string, exists bool) {
+	v := m.last_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastName returns the old "last_name" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldLastName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+	}
+	return oldValue.LastName, nil
+}
+
+// ClearLastName clears the value of the "last_name" field.
+func (m *ProfileMutation) ClearLastName() {
+	m.last_name = nil
+	m.clearedFields[profile.FieldLastName] = struct{}{}
+}
+
+// LastNameCleared returns if the "last_name" field was cleared in this mutation.
+func (m *ProfileMutation) LastNameCleared() bool {
+	_, ok := m.clearedFields[profile.FieldLastName]
+	return ok
+}
+
+// ResetLastName resets all changes to the "last_name" field.
+func (m *ProfileMutation) ResetLastName() {
+	m.last_name = nil
+	delete(m.clearedFields, profile.FieldLastName)
+}
+
+// SetHeadline sets the "headline" field.
+func (m *ProfileMutation) SetHeadline(s string) {
+	m.headline = &s
+}
+
+// Headline returns the value of the "headline" field in the mutation.
+func (m *ProfileMutation) Headline() (r string, exists bool) {
+	v := m.headline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeadline returns the old "headline" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldHeadline(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeadline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeadline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeadline: %w", err)
+	}
+	return oldValue.Headline, nil
+}
+
+// ClearHeadline clears the value of the "headline" field.
+func (m *ProfileMutation) ClearHeadline() {
+	m.headline = nil
+	m.clearedFields[profile.FieldHeadline] = struct{}{}
+}
+
+// HeadlineCleared returns if the "headline" field was cleared in this mutation.
+func (m *ProfileMutation) HeadlineCleared() bool {
+	_, ok := m.clearedFields[profile.FieldHeadline]
+	return ok
+}
+
+// ResetHeadline resets all changes to the "headline" field.
+func (m *ProfileMutation) ResetHeadline() {
+	m.headline = nil
+	delete(m.clearedFields, profile.FieldHeadline)
+}
+
+// SetTitle sets the "title" field.
+func (m *ProfileMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ProfileMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *ProfileMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[profile.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *ProfileMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[profile.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ProfileMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, profile.FieldTitle)
+}
+
+// SetCountry sets the "country" field.
+func (m *ProfileMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *ProfileMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountry returns the old "country" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldCountry(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
+}
+
+// ClearCountry clears the value of the "country" field.
+func (m *ProfileMutation) ClearCountry() {
+	m.country = nil
+	m.clearedFields[profile.FieldCountry] = struct{}{}
+}
+
+// CountryCleared returns if the "country" field was cleared in this mutation.
+func (m *ProfileMutation) CountryCleared() bool {
+	_, ok := m.clearedFields[profile.FieldCountry]
+	return ok
+}
+
+// ResetCountry resets all changes to the "country" field.
+func (m *ProfileMutation) ResetCountry() {
+	m.country = nil
+	delete(m.clearedFields, profile.FieldCountry)
+}
+
+// SetCity sets the "city" field.
+func (m *ProfileMutation) SetCity(s string) {
+	m.city = &s
+}
+
+// City returns the value of the "city" field in the mutation.
+func (m *ProfileMutation) City() (r string, exists bool) {
+	v := m.city
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCity returns the old "city" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldCity(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCity: %w", err)
+	}
+	return oldValue.City, nil
+}
+
+// ClearCity clears the value of the "city" field.
+func (m *ProfileMutation) ClearCity() {
+	m.city = nil
+	m.clearedFields[profile.FieldCity] = struct{}{}
+}
+
+// CityCleared returns if the "city" field was cleared in this mutation.
+func (m *ProfileMutation) CityCleared() bool {
+	_, ok := m.clearedFields[profile.FieldCity]
+	return ok
+}
+
+// ResetCity resets all changes to the "city" field.
+func (m *ProfileMutation) ResetCity() {
+	m.city = nil
+	delete(m.clearedFields, profile.FieldCity)
+}
+
+// SetEducations sets the "educations" field.
+func (m *ProfileMutation) SetEducations(value []map[string]interface{}) {
+	m.educations = &value
+	m.appendeducations = nil
+}
+
+// Educations returns the value of the "educations" field in the mutation.
+func (m *ProfileMutation) Educations() (r []map[string]interface{}, exists bool) {
+	v := m.educations
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEducations returns the old "educations" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldEducations(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEducations is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEducations requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEducations: %w", err)
+	}
+	return oldValue.Educations, nil
+}
+
+// AppendEducations adds value to the "educations" field.
+func (m *ProfileMutation) AppendEducations(value []map[string]interface{}) {
+	m.appendeducations = append(m.appendeducations, value...)
+}
+
+// AppendedEducations returns the list of values that were appended to the "educations" field in this mutation.
+func (m *ProfileMutation) AppendedEducations() ([]map[string]interface{}, bool) {
+	if len(m.appendeducations) == 0 {
+		return nil, false
+	}
+	return m.appendeducations, true
+}
+
+// ClearEducations clears the value of the "educations" field.
+func (m *ProfileMutation) ClearEducations() {
+	m.educations = nil
+	m.appendeducations = nil
+	m.clearedFields[profile.FieldEducations] = struct{}{}
+}
+
+// EducationsCleared returns if the "educations" field was cleared in this mutation.
+func (m *ProfileMutation) EducationsCleared() bool {
+	_, ok := m.clearedFields[profile.FieldEducations]
+	return ok
+}
+
+// ResetEducations resets all changes to the "educations" field.
+func (m *ProfileMutation) ResetEducations() {
+	m.educations = nil
+	m.appendeducations = nil
+	delete(m.clearedFields, profile.FieldEducations)
+}
+
+// SetPositions sets the "positions" field.
+func (m *ProfileMutation) SetPositions(value []map[string]interface{}) {
+	m.positions = &value
+	m.appendpositions = nil
+}
+
+// Positions returns the value of the "positions" field in the mutation.
+func (m *ProfileMutation) Positions() (r []map[string]interface{}, exists bool) {
+	v := m.positions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPositions returns the old "positions" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldPositions(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPositions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPositions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPositions: %w", err)
+	}
+	return oldValue.Positions, nil
+}
+
+// AppendPositions adds value to the "positions" field.
+func (m *ProfileMutation) AppendPositions(value []map[string]interface{}) {
+	m.appendpositions = append(m.appendpositions, value...)
+}
+
+// AppendedPositions returns the list of values that were appended to the "positions" field in this mutation.
+func (m *ProfileMutation) AppendedPositions() ([]map[string]interface{}, bool) {
+	if len(m.appendpositions) == 0 {
+		return nil, false
+	}
+	return m.appendpositions, true
+}
+
+// ClearPositions clears the value of the "positions" field.
+func (m *ProfileMutation) ClearPositions() {
+	m.positions = nil
+	m.appendpositions = nil
+	m.clearedFields[profile.FieldPositions] = struct{}{}
+}
+
+// PositionsCleared returns if the "positions" field was cleared in this mutation.
+func (m *ProfileMutation) PositionsCleared() bool {
+	_, ok := m.clearedFields[profile.FieldPositions]
+	return ok
+}
+
+// ResetPositions resets all changes to the "positions" field.
+func (m *ProfileMutation) ResetPositions() {
+	m.positions = nil
+	m.appendpositions = nil
+	delete(m.clearedFields, profile.FieldPositions)
+}
+
+// SetSkills sets the "skills" field.
+func (m *ProfileMutation) SetSkills(value []map[string]interface{}) {
+	m.skills = &value
+	m.appendskills = nil
+}
+
+// Skills returns the value of the "skills" field in the mutation.
+func (m *ProfileMutation) Skills() (r []map[string]interface{}, exists bool) {
+	v := m.skills
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkills returns the old "skills" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldSkills(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkills is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkills requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkills: %w", err)
+	}
+	return oldValue.Skills, nil
+}
+
+// AppendSkills adds value to the "skills" field.
+func (m *ProfileMutation) AppendSkills(value []map[string]interface{}) {
+	m.appendskills = append(m.appendskills, value...)
+}
+
+// AppendedSkills returns the list of values that were appended to the "skills" field in this mutation.
+func (m *ProfileMutation) AppendedSkills() ([]map[string]interface{}, bool) {
+	if len(m.appendskills) == 0 {
+		return nil, false
+	}
+	return m.appendskills, true
+}
+
+// ClearSkills clears the value of the "skills" field.
+func (m *ProfileMutation) ClearSkills() {
+	m.skills = nil
+	m.appendskills = nil
+	m.clearedFields[profile.FieldSkills] = struct{}{}
+}
+
+// SkillsCleared returns if the "skills" field was cleared in this mutation.
+func (m *ProfileMutation) SkillsCleared() bool {
+	_, ok := m.clearedFields[profile.FieldSkills]
+	return ok
+}
+
+// ResetSkills resets all changes to the "skills" field.
+func (m *ProfileMutation) ResetSkills() {
+	m.skills = nil
+	m.appendskills = nil
+	delete(m.clearedFields, profile.FieldSkills)
+}
+
+// SetGeoData sets the "geo_data" field.
+func (m *ProfileMutation) SetGeoData(value map[string]interface{}) {
+	m.geo_data = &value
+}
+
+// GeoData returns the value of the "geo_data" field in the mutation.
+func (m *ProfileMutation) GeoData() (r map[string]interface{}, exists bool) {
+	v := m.geo_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeoData returns the old "geo_data" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldGeoData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeoData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeoData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeoData: %w", err)
+	}
+	return oldValue.GeoData, nil
+}
+
+// ClearGeoData clears the value of the "geo_data" field.
+func (m *ProfileMutation) ClearGeoData() {
+	m.geo_data = nil
+	m.clearedFields[profile.FieldGeoData] = struct{}{}
+}
+
+// GeoDataCleared returns if the "geo_data" field was cleared in this mutation.
+func (m *ProfileMutation) GeoDataCleared() bool {
+	_, ok := m.clearedFields[profile.FieldGeoData]
+	return ok
+}
+
+// ResetGeoData resets all changes to the "geo_data" field.
+func (m *ProfileMutation) ResetGeoData() {
+	m.geo_data = nil
+	delete(m.clearedFields, profile.FieldGeoData)
+}
+
+// SetRawDataS3Key sets the "raw_data_s3_key" field.
+func (m *ProfileMutation) SetRawDataS3Key(s string) {
+	m.raw_data_s3_key = &s
+}
+
+// RawDataS3Key returns the value of the "raw_data_s3_key" field in the mutation.
+func (m *ProfileMutation) RawDataS3Key() (r string, exists bool) {
+	v := m.raw_data_s3_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawDataS3Key returns the old "raw_data_s3_key" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldRawDataS3Key(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawDataS3Key is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawDataS3Key requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawDataS3Key: %w", err)
+	}
+	return oldValue.RawDataS3Key, nil
+}
+
+// ClearRawDataS3Key clears the value of the "raw_data_s3_key" field.
+func (m *ProfileMutation) ClearRawDataS3Key() {
+	m.raw_data_s3_key = nil
+	m.clearedFields[profile.FieldRawDataS3Key] = struct{}{}
+}
+
+// RawDataS3KeyCleared returns if the "raw_data_s3_key" field was cleared in this mutation.
+func (m *ProfileMutation) RawDataS3KeyCleared() bool {
+	_, ok := m.clearedFields[profile.FieldRawDataS3Key]
+	return ok
+}
+
+// ResetRawDataS3Key resets all changes to the "raw_data_s3_key" field.
+func (m *ProfileMutation) ResetRawDataS3Key() {
+	m.raw_data_s3_key = nil
+	delete(m.clearedFields, profile.FieldRawDataS3Key)
+}
+
+// SetCleanedDataS3Key sets the "cleaned_data_s3_key" field.
+func (m *ProfileMutation) SetCleanedDataS3Key(s string) {
+	m.cleaned_data_s3_key = &s
+}
+
+// CleanedDataS3Key returns the value of the "cleaned_data_s3_key" field in the mutation.
+func (m *ProfileMutation) CleanedDataS3Key() (r string, exists bool) {
+	v := m.cleaned_data_s3_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCleanedDataS3Key returns the old "cleaned_data_s3_key" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldCleanedDataS3Key(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCleanedDataS3Key is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCleanedDataS3Key requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCleanedDataS3Key: %w", err)
+	}
+	return oldValue.CleanedDataS3Key, nil
+}
+
+// ClearCleanedDataS3Key clears the value of the "cleaned_data_s3_key" field.
+func (m *ProfileMutation) ClearCleanedDataS3Key() {
+	m.cleaned_data_s3_key = nil
+	m.clearedFields[profile.FieldCleanedDataS3Key] = struct{}{}
+}
+
+// CleanedDataS3KeyCleared returns if the "cleaned_data_s3_key" field was cleared in this mutation.
+func (m *ProfileMutation) CleanedDataS3KeyCleared() bool {
+	_, ok := m.clearedFields[profile.FieldCleanedDataS3Key]
+	return ok
+}
+
+// ResetCleanedDataS3Key resets all changes to the "cleaned_data_s3_key" field.
+func (m *ProfileMutation) ResetCleanedDataS3Key() {
+	m.cleaned_data_s3_key = nil
+	delete(m.clearedFields, profile.FieldCleanedDataS3Key)
+}
+
+// SetSourceFile sets the "source_file" field.
+func (m *ProfileMutation) SetSourceFile(s string) {
+	m.source_file = &s
+}
+
+// SourceFile returns the value of the "source_file" field in the mutation.
+func (m *ProfileMutation) SourceFile() (r string, exists bool) {
+	v := m.source_file
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceFile returns the old "source_file" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldSourceFile(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceFile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceFile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceFile: %w", err)
+	}
+	return oldValue.SourceFile, nil
+}
+
+// ClearSourceFile clears the value of the "source_file" field.
+func (m *ProfileMutation) ClearSourceFile() {
+	m.source_file = nil
+	m.clearedFields[profile.FieldSourceFile] = struct{}{}
+}
+
+// SourceFileCleared returns if the "source_file" field was cleared in this mutation.
+func (m *ProfileMutation) SourceFileCleared() bool {
+	_, ok := m.clearedFields[profile.FieldSourceFile]
+	return ok
+}
+
+// ResetSourceFile resets all changes to the "source_file" field.
+func (m *ProfileMutation) ResetSourceFile() {
+	m.source_file = nil
+	delete(m.clearedFields, profile.FieldSourceFile)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProfileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProfileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProfileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetProfileEntryID sets the "profile_entry" edge to the ProfileEntry entity by id.
+func (m *ProfileMutation) SetProfileEntryID(id ulid.ID) {
+	m.profile_entry = &id
+}
+
+// ClearProfileEntry clears the "profile_entry" edge to the ProfileEntry entity.
+func (m *ProfileMutation) ClearProfileEntry() {
+	m.clearedprofile_entry = true
+}
+
+// ProfileEntryCleared reports if the "profile_entry" edge to the ProfileEntry entity was cleared.
+func (m *ProfileMutation) ProfileEntryCleared() bool {
+	return m.clearedprofile_entry
+}
+
+// ProfileEntryID returns the "profile_entry" edge ID in the mutation.
+func (m *ProfileMutation) ProfileEntryID() (id ulid.ID, exists bool) {
+	if m.profile_entry != nil {
+		return *m.profile_entry, true
+	}
+	return
+}
+
+// ProfileEntryIDs returns the "profile_entry" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileEntryID instead. It exists only for internal usage by the builders.
+func (m *ProfileMutation) ProfileEntryIDs() (ids []ulid.ID) {
+	if id := m.profile_entry; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfileEntry resets all changes to the "profile_entry" edge.
+func (m *ProfileMutation) ResetProfileEntry() {
+	m.profile_entry = nil
+	m.clearedprofile_entry = false
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the ProfileSnapshot entity by ids.
+func (m *ProfileMutation) AddSnapshotIDs(ids ...ulid.ID) {
+	if m.snapshots == nil {
+		m.snapshots = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSnapshots clears the "snapshots" edge to the ProfileSnapshot entity.
+func (m *ProfileMutation) ClearSnapshots() {
+	m.clearedsnapshots = true
+}
+
+// SnapshotsCleared reports if the "snapshots" edge to the ProfileSnapshot entity was cleared.
+func (m *ProfileMutation) SnapshotsCleared() bool {
+	return m.clearedsnapshots
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to the ProfileSnapshot entity by IDs.
+func (m *ProfileMutation) RemoveSnapshotIDs(ids ...ulid.ID) {
+	if m.removedsnapshots == nil {
+		m.removedsnapshots = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.snapshots, ids[i])
+		m.removedsnapshots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSnapshots returns the removed IDs of the "snapshots" edge to the ProfileSnapshot entity.
+func (m *ProfileMutation) RemovedSnapshotsIDs() (ids []ulid.ID) {
+	for id := range m.removedsnapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SnapshotsIDs returns the "snapshots" edge IDs in the mutation.
+func (m *ProfileMutation) SnapshotsIDs() (ids []ulid.ID) {
+	for id := range m.snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSnapshots resets all changes to the "snapshots" edge.
+func (m *ProfileMutation) ResetSnapshots() {
+	m.snapshots = nil
+	m.clearedsnapshots = false
+	m.removedsnapshots = nil
+}
+
+// AddChangeEventIDs adds the "change_events" edge to the ProfileChangeEvent entity by ids.
+func (m *ProfileMutation) AddChangeEventIDs(ids ...ulid.ID) {
+	if m.change_events == nil {
+		m.change_events = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.change_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChangeEvents clears the "change_events" edge to the ProfileChangeEvent entity.
+func (m *ProfileMutation) ClearChangeEvents() {
+	m.clearedchange_events = true
+}
+
+// ChangeEventsCleared reports if the "change_events" edge to the ProfileChangeEvent entity was cleared.
+func (m *ProfileMutation) ChangeEventsCleared() bool {
+	return m.clearedchange_events
+}
+
+// RemoveChangeEventIDs removes the "change_events" edge to the ProfileChangeEvent entity by IDs.
+func (m *ProfileMutation) RemoveChangeEventIDs(ids ...ulid.ID) {
+	if m.removedchange_events == nil {
+		m.removedchange_events = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.change_events, ids[i])
+		m.removedchange_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChangeEvents returns the removed IDs of the "change_events" edge to the ProfileChangeEvent entity.
+func (m *ProfileMutation) RemovedChangeEventsIDs() (ids []ulid.ID) {
+	for id := range m.removedchange_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChangeEventsIDs returns the "change_events" edge IDs in the mutation.
+func (m *ProfileMutation) ChangeEventsIDs() (ids []ulid.ID) {
+	for id := range m.change_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChangeEvents resets all changes to the "change_events" edge.
+func (m *ProfileMutation) ResetChangeEvents() {
+	m.change_events = nil
+	m.clearedchange_events = false
+	m.removedchange_events = nil
+}
+
+// AddProfilePositionIDs adds the "profile_positions" edge to the ProfilePosition entity by ids.
+func (m *ProfileMutation) AddProfilePositionIDs(ids ...ulid.ID) {
+	if m.profile_positions == nil {
+		m.profile_positions = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.profile_positions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProfilePositions clears the "profile_positions" edge to the ProfilePosition entity.
+func (m *ProfileMutation) ClearProfilePositions() {
+	m.clearedprofile_positions = true
+}
+
+// ProfilePositionsCleared reports if the "profile_positions" edge to the ProfilePosition entity was cleared.
+func (m *ProfileMutation) ProfilePositionsCleared() bool {
+	return m.clearedprofile_positions
+}
+
+// RemoveProfilePositionIDs removes the "profile_positions" edge to the ProfilePosition entity by IDs.
+func (m *ProfileMutation) RemoveProfilePositionIDs(ids ...ulid.ID) {
+	if m.removedprofile_positions == nil {
+		m.removedprofile_positions = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.profile_positions, ids[i])
+		m.removedprofile_positions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProfilePositions returns the removed IDs of the "profile_positions" edge to the ProfilePosition entity.
+func (m *ProfileMutation) RemovedProfilePositionsIDs() (ids []ulid.ID) {
+	for id := range m.removedprofile_positions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProfilePositionsIDs returns the "profile_positions" edge IDs in the mutation.
+func (m *ProfileMutation) ProfilePositionsIDs() (ids []ulid.ID) {
+	for id := range m.profile_positions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProfilePositions resets all changes to the "profile_positions" edge.
+func (m *ProfileMutation) ResetProfilePositions() {
+	m.profile_positions = nil
+	m.clearedprofile_positions = false
+	m.removedprofile_positions = nil
+}
+
+// AddProfileEducationIDs adds the "profile_educations" edge to the ProfileEducation entity by ids.
+func (m *ProfileMutation) AddProfileEducationIDs(ids ...ulid.ID) {
+	if m.profile_educations == nil {
+		m.profile_educations = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.profile_educations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProfileEducations clears the "profile_educations" edge to the ProfileEducation entity.
+func (m *ProfileMutation) ClearProfileEducations() {
+	m.clearedprofile_educations = true
+}
+
+// ProfileEducationsCleared reports if the "profile_educations" edge to the ProfileEducation entity was cleared.
+func (m *ProfileMutation) ProfileEducationsCleared() bool {
+	return m.clearedprofile_educations
+}
+
+// RemoveProfileEducationIDs removes the "profile_educations" edge to the ProfileEducation entity by IDs.
+func (m *ProfileMutation) RemoveProfileEducationIDs(ids ...ulid.ID) {
+	if m.removedprofile_educations == nil {
+		m.removedprofile_educations = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.profile_educations, ids[i])
+		m.removedprofile_educations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProfileEducations returns the removed IDs of the "profile_educations" edge to the ProfileEducation entity.
+func (m *ProfileMutation) RemovedProfileEducationsIDs() (ids []ulid.ID) {
+	for id := range m.removedprofile_educations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProfileEducationsIDs returns the "profile_educations" edge IDs in the mutation.
+func (m *ProfileMutation) ProfileEducationsIDs() (ids []ulid.ID) {
+	for id := range m.profile_educations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProfileEducations resets all changes to the "profile_educations" edge.
+func (m *ProfileMutation) ResetProfileEducations() {
+	m.profile_educations = nil
+	m.clearedprofile_educations = false
+	m.removedprofile_educations = nil
+}
+
+// AddProfileSkillIDs adds the "profile_skills" edge to the ProfileSkill entity by ids.
+func (m *ProfileMutation) AddProfileSkillIDs(ids ...ulid.ID) {
+	if m.profile_skills == nil {
+		m.profile_skills = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.profile_skills[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProfileSkills clears the "profile_skills" edge to the ProfileSkill entity.
+func (m *ProfileMutation) ClearProfileSkills() {
+	m.clearedprofile_skills = true
+}
+
+// ProfileSkillsCleared reports if the "profile_skills" edge to the ProfileSkill entity was cleared.
+func (m *ProfileMutation) ProfileSkillsCleared() bool {
+	return m.clearedprofile_skills
+}
+
+// RemoveProfileSkillIDs removes the "profile_skills" edge to the ProfileSkill entity by IDs.
+func (m *ProfileMutation) RemoveProfileSkillIDs(ids ...ulid.ID) {
+	if m.removedprofile_skills == nil {
+		m.removedprofile_skills = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.profile_skills, ids[i])
+		m.removedprofile_skills[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProfileSkills returns the removed IDs of the "profile_skills" edge to the ProfileSkill entity.
+func (m *ProfileMutation) RemovedProfileSkillsIDs() (ids []ulid.ID) {
+	for id := range m.removedprofile_skills {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProfileSkillsIDs returns the "profile_skills" edge IDs in the mutation.
+func (m *ProfileMutation) ProfileSkillsIDs() (ids []ulid.ID) {
+	for id := range m.profile_skills {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProfileSkills resets all changes to the "profile_skills" edge.
+func (m *ProfileMutation) ResetProfileSkills() {
+	m.profile_skills = nil
+	m.clearedprofile_skills = false
+	m.removedprofile_skills = nil
+}
+
+// AddCurrentCompanyIDs adds the "current_companies" edge to the Company entity by ids.
+func (m *ProfileMutation) AddCurrentCompanyIDs(ids ...ulid.ID) {
+	if m.current_companies == nil {
+		m.current_companies = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.current_companies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCurrentCompanies clears the "current_companies" edge to the Company entity.
+func (m *ProfileMutation) ClearCurrentCompanies() {
+	m.clearedcurrent_companies = true
+}
+
+// CurrentCompaniesCleared reports if the "current_companies" edge to the Company entity was cleared.
+func (m *ProfileMutation) CurrentCompaniesCleared() bool {
+	return m.clearedcurrent_companies
+}
+
+// RemoveCurrentCompanyIDs removes the "current_companies" edge to the Company entity by IDs.
+func (m *ProfileMutation) RemoveCurrentCompanyIDs(ids ...ulid.ID) {
+	if m.removedcurrent_companies == nil {
+		m.removedcurrent_companies = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.current_companies, ids[i])
+		m.removedcurrent_companies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCurrentCompanies returns the removed IDs of the "current_companies" edge to the Company entity.
+func (m *ProfileMutation) RemovedCurrentCompaniesIDs() (ids []ulid.ID) {
+	for id := range m.removedcurrent_companies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CurrentCompaniesIDs returns the "current_companies" edge IDs in the mutation.
+func (m *ProfileMutation) CurrentCompaniesIDs() (ids []ulid.ID) {
+	for id := range m.current_companies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCurrentCompanies resets all changes to the "current_companies" edge.
+func (m *ProfileMutation) ResetCurrentCompanies() {
+	m.current_companies = nil
+	m.clearedcurrent_companies = false
+	m.removedcurrent_companies = nil
+}
+
+// AddPastCompanyIDs adds the "past_companies" edge to the Company entity by ids.
+func (m *ProfileMutation) AddPastCompanyIDs(ids ...ulid.ID) {
+	if m.past_companies == nil {
+		m.past_companies = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.past_companies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPastCompanies clears the "past_companies" edge to the Company entity.
+func (m *ProfileMutation) ClearPastCompanies() {
+	m.clearedpast_companies = true
+}
+
+// PastCompaniesCleared reports if the "past_companies" edge to the Company entity was cleared.
+func (m *ProfileMutation) PastCompaniesCleared() bool {
+	return m.clearedpast_companies
+}
+
+// RemovePastCompanyIDs removes the "past_companies" edge to the Company entity by IDs.
+func (m *ProfileMutation) RemovePastCompanyIDs(ids ...ulid.ID) {
+	if m.removedpast_companies == nil {
+		m.removedpast_companies = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.past_companies, ids[i])
+		m.removedpast_companies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPastCompanies returns the removed IDs of the "past_companies" edge to the Company entity.
+func (m *ProfileMutation) RemovedPastCompaniesIDs() (ids []ulid.ID) {
+	for id := range m.removedpast_companies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PastCompaniesIDs returns the "past_companies" edge IDs in the mutation.
+func (m *ProfileMutation) PastCompaniesIDs() (ids []ulid.ID) {
+	for id := range m.past_companies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPastCompanies resets all changes to the "past_companies" edge.
+func (m *ProfileMutation) ResetPastCompanies() {
+	m.past_companies = nil
+	m.clearedpast_companies = false
+	m.removedpast_companies = nil
+}
+
+// Where appends a list predicates to the ProfileMutation builder.
+func (m *ProfileMutation) Where(ps ...predicate.Profile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Profile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Profile).
+func (m *ProfileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfileMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.urn != nil {
+		fields = append(fields, profile.FieldUrn)
+	}
+	if m.username != nil {
+		fields = append(fields, profile.FieldUsername)
+	}
+	if m.first_name != nil {
+		fields = append(fields, profile.FieldFirstName)
+	}
+	if m.last_name != nil {
+		fields = append(fields, profile.FieldLastName)
+	}
+	if m.headline != nil {
+		fields = append(fields, profile.FieldHeadline)
+	}
+	if m.title != nil {
+		fields = append(fields, profile.FieldTitle)
+	}
+	if m.country != nil {
+		fields = append(fields, profile.FieldCountry)
+	}
+	if m.city != nil {
+		fields = append(fields, profile.FieldCity)
+	}
+	if m.educations != nil {
+		fields = append(fields, profile.FieldEducations)
+	}
+	if m.positions != nil {
+		fields = append(fields, profile.FieldPositions)
+	}
+	if m.skills != nil {
+		fields = append(fields, profile.FieldSkills)
+	}
+	if m.geo_data != nil {
+		fields = append(fields, profile.FieldGeoData)
+	}
+	if m.raw_data_s3_key != nil {
+		fields = append(fields, profile.FieldRawDataS3Key)
+	}
+	if m.cleaned_data_s3_key != nil {
+		fields = append(fields, profile.FieldCleanedDataS3Key)
+	}
+	if m.source_file != nil {
+		fields = append(fields, profile.FieldSourceFile)
+	}
+	if m.created_at != nil {
+		fields = append(fields, profile.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, profile.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profile.FieldUrn:
+		return m.Urn()
+	case profile.FieldUsername:
+		return m.Username()
+	case profile.FieldFirstName:
+		return m.FirstName()
+	case profile.FieldLastName:
+		return m.LastName()
+	case profile.FieldHeadline:
+		return m.Headline()
+	case profile.FieldTitle:
+		return m.Title()
+	case profile.FieldCountry:
+		return m.Country()
+	case profile.FieldCity:
+		return m.City()
+	case profile.FieldEducations:
+		return m.Educations()
+	case profile.FieldPositions:
+		return m.Positions()
+	case profile.FieldSkills:
+		return m.Skills()
+	case profile.FieldGeoData:
+		return m.GeoData()
+	case profile.FieldRawDataS3Key:
+		return m.RawDataS3Key()
+	case profile.FieldCleanedDataS3Key:
+		return m.CleanedDataS3Key()
+	case profile.FieldSourceFile:
+		return m.SourceFile()
+	case profile.FieldCreatedAt:
+		return m.CreatedAt()
+	case profile.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profile.FieldUrn:
+		return m.OldUrn(ctx)
+	case profile.FieldUsername:
+		return m.OldUsername(ctx)
+	case profile.FieldFirstName:
+		return m.OldFirstName(ctx)
+	case profile.FieldLastName:
+		return m.OldLastName(ctx)
+	case profile.FieldHeadline:
+		return m.OldHeadline(ctx)
+	case profile.FieldTitle:
+		return m.OldTitle(ctx)
+	case profile.FieldCountry:
+		return m.OldCountry(ctx)
+	case profile.FieldCity:
+		return m.OldCity(ctx)
+	case profile.FieldEducations:
+		return m.OldEducations(ctx)
+	case profile.FieldPositions:
+		return m.OldPositions(ctx)
+	case profile.FieldSkills:
+		return m.OldSkills(ctx)
+	case profile.FieldGeoData:
+		return m.OldGeoData(ctx)
+	case profile.FieldRawDataS3Key:
+		return m.OldRawDataS3Key(ctx)
+	case profile.FieldCleanedDataS3Key:
+		return m.OldCleanedDataS3Key(ctx)
+	case profile.FieldSourceFile:
+		return m.OldSourceFile(ctx)
+	case profile.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profile.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Profile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profile.FieldUrn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUrn(v)
+		return nil
+	case profile.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case profile.FieldFirstName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstName(v)
+		return nil
+	case profile.FieldLastName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastName(v)
+		return nil
+	case profile.FieldHeadline:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeadline(v)
+		return nil
+	case profile.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case profile.FieldCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountry(v)
+		return nil
+	case profile.FieldCity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCity(v)
+		return nil
+	case profile.FieldEducations:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEducations(v)
+		return nil
+	case profile.FieldPositions:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPositions(v)
+		return nil
+	case profile.FieldSkills:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkills(v)
+		return nil
+	case profile.FieldGeoData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeoData(v)
+		return nil
+	case profile.FieldRawDataS3Key:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawDataS3Key(v)
+		return nil
+	case profile.FieldCleanedDataS3Key:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCleanedDataS3Key(v)
+		return nil
+	case profile.FieldSourceFile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceFile(v)
+		return nil
+	case profile.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profile.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Profile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfileMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfileMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Profile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProfileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(profile.FieldUsername) {
+		fields = append(fields, profile.FieldUsername)
+	}
+	if m.FieldCleared(profile.FieldFirstName) {
+		fields = append(fields, profile.FieldFirstName)
+	}
+	if m.FieldCleared(profile.FieldLastName) {
+		fields = append(fields, profile.FieldLastName)
+	}
+	if m.FieldCleared(profile.FieldHeadline) {
+		fields = append(fields, profile.FieldHeadline)
+	}
+	if m.FieldCleared(profile.FieldTitle) {
+		fields = append(fields, profile.FieldTitle)
+	}
+	if m.FieldCleared(profile.FieldCountry) {
+		fields = append(fields, profile.FieldCountry)
+	}
+	if m.FieldCleared(profile.FieldCity) {
+		fields = append(fields, profile.FieldCity)
+	}
+	if m.FieldCleared(profile.FieldEducations) {
+		fields = append(fields, profile.FieldEducations)
+	}
+	if m.FieldCleared(profile.FieldPositions) {
+		fields = append(fields, profile.FieldPositions)
+	}
+	if m.FieldCleared(profile.FieldSkills) {
+		fields = append(fields, profile.FieldSkills)
+	}
+	if m.FieldCleared(profile.FieldGeoData) {
+		fields = append(fields, profile.FieldGeoData)
+	}
+	if m.FieldCleared(profile.FieldRawDataS3Key) {
+		fields = append(fields, profile.FieldRawDataS3Key)
+	}
+	if m.FieldCleared(profile.FieldCleanedDataS3Key) {
+		fields = append(fields, profile.FieldCleanedDataS3Key)
+	}
+	if m.FieldCleared(profile.FieldSourceFile) {
+		fields = append(fields, profile.FieldSourceFile)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProfileMutation) ClearField(name string) error {
+	switch name {
+	case profile.FieldUsername:
+		m.ClearUsername()
+		return nil
+	case profile.FieldFirstName:
+		m.ClearFirstName()
+		return nil
+	case profile.FieldLastName:
+		m.ClearLastName()
+		return nil
+	case profile.FieldHeadline:
+		m.ClearHeadline()
+		return nil
+	case profile.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case profile.FieldCountry:
+		m.ClearCountry()
+		return nil
+	case profile.FieldCity:
+		m.ClearCity()
+		return nil
+	case profile.FieldEducations:
+		m.ClearEducations()
+		return nil
+	case profile.FieldPositions:
+		m.ClearPositions()
+		return nil
+	case profile.FieldSkills:
+		m.ClearSkills()
+		return nil
+	case profile.FieldGeoData:
+		m.ClearGeoData()
+		return nil
+	case profile.FieldRawDataS3Key:
+		m.ClearRawDataS3Key()
+		return nil
+	case profile.FieldCleanedDataS3Key:
+		m.ClearCleanedDataS3Key()
+		return nil
+	case profile.FieldSourceFile:
+		m.ClearSourceFile()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProfileMutation) ResetField(name string) error {
+	switch name {
+	case profile.FieldUrn:
+		m.ResetUrn()
+		return nil
+	case profile.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case profile.FieldFirstName:
+		m.ResetFirstName()
+		return nil
+	case profile.FieldLastName:
+		m.ResetLastName()
+		return nil
+	case profile.FieldHeadline:
+		m.ResetHeadline()
+		return nil
+	case profile.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case profile.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case profile.FieldCity:
+		m.ResetCity()
+		return nil
+	case profile.FieldEducations:
+		m.ResetEducations()
+		return nil
+	case profile.FieldPositions:
+		m.ResetPositions()
+		return nil
+	case profile.FieldSkills:
+		m.ResetSkills()
+		return nil
+	case profile.FieldGeoData:
+		m.ResetGeoData()
+		return nil
+	case profile.FieldRawDataS3Key:
+		m.ResetRawDataS3Key()
+		return nil
+	case profile.FieldCleanedDataS3Key:
+		m.ResetCleanedDataS3Key()
+		return nil
+	case profile.FieldSourceFile:
+		m.ResetSourceFile()
+		return nil
+	case profile.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case profile.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.profile_entry != nil {
+		edges = append(edges, profile.EdgeProfileEntry)
+	}
+	if m.snapshots != nil {
+		edges = append(edges, profile.EdgeSnapshots)
+	}
+	if m.change_events != nil {
+		edges = append(edges, profile.EdgeChangeEvents)
+	}
+	if m.profile_positions != nil {
+		edges = append(edges, profile.EdgeProfilePositions)
+	}
+	if m.profile_educations != nil {
+		edges = append(edges, profile.EdgeProfileEducations)
+	}
+	if m.profile_skills != nil {
+		edges = append(edges, profile.EdgeProfileSkills)
+	}
+	if m.current_companies != nil {
+		edges = append(edges, profile.EdgeCurrentCompanies)
+	}
+	if m.past_companies != nil {
+		edges = append(edges, profile.EdgePastCompanies)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProfileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case profile.EdgeProfileEntry:
+		if id := m.profile_entry; id != nil {
+			return []ent.Value{*id}
+		}
+	case profile.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.snapshots))
+		for id := range m.snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeChangeEvents:
+		ids := make([]ent.Value, 0, len(m.change_events))
+		for id := range m.change_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeProfilePositions:
+		ids := make([]ent.Value, 0, len(m.profile_positions))
+		for id := range m.profile_positions {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeProfileEducations:
+		ids := make([]ent.Value, 0, len(m.profile_educations))
+		for id := range m.profile_educations {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeProfileSkills:
+		ids := make([]ent.Value, 0, len(m.profile_skills))
+		for id := range m.profile_skills {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeCurrentCompanies:
+		ids := make([]ent.Value, 0, len(m.current_companies))
+		for id := range m.current_companies {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgePastCompanies:
+		ids := make([]ent.Value, 0, len(m.past_companies))
+		for id := range m.past_companies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedsnapshots != nil {
+		edges = append(edges, profile.EdgeSnapshots)
+	}
+	if m.removedchange_events != nil {
+		edges = append(edges, profile.EdgeChangeEvents)
+	}
+	if m.removedprofile_positions != nil {
+		edges = append(edges, profile.EdgeProfilePositions)
+	}
+	if m.removedprofile_educations != nil {
+		edges = append(edges, profile.EdgeProfileEducations)
+	}
+	if m.removedprofile_skills != nil {
+		edges = append(edges, profile.EdgeProfileSkills)
+	}
+	if m.removedcurrent_companies != nil {
+		edges = append(edges, profile.EdgeCurrentCompanies)
+	}
+	if m.removedpast_companies != nil {
+		edges = append(edges, profile.EdgePastCompanies)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProfileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case profile.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.removedsnapshots))
+		for id := range m.removedsnapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeChangeEvents:
+		ids := make([]ent.Value, 0, len(m.removedchange_events))
+		for id := range m.removedchange_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeProfilePositions:
+		ids := make([]ent.Value, 0, len(m.removedprofile_positions))
+		for id := range m.removedprofile_positions {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeProfileEducations:
+		ids := make([]ent.Value, 0, len(m.removedprofile_educations))
+		for id := range m.removedprofile_educations {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeProfileSkills:
+		ids := make([]ent.Value, 0, len(m.removedprofile_skills))
+		for id := range m.removedprofile_skills {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeCurrentCompanies:
+		ids := make([]ent.Value, 0, len(m.removedcurrent_companies))
+		for id := range m.removedcurrent_companies {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgePastCompanies:
+		ids := make([]ent.Value, 0, len(m.removedpast_companies))
+		for id := range m.removedpast_companies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedprofile_entry {
+		edges = append(edges, profile.EdgeProfileEntry)
+	}
+	if m.clearedsnapshots {
+		edges = append(edges, profile.EdgeSnapshots)
+	}
+	if m.clearedchange_events {
+		edges = append(edges, profile.EdgeChangeEvents)
+	}
+	if m.clearedprofile_positions {
+		edges = append(edges, profile.EdgeProfilePositions)
+	}
+	if m.clearedprofile_educations {
+		edges = append(edges, profile.EdgeProfileEducations)
+	}
+	if m.clearedprofile_skills {
+		edges = append(edges, profile.EdgeProfileSkills)
+	}
+	if m.clearedcurrent_companies {
+		edges = append(edges, profile.EdgeCurrentCompanies)
+	}
+	if m.clearedpast_companies {
+		edges = append(edges, profile.EdgePastCompanies)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProfileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case profile.EdgeProfileEntry:
+		return m.clearedprofile_entry
+	case profile.EdgeSnapshots:
+		return m.clearedsnapshots
+	case profile.EdgeChangeEvents:
+		return m.clearedchange_events
+	case profile.EdgeProfilePositions:
+		return m.clearedprofile_positions
+	case profile.EdgeProfileEducations:
+		return m.clearedprofile_educations
+	case profile.EdgeProfileSkills:
+		return m.clearedprofile_skills
+	case profile.EdgeCurrentCompanies:
+		return m.clearedcurrent_companies
+	case profile.EdgePastCompanies:
+		return m.clearedpast_companies
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProfileMutation) ClearEdge(name string) error {
+	switch name {
+	case profile.EdgeProfileEntry:
+		m.ClearProfileEntry()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProfileMutation) ResetEdge(name string) error {
+	switch name {
+	case profile.EdgeProfileEntry:
+		m.ResetProfileEntry()
+		return nil
+	case profile.EdgeSnapshots:
+		m.ResetSnapshots()
+		return nil
+	case profile.EdgeChangeEvents:
+		m.ResetChangeEvents()
+		return nil
+	case profile.EdgeProfilePositions:
+		m.ResetProfilePositions()
+		return nil
+	case profile.EdgeProfileEducations:
+		m.ResetProfileEducations()
+		return nil
+	case profile.EdgeProfileSkills:
+		m.ResetProfileSkills()
+		return nil
+	case profile.EdgeCurrentCompanies:
+		m.ResetCurrentCompanies()
+		return nil
+	case profile.EdgePastCompanies:
+		m.ResetPastCompanies()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile edge %s", name)
+}
+
+// ProfileChangeEventMutation represents an operation that mutates the ProfileChangeEvent nodes in the graph.
+type ProfileChangeEventMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *ulid.ID
+	_type                *profilechangeevent.Type
+	old_value            *string
+	new_value            *string
+	detected_at          *time.Time
+	delivery_status      *profilechangeevent.DeliveryStatus
+	delivery_attempts    *int
+	adddelivery_attempts *int
+	next_delivery_at     *time.Time
+	delivered_at         *time.Time
+	last_delivery_error  *string
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	profile              *ulid.ID
+	clearedprofile       bool
+	snapshot             *ulid.ID
+	clearedsnapshot      bool
+	done                 bool
+	oldValue             func(context.Context) (*ProfileChangeEvent, error)
+	predicates           []predicate.ProfileChangeEvent
+}
+
+var _ ent.Mutation = (*ProfileChangeEventMutation)(nil)
+
+// profilechangeeventOption allows management of the mutation configuration using functional options.
+type profilechangeeventOption func(*ProfileChangeEventMutation)
+
+// newProfileChangeEventMutation creates new mutation for the ProfileChangeEvent entity.
+func newProfileChangeEventMutation(c config, op Op, opts ...profilechangeeventOption) *ProfileChangeEventMutation {
+	m := &ProfileChangeEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfileChangeEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProfileChangeEventID sets the ID field of the mutation.
+func withProfileChangeEventID(id ulid.ID) profilechangeeventOption {
+	return func(m *ProfileChangeEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProfileChangeEvent
+		)
+		m.oldValue = func(ctx context.Context) (*ProfileChangeEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProfileChangeEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProfileChangeEvent sets the old ProfileChangeEvent of the mutation.
+func withProfileChangeEvent(node *ProfileChangeEvent) profilechangeeventOption {
+	return func(m *ProfileChangeEventMutation) {
+		m.oldValue = func(context.Context) (*ProfileChangeEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfileChangeEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfileChangeEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProfileChangeEvent entities.
+func (m *ProfileChangeEventMutation) SetID(id ulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfileChangeEventMutation) ID() (id ulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfileChangeEventMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []ulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProfileChangeEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *ProfileChangeEventMutation) SetType(pr profilechangeevent.Type) {
+	m._type = &pr
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ProfileChangeEventMutation) GetType() (r profilechangeevent.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ProfileChangeEvent entity.
+// If the ProfileChangeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileChangeEventMutation) OldType(ctx context.Context) (v profilechangeevent.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ProfileChangeEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetOldValue sets the "old_value" field.
+func (m *ProfileChangeEventMutation) SetOldValue(s string) {
+	m.old_value = &s
+}
+
+// OldValue returns the value of the "old_value" field in the mutation.
+func (m *ProfileChangeEventMutation) OldValue() (r string, exists bool) {
+	v := m.old_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldValue returns the old "old_value" field's value of the ProfileChangeEvent entity.
+// If the ProfileChangeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileChangeEventMutation) OldOldValue(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldValue: %w", err)
+	}
+	return oldValue.OldValue, nil
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (m *ProfileChangeEventMutation) ClearOldValue() {
+	m.old_value = nil
+	m.clearedFields[profilechangeevent.FieldOldValue] = struct{}{}
+}
+
+// OldValueCleared returns if the "old_value" field was cleared in this mutation.
+func (m *ProfileChangeEventMutation) OldValueCleared() bool {
+	_, ok := m.clearedFields[profilechangeevent.FieldOldValue]
+	return ok
+}
+
+// ResetOldValue resets all changes to the "old_value" field.
+func (m *ProfileChangeEventMutation) ResetOldValue() {
+	m.old_value = nil
+	delete(m.clearedFields, profilechangeevent.FieldOldValue)
+}
+
+// SetNewValue sets the "new_value" field.
+func (m *ProfileChangeEventMutation) SetNewValue(s string) {
+	m.new_value = &s
+}
+
+// NewValue returns the value of the "new_value" field in the mutation.
+func (m *ProfileChangeEventMutation) NewValue() (r string, exists bool) {
+	v := m.new_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewValue returns the old "new_value" field's value of the ProfileChangeEvent entity.
+// If the ProfileChangeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileChangeEventMutation) OldNewValue(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewValue: %w", err)
+	}
+	return oldValue.NewValue, nil
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (m *ProfileChangeEventMutation) ClearNewValue() {
+	m.new_value = nil
+	m.clearedFields[profilechangeevent.FieldNewValue] = struct{}{}
+}
+
+// NewValueCleared returns if the "new_value" field was cleared in this mutation.
+func (m *ProfileChangeEventMutation) NewValueCleared() bool {
+	_, ok := m.clearedFields[profilechangeevent.FieldNewValue]
+	return ok
+}
+
+// ResetNewValue resets all changes to the "new_value" field.
+func (m *ProfileChangeEventMutation) ResetNewValue() {
+	m.new_value = nil
+	delete(m.clearedFields, profilechangeevent.FieldNewValue)
+}
+
+// SetDetectedAt sets the "detected_at" field.
+func (m *ProfileChangeEventMutation) SetDetectedAt(t time.Time) {
+	m.detected_at = &t
+}
+
+// DetectedAt returns the value of the "detected_at" field in the mutation.
+func (m *ProfileChangeEventMutation) DetectedAt() (r time.Time, exists bool) {
+	v := m.detected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetectedAt returns the old "detected_at" field's value of the ProfileChangeEvent entity.
+// If the ProfileChangeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileChangeEventMutation) OldDetectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetectedAt: %w", err)
+	}
+	return oldValue.DetectedAt, nil
+}
+
+// ResetDetectedAt resets all changes to the "detected_at" field.
+func (m *ProfileChangeEventMutation) ResetDetectedAt() {
+	m.detected_at = nil
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (m *ProfileChangeEventMutation) SetDeliveryStatus(ps profilechangeevent.DeliveryStatus) {
+	m.delivery_status = &ps
+}
+
+// DeliveryStatus returns the value of the "delivery_status" field in the mutation.
+func (m *ProfileChangeEventMutation) DeliveryStatus() (r profilechangeevent.DeliveryStatus, exists bool) {
+	v := m.delivery_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveryStatus returns the old "delivery_status" field's value of the ProfileChangeEvent entity.
+// If the ProfileChangeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileChangeEventMutation) OldDeliveryStatus(ctx context.Context) (v profilechangeevent.DeliveryStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveryStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveryStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveryStatus: %w", err)
+	}
+	return oldValue.DeliveryStatus, nil
+}
+
+// ResetDeliveryStatus resets all changes to the "delivery_status" field.
+func (m *ProfileChangeEventMutation) ResetDeliveryStatus() {
+	m.delivery_status = nil
+}
+
+// SetDeliveryAttempts sets the "delivery_attempts" field.
+func (m *ProfileChangeEventMutation) SetDeliveryAttempts(i int) {
+	m.delivery_attempts = &i
+	m.adddelivery_attempts = nil
+}
+
+// DeliveryAttempts returns the value of the "delivery_attempts" field in the mutation.
+func (m *ProfileChangeEventMutation) DeliveryAttempts() (r int, exists bool) {
+	v := m.delivery_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveryAttempts returns the old "delivery_attempts" field's value of the ProfileChangeEvent entity.
+// If the ProfileChangeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileChangeEventMutation) OldDeliveryAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveryAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveryAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveryAttempts: %w", err)
+	}
+	return oldValue.DeliveryAttempts, nil
+}
+
+// AddDeliveryAttempts adds i to the "delivery_attempts" field.
+func (m *ProfileChangeEventMutation) AddDeliveryAttempts(i int) {
+	if m.adddelivery_attempts != nil {
+		*m.adddelivery_attempts += i
+	} else {
+		m.adddelivery_attempts = &i
+	}
+}
+
+// AddedDeliveryAttempts returns the value that was added to the "delivery_attempts" field in this mutation.
+func (m *ProfileChangeEventMutation) AddedDeliveryAttempts() (r int, exists bool) {
+	v := m.adddelivery_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeliveryAttempts resets all changes to the "delivery_attempts" field.
+func (m *ProfileChangeEventMutation) ResetDeliveryAttempts() {
+	m.delivery_attempts = nil
+	m.adddelivery_attempts = nil
+}
+
+// SetNextDeliveryAt sets the "next_delivery_at" field.
+func (m *ProfileChangeEventMutation) SetNextDeliveryAt(t time.Time) {
+	m.next_delivery_at = &t
+}
+
+// NextDeliveryAt returns the value of the "next_delivery_at" field in the mutation.
+func (m *ProfileChangeEventMutation) NextDeliveryAt() (r time.Time, exists bool) {
+	v := m.next_delivery_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextDeliveryAt returns the old "next_delivery_at" field's value of the ProfileChangeEvent entity.
+// If the ProfileChangeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileChangeEventMutation) OldNextDeliveryAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextDeliveryAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextDeliveryAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextDeliveryAt: %w", err)
+	}
+	return oldValue.NextDeliveryAt, nil
+}
+
+// ClearNextDeliveryAt clears the value of the "next_delivery_at" field.
+func (m *ProfileChangeEventMutation) ClearNextDeliveryAt() {
+	m.next_delivery_at = nil
+	m.clearedFields[profilechangeevent.FieldNextDeliveryAt] = struct{}{}
+}
+
+// NextDeliveryAtCleared returns if the "next_delivery_at" field was cleared in this mutation.
+func (m *ProfileChangeEventMutation) NextDeliveryAtCleared() bool {
+	_, ok := m.clearedFields[profilechangeevent.FieldNextDeliveryAt]
+	return ok
+}
+
+// ResetNextDeliveryAt resets all changes to the "next_delivery_at" field.
+func (m *ProfileChangeEventMutation) ResetNextDeliveryAt() {
+	m.next_delivery_at = nil
+	delete(m.clearedFields, profilechangeevent.FieldNextDeliveryAt)
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *ProfileChangeEventMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *ProfileChangeEventMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredAt returns the old "delivered_at" field's value of the ProfileChangeEvent entity.
+// If the ProfileChangeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileChangeEventMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *ProfileChangeEventMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[profilechangeevent.FieldDeliveredAt] = struct{}{}
+}
+
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *ProfileChangeEventMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[profilechangeevent.FieldDeliveredAt]
+	return ok
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *ProfileChangeEventMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, profilechangeevent.FieldDeliveredAt)
+}
+
+// SetLastDeliveryError sets the "last_delivery_error" field.
+func (m *ProfileChangeEventMutation) SetLastDeliveryError(s string) {
+	m.last_delivery_error = &s
+}
+
+// LastDeliveryError returns the value of the "last_delivery_error" field in the mutation.
+func (m *ProfileChangeEventMutation) LastDeliveryError() (r string, exists bool) {
+	v := m.last_delivery_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastDeliveryError returns the old "last_delivery_error" field's value of the ProfileChangeEvent entity.
+// If the ProfileChangeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileChangeEventMutation) OldLastDeliveryError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastDeliveryError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastDeliveryError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastDeliveryError: %w", err)
+	}
+	return oldValue.LastDeliveryError, nil
+}
+
+// ClearLastDeliveryError clears the value of the "last_delivery_error" field.
+func (m *ProfileChangeEventMutation) ClearLastDeliveryError() {
+	m.last_delivery_error = nil
+	m.clearedFields[profilechangeevent.FieldLastDeliveryError] = struct{}{}
+}
+
+// LastDeliveryErrorCleared returns if the "last_delivery_error" field was cleared in this mutation.
+func (m *ProfileChangeEventMutation) LastDeliveryErrorCleared() bool {
+	_, ok := m.clearedFields[profilechangeevent.FieldLastDeliveryError]
+	return ok
+}
+
+// ResetLastDeliveryError resets all changes to the "last_delivery_error" field.
+func (m *ProfileChangeEventMutation) ResetLastDeliveryError() {
+	m.last_delivery_error = nil
+	delete(m.clearedFields, profilechangeevent.FieldLastDeliveryError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfileChangeEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfileChangeEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProfileChangeEvent entity.
+// If the ProfileChangeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileChangeEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfileChangeEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProfileChangeEventMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProfileChangeEventMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProfileChangeEvent entity.
+// If the ProfileChangeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileChangeEventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProfileChangeEventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by id.
+func (m *ProfileChangeEventMutation) SetProfileID(id ulid.ID) {
+	m.profile = &id
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *ProfileChangeEventMutation) ClearProfile() {
+	m.clearedprofile = true
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *ProfileChangeEventMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileID returns the "profile" edge ID in the mutation.
+func (m *ProfileChangeEventMutation) ProfileID() (id ulid.ID, exists bool) {
+	if m.profile != nil {
+		return *m.profile, true
+	}
+	return
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *ProfileChangeEventMutation) ProfileIDs() (ids []ulid.ID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *ProfileChangeEventMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// SetSnapshotID sets the "snapshot" edge to the ProfileSnapshot entity by id.
+func (m *ProfileChangeEventMutation) SetSnapshotID(id ulid.ID) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the ProfileSnapshot entity.
+func (m *ProfileChangeEventMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the ProfileSnapshot entity was cleared.
+func (m *ProfileChangeEventMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *ProfileChangeEventMutation) SnapshotID() (id ulid.ID, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *ProfileChangeEventMutation) SnapshotIDs() (ids []ulid.ID) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *ProfileChangeEventMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// Where appends a list predicates to the ProfileChangeEventMutation builder.
+func (m *ProfileChangeEventMutation) Where(ps ...predicate.ProfileChangeEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfileChangeEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfileChangeEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProfileChangeEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfileChangeEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfileChangeEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProfileChangeEvent).
+func (m *ProfileChangeEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfileChangeEventMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m._type != nil {
+		fields = append(fields, profilechangeevent.FieldType)
+	}
+	if m.old_value != nil {
+		fields = append(fields, profilechangeevent.FieldOldValue)
+	}
+	if m.new_value != nil {
+		fields = append(fields, profilechangeevent.FieldNewValue)
+	}
+	if m.detected_at != nil {
+		fields = append(fields, profilechangeevent.FieldDetectedAt)
+	}
+	if m.delivery_status != nil {
+		fields = append(fields, profilechangeevent.FieldDeliveryStatus)
+	}
+	if m.delivery_attempts != nil {
+		fields = append(fields, profilechangeevent.FieldDeliveryAttempts)
+	}
+	if m.next_delivery_at != nil {
+		fields = append(fields, profilechangeevent.FieldNextDeliveryAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, profilechangeevent.FieldDeliveredAt)
+	}
+	if m.last_delivery_error != nil {
+		fields = append(fields, profilechangeevent.FieldLastDeliveryError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, profilechangeevent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, profilechangeevent.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfileChangeEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profilechangeevent.FieldType:
+		return m.GetType()
+	case profilechangeevent.FieldOldValue:
+		return m.OldValue()
+	case profilechangeevent.FieldNewValue:
+		return m.NewValue()
+	case profilechangeevent.FieldDetectedAt:
+		return m.DetectedAt()
+	case profilechangeevent.FieldDeliveryStatus:
+		return m.DeliveryStatus()
+	case profilechangeevent.FieldDeliveryAttempts:
+		return m.DeliveryAttempts()
+	case profilechangeevent.FieldNextDeliveryAt:
+		return m.NextDeliveryAt()
+	case profilechangeevent.FieldDeliveredAt:
+		return m.DeliveredAt()
+	case profilechangeevent.FieldLastDeliveryError:
+		return m.LastDeliveryError()
+	case profilechangeevent.FieldCreatedAt:
+		return m.CreatedAt()
+	case profilechangeevent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfileChangeEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profilechangeevent.FieldType:
+		return m.OldType(ctx)
+	case profilechangeevent.FieldOldValue:
+		return m.OldOldValue(ctx)
+	case profilechangeevent.FieldNewValue:
+		return m.OldNewValue(ctx)
+	case profilechangeevent.FieldDetectedAt:
+		return m.OldDetectedAt(ctx)
+	case profilechangeevent.FieldDeliveryStatus:
+		return m.OldDeliveryStatus(ctx)
+	case profilechangeevent.FieldDeliveryAttempts:
+		return m.OldDeliveryAttempts(ctx)
+	case profilechangeevent.FieldNextDeliveryAt:
+		return m.OldNextDeliveryAt(ctx)
+	case profilechangeevent.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	case profilechangeevent.FieldLastDeliveryError:
+		return m.OldLastDeliveryError(ctx)
+	case profilechangeevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profilechangeevent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProfileChangeEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileChangeEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profilechangeevent.FieldType:
+		v, ok := value.(profilechangeevent.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case profilechangeevent.FieldOldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldValue(v)
+		return nil
+	case profilechangeevent.FieldNewValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewValue(v)
+		return nil
+	case profilechangeevent.FieldDetectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetectedAt(v)
+		return nil
+	case profilechangeevent.FieldDeliveryStatus:
+		v, ok := value.(profilechangeevent.DeliveryStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveryStatus(v)
+		return nil
+	case profilechangeevent.FieldDeliveryAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveryAttempts(v)
+		return nil
+	case profilechangeevent.FieldNextDeliveryAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextDeliveryAt(v)
+		return nil
+	case profilechangeevent.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	case profilechangeevent.FieldLastDeliveryError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastDeliveryError(v)
+		return nil
+	case profilechangeevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profilechangeevent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileChangeEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfileChangeEventMutation) AddedFields() []string {
+	var fields []string
+	if m.adddelivery_attempts != nil {
+		fields = append(fields, profilechangeevent.FieldDeliveryAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfileChangeEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case profilechangeevent.FieldDeliveryAttempts:
+		return m.AddedDeliveryAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileChangeEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case profilechangeevent.FieldDeliveryAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeliveryAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileChangeEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProfileChangeEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(profilechangeevent.FieldOldValue) {
+		fields = append(fields, profilechangeevent.FieldOldValue)
+	}
+	if m.FieldCleared(profilechangeevent.FieldNewValue) {
+		fields = append(fields, profilechangeevent.FieldNewValue)
+	}
+	if m.FieldCleared(profilechangeevent.FieldNextDeliveryAt) {
+		fields = append(fields, profilechangeevent.FieldNextDeliveryAt)
+	}
+	if m.FieldCleared(profilechangeevent.FieldDeliveredAt) {
+		fields = append(fields, profilechangeevent.FieldDeliveredAt)
+	}
+	if m.FieldCleared(profilechangeevent.FieldLastDeliveryError) {
+		fields = append(fields, profilechangeevent.FieldLastDeliveryError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProfileChangeEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProfileChangeEventMutation) ClearField(name string) error {
+	switch name {
+	case profilechangeevent.FieldOldValue:
+		m.ClearOldValue()
+		return nil
+	case profilechangeevent.FieldNewValue:
+		m.ClearNewValue()
+		return nil
+	case profilechangeevent.FieldNextDeliveryAt:
+		m.ClearNextDeliveryAt()
+		return nil
+	case profilechangeevent.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	case profilechangeevent.FieldLastDeliveryError:
+		m.ClearLastDeliveryError()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileChangeEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProfileChangeEventMutation) ResetField(name string) error {
+	switch name {
+	case profilechangeevent.FieldType:
+		m.ResetType()
+		return nil
+	case profilechangeevent.FieldOldValue:
+		m.ResetOldValue()
+		return nil
+	case profilechangeevent.FieldNewValue:
+		m.ResetNewValue()
+		return nil
+	case profilechangeevent.FieldDetectedAt:
+		m.ResetDetectedAt()
+		return nil
+	case profilechangeevent.FieldDeliveryStatus:
+		m.ResetDeliveryStatus()
+		return nil
+	case profilechangeevent.FieldDeliveryAttempts:
+		m.ResetDeliveryAttempts()
+		return nil
+	case profilechangeevent.FieldNextDeliveryAt:
+		m.ResetNextDeliveryAt()
+		return nil
+	case profilechangeevent.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	case profilechangeevent.FieldLastDeliveryError:
+		m.ResetLastDeliveryError()
+		return nil
+	case profilechangeevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case profilechangeevent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileChangeEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProfileChangeEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.profile != nil {
+		edges = append(edges, profilechangeevent.EdgeProfile)
+	}
+	if m.snapshot != nil {
+		edges = append(edges, profilechangeevent.EdgeSnapshot)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProfileChangeEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case profilechangeevent.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	case profilechangeevent.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProfileChangeEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProfileChangeEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProfileChangeEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedprofile {
+		edges = append(edges, profilechangeevent.EdgeProfile)
+	}
+	if m.clearedsnapshot {
+		edges = append(edges, profilechangeevent.EdgeSnapshot)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProfileChangeEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case profilechangeevent.EdgeProfile:
+		return m.clearedprofile
+	case profilechangeevent.EdgeSnapshot:
+		return m.clearedsnapshot
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProfileChangeEventMutation) ClearEdge(name string) error {
+	switch name {
+	case profilechangeevent.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	case profilechangeevent.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileChangeEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProfileChangeEventMutation) ResetEdge(name string) error {
+	switch name {
+	case profilechangeevent.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	case profilechangeevent.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileChangeEvent edge %s", name)
+}
+
+// ProfileEducationMutation represents an operation that mutates the ProfileEducation nodes in the graph.
+type ProfileEducationMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *ulid.ID
+	education_index    *int
+	addeducation_index *int
+	school_name        *string
+	school_id          *string
+	degree             *string
+	field_of_study     *string
+	start_date         *time.Time
+	end_date           *time.Time
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	profile            *ulid.ID
+	clearedprofile     bool
+	done               bool
+	oldValue           func(context.Context) (*ProfileEducation, error)
+	predicates         []predicate.ProfileEducation
+}
+
+var _ ent.Mutation = (*ProfileEducationMutation)(nil)
+
+// profileeducationOption allows management of the mutation configuration using functional options.
+type profileeducationOption func(*ProfileEducationMutation)
+
+// newProfileEducationMutation creates new mutation for the ProfileEducation entity.
+func newProfileEducationMutation(c config, op Op, opts ...profileeducationOption) *ProfileEducationMutation {
+	m := &ProfileEducationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfileEducation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProfileEducationID sets the ID field of the mutation.
+func withProfileEducationID(id ulid.ID) profileeducationOption {
+	return func(m *ProfileEducationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProfileEducation
+		)
+		m.oldValue = func(ctx context.Context) (*ProfileEducation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProfileEducation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProfileEducation sets the old ProfileEducation of the mutation.
+func withProfileEducation(node *ProfileEducation) profileeducationOption {
+	return func(m *ProfileEducationMutation) {
+		m.oldValue = func(context.Context) (*ProfileEducation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfileEducationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfileEducationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProfileEducation entities.
+func (m *ProfileEducationMutation) SetID(id ulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfileEducationMutation) ID() (id ulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfileEducationMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []ulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProfileEducation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEducationIndex sets the "education_index" field.
+func (m *ProfileEducationMutation) SetEducationIndex(i int) {
+	m.education_index = &i
+	m.addeducation_index = nil
+}
+
+// EducationIndex returns the value of the "education_index" field in the mutation.
+func (m *ProfileEducationMutation) EducationIndex() (r int, exists bool) {
+	v := m.education_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEducationIndex returns the old "education_index" field's value of the ProfileEducation entity.
+// If the ProfileEducation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEducationMutation) OldEducationIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEducationIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEducationIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEducationIndex: %w", err)
+	}
+	return oldValue.EducationIndex, nil
+}
+
+// AddEducationIndex adds i to the "education_index" field.
+func (m *ProfileEducationMutation) AddEducationIndex(i int) {
+	if m.addeducation_index != nil {
+		*m.addeducation_index += i
+	} else {
+		m.addeducation_index = &i
+	}
+}
+
+// AddedEducationIndex returns the value that was added to the "education_index" field in this mutation.
+func (m *ProfileEducationMutation) AddedEducationIndex() (r int, exists bool) {
+	v := m.addeducation_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEducationIndex resets all changes to the "education_index" field.
+func (m *ProfileEducationMutation) ResetEducationIndex() {
+	m.education_index = nil
+	m.addeducation_index = nil
+}
+
+// SetSchoolName sets the "school_name" field.
+func (m *ProfileEducationMutation) SetSchoolName(s string) {
+	m.school_name = &s
+}
+
+// SchoolName returns the value of the "school_name" field in the mutation.
+func (m *ProfileEducationMutation) SchoolName() (r string, exists bool) {
+	v := m.school_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchoolName returns the old "school_name" field's value of the ProfileEducation entity.
+// If the ProfileEducation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEducationMutation) OldSchoolName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchoolName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchoolName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchoolName: %w", err)
+	}
+	return oldValue.SchoolName, nil
+}
+
+// ResetSchoolName resets all changes to the "school_name" field.
+func (m *ProfileEducationMutation) ResetSchoolName() {
+	m.school_name = nil
+}
+
+// SetSchoolID sets the "school_id" field.
+func (m *ProfileEducationMutation) SetSchoolID(s string) {
+	m.school_id = &s
+}
+
+// SchoolID returns the value of the "school_id" field in the mutation.
+func (m *ProfileEducationMutation) SchoolID() (r string, exists bool) {
+	v := m.school_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchoolID returns the old "school_id" field's value of the ProfileEducation entity.
+// If the ProfileEducation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEducationMutation) OldSchoolID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchoolID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchoolID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchoolID: %w", err)
+	}
+	return oldValue.SchoolID, nil
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (m *ProfileEducationMutation) ClearSchoolID() {
+	m.school_id = nil
+	m.clearedFields[profileeducation.FieldSchoolID] = struct{}{}
+}
+
+// SchoolIDCleared returns if the "school_id" field was cleared in this mutation.
+func (m *ProfileEducationMutation) SchoolIDCleared() bool {
+	_, ok := m.clearedFields[profileeducation.FieldSchoolID]
+	return ok
+}
+
+// ResetSchoolID resets all changes to the "school_id" field.
+func (m *ProfileEducationMutation) ResetSchoolID() {
+	m.school_id = nil
+	delete(m.clearedFields, profileeducation.FieldSchoolID)
+}
+
+// SetDegree sets the "degree" field.
+func (m *ProfileEducationMutation) SetDegree(s string) {
+	m.degree = &s
+}
+
+// Degree returns the value of the "degree" field in the mutation.
+func (m *ProfileEducationMutation) Degree() (r string, exists bool) {
+	v := m.degree
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDegree returns the old "degree" field's value of the ProfileEducation entity.
+// If the ProfileEducation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEducationMutation) OldDegree(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDegree is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDegree requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDegree: %w", err)
+	}
+	return oldValue.Degree, nil
+}
+
+// ClearDegree clears the value of the "degree" field.
+func (m *ProfileEducationMutation) ClearDegree() {
+	m.degree = nil
+	m.clearedFields[profileeducation.FieldDegree] = struct{}{}
+}
+
+// DegreeCleared returns if the "degree" field was cleared in this mutation.
+func (m *ProfileEducationMutation) DegreeCleared() bool {
+	_, ok := m.clearedFields[profileeducation.FieldDegree]
+	return ok
+}
+
+// ResetDegree resets all changes to the "degree" field.
+func (m *ProfileEducationMutation) ResetDegree() {
+	m.degree = nil
+	delete(m.clearedFields, profileeducation.FieldDegree)
+}
+
+// SetFieldOfStudy sets the "field_of_study" field.
+func (m *ProfileEducationMutation) SetFieldOfStudy(s string) {
+	m.field_of_study = &s
+}
+
+// FieldOfStudy returns the value of the "field_of_study" field in the mutation.
+func (m *ProfileEducationMutation) FieldOfStudy() (r string, exists bool) {
+	v := m.field_of_study
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFieldOfStudy returns the old "field_of_study" field's value of the ProfileEducation entity.
+// If the ProfileEducation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEducationMutation) OldFieldOfStudy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFieldOfStudy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFieldOfStudy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFieldOfStudy: %w", err)
+	}
+	return oldValue.FieldOfStudy, nil
+}
+
+// ClearFieldOfStudy clears the value of the "field_of_study" field.
+func (m *ProfileEducationMutation) ClearFieldOfStudy() {
+	m.field_of_study = nil
+	m.clearedFields[profileeducation.FieldFieldOfStudy] = struct{}{}
+}
+
+// FieldOfStudyCleared returns if the "field_of_study" field was cleared in this mutation.
+func (m *ProfileEducationMutation) FieldOfStudyCleared() bool {
+	_, ok := m.clearedFields[profileeducation.FieldFieldOfStudy]
+	return ok
+}
+
+// ResetFieldOfStudy resets all changes to the "field_of_study" field.
+func (m *ProfileEducationMutation) ResetFieldOfStudy() {
+	m.field_of_study = nil
+	delete(m.clearedFields, profileeducation.FieldFieldOfStudy)
+}
+
+// SetStartDate sets the "start_date" field.
+func (m *ProfileEducationMutation) SetStartDate(t time.Time) {
+	m.start_date = &t
+}
+
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *ProfileEducationMutation) StartDate() (r time.Time, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartDate returns the old "start_date" field's value of the ProfileEducation entity.
+// If the ProfileEducation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEducationMutation) OldStartDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (m *ProfileEducationMutation) ClearStartDate() {
+	m.start_date = nil
+	m.clearedFields[profileeducation.FieldStartDate] = struct{}{}
+}
+
+// StartDateCleared returns if the "start_date" field was cleared in this mutation.
+func (m *ProfileEducationMutation) StartDateCleared() bool {
+	_, ok := m.clearedFields[profileeducation.FieldStartDate]
+	return ok
+}
+
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *ProfileEducationMutation) ResetStartDate() {
+	m.start_date = nil
+	delete(m.clearedFields, profileeducation.FieldStartDate)
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *ProfileEducationMutation) SetEndDate(t time.Time) {
+	m.end_date = &t
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *ProfileEducationMutation) EndDate() (r time.Time, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the ProfileEducation entity.
+// If the ProfileEducation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEducationMutation) OldEndDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	}
+	return oldValue.EndDate, nil
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (m *ProfileEducationMutation) ClearEndDate() {
+	m.end_date = nil
+	m.clearedFields[profileeducation.FieldEndDate] = struct{}{}
+}
+
+// EndDateCleared returns if the "end_date" field was cleared in this mutation.
+func (m *ProfileEducationMutation) EndDateCleared() bool {
+	_, ok := m.clearedFields[profileeducation.FieldEndDate]
+	return ok
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *ProfileEducationMutation) ResetEndDate() {
+	m.end_date = nil
+	delete(m.clearedFields, profileeducation.FieldEndDate)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfileEducationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfileEducationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProfileEducation entity.
+// If the ProfileEducation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEducationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfileEducationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProfileEducationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProfileEducationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProfileEducation entity.
+// If the ProfileEducation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEducationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProfileEducationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by id.
+func (m *ProfileEducationMutation) SetProfileID(id ulid.ID) {
+	m.profile = &id
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *ProfileEducationMutation) ClearProfile() {
+	m.clearedprofile = true
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *ProfileEducationMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileID returns the "profile" edge ID in the mutation.
+func (m *ProfileEducationMutation) ProfileID() (id ulid.ID, exists bool) {
+	if m.profile != nil {
+		return *m.profile, true
+	}
+	return
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *ProfileEducationMutation) ProfileIDs() (ids []ulid.ID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *ProfileEducationMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// Where appends a list predicates to the ProfileEducationMutation builder.
+func (m *ProfileEducationMutation) Where(ps ...predicate.ProfileEducation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfileEducationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfileEducationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProfileEducation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfileEducationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfileEducationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProfileEducation).
+func (m *ProfileEducationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfileEducationMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.education_index != nil {
+		fields = append(fields, profileeducation.FieldEducationIndex)
+	}
+	if m.school_name != nil {
+		fields = append(fields, profileeducation.FieldSchoolName)
+	}
+	if m.school_id != nil {
+		fields = append(fields, profileeducation.FieldSchoolID)
+	}
+	if m.degree != nil {
+		fields = append(fields, profileeducation.FieldDegree)
+	}
+	if m.field_of_study != nil {
+		fields = append(fields, profileeducation.FieldFieldOfStudy)
+	}
+	if m.start_date != nil {
+		fields = append(fields, profileeducation.FieldStartDate)
+	}
+	if m.end_date != nil {
+		fields = append(fields, profileeducation.FieldEndDate)
+	}
+	if m.created_at != nil {
+		fields = append(fields, profileeducation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, profileeducation.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfileEducationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profileeducation.FieldEducationIndex:
+		return m.EducationIndex()
+	case profileeducation.FieldSchoolName:
+		return m.SchoolName()
+	case profileeducation.FieldSchoolID:
+		return m.SchoolID()
+	case profileeducation.FieldDegree:
+		return m.Degree()
+	case profileeducation.FieldFieldOfStudy:
+		return m.FieldOfStudy()
+	case profileeducation.FieldStartDate:
+		return m.StartDate()
+	case profileeducation.FieldEndDate:
+		return m.EndDate()
+	case profileeducation.FieldCreatedAt:
+		return m.CreatedAt()
+	case profileeducation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfileEducationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profileeducation.FieldEducationIndex:
+		return m.OldEducationIndex(ctx)
+	case profileeducation.FieldSchoolName:
+		return m.OldSchoolName(ctx)
+	case profileeducation.FieldSchoolID:
+		return m.OldSchoolID(ctx)
+	case profileeducation.FieldDegree:
+		return m.OldDegree(ctx)
+	case profileeducation.FieldFieldOfStudy:
+		return m.OldFieldOfStudy(ctx)
+	case profileeducation.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case profileeducation.FieldEndDate:
+		return m.OldEndDate(ctx)
+	case profileeducation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profileeducation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProfileEducation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileEducationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profileeducation.FieldEducationIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEducationIndex(v)
+		return nil
+	case profileeducation.FieldSchoolName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchoolName(v)
+		return nil
+	case profileeducation.FieldSchoolID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchoolID(v)
+		return nil
+	case profileeducation.FieldDegree:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDegree(v)
+		return nil
+	case profileeducation.FieldFieldOfStudy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldOfStudy(v)
+		return nil
+	case profileeducation.FieldStartDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case profileeducation.FieldEndDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	case profileeducation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profileeducation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEducation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfileEducationMutation) AddedFields() []string {
+	var fields []string
+	if m.addeducation_index != nil {
+		fields = append(fields, profileeducation.FieldEducationIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfileEducationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case profileeducation.FieldEducationIndex:
+		return m.AddedEducationIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileEducationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case profileeducation.FieldEducationIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEducationIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEducation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProfileEducationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(profileeducation.FieldSchoolID) {
+		fields = append(fields, profileeducation.FieldSchoolID)
+	}
+	if m.FieldCleared(profileeducation.FieldDegree) {
+		fields = append(fields, profileeducation.FieldDegree)
+	}
+	if m.FieldCleared(profileeducation.FieldFieldOfStudy) {
+		fields = append(fields, profileeducation.FieldFieldOfStudy)
+	}
+	if m.FieldCleared(profileeducation.FieldStartDate) {
+		fields = append(fields, profileeducation.FieldStartDate)
+	}
+	if m.FieldCleared(profileeducation.FieldEndDate) {
+		fields = append(fields, profileeducation.FieldEndDate)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProfileEducationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProfileEducationMutation) ClearField(name string) error {
+	switch name {
+	case profileeducation.FieldSchoolID:
+		m.ClearSchoolID()
+		return nil
+	case profileeducation.FieldDegree:
+		m.ClearDegree()
+		return nil
+	case profileeducation.FieldFieldOfStudy:
+		m.ClearFieldOfStudy()
+		return nil
+	case profileeducation.FieldStartDate:
+		m.ClearStartDate()
+		return nil
+	case profileeducation.FieldEndDate:
+		m.ClearEndDate()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEducation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProfileEducationMutation) ResetField(name string) error {
+	switch name {
+	case profileeducation.FieldEducationIndex:
+		m.ResetEducationIndex()
+		return nil
+	case profileeducation.FieldSchoolName:
+		m.ResetSchoolName()
+		return nil
+	case profileeducation.FieldSchoolID:
+		m.ResetSchoolID()
+		return nil
+	case profileeducation.FieldDegree:
+		m.ResetDegree()
+		return nil
+	case profileeducation.FieldFieldOfStudy:
+		m.ResetFieldOfStudy()
+		return nil
+	case profileeducation.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case profileeducation.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	case profileeducation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case profileeducation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEducation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProfileEducationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.profile != nil {
+		edges = append(edges, profileeducation.EdgeProfile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProfileEducationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case profileeducation.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProfileEducationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProfileEducationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProfileEducationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprofile {
+		edges = append(edges, profileeducation.EdgeProfile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProfileEducationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case profileeducation.EdgeProfile:
+		return m.clearedprofile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProfileEducationMutation) ClearEdge(name string) error {
+	switch name {
+	case profileeducation.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEducation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProfileEducationMutation) ResetEdge(name string) error {
+	switch name {
+	case profileeducation.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEducation edge %s", name)
+}
+
+// ProfileEntryMutation represents an operation that mutates the ProfileEntry nodes in the graph.
+type ProfileEntryMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *ulid.ID
+	created_at            *time.Time
+	updated_at            *time.Time
+	linkedin_urn          *string
+	gender                *string
+	status                *profileentry.Status
+	profile_data          *map[string]interface{}
+	template_json_s3_key  *string
+	raw_response_s3_key   *string
+	fetch_count           *int
+	addfetch_count        *int
+	last_fetched_at       *time.Time
+	error_message         *string
+	attempt_count         *int
+	addattempt_count      *int
+	next_retry_at         *time.Time
+	lease_owner           *string
+	lease_expires_at      *time.Time
+	clearedFields         map[string]struct{}
+	profile               *ulid.ID
+	clearedprofile        bool
+	job_executions        map[ulid.ID]struct{}
+	removedjob_executions map[ulid.ID]struct{}
+	clearedjob_executions bool
+	done                  bool
+	oldValue              func(context.Context) (*ProfileEntry, error)
+	predicates            []predicate.ProfileEntry
+}
+
+var _ ent.Mutation = (*ProfileEntryMutation)(nil)
+
+// profileentryOption allows management of the mutation configuration using functional options.
+type profileentryOption func(*ProfileEntryMutation)
+
+// newProfileEntryMutation creates new mutation for the ProfileEntry entity.
+func newProfileEntryMutation(c config, op Op, opts ...profileentryOption) *ProfileEntryMutation {
+	m := &ProfileEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfileEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProfileEntryID sets the ID field of the mutation.
+func withProfileEntryID(id ulid.ID) profileentryOption {
+	return func(m *ProfileEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProfileEntry
+		)
+		m.oldValue = func(ctx context.Context) (*ProfileEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProfileEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProfileEntry sets the old ProfileEntry of the mutation.
+func withProfileEntry(node *ProfileEntry) profileentryOption {
+	return func(m *ProfileEntryMutation) {
+		m.oldValue = func(context.Context) (*ProfileEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfileEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfileEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProfileEntry entities.
+func (m *ProfileEntryMutation) SetID(id ulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfileEntryMutation) ID() (id ulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfileEntryMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []ulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProfileEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfileEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfileEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfileEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProfileEntryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProfileEntryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProfileEntryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetLinkedinUrn sets the "linkedin_urn" field.
+func (m *ProfileEntryMutation) SetLinkedinUrn(s string) {
+	m.linkedin_urn = &s
+}
+
+// LinkedinUrn returns the value of the "linkedin_urn" field in the mutation.
+func (m *ProfileEntryMutation) LinkedinUrn() (r string, exists bool) {
+	v := m.linkedin_urn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLinkedinUrn returns the old "linkedin_urn" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldLinkedinUrn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLinkedinUrn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLinkedinUrn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLinkedinUrn: %w", err)
+	}
+	return oldValue.LinkedinUrn, nil
+}
+
+// ResetLinkedinUrn resets all changes to the "linkedin_urn" field.
+func (m *ProfileEntryMutation) ResetLinkedinUrn() {
+	m.linkedin_urn = nil
+}
+
+// SetGender sets the "gender" field.
+func (m *ProfileEntryMutation) SetGender(s string) {
+	m.gender = &s
+}
+
+// Gender returns the value of the "gender" field in the mutation.
+func (m *ProfileEntryMutation) Gender() (r string, exists bool) {
+	v := m.gender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGender returns the old "gender" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldGender(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGender is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGender requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGender: %w", err)
+	}
+	return oldValue.Gender, nil
+}
+
+// ClearGender clears the value of the "gender" field.
+func (m *ProfileEntryMutation) ClearGender() {
+	m.gender = nil
+	m.clearedFields[profileentry.FieldGender] = struct{}{}
+}
+
+// GenderCleared returns if the "gender" field was cleared in this mutation.
+func (m *ProfileEntryMutation) GenderCleared() bool {
+	_, ok := m.clearedFields[profileentry.FieldGender]
+	return ok
+}
+
+// ResetGender resets all changes to the "gender" field.
+func (m *ProfileEntryMutation) ResetGender() {
+	m.gender = nil
+	delete(m.clearedFields, profileentry.FieldGender)
+}
+
+// SetStatus sets the "status" field.
+func (m *ProfileEntryMutation) SetStatus(pr profileentry.Status) {
+	m.status = &pr
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ProfileEntryMutation) Status() (r profileentry.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldStatus(ctx context.Context) (v profileentry.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ProfileEntryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetProfileData sets the "profile_data" field.
+func (m *ProfileEntryMutation) SetProfileData(value map[string]interface{}) {
+	m.profile_data = &value
+}
+
+// ProfileData returns the value of the "profile_data" field in the mutation.
+func (m *ProfileEntryMutation) ProfileData() (r map[string]interface{}, exists bool) {
+	v := m.profile_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileData returns the old "profile_data" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldProfileData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileData: %w", err)
+	}
+	return oldValue.ProfileData, nil
+}
+
+// ClearProfileData clears the value of the "profile_data" field.
+func (m *ProfileEntryMutation) ClearProfileData() {
+	m.profile_data = nil
+	m.clearedFields[profileentry.FieldProfileData] = struct{}{}
+}
+
+// ProfileDataCleared returns if the "profile_data" field was cleared in this mutation.
+func (m *ProfileEntryMutation) ProfileDataCleared() bool {
+	_, ok := m.clearedFields[profileentry.FieldProfileData]
+	return ok
+}
+
+// ResetProfileData resets all changes to the "profile_data" field.
+func (m *ProfileEntryMutation) ResetProfileData() {
+	m.profile_data = nil
+	delete(m.clearedFields, profileentry.FieldProfileData)
+}
+
+// SetTemplateJSONS3Key sets the "template_json_s3_key" field.
+func (m *ProfileEntryMutation) SetTemplateJSONS3Key(s string) {
+	m.template_json_s3_key = &s
+}
+
+// TemplateJSONS3Key returns the value of the "template_json_s3_key" field in the mutation.
+func (m *ProfileEntryMutation) TemplateJSONS3Key() (r string, exists bool) {
+	v := m.template_json_s3_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplateJSONS3Key returns the old "template_json_s3_key" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldTemplateJSONS3Key(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplateJSONS3Key is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplateJSONS3Key requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplateJSONS3Key: %w", err)
+	}
+	return oldValue.TemplateJSONS3Key, nil
+}
+
+// ClearTemplateJSONS3Key clears the value of the "template_json_s3_key" field.
+func (m *ProfileEntryMutation) ClearTemplateJSONS3Key() {
+	m.template_json_s3_key = nil
+	m.clearedFields[profileentry.FieldTemplateJSONS3Key] = struct{}{}
+}
+
+// TemplateJSONS3KeyCleared returns if the "template_json_s3_key" field was cleared in this mutation.
+func (m *ProfileEntryMutation) TemplateJSONS3KeyCleared() bool {
+	_, ok := m.clearedFields[profileentry.FieldTemplateJSONS3Key]
+	return ok
+}
+
+// ResetTemplateJSONS3Key resets all changes to the "template_json_s3_key" field.
+func (m *ProfileEntryMutation) ResetTemplateJSONS3Key() {
+	m.template_json_s3_key = nil
+	delete(m.clearedFields, profileentry.FieldTemplateJSONS3Key)
+}
+
+// SetRawResponseS3Key sets the "raw_response_s3_key" field.
+func (m *ProfileEntryMutation) SetRawResponseS3Key(s string) {
+	m.raw_response_s3_key = &s
+}
+
+// RawResponseS3Key returns the value of the "raw_response_s3_key" field in the mutation.
+func (m *ProfileEntryMutation) RawResponseS3Key() (r string, exists bool) {
+	v := m.raw_response_s3_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawResponseS3Key returns the old "raw_response_s3_key" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldRawResponseS3Key(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawResponseS3Key is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawResponseS3Key requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawResponseS3Key: %w", err)
+	}
+	return oldValue.RawResponseS3Key, nil
+}
+
+// ClearRawResponseS3Key clears the value of the "raw_response_s3_key" field.
+func (m *ProfileEntryMutation) ClearRawResponseS3Key() {
+	m.raw_response_s3_key = nil
+	m.clearedFields[profileentry.FieldRawResponseS3Key] = struct{}{}
+}
+
+// RawResponseS3KeyCleared returns if the "raw_response_s3_key" field was cleared in this mutation.
+func (m *ProfileEntryMutation) RawResponseS3KeyCleared() bool {
+	_, ok := m.clearedFields[profileentry.FieldRawResponseS3Key]
+	return ok
+}
+
+// ResetRawResponseS3Key resets all changes to the "raw_response_s3_key" field.
+func (m *ProfileEntryMutation) ResetRawResponseS3Key() {
+	m.raw_response_s3_key = nil
+	delete(m.clearedFields, profileentry.FieldRawResponseS3Key)
+}
+
+// SetFetchCount sets the "fetch_count" field.
+func (m *ProfileEntryMutation) SetFetchCount(i int) {
+	m.fetch_count = &i
+	m.addfetch_count = nil
+}
+
+// FetchCount returns the value of the "fetch_count" field in the mutation.
+func (m *ProfileEntryMutation) FetchCount() (r int, exists bool) {
+	v := m.fetch_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFetchCount returns the old "fetch_count" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldFetchCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFetchCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFetchCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFetchCount: %w", err)
+	}
+	return oldValue.FetchCount, nil
+}
+
+// AddFetchCount adds i to the "fetch_count" field.
+func (m *ProfileEntryMutation) AddFetchCount(i int) {
+	if m.addfetch_count != nil {
+		*m.addfetch_count += i
+	} else {
+		m.addfetch_count = &i
+	}
+}
+
+// AddedFetchCount returns the value that was added to the "fetch_count" field in this mutation.
+func (m *ProfileEntryMutation) AddedFetchCount() (r int, exists bool) {
+	v := m.addfetch_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFetchCount resets all changes to the "fetch_count" field.
+func (m *ProfileEntryMutation) ResetFetchCount() {
+	m.fetch_count = nil
+	m.addfetch_count = nil
+}
+
+// SetLastFetchedAt sets the "last_fetched_at" field.
+func (m *ProfileEntryMutation) SetLastFetchedAt(t time.Time) {
+	m.last_fetched_at = &t
+}
+
+// LastFetchedAt returns the value of the "last_fetched_at" field in the mutation.
+func (m *ProfileEntryMutation) LastFetchedAt() (r time.Time, exists bool) {
+	v := m.last_fetched_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastFetchedAt returns the old "last_fetched_at" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldLastFetchedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFetchedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFetchedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFetchedAt: %w", err)
+	}
+	return oldValue.LastFetchedAt, nil
+}
+
+// ClearLastFetchedAt clears the value of the "last_fetched_at" field.
+func (m *ProfileEntryMutation) ClearLastFetchedAt() {
+	m.last_fetched_at = nil
+	m.clearedFields[profileentry.FieldLastFetchedAt] = struct{}{}
+}
+
+// LastFetchedAtCleared returns if the "last_fetched_at" field was cleared in this mutation.
+func (m *ProfileEntryMutation) LastFetchedAtCleared() bool {
+	_, ok := m.clearedFields[profileentry.FieldLastFetchedAt]
+	return ok
+}
+
+// ResetLastFetchedAt resets all changes to the "last_fetched_at" field.
+func (m *ProfileEntryMutation) ResetLastFetchedAt() {
+	m.last_fetched_at = nil
+	delete(m.clearedFields, profileentry.FieldLastFetchedAt)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *ProfileEntryMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *ProfileEntryMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *ProfileEntryMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[profileentry.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *ProfileEntryMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[profileentry.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *ProfileEntryMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, profileentry.FieldErrorMessage)
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (m *ProfileEntryMutation) SetAttemptCount(i int) {
+	m.attempt_count = &i
+	m.addattempt_count = nil
+}
+
+// AttemptCount returns the value of the "attempt_count" field in the mutation.
+func (m *ProfileEntryMutation) AttemptCount() (r int, exists bool) {
+	v := m.attempt_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttemptCount returns the old "attempt_count" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldAttemptCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttemptCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttemptCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttemptCount: %w", err)
+	}
+	return oldValue.AttemptCount, nil
+}
+
+// AddAttemptCount adds i to the "attempt_count" field.
+func (m *ProfileEntryMutation) AddAttemptCount(i int) {
+	if m.addattempt_count != nil {
+		*m.addattempt_count += i
+	} else {
+		m.addattempt_count = &i
+	}
+}
+
+// AddedAttemptCount returns the value that was added to the "attempt_count" field in this mutation.
+func (m *ProfileEntryMutation) AddedAttemptCount() (r int, exists bool) {
+	v := m.addattempt_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttemptCount resets all changes to the "attempt_count" field.
+func (m *ProfileEntryMutation) ResetAttemptCount() {
+	m.attempt_count = nil
+	m.addattempt_count = nil
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (m *ProfileEntryMutation) SetNextRetryAt(t time.Time) {
+	m.next_retry_at = &t
+}
+
+// NextRetryAt returns the value of the "next_retry_at" field in the mutation.
+func (m *ProfileEntryMutation) NextRetryAt() (r time.Time, exists bool) {
+	v := m.next_retry_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRetryAt returns the old "next_retry_at" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldNextRetryAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRetryAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRetryAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRetryAt: %w", err)
+	}
+	return oldValue.NextRetryAt, nil
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (m *ProfileEntryMutation) ClearNextRetryAt() {
+	m.next_retry_at = nil
+	m.clearedFields[profileentry.FieldNextRetryAt] = struct{}{}
+}
+
+// NextRetryAtCleared returns if the "next_retry_at" field was cleared in this mutation.
+func (m *ProfileEntryMutation) NextRetryAtCleared() bool {
+	_, ok := m.clearedFields[profileentry.FieldNextRetryAt]
+	return ok
+}
+
+// ResetNextRetryAt resets all changes to the "next_retry_at" field.
+func (m *ProfileEntryMutation) ResetNextRetryAt() {
+	m.next_retry_at = nil
+	delete(m.clearedFields, profileentry.FieldNextRetryAt)
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (m *ProfileEntryMutation) SetLeaseOwner(s string) {
+	m.lease_owner = &s
+}
+
+// LeaseOwner returns the value of the "lease_owner" field in the mutation.
+func (m *ProfileEntryMutation) LeaseOwner() (r string, exists bool) {
+	v := m.lease_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseOwner returns the old "lease_owner" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldLeaseOwner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseOwner: %w", err)
+	}
+	return oldValue.LeaseOwner, nil
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (m *ProfileEntryMutation) ClearLeaseOwner() {
+	m.lease_owner = nil
+	m.clearedFields[profileentry.FieldLeaseOwner] = struct{}{}
+}
+
+// LeaseOwnerCleared returns if the "lease_owner" field was cleared in this mutation.
+func (m *ProfileEntryMutation) LeaseOwnerCleared() bool {
+	_, ok := m.clearedFields[profileentry.FieldLeaseOwner]
+	return ok
+}
+
+// ResetLeaseOwner resets all changes to the "lease_owner" field.
+func (m *ProfileEntryMutation) ResetLeaseOwner() {
+	m.lease_owner = nil
+	delete(m.clearedFields, profileentry.FieldLeaseOwner)
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (m *ProfileEntryMutation) SetLeaseExpiresAt(t time.Time) {
+	m.lease_expires_at = &t
+}
+
+// LeaseExpiresAt returns the value of the "lease_expires_at" field in the mutation.
+func (m *ProfileEntryMutation) LeaseExpiresAt() (r time.Time, exists bool) {
+	v := m.lease_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseExpiresAt returns the old "lease_expires_at" field's value of the ProfileEntry entity.
+// If the ProfileEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileEntryMutation) OldLeaseExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseExpiresAt: %w", err)
+	}
+	return oldValue.LeaseExpiresAt, nil
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (m *ProfileEntryMutation) ClearLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	m.clearedFields[profileentry.FieldLeaseExpiresAt] = struct{}{}
+}
+
+// LeaseExpiresAtCleared returns if the "lease_expires_at" field was cleared in this mutation.
+func (m *ProfileEntryMutation) LeaseExpiresAtCleared() bool {
+	_, ok := m.clearedFields[profileentry.FieldLeaseExpiresAt]
+	return ok
+}
+
+// ResetLeaseExpiresAt resets all changes to the "lease_expires_at" field.
+func (m *ProfileEntryMutation) ResetLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	delete(m.clearedFields, profileentry.FieldLeaseExpiresAt)
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by id.
+func (m *ProfileEntryMutation) SetProfileID(id ulid.ID) {
+	m.profile = &id
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *ProfileEntryMutation) ClearProfile() {
+	m.clearedprofile = true
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *ProfileEntryMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileID returns the "profile" edge ID in the mutation.
+func (m *ProfileEntryMutation) ProfileID() (id ulid.ID, exists bool) {
+	if m.profile != nil {
+		return *m.profile, true
+	}
+	return
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *ProfileEntryMutation) ProfileIDs() (ids []ulid.ID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *ProfileEntryMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// AddJobExecutionIDs adds the "job_executions" edge to the JobExecutionHistory entity by ids.
+func (m *ProfileEntryMutation) AddJobExecutionIDs(ids ...ulid.ID) {
+	if m.job_executions == nil {
+		m.job_executions = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.job_executions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobExecutions clears the "job_executions" edge to the JobExecutionHistory entity.
+func (m *ProfileEntryMutation) ClearJobExecutions() {
+	m.clearedjob_executions = true
+}
+
+// JobExecutionsCleared reports if the "job_executions" edge to the JobExecutionHistory entity was cleared.
+func (m *ProfileEntryMutation) JobExecutionsCleared() bool {
+	return m.clearedjob_executions
+}
+
+// RemoveJobExecutionIDs removes the "job_executions" edge to the JobExecutionHistory entity by IDs.
+func (m *ProfileEntryMutation) RemoveJobExecutionIDs(ids ...ulid.ID) {
+	if m.removedjob_executions == nil {
+		m.removedjob_executions = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.job_executions, ids[i])
+		m.removedjob_executions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobExecutions returns the removed IDs of the "job_executions" edge to the JobExecutionHistory entity.
+func (m *ProfileEntryMutation) RemovedJobExecutionsIDs() (ids []ulid.ID) {
+	for id := range m.removedjob_executions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobExecutionsIDs returns the "job_executions" edge IDs in the mutation.
+func (m *ProfileEntryMutation) JobExecutionsIDs() (ids []ulid.ID) {
+	for id := range m.job_executions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobExecutions resets all changes to the "job_executions" edge.
+func (m *ProfileEntryMutation) ResetJobExecutions() {
+	m.job_executions = nil
+	m.clearedjob_executions = false
+	m.removedjob_executions = nil
+}
+
+// Where appends a list predicates to the ProfileEntryMutation builder.
+func (m *ProfileEntryMutation) Where(ps ...predicate.ProfileEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfileEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfileEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProfileEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfileEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfileEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProfileEntry).
+func (m *ProfileEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfileEntryMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.created_at != nil {
+		fields = append(fields, profileentry.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, profileentry.FieldUpdatedAt)
+	}
+	if m.linkedin_urn != nil {
+		fields = append(fields, profileentry.FieldLinkedinUrn)
+	}
+	if m.gender != nil {
+		fields = append(fields, profileentry.FieldGender)
+	}
+	if m.status != nil {
+		fields = append(fields, profileentry.FieldStatus)
+	}
+	if m.profile_data != nil {
+		fields = append(fields, profileentry.FieldProfileData)
+	}
+	if m.template_json_s3_key != nil {
+		fields = append(fields, profileentry.FieldTemplateJSONS3Key)
+	}
+	if m.raw_response_s3_key != nil {
+		fields = append(fields, profileentry.FieldRawResponseS3Key)
+	}
+	if m.fetch_count != nil {
+		fields = append(fields, profileentry.FieldFetchCount)
+	}
+	if m.last_fetched_at != nil {
+		fields = append(fields, profileentry.FieldLastFetchedAt)
+	}
+	if m.error_message != nil {
+		fields = append(fields, profileentry.FieldErrorMessage)
+	}
+	if m.attempt_count != nil {
+		fields = append(fields, profileentry.FieldAttemptCount)
+	}
+	if m.next_retry_at != nil {
+		fields = append(fields, profileentry.FieldNextRetryAt)
+	}
+	if m.lease_owner != nil {
+		fields = append(fields, profileentry.FieldLeaseOwner)
+	}
+	if m.lease_expires_at != nil {
+		fields = append(fields, profileentry.FieldLeaseExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfileEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profileentry.FieldCreatedAt:
+		return m.CreatedAt()
+	case profileentry.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case profileentry.FieldLinkedinUrn:
+		return m.LinkedinUrn()
+	case profileentry.FieldGender:
+		return m.Gender()
+	case profileentry.FieldStatus:
+		return m.Status()
+	case profileentry.FieldProfileData:
+		return m.ProfileData()
+	case profileentry.FieldTemplateJSONS3Key:
+		return m.TemplateJSONS3Key()
+	case profileentry.FieldRawResponseS3Key:
+		return m.RawResponseS3Key()
+	case profileentry.FieldFetchCount:
+		return m.FetchCount()
+	case profileentry.FieldLastFetchedAt:
+		return m.LastFetchedAt()
+	case profileentry.FieldErrorMessage:
+		return m.ErrorMessage()
+	case profileentry.FieldAttemptCount:
+		return m.AttemptCount()
+	case profileentry.FieldNextRetryAt:
+		return m.NextRetryAt()
+	case profileentry.FieldLeaseOwner:
+		return m.LeaseOwner()
+	case profileentry.FieldLeaseExpiresAt:
+		return m.LeaseExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfileEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profileentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profileentry.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case profileentry.FieldLinkedinUrn:
+		return m.OldLinkedinUrn(ctx)
+	case profileentry.FieldGender:
+		return m.OldGender(ctx)
+	case profileentry.FieldStatus:
+		return m.OldStatus(ctx)
+	case profileentry.FieldProfileData:
+		return m.OldProfileData(ctx)
+	case profileentry.FieldTemplateJSONS3Key:
+		return m.OldTemplateJSONS3Key(ctx)
+	case profileentry.FieldRawResponseS3Key:
+		return m.OldRawResponseS3Key(ctx)
+	case profileentry.FieldFetchCount:
+		return m.OldFetchCount(ctx)
+	case profileentry.FieldLastFetchedAt:
+		return m.OldLastFetchedAt(ctx)
+	case profileentry.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case profileentry.FieldAttemptCount:
+		return m.OldAttemptCount(ctx)
+	case profileentry.FieldNextRetryAt:
+		return m.OldNextRetryAt(ctx)
+	case profileentry.FieldLeaseOwner:
+		return m.OldLeaseOwner(ctx)
+	case profileentry.FieldLeaseExpiresAt:
+		return m.OldLeaseExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProfileEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profileentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profileentry.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case profileentry.FieldLinkedinUrn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkedinUrn(v)
+		return nil
+	case profileentry.FieldGender:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGender(v)
+		return nil
+	case profileentry.FieldStatus:
+		v, ok := value.(profileentry.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case profileentry.FieldProfileData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileData(v)
+		return nil
+	case profileentry.FieldTemplateJSONS3Key:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplateJSONS3Key(v)
+		return nil
+	case profileentry.FieldRawResponseS3Key:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawResponseS3Key(v)
+		return nil
+	case profileentry.FieldFetchCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFetchCount(v)
+		return nil
+	case profileentry.FieldLastFetchedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastFetchedAt(v)
+		return nil
+	case profileentry.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case profileentry.FieldAttemptCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttemptCount(v)
+		return nil
+	case profileentry.FieldNextRetryAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRetryAt(v)
+		return nil
+	case profileentry.FieldLeaseOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseOwner(v)
+		return nil
+	case profileentry.FieldLeaseExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfileEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addfetch_count != nil {
+		fields = append(fields, profileentry.FieldFetchCount)
+	}
+	if m.addattempt_count != nil {
+		fields = append(fields, profileentry.FieldAttemptCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfileEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case profileentry.FieldFetchCount:
+		return m.AddedFetchCount()
+	case profileentry.FieldAttemptCount:
+		return m.AddedAttemptCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case profileentry.FieldFetchCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFetchCount(v)
+		return nil
+	case profileentry.FieldAttemptCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttemptCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProfileEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(profileentry.FieldGender) {
+		fields = append(fields, profileentry.FieldGender)
+	}
+	if m.FieldCleared(profileentry.FieldProfileData) {
+		fields = append(fields, profileentry.FieldProfileData)
+	}
+	if m.FieldCleared(profileentry.FieldTemplateJSONS3Key) {
+		fields = append(fields, profileentry.FieldTemplateJSONS3Key)
+	}
+	if m.FieldCleared(profileentry.FieldRawResponseS3Key) {
+		fields = append(fields, profileentry.FieldRawResponseS3Key)
+	}
+	if m.FieldCleared(profileentry.FieldLastFetchedAt) {
+		fields = append(fields, profileentry.FieldLastFetchedAt)
+	}
+	if m.FieldCleared(profileentry.FieldErrorMessage) {
+		fields = append(fields, profileentry.FieldErrorMessage)
+	}
+	if m.FieldCleared(profileentry.FieldNextRetryAt) {
+		fields = append(fields, profileentry.FieldNextRetryAt)
+	}
+	if m.FieldCleared(profileentry.FieldLeaseOwner) {
+		fields = append(fields, profileentry.FieldLeaseOwner)
+	}
+	if m.FieldCleared(profileentry.FieldLeaseExpiresAt) {
+		fields = append(fields, profileentry.FieldLeaseExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProfileEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProfileEntryMutation) ClearField(name string) error {
+	switch name {
+	case profileentry.FieldGender:
+		m.ClearGender()
+		return nil
+	case profileentry.FieldProfileData:
+		m.ClearProfileData()
+		return nil
+	case profileentry.FieldTemplateJSONS3Key:
+		m.ClearTemplateJSONS3Key()
+		return nil
+	case profileentry.FieldRawResponseS3Key:
+		m.ClearRawResponseS3Key()
+		return nil
+	case profileentry.FieldLastFetchedAt:
+		m.ClearLastFetchedAt()
+		return nil
+	case profileentry.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case profileentry.FieldNextRetryAt:
+		m.ClearNextRetryAt()
+		return nil
+	case profileentry.FieldLeaseOwner:
+		m.ClearLeaseOwner()
+		return nil
+	case profileentry.FieldLeaseExpiresAt:
+		m.ClearLeaseExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProfileEntryMutation) ResetField(name string) error {
+	switch name {
+	case profileentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case profileentry.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case profileentry.FieldLinkedinUrn:
+		m.ResetLinkedinUrn()
+		return nil
+	case profileentry.FieldGender:
+		m.ResetGender()
+		return nil
+	case profileentry.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case profileentry.FieldProfileData:
+		m.ResetProfileData()
+		return nil
+	case profileentry.FieldTemplateJSONS3Key:
+		m.ResetTemplateJSONS3Key()
+		return nil
+	case profileentry.FieldRawResponseS3Key:
+		m.ResetRawResponseS3Key()
+		return nil
+	case profileentry.FieldFetchCount:
+		m.ResetFetchCount()
+		return nil
+	case profileentry.FieldLastFetchedAt:
+		m.ResetLastFetchedAt()
+		return nil
+	case profileentry.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case profileentry.FieldAttemptCount:
+		m.ResetAttemptCount()
+		return nil
+	case profileentry.FieldNextRetryAt:
+		m.ResetNextRetryAt()
+		return nil
+	case profileentry.FieldLeaseOwner:
+		m.ResetLeaseOwner()
+		return nil
+	case profileentry.FieldLeaseExpiresAt:
+		m.ResetLeaseExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProfileEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.profile != nil {
+		edges = append(edges, profileentry.EdgeProfile)
+	}
+	if m.job_executions != nil {
+		edges = append(edges, profileentry.EdgeJobExecutions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProfileEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case profileentry.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	case profileentry.EdgeJobExecutions:
+		ids := make([]ent.Value, 0, len(m.job_executions))
+		for id := range m.job_executions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProfileEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedjob_executions != nil {
+		edges = append(edges, profileentry.EdgeJobExecutions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProfileEntryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case profileentry.EdgeJobExecutions:
+		ids := make([]ent.Value, 0, len(m.removedjob_executions))
+		for id := range m.removedjob_executions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProfileEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedprofile {
+		edges = append(edges, profileentry.EdgeProfile)
+	}
+	if m.clearedjob_executions {
+		edges = append(edges, profileentry.EdgeJobExecutions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProfileEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case profileentry.EdgeProfile:
+		return m.clearedprofile
+	case profileentry.EdgeJobExecutions:
+		return m.clearedjob_executions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProfileEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case profileentry.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProfileEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case profileentry.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	case profileentry.EdgeJobExecutions:
+		m.ResetJobExecutions()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileEntry edge %s", name)
+}
+
+// ProfilePositionMutation represents an operation that mutates the ProfilePosition nodes in the graph.
+type ProfilePositionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *ulid.ID
+	position_index    *int
+	addposition_index *int
+	company_name      *string
+	company_urn       *string
+	company_username  *string
+	title             *string
+	location          *string
+	employment_type   *string
+	description       *string
+	start_date        *time.Time
+	end_date          *time.Time
+	is_current        *bool
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	profile           *ulid.ID
+	clearedprofile    bool
+	company           *ulid.ID
+	clearedcompany    bool
+	done              bool
+	oldValue          func(context.Context) (*ProfilePosition, error)
+	predicates        []predicate.ProfilePosition
+}
+
+var _ ent.Mutation = (*ProfilePositionMutation)(nil)
+
+// profilepositionOption allows management of the mutation configuration using functional options.
+type profilepositionOption func(*ProfilePositionMutation)
+
+// newProfilePositionMutation creates new mutation for the ProfilePosition entity.
+func newProfilePositionMutation(c config, op Op, opts ...profilepositionOption) *ProfilePositionMutation {
+	m := &ProfilePositionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfilePosition,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProfilePositionID sets the ID field of the mutation.
+func withProfilePositionID(id ulid.ID) profilepositionOption {
+	return func(m *ProfilePositionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProfilePosition
+		)
+		m.oldValue = func(ctx context.Context) (*ProfilePosition, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProfilePosition.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProfilePosition sets the old ProfilePosition of the mutation.
+func withProfilePosition(node *ProfilePosition) profilepositionOption {
+	return func(m *ProfilePositionMutation) {
+		m.oldValue = func(context.Context) (*ProfilePosition, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfilePositionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfilePositionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProfilePosition entities.
+func (m *ProfilePositionMutation) SetID(id ulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfilePositionMutation) ID() (id ulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfilePositionMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []ulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProfilePosition.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPositionIndex sets the "position_index" field.
+func (m *ProfilePositionMutation) SetPositionIndex(i int) {
+	m.position_index = &i
+	m.addposition_index = nil
+}
+
+// PositionIndex returns the value of the "position_index" field in the mutation.
+func (m *ProfilePositionMutation) PositionIndex() (r int, exists bool) {
+	v := m.position_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPositionIndex returns the old "position_index" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldPositionIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPositionIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPositionIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPositionIndex: %w", err)
+	}
+	return oldValue.PositionIndex, nil
+}
+
+// AddPositionIndex adds i to the "position_index" field.
+func (m *ProfilePositionMutation) AddPositionIndex(i int) {
+	if m.addposition_index != nil {
+		*m.addposition_index += i
+	} else {
+		m.addposition_index = &i
+	}
+}
+
+// AddedPositionIndex returns the value that was added to the "position_index" field in this mutation.
+func (m *ProfilePositionMutation) AddedPositionIndex() (r int, exists bool) {
+	v := m.addposition_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPositionIndex resets all changes to the "position_index" field.
+func (m *ProfilePositionMutation) ResetPositionIndex() {
+	m.position_index = nil
+	m.addposition_index = nil
+}
+
+// SetCompanyName sets the "company_name" field.
+func (m *ProfilePositionMutation) SetCompanyName(s string) {
+	m.company_name = &s
+}
+
+// CompanyName returns the value of the "company_name" field in the mutation.
+func (m *ProfilePositionMutation) CompanyName() (r string, exists bool) {
+	v := m.company_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompanyName returns the old "company_name" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldCompanyName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompanyName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompanyName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompanyName: %w", err)
+	}
+	return oldValue.CompanyName, nil
+}
+
+// ClearCompanyName clears the value of the "company_name" field.
+func (m *ProfilePositionMutation) ClearCompanyName() {
+	m.company_name = nil
+	m.clearedFields[profileposition.FieldCompanyName] = struct{}{}
+}
+
+// CompanyNameCleared returns if the "company_name" field was cleared in this mutation.
+func (m *ProfilePositionMutation) CompanyNameCleared() bool {
+	_, ok := m.clearedFields[profileposition.FieldCompanyName]
+	return ok
+}
+
+// ResetCompanyName resets all changes to the "company_name" field.
+func (m *ProfilePositionMutation) ResetCompanyName() {
+	m.company_name = nil
+	delete(m.clearedFields, profileposition.FieldCompanyName)
+}
+
+// SetCompanyUrn sets the "company_urn" field.
+func (m *ProfilePositionMutation) SetCompanyUrn(s string) {
+	m.company_urn = &s
+}
+
+// CompanyUrn returns the value of the "company_urn" field in the mutation.
+func (m *ProfilePositionMutation) CompanyUrn() (r string, exists bool) {
+	v := m.company_urn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompanyUrn returns the old "company_urn" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldCompanyUrn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompanyUrn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompanyUrn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompanyUrn: %w", err)
+	}
+	return oldValue.CompanyUrn, nil
+}
+
+// ClearCompanyUrn clears the value of the "company_urn" field.
+func (m *ProfilePositionMutation) ClearCompanyUrn() {
+	m.company_urn = nil
+	m.clearedFields[profileposition.FieldCompanyUrn] = struct{}{}
+}
+
+// CompanyUrnCleared returns if the "company_urn" field was cleared in this mutation.
+func (m *ProfilePositionMutation) CompanyUrnCleared() bool {
+	_, ok := m.clearedFields[profileposition.FieldCompanyUrn]
+	return ok
+}
+
+// ResetCompanyUrn resets all changes to the "company_urn" field.
+func (m *ProfilePositionMutation) ResetCompanyUrn() {
+	m.company_urn = nil
+	delete(m.clearedFields, profileposition.FieldCompanyUrn)
+}
+
+// SetCompanyUsername sets the "company_username" field.
+func (m *ProfilePositionMutation) SetCompanyUsername(s string) {
+	m.company_username = &s
+}
+
+// CompanyUsername returns the value of the "company_username" field in the mutation.
+func (m *ProfilePositionMutation) CompanyUsername() (r string, exists bool) {
+	v := m.company_username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompanyUsername returns the old "company_username" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldCompanyUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompanyUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompanyUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompanyUsername: %w", err)
+	}
+	return oldValue.CompanyUsername, nil
+}
+
+// ClearCompanyUsername clears the value of the "company_username" field.
+func (m *ProfilePositionMutation) ClearCompanyUsername() {
+	m.company_username = nil
+	m.clearedFields[profileposition.FieldCompanyUsername] = struct{}{}
+}
+
+// CompanyUsernameCleared returns if the "company_username" field was cleared in this mutation.
+func (m *ProfilePositionMutation) CompanyUsernameCleared() bool {
+	_, ok := m.clearedFields[profileposition.FieldCompanyUsername]
+	return ok
+}
+
+// ResetCompanyUsername resets all changes to the "company_username" field.
+func (m *ProfilePositionMutation) ResetCompanyUsername() {
+	m.company_username = nil
+	delete(m.clearedFields, profileposition.FieldCompanyUsername)
+}
+
+// SetTitle sets the "title" field.
+func (m *ProfilePositionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ProfilePositionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *ProfilePositionMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[profileposition.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *ProfilePositionMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[profileposition.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ProfilePositionMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, profileposition.FieldTitle)
+}
+
+// SetLocation sets the "location" field.
+func (m *ProfilePositionMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *ProfilePositionMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ClearLocation clears the value of the "location" field.
+func (m *ProfilePositionMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[profileposition.FieldLocation] = struct{}{}
+}
+
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *ProfilePositionMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[profileposition.FieldLocation]
+	return ok
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *ProfilePositionMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, profileposition.FieldLocation)
+}
+
+// SetEmploymentType sets the "employment_type" field.
+func (m *ProfilePositionMutation) SetEmploymentType(s string) {
+	m.employment_type = &s
+}
+
+// EmploymentType returns the value of the "employment_type" field in the mutation.
+func (m *ProfilePositionMutation) EmploymentType() (r string, exists bool) {
+	v := m.employment_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmploymentType returns the old "employment_type" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldEmploymentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmploymentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmploymentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmploymentType: %w", err)
+	}
+	return oldValue.EmploymentType, nil
+}
+
+// ClearEmploymentType clears the value of the "employment_type" field.
+func (m *ProfilePositionMutation) ClearEmploymentType() {
+	m.employment_type = nil
+	m.clearedFields[profileposition.FieldEmploymentType] = struct{}{}
+}
+
+// EmploymentTypeCleared returns if the "employment_type" field was cleared in this mutation.
+func (m *ProfilePositionMutation) EmploymentTypeCleared() bool {
+	_, ok := m.clearedFields[profileposition.FieldEmploymentType]
+	return ok
+}
+
+// ResetEmploymentType resets all changes to the "employment_type" field.
+func (m *ProfilePositionMutation) ResetEmploymentType() {
+	m.employment_type = nil
+	delete(m.clearedFields, profileposition.FieldEmploymentType)
+}
+
+// SetDescription sets the "description" field.
+func (m *ProfilePositionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProfilePositionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ProfilePositionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[profileposition.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProfilePositionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[profileposition.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProfilePositionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, profileposition.FieldDescription)
+}
+
+// SetStartDate sets the "start_date" field.
+func (m *ProfilePositionMutation) SetStartDate(t time.Time) {
+	m.start_date = &t
+}
+
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *ProfilePositionMutation) StartDate() (r time.Time, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartDate returns the old "start_date" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldStartDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (m *ProfilePositionMutation) ClearStartDate() {
+	m.start_date = nil
+	m.clearedFields[profileposition.FieldStartDate] = struct{}{}
+}
+
+// StartDateCleared returns if the "start_date" field was cleared in this mutation.
+func (m *ProfilePositionMutation) StartDateCleared() bool {
+	_, ok := m.clearedFields[profileposition.FieldStartDate]
+	return ok
+}
+
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *ProfilePositionMutation) ResetStartDate() {
+	m.start_date = nil
+	delete(m.clearedFields, profileposition.FieldStartDate)
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *ProfilePositionMutation) SetEndDate(t time.Time) {
+	m.end_date = &t
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *ProfilePositionMutation) EndDate() (r time.Time, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldEndDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	}
+	return oldValue.EndDate, nil
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (m *ProfilePositionMutation) ClearEndDate() {
+	m.end_date = nil
+	m.clearedFields[profileposition.FieldEndDate] = struct{}{}
+}
+
+// EndDateCleared returns if the "end_date" field was cleared in this mutation.
+func (m *ProfilePositionMutation) EndDateCleared() bool {
+	_, ok := m.clearedFields[profileposition.FieldEndDate]
+	return ok
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *ProfilePositionMutation) ResetEndDate() {
+	m.end_date = nil
+	delete(m.clearedFields, profileposition.FieldEndDate)
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (m *ProfilePositionMutation) SetIsCurrent(b bool) {
+	m.is_current = &b
+}
+
+// IsCurrent returns the value of the "is_current" field in the mutation.
+func (m *ProfilePositionMutation) IsCurrent() (r bool, exists bool) {
+	v := m.is_current
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsCurrent returns the old "is_current" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldIsCurrent(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsCurrent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsCurrent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsCurrent: %w", err)
+	}
+	return oldValue.IsCurrent, nil
+}
+
+// ResetIsCurrent resets all changes to the "is_current" field.
+func (m *ProfilePositionMutation) ResetIsCurrent() {
+	m.is_current = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfilePositionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfilePositionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfilePositionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProfilePositionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProfilePositionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProfilePosition entity.
+// If the ProfilePosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePositionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProfilePositionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by id.
+func (m *ProfilePositionMutation) SetProfileID(id ulid.ID) {
+	m.profile = &id
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *ProfilePositionMutation) ClearProfile() {
+	m.clearedprofile = true
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *ProfilePositionMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileID returns the "profile" edge ID in the mutation.
+func (m *ProfilePositionMutation) ProfileID() (id ulid.ID, exists bool) {
+	if m.profile != nil {
+		return *m.profile, true
+	}
+	return
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *ProfilePositionMutation) ProfileIDs() (ids []ulid.ID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *ProfilePositionMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// SetCompanyID sets the "company" edge to the Company entity by id.
+func (m *ProfilePositionMutation) SetCompanyID(id ulid.ID) {
+	m.company = &id
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (m *ProfilePositionMutation) ClearCompany() {
+	m.clearedcompany = true
+}
+
+// CompanyCleared reports if the "company" edge to the Company entity was cleared.
+func (m *ProfilePositionMutation) CompanyCleared() bool {
+	return m.clearedcompany
+}
+
+// CompanyID returns the "company" edge ID in the mutation.
+func (m *ProfilePositionMutation) CompanyID() (id ulid.ID, exists bool) {
+	if m.company != nil {
+		return *m.company, true
+	}
+	return
+}
+
+// CompanyIDs returns the "company" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CompanyID instead. It exists only for internal usage by the builders.
+func (m *ProfilePositionMutation) CompanyIDs() (ids []ulid.ID) {
+	if id := m.company; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCompany resets all changes to the "company" edge.
+func (m *ProfilePositionMutation) ResetCompany() {
+	m.company = nil
+	m.clearedcompany = false
+}
+
+// Where appends a list predicates to the ProfilePositionMutation builder.
+func (m *ProfilePositionMutation) Where(ps ...predicate.ProfilePosition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfilePositionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfilePositionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProfilePosition, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfilePositionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfilePositionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProfilePosition).
+func (m *ProfilePositionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfilePositionMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.position_index != nil {
+		fields = append(fields, profileposition.FieldPositionIndex)
+	}
+	if m.company_name != nil {
+		fields = append(fields, profileposition.FieldCompanyName)
+	}
+	if m.company_urn != nil {
+		fields = append(fields, profileposition.FieldCompanyUrn)
+	}
+	if m.company_username != nil {
+		fields = append(fields, profileposition.FieldCompanyUsername)
+	}
+	if m.title != nil {
+		fields = append(fields, profileposition.FieldTitle)
+	}
+	if m.location != nil {
+		fields = append(fields, profileposition.FieldLocation)
+	}
+	if m.employment_type != nil {
+		fields = append(fields, profileposition.FieldEmploymentType)
+	}
+	if m.description != nil {
+		fields = append(fields, profileposition.FieldDescription)
+	}
+	if m.start_date != nil {
+		fields = append(fields, profileposition.FieldStartDate)
+	}
+	if m.end_date != nil {
+		fields = append(fields, profileposition.FieldEndDate)
+	}
+	if m.is_current != nil {
+		fields = append(fields, profileposition.FieldIsCurrent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, profileposition.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, profileposition.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfilePositionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profileposition.FieldPositionIndex:
+		return m.PositionIndex()
+	case profileposition.FieldCompanyName:
+		return m.CompanyName()
+	case profileposition.FieldCompanyUrn:
+		return m.CompanyUrn()
+	case profileposition.FieldCompanyUsername:
+		return m.CompanyUsername()
+	case profileposition.FieldTitle:
+		return m.Title()
+	case profileposition.FieldLocation:
+		return m.Location()
+	case profileposition.FieldEmploymentType:
+		return m.EmploymentType()
+	case profileposition.FieldDescription:
+		return m.Description()
+	case profileposition.FieldStartDate:
+		return m.StartDate()
+	case profileposition.FieldEndDate:
+		return m.EndDate()
+	case profileposition.FieldIsCurrent:
+		return m.IsCurrent()
+	case profileposition.FieldCreatedAt:
+		return m.CreatedAt()
+	case profileposition.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfilePositionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profileposition.FieldPositionIndex:
+		return m.OldPositionIndex(ctx)
+	case profileposition.FieldCompanyName:
+		return m.OldCompanyName(ctx)
+	case profileposition.FieldCompanyUrn:
+		return m.OldCompanyUrn(ctx)
+	case profileposition.FieldCompanyUsername:
+		return m.OldCompanyUsername(ctx)
+	case profileposition.FieldTitle:
+		return m.OldTitle(ctx)
+	case profileposition.FieldLocation:
+		return m.OldLocation(ctx)
+	case profileposition.FieldEmploymentType:
+		return m.OldEmploymentType(ctx)
+	case profileposition.FieldDescription:
+		return m.OldDescription(ctx)
+	case profileposition.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case profileposition.FieldEndDate:
+		return m.OldEndDate(ctx)
+	case profileposition.FieldIsCurrent:
+		return m.OldIsCurrent(ctx)
+	case profileposition.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profileposition.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProfilePosition field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfilePositionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profileposition.FieldPositionIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPositionIndex(v)
+		return nil
+	case profileposition.FieldCompanyName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompanyName(v)
+		return nil
+	case profileposition.FieldCompanyUrn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompanyUrn(v)
+		return nil
+	case profileposition.FieldCompanyUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompanyUsername(v)
+		return nil
+	case profileposition.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case profileposition.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case profileposition.FieldEmploymentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmploymentType(v)
+		return nil
+	case profileposition.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case profileposition.FieldStartDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case profileposition.FieldEndDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	case profileposition.FieldIsCurrent:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsCurrent(v)
+		return nil
+	case profileposition.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profileposition.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfilePosition field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfilePositionMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition_index != nil {
+		fields = append(fields, profileposition.FieldPositionIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfilePositionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case profileposition.FieldPositionIndex:
+		return m.AddedPositionIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfilePositionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case profileposition.FieldPositionIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPositionIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfilePosition numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProfilePositionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(profileposition.FieldCompanyName) {
+		fields = append(fields, profileposition.FieldCompanyName)
+	}
+	if m.FieldCleared(profileposition.FieldCompanyUrn) {
+		fields = append(fields, profileposition.FieldCompanyUrn)
+	}
+	if m.FieldCleared(profileposition.FieldCompanyUsername) {
+		fields = append(fields, profileposition.FieldCompanyUsername)
+	}
+	if m.FieldCleared(profileposition.FieldTitle) {
+		fields = append(fields, profileposition.FieldTitle)
+	}
+	if m.FieldCleared(profileposition.FieldLocation) {
+		fields = append(fields, profileposition.FieldLocation)
+	}
+	if m.FieldCleared(profileposition.FieldEmploymentType) {
+		fields = append(fields, profileposition.FieldEmploymentType)
+	}
+	if m.FieldCleared(profileposition.FieldDescription) {
+		fields = append(fields, profileposition.FieldDescription)
+	}
+	if m.FieldCleared(profileposition.FieldStartDate) {
+		fields = append(fields, profileposition.FieldStartDate)
+	}
+	if m.FieldCleared(profileposition.FieldEndDate) {
+		fields = append(fields, profileposition.FieldEndDate)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProfilePositionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProfilePositionMutation) ClearField(name string) error {
+	switch name {
+	case profileposition.FieldCompanyName:
+		m.ClearCompanyName()
+		return nil
+	case profileposition.FieldCompanyUrn:
+		m.ClearCompanyUrn()
+		return nil
+	case profileposition.FieldCompanyUsername:
+		m.ClearCompanyUsername()
+		return nil
+	case profileposition.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case profileposition.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case profileposition.FieldEmploymentType:
+		m.ClearEmploymentType()
+		return nil
+	case profileposition.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case profileposition.FieldStartDate:
+		m.ClearStartDate()
+		return nil
+	case profileposition.FieldEndDate:
+		m.ClearEndDate()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfilePosition nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProfilePositionMutation) ResetField(name string) error {
+	switch name {
+	case profileposition.FieldPositionIndex:
+		m.ResetPositionIndex()
+		return nil
+	case profileposition.FieldCompanyName:
+		m.ResetCompanyName()
+		return nil
+	case profileposition.FieldCompanyUrn:
+		m.ResetCompanyUrn()
+		return nil
+	case profileposition.FieldCompanyUsername:
+		m.ResetCompanyUsername()
+		return nil
+	case profileposition.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case profileposition.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case profileposition.FieldEmploymentType:
+		m.ResetEmploymentType()
+		return nil
+	case profileposition.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case profileposition.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case profileposition.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	case profileposition.FieldIsCurrent:
+		m.ResetIsCurrent()
+		return nil
+	case profileposition.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case profileposition.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfilePosition field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProfilePositionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.profile != nil {
+		edges = append(edges, profileposition.EdgeProfile)
+	}
+	if m.company != nil {
+		edges = append(edges, profileposition.EdgeCompany)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProfilePositionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case profileposition.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	case profileposition.EdgeCompany:
+		if id := m.company; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProfilePositionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProfilePositionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProfilePositionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedprofile {
+		edges = append(edges, profileposition.EdgeProfile)
+	}
+	if m.clearedcompany {
+		edges = append(edges, profileposition.EdgeCompany)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProfilePositionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case profileposition.EdgeProfile:
+		return m.clearedprofile
+	case profileposition.EdgeCompany:
+		return m.clearedcompany
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProfilePositionMutation) ClearEdge(name string) error {
+	switch name {
+	case profileposition.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	case profileposition.EdgeCompany:
+		m.ClearCompany()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfilePosition unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProfilePositionMutation) ResetEdge(name string) error {
+	switch name {
+	case profileposition.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	case profileposition.EdgeCompany:
+		m.ResetCompany()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfilePosition edge %s", name)
+}
+
+// ProfilePostMutation represents an operation that mutates the ProfilePost nodes in the graph.
+type ProfilePostMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *ulid.ID
+	profile_username *string
+	fetch_status     *profilepost.FetchStatus
+	s3_key           *string
+	error_message    *string
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	items            map[ulid.ID]struct{}
+	removeditems     map[ulid.ID]struct{}
+	cleareditems     bool
+	done             bool
+	oldValue         func(context.Context) (*ProfilePost, error)
+	predicates       []predicate.ProfilePost
+}
+
+var _ ent.Mutation = (*ProfilePostMutation)(nil)
+
+// profilepostOption allows management of the mutation configuration using functional options.
+type profilepostOption func(*ProfilePostMutation)
+
+// newProfilePostMutation creates new mutation for the ProfilePost entity.
+func newProfilePostMutation(c config, op Op, opts ...profilepostOption) *ProfilePostMutation {
+	m := &ProfilePostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfilePost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProfilePostID sets the ID field of the mutation.
+func withProfilePostID(id ulid.ID) profilepostOption {
+	return func(m *ProfilePostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProfilePost
+		)
+		m.oldValue = func(ctx context.Context) (*ProfilePost, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProfilePost.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProfilePost sets the old ProfilePost of the mutation.
+func withProfilePost(node *ProfilePost) profilepostOption {
+	return func(m *ProfilePostMutation) {
+		m.oldValue = func(context.Context) (*ProfilePost, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfilePostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfilePostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProfilePost entities.
+func (m *ProfilePostMutation) SetID(id ulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfilePostMutation) ID() (id ulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfilePostMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []ulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProfilePost.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProfileUsername sets the "profile_username" field.
+func (m *ProfilePostMutation) SetProfileUsername(s string) {
+	m.profile_username = &s
+}
+
+// ProfileUsername returns the value of the "profile_username" field in the mutation.
+func (m *ProfilePostMutation) ProfileUsername() (r string, exists bool) {
+	v := m.profile_username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileUsername returns the old "profile_username" field's value of the ProfilePost entity.
+// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostMutation) OldProfileUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileUsername: %w", err)
+	}
+	return oldValue.ProfileUsername, nil
+}
+
+// ResetProfileUsername resets all changes to the "profile_username" field.
+func (m *ProfilePostMutation) ResetProfileUsername() {
+	m.profile_username = nil
+}
+
+// SetFetchStatus sets the "fetch_status" field.
+func (m *ProfilePostMutation) SetFetchStatus(ps profilepost.FetchStatus) {
+	m.fetch_status = &ps
+}
+
+// FetchStatus returns the value of the "fetch_status" field in the mutation.
+func (m *ProfilePostMutation) FetchStatus() (r profilepost.FetchStatus, exists bool) {
+	v := m.fetch_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFetchStatus returns the old "fetch_status" field's value of the ProfilePost entity.
+// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostMutation) OldFetchStatus(ctx context.Context) (v profilepost.FetchStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFetchStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFetchStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFetchStatus: %w", err)
+	}
+	return oldValue.FetchStatus, nil
+}
+
+// ResetFetchStatus resets all changes to the "fetch_status" field.
+func (m *ProfilePostMutation) ResetFetchStatus() {
+	m.fetch_status = nil
+}
+
+// SetS3Key sets the "s3_key" field.
+func (m *ProfilePostMutation) SetS3Key(s string) {
+	m.s3_key = &s
+}
+
+// S3Key returns the value of the "s3_key" field in the mutation.
+func (m *ProfilePostMutation) S3Key() (r string, exists bool) {
+	v := m.s3_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldS3Key returns the old "s3_key" field's value of the ProfilePost entity.
+// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostMutation) OldS3Key(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldS3Key is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldS3Key requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldS3Key: %w", err)
+	}
+	return oldValue.S3Key, nil
+}
+
+// ClearS3Key clears the value of the "s3_key" field.
+func (m *ProfilePostMutation) ClearS3Key() {
+	m.s3_key = nil
+	m.clearedFields[profilepost.FieldS3Key] = struct{}{}
+}
+
+// S3KeyCleared returns if the "s3_key" field was cleared in this mutation.
+func (m *ProfilePostMutation) S3KeyCleared() bool {
+	_, ok := m.clearedFields[profilepost.FieldS3Key]
+	return ok
+}
+
+// ResetS3Key resets all changes to the "s3_key" field.
+func (m *ProfilePostMutation) ResetS3Key() {
+	m.s3_key = nil
+	delete(m.clearedFields, profilepost.FieldS3Key)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *ProfilePostMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *ProfilePostMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the ProfilePost entity.
+// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *ProfilePostMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[profilepost.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *ProfilePostMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[profilepost.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *ProfilePostMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, profilepost.FieldErrorMessage)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfilePostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfilePostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProfilePost entity.
+// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfilePostMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProfilePostMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProfilePostMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProfilePost entity.
+// If the ProfilePost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProfilePostMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddItemIDs adds the "items" edge to the ProfilePostItem entity by ids.
+func (m *ProfilePostMutation) AddItemIDs(ids ...ulid.ID) {
+	if m.items == nil {
+		m.items = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the ProfilePostItem entity.
+func (m *ProfilePostMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the ProfilePostItem entity was cleared.
+func (m *ProfilePostMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the ProfilePostItem entity by IDs.
+func (m *ProfilePostMutation) RemoveItemIDs(ids ...ulid.ID) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the ProfilePostItem entity.
+func (m *ProfilePostMutation) RemovedItemsIDs() (ids []ulid.ID) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *ProfilePostMutation) ItemsIDs() (ids []ulid.ID) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *ProfilePostMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the ProfilePostMutation builder.
+func (m *ProfilePostMutation) Where(ps ...predicate.ProfilePost) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfilePostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfilePostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProfilePost, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfilePostMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfilePostMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProfilePost).
+func (m *ProfilePostMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfilePostMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.profile_username != nil {
+		fields = append(fields, profilepost.FieldProfileUsername)
+	}
+	if m.fetch_status != nil {
+		fields = append(fields, profilepost.FieldFetchStatus)
+	}
+	if m.s3_key != nil {
+		fields = append(fields, profilepost.FieldS3Key)
+	}
+	if m.error_message != nil {
+		fields = append(fields, profilepost.FieldErrorMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, profilepost.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, profilepost.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfilePostMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profilepost.FieldProfileUsername:
+		return m.ProfileUsername()
+	case profilepost.FieldFetchStatus:
+		return m.FetchStatus()
+	case profilepost.FieldS3Key:
+		return m.S3Key()
+	case profilepost.FieldErrorMessage:
+		return m.ErrorMessage()
+	case profilepost.FieldCreatedAt:
+		return m.CreatedAt()
+	case profilepost.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfilePostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profilepost.FieldProfileUsername:
+		return m.OldProfileUsername(ctx)
+	case profilepost.FieldFetchStatus:
+		return m.OldFetchStatus(ctx)
+	case profilepost.FieldS3Key:
+		return m.OldS3Key(ctx)
+	case profilepost.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case profilepost.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profilepost.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProfilePost field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfilePostMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profilepost.FieldProfileUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileUsername(v)
+		return nil
+	case profilepost.FieldFetchStatus:
+		v, ok := value.(profilepost.FetchStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFetchStatus(v)
+		return nil
+	case profilepost.FieldS3Key:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetS3Key(v)
+		return nil
+	case profilepost.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case profilepost.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profilepost.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfilePost field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfilePostMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfilePostMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfilePostMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProfilePost numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProfilePostMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(profilepost.FieldS3Key) {
+		fields = append(fields, profilepost.FieldS3Key)
+	}
+	if m.FieldCleared(profilepost.FieldErrorMessage) {
+		fields = append(fields, profilepost.FieldErrorMessage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProfilePostMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProfilePostMutation) ClearField(name string) error {
+	switch name {
+	case profilepost.FieldS3Key:
+		m.ClearS3Key()
+		return nil
+	case profilepost.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfilePost nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProfilePostMutation) ResetField(name string) error {
+	switch name {
+	case profilepost.FieldProfileUsername:
+		m.ResetProfileUsername()
+		return nil
+	case profilepost.FieldFetchStatus:
+		m.ResetFetchStatus()
+		return nil
+	case profilepost.FieldS3Key:
+		m.ResetS3Key()
+		return nil
+	case profilepost.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case profilepost.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case profilepost.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfilePost field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProfilePostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.items != nil {
+		edges = append(edges, profilepost.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProfilePostMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case profilepost.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProfilePostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeditems != nil {
+		edges = append(edges, profilepost.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProfilePostMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case profilepost.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProfilePostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareditems {
+		edges = append(edges, profilepost.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProfilePostMutation) EdgeCleared(name string) bool {
+	switch name {
+	case profilepost.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProfilePostMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProfilePost unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProfilePostMutation) ResetEdge(name string) error {
+	switch name {
+	case profilepost.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfilePost edge %s", name)
+}
+
+// ProfilePostItemMutation represents an operation that mutates the ProfilePostItem nodes in the graph.
+type ProfilePostItemMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *ulid.ID
+	profile_username    *string
+	post_urn            *string
+	post_url            *string
+	text                *string
+	content_type        *string
+	is_repost           *bool
+	total_reactions     *int
+	addtotal_reactions  *int
+	like_count          *int
+	addlike_count       *int
+	comments_count      *int
+	addcomments_count   *int
+	reposts_count       *int
+	addreposts_count    *int
+	empathy_count       *int
+	addempathy_count    *int
+	praise_count        *int
+	addpraise_count     *int
+	funny_count         *int
+	addfunny_count      *int
+	interest_count      *int
+	addinterest_count   *int
+	posted_at           *time.Time
+	raw_data            *map[string]interface{}
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	profile_post        *ulid.ID
+	clearedprofile_post bool
+	done                bool
+	oldValue            func(context.Context) (*ProfilePostItem, error)
+	predicates          []predicate.ProfilePostItem
+}
+
+var _ ent.Mutation = (*ProfilePostItemMutation)(nil)
+
+// profilepostitemOption allows management of the mutation configuration using functional options.
+type profilepostitemOption func(*ProfilePostItemMutation)
+
+// newProfilePostItemMutation creates new mutation for the ProfilePostItem entity.
+func newProfilePostItemMutation(c config, op Op, opts ...profilepostitemOption) *ProfilePostItemMutation {
+	m := &ProfilePostItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfilePostItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProfilePostItemID sets the ID field of the mutation.
+func withProfilePostItemID(id ulid.ID) profilepostitemOption {
+	return func(m *ProfilePostItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProfilePostItem
+		)
+		m.oldValue = func(ctx context.Context) (*ProfilePostItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProfilePostItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProfilePostItem sets the old ProfilePostItem of the mutation.
+func withProfilePostItem(node *ProfilePostItem) profilepostitemOption {
+	return func(m *ProfilePostItemMutation) {
+		m.oldValue = func(context.Context) (*ProfilePostItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfilePostItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfilePostItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProfilePostItem entities.
+func (m *ProfilePostItemMutation) SetID(id ulid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfilePostItemMutation) ID() (id ulid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfilePostItemMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []ulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProfilePostItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProfileUsername sets the "profile_username" field.
+func (m *ProfilePostItemMutation) SetProfileUsername(s string) {
+	m.profile_username = &s
+}
+
+// ProfileUsername returns the value of the "profile_username" field in the mutation.
+func (m *ProfilePostItemMutation) ProfileUsername() (r string, exists bool) {
+	v := m.profile_username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileUsername returns the old "profile_username" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldProfileUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileUsername: %w", err)
+	}
+	return oldValue.ProfileUsername, nil
+}
+
+// ResetProfileUsername resets all changes to the "profile_username" field.
+func (m *ProfilePostItemMutation) ResetProfileUsername() {
+	m.profile_username = nil
+}
+
+// SetPostUrn sets the "post_urn" field.
+func (m *ProfilePostItemMutation) SetPostUrn(s string) {
+	m.post_urn = &s
+}
+
+// PostUrn returns the value of the "post_urn" field in the mutation.
+func (m *ProfilePostItemMutation) PostUrn() (r string, exists bool) {
+	v := m.post_urn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostUrn returns the old "post_urn" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldPostUrn(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostUrn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostUrn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostUrn: %w", err)
+	}
+	return oldValue.PostUrn, nil
+}
+
+// ClearPostUrn clears the value of the "post_urn" field.
+func (m *ProfilePostItemMutation) ClearPostUrn() {
+	m.post_urn = nil
+	m.clearedFields[profilepostitem.FieldPostUrn] = struct{}{}
+}
+
+// PostUrnCleared returns if the "post_urn" field was cleared in this mutation.
+func (m *ProfilePostItemMutation) PostUrnCleared() bool {
+	_, ok := m.clearedFields[profilepostitem.FieldPostUrn]
+	return ok
+}
+
+// ResetPostUrn resets all changes to the "post_urn" field.
+func (m *ProfilePostItemMutation) ResetPostUrn() {
+	m.post_urn = nil
+	delete(m.clearedFields, profilepostitem.FieldPostUrn)
+}
+
+// SetPostURL sets the "post_url" field.
+func (m *ProfilePostItemMutation) SetPostURL(s string) {
+	m.post_url = &s
+}
+
+// PostURL returns the value of the "post_url" field in the mutation.
+func (m *ProfilePostItemMutation) PostURL() (r string, exists bool) {
+	v := m.post_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostURL returns the old "post_url" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldPostURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostURL: %w", err)
+	}
+	return oldValue.PostURL, nil
+}
+
+// ClearPostURL clears the value of the "post_url" field.
+func (m *ProfilePostItemMutation) ClearPostURL() {
+	m.post_url = nil
+	m.clearedFields[profilepostitem.FieldPostURL] = struct{}{}
+}
+
+// PostURLCleared returns if the "post_url" field was cleared in this mutation.
+func (m *ProfilePostItemMutation) PostURLCleared() bool {
+	_, ok := m.clearedFields[profilepostitem.FieldPostURL]
+	return ok
+}
+
+// ResetPostURL resets all changes to the "post_url" field.
+func (m *ProfilePostItemMutation) ResetPostURL() {
+	m.post_url = nil
+	delete(m.clearedFields, profilepostitem.FieldPostURL)
+}
+
+// SetText sets the "text" field.
+func (m *ProfilePostItemMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *ProfilePostItemMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldText(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ClearText clears the value of the "text" field.
+func (m *ProfilePostItemMutation) ClearText() {
+	m.text = nil
+	m.clearedFields[profilepostitem.FieldText] = struct{}{}
+}
+
+// TextCleared returns if the "text" field was cleared in this mutation.
+func (m *ProfilePostItemMutation) TextCleared() bool {
+	_, ok := m.clearedFields[profilepostitem.FieldText]
+	return ok
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *ProfilePostItemMutation) ResetText() {
+	m.text = nil
+	delete(m.clearedFields, profilepostitem.FieldText)
+}
+
+// SetContentType sets the "content_type" field.
+func (m *ProfilePostItemMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *ProfilePostItemMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldContentType(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (m *ProfilePostItemMutation) ClearContentType() {
+	m.content_type = nil
+	m.clearedFields[profilepostitem.FieldContentType] = struct{}{}
+}
+
+// ContentTypeCleared returns if the "content_type" field was cleared in this mutation.
+func (m *ProfilePostItemMutation) ContentTypeCleared() bool {
+	_, ok := m.clearedFields[profilepostitem.FieldContentType]
+	return ok
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *ProfilePostItemMutation) ResetContentType() {
+	m.content_type = nil
+	delete(m.clearedFields, profilepostitem.FieldContentType)
+}
+
+// SetIsRepost sets the "is_repost" field.
+func (m *ProfilePostItemMutation) SetIsRepost(b bool) {
+	m.is_repost = &b
+}
+
+// IsRepost returns the value of the "is_repost" field in the mutation.
+func (m *ProfilePostItemMutation) IsRepost() (r bool, exists bool) {
+	v := m.is_repost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsRepost returns the old "is_repost" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldIsRepost(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsRepost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsRepost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsRepost: %w", err)
+	}
+	return oldValue.IsRepost, nil
+}
+
+// ResetIsRepost resets all changes to the "is_repost" field.
+func (m *ProfilePostItemMutation) ResetIsRepost() {
+	m.is_repost = nil
+}
+
+// SetTotalReactions sets the "total_reactions" field.
+func (m *ProfilePostItemMutation) SetTotalReactions(i int) {
+	m.total_reactions = &i
+	m.addtotal_reactions = nil
+}
+
+// TotalReactions returns the value of the "total_reactions" field in the mutation.
+func (m *ProfilePostItemMutation) TotalReactions() (r int, exists bool) {
+	v := m.total_reactions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalReactions returns the old "total_reactions" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldTotalReactions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalReactions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalReactions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalReactions: %w", err)
+	}
+	return oldValue.TotalReactions, nil
+}
+
+// AddTotalReactions adds i to the "total_reactions" field.
+func (m *ProfilePostItemMutation) AddTotalReactions(i int) {
+	if m.addtotal_reactions != nil {
+		*m.addtotal_reactions += i
+	} else {
+		m.addtotal_reactions = &i
+	}
+}
+
+// AddedTotalReactions returns the value that was added to the "total_reactions" field in this mutation.
+func (m *ProfilePostItemMutation) AddedTotalReactions() (r int, exists bool) {
+	v := m.addtotal_reactions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalReactions resets all changes to the "total_reactions" field.
+func (m *ProfilePostItemMutation) ResetTotalReactions() {
+	m.total_reactions = nil
+	m.addtotal_reactions = nil
+}
+
+// SetLikeCount sets the "like_count" field.
+func (m *ProfilePostItemMutation) SetLikeCount(i int) {
+	m.like_count = &i
+	m.addlike_count = nil
+}
+
+// LikeCount returns the value of the "like_count" field in the mutation.
+func (m *ProfilePostItemMutation) LikeCount() (r int, exists bool) {
+	v := m.like_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLikeCount returns the old "like_count" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldLikeCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLikeCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLikeCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLikeCount: %w", err)
+	}
+	return oldValue.LikeCount, nil
+}
+
+// AddLikeCount adds i to the "like_count" field.
+func (m *ProfilePostItemMutation) AddLikeCount(i int) {
+	if m.addlike_count != nil {
+		*m.addlike_count += i
+	} else {
+		m.addlike_count = &i
+	}
+}
+
+// AddedLikeCount returns the value that was added to the "like_count" field in this mutation.
+func (m *ProfilePostItemMutation) AddedLikeCount() (r int, exists bool) {
+	v := m.addlike_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLikeCount resets all changes to the "like_count" field.
+func (m *ProfilePostItemMutation) ResetLikeCount() {
+	m.like_count = nil
+	m.addlike_count = nil
+}
+
+// SetCommentsCount sets the "comments_count" field.
+func (m *ProfilePostItemMutation) SetCommentsCount(i int) {
+	m.comments_count = &i
+	m.addcomments_count = nil
+}
+
+// CommentsCount returns the value of the "comments_count" field in the mutation.
+func (m *ProfilePostItemMutation) CommentsCount() (r int, exists bool) {
+	v := m.comments_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommentsCount returns the old "comments_count" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldCommentsCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommentsCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommentsCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommentsCount: %w", err)
+	}
+	return oldValue.CommentsCount, nil
+}
+
+// AddCommentsCount adds i to the "comments_count" field.
+func (m *ProfilePostItemMutation) AddCommentsCount(i int) {
+	if m.addcomments_count != nil {
+		*m.addcomments_count += i
+	} else {
+		m.addcomments_count = &i
+	}
+}
+
+// AddedCommentsCount returns the value that was added to the "comments_count" field in this mutation.
+func (m *ProfilePostItemMutation) AddedCommentsCount() (r int, exists bool) {
+	v := m.addcomments_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCommentsCount resets all changes to the "comments_count" field.
+func (m *ProfilePostItemMutation) ResetCommentsCount() {
+	m.comments_count = nil
+	m.addcomments_count = nil
+}
+
+// SetRepostsCount sets the "reposts_count" field.
+func (m *ProfilePostItemMutation) SetRepostsCount(i int) {
+	m.reposts_count = &i
+	m.addreposts_count = nil
+}
+
+// RepostsCount returns the value of the "reposts_count" field in the mutation.
+func (m *ProfilePostItemMutation) RepostsCount() (r int, exists bool) {
+	v := m.reposts_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepostsCount returns the old "reposts_count" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldRepostsCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepostsCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepostsCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepostsCount: %w", err)
+	}
+	return oldValue.RepostsCount, nil
+}
+
+// AddRepostsCount adds i to the "reposts_count" field.
+func (m *ProfilePostItemMutation) AddRepostsCount(i int) {
+	if m.addreposts_count != nil {
+		*m.addreposts_count += i
+	} else {
+		m.addreposts_count = &i
+	}
+}
+
+// AddedRepostsCount returns the value that was added to the "reposts_count" field in this mutation.
+func (m *ProfilePostItemMutation) AddedRepostsCount() (r int, exists bool) {
+	v := m.addreposts_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRepostsCount resets all changes to the "reposts_count" field.
+func (m *ProfilePostItemMutation) ResetRepostsCount() {
+	m.reposts_count = nil
+	m.addreposts_count = nil
+}
+
+// SetEmpathyCount sets the "empathy_count" field.
+func (m *ProfilePostItemMutation) SetEmpathyCount(i int) {
+	m.empathy_count = &i
+	m.addempathy_count = nil
+}
+
+// EmpathyCount returns the value of the "empathy_count" field in the mutation.
+func (m *ProfilePostItemMutation) EmpathyCount() (r int, exists bool) {
+	v := m.empathy_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmpathyCount returns the old "empathy_count" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldEmpathyCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmpathyCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmpathyCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmpathyCount: %w", err)
+	}
+	return oldValue.EmpathyCount, nil
+}
+
+// AddEmpathyCount adds i to the "empathy_count" field.
+func (m *ProfilePostItemMutation) AddEmpathyCount(i int) {
+	if m.addempathy_count != nil {
+		*m.addempathy_count += i
+	} else {
+		m.addempathy_count = &i
+	}
+}
+
+// AddedEmpathyCount returns the value that was added to the "empathy_count" field in this mutation.
+func (m *ProfilePostItemMutation) AddedEmpathyCount() (r int, exists bool) {
+	v := m.addempathy_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEmpathyCount resets all changes to the "empathy_count" field.
+func (m *ProfilePostItemMutation) ResetEmpathyCount() {
+	m.empathy_count = nil
+	m.addempathy_count = nil
+}
+
+// SetPraiseCount sets the "praise_count" field.
+func (m *ProfilePostItemMutation) SetPraiseCount(i int) {
+	m.praise_count = &i
+	m.addpraise_count = nil
+}
+
+// PraiseCount returns the value of the "praise_count" field in the mutation.
+func (m *ProfilePostItemMutation) PraiseCount() (r int, exists bool) {
+	v := m.praise_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPraiseCount returns the old "praise_count" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldPraiseCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPraiseCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPraiseCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPraiseCount: %w", err)
+	}
+	return oldValue.PraiseCount, nil
+}
+
+// AddPraiseCount adds i to the "praise_count" field.
+func (m *ProfilePostItemMutation) AddPraiseCount(i int) {
+	if m.addpraise_count != nil {
+		*m.addpraise_count += i
+	} else {
+		m.addpraise_count = &i
+	}
+}
+
+// AddedPraiseCount returns the value that was added to the "praise_count" field in this mutation.
+func (m *ProfilePostItemMutation) AddedPraiseCount() (r int, exists bool) {
+	v := m.addpraise_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPraiseCount resets all changes to the "praise_count" field.
+func (m *ProfilePostItemMutation) ResetPraiseCount() {
+	m.praise_count = nil
+	m.addpraise_count = nil
+}
+
+// SetFunnyCount sets the "funny_count" field.
+func (m *ProfilePostItemMutation) SetFunnyCount(i int) {
+	m.funny_count = &i
+	m.addfunny_count = nil
+}
+
+// FunnyCount returns the value of the "funny_count" field in the mutation.
+func (m *ProfilePostItemMutation) FunnyCount() (r int, exists bool) {
+	v := m.funny_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFunnyCount returns the old "funny_count" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldFunnyCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFunnyCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFunnyCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFunnyCount: %w", err)
+	}
+	return oldValue.FunnyCount, nil
+}
+
+// AddFunnyCount adds i to the "funny_count" field.
+func (m *ProfilePostItemMutation) AddFunnyCount(i int) {
+	if m.addfunny_count != nil {
+		*m.addfunny_count += i
+	} else {
+		m.addfunny_count = &i
+	}
+}
+
+// AddedFunnyCount returns the value that was added to the "funny_count" field in this mutation.
+func (m *ProfilePostItemMutation) AddedFunnyCount() (r int, exists bool) {
+	v := m.addfunny_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFunnyCount resets all changes to the "funny_count" field.
+func (m *ProfilePostItemMutation) ResetFunnyCount() {
+	m.funny_count = nil
+	m.addfunny_count = nil
+}
+
+// SetInterestCount sets the "interest_count" field.
+func (m *ProfilePostItemMutation) SetInterestCount(i int) {
+	m.interest_count = &i
+	m.addinterest_count = nil
+}
+
+// InterestCount returns the value of the "interest_count" field in the mutation.
+func (m *ProfilePostItemMutation) InterestCount() (r int, exists bool) {
+	v := m.interest_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInterestCount returns the old "interest_count" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldInterestCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInterestCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInterestCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInterestCount: %w", err)
+	}
+	return oldValue.InterestCount, nil
+}
+
+// AddInterestCount adds i to the "interest_count" field.
+func (m *ProfilePostItemMutation) AddInterestCount(i int) {
+	if m.addinterest_count != nil {
+		*m.addinterest_count += i
+	} else {
+		m.addinterest_count = &i
+	}
+}
+
+// AddedInterestCount returns the value that was added to the "interest_count" field in this mutation.
+func (m *ProfilePostItemMutation) AddedInterestCount() (r int, exists bool) {
+	v := m.addinterest_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInterestCount resets all changes to the "interest_count" field.
+func (m *ProfilePostItemMutation) ResetInterestCount() {
+	m.interest_count = nil
+	m.addinterest_count = nil
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (m *ProfilePostItemMutation) SetPostedAt(t time.Time) {
+	m.posted_at = &t
+}
+
+// PostedAt returns the value of the "posted_at" field in the mutation.
+func (m *ProfilePostItemMutation) PostedAt() (r time.Time, exists bool) {
+	v := m.posted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostedAt returns the old "posted_at" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldPostedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostedAt: %w", err)
+	}
+	return oldValue.PostedAt, nil
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (m *ProfilePostItemMutation) ClearPostedAt() {
+	m.posted_at = nil
+	m.clearedFields[profilepostitem.FieldPostedAt] = struct{}{}
+}
+
+// PostedAtCleared returns if the "posted_at" field was cleared in this mutation.
+func (m *ProfilePostItemMutation) PostedAtCleared() bool {
+	_, ok := m.clearedFields[profilepostitem.FieldPostedAt]
+	return ok
+}
+
+// ResetPostedAt resets all changes to the "posted_at" field.
+func (m *ProfilePostItemMutation) ResetPostedAt() {
+	m.posted_at = nil
+	delete(m.clearedFields, profilepostitem.FieldPostedAt)
+}
+
+// SetRawData sets the "raw_data" field.
+func (m *ProfilePostItemMutation) SetRawData(value map[string]interface{}) {
+	m.raw_data = &value
+}
+
+// RawData returns the value of the "raw_data" field in the mutation.
+func (m *ProfilePostItemMutation) RawData() (r map[string]interface{}, exists bool) {
+	v := m.raw_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawData returns the old "raw_data" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldRawData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawData: %w", err)
+	}
+	return oldValue.RawData, nil
+}
+
+// ClearRawData clears the value of the "raw_data" field.
+func (m *ProfilePostItemMutation) ClearRawData() {
+	m.raw_data = nil
+	m.clearedFields[profilepostitem.FieldRawData] = struct{}{}
+}
+
+// RawDataCleared returns if the "raw_data" field was cleared in this mutation.
+func (m *ProfilePostItemMutation) RawDataCleared() bool {
+	_, ok := m.clearedFields[profilepostitem.FieldRawData]
+	return ok
+}
+
+// ResetRawData resets all changes to the "raw_data" field.
+func (m *ProfilePostItemMutation) ResetRawData() {
+	m.raw_data = nil
+	delete(m.clearedFields, profilepostitem.FieldRawData)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfilePostItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfilePostItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfilePostItemMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProfilePostItemMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProfilePostItemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProfilePostItem entity.
+// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfilePostItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProfilePostItemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetProfilePostID sets the "profile_post" edge to the ProfilePost entity by id.
+func (m *ProfilePostItemMutation) SetProfilePostID(id ulid.ID) {
+	m.profile_post = &id
+}
+
+// ClearProfilePost clears the "profile_post" edge to the ProfilePost entity.
+func (m *ProfilePostItemMutation) ClearProfilePost() {
+	m.clearedprofile_post = true
+}
+
+// ProfilePostCleared reports if the "profile_post" edge to the ProfilePost entity was cleared.
+func (m *ProfilePostItemMutation) ProfilePostCleared() bool {
+	return m.clearedprofile_post
+}
+
+// ProfilePostID returns the "profile_post" edge ID in the mutation.
+func (m *ProfilePostItemMutation) ProfilePostID() (id ulid.ID, exists bool) {
+	if m.profile_post != nil {
+		return *m.profile_post, true
+	}
+	return
+}
+
+// ProfilePostIDs returns the "profile_post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfilePostID instead. It exists only for internal usage by the builders.
+func (m *ProfilePostItemMutation) ProfilePostIDs() (ids []ulid.ID) {
+	if id := m.profile_post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfilePost resets all changes to the "profile_post" edge.
+func (m *ProfilePostItemMutation) ResetProfilePost() {
+	m.profile_post = nil
+	m.clearedprofile_post = false
+}
+
+// Where appends a list predicates to the ProfilePostItemMutation builder.
+func (m *ProfilePostItemMutation) Where(ps ...predicate.ProfilePostItem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfilePostItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfilePostItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProfilePostItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfilePostItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfilePostItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProfilePostItem).
+func (m *ProfilePostItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfilePostItemMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.profile_username != nil {
+		fields = append(fields, profilepostitem.FieldProfileUsername)
+	}
+	if m.post_urn != nil {
+		fields = append(fields, profilepostitem.FieldPostUrn)
+	}
+	if m.post_url != nil {
+		fields = append(fields, profilepostitem.FieldPostURL)
+	}
+	if m.text != nil {
+		fields = append(fields, profilepostitem.FieldText)
+	}
+	if m.content_type != nil {
+		fields = append(fields, profilepostitem.FieldContentType)
+	}
+	if m.is_repost != nil {
+		fields = append(fields, profilepostitem.FieldIsRepost)
+	}
+	if m.total_reactions != nil {
+		fields = append(fields, profilepostitem.FieldTotalReactions)
+	}
+	if m.like_count != nil {
+		fields = append(fields, profilepostitem.FieldLikeCount)
+	}
+	if m.comments_count != nil {
+		fields = append(fields, profilepostitem.FieldCommentsCount)
+	}
+	if m.reposts_count != nil {
+		fields = append(fields, profilepostitem.FieldRepostsCount)
+	}
+	if m.empathy_count != nil {
+		fields = append(fields, profilepostitem.FieldEmpathyCount)
+	}
+	if m.praise_count != nil {
+		fields = append(fields, profilepostitem.FieldPraiseCount)
+	}
+	if m.funny_count != nil {
+		fields = append(fields, profilepostitem.FieldFunnyCount)
+	}
+	if m.interest_count != nil {
+		fields = append(fields, profilepostitem.FieldInterestCount)
+	}
+	if m.posted_at != nil {
+		fields = append(fields, profilepostitem.FieldPostedAt)
+	}
+	if m.raw_data != nil {
+		fields = append(fields, profilepostitem.FieldRawData)
+	}
+	if m.created_at != nil {
+		fields = append(fields, profilepostitem.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, profilepostitem.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfilePostItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profilepostitem.FieldProfileUsername:
+		return m.ProfileUsername()
+	case profilepostitem.FieldPostUrn:
+		return m.PostUrn()
+	case profilepostitem.FieldPostURL:
+		return m.PostURL()
+	case profilepostitem.FieldText:
+		return m.Text()
+	case profilepostitem.FieldContentType:
+		return m.ContentType()
+	case profilepostitem.FieldIsRepost:
+		return m.IsRepost()
+	case profilepostitem.FieldTotalReactions:
+		return m.TotalReactions()
+	case profilepostitem.FieldLikeCount:
+		return m.LikeCount()
+	case profilepostitem.FieldCommentsCount:
+		return m.CommentsCount()
+	case profilepostitem.FieldRepostsCount:
+		return m.RepostsCount()
+	case profilepostitem.FieldEmpathyCount:
+		return m.EmpathyCount()
+	case profilepostitem.FieldPraiseCount:
+		return m.PraiseCount()
+	case profilepostitem.FieldFunnyCount:
+		return m.FunnyCount()
+	case profilepostitem.FieldInterestCount:
+		return m.InterestCount()
+	case profilepostitem.FieldPostedAt:
+		return m.PostedAt()
+	case profilepostitem.FieldRawData:
+		return m.RawData()
+	case profilepostitem.FieldCreatedAt:
+		return m.CreatedAt()
+	case profilepostitem.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfilePostItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profilepostitem.FieldProfileUsername:
+		return m.OldProfileUsername(ctx)
+	case profilepostitem.FieldPostUrn:
+		return m.OldPostUrn(ctx)
+	case profilepostitem.FieldPostURL:
+		return m.OldPostURL(ctx)
+	case profilepostitem.FieldText:
+		return m.OldText(ctx)
+	case profilepostitem.FieldContentType:
+		return m.OldContentType(ctx)
+	case profilepostitem.FieldIsRepost:
+		return m.OldIsRepost(ctx)
+	case profilepostitem.FieldTotalReactions:
+		return m.OldTotalReactions(ctx)
+	case profilepostitem.FieldLikeCount:
+		return m.OldLikeCount(ctx)
+	case profilepostitem.FieldCommentsCount:
+		return m.OldCommentsCount(ctx)
+	case profilepostitem.FieldRepostsCount:
+		return m.OldRepostsCount(ctx)
+	case profilepostitem.FieldEmpathyCount:
+		return m.OldEmpathyCount(ctx)
+	case profilepostitem.FieldPraiseCount:
+		return m.OldPraiseCount(ctx)
+	case profilepostitem.FieldFunnyCount:
+		return m.OldFunnyCount(ctx)
+	case profilepostitem.FieldInterestCount:
+		return m.OldInterestCount(ctx)
+	case profilepostitem.FieldPostedAt:
+		return m.OldPostedAt(ctx)
+	case profilepostitem.FieldRawData:
+		return m.OldRawData(ctx)
+	case profilepostitem.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profilepostitem.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProfilePostItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfilePostItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profilepostitem.FieldProfileUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileUsername(v)
+		return nil
+	case profilepostitem.FieldPostUrn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostUrn(v)
+		return nil
+	case profilepostitem.FieldPostURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostURL(v)
+		return nil
+	case profilepostitem.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case profilepostitem.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case profilepostitem.FieldIsRepost:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsRepost(v)
+		return nil
+	case profilepostitem.FieldTotalReactions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalReactions(v)
+		return nil
+	case profilepostitem.FieldLikeCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLikeCount(v)
+		return nil
+	case profilepostitem.FieldCommentsCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommentsCount(v)
+		return nil
+	case profilepostitem.FieldRepostsCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepostsCount(v)
+		return nil
+	case profilepostitem.FieldEmpathyCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmpathyCount(v)
+		return nil
+	case profilepostitem.FieldPraiseCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPraiseCount(v)
+		return nil
+	case profilepostitem.FieldFunnyCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFunnyCount(v)
+		return nil
+	case profilepostitem.FieldInterestCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterestCount(v)
+		return nil
+	case profilepostitem.FieldPostedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostedAt(v)
+		return nil
+	case profilepostitem.FieldRawData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawData(v)
+		return nil
+	case profilepostitem.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profilepostitem.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfilePostItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfilePostItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_reactions != nil {
+		fields = append(fields, profilepostitem.FieldTotalReactions)
+	}
+	if m.addlike_count != nil {
+		fields = append(fields, profilepostitem.FieldLikeCount)
+	}
+	if m.addcomments_count != nil {
+		fields = append(fields, profilepostitem.FieldCommentsCount)
+	}
+	if m.addreposts_count != nil {
+		fields = append(fields, profilepostitem.FieldRepostsCount)
+	}
+	if m.addempathy_count != nil {
+		fields = append(fields, profilepostitem.FieldEmpathyCount)
+	}
+	if m.addpraise_count != nil {
+		fields = append(fields, profilepostitem.FieldPraiseCount)
+	}
+	if m.addfunny_count != nil {
+		fields = append(fields, profilepostitem.FieldFunnyCount)
+	}
+	if m.addinterest_count != nil {
+		fields = append(fields, profilepostitem.FieldInterestCount)
 	}
-	return nil, false
+	return fields
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ProfilePostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfilePostItemMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case profilepost.FieldProfileUsername:
-		return m.OldProfileUsername(ctx)
-	case profilepost.FieldFetchStatus:
-		return m.OldFetchStatus(ctx)
-	case profilepost.FieldS3Key:
-		return m.OldS3Key(ctx)
-	case profilepost.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case profilepost.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case profilepost.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case profilepostitem.FieldTotalReactions:
+		return m.AddedTotalReactions()
+	case profilepostitem.FieldLikeCount:
+		return m.AddedLikeCount()
+	case profilepostitem.FieldCommentsCount:
+		return m.AddedCommentsCount()
+	case profilepostitem.FieldRepostsCount:
+		return m.AddedRepostsCount()
+	case profilepostitem.FieldEmpathyCount:
+		return m.AddedEmpathyCount()
+	case profilepostitem.FieldPraiseCount:
+		return m.AddedPraiseCount()
+	case profilepostitem.FieldFunnyCount:
+		return m.AddedFunnyCount()
+	case profilepostitem.FieldInterestCount:
+		return m.AddedInterestCount()
 	}
-	return nil, fmt.Errorf("unknown ProfilePost field %s", name)
+	return nil, false
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
+// AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProfilePostMutation) SetField(name string, value ent.Value) error {
+func (m *ProfilePostItemMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case profilepost.FieldProfileUsername:
-		v, ok := value.(string)
+	case profilepostitem.FieldTotalReactions:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProfileUsername(v)
+		m.AddTotalReactions(v)
 		return nil
-	case profilepost.FieldFetchStatus:
-		v, ok := value.(profilepost.FetchStatus)
+	case profilepostitem.FieldLikeCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFetchStatus(v)
+		m.AddLikeCount(v)
 		return nil
-	case profilepost.FieldS3Key:
-		v, ok := value.(string)
+	case profilepostitem.FieldCommentsCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetS3Key(v)
+		m.AddCommentsCount(v)
 		return nil
-	case profilepost.FieldErrorMessage:
-		v, ok := value.(string)
+	case profilepostitem.FieldRepostsCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.AddRepostsCount(v)
 		return nil
-	case profilepost.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case profilepostitem.FieldEmpathyCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.AddEmpathyCount(v)
 		return nil
-	case profilepost.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case profilepostitem.FieldPraiseCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.AddPraiseCount(v)
+		return nil
+	case profilepostitem.FieldFunnyCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFunnyCount(v)
+		return nil
+	case profilepostitem.FieldInterestCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInterestCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProfilePost field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ProfilePostMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ProfilePostMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProfilePostMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown ProfilePost numeric field %s", name)
+	return fmt.Errorf("unknown ProfilePostItem numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProfilePostMutation) ClearedFields() []string {
+func (m *ProfilePostItemMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(profilepost.FieldS3Key) {
-		fields = append(fields, profilepost.FieldS3Key)
+	if m.FieldCleared(profilepostitem.FieldPostUrn) {
+		fields = append(fields, profilepostitem.FieldPostUrn)
 	}
-	if m.FieldCleared(profilepost.FieldErrorMessage) {
-		fields = append(fields, profilepost.FieldErrorMessage)
+	if m.FieldCleared(profilepostitem.FieldPostURL) {
+		fields = append(fields, profilepostitem.FieldPostURL)
+	}
+	if m.FieldCleared(profilepostitem.FieldText) {
+		fields = append(fields, profilepostitem.FieldText)
+	}
+	if m.FieldCleared(profilepostitem.FieldContentType) {
+		fields = append(fields, profilepostitem.FieldContentType)
+	}
+	if m.FieldCleared(profilepostitem.FieldPostedAt) {
+		fields = append(fields, profilepostitem.FieldPostedAt)
+	}
+	if m.FieldCleared(profilepostitem.FieldRawData) {
+		fields = append(fields, profilepostitem.FieldRawData)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProfilePostMutation) FieldCleared(name string) bool {
+func (m *ProfilePostItemMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProfilePostMutation) ClearField(name string) error {
+func (m *ProfilePostItemMutation) ClearField(name string) error {
 	switch name {
-	case profilepost.FieldS3Key:
-		m.ClearS3Key()
+	case profilepostitem.FieldPostUrn:
+		m.ClearPostUrn()
 		return nil
-	case profilepost.FieldErrorMessage:
-		m.ClearErrorMessage()
+	case profilepostitem.FieldPostURL:
+		m.ClearPostURL()
+		return nil
+	case profilepostitem.FieldText:
+		m.ClearText()
+		return nil
+	case profilepostitem.FieldContentType:
+		m.ClearContentType()
+		return nil
+	case profilepostitem.FieldPostedAt:
+		m.ClearPostedAt()
+		return nil
+	case profilepostitem.FieldRawData:
+		m.ClearRawData()
 		return nil
 	}
-	return fmt.Errorf("unknown ProfilePost nullable field %s", name)
+	return fmt.Errorf("unknown ProfilePostItem nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProfilePostMutation) ResetField(name string) error {
+func (m *ProfilePostItemMutation) ResetField(name string) error {
 	switch name {
-	case profilepost.FieldProfileUsername:
+	case profilepostitem.FieldProfileUsername:
 		m.ResetProfileUsername()
 		return nil
-	case profilepost.FieldFetchStatus:
-		m.ResetFetchStatus()
+	case profilepostitem.FieldPostUrn:
+		m.ResetPostUrn()
 		return nil
-	case profilepost.FieldS3Key:
-		m.ResetS3Key()
+	case profilepostitem.FieldPostURL:
+		m.ResetPostURL()
 		return nil
-	case profilepost.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case profilepostitem.FieldText:
+		m.ResetText()
 		return nil
-	case profilepost.FieldCreatedAt:
+	case profilepostitem.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case profilepostitem.FieldIsRepost:
+		m.ResetIsRepost()
+		return nil
+	case profilepostitem.FieldTotalReactions:
+		m.ResetTotalReactions()
+		return nil
+	case profilepostitem.FieldLikeCount:
+		m.ResetLikeCount()
+		return nil
+	case profilepostitem.FieldCommentsCount:
+		m.ResetCommentsCount()
+		return nil
+	case profilepostitem.FieldRepostsCount:
+		m.ResetRepostsCount()
+		return nil
+	case profilepostitem.FieldEmpathyCount:
+		m.ResetEmpathyCount()
+		return nil
+	case profilepostitem.FieldPraiseCount:
+		m.ResetPraiseCount()
+		return nil
+	case profilepostitem.FieldFunnyCount:
+		m.ResetFunnyCount()
+		return nil
+	case profilepostitem.FieldInterestCount:
+		m.ResetInterestCount()
+		return nil
+	case profilepostitem.FieldPostedAt:
+		m.ResetPostedAt()
+		return nil
+	case profilepostitem.FieldRawData:
+		m.ResetRawData()
+		return nil
+	case profilepostitem.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case profilepost.FieldUpdatedAt:
+	case profilepostitem.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ProfilePost field %s", name)
+	return fmt.Errorf("unknown ProfilePostItem field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProfilePostMutation) AddedEdges() []string {
+func (m *ProfilePostItemMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.items != nil {
-		edges = append(edges, profilepost.EdgeItems)
+	if m.profile_post != nil {
+		edges = append(edges, profilepostitem.EdgeProfilePost)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProfilePostMutation) AddedIDs(name string) []ent.Value {
+func (m *ProfilePostItemMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case profilepost.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.items))
-		for id := range m.items {
-			ids = append(ids, id)
+	case profilepostitem.EdgeProfilePost:
+		if id := m.profile_post; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProfilePostMutation) RemovedEdges() []string {
+func (m *ProfilePostItemMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removeditems != nil {
-		edges = append(edges, profilepost.EdgeItems)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProfilePostMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case profilepost.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.removeditems))
-		for id := range m.removeditems {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ProfilePostItemMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProfilePostMutation) ClearedEdges() []string {
+func (m *ProfilePostItemMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareditems {
-		edges = append(edges, profilepost.EdgeItems)
+	if m.clearedprofile_post {
+		edges = append(edges, profilepostitem.EdgeProfilePost)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProfilePostMutation) EdgeCleared(name string) bool {
+func (m *ProfilePostItemMutation) EdgeCleared(name string) bool {
 	switch name {
-	case profilepost.EdgeItems:
-		return m.cleareditems
+	case profilepostitem.EdgeProfilePost:
+		return m.clearedprofile_post
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProfilePostMutation) ClearEdge(name string) error {
+func (m *ProfilePostItemMutation) ClearEdge(name string) error {
 	switch name {
+	case profilepostitem.EdgeProfilePost:
+		m.ClearProfilePost()
+		return nil
 	}
-	return fmt.Errorf("unknown ProfilePost unique edge %s", name)
+	return fmt.Errorf("unknown ProfilePostItem unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProfilePostMutation) ResetEdge(name string) error {
+func (m *ProfilePostItemMutation) ResetEdge(name string) error {
 	switch name {
-	case profilepost.EdgeItems:
-		m.ResetItems()
+	case profilepostitem.EdgeProfilePost:
+		m.ResetProfilePost()
 		return nil
 	}
-	return fmt.Errorf("unknown ProfilePost edge %s", name)
+	return fmt.Errorf("unknown ProfilePostItem edge %s", name)
 }
 
-// ProfilePostItemMutation represents an operation that mutates the ProfilePostItem nodes in the graph.
-type ProfilePostItemMutation struct {
+// ProfileSkillMutation represents an operation that mutates the ProfileSkill nodes in the graph.
+type ProfileSkillMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *ulid.ID
-	profile_username    *string
-	post_urn            *string
-	post_url            *string
-	text                *string
-	content_type        *string
-	is_repost           *bool
-	total_reactions     *int
-	addtotal_reactions  *int
-	like_count          *int
-	addlike_count       *int
-	comments_count      *int
-	addcomments_count   *int
-	reposts_count       *int
-	addreposts_count    *int
-	empathy_count       *int
-	addempathy_count    *int
-	praise_count        *int
-	addpraise_count     *int
-	funny_count         *int
-	addfunny_count      *int
-	interest_count      *int
-	addinterest_count   *int
-	posted_at           *time.Time
-	raw_data            *map[string]interface{}
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	profile_post        *ulid.ID
-	clearedprofile_post bool
-	done                bool
-	oldValue            func(context.Context) (*ProfilePostItem, error)
-	predicates          []predicate.ProfilePostItem
+	op                   Op
+	typ                  string
+	id                   *ulid.ID
+	name                 *string
+	endorsement_count    *int
+	addendorsement_count *int
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	profile              *ulid.ID
+	clearedprofile       bool
+	done                 bool
+	oldValue             func(context.Context) (*ProfileSkill, error)
+	predicates           []predicate.ProfileSkill
 }
 
-var _ ent.Mutation = (*ProfilePostItemMutation)(nil)
+var _ ent.Mutation = (*ProfileSkillMutation)(nil)
 
-// profilepostitemOption allows management of the mutation configuration using functional options.
-type profilepostitemOption func(*ProfilePostItemMutation)
+// profileskillOption allows management of the mutation configuration using functional options.
+type profileskillOption func(*ProfileSkillMutation)
 
-// newProfilePostItemMutation creates new mutation for the ProfilePostItem entity.
-func newProfilePostItemMutation(c config, op Op, opts ...profilepostitemOption) *ProfilePostItemMutation {
-	m := &ProfilePostItemMutation{
+// newProfileSkillMutation creates new mutation for the ProfileSkill entity.
+func newProfileSkillMutation(c config, op Op, opts ...profileskillOption) *ProfileSkillMutation {
+	m := &ProfileSkillMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProfilePostItem,
+		typ:           TypeProfileSkill,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6838,20 +17474,20 @@ func newProfilePostItemMutation(c config, op Op, opts ...profilepostitemOption)
 	return m
 }
 
-// withProfilePostItemID sets the ID field of the mutation.
-func withProfilePostItemID(id ulid.ID) profilepostitemOption {
-	return func(m *ProfilePostItemMutation) {
+// withProfileSkillID sets the ID field of the mutation.
+func withProfileSkillID(id ulid.ID) profileskillOption {
+	return func(m *ProfileSkillMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ProfilePostItem
+			value *ProfileSkill
 		)
-		m.oldValue = func(ctx context.Context) (*ProfilePostItem, error) {
+		m.oldValue = func(ctx context.Context) (*ProfileSkill, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ProfilePostItem.Get(ctx, id)
+					value, err = m.Client().ProfileSkill.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6860,10 +17496,10 @@ func withProfilePostItemID(id ulid.ID) profilepostitemOption {
 	}
 }
 
-// withProfilePostItem sets the old ProfilePostItem of the mutation.
-func withProfilePostItem(node *ProfilePostItem) profilepostitemOption {
-	return func(m *ProfilePostItemMutation) {
-		m.oldValue = func(context.Context) (*ProfilePostItem, error) {
+// withProfileSkill sets the old ProfileSkill of the mutation.
+func withProfileSkill(node *ProfileSkill) profileskillOption {
+	return func(m *ProfileSkillMutation) {
+		m.oldValue = func(context.Context) (*ProfileSkill, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6872,7 +17508,7 @@ func withProfilePostItem(node *ProfilePostItem) profilepostitemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProfilePostItemMutation) Client() *Client {
+func (m ProfileSkillMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6880,7 +17516,7 @@ func (m ProfilePostItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProfilePostItemMutation) Tx() (*Tx, error) {
+func (m ProfileSkillMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6890,14 +17526,14 @@ func (m ProfilePostItemMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ProfilePostItem entities.
-func (m *ProfilePostItemMutation) SetID(id ulid.ID) {
+// operation is only accepted on creation of ProfileSkill entities.
+func (m *ProfileSkillMutation) SetID(id ulid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProfilePostItemMutation) ID() (id ulid.ID, exists bool) {
+func (m *ProfileSkillMutation) ID() (id ulid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6908,7 +17544,7 @@ func (m *ProfilePostItemMutation) ID() (id ulid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProfilePostItemMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+func (m *ProfileSkillMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6917,833 +17553,1154 @@ func (m *ProfilePostItemMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProfilePostItem.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ProfileSkill.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetProfileUsername sets the "profile_username" field.
-func (m *ProfilePostItemMutation) SetProfileUsername(s string) {
-	m.profile_username = &s
+// SetName sets the "name" field.
+func (m *ProfileSkillMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ProfileUsername returns the value of the "profile_username" field in the mutation.
-func (m *ProfilePostItemMutation) ProfileUsername() (r string, exists bool) {
-	v := m.profile_username
+// Name returns the value of the "name" field in the mutation.
+func (m *ProfileSkillMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProfileUsername returns the old "profile_username" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ProfileSkill entity.
+// If the ProfileSkill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldProfileUsername(ctx context.Context) (v string, err error) {
+func (m *ProfileSkillMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfileUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfileUsername requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfileUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.ProfileUsername, nil
+	return oldValue.Name, nil
 }
 
-// ResetProfileUsername resets all changes to the "profile_username" field.
-func (m *ProfilePostItemMutation) ResetProfileUsername() {
-	m.profile_username = nil
+// ResetName resets all changes to the "name" field.
+func (m *ProfileSkillMutation) ResetName() {
+	m.name = nil
 }
 
-// SetPostUrn sets the "post_urn" field.
-func (m *ProfilePostItemMutation) SetPostUrn(s string) {
-	m.post_urn = &s
+// SetEndorsementCount sets the "endorsement_count" field.
+func (m *ProfileSkillMutation) SetEndorsementCount(i int) {
+	m.endorsement_count = &i
+	m.addendorsement_count = nil
 }
 
-// PostUrn returns the value of the "post_urn" field in the mutation.
-func (m *ProfilePostItemMutation) PostUrn() (r string, exists bool) {
-	v := m.post_urn
+// EndorsementCount returns the value of the "endorsement_count" field in the mutation.
+func (m *ProfileSkillMutation) EndorsementCount() (r int, exists bool) {
+	v := m.endorsement_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndorsementCount returns the old "endorsement_count" field's value of the ProfileSkill entity.
+// If the ProfileSkill object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileSkillMutation) OldEndorsementCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndorsementCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndorsementCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndorsementCount: %w", err)
+	}
+	return oldValue.EndorsementCount, nil
+}
+
+// AddEndorsementCount adds i to the "endorsement_count" field.
+func (m *ProfileSkillMutation) AddEndorsementCount(i int) {
+	if m.addendorsement_count != nil {
+		*m.addendorsement_count += i
+	} else {
+		m.addendorsement_count = &i
+	}
+}
+
+// AddedEndorsementCount returns the value that was added to the "endorsement_count" field in this mutation.
+func (m *ProfileSkillMutation) AddedEndorsementCount() (r int, exists bool) {
+	v := m.addendorsement_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEndorsementCount resets all changes to the "endorsement_count" field.
+func (m *ProfileSkillMutation) ResetEndorsementCount() {
+	m.endorsement_count = nil
+	m.addendorsement_count = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfileSkillMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfileSkillMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPostUrn returns the old "post_urn" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldPostUrn(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPostUrn is only allowed on UpdateOne operations")
+// OldCreatedAt returns the old "created_at" field's value of the ProfileSkill entity.
+// If the ProfileSkill object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileSkillMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfileSkillMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProfileSkillMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProfileSkillMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProfileSkill entity.
+// If the ProfileSkill object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileSkillMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProfileSkillMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by id.
+func (m *ProfileSkillMutation) SetProfileID(id ulid.ID) {
+	m.profile = &id
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *ProfileSkillMutation) ClearProfile() {
+	m.clearedprofile = true
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *ProfileSkillMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileID returns the "profile" edge ID in the mutation.
+func (m *ProfileSkillMutation) ProfileID() (id ulid.ID, exists bool) {
+	if m.profile != nil {
+		return *m.profile, true
+	}
+	return
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *ProfileSkillMutation) ProfileIDs() (ids []ulid.ID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *ProfileSkillMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// Where appends a list predicates to the ProfileSkillMutation builder.
+func (m *ProfileSkillMutation) Where(ps ...predicate.ProfileSkill) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfileSkillMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfileSkillMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProfileSkill, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfileSkillMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfileSkillMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProfileSkill).
+func (m *ProfileSkillMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfileSkillMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, profileskill.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPostUrn requires an ID field in the mutation")
+	if m.endorsement_count != nil {
+		fields = append(fields, profileskill.FieldEndorsementCount)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPostUrn: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, profileskill.FieldCreatedAt)
 	}
-	return oldValue.PostUrn, nil
+	if m.updated_at != nil {
+		fields = append(fields, profileskill.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ClearPostUrn clears the value of the "post_urn" field.
-func (m *ProfilePostItemMutation) ClearPostUrn() {
-	m.post_urn = nil
-	m.clearedFields[profilepostitem.FieldPostUrn] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfileSkillMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profileskill.FieldName:
+		return m.Name()
+	case profileskill.FieldEndorsementCount:
+		return m.EndorsementCount()
+	case profileskill.FieldCreatedAt:
+		return m.CreatedAt()
+	case profileskill.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// PostUrnCleared returns if the "post_urn" field was cleared in this mutation.
-func (m *ProfilePostItemMutation) PostUrnCleared() bool {
-	_, ok := m.clearedFields[profilepostitem.FieldPostUrn]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfileSkillMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profileskill.FieldName:
+		return m.OldName(ctx)
+	case profileskill.FieldEndorsementCount:
+		return m.OldEndorsementCount(ctx)
+	case profileskill.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profileskill.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProfileSkill field %s", name)
 }
 
-// ResetPostUrn resets all changes to the "post_urn" field.
-func (m *ProfilePostItemMutation) ResetPostUrn() {
-	m.post_urn = nil
-	delete(m.clearedFields, profilepostitem.FieldPostUrn)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileSkillMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profileskill.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case profileskill.FieldEndorsementCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndorsementCount(v)
+		return nil
+	case profileskill.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profileskill.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileSkill field %s", name)
 }
 
-// SetPostURL sets the "post_url" field.
-func (m *ProfilePostItemMutation) SetPostURL(s string) {
-	m.post_url = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfileSkillMutation) AddedFields() []string {
+	var fields []string
+	if m.addendorsement_count != nil {
+		fields = append(fields, profileskill.FieldEndorsementCount)
+	}
+	return fields
 }
 
-// PostURL returns the value of the "post_url" field in the mutation.
-func (m *ProfilePostItemMutation) PostURL() (r string, exists bool) {
-	v := m.post_url
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfileSkillMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case profileskill.FieldEndorsementCount:
+		return m.AddedEndorsementCount()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldPostURL returns the old "post_url" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldPostURL(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPostURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPostURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPostURL: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileSkillMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case profileskill.FieldEndorsementCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndorsementCount(v)
+		return nil
 	}
-	return oldValue.PostURL, nil
+	return fmt.Errorf("unknown ProfileSkill numeric field %s", name)
 }
 
-// ClearPostURL clears the value of the "post_url" field.
-func (m *ProfilePostItemMutation) ClearPostURL() {
-	m.post_url = nil
-	m.clearedFields[profilepostitem.FieldPostURL] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProfileSkillMutation) ClearedFields() []string {
+	return nil
 }
 
-// PostURLCleared returns if the "post_url" field was cleared in this mutation.
-func (m *ProfilePostItemMutation) PostURLCleared() bool {
-	_, ok := m.clearedFields[profilepostitem.FieldPostURL]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProfileSkillMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetPostURL resets all changes to the "post_url" field.
-func (m *ProfilePostItemMutation) ResetPostURL() {
-	m.post_url = nil
-	delete(m.clearedFields, profilepostitem.FieldPostURL)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProfileSkillMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ProfileSkill nullable field %s", name)
 }
 
-// SetText sets the "text" field.
-func (m *ProfilePostItemMutation) SetText(s string) {
-	m.text = &s
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProfileSkillMutation) ResetField(name string) error {
+	switch name {
+	case profileskill.FieldName:
+		m.ResetName()
+		return nil
+	case profileskill.FieldEndorsementCount:
+		m.ResetEndorsementCount()
+		return nil
+	case profileskill.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case profileskill.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileSkill field %s", name)
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *ProfilePostItemMutation) Text() (r string, exists bool) {
-	v := m.text
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProfileSkillMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.profile != nil {
+		edges = append(edges, profileskill.EdgeProfile)
 	}
-	return *v, true
+	return edges
 }
 
-// OldText returns the old "text" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldText(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProfileSkillMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case profileskill.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProfileSkillMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProfileSkillMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProfileSkillMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprofile {
+		edges = append(edges, profileskill.EdgeProfile)
 	}
-	return oldValue.Text, nil
+	return edges
 }
 
-// ClearText clears the value of the "text" field.
-func (m *ProfilePostItemMutation) ClearText() {
-	m.text = nil
-	m.clearedFields[profilepostitem.FieldText] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProfileSkillMutation) EdgeCleared(name string) bool {
+	switch name {
+	case profileskill.EdgeProfile:
+		return m.clearedprofile
+	}
+	return false
 }
 
-// TextCleared returns if the "text" field was cleared in this mutation.
-func (m *ProfilePostItemMutation) TextCleared() bool {
-	_, ok := m.clearedFields[profilepostitem.FieldText]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProfileSkillMutation) ClearEdge(name string) error {
+	switch name {
+	case profileskill.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileSkill unique edge %s", name)
 }
 
-// ResetText resets all changes to the "text" field.
-func (m *ProfilePostItemMutation) ResetText() {
-	m.text = nil
-	delete(m.clearedFields, profilepostitem.FieldText)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProfileSkillMutation) ResetEdge(name string) error {
+	switch name {
+	case profileskill.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileSkill edge %s", name)
 }
 
-// SetContentType sets the "content_type" field.
-func (m *ProfilePostItemMutation) SetContentType(s string) {
-	m.content_type = &s
+// ProfileSnapshotMutation represents an operation that mutates the ProfileSnapshot nodes in the graph.
+type ProfileSnapshotMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *ulid.ID
+	fetched_at           *time.Time
+	raw_data_s3_key      *string
+	cleaned_data_s3_key  *string
+	headline             *string
+	title                *string
+	country              *string
+	city                 *string
+	positions            *[]map[string]interface{}
+	appendpositions      []map[string]interface{}
+	educations           *[]map[string]interface{}
+	appendeducations     []map[string]interface{}
+	skills               *[]map[string]interface{}
+	appendskills         []map[string]interface{}
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	profile              *ulid.ID
+	clearedprofile       bool
+	change_events        map[ulid.ID]struct{}
+	removedchange_events map[ulid.ID]struct{}
+	clearedchange_events bool
+	done                 bool
+	oldValue             func(context.Context) (*ProfileSnapshot, error)
+	predicates           []predicate.ProfileSnapshot
 }
 
-// ContentType returns the value of the "content_type" field in the mutation.
-func (m *ProfilePostItemMutation) ContentType() (r string, exists bool) {
-	v := m.content_type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+var _ ent.Mutation = (*ProfileSnapshotMutation)(nil)
 
-// OldContentType returns the old "content_type" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldContentType(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+// profilesnapshotOption allows management of the mutation configuration using functional options.
+type profilesnapshotOption func(*ProfileSnapshotMutation)
+
+// newProfileSnapshotMutation creates new mutation for the ProfileSnapshot entity.
+func newProfileSnapshotMutation(c config, op Op, opts ...profilesnapshotOption) *ProfileSnapshotMutation {
+	m := &ProfileSnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfileSnapshot,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentType requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	return m
+}
+
+// withProfileSnapshotID sets the ID field of the mutation.
+func withProfileSnapshotID(id ulid.ID) profilesnapshotOption {
+	return func(m *ProfileSnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProfileSnapshot
+		)
+		m.oldValue = func(ctx context.Context) (*ProfileSnapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProfileSnapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.ContentType, nil
 }
 
-// ClearContentType clears the value of the "content_type" field.
-func (m *ProfilePostItemMutation) ClearContentType() {
-	m.content_type = nil
-	m.clearedFields[profilepostitem.FieldContentType] = struct{}{}
+// withProfileSnapshot sets the old ProfileSnapshot of the mutation.
+func withProfileSnapshot(node *ProfileSnapshot) profilesnapshotOption {
+	return func(m *ProfileSnapshotMutation) {
+		m.oldValue = func(context.Context) (*ProfileSnapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ContentTypeCleared returns if the "content_type" field was cleared in this mutation.
-func (m *ProfilePostItemMutation) ContentTypeCleared() bool {
-	_, ok := m.clearedFields[profilepostitem.FieldContentType]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfileSnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetContentType resets all changes to the "content_type" field.
-func (m *ProfilePostItemMutation) ResetContentType() {
-	m.content_type = nil
-	delete(m.clearedFields, profilepostitem.FieldContentType)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfileSnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetIsRepost sets the "is_repost" field.
-func (m *ProfilePostItemMutation) SetIsRepost(b bool) {
-	m.is_repost = &b
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProfileSnapshot entities.
+func (m *ProfileSnapshotMutation) SetID(id ulid.ID) {
+	m.id = &id
 }
 
-// IsRepost returns the value of the "is_repost" field in the mutation.
-func (m *ProfilePostItemMutation) IsRepost() (r bool, exists bool) {
-	v := m.is_repost
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfileSnapshotMutation) ID() (id ulid.ID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldIsRepost returns the old "is_repost" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldIsRepost(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsRepost is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsRepost requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsRepost: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfileSnapshotMutation) IDs(ctx context.Context) ([]ulid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []ulid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProfileSnapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.IsRepost, nil
-}
-
-// ResetIsRepost resets all changes to the "is_repost" field.
-func (m *ProfilePostItemMutation) ResetIsRepost() {
-	m.is_repost = nil
 }
 
-// SetTotalReactions sets the "total_reactions" field.
-func (m *ProfilePostItemMutation) SetTotalReactions(i int) {
-	m.total_reactions = &i
-	m.addtotal_reactions = nil
+// SetFetchedAt sets the "fetched_at" field.
+func (m *ProfileSnapshotMutation) SetFetchedAt(t time.Time) {
+	m.fetched_at = &t
 }
 
-// TotalReactions returns the value of the "total_reactions" field in the mutation.
-func (m *ProfilePostItemMutation) TotalReactions() (r int, exists bool) {
-	v := m.total_reactions
+// FetchedAt returns the value of the "fetched_at" field in the mutation.
+func (m *ProfileSnapshotMutation) FetchedAt() (r time.Time, exists bool) {
+	v := m.fetched_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalReactions returns the old "total_reactions" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldFetchedAt returns the old "fetched_at" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldTotalReactions(ctx context.Context) (v int, err error) {
+func (m *ProfileSnapshotMutation) OldFetchedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalReactions is only allowed on UpdateOne operations")
+		return v, errors.New("OldFetchedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalReactions requires an ID field in the mutation")
+		return v, errors.New("OldFetchedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalReactions: %w", err)
-	}
-	return oldValue.TotalReactions, nil
-}
-
-// AddTotalReactions adds i to the "total_reactions" field.
-func (m *ProfilePostItemMutation) AddTotalReactions(i int) {
-	if m.addtotal_reactions != nil {
-		*m.addtotal_reactions += i
-	} else {
-		m.addtotal_reactions = &i
-	}
-}
-
-// AddedTotalReactions returns the value that was added to the "total_reactions" field in this mutation.
-func (m *ProfilePostItemMutation) AddedTotalReactions() (r int, exists bool) {
-	v := m.addtotal_reactions
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldFetchedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.FetchedAt, nil
 }
 
-// ResetTotalReactions resets all changes to the "total_reactions" field.
-func (m *ProfilePostItemMutation) ResetTotalReactions() {
-	m.total_reactions = nil
-	m.addtotal_reactions = nil
+// ResetFetchedAt resets all changes to the "fetched_at" field.
+func (m *ProfileSnapshotMutation) ResetFetchedAt() {
+	m.fetched_at = nil
 }
 
-// SetLikeCount sets the "like_count" field.
-func (m *ProfilePostItemMutation) SetLikeCount(i int) {
-	m.like_count = &i
-	m.addlike_count = nil
+// SetRawDataS3Key sets the "raw_data_s3_key" field.
+func (m *ProfileSnapshotMutation) SetRawDataS3Key(s string) {
+	m.raw_data_s3_key = &s
 }
 
-// LikeCount returns the value of the "like_count" field in the mutation.
-func (m *ProfilePostItemMutation) LikeCount() (r int, exists bool) {
-	v := m.like_count
+// RawDataS3Key returns the value of the "raw_data_s3_key" field in the mutation.
+func (m *ProfileSnapshotMutation) RawDataS3Key() (r string, exists bool) {
+	v := m.raw_data_s3_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLikeCount returns the old "like_count" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldRawDataS3Key returns the old "raw_data_s3_key" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldLikeCount(ctx context.Context) (v int, err error) {
+func (m *ProfileSnapshotMutation) OldRawDataS3Key(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLikeCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldRawDataS3Key is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLikeCount requires an ID field in the mutation")
+		return v, errors.New("OldRawDataS3Key requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLikeCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldRawDataS3Key: %w", err)
 	}
-	return oldValue.LikeCount, nil
+	return oldValue.RawDataS3Key, nil
 }
 
-// AddLikeCount adds i to the "like_count" field.
-func (m *ProfilePostItemMutation) AddLikeCount(i int) {
-	if m.addlike_count != nil {
-		*m.addlike_count += i
-	} else {
-		m.addlike_count = &i
-	}
+// ClearRawDataS3Key clears the value of the "raw_data_s3_key" field.
+func (m *ProfileSnapshotMutation) ClearRawDataS3Key() {
+	m.raw_data_s3_key = nil
+	m.clearedFields[profilesnapshot.FieldRawDataS3Key] = struct{}{}
 }
 
-// AddedLikeCount returns the value that was added to the "like_count" field in this mutation.
-func (m *ProfilePostItemMutation) AddedLikeCount() (r int, exists bool) {
-	v := m.addlike_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// RawDataS3KeyCleared returns if the "raw_data_s3_key" field was cleared in this mutation.
+func (m *ProfileSnapshotMutation) RawDataS3KeyCleared() bool {
+	_, ok := m.clearedFields[profilesnapshot.FieldRawDataS3Key]
+	return ok
 }
 
-// ResetLikeCount resets all changes to the "like_count" field.
-func (m *ProfilePostItemMutation) ResetLikeCount() {
-	m.like_count = nil
-	m.addlike_count = nil
+// ResetRawDataS3Key resets all changes to the "raw_data_s3_key" field.
+func (m *ProfileSnapshotMutation) ResetRawDataS3Key() {
+	m.raw_data_s3_key = nil
+	delete(m.clearedFields, profilesnapshot.FieldRawDataS3Key)
 }
 
-// SetCommentsCount sets the "comments_count" field.
-func (m *ProfilePostItemMutation) SetCommentsCount(i int) {
-	m.comments_count = &i
-	m.addcomments_count = nil
+// SetCleanedDataS3Key sets the "cleaned_data_s3_key" field.
+func (m *ProfileSnapshotMutation) SetCleanedDataS3Key(s string) {
+	m.cleaned_data_s3_key = &s
 }
 
-// CommentsCount returns the value of the "comments_count" field in the mutation.
-func (m *ProfilePostItemMutation) CommentsCount() (r int, exists bool) {
-	v := m.comments_count
+// CleanedDataS3Key returns the value of the "cleaned_data_s3_key" field in the mutation.
+func (m *ProfileSnapshotMutation) CleanedDataS3Key() (r string, exists bool) {
+	v := m.cleaned_data_s3_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCommentsCount returns the old "comments_count" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldCleanedDataS3Key returns the old "cleaned_data_s3_key" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldCommentsCount(ctx context.Context) (v int, err error) {
+func (m *ProfileSnapshotMutation) OldCleanedDataS3Key(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommentsCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldCleanedDataS3Key is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommentsCount requires an ID field in the mutation")
+		return v, errors.New("OldCleanedDataS3Key requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommentsCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldCleanedDataS3Key: %w", err)
 	}
-	return oldValue.CommentsCount, nil
+	return oldValue.CleanedDataS3Key, nil
 }
 
-// AddCommentsCount adds i to the "comments_count" field.
-func (m *ProfilePostItemMutation) AddCommentsCount(i int) {
-	if m.addcomments_count != nil {
-		*m.addcomments_count += i
-	} else {
-		m.addcomments_count = &i
-	}
+// ClearCleanedDataS3Key clears the value of the "cleaned_data_s3_key" field.
+func (m *ProfileSnapshotMutation) ClearCleanedDataS3Key() {
+	m.cleaned_data_s3_key = nil
+	m.clearedFields[profilesnapshot.FieldCleanedDataS3Key] = struct{}{}
 }
 
-// AddedCommentsCount returns the value that was added to the "comments_count" field in this mutation.
-func (m *ProfilePostItemMutation) AddedCommentsCount() (r int, exists bool) {
-	v := m.addcomments_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// CleanedDataS3KeyCleared returns if the "cleaned_data_s3_key" field was cleared in this mutation.
+func (m *ProfileSnapshotMutation) CleanedDataS3KeyCleared() bool {
+	_, ok := m.clearedFields[profilesnapshot.FieldCleanedDataS3Key]
+	return ok
 }
 
-// ResetCommentsCount resets all changes to the "comments_count" field.
-func (m *ProfilePostItemMutation) ResetCommentsCount() {
-	m.comments_count = nil
-	m.addcomments_count = nil
+// ResetCleanedDataS3Key resets all changes to the "cleaned_data_s3_key" field.
+func (m *ProfileSnapshotMutation) ResetCleanedDataS3Key() {
+	m.cleaned_data_s3_key = nil
+	delete(m.clearedFields, profilesnapshot.FieldCleanedDataS3Key)
 }
 
-// SetRepostsCount sets the "reposts_count" field.
-func (m *ProfilePostItemMutation) SetRepostsCount(i int) {
-	m.reposts_count = &i
-	m.addreposts_count = nil
+// SetHeadline sets the "headline" field.
+func (m *ProfileSnapshotMutation) SetHeadline(s string) {
+	m.headline = &s
 }
 
-// RepostsCount returns the value of the "reposts_count" field in the mutation.
-func (m *ProfilePostItemMutation) RepostsCount() (r int, exists bool) {
-	v := m.reposts_count
+// Headline returns the value of the "headline" field in the mutation.
+func (m *ProfileSnapshotMutation) Headline() (r string, exists bool) {
+	v := m.headline
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRepostsCount returns the old "reposts_count" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldHeadline returns the old "headline" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldRepostsCount(ctx context.Context) (v int, err error) {
+func (m *ProfileSnapshotMutation) OldHeadline(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRepostsCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldHeadline is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRepostsCount requires an ID field in the mutation")
+		return v, errors.New("OldHeadline requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRepostsCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldHeadline: %w", err)
 	}
-	return oldValue.RepostsCount, nil
+	return oldValue.Headline, nil
 }
 
-// AddRepostsCount adds i to the "reposts_count" field.
-func (m *ProfilePostItemMutation) AddRepostsCount(i int) {
-	if m.addreposts_count != nil {
-		*m.addreposts_count += i
-	} else {
-		m.addreposts_count = &i
-	}
+// ClearHeadline clears the value of the "headline" field.
+func (m *ProfileSnapshotMutation) ClearHeadline() {
+	m.headline = nil
+	m.clearedFields[profilesnapshot.FieldHeadline] = struct{}{}
 }
 
-// AddedRepostsCount returns the value that was added to the "reposts_count" field in this mutation.
-func (m *ProfilePostItemMutation) AddedRepostsCount() (r int, exists bool) {
-	v := m.addreposts_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// HeadlineCleared returns if the "headline" field was cleared in this mutation.
+func (m *ProfileSnapshotMutation) HeadlineCleared() bool {
+	_, ok := m.clearedFields[profilesnapshot.FieldHeadline]
+	return ok
 }
 
-// ResetRepostsCount resets all changes to the "reposts_count" field.
-func (m *ProfilePostItemMutation) ResetRepostsCount() {
-	m.reposts_count = nil
-	m.addreposts_count = nil
+// ResetHeadline resets all changes to the "headline" field.
+func (m *ProfileSnapshotMutation) ResetHeadline() {
+	m.headline = nil
+	delete(m.clearedFields, profilesnapshot.FieldHeadline)
 }
 
-// SetEmpathyCount sets the "empathy_count" field.
-func (m *ProfilePostItemMutation) SetEmpathyCount(i int) {
-	m.empathy_count = &i
-	m.addempathy_count = nil
+// SetTitle sets the "title" field.
+func (m *ProfileSnapshotMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// EmpathyCount returns the value of the "empathy_count" field in the mutation.
-func (m *ProfilePostItemMutation) EmpathyCount() (r int, exists bool) {
-	v := m.empathy_count
+// Title returns the value of the "title" field in the mutation.
+func (m *ProfileSnapshotMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmpathyCount returns the old "empathy_count" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldEmpathyCount(ctx context.Context) (v int, err error) {
+func (m *ProfileSnapshotMutation) OldTitle(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmpathyCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmpathyCount requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmpathyCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.EmpathyCount, nil
+	return oldValue.Title, nil
 }
 
-// AddEmpathyCount adds i to the "empathy_count" field.
-func (m *ProfilePostItemMutation) AddEmpathyCount(i int) {
-	if m.addempathy_count != nil {
-		*m.addempathy_count += i
-	} else {
-		m.addempathy_count = &i
-	}
+// ClearTitle clears the value of the "title" field.
+func (m *ProfileSnapshotMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[profilesnapshot.FieldTitle] = struct{}{}
 }
 
-// AddedEmpathyCount returns the value that was added to the "empathy_count" field in this mutation.
-func (m *ProfilePostItemMutation) AddedEmpathyCount() (r int, exists bool) {
-	v := m.addempathy_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *ProfileSnapshotMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[profilesnapshot.FieldTitle]
+	return ok
 }
 
-// ResetEmpathyCount resets all changes to the "empathy_count" field.
-func (m *ProfilePostItemMutation) ResetEmpathyCount() {
-	m.empathy_count = nil
-	m.addempathy_count = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *ProfileSnapshotMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, profilesnapshot.FieldTitle)
 }
 
-// SetPraiseCount sets the "praise_count" field.
-func (m *ProfilePostItemMutation) SetPraiseCount(i int) {
-	m.praise_count = &i
-	m.addpraise_count = nil
+// SetCountry sets the "country" field.
+func (m *ProfileSnapshotMutation) SetCountry(s string) {
+	m.country = &s
 }
 
-// PraiseCount returns the value of the "praise_count" field in the mutation.
-func (m *ProfilePostItemMutation) PraiseCount() (r int, exists bool) {
-	v := m.praise_count
+// Country returns the value of the "country" field in the mutation.
+func (m *ProfileSnapshotMutation) Country() (r string, exists bool) {
+	v := m.country
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPraiseCount returns the old "praise_count" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldCountry returns the old "country" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldPraiseCount(ctx context.Context) (v int, err error) {
+func (m *ProfileSnapshotMutation) OldCountry(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPraiseCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPraiseCount requires an ID field in the mutation")
+		return v, errors.New("OldCountry requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPraiseCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
 	}
-	return oldValue.PraiseCount, nil
+	return oldValue.Country, nil
 }
 
-// AddPraiseCount adds i to the "praise_count" field.
-func (m *ProfilePostItemMutation) AddPraiseCount(i int) {
-	if m.addpraise_count != nil {
-		*m.addpraise_count += i
-	} else {
-		m.addpraise_count = &i
-	}
+// ClearCountry clears the value of the "country" field.
+func (m *ProfileSnapshotMutation) ClearCountry() {
+	m.country = nil
+	m.clearedFields[profilesnapshot.FieldCountry] = struct{}{}
 }
 
-// AddedPraiseCount returns the value that was added to the "praise_count" field in this mutation.
-func (m *ProfilePostItemMutation) AddedPraiseCount() (r int, exists bool) {
-	v := m.addpraise_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// CountryCleared returns if the "country" field was cleared in this mutation.
+func (m *ProfileSnapshotMutation) CountryCleared() bool {
+	_, ok := m.clearedFields[profilesnapshot.FieldCountry]
+	return ok
 }
 
-// ResetPraiseCount resets all changes to the "praise_count" field.
-func (m *ProfilePostItemMutation) ResetPraiseCount() {
-	m.praise_count = nil
-	m.addpraise_count = nil
+// ResetCountry resets all changes to the "country" field.
+func (m *ProfileSnapshotMutation) ResetCountry() {
+	m.country = nil
+	delete(m.clearedFields, profilesnapshot.FieldCountry)
 }
 
-// SetFunnyCount sets the "funny_count" field.
-func (m *ProfilePostItemMutation) SetFunnyCount(i int) {
-	m.funny_count = &i
-	m.addfunny_count = nil
+// SetCity sets the "city" field.
+func (m *ProfileSnapshotMutation) SetCity(s string) {
+	m.city = &s
 }
 
-// FunnyCount returns the value of the "funny_count" field in the mutation.
-func (m *ProfilePostItemMutation) FunnyCount() (r int, exists bool) {
-	v := m.funny_count
+// City returns the value of the "city" field in the mutation.
+func (m *ProfileSnapshotMutation) City() (r string, exists bool) {
+	v := m.city
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFunnyCount returns the old "funny_count" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldCity returns the old "city" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldFunnyCount(ctx context.Context) (v int, err error) {
+func (m *ProfileSnapshotMutation) OldCity(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFunnyCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldCity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFunnyCount requires an ID field in the mutation")
+		return v, errors.New("OldCity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFunnyCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldCity: %w", err)
 	}
-	return oldValue.FunnyCount, nil
+	return oldValue.City, nil
 }
 
-// AddFunnyCount adds i to the "funny_count" field.
-func (m *ProfilePostItemMutation) AddFunnyCount(i int) {
-	if m.addfunny_count != nil {
-		*m.addfunny_count += i
-	} else {
-		m.addfunny_count = &i
-	}
+// ClearCity clears the value of the "city" field.
+func (m *ProfileSnapshotMutation) ClearCity() {
+	m.city = nil
+	m.clearedFields[profilesnapshot.FieldCity] = struct{}{}
 }
 
-// AddedFunnyCount returns the value that was added to the "funny_count" field in this mutation.
-func (m *ProfilePostItemMutation) AddedFunnyCount() (r int, exists bool) {
-	v := m.addfunny_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// CityCleared returns if the "city" field was cleared in this mutation.
+func (m *ProfileSnapshotMutation) CityCleared() bool {
+	_, ok := m.clearedFields[profilesnapshot.FieldCity]
+	return ok
 }
 
-// ResetFunnyCount resets all changes to the "funny_count" field.
-func (m *ProfilePostItemMutation) ResetFunnyCount() {
-	m.funny_count = nil
-	m.addfunny_count = nil
+// ResetCity resets all changes to the "city" field.
+func (m *ProfileSnapshotMutation) ResetCity() {
+	m.city = nil
+	delete(m.clearedFields, profilesnapshot.FieldCity)
 }
 
-// SetInterestCount sets the "interest_count" field.
-func (m *ProfilePostItemMutation) SetInterestCount(i int) {
-	m.interest_count = &i
-	m.addinterest_count = nil
+// SetPositions sets the "positions" field.
+func (m *ProfileSnapshotMutation) SetPositions(value []map[string]interface{}) {
+	m.positions = &value
+	m.appendpositions = nil
 }
 
-// InterestCount returns the value of the "interest_count" field in the mutation.
-func (m *ProfilePostItemMutation) InterestCount() (r int, exists bool) {
-	v := m.interest_count
+// Positions returns the value of the "positions" field in the mutation.
+func (m *ProfileSnapshotMutation) Positions() (r []map[string]interface{}, exists bool) {
+	v := m.positions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInterestCount returns the old "interest_count" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldPositions returns the old "positions" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldInterestCount(ctx context.Context) (v int, err error) {
+func (m *ProfileSnapshotMutation) OldPositions(ctx context.Context) (v []map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInterestCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldPositions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInterestCount requires an ID field in the mutation")
+		return v, errors.New("OldPositions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInterestCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldPositions: %w", err)
 	}
-	return oldValue.InterestCount, nil
+	return oldValue.Positions, nil
 }
 
-// AddInterestCount adds i to the "interest_count" field.
-func (m *ProfilePostItemMutation) AddInterestCount(i int) {
-	if m.addinterest_count != nil {
-		*m.addinterest_count += i
-	} else {
-		m.addinterest_count = &i
-	}
+// AppendPositions adds value to the "positions" field.
+func (m *ProfileSnapshotMutation) AppendPositions(value []map[string]interface{}) {
+	m.appendpositions = append(m.appendpositions, value...)
 }
 
-// AddedInterestCount returns the value that was added to the "interest_count" field in this mutation.
-func (m *ProfilePostItemMutation) AddedInterestCount() (r int, exists bool) {
-	v := m.addinterest_count
-	if v == nil {
-		return
+// AppendedPositions returns the list of values that were appended to the "positions" field in this mutation.
+func (m *ProfileSnapshotMutation) AppendedPositions() ([]map[string]interface{}, bool) {
+	if len(m.appendpositions) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendpositions, true
 }
 
-// ResetInterestCount resets all changes to the "interest_count" field.
-func (m *ProfilePostItemMutation) ResetInterestCount() {
-	m.interest_count = nil
-	m.addinterest_count = nil
+// ClearPositions clears the value of the "positions" field.
+func (m *ProfileSnapshotMutation) ClearPositions() {
+	m.positions = nil
+	m.appendpositions = nil
+	m.clearedFields[profilesnapshot.FieldPositions] = struct{}{}
 }
 
-// SetPostedAt sets the "posted_at" field.
-func (m *ProfilePostItemMutation) SetPostedAt(t time.Time) {
-	m.posted_at = &t
+// PositionsCleared returns if the "positions" field was cleared in this mutation.
+func (m *ProfileSnapshotMutation) PositionsCleared() bool {
+	_, ok := m.clearedFields[profilesnapshot.FieldPositions]
+	return ok
 }
 
-// PostedAt returns the value of the "posted_at" field in the mutation.
-func (m *ProfilePostItemMutation) PostedAt() (r time.Time, exists bool) {
-	v := m.posted_at
+// ResetPositions resets all changes to the "positions" field.
+func (m *ProfileSnapshotMutation) ResetPositions() {
+	m.positions = nil
+	m.appendpositions = nil
+	delete(m.clearedFields, profilesnapshot.FieldPositions)
+}
+
+// SetEducations sets the "educations" field.
+func (m *ProfileSnapshotMutation) SetEducations(value []map[string]interface{}) {
+	m.educations = &value
+	m.appendeducations = nil
+}
+
+// Educations returns the value of the "educations" field in the mutation.
+func (m *ProfileSnapshotMutation) Educations() (r []map[string]interface{}, exists bool) {
+	v := m.educations
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPostedAt returns the old "posted_at" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldEducations returns the old "educations" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldPostedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ProfileSnapshotMutation) OldEducations(ctx context.Context) (v []map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPostedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEducations is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPostedAt requires an ID field in the mutation")
+		return v, errors.New("OldEducations requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPostedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEducations: %w", err)
+	}
+	return oldValue.Educations, nil
+}
+
+// AppendEducations adds value to the "educations" field.
+func (m *ProfileSnapshotMutation) AppendEducations(value []map[string]interface{}) {
+	m.appendeducations = append(m.appendeducations, value...)
+}
+
+// AppendedEducations returns the list of values that were appended to the "educations" field in this mutation.
+func (m *ProfileSnapshotMutation) AppendedEducations() ([]map[string]interface{}, bool) {
+	if len(m.appendeducations) == 0 {
+		return nil, false
 	}
-	return oldValue.PostedAt, nil
+	return m.appendeducations, true
 }
 
-// ClearPostedAt clears the value of the "posted_at" field.
-func (m *ProfilePostItemMutation) ClearPostedAt() {
-	m.posted_at = nil
-	m.clearedFields[profilepostitem.FieldPostedAt] = struct{}{}
+// ClearEducations clears the value of the "educations" field.
+func (m *ProfileSnapshotMutation) ClearEducations() {
+	m.educations = nil
+	m.appendeducations = nil
+	m.clearedFields[profilesnapshot.FieldEducations] = struct{}{}
 }
 
-// PostedAtCleared returns if the "posted_at" field was cleared in this mutation.
-func (m *ProfilePostItemMutation) PostedAtCleared() bool {
-	_, ok := m.clearedFields[profilepostitem.FieldPostedAt]
+// EducationsCleared returns if the "educations" field was cleared in this mutation.
+func (m *ProfileSnapshotMutation) EducationsCleared() bool {
+	_, ok := m.clearedFields[profilesnapshot.FieldEducations]
 	return ok
 }
 
-// ResetPostedAt resets all changes to the "posted_at" field.
-func (m *ProfilePostItemMutation) ResetPostedAt() {
-	m.posted_at = nil
-	delete(m.clearedFields, profilepostitem.FieldPostedAt)
+// ResetEducations resets all changes to the "educations" field.
+func (m *ProfileSnapshotMutation) ResetEducations() {
+	m.educations = nil
+	m.appendeducations = nil
+	delete(m.clearedFields, profilesnapshot.FieldEducations)
 }
 
-// SetRawData sets the "raw_data" field.
-func (m *ProfilePostItemMutation) SetRawData(value map[string]interface{}) {
-	m.raw_data = &value
+// SetSkills sets the "skills" field.
+func (m *ProfileSnapshotMutation) SetSkills(value []map[string]interface{}) {
+	m.skills = &value
+	m.appendskills = nil
 }
 
-// RawData returns the value of the "raw_data" field in the mutation.
-func (m *ProfilePostItemMutation) RawData() (r map[string]interface{}, exists bool) {
-	v := m.raw_data
+// Skills returns the value of the "skills" field in the mutation.
+func (m *ProfileSnapshotMutation) Skills() (r []map[string]interface{}, exists bool) {
+	v := m.skills
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRawData returns the old "raw_data" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldSkills returns the old "skills" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldRawData(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ProfileSnapshotMutation) OldSkills(ctx context.Context) (v []map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRawData is only allowed on UpdateOne operations")
+		return v, errors.New("OldSkills is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRawData requires an ID field in the mutation")
+		return v, errors.New("OldSkills requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRawData: %w", err)
+		return v, fmt.Errorf("querying old value for OldSkills: %w", err)
 	}
-	return oldValue.RawData, nil
+	return oldValue.Skills, nil
 }
 
-// ClearRawData clears the value of the "raw_data" field.
-func (m *ProfilePostItemMutation) ClearRawData() {
-	m.raw_data = nil
-	m.clearedFields[profilepostitem.FieldRawData] = struct{}{}
+// AppendSkills adds value to the "skills" field.
+func (m *ProfileSnapshotMutation) AppendSkills(value []map[string]interface{}) {
+	m.appendskills = append(m.appendskills, value...)
 }
 
-// RawDataCleared returns if the "raw_data" field was cleared in this mutation.
-func (m *ProfilePostItemMutation) RawDataCleared() bool {
-	_, ok := m.clearedFields[profilepostitem.FieldRawData]
+// AppendedSkills returns the list of values that were appended to the "skills" field in this mutation.
+func (m *ProfileSnapshotMutation) AppendedSkills() ([]map[string]interface{}, bool) {
+	if len(m.appendskills) == 0 {
+		return nil, false
+	}
+	return m.appendskills, true
+}
+
+// ClearSkills clears the value of the "skills" field.
+func (m *ProfileSnapshotMutation) ClearSkills() {
+	m.skills = nil
+	m.appendskills = nil
+	m.clearedFields[profilesnapshot.FieldSkills] = struct{}{}
+}
+
+// SkillsCleared returns if the "skills" field was cleared in this mutation.
+func (m *ProfileSnapshotMutation) SkillsCleared() bool {
+	_, ok := m.clearedFields[profilesnapshot.FieldSkills]
 	return ok
 }
 
-// ResetRawData resets all changes to the "raw_data" field.
-func (m *ProfilePostItemMutation) ResetRawData() {
-	m.raw_data = nil
-	delete(m.clearedFields, profilepostitem.FieldRawData)
+// ResetSkills resets all changes to the "skills" field.
+func (m *ProfileSnapshotMutation) ResetSkills() {
+	m.skills = nil
+	m.appendskills = nil
+	delete(m.clearedFields, profilesnapshot.FieldSkills)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ProfilePostItemMutation) SetCreatedAt(t time.Time) {
+func (m *ProfileSnapshotMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProfilePostItemMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ProfileSnapshotMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -7751,10 +18708,10 @@ func (m *ProfilePostItemMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProfileSnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -7769,17 +18726,17 @@ func (m *ProfilePostItemMutation) OldCreatedAt(ctx context.Context) (v time.Time
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProfilePostItemMutation) ResetCreatedAt() {
+func (m *ProfileSnapshotMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ProfilePostItemMutation) SetUpdatedAt(t time.Time) {
+func (m *ProfileSnapshotMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProfilePostItemMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ProfileSnapshotMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -7787,10 +18744,10 @@ func (m *ProfilePostItemMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ProfilePostItem entity.
-// If the ProfilePostItem object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ProfileSnapshot entity.
+// If the ProfileSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfilePostItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProfileSnapshotMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -7805,679 +18762,578 @@ func (m *ProfilePostItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProfilePostItemMutation) ResetUpdatedAt() {
+func (m *ProfileSnapshotMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetProfilePostID sets the "profile_post" edge to the ProfilePost entity by id.
-func (m *ProfilePostItemMutation) SetProfilePostID(id ulid.ID) {
-	m.profile_post = &id
+// SetProfileID sets the "profile" edge to the Profile entity by id.
+func (m *ProfileSnapshotMutation) SetProfileID(id ulid.ID) {
+	m.profile = &id
 }
 
-// ClearProfilePost clears the "profile_post" edge to the ProfilePost entity.
-func (m *ProfilePostItemMutation) ClearProfilePost() {
-	m.clearedprofile_post = true
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *ProfileSnapshotMutation) ClearProfile() {
+	m.clearedprofile = true
 }
 
-// ProfilePostCleared reports if the "profile_post" edge to the ProfilePost entity was cleared.
-func (m *ProfilePostItemMutation) ProfilePostCleared() bool {
-	return m.clearedprofile_post
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *ProfileSnapshotMutation) ProfileCleared() bool {
+	return m.clearedprofile
 }
 
-// ProfilePostID returns the "profile_post" edge ID in the mutation.
-func (m *ProfilePostItemMutation) ProfilePostID() (id ulid.ID, exists bool) {
-	if m.profile_post != nil {
-		return *m.profile_post, true
+// ProfileID returns the "profile" edge ID in the mutation.
+func (m *ProfileSnapshotMutation) ProfileID() (id ulid.ID, exists bool) {
+	if m.profile != nil {
+		return *m.profile, true
 	}
 	return
 }
 
-// ProfilePostIDs returns the "profile_post" edge IDs in the mutation.
+// ProfileIDs returns the "profile" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProfilePostID instead. It exists only for internal usage by the builders.
-func (m *ProfilePostItemMutation) ProfilePostIDs() (ids []ulid.ID) {
-	if id := m.profile_post; id != nil {
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *ProfileSnapshotMutation) ProfileIDs() (ids []ulid.ID) {
+	if id := m.profile; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetProfilePost resets all changes to the "profile_post" edge.
-func (m *ProfilePostItemMutation) ResetProfilePost() {
-	m.profile_post = nil
-	m.clearedprofile_post = false
-}
-
-// Where appends a list predicates to the ProfilePostItemMutation builder.
-func (m *ProfilePostItemMutation) Where(ps ...predicate.ProfilePostItem) {
-	m.predicates = append(m.predicates, ps...)
+// ResetProfile resets all changes to the "profile" edge.
+func (m *ProfileSnapshotMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
 }
 
-// WhereP appends storage-level predicates to the ProfilePostItemMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProfilePostItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProfilePostItem, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddChangeEventIDs adds the "change_events" edge to the ProfileChangeEvent entity by ids.
+func (m *ProfileSnapshotMutation) AddChangeEventIDs(ids ...ulid.ID) {
+	if m.change_events == nil {
+		m.change_events = make(map[ulid.ID]struct{})
+	}
+	for i := range ids {
+		m.change_events[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *ProfilePostItemMutation) Op() Op {
-	return m.op
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ProfilePostItemMutation) SetOp(op Op) {
-	m.op = op
+// ClearChangeEvents clears the "change_events" edge to the ProfileChangeEvent entity.
+func (m *ProfileSnapshotMutation) ClearChangeEvents() {
+	m.clearedchange_events = true
 }
 
-// Type returns the node type of this mutation (ProfilePostItem).
-func (m *ProfilePostItemMutation) Type() string {
-	return m.typ
+// ChangeEventsCleared reports if the "change_events" edge to the ProfileChangeEvent entity was cleared.
+func (m *ProfileSnapshotMutation) ChangeEventsCleared() bool {
+	return m.clearedchange_events
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ProfilePostItemMutation) Fields() []string {
-	fields := make([]string, 0, 18)
-	if m.profile_username != nil {
-		fields = append(fields, profilepostitem.FieldProfileUsername)
-	}
-	if m.post_urn != nil {
-		fields = append(fields, profilepostitem.FieldPostUrn)
-	}
-	if m.post_url != nil {
-		fields = append(fields, profilepostitem.FieldPostURL)
-	}
-	if m.text != nil {
-		fields = append(fields, profilepostitem.FieldText)
-	}
-	if m.content_type != nil {
-		fields = append(fields, profilepostitem.FieldContentType)
-	}
-	if m.is_repost != nil {
-		fields = append(fields, profilepostitem.FieldIsRepost)
-	}
-	if m.total_reactions != nil {
-		fields = append(fields, profilepostitem.FieldTotalReactions)
-	}
-	if m.like_count != nil {
-		fields = append(fields, profilepostitem.FieldLikeCount)
-	}
-	if m.comments_count != nil {
-		fields = append(fields, profilepostitem.FieldCommentsCount)
-	}
-	if m.reposts_count != nil {
-		fields = append(fields, profilepostitem.FieldRepostsCount)
-	}
-	if m.empathy_count != nil {
-		fields = append(fields, profilepostitem.FieldEmpathyCount)
-	}
-	if m.praise_count != nil {
-		fields = append(fields, profilepostitem.FieldPraiseCount)
+// RemoveChangeEventIDs removes the "change_events" edge to the ProfileChangeEvent entity by IDs.
+func (m *ProfileSnapshotMutation) RemoveChangeEventIDs(ids ...ulid.ID) {
+	if m.removedchange_events == nil {
+		m.removedchange_events = make(map[ulid.ID]struct{})
 	}
-	if m.funny_count != nil {
-		fields = append(fields, profilepostitem.FieldFunnyCount)
-	}
-	if m.interest_count != nil {
-		fields = append(fields, profilepostitem.FieldInterestCount)
-	}
-	if m.posted_at != nil {
-		fields = append(fields, profilepostitem.FieldPostedAt)
-	}
-	if m.raw_data != nil {
-		fields = append(fields, profilepostitem.FieldRawData)
-	}
-	if m.created_at != nil {
-		fields = append(fields, profilepostitem.FieldCreatedAt)
+	for i := range ids {
+		delete(m.change_events, ids[i])
+		m.removedchange_events[ids[i]] = struct{}{}
 	}
-	if m.updated_at != nil {
-		fields = append(fields, profilepostitem.FieldUpdatedAt)
+}
+
+// RemovedChangeEvents returns the removed IDs of the "change_events" edge to the ProfileChangeEvent entity.
+func (m *ProfileSnapshotMutation) RemovedChangeEventsIDs() (ids []ulid.ID) {
+	for id := range m.removedchange_events {
+		ids = append(ids, id)
 	}
-	return fields
+	return
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ProfilePostItemMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case profilepostitem.FieldProfileUsername:
-		return m.ProfileUsername()
-	case profilepostitem.FieldPostUrn:
-		return m.PostUrn()
-	case profilepostitem.FieldPostURL:
-		return m.PostURL()
-	case profilepostitem.FieldText:
-		return m.Text()
-	case profilepostitem.FieldContentType:
-		return m.ContentType()
-	case profilepostitem.FieldIsRepost:
-		return m.IsRepost()
-	case profilepostitem.FieldTotalReactions:
-		return m.TotalReactions()
-	case profilepostitem.FieldLikeCount:
-		return m.LikeCount()
-	case profilepostitem.FieldCommentsCount:
-		return m.CommentsCount()
-	case profilepostitem.FieldRepostsCount:
-		return m.RepostsCount()
-	case profilepostitem.FieldEmpathyCount:
-		return m.EmpathyCount()
-	case profilepostitem.FieldPraiseCount:
-		return m.PraiseCount()
-	case profilepostitem.FieldFunnyCount:
-		return m.FunnyCount()
-	case profilepostitem.FieldInterestCount:
-		return m.InterestCount()
-	case profilepostitem.FieldPostedAt:
-		return m.PostedAt()
-	case profilepostitem.FieldRawData:
-		return m.RawData()
-	case profilepostitem.FieldCreatedAt:
-		return m.CreatedAt()
-	case profilepostitem.FieldUpdatedAt:
-		return m.UpdatedAt()
+// ChangeEventsIDs returns the "change_events" edge IDs in the mutation.
+func (m *ProfileSnapshotMutation) ChangeEventsIDs() (ids []ulid.ID) {
+	for id := range m.change_events {
+		ids = append(ids, id)
 	}
-	return nil, false
+	return
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ProfilePostItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case profilepostitem.FieldProfileUsername:
-		return m.OldProfileUsername(ctx)
-	case profilepostitem.FieldPostUrn:
-		return m.OldPostUrn(ctx)
-	case profilepostitem.FieldPostURL:
-		return m.OldPostURL(ctx)
-	case profilepostitem.FieldText:
-		return m.OldText(ctx)
-	case profilepostitem.FieldContentType:
-		return m.OldContentType(ctx)
-	case profilepostitem.FieldIsRepost:
-		return m.OldIsRepost(ctx)
-	case profilepostitem.FieldTotalReactions:
-		return m.OldTotalReactions(ctx)
-	case profilepostitem.FieldLikeCount:
-		return m.OldLikeCount(ctx)
-	case profilepostitem.FieldCommentsCount:
-		return m.OldCommentsCount(ctx)
-	case profilepostitem.FieldRepostsCount:
-		return m.OldRepostsCount(ctx)
-	case profilepostitem.FieldEmpathyCount:
-		return m.OldEmpathyCount(ctx)
-	case profilepostitem.FieldPraiseCount:
-		return m.OldPraiseCount(ctx)
-	case profilepostitem.FieldFunnyCount:
-		return m.OldFunnyCount(ctx)
-	case profilepostitem.FieldInterestCount:
-		return m.OldInterestCount(ctx)
-	case profilepostitem.FieldPostedAt:
-		return m.OldPostedAt(ctx)
-	case profilepostitem.FieldRawData:
-		return m.OldRawData(ctx)
-	case profilepostitem.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case profilepostitem.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown ProfilePostItem field %s", name)
+// ResetChangeEvents resets all changes to the "change_events" edge.
+func (m *ProfileSnapshotMutation) ResetChangeEvents() {
+	m.change_events = nil
+	m.clearedchange_events = false
+	m.removedchange_events = nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProfilePostItemMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case profilepostitem.FieldProfileUsername:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProfileUsername(v)
-		return nil
-	case profilepostitem.FieldPostUrn:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPostUrn(v)
-		return nil
-	case profilepostitem.FieldPostURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPostURL(v)
-		return nil
-	case profilepostitem.FieldText:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetText(v)
-		return nil
-	case profilepostitem.FieldContentType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContentType(v)
-		return nil
-	case profilepostitem.FieldIsRepost:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsRepost(v)
-		return nil
-	case profilepostitem.FieldTotalReactions:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalReactions(v)
-		return nil
-	case profilepostitem.FieldLikeCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLikeCount(v)
-		return nil
-	case profilepostitem.FieldCommentsCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCommentsCount(v)
-		return nil
-	case profilepostitem.FieldRepostsCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRepostsCount(v)
-		return nil
-	case profilepostitem.FieldEmpathyCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmpathyCount(v)
-		return nil
-	case profilepostitem.FieldPraiseCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPraiseCount(v)
-		return nil
-	case profilepostitem.FieldFunnyCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFunnyCount(v)
-		return nil
-	case profilepostitem.FieldInterestCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInterestCount(v)
-		return nil
-	case profilepostitem.FieldPostedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPostedAt(v)
-		return nil
-	case profilepostitem.FieldRawData:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRawData(v)
-		return nil
-	case profilepostitem.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case profilepostitem.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// Where appends a list predicates to the ProfileSnapshotMutation builder.
+func (m *ProfileSnapshotMutation) Where(ps ...predicate.ProfileSnapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfileSnapshotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfileSnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProfileSnapshot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return fmt.Errorf("unknown ProfilePostItem field %s", name)
+	m.Where(p...)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ProfilePostItemMutation) AddedFields() []string {
-	var fields []string
-	if m.addtotal_reactions != nil {
-		fields = append(fields, profilepostitem.FieldTotalReactions)
+// Op returns the operation name.
+func (m *ProfileSnapshotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfileSnapshotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProfileSnapshot).
+func (m *ProfileSnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfileSnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.fetched_at != nil {
+		fields = append(fields, profilesnapshot.FieldFetchedAt)
 	}
-	if m.addlike_count != nil {
-		fields = append(fields, profilepostitem.FieldLikeCount)
+	if m.raw_data_s3_key != nil {
+		fields = append(fields, profilesnapshot.FieldRawDataS3Key)
 	}
-	if m.addcomments_count != nil {
-		fields = append(fields, profilepostitem.FieldCommentsCount)
+	if m.cleaned_data_s3_key != nil {
+		fields = append(fields, profilesnapshot.FieldCleanedDataS3Key)
 	}
-	if m.addreposts_count != nil {
-		fields = append(fields, profilepostitem.FieldRepostsCount)
+	if m.headline != nil {
+		fields = append(fields, profilesnapshot.FieldHeadline)
 	}
-	if m.addempathy_count != nil {
-		fields = append(fields, profilepostitem.FieldEmpathyCount)
+	if m.title != nil {
+		fields = append(fields, profilesnapshot.FieldTitle)
 	}
-	if m.addpraise_count != nil {
-		fields = append(fields, profilepostitem.FieldPraiseCount)
+	if m.country != nil {
+		fields = append(fields, profilesnapshot.FieldCountry)
 	}
-	if m.addfunny_count != nil {
-		fields = append(fields, profilepostitem.FieldFunnyCount)
+	if m.city != nil {
+		fields = append(fields, profilesnapshot.FieldCity)
 	}
-	if m.addinterest_count != nil {
-		fields = append(fields, profilepostitem.FieldInterestCount)
+	if m.positions != nil {
+		fields = append(fields, profilesnapshot.FieldPositions)
+	}
+	if m.educations != nil {
+		fields = append(fields, profilesnapshot.FieldEducations)
+	}
+	if m.skills != nil {
+		fields = append(fields, profilesnapshot.FieldSkills)
+	}
+	if m.created_at != nil {
+		fields = append(fields, profilesnapshot.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, profilesnapshot.FieldUpdatedAt)
 	}
 	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ProfilePostItemMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case profilepostitem.FieldTotalReactions:
-		return m.AddedTotalReactions()
-	case profilepostitem.FieldLikeCount:
-		return m.AddedLikeCount()
-	case profilepostitem.FieldCommentsCount:
-		return m.AddedCommentsCount()
-	case profilepostitem.FieldRepostsCount:
-		return m.AddedRepostsCount()
-	case profilepostitem.FieldEmpathyCount:
-		return m.AddedEmpathyCount()
-	case profilepostitem.FieldPraiseCount:
-		return m.AddedPraiseCount()
-	case profilepostitem.FieldFunnyCount:
-		return m.AddedFunnyCount()
-	case profilepostitem.FieldInterestCount:
-		return m.AddedInterestCount()
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfileSnapshotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profilesnapshot.FieldFetchedAt:
+		return m.FetchedAt()
+	case profilesnapshot.FieldRawDataS3Key:
+		return m.RawDataS3Key()
+	case profilesnapshot.FieldCleanedDataS3Key:
+		return m.CleanedDataS3Key()
+	case profilesnapshot.FieldHeadline:
+		return m.Headline()
+	case profilesnapshot.FieldTitle:
+		return m.Title()
+	case profilesnapshot.FieldCountry:
+		return m.Country()
+	case profilesnapshot.FieldCity:
+		return m.City()
+	case profilesnapshot.FieldPositions:
+		return m.Positions()
+	case profilesnapshot.FieldEducations:
+		return m.Educations()
+	case profilesnapshot.FieldSkills:
+		return m.Skills()
+	case profilesnapshot.FieldCreatedAt:
+		return m.CreatedAt()
+	case profilesnapshot.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfileSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profilesnapshot.FieldFetchedAt:
+		return m.OldFetchedAt(ctx)
+	case profilesnapshot.FieldRawDataS3Key:
+		return m.OldRawDataS3Key(ctx)
+	case profilesnapshot.FieldCleanedDataS3Key:
+		return m.OldCleanedDataS3Key(ctx)
+	case profilesnapshot.FieldHeadline:
+		return m.OldHeadline(ctx)
+	case profilesnapshot.FieldTitle:
+		return m.OldTitle(ctx)
+	case profilesnapshot.FieldCountry:
+		return m.OldCountry(ctx)
+	case profilesnapshot.FieldCity:
+		return m.OldCity(ctx)
+	case profilesnapshot.FieldPositions:
+		return m.OldPositions(ctx)
+	case profilesnapshot.FieldEducations:
+		return m.OldEducations(ctx)
+	case profilesnapshot.FieldSkills:
+		return m.OldSkills(ctx)
+	case profilesnapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profilesnapshot.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProfileSnapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProfilePostItemMutation) AddField(name string, value ent.Value) error {
+func (m *ProfileSnapshotMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case profilepostitem.FieldTotalReactions:
-		v, ok := value.(int)
+	case profilesnapshot.FieldFetchedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalReactions(v)
+		m.SetFetchedAt(v)
 		return nil
-	case profilepostitem.FieldLikeCount:
-		v, ok := value.(int)
+	case profilesnapshot.FieldRawDataS3Key:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLikeCount(v)
+		m.SetRawDataS3Key(v)
 		return nil
-	case profilepostitem.FieldCommentsCount:
-		v, ok := value.(int)
+	case profilesnapshot.FieldCleanedDataS3Key:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCommentsCount(v)
+		m.SetCleanedDataS3Key(v)
 		return nil
-	case profilepostitem.FieldRepostsCount:
-		v, ok := value.(int)
+	case profilesnapshot.FieldHeadline:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRepostsCount(v)
+		m.SetHeadline(v)
 		return nil
-	case profilepostitem.FieldEmpathyCount:
-		v, ok := value.(int)
+	case profilesnapshot.FieldTitle:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddEmpathyCount(v)
+		m.SetTitle(v)
 		return nil
-	case profilepostitem.FieldPraiseCount:
-		v, ok := value.(int)
+	case profilesnapshot.FieldCountry:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPraiseCount(v)
+		m.SetCountry(v)
 		return nil
-	case profilepostitem.FieldFunnyCount:
-		v, ok := value.(int)
+	case profilesnapshot.FieldCity:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFunnyCount(v)
+		m.SetCity(v)
 		return nil
-	case profilepostitem.FieldInterestCount:
-		v, ok := value.(int)
+	case profilesnapshot.FieldPositions:
+		v, ok := value.([]map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddInterestCount(v)
+		m.SetPositions(v)
+		return nil
+	case profilesnapshot.FieldEducations:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEducations(v)
+		return nil
+	case profilesnapshot.FieldSkills:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkills(v)
+		return nil
+	case profilesnapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profilesnapshot.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProfilePostItem numeric field %s", name)
+	return fmt.Errorf("unknown ProfileSnapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfileSnapshotMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfileSnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileSnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProfileSnapshot numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProfilePostItemMutation) ClearedFields() []string {
+func (m *ProfileSnapshotMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(profilepostitem.FieldPostUrn) {
-		fields = append(fields, profilepostitem.FieldPostUrn)
+	if m.FieldCleared(profilesnapshot.FieldRawDataS3Key) {
+		fields = append(fields, profilesnapshot.FieldRawDataS3Key)
 	}
-	if m.FieldCleared(profilepostitem.FieldPostURL) {
-		fields = append(fields, profilepostitem.FieldPostURL)
+	if m.FieldCleared(profilesnapshot.FieldCleanedDataS3Key) {
+		fields = append(fields, profilesnapshot.FieldCleanedDataS3Key)
 	}
-	if m.FieldCleared(profilepostitem.FieldText) {
-		fields = append(fields, profilepostitem.FieldText)
+	if m.FieldCleared(profilesnapshot.FieldHeadline) {
+		fields = append(fields, profilesnapshot.FieldHeadline)
 	}
-	if m.FieldCleared(profilepostitem.FieldContentType) {
-		fields = append(fields, profilepostitem.FieldContentType)
+	if m.FieldCleared(profilesnapshot.FieldTitle) {
+		fields = append(fields, profilesnapshot.FieldTitle)
 	}
-	if m.FieldCleared(profilepostitem.FieldPostedAt) {
-		fields = append(fields, profilepostitem.FieldPostedAt)
+	if m.FieldCleared(profilesnapshot.FieldCountry) {
+		fields = append(fields, profilesnapshot.FieldCountry)
 	}
-	if m.FieldCleared(profilepostitem.FieldRawData) {
-		fields = append(fields, profilepostitem.FieldRawData)
+	if m.FieldCleared(profilesnapshot.FieldCity) {
+		fields = append(fields, profilesnapshot.FieldCity)
+	}
+	if m.FieldCleared(profilesnapshot.FieldPositions) {
+		fields = append(fields, profilesnapshot.FieldPositions)
+	}
+	if m.FieldCleared(profilesnapshot.FieldEducations) {
+		fields = append(fields, profilesnapshot.FieldEducations)
+	}
+	if m.FieldCleared(profilesnapshot.FieldSkills) {
+		fields = append(fields, profilesnapshot.FieldSkills)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProfilePostItemMutation) FieldCleared(name string) bool {
+func (m *ProfileSnapshotMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProfilePostItemMutation) ClearField(name string) error {
+func (m *ProfileSnapshotMutation) ClearField(name string) error {
 	switch name {
-	case profilepostitem.FieldPostUrn:
-		m.ClearPostUrn()
+	case profilesnapshot.FieldRawDataS3Key:
+		m.ClearRawDataS3Key()
 		return nil
-	case profilepostitem.FieldPostURL:
-		m.ClearPostURL()
+	case profilesnapshot.FieldCleanedDataS3Key:
+		m.ClearCleanedDataS3Key()
 		return nil
-	case profilepostitem.FieldText:
-		m.ClearText()
+	case profilesnapshot.FieldHeadline:
+		m.ClearHeadline()
 		return nil
-	case profilepostitem.FieldContentType:
-		m.ClearContentType()
+	case profilesnapshot.FieldTitle:
+		m.ClearTitle()
 		return nil
-	case profilepostitem.FieldPostedAt:
-		m.ClearPostedAt()
+	case profilesnapshot.FieldCountry:
+		m.ClearCountry()
 		return nil
-	case profilepostitem.FieldRawData:
-		m.ClearRawData()
+	case profilesnapshot.FieldCity:
+		m.ClearCity()
+		return nil
+	case profilesnapshot.FieldPositions:
+		m.ClearPositions()
+		return nil
+	case profilesnapshot.FieldEducations:
+		m.ClearEducations()
+		return nil
+	case profilesnapshot.FieldSkills:
+		m.ClearSkills()
 		return nil
 	}
-	return fmt.Errorf("unknown ProfilePostItem nullable field %s", name)
+	return fmt.Errorf("unknown ProfileSnapshot nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProfilePostItemMutation) ResetField(name string) error {
+func (m *ProfileSnapshotMutation) ResetField(name string) error {
 	switch name {
-	case profilepostitem.FieldProfileUsername:
-		m.ResetProfileUsername()
-		return nil
-	case profilepostitem.FieldPostUrn:
-		m.ResetPostUrn()
-		return nil
-	case profilepostitem.FieldPostURL:
-		m.ResetPostURL()
-		return nil
-	case profilepostitem.FieldText:
-		m.ResetText()
-		return nil
-	case profilepostitem.FieldContentType:
-		m.ResetContentType()
-		return nil
-	case profilepostitem.FieldIsRepost:
-		m.ResetIsRepost()
-		return nil
-	case profilepostitem.FieldTotalReactions:
-		m.ResetTotalReactions()
+	case profilesnapshot.FieldFetchedAt:
+		m.ResetFetchedAt()
 		return nil
-	case profilepostitem.FieldLikeCount:
-		m.ResetLikeCount()
+	case profilesnapshot.FieldRawDataS3Key:
+		m.ResetRawDataS3Key()
 		return nil
-	case profilepostitem.FieldCommentsCount:
-		m.ResetCommentsCount()
+	case profilesnapshot.FieldCleanedDataS3Key:
+		m.ResetCleanedDataS3Key()
 		return nil
-	case profilepostitem.FieldRepostsCount:
-		m.ResetRepostsCount()
+	case profilesnapshot.FieldHeadline:
+		m.ResetHeadline()
 		return nil
-	case profilepostitem.FieldEmpathyCount:
-		m.ResetEmpathyCount()
+	case profilesnapshot.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case profilepostitem.FieldPraiseCount:
-		m.ResetPraiseCount()
+	case profilesnapshot.FieldCountry:
+		m.ResetCountry()
 		return nil
-	case profilepostitem.FieldFunnyCount:
-		m.ResetFunnyCount()
+	case profilesnapshot.FieldCity:
+		m.ResetCity()
 		return nil
-	case profilepostitem.FieldInterestCount:
-		m.ResetInterestCount()
+	case profilesnapshot.FieldPositions:
+		m.ResetPositions()
 		return nil
-	case profilepostitem.FieldPostedAt:
-		m.ResetPostedAt()
+	case profilesnapshot.FieldEducations:
+		m.ResetEducations()
 		return nil
-	case profilepostitem.FieldRawData:
-		m.ResetRawData()
+	case profilesnapshot.FieldSkills:
+		m.ResetSkills()
 		return nil
-	case profilepostitem.FieldCreatedAt:
+	case profilesnapshot.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case profilepostitem.FieldUpdatedAt:
+	case profilesnapshot.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ProfilePostItem field %s", name)
+	return fmt.Errorf("unknown ProfileSnapshot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProfilePostItemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.profile_post != nil {
-		edges = append(edges, profilepostitem.EdgeProfilePost)
+func (m *ProfileSnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.profile != nil {
+		edges = append(edges, profilesnapshot.EdgeProfile)
+	}
+	if m.change_events != nil {
+		edges = append(edges, profilesnapshot.EdgeChangeEvents)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProfilePostItemMutation) AddedIDs(name string) []ent.Value {
+func (m *ProfileSnapshotMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case profilepostitem.EdgeProfilePost:
-		if id := m.profile_post; id != nil {
+	case profilesnapshot.EdgeProfile:
+		if id := m.profile; id != nil {
 			return []ent.Value{*id}
 		}
+	case profilesnapshot.EdgeChangeEvents:
+		ids := make([]ent.Value, 0, len(m.change_events))
+		for id := range m.change_events {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProfilePostItemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ProfileSnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedchange_events != nil {
+		edges = append(edges, profilesnapshot.EdgeChangeEvents)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProfilePostItemMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProfileSnapshotMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case profilesnapshot.EdgeChangeEvents:
+		ids := make([]ent.Value, 0, len(m.removedchange_events))
+		for id := range m.removedchange_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProfilePostItemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedprofile_post {
-		edges = append(edges, profilepostitem.EdgeProfilePost)
+func (m *ProfileSnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedprofile {
+		edges = append(edges, profilesnapshot.EdgeProfile)
+	}
+	if m.clearedchange_events {
+		edges = append(edges, profilesnapshot.EdgeChangeEvents)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProfilePostItemMutation) EdgeCleared(name string) bool {
+func (m *ProfileSnapshotMutation) EdgeCleared(name string) bool {
 	switch name {
-	case profilepostitem.EdgeProfilePost:
-		return m.clearedprofile_post
+	case profilesnapshot.EdgeProfile:
+		return m.clearedprofile
+	case profilesnapshot.EdgeChangeEvents:
+		return m.clearedchange_events
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProfilePostItemMutation) ClearEdge(name string) error {
+func (m *ProfileSnapshotMutation) ClearEdge(name string) error {
 	switch name {
-	case profilepostitem.EdgeProfilePost:
-		m.ClearProfilePost()
+	case profilesnapshot.EdgeProfile:
+		m.ClearProfile()
 		return nil
 	}
-	return fmt.Errorf("unknown ProfilePostItem unique edge %s", name)
+	return fmt.Errorf("unknown ProfileSnapshot unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProfilePostItemMutation) ResetEdge(name string) error {
+func (m *ProfileSnapshotMutation) ResetEdge(name string) error {
 	switch name {
-	case profilepostitem.EdgeProfilePost:
-		m.ResetProfilePost()
+	case profilesnapshot.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	case profilesnapshot.EdgeChangeEvents:
+		m.ResetChangeEvents()
 		return nil
 	}
-	return fmt.Errorf("unknown ProfilePostItem edge %s", name)
+	return fmt.Errorf("unknown ProfileSnapshot edge %s", name)
 }
 
 // TodoMutation represents an operation that mutates the Todo nodes in the graph.
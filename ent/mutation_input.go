@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/cronjobconfig"
 	"sheng-go-backend/ent/jobexecutionhistory"
+	"sheng-go-backend/ent/profilechangeevent"
 	"sheng-go-backend/ent/profileentry"
 	"sheng-go-backend/ent/profilepost"
 	"sheng-go-backend/ent/schema/ulid"
@@ -12,18 +14,339 @@ import (
 	"time"
 )
 
+// CreateAPICallLogInput represents a mutation input for creating apicalllogs.
+type CreateAPICallLogInput struct {
+	Endpoint       string
+	KeyLabel       string
+	Caller         *string
+	RunID          *string
+	Urn            *string
+	HTTPStatus     *int
+	LatencyMs      *int
+	Bytes          *int
+	Counted        *bool
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+	JobExecutionID *ulid.ID
+}
+
+// Mutate applies the CreateAPICallLogInput on the APICallLogCreate builder.
+func (i *CreateAPICallLogInput) Mutate(m *APICallLogCreate) {
+	m.SetEndpoint(i.Endpoint)
+	m.SetKeyLabel(i.KeyLabel)
+	if v := i.Caller; v != nil {
+		m.SetCaller(*v)
+	}
+	if v := i.RunID; v != nil {
+		m.SetRunID(*v)
+	}
+	if v := i.Urn; v != nil {
+		m.SetUrn(*v)
+	}
+	if v := i.HTTPStatus; v != nil {
+		m.SetHTTPStatus(*v)
+	}
+	if v := i.LatencyMs; v != nil {
+		m.SetLatencyMs(*v)
+	}
+	if v := i.Bytes; v != nil {
+		m.SetBytes(*v)
+	}
+	if v := i.Counted; v != nil {
+		m.SetCounted(*v)
+	}
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.JobExecutionID; v != nil {
+		m.SetJobExecutionID(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateAPICallLogInput on the create builder.
+func (c *APICallLogCreate) SetInput(i CreateAPICallLogInput) *APICallLogCreate {
+	i.Mutate(c)
+	return c
+}
+
+// UpdateAPICallLogInput represents a mutation input for updating apicalllogs.
+type UpdateAPICallLogInput struct {
+	ID                ulid.ID
+	Endpoint          *string
+	KeyLabel          *string
+	Caller            *string
+	RunID             *string
+	ClearRunID        bool
+	Urn               *string
+	ClearUrn          bool
+	HTTPStatus        *int
+	ClearHTTPStatus   bool
+	LatencyMs         *int
+	Bytes             *int
+	Counted           *bool
+	UpdatedAt         *time.Time
+	JobExecutionID    *ulid.ID
+	ClearJobExecution bool
+}
+
+// Mutate applies the UpdateAPICallLogInput on the APICallLogMutation.
+func (i *UpdateAPICallLogInput) Mutate(m *APICallLogMutation) {
+	if v := i.Endpoint; v != nil {
+		m.SetEndpoint(*v)
+	}
+	if v := i.KeyLabel; v != nil {
+		m.SetKeyLabel(*v)
+	}
+	if v := i.Caller; v != nil {
+		m.SetCaller(*v)
+	}
+	if i.ClearRunID {
+		m.ClearRunID()
+	}
+	if v := i.RunID; v != nil {
+		m.SetRunID(*v)
+	}
+	if i.ClearUrn {
+		m.ClearUrn()
+	}
+	if v := i.Urn; v != nil {
+		m.SetUrn(*v)
+	}
+	if i.ClearHTTPStatus {
+		m.ClearHTTPStatus()
+	}
+	if v := i.HTTPStatus; v != nil {
+		m.SetHTTPStatus(*v)
+	}
+	if v := i.LatencyMs; v != nil {
+		m.SetLatencyMs(*v)
+	}
+	if v := i.Bytes; v != nil {
+		m.SetBytes(*v)
+	}
+	if v := i.Counted; v != nil {
+		m.SetCounted(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if i.ClearJobExecution {
+		m.ClearJobExecution()
+	}
+	if v := i.JobExecutionID; v != nil {
+		m.SetJobExecutionID(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateAPICallLogInput on the update builder.
+func (u *APICallLogUpdate) SetInput(i UpdateAPICallLogInput) *APICallLogUpdate {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// SetInput applies the change-set in the UpdateAPICallLogInput on the update-one builder.
+func (u *APICallLogUpdateOne) SetInput(i UpdateAPICallLogInput) *APICallLogUpdateOne {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// CreateAPIQuotaBudgetInput represents a mutation input for creating apiquotabudgets.
+type CreateAPIQuotaBudgetInput struct {
+	Name        string
+	Percent     float64
+	Description *string
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+// Mutate applies the CreateAPIQuotaBudgetInput on the APIQuotaBudgetCreate builder.
+func (i *CreateAPIQuotaBudgetInput) Mutate(m *APIQuotaBudgetCreate) {
+	m.SetName(i.Name)
+	m.SetPercent(i.Percent)
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateAPIQuotaBudgetInput on the create builder.
+func (c *APIQuotaBudgetCreate) SetInput(i CreateAPIQuotaBudgetInput) *APIQuotaBudgetCreate {
+	i.Mutate(c)
+	return c
+}
+
+// UpdateAPIQuotaBudgetInput represents a mutation input for updating apiquotabudgets.
+type UpdateAPIQuotaBudgetInput struct {
+	ID               ulid.ID
+	Percent          *float64
+	Description      *string
+	ClearDescription bool
+	UpdatedAt        *time.Time
+}
+
+// Mutate applies the UpdateAPIQuotaBudgetInput on the APIQuotaBudgetMutation.
+func (i *UpdateAPIQuotaBudgetInput) Mutate(m *APIQuotaBudgetMutation) {
+	if v := i.Percent; v != nil {
+		m.SetPercent(*v)
+	}
+	if i.ClearDescription {
+		m.ClearDescription()
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateAPIQuotaBudgetInput on the update builder.
+func (u *APIQuotaBudgetUpdate) SetInput(i UpdateAPIQuotaBudgetInput) *APIQuotaBudgetUpdate {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// SetInput applies the change-set in the UpdateAPIQuotaBudgetInput on the update-one builder.
+func (u *APIQuotaBudgetUpdateOne) SetInput(i UpdateAPIQuotaBudgetInput) *APIQuotaBudgetUpdateOne {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// CreateAPIQuotaReservationInput represents a mutation input for creating apiquotareservations.
+type CreateAPIQuotaReservationInput struct {
+	Reserved        int
+	Used            *int
+	Status          *apiquotareservation.Status
+	ExpiresAt       time.Time
+	SettledAt       *time.Time
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+	TrackerID       ulid.ID
+	BudgetTrackerID *ulid.ID
+}
+
+// Mutate applies the CreateAPIQuotaReservationInput on the APIQuotaReservationCreate builder.
+func (i *CreateAPIQuotaReservationInput) Mutate(m *APIQuotaReservationCreate) {
+	m.SetReserved(i.Reserved)
+	if v := i.Used; v != nil {
+		m.SetUsed(*v)
+	}
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	m.SetExpiresAt(i.ExpiresAt)
+	if v := i.SettledAt; v != nil {
+		m.SetSettledAt(*v)
+	}
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	m.SetTrackerID(i.TrackerID)
+	if v := i.BudgetTrackerID; v != nil {
+		m.SetBudgetTrackerID(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateAPIQuotaReservationInput on the create builder.
+func (c *APIQuotaReservationCreate) SetInput(i CreateAPIQuotaReservationInput) *APIQuotaReservationCreate {
+	i.Mutate(c)
+	return c
+}
+
+// UpdateAPIQuotaReservationInput represents a mutation input for updating apiquotareservations.
+type UpdateAPIQuotaReservationInput struct {
+	ID                 ulid.ID
+	Used               *int
+	Status             *apiquotareservation.Status
+	ExpiresAt          *time.Time
+	SettledAt          *time.Time
+	ClearSettledAt     bool
+	UpdatedAt          *time.Time
+	TrackerID          *ulid.ID
+	ClearTracker       bool
+	BudgetTrackerID    *ulid.ID
+	ClearBudgetTracker bool
+}
+
+// Mutate applies the UpdateAPIQuotaReservationInput on the APIQuotaReservationMutation.
+func (i *UpdateAPIQuotaReservationInput) Mutate(m *APIQuotaReservationMutation) {
+	if v := i.Used; v != nil {
+		m.SetUsed(*v)
+	}
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if v := i.ExpiresAt; v != nil {
+		m.SetExpiresAt(*v)
+	}
+	if i.ClearSettledAt {
+		m.ClearSettledAt()
+	}
+	if v := i.SettledAt; v != nil {
+		m.SetSettledAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if i.ClearTracker {
+		m.ClearTracker()
+	}
+	if v := i.TrackerID; v != nil {
+		m.SetTrackerID(*v)
+	}
+	if i.ClearBudgetTracker {
+		m.ClearBudgetTracker()
+	}
+	if v := i.BudgetTrackerID; v != nil {
+		m.SetBudgetTrackerID(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateAPIQuotaReservationInput on the update builder.
+func (u *APIQuotaReservationUpdate) SetInput(i UpdateAPIQuotaReservationInput) *APIQuotaReservationUpdate {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// SetInput applies the change-set in the UpdateAPIQuotaReservationInput on the update-one builder.
+func (u *APIQuotaReservationUpdateOne) SetInput(i UpdateAPIQuotaReservationInput) *APIQuotaReservationUpdateOne {
+	i.Mutate(u.Mutation())
+	return u
+}
+
 // CreateAPIQuotaTrackerInput represents a mutation input for creating apiquotatrackers.
 type CreateAPIQuotaTrackerInput struct {
-	CreatedAt        *time.Time
-	UpdatedAt        *time.Time
-	Month            int
-	Year             int
-	CallCount        *int
-	QuotaLimit       *int
-	QuotaExceeded    *bool
-	OverrideEnabled  *bool
-	NotificationSent *bool
-	LastCallAt       *time.Time
+	CreatedAt            *time.Time
+	UpdatedAt            *time.Time
+	KeyLabel             *string
+	Budget               *string
+	Month                int
+	Year                 int
+	CallCount            *int
+	ReservedCount        *int
+	QuotaLimit           *int
+	DailyCap             *int
+	HourlyCap            *int
+	EvenPacing           *bool
+	QuotaExceeded        *bool
+	OverrideEnabled      *bool
+	NotificationSent     *bool
+	AlertedThresholds    *[]int
+	LastCallAt           *time.Time
+	RateLimitedUntil     *time.Time
+	ReservationIDs       []ulid.ID
+	BudgetReservationIDs []ulid.ID
 }
 
 // Mutate applies the CreateAPIQuotaTrackerInput on the APIQuotaTrackerCreate builder.
@@ -34,14 +357,32 @@ func (i *CreateAPIQuotaTrackerInput) Mutate(m *APIQuotaTrackerCreate) {
 	if v := i.UpdatedAt; v != nil {
 		m.SetUpdatedAt(*v)
 	}
+	if v := i.KeyLabel; v != nil {
+		m.SetKeyLabel(*v)
+	}
+	if v := i.Budget; v != nil {
+		m.SetBudget(*v)
+	}
 	m.SetMonth(i.Month)
 	m.SetYear(i.Year)
 	if v := i.CallCount; v != nil {
 		m.SetCallCount(*v)
 	}
+	if v := i.ReservedCount; v != nil {
+		m.SetReservedCount(*v)
+	}
 	if v := i.QuotaLimit; v != nil {
 		m.SetQuotaLimit(*v)
 	}
+	if v := i.DailyCap; v != nil {
+		m.SetDailyCap(*v)
+	}
+	if v := i.HourlyCap; v != nil {
+		m.SetHourlyCap(*v)
+	}
+	if v := i.EvenPacing; v != nil {
+		m.SetEvenPacing(*v)
+	}
 	if v := i.QuotaExceeded; v != nil {
 		m.SetQuotaExceeded(*v)
 	}
@@ -51,9 +392,21 @@ func (i *CreateAPIQuotaTrackerInput) Mutate(m *APIQuotaTrackerCreate) {
 	if v := i.NotificationSent; v != nil {
 		m.SetNotificationSent(*v)
 	}
+	if v := i.AlertedThresholds; v != nil {
+		m.SetAlertedThresholds(*v)
+	}
 	if v := i.LastCallAt; v != nil {
 		m.SetLastCallAt(*v)
 	}
+	if v := i.RateLimitedUntil; v != nil {
+		m.SetRateLimitedUntil(*v)
+	}
+	if ids := i.ReservationIDs; len(ids) > 0 {
+		m.AddReservationIDs(ids...)
+	}
+	if ids := i.BudgetReservationIDs; len(ids) > 0 {
+		m.AddBudgetReservationIDs(ids...)
+	}
 }
 
 // SetInput applies the change-set in the CreateAPIQuotaTrackerInput on the create builder.
@@ -64,17 +417,33 @@ func (c *APIQuotaTrackerCreate) SetInput(i CreateAPIQuotaTrackerInput) *APIQuota
 
 // UpdateAPIQuotaTrackerInput represents a mutation input for updating apiquotatrackers.
 type UpdateAPIQuotaTrackerInput struct {
-	ID               ulid.ID
-	UpdatedAt        *time.Time
-	Month            *int
-	Year             *int
-	CallCount        *int
-	QuotaLimit       *int
-	QuotaExceeded    *bool
-	OverrideEnabled  *bool
-	NotificationSent *bool
-	LastCallAt       *time.Time
-	ClearLastCallAt  bool
+	ID                         ulid.ID
+	UpdatedAt                  *time.Time
+	KeyLabel                   *string
+	Budget                     *string
+	Month                      *int
+	Year                       *int
+	CallCount                  *int
+	ReservedCount              *int
+	QuotaLimit                 *int
+	DailyCap                   *int
+	ClearDailyCap              bool
+	HourlyCap                  *int
+	ClearHourlyCap             bool
+	EvenPacing                 *bool
+	QuotaExceeded              *bool
+	OverrideEnabled            *bool
+	NotificationSent           *bool
+	AlertedThresholds          *[]int
+	ClearAlertedThresholds     bool
+	LastCallAt                 *time.Time
+	ClearLastCallAt            bool
+	RateLimitedUntil           *time.Time
+	ClearRateLimitedUntil      bool
+	AddReservationIDs          []ulid.ID
+	RemoveReservationIDs       []ulid.ID
+	AddBudgetReservationIDs    []ulid.ID
+	RemoveBudgetReservationIDs []ulid.ID
 }
 
 // Mutate applies the UpdateAPIQuotaTrackerInput on the APIQuotaTrackerMutation.
@@ -82,6 +451,12 @@ func (i *UpdateAPIQuotaTrackerInput) Mutate(m *APIQuotaTrackerMutation) {
 	if v := i.UpdatedAt; v != nil {
 		m.SetUpdatedAt(*v)
 	}
+	if v := i.KeyLabel; v != nil {
+		m.SetKeyLabel(*v)
+	}
+	if v := i.Budget; v != nil {
+		m.SetBudget(*v)
+	}
 	if v := i.Month; v != nil {
 		m.SetMonth(*v)
 	}
@@ -91,9 +466,27 @@ func (i *UpdateAPIQuotaTrackerInput) Mutate(m *APIQuotaTrackerMutation) {
 	if v := i.CallCount; v != nil {
 		m.SetCallCount(*v)
 	}
+	if v := i.ReservedCount; v != nil {
+		m.SetReservedCount(*v)
+	}
 	if v := i.QuotaLimit; v != nil {
 		m.SetQuotaLimit(*v)
 	}
+	if i.ClearDailyCap {
+		m.ClearDailyCap()
+	}
+	if v := i.DailyCap; v != nil {
+		m.SetDailyCap(*v)
+	}
+	if i.ClearHourlyCap {
+		m.ClearHourlyCap()
+	}
+	if v := i.HourlyCap; v != nil {
+		m.SetHourlyCap(*v)
+	}
+	if v := i.EvenPacing; v != nil {
+		m.SetEvenPacing(*v)
+	}
 	if v := i.QuotaExceeded; v != nil {
 		m.SetQuotaExceeded(*v)
 	}
@@ -103,12 +496,36 @@ func (i *UpdateAPIQuotaTrackerInput) Mutate(m *APIQuotaTrackerMutation) {
 	if v := i.NotificationSent; v != nil {
 		m.SetNotificationSent(*v)
 	}
+	if i.ClearAlertedThresholds {
+		m.ClearAlertedThresholds()
+	}
+	if v := i.AlertedThresholds; v != nil {
+		m.SetAlertedThresholds(*v)
+	}
 	if i.ClearLastCallAt {
 		m.ClearLastCallAt()
 	}
 	if v := i.LastCallAt; v != nil {
 		m.SetLastCallAt(*v)
 	}
+	if i.ClearRateLimitedUntil {
+		m.ClearRateLimitedUntil()
+	}
+	if v := i.RateLimitedUntil; v != nil {
+		m.SetRateLimitedUntil(*v)
+	}
+	if ids := i.AddReservationIDs; len(ids) > 0 {
+		m.AddReservationIDs(ids...)
+	}
+	if ids := i.RemoveReservationIDs; len(ids) > 0 {
+		m.RemoveReservationIDs(ids...)
+	}
+	if ids := i.AddBudgetReservationIDs; len(ids) > 0 {
+		m.AddBudgetReservationIDs(ids...)
+	}
+	if ids := i.RemoveBudgetReservationIDs; len(ids) > 0 {
+		m.RemoveBudgetReservationIDs(ids...)
+	}
 }
 
 // SetInput applies the change-set in the UpdateAPIQuotaTrackerInput on the update builder.
@@ -123,6 +540,127 @@ func (u *APIQuotaTrackerUpdateOne) SetInput(i UpdateAPIQuotaTrackerInput) *APIQu
 	return u
 }
 
+// CreateCompanyInput represents a mutation input for creating companies.
+type CreateCompanyInput struct {
+	Key                string
+	Name               string
+	NormalizedName     string
+	LinkedinUrn        *string
+	LinkedinUsername   *string
+	CreatedAt          *time.Time
+	UpdatedAt          *time.Time
+	PositionIDs        []ulid.ID
+	CurrentEmployeeIDs []ulid.ID
+	AlumniIDs          []ulid.ID
+}
+
+// Mutate applies the CreateCompanyInput on the CompanyCreate builder.
+func (i *CreateCompanyInput) Mutate(m *CompanyCreate) {
+	m.SetKey(i.Key)
+	m.SetName(i.Name)
+	m.SetNormalizedName(i.NormalizedName)
+	if v := i.LinkedinUrn; v != nil {
+		m.SetLinkedinUrn(*v)
+	}
+	if v := i.LinkedinUsername; v != nil {
+		m.SetLinkedinUsername(*v)
+	}
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if ids := i.PositionIDs; len(ids) > 0 {
+		m.AddPositionIDs(ids...)
+	}
+	if ids := i.CurrentEmployeeIDs; len(ids) > 0 {
+		m.AddCurrentEmployeeIDs(ids...)
+	}
+	if ids := i.AlumniIDs; len(ids) > 0 {
+		m.AddAlumniIDs(ids...)
+	}
+}
+
+// SetInput applies the change-set in the CreateCompanyInput on the create builder.
+func (c *CompanyCreate) SetInput(i CreateCompanyInput) *CompanyCreate {
+	i.Mutate(c)
+	return c
+}
+
+// UpdateCompanyInput represents a mutation input for updating companies.
+type UpdateCompanyInput struct {
+	ID                       ulid.ID
+	Name                     *string
+	NormalizedName           *string
+	LinkedinUrn              *string
+	ClearLinkedinUrn         bool
+	LinkedinUsername         *string
+	ClearLinkedinUsername    bool
+	UpdatedAt                *time.Time
+	AddPositionIDs           []ulid.ID
+	RemovePositionIDs        []ulid.ID
+	AddCurrentEmployeeIDs    []ulid.ID
+	RemoveCurrentEmployeeIDs []ulid.ID
+	AddAlumniIDs             []ulid.ID
+	RemoveAlumniIDs          []ulid.ID
+}
+
+// Mutate applies the UpdateCompanyInput on the CompanyMutation.
+func (i *UpdateCompanyInput) Mutate(m *CompanyMutation) {
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if v := i.NormalizedName; v != nil {
+		m.SetNormalizedName(*v)
+	}
+	if i.ClearLinkedinUrn {
+		m.ClearLinkedinUrn()
+	}
+	if v := i.LinkedinUrn; v != nil {
+		m.SetLinkedinUrn(*v)
+	}
+	if i.ClearLinkedinUsername {
+		m.ClearLinkedinUsername()
+	}
+	if v := i.LinkedinUsername; v != nil {
+		m.SetLinkedinUsername(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if ids := i.AddPositionIDs; len(ids) > 0 {
+		m.AddPositionIDs(ids...)
+	}
+	if ids := i.RemovePositionIDs; len(ids) > 0 {
+		m.RemovePositionIDs(ids...)
+	}
+	if ids := i.AddCurrentEmployeeIDs; len(ids) > 0 {
+		m.AddCurrentEmployeeIDs(ids...)
+	}
+	if ids := i.RemoveCurrentEmployeeIDs; len(ids) > 0 {
+		m.RemoveCurrentEmployeeIDs(ids...)
+	}
+	if ids := i.AddAlumniIDs; len(ids) > 0 {
+		m.AddAlumniIDs(ids...)
+	}
+	if ids := i.RemoveAlumniIDs; len(ids) > 0 {
+		m.RemoveAlumniIDs(ids...)
+	}
+}
+
+// SetInput applies the change-set in the UpdateCompanyInput on the update builder.
+func (u *CompanyUpdate) SetInput(i UpdateCompanyInput) *CompanyUpdate {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// SetInput applies the change-set in the UpdateCompanyInput on the update-one builder.
+func (u *CompanyUpdateOne) SetInput(i UpdateCompanyInput) *CompanyUpdateOne {
+	i.Mutate(u.Mutation())
+	return u
+}
+
 // CreateCronJobConfigInput represents a mutation input for creating cronjobconfigs.
 type CreateCronJobConfigInput struct {
 	CreatedAt    *time.Time
@@ -132,6 +670,7 @@ type CreateCronJobConfigInput struct {
 	Schedule     string
 	Enabled      *bool
 	BatchSize    *int
+	Concurrency  *int
 	AdminEmail   string
 	RespectQuota *bool
 	LastRunAt    *time.Time
@@ -155,6 +694,9 @@ func (i *CreateCronJobConfigInput) Mutate(m *CronJobConfigCreate) {
 	if v := i.BatchSize; v != nil {
 		m.SetBatchSize(*v)
 	}
+	if v := i.Concurrency; v != nil {
+		m.SetConcurrency(*v)
+	}
 	m.SetAdminEmail(i.AdminEmail)
 	if v := i.RespectQuota; v != nil {
 		m.SetRespectQuota(*v)
@@ -182,6 +724,7 @@ type UpdateCronJobConfigInput struct {
 	Schedule       *string
 	Enabled        *bool
 	BatchSize      *int
+	Concurrency    *int
 	AdminEmail     *string
 	RespectQuota   *bool
 	LastRunAt      *time.Time
@@ -210,6 +753,9 @@ func (i *UpdateCronJobConfigInput) Mutate(m *CronJobConfigMutation) {
 	if v := i.BatchSize; v != nil {
 		m.SetBatchSize(*v)
 	}
+	if v := i.Concurrency; v != nil {
+		m.SetConcurrency(*v)
+	}
 	if v := i.AdminEmail; v != nil {
 		m.SetAdminEmail(*v)
 	}
@@ -244,20 +790,23 @@ func (u *CronJobConfigUpdateOne) SetInput(i UpdateCronJobConfigInput) *CronJobCo
 
 // CreateJobExecutionHistoryInput represents a mutation input for creating jobexecutionhistories.
 type CreateJobExecutionHistoryInput struct {
-	CreatedAt       *time.Time
-	UpdatedAt       *time.Time
-	JobName         string
-	Status          jobexecutionhistory.Status
-	StartedAt       time.Time
-	CompletedAt     *time.Time
-	DurationSeconds *int
-	TotalProcessed  *int
-	SuccessfulCount *int
-	FailedCount     *int
-	APICallsMade    *int
-	QuotaRemaining  *int
-	ErrorSummary    *string
-	ProfileEntryIDs []ulid.ID
+	CreatedAt           *time.Time
+	UpdatedAt           *time.Time
+	JobName             string
+	Status              jobexecutionhistory.Status
+	StartedAt           time.Time
+	CompletedAt         *time.Time
+	DurationSeconds     *int
+	TotalProcessed      *int
+	SuccessfulCount     *int
+	FailedCount         *int
+	APICallsMade        *int
+	QuotaRemaining      *int
+	ErrorSummary        *string
+	SchemaDriftDetected *bool
+	SchemaDrift         *map[string]interface{}
+	ProfileEntryIDs     []ulid.ID
+	APICallIDs          []ulid.ID
 }
 
 // Mutate applies the CreateJobExecutionHistoryInput on the JobExecutionHistoryCreate builder.
@@ -295,9 +844,18 @@ func (i *CreateJobExecutionHistoryInput) Mutate(m *JobExecutionHistoryCreate) {
 	if v := i.ErrorSummary; v != nil {
 		m.SetErrorSummary(*v)
 	}
+	if v := i.SchemaDriftDetected; v != nil {
+		m.SetSchemaDriftDetected(*v)
+	}
+	if v := i.SchemaDrift; v != nil {
+		m.SetSchemaDrift(*v)
+	}
 	if ids := i.ProfileEntryIDs; len(ids) > 0 {
 		m.AddProfileEntryIDs(ids...)
 	}
+	if ids := i.APICallIDs; len(ids) > 0 {
+		m.AddAPICallIDs(ids...)
+	}
 }
 
 // SetInput applies the change-set in the CreateJobExecutionHistoryInput on the create builder.
@@ -323,8 +881,13 @@ type UpdateJobExecutionHistoryInput struct {
 	QuotaRemaining        *int
 	ErrorSummary          *string
 	ClearErrorSummary     bool
+	SchemaDriftDetected   *bool
+	SchemaDrift           *map[string]interface{}
+	ClearSchemaDrift      bool
 	AddProfileEntryIDs    []ulid.ID
 	RemoveProfileEntryIDs []ulid.ID
+	AddAPICallIDs         []ulid.ID
+	RemoveAPICallIDs      []ulid.ID
 }
 
 // Mutate applies the UpdateJobExecutionHistoryInput on the JobExecutionHistoryMutation.
@@ -371,12 +934,27 @@ func (i *UpdateJobExecutionHistoryInput) Mutate(m *JobExecutionHistoryMutation)
 	if v := i.ErrorSummary; v != nil {
 		m.SetErrorSummary(*v)
 	}
+	if v := i.SchemaDriftDetected; v != nil {
+		m.SetSchemaDriftDetected(*v)
+	}
+	if i.ClearSchemaDrift {
+		m.ClearSchemaDrift()
+	}
+	if v := i.SchemaDrift; v != nil {
+		m.SetSchemaDrift(*v)
+	}
 	if ids := i.AddProfileEntryIDs; len(ids) > 0 {
 		m.AddProfileEntryIDs(ids...)
 	}
 	if ids := i.RemoveProfileEntryIDs; len(ids) > 0 {
 		m.RemoveProfileEntryIDs(ids...)
 	}
+	if ids := i.AddAPICallIDs; len(ids) > 0 {
+		m.AddAPICallIDs(ids...)
+	}
+	if ids := i.RemoveAPICallIDs; len(ids) > 0 {
+		m.RemoveAPICallIDs(ids...)
+	}
 }
 
 // SetInput applies the change-set in the UpdateJobExecutionHistoryInput on the update builder.
@@ -393,24 +971,31 @@ func (u *JobExecutionHistoryUpdateOne) SetInput(i UpdateJobExecutionHistoryInput
 
 // CreateProfileInput represents a mutation input for creating profiles.
 type CreateProfileInput struct {
-	Urn              string
-	Username         *string
-	FirstName        *string
-	LastName         *string
-	Headline         *string
-	Title            *string
-	Country          *string
-	City             *string
-	Educations       *[]map[string]interface{}
-	Positions        *[]map[string]interface{}
-	Skills           *[]map[string]interface{}
-	GeoData          *map[string]interface{}
-	RawDataS3Key     *string
-	CleanedDataS3Key *string
-	SourceFile       *string
-	CreatedAt        *time.Time
-	UpdatedAt        *time.Time
-	ProfileEntryID   *ulid.ID
+	Urn                 string
+	Username            *string
+	FirstName           *string
+	LastName            *string
+	Headline            *string
+	Title               *string
+	Country             *string
+	City                *string
+	Educations          *[]map[string]interface{}
+	Positions           *[]map[string]interface{}
+	Skills              *[]map[string]interface{}
+	GeoData             *map[string]interface{}
+	RawDataS3Key        *string
+	CleanedDataS3Key    *string
+	SourceFile          *string
+	CreatedAt           *time.Time
+	UpdatedAt           *time.Time
+	ProfileEntryID      *ulid.ID
+	SnapshotIDs         []ulid.ID
+	ChangeEventIDs      []ulid.ID
+	ProfilePositionIDs  []ulid.ID
+	ProfileEducationIDs []ulid.ID
+	ProfileSkillIDs     []ulid.ID
+	CurrentCompanyIDs   []ulid.ID
+	PastCompanyIDs      []ulid.ID
 }
 
 // Mutate applies the CreateProfileInput on the ProfileCreate builder.
@@ -467,6 +1052,27 @@ func (i *CreateProfileInput) Mutate(m *ProfileCreate) {
 	if v := i.ProfileEntryID; v != nil {
 		m.SetProfileEntryID(*v)
 	}
+	if ids := i.SnapshotIDs; len(ids) > 0 {
+		m.AddSnapshotIDs(ids...)
+	}
+	if ids := i.ChangeEventIDs; len(ids) > 0 {
+		m.AddChangeEventIDs(ids...)
+	}
+	if ids := i.ProfilePositionIDs; len(ids) > 0 {
+		m.AddProfilePositionIDs(ids...)
+	}
+	if ids := i.ProfileEducationIDs; len(ids) > 0 {
+		m.AddProfileEducationIDs(ids...)
+	}
+	if ids := i.ProfileSkillIDs; len(ids) > 0 {
+		m.AddProfileSkillIDs(ids...)
+	}
+	if ids := i.CurrentCompanyIDs; len(ids) > 0 {
+		m.AddCurrentCompanyIDs(ids...)
+	}
+	if ids := i.PastCompanyIDs; len(ids) > 0 {
+		m.AddPastCompanyIDs(ids...)
+	}
 }
 
 // SetInput applies the change-set in the CreateProfileInput on the create builder.
@@ -477,39 +1083,53 @@ func (c *ProfileCreate) SetInput(i CreateProfileInput) *ProfileCreate {
 
 // UpdateProfileInput represents a mutation input for updating profiles.
 type UpdateProfileInput struct {
-	ID                    ulid.ID
-	Urn                   *string
-	Username              *string
-	ClearUsername         bool
-	FirstName             *string
-	ClearFirstName        bool
-	LastName              *string
-	ClearLastName         bool
-	Headline              *string
-	ClearHeadline         bool
-	Title                 *string
-	ClearTitle            bool
-	Country               *string
-	ClearCountry          bool
-	City                  *string
-	ClearCity             bool
-	Educations            *[]map[string]interface{}
-	ClearEducations       bool
-	Positions             *[]map[string]interface{}
-	ClearPositions        bool
-	Skills                *[]map[string]interface{}
-	ClearSkills           bool
-	GeoData               *map[string]interface{}
-	ClearGeoData          bool
-	RawDataS3Key          *string
-	ClearRawDataS3Key     bool
-	CleanedDataS3Key      *string
-	ClearCleanedDataS3Key bool
-	SourceFile            *string
-	ClearSourceFile       bool
-	UpdatedAt             *time.Time
-	ProfileEntryID        *ulid.ID
-	ClearProfileEntry     bool
+	ID                        ulid.ID
+	Urn                       *string
+	Username                  *string
+	ClearUsername             bool
+	FirstName                 *string
+	ClearFirstName            bool
+	LastName                  *string
+	ClearLastName             bool
+	Headline                  *string
+	ClearHeadline             bool
+	Title                     *string
+	ClearTitle                bool
+	Country                   *string
+	ClearCountry              bool
+	City                      *string
+	ClearCity                 bool
+	Educations                *[]map[string]interface{}
+	ClearEducations           bool
+	Positions                 *[]map[string]interface{}
+	ClearPositions            bool
+	Skills                    *[]map[string]interface{}
+	ClearSkills               bool
+	GeoData                   *map[string]interface{}
+	ClearGeoData              bool
+	RawDataS3Key              *string
+	ClearRawDataS3Key         bool
+	CleanedDataS3Key          *string
+	ClearCleanedDataS3Key     bool
+	SourceFile                *string
+	ClearSourceFile           bool
+	UpdatedAt                 *time.Time
+	ProfileEntryID            *ulid.ID
+	ClearProfileEntry         bool
+	AddSnapshotIDs            []ulid.ID
+	RemoveSnapshotIDs         []ulid.ID
+	AddChangeEventIDs         []ulid.ID
+	RemoveChangeEventIDs      []ulid.ID
+	AddProfilePositionIDs     []ulid.ID
+	RemoveProfilePositionIDs  []ulid.ID
+	AddProfileEducationIDs    []ulid.ID
+	RemoveProfileEducationIDs []ulid.ID
+	AddProfileSkillIDs        []ulid.ID
+	RemoveProfileSkillIDs     []ulid.ID
+	AddCurrentCompanyIDs      []ulid.ID
+	RemoveCurrentCompanyIDs   []ulid.ID
+	AddPastCompanyIDs         []ulid.ID
+	RemovePastCompanyIDs      []ulid.ID
 }
 
 // Mutate applies the UpdateProfileInput on the ProfileMutation.
@@ -565,61 +1185,365 @@ func (i *UpdateProfileInput) Mutate(m *ProfileMutation) {
 	if v := i.Educations; v != nil {
 		m.SetEducations(*v)
 	}
-	if i.ClearPositions {
-		m.ClearPositions()
+	if i.ClearPositions {
+		m.ClearPositions()
+	}
+	if v := i.Positions; v != nil {
+		m.SetPositions(*v)
+	}
+	if i.ClearSkills {
+		m.ClearSkills()
+	}
+	if v := i.Skills; v != nil {
+		m.SetSkills(*v)
+	}
+	if i.ClearGeoData {
+		m.ClearGeoData()
+	}
+	if v := i.GeoData; v != nil {
+		m.SetGeoData(*v)
+	}
+	if i.ClearRawDataS3Key {
+		m.ClearRawDataS3Key()
+	}
+	if v := i.RawDataS3Key; v != nil {
+		m.SetRawDataS3Key(*v)
+	}
+	if i.ClearCleanedDataS3Key {
+		m.ClearCleanedDataS3Key()
+	}
+	if v := i.CleanedDataS3Key; v != nil {
+		m.SetCleanedDataS3Key(*v)
+	}
+	if i.ClearSourceFile {
+		m.ClearSourceFile()
+	}
+	if v := i.SourceFile; v != nil {
+		m.SetSourceFile(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if i.ClearProfileEntry {
+		m.ClearProfileEntry()
+	}
+	if v := i.ProfileEntryID; v != nil {
+		m.SetProfileEntryID(*v)
+	}
+	if ids := i.AddSnapshotIDs; len(ids) > 0 {
+		m.AddSnapshotIDs(ids...)
+	}
+	if ids := i.RemoveSnapshotIDs; len(ids) > 0 {
+		m.RemoveSnapshotIDs(ids...)
+	}
+	if ids := i.AddChangeEventIDs; len(ids) > 0 {
+		m.AddChangeEventIDs(ids...)
+	}
+	if ids := i.RemoveChangeEventIDs; len(ids) > 0 {
+		m.RemoveChangeEventIDs(ids...)
+	}
+	if ids := i.AddProfilePositionIDs; len(ids) > 0 {
+		m.AddProfilePositionIDs(ids...)
+	}
+	if ids := i.RemoveProfilePositionIDs; len(ids) > 0 {
+		m.RemoveProfilePositionIDs(ids...)
+	}
+	if ids := i.AddProfileEducationIDs; len(ids) > 0 {
+		m.AddProfileEducationIDs(ids...)
+	}
+	if ids := i.RemoveProfileEducationIDs; len(ids) > 0 {
+		m.RemoveProfileEducationIDs(ids...)
+	}
+	if ids := i.AddProfileSkillIDs; len(ids) > 0 {
+		m.AddProfileSkillIDs(ids...)
+	}
+	if ids := i.RemoveProfileSkillIDs; len(ids) > 0 {
+		m.RemoveProfileSkillIDs(ids...)
+	}
+	if ids := i.AddCurrentCompanyIDs; len(ids) > 0 {
+		m.AddCurrentCompanyIDs(ids...)
+	}
+	if ids := i.RemoveCurrentCompanyIDs; len(ids) > 0 {
+		m.RemoveCurrentCompanyIDs(ids...)
+	}
+	if ids := i.AddPastCompanyIDs; len(ids) > 0 {
+		m.AddPastCompanyIDs(ids...)
+	}
+	if ids := i.RemovePastCompanyIDs; len(ids) > 0 {
+		m.RemovePastCompanyIDs(ids...)
+	}
+}
+
+// SetInput applies the change-set in the UpdateProfileInput on the update builder.
+func (u *ProfileUpdate) SetInput(i UpdateProfileInput) *ProfileUpdate {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// SetInput applies the change-set in the UpdateProfileInput on the update-one builder.
+func (u *ProfileUpdateOne) SetInput(i UpdateProfileInput) *ProfileUpdateOne {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// CreateProfileChangeEventInput represents a mutation input for creating profilechangeevents.
+type CreateProfileChangeEventInput struct {
+	Type              profilechangeevent.Type
+	OldValue          *string
+	NewValue          *string
+	DetectedAt        time.Time
+	DeliveryStatus    *profilechangeevent.DeliveryStatus
+	DeliveryAttempts  *int
+	NextDeliveryAt    *time.Time
+	DeliveredAt       *time.Time
+	LastDeliveryError *string
+	CreatedAt         *time.Time
+	UpdatedAt         *time.Time
+	ProfileID         ulid.ID
+	SnapshotID        *ulid.ID
+}
+
+// Mutate applies the CreateProfileChangeEventInput on the ProfileChangeEventCreate builder.
+func (i *CreateProfileChangeEventInput) Mutate(m *ProfileChangeEventCreate) {
+	m.SetType(i.Type)
+	if v := i.OldValue; v != nil {
+		m.SetOldValue(*v)
+	}
+	if v := i.NewValue; v != nil {
+		m.SetNewValue(*v)
+	}
+	m.SetDetectedAt(i.DetectedAt)
+	if v := i.DeliveryStatus; v != nil {
+		m.SetDeliveryStatus(*v)
+	}
+	if v := i.DeliveryAttempts; v != nil {
+		m.SetDeliveryAttempts(*v)
+	}
+	if v := i.NextDeliveryAt; v != nil {
+		m.SetNextDeliveryAt(*v)
+	}
+	if v := i.DeliveredAt; v != nil {
+		m.SetDeliveredAt(*v)
+	}
+	if v := i.LastDeliveryError; v != nil {
+		m.SetLastDeliveryError(*v)
+	}
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	m.SetProfileID(i.ProfileID)
+	if v := i.SnapshotID; v != nil {
+		m.SetSnapshotID(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateProfileChangeEventInput on the create builder.
+func (c *ProfileChangeEventCreate) SetInput(i CreateProfileChangeEventInput) *ProfileChangeEventCreate {
+	i.Mutate(c)
+	return c
+}
+
+// UpdateProfileChangeEventInput represents a mutation input for updating profilechangeevents.
+type UpdateProfileChangeEventInput struct {
+	ID                     ulid.ID
+	DeliveryStatus         *profilechangeevent.DeliveryStatus
+	DeliveryAttempts       *int
+	NextDeliveryAt         *time.Time
+	ClearNextDeliveryAt    bool
+	DeliveredAt            *time.Time
+	ClearDeliveredAt       bool
+	LastDeliveryError      *string
+	ClearLastDeliveryError bool
+	UpdatedAt              *time.Time
+	ProfileID              *ulid.ID
+	ClearProfile           bool
+	SnapshotID             *ulid.ID
+	ClearSnapshot          bool
+}
+
+// Mutate applies the UpdateProfileChangeEventInput on the ProfileChangeEventMutation.
+func (i *UpdateProfileChangeEventInput) Mutate(m *ProfileChangeEventMutation) {
+	if v := i.DeliveryStatus; v != nil {
+		m.SetDeliveryStatus(*v)
+	}
+	if v := i.DeliveryAttempts; v != nil {
+		m.SetDeliveryAttempts(*v)
+	}
+	if i.ClearNextDeliveryAt {
+		m.ClearNextDeliveryAt()
+	}
+	if v := i.NextDeliveryAt; v != nil {
+		m.SetNextDeliveryAt(*v)
+	}
+	if i.ClearDeliveredAt {
+		m.ClearDeliveredAt()
+	}
+	if v := i.DeliveredAt; v != nil {
+		m.SetDeliveredAt(*v)
+	}
+	if i.ClearLastDeliveryError {
+		m.ClearLastDeliveryError()
+	}
+	if v := i.LastDeliveryError; v != nil {
+		m.SetLastDeliveryError(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if i.ClearProfile {
+		m.ClearProfile()
+	}
+	if v := i.ProfileID; v != nil {
+		m.SetProfileID(*v)
+	}
+	if i.ClearSnapshot {
+		m.ClearSnapshot()
+	}
+	if v := i.SnapshotID; v != nil {
+		m.SetSnapshotID(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateProfileChangeEventInput on the update builder.
+func (u *ProfileChangeEventUpdate) SetInput(i UpdateProfileChangeEventInput) *ProfileChangeEventUpdate {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// SetInput applies the change-set in the UpdateProfileChangeEventInput on the update-one builder.
+func (u *ProfileChangeEventUpdateOne) SetInput(i UpdateProfileChangeEventInput) *ProfileChangeEventUpdateOne {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// CreateProfileEducationInput represents a mutation input for creating profileeducations.
+type CreateProfileEducationInput struct {
+	EducationIndex int
+	SchoolName     string
+	SchoolID       *string
+	Degree         *string
+	FieldOfStudy   *string
+	StartDate      *time.Time
+	EndDate        *time.Time
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+	ProfileID      ulid.ID
+}
+
+// Mutate applies the CreateProfileEducationInput on the ProfileEducationCreate builder.
+func (i *CreateProfileEducationInput) Mutate(m *ProfileEducationCreate) {
+	m.SetEducationIndex(i.EducationIndex)
+	m.SetSchoolName(i.SchoolName)
+	if v := i.SchoolID; v != nil {
+		m.SetSchoolID(*v)
+	}
+	if v := i.Degree; v != nil {
+		m.SetDegree(*v)
+	}
+	if v := i.FieldOfStudy; v != nil {
+		m.SetFieldOfStudy(*v)
+	}
+	if v := i.StartDate; v != nil {
+		m.SetStartDate(*v)
+	}
+	if v := i.EndDate; v != nil {
+		m.SetEndDate(*v)
+	}
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	m.SetProfileID(i.ProfileID)
+}
+
+// SetInput applies the change-set in the CreateProfileEducationInput on the create builder.
+func (c *ProfileEducationCreate) SetInput(i CreateProfileEducationInput) *ProfileEducationCreate {
+	i.Mutate(c)
+	return c
+}
+
+// UpdateProfileEducationInput represents a mutation input for updating profileeducations.
+type UpdateProfileEducationInput struct {
+	ID                ulid.ID
+	EducationIndex    *int
+	SchoolName        *string
+	SchoolID          *string
+	ClearSchoolID     bool
+	Degree            *string
+	ClearDegree       bool
+	FieldOfStudy      *string
+	ClearFieldOfStudy bool
+	StartDate         *time.Time
+	ClearStartDate    bool
+	EndDate           *time.Time
+	ClearEndDate      bool
+	UpdatedAt         *time.Time
+	ProfileID         *ulid.ID
+	ClearProfile      bool
+}
+
+// Mutate applies the UpdateProfileEducationInput on the ProfileEducationMutation.
+func (i *UpdateProfileEducationInput) Mutate(m *ProfileEducationMutation) {
+	if v := i.EducationIndex; v != nil {
+		m.SetEducationIndex(*v)
 	}
-	if v := i.Positions; v != nil {
-		m.SetPositions(*v)
+	if v := i.SchoolName; v != nil {
+		m.SetSchoolName(*v)
 	}
-	if i.ClearSkills {
-		m.ClearSkills()
+	if i.ClearSchoolID {
+		m.ClearSchoolID()
 	}
-	if v := i.Skills; v != nil {
-		m.SetSkills(*v)
+	if v := i.SchoolID; v != nil {
+		m.SetSchoolID(*v)
 	}
-	if i.ClearGeoData {
-		m.ClearGeoData()
+	if i.ClearDegree {
+		m.ClearDegree()
 	}
-	if v := i.GeoData; v != nil {
-		m.SetGeoData(*v)
+	if v := i.Degree; v != nil {
+		m.SetDegree(*v)
 	}
-	if i.ClearRawDataS3Key {
-		m.ClearRawDataS3Key()
+	if i.ClearFieldOfStudy {
+		m.ClearFieldOfStudy()
 	}
-	if v := i.RawDataS3Key; v != nil {
-		m.SetRawDataS3Key(*v)
+	if v := i.FieldOfStudy; v != nil {
+		m.SetFieldOfStudy(*v)
 	}
-	if i.ClearCleanedDataS3Key {
-		m.ClearCleanedDataS3Key()
+	if i.ClearStartDate {
+		m.ClearStartDate()
 	}
-	if v := i.CleanedDataS3Key; v != nil {
-		m.SetCleanedDataS3Key(*v)
+	if v := i.StartDate; v != nil {
+		m.SetStartDate(*v)
 	}
-	if i.ClearSourceFile {
-		m.ClearSourceFile()
+	if i.ClearEndDate {
+		m.ClearEndDate()
 	}
-	if v := i.SourceFile; v != nil {
-		m.SetSourceFile(*v)
+	if v := i.EndDate; v != nil {
+		m.SetEndDate(*v)
 	}
 	if v := i.UpdatedAt; v != nil {
 		m.SetUpdatedAt(*v)
 	}
-	if i.ClearProfileEntry {
-		m.ClearProfileEntry()
+	if i.ClearProfile {
+		m.ClearProfile()
 	}
-	if v := i.ProfileEntryID; v != nil {
-		m.SetProfileEntryID(*v)
+	if v := i.ProfileID; v != nil {
+		m.SetProfileID(*v)
 	}
 }
 
-// SetInput applies the change-set in the UpdateProfileInput on the update builder.
-func (u *ProfileUpdate) SetInput(i UpdateProfileInput) *ProfileUpdate {
+// SetInput applies the change-set in the UpdateProfileEducationInput on the update builder.
+func (u *ProfileEducationUpdate) SetInput(i UpdateProfileEducationInput) *ProfileEducationUpdate {
 	i.Mutate(u.Mutation())
 	return u
 }
 
-// SetInput applies the change-set in the UpdateProfileInput on the update-one builder.
-func (u *ProfileUpdateOne) SetInput(i UpdateProfileInput) *ProfileUpdateOne {
+// SetInput applies the change-set in the UpdateProfileEducationInput on the update-one builder.
+func (u *ProfileEducationUpdateOne) SetInput(i UpdateProfileEducationInput) *ProfileEducationUpdateOne {
 	i.Mutate(u.Mutation())
 	return u
 }
@@ -637,6 +1561,10 @@ type CreateProfileEntryInput struct {
 	FetchCount        *int
 	LastFetchedAt     *time.Time
 	ErrorMessage      *string
+	AttemptCount      *int
+	NextRetryAt       *time.Time
+	LeaseOwner        *string
+	LeaseExpiresAt    *time.Time
 	ProfileID         *ulid.ID
 	JobExecutionIDs   []ulid.ID
 }
@@ -674,6 +1602,18 @@ func (i *CreateProfileEntryInput) Mutate(m *ProfileEntryCreate) {
 	if v := i.ErrorMessage; v != nil {
 		m.SetErrorMessage(*v)
 	}
+	if v := i.AttemptCount; v != nil {
+		m.SetAttemptCount(*v)
+	}
+	if v := i.NextRetryAt; v != nil {
+		m.SetNextRetryAt(*v)
+	}
+	if v := i.LeaseOwner; v != nil {
+		m.SetLeaseOwner(*v)
+	}
+	if v := i.LeaseExpiresAt; v != nil {
+		m.SetLeaseExpiresAt(*v)
+	}
 	if v := i.ProfileID; v != nil {
 		m.SetProfileID(*v)
 	}
@@ -707,6 +1647,13 @@ type UpdateProfileEntryInput struct {
 	ClearLastFetchedAt     bool
 	ErrorMessage           *string
 	ClearErrorMessage      bool
+	AttemptCount           *int
+	NextRetryAt            *time.Time
+	ClearNextRetryAt       bool
+	LeaseOwner             *string
+	ClearLeaseOwner        bool
+	LeaseExpiresAt         *time.Time
+	ClearLeaseExpiresAt    bool
 	ProfileID              *ulid.ID
 	ClearProfile           bool
 	AddJobExecutionIDs     []ulid.ID
@@ -763,6 +1710,27 @@ func (i *UpdateProfileEntryInput) Mutate(m *ProfileEntryMutation) {
 	if v := i.ErrorMessage; v != nil {
 		m.SetErrorMessage(*v)
 	}
+	if v := i.AttemptCount; v != nil {
+		m.SetAttemptCount(*v)
+	}
+	if i.ClearNextRetryAt {
+		m.ClearNextRetryAt()
+	}
+	if v := i.NextRetryAt; v != nil {
+		m.SetNextRetryAt(*v)
+	}
+	if i.ClearLeaseOwner {
+		m.ClearLeaseOwner()
+	}
+	if v := i.LeaseOwner; v != nil {
+		m.SetLeaseOwner(*v)
+	}
+	if i.ClearLeaseExpiresAt {
+		m.ClearLeaseExpiresAt()
+	}
+	if v := i.LeaseExpiresAt; v != nil {
+		m.SetLeaseExpiresAt(*v)
+	}
 	if i.ClearProfile {
 		m.ClearProfile()
 	}
@@ -789,6 +1757,197 @@ func (u *ProfileEntryUpdateOne) SetInput(i UpdateProfileEntryInput) *ProfileEntr
 	return u
 }
 
+// CreateProfilePositionInput represents a mutation input for creating profilepositions.
+type CreateProfilePositionInput struct {
+	PositionIndex   int
+	CompanyName     *string
+	CompanyUrn      *string
+	CompanyUsername *string
+	Title           *string
+	Location        *string
+	EmploymentType  *string
+	Description     *string
+	StartDate       *time.Time
+	EndDate         *time.Time
+	IsCurrent       *bool
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+	ProfileID       ulid.ID
+	CompanyID       *ulid.ID
+}
+
+// Mutate applies the CreateProfilePositionInput on the ProfilePositionCreate builder.
+func (i *CreateProfilePositionInput) Mutate(m *ProfilePositionCreate) {
+	m.SetPositionIndex(i.PositionIndex)
+	if v := i.CompanyName; v != nil {
+		m.SetCompanyName(*v)
+	}
+	if v := i.CompanyUrn; v != nil {
+		m.SetCompanyUrn(*v)
+	}
+	if v := i.CompanyUsername; v != nil {
+		m.SetCompanyUsername(*v)
+	}
+	if v := i.Title; v != nil {
+		m.SetTitle(*v)
+	}
+	if v := i.Location; v != nil {
+		m.SetLocation(*v)
+	}
+	if v := i.EmploymentType; v != nil {
+		m.SetEmploymentType(*v)
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.StartDate; v != nil {
+		m.SetStartDate(*v)
+	}
+	if v := i.EndDate; v != nil {
+		m.SetEndDate(*v)
+	}
+	if v := i.IsCurrent; v != nil {
+		m.SetIsCurrent(*v)
+	}
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	m.SetProfileID(i.ProfileID)
+	if v := i.CompanyID; v != nil {
+		m.SetCompanyID(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateProfilePositionInput on the create builder.
+func (c *ProfilePositionCreate) SetInput(i CreateProfilePositionInput) *ProfilePositionCreate {
+	i.Mutate(c)
+	return c
+}
+
+// UpdateProfilePositionInput represents a mutation input for updating profilepositions.
+type UpdateProfilePositionInput struct {
+	ID                   ulid.ID
+	PositionIndex        *int
+	CompanyName          *string
+	ClearCompanyName     bool
+	CompanyUrn           *string
+	ClearCompanyUrn      bool
+	CompanyUsername      *string
+	ClearCompanyUsername bool
+	Title                *string
+	ClearTitle           bool
+	Location             *string
+	ClearLocation        bool
+	EmploymentType       *string
+	ClearEmploymentType  bool
+	Description          *string
+	ClearDescription     bool
+	StartDate            *time.Time
+	ClearStartDate       bool
+	EndDate              *time.Time
+	ClearEndDate         bool
+	IsCurrent            *bool
+	UpdatedAt            *time.Time
+	ProfileID            *ulid.ID
+	ClearProfile         bool
+	CompanyID            *ulid.ID
+	ClearCompany         bool
+}
+
+// Mutate applies the UpdateProfilePositionInput on the ProfilePositionMutation.
+func (i *UpdateProfilePositionInput) Mutate(m *ProfilePositionMutation) {
+	if v := i.PositionIndex; v != nil {
+		m.SetPositionIndex(*v)
+	}
+	if i.ClearCompanyName {
+		m.ClearCompanyName()
+	}
+	if v := i.CompanyName; v != nil {
+		m.SetCompanyName(*v)
+	}
+	if i.ClearCompanyUrn {
+		m.ClearCompanyUrn()
+	}
+	if v := i.CompanyUrn; v != nil {
+		m.SetCompanyUrn(*v)
+	}
+	if i.ClearCompanyUsername {
+		m.ClearCompanyUsername()
+	}
+	if v := i.CompanyUsername; v != nil {
+		m.SetCompanyUsername(*v)
+	}
+	if i.ClearTitle {
+		m.ClearTitle()
+	}
+	if v := i.Title; v != nil {
+		m.SetTitle(*v)
+	}
+	if i.ClearLocation {
+		m.ClearLocation()
+	}
+	if v := i.Location; v != nil {
+		m.SetLocation(*v)
+	}
+	if i.ClearEmploymentType {
+		m.ClearEmploymentType()
+	}
+	if v := i.EmploymentType; v != nil {
+		m.SetEmploymentType(*v)
+	}
+	if i.ClearDescription {
+		m.ClearDescription()
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if i.ClearStartDate {
+		m.ClearStartDate()
+	}
+	if v := i.StartDate; v != nil {
+		m.SetStartDate(*v)
+	}
+	if i.ClearEndDate {
+		m.ClearEndDate()
+	}
+	if v := i.EndDate; v != nil {
+		m.SetEndDate(*v)
+	}
+	if v := i.IsCurrent; v != nil {
+		m.SetIsCurrent(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if i.ClearProfile {
+		m.ClearProfile()
+	}
+	if v := i.ProfileID; v != nil {
+		m.SetProfileID(*v)
+	}
+	if i.ClearCompany {
+		m.ClearCompany()
+	}
+	if v := i.CompanyID; v != nil {
+		m.SetCompanyID(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateProfilePositionInput on the update builder.
+func (u *ProfilePositionUpdate) SetInput(i UpdateProfilePositionInput) *ProfilePositionUpdate {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// SetInput applies the change-set in the UpdateProfilePositionInput on the update-one builder.
+func (u *ProfilePositionUpdateOne) SetInput(i UpdateProfilePositionInput) *ProfilePositionUpdateOne {
+	i.Mutate(u.Mutation())
+	return u
+}
+
 // CreateProfilePostInput represents a mutation input for creating profileposts.
 type CreateProfilePostInput struct {
 	ProfileUsername string
@@ -1095,6 +2254,184 @@ func (u *ProfilePostItemUpdateOne) SetInput(i UpdateProfilePostItemInput) *Profi
 	return u
 }
 
+// CreateProfileSkillInput represents a mutation input for creating profileskills.
+type CreateProfileSkillInput struct {
+	Name             string
+	EndorsementCount *int
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+	ProfileID        ulid.ID
+}
+
+// Mutate applies the CreateProfileSkillInput on the ProfileSkillCreate builder.
+func (i *CreateProfileSkillInput) Mutate(m *ProfileSkillCreate) {
+	m.SetName(i.Name)
+	if v := i.EndorsementCount; v != nil {
+		m.SetEndorsementCount(*v)
+	}
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	m.SetProfileID(i.ProfileID)
+}
+
+// SetInput applies the change-set in the CreateProfileSkillInput on the create builder.
+func (c *ProfileSkillCreate) SetInput(i CreateProfileSkillInput) *ProfileSkillCreate {
+	i.Mutate(c)
+	return c
+}
+
+// UpdateProfileSkillInput represents a mutation input for updating profileskills.
+type UpdateProfileSkillInput struct {
+	ID               ulid.ID
+	Name             *string
+	EndorsementCount *int
+	UpdatedAt        *time.Time
+	ProfileID        *ulid.ID
+	ClearProfile     bool
+}
+
+// Mutate applies the UpdateProfileSkillInput on the ProfileSkillMutation.
+func (i *UpdateProfileSkillInput) Mutate(m *ProfileSkillMutation) {
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if v := i.EndorsementCount; v != nil {
+		m.SetEndorsementCount(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if i.ClearProfile {
+		m.ClearProfile()
+	}
+	if v := i.ProfileID; v != nil {
+		m.SetProfileID(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateProfileSkillInput on the update builder.
+func (u *ProfileSkillUpdate) SetInput(i UpdateProfileSkillInput) *ProfileSkillUpdate {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// SetInput applies the change-set in the UpdateProfileSkillInput on the update-one builder.
+func (u *ProfileSkillUpdateOne) SetInput(i UpdateProfileSkillInput) *ProfileSkillUpdateOne {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// CreateProfileSnapshotInput represents a mutation input for creating profilesnapshots.
+type CreateProfileSnapshotInput struct {
+	FetchedAt        time.Time
+	RawDataS3Key     *string
+	CleanedDataS3Key *string
+	Headline         *string
+	Title            *string
+	Country          *string
+	City             *string
+	Positions        *[]map[string]interface{}
+	Educations       *[]map[string]interface{}
+	Skills           *[]map[string]interface{}
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+	ProfileID        ulid.ID
+	ChangeEventIDs   []ulid.ID
+}
+
+// Mutate applies the CreateProfileSnapshotInput on the ProfileSnapshotCreate builder.
+func (i *CreateProfileSnapshotInput) Mutate(m *ProfileSnapshotCreate) {
+	m.SetFetchedAt(i.FetchedAt)
+	if v := i.RawDataS3Key; v != nil {
+		m.SetRawDataS3Key(*v)
+	}
+	if v := i.CleanedDataS3Key; v != nil {
+		m.SetCleanedDataS3Key(*v)
+	}
+	if v := i.Headline; v != nil {
+		m.SetHeadline(*v)
+	}
+	if v := i.Title; v != nil {
+		m.SetTitle(*v)
+	}
+	if v := i.Country; v != nil {
+		m.SetCountry(*v)
+	}
+	if v := i.City; v != nil {
+		m.SetCity(*v)
+	}
+	if v := i.Positions; v != nil {
+		m.SetPositions(*v)
+	}
+	if v := i.Educations; v != nil {
+		m.SetEducations(*v)
+	}
+	if v := i.Skills; v != nil {
+		m.SetSkills(*v)
+	}
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	m.SetProfileID(i.ProfileID)
+	if ids := i.ChangeEventIDs; len(ids) > 0 {
+		m.AddChangeEventIDs(ids...)
+	}
+}
+
+// SetInput applies the change-set in the CreateProfileSnapshotInput on the create builder.
+func (c *ProfileSnapshotCreate) SetInput(i CreateProfileSnapshotInput) *ProfileSnapshotCreate {
+	i.Mutate(c)
+	return c
+}
+
+// UpdateProfileSnapshotInput represents a mutation input for updating profilesnapshots.
+type UpdateProfileSnapshotInput struct {
+	ID                   ulid.ID
+	UpdatedAt            *time.Time
+	ProfileID            *ulid.ID
+	ClearProfile         bool
+	AddChangeEventIDs    []ulid.ID
+	RemoveChangeEventIDs []ulid.ID
+}
+
+// Mutate applies the UpdateProfileSnapshotInput on the ProfileSnapshotMutation.
+func (i *UpdateProfileSnapshotInput) Mutate(m *ProfileSnapshotMutation) {
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if i.ClearProfile {
+		m.ClearProfile()
+	}
+	if v := i.ProfileID; v != nil {
+		m.SetProfileID(*v)
+	}
+	if ids := i.AddChangeEventIDs; len(ids) > 0 {
+		m.AddChangeEventIDs(ids...)
+	}
+	if ids := i.RemoveChangeEventIDs; len(ids) > 0 {
+		m.RemoveChangeEventIDs(ids...)
+	}
+}
+
+// SetInput applies the change-set in the UpdateProfileSnapshotInput on the update builder.
+func (u *ProfileSnapshotUpdate) SetInput(i UpdateProfileSnapshotInput) *ProfileSnapshotUpdate {
+	i.Mutate(u.Mutation())
+	return u
+}
+
+// SetInput applies the change-set in the UpdateProfileSnapshotInput on the update-one builder.
+func (u *ProfileSnapshotUpdateOne) SetInput(i UpdateProfileSnapshotInput) *ProfileSnapshotUpdateOne {
+	i.Mutate(u.Mutation())
+	return u
+}
+
 // CreateTodoInput represents a mutation input for creating todos.
 type CreateTodoInput struct {
 	Name      *string
@@ -6,9 +6,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APICallLog is the predicate function for apicalllog builders.
+type APICallLog func(*sql.Selector)
+
+// APIQuotaBudget is the predicate function for apiquotabudget builders.
+type APIQuotaBudget func(*sql.Selector)
+
+// APIQuotaReservation is the predicate function for apiquotareservation builders.
+type APIQuotaReservation func(*sql.Selector)
+
 // APIQuotaTracker is the predicate function for apiquotatracker builders.
 type APIQuotaTracker func(*sql.Selector)
 
+// Company is the predicate function for company builders.
+type Company func(*sql.Selector)
+
 // CronJobConfig is the predicate function for cronjobconfig builders.
 type CronJobConfig func(*sql.Selector)
 
@@ -18,15 +30,30 @@ type JobExecutionHistory func(*sql.Selector)
 // Profile is the predicate function for profile builders.
 type Profile func(*sql.Selector)
 
+// ProfileChangeEvent is the predicate function for profilechangeevent builders.
+type ProfileChangeEvent func(*sql.Selector)
+
+// ProfileEducation is the predicate function for profileeducation builders.
+type ProfileEducation func(*sql.Selector)
+
 // ProfileEntry is the predicate function for profileentry builders.
 type ProfileEntry func(*sql.Selector)
 
+// ProfilePosition is the predicate function for profileposition builders.
+type ProfilePosition func(*sql.Selector)
+
 // ProfilePost is the predicate function for profilepost builders.
 type ProfilePost func(*sql.Selector)
 
 // ProfilePostItem is the predicate function for profilepostitem builders.
 type ProfilePostItem func(*sql.Selector)
 
+// ProfileSkill is the predicate function for profileskill builders.
+type ProfileSkill func(*sql.Selector)
+
+// ProfileSnapshot is the predicate function for profilesnapshot builders.
+type ProfileSnapshot func(*sql.Selector)
+
 // Todo is the predicate function for todo builders.
 type Todo func(*sql.Selector)
 
@@ -65,11 +65,33 @@ type Profile struct {
 type ProfileEdges struct {
 	// Associated ProfileEntry that triggered this profile fetch
 	ProfileEntry *ProfileEntry `json:"profile_entry,omitempty"`
+	// Snapshots recorded at each fetch of this profile
+	Snapshots []*ProfileSnapshot `json:"snapshots,omitempty"`
+	// Change events detected between snapshots of this profile
+	ChangeEvents []*ProfileChangeEvent `json:"change_events,omitempty"`
+	// Typed rows of the positions JSON
+	ProfilePositions []*ProfilePosition `json:"profile_positions,omitempty"`
+	// Typed rows of the educations JSON
+	ProfileEducations []*ProfileEducation `json:"profile_educations,omitempty"`
+	// Typed rows of the skills JSON
+	ProfileSkills []*ProfileSkill `json:"profile_skills,omitempty"`
+	// Companies of the profile's current positions
+	CurrentCompanies []*Company `json:"current_companies,omitempty"`
+	// Companies the profile has left
+	PastCompanies []*Company `json:"past_companies,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [8]bool
 	// totalCount holds the count of the edges above.
-	totalCount [1]map[string]int
+	totalCount [8]map[string]int
+
+	namedSnapshots         map[string][]*ProfileSnapshot
+	namedChangeEvents      map[string][]*ProfileChangeEvent
+	namedProfilePositions  map[string][]*ProfilePosition
+	namedProfileEducations map[string][]*ProfileEducation
+	namedProfileSkills     map[string][]*ProfileSkill
+	namedCurrentCompanies  map[string][]*Company
+	namedPastCompanies     map[string][]*Company
 }
 
 // ProfileEntryOrErr returns the ProfileEntry value or an error if the edge
@@ -83,6 +105,69 @@ func (e ProfileEdges) ProfileEntryOrErr() (*ProfileEntry, error) {
 	return nil, &NotLoadedError{edge: "profile_entry"}
 }
 
+// SnapshotsOrErr returns the Snapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) SnapshotsOrErr() ([]*ProfileSnapshot, error) {
+	if e.loadedTypes[1] {
+		return e.Snapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "snapshots"}
+}
+
+// ChangeEventsOrErr returns the ChangeEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) ChangeEventsOrErr() ([]*ProfileChangeEvent, error) {
+	if e.loadedTypes[2] {
+		return e.ChangeEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "change_events"}
+}
+
+// ProfilePositionsOrErr returns the ProfilePositions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) ProfilePositionsOrErr() ([]*ProfilePosition, error) {
+	if e.loadedTypes[3] {
+		return e.ProfilePositions, nil
+	}
+	return nil, &NotLoadedError{edge: "profile_positions"}
+}
+
+// ProfileEducationsOrErr returns the ProfileEducations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) ProfileEducationsOrErr() ([]*ProfileEducation, error) {
+	if e.loadedTypes[4] {
+		return e.ProfileEducations, nil
+	}
+	return nil, &NotLoadedError{edge: "profile_educations"}
+}
+
+// ProfileSkillsOrErr returns the ProfileSkills value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) ProfileSkillsOrErr() ([]*ProfileSkill, error) {
+	if e.loadedTypes[5] {
+		return e.ProfileSkills, nil
+	}
+	return nil, &NotLoadedError{edge: "profile_skills"}
+}
+
+// CurrentCompaniesOrErr returns the CurrentCompanies value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) CurrentCompaniesOrErr() ([]*Company, error) {
+	if e.loadedTypes[6] {
+		return e.CurrentCompanies, nil
+	}
+	return nil, &NotLoadedError{edge: "current_companies"}
+}
+
+// PastCompaniesOrErr returns the PastCompanies value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) PastCompaniesOrErr() ([]*Company, error) {
+	if e.loadedTypes[7] {
+		return e.PastCompanies, nil
+	}
+	return nil, &NotLoadedError{edge: "past_companies"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Profile) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -264,6 +349,41 @@ func (pr *Profile) QueryProfileEntry() *ProfileEntryQuery {
 	return NewProfileClient(pr.config).QueryProfileEntry(pr)
 }
 
+// QuerySnapshots queries the "snapshots" edge of the Profile entity.
+func (pr *Profile) QuerySnapshots() *ProfileSnapshotQuery {
+	return NewProfileClient(pr.config).QuerySnapshots(pr)
+}
+
+// QueryChangeEvents queries the "change_events" edge of the Profile entity.
+func (pr *Profile) QueryChangeEvents() *ProfileChangeEventQuery {
+	return NewProfileClient(pr.config).QueryChangeEvents(pr)
+}
+
+// QueryProfilePositions queries the "profile_positions" edge of the Profile entity.
+func (pr *Profile) QueryProfilePositions() *ProfilePositionQuery {
+	return NewProfileClient(pr.config).QueryProfilePositions(pr)
+}
+
+// QueryProfileEducations queries the "profile_educations" edge of the Profile entity.
+func (pr *Profile) QueryProfileEducations() *ProfileEducationQuery {
+	return NewProfileClient(pr.config).QueryProfileEducations(pr)
+}
+
+// QueryProfileSkills queries the "profile_skills" edge of the Profile entity.
+func (pr *Profile) QueryProfileSkills() *ProfileSkillQuery {
+	return NewProfileClient(pr.config).QueryProfileSkills(pr)
+}
+
+// QueryCurrentCompanies queries the "current_companies" edge of the Profile entity.
+func (pr *Profile) QueryCurrentCompanies() *CompanyQuery {
+	return NewProfileClient(pr.config).QueryCurrentCompanies(pr)
+}
+
+// QueryPastCompanies queries the "past_companies" edge of the Profile entity.
+func (pr *Profile) QueryPastCompanies() *CompanyQuery {
+	return NewProfileClient(pr.config).QueryPastCompanies(pr)
+}
+
 // Update returns a builder for updating this Profile.
 // Note that you need to call Profile.Unwrap() before calling this method if this Profile
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -361,5 +481,173 @@ func (pr *Profile) String() string {
 	return builder.String()
 }
 
+// NamedSnapshots returns the Snapshots named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (pr *Profile) NamedSnapshots(name string) ([]*ProfileSnapshot, error) {
+	if pr.Edges.namedSnapshots == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := pr.Edges.namedSnapshots[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (pr *Profile) appendNamedSnapshots(name string, edges ...*ProfileSnapshot) {
+	if pr.Edges.namedSnapshots == nil {
+		pr.Edges.namedSnapshots = make(map[string][]*ProfileSnapshot)
+	}
+	if len(edges) == 0 {
+		pr.Edges.namedSnapshots[name] = []*ProfileSnapshot{}
+	} else {
+		pr.Edges.namedSnapshots[name] = append(pr.Edges.namedSnapshots[name], edges...)
+	}
+}
+
+// NamedChangeEvents returns the ChangeEvents named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (pr *Profile) NamedChangeEvents(name string) ([]*ProfileChangeEvent, error) {
+	if pr.Edges.namedChangeEvents == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := pr.Edges.namedChangeEvents[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (pr *Profile) appendNamedChangeEvents(name string, edges ...*ProfileChangeEvent) {
+	if pr.Edges.namedChangeEvents == nil {
+		pr.Edges.namedChangeEvents = make(map[string][]*ProfileChangeEvent)
+	}
+	if len(edges) == 0 {
+		pr.Edges.namedChangeEvents[name] = []*ProfileChangeEvent{}
+	} else {
+		pr.Edges.namedChangeEvents[name] = append(pr.Edges.namedChangeEvents[name], edges...)
+	}
+}
+
+// NamedProfilePositions returns the ProfilePositions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (pr *Profile) NamedProfilePositions(name string) ([]*ProfilePosition, error) {
+	if pr.Edges.namedProfilePositions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := pr.Edges.namedProfilePositions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (pr *Profile) appendNamedProfilePositions(name string, edges ...*ProfilePosition) {
+	if pr.Edges.namedProfilePositions == nil {
+		pr.Edges.namedProfilePositions = make(map[string][]*ProfilePosition)
+	}
+	if len(edges) == 0 {
+		pr.Edges.namedProfilePositions[name] = []*ProfilePosition{}
+	} else {
+		pr.Edges.namedProfilePositions[name] = append(pr.Edges.namedProfilePositions[name], edges...)
+	}
+}
+
+// NamedProfileEducations returns the ProfileEducations named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (pr *Profile) NamedProfileEducations(name string) ([]*ProfileEducation, error) {
+	if pr.Edges.namedProfileEducations == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := pr.Edges.namedProfileEducations[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (pr *Profile) appendNamedProfileEducations(name string, edges ...*ProfileEducation) {
+	if pr.Edges.namedProfileEducations == nil {
+		pr.Edges.namedProfileEducations = make(map[string][]*ProfileEducation)
+	}
+	if len(edges) == 0 {
+		pr.Edges.namedProfileEducations[name] = []*ProfileEducation{}
+	} else {
+		pr.Edges.namedProfileEducations[name] = append(pr.Edges.namedProfileEducations[name], edges...)
+	}
+}
+
+// NamedProfileSkills returns the ProfileSkills named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (pr *Profile) NamedProfileSkills(name string) ([]*ProfileSkill, error) {
+	if pr.Edges.namedProfileSkills == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := pr.Edges.namedProfileSkills[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (pr *Profile) appendNamedProfileSkills(name string, edges ...*ProfileSkill) {
+	if pr.Edges.namedProfileSkills == nil {
+		pr.Edges.namedProfileSkills = make(map[string][]*ProfileSkill)
+	}
+	if len(edges) == 0 {
+		pr.Edges.namedProfileSkills[name] = []*ProfileSkill{}
+	} else {
+		pr.Edges.namedProfileSkills[name] = append(pr.Edges.namedProfileSkills[name], edges...)
+	}
+}
+
+// NamedCurrentCompanies returns the CurrentCompanies named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (pr *Profile) NamedCurrentCompanies(name string) ([]*Company, error) {
+	if pr.Edges.namedCurrentCompanies == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := pr.Edges.namedCurrentCompanies[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (pr *Profile) appendNamedCurrentCompanies(name string, edges ...*Company) {
+	if pr.Edges.namedCurrentCompanies == nil {
+		pr.Edges.namedCurrentCompanies = make(map[string][]*Company)
+	}
+	if len(edges) == 0 {
+		pr.Edges.namedCurrentCompanies[name] = []*Company{}
+	} else {
+		pr.Edges.namedCurrentCompanies[name] = append(pr.Edges.namedCurrentCompanies[name], edges...)
+	}
+}
+
+// NamedPastCompanies returns the PastCompanies named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (pr *Profile) NamedPastCompanies(name string) ([]*Company, error) {
+	if pr.Edges.namedPastCompanies == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := pr.Edges.namedPastCompanies[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (pr *Profile) appendNamedPastCompanies(name string, edges ...*Company) {
+	if pr.Edges.namedPastCompanies == nil {
+		pr.Edges.namedPastCompanies = make(map[string][]*Company)
+	}
+	if len(edges) == 0 {
+		pr.Edges.namedPastCompanies[name] = []*Company{}
+	} else {
+		pr.Edges.namedPastCompanies[name] = append(pr.Edges.namedPastCompanies[name], edges...)
+	}
+}
+
 // Profiles is a parsable slice of Profile.
 type Profiles []*Profile
@@ -51,6 +51,20 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeProfileEntry holds the string denoting the profile_entry edge name in mutations.
 	EdgeProfileEntry = "profile_entry"
+	// EdgeSnapshots holds the string denoting the snapshots edge name in mutations.
+	EdgeSnapshots = "snapshots"
+	// EdgeChangeEvents holds the string denoting the change_events edge name in mutations.
+	EdgeChangeEvents = "change_events"
+	// EdgeProfilePositions holds the string denoting the profile_positions edge name in mutations.
+	EdgeProfilePositions = "profile_positions"
+	// EdgeProfileEducations holds the string denoting the profile_educations edge name in mutations.
+	EdgeProfileEducations = "profile_educations"
+	// EdgeProfileSkills holds the string denoting the profile_skills edge name in mutations.
+	EdgeProfileSkills = "profile_skills"
+	// EdgeCurrentCompanies holds the string denoting the current_companies edge name in mutations.
+	EdgeCurrentCompanies = "current_companies"
+	// EdgePastCompanies holds the string denoting the past_companies edge name in mutations.
+	EdgePastCompanies = "past_companies"
 	// Table holds the table name of the profile in the database.
 	Table = "profiles"
 	// ProfileEntryTable is the table that holds the profile_entry relation/edge.
@@ -60,6 +74,51 @@ const (
 	ProfileEntryInverseTable = "profile_entries"
 	// ProfileEntryColumn is the table column denoting the profile_entry relation/edge.
 	ProfileEntryColumn = "profile_entry_profile"
+	// SnapshotsTable is the table that holds the snapshots relation/edge.
+	SnapshotsTable = "profile_snapshots"
+	// SnapshotsInverseTable is the table name for the ProfileSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "profilesnapshot" package.
+	SnapshotsInverseTable = "profile_snapshots"
+	// SnapshotsColumn is the table column denoting the snapshots relation/edge.
+	SnapshotsColumn = "profile_snapshots"
+	// ChangeEventsTable is the table that holds the change_events relation/edge.
+	ChangeEventsTable = "profile_change_events"
+	// ChangeEventsInverseTable is the table name for the ProfileChangeEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "profilechangeevent" package.
+	ChangeEventsInverseTable = "profile_change_events"
+	// ChangeEventsColumn is the table column denoting the change_events relation/edge.
+	ChangeEventsColumn = "profile_change_events"
+	// ProfilePositionsTable is the table that holds the profile_positions relation/edge.
+	ProfilePositionsTable = "profile_positions"
+	// ProfilePositionsInverseTable is the table name for the ProfilePosition entity.
+	// It exists in this package in order to avoid circular dependency with the "profileposition" package.
+	ProfilePositionsInverseTable = "profile_positions"
+	// ProfilePositionsColumn is the table column denoting the profile_positions relation/edge.
+	ProfilePositionsColumn = "profile_profile_positions"
+	// ProfileEducationsTable is the table that holds the profile_educations relation/edge.
+	ProfileEducationsTable = "profile_educations"
+	// ProfileEducationsInverseTable is the table name for the ProfileEducation entity.
+	// It exists in this package in order to avoid circular dependency with the "profileeducation" package.
+	ProfileEducationsInverseTable = "profile_educations"
+	// ProfileEducationsColumn is the table column denoting the profile_educations relation/edge.
+	ProfileEducationsColumn = "profile_profile_educations"
+	// ProfileSkillsTable is the table that holds the profile_skills relation/edge.
+	ProfileSkillsTable = "profile_skills"
+	// ProfileSkillsInverseTable is the table name for the ProfileSkill entity.
+	// It exists in this package in order to avoid circular dependency with the "profileskill" package.
+	ProfileSkillsInverseTable = "profile_skills"
+	// ProfileSkillsColumn is the table column denoting the profile_skills relation/edge.
+	ProfileSkillsColumn = "profile_profile_skills"
+	// CurrentCompaniesTable is the table that holds the current_companies relation/edge. The primary key declared below.
+	CurrentCompaniesTable = "profile_current_companies"
+	// CurrentCompaniesInverseTable is the table name for the Company entity.
+	// It exists in this package in order to avoid circular dependency with the "company" package.
+	CurrentCompaniesInverseTable = "companies"
+	// PastCompaniesTable is the table that holds the past_companies relation/edge. The primary key declared below.
+	PastCompaniesTable = "profile_past_companies"
+	// PastCompaniesInverseTable is the table name for the Company entity.
+	// It exists in this package in order to avoid circular dependency with the "company" package.
+	PastCompaniesInverseTable = "companies"
 )
 
 // Columns holds all SQL columns for profile fields.
@@ -90,6 +149,15 @@ var ForeignKeys = []string{
 	"profile_entry_profile",
 }
 
+var (
+	// CurrentCompaniesPrimaryKey and CurrentCompaniesColumn2 are the table columns denoting the
+	// primary key for the current_companies relation (M2M).
+	CurrentCompaniesPrimaryKey = []string{"profile_id", "company_id"}
+	// PastCompaniesPrimaryKey and PastCompaniesColumn2 are the table columns denoting the
+	// primary key for the past_companies relation (M2M).
+	PastCompaniesPrimaryKey = []string{"profile_id", "company_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -201,6 +269,104 @@ func ByProfileEntryField(field string, opts ...sql.OrderTermOption) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newProfileEntryStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// BySnapshotsCount orders the results by snapshots count.
+func BySnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSnapshotsStep(), opts...)
+	}
+}
+
+// BySnapshots orders the results by snapshots terms.
+func BySnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChangeEventsCount orders the results by change_events count.
+func ByChangeEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChangeEventsStep(), opts...)
+	}
+}
+
+// ByChangeEvents orders the results by change_events terms.
+func ByChangeEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChangeEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByProfilePositionsCount orders the results by profile_positions count.
+func ByProfilePositionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProfilePositionsStep(), opts...)
+	}
+}
+
+// ByProfilePositions orders the results by profile_positions terms.
+func ByProfilePositions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfilePositionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByProfileEducationsCount orders the results by profile_educations count.
+func ByProfileEducationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProfileEducationsStep(), opts...)
+	}
+}
+
+// ByProfileEducations orders the results by profile_educations terms.
+func ByProfileEducations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileEducationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByProfileSkillsCount orders the results by profile_skills count.
+func ByProfileSkillsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProfileSkillsStep(), opts...)
+	}
+}
+
+// ByProfileSkills orders the results by profile_skills terms.
+func ByProfileSkills(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileSkillsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCurrentCompaniesCount orders the results by current_companies count.
+func ByCurrentCompaniesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCurrentCompaniesStep(), opts...)
+	}
+}
+
+// ByCurrentCompanies orders the results by current_companies terms.
+func ByCurrentCompanies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCurrentCompaniesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPastCompaniesCount orders the results by past_companies count.
+func ByPastCompaniesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPastCompaniesStep(), opts...)
+	}
+}
+
+// ByPastCompanies orders the results by past_companies terms.
+func ByPastCompanies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPastCompaniesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProfileEntryStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -208,3 +374,52 @@ func newProfileEntryStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, true, ProfileEntryTable, ProfileEntryColumn),
 	)
 }
+func newSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+	)
+}
+func newChangeEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChangeEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChangeEventsTable, ChangeEventsColumn),
+	)
+}
+func newProfilePositionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfilePositionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProfilePositionsTable, ProfilePositionsColumn),
+	)
+}
+func newProfileEducationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileEducationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProfileEducationsTable, ProfileEducationsColumn),
+	)
+}
+func newProfileSkillsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileSkillsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProfileSkillsTable, ProfileSkillsColumn),
+	)
+}
+func newCurrentCompaniesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CurrentCompaniesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, CurrentCompaniesTable, CurrentCompaniesPrimaryKey...),
+	)
+}
+func newPastCompaniesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PastCompaniesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, PastCompaniesTable, PastCompaniesPrimaryKey...),
+	)
+}
@@ -1079,6 +1079,167 @@ func HasProfileEntryWith(preds ...predicate.ProfileEntry) predicate.Profile {
 	})
 }
 
+// HasSnapshots applies the HasEdge predicate on the "snapshots" edge.
+func HasSnapshots() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotsWith applies the HasEdge predicate on the "snapshots" edge with a given conditions (other predicates).
+func HasSnapshotsWith(preds ...predicate.ProfileSnapshot) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChangeEvents applies the HasEdge predicate on the "change_events" edge.
+func HasChangeEvents() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChangeEventsTable, ChangeEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChangeEventsWith applies the HasEdge predicate on the "change_events" edge with a given conditions (other predicates).
+func HasChangeEventsWith(preds ...predicate.ProfileChangeEvent) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newChangeEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProfilePositions applies the HasEdge predicate on the "profile_positions" edge.
+func HasProfilePositions() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProfilePositionsTable, ProfilePositionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfilePositionsWith applies the HasEdge predicate on the "profile_positions" edge with a given conditions (other predicates).
+func HasProfilePositionsWith(preds ...predicate.ProfilePosition) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newProfilePositionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProfileEducations applies the HasEdge predicate on the "profile_educations" edge.
+func HasProfileEducations() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProfileEducationsTable, ProfileEducationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileEducationsWith applies the HasEdge predicate on the "profile_educations" edge with a given conditions (other predicates).
+func HasProfileEducationsWith(preds ...predicate.ProfileEducation) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newProfileEducationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProfileSkills applies the HasEdge predicate on the "profile_skills" edge.
+func HasProfileSkills() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProfileSkillsTable, ProfileSkillsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileSkillsWith applies the HasEdge predicate on the "profile_skills" edge with a given conditions (other predicates).
+func HasProfileSkillsWith(preds ...predicate.ProfileSkill) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newProfileSkillsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCurrentCompanies applies the HasEdge predicate on the "current_companies" edge.
+func HasCurrentCompanies() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, CurrentCompaniesTable, CurrentCompaniesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCurrentCompaniesWith applies the HasEdge predicate on the "current_companies" edge with a given conditions (other predicates).
+func HasCurrentCompaniesWith(preds ...predicate.Company) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newCurrentCompaniesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPastCompanies applies the HasEdge predicate on the "past_companies" edge.
+func HasPastCompanies() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, PastCompaniesTable, PastCompaniesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPastCompaniesWith applies the HasEdge predicate on the "past_companies" edge with a given conditions (other predicates).
+func HasPastCompaniesWith(preds ...predicate.Company) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newPastCompaniesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Profile) predicate.Profile {
 	return predicate.Profile(sql.AndPredicates(predicates...))
@@ -6,11 +6,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/schema/ulid"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +28,7 @@ type ProfileCreate struct {
 	config
 	mutation *ProfileMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUrn sets the "urn" field.
@@ -253,6 +262,111 @@ func (pc *ProfileCreate) SetProfileEntry(p *ProfileEntry) *ProfileCreate {
 	return pc.SetProfileEntryID(p.ID)
 }
 
+// AddSnapshotIDs adds the "snapshots" edge to the ProfileSnapshot entity by IDs.
+func (pc *ProfileCreate) AddSnapshotIDs(ids ...ulid.ID) *ProfileCreate {
+	pc.mutation.AddSnapshotIDs(ids...)
+	return pc
+}
+
+// AddSnapshots adds the "snapshots" edges to the ProfileSnapshot entity.
+func (pc *ProfileCreate) AddSnapshots(p ...*ProfileSnapshot) *ProfileCreate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddSnapshotIDs(ids...)
+}
+
+// AddChangeEventIDs adds the "change_events" edge to the ProfileChangeEvent entity by IDs.
+func (pc *ProfileCreate) AddChangeEventIDs(ids ...ulid.ID) *ProfileCreate {
+	pc.mutation.AddChangeEventIDs(ids...)
+	return pc
+}
+
+// AddChangeEvents adds the "change_events" edges to the ProfileChangeEvent entity.
+func (pc *ProfileCreate) AddChangeEvents(p ...*ProfileChangeEvent) *ProfileCreate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddChangeEventIDs(ids...)
+}
+
+// AddProfilePositionIDs adds the "profile_positions" edge to the ProfilePosition entity by IDs.
+func (pc *ProfileCreate) AddProfilePositionIDs(ids ...ulid.ID) *ProfileCreate {
+	pc.mutation.AddProfilePositionIDs(ids...)
+	return pc
+}
+
+// AddProfilePositions adds the "profile_positions" edges to the ProfilePosition entity.
+func (pc *ProfileCreate) AddProfilePositions(p ...*ProfilePosition) *ProfileCreate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddProfilePositionIDs(ids...)
+}
+
+// AddProfileEducationIDs adds the "profile_educations" edge to the ProfileEducation entity by IDs.
+func (pc *ProfileCreate) AddProfileEducationIDs(ids ...ulid.ID) *ProfileCreate {
+	pc.mutation.AddProfileEducationIDs(ids...)
+	return pc
+}
+
+// AddProfileEducations adds the "profile_educations" edges to the ProfileEducation entity.
+func (pc *ProfileCreate) AddProfileEducations(p ...*ProfileEducation) *ProfileCreate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddProfileEducationIDs(ids...)
+}
+
+// AddProfileSkillIDs adds the "profile_skills" edge to the ProfileSkill entity by IDs.
+func (pc *ProfileCreate) AddProfileSkillIDs(ids ...ulid.ID) *ProfileCreate {
+	pc.mutation.AddProfileSkillIDs(ids...)
+	return pc
+}
+
+// AddProfileSkills adds the "profile_skills" edges to the ProfileSkill entity.
+func (pc *ProfileCreate) AddProfileSkills(p ...*ProfileSkill) *ProfileCreate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddProfileSkillIDs(ids...)
+}
+
+// AddCurrentCompanyIDs adds the "current_companies" edge to the Company entity by IDs.
+func (pc *ProfileCreate) AddCurrentCompanyIDs(ids ...ulid.ID) *ProfileCreate {
+	pc.mutation.AddCurrentCompanyIDs(ids...)
+	return pc
+}
+
+// AddCurrentCompanies adds the "current_companies" edges to the Company entity.
+func (pc *ProfileCreate) AddCurrentCompanies(c ...*Company) *ProfileCreate {
+	ids := make([]ulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pc.AddCurrentCompanyIDs(ids...)
+}
+
+// AddPastCompanyIDs adds the "past_companies" edge to the Company entity by IDs.
+func (pc *ProfileCreate) AddPastCompanyIDs(ids ...ulid.ID) *ProfileCreate {
+	pc.mutation.AddPastCompanyIDs(ids...)
+	return pc
+}
+
+// AddPastCompanies adds the "past_companies" edges to the Company entity.
+func (pc *ProfileCreate) AddPastCompanies(c ...*Company) *ProfileCreate {
+	ids := make([]ulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pc.AddPastCompanyIDs(ids...)
+}
+
 // Mutation returns the ProfileMutation object of the builder.
 func (pc *ProfileCreate) Mutation() *ProfileMutation {
 	return pc.mutation
@@ -359,6 +473,7 @@ func (pc *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
 		_node = &Profile{config: pc.config}
 		_spec = sqlgraph.NewCreateSpec(profile.Table, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = pc.conflict
 	if id, ok := pc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -448,14 +563,863 @@ func (pc *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
 		_node.profile_entry_profile = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SnapshotsTable,
+			Columns: []string{profile.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ChangeEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ChangeEventsTable,
+			Columns: []string{profile.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ProfilePositionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfilePositionsTable,
+			Columns: []string{profile.ProfilePositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ProfileEducationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileEducationsTable,
+			Columns: []string{profile.ProfileEducationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ProfileSkillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileSkillsTable,
+			Columns: []string{profile.ProfileSkillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.CurrentCompaniesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.CurrentCompaniesTable,
+			Columns: profile.CurrentCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.PastCompaniesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.PastCompaniesTable,
+			Columns: profile.PastCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Profile.Create().
+//		SetUrn(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileUpsert) {
+//			SetUrn(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *ProfileCreate) OnConflict(opts ...sql.ConflictOption) *ProfileUpsertOne {
+	pc.conflict = opts
+	return &ProfileUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *ProfileCreate) OnConflictColumns(columns ...string) *ProfileUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &ProfileUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// ProfileUpsertOne is the builder for "upsert"-ing
+	//  one Profile node.
+	ProfileUpsertOne struct {
+		create *ProfileCreate
+	}
+
+	// ProfileUpsert is the "OnConflict" setter.
+	ProfileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUrn sets the "urn" field.
+func (u *ProfileUpsert) SetUrn(v string) *ProfileUpsert {
+	u.Set(profile.FieldUrn, v)
+	return u
+}
+
+// UpdateUrn sets the "urn" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateUrn() *ProfileUpsert {
+	u.SetExcluded(profile.FieldUrn)
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *ProfileUpsert) SetUsername(v string) *ProfileUpsert {
+	u.Set(profile.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateUsername() *ProfileUpsert {
+	u.SetExcluded(profile.FieldUsername)
+	return u
+}
+
+// ClearUsername clears the value of the "username" field.
+func (u *ProfileUpsert) ClearUsername() *ProfileUpsert {
+	u.SetNull(profile.FieldUsername)
+	return u
+}
+
+// SetFirstName sets the "first_name" field.
+func (u *ProfileUpsert) SetFirstName(v string) *ProfileUpsert {
+	u.Set(profile.FieldFirstName, v)
+	return u
+}
+
+// UpdateFirstName sets the "first_name" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateFirstName() *ProfileUpsert {
+	u.SetExcluded(profile.FieldFirstName)
+	return u
+}
+
+// ClearFirstName clears the value of the "first_name" field.
+func (u *ProfileUpsert) ClearFirstName() *ProfileUpsert {
+	u.SetNull(profile.FieldFirstName)
+	return u
+}
+
+// SetLastName sets the "last_name" field.
+func (u *ProfileUpsert) SetLastName(v string) *ProfileUpsert {
+	u.Set(profile.FieldLastName, v)
+	return u
+}
+
+// UpdateLastName sets the "last_name" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateLastName() *ProfileUpsert {
+	u.SetExcluded(profile.FieldLastName)
+	return u
+}
+
+// ClearLastName clears the value of the "last_name" field.
+func (u *ProfileUpsert) ClearLastName() *ProfileUpsert {
+	u.SetNull(profile.FieldLastName)
+	return u
+}
+
+// SetHeadline sets the "headline" field.
+func (u *ProfileUpsert) SetHeadline(v string) *ProfileUpsert {
+	u.Set(profile.FieldHeadline, v)
+	return u
+}
+
+// UpdateHeadline sets the "headline" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateHeadline() *ProfileUpsert {
+	u.SetExcluded(profile.FieldHeadline)
+	return u
+}
+
+// ClearHeadline clears the value of the "headline" field.
+func (u *ProfileUpsert) ClearHeadline() *ProfileUpsert {
+	u.SetNull(profile.FieldHeadline)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ProfileUpsert) SetTitle(v string) *ProfileUpsert {
+	u.Set(profile.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateTitle() *ProfileUpsert {
+	u.SetExcluded(profile.FieldTitle)
+	return u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ProfileUpsert) ClearTitle() *ProfileUpsert {
+	u.SetNull(profile.FieldTitle)
+	return u
+}
+
+// SetCountry sets the "country" field.
+func (u *ProfileUpsert) SetCountry(v string) *ProfileUpsert {
+	u.Set(profile.FieldCountry, v)
+	return u
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateCountry() *ProfileUpsert {
+	u.SetExcluded(profile.FieldCountry)
+	return u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (u *ProfileUpsert) ClearCountry() *ProfileUpsert {
+	u.SetNull(profile.FieldCountry)
+	return u
+}
+
+// SetCity sets the "city" field.
+func (u *ProfileUpsert) SetCity(v string) *ProfileUpsert {
+	u.Set(profile.FieldCity, v)
+	return u
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateCity() *ProfileUpsert {
+	u.SetExcluded(profile.FieldCity)
+	return u
+}
+
+// ClearCity clears the value of the "city" field.
+func (u *ProfileUpsert) ClearCity() *ProfileUpsert {
+	u.SetNull(profile.FieldCity)
+	return u
+}
+
+// SetEducations sets the "educations" field.
+func (u *ProfileUpsert) SetEducations(v []map[string]interface{}) *ProfileUpsert {
+	u.Set(profile.FieldEducations, v)
+	return u
+}
+
+// UpdateEducations sets the "educations" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateEducations() *ProfileUpsert {
+	u.SetExcluded(profile.FieldEducations)
+	return u
+}
+
+// ClearEducations clears the value of the "educations" field.
+func (u *ProfileUpsert) ClearEducations() *ProfileUpsert {
+	u.SetNull(profile.FieldEducations)
+	return u
+}
+
+// SetPositions sets the "positions" field.
+func (u *ProfileUpsert) SetPositions(v []map[string]interface{}) *ProfileUpsert {
+	u.Set(profile.FieldPositions, v)
+	return u
+}
+
+// UpdatePositions sets the "positions" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdatePositions() *ProfileUpsert {
+	u.SetExcluded(profile.FieldPositions)
+	return u
+}
+
+// ClearPositions clears the value of the "positions" field.
+func (u *ProfileUpsert) ClearPositions() *ProfileUpsert {
+	u.SetNull(profile.FieldPositions)
+	return u
+}
+
+// SetSkills sets the "skills" field.
+func (u *ProfileUpsert) SetSkills(v []map[string]interface{}) *ProfileUpsert {
+	u.Set(profile.FieldSkills, v)
+	return u
+}
+
+// UpdateSkills sets the "skills" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateSkills() *ProfileUpsert {
+	u.SetExcluded(profile.FieldSkills)
+	return u
+}
+
+// ClearSkills clears the value of the "skills" field.
+func (u *ProfileUpsert) ClearSkills() *ProfileUpsert {
+	u.SetNull(profile.FieldSkills)
+	return u
+}
+
+// SetGeoData sets the "geo_data" field.
+func (u *ProfileUpsert) SetGeoData(v map[string]interface{}) *ProfileUpsert {
+	u.Set(profile.FieldGeoData, v)
+	return u
+}
+
+// UpdateGeoData sets the "geo_data" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateGeoData() *ProfileUpsert {
+	u.SetExcluded(profile.FieldGeoData)
+	return u
+}
+
+// ClearGeoData clears the value of the "geo_data" field.
+func (u *ProfileUpsert) ClearGeoData() *ProfileUpsert {
+	u.SetNull(profile.FieldGeoData)
+	return u
+}
+
+// SetRawDataS3Key sets the "raw_data_s3_key" field.
+func (u *ProfileUpsert) SetRawDataS3Key(v string) *ProfileUpsert {
+	u.Set(profile.FieldRawDataS3Key, v)
+	return u
+}
+
+// UpdateRawDataS3Key sets the "raw_data_s3_key" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateRawDataS3Key() *ProfileUpsert {
+	u.SetExcluded(profile.FieldRawDataS3Key)
+	return u
+}
+
+// ClearRawDataS3Key clears the value of the "raw_data_s3_key" field.
+func (u *ProfileUpsert) ClearRawDataS3Key() *ProfileUpsert {
+	u.SetNull(profile.FieldRawDataS3Key)
+	return u
+}
+
+// SetCleanedDataS3Key sets the "cleaned_data_s3_key" field.
+func (u *ProfileUpsert) SetCleanedDataS3Key(v string) *ProfileUpsert {
+	u.Set(profile.FieldCleanedDataS3Key, v)
+	return u
+}
+
+// UpdateCleanedDataS3Key sets the "cleaned_data_s3_key" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateCleanedDataS3Key() *ProfileUpsert {
+	u.SetExcluded(profile.FieldCleanedDataS3Key)
+	return u
+}
+
+// ClearCleanedDataS3Key clears the value of the "cleaned_data_s3_key" field.
+func (u *ProfileUpsert) ClearCleanedDataS3Key() *ProfileUpsert {
+	u.SetNull(profile.FieldCleanedDataS3Key)
+	return u
+}
+
+// SetSourceFile sets the "source_file" field.
+func (u *ProfileUpsert) SetSourceFile(v string) *ProfileUpsert {
+	u.Set(profile.FieldSourceFile, v)
+	return u
+}
+
+// UpdateSourceFile sets the "source_file" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateSourceFile() *ProfileUpsert {
+	u.SetExcluded(profile.FieldSourceFile)
+	return u
+}
+
+// ClearSourceFile clears the value of the "source_file" field.
+func (u *ProfileUpsert) ClearSourceFile() *ProfileUpsert {
+	u.SetNull(profile.FieldSourceFile)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileUpsert) SetUpdatedAt(v time.Time) *ProfileUpsert {
+	u.Set(profile.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateUpdatedAt() *ProfileUpsert {
+	u.SetExcluded(profile.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileUpsertOne) UpdateNewValues() *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(profile.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(profile.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProfileUpsertOne) Ignore() *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileUpsertOne) DoNothing() *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileCreate.OnConflict
+// documentation for more info.
+func (u *ProfileUpsertOne) Update(set func(*ProfileUpsert)) *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUrn sets the "urn" field.
+func (u *ProfileUpsertOne) SetUrn(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetUrn(v)
+	})
+}
+
+// UpdateUrn sets the "urn" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateUrn() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateUrn()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *ProfileUpsertOne) SetUsername(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateUsername() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// ClearUsername clears the value of the "username" field.
+func (u *ProfileUpsertOne) ClearUsername() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearUsername()
+	})
+}
+
+// SetFirstName sets the "first_name" field.
+func (u *ProfileUpsertOne) SetFirstName(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetFirstName(v)
+	})
+}
+
+// UpdateFirstName sets the "first_name" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateFirstName() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateFirstName()
+	})
+}
+
+// ClearFirstName clears the value of the "first_name" field.
+func (u *ProfileUpsertOne) ClearFirstName() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearFirstName()
+	})
+}
+
+// SetLastName sets the "last_name" field.
+func (u *ProfileUpsertOne) SetLastName(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetLastName(v)
+	})
+}
+
+// UpdateLastName sets the "last_name" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateLastName() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateLastName()
+	})
+}
+
+// ClearLastName clears the value of the "last_name" field.
+func (u *ProfileUpsertOne) ClearLastName() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearLastName()
+	})
+}
+
+// SetHeadline sets the "headline" field.
+func (u *ProfileUpsertOne) SetHeadline(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetHeadline(v)
+	})
+}
+
+// UpdateHeadline sets the "headline" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateHeadline() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateHeadline()
+	})
+}
+
+// ClearHeadline clears the value of the "headline" field.
+func (u *ProfileUpsertOne) ClearHeadline() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearHeadline()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ProfileUpsertOne) SetTitle(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateTitle() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ProfileUpsertOne) ClearTitle() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetCountry sets the "country" field.
+func (u *ProfileUpsertOne) SetCountry(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateCountry() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// ClearCountry clears the value of the "country" field.
+func (u *ProfileUpsertOne) ClearCountry() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearCountry()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *ProfileUpsertOne) SetCity(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateCity() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// ClearCity clears the value of the "city" field.
+func (u *ProfileUpsertOne) ClearCity() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearCity()
+	})
+}
+
+// SetEducations sets the "educations" field.
+func (u *ProfileUpsertOne) SetEducations(v []map[string]interface{}) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetEducations(v)
+	})
+}
+
+// UpdateEducations sets the "educations" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateEducations() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateEducations()
+	})
+}
+
+// ClearEducations clears the value of the "educations" field.
+func (u *ProfileUpsertOne) ClearEducations() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearEducations()
+	})
+}
+
+// SetPositions sets the "positions" field.
+func (u *ProfileUpsertOne) SetPositions(v []map[string]interface{}) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetPositions(v)
+	})
+}
+
+// UpdatePositions sets the "positions" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdatePositions() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdatePositions()
+	})
+}
+
+// ClearPositions clears the value of the "positions" field.
+func (u *ProfileUpsertOne) ClearPositions() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearPositions()
+	})
+}
+
+// SetSkills sets the "skills" field.
+func (u *ProfileUpsertOne) SetSkills(v []map[string]interface{}) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetSkills(v)
+	})
+}
+
+// UpdateSkills sets the "skills" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateSkills() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateSkills()
+	})
+}
+
+// ClearSkills clears the value of the "skills" field.
+func (u *ProfileUpsertOne) ClearSkills() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearSkills()
+	})
+}
+
+// SetGeoData sets the "geo_data" field.
+func (u *ProfileUpsertOne) SetGeoData(v map[string]interface{}) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetGeoData(v)
+	})
+}
+
+// UpdateGeoData sets the "geo_data" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateGeoData() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateGeoData()
+	})
+}
+
+// ClearGeoData clears the value of the "geo_data" field.
+func (u *ProfileUpsertOne) ClearGeoData() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearGeoData()
+	})
+}
+
+// SetRawDataS3Key sets the "raw_data_s3_key" field.
+func (u *ProfileUpsertOne) SetRawDataS3Key(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetRawDataS3Key(v)
+	})
+}
+
+// UpdateRawDataS3Key sets the "raw_data_s3_key" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateRawDataS3Key() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateRawDataS3Key()
+	})
+}
+
+// ClearRawDataS3Key clears the value of the "raw_data_s3_key" field.
+func (u *ProfileUpsertOne) ClearRawDataS3Key() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearRawDataS3Key()
+	})
+}
+
+// SetCleanedDataS3Key sets the "cleaned_data_s3_key" field.
+func (u *ProfileUpsertOne) SetCleanedDataS3Key(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetCleanedDataS3Key(v)
+	})
+}
+
+// UpdateCleanedDataS3Key sets the "cleaned_data_s3_key" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateCleanedDataS3Key() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateCleanedDataS3Key()
+	})
+}
+
+// ClearCleanedDataS3Key clears the value of the "cleaned_data_s3_key" field.
+func (u *ProfileUpsertOne) ClearCleanedDataS3Key() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearCleanedDataS3Key()
+	})
+}
+
+// SetSourceFile sets the "source_file" field.
+func (u *ProfileUpsertOne) SetSourceFile(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetSourceFile(v)
+	})
+}
+
+// UpdateSourceFile sets the "source_file" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateSourceFile() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateSourceFile()
+	})
+}
+
+// ClearSourceFile clears the value of the "source_file" field.
+func (u *ProfileUpsertOne) ClearSourceFile() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearSourceFile()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileUpsertOne) SetUpdatedAt(v time.Time) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateUpdatedAt() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProfileUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProfileUpsertOne.ID is not supported by MySQL driver. Use ProfileUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProfileUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ProfileCreateBulk is the builder for creating many Profile entities in bulk.
 type ProfileCreateBulk struct {
 	config
 	err      error
 	builders []*ProfileCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Profile entities in the database.
@@ -485,6 +1449,7 @@ func (pcb *ProfileCreateBulk) Save(ctx context.Context) ([]*Profile, error) {
 					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -534,3 +1499,442 @@ func (pcb *ProfileCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Profile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileUpsert) {
+//			SetUrn(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *ProfileCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProfileUpsertBulk {
+	pcb.conflict = opts
+	return &ProfileUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *ProfileCreateBulk) OnConflictColumns(columns ...string) *ProfileUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &ProfileUpsertBulk{
+		create: pcb,
+	}
+}
+
+// ProfileUpsertBulk is the builder for "upsert"-ing
+// a bulk of Profile nodes.
+type ProfileUpsertBulk struct {
+	create *ProfileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileUpsertBulk) UpdateNewValues() *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(profile.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(profile.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProfileUpsertBulk) Ignore() *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileUpsertBulk) DoNothing() *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProfileUpsertBulk) Update(set func(*ProfileUpsert)) *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUrn sets the "urn" field.
+func (u *ProfileUpsertBulk) SetUrn(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetUrn(v)
+	})
+}
+
+// UpdateUrn sets the "urn" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateUrn() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateUrn()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *ProfileUpsertBulk) SetUsername(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateUsername() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// ClearUsername clears the value of the "username" field.
+func (u *ProfileUpsertBulk) ClearUsername() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearUsername()
+	})
+}
+
+// SetFirstName sets the "first_name" field.
+func (u *ProfileUpsertBulk) SetFirstName(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetFirstName(v)
+	})
+}
+
+// UpdateFirstName sets the "first_name" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateFirstName() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateFirstName()
+	})
+}
+
+// ClearFirstName clears the value of the "first_name" field.
+func (u *ProfileUpsertBulk) ClearFirstName() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearFirstName()
+	})
+}
+
+// SetLastName sets the "last_name" field.
+func (u *ProfileUpsertBulk) SetLastName(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetLastName(v)
+	})
+}
+
+// UpdateLastName sets the "last_name" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateLastName() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateLastName()
+	})
+}
+
+// ClearLastName clears the value of the "last_name" field.
+func (u *ProfileUpsertBulk) ClearLastName() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearLastName()
+	})
+}
+
+// SetHeadline sets the "headline" field.
+func (u *ProfileUpsertBulk) SetHeadline(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetHeadline(v)
+	})
+}
+
+// UpdateHeadline sets the "headline" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateHeadline() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateHeadline()
+	})
+}
+
+// ClearHeadline clears the value of the "headline" field.
+func (u *ProfileUpsertBulk) ClearHeadline() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearHeadline()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ProfileUpsertBulk) SetTitle(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateTitle() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ProfileUpsertBulk) ClearTitle() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetCountry sets the "country" field.
+func (u *ProfileUpsertBulk) SetCountry(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateCountry() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// ClearCountry clears the value of the "country" field.
+func (u *ProfileUpsertBulk) ClearCountry() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearCountry()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *ProfileUpsertBulk) SetCity(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateCity() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// ClearCity clears the value of the "city" field.
+func (u *ProfileUpsertBulk) ClearCity() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearCity()
+	})
+}
+
+// SetEducations sets the "educations" field.
+func (u *ProfileUpsertBulk) SetEducations(v []map[string]interface{}) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetEducations(v)
+	})
+}
+
+// UpdateEducations sets the "educations" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateEducations() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateEducations()
+	})
+}
+
+// ClearEducations clears the value of the "educations" field.
+func (u *ProfileUpsertBulk) ClearEducations() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearEducations()
+	})
+}
+
+// SetPositions sets the "positions" field.
+func (u *ProfileUpsertBulk) SetPositions(v []map[string]interface{}) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetPositions(v)
+	})
+}
+
+// UpdatePositions sets the "positions" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdatePositions() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdatePositions()
+	})
+}
+
+// ClearPositions clears the value of the "positions" field.
+func (u *ProfileUpsertBulk) ClearPositions() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearPositions()
+	})
+}
+
+// SetSkills sets the "skills" field.
+func (u *ProfileUpsertBulk) SetSkills(v []map[string]interface{}) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetSkills(v)
+	})
+}
+
+// UpdateSkills sets the "skills" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateSkills() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateSkills()
+	})
+}
+
+// ClearSkills clears the value of the "skills" field.
+func (u *ProfileUpsertBulk) ClearSkills() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearSkills()
+	})
+}
+
+// SetGeoData sets the "geo_data" field.
+func (u *ProfileUpsertBulk) SetGeoData(v map[string]interface{}) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetGeoData(v)
+	})
+}
+
+// UpdateGeoData sets the "geo_data" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateGeoData() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateGeoData()
+	})
+}
+
+// ClearGeoData clears the value of the "geo_data" field.
+func (u *ProfileUpsertBulk) ClearGeoData() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearGeoData()
+	})
+}
+
+// SetRawDataS3Key sets the "raw_data_s3_key" field.
+func (u *ProfileUpsertBulk) SetRawDataS3Key(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetRawDataS3Key(v)
+	})
+}
+
+// UpdateRawDataS3Key sets the "raw_data_s3_key" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateRawDataS3Key() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateRawDataS3Key()
+	})
+}
+
+// ClearRawDataS3Key clears the value of the "raw_data_s3_key" field.
+func (u *ProfileUpsertBulk) ClearRawDataS3Key() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearRawDataS3Key()
+	})
+}
+
+// SetCleanedDataS3Key sets the "cleaned_data_s3_key" field.
+func (u *ProfileUpsertBulk) SetCleanedDataS3Key(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetCleanedDataS3Key(v)
+	})
+}
+
+// UpdateCleanedDataS3Key sets the "cleaned_data_s3_key" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateCleanedDataS3Key() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateCleanedDataS3Key()
+	})
+}
+
+// ClearCleanedDataS3Key clears the value of the "cleaned_data_s3_key" field.
+func (u *ProfileUpsertBulk) ClearCleanedDataS3Key() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearCleanedDataS3Key()
+	})
+}
+
+// SetSourceFile sets the "source_file" field.
+func (u *ProfileUpsertBulk) SetSourceFile(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetSourceFile(v)
+	})
+}
+
+// UpdateSourceFile sets the "source_file" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateSourceFile() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateSourceFile()
+	})
+}
+
+// ClearSourceFile clears the value of the "source_file" field.
+func (u *ProfileUpsertBulk) ClearSourceFile() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearSourceFile()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileUpsertBulk) SetUpdatedAt(v time.Time) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateUpdatedAt() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProfileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
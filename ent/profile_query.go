@@ -4,14 +4,22 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/predicate"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/schema/ulid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -20,14 +28,28 @@ import (
 // ProfileQuery is the builder for querying Profile entities.
 type ProfileQuery struct {
 	config
-	ctx              *QueryContext
-	order            []profile.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Profile
-	withProfileEntry *ProfileEntryQuery
-	withFKs          bool
-	modifiers        []func(*sql.Selector)
-	loadTotal        []func(context.Context, []*Profile) error
+	ctx                        *QueryContext
+	order                      []profile.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.Profile
+	withProfileEntry           *ProfileEntryQuery
+	withSnapshots              *ProfileSnapshotQuery
+	withChangeEvents           *ProfileChangeEventQuery
+	withProfilePositions       *ProfilePositionQuery
+	withProfileEducations      *ProfileEducationQuery
+	withProfileSkills          *ProfileSkillQuery
+	withCurrentCompanies       *CompanyQuery
+	withPastCompanies          *CompanyQuery
+	withFKs                    bool
+	loadTotal                  []func(context.Context, []*Profile) error
+	modifiers                  []func(*sql.Selector)
+	withNamedSnapshots         map[string]*ProfileSnapshotQuery
+	withNamedChangeEvents      map[string]*ProfileChangeEventQuery
+	withNamedProfilePositions  map[string]*ProfilePositionQuery
+	withNamedProfileEducations map[string]*ProfileEducationQuery
+	withNamedProfileSkills     map[string]*ProfileSkillQuery
+	withNamedCurrentCompanies  map[string]*CompanyQuery
+	withNamedPastCompanies     map[string]*CompanyQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +108,160 @@ func (pq *ProfileQuery) QueryProfileEntry() *ProfileEntryQuery {
 	return query
 }
 
+// QuerySnapshots chains the current query on the "snapshots" edge.
+func (pq *ProfileQuery) QuerySnapshots() *ProfileSnapshotQuery {
+	query := (&ProfileSnapshotClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(profilesnapshot.Table, profilesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.SnapshotsTable, profile.SnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChangeEvents chains the current query on the "change_events" edge.
+func (pq *ProfileQuery) QueryChangeEvents() *ProfileChangeEventQuery {
+	query := (&ProfileChangeEventClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(profilechangeevent.Table, profilechangeevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ChangeEventsTable, profile.ChangeEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProfilePositions chains the current query on the "profile_positions" edge.
+func (pq *ProfileQuery) QueryProfilePositions() *ProfilePositionQuery {
+	query := (&ProfilePositionClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(profileposition.Table, profileposition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ProfilePositionsTable, profile.ProfilePositionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProfileEducations chains the current query on the "profile_educations" edge.
+func (pq *ProfileQuery) QueryProfileEducations() *ProfileEducationQuery {
+	query := (&ProfileEducationClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(profileeducation.Table, profileeducation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ProfileEducationsTable, profile.ProfileEducationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProfileSkills chains the current query on the "profile_skills" edge.
+func (pq *ProfileQuery) QueryProfileSkills() *ProfileSkillQuery {
+	query := (&ProfileSkillClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(profileskill.Table, profileskill.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ProfileSkillsTable, profile.ProfileSkillsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCurrentCompanies chains the current query on the "current_companies" edge.
+func (pq *ProfileQuery) QueryCurrentCompanies() *CompanyQuery {
+	query := (&CompanyClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, profile.CurrentCompaniesTable, profile.CurrentCompaniesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPastCompanies chains the current query on the "past_companies" edge.
+func (pq *ProfileQuery) QueryPastCompanies() *CompanyQuery {
+	query := (&CompanyClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, profile.PastCompaniesTable, profile.PastCompaniesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Profile entity from the query.
 // Returns a *NotFoundError when no Profile was found.
 func (pq *ProfileQuery) First(ctx context.Context) (*Profile, error) {
@@ -273,12 +449,19 @@ func (pq *ProfileQuery) Clone() *ProfileQuery {
 		return nil
 	}
 	return &ProfileQuery{
-		config:           pq.config,
-		ctx:              pq.ctx.Clone(),
-		order:            append([]profile.OrderOption{}, pq.order...),
-		inters:           append([]Interceptor{}, pq.inters...),
-		predicates:       append([]predicate.Profile{}, pq.predicates...),
-		withProfileEntry: pq.withProfileEntry.Clone(),
+		config:                pq.config,
+		ctx:                   pq.ctx.Clone(),
+		order:                 append([]profile.OrderOption{}, pq.order...),
+		inters:                append([]Interceptor{}, pq.inters...),
+		predicates:            append([]predicate.Profile{}, pq.predicates...),
+		withProfileEntry:      pq.withProfileEntry.Clone(),
+		withSnapshots:         pq.withSnapshots.Clone(),
+		withChangeEvents:      pq.withChangeEvents.Clone(),
+		withProfilePositions:  pq.withProfilePositions.Clone(),
+		withProfileEducations: pq.withProfileEducations.Clone(),
+		withProfileSkills:     pq.withProfileSkills.Clone(),
+		withCurrentCompanies:  pq.withCurrentCompanies.Clone(),
+		withPastCompanies:     pq.withPastCompanies.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -296,6 +479,83 @@ func (pq *ProfileQuery) WithProfileEntry(opts ...func(*ProfileEntryQuery)) *Prof
 	return pq
 }
 
+// WithSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithSnapshots(opts ...func(*ProfileSnapshotQuery)) *ProfileQuery {
+	query := (&ProfileSnapshotClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withSnapshots = query
+	return pq
+}
+
+// WithChangeEvents tells the query-builder to eager-load the nodes that are connected to
+// the "change_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithChangeEvents(opts ...func(*ProfileChangeEventQuery)) *ProfileQuery {
+	query := (&ProfileChangeEventClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withChangeEvents = query
+	return pq
+}
+
+// WithProfilePositions tells the query-builder to eager-load the nodes that are connected to
+// the "profile_positions" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithProfilePositions(opts ...func(*ProfilePositionQuery)) *ProfileQuery {
+	query := (&ProfilePositionClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withProfilePositions = query
+	return pq
+}
+
+// WithProfileEducations tells the query-builder to eager-load the nodes that are connected to
+// the "profile_educations" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithProfileEducations(opts ...func(*ProfileEducationQuery)) *ProfileQuery {
+	query := (&ProfileEducationClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withProfileEducations = query
+	return pq
+}
+
+// WithProfileSkills tells the query-builder to eager-load the nodes that are connected to
+// the "profile_skills" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithProfileSkills(opts ...func(*ProfileSkillQuery)) *ProfileQuery {
+	query := (&ProfileSkillClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withProfileSkills = query
+	return pq
+}
+
+// WithCurrentCompanies tells the query-builder to eager-load the nodes that are connected to
+// the "current_companies" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithCurrentCompanies(opts ...func(*CompanyQuery)) *ProfileQuery {
+	query := (&CompanyClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withCurrentCompanies = query
+	return pq
+}
+
+// WithPastCompanies tells the query-builder to eager-load the nodes that are connected to
+// the "past_companies" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithPastCompanies(opts ...func(*CompanyQuery)) *ProfileQuery {
+	query := (&CompanyClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withPastCompanies = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -375,8 +635,15 @@ func (pq *ProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prof
 		nodes       = []*Profile{}
 		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [8]bool{
 			pq.withProfileEntry != nil,
+			pq.withSnapshots != nil,
+			pq.withChangeEvents != nil,
+			pq.withProfilePositions != nil,
+			pq.withProfileEducations != nil,
+			pq.withProfileSkills != nil,
+			pq.withCurrentCompanies != nil,
+			pq.withPastCompanies != nil,
 		}
 	)
 	if pq.withProfileEntry != nil {
@@ -412,6 +679,106 @@ func (pq *ProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prof
 			return nil, err
 		}
 	}
+	if query := pq.withSnapshots; query != nil {
+		if err := pq.loadSnapshots(ctx, query, nodes,
+			func(n *Profile) { n.Edges.Snapshots = []*ProfileSnapshot{} },
+			func(n *Profile, e *ProfileSnapshot) { n.Edges.Snapshots = append(n.Edges.Snapshots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withChangeEvents; query != nil {
+		if err := pq.loadChangeEvents(ctx, query, nodes,
+			func(n *Profile) { n.Edges.ChangeEvents = []*ProfileChangeEvent{} },
+			func(n *Profile, e *ProfileChangeEvent) { n.Edges.ChangeEvents = append(n.Edges.ChangeEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withProfilePositions; query != nil {
+		if err := pq.loadProfilePositions(ctx, query, nodes,
+			func(n *Profile) { n.Edges.ProfilePositions = []*ProfilePosition{} },
+			func(n *Profile, e *ProfilePosition) { n.Edges.ProfilePositions = append(n.Edges.ProfilePositions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withProfileEducations; query != nil {
+		if err := pq.loadProfileEducations(ctx, query, nodes,
+			func(n *Profile) { n.Edges.ProfileEducations = []*ProfileEducation{} },
+			func(n *Profile, e *ProfileEducation) {
+				n.Edges.ProfileEducations = append(n.Edges.ProfileEducations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withProfileSkills; query != nil {
+		if err := pq.loadProfileSkills(ctx, query, nodes,
+			func(n *Profile) { n.Edges.ProfileSkills = []*ProfileSkill{} },
+			func(n *Profile, e *ProfileSkill) { n.Edges.ProfileSkills = append(n.Edges.ProfileSkills, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withCurrentCompanies; query != nil {
+		if err := pq.loadCurrentCompanies(ctx, query, nodes,
+			func(n *Profile) { n.Edges.CurrentCompanies = []*Company{} },
+			func(n *Profile, e *Company) { n.Edges.CurrentCompanies = append(n.Edges.CurrentCompanies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withPastCompanies; query != nil {
+		if err := pq.loadPastCompanies(ctx, query, nodes,
+			func(n *Profile) { n.Edges.PastCompanies = []*Company{} },
+			func(n *Profile, e *Company) { n.Edges.PastCompanies = append(n.Edges.PastCompanies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range pq.withNamedSnapshots {
+		if err := pq.loadSnapshots(ctx, query, nodes,
+			func(n *Profile) { n.appendNamedSnapshots(name) },
+			func(n *Profile, e *ProfileSnapshot) { n.appendNamedSnapshots(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range pq.withNamedChangeEvents {
+		if err := pq.loadChangeEvents(ctx, query, nodes,
+			func(n *Profile) { n.appendNamedChangeEvents(name) },
+			func(n *Profile, e *ProfileChangeEvent) { n.appendNamedChangeEvents(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range pq.withNamedProfilePositions {
+		if err := pq.loadProfilePositions(ctx, query, nodes,
+			func(n *Profile) { n.appendNamedProfilePositions(name) },
+			func(n *Profile, e *ProfilePosition) { n.appendNamedProfilePositions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range pq.withNamedProfileEducations {
+		if err := pq.loadProfileEducations(ctx, query, nodes,
+			func(n *Profile) { n.appendNamedProfileEducations(name) },
+			func(n *Profile, e *ProfileEducation) { n.appendNamedProfileEducations(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range pq.withNamedProfileSkills {
+		if err := pq.loadProfileSkills(ctx, query, nodes,
+			func(n *Profile) { n.appendNamedProfileSkills(name) },
+			func(n *Profile, e *ProfileSkill) { n.appendNamedProfileSkills(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range pq.withNamedCurrentCompanies {
+		if err := pq.loadCurrentCompanies(ctx, query, nodes,
+			func(n *Profile) { n.appendNamedCurrentCompanies(name) },
+			func(n *Profile, e *Company) { n.appendNamedCurrentCompanies(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range pq.withNamedPastCompanies {
+		if err := pq.loadPastCompanies(ctx, query, nodes,
+			func(n *Profile) { n.appendNamedPastCompanies(name) },
+			func(n *Profile, e *Company) { n.appendNamedPastCompanies(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range pq.loadTotal {
 		if err := pq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -452,6 +819,283 @@ func (pq *ProfileQuery) loadProfileEntry(ctx context.Context, query *ProfileEntr
 	}
 	return nil
 }
+func (pq *ProfileQuery) loadSnapshots(ctx context.Context, query *ProfileSnapshotQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *ProfileSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[ulid.ID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProfileSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.SnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.profile_snapshots
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "profile_snapshots" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_snapshots" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProfileQuery) loadChangeEvents(ctx context.Context, query *ProfileChangeEventQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *ProfileChangeEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[ulid.ID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProfileChangeEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.ChangeEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.profile_change_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "profile_change_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_change_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProfileQuery) loadProfilePositions(ctx context.Context, query *ProfilePositionQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *ProfilePosition)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[ulid.ID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProfilePosition(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.ProfilePositionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.profile_profile_positions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "profile_profile_positions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_profile_positions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProfileQuery) loadProfileEducations(ctx context.Context, query *ProfileEducationQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *ProfileEducation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[ulid.ID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProfileEducation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.ProfileEducationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.profile_profile_educations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "profile_profile_educations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_profile_educations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProfileQuery) loadProfileSkills(ctx context.Context, query *ProfileSkillQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *ProfileSkill)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[ulid.ID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProfileSkill(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.ProfileSkillsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.profile_profile_skills
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "profile_profile_skills" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_profile_skills" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProfileQuery) loadCurrentCompanies(ctx context.Context, query *CompanyQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *Company)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[ulid.ID]*Profile)
+	nids := make(map[ulid.ID]map[*Profile]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(profile.CurrentCompaniesTable)
+		s.Join(joinT).On(s.C(company.FieldID), joinT.C(profile.CurrentCompaniesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(profile.CurrentCompaniesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(profile.CurrentCompaniesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(ulid.ID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*ulid.ID)
+				inValue := *values[1].(*ulid.ID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Profile]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Company](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "current_companies" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pq *ProfileQuery) loadPastCompanies(ctx context.Context, query *CompanyQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *Company)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[ulid.ID]*Profile)
+	nids := make(map[ulid.ID]map[*Profile]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(profile.PastCompaniesTable)
+		s.Join(joinT).On(s.C(company.FieldID), joinT.C(profile.PastCompaniesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(profile.PastCompaniesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(profile.PastCompaniesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(ulid.ID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*ulid.ID)
+				inValue := *values[1].(*ulid.ID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Profile]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Company](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "past_companies" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (pq *ProfileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
@@ -520,6 +1164,9 @@ func (pq *ProfileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pq.ctx.Unique != nil && *pq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pq.modifiers {
+		m(selector)
+	}
 	for _, p := range pq.predicates {
 		p(selector)
 	}
@@ -537,6 +1184,130 @@ func (pq *ProfileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pq *ProfileQuery) ForUpdate(opts ...sql.LockOption) *ProfileQuery {
+	if pq.driver.Dialect() == dialect.Postgres {
+		pq.Unique(false)
+	}
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pq *ProfileQuery) ForShare(opts ...sql.LockOption) *ProfileQuery {
+	if pq.driver.Dialect() == dialect.Postgres {
+		pq.Unique(false)
+	}
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pq
+}
+
+// WithNamedSnapshots tells the query-builder to eager-load the nodes that are connected to the "snapshots"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithNamedSnapshots(name string, opts ...func(*ProfileSnapshotQuery)) *ProfileQuery {
+	query := (&ProfileSnapshotClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if pq.withNamedSnapshots == nil {
+		pq.withNamedSnapshots = make(map[string]*ProfileSnapshotQuery)
+	}
+	pq.withNamedSnapshots[name] = query
+	return pq
+}
+
+// WithNamedChangeEvents tells the query-builder to eager-load the nodes that are connected to the "change_events"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithNamedChangeEvents(name string, opts ...func(*ProfileChangeEventQuery)) *ProfileQuery {
+	query := (&ProfileChangeEventClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if pq.withNamedChangeEvents == nil {
+		pq.withNamedChangeEvents = make(map[string]*ProfileChangeEventQuery)
+	}
+	pq.withNamedChangeEvents[name] = query
+	return pq
+}
+
+// WithNamedProfilePositions tells the query-builder to eager-load the nodes that are connected to the "profile_positions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithNamedProfilePositions(name string, opts ...func(*ProfilePositionQuery)) *ProfileQuery {
+	query := (&ProfilePositionClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if pq.withNamedProfilePositions == nil {
+		pq.withNamedProfilePositions = make(map[string]*ProfilePositionQuery)
+	}
+	pq.withNamedProfilePositions[name] = query
+	return pq
+}
+
+// WithNamedProfileEducations tells the query-builder to eager-load the nodes that are connected to the "profile_educations"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithNamedProfileEducations(name string, opts ...func(*ProfileEducationQuery)) *ProfileQuery {
+	query := (&ProfileEducationClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if pq.withNamedProfileEducations == nil {
+		pq.withNamedProfileEducations = make(map[string]*ProfileEducationQuery)
+	}
+	pq.withNamedProfileEducations[name] = query
+	return pq
+}
+
+// WithNamedProfileSkills tells the query-builder to eager-load the nodes that are connected to the "profile_skills"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithNamedProfileSkills(name string, opts ...func(*ProfileSkillQuery)) *ProfileQuery {
+	query := (&ProfileSkillClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if pq.withNamedProfileSkills == nil {
+		pq.withNamedProfileSkills = make(map[string]*ProfileSkillQuery)
+	}
+	pq.withNamedProfileSkills[name] = query
+	return pq
+}
+
+// WithNamedCurrentCompanies tells the query-builder to eager-load the nodes that are connected to the "current_companies"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithNamedCurrentCompanies(name string, opts ...func(*CompanyQuery)) *ProfileQuery {
+	query := (&CompanyClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if pq.withNamedCurrentCompanies == nil {
+		pq.withNamedCurrentCompanies = make(map[string]*CompanyQuery)
+	}
+	pq.withNamedCurrentCompanies[name] = query
+	return pq
+}
+
+// WithNamedPastCompanies tells the query-builder to eager-load the nodes that are connected to the "past_companies"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithNamedPastCompanies(name string, opts ...func(*CompanyQuery)) *ProfileQuery {
+	query := (&CompanyClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if pq.withNamedPastCompanies == nil {
+		pq.withNamedPastCompanies = make(map[string]*CompanyQuery)
+	}
+	pq.withNamedPastCompanies[name] = query
+	return pq
+}
+
 // ProfileGroupBy is the group-by builder for Profile entities.
 type ProfileGroupBy struct {
 	selector
@@ -6,9 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/predicate"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/schema/ulid"
 	"time"
 
@@ -336,6 +342,111 @@ func (pu *ProfileUpdate) SetProfileEntry(p *ProfileEntry) *ProfileUpdate {
 	return pu.SetProfileEntryID(p.ID)
 }
 
+// AddSnapshotIDs adds the "snapshots" edge to the ProfileSnapshot entity by IDs.
+func (pu *ProfileUpdate) AddSnapshotIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.AddSnapshotIDs(ids...)
+	return pu
+}
+
+// AddSnapshots adds the "snapshots" edges to the ProfileSnapshot entity.
+func (pu *ProfileUpdate) AddSnapshots(p ...*ProfileSnapshot) *ProfileUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddSnapshotIDs(ids...)
+}
+
+// AddChangeEventIDs adds the "change_events" edge to the ProfileChangeEvent entity by IDs.
+func (pu *ProfileUpdate) AddChangeEventIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.AddChangeEventIDs(ids...)
+	return pu
+}
+
+// AddChangeEvents adds the "change_events" edges to the ProfileChangeEvent entity.
+func (pu *ProfileUpdate) AddChangeEvents(p ...*ProfileChangeEvent) *ProfileUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddChangeEventIDs(ids...)
+}
+
+// AddProfilePositionIDs adds the "profile_positions" edge to the ProfilePosition entity by IDs.
+func (pu *ProfileUpdate) AddProfilePositionIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.AddProfilePositionIDs(ids...)
+	return pu
+}
+
+// AddProfilePositions adds the "profile_positions" edges to the ProfilePosition entity.
+func (pu *ProfileUpdate) AddProfilePositions(p ...*ProfilePosition) *ProfileUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddProfilePositionIDs(ids...)
+}
+
+// AddProfileEducationIDs adds the "profile_educations" edge to the ProfileEducation entity by IDs.
+func (pu *ProfileUpdate) AddProfileEducationIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.AddProfileEducationIDs(ids...)
+	return pu
+}
+
+// AddProfileEducations adds the "profile_educations" edges to the ProfileEducation entity.
+func (pu *ProfileUpdate) AddProfileEducations(p ...*ProfileEducation) *ProfileUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddProfileEducationIDs(ids...)
+}
+
+// AddProfileSkillIDs adds the "profile_skills" edge to the ProfileSkill entity by IDs.
+func (pu *ProfileUpdate) AddProfileSkillIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.AddProfileSkillIDs(ids...)
+	return pu
+}
+
+// AddProfileSkills adds the "profile_skills" edges to the ProfileSkill entity.
+func (pu *ProfileUpdate) AddProfileSkills(p ...*ProfileSkill) *ProfileUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddProfileSkillIDs(ids...)
+}
+
+// AddCurrentCompanyIDs adds the "current_companies" edge to the Company entity by IDs.
+func (pu *ProfileUpdate) AddCurrentCompanyIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.AddCurrentCompanyIDs(ids...)
+	return pu
+}
+
+// AddCurrentCompanies adds the "current_companies" edges to the Company entity.
+func (pu *ProfileUpdate) AddCurrentCompanies(c ...*Company) *ProfileUpdate {
+	ids := make([]ulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.AddCurrentCompanyIDs(ids...)
+}
+
+// AddPastCompanyIDs adds the "past_companies" edge to the Company entity by IDs.
+func (pu *ProfileUpdate) AddPastCompanyIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.AddPastCompanyIDs(ids...)
+	return pu
+}
+
+// AddPastCompanies adds the "past_companies" edges to the Company entity.
+func (pu *ProfileUpdate) AddPastCompanies(c ...*Company) *ProfileUpdate {
+	ids := make([]ulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.AddPastCompanyIDs(ids...)
+}
+
 // Mutation returns the ProfileMutation object of the builder.
 func (pu *ProfileUpdate) Mutation() *ProfileMutation {
 	return pu.mutation
@@ -347,6 +458,153 @@ func (pu *ProfileUpdate) ClearProfileEntry() *ProfileUpdate {
 	return pu
 }
 
+// ClearSnapshots clears all "snapshots" edges to the ProfileSnapshot entity.
+func (pu *ProfileUpdate) ClearSnapshots() *ProfileUpdate {
+	pu.mutation.ClearSnapshots()
+	return pu
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to ProfileSnapshot entities by IDs.
+func (pu *ProfileUpdate) RemoveSnapshotIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.RemoveSnapshotIDs(ids...)
+	return pu
+}
+
+// RemoveSnapshots removes "snapshots" edges to ProfileSnapshot entities.
+func (pu *ProfileUpdate) RemoveSnapshots(p ...*ProfileSnapshot) *ProfileUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveSnapshotIDs(ids...)
+}
+
+// ClearChangeEvents clears all "change_events" edges to the ProfileChangeEvent entity.
+func (pu *ProfileUpdate) ClearChangeEvents() *ProfileUpdate {
+	pu.mutation.ClearChangeEvents()
+	return pu
+}
+
+// RemoveChangeEventIDs removes the "change_events" edge to ProfileChangeEvent entities by IDs.
+func (pu *ProfileUpdate) RemoveChangeEventIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.RemoveChangeEventIDs(ids...)
+	return pu
+}
+
+// RemoveChangeEvents removes "change_events" edges to ProfileChangeEvent entities.
+func (pu *ProfileUpdate) RemoveChangeEvents(p ...*ProfileChangeEvent) *ProfileUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveChangeEventIDs(ids...)
+}
+
+// ClearProfilePositions clears all "profile_positions" edges to the ProfilePosition entity.
+func (pu *ProfileUpdate) ClearProfilePositions() *ProfileUpdate {
+	pu.mutation.ClearProfilePositions()
+	return pu
+}
+
+// RemoveProfilePositionIDs removes the "profile_positions" edge to ProfilePosition entities by IDs.
+func (pu *ProfileUpdate) RemoveProfilePositionIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.RemoveProfilePositionIDs(ids...)
+	return pu
+}
+
+// RemoveProfilePositions removes "profile_positions" edges to ProfilePosition entities.
+func (pu *ProfileUpdate) RemoveProfilePositions(p ...*ProfilePosition) *ProfileUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveProfilePositionIDs(ids...)
+}
+
+// ClearProfileEducations clears all "profile_educations" edges to the ProfileEducation entity.
+func (pu *ProfileUpdate) ClearProfileEducations() *ProfileUpdate {
+	pu.mutation.ClearProfileEducations()
+	return pu
+}
+
+// RemoveProfileEducationIDs removes the "profile_educations" edge to ProfileEducation entities by IDs.
+func (pu *ProfileUpdate) RemoveProfileEducationIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.RemoveProfileEducationIDs(ids...)
+	return pu
+}
+
+// RemoveProfileEducations removes "profile_educations" edges to ProfileEducation entities.
+func (pu *ProfileUpdate) RemoveProfileEducations(p ...*ProfileEducation) *ProfileUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveProfileEducationIDs(ids...)
+}
+
+// ClearProfileSkills clears all "profile_skills" edges to the ProfileSkill entity.
+func (pu *ProfileUpdate) ClearProfileSkills() *ProfileUpdate {
+	pu.mutation.ClearProfileSkills()
+	return pu
+}
+
+// RemoveProfileSkillIDs removes the "profile_skills" edge to ProfileSkill entities by IDs.
+func (pu *ProfileUpdate) RemoveProfileSkillIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.RemoveProfileSkillIDs(ids...)
+	return pu
+}
+
+// RemoveProfileSkills removes "profile_skills" edges to ProfileSkill entities.
+func (pu *ProfileUpdate) RemoveProfileSkills(p ...*ProfileSkill) *ProfileUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveProfileSkillIDs(ids...)
+}
+
+// ClearCurrentCompanies clears all "current_companies" edges to the Company entity.
+func (pu *ProfileUpdate) ClearCurrentCompanies() *ProfileUpdate {
+	pu.mutation.ClearCurrentCompanies()
+	return pu
+}
+
+// RemoveCurrentCompanyIDs removes the "current_companies" edge to Company entities by IDs.
+func (pu *ProfileUpdate) RemoveCurrentCompanyIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.RemoveCurrentCompanyIDs(ids...)
+	return pu
+}
+
+// RemoveCurrentCompanies removes "current_companies" edges to Company entities.
+func (pu *ProfileUpdate) RemoveCurrentCompanies(c ...*Company) *ProfileUpdate {
+	ids := make([]ulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.RemoveCurrentCompanyIDs(ids...)
+}
+
+// ClearPastCompanies clears all "past_companies" edges to the Company entity.
+func (pu *ProfileUpdate) ClearPastCompanies() *ProfileUpdate {
+	pu.mutation.ClearPastCompanies()
+	return pu
+}
+
+// RemovePastCompanyIDs removes the "past_companies" edge to Company entities by IDs.
+func (pu *ProfileUpdate) RemovePastCompanyIDs(ids ...ulid.ID) *ProfileUpdate {
+	pu.mutation.RemovePastCompanyIDs(ids...)
+	return pu
+}
+
+// RemovePastCompanies removes "past_companies" edges to Company entities.
+func (pu *ProfileUpdate) RemovePastCompanies(c ...*Company) *ProfileUpdate {
+	ids := make([]ulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.RemovePastCompanyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *ProfileUpdate) Save(ctx context.Context) (int, error) {
 	pu.defaults()
@@ -549,6 +807,321 @@ func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SnapshotsTable,
+			Columns: []string{profile.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !pu.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SnapshotsTable,
+			Columns: []string{profile.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SnapshotsTable,
+			Columns: []string{profile.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ChangeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ChangeEventsTable,
+			Columns: []string{profile.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedChangeEventsIDs(); len(nodes) > 0 && !pu.mutation.ChangeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ChangeEventsTable,
+			Columns: []string{profile.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ChangeEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ChangeEventsTable,
+			Columns: []string{profile.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ProfilePositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfilePositionsTable,
+			Columns: []string{profile.ProfilePositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedProfilePositionsIDs(); len(nodes) > 0 && !pu.mutation.ProfilePositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfilePositionsTable,
+			Columns: []string{profile.ProfilePositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ProfilePositionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfilePositionsTable,
+			Columns: []string{profile.ProfilePositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ProfileEducationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileEducationsTable,
+			Columns: []string{profile.ProfileEducationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedProfileEducationsIDs(); len(nodes) > 0 && !pu.mutation.ProfileEducationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileEducationsTable,
+			Columns: []string{profile.ProfileEducationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ProfileEducationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileEducationsTable,
+			Columns: []string{profile.ProfileEducationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ProfileSkillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileSkillsTable,
+			Columns: []string{profile.ProfileSkillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedProfileSkillsIDs(); len(nodes) > 0 && !pu.mutation.ProfileSkillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileSkillsTable,
+			Columns: []string{profile.ProfileSkillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ProfileSkillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileSkillsTable,
+			Columns: []string{profile.ProfileSkillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.CurrentCompaniesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.CurrentCompaniesTable,
+			Columns: profile.CurrentCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedCurrentCompaniesIDs(); len(nodes) > 0 && !pu.mutation.CurrentCompaniesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.CurrentCompaniesTable,
+			Columns: profile.CurrentCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.CurrentCompaniesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.CurrentCompaniesTable,
+			Columns: profile.CurrentCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.PastCompaniesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.PastCompaniesTable,
+			Columns: profile.PastCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedPastCompaniesIDs(); len(nodes) > 0 && !pu.mutation.PastCompaniesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.PastCompaniesTable,
+			Columns: profile.PastCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.PastCompaniesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.PastCompaniesTable,
+			Columns: profile.PastCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{profile.Label}
@@ -783,108 +1356,360 @@ func (puo *ProfileUpdateOne) SetGeoData(m map[string]interface{}) *ProfileUpdate
 	return puo
 }
 
-// ClearGeoData clears the value of the "geo_data" field.
-func (puo *ProfileUpdateOne) ClearGeoData() *ProfileUpdateOne {
-	puo.mutation.ClearGeoData()
+// ClearGeoData clears the value of the "geo_data" field.
+func (puo *ProfileUpdateOne) ClearGeoData() *ProfileUpdateOne {
+	puo.mutation.ClearGeoData()
+	return puo
+}
+
+// SetRawDataS3Key sets the "raw_data_s3_key" field.
+func (puo *ProfileUpdateOne) SetRawDataS3Key(s string) *ProfileUpdateOne {
+	puo.mutation.SetRawDataS3Key(s)
+	return puo
+}
+
+// SetNillableRawDataS3Key sets the "raw_data_s3_key" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableRawDataS3Key(s *string) *ProfileUpdateOne {
+	if s != nil {
+		puo.SetRawDataS3Key(*s)
+	}
+	return puo
+}
+
+// ClearRawDataS3Key clears the value of the "raw_data_s3_key" field.
+func (puo *ProfileUpdateOne) ClearRawDataS3Key() *ProfileUpdateOne {
+	puo.mutation.ClearRawDataS3Key()
+	return puo
+}
+
+// SetCleanedDataS3Key sets the "cleaned_data_s3_key" field.
+func (puo *ProfileUpdateOne) SetCleanedDataS3Key(s string) *ProfileUpdateOne {
+	puo.mutation.SetCleanedDataS3Key(s)
+	return puo
+}
+
+// SetNillableCleanedDataS3Key sets the "cleaned_data_s3_key" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableCleanedDataS3Key(s *string) *ProfileUpdateOne {
+	if s != nil {
+		puo.SetCleanedDataS3Key(*s)
+	}
+	return puo
+}
+
+// ClearCleanedDataS3Key clears the value of the "cleaned_data_s3_key" field.
+func (puo *ProfileUpdateOne) ClearCleanedDataS3Key() *ProfileUpdateOne {
+	puo.mutation.ClearCleanedDataS3Key()
+	return puo
+}
+
+// SetSourceFile sets the "source_file" field.
+func (puo *ProfileUpdateOne) SetSourceFile(s string) *ProfileUpdateOne {
+	puo.mutation.SetSourceFile(s)
+	return puo
+}
+
+// SetNillableSourceFile sets the "source_file" field if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableSourceFile(s *string) *ProfileUpdateOne {
+	if s != nil {
+		puo.SetSourceFile(*s)
+	}
+	return puo
+}
+
+// ClearSourceFile clears the value of the "source_file" field.
+func (puo *ProfileUpdateOne) ClearSourceFile() *ProfileUpdateOne {
+	puo.mutation.ClearSourceFile()
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *ProfileUpdateOne) SetUpdatedAt(t time.Time) *ProfileUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetProfileEntryID sets the "profile_entry" edge to the ProfileEntry entity by ID.
+func (puo *ProfileUpdateOne) SetProfileEntryID(id ulid.ID) *ProfileUpdateOne {
+	puo.mutation.SetProfileEntryID(id)
+	return puo
+}
+
+// SetNillableProfileEntryID sets the "profile_entry" edge to the ProfileEntry entity by ID if the given value is not nil.
+func (puo *ProfileUpdateOne) SetNillableProfileEntryID(id *ulid.ID) *ProfileUpdateOne {
+	if id != nil {
+		puo = puo.SetProfileEntryID(*id)
+	}
+	return puo
+}
+
+// SetProfileEntry sets the "profile_entry" edge to the ProfileEntry entity.
+func (puo *ProfileUpdateOne) SetProfileEntry(p *ProfileEntry) *ProfileUpdateOne {
+	return puo.SetProfileEntryID(p.ID)
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the ProfileSnapshot entity by IDs.
+func (puo *ProfileUpdateOne) AddSnapshotIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.AddSnapshotIDs(ids...)
+	return puo
+}
+
+// AddSnapshots adds the "snapshots" edges to the ProfileSnapshot entity.
+func (puo *ProfileUpdateOne) AddSnapshots(p ...*ProfileSnapshot) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddSnapshotIDs(ids...)
+}
+
+// AddChangeEventIDs adds the "change_events" edge to the ProfileChangeEvent entity by IDs.
+func (puo *ProfileUpdateOne) AddChangeEventIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.AddChangeEventIDs(ids...)
+	return puo
+}
+
+// AddChangeEvents adds the "change_events" edges to the ProfileChangeEvent entity.
+func (puo *ProfileUpdateOne) AddChangeEvents(p ...*ProfileChangeEvent) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddChangeEventIDs(ids...)
+}
+
+// AddProfilePositionIDs adds the "profile_positions" edge to the ProfilePosition entity by IDs.
+func (puo *ProfileUpdateOne) AddProfilePositionIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.AddProfilePositionIDs(ids...)
+	return puo
+}
+
+// AddProfilePositions adds the "profile_positions" edges to the ProfilePosition entity.
+func (puo *ProfileUpdateOne) AddProfilePositions(p ...*ProfilePosition) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddProfilePositionIDs(ids...)
+}
+
+// AddProfileEducationIDs adds the "profile_educations" edge to the ProfileEducation entity by IDs.
+func (puo *ProfileUpdateOne) AddProfileEducationIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.AddProfileEducationIDs(ids...)
+	return puo
+}
+
+// AddProfileEducations adds the "profile_educations" edges to the ProfileEducation entity.
+func (puo *ProfileUpdateOne) AddProfileEducations(p ...*ProfileEducation) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddProfileEducationIDs(ids...)
+}
+
+// AddProfileSkillIDs adds the "profile_skills" edge to the ProfileSkill entity by IDs.
+func (puo *ProfileUpdateOne) AddProfileSkillIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.AddProfileSkillIDs(ids...)
+	return puo
+}
+
+// AddProfileSkills adds the "profile_skills" edges to the ProfileSkill entity.
+func (puo *ProfileUpdateOne) AddProfileSkills(p ...*ProfileSkill) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddProfileSkillIDs(ids...)
+}
+
+// AddCurrentCompanyIDs adds the "current_companies" edge to the Company entity by IDs.
+func (puo *ProfileUpdateOne) AddCurrentCompanyIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.AddCurrentCompanyIDs(ids...)
+	return puo
+}
+
+// AddCurrentCompanies adds the "current_companies" edges to the Company entity.
+func (puo *ProfileUpdateOne) AddCurrentCompanies(c ...*Company) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.AddCurrentCompanyIDs(ids...)
+}
+
+// AddPastCompanyIDs adds the "past_companies" edge to the Company entity by IDs.
+func (puo *ProfileUpdateOne) AddPastCompanyIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.AddPastCompanyIDs(ids...)
+	return puo
+}
+
+// AddPastCompanies adds the "past_companies" edges to the Company entity.
+func (puo *ProfileUpdateOne) AddPastCompanies(c ...*Company) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.AddPastCompanyIDs(ids...)
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (puo *ProfileUpdateOne) Mutation() *ProfileMutation {
+	return puo.mutation
+}
+
+// ClearProfileEntry clears the "profile_entry" edge to the ProfileEntry entity.
+func (puo *ProfileUpdateOne) ClearProfileEntry() *ProfileUpdateOne {
+	puo.mutation.ClearProfileEntry()
+	return puo
+}
+
+// ClearSnapshots clears all "snapshots" edges to the ProfileSnapshot entity.
+func (puo *ProfileUpdateOne) ClearSnapshots() *ProfileUpdateOne {
+	puo.mutation.ClearSnapshots()
+	return puo
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to ProfileSnapshot entities by IDs.
+func (puo *ProfileUpdateOne) RemoveSnapshotIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.RemoveSnapshotIDs(ids...)
+	return puo
+}
+
+// RemoveSnapshots removes "snapshots" edges to ProfileSnapshot entities.
+func (puo *ProfileUpdateOne) RemoveSnapshots(p ...*ProfileSnapshot) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveSnapshotIDs(ids...)
+}
+
+// ClearChangeEvents clears all "change_events" edges to the ProfileChangeEvent entity.
+func (puo *ProfileUpdateOne) ClearChangeEvents() *ProfileUpdateOne {
+	puo.mutation.ClearChangeEvents()
 	return puo
 }
 
-// SetRawDataS3Key sets the "raw_data_s3_key" field.
-func (puo *ProfileUpdateOne) SetRawDataS3Key(s string) *ProfileUpdateOne {
-	puo.mutation.SetRawDataS3Key(s)
+// RemoveChangeEventIDs removes the "change_events" edge to ProfileChangeEvent entities by IDs.
+func (puo *ProfileUpdateOne) RemoveChangeEventIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.RemoveChangeEventIDs(ids...)
 	return puo
 }
 
-// SetNillableRawDataS3Key sets the "raw_data_s3_key" field if the given value is not nil.
-func (puo *ProfileUpdateOne) SetNillableRawDataS3Key(s *string) *ProfileUpdateOne {
-	if s != nil {
-		puo.SetRawDataS3Key(*s)
+// RemoveChangeEvents removes "change_events" edges to ProfileChangeEvent entities.
+func (puo *ProfileUpdateOne) RemoveChangeEvents(p ...*ProfileChangeEvent) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return puo
+	return puo.RemoveChangeEventIDs(ids...)
 }
 
-// ClearRawDataS3Key clears the value of the "raw_data_s3_key" field.
-func (puo *ProfileUpdateOne) ClearRawDataS3Key() *ProfileUpdateOne {
-	puo.mutation.ClearRawDataS3Key()
+// ClearProfilePositions clears all "profile_positions" edges to the ProfilePosition entity.
+func (puo *ProfileUpdateOne) ClearProfilePositions() *ProfileUpdateOne {
+	puo.mutation.ClearProfilePositions()
 	return puo
 }
 
-// SetCleanedDataS3Key sets the "cleaned_data_s3_key" field.
-func (puo *ProfileUpdateOne) SetCleanedDataS3Key(s string) *ProfileUpdateOne {
-	puo.mutation.SetCleanedDataS3Key(s)
+// RemoveProfilePositionIDs removes the "profile_positions" edge to ProfilePosition entities by IDs.
+func (puo *ProfileUpdateOne) RemoveProfilePositionIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.RemoveProfilePositionIDs(ids...)
 	return puo
 }
 
-// SetNillableCleanedDataS3Key sets the "cleaned_data_s3_key" field if the given value is not nil.
-func (puo *ProfileUpdateOne) SetNillableCleanedDataS3Key(s *string) *ProfileUpdateOne {
-	if s != nil {
-		puo.SetCleanedDataS3Key(*s)
+// RemoveProfilePositions removes "profile_positions" edges to ProfilePosition entities.
+func (puo *ProfileUpdateOne) RemoveProfilePositions(p ...*ProfilePosition) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return puo
+	return puo.RemoveProfilePositionIDs(ids...)
 }
 
-// ClearCleanedDataS3Key clears the value of the "cleaned_data_s3_key" field.
-func (puo *ProfileUpdateOne) ClearCleanedDataS3Key() *ProfileUpdateOne {
-	puo.mutation.ClearCleanedDataS3Key()
+// ClearProfileEducations clears all "profile_educations" edges to the ProfileEducation entity.
+func (puo *ProfileUpdateOne) ClearProfileEducations() *ProfileUpdateOne {
+	puo.mutation.ClearProfileEducations()
 	return puo
 }
 
-// SetSourceFile sets the "source_file" field.
-func (puo *ProfileUpdateOne) SetSourceFile(s string) *ProfileUpdateOne {
-	puo.mutation.SetSourceFile(s)
+// RemoveProfileEducationIDs removes the "profile_educations" edge to ProfileEducation entities by IDs.
+func (puo *ProfileUpdateOne) RemoveProfileEducationIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.RemoveProfileEducationIDs(ids...)
 	return puo
 }
 
-// SetNillableSourceFile sets the "source_file" field if the given value is not nil.
-func (puo *ProfileUpdateOne) SetNillableSourceFile(s *string) *ProfileUpdateOne {
-	if s != nil {
-		puo.SetSourceFile(*s)
+// RemoveProfileEducations removes "profile_educations" edges to ProfileEducation entities.
+func (puo *ProfileUpdateOne) RemoveProfileEducations(p ...*ProfileEducation) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return puo
+	return puo.RemoveProfileEducationIDs(ids...)
 }
 
-// ClearSourceFile clears the value of the "source_file" field.
-func (puo *ProfileUpdateOne) ClearSourceFile() *ProfileUpdateOne {
-	puo.mutation.ClearSourceFile()
+// ClearProfileSkills clears all "profile_skills" edges to the ProfileSkill entity.
+func (puo *ProfileUpdateOne) ClearProfileSkills() *ProfileUpdateOne {
+	puo.mutation.ClearProfileSkills()
 	return puo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (puo *ProfileUpdateOne) SetUpdatedAt(t time.Time) *ProfileUpdateOne {
-	puo.mutation.SetUpdatedAt(t)
+// RemoveProfileSkillIDs removes the "profile_skills" edge to ProfileSkill entities by IDs.
+func (puo *ProfileUpdateOne) RemoveProfileSkillIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.RemoveProfileSkillIDs(ids...)
 	return puo
 }
 
-// SetProfileEntryID sets the "profile_entry" edge to the ProfileEntry entity by ID.
-func (puo *ProfileUpdateOne) SetProfileEntryID(id ulid.ID) *ProfileUpdateOne {
-	puo.mutation.SetProfileEntryID(id)
+// RemoveProfileSkills removes "profile_skills" edges to ProfileSkill entities.
+func (puo *ProfileUpdateOne) RemoveProfileSkills(p ...*ProfileSkill) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveProfileSkillIDs(ids...)
+}
+
+// ClearCurrentCompanies clears all "current_companies" edges to the Company entity.
+func (puo *ProfileUpdateOne) ClearCurrentCompanies() *ProfileUpdateOne {
+	puo.mutation.ClearCurrentCompanies()
 	return puo
 }
 
-// SetNillableProfileEntryID sets the "profile_entry" edge to the ProfileEntry entity by ID if the given value is not nil.
-func (puo *ProfileUpdateOne) SetNillableProfileEntryID(id *ulid.ID) *ProfileUpdateOne {
-	if id != nil {
-		puo = puo.SetProfileEntryID(*id)
-	}
+// RemoveCurrentCompanyIDs removes the "current_companies" edge to Company entities by IDs.
+func (puo *ProfileUpdateOne) RemoveCurrentCompanyIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.RemoveCurrentCompanyIDs(ids...)
 	return puo
 }
 
-// SetProfileEntry sets the "profile_entry" edge to the ProfileEntry entity.
-func (puo *ProfileUpdateOne) SetProfileEntry(p *ProfileEntry) *ProfileUpdateOne {
-	return puo.SetProfileEntryID(p.ID)
+// RemoveCurrentCompanies removes "current_companies" edges to Company entities.
+func (puo *ProfileUpdateOne) RemoveCurrentCompanies(c ...*Company) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.RemoveCurrentCompanyIDs(ids...)
 }
 
-// Mutation returns the ProfileMutation object of the builder.
-func (puo *ProfileUpdateOne) Mutation() *ProfileMutation {
-	return puo.mutation
+// ClearPastCompanies clears all "past_companies" edges to the Company entity.
+func (puo *ProfileUpdateOne) ClearPastCompanies() *ProfileUpdateOne {
+	puo.mutation.ClearPastCompanies()
+	return puo
 }
 
-// ClearProfileEntry clears the "profile_entry" edge to the ProfileEntry entity.
-func (puo *ProfileUpdateOne) ClearProfileEntry() *ProfileUpdateOne {
-	puo.mutation.ClearProfileEntry()
+// RemovePastCompanyIDs removes the "past_companies" edge to Company entities by IDs.
+func (puo *ProfileUpdateOne) RemovePastCompanyIDs(ids ...ulid.ID) *ProfileUpdateOne {
+	puo.mutation.RemovePastCompanyIDs(ids...)
 	return puo
 }
 
+// RemovePastCompanies removes "past_companies" edges to Company entities.
+func (puo *ProfileUpdateOne) RemovePastCompanies(c ...*Company) *ProfileUpdateOne {
+	ids := make([]ulid.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.RemovePastCompanyIDs(ids...)
+}
+
 // Where appends a list predicates to the ProfileUpdate builder.
 func (puo *ProfileUpdateOne) Where(ps ...predicate.Profile) *ProfileUpdateOne {
 	puo.mutation.Where(ps...)
@@ -1117,6 +1942,321 @@ func (puo *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SnapshotsTable,
+			Columns: []string{profile.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !puo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SnapshotsTable,
+			Columns: []string{profile.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SnapshotsTable,
+			Columns: []string{profile.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ChangeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ChangeEventsTable,
+			Columns: []string{profile.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedChangeEventsIDs(); len(nodes) > 0 && !puo.mutation.ChangeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ChangeEventsTable,
+			Columns: []string{profile.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ChangeEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ChangeEventsTable,
+			Columns: []string{profile.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ProfilePositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfilePositionsTable,
+			Columns: []string{profile.ProfilePositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedProfilePositionsIDs(); len(nodes) > 0 && !puo.mutation.ProfilePositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfilePositionsTable,
+			Columns: []string{profile.ProfilePositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ProfilePositionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfilePositionsTable,
+			Columns: []string{profile.ProfilePositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ProfileEducationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileEducationsTable,
+			Columns: []string{profile.ProfileEducationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedProfileEducationsIDs(); len(nodes) > 0 && !puo.mutation.ProfileEducationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileEducationsTable,
+			Columns: []string{profile.ProfileEducationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ProfileEducationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileEducationsTable,
+			Columns: []string{profile.ProfileEducationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ProfileSkillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileSkillsTable,
+			Columns: []string{profile.ProfileSkillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedProfileSkillsIDs(); len(nodes) > 0 && !puo.mutation.ProfileSkillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileSkillsTable,
+			Columns: []string{profile.ProfileSkillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ProfileSkillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ProfileSkillsTable,
+			Columns: []string{profile.ProfileSkillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.CurrentCompaniesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.CurrentCompaniesTable,
+			Columns: profile.CurrentCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedCurrentCompaniesIDs(); len(nodes) > 0 && !puo.mutation.CurrentCompaniesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.CurrentCompaniesTable,
+			Columns: profile.CurrentCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.CurrentCompaniesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.CurrentCompaniesTable,
+			Columns: profile.CurrentCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.PastCompaniesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.PastCompaniesTable,
+			Columns: profile.PastCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedPastCompaniesIDs(); len(nodes) > 0 && !puo.mutation.PastCompaniesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.PastCompaniesTable,
+			Columns: profile.PastCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.PastCompaniesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.PastCompaniesTable,
+			Columns: profile.PastCompaniesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Profile{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
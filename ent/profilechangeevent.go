@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profilesnapshot"
+	"sheng-go-backend/ent/schema/ulid"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProfileChangeEvent is the model entity for the ProfileChangeEvent schema.
+type ProfileChangeEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID ulid.ID `json:"id,omitempty"`
+	// Kind of change
+	Type profilechangeevent.Type `json:"type,omitempty"`
+	// Value before the change
+	OldValue *string `json:"old_value,omitempty"`
+	// Value after the change
+	NewValue *string `json:"new_value,omitempty"`
+	// Fetch time of the snapshot that revealed the change
+	DetectedAt time.Time `json:"detected_at,omitempty"`
+	// Webhook delivery state
+	DeliveryStatus profilechangeevent.DeliveryStatus `json:"delivery_status,omitempty"`
+	// Webhook delivery attempts made so far
+	DeliveryAttempts int `json:"delivery_attempts,omitempty"`
+	// Earliest time the next delivery attempt may run
+	NextDeliveryAt *time.Time `json:"next_delivery_at,omitempty"`
+	// Time the webhook acknowledged the event
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// Error from the most recent failed delivery attempt
+	LastDeliveryError *string `json:"last_delivery_error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProfileChangeEventQuery when eager-loading is set.
+	Edges                          ProfileChangeEventEdges `json:"edges"`
+	profile_change_events          *ulid.ID
+	profile_snapshot_change_events *ulid.ID
+	selectValues                   sql.SelectValues
+}
+
+// ProfileChangeEventEdges holds the relations/edges for other nodes in the graph.
+type ProfileChangeEventEdges struct {
+	// Profile the change was detected on
+	Profile *Profile `json:"profile,omitempty"`
+	// Snapshot that revealed the change
+	Snapshot *ProfileSnapshot `json:"snapshot,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProfileChangeEventEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// SnapshotOrErr returns the Snapshot value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProfileChangeEventEdges) SnapshotOrErr() (*ProfileSnapshot, error) {
+	if e.Snapshot != nil {
+		return e.Snapshot, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: profilesnapshot.Label}
+	}
+	return nil, &NotLoadedError{edge: "snapshot"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProfileChangeEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case profilechangeevent.FieldDeliveryAttempts:
+			values[i] = new(sql.NullInt64)
+		case profilechangeevent.FieldType, profilechangeevent.FieldOldValue, profilechangeevent.FieldNewValue, profilechangeevent.FieldDeliveryStatus, profilechangeevent.FieldLastDeliveryError:
+			values[i] = new(sql.NullString)
+		case profilechangeevent.FieldDetectedAt, profilechangeevent.FieldNextDeliveryAt, profilechangeevent.FieldDeliveredAt, profilechangeevent.FieldCreatedAt, profilechangeevent.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case profilechangeevent.FieldID:
+			values[i] = new(ulid.ID)
+		case profilechangeevent.ForeignKeys[0]: // profile_change_events
+			values[i] = &sql.NullScanner{S: new(ulid.ID)}
+		case profilechangeevent.ForeignKeys[1]: // profile_snapshot_change_events
+			values[i] = &sql.NullScanner{S: new(ulid.ID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProfileChangeEvent fields.
+func (pce *ProfileChangeEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case profilechangeevent.FieldID:
+			if value, ok := values[i].(*ulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pce.ID = *value
+			}
+		case profilechangeevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				pce.Type = profilechangeevent.Type(value.String)
+			}
+		case profilechangeevent.FieldOldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_value", values[i])
+			} else if value.Valid {
+				pce.OldValue = new(string)
+				*pce.OldValue = value.String
+			}
+		case profilechangeevent.FieldNewValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_value", values[i])
+			} else if value.Valid {
+				pce.NewValue = new(string)
+				*pce.NewValue = value.String
+			}
+		case profilechangeevent.FieldDetectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field detected_at", values[i])
+			} else if value.Valid {
+				pce.DetectedAt = value.Time
+			}
+		case profilechangeevent.FieldDeliveryStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_status", values[i])
+			} else if value.Valid {
+				pce.DeliveryStatus = profilechangeevent.DeliveryStatus(value.String)
+			}
+		case profilechangeevent.FieldDeliveryAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_attempts", values[i])
+			} else if value.Valid {
+				pce.DeliveryAttempts = int(value.Int64)
+			}
+		case profilechangeevent.FieldNextDeliveryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_delivery_at", values[i])
+			} else if value.Valid {
+				pce.NextDeliveryAt = new(time.Time)
+				*pce.NextDeliveryAt = value.Time
+			}
+		case profilechangeevent.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				pce.DeliveredAt = new(time.Time)
+				*pce.DeliveredAt = value.Time
+			}
+		case profilechangeevent.FieldLastDeliveryError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_delivery_error", values[i])
+			} else if value.Valid {
+				pce.LastDeliveryError = new(string)
+				*pce.LastDeliveryError = value.String
+			}
+		case profilechangeevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pce.CreatedAt = value.Time
+			}
+		case profilechangeevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pce.UpdatedAt = value.Time
+			}
+		case profilechangeevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_change_events", values[i])
+			} else if value.Valid {
+				pce.profile_change_events = new(ulid.ID)
+				*pce.profile_change_events = *value.S.(*ulid.ID)
+			}
+		case profilechangeevent.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_snapshot_change_events", values[i])
+			} else if value.Valid {
+				pce.profile_snapshot_change_events = new(ulid.ID)
+				*pce.profile_snapshot_change_events = *value.S.(*ulid.ID)
+			}
+		default:
+			pce.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProfileChangeEvent.
+// This includes values selected through modifiers, order, etc.
+func (pce *ProfileChangeEvent) Value(name string) (ent.Value, error) {
+	return pce.selectValues.Get(name)
+}
+
+// QueryProfile queries the "profile" edge of the ProfileChangeEvent entity.
+func (pce *ProfileChangeEvent) QueryProfile() *ProfileQuery {
+	return NewProfileChangeEventClient(pce.config).QueryProfile(pce)
+}
+
+// QuerySnapshot queries the "snapshot" edge of the ProfileChangeEvent entity.
+func (pce *ProfileChangeEvent) QuerySnapshot() *ProfileSnapshotQuery {
+	return NewProfileChangeEventClient(pce.config).QuerySnapshot(pce)
+}
+
+// Update returns a builder for updating this ProfileChangeEvent.
+// Note that you need to call ProfileChangeEvent.Unwrap() before calling this method if this ProfileChangeEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pce *ProfileChangeEvent) Update() *ProfileChangeEventUpdateOne {
+	return NewProfileChangeEventClient(pce.config).UpdateOne(pce)
+}
+
+// Unwrap unwraps the ProfileChangeEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pce *ProfileChangeEvent) Unwrap() *ProfileChangeEvent {
+	_tx, ok := pce.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProfileChangeEvent is not a transactional entity")
+	}
+	pce.config.driver = _tx.drv
+	return pce
+}
+
+// String implements the fmt.Stringer.
+func (pce *ProfileChangeEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProfileChangeEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pce.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", pce.Type))
+	builder.WriteString(", ")
+	if v := pce.OldValue; v != nil {
+		builder.WriteString("old_value=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pce.NewValue; v != nil {
+		builder.WriteString("new_value=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("detected_at=")
+	builder.WriteString(pce.DetectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("delivery_status=")
+	builder.WriteString(fmt.Sprintf("%v", pce.DeliveryStatus))
+	builder.WriteString(", ")
+	builder.WriteString("delivery_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", pce.DeliveryAttempts))
+	builder.WriteString(", ")
+	if v := pce.NextDeliveryAt; v != nil {
+		builder.WriteString("next_delivery_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pce.DeliveredAt; v != nil {
+		builder.WriteString("delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pce.LastDeliveryError; v != nil {
+		builder.WriteString("last_delivery_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pce.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pce.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProfileChangeEvents is a parsable slice of ProfileChangeEvent.
+type ProfileChangeEvents []*ProfileChangeEvent
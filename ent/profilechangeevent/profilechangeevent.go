@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profilechangeevent
+
+import (
+	"fmt"
+	"io"
+	"sheng-go-backend/ent/schema/ulid"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the profilechangeevent type in the database.
+	Label = "profile_change_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldOldValue holds the string denoting the old_value field in the database.
+	FieldOldValue = "old_value"
+	// FieldNewValue holds the string denoting the new_value field in the database.
+	FieldNewValue = "new_value"
+	// FieldDetectedAt holds the string denoting the detected_at field in the database.
+	FieldDetectedAt = "detected_at"
+	// FieldDeliveryStatus holds the string denoting the delivery_status field in the database.
+	FieldDeliveryStatus = "delivery_status"
+	// FieldDeliveryAttempts holds the string denoting the delivery_attempts field in the database.
+	FieldDeliveryAttempts = "delivery_attempts"
+	// FieldNextDeliveryAt holds the string denoting the next_delivery_at field in the database.
+	FieldNextDeliveryAt = "next_delivery_at"
+	// FieldDeliveredAt holds the string denoting the delivered_at field in the database.
+	FieldDeliveredAt = "delivered_at"
+	// FieldLastDeliveryError holds the string denoting the last_delivery_error field in the database.
+	FieldLastDeliveryError = "last_delivery_error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeProfile holds the string denoting the profile edge name in mutations.
+	EdgeProfile = "profile"
+	// EdgeSnapshot holds the string denoting the snapshot edge name in mutations.
+	EdgeSnapshot = "snapshot"
+	// Table holds the table name of the profilechangeevent in the database.
+	Table = "profile_change_events"
+	// ProfileTable is the table that holds the profile relation/edge.
+	ProfileTable = "profile_change_events"
+	// ProfileInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	ProfileInverseTable = "profiles"
+	// ProfileColumn is the table column denoting the profile relation/edge.
+	ProfileColumn = "profile_change_events"
+	// SnapshotTable is the table that holds the snapshot relation/edge.
+	SnapshotTable = "profile_change_events"
+	// SnapshotInverseTable is the table name for the ProfileSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "profilesnapshot" package.
+	SnapshotInverseTable = "profile_snapshots"
+	// SnapshotColumn is the table column denoting the snapshot relation/edge.
+	SnapshotColumn = "profile_snapshot_change_events"
+)
+
+// Columns holds all SQL columns for profilechangeevent fields.
+var Columns = []string{
+	FieldID,
+	FieldType,
+	FieldOldValue,
+	FieldNewValue,
+	FieldDetectedAt,
+	FieldDeliveryStatus,
+	FieldDeliveryAttempts,
+	FieldNextDeliveryAt,
+	FieldDeliveredAt,
+	FieldLastDeliveryError,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "profile_change_events"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"profile_change_events",
+	"profile_snapshot_change_events",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDeliveryAttempts holds the default value on creation for the "delivery_attempts" field.
+	DefaultDeliveryAttempts int
+	// DeliveryAttemptsValidator is a validator for the "delivery_attempts" field. It is called by the builders before save.
+	DeliveryAttemptsValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() ulid.ID
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeJobChange      Type = "JOB_CHANGE"
+	TypeTitleChange    Type = "TITLE_CHANGE"
+	TypeLocationChange Type = "LOCATION_CHANGE"
+	TypeNewSkill       Type = "NEW_SKILL"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeJobChange, TypeTitleChange, TypeLocationChange, TypeNewSkill:
+		return nil
+	default:
+		return fmt.Errorf("profilechangeevent: invalid enum value for type field: %q", _type)
+	}
+}
+
+// DeliveryStatus defines the type for the "delivery_status" enum field.
+type DeliveryStatus string
+
+// DeliveryStatusPending is the default value of the DeliveryStatus enum.
+const DefaultDeliveryStatus = DeliveryStatusPending
+
+// DeliveryStatus values.
+const (
+	DeliveryStatusPending   DeliveryStatus = "PENDING"
+	DeliveryStatusDelivered DeliveryStatus = "DELIVERED"
+	DeliveryStatusFailed    DeliveryStatus = "FAILED"
+	DeliveryStatusSkipped   DeliveryStatus = "SKIPPED"
+)
+
+func (ds DeliveryStatus) String() string {
+	return string(ds)
+}
+
+// DeliveryStatusValidator is a validator for the "delivery_status" field enum values. It is called by the builders before save.
+func DeliveryStatusValidator(ds DeliveryStatus) error {
+	switch ds {
+	case DeliveryStatusPending, DeliveryStatusDelivered, DeliveryStatusFailed, DeliveryStatusSkipped:
+		return nil
+	default:
+		return fmt.Errorf("profilechangeevent: invalid enum value for delivery_status field: %q", ds)
+	}
+}
+
+// OrderOption defines the ordering options for the ProfileChangeEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByOldValue orders the results by the old_value field.
+func ByOldValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldValue, opts...).ToFunc()
+}
+
+// ByNewValue orders the results by the new_value field.
+func ByNewValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewValue, opts...).ToFunc()
+}
+
+// ByDetectedAt orders the results by the detected_at field.
+func ByDetectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetectedAt, opts...).ToFunc()
+}
+
+// ByDeliveryStatus orders the results by the delivery_status field.
+func ByDeliveryStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryStatus, opts...).ToFunc()
+}
+
+// ByDeliveryAttempts orders the results by the delivery_attempts field.
+func ByDeliveryAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryAttempts, opts...).ToFunc()
+}
+
+// ByNextDeliveryAt orders the results by the next_delivery_at field.
+func ByNextDeliveryAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextDeliveryAt, opts...).ToFunc()
+}
+
+// ByDeliveredAt orders the results by the delivered_at field.
+func ByDeliveredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveredAt, opts...).ToFunc()
+}
+
+// ByLastDeliveryError orders the results by the last_delivery_error field.
+func ByLastDeliveryError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDeliveryError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByProfileField orders the results by profile field.
+func ByProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySnapshotField orders the results by snapshot field.
+func BySnapshotField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+	)
+}
+func newSnapshotStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SnapshotTable, SnapshotColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Type) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Type) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Type(str)
+	if err := TypeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Type", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e DeliveryStatus) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *DeliveryStatus) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = DeliveryStatus(str)
+	if err := DeliveryStatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid DeliveryStatus", str)
+	}
+	return nil
+}
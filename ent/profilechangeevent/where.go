@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profilechangeevent
+
+import (
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id ulid.ID) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id ulid.ID) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id ulid.ID) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...ulid.ID) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...ulid.ID) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id ulid.ID) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id ulid.ID) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id ulid.ID) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id ulid.ID) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLTE(FieldID, id))
+}
+
+// OldValue applies equality check predicate on the "old_value" field. It's identical to OldValueEQ.
+func OldValue(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldOldValue, v))
+}
+
+// NewValue applies equality check predicate on the "new_value" field. It's identical to NewValueEQ.
+func NewValue(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldNewValue, v))
+}
+
+// DetectedAt applies equality check predicate on the "detected_at" field. It's identical to DetectedAtEQ.
+func DetectedAt(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldDetectedAt, v))
+}
+
+// DeliveryAttempts applies equality check predicate on the "delivery_attempts" field. It's identical to DeliveryAttemptsEQ.
+func DeliveryAttempts(v int) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldDeliveryAttempts, v))
+}
+
+// NextDeliveryAt applies equality check predicate on the "next_delivery_at" field. It's identical to NextDeliveryAtEQ.
+func NextDeliveryAt(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldNextDeliveryAt, v))
+}
+
+// DeliveredAt applies equality check predicate on the "delivered_at" field. It's identical to DeliveredAtEQ.
+func DeliveredAt(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// LastDeliveryError applies equality check predicate on the "last_delivery_error" field. It's identical to LastDeliveryErrorEQ.
+func LastDeliveryError(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldLastDeliveryError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// OldValueEQ applies the EQ predicate on the "old_value" field.
+func OldValueEQ(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldOldValue, v))
+}
+
+// OldValueNEQ applies the NEQ predicate on the "old_value" field.
+func OldValueNEQ(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldOldValue, v))
+}
+
+// OldValueIn applies the In predicate on the "old_value" field.
+func OldValueIn(vs ...string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldOldValue, vs...))
+}
+
+// OldValueNotIn applies the NotIn predicate on the "old_value" field.
+func OldValueNotIn(vs ...string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldOldValue, vs...))
+}
+
+// OldValueGT applies the GT predicate on the "old_value" field.
+func OldValueGT(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGT(FieldOldValue, v))
+}
+
+// OldValueGTE applies the GTE predicate on the "old_value" field.
+func OldValueGTE(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGTE(FieldOldValue, v))
+}
+
+// OldValueLT applies the LT predicate on the "old_value" field.
+func OldValueLT(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLT(FieldOldValue, v))
+}
+
+// OldValueLTE applies the LTE predicate on the "old_value" field.
+func OldValueLTE(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLTE(FieldOldValue, v))
+}
+
+// OldValueContains applies the Contains predicate on the "old_value" field.
+func OldValueContains(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldContains(FieldOldValue, v))
+}
+
+// OldValueHasPrefix applies the HasPrefix predicate on the "old_value" field.
+func OldValueHasPrefix(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldHasPrefix(FieldOldValue, v))
+}
+
+// OldValueHasSuffix applies the HasSuffix predicate on the "old_value" field.
+func OldValueHasSuffix(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldHasSuffix(FieldOldValue, v))
+}
+
+// OldValueIsNil applies the IsNil predicate on the "old_value" field.
+func OldValueIsNil() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIsNull(FieldOldValue))
+}
+
+// OldValueNotNil applies the NotNil predicate on the "old_value" field.
+func OldValueNotNil() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotNull(FieldOldValue))
+}
+
+// OldValueEqualFold applies the EqualFold predicate on the "old_value" field.
+func OldValueEqualFold(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEqualFold(FieldOldValue, v))
+}
+
+// OldValueContainsFold applies the ContainsFold predicate on the "old_value" field.
+func OldValueContainsFold(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldContainsFold(FieldOldValue, v))
+}
+
+// NewValueEQ applies the EQ predicate on the "new_value" field.
+func NewValueEQ(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldNewValue, v))
+}
+
+// NewValueNEQ applies the NEQ predicate on the "new_value" field.
+func NewValueNEQ(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldNewValue, v))
+}
+
+// NewValueIn applies the In predicate on the "new_value" field.
+func NewValueIn(vs ...string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldNewValue, vs...))
+}
+
+// NewValueNotIn applies the NotIn predicate on the "new_value" field.
+func NewValueNotIn(vs ...string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldNewValue, vs...))
+}
+
+// NewValueGT applies the GT predicate on the "new_value" field.
+func NewValueGT(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGT(FieldNewValue, v))
+}
+
+// NewValueGTE applies the GTE predicate on the "new_value" field.
+func NewValueGTE(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGTE(FieldNewValue, v))
+}
+
+// NewValueLT applies the LT predicate on the "new_value" field.
+func NewValueLT(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLT(FieldNewValue, v))
+}
+
+// NewValueLTE applies the LTE predicate on the "new_value" field.
+func NewValueLTE(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLTE(FieldNewValue, v))
+}
+
+// NewValueContains applies the Contains predicate on the "new_value" field.
+func NewValueContains(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldContains(FieldNewValue, v))
+}
+
+// NewValueHasPrefix applies the HasPrefix predicate on the "new_value" field.
+func NewValueHasPrefix(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldHasPrefix(FieldNewValue, v))
+}
+
+// NewValueHasSuffix applies the HasSuffix predicate on the "new_value" field.
+func NewValueHasSuffix(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldHasSuffix(FieldNewValue, v))
+}
+
+// NewValueIsNil applies the IsNil predicate on the "new_value" field.
+func NewValueIsNil() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIsNull(FieldNewValue))
+}
+
+// NewValueNotNil applies the NotNil predicate on the "new_value" field.
+func NewValueNotNil() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotNull(FieldNewValue))
+}
+
+// NewValueEqualFold applies the EqualFold predicate on the "new_value" field.
+func NewValueEqualFold(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEqualFold(FieldNewValue, v))
+}
+
+// NewValueContainsFold applies the ContainsFold predicate on the "new_value" field.
+func NewValueContainsFold(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldContainsFold(FieldNewValue, v))
+}
+
+// DetectedAtEQ applies the EQ predicate on the "detected_at" field.
+func DetectedAtEQ(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldDetectedAt, v))
+}
+
+// DetectedAtNEQ applies the NEQ predicate on the "detected_at" field.
+func DetectedAtNEQ(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldDetectedAt, v))
+}
+
+// DetectedAtIn applies the In predicate on the "detected_at" field.
+func DetectedAtIn(vs ...time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldDetectedAt, vs...))
+}
+
+// DetectedAtNotIn applies the NotIn predicate on the "detected_at" field.
+func DetectedAtNotIn(vs ...time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldDetectedAt, vs...))
+}
+
+// DetectedAtGT applies the GT predicate on the "detected_at" field.
+func DetectedAtGT(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGT(FieldDetectedAt, v))
+}
+
+// DetectedAtGTE applies the GTE predicate on the "detected_at" field.
+func DetectedAtGTE(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGTE(FieldDetectedAt, v))
+}
+
+// DetectedAtLT applies the LT predicate on the "detected_at" field.
+func DetectedAtLT(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLT(FieldDetectedAt, v))
+}
+
+// DetectedAtLTE applies the LTE predicate on the "detected_at" field.
+func DetectedAtLTE(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLTE(FieldDetectedAt, v))
+}
+
+// DeliveryStatusEQ applies the EQ predicate on the "delivery_status" field.
+func DeliveryStatusEQ(v DeliveryStatus) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldDeliveryStatus, v))
+}
+
+// DeliveryStatusNEQ applies the NEQ predicate on the "delivery_status" field.
+func DeliveryStatusNEQ(v DeliveryStatus) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldDeliveryStatus, v))
+}
+
+// DeliveryStatusIn applies the In predicate on the "delivery_status" field.
+func DeliveryStatusIn(vs ...DeliveryStatus) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldDeliveryStatus, vs...))
+}
+
+// DeliveryStatusNotIn applies the NotIn predicate on the "delivery_status" field.
+func DeliveryStatusNotIn(vs ...DeliveryStatus) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldDeliveryStatus, vs...))
+}
+
+// DeliveryAttemptsEQ applies the EQ predicate on the "delivery_attempts" field.
+func DeliveryAttemptsEQ(v int) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldDeliveryAttempts, v))
+}
+
+// DeliveryAttemptsNEQ applies the NEQ predicate on the "delivery_attempts" field.
+func DeliveryAttemptsNEQ(v int) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldDeliveryAttempts, v))
+}
+
+// DeliveryAttemptsIn applies the In predicate on the "delivery_attempts" field.
+func DeliveryAttemptsIn(vs ...int) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldDeliveryAttempts, vs...))
+}
+
+// DeliveryAttemptsNotIn applies the NotIn predicate on the "delivery_attempts" field.
+func DeliveryAttemptsNotIn(vs ...int) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldDeliveryAttempts, vs...))
+}
+
+// DeliveryAttemptsGT applies the GT predicate on the "delivery_attempts" field.
+func DeliveryAttemptsGT(v int) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGT(FieldDeliveryAttempts, v))
+}
+
+// DeliveryAttemptsGTE applies the GTE predicate on the "delivery_attempts" field.
+func DeliveryAttemptsGTE(v int) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGTE(FieldDeliveryAttempts, v))
+}
+
+// DeliveryAttemptsLT applies the LT predicate on the "delivery_attempts" field.
+func DeliveryAttemptsLT(v int) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLT(FieldDeliveryAttempts, v))
+}
+
+// DeliveryAttemptsLTE applies the LTE predicate on the "delivery_attempts" field.
+func DeliveryAttemptsLTE(v int) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLTE(FieldDeliveryAttempts, v))
+}
+
+// NextDeliveryAtEQ applies the EQ predicate on the "next_delivery_at" field.
+func NextDeliveryAtEQ(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldNextDeliveryAt, v))
+}
+
+// NextDeliveryAtNEQ applies the NEQ predicate on the "next_delivery_at" field.
+func NextDeliveryAtNEQ(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldNextDeliveryAt, v))
+}
+
+// NextDeliveryAtIn applies the In predicate on the "next_delivery_at" field.
+func NextDeliveryAtIn(vs ...time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldNextDeliveryAt, vs...))
+}
+
+// NextDeliveryAtNotIn applies the NotIn predicate on the "next_delivery_at" field.
+func NextDeliveryAtNotIn(vs ...time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldNextDeliveryAt, vs...))
+}
+
+// NextDeliveryAtGT applies the GT predicate on the "next_delivery_at" field.
+func NextDeliveryAtGT(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGT(FieldNextDeliveryAt, v))
+}
+
+// NextDeliveryAtGTE applies the GTE predicate on the "next_delivery_at" field.
+func NextDeliveryAtGTE(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGTE(FieldNextDeliveryAt, v))
+}
+
+// NextDeliveryAtLT applies the LT predicate on the "next_delivery_at" field.
+func NextDeliveryAtLT(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLT(FieldNextDeliveryAt, v))
+}
+
+// NextDeliveryAtLTE applies the LTE predicate on the "next_delivery_at" field.
+func NextDeliveryAtLTE(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLTE(FieldNextDeliveryAt, v))
+}
+
+// NextDeliveryAtIsNil applies the IsNil predicate on the "next_delivery_at" field.
+func NextDeliveryAtIsNil() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIsNull(FieldNextDeliveryAt))
+}
+
+// NextDeliveryAtNotNil applies the NotNil predicate on the "next_delivery_at" field.
+func NextDeliveryAtNotNil() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotNull(FieldNextDeliveryAt))
+}
+
+// DeliveredAtEQ applies the EQ predicate on the "delivered_at" field.
+func DeliveredAtEQ(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtNEQ applies the NEQ predicate on the "delivered_at" field.
+func DeliveredAtNEQ(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIn applies the In predicate on the "delivered_at" field.
+func DeliveredAtIn(vs ...time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtNotIn applies the NotIn predicate on the "delivered_at" field.
+func DeliveredAtNotIn(vs ...time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtGT applies the GT predicate on the "delivered_at" field.
+func DeliveredAtGT(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtGTE applies the GTE predicate on the "delivered_at" field.
+func DeliveredAtGTE(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLT applies the LT predicate on the "delivered_at" field.
+func DeliveredAtLT(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLTE applies the LTE predicate on the "delivered_at" field.
+func DeliveredAtLTE(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIsNil applies the IsNil predicate on the "delivered_at" field.
+func DeliveredAtIsNil() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIsNull(FieldDeliveredAt))
+}
+
+// DeliveredAtNotNil applies the NotNil predicate on the "delivered_at" field.
+func DeliveredAtNotNil() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotNull(FieldDeliveredAt))
+}
+
+// LastDeliveryErrorEQ applies the EQ predicate on the "last_delivery_error" field.
+func LastDeliveryErrorEQ(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldLastDeliveryError, v))
+}
+
+// LastDeliveryErrorNEQ applies the NEQ predicate on the "last_delivery_error" field.
+func LastDeliveryErrorNEQ(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldLastDeliveryError, v))
+}
+
+// LastDeliveryErrorIn applies the In predicate on the "last_delivery_error" field.
+func LastDeliveryErrorIn(vs ...string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldLastDeliveryError, vs...))
+}
+
+// LastDeliveryErrorNotIn applies the NotIn predicate on the "last_delivery_error" field.
+func LastDeliveryErrorNotIn(vs ...string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldLastDeliveryError, vs...))
+}
+
+// LastDeliveryErrorGT applies the GT predicate on the "last_delivery_error" field.
+func LastDeliveryErrorGT(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGT(FieldLastDeliveryError, v))
+}
+
+// LastDeliveryErrorGTE applies the GTE predicate on the "last_delivery_error" field.
+func LastDeliveryErrorGTE(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGTE(FieldLastDeliveryError, v))
+}
+
+// LastDeliveryErrorLT applies the LT predicate on the "last_delivery_error" field.
+func LastDeliveryErrorLT(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLT(FieldLastDeliveryError, v))
+}
+
+// LastDeliveryErrorLTE applies the LTE predicate on the "last_delivery_error" field.
+func LastDeliveryErrorLTE(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLTE(FieldLastDeliveryError, v))
+}
+
+// LastDeliveryErrorContains applies the Contains predicate on the "last_delivery_error" field.
+func LastDeliveryErrorContains(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldContains(FieldLastDeliveryError, v))
+}
+
+// LastDeliveryErrorHasPrefix applies the HasPrefix predicate on the "last_delivery_error" field.
+func LastDeliveryErrorHasPrefix(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldHasPrefix(FieldLastDeliveryError, v))
+}
+
+// LastDeliveryErrorHasSuffix applies the HasSuffix predicate on the "last_delivery_error" field.
+func LastDeliveryErrorHasSuffix(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldHasSuffix(FieldLastDeliveryError, v))
+}
+
+// LastDeliveryErrorIsNil applies the IsNil predicate on the "last_delivery_error" field.
+func LastDeliveryErrorIsNil() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIsNull(FieldLastDeliveryError))
+}
+
+// LastDeliveryErrorNotNil applies the NotNil predicate on the "last_delivery_error" field.
+func LastDeliveryErrorNotNil() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotNull(FieldLastDeliveryError))
+}
+
+// LastDeliveryErrorEqualFold applies the EqualFold predicate on the "last_delivery_error" field.
+func LastDeliveryErrorEqualFold(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEqualFold(FieldLastDeliveryError, v))
+}
+
+// LastDeliveryErrorContainsFold applies the ContainsFold predicate on the "last_delivery_error" field.
+func LastDeliveryErrorContainsFold(v string) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldContainsFold(FieldLastDeliveryError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSnapshot applies the HasEdge predicate on the "snapshot" edge.
+func HasSnapshot() predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SnapshotTable, SnapshotColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotWith applies the HasEdge predicate on the "snapshot" edge with a given conditions (other predicates).
+func HasSnapshotWith(preds ...predicate.ProfileSnapshot) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(func(s *sql.Selector) {
+		step := newSnapshotStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProfileChangeEvent) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProfileChangeEvent) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProfileChangeEvent) predicate.ProfileChangeEvent {
+	return predicate.ProfileChangeEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,1106 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profilesnapshot"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileChangeEventCreate is the builder for creating a ProfileChangeEvent entity.
+type ProfileChangeEventCreate struct {
+	config
+	mutation *ProfileChangeEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetType sets the "type" field.
+func (pcec *ProfileChangeEventCreate) SetType(pr profilechangeevent.Type) *ProfileChangeEventCreate {
+	pcec.mutation.SetType(pr)
+	return pcec
+}
+
+// SetOldValue sets the "old_value" field.
+func (pcec *ProfileChangeEventCreate) SetOldValue(s string) *ProfileChangeEventCreate {
+	pcec.mutation.SetOldValue(s)
+	return pcec
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (pcec *ProfileChangeEventCreate) SetNillableOldValue(s *string) *ProfileChangeEventCreate {
+	if s != nil {
+		pcec.SetOldValue(*s)
+	}
+	return pcec
+}
+
+// SetNewValue sets the "new_value" field.
+func (pcec *ProfileChangeEventCreate) SetNewValue(s string) *ProfileChangeEventCreate {
+	pcec.mutation.SetNewValue(s)
+	return pcec
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (pcec *ProfileChangeEventCreate) SetNillableNewValue(s *string) *ProfileChangeEventCreate {
+	if s != nil {
+		pcec.SetNewValue(*s)
+	}
+	return pcec
+}
+
+// SetDetectedAt sets the "detected_at" field.
+func (pcec *ProfileChangeEventCreate) SetDetectedAt(t time.Time) *ProfileChangeEventCreate {
+	pcec.mutation.SetDetectedAt(t)
+	return pcec
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (pcec *ProfileChangeEventCreate) SetDeliveryStatus(ps profilechangeevent.DeliveryStatus) *ProfileChangeEventCreate {
+	pcec.mutation.SetDeliveryStatus(ps)
+	return pcec
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (pcec *ProfileChangeEventCreate) SetNillableDeliveryStatus(ps *profilechangeevent.DeliveryStatus) *ProfileChangeEventCreate {
+	if ps != nil {
+		pcec.SetDeliveryStatus(*ps)
+	}
+	return pcec
+}
+
+// SetDeliveryAttempts sets the "delivery_attempts" field.
+func (pcec *ProfileChangeEventCreate) SetDeliveryAttempts(i int) *ProfileChangeEventCreate {
+	pcec.mutation.SetDeliveryAttempts(i)
+	return pcec
+}
+
+// SetNillableDeliveryAttempts sets the "delivery_attempts" field if the given value is not nil.
+func (pcec *ProfileChangeEventCreate) SetNillableDeliveryAttempts(i *int) *ProfileChangeEventCreate {
+	if i != nil {
+		pcec.SetDeliveryAttempts(*i)
+	}
+	return pcec
+}
+
+// SetNextDeliveryAt sets the "next_delivery_at" field.
+func (pcec *ProfileChangeEventCreate) SetNextDeliveryAt(t time.Time) *ProfileChangeEventCreate {
+	pcec.mutation.SetNextDeliveryAt(t)
+	return pcec
+}
+
+// SetNillableNextDeliveryAt sets the "next_delivery_at" field if the given value is not nil.
+func (pcec *ProfileChangeEventCreate) SetNillableNextDeliveryAt(t *time.Time) *ProfileChangeEventCreate {
+	if t != nil {
+		pcec.SetNextDeliveryAt(*t)
+	}
+	return pcec
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (pcec *ProfileChangeEventCreate) SetDeliveredAt(t time.Time) *ProfileChangeEventCreate {
+	pcec.mutation.SetDeliveredAt(t)
+	return pcec
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (pcec *ProfileChangeEventCreate) SetNillableDeliveredAt(t *time.Time) *ProfileChangeEventCreate {
+	if t != nil {
+		pcec.SetDeliveredAt(*t)
+	}
+	return pcec
+}
+
+// SetLastDeliveryError sets the "last_delivery_error" field.
+func (pcec *ProfileChangeEventCreate) SetLastDeliveryError(s string) *ProfileChangeEventCreate {
+	pcec.mutation.SetLastDeliveryError(s)
+	return pcec
+}
+
+// SetNillableLastDeliveryError sets the "last_delivery_error" field if the given value is not nil.
+func (pcec *ProfileChangeEventCreate) SetNillableLastDeliveryError(s *string) *ProfileChangeEventCreate {
+	if s != nil {
+		pcec.SetLastDeliveryError(*s)
+	}
+	return pcec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pcec *ProfileChangeEventCreate) SetCreatedAt(t time.Time) *ProfileChangeEventCreate {
+	pcec.mutation.SetCreatedAt(t)
+	return pcec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pcec *ProfileChangeEventCreate) SetNillableCreatedAt(t *time.Time) *ProfileChangeEventCreate {
+	if t != nil {
+		pcec.SetCreatedAt(*t)
+	}
+	return pcec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcec *ProfileChangeEventCreate) SetUpdatedAt(t time.Time) *ProfileChangeEventCreate {
+	pcec.mutation.SetUpdatedAt(t)
+	return pcec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pcec *ProfileChangeEventCreate) SetNillableUpdatedAt(t *time.Time) *ProfileChangeEventCreate {
+	if t != nil {
+		pcec.SetUpdatedAt(*t)
+	}
+	return pcec
+}
+
+// SetID sets the "id" field.
+func (pcec *ProfileChangeEventCreate) SetID(u ulid.ID) *ProfileChangeEventCreate {
+	pcec.mutation.SetID(u)
+	return pcec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pcec *ProfileChangeEventCreate) SetNillableID(u *ulid.ID) *ProfileChangeEventCreate {
+	if u != nil {
+		pcec.SetID(*u)
+	}
+	return pcec
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (pcec *ProfileChangeEventCreate) SetProfileID(id ulid.ID) *ProfileChangeEventCreate {
+	pcec.mutation.SetProfileID(id)
+	return pcec
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (pcec *ProfileChangeEventCreate) SetProfile(p *Profile) *ProfileChangeEventCreate {
+	return pcec.SetProfileID(p.ID)
+}
+
+// SetSnapshotID sets the "snapshot" edge to the ProfileSnapshot entity by ID.
+func (pcec *ProfileChangeEventCreate) SetSnapshotID(id ulid.ID) *ProfileChangeEventCreate {
+	pcec.mutation.SetSnapshotID(id)
+	return pcec
+}
+
+// SetNillableSnapshotID sets the "snapshot" edge to the ProfileSnapshot entity by ID if the given value is not nil.
+func (pcec *ProfileChangeEventCreate) SetNillableSnapshotID(id *ulid.ID) *ProfileChangeEventCreate {
+	if id != nil {
+		pcec = pcec.SetSnapshotID(*id)
+	}
+	return pcec
+}
+
+// SetSnapshot sets the "snapshot" edge to the ProfileSnapshot entity.
+func (pcec *ProfileChangeEventCreate) SetSnapshot(p *ProfileSnapshot) *ProfileChangeEventCreate {
+	return pcec.SetSnapshotID(p.ID)
+}
+
+// Mutation returns the ProfileChangeEventMutation object of the builder.
+func (pcec *ProfileChangeEventCreate) Mutation() *ProfileChangeEventMutation {
+	return pcec.mutation
+}
+
+// Save creates the ProfileChangeEvent in the database.
+func (pcec *ProfileChangeEventCreate) Save(ctx context.Context) (*ProfileChangeEvent, error) {
+	pcec.defaults()
+	return withHooks(ctx, pcec.sqlSave, pcec.mutation, pcec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcec *ProfileChangeEventCreate) SaveX(ctx context.Context) *ProfileChangeEvent {
+	v, err := pcec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcec *ProfileChangeEventCreate) Exec(ctx context.Context) error {
+	_, err := pcec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcec *ProfileChangeEventCreate) ExecX(ctx context.Context) {
+	if err := pcec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcec *ProfileChangeEventCreate) defaults() {
+	if _, ok := pcec.mutation.DeliveryStatus(); !ok {
+		v := profilechangeevent.DefaultDeliveryStatus
+		pcec.mutation.SetDeliveryStatus(v)
+	}
+	if _, ok := pcec.mutation.DeliveryAttempts(); !ok {
+		v := profilechangeevent.DefaultDeliveryAttempts
+		pcec.mutation.SetDeliveryAttempts(v)
+	}
+	if _, ok := pcec.mutation.CreatedAt(); !ok {
+		v := profilechangeevent.DefaultCreatedAt()
+		pcec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pcec.mutation.UpdatedAt(); !ok {
+		v := profilechangeevent.DefaultUpdatedAt()
+		pcec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pcec.mutation.ID(); !ok {
+		v := profilechangeevent.DefaultID()
+		pcec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcec *ProfileChangeEventCreate) check() error {
+	if _, ok := pcec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ProfileChangeEvent.type"`)}
+	}
+	if v, ok := pcec.mutation.GetType(); ok {
+		if err := profilechangeevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ProfileChangeEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := pcec.mutation.DetectedAt(); !ok {
+		return &ValidationError{Name: "detected_at", err: errors.New(`ent: missing required field "ProfileChangeEvent.detected_at"`)}
+	}
+	if _, ok := pcec.mutation.DeliveryStatus(); !ok {
+		return &ValidationError{Name: "delivery_status", err: errors.New(`ent: missing required field "ProfileChangeEvent.delivery_status"`)}
+	}
+	if v, ok := pcec.mutation.DeliveryStatus(); ok {
+		if err := profilechangeevent.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "ProfileChangeEvent.delivery_status": %w`, err)}
+		}
+	}
+	if _, ok := pcec.mutation.DeliveryAttempts(); !ok {
+		return &ValidationError{Name: "delivery_attempts", err: errors.New(`ent: missing required field "ProfileChangeEvent.delivery_attempts"`)}
+	}
+	if v, ok := pcec.mutation.DeliveryAttempts(); ok {
+		if err := profilechangeevent.DeliveryAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_attempts", err: fmt.Errorf(`ent: validator failed for field "ProfileChangeEvent.delivery_attempts": %w`, err)}
+		}
+	}
+	if _, ok := pcec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProfileChangeEvent.created_at"`)}
+	}
+	if _, ok := pcec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProfileChangeEvent.updated_at"`)}
+	}
+	if len(pcec.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "ProfileChangeEvent.profile"`)}
+	}
+	return nil
+}
+
+func (pcec *ProfileChangeEventCreate) sqlSave(ctx context.Context) (*ProfileChangeEvent, error) {
+	if err := pcec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pcec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*ulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pcec.mutation.id = &_node.ID
+	pcec.mutation.done = true
+	return _node, nil
+}
+
+func (pcec *ProfileChangeEventCreate) createSpec() (*ProfileChangeEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProfileChangeEvent{config: pcec.config}
+		_spec = sqlgraph.NewCreateSpec(profilechangeevent.Table, sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = pcec.conflict
+	if id, ok := pcec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pcec.mutation.GetType(); ok {
+		_spec.SetField(profilechangeevent.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := pcec.mutation.OldValue(); ok {
+		_spec.SetField(profilechangeevent.FieldOldValue, field.TypeString, value)
+		_node.OldValue = &value
+	}
+	if value, ok := pcec.mutation.NewValue(); ok {
+		_spec.SetField(profilechangeevent.FieldNewValue, field.TypeString, value)
+		_node.NewValue = &value
+	}
+	if value, ok := pcec.mutation.DetectedAt(); ok {
+		_spec.SetField(profilechangeevent.FieldDetectedAt, field.TypeTime, value)
+		_node.DetectedAt = value
+	}
+	if value, ok := pcec.mutation.DeliveryStatus(); ok {
+		_spec.SetField(profilechangeevent.FieldDeliveryStatus, field.TypeEnum, value)
+		_node.DeliveryStatus = value
+	}
+	if value, ok := pcec.mutation.DeliveryAttempts(); ok {
+		_spec.SetField(profilechangeevent.FieldDeliveryAttempts, field.TypeInt, value)
+		_node.DeliveryAttempts = value
+	}
+	if value, ok := pcec.mutation.NextDeliveryAt(); ok {
+		_spec.SetField(profilechangeevent.FieldNextDeliveryAt, field.TypeTime, value)
+		_node.NextDeliveryAt = &value
+	}
+	if value, ok := pcec.mutation.DeliveredAt(); ok {
+		_spec.SetField(profilechangeevent.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if value, ok := pcec.mutation.LastDeliveryError(); ok {
+		_spec.SetField(profilechangeevent.FieldLastDeliveryError, field.TypeString, value)
+		_node.LastDeliveryError = &value
+	}
+	if value, ok := pcec.mutation.CreatedAt(); ok {
+		_spec.SetField(profilechangeevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pcec.mutation.UpdatedAt(); ok {
+		_spec.SetField(profilechangeevent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pcec.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilechangeevent.ProfileTable,
+			Columns: []string{profilechangeevent.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.profile_change_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pcec.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilechangeevent.SnapshotTable,
+			Columns: []string{profilechangeevent.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.profile_snapshot_change_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfileChangeEvent.Create().
+//		SetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileChangeEventUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (pcec *ProfileChangeEventCreate) OnConflict(opts ...sql.ConflictOption) *ProfileChangeEventUpsertOne {
+	pcec.conflict = opts
+	return &ProfileChangeEventUpsertOne{
+		create: pcec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfileChangeEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcec *ProfileChangeEventCreate) OnConflictColumns(columns ...string) *ProfileChangeEventUpsertOne {
+	pcec.conflict = append(pcec.conflict, sql.ConflictColumns(columns...))
+	return &ProfileChangeEventUpsertOne{
+		create: pcec,
+	}
+}
+
+type (
+	// ProfileChangeEventUpsertOne is the builder for "upsert"-ing
+	//  one ProfileChangeEvent node.
+	ProfileChangeEventUpsertOne struct {
+		create *ProfileChangeEventCreate
+	}
+
+	// ProfileChangeEventUpsert is the "OnConflict" setter.
+	ProfileChangeEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (u *ProfileChangeEventUpsert) SetDeliveryStatus(v profilechangeevent.DeliveryStatus) *ProfileChangeEventUpsert {
+	u.Set(profilechangeevent.FieldDeliveryStatus, v)
+	return u
+}
+
+// UpdateDeliveryStatus sets the "delivery_status" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsert) UpdateDeliveryStatus() *ProfileChangeEventUpsert {
+	u.SetExcluded(profilechangeevent.FieldDeliveryStatus)
+	return u
+}
+
+// SetDeliveryAttempts sets the "delivery_attempts" field.
+func (u *ProfileChangeEventUpsert) SetDeliveryAttempts(v int) *ProfileChangeEventUpsert {
+	u.Set(profilechangeevent.FieldDeliveryAttempts, v)
+	return u
+}
+
+// UpdateDeliveryAttempts sets the "delivery_attempts" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsert) UpdateDeliveryAttempts() *ProfileChangeEventUpsert {
+	u.SetExcluded(profilechangeevent.FieldDeliveryAttempts)
+	return u
+}
+
+// AddDeliveryAttempts adds v to the "delivery_attempts" field.
+func (u *ProfileChangeEventUpsert) AddDeliveryAttempts(v int) *ProfileChangeEventUpsert {
+	u.Add(profilechangeevent.FieldDeliveryAttempts, v)
+	return u
+}
+
+// SetNextDeliveryAt sets the "next_delivery_at" field.
+func (u *ProfileChangeEventUpsert) SetNextDeliveryAt(v time.Time) *ProfileChangeEventUpsert {
+	u.Set(profilechangeevent.FieldNextDeliveryAt, v)
+	return u
+}
+
+// UpdateNextDeliveryAt sets the "next_delivery_at" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsert) UpdateNextDeliveryAt() *ProfileChangeEventUpsert {
+	u.SetExcluded(profilechangeevent.FieldNextDeliveryAt)
+	return u
+}
+
+// ClearNextDeliveryAt clears the value of the "next_delivery_at" field.
+func (u *ProfileChangeEventUpsert) ClearNextDeliveryAt() *ProfileChangeEventUpsert {
+	u.SetNull(profilechangeevent.FieldNextDeliveryAt)
+	return u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *ProfileChangeEventUpsert) SetDeliveredAt(v time.Time) *ProfileChangeEventUpsert {
+	u.Set(profilechangeevent.FieldDeliveredAt, v)
+	return u
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsert) UpdateDeliveredAt() *ProfileChangeEventUpsert {
+	u.SetExcluded(profilechangeevent.FieldDeliveredAt)
+	return u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *ProfileChangeEventUpsert) ClearDeliveredAt() *ProfileChangeEventUpsert {
+	u.SetNull(profilechangeevent.FieldDeliveredAt)
+	return u
+}
+
+// SetLastDeliveryError sets the "last_delivery_error" field.
+func (u *ProfileChangeEventUpsert) SetLastDeliveryError(v string) *ProfileChangeEventUpsert {
+	u.Set(profilechangeevent.FieldLastDeliveryError, v)
+	return u
+}
+
+// UpdateLastDeliveryError sets the "last_delivery_error" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsert) UpdateLastDeliveryError() *ProfileChangeEventUpsert {
+	u.SetExcluded(profilechangeevent.FieldLastDeliveryError)
+	return u
+}
+
+// ClearLastDeliveryError clears the value of the "last_delivery_error" field.
+func (u *ProfileChangeEventUpsert) ClearLastDeliveryError() *ProfileChangeEventUpsert {
+	u.SetNull(profilechangeevent.FieldLastDeliveryError)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileChangeEventUpsert) SetUpdatedAt(v time.Time) *ProfileChangeEventUpsert {
+	u.Set(profilechangeevent.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsert) UpdateUpdatedAt() *ProfileChangeEventUpsert {
+	u.SetExcluded(profilechangeevent.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProfileChangeEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profilechangeevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileChangeEventUpsertOne) UpdateNewValues() *ProfileChangeEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(profilechangeevent.FieldID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(profilechangeevent.FieldType)
+		}
+		if _, exists := u.create.mutation.OldValue(); exists {
+			s.SetIgnore(profilechangeevent.FieldOldValue)
+		}
+		if _, exists := u.create.mutation.NewValue(); exists {
+			s.SetIgnore(profilechangeevent.FieldNewValue)
+		}
+		if _, exists := u.create.mutation.DetectedAt(); exists {
+			s.SetIgnore(profilechangeevent.FieldDetectedAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(profilechangeevent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfileChangeEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProfileChangeEventUpsertOne) Ignore() *ProfileChangeEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileChangeEventUpsertOne) DoNothing() *ProfileChangeEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileChangeEventCreate.OnConflict
+// documentation for more info.
+func (u *ProfileChangeEventUpsertOne) Update(set func(*ProfileChangeEventUpsert)) *ProfileChangeEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileChangeEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (u *ProfileChangeEventUpsertOne) SetDeliveryStatus(v profilechangeevent.DeliveryStatus) *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetDeliveryStatus(v)
+	})
+}
+
+// UpdateDeliveryStatus sets the "delivery_status" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertOne) UpdateDeliveryStatus() *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateDeliveryStatus()
+	})
+}
+
+// SetDeliveryAttempts sets the "delivery_attempts" field.
+func (u *ProfileChangeEventUpsertOne) SetDeliveryAttempts(v int) *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetDeliveryAttempts(v)
+	})
+}
+
+// AddDeliveryAttempts adds v to the "delivery_attempts" field.
+func (u *ProfileChangeEventUpsertOne) AddDeliveryAttempts(v int) *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.AddDeliveryAttempts(v)
+	})
+}
+
+// UpdateDeliveryAttempts sets the "delivery_attempts" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertOne) UpdateDeliveryAttempts() *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateDeliveryAttempts()
+	})
+}
+
+// SetNextDeliveryAt sets the "next_delivery_at" field.
+func (u *ProfileChangeEventUpsertOne) SetNextDeliveryAt(v time.Time) *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetNextDeliveryAt(v)
+	})
+}
+
+// UpdateNextDeliveryAt sets the "next_delivery_at" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertOne) UpdateNextDeliveryAt() *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateNextDeliveryAt()
+	})
+}
+
+// ClearNextDeliveryAt clears the value of the "next_delivery_at" field.
+func (u *ProfileChangeEventUpsertOne) ClearNextDeliveryAt() *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.ClearNextDeliveryAt()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *ProfileChangeEventUpsertOne) SetDeliveredAt(v time.Time) *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertOne) UpdateDeliveredAt() *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *ProfileChangeEventUpsertOne) ClearDeliveredAt() *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// SetLastDeliveryError sets the "last_delivery_error" field.
+func (u *ProfileChangeEventUpsertOne) SetLastDeliveryError(v string) *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetLastDeliveryError(v)
+	})
+}
+
+// UpdateLastDeliveryError sets the "last_delivery_error" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertOne) UpdateLastDeliveryError() *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateLastDeliveryError()
+	})
+}
+
+// ClearLastDeliveryError clears the value of the "last_delivery_error" field.
+func (u *ProfileChangeEventUpsertOne) ClearLastDeliveryError() *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.ClearLastDeliveryError()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileChangeEventUpsertOne) SetUpdatedAt(v time.Time) *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertOne) UpdateUpdatedAt() *ProfileChangeEventUpsertOne {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileChangeEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileChangeEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileChangeEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProfileChangeEventUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProfileChangeEventUpsertOne.ID is not supported by MySQL driver. Use ProfileChangeEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProfileChangeEventUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProfileChangeEventCreateBulk is the builder for creating many ProfileChangeEvent entities in bulk.
+type ProfileChangeEventCreateBulk struct {
+	config
+	err      error
+	builders []*ProfileChangeEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProfileChangeEvent entities in the database.
+func (pcecb *ProfileChangeEventCreateBulk) Save(ctx context.Context) ([]*ProfileChangeEvent, error) {
+	if pcecb.err != nil {
+		return nil, pcecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcecb.builders))
+	nodes := make([]*ProfileChangeEvent, len(pcecb.builders))
+	mutators := make([]Mutator, len(pcecb.builders))
+	for i := range pcecb.builders {
+		func(i int, root context.Context) {
+			builder := pcecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProfileChangeEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcecb *ProfileChangeEventCreateBulk) SaveX(ctx context.Context) []*ProfileChangeEvent {
+	v, err := pcecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcecb *ProfileChangeEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcecb *ProfileChangeEventCreateBulk) ExecX(ctx context.Context) {
+	if err := pcecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfileChangeEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileChangeEventUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (pcecb *ProfileChangeEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProfileChangeEventUpsertBulk {
+	pcecb.conflict = opts
+	return &ProfileChangeEventUpsertBulk{
+		create: pcecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfileChangeEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcecb *ProfileChangeEventCreateBulk) OnConflictColumns(columns ...string) *ProfileChangeEventUpsertBulk {
+	pcecb.conflict = append(pcecb.conflict, sql.ConflictColumns(columns...))
+	return &ProfileChangeEventUpsertBulk{
+		create: pcecb,
+	}
+}
+
+// ProfileChangeEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProfileChangeEvent nodes.
+type ProfileChangeEventUpsertBulk struct {
+	create *ProfileChangeEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProfileChangeEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profilechangeevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileChangeEventUpsertBulk) UpdateNewValues() *ProfileChangeEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(profilechangeevent.FieldID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(profilechangeevent.FieldType)
+			}
+			if _, exists := b.mutation.OldValue(); exists {
+				s.SetIgnore(profilechangeevent.FieldOldValue)
+			}
+			if _, exists := b.mutation.NewValue(); exists {
+				s.SetIgnore(profilechangeevent.FieldNewValue)
+			}
+			if _, exists := b.mutation.DetectedAt(); exists {
+				s.SetIgnore(profilechangeevent.FieldDetectedAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(profilechangeevent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfileChangeEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProfileChangeEventUpsertBulk) Ignore() *ProfileChangeEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileChangeEventUpsertBulk) DoNothing() *ProfileChangeEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileChangeEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProfileChangeEventUpsertBulk) Update(set func(*ProfileChangeEventUpsert)) *ProfileChangeEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileChangeEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (u *ProfileChangeEventUpsertBulk) SetDeliveryStatus(v profilechangeevent.DeliveryStatus) *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetDeliveryStatus(v)
+	})
+}
+
+// UpdateDeliveryStatus sets the "delivery_status" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertBulk) UpdateDeliveryStatus() *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateDeliveryStatus()
+	})
+}
+
+// SetDeliveryAttempts sets the "delivery_attempts" field.
+func (u *ProfileChangeEventUpsertBulk) SetDeliveryAttempts(v int) *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetDeliveryAttempts(v)
+	})
+}
+
+// AddDeliveryAttempts adds v to the "delivery_attempts" field.
+func (u *ProfileChangeEventUpsertBulk) AddDeliveryAttempts(v int) *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.AddDeliveryAttempts(v)
+	})
+}
+
+// UpdateDeliveryAttempts sets the "delivery_attempts" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertBulk) UpdateDeliveryAttempts() *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateDeliveryAttempts()
+	})
+}
+
+// SetNextDeliveryAt sets the "next_delivery_at" field.
+func (u *ProfileChangeEventUpsertBulk) SetNextDeliveryAt(v time.Time) *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetNextDeliveryAt(v)
+	})
+}
+
+// UpdateNextDeliveryAt sets the "next_delivery_at" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertBulk) UpdateNextDeliveryAt() *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateNextDeliveryAt()
+	})
+}
+
+// ClearNextDeliveryAt clears the value of the "next_delivery_at" field.
+func (u *ProfileChangeEventUpsertBulk) ClearNextDeliveryAt() *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.ClearNextDeliveryAt()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *ProfileChangeEventUpsertBulk) SetDeliveredAt(v time.Time) *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertBulk) UpdateDeliveredAt() *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *ProfileChangeEventUpsertBulk) ClearDeliveredAt() *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// SetLastDeliveryError sets the "last_delivery_error" field.
+func (u *ProfileChangeEventUpsertBulk) SetLastDeliveryError(v string) *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetLastDeliveryError(v)
+	})
+}
+
+// UpdateLastDeliveryError sets the "last_delivery_error" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertBulk) UpdateLastDeliveryError() *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateLastDeliveryError()
+	})
+}
+
+// ClearLastDeliveryError clears the value of the "last_delivery_error" field.
+func (u *ProfileChangeEventUpsertBulk) ClearLastDeliveryError() *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.ClearLastDeliveryError()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileChangeEventUpsertBulk) SetUpdatedAt(v time.Time) *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileChangeEventUpsertBulk) UpdateUpdatedAt() *ProfileChangeEventUpsertBulk {
+	return u.Update(func(s *ProfileChangeEventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileChangeEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProfileChangeEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileChangeEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileChangeEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profilechangeevent"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileChangeEventDelete is the builder for deleting a ProfileChangeEvent entity.
+type ProfileChangeEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProfileChangeEventMutation
+}
+
+// Where appends a list predicates to the ProfileChangeEventDelete builder.
+func (pced *ProfileChangeEventDelete) Where(ps ...predicate.ProfileChangeEvent) *ProfileChangeEventDelete {
+	pced.mutation.Where(ps...)
+	return pced
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pced *ProfileChangeEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pced.sqlExec, pced.mutation, pced.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pced *ProfileChangeEventDelete) ExecX(ctx context.Context) int {
+	n, err := pced.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pced *ProfileChangeEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(profilechangeevent.Table, sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString))
+	if ps := pced.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pced.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pced.mutation.done = true
+	return affected, err
+}
+
+// ProfileChangeEventDeleteOne is the builder for deleting a single ProfileChangeEvent entity.
+type ProfileChangeEventDeleteOne struct {
+	pced *ProfileChangeEventDelete
+}
+
+// Where appends a list predicates to the ProfileChangeEventDelete builder.
+func (pcedo *ProfileChangeEventDeleteOne) Where(ps ...predicate.ProfileChangeEvent) *ProfileChangeEventDeleteOne {
+	pcedo.pced.mutation.Where(ps...)
+	return pcedo
+}
+
+// Exec executes the deletion query.
+func (pcedo *ProfileChangeEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := pcedo.pced.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{profilechangeevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcedo *ProfileChangeEventDeleteOne) ExecX(ctx context.Context) {
+	if err := pcedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,733 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profilesnapshot"
+	"sheng-go-backend/ent/schema/ulid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileChangeEventQuery is the builder for querying ProfileChangeEvent entities.
+type ProfileChangeEventQuery struct {
+	config
+	ctx          *QueryContext
+	order        []profilechangeevent.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ProfileChangeEvent
+	withProfile  *ProfileQuery
+	withSnapshot *ProfileSnapshotQuery
+	withFKs      bool
+	loadTotal    []func(context.Context, []*ProfileChangeEvent) error
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProfileChangeEventQuery builder.
+func (pceq *ProfileChangeEventQuery) Where(ps ...predicate.ProfileChangeEvent) *ProfileChangeEventQuery {
+	pceq.predicates = append(pceq.predicates, ps...)
+	return pceq
+}
+
+// Limit the number of records to be returned by this query.
+func (pceq *ProfileChangeEventQuery) Limit(limit int) *ProfileChangeEventQuery {
+	pceq.ctx.Limit = &limit
+	return pceq
+}
+
+// Offset to start from.
+func (pceq *ProfileChangeEventQuery) Offset(offset int) *ProfileChangeEventQuery {
+	pceq.ctx.Offset = &offset
+	return pceq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pceq *ProfileChangeEventQuery) Unique(unique bool) *ProfileChangeEventQuery {
+	pceq.ctx.Unique = &unique
+	return pceq
+}
+
+// Order specifies how the records should be ordered.
+func (pceq *ProfileChangeEventQuery) Order(o ...profilechangeevent.OrderOption) *ProfileChangeEventQuery {
+	pceq.order = append(pceq.order, o...)
+	return pceq
+}
+
+// QueryProfile chains the current query on the "profile" edge.
+func (pceq *ProfileChangeEventQuery) QueryProfile() *ProfileQuery {
+	query := (&ProfileClient{config: pceq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pceq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pceq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profilechangeevent.Table, profilechangeevent.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profilechangeevent.ProfileTable, profilechangeevent.ProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pceq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySnapshot chains the current query on the "snapshot" edge.
+func (pceq *ProfileChangeEventQuery) QuerySnapshot() *ProfileSnapshotQuery {
+	query := (&ProfileSnapshotClient{config: pceq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pceq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pceq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profilechangeevent.Table, profilechangeevent.FieldID, selector),
+			sqlgraph.To(profilesnapshot.Table, profilesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profilechangeevent.SnapshotTable, profilechangeevent.SnapshotColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pceq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProfileChangeEvent entity from the query.
+// Returns a *NotFoundError when no ProfileChangeEvent was found.
+func (pceq *ProfileChangeEventQuery) First(ctx context.Context) (*ProfileChangeEvent, error) {
+	nodes, err := pceq.Limit(1).All(setContextOp(ctx, pceq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{profilechangeevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pceq *ProfileChangeEventQuery) FirstX(ctx context.Context) *ProfileChangeEvent {
+	node, err := pceq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProfileChangeEvent ID from the query.
+// Returns a *NotFoundError when no ProfileChangeEvent ID was found.
+func (pceq *ProfileChangeEventQuery) FirstID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = pceq.Limit(1).IDs(setContextOp(ctx, pceq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{profilechangeevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pceq *ProfileChangeEventQuery) FirstIDX(ctx context.Context) ulid.ID {
+	id, err := pceq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProfileChangeEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProfileChangeEvent entity is found.
+// Returns a *NotFoundError when no ProfileChangeEvent entities are found.
+func (pceq *ProfileChangeEventQuery) Only(ctx context.Context) (*ProfileChangeEvent, error) {
+	nodes, err := pceq.Limit(2).All(setContextOp(ctx, pceq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{profilechangeevent.Label}
+	default:
+		return nil, &NotSingularError{profilechangeevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pceq *ProfileChangeEventQuery) OnlyX(ctx context.Context) *ProfileChangeEvent {
+	node, err := pceq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProfileChangeEvent ID in the query.
+// Returns a *NotSingularError when more than one ProfileChangeEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pceq *ProfileChangeEventQuery) OnlyID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = pceq.Limit(2).IDs(setContextOp(ctx, pceq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{profilechangeevent.Label}
+	default:
+		err = &NotSingularError{profilechangeevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pceq *ProfileChangeEventQuery) OnlyIDX(ctx context.Context) ulid.ID {
+	id, err := pceq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProfileChangeEvents.
+func (pceq *ProfileChangeEventQuery) All(ctx context.Context) ([]*ProfileChangeEvent, error) {
+	ctx = setContextOp(ctx, pceq.ctx, ent.OpQueryAll)
+	if err := pceq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProfileChangeEvent, *ProfileChangeEventQuery]()
+	return withInterceptors[[]*ProfileChangeEvent](ctx, pceq, qr, pceq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pceq *ProfileChangeEventQuery) AllX(ctx context.Context) []*ProfileChangeEvent {
+	nodes, err := pceq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProfileChangeEvent IDs.
+func (pceq *ProfileChangeEventQuery) IDs(ctx context.Context) (ids []ulid.ID, err error) {
+	if pceq.ctx.Unique == nil && pceq.path != nil {
+		pceq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pceq.ctx, ent.OpQueryIDs)
+	if err = pceq.Select(profilechangeevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pceq *ProfileChangeEventQuery) IDsX(ctx context.Context) []ulid.ID {
+	ids, err := pceq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pceq *ProfileChangeEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pceq.ctx, ent.OpQueryCount)
+	if err := pceq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pceq, querierCount[*ProfileChangeEventQuery](), pceq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pceq *ProfileChangeEventQuery) CountX(ctx context.Context) int {
+	count, err := pceq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pceq *ProfileChangeEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pceq.ctx, ent.OpQueryExist)
+	switch _, err := pceq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pceq *ProfileChangeEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := pceq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProfileChangeEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pceq *ProfileChangeEventQuery) Clone() *ProfileChangeEventQuery {
+	if pceq == nil {
+		return nil
+	}
+	return &ProfileChangeEventQuery{
+		config:       pceq.config,
+		ctx:          pceq.ctx.Clone(),
+		order:        append([]profilechangeevent.OrderOption{}, pceq.order...),
+		inters:       append([]Interceptor{}, pceq.inters...),
+		predicates:   append([]predicate.ProfileChangeEvent{}, pceq.predicates...),
+		withProfile:  pceq.withProfile.Clone(),
+		withSnapshot: pceq.withSnapshot.Clone(),
+		// clone intermediate query.
+		sql:  pceq.sql.Clone(),
+		path: pceq.path,
+	}
+}
+
+// WithProfile tells the query-builder to eager-load the nodes that are connected to
+// the "profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (pceq *ProfileChangeEventQuery) WithProfile(opts ...func(*ProfileQuery)) *ProfileChangeEventQuery {
+	query := (&ProfileClient{config: pceq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pceq.withProfile = query
+	return pceq
+}
+
+// WithSnapshot tells the query-builder to eager-load the nodes that are connected to
+// the "snapshot" edge. The optional arguments are used to configure the query builder of the edge.
+func (pceq *ProfileChangeEventQuery) WithSnapshot(opts ...func(*ProfileSnapshotQuery)) *ProfileChangeEventQuery {
+	query := (&ProfileSnapshotClient{config: pceq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pceq.withSnapshot = query
+	return pceq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Type profilechangeevent.Type `json:"type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProfileChangeEvent.Query().
+//		GroupBy(profilechangeevent.FieldType).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pceq *ProfileChangeEventQuery) GroupBy(field string, fields ...string) *ProfileChangeEventGroupBy {
+	pceq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProfileChangeEventGroupBy{build: pceq}
+	grbuild.flds = &pceq.ctx.Fields
+	grbuild.label = profilechangeevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Type profilechangeevent.Type `json:"type,omitempty"`
+//	}
+//
+//	client.ProfileChangeEvent.Query().
+//		Select(profilechangeevent.FieldType).
+//		Scan(ctx, &v)
+func (pceq *ProfileChangeEventQuery) Select(fields ...string) *ProfileChangeEventSelect {
+	pceq.ctx.Fields = append(pceq.ctx.Fields, fields...)
+	sbuild := &ProfileChangeEventSelect{ProfileChangeEventQuery: pceq}
+	sbuild.label = profilechangeevent.Label
+	sbuild.flds, sbuild.scan = &pceq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProfileChangeEventSelect configured with the given aggregations.
+func (pceq *ProfileChangeEventQuery) Aggregate(fns ...AggregateFunc) *ProfileChangeEventSelect {
+	return pceq.Select().Aggregate(fns...)
+}
+
+func (pceq *ProfileChangeEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pceq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pceq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pceq.ctx.Fields {
+		if !profilechangeevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pceq.path != nil {
+		prev, err := pceq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pceq.sql = prev
+	}
+	return nil
+}
+
+func (pceq *ProfileChangeEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProfileChangeEvent, error) {
+	var (
+		nodes       = []*ProfileChangeEvent{}
+		withFKs     = pceq.withFKs
+		_spec       = pceq.querySpec()
+		loadedTypes = [2]bool{
+			pceq.withProfile != nil,
+			pceq.withSnapshot != nil,
+		}
+	)
+	if pceq.withProfile != nil || pceq.withSnapshot != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, profilechangeevent.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProfileChangeEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProfileChangeEvent{config: pceq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(pceq.modifiers) > 0 {
+		_spec.Modifiers = pceq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pceq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pceq.withProfile; query != nil {
+		if err := pceq.loadProfile(ctx, query, nodes, nil,
+			func(n *ProfileChangeEvent, e *Profile) { n.Edges.Profile = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pceq.withSnapshot; query != nil {
+		if err := pceq.loadSnapshot(ctx, query, nodes, nil,
+			func(n *ProfileChangeEvent, e *ProfileSnapshot) { n.Edges.Snapshot = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range pceq.loadTotal {
+		if err := pceq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pceq *ProfileChangeEventQuery) loadProfile(ctx context.Context, query *ProfileQuery, nodes []*ProfileChangeEvent, init func(*ProfileChangeEvent), assign func(*ProfileChangeEvent, *Profile)) error {
+	ids := make([]ulid.ID, 0, len(nodes))
+	nodeids := make(map[ulid.ID][]*ProfileChangeEvent)
+	for i := range nodes {
+		if nodes[i].profile_change_events == nil {
+			continue
+		}
+		fk := *nodes[i].profile_change_events
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(profile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "profile_change_events" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pceq *ProfileChangeEventQuery) loadSnapshot(ctx context.Context, query *ProfileSnapshotQuery, nodes []*ProfileChangeEvent, init func(*ProfileChangeEvent), assign func(*ProfileChangeEvent, *ProfileSnapshot)) error {
+	ids := make([]ulid.ID, 0, len(nodes))
+	nodeids := make(map[ulid.ID][]*ProfileChangeEvent)
+	for i := range nodes {
+		if nodes[i].profile_snapshot_change_events == nil {
+			continue
+		}
+		fk := *nodes[i].profile_snapshot_change_events
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(profilesnapshot.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "profile_snapshot_change_events" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pceq *ProfileChangeEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pceq.querySpec()
+	if len(pceq.modifiers) > 0 {
+		_spec.Modifiers = pceq.modifiers
+	}
+	_spec.Node.Columns = pceq.ctx.Fields
+	if len(pceq.ctx.Fields) > 0 {
+		_spec.Unique = pceq.ctx.Unique != nil && *pceq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pceq.driver, _spec)
+}
+
+func (pceq *ProfileChangeEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(profilechangeevent.Table, profilechangeevent.Columns, sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString))
+	_spec.From = pceq.sql
+	if unique := pceq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pceq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pceq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profilechangeevent.FieldID)
+		for i := range fields {
+			if fields[i] != profilechangeevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pceq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pceq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pceq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pceq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pceq *ProfileChangeEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pceq.driver.Dialect())
+	t1 := builder.Table(profilechangeevent.Table)
+	columns := pceq.ctx.Fields
+	if len(columns) == 0 {
+		columns = profilechangeevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pceq.sql != nil {
+		selector = pceq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pceq.ctx.Unique != nil && *pceq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range pceq.modifiers {
+		m(selector)
+	}
+	for _, p := range pceq.predicates {
+		p(selector)
+	}
+	for _, p := range pceq.order {
+		p(selector)
+	}
+	if offset := pceq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pceq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pceq *ProfileChangeEventQuery) ForUpdate(opts ...sql.LockOption) *ProfileChangeEventQuery {
+	if pceq.driver.Dialect() == dialect.Postgres {
+		pceq.Unique(false)
+	}
+	pceq.modifiers = append(pceq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pceq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pceq *ProfileChangeEventQuery) ForShare(opts ...sql.LockOption) *ProfileChangeEventQuery {
+	if pceq.driver.Dialect() == dialect.Postgres {
+		pceq.Unique(false)
+	}
+	pceq.modifiers = append(pceq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pceq
+}
+
+// ProfileChangeEventGroupBy is the group-by builder for ProfileChangeEvent entities.
+type ProfileChangeEventGroupBy struct {
+	selector
+	build *ProfileChangeEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pcegb *ProfileChangeEventGroupBy) Aggregate(fns ...AggregateFunc) *ProfileChangeEventGroupBy {
+	pcegb.fns = append(pcegb.fns, fns...)
+	return pcegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcegb *ProfileChangeEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcegb.build.ctx, ent.OpQueryGroupBy)
+	if err := pcegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfileChangeEventQuery, *ProfileChangeEventGroupBy](ctx, pcegb.build, pcegb, pcegb.build.inters, v)
+}
+
+func (pcegb *ProfileChangeEventGroupBy) sqlScan(ctx context.Context, root *ProfileChangeEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pcegb.fns))
+	for _, fn := range pcegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pcegb.flds)+len(pcegb.fns))
+		for _, f := range *pcegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pcegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProfileChangeEventSelect is the builder for selecting fields of ProfileChangeEvent entities.
+type ProfileChangeEventSelect struct {
+	*ProfileChangeEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pces *ProfileChangeEventSelect) Aggregate(fns ...AggregateFunc) *ProfileChangeEventSelect {
+	pces.fns = append(pces.fns, fns...)
+	return pces
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pces *ProfileChangeEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pces.ctx, ent.OpQuerySelect)
+	if err := pces.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfileChangeEventQuery, *ProfileChangeEventSelect](ctx, pces.ProfileChangeEventQuery, pces, pces.inters, v)
+}
+
+func (pces *ProfileChangeEventSelect) sqlScan(ctx context.Context, root *ProfileChangeEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pces.fns))
+	for _, fn := range pces.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pces.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pces.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
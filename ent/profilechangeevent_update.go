@@ -0,0 +1,713 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profilesnapshot"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileChangeEventUpdate is the builder for updating ProfileChangeEvent entities.
+type ProfileChangeEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProfileChangeEventMutation
+}
+
+// Where appends a list predicates to the ProfileChangeEventUpdate builder.
+func (pceu *ProfileChangeEventUpdate) Where(ps ...predicate.ProfileChangeEvent) *ProfileChangeEventUpdate {
+	pceu.mutation.Where(ps...)
+	return pceu
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (pceu *ProfileChangeEventUpdate) SetDeliveryStatus(ps profilechangeevent.DeliveryStatus) *ProfileChangeEventUpdate {
+	pceu.mutation.SetDeliveryStatus(ps)
+	return pceu
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (pceu *ProfileChangeEventUpdate) SetNillableDeliveryStatus(ps *profilechangeevent.DeliveryStatus) *ProfileChangeEventUpdate {
+	if ps != nil {
+		pceu.SetDeliveryStatus(*ps)
+	}
+	return pceu
+}
+
+// SetDeliveryAttempts sets the "delivery_attempts" field.
+func (pceu *ProfileChangeEventUpdate) SetDeliveryAttempts(i int) *ProfileChangeEventUpdate {
+	pceu.mutation.ResetDeliveryAttempts()
+	pceu.mutation.SetDeliveryAttempts(i)
+	return pceu
+}
+
+// SetNillableDeliveryAttempts sets the "delivery_attempts" field if the given value is not nil.
+func (pceu *ProfileChangeEventUpdate) SetNillableDeliveryAttempts(i *int) *ProfileChangeEventUpdate {
+	if i != nil {
+		pceu.SetDeliveryAttempts(*i)
+	}
+	return pceu
+}
+
+// AddDeliveryAttempts adds i to the "delivery_attempts" field.
+func (pceu *ProfileChangeEventUpdate) AddDeliveryAttempts(i int) *ProfileChangeEventUpdate {
+	pceu.mutation.AddDeliveryAttempts(i)
+	return pceu
+}
+
+// SetNextDeliveryAt sets the "next_delivery_at" field.
+func (pceu *ProfileChangeEventUpdate) SetNextDeliveryAt(t time.Time) *ProfileChangeEventUpdate {
+	pceu.mutation.SetNextDeliveryAt(t)
+	return pceu
+}
+
+// SetNillableNextDeliveryAt sets the "next_delivery_at" field if the given value is not nil.
+func (pceu *ProfileChangeEventUpdate) SetNillableNextDeliveryAt(t *time.Time) *ProfileChangeEventUpdate {
+	if t != nil {
+		pceu.SetNextDeliveryAt(*t)
+	}
+	return pceu
+}
+
+// ClearNextDeliveryAt clears the value of the "next_delivery_at" field.
+func (pceu *ProfileChangeEventUpdate) ClearNextDeliveryAt() *ProfileChangeEventUpdate {
+	pceu.mutation.ClearNextDeliveryAt()
+	return pceu
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (pceu *ProfileChangeEventUpdate) SetDeliveredAt(t time.Time) *ProfileChangeEventUpdate {
+	pceu.mutation.SetDeliveredAt(t)
+	return pceu
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (pceu *ProfileChangeEventUpdate) SetNillableDeliveredAt(t *time.Time) *ProfileChangeEventUpdate {
+	if t != nil {
+		pceu.SetDeliveredAt(*t)
+	}
+	return pceu
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (pceu *ProfileChangeEventUpdate) ClearDeliveredAt() *ProfileChangeEventUpdate {
+	pceu.mutation.ClearDeliveredAt()
+	return pceu
+}
+
+// SetLastDeliveryError sets the "last_delivery_error" field.
+func (pceu *ProfileChangeEventUpdate) SetLastDeliveryError(s string) *ProfileChangeEventUpdate {
+	pceu.mutation.SetLastDeliveryError(s)
+	return pceu
+}
+
+// SetNillableLastDeliveryError sets the "last_delivery_error" field if the given value is not nil.
+func (pceu *ProfileChangeEventUpdate) SetNillableLastDeliveryError(s *string) *ProfileChangeEventUpdate {
+	if s != nil {
+		pceu.SetLastDeliveryError(*s)
+	}
+	return pceu
+}
+
+// ClearLastDeliveryError clears the value of the "last_delivery_error" field.
+func (pceu *ProfileChangeEventUpdate) ClearLastDeliveryError() *ProfileChangeEventUpdate {
+	pceu.mutation.ClearLastDeliveryError()
+	return pceu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pceu *ProfileChangeEventUpdate) SetUpdatedAt(t time.Time) *ProfileChangeEventUpdate {
+	pceu.mutation.SetUpdatedAt(t)
+	return pceu
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (pceu *ProfileChangeEventUpdate) SetProfileID(id ulid.ID) *ProfileChangeEventUpdate {
+	pceu.mutation.SetProfileID(id)
+	return pceu
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (pceu *ProfileChangeEventUpdate) SetProfile(p *Profile) *ProfileChangeEventUpdate {
+	return pceu.SetProfileID(p.ID)
+}
+
+// SetSnapshotID sets the "snapshot" edge to the ProfileSnapshot entity by ID.
+func (pceu *ProfileChangeEventUpdate) SetSnapshotID(id ulid.ID) *ProfileChangeEventUpdate {
+	pceu.mutation.SetSnapshotID(id)
+	return pceu
+}
+
+// SetNillableSnapshotID sets the "snapshot" edge to the ProfileSnapshot entity by ID if the given value is not nil.
+func (pceu *ProfileChangeEventUpdate) SetNillableSnapshotID(id *ulid.ID) *ProfileChangeEventUpdate {
+	if id != nil {
+		pceu = pceu.SetSnapshotID(*id)
+	}
+	return pceu
+}
+
+// SetSnapshot sets the "snapshot" edge to the ProfileSnapshot entity.
+func (pceu *ProfileChangeEventUpdate) SetSnapshot(p *ProfileSnapshot) *ProfileChangeEventUpdate {
+	return pceu.SetSnapshotID(p.ID)
+}
+
+// Mutation returns the ProfileChangeEventMutation object of the builder.
+func (pceu *ProfileChangeEventUpdate) Mutation() *ProfileChangeEventMutation {
+	return pceu.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (pceu *ProfileChangeEventUpdate) ClearProfile() *ProfileChangeEventUpdate {
+	pceu.mutation.ClearProfile()
+	return pceu
+}
+
+// ClearSnapshot clears the "snapshot" edge to the ProfileSnapshot entity.
+func (pceu *ProfileChangeEventUpdate) ClearSnapshot() *ProfileChangeEventUpdate {
+	pceu.mutation.ClearSnapshot()
+	return pceu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pceu *ProfileChangeEventUpdate) Save(ctx context.Context) (int, error) {
+	pceu.defaults()
+	return withHooks(ctx, pceu.sqlSave, pceu.mutation, pceu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pceu *ProfileChangeEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := pceu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pceu *ProfileChangeEventUpdate) Exec(ctx context.Context) error {
+	_, err := pceu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pceu *ProfileChangeEventUpdate) ExecX(ctx context.Context) {
+	if err := pceu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pceu *ProfileChangeEventUpdate) defaults() {
+	if _, ok := pceu.mutation.UpdatedAt(); !ok {
+		v := profilechangeevent.UpdateDefaultUpdatedAt()
+		pceu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pceu *ProfileChangeEventUpdate) check() error {
+	if v, ok := pceu.mutation.DeliveryStatus(); ok {
+		if err := profilechangeevent.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "ProfileChangeEvent.delivery_status": %w`, err)}
+		}
+	}
+	if v, ok := pceu.mutation.DeliveryAttempts(); ok {
+		if err := profilechangeevent.DeliveryAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_attempts", err: fmt.Errorf(`ent: validator failed for field "ProfileChangeEvent.delivery_attempts": %w`, err)}
+		}
+	}
+	if pceu.mutation.ProfileCleared() && len(pceu.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProfileChangeEvent.profile"`)
+	}
+	return nil
+}
+
+func (pceu *ProfileChangeEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pceu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profilechangeevent.Table, profilechangeevent.Columns, sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString))
+	if ps := pceu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pceu.mutation.OldValueCleared() {
+		_spec.ClearField(profilechangeevent.FieldOldValue, field.TypeString)
+	}
+	if pceu.mutation.NewValueCleared() {
+		_spec.ClearField(profilechangeevent.FieldNewValue, field.TypeString)
+	}
+	if value, ok := pceu.mutation.DeliveryStatus(); ok {
+		_spec.SetField(profilechangeevent.FieldDeliveryStatus, field.TypeEnum, value)
+	}
+	if value, ok := pceu.mutation.DeliveryAttempts(); ok {
+		_spec.SetField(profilechangeevent.FieldDeliveryAttempts, field.TypeInt, value)
+	}
+	if value, ok := pceu.mutation.AddedDeliveryAttempts(); ok {
+		_spec.AddField(profilechangeevent.FieldDeliveryAttempts, field.TypeInt, value)
+	}
+	if value, ok := pceu.mutation.NextDeliveryAt(); ok {
+		_spec.SetField(profilechangeevent.FieldNextDeliveryAt, field.TypeTime, value)
+	}
+	if pceu.mutation.NextDeliveryAtCleared() {
+		_spec.ClearField(profilechangeevent.FieldNextDeliveryAt, field.TypeTime)
+	}
+	if value, ok := pceu.mutation.DeliveredAt(); ok {
+		_spec.SetField(profilechangeevent.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if pceu.mutation.DeliveredAtCleared() {
+		_spec.ClearField(profilechangeevent.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := pceu.mutation.LastDeliveryError(); ok {
+		_spec.SetField(profilechangeevent.FieldLastDeliveryError, field.TypeString, value)
+	}
+	if pceu.mutation.LastDeliveryErrorCleared() {
+		_spec.ClearField(profilechangeevent.FieldLastDeliveryError, field.TypeString)
+	}
+	if value, ok := pceu.mutation.UpdatedAt(); ok {
+		_spec.SetField(profilechangeevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pceu.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilechangeevent.ProfileTable,
+			Columns: []string{profilechangeevent.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pceu.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilechangeevent.ProfileTable,
+			Columns: []string{profilechangeevent.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pceu.mutation.SnapshotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilechangeevent.SnapshotTable,
+			Columns: []string{profilechangeevent.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pceu.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilechangeevent.SnapshotTable,
+			Columns: []string{profilechangeevent.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pceu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profilechangeevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pceu.mutation.done = true
+	return n, nil
+}
+
+// ProfileChangeEventUpdateOne is the builder for updating a single ProfileChangeEvent entity.
+type ProfileChangeEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProfileChangeEventMutation
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (pceuo *ProfileChangeEventUpdateOne) SetDeliveryStatus(ps profilechangeevent.DeliveryStatus) *ProfileChangeEventUpdateOne {
+	pceuo.mutation.SetDeliveryStatus(ps)
+	return pceuo
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (pceuo *ProfileChangeEventUpdateOne) SetNillableDeliveryStatus(ps *profilechangeevent.DeliveryStatus) *ProfileChangeEventUpdateOne {
+	if ps != nil {
+		pceuo.SetDeliveryStatus(*ps)
+	}
+	return pceuo
+}
+
+// SetDeliveryAttempts sets the "delivery_attempts" field.
+func (pceuo *ProfileChangeEventUpdateOne) SetDeliveryAttempts(i int) *ProfileChangeEventUpdateOne {
+	pceuo.mutation.ResetDeliveryAttempts()
+	pceuo.mutation.SetDeliveryAttempts(i)
+	return pceuo
+}
+
+// SetNillableDeliveryAttempts sets the "delivery_attempts" field if the given value is not nil.
+func (pceuo *ProfileChangeEventUpdateOne) SetNillableDeliveryAttempts(i *int) *ProfileChangeEventUpdateOne {
+	if i != nil {
+		pceuo.SetDeliveryAttempts(*i)
+	}
+	return pceuo
+}
+
+// AddDeliveryAttempts adds i to the "delivery_attempts" field.
+func (pceuo *ProfileChangeEventUpdateOne) AddDeliveryAttempts(i int) *ProfileChangeEventUpdateOne {
+	pceuo.mutation.AddDeliveryAttempts(i)
+	return pceuo
+}
+
+// SetNextDeliveryAt sets the "next_delivery_at" field.
+func (pceuo *ProfileChangeEventUpdateOne) SetNextDeliveryAt(t time.Time) *ProfileChangeEventUpdateOne {
+	pceuo.mutation.SetNextDeliveryAt(t)
+	return pceuo
+}
+
+// SetNillableNextDeliveryAt sets the "next_delivery_at" field if the given value is not nil.
+func (pceuo *ProfileChangeEventUpdateOne) SetNillableNextDeliveryAt(t *time.Time) *ProfileChangeEventUpdateOne {
+	if t != nil {
+		pceuo.SetNextDeliveryAt(*t)
+	}
+	return pceuo
+}
+
+// ClearNextDeliveryAt clears the value of the "next_delivery_at" field.
+func (pceuo *ProfileChangeEventUpdateOne) ClearNextDeliveryAt() *ProfileChangeEventUpdateOne {
+	pceuo.mutation.ClearNextDeliveryAt()
+	return pceuo
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (pceuo *ProfileChangeEventUpdateOne) SetDeliveredAt(t time.Time) *ProfileChangeEventUpdateOne {
+	pceuo.mutation.SetDeliveredAt(t)
+	return pceuo
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (pceuo *ProfileChangeEventUpdateOne) SetNillableDeliveredAt(t *time.Time) *ProfileChangeEventUpdateOne {
+	if t != nil {
+		pceuo.SetDeliveredAt(*t)
+	}
+	return pceuo
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (pceuo *ProfileChangeEventUpdateOne) ClearDeliveredAt() *ProfileChangeEventUpdateOne {
+	pceuo.mutation.ClearDeliveredAt()
+	return pceuo
+}
+
+// SetLastDeliveryError sets the "last_delivery_error" field.
+func (pceuo *ProfileChangeEventUpdateOne) SetLastDeliveryError(s string) *ProfileChangeEventUpdateOne {
+	pceuo.mutation.SetLastDeliveryError(s)
+	return pceuo
+}
+
+// SetNillableLastDeliveryError sets the "last_delivery_error" field if the given value is not nil.
+func (pceuo *ProfileChangeEventUpdateOne) SetNillableLastDeliveryError(s *string) *ProfileChangeEventUpdateOne {
+	if s != nil {
+		pceuo.SetLastDeliveryError(*s)
+	}
+	return pceuo
+}
+
+// ClearLastDeliveryError clears the value of the "last_delivery_error" field.
+func (pceuo *ProfileChangeEventUpdateOne) ClearLastDeliveryError() *ProfileChangeEventUpdateOne {
+	pceuo.mutation.ClearLastDeliveryError()
+	return pceuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pceuo *ProfileChangeEventUpdateOne) SetUpdatedAt(t time.Time) *ProfileChangeEventUpdateOne {
+	pceuo.mutation.SetUpdatedAt(t)
+	return pceuo
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (pceuo *ProfileChangeEventUpdateOne) SetProfileID(id ulid.ID) *ProfileChangeEventUpdateOne {
+	pceuo.mutation.SetProfileID(id)
+	return pceuo
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (pceuo *ProfileChangeEventUpdateOne) SetProfile(p *Profile) *ProfileChangeEventUpdateOne {
+	return pceuo.SetProfileID(p.ID)
+}
+
+// SetSnapshotID sets the "snapshot" edge to the ProfileSnapshot entity by ID.
+func (pceuo *ProfileChangeEventUpdateOne) SetSnapshotID(id ulid.ID) *ProfileChangeEventUpdateOne {
+	pceuo.mutation.SetSnapshotID(id)
+	return pceuo
+}
+
+// SetNillableSnapshotID sets the "snapshot" edge to the ProfileSnapshot entity by ID if the given value is not nil.
+func (pceuo *ProfileChangeEventUpdateOne) SetNillableSnapshotID(id *ulid.ID) *ProfileChangeEventUpdateOne {
+	if id != nil {
+		pceuo = pceuo.SetSnapshotID(*id)
+	}
+	return pceuo
+}
+
+// SetSnapshot sets the "snapshot" edge to the ProfileSnapshot entity.
+func (pceuo *ProfileChangeEventUpdateOne) SetSnapshot(p *ProfileSnapshot) *ProfileChangeEventUpdateOne {
+	return pceuo.SetSnapshotID(p.ID)
+}
+
+// Mutation returns the ProfileChangeEventMutation object of the builder.
+func (pceuo *ProfileChangeEventUpdateOne) Mutation() *ProfileChangeEventMutation {
+	return pceuo.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (pceuo *ProfileChangeEventUpdateOne) ClearProfile() *ProfileChangeEventUpdateOne {
+	pceuo.mutation.ClearProfile()
+	return pceuo
+}
+
+// ClearSnapshot clears the "snapshot" edge to the ProfileSnapshot entity.
+func (pceuo *ProfileChangeEventUpdateOne) ClearSnapshot() *ProfileChangeEventUpdateOne {
+	pceuo.mutation.ClearSnapshot()
+	return pceuo
+}
+
+// Where appends a list predicates to the ProfileChangeEventUpdate builder.
+func (pceuo *ProfileChangeEventUpdateOne) Where(ps ...predicate.ProfileChangeEvent) *ProfileChangeEventUpdateOne {
+	pceuo.mutation.Where(ps...)
+	return pceuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pceuo *ProfileChangeEventUpdateOne) Select(field string, fields ...string) *ProfileChangeEventUpdateOne {
+	pceuo.fields = append([]string{field}, fields...)
+	return pceuo
+}
+
+// Save executes the query and returns the updated ProfileChangeEvent entity.
+func (pceuo *ProfileChangeEventUpdateOne) Save(ctx context.Context) (*ProfileChangeEvent, error) {
+	pceuo.defaults()
+	return withHooks(ctx, pceuo.sqlSave, pceuo.mutation, pceuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pceuo *ProfileChangeEventUpdateOne) SaveX(ctx context.Context) *ProfileChangeEvent {
+	node, err := pceuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pceuo *ProfileChangeEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := pceuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pceuo *ProfileChangeEventUpdateOne) ExecX(ctx context.Context) {
+	if err := pceuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pceuo *ProfileChangeEventUpdateOne) defaults() {
+	if _, ok := pceuo.mutation.UpdatedAt(); !ok {
+		v := profilechangeevent.UpdateDefaultUpdatedAt()
+		pceuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pceuo *ProfileChangeEventUpdateOne) check() error {
+	if v, ok := pceuo.mutation.DeliveryStatus(); ok {
+		if err := profilechangeevent.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "ProfileChangeEvent.delivery_status": %w`, err)}
+		}
+	}
+	if v, ok := pceuo.mutation.DeliveryAttempts(); ok {
+		if err := profilechangeevent.DeliveryAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_attempts", err: fmt.Errorf(`ent: validator failed for field "ProfileChangeEvent.delivery_attempts": %w`, err)}
+		}
+	}
+	if pceuo.mutation.ProfileCleared() && len(pceuo.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProfileChangeEvent.profile"`)
+	}
+	return nil
+}
+
+func (pceuo *ProfileChangeEventUpdateOne) sqlSave(ctx context.Context) (_node *ProfileChangeEvent, err error) {
+	if err := pceuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profilechangeevent.Table, profilechangeevent.Columns, sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString))
+	id, ok := pceuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProfileChangeEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pceuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profilechangeevent.FieldID)
+		for _, f := range fields {
+			if !profilechangeevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != profilechangeevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pceuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pceuo.mutation.OldValueCleared() {
+		_spec.ClearField(profilechangeevent.FieldOldValue, field.TypeString)
+	}
+	if pceuo.mutation.NewValueCleared() {
+		_spec.ClearField(profilechangeevent.FieldNewValue, field.TypeString)
+	}
+	if value, ok := pceuo.mutation.DeliveryStatus(); ok {
+		_spec.SetField(profilechangeevent.FieldDeliveryStatus, field.TypeEnum, value)
+	}
+	if value, ok := pceuo.mutation.DeliveryAttempts(); ok {
+		_spec.SetField(profilechangeevent.FieldDeliveryAttempts, field.TypeInt, value)
+	}
+	if value, ok := pceuo.mutation.AddedDeliveryAttempts(); ok {
+		_spec.AddField(profilechangeevent.FieldDeliveryAttempts, field.TypeInt, value)
+	}
+	if value, ok := pceuo.mutation.NextDeliveryAt(); ok {
+		_spec.SetField(profilechangeevent.FieldNextDeliveryAt, field.TypeTime, value)
+	}
+	if pceuo.mutation.NextDeliveryAtCleared() {
+		_spec.ClearField(profilechangeevent.FieldNextDeliveryAt, field.TypeTime)
+	}
+	if value, ok := pceuo.mutation.DeliveredAt(); ok {
+		_spec.SetField(profilechangeevent.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if pceuo.mutation.DeliveredAtCleared() {
+		_spec.ClearField(profilechangeevent.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := pceuo.mutation.LastDeliveryError(); ok {
+		_spec.SetField(profilechangeevent.FieldLastDeliveryError, field.TypeString, value)
+	}
+	if pceuo.mutation.LastDeliveryErrorCleared() {
+		_spec.ClearField(profilechangeevent.FieldLastDeliveryError, field.TypeString)
+	}
+	if value, ok := pceuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(profilechangeevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pceuo.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilechangeevent.ProfileTable,
+			Columns: []string{profilechangeevent.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pceuo.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilechangeevent.ProfileTable,
+			Columns: []string{profilechangeevent.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pceuo.mutation.SnapshotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilechangeevent.SnapshotTable,
+			Columns: []string{profilechangeevent.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pceuo.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilechangeevent.SnapshotTable,
+			Columns: []string{profilechangeevent.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProfileChangeEvent{config: pceuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pceuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profilechangeevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pceuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileeducation"
+	"sheng-go-backend/ent/schema/ulid"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProfileEducation is the model entity for the ProfileEducation schema.
+type ProfileEducation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID ulid.ID `json:"id,omitempty"`
+	// Order in educations; 0 is the most recent
+	EducationIndex int `json:"education_index,omitempty"`
+	// School name
+	SchoolName string `json:"school_name,omitempty"`
+	// LinkedIn school ID, when present
+	SchoolID string `json:"school_id,omitempty"`
+	// Degree, e.g. PhD
+	Degree string `json:"degree,omitempty"`
+	// Field of study
+	FieldOfStudy string `json:"field_of_study,omitempty"`
+	// Start date; unknown month/day default to 1
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// End date; unknown month/day default to 1
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProfileEducationQuery when eager-loading is set.
+	Edges                      ProfileEducationEdges `json:"edges"`
+	profile_profile_educations *ulid.ID
+	selectValues               sql.SelectValues
+}
+
+// ProfileEducationEdges holds the relations/edges for other nodes in the graph.
+type ProfileEducationEdges struct {
+	// Profile this education belongs to
+	Profile *Profile `json:"profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProfileEducationEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProfileEducation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case profileeducation.FieldEducationIndex:
+			values[i] = new(sql.NullInt64)
+		case profileeducation.FieldSchoolName, profileeducation.FieldSchoolID, profileeducation.FieldDegree, profileeducation.FieldFieldOfStudy:
+			values[i] = new(sql.NullString)
+		case profileeducation.FieldStartDate, profileeducation.FieldEndDate, profileeducation.FieldCreatedAt, profileeducation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case profileeducation.FieldID:
+			values[i] = new(ulid.ID)
+		case profileeducation.ForeignKeys[0]: // profile_profile_educations
+			values[i] = &sql.NullScanner{S: new(ulid.ID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProfileEducation fields.
+func (pe *ProfileEducation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case profileeducation.FieldID:
+			if value, ok := values[i].(*ulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pe.ID = *value
+			}
+		case profileeducation.FieldEducationIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field education_index", values[i])
+			} else if value.Valid {
+				pe.EducationIndex = int(value.Int64)
+			}
+		case profileeducation.FieldSchoolName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field school_name", values[i])
+			} else if value.Valid {
+				pe.SchoolName = value.String
+			}
+		case profileeducation.FieldSchoolID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field school_id", values[i])
+			} else if value.Valid {
+				pe.SchoolID = value.String
+			}
+		case profileeducation.FieldDegree:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field degree", values[i])
+			} else if value.Valid {
+				pe.Degree = value.String
+			}
+		case profileeducation.FieldFieldOfStudy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field field_of_study", values[i])
+			} else if value.Valid {
+				pe.FieldOfStudy = value.String
+			}
+		case profileeducation.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				pe.StartDate = new(time.Time)
+				*pe.StartDate = value.Time
+			}
+		case profileeducation.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				pe.EndDate = new(time.Time)
+				*pe.EndDate = value.Time
+			}
+		case profileeducation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pe.CreatedAt = value.Time
+			}
+		case profileeducation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pe.UpdatedAt = value.Time
+			}
+		case profileeducation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_profile_educations", values[i])
+			} else if value.Valid {
+				pe.profile_profile_educations = new(ulid.ID)
+				*pe.profile_profile_educations = *value.S.(*ulid.ID)
+			}
+		default:
+			pe.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProfileEducation.
+// This includes values selected through modifiers, order, etc.
+func (pe *ProfileEducation) Value(name string) (ent.Value, error) {
+	return pe.selectValues.Get(name)
+}
+
+// QueryProfile queries the "profile" edge of the ProfileEducation entity.
+func (pe *ProfileEducation) QueryProfile() *ProfileQuery {
+	return NewProfileEducationClient(pe.config).QueryProfile(pe)
+}
+
+// Update returns a builder for updating this ProfileEducation.
+// Note that you need to call ProfileEducation.Unwrap() before calling this method if this ProfileEducation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pe *ProfileEducation) Update() *ProfileEducationUpdateOne {
+	return NewProfileEducationClient(pe.config).UpdateOne(pe)
+}
+
+// Unwrap unwraps the ProfileEducation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pe *ProfileEducation) Unwrap() *ProfileEducation {
+	_tx, ok := pe.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProfileEducation is not a transactional entity")
+	}
+	pe.config.driver = _tx.drv
+	return pe
+}
+
+// String implements the fmt.Stringer.
+func (pe *ProfileEducation) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProfileEducation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pe.ID))
+	builder.WriteString("education_index=")
+	builder.WriteString(fmt.Sprintf("%v", pe.EducationIndex))
+	builder.WriteString(", ")
+	builder.WriteString("school_name=")
+	builder.WriteString(pe.SchoolName)
+	builder.WriteString(", ")
+	builder.WriteString("school_id=")
+	builder.WriteString(pe.SchoolID)
+	builder.WriteString(", ")
+	builder.WriteString("degree=")
+	builder.WriteString(pe.Degree)
+	builder.WriteString(", ")
+	builder.WriteString("field_of_study=")
+	builder.WriteString(pe.FieldOfStudy)
+	builder.WriteString(", ")
+	if v := pe.StartDate; v != nil {
+		builder.WriteString("start_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pe.EndDate; v != nil {
+		builder.WriteString("end_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pe.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pe.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProfileEducations is a parsable slice of ProfileEducation.
+type ProfileEducations []*ProfileEducation
@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profileeducation
+
+import (
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the profileeducation type in the database.
+	Label = "profile_education"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEducationIndex holds the string denoting the education_index field in the database.
+	FieldEducationIndex = "education_index"
+	// FieldSchoolName holds the string denoting the school_name field in the database.
+	FieldSchoolName = "school_name"
+	// FieldSchoolID holds the string denoting the school_id field in the database.
+	FieldSchoolID = "school_id"
+	// FieldDegree holds the string denoting the degree field in the database.
+	FieldDegree = "degree"
+	// FieldFieldOfStudy holds the string denoting the field_of_study field in the database.
+	FieldFieldOfStudy = "field_of_study"
+	// FieldStartDate holds the string denoting the start_date field in the database.
+	FieldStartDate = "start_date"
+	// FieldEndDate holds the string denoting the end_date field in the database.
+	FieldEndDate = "end_date"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeProfile holds the string denoting the profile edge name in mutations.
+	EdgeProfile = "profile"
+	// Table holds the table name of the profileeducation in the database.
+	Table = "profile_educations"
+	// ProfileTable is the table that holds the profile relation/edge.
+	ProfileTable = "profile_educations"
+	// ProfileInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	ProfileInverseTable = "profiles"
+	// ProfileColumn is the table column denoting the profile relation/edge.
+	ProfileColumn = "profile_profile_educations"
+)
+
+// Columns holds all SQL columns for profileeducation fields.
+var Columns = []string{
+	FieldID,
+	FieldEducationIndex,
+	FieldSchoolName,
+	FieldSchoolID,
+	FieldDegree,
+	FieldFieldOfStudy,
+	FieldStartDate,
+	FieldEndDate,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "profile_educations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"profile_profile_educations",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EducationIndexValidator is a validator for the "education_index" field. It is called by the builders before save.
+	EducationIndexValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() ulid.ID
+)
+
+// OrderOption defines the ordering options for the ProfileEducation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEducationIndex orders the results by the education_index field.
+func ByEducationIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEducationIndex, opts...).ToFunc()
+}
+
+// BySchoolName orders the results by the school_name field.
+func BySchoolName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchoolName, opts...).ToFunc()
+}
+
+// BySchoolID orders the results by the school_id field.
+func BySchoolID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchoolID, opts...).ToFunc()
+}
+
+// ByDegree orders the results by the degree field.
+func ByDegree(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDegree, opts...).ToFunc()
+}
+
+// ByFieldOfStudy orders the results by the field_of_study field.
+func ByFieldOfStudy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFieldOfStudy, opts...).ToFunc()
+}
+
+// ByStartDate orders the results by the start_date field.
+func ByStartDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartDate, opts...).ToFunc()
+}
+
+// ByEndDate orders the results by the end_date field.
+func ByEndDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndDate, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByProfileField orders the results by profile field.
+func ByProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+	)
+}
@@ -0,0 +1,650 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profileeducation
+
+import (
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id ulid.ID) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id ulid.ID) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id ulid.ID) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...ulid.ID) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...ulid.ID) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id ulid.ID) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id ulid.ID) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id ulid.ID) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id ulid.ID) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLTE(FieldID, id))
+}
+
+// EducationIndex applies equality check predicate on the "education_index" field. It's identical to EducationIndexEQ.
+func EducationIndex(v int) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldEducationIndex, v))
+}
+
+// SchoolName applies equality check predicate on the "school_name" field. It's identical to SchoolNameEQ.
+func SchoolName(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldSchoolName, v))
+}
+
+// SchoolID applies equality check predicate on the "school_id" field. It's identical to SchoolIDEQ.
+func SchoolID(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldSchoolID, v))
+}
+
+// Degree applies equality check predicate on the "degree" field. It's identical to DegreeEQ.
+func Degree(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldDegree, v))
+}
+
+// FieldOfStudy applies equality check predicate on the "field_of_study" field. It's identical to FieldOfStudyEQ.
+func FieldOfStudy(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldFieldOfStudy, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldEndDate, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EducationIndexEQ applies the EQ predicate on the "education_index" field.
+func EducationIndexEQ(v int) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldEducationIndex, v))
+}
+
+// EducationIndexNEQ applies the NEQ predicate on the "education_index" field.
+func EducationIndexNEQ(v int) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNEQ(FieldEducationIndex, v))
+}
+
+// EducationIndexIn applies the In predicate on the "education_index" field.
+func EducationIndexIn(vs ...int) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIn(FieldEducationIndex, vs...))
+}
+
+// EducationIndexNotIn applies the NotIn predicate on the "education_index" field.
+func EducationIndexNotIn(vs ...int) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotIn(FieldEducationIndex, vs...))
+}
+
+// EducationIndexGT applies the GT predicate on the "education_index" field.
+func EducationIndexGT(v int) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGT(FieldEducationIndex, v))
+}
+
+// EducationIndexGTE applies the GTE predicate on the "education_index" field.
+func EducationIndexGTE(v int) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGTE(FieldEducationIndex, v))
+}
+
+// EducationIndexLT applies the LT predicate on the "education_index" field.
+func EducationIndexLT(v int) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLT(FieldEducationIndex, v))
+}
+
+// EducationIndexLTE applies the LTE predicate on the "education_index" field.
+func EducationIndexLTE(v int) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLTE(FieldEducationIndex, v))
+}
+
+// SchoolNameEQ applies the EQ predicate on the "school_name" field.
+func SchoolNameEQ(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldSchoolName, v))
+}
+
+// SchoolNameNEQ applies the NEQ predicate on the "school_name" field.
+func SchoolNameNEQ(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNEQ(FieldSchoolName, v))
+}
+
+// SchoolNameIn applies the In predicate on the "school_name" field.
+func SchoolNameIn(vs ...string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIn(FieldSchoolName, vs...))
+}
+
+// SchoolNameNotIn applies the NotIn predicate on the "school_name" field.
+func SchoolNameNotIn(vs ...string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotIn(FieldSchoolName, vs...))
+}
+
+// SchoolNameGT applies the GT predicate on the "school_name" field.
+func SchoolNameGT(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGT(FieldSchoolName, v))
+}
+
+// SchoolNameGTE applies the GTE predicate on the "school_name" field.
+func SchoolNameGTE(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGTE(FieldSchoolName, v))
+}
+
+// SchoolNameLT applies the LT predicate on the "school_name" field.
+func SchoolNameLT(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLT(FieldSchoolName, v))
+}
+
+// SchoolNameLTE applies the LTE predicate on the "school_name" field.
+func SchoolNameLTE(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLTE(FieldSchoolName, v))
+}
+
+// SchoolNameContains applies the Contains predicate on the "school_name" field.
+func SchoolNameContains(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldContains(FieldSchoolName, v))
+}
+
+// SchoolNameHasPrefix applies the HasPrefix predicate on the "school_name" field.
+func SchoolNameHasPrefix(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldHasPrefix(FieldSchoolName, v))
+}
+
+// SchoolNameHasSuffix applies the HasSuffix predicate on the "school_name" field.
+func SchoolNameHasSuffix(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldHasSuffix(FieldSchoolName, v))
+}
+
+// SchoolNameEqualFold applies the EqualFold predicate on the "school_name" field.
+func SchoolNameEqualFold(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEqualFold(FieldSchoolName, v))
+}
+
+// SchoolNameContainsFold applies the ContainsFold predicate on the "school_name" field.
+func SchoolNameContainsFold(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldContainsFold(FieldSchoolName, v))
+}
+
+// SchoolIDEQ applies the EQ predicate on the "school_id" field.
+func SchoolIDEQ(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldSchoolID, v))
+}
+
+// SchoolIDNEQ applies the NEQ predicate on the "school_id" field.
+func SchoolIDNEQ(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNEQ(FieldSchoolID, v))
+}
+
+// SchoolIDIn applies the In predicate on the "school_id" field.
+func SchoolIDIn(vs ...string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIn(FieldSchoolID, vs...))
+}
+
+// SchoolIDNotIn applies the NotIn predicate on the "school_id" field.
+func SchoolIDNotIn(vs ...string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotIn(FieldSchoolID, vs...))
+}
+
+// SchoolIDGT applies the GT predicate on the "school_id" field.
+func SchoolIDGT(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGT(FieldSchoolID, v))
+}
+
+// SchoolIDGTE applies the GTE predicate on the "school_id" field.
+func SchoolIDGTE(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGTE(FieldSchoolID, v))
+}
+
+// SchoolIDLT applies the LT predicate on the "school_id" field.
+func SchoolIDLT(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLT(FieldSchoolID, v))
+}
+
+// SchoolIDLTE applies the LTE predicate on the "school_id" field.
+func SchoolIDLTE(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLTE(FieldSchoolID, v))
+}
+
+// SchoolIDContains applies the Contains predicate on the "school_id" field.
+func SchoolIDContains(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldContains(FieldSchoolID, v))
+}
+
+// SchoolIDHasPrefix applies the HasPrefix predicate on the "school_id" field.
+func SchoolIDHasPrefix(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldHasPrefix(FieldSchoolID, v))
+}
+
+// SchoolIDHasSuffix applies the HasSuffix predicate on the "school_id" field.
+func SchoolIDHasSuffix(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldHasSuffix(FieldSchoolID, v))
+}
+
+// SchoolIDIsNil applies the IsNil predicate on the "school_id" field.
+func SchoolIDIsNil() predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIsNull(FieldSchoolID))
+}
+
+// SchoolIDNotNil applies the NotNil predicate on the "school_id" field.
+func SchoolIDNotNil() predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotNull(FieldSchoolID))
+}
+
+// SchoolIDEqualFold applies the EqualFold predicate on the "school_id" field.
+func SchoolIDEqualFold(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEqualFold(FieldSchoolID, v))
+}
+
+// SchoolIDContainsFold applies the ContainsFold predicate on the "school_id" field.
+func SchoolIDContainsFold(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldContainsFold(FieldSchoolID, v))
+}
+
+// DegreeEQ applies the EQ predicate on the "degree" field.
+func DegreeEQ(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldDegree, v))
+}
+
+// DegreeNEQ applies the NEQ predicate on the "degree" field.
+func DegreeNEQ(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNEQ(FieldDegree, v))
+}
+
+// DegreeIn applies the In predicate on the "degree" field.
+func DegreeIn(vs ...string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIn(FieldDegree, vs...))
+}
+
+// DegreeNotIn applies the NotIn predicate on the "degree" field.
+func DegreeNotIn(vs ...string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotIn(FieldDegree, vs...))
+}
+
+// DegreeGT applies the GT predicate on the "degree" field.
+func DegreeGT(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGT(FieldDegree, v))
+}
+
+// DegreeGTE applies the GTE predicate on the "degree" field.
+func DegreeGTE(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGTE(FieldDegree, v))
+}
+
+// DegreeLT applies the LT predicate on the "degree" field.
+func DegreeLT(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLT(FieldDegree, v))
+}
+
+// DegreeLTE applies the LTE predicate on the "degree" field.
+func DegreeLTE(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLTE(FieldDegree, v))
+}
+
+// DegreeContains applies the Contains predicate on the "degree" field.
+func DegreeContains(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldContains(FieldDegree, v))
+}
+
+// DegreeHasPrefix applies the HasPrefix predicate on the "degree" field.
+func DegreeHasPrefix(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldHasPrefix(FieldDegree, v))
+}
+
+// DegreeHasSuffix applies the HasSuffix predicate on the "degree" field.
+func DegreeHasSuffix(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldHasSuffix(FieldDegree, v))
+}
+
+// DegreeIsNil applies the IsNil predicate on the "degree" field.
+func DegreeIsNil() predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIsNull(FieldDegree))
+}
+
+// DegreeNotNil applies the NotNil predicate on the "degree" field.
+func DegreeNotNil() predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotNull(FieldDegree))
+}
+
+// DegreeEqualFold applies the EqualFold predicate on the "degree" field.
+func DegreeEqualFold(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEqualFold(FieldDegree, v))
+}
+
+// DegreeContainsFold applies the ContainsFold predicate on the "degree" field.
+func DegreeContainsFold(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldContainsFold(FieldDegree, v))
+}
+
+// FieldOfStudyEQ applies the EQ predicate on the "field_of_study" field.
+func FieldOfStudyEQ(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldFieldOfStudy, v))
+}
+
+// FieldOfStudyNEQ applies the NEQ predicate on the "field_of_study" field.
+func FieldOfStudyNEQ(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNEQ(FieldFieldOfStudy, v))
+}
+
+// FieldOfStudyIn applies the In predicate on the "field_of_study" field.
+func FieldOfStudyIn(vs ...string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIn(FieldFieldOfStudy, vs...))
+}
+
+// FieldOfStudyNotIn applies the NotIn predicate on the "field_of_study" field.
+func FieldOfStudyNotIn(vs ...string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotIn(FieldFieldOfStudy, vs...))
+}
+
+// FieldOfStudyGT applies the GT predicate on the "field_of_study" field.
+func FieldOfStudyGT(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGT(FieldFieldOfStudy, v))
+}
+
+// FieldOfStudyGTE applies the GTE predicate on the "field_of_study" field.
+func FieldOfStudyGTE(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGTE(FieldFieldOfStudy, v))
+}
+
+// FieldOfStudyLT applies the LT predicate on the "field_of_study" field.
+func FieldOfStudyLT(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLT(FieldFieldOfStudy, v))
+}
+
+// FieldOfStudyLTE applies the LTE predicate on the "field_of_study" field.
+func FieldOfStudyLTE(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLTE(FieldFieldOfStudy, v))
+}
+
+// FieldOfStudyContains applies the Contains predicate on the "field_of_study" field.
+func FieldOfStudyContains(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldContains(FieldFieldOfStudy, v))
+}
+
+// FieldOfStudyHasPrefix applies the HasPrefix predicate on the "field_of_study" field.
+func FieldOfStudyHasPrefix(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldHasPrefix(FieldFieldOfStudy, v))
+}
+
+// FieldOfStudyHasSuffix applies the HasSuffix predicate on the "field_of_study" field.
+func FieldOfStudyHasSuffix(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldHasSuffix(FieldFieldOfStudy, v))
+}
+
+// FieldOfStudyIsNil applies the IsNil predicate on the "field_of_study" field.
+func FieldOfStudyIsNil() predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIsNull(FieldFieldOfStudy))
+}
+
+// FieldOfStudyNotNil applies the NotNil predicate on the "field_of_study" field.
+func FieldOfStudyNotNil() predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotNull(FieldFieldOfStudy))
+}
+
+// FieldOfStudyEqualFold applies the EqualFold predicate on the "field_of_study" field.
+func FieldOfStudyEqualFold(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEqualFold(FieldFieldOfStudy, v))
+}
+
+// FieldOfStudyContainsFold applies the ContainsFold predicate on the "field_of_study" field.
+func FieldOfStudyContainsFold(v string) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldContainsFold(FieldFieldOfStudy, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLTE(FieldStartDate, v))
+}
+
+// StartDateIsNil applies the IsNil predicate on the "start_date" field.
+func StartDateIsNil() predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIsNull(FieldStartDate))
+}
+
+// StartDateNotNil applies the NotNil predicate on the "start_date" field.
+func StartDateNotNil() predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotNull(FieldStartDate))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLTE(FieldEndDate, v))
+}
+
+// EndDateIsNil applies the IsNil predicate on the "end_date" field.
+func EndDateIsNil() predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIsNull(FieldEndDate))
+}
+
+// EndDateNotNil applies the NotNil predicate on the "end_date" field.
+func EndDateNotNil() predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotNull(FieldEndDate))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.ProfileEducation {
+	return predicate.ProfileEducation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.ProfileEducation {
+	return predicate.ProfileEducation(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProfileEducation) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProfileEducation) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProfileEducation) predicate.ProfileEducation {
+	return predicate.ProfileEducation(sql.NotPredicates(p))
+}
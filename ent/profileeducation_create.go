@@ -0,0 +1,1105 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileeducation"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileEducationCreate is the builder for creating a ProfileEducation entity.
+type ProfileEducationCreate struct {
+	config
+	mutation *ProfileEducationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEducationIndex sets the "education_index" field.
+func (pec *ProfileEducationCreate) SetEducationIndex(i int) *ProfileEducationCreate {
+	pec.mutation.SetEducationIndex(i)
+	return pec
+}
+
+// SetSchoolName sets the "school_name" field.
+func (pec *ProfileEducationCreate) SetSchoolName(s string) *ProfileEducationCreate {
+	pec.mutation.SetSchoolName(s)
+	return pec
+}
+
+// SetSchoolID sets the "school_id" field.
+func (pec *ProfileEducationCreate) SetSchoolID(s string) *ProfileEducationCreate {
+	pec.mutation.SetSchoolID(s)
+	return pec
+}
+
+// SetNillableSchoolID sets the "school_id" field if the given value is not nil.
+func (pec *ProfileEducationCreate) SetNillableSchoolID(s *string) *ProfileEducationCreate {
+	if s != nil {
+		pec.SetSchoolID(*s)
+	}
+	return pec
+}
+
+// SetDegree sets the "degree" field.
+func (pec *ProfileEducationCreate) SetDegree(s string) *ProfileEducationCreate {
+	pec.mutation.SetDegree(s)
+	return pec
+}
+
+// SetNillableDegree sets the "degree" field if the given value is not nil.
+func (pec *ProfileEducationCreate) SetNillableDegree(s *string) *ProfileEducationCreate {
+	if s != nil {
+		pec.SetDegree(*s)
+	}
+	return pec
+}
+
+// SetFieldOfStudy sets the "field_of_study" field.
+func (pec *ProfileEducationCreate) SetFieldOfStudy(s string) *ProfileEducationCreate {
+	pec.mutation.SetFieldOfStudy(s)
+	return pec
+}
+
+// SetNillableFieldOfStudy sets the "field_of_study" field if the given value is not nil.
+func (pec *ProfileEducationCreate) SetNillableFieldOfStudy(s *string) *ProfileEducationCreate {
+	if s != nil {
+		pec.SetFieldOfStudy(*s)
+	}
+	return pec
+}
+
+// SetStartDate sets the "start_date" field.
+func (pec *ProfileEducationCreate) SetStartDate(t time.Time) *ProfileEducationCreate {
+	pec.mutation.SetStartDate(t)
+	return pec
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (pec *ProfileEducationCreate) SetNillableStartDate(t *time.Time) *ProfileEducationCreate {
+	if t != nil {
+		pec.SetStartDate(*t)
+	}
+	return pec
+}
+
+// SetEndDate sets the "end_date" field.
+func (pec *ProfileEducationCreate) SetEndDate(t time.Time) *ProfileEducationCreate {
+	pec.mutation.SetEndDate(t)
+	return pec
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (pec *ProfileEducationCreate) SetNillableEndDate(t *time.Time) *ProfileEducationCreate {
+	if t != nil {
+		pec.SetEndDate(*t)
+	}
+	return pec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pec *ProfileEducationCreate) SetCreatedAt(t time.Time) *ProfileEducationCreate {
+	pec.mutation.SetCreatedAt(t)
+	return pec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pec *ProfileEducationCreate) SetNillableCreatedAt(t *time.Time) *ProfileEducationCreate {
+	if t != nil {
+		pec.SetCreatedAt(*t)
+	}
+	return pec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pec *ProfileEducationCreate) SetUpdatedAt(t time.Time) *ProfileEducationCreate {
+	pec.mutation.SetUpdatedAt(t)
+	return pec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pec *ProfileEducationCreate) SetNillableUpdatedAt(t *time.Time) *ProfileEducationCreate {
+	if t != nil {
+		pec.SetUpdatedAt(*t)
+	}
+	return pec
+}
+
+// SetID sets the "id" field.
+func (pec *ProfileEducationCreate) SetID(u ulid.ID) *ProfileEducationCreate {
+	pec.mutation.SetID(u)
+	return pec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pec *ProfileEducationCreate) SetNillableID(u *ulid.ID) *ProfileEducationCreate {
+	if u != nil {
+		pec.SetID(*u)
+	}
+	return pec
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (pec *ProfileEducationCreate) SetProfileID(id ulid.ID) *ProfileEducationCreate {
+	pec.mutation.SetProfileID(id)
+	return pec
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (pec *ProfileEducationCreate) SetProfile(p *Profile) *ProfileEducationCreate {
+	return pec.SetProfileID(p.ID)
+}
+
+// Mutation returns the ProfileEducationMutation object of the builder.
+func (pec *ProfileEducationCreate) Mutation() *ProfileEducationMutation {
+	return pec.mutation
+}
+
+// Save creates the ProfileEducation in the database.
+func (pec *ProfileEducationCreate) Save(ctx context.Context) (*ProfileEducation, error) {
+	pec.defaults()
+	return withHooks(ctx, pec.sqlSave, pec.mutation, pec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pec *ProfileEducationCreate) SaveX(ctx context.Context) *ProfileEducation {
+	v, err := pec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pec *ProfileEducationCreate) Exec(ctx context.Context) error {
+	_, err := pec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pec *ProfileEducationCreate) ExecX(ctx context.Context) {
+	if err := pec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pec *ProfileEducationCreate) defaults() {
+	if _, ok := pec.mutation.CreatedAt(); !ok {
+		v := profileeducation.DefaultCreatedAt()
+		pec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pec.mutation.UpdatedAt(); !ok {
+		v := profileeducation.DefaultUpdatedAt()
+		pec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pec.mutation.ID(); !ok {
+		v := profileeducation.DefaultID()
+		pec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pec *ProfileEducationCreate) check() error {
+	if _, ok := pec.mutation.EducationIndex(); !ok {
+		return &ValidationError{Name: "education_index", err: errors.New(`ent: missing required field "ProfileEducation.education_index"`)}
+	}
+	if v, ok := pec.mutation.EducationIndex(); ok {
+		if err := profileeducation.EducationIndexValidator(v); err != nil {
+			return &ValidationError{Name: "education_index", err: fmt.Errorf(`ent: validator failed for field "ProfileEducation.education_index": %w`, err)}
+		}
+	}
+	if _, ok := pec.mutation.SchoolName(); !ok {
+		return &ValidationError{Name: "school_name", err: errors.New(`ent: missing required field "ProfileEducation.school_name"`)}
+	}
+	if _, ok := pec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProfileEducation.created_at"`)}
+	}
+	if _, ok := pec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProfileEducation.updated_at"`)}
+	}
+	if len(pec.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "ProfileEducation.profile"`)}
+	}
+	return nil
+}
+
+func (pec *ProfileEducationCreate) sqlSave(ctx context.Context) (*ProfileEducation, error) {
+	if err := pec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*ulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pec.mutation.id = &_node.ID
+	pec.mutation.done = true
+	return _node, nil
+}
+
+func (pec *ProfileEducationCreate) createSpec() (*ProfileEducation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProfileEducation{config: pec.config}
+		_spec = sqlgraph.NewCreateSpec(profileeducation.Table, sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = pec.conflict
+	if id, ok := pec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pec.mutation.EducationIndex(); ok {
+		_spec.SetField(profileeducation.FieldEducationIndex, field.TypeInt, value)
+		_node.EducationIndex = value
+	}
+	if value, ok := pec.mutation.SchoolName(); ok {
+		_spec.SetField(profileeducation.FieldSchoolName, field.TypeString, value)
+		_node.SchoolName = value
+	}
+	if value, ok := pec.mutation.SchoolID(); ok {
+		_spec.SetField(profileeducation.FieldSchoolID, field.TypeString, value)
+		_node.SchoolID = value
+	}
+	if value, ok := pec.mutation.Degree(); ok {
+		_spec.SetField(profileeducation.FieldDegree, field.TypeString, value)
+		_node.Degree = value
+	}
+	if value, ok := pec.mutation.FieldOfStudy(); ok {
+		_spec.SetField(profileeducation.FieldFieldOfStudy, field.TypeString, value)
+		_node.FieldOfStudy = value
+	}
+	if value, ok := pec.mutation.StartDate(); ok {
+		_spec.SetField(profileeducation.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = &value
+	}
+	if value, ok := pec.mutation.EndDate(); ok {
+		_spec.SetField(profileeducation.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = &value
+	}
+	if value, ok := pec.mutation.CreatedAt(); ok {
+		_spec.SetField(profileeducation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pec.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileeducation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pec.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileeducation.ProfileTable,
+			Columns: []string{profileeducation.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.profile_profile_educations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfileEducation.Create().
+//		SetEducationIndex(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileEducationUpsert) {
+//			SetEducationIndex(v+v).
+//		}).
+//		Exec(ctx)
+func (pec *ProfileEducationCreate) OnConflict(opts ...sql.ConflictOption) *ProfileEducationUpsertOne {
+	pec.conflict = opts
+	return &ProfileEducationUpsertOne{
+		create: pec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfileEducation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pec *ProfileEducationCreate) OnConflictColumns(columns ...string) *ProfileEducationUpsertOne {
+	pec.conflict = append(pec.conflict, sql.ConflictColumns(columns...))
+	return &ProfileEducationUpsertOne{
+		create: pec,
+	}
+}
+
+type (
+	// ProfileEducationUpsertOne is the builder for "upsert"-ing
+	//  one ProfileEducation node.
+	ProfileEducationUpsertOne struct {
+		create *ProfileEducationCreate
+	}
+
+	// ProfileEducationUpsert is the "OnConflict" setter.
+	ProfileEducationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEducationIndex sets the "education_index" field.
+func (u *ProfileEducationUpsert) SetEducationIndex(v int) *ProfileEducationUpsert {
+	u.Set(profileeducation.FieldEducationIndex, v)
+	return u
+}
+
+// UpdateEducationIndex sets the "education_index" field to the value that was provided on create.
+func (u *ProfileEducationUpsert) UpdateEducationIndex() *ProfileEducationUpsert {
+	u.SetExcluded(profileeducation.FieldEducationIndex)
+	return u
+}
+
+// AddEducationIndex adds v to the "education_index" field.
+func (u *ProfileEducationUpsert) AddEducationIndex(v int) *ProfileEducationUpsert {
+	u.Add(profileeducation.FieldEducationIndex, v)
+	return u
+}
+
+// SetSchoolName sets the "school_name" field.
+func (u *ProfileEducationUpsert) SetSchoolName(v string) *ProfileEducationUpsert {
+	u.Set(profileeducation.FieldSchoolName, v)
+	return u
+}
+
+// UpdateSchoolName sets the "school_name" field to the value that was provided on create.
+func (u *ProfileEducationUpsert) UpdateSchoolName() *ProfileEducationUpsert {
+	u.SetExcluded(profileeducation.FieldSchoolName)
+	return u
+}
+
+// SetSchoolID sets the "school_id" field.
+func (u *ProfileEducationUpsert) SetSchoolID(v string) *ProfileEducationUpsert {
+	u.Set(profileeducation.FieldSchoolID, v)
+	return u
+}
+
+// UpdateSchoolID sets the "school_id" field to the value that was provided on create.
+func (u *ProfileEducationUpsert) UpdateSchoolID() *ProfileEducationUpsert {
+	u.SetExcluded(profileeducation.FieldSchoolID)
+	return u
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (u *ProfileEducationUpsert) ClearSchoolID() *ProfileEducationUpsert {
+	u.SetNull(profileeducation.FieldSchoolID)
+	return u
+}
+
+// SetDegree sets the "degree" field.
+func (u *ProfileEducationUpsert) SetDegree(v string) *ProfileEducationUpsert {
+	u.Set(profileeducation.FieldDegree, v)
+	return u
+}
+
+// UpdateDegree sets the "degree" field to the value that was provided on create.
+func (u *ProfileEducationUpsert) UpdateDegree() *ProfileEducationUpsert {
+	u.SetExcluded(profileeducation.FieldDegree)
+	return u
+}
+
+// ClearDegree clears the value of the "degree" field.
+func (u *ProfileEducationUpsert) ClearDegree() *ProfileEducationUpsert {
+	u.SetNull(profileeducation.FieldDegree)
+	return u
+}
+
+// SetFieldOfStudy sets the "field_of_study" field.
+func (u *ProfileEducationUpsert) SetFieldOfStudy(v string) *ProfileEducationUpsert {
+	u.Set(profileeducation.FieldFieldOfStudy, v)
+	return u
+}
+
+// UpdateFieldOfStudy sets the "field_of_study" field to the value that was provided on create.
+func (u *ProfileEducationUpsert) UpdateFieldOfStudy() *ProfileEducationUpsert {
+	u.SetExcluded(profileeducation.FieldFieldOfStudy)
+	return u
+}
+
+// ClearFieldOfStudy clears the value of the "field_of_study" field.
+func (u *ProfileEducationUpsert) ClearFieldOfStudy() *ProfileEducationUpsert {
+	u.SetNull(profileeducation.FieldFieldOfStudy)
+	return u
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *ProfileEducationUpsert) SetStartDate(v time.Time) *ProfileEducationUpsert {
+	u.Set(profileeducation.FieldStartDate, v)
+	return u
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *ProfileEducationUpsert) UpdateStartDate() *ProfileEducationUpsert {
+	u.SetExcluded(profileeducation.FieldStartDate)
+	return u
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (u *ProfileEducationUpsert) ClearStartDate() *ProfileEducationUpsert {
+	u.SetNull(profileeducation.FieldStartDate)
+	return u
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *ProfileEducationUpsert) SetEndDate(v time.Time) *ProfileEducationUpsert {
+	u.Set(profileeducation.FieldEndDate, v)
+	return u
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *ProfileEducationUpsert) UpdateEndDate() *ProfileEducationUpsert {
+	u.SetExcluded(profileeducation.FieldEndDate)
+	return u
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *ProfileEducationUpsert) ClearEndDate() *ProfileEducationUpsert {
+	u.SetNull(profileeducation.FieldEndDate)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileEducationUpsert) SetUpdatedAt(v time.Time) *ProfileEducationUpsert {
+	u.Set(profileeducation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileEducationUpsert) UpdateUpdatedAt() *ProfileEducationUpsert {
+	u.SetExcluded(profileeducation.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProfileEducation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profileeducation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileEducationUpsertOne) UpdateNewValues() *ProfileEducationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(profileeducation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(profileeducation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfileEducation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProfileEducationUpsertOne) Ignore() *ProfileEducationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileEducationUpsertOne) DoNothing() *ProfileEducationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileEducationCreate.OnConflict
+// documentation for more info.
+func (u *ProfileEducationUpsertOne) Update(set func(*ProfileEducationUpsert)) *ProfileEducationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileEducationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEducationIndex sets the "education_index" field.
+func (u *ProfileEducationUpsertOne) SetEducationIndex(v int) *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetEducationIndex(v)
+	})
+}
+
+// AddEducationIndex adds v to the "education_index" field.
+func (u *ProfileEducationUpsertOne) AddEducationIndex(v int) *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.AddEducationIndex(v)
+	})
+}
+
+// UpdateEducationIndex sets the "education_index" field to the value that was provided on create.
+func (u *ProfileEducationUpsertOne) UpdateEducationIndex() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateEducationIndex()
+	})
+}
+
+// SetSchoolName sets the "school_name" field.
+func (u *ProfileEducationUpsertOne) SetSchoolName(v string) *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetSchoolName(v)
+	})
+}
+
+// UpdateSchoolName sets the "school_name" field to the value that was provided on create.
+func (u *ProfileEducationUpsertOne) UpdateSchoolName() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateSchoolName()
+	})
+}
+
+// SetSchoolID sets the "school_id" field.
+func (u *ProfileEducationUpsertOne) SetSchoolID(v string) *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetSchoolID(v)
+	})
+}
+
+// UpdateSchoolID sets the "school_id" field to the value that was provided on create.
+func (u *ProfileEducationUpsertOne) UpdateSchoolID() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateSchoolID()
+	})
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (u *ProfileEducationUpsertOne) ClearSchoolID() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.ClearSchoolID()
+	})
+}
+
+// SetDegree sets the "degree" field.
+func (u *ProfileEducationUpsertOne) SetDegree(v string) *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetDegree(v)
+	})
+}
+
+// UpdateDegree sets the "degree" field to the value that was provided on create.
+func (u *ProfileEducationUpsertOne) UpdateDegree() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateDegree()
+	})
+}
+
+// ClearDegree clears the value of the "degree" field.
+func (u *ProfileEducationUpsertOne) ClearDegree() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.ClearDegree()
+	})
+}
+
+// SetFieldOfStudy sets the "field_of_study" field.
+func (u *ProfileEducationUpsertOne) SetFieldOfStudy(v string) *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetFieldOfStudy(v)
+	})
+}
+
+// UpdateFieldOfStudy sets the "field_of_study" field to the value that was provided on create.
+func (u *ProfileEducationUpsertOne) UpdateFieldOfStudy() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateFieldOfStudy()
+	})
+}
+
+// ClearFieldOfStudy clears the value of the "field_of_study" field.
+func (u *ProfileEducationUpsertOne) ClearFieldOfStudy() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.ClearFieldOfStudy()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *ProfileEducationUpsertOne) SetStartDate(v time.Time) *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *ProfileEducationUpsertOne) UpdateStartDate() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (u *ProfileEducationUpsertOne) ClearStartDate() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.ClearStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *ProfileEducationUpsertOne) SetEndDate(v time.Time) *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *ProfileEducationUpsertOne) UpdateEndDate() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *ProfileEducationUpsertOne) ClearEndDate() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.ClearEndDate()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileEducationUpsertOne) SetUpdatedAt(v time.Time) *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileEducationUpsertOne) UpdateUpdatedAt() *ProfileEducationUpsertOne {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileEducationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileEducationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileEducationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProfileEducationUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProfileEducationUpsertOne.ID is not supported by MySQL driver. Use ProfileEducationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProfileEducationUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProfileEducationCreateBulk is the builder for creating many ProfileEducation entities in bulk.
+type ProfileEducationCreateBulk struct {
+	config
+	err      error
+	builders []*ProfileEducationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProfileEducation entities in the database.
+func (pecb *ProfileEducationCreateBulk) Save(ctx context.Context) ([]*ProfileEducation, error) {
+	if pecb.err != nil {
+		return nil, pecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pecb.builders))
+	nodes := make([]*ProfileEducation, len(pecb.builders))
+	mutators := make([]Mutator, len(pecb.builders))
+	for i := range pecb.builders {
+		func(i int, root context.Context) {
+			builder := pecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProfileEducationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pecb *ProfileEducationCreateBulk) SaveX(ctx context.Context) []*ProfileEducation {
+	v, err := pecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pecb *ProfileEducationCreateBulk) Exec(ctx context.Context) error {
+	_, err := pecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pecb *ProfileEducationCreateBulk) ExecX(ctx context.Context) {
+	if err := pecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfileEducation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileEducationUpsert) {
+//			SetEducationIndex(v+v).
+//		}).
+//		Exec(ctx)
+func (pecb *ProfileEducationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProfileEducationUpsertBulk {
+	pecb.conflict = opts
+	return &ProfileEducationUpsertBulk{
+		create: pecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfileEducation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pecb *ProfileEducationCreateBulk) OnConflictColumns(columns ...string) *ProfileEducationUpsertBulk {
+	pecb.conflict = append(pecb.conflict, sql.ConflictColumns(columns...))
+	return &ProfileEducationUpsertBulk{
+		create: pecb,
+	}
+}
+
+// ProfileEducationUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProfileEducation nodes.
+type ProfileEducationUpsertBulk struct {
+	create *ProfileEducationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProfileEducation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profileeducation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileEducationUpsertBulk) UpdateNewValues() *ProfileEducationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(profileeducation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(profileeducation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfileEducation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProfileEducationUpsertBulk) Ignore() *ProfileEducationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileEducationUpsertBulk) DoNothing() *ProfileEducationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileEducationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProfileEducationUpsertBulk) Update(set func(*ProfileEducationUpsert)) *ProfileEducationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileEducationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEducationIndex sets the "education_index" field.
+func (u *ProfileEducationUpsertBulk) SetEducationIndex(v int) *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetEducationIndex(v)
+	})
+}
+
+// AddEducationIndex adds v to the "education_index" field.
+func (u *ProfileEducationUpsertBulk) AddEducationIndex(v int) *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.AddEducationIndex(v)
+	})
+}
+
+// UpdateEducationIndex sets the "education_index" field to the value that was provided on create.
+func (u *ProfileEducationUpsertBulk) UpdateEducationIndex() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateEducationIndex()
+	})
+}
+
+// SetSchoolName sets the "school_name" field.
+func (u *ProfileEducationUpsertBulk) SetSchoolName(v string) *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetSchoolName(v)
+	})
+}
+
+// UpdateSchoolName sets the "school_name" field to the value that was provided on create.
+func (u *ProfileEducationUpsertBulk) UpdateSchoolName() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateSchoolName()
+	})
+}
+
+// SetSchoolID sets the "school_id" field.
+func (u *ProfileEducationUpsertBulk) SetSchoolID(v string) *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetSchoolID(v)
+	})
+}
+
+// UpdateSchoolID sets the "school_id" field to the value that was provided on create.
+func (u *ProfileEducationUpsertBulk) UpdateSchoolID() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateSchoolID()
+	})
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (u *ProfileEducationUpsertBulk) ClearSchoolID() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.ClearSchoolID()
+	})
+}
+
+// SetDegree sets the "degree" field.
+func (u *ProfileEducationUpsertBulk) SetDegree(v string) *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetDegree(v)
+	})
+}
+
+// UpdateDegree sets the "degree" field to the value that was provided on create.
+func (u *ProfileEducationUpsertBulk) UpdateDegree() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateDegree()
+	})
+}
+
+// ClearDegree clears the value of the "degree" field.
+func (u *ProfileEducationUpsertBulk) ClearDegree() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.ClearDegree()
+	})
+}
+
+// SetFieldOfStudy sets the "field_of_study" field.
+func (u *ProfileEducationUpsertBulk) SetFieldOfStudy(v string) *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetFieldOfStudy(v)
+	})
+}
+
+// UpdateFieldOfStudy sets the "field_of_study" field to the value that was provided on create.
+func (u *ProfileEducationUpsertBulk) UpdateFieldOfStudy() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateFieldOfStudy()
+	})
+}
+
+// ClearFieldOfStudy clears the value of the "field_of_study" field.
+func (u *ProfileEducationUpsertBulk) ClearFieldOfStudy() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.ClearFieldOfStudy()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *ProfileEducationUpsertBulk) SetStartDate(v time.Time) *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *ProfileEducationUpsertBulk) UpdateStartDate() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (u *ProfileEducationUpsertBulk) ClearStartDate() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.ClearStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *ProfileEducationUpsertBulk) SetEndDate(v time.Time) *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *ProfileEducationUpsertBulk) UpdateEndDate() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *ProfileEducationUpsertBulk) ClearEndDate() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.ClearEndDate()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileEducationUpsertBulk) SetUpdatedAt(v time.Time) *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileEducationUpsertBulk) UpdateUpdatedAt() *ProfileEducationUpsertBulk {
+	return u.Update(func(s *ProfileEducationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileEducationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProfileEducationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileEducationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileEducationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
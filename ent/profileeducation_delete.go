@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profileeducation"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileEducationDelete is the builder for deleting a ProfileEducation entity.
+type ProfileEducationDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProfileEducationMutation
+}
+
+// Where appends a list predicates to the ProfileEducationDelete builder.
+func (ped *ProfileEducationDelete) Where(ps ...predicate.ProfileEducation) *ProfileEducationDelete {
+	ped.mutation.Where(ps...)
+	return ped
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ped *ProfileEducationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ped.sqlExec, ped.mutation, ped.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ped *ProfileEducationDelete) ExecX(ctx context.Context) int {
+	n, err := ped.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ped *ProfileEducationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(profileeducation.Table, sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString))
+	if ps := ped.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ped.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ped.mutation.done = true
+	return affected, err
+}
+
+// ProfileEducationDeleteOne is the builder for deleting a single ProfileEducation entity.
+type ProfileEducationDeleteOne struct {
+	ped *ProfileEducationDelete
+}
+
+// Where appends a list predicates to the ProfileEducationDelete builder.
+func (pedo *ProfileEducationDeleteOne) Where(ps ...predicate.ProfileEducation) *ProfileEducationDeleteOne {
+	pedo.ped.mutation.Where(ps...)
+	return pedo
+}
+
+// Exec executes the deletion query.
+func (pedo *ProfileEducationDeleteOne) Exec(ctx context.Context) error {
+	n, err := pedo.ped.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{profileeducation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pedo *ProfileEducationDeleteOne) ExecX(ctx context.Context) {
+	if err := pedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
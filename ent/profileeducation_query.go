@@ -0,0 +1,658 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileeducation"
+	"sheng-go-backend/ent/schema/ulid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileEducationQuery is the builder for querying ProfileEducation entities.
+type ProfileEducationQuery struct {
+	config
+	ctx         *QueryContext
+	order       []profileeducation.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ProfileEducation
+	withProfile *ProfileQuery
+	withFKs     bool
+	loadTotal   []func(context.Context, []*ProfileEducation) error
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProfileEducationQuery builder.
+func (peq *ProfileEducationQuery) Where(ps ...predicate.ProfileEducation) *ProfileEducationQuery {
+	peq.predicates = append(peq.predicates, ps...)
+	return peq
+}
+
+// Limit the number of records to be returned by this query.
+func (peq *ProfileEducationQuery) Limit(limit int) *ProfileEducationQuery {
+	peq.ctx.Limit = &limit
+	return peq
+}
+
+// Offset to start from.
+func (peq *ProfileEducationQuery) Offset(offset int) *ProfileEducationQuery {
+	peq.ctx.Offset = &offset
+	return peq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (peq *ProfileEducationQuery) Unique(unique bool) *ProfileEducationQuery {
+	peq.ctx.Unique = &unique
+	return peq
+}
+
+// Order specifies how the records should be ordered.
+func (peq *ProfileEducationQuery) Order(o ...profileeducation.OrderOption) *ProfileEducationQuery {
+	peq.order = append(peq.order, o...)
+	return peq
+}
+
+// QueryProfile chains the current query on the "profile" edge.
+func (peq *ProfileEducationQuery) QueryProfile() *ProfileQuery {
+	query := (&ProfileClient{config: peq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := peq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := peq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profileeducation.Table, profileeducation.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profileeducation.ProfileTable, profileeducation.ProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(peq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProfileEducation entity from the query.
+// Returns a *NotFoundError when no ProfileEducation was found.
+func (peq *ProfileEducationQuery) First(ctx context.Context) (*ProfileEducation, error) {
+	nodes, err := peq.Limit(1).All(setContextOp(ctx, peq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{profileeducation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (peq *ProfileEducationQuery) FirstX(ctx context.Context) *ProfileEducation {
+	node, err := peq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProfileEducation ID from the query.
+// Returns a *NotFoundError when no ProfileEducation ID was found.
+func (peq *ProfileEducationQuery) FirstID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = peq.Limit(1).IDs(setContextOp(ctx, peq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{profileeducation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (peq *ProfileEducationQuery) FirstIDX(ctx context.Context) ulid.ID {
+	id, err := peq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProfileEducation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProfileEducation entity is found.
+// Returns a *NotFoundError when no ProfileEducation entities are found.
+func (peq *ProfileEducationQuery) Only(ctx context.Context) (*ProfileEducation, error) {
+	nodes, err := peq.Limit(2).All(setContextOp(ctx, peq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{profileeducation.Label}
+	default:
+		return nil, &NotSingularError{profileeducation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (peq *ProfileEducationQuery) OnlyX(ctx context.Context) *ProfileEducation {
+	node, err := peq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProfileEducation ID in the query.
+// Returns a *NotSingularError when more than one ProfileEducation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (peq *ProfileEducationQuery) OnlyID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = peq.Limit(2).IDs(setContextOp(ctx, peq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{profileeducation.Label}
+	default:
+		err = &NotSingularError{profileeducation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (peq *ProfileEducationQuery) OnlyIDX(ctx context.Context) ulid.ID {
+	id, err := peq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProfileEducations.
+func (peq *ProfileEducationQuery) All(ctx context.Context) ([]*ProfileEducation, error) {
+	ctx = setContextOp(ctx, peq.ctx, ent.OpQueryAll)
+	if err := peq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProfileEducation, *ProfileEducationQuery]()
+	return withInterceptors[[]*ProfileEducation](ctx, peq, qr, peq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (peq *ProfileEducationQuery) AllX(ctx context.Context) []*ProfileEducation {
+	nodes, err := peq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProfileEducation IDs.
+func (peq *ProfileEducationQuery) IDs(ctx context.Context) (ids []ulid.ID, err error) {
+	if peq.ctx.Unique == nil && peq.path != nil {
+		peq.Unique(true)
+	}
+	ctx = setContextOp(ctx, peq.ctx, ent.OpQueryIDs)
+	if err = peq.Select(profileeducation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (peq *ProfileEducationQuery) IDsX(ctx context.Context) []ulid.ID {
+	ids, err := peq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (peq *ProfileEducationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, peq.ctx, ent.OpQueryCount)
+	if err := peq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, peq, querierCount[*ProfileEducationQuery](), peq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (peq *ProfileEducationQuery) CountX(ctx context.Context) int {
+	count, err := peq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (peq *ProfileEducationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, peq.ctx, ent.OpQueryExist)
+	switch _, err := peq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (peq *ProfileEducationQuery) ExistX(ctx context.Context) bool {
+	exist, err := peq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProfileEducationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (peq *ProfileEducationQuery) Clone() *ProfileEducationQuery {
+	if peq == nil {
+		return nil
+	}
+	return &ProfileEducationQuery{
+		config:      peq.config,
+		ctx:         peq.ctx.Clone(),
+		order:       append([]profileeducation.OrderOption{}, peq.order...),
+		inters:      append([]Interceptor{}, peq.inters...),
+		predicates:  append([]predicate.ProfileEducation{}, peq.predicates...),
+		withProfile: peq.withProfile.Clone(),
+		// clone intermediate query.
+		sql:  peq.sql.Clone(),
+		path: peq.path,
+	}
+}
+
+// WithProfile tells the query-builder to eager-load the nodes that are connected to
+// the "profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (peq *ProfileEducationQuery) WithProfile(opts ...func(*ProfileQuery)) *ProfileEducationQuery {
+	query := (&ProfileClient{config: peq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	peq.withProfile = query
+	return peq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EducationIndex int `json:"education_index,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProfileEducation.Query().
+//		GroupBy(profileeducation.FieldEducationIndex).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (peq *ProfileEducationQuery) GroupBy(field string, fields ...string) *ProfileEducationGroupBy {
+	peq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProfileEducationGroupBy{build: peq}
+	grbuild.flds = &peq.ctx.Fields
+	grbuild.label = profileeducation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EducationIndex int `json:"education_index,omitempty"`
+//	}
+//
+//	client.ProfileEducation.Query().
+//		Select(profileeducation.FieldEducationIndex).
+//		Scan(ctx, &v)
+func (peq *ProfileEducationQuery) Select(fields ...string) *ProfileEducationSelect {
+	peq.ctx.Fields = append(peq.ctx.Fields, fields...)
+	sbuild := &ProfileEducationSelect{ProfileEducationQuery: peq}
+	sbuild.label = profileeducation.Label
+	sbuild.flds, sbuild.scan = &peq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProfileEducationSelect configured with the given aggregations.
+func (peq *ProfileEducationQuery) Aggregate(fns ...AggregateFunc) *ProfileEducationSelect {
+	return peq.Select().Aggregate(fns...)
+}
+
+func (peq *ProfileEducationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range peq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, peq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range peq.ctx.Fields {
+		if !profileeducation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if peq.path != nil {
+		prev, err := peq.path(ctx)
+		if err != nil {
+			return err
+		}
+		peq.sql = prev
+	}
+	return nil
+}
+
+func (peq *ProfileEducationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProfileEducation, error) {
+	var (
+		nodes       = []*ProfileEducation{}
+		withFKs     = peq.withFKs
+		_spec       = peq.querySpec()
+		loadedTypes = [1]bool{
+			peq.withProfile != nil,
+		}
+	)
+	if peq.withProfile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, profileeducation.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProfileEducation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProfileEducation{config: peq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(peq.modifiers) > 0 {
+		_spec.Modifiers = peq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, peq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := peq.withProfile; query != nil {
+		if err := peq.loadProfile(ctx, query, nodes, nil,
+			func(n *ProfileEducation, e *Profile) { n.Edges.Profile = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range peq.loadTotal {
+		if err := peq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (peq *ProfileEducationQuery) loadProfile(ctx context.Context, query *ProfileQuery, nodes []*ProfileEducation, init func(*ProfileEducation), assign func(*ProfileEducation, *Profile)) error {
+	ids := make([]ulid.ID, 0, len(nodes))
+	nodeids := make(map[ulid.ID][]*ProfileEducation)
+	for i := range nodes {
+		if nodes[i].profile_profile_educations == nil {
+			continue
+		}
+		fk := *nodes[i].profile_profile_educations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(profile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "profile_profile_educations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (peq *ProfileEducationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := peq.querySpec()
+	if len(peq.modifiers) > 0 {
+		_spec.Modifiers = peq.modifiers
+	}
+	_spec.Node.Columns = peq.ctx.Fields
+	if len(peq.ctx.Fields) > 0 {
+		_spec.Unique = peq.ctx.Unique != nil && *peq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, peq.driver, _spec)
+}
+
+func (peq *ProfileEducationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(profileeducation.Table, profileeducation.Columns, sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString))
+	_spec.From = peq.sql
+	if unique := peq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if peq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := peq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profileeducation.FieldID)
+		for i := range fields {
+			if fields[i] != profileeducation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := peq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := peq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := peq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := peq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (peq *ProfileEducationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(peq.driver.Dialect())
+	t1 := builder.Table(profileeducation.Table)
+	columns := peq.ctx.Fields
+	if len(columns) == 0 {
+		columns = profileeducation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if peq.sql != nil {
+		selector = peq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if peq.ctx.Unique != nil && *peq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range peq.modifiers {
+		m(selector)
+	}
+	for _, p := range peq.predicates {
+		p(selector)
+	}
+	for _, p := range peq.order {
+		p(selector)
+	}
+	if offset := peq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := peq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (peq *ProfileEducationQuery) ForUpdate(opts ...sql.LockOption) *ProfileEducationQuery {
+	if peq.driver.Dialect() == dialect.Postgres {
+		peq.Unique(false)
+	}
+	peq.modifiers = append(peq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return peq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (peq *ProfileEducationQuery) ForShare(opts ...sql.LockOption) *ProfileEducationQuery {
+	if peq.driver.Dialect() == dialect.Postgres {
+		peq.Unique(false)
+	}
+	peq.modifiers = append(peq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return peq
+}
+
+// ProfileEducationGroupBy is the group-by builder for ProfileEducation entities.
+type ProfileEducationGroupBy struct {
+	selector
+	build *ProfileEducationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pegb *ProfileEducationGroupBy) Aggregate(fns ...AggregateFunc) *ProfileEducationGroupBy {
+	pegb.fns = append(pegb.fns, fns...)
+	return pegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pegb *ProfileEducationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pegb.build.ctx, ent.OpQueryGroupBy)
+	if err := pegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfileEducationQuery, *ProfileEducationGroupBy](ctx, pegb.build, pegb, pegb.build.inters, v)
+}
+
+func (pegb *ProfileEducationGroupBy) sqlScan(ctx context.Context, root *ProfileEducationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pegb.fns))
+	for _, fn := range pegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pegb.flds)+len(pegb.fns))
+		for _, f := range *pegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProfileEducationSelect is the builder for selecting fields of ProfileEducation entities.
+type ProfileEducationSelect struct {
+	*ProfileEducationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pes *ProfileEducationSelect) Aggregate(fns ...AggregateFunc) *ProfileEducationSelect {
+	pes.fns = append(pes.fns, fns...)
+	return pes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pes *ProfileEducationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pes.ctx, ent.OpQuerySelect)
+	if err := pes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfileEducationQuery, *ProfileEducationSelect](ctx, pes.ProfileEducationQuery, pes, pes.inters, v)
+}
+
+func (pes *ProfileEducationSelect) sqlScan(ctx context.Context, root *ProfileEducationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pes.fns))
+	for _, fn := range pes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,686 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileeducation"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileEducationUpdate is the builder for updating ProfileEducation entities.
+type ProfileEducationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProfileEducationMutation
+}
+
+// Where appends a list predicates to the ProfileEducationUpdate builder.
+func (peu *ProfileEducationUpdate) Where(ps ...predicate.ProfileEducation) *ProfileEducationUpdate {
+	peu.mutation.Where(ps...)
+	return peu
+}
+
+// SetEducationIndex sets the "education_index" field.
+func (peu *ProfileEducationUpdate) SetEducationIndex(i int) *ProfileEducationUpdate {
+	peu.mutation.ResetEducationIndex()
+	peu.mutation.SetEducationIndex(i)
+	return peu
+}
+
+// SetNillableEducationIndex sets the "education_index" field if the given value is not nil.
+func (peu *ProfileEducationUpdate) SetNillableEducationIndex(i *int) *ProfileEducationUpdate {
+	if i != nil {
+		peu.SetEducationIndex(*i)
+	}
+	return peu
+}
+
+// AddEducationIndex adds i to the "education_index" field.
+func (peu *ProfileEducationUpdate) AddEducationIndex(i int) *ProfileEducationUpdate {
+	peu.mutation.AddEducationIndex(i)
+	return peu
+}
+
+// SetSchoolName sets the "school_name" field.
+func (peu *ProfileEducationUpdate) SetSchoolName(s string) *ProfileEducationUpdate {
+	peu.mutation.SetSchoolName(s)
+	return peu
+}
+
+// SetNillableSchoolName sets the "school_name" field if the given value is not nil.
+func (peu *ProfileEducationUpdate) SetNillableSchoolName(s *string) *ProfileEducationUpdate {
+	if s != nil {
+		peu.SetSchoolName(*s)
+	}
+	return peu
+}
+
+// SetSchoolID sets the "school_id" field.
+func (peu *ProfileEducationUpdate) SetSchoolID(s string) *ProfileEducationUpdate {
+	peu.mutation.SetSchoolID(s)
+	return peu
+}
+
+// SetNillableSchoolID sets the "school_id" field if the given value is not nil.
+func (peu *ProfileEducationUpdate) SetNillableSchoolID(s *string) *ProfileEducationUpdate {
+	if s != nil {
+		peu.SetSchoolID(*s)
+	}
+	return peu
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (peu *ProfileEducationUpdate) ClearSchoolID() *ProfileEducationUpdate {
+	peu.mutation.ClearSchoolID()
+	return peu
+}
+
+// SetDegree sets the "degree" field.
+func (peu *ProfileEducationUpdate) SetDegree(s string) *ProfileEducationUpdate {
+	peu.mutation.SetDegree(s)
+	return peu
+}
+
+// SetNillableDegree sets the "degree" field if the given value is not nil.
+func (peu *ProfileEducationUpdate) SetNillableDegree(s *string) *ProfileEducationUpdate {
+	if s != nil {
+		peu.SetDegree(*s)
+	}
+	return peu
+}
+
+// ClearDegree clears the value of the "degree" field.
+func (peu *ProfileEducationUpdate) ClearDegree() *ProfileEducationUpdate {
+	peu.mutation.ClearDegree()
+	return peu
+}
+
+// SetFieldOfStudy sets the "field_of_study" field.
+func (peu *ProfileEducationUpdate) SetFieldOfStudy(s string) *ProfileEducationUpdate {
+	peu.mutation.SetFieldOfStudy(s)
+	return peu
+}
+
+// SetNillableFieldOfStudy sets the "field_of_study" field if the given value is not nil.
+func (peu *ProfileEducationUpdate) SetNillableFieldOfStudy(s *string) *ProfileEducationUpdate {
+	if s != nil {
+		peu.SetFieldOfStudy(*s)
+	}
+	return peu
+}
+
+// ClearFieldOfStudy clears the value of the "field_of_study" field.
+func (peu *ProfileEducationUpdate) ClearFieldOfStudy() *ProfileEducationUpdate {
+	peu.mutation.ClearFieldOfStudy()
+	return peu
+}
+
+// SetStartDate sets the "start_date" field.
+func (peu *ProfileEducationUpdate) SetStartDate(t time.Time) *ProfileEducationUpdate {
+	peu.mutation.SetStartDate(t)
+	return peu
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (peu *ProfileEducationUpdate) SetNillableStartDate(t *time.Time) *ProfileEducationUpdate {
+	if t != nil {
+		peu.SetStartDate(*t)
+	}
+	return peu
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (peu *ProfileEducationUpdate) ClearStartDate() *ProfileEducationUpdate {
+	peu.mutation.ClearStartDate()
+	return peu
+}
+
+// SetEndDate sets the "end_date" field.
+func (peu *ProfileEducationUpdate) SetEndDate(t time.Time) *ProfileEducationUpdate {
+	peu.mutation.SetEndDate(t)
+	return peu
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (peu *ProfileEducationUpdate) SetNillableEndDate(t *time.Time) *ProfileEducationUpdate {
+	if t != nil {
+		peu.SetEndDate(*t)
+	}
+	return peu
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (peu *ProfileEducationUpdate) ClearEndDate() *ProfileEducationUpdate {
+	peu.mutation.ClearEndDate()
+	return peu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (peu *ProfileEducationUpdate) SetUpdatedAt(t time.Time) *ProfileEducationUpdate {
+	peu.mutation.SetUpdatedAt(t)
+	return peu
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (peu *ProfileEducationUpdate) SetProfileID(id ulid.ID) *ProfileEducationUpdate {
+	peu.mutation.SetProfileID(id)
+	return peu
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (peu *ProfileEducationUpdate) SetProfile(p *Profile) *ProfileEducationUpdate {
+	return peu.SetProfileID(p.ID)
+}
+
+// Mutation returns the ProfileEducationMutation object of the builder.
+func (peu *ProfileEducationUpdate) Mutation() *ProfileEducationMutation {
+	return peu.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (peu *ProfileEducationUpdate) ClearProfile() *ProfileEducationUpdate {
+	peu.mutation.ClearProfile()
+	return peu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (peu *ProfileEducationUpdate) Save(ctx context.Context) (int, error) {
+	peu.defaults()
+	return withHooks(ctx, peu.sqlSave, peu.mutation, peu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (peu *ProfileEducationUpdate) SaveX(ctx context.Context) int {
+	affected, err := peu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (peu *ProfileEducationUpdate) Exec(ctx context.Context) error {
+	_, err := peu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (peu *ProfileEducationUpdate) ExecX(ctx context.Context) {
+	if err := peu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (peu *ProfileEducationUpdate) defaults() {
+	if _, ok := peu.mutation.UpdatedAt(); !ok {
+		v := profileeducation.UpdateDefaultUpdatedAt()
+		peu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (peu *ProfileEducationUpdate) check() error {
+	if v, ok := peu.mutation.EducationIndex(); ok {
+		if err := profileeducation.EducationIndexValidator(v); err != nil {
+			return &ValidationError{Name: "education_index", err: fmt.Errorf(`ent: validator failed for field "ProfileEducation.education_index": %w`, err)}
+		}
+	}
+	if peu.mutation.ProfileCleared() && len(peu.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProfileEducation.profile"`)
+	}
+	return nil
+}
+
+func (peu *ProfileEducationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := peu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profileeducation.Table, profileeducation.Columns, sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString))
+	if ps := peu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := peu.mutation.EducationIndex(); ok {
+		_spec.SetField(profileeducation.FieldEducationIndex, field.TypeInt, value)
+	}
+	if value, ok := peu.mutation.AddedEducationIndex(); ok {
+		_spec.AddField(profileeducation.FieldEducationIndex, field.TypeInt, value)
+	}
+	if value, ok := peu.mutation.SchoolName(); ok {
+		_spec.SetField(profileeducation.FieldSchoolName, field.TypeString, value)
+	}
+	if value, ok := peu.mutation.SchoolID(); ok {
+		_spec.SetField(profileeducation.FieldSchoolID, field.TypeString, value)
+	}
+	if peu.mutation.SchoolIDCleared() {
+		_spec.ClearField(profileeducation.FieldSchoolID, field.TypeString)
+	}
+	if value, ok := peu.mutation.Degree(); ok {
+		_spec.SetField(profileeducation.FieldDegree, field.TypeString, value)
+	}
+	if peu.mutation.DegreeCleared() {
+		_spec.ClearField(profileeducation.FieldDegree, field.TypeString)
+	}
+	if value, ok := peu.mutation.FieldOfStudy(); ok {
+		_spec.SetField(profileeducation.FieldFieldOfStudy, field.TypeString, value)
+	}
+	if peu.mutation.FieldOfStudyCleared() {
+		_spec.ClearField(profileeducation.FieldFieldOfStudy, field.TypeString)
+	}
+	if value, ok := peu.mutation.StartDate(); ok {
+		_spec.SetField(profileeducation.FieldStartDate, field.TypeTime, value)
+	}
+	if peu.mutation.StartDateCleared() {
+		_spec.ClearField(profileeducation.FieldStartDate, field.TypeTime)
+	}
+	if value, ok := peu.mutation.EndDate(); ok {
+		_spec.SetField(profileeducation.FieldEndDate, field.TypeTime, value)
+	}
+	if peu.mutation.EndDateCleared() {
+		_spec.ClearField(profileeducation.FieldEndDate, field.TypeTime)
+	}
+	if value, ok := peu.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileeducation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if peu.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileeducation.ProfileTable,
+			Columns: []string{profileeducation.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := peu.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileeducation.ProfileTable,
+			Columns: []string{profileeducation.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, peu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profileeducation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	peu.mutation.done = true
+	return n, nil
+}
+
+// ProfileEducationUpdateOne is the builder for updating a single ProfileEducation entity.
+type ProfileEducationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProfileEducationMutation
+}
+
+// SetEducationIndex sets the "education_index" field.
+func (peuo *ProfileEducationUpdateOne) SetEducationIndex(i int) *ProfileEducationUpdateOne {
+	peuo.mutation.ResetEducationIndex()
+	peuo.mutation.SetEducationIndex(i)
+	return peuo
+}
+
+// SetNillableEducationIndex sets the "education_index" field if the given value is not nil.
+func (peuo *ProfileEducationUpdateOne) SetNillableEducationIndex(i *int) *ProfileEducationUpdateOne {
+	if i != nil {
+		peuo.SetEducationIndex(*i)
+	}
+	return peuo
+}
+
+// AddEducationIndex adds i to the "education_index" field.
+func (peuo *ProfileEducationUpdateOne) AddEducationIndex(i int) *ProfileEducationUpdateOne {
+	peuo.mutation.AddEducationIndex(i)
+	return peuo
+}
+
+// SetSchoolName sets the "school_name" field.
+func (peuo *ProfileEducationUpdateOne) SetSchoolName(s string) *ProfileEducationUpdateOne {
+	peuo.mutation.SetSchoolName(s)
+	return peuo
+}
+
+// SetNillableSchoolName sets the "school_name" field if the given value is not nil.
+func (peuo *ProfileEducationUpdateOne) SetNillableSchoolName(s *string) *ProfileEducationUpdateOne {
+	if s != nil {
+		peuo.SetSchoolName(*s)
+	}
+	return peuo
+}
+
+// SetSchoolID sets the "school_id" field.
+func (peuo *ProfileEducationUpdateOne) SetSchoolID(s string) *ProfileEducationUpdateOne {
+	peuo.mutation.SetSchoolID(s)
+	return peuo
+}
+
+// SetNillableSchoolID sets the "school_id" field if the given value is not nil.
+func (peuo *ProfileEducationUpdateOne) SetNillableSchoolID(s *string) *ProfileEducationUpdateOne {
+	if s != nil {
+		peuo.SetSchoolID(*s)
+	}
+	return peuo
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (peuo *ProfileEducationUpdateOne) ClearSchoolID() *ProfileEducationUpdateOne {
+	peuo.mutation.ClearSchoolID()
+	return peuo
+}
+
+// SetDegree sets the "degree" field.
+func (peuo *ProfileEducationUpdateOne) SetDegree(s string) *ProfileEducationUpdateOne {
+	peuo.mutation.SetDegree(s)
+	return peuo
+}
+
+// SetNillableDegree sets the "degree" field if the given value is not nil.
+func (peuo *ProfileEducationUpdateOne) SetNillableDegree(s *string) *ProfileEducationUpdateOne {
+	if s != nil {
+		peuo.SetDegree(*s)
+	}
+	return peuo
+}
+
+// ClearDegree clears the value of the "degree" field.
+func (peuo *ProfileEducationUpdateOne) ClearDegree() *ProfileEducationUpdateOne {
+	peuo.mutation.ClearDegree()
+	return peuo
+}
+
+// SetFieldOfStudy sets the "field_of_study" field.
+func (peuo *ProfileEducationUpdateOne) SetFieldOfStudy(s string) *ProfileEducationUpdateOne {
+	peuo.mutation.SetFieldOfStudy(s)
+	return peuo
+}
+
+// SetNillableFieldOfStudy sets the "field_of_study" field if the given value is not nil.
+func (peuo *ProfileEducationUpdateOne) SetNillableFieldOfStudy(s *string) *ProfileEducationUpdateOne {
+	if s != nil {
+		peuo.SetFieldOfStudy(*s)
+	}
+	return peuo
+}
+
+// ClearFieldOfStudy clears the value of the "field_of_study" field.
+func (peuo *ProfileEducationUpdateOne) ClearFieldOfStudy() *ProfileEducationUpdateOne {
+	peuo.mutation.ClearFieldOfStudy()
+	return peuo
+}
+
+// SetStartDate sets the "start_date" field.
+func (peuo *ProfileEducationUpdateOne) SetStartDate(t time.Time) *ProfileEducationUpdateOne {
+	peuo.mutation.SetStartDate(t)
+	return peuo
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (peuo *ProfileEducationUpdateOne) SetNillableStartDate(t *time.Time) *ProfileEducationUpdateOne {
+	if t != nil {
+		peuo.SetStartDate(*t)
+	}
+	return peuo
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (peuo *ProfileEducationUpdateOne) ClearStartDate() *ProfileEducationUpdateOne {
+	peuo.mutation.ClearStartDate()
+	return peuo
+}
+
+// SetEndDate sets the "end_date" field.
+func (peuo *ProfileEducationUpdateOne) SetEndDate(t time.Time) *ProfileEducationUpdateOne {
+	peuo.mutation.SetEndDate(t)
+	return peuo
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (peuo *ProfileEducationUpdateOne) SetNillableEndDate(t *time.Time) *ProfileEducationUpdateOne {
+	if t != nil {
+		peuo.SetEndDate(*t)
+	}
+	return peuo
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (peuo *ProfileEducationUpdateOne) ClearEndDate() *ProfileEducationUpdateOne {
+	peuo.mutation.ClearEndDate()
+	return peuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (peuo *ProfileEducationUpdateOne) SetUpdatedAt(t time.Time) *ProfileEducationUpdateOne {
+	peuo.mutation.SetUpdatedAt(t)
+	return peuo
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (peuo *ProfileEducationUpdateOne) SetProfileID(id ulid.ID) *ProfileEducationUpdateOne {
+	peuo.mutation.SetProfileID(id)
+	return peuo
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (peuo *ProfileEducationUpdateOne) SetProfile(p *Profile) *ProfileEducationUpdateOne {
+	return peuo.SetProfileID(p.ID)
+}
+
+// Mutation returns the ProfileEducationMutation object of the builder.
+func (peuo *ProfileEducationUpdateOne) Mutation() *ProfileEducationMutation {
+	return peuo.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (peuo *ProfileEducationUpdateOne) ClearProfile() *ProfileEducationUpdateOne {
+	peuo.mutation.ClearProfile()
+	return peuo
+}
+
+// Where appends a list predicates to the ProfileEducationUpdate builder.
+func (peuo *ProfileEducationUpdateOne) Where(ps ...predicate.ProfileEducation) *ProfileEducationUpdateOne {
+	peuo.mutation.Where(ps...)
+	return peuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (peuo *ProfileEducationUpdateOne) Select(field string, fields ...string) *ProfileEducationUpdateOne {
+	peuo.fields = append([]string{field}, fields...)
+	return peuo
+}
+
+// Save executes the query and returns the updated ProfileEducation entity.
+func (peuo *ProfileEducationUpdateOne) Save(ctx context.Context) (*ProfileEducation, error) {
+	peuo.defaults()
+	return withHooks(ctx, peuo.sqlSave, peuo.mutation, peuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (peuo *ProfileEducationUpdateOne) SaveX(ctx context.Context) *ProfileEducation {
+	node, err := peuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (peuo *ProfileEducationUpdateOne) Exec(ctx context.Context) error {
+	_, err := peuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (peuo *ProfileEducationUpdateOne) ExecX(ctx context.Context) {
+	if err := peuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (peuo *ProfileEducationUpdateOne) defaults() {
+	if _, ok := peuo.mutation.UpdatedAt(); !ok {
+		v := profileeducation.UpdateDefaultUpdatedAt()
+		peuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (peuo *ProfileEducationUpdateOne) check() error {
+	if v, ok := peuo.mutation.EducationIndex(); ok {
+		if err := profileeducation.EducationIndexValidator(v); err != nil {
+			return &ValidationError{Name: "education_index", err: fmt.Errorf(`ent: validator failed for field "ProfileEducation.education_index": %w`, err)}
+		}
+	}
+	if peuo.mutation.ProfileCleared() && len(peuo.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProfileEducation.profile"`)
+	}
+	return nil
+}
+
+func (peuo *ProfileEducationUpdateOne) sqlSave(ctx context.Context) (_node *ProfileEducation, err error) {
+	if err := peuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profileeducation.Table, profileeducation.Columns, sqlgraph.NewFieldSpec(profileeducation.FieldID, field.TypeString))
+	id, ok := peuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProfileEducation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := peuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profileeducation.FieldID)
+		for _, f := range fields {
+			if !profileeducation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != profileeducation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := peuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := peuo.mutation.EducationIndex(); ok {
+		_spec.SetField(profileeducation.FieldEducationIndex, field.TypeInt, value)
+	}
+	if value, ok := peuo.mutation.AddedEducationIndex(); ok {
+		_spec.AddField(profileeducation.FieldEducationIndex, field.TypeInt, value)
+	}
+	if value, ok := peuo.mutation.SchoolName(); ok {
+		_spec.SetField(profileeducation.FieldSchoolName, field.TypeString, value)
+	}
+	if value, ok := peuo.mutation.SchoolID(); ok {
+		_spec.SetField(profileeducation.FieldSchoolID, field.TypeString, value)
+	}
+	if peuo.mutation.SchoolIDCleared() {
+		_spec.ClearField(profileeducation.FieldSchoolID, field.TypeString)
+	}
+	if value, ok := peuo.mutation.Degree(); ok {
+		_spec.SetField(profileeducation.FieldDegree, field.TypeString, value)
+	}
+	if peuo.mutation.DegreeCleared() {
+		_spec.ClearField(profileeducation.FieldDegree, field.TypeString)
+	}
+	if value, ok := peuo.mutation.FieldOfStudy(); ok {
+		_spec.SetField(profileeducation.FieldFieldOfStudy, field.TypeString, value)
+	}
+	if peuo.mutation.FieldOfStudyCleared() {
+		_spec.ClearField(profileeducation.FieldFieldOfStudy, field.TypeString)
+	}
+	if value, ok := peuo.mutation.StartDate(); ok {
+		_spec.SetField(profileeducation.FieldStartDate, field.TypeTime, value)
+	}
+	if peuo.mutation.StartDateCleared() {
+		_spec.ClearField(profileeducation.FieldStartDate, field.TypeTime)
+	}
+	if value, ok := peuo.mutation.EndDate(); ok {
+		_spec.SetField(profileeducation.FieldEndDate, field.TypeTime, value)
+	}
+	if peuo.mutation.EndDateCleared() {
+		_spec.ClearField(profileeducation.FieldEndDate, field.TypeTime)
+	}
+	if value, ok := peuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileeducation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if peuo.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileeducation.ProfileTable,
+			Columns: []string{profileeducation.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := peuo.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileeducation.ProfileTable,
+			Columns: []string{profileeducation.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProfileEducation{config: peuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, peuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profileeducation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	peuo.mutation.done = true
+	return _node, nil
+}
@@ -42,6 +42,14 @@ type ProfileEntry struct {
 	LastFetchedAt *time.Time `json:"last_fetched_at,omitempty"`
 	// Error message if fetch failed
 	ErrorMessage *string `json:"error_message,omitempty"`
+	// Number of consecutive failed fetch attempts
+	AttemptCount int `json:"attempt_count,omitempty"`
+	// Earliest time a FAILED entry is retried; unset when no retry is scheduled
+	NextRetryAt *time.Time `json:"next_retry_at,omitempty"`
+	// Worker that currently holds the fetch lease
+	LeaseOwner *string `json:"lease_owner,omitempty"`
+	// Time at which the current fetch lease expires
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProfileEntryQuery when eager-loading is set.
 	Edges        ProfileEntryEdges `json:"edges"`
@@ -90,11 +98,11 @@ func (*ProfileEntry) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case profileentry.FieldProfileData:
 			values[i] = new([]byte)
-		case profileentry.FieldFetchCount:
+		case profileentry.FieldFetchCount, profileentry.FieldAttemptCount:
 			values[i] = new(sql.NullInt64)
-		case profileentry.FieldLinkedinUrn, profileentry.FieldGender, profileentry.FieldStatus, profileentry.FieldTemplateJSONS3Key, profileentry.FieldRawResponseS3Key, profileentry.FieldErrorMessage:
+		case profileentry.FieldLinkedinUrn, profileentry.FieldGender, profileentry.FieldStatus, profileentry.FieldTemplateJSONS3Key, profileentry.FieldRawResponseS3Key, profileentry.FieldErrorMessage, profileentry.FieldLeaseOwner:
 			values[i] = new(sql.NullString)
-		case profileentry.FieldCreatedAt, profileentry.FieldUpdatedAt, profileentry.FieldLastFetchedAt:
+		case profileentry.FieldCreatedAt, profileentry.FieldUpdatedAt, profileentry.FieldLastFetchedAt, profileentry.FieldNextRetryAt, profileentry.FieldLeaseExpiresAt:
 			values[i] = new(sql.NullTime)
 		case profileentry.FieldID:
 			values[i] = new(ulid.ID)
@@ -192,6 +200,33 @@ func (pe *ProfileEntry) assignValues(columns []string, values []any) error {
 				pe.ErrorMessage = new(string)
 				*pe.ErrorMessage = value.String
 			}
+		case profileentry.FieldAttemptCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_count", values[i])
+			} else if value.Valid {
+				pe.AttemptCount = int(value.Int64)
+			}
+		case profileentry.FieldNextRetryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_retry_at", values[i])
+			} else if value.Valid {
+				pe.NextRetryAt = new(time.Time)
+				*pe.NextRetryAt = value.Time
+			}
+		case profileentry.FieldLeaseOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_owner", values[i])
+			} else if value.Valid {
+				pe.LeaseOwner = new(string)
+				*pe.LeaseOwner = value.String
+			}
+		case profileentry.FieldLeaseExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_expires_at", values[i])
+			} else if value.Valid {
+				pe.LeaseExpiresAt = new(time.Time)
+				*pe.LeaseExpiresAt = value.Time
+			}
 		default:
 			pe.selectValues.Set(columns[i], values[i])
 		}
@@ -280,6 +315,24 @@ func (pe *ProfileEntry) String() string {
 		builder.WriteString("error_message=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	builder.WriteString("attempt_count=")
+	builder.WriteString(fmt.Sprintf("%v", pe.AttemptCount))
+	builder.WriteString(", ")
+	if v := pe.NextRetryAt; v != nil {
+		builder.WriteString("next_retry_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pe.LeaseOwner; v != nil {
+		builder.WriteString("lease_owner=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pe.LeaseExpiresAt; v != nil {
+		builder.WriteString("lease_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
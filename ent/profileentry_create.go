@@ -12,6 +12,8 @@ import (
 	"sheng-go-backend/ent/schema/ulid"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -21,6 +23,7 @@ type ProfileEntryCreate struct {
 	config
 	mutation *ProfileEntryMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -161,6 +164,62 @@ func (pec *ProfileEntryCreate) SetNillableErrorMessage(s *string) *ProfileEntryC
 	return pec
 }
 
+// SetAttemptCount sets the "attempt_count" field.
+func (pec *ProfileEntryCreate) SetAttemptCount(i int) *ProfileEntryCreate {
+	pec.mutation.SetAttemptCount(i)
+	return pec
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (pec *ProfileEntryCreate) SetNillableAttemptCount(i *int) *ProfileEntryCreate {
+	if i != nil {
+		pec.SetAttemptCount(*i)
+	}
+	return pec
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (pec *ProfileEntryCreate) SetNextRetryAt(t time.Time) *ProfileEntryCreate {
+	pec.mutation.SetNextRetryAt(t)
+	return pec
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (pec *ProfileEntryCreate) SetNillableNextRetryAt(t *time.Time) *ProfileEntryCreate {
+	if t != nil {
+		pec.SetNextRetryAt(*t)
+	}
+	return pec
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (pec *ProfileEntryCreate) SetLeaseOwner(s string) *ProfileEntryCreate {
+	pec.mutation.SetLeaseOwner(s)
+	return pec
+}
+
+// SetNillableLeaseOwner sets the "lease_owner" field if the given value is not nil.
+func (pec *ProfileEntryCreate) SetNillableLeaseOwner(s *string) *ProfileEntryCreate {
+	if s != nil {
+		pec.SetLeaseOwner(*s)
+	}
+	return pec
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (pec *ProfileEntryCreate) SetLeaseExpiresAt(t time.Time) *ProfileEntryCreate {
+	pec.mutation.SetLeaseExpiresAt(t)
+	return pec
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (pec *ProfileEntryCreate) SetNillableLeaseExpiresAt(t *time.Time) *ProfileEntryCreate {
+	if t != nil {
+		pec.SetLeaseExpiresAt(*t)
+	}
+	return pec
+}
+
 // SetID sets the "id" field.
 func (pec *ProfileEntryCreate) SetID(u ulid.ID) *ProfileEntryCreate {
 	pec.mutation.SetID(u)
@@ -260,6 +319,10 @@ func (pec *ProfileEntryCreate) defaults() {
 		v := profileentry.DefaultFetchCount
 		pec.mutation.SetFetchCount(v)
 	}
+	if _, ok := pec.mutation.AttemptCount(); !ok {
+		v := profileentry.DefaultAttemptCount
+		pec.mutation.SetAttemptCount(v)
+	}
 	if _, ok := pec.mutation.ID(); !ok {
 		v := profileentry.DefaultID()
 		pec.mutation.SetID(v)
@@ -308,6 +371,19 @@ func (pec *ProfileEntryCreate) check() error {
 			return &ValidationError{Name: "fetch_count", err: fmt.Errorf(`ent: validator failed for field "ProfileEntry.fetch_count": %w`, err)}
 		}
 	}
+	if _, ok := pec.mutation.AttemptCount(); !ok {
+		return &ValidationError{Name: "attempt_count", err: errors.New(`ent: missing required field "ProfileEntry.attempt_count"`)}
+	}
+	if v, ok := pec.mutation.AttemptCount(); ok {
+		if err := profileentry.AttemptCountValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_count", err: fmt.Errorf(`ent: validator failed for field "ProfileEntry.attempt_count": %w`, err)}
+		}
+	}
+	if v, ok := pec.mutation.LeaseOwner(); ok {
+		if err := profileentry.LeaseOwnerValidator(v); err != nil {
+			return &ValidationError{Name: "lease_owner", err: fmt.Errorf(`ent: validator failed for field "ProfileEntry.lease_owner": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -339,6 +415,7 @@ func (pec *ProfileEntryCreate) createSpec() (*ProfileEntry, *sqlgraph.CreateSpec
 		_node = &ProfileEntry{config: pec.config}
 		_spec = sqlgraph.NewCreateSpec(profileentry.Table, sqlgraph.NewFieldSpec(profileentry.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = pec.conflict
 	if id, ok := pec.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -387,6 +464,22 @@ func (pec *ProfileEntryCreate) createSpec() (*ProfileEntry, *sqlgraph.CreateSpec
 		_spec.SetField(profileentry.FieldErrorMessage, field.TypeString, value)
 		_node.ErrorMessage = &value
 	}
+	if value, ok := pec.mutation.AttemptCount(); ok {
+		_spec.SetField(profileentry.FieldAttemptCount, field.TypeInt, value)
+		_node.AttemptCount = value
+	}
+	if value, ok := pec.mutation.NextRetryAt(); ok {
+		_spec.SetField(profileentry.FieldNextRetryAt, field.TypeTime, value)
+		_node.NextRetryAt = &value
+	}
+	if value, ok := pec.mutation.LeaseOwner(); ok {
+		_spec.SetField(profileentry.FieldLeaseOwner, field.TypeString, value)
+		_node.LeaseOwner = &value
+	}
+	if value, ok := pec.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(profileentry.FieldLeaseExpiresAt, field.TypeTime, value)
+		_node.LeaseExpiresAt = &value
+	}
 	if nodes := pec.mutation.ProfileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -422,11 +515,657 @@ func (pec *ProfileEntryCreate) createSpec() (*ProfileEntry, *sqlgraph.CreateSpec
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfileEntry.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileEntryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pec *ProfileEntryCreate) OnConflict(opts ...sql.ConflictOption) *ProfileEntryUpsertOne {
+	pec.conflict = opts
+	return &ProfileEntryUpsertOne{
+		create: pec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfileEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pec *ProfileEntryCreate) OnConflictColumns(columns ...string) *ProfileEntryUpsertOne {
+	pec.conflict = append(pec.conflict, sql.ConflictColumns(columns...))
+	return &ProfileEntryUpsertOne{
+		create: pec,
+	}
+}
+
+type (
+	// ProfileEntryUpsertOne is the builder for "upsert"-ing
+	//  one ProfileEntry node.
+	ProfileEntryUpsertOne struct {
+		create *ProfileEntryCreate
+	}
+
+	// ProfileEntryUpsert is the "OnConflict" setter.
+	ProfileEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileEntryUpsert) SetUpdatedAt(v time.Time) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateUpdatedAt() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldUpdatedAt)
+	return u
+}
+
+// SetLinkedinUrn sets the "linkedin_urn" field.
+func (u *ProfileEntryUpsert) SetLinkedinUrn(v string) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldLinkedinUrn, v)
+	return u
+}
+
+// UpdateLinkedinUrn sets the "linkedin_urn" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateLinkedinUrn() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldLinkedinUrn)
+	return u
+}
+
+// SetGender sets the "gender" field.
+func (u *ProfileEntryUpsert) SetGender(v string) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldGender, v)
+	return u
+}
+
+// UpdateGender sets the "gender" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateGender() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldGender)
+	return u
+}
+
+// ClearGender clears the value of the "gender" field.
+func (u *ProfileEntryUpsert) ClearGender() *ProfileEntryUpsert {
+	u.SetNull(profileentry.FieldGender)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ProfileEntryUpsert) SetStatus(v profileentry.Status) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateStatus() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldStatus)
+	return u
+}
+
+// SetProfileData sets the "profile_data" field.
+func (u *ProfileEntryUpsert) SetProfileData(v map[string]interface{}) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldProfileData, v)
+	return u
+}
+
+// UpdateProfileData sets the "profile_data" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateProfileData() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldProfileData)
+	return u
+}
+
+// ClearProfileData clears the value of the "profile_data" field.
+func (u *ProfileEntryUpsert) ClearProfileData() *ProfileEntryUpsert {
+	u.SetNull(profileentry.FieldProfileData)
+	return u
+}
+
+// SetTemplateJSONS3Key sets the "template_json_s3_key" field.
+func (u *ProfileEntryUpsert) SetTemplateJSONS3Key(v string) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldTemplateJSONS3Key, v)
+	return u
+}
+
+// UpdateTemplateJSONS3Key sets the "template_json_s3_key" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateTemplateJSONS3Key() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldTemplateJSONS3Key)
+	return u
+}
+
+// ClearTemplateJSONS3Key clears the value of the "template_json_s3_key" field.
+func (u *ProfileEntryUpsert) ClearTemplateJSONS3Key() *ProfileEntryUpsert {
+	u.SetNull(profileentry.FieldTemplateJSONS3Key)
+	return u
+}
+
+// SetRawResponseS3Key sets the "raw_response_s3_key" field.
+func (u *ProfileEntryUpsert) SetRawResponseS3Key(v string) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldRawResponseS3Key, v)
+	return u
+}
+
+// UpdateRawResponseS3Key sets the "raw_response_s3_key" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateRawResponseS3Key() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldRawResponseS3Key)
+	return u
+}
+
+// ClearRawResponseS3Key clears the value of the "raw_response_s3_key" field.
+func (u *ProfileEntryUpsert) ClearRawResponseS3Key() *ProfileEntryUpsert {
+	u.SetNull(profileentry.FieldRawResponseS3Key)
+	return u
+}
+
+// SetFetchCount sets the "fetch_count" field.
+func (u *ProfileEntryUpsert) SetFetchCount(v int) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldFetchCount, v)
+	return u
+}
+
+// UpdateFetchCount sets the "fetch_count" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateFetchCount() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldFetchCount)
+	return u
+}
+
+// AddFetchCount adds v to the "fetch_count" field.
+func (u *ProfileEntryUpsert) AddFetchCount(v int) *ProfileEntryUpsert {
+	u.Add(profileentry.FieldFetchCount, v)
+	return u
+}
+
+// SetLastFetchedAt sets the "last_fetched_at" field.
+func (u *ProfileEntryUpsert) SetLastFetchedAt(v time.Time) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldLastFetchedAt, v)
+	return u
+}
+
+// UpdateLastFetchedAt sets the "last_fetched_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateLastFetchedAt() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldLastFetchedAt)
+	return u
+}
+
+// ClearLastFetchedAt clears the value of the "last_fetched_at" field.
+func (u *ProfileEntryUpsert) ClearLastFetchedAt() *ProfileEntryUpsert {
+	u.SetNull(profileentry.FieldLastFetchedAt)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *ProfileEntryUpsert) SetErrorMessage(v string) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateErrorMessage() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *ProfileEntryUpsert) ClearErrorMessage() *ProfileEntryUpsert {
+	u.SetNull(profileentry.FieldErrorMessage)
+	return u
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (u *ProfileEntryUpsert) SetAttemptCount(v int) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldAttemptCount, v)
+	return u
+}
+
+// UpdateAttemptCount sets the "attempt_count" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateAttemptCount() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldAttemptCount)
+	return u
+}
+
+// AddAttemptCount adds v to the "attempt_count" field.
+func (u *ProfileEntryUpsert) AddAttemptCount(v int) *ProfileEntryUpsert {
+	u.Add(profileentry.FieldAttemptCount, v)
+	return u
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *ProfileEntryUpsert) SetNextRetryAt(v time.Time) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldNextRetryAt, v)
+	return u
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateNextRetryAt() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldNextRetryAt)
+	return u
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (u *ProfileEntryUpsert) ClearNextRetryAt() *ProfileEntryUpsert {
+	u.SetNull(profileentry.FieldNextRetryAt)
+	return u
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (u *ProfileEntryUpsert) SetLeaseOwner(v string) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldLeaseOwner, v)
+	return u
+}
+
+// UpdateLeaseOwner sets the "lease_owner" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateLeaseOwner() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldLeaseOwner)
+	return u
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (u *ProfileEntryUpsert) ClearLeaseOwner() *ProfileEntryUpsert {
+	u.SetNull(profileentry.FieldLeaseOwner)
+	return u
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *ProfileEntryUpsert) SetLeaseExpiresAt(v time.Time) *ProfileEntryUpsert {
+	u.Set(profileentry.FieldLeaseExpiresAt, v)
+	return u
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsert) UpdateLeaseExpiresAt() *ProfileEntryUpsert {
+	u.SetExcluded(profileentry.FieldLeaseExpiresAt)
+	return u
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *ProfileEntryUpsert) ClearLeaseExpiresAt() *ProfileEntryUpsert {
+	u.SetNull(profileentry.FieldLeaseExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProfileEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profileentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileEntryUpsertOne) UpdateNewValues() *ProfileEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(profileentry.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(profileentry.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfileEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProfileEntryUpsertOne) Ignore() *ProfileEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileEntryUpsertOne) DoNothing() *ProfileEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileEntryCreate.OnConflict
+// documentation for more info.
+func (u *ProfileEntryUpsertOne) Update(set func(*ProfileEntryUpsert)) *ProfileEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileEntryUpsertOne) SetUpdatedAt(v time.Time) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateUpdatedAt() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLinkedinUrn sets the "linkedin_urn" field.
+func (u *ProfileEntryUpsertOne) SetLinkedinUrn(v string) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetLinkedinUrn(v)
+	})
+}
+
+// UpdateLinkedinUrn sets the "linkedin_urn" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateLinkedinUrn() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateLinkedinUrn()
+	})
+}
+
+// SetGender sets the "gender" field.
+func (u *ProfileEntryUpsertOne) SetGender(v string) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetGender(v)
+	})
+}
+
+// UpdateGender sets the "gender" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateGender() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateGender()
+	})
+}
+
+// ClearGender clears the value of the "gender" field.
+func (u *ProfileEntryUpsertOne) ClearGender() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearGender()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ProfileEntryUpsertOne) SetStatus(v profileentry.Status) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateStatus() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetProfileData sets the "profile_data" field.
+func (u *ProfileEntryUpsertOne) SetProfileData(v map[string]interface{}) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetProfileData(v)
+	})
+}
+
+// UpdateProfileData sets the "profile_data" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateProfileData() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateProfileData()
+	})
+}
+
+// ClearProfileData clears the value of the "profile_data" field.
+func (u *ProfileEntryUpsertOne) ClearProfileData() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearProfileData()
+	})
+}
+
+// SetTemplateJSONS3Key sets the "template_json_s3_key" field.
+func (u *ProfileEntryUpsertOne) SetTemplateJSONS3Key(v string) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetTemplateJSONS3Key(v)
+	})
+}
+
+// UpdateTemplateJSONS3Key sets the "template_json_s3_key" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateTemplateJSONS3Key() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateTemplateJSONS3Key()
+	})
+}
+
+// ClearTemplateJSONS3Key clears the value of the "template_json_s3_key" field.
+func (u *ProfileEntryUpsertOne) ClearTemplateJSONS3Key() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearTemplateJSONS3Key()
+	})
+}
+
+// SetRawResponseS3Key sets the "raw_response_s3_key" field.
+func (u *ProfileEntryUpsertOne) SetRawResponseS3Key(v string) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetRawResponseS3Key(v)
+	})
+}
+
+// UpdateRawResponseS3Key sets the "raw_response_s3_key" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateRawResponseS3Key() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateRawResponseS3Key()
+	})
+}
+
+// ClearRawResponseS3Key clears the value of the "raw_response_s3_key" field.
+func (u *ProfileEntryUpsertOne) ClearRawResponseS3Key() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearRawResponseS3Key()
+	})
+}
+
+// SetFetchCount sets the "fetch_count" field.
+func (u *ProfileEntryUpsertOne) SetFetchCount(v int) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetFetchCount(v)
+	})
+}
+
+// AddFetchCount adds v to the "fetch_count" field.
+func (u *ProfileEntryUpsertOne) AddFetchCount(v int) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.AddFetchCount(v)
+	})
+}
+
+// UpdateFetchCount sets the "fetch_count" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateFetchCount() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateFetchCount()
+	})
+}
+
+// SetLastFetchedAt sets the "last_fetched_at" field.
+func (u *ProfileEntryUpsertOne) SetLastFetchedAt(v time.Time) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetLastFetchedAt(v)
+	})
+}
+
+// UpdateLastFetchedAt sets the "last_fetched_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateLastFetchedAt() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateLastFetchedAt()
+	})
+}
+
+// ClearLastFetchedAt clears the value of the "last_fetched_at" field.
+func (u *ProfileEntryUpsertOne) ClearLastFetchedAt() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearLastFetchedAt()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *ProfileEntryUpsertOne) SetErrorMessage(v string) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateErrorMessage() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *ProfileEntryUpsertOne) ClearErrorMessage() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (u *ProfileEntryUpsertOne) SetAttemptCount(v int) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetAttemptCount(v)
+	})
+}
+
+// AddAttemptCount adds v to the "attempt_count" field.
+func (u *ProfileEntryUpsertOne) AddAttemptCount(v int) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.AddAttemptCount(v)
+	})
+}
+
+// UpdateAttemptCount sets the "attempt_count" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateAttemptCount() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateAttemptCount()
+	})
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *ProfileEntryUpsertOne) SetNextRetryAt(v time.Time) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetNextRetryAt(v)
+	})
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateNextRetryAt() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateNextRetryAt()
+	})
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (u *ProfileEntryUpsertOne) ClearNextRetryAt() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearNextRetryAt()
+	})
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (u *ProfileEntryUpsertOne) SetLeaseOwner(v string) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetLeaseOwner(v)
+	})
+}
+
+// UpdateLeaseOwner sets the "lease_owner" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateLeaseOwner() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateLeaseOwner()
+	})
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (u *ProfileEntryUpsertOne) ClearLeaseOwner() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearLeaseOwner()
+	})
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *ProfileEntryUpsertOne) SetLeaseExpiresAt(v time.Time) *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetLeaseExpiresAt(v)
+	})
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsertOne) UpdateLeaseExpiresAt() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateLeaseExpiresAt()
+	})
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *ProfileEntryUpsertOne) ClearLeaseExpiresAt() *ProfileEntryUpsertOne {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearLeaseExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProfileEntryUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProfileEntryUpsertOne.ID is not supported by MySQL driver. Use ProfileEntryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProfileEntryUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ProfileEntryCreateBulk is the builder for creating many ProfileEntry entities in bulk.
 type ProfileEntryCreateBulk struct {
 	config
 	err      error
 	builders []*ProfileEntryCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the ProfileEntry entities in the database.
@@ -456,6 +1195,7 @@ func (pecb *ProfileEntryCreateBulk) Save(ctx context.Context) ([]*ProfileEntry,
 					_, err = mutators[i+1].Mutate(root, pecb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pecb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pecb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -505,3 +1245,393 @@ func (pecb *ProfileEntryCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfileEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileEntryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pecb *ProfileEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProfileEntryUpsertBulk {
+	pecb.conflict = opts
+	return &ProfileEntryUpsertBulk{
+		create: pecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfileEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pecb *ProfileEntryCreateBulk) OnConflictColumns(columns ...string) *ProfileEntryUpsertBulk {
+	pecb.conflict = append(pecb.conflict, sql.ConflictColumns(columns...))
+	return &ProfileEntryUpsertBulk{
+		create: pecb,
+	}
+}
+
+// ProfileEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProfileEntry nodes.
+type ProfileEntryUpsertBulk struct {
+	create *ProfileEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProfileEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profileentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileEntryUpsertBulk) UpdateNewValues() *ProfileEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(profileentry.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(profileentry.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfileEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProfileEntryUpsertBulk) Ignore() *ProfileEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileEntryUpsertBulk) DoNothing() *ProfileEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProfileEntryUpsertBulk) Update(set func(*ProfileEntryUpsert)) *ProfileEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileEntryUpsertBulk) SetUpdatedAt(v time.Time) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateUpdatedAt() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLinkedinUrn sets the "linkedin_urn" field.
+func (u *ProfileEntryUpsertBulk) SetLinkedinUrn(v string) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetLinkedinUrn(v)
+	})
+}
+
+// UpdateLinkedinUrn sets the "linkedin_urn" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateLinkedinUrn() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateLinkedinUrn()
+	})
+}
+
+// SetGender sets the "gender" field.
+func (u *ProfileEntryUpsertBulk) SetGender(v string) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetGender(v)
+	})
+}
+
+// UpdateGender sets the "gender" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateGender() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateGender()
+	})
+}
+
+// ClearGender clears the value of the "gender" field.
+func (u *ProfileEntryUpsertBulk) ClearGender() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearGender()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ProfileEntryUpsertBulk) SetStatus(v profileentry.Status) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateStatus() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetProfileData sets the "profile_data" field.
+func (u *ProfileEntryUpsertBulk) SetProfileData(v map[string]interface{}) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetProfileData(v)
+	})
+}
+
+// UpdateProfileData sets the "profile_data" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateProfileData() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateProfileData()
+	})
+}
+
+// ClearProfileData clears the value of the "profile_data" field.
+func (u *ProfileEntryUpsertBulk) ClearProfileData() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearProfileData()
+	})
+}
+
+// SetTemplateJSONS3Key sets the "template_json_s3_key" field.
+func (u *ProfileEntryUpsertBulk) SetTemplateJSONS3Key(v string) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetTemplateJSONS3Key(v)
+	})
+}
+
+// UpdateTemplateJSONS3Key sets the "template_json_s3_key" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateTemplateJSONS3Key() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateTemplateJSONS3Key()
+	})
+}
+
+// ClearTemplateJSONS3Key clears the value of the "template_json_s3_key" field.
+func (u *ProfileEntryUpsertBulk) ClearTemplateJSONS3Key() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearTemplateJSONS3Key()
+	})
+}
+
+// SetRawResponseS3Key sets the "raw_response_s3_key" field.
+func (u *ProfileEntryUpsertBulk) SetRawResponseS3Key(v string) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetRawResponseS3Key(v)
+	})
+}
+
+// UpdateRawResponseS3Key sets the "raw_response_s3_key" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateRawResponseS3Key() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateRawResponseS3Key()
+	})
+}
+
+// ClearRawResponseS3Key clears the value of the "raw_response_s3_key" field.
+func (u *ProfileEntryUpsertBulk) ClearRawResponseS3Key() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearRawResponseS3Key()
+	})
+}
+
+// SetFetchCount sets the "fetch_count" field.
+func (u *ProfileEntryUpsertBulk) SetFetchCount(v int) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetFetchCount(v)
+	})
+}
+
+// AddFetchCount adds v to the "fetch_count" field.
+func (u *ProfileEntryUpsertBulk) AddFetchCount(v int) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.AddFetchCount(v)
+	})
+}
+
+// UpdateFetchCount sets the "fetch_count" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateFetchCount() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateFetchCount()
+	})
+}
+
+// SetLastFetchedAt sets the "last_fetched_at" field.
+func (u *ProfileEntryUpsertBulk) SetLastFetchedAt(v time.Time) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetLastFetchedAt(v)
+	})
+}
+
+// UpdateLastFetchedAt sets the "last_fetched_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateLastFetchedAt() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateLastFetchedAt()
+	})
+}
+
+// ClearLastFetchedAt clears the value of the "last_fetched_at" field.
+func (u *ProfileEntryUpsertBulk) ClearLastFetchedAt() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearLastFetchedAt()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *ProfileEntryUpsertBulk) SetErrorMessage(v string) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateErrorMessage() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *ProfileEntryUpsertBulk) ClearErrorMessage() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (u *ProfileEntryUpsertBulk) SetAttemptCount(v int) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetAttemptCount(v)
+	})
+}
+
+// AddAttemptCount adds v to the "attempt_count" field.
+func (u *ProfileEntryUpsertBulk) AddAttemptCount(v int) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.AddAttemptCount(v)
+	})
+}
+
+// UpdateAttemptCount sets the "attempt_count" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateAttemptCount() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateAttemptCount()
+	})
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *ProfileEntryUpsertBulk) SetNextRetryAt(v time.Time) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetNextRetryAt(v)
+	})
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateNextRetryAt() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateNextRetryAt()
+	})
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (u *ProfileEntryUpsertBulk) ClearNextRetryAt() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearNextRetryAt()
+	})
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (u *ProfileEntryUpsertBulk) SetLeaseOwner(v string) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetLeaseOwner(v)
+	})
+}
+
+// UpdateLeaseOwner sets the "lease_owner" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateLeaseOwner() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateLeaseOwner()
+	})
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (u *ProfileEntryUpsertBulk) ClearLeaseOwner() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearLeaseOwner()
+	})
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *ProfileEntryUpsertBulk) SetLeaseExpiresAt(v time.Time) *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.SetLeaseExpiresAt(v)
+	})
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *ProfileEntryUpsertBulk) UpdateLeaseExpiresAt() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.UpdateLeaseExpiresAt()
+	})
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *ProfileEntryUpsertBulk) ClearLeaseExpiresAt() *ProfileEntryUpsertBulk {
+	return u.Update(func(s *ProfileEntryUpsert) {
+		s.ClearLeaseExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProfileEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
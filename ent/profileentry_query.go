@@ -14,6 +14,7 @@ import (
 	"sheng-go-backend/ent/schema/ulid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -28,8 +29,8 @@ type ProfileEntryQuery struct {
 	predicates             []predicate.ProfileEntry
 	withProfile            *ProfileQuery
 	withJobExecutions      *JobExecutionHistoryQuery
-	modifiers              []func(*sql.Selector)
 	loadTotal              []func(context.Context, []*ProfileEntry) error
+	modifiers              []func(*sql.Selector)
 	withNamedJobExecutions map[string]*JobExecutionHistoryQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -624,6 +625,9 @@ func (peq *ProfileEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if peq.ctx.Unique != nil && *peq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range peq.modifiers {
+		m(selector)
+	}
 	for _, p := range peq.predicates {
 		p(selector)
 	}
@@ -641,6 +645,32 @@ func (peq *ProfileEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (peq *ProfileEntryQuery) ForUpdate(opts ...sql.LockOption) *ProfileEntryQuery {
+	if peq.driver.Dialect() == dialect.Postgres {
+		peq.Unique(false)
+	}
+	peq.modifiers = append(peq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return peq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (peq *ProfileEntryQuery) ForShare(opts ...sql.LockOption) *ProfileEntryQuery {
+	if peq.driver.Dialect() == dialect.Postgres {
+		peq.Unique(false)
+	}
+	peq.modifiers = append(peq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return peq
+}
+
 // WithNamedJobExecutions tells the query-builder to eager-load the nodes that are connected to the "job_executions"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (peq *ProfileEntryQuery) WithNamedJobExecutions(name string, opts ...func(*JobExecutionHistoryQuery)) *ProfileEntryQuery {
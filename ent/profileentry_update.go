@@ -198,6 +198,87 @@ func (peu *ProfileEntryUpdate) ClearErrorMessage() *ProfileEntryUpdate {
 	return peu
 }
 
+// SetAttemptCount sets the "attempt_count" field.
+func (peu *ProfileEntryUpdate) SetAttemptCount(i int) *ProfileEntryUpdate {
+	peu.mutation.ResetAttemptCount()
+	peu.mutation.SetAttemptCount(i)
+	return peu
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (peu *ProfileEntryUpdate) SetNillableAttemptCount(i *int) *ProfileEntryUpdate {
+	if i != nil {
+		peu.SetAttemptCount(*i)
+	}
+	return peu
+}
+
+// AddAttemptCount adds i to the "attempt_count" field.
+func (peu *ProfileEntryUpdate) AddAttemptCount(i int) *ProfileEntryUpdate {
+	peu.mutation.AddAttemptCount(i)
+	return peu
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (peu *ProfileEntryUpdate) SetNextRetryAt(t time.Time) *ProfileEntryUpdate {
+	peu.mutation.SetNextRetryAt(t)
+	return peu
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (peu *ProfileEntryUpdate) SetNillableNextRetryAt(t *time.Time) *ProfileEntryUpdate {
+	if t != nil {
+		peu.SetNextRetryAt(*t)
+	}
+	return peu
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (peu *ProfileEntryUpdate) ClearNextRetryAt() *ProfileEntryUpdate {
+	peu.mutation.ClearNextRetryAt()
+	return peu
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (peu *ProfileEntryUpdate) SetLeaseOwner(s string) *ProfileEntryUpdate {
+	peu.mutation.SetLeaseOwner(s)
+	return peu
+}
+
+// SetNillableLeaseOwner sets the "lease_owner" field if the given value is not nil.
+func (peu *ProfileEntryUpdate) SetNillableLeaseOwner(s *string) *ProfileEntryUpdate {
+	if s != nil {
+		peu.SetLeaseOwner(*s)
+	}
+	return peu
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (peu *ProfileEntryUpdate) ClearLeaseOwner() *ProfileEntryUpdate {
+	peu.mutation.ClearLeaseOwner()
+	return peu
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (peu *ProfileEntryUpdate) SetLeaseExpiresAt(t time.Time) *ProfileEntryUpdate {
+	peu.mutation.SetLeaseExpiresAt(t)
+	return peu
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (peu *ProfileEntryUpdate) SetNillableLeaseExpiresAt(t *time.Time) *ProfileEntryUpdate {
+	if t != nil {
+		peu.SetLeaseExpiresAt(*t)
+	}
+	return peu
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (peu *ProfileEntryUpdate) ClearLeaseExpiresAt() *ProfileEntryUpdate {
+	peu.mutation.ClearLeaseExpiresAt()
+	return peu
+}
+
 // SetProfileID sets the "profile" edge to the Profile entity by ID.
 func (peu *ProfileEntryUpdate) SetProfileID(id ulid.ID) *ProfileEntryUpdate {
 	peu.mutation.SetProfileID(id)
@@ -327,6 +408,16 @@ func (peu *ProfileEntryUpdate) check() error {
 			return &ValidationError{Name: "fetch_count", err: fmt.Errorf(`ent: validator failed for field "ProfileEntry.fetch_count": %w`, err)}
 		}
 	}
+	if v, ok := peu.mutation.AttemptCount(); ok {
+		if err := profileentry.AttemptCountValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_count", err: fmt.Errorf(`ent: validator failed for field "ProfileEntry.attempt_count": %w`, err)}
+		}
+	}
+	if v, ok := peu.mutation.LeaseOwner(); ok {
+		if err := profileentry.LeaseOwnerValidator(v); err != nil {
+			return &ValidationError{Name: "lease_owner", err: fmt.Errorf(`ent: validator failed for field "ProfileEntry.lease_owner": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -393,6 +484,30 @@ func (peu *ProfileEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if peu.mutation.ErrorMessageCleared() {
 		_spec.ClearField(profileentry.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := peu.mutation.AttemptCount(); ok {
+		_spec.SetField(profileentry.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := peu.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(profileentry.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := peu.mutation.NextRetryAt(); ok {
+		_spec.SetField(profileentry.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if peu.mutation.NextRetryAtCleared() {
+		_spec.ClearField(profileentry.FieldNextRetryAt, field.TypeTime)
+	}
+	if value, ok := peu.mutation.LeaseOwner(); ok {
+		_spec.SetField(profileentry.FieldLeaseOwner, field.TypeString, value)
+	}
+	if peu.mutation.LeaseOwnerCleared() {
+		_spec.ClearField(profileentry.FieldLeaseOwner, field.TypeString)
+	}
+	if value, ok := peu.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(profileentry.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if peu.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(profileentry.FieldLeaseExpiresAt, field.TypeTime)
+	}
 	if peu.mutation.ProfileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -654,6 +769,87 @@ func (peuo *ProfileEntryUpdateOne) ClearErrorMessage() *ProfileEntryUpdateOne {
 	return peuo
 }
 
+// SetAttemptCount sets the "attempt_count" field.
+func (peuo *ProfileEntryUpdateOne) SetAttemptCount(i int) *ProfileEntryUpdateOne {
+	peuo.mutation.ResetAttemptCount()
+	peuo.mutation.SetAttemptCount(i)
+	return peuo
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (peuo *ProfileEntryUpdateOne) SetNillableAttemptCount(i *int) *ProfileEntryUpdateOne {
+	if i != nil {
+		peuo.SetAttemptCount(*i)
+	}
+	return peuo
+}
+
+// AddAttemptCount adds i to the "attempt_count" field.
+func (peuo *ProfileEntryUpdateOne) AddAttemptCount(i int) *ProfileEntryUpdateOne {
+	peuo.mutation.AddAttemptCount(i)
+	return peuo
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (peuo *ProfileEntryUpdateOne) SetNextRetryAt(t time.Time) *ProfileEntryUpdateOne {
+	peuo.mutation.SetNextRetryAt(t)
+	return peuo
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (peuo *ProfileEntryUpdateOne) SetNillableNextRetryAt(t *time.Time) *ProfileEntryUpdateOne {
+	if t != nil {
+		peuo.SetNextRetryAt(*t)
+	}
+	return peuo
+}
+
+// ClearNextRetryAt clears the value of the "next_retry_at" field.
+func (peuo *ProfileEntryUpdateOne) ClearNextRetryAt() *ProfileEntryUpdateOne {
+	peuo.mutation.ClearNextRetryAt()
+	return peuo
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (peuo *ProfileEntryUpdateOne) SetLeaseOwner(s string) *ProfileEntryUpdateOne {
+	peuo.mutation.SetLeaseOwner(s)
+	return peuo
+}
+
+// SetNillableLeaseOwner sets the "lease_owner" field if the given value is not nil.
+func (peuo *ProfileEntryUpdateOne) SetNillableLeaseOwner(s *string) *ProfileEntryUpdateOne {
+	if s != nil {
+		peuo.SetLeaseOwner(*s)
+	}
+	return peuo
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (peuo *ProfileEntryUpdateOne) ClearLeaseOwner() *ProfileEntryUpdateOne {
+	peuo.mutation.ClearLeaseOwner()
+	return peuo
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (peuo *ProfileEntryUpdateOne) SetLeaseExpiresAt(t time.Time) *ProfileEntryUpdateOne {
+	peuo.mutation.SetLeaseExpiresAt(t)
+	return peuo
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (peuo *ProfileEntryUpdateOne) SetNillableLeaseExpiresAt(t *time.Time) *ProfileEntryUpdateOne {
+	if t != nil {
+		peuo.SetLeaseExpiresAt(*t)
+	}
+	return peuo
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (peuo *ProfileEntryUpdateOne) ClearLeaseExpiresAt() *ProfileEntryUpdateOne {
+	peuo.mutation.ClearLeaseExpiresAt()
+	return peuo
+}
+
 // SetProfileID sets the "profile" edge to the Profile entity by ID.
 func (peuo *ProfileEntryUpdateOne) SetProfileID(id ulid.ID) *ProfileEntryUpdateOne {
 	peuo.mutation.SetProfileID(id)
@@ -796,6 +992,16 @@ func (peuo *ProfileEntryUpdateOne) check() error {
 			return &ValidationError{Name: "fetch_count", err: fmt.Errorf(`ent: validator failed for field "ProfileEntry.fetch_count": %w`, err)}
 		}
 	}
+	if v, ok := peuo.mutation.AttemptCount(); ok {
+		if err := profileentry.AttemptCountValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_count", err: fmt.Errorf(`ent: validator failed for field "ProfileEntry.attempt_count": %w`, err)}
+		}
+	}
+	if v, ok := peuo.mutation.LeaseOwner(); ok {
+		if err := profileentry.LeaseOwnerValidator(v); err != nil {
+			return &ValidationError{Name: "lease_owner", err: fmt.Errorf(`ent: validator failed for field "ProfileEntry.lease_owner": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -879,6 +1085,30 @@ func (peuo *ProfileEntryUpdateOne) sqlSave(ctx context.Context) (_node *ProfileE
 	if peuo.mutation.ErrorMessageCleared() {
 		_spec.ClearField(profileentry.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := peuo.mutation.AttemptCount(); ok {
+		_spec.SetField(profileentry.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := peuo.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(profileentry.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := peuo.mutation.NextRetryAt(); ok {
+		_spec.SetField(profileentry.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if peuo.mutation.NextRetryAtCleared() {
+		_spec.ClearField(profileentry.FieldNextRetryAt, field.TypeTime)
+	}
+	if value, ok := peuo.mutation.LeaseOwner(); ok {
+		_spec.SetField(profileentry.FieldLeaseOwner, field.TypeString, value)
+	}
+	if peuo.mutation.LeaseOwnerCleared() {
+		_spec.ClearField(profileentry.FieldLeaseOwner, field.TypeString)
+	}
+	if value, ok := peuo.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(profileentry.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if peuo.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(profileentry.FieldLeaseExpiresAt, field.TypeTime)
+	}
 	if peuo.mutation.ProfileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sheng-go-backend/ent/company"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/ent/schema/ulid"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProfilePosition is the model entity for the ProfilePosition schema.
+type ProfilePosition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID ulid.ID `json:"id,omitempty"`
+	// Order in fullPositions; 0 is the most recent
+	PositionIndex int `json:"position_index,omitempty"`
+	// Company name
+	CompanyName string `json:"company_name,omitempty"`
+	// LinkedIn company URN or ID, when present
+	CompanyUrn string `json:"company_urn,omitempty"`
+	// LinkedIn company slug, when present
+	CompanyUsername string `json:"company_username,omitempty"`
+	// Job title
+	Title string `json:"title,omitempty"`
+	// Position location
+	Location string `json:"location,omitempty"`
+	// Employment type, e.g. Full-time
+	EmploymentType string `json:"employment_type,omitempty"`
+	// Position description
+	Description string `json:"description,omitempty"`
+	// Start date; unknown month/day default to 1
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// End date; unknown month/day default to 1
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// Whether this is a current position
+	IsCurrent bool `json:"is_current,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProfilePositionQuery when eager-loading is set.
+	Edges                     ProfilePositionEdges `json:"edges"`
+	company_positions         *ulid.ID
+	profile_profile_positions *ulid.ID
+	selectValues              sql.SelectValues
+}
+
+// ProfilePositionEdges holds the relations/edges for other nodes in the graph.
+type ProfilePositionEdges struct {
+	// Profile this position belongs to
+	Profile *Profile `json:"profile,omitempty"`
+	// Company normalized from company_name/company_urn
+	Company *Company `json:"company,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProfilePositionEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// CompanyOrErr returns the Company value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProfilePositionEdges) CompanyOrErr() (*Company, error) {
+	if e.Company != nil {
+		return e.Company, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: company.Label}
+	}
+	return nil, &NotLoadedError{edge: "company"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProfilePosition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case profileposition.FieldIsCurrent:
+			values[i] = new(sql.NullBool)
+		case profileposition.FieldPositionIndex:
+			values[i] = new(sql.NullInt64)
+		case profileposition.FieldCompanyName, profileposition.FieldCompanyUrn, profileposition.FieldCompanyUsername, profileposition.FieldTitle, profileposition.FieldLocation, profileposition.FieldEmploymentType, profileposition.FieldDescription:
+			values[i] = new(sql.NullString)
+		case profileposition.FieldStartDate, profileposition.FieldEndDate, profileposition.FieldCreatedAt, profileposition.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case profileposition.FieldID:
+			values[i] = new(ulid.ID)
+		case profileposition.ForeignKeys[0]: // company_positions
+			values[i] = &sql.NullScanner{S: new(ulid.ID)}
+		case profileposition.ForeignKeys[1]: // profile_profile_positions
+			values[i] = &sql.NullScanner{S: new(ulid.ID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProfilePosition fields.
+func (pp *ProfilePosition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case profileposition.FieldID:
+			if value, ok := values[i].(*ulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pp.ID = *value
+			}
+		case profileposition.FieldPositionIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position_index", values[i])
+			} else if value.Valid {
+				pp.PositionIndex = int(value.Int64)
+			}
+		case profileposition.FieldCompanyName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field company_name", values[i])
+			} else if value.Valid {
+				pp.CompanyName = value.String
+			}
+		case profileposition.FieldCompanyUrn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field company_urn", values[i])
+			} else if value.Valid {
+				pp.CompanyUrn = value.String
+			}
+		case profileposition.FieldCompanyUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field company_username", values[i])
+			} else if value.Valid {
+				pp.CompanyUsername = value.String
+			}
+		case profileposition.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				pp.Title = value.String
+			}
+		case profileposition.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				pp.Location = value.String
+			}
+		case profileposition.FieldEmploymentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field employment_type", values[i])
+			} else if value.Valid {
+				pp.EmploymentType = value.String
+			}
+		case profileposition.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pp.Description = value.String
+			}
+		case profileposition.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				pp.StartDate = new(time.Time)
+				*pp.StartDate = value.Time
+			}
+		case profileposition.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				pp.EndDate = new(time.Time)
+				*pp.EndDate = value.Time
+			}
+		case profileposition.FieldIsCurrent:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_current", values[i])
+			} else if value.Valid {
+				pp.IsCurrent = value.Bool
+			}
+		case profileposition.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pp.CreatedAt = value.Time
+			}
+		case profileposition.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pp.UpdatedAt = value.Time
+			}
+		case profileposition.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field company_positions", values[i])
+			} else if value.Valid {
+				pp.company_positions = new(ulid.ID)
+				*pp.company_positions = *value.S.(*ulid.ID)
+			}
+		case profileposition.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_profile_positions", values[i])
+			} else if value.Valid {
+				pp.profile_profile_positions = new(ulid.ID)
+				*pp.profile_profile_positions = *value.S.(*ulid.ID)
+			}
+		default:
+			pp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProfilePosition.
+// This includes values selected through modifiers, order, etc.
+func (pp *ProfilePosition) Value(name string) (ent.Value, error) {
+	return pp.selectValues.Get(name)
+}
+
+// QueryProfile queries the "profile" edge of the ProfilePosition entity.
+func (pp *ProfilePosition) QueryProfile() *ProfileQuery {
+	return NewProfilePositionClient(pp.config).QueryProfile(pp)
+}
+
+// QueryCompany queries the "company" edge of the ProfilePosition entity.
+func (pp *ProfilePosition) QueryCompany() *CompanyQuery {
+	return NewProfilePositionClient(pp.config).QueryCompany(pp)
+}
+
+// Update returns a builder for updating this ProfilePosition.
+// Note that you need to call ProfilePosition.Unwrap() before calling this method if this ProfilePosition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pp *ProfilePosition) Update() *ProfilePositionUpdateOne {
+	return NewProfilePositionClient(pp.config).UpdateOne(pp)
+}
+
+// Unwrap unwraps the ProfilePosition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pp *ProfilePosition) Unwrap() *ProfilePosition {
+	_tx, ok := pp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProfilePosition is not a transactional entity")
+	}
+	pp.config.driver = _tx.drv
+	return pp
+}
+
+// String implements the fmt.Stringer.
+func (pp *ProfilePosition) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProfilePosition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pp.ID))
+	builder.WriteString("position_index=")
+	builder.WriteString(fmt.Sprintf("%v", pp.PositionIndex))
+	builder.WriteString(", ")
+	builder.WriteString("company_name=")
+	builder.WriteString(pp.CompanyName)
+	builder.WriteString(", ")
+	builder.WriteString("company_urn=")
+	builder.WriteString(pp.CompanyUrn)
+	builder.WriteString(", ")
+	builder.WriteString("company_username=")
+	builder.WriteString(pp.CompanyUsername)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(pp.Title)
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(pp.Location)
+	builder.WriteString(", ")
+	builder.WriteString("employment_type=")
+	builder.WriteString(pp.EmploymentType)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pp.Description)
+	builder.WriteString(", ")
+	if v := pp.StartDate; v != nil {
+		builder.WriteString("start_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pp.EndDate; v != nil {
+		builder.WriteString("end_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_current=")
+	builder.WriteString(fmt.Sprintf("%v", pp.IsCurrent))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProfilePositions is a parsable slice of ProfilePosition.
+type ProfilePositions []*ProfilePosition
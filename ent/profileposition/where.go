@@ -0,0 +1,938 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profileposition
+
+import (
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id ulid.ID) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id ulid.ID) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id ulid.ID) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...ulid.ID) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...ulid.ID) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id ulid.ID) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id ulid.ID) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id ulid.ID) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id ulid.ID) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldID, id))
+}
+
+// PositionIndex applies equality check predicate on the "position_index" field. It's identical to PositionIndexEQ.
+func PositionIndex(v int) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldPositionIndex, v))
+}
+
+// CompanyName applies equality check predicate on the "company_name" field. It's identical to CompanyNameEQ.
+func CompanyName(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldCompanyName, v))
+}
+
+// CompanyUrn applies equality check predicate on the "company_urn" field. It's identical to CompanyUrnEQ.
+func CompanyUrn(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldCompanyUrn, v))
+}
+
+// CompanyUsername applies equality check predicate on the "company_username" field. It's identical to CompanyUsernameEQ.
+func CompanyUsername(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldCompanyUsername, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldTitle, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldLocation, v))
+}
+
+// EmploymentType applies equality check predicate on the "employment_type" field. It's identical to EmploymentTypeEQ.
+func EmploymentType(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldEmploymentType, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldDescription, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldEndDate, v))
+}
+
+// IsCurrent applies equality check predicate on the "is_current" field. It's identical to IsCurrentEQ.
+func IsCurrent(v bool) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldIsCurrent, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PositionIndexEQ applies the EQ predicate on the "position_index" field.
+func PositionIndexEQ(v int) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldPositionIndex, v))
+}
+
+// PositionIndexNEQ applies the NEQ predicate on the "position_index" field.
+func PositionIndexNEQ(v int) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldPositionIndex, v))
+}
+
+// PositionIndexIn applies the In predicate on the "position_index" field.
+func PositionIndexIn(vs ...int) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldPositionIndex, vs...))
+}
+
+// PositionIndexNotIn applies the NotIn predicate on the "position_index" field.
+func PositionIndexNotIn(vs ...int) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldPositionIndex, vs...))
+}
+
+// PositionIndexGT applies the GT predicate on the "position_index" field.
+func PositionIndexGT(v int) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldPositionIndex, v))
+}
+
+// PositionIndexGTE applies the GTE predicate on the "position_index" field.
+func PositionIndexGTE(v int) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldPositionIndex, v))
+}
+
+// PositionIndexLT applies the LT predicate on the "position_index" field.
+func PositionIndexLT(v int) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldPositionIndex, v))
+}
+
+// PositionIndexLTE applies the LTE predicate on the "position_index" field.
+func PositionIndexLTE(v int) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldPositionIndex, v))
+}
+
+// CompanyNameEQ applies the EQ predicate on the "company_name" field.
+func CompanyNameEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldCompanyName, v))
+}
+
+// CompanyNameNEQ applies the NEQ predicate on the "company_name" field.
+func CompanyNameNEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldCompanyName, v))
+}
+
+// CompanyNameIn applies the In predicate on the "company_name" field.
+func CompanyNameIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldCompanyName, vs...))
+}
+
+// CompanyNameNotIn applies the NotIn predicate on the "company_name" field.
+func CompanyNameNotIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldCompanyName, vs...))
+}
+
+// CompanyNameGT applies the GT predicate on the "company_name" field.
+func CompanyNameGT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldCompanyName, v))
+}
+
+// CompanyNameGTE applies the GTE predicate on the "company_name" field.
+func CompanyNameGTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldCompanyName, v))
+}
+
+// CompanyNameLT applies the LT predicate on the "company_name" field.
+func CompanyNameLT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldCompanyName, v))
+}
+
+// CompanyNameLTE applies the LTE predicate on the "company_name" field.
+func CompanyNameLTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldCompanyName, v))
+}
+
+// CompanyNameContains applies the Contains predicate on the "company_name" field.
+func CompanyNameContains(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContains(FieldCompanyName, v))
+}
+
+// CompanyNameHasPrefix applies the HasPrefix predicate on the "company_name" field.
+func CompanyNameHasPrefix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasPrefix(FieldCompanyName, v))
+}
+
+// CompanyNameHasSuffix applies the HasSuffix predicate on the "company_name" field.
+func CompanyNameHasSuffix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasSuffix(FieldCompanyName, v))
+}
+
+// CompanyNameIsNil applies the IsNil predicate on the "company_name" field.
+func CompanyNameIsNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIsNull(FieldCompanyName))
+}
+
+// CompanyNameNotNil applies the NotNil predicate on the "company_name" field.
+func CompanyNameNotNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotNull(FieldCompanyName))
+}
+
+// CompanyNameEqualFold applies the EqualFold predicate on the "company_name" field.
+func CompanyNameEqualFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEqualFold(FieldCompanyName, v))
+}
+
+// CompanyNameContainsFold applies the ContainsFold predicate on the "company_name" field.
+func CompanyNameContainsFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContainsFold(FieldCompanyName, v))
+}
+
+// CompanyUrnEQ applies the EQ predicate on the "company_urn" field.
+func CompanyUrnEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldCompanyUrn, v))
+}
+
+// CompanyUrnNEQ applies the NEQ predicate on the "company_urn" field.
+func CompanyUrnNEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldCompanyUrn, v))
+}
+
+// CompanyUrnIn applies the In predicate on the "company_urn" field.
+func CompanyUrnIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldCompanyUrn, vs...))
+}
+
+// CompanyUrnNotIn applies the NotIn predicate on the "company_urn" field.
+func CompanyUrnNotIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldCompanyUrn, vs...))
+}
+
+// CompanyUrnGT applies the GT predicate on the "company_urn" field.
+func CompanyUrnGT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldCompanyUrn, v))
+}
+
+// CompanyUrnGTE applies the GTE predicate on the "company_urn" field.
+func CompanyUrnGTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldCompanyUrn, v))
+}
+
+// CompanyUrnLT applies the LT predicate on the "company_urn" field.
+func CompanyUrnLT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldCompanyUrn, v))
+}
+
+// CompanyUrnLTE applies the LTE predicate on the "company_urn" field.
+func CompanyUrnLTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldCompanyUrn, v))
+}
+
+// CompanyUrnContains applies the Contains predicate on the "company_urn" field.
+func CompanyUrnContains(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContains(FieldCompanyUrn, v))
+}
+
+// CompanyUrnHasPrefix applies the HasPrefix predicate on the "company_urn" field.
+func CompanyUrnHasPrefix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasPrefix(FieldCompanyUrn, v))
+}
+
+// CompanyUrnHasSuffix applies the HasSuffix predicate on the "company_urn" field.
+func CompanyUrnHasSuffix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasSuffix(FieldCompanyUrn, v))
+}
+
+// CompanyUrnIsNil applies the IsNil predicate on the "company_urn" field.
+func CompanyUrnIsNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIsNull(FieldCompanyUrn))
+}
+
+// CompanyUrnNotNil applies the NotNil predicate on the "company_urn" field.
+func CompanyUrnNotNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotNull(FieldCompanyUrn))
+}
+
+// CompanyUrnEqualFold applies the EqualFold predicate on the "company_urn" field.
+func CompanyUrnEqualFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEqualFold(FieldCompanyUrn, v))
+}
+
+// CompanyUrnContainsFold applies the ContainsFold predicate on the "company_urn" field.
+func CompanyUrnContainsFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContainsFold(FieldCompanyUrn, v))
+}
+
+// CompanyUsernameEQ applies the EQ predicate on the "company_username" field.
+func CompanyUsernameEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldCompanyUsername, v))
+}
+
+// CompanyUsernameNEQ applies the NEQ predicate on the "company_username" field.
+func CompanyUsernameNEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldCompanyUsername, v))
+}
+
+// CompanyUsernameIn applies the In predicate on the "company_username" field.
+func CompanyUsernameIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldCompanyUsername, vs...))
+}
+
+// CompanyUsernameNotIn applies the NotIn predicate on the "company_username" field.
+func CompanyUsernameNotIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldCompanyUsername, vs...))
+}
+
+// CompanyUsernameGT applies the GT predicate on the "company_username" field.
+func CompanyUsernameGT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldCompanyUsername, v))
+}
+
+// CompanyUsernameGTE applies the GTE predicate on the "company_username" field.
+func CompanyUsernameGTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldCompanyUsername, v))
+}
+
+// CompanyUsernameLT applies the LT predicate on the "company_username" field.
+func CompanyUsernameLT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldCompanyUsername, v))
+}
+
+// CompanyUsernameLTE applies the LTE predicate on the "company_username" field.
+func CompanyUsernameLTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldCompanyUsername, v))
+}
+
+// CompanyUsernameContains applies the Contains predicate on the "company_username" field.
+func CompanyUsernameContains(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContains(FieldCompanyUsername, v))
+}
+
+// CompanyUsernameHasPrefix applies the HasPrefix predicate on the "company_username" field.
+func CompanyUsernameHasPrefix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasPrefix(FieldCompanyUsername, v))
+}
+
+// CompanyUsernameHasSuffix applies the HasSuffix predicate on the "company_username" field.
+func CompanyUsernameHasSuffix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasSuffix(FieldCompanyUsername, v))
+}
+
+// CompanyUsernameIsNil applies the IsNil predicate on the "company_username" field.
+func CompanyUsernameIsNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIsNull(FieldCompanyUsername))
+}
+
+// CompanyUsernameNotNil applies the NotNil predicate on the "company_username" field.
+func CompanyUsernameNotNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotNull(FieldCompanyUsername))
+}
+
+// CompanyUsernameEqualFold applies the EqualFold predicate on the "company_username" field.
+func CompanyUsernameEqualFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEqualFold(FieldCompanyUsername, v))
+}
+
+// CompanyUsernameContainsFold applies the ContainsFold predicate on the "company_username" field.
+func CompanyUsernameContainsFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContainsFold(FieldCompanyUsername, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// EmploymentTypeEQ applies the EQ predicate on the "employment_type" field.
+func EmploymentTypeEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldEmploymentType, v))
+}
+
+// EmploymentTypeNEQ applies the NEQ predicate on the "employment_type" field.
+func EmploymentTypeNEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldEmploymentType, v))
+}
+
+// EmploymentTypeIn applies the In predicate on the "employment_type" field.
+func EmploymentTypeIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldEmploymentType, vs...))
+}
+
+// EmploymentTypeNotIn applies the NotIn predicate on the "employment_type" field.
+func EmploymentTypeNotIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldEmploymentType, vs...))
+}
+
+// EmploymentTypeGT applies the GT predicate on the "employment_type" field.
+func EmploymentTypeGT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldEmploymentType, v))
+}
+
+// EmploymentTypeGTE applies the GTE predicate on the "employment_type" field.
+func EmploymentTypeGTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldEmploymentType, v))
+}
+
+// EmploymentTypeLT applies the LT predicate on the "employment_type" field.
+func EmploymentTypeLT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldEmploymentType, v))
+}
+
+// EmploymentTypeLTE applies the LTE predicate on the "employment_type" field.
+func EmploymentTypeLTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldEmploymentType, v))
+}
+
+// EmploymentTypeContains applies the Contains predicate on the "employment_type" field.
+func EmploymentTypeContains(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContains(FieldEmploymentType, v))
+}
+
+// EmploymentTypeHasPrefix applies the HasPrefix predicate on the "employment_type" field.
+func EmploymentTypeHasPrefix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasPrefix(FieldEmploymentType, v))
+}
+
+// EmploymentTypeHasSuffix applies the HasSuffix predicate on the "employment_type" field.
+func EmploymentTypeHasSuffix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasSuffix(FieldEmploymentType, v))
+}
+
+// EmploymentTypeIsNil applies the IsNil predicate on the "employment_type" field.
+func EmploymentTypeIsNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIsNull(FieldEmploymentType))
+}
+
+// EmploymentTypeNotNil applies the NotNil predicate on the "employment_type" field.
+func EmploymentTypeNotNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotNull(FieldEmploymentType))
+}
+
+// EmploymentTypeEqualFold applies the EqualFold predicate on the "employment_type" field.
+func EmploymentTypeEqualFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEqualFold(FieldEmploymentType, v))
+}
+
+// EmploymentTypeContainsFold applies the ContainsFold predicate on the "employment_type" field.
+func EmploymentTypeContainsFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContainsFold(FieldEmploymentType, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldStartDate, v))
+}
+
+// StartDateIsNil applies the IsNil predicate on the "start_date" field.
+func StartDateIsNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIsNull(FieldStartDate))
+}
+
+// StartDateNotNil applies the NotNil predicate on the "start_date" field.
+func StartDateNotNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotNull(FieldStartDate))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldEndDate, v))
+}
+
+// EndDateIsNil applies the IsNil predicate on the "end_date" field.
+func EndDateIsNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIsNull(FieldEndDate))
+}
+
+// EndDateNotNil applies the NotNil predicate on the "end_date" field.
+func EndDateNotNil() predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotNull(FieldEndDate))
+}
+
+// IsCurrentEQ applies the EQ predicate on the "is_current" field.
+func IsCurrentEQ(v bool) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldIsCurrent, v))
+}
+
+// IsCurrentNEQ applies the NEQ predicate on the "is_current" field.
+func IsCurrentNEQ(v bool) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldIsCurrent, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.ProfilePosition {
+	return predicate.ProfilePosition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.ProfilePosition {
+	return predicate.ProfilePosition(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCompany applies the HasEdge predicate on the "company" edge.
+func HasCompany() predicate.ProfilePosition {
+	return predicate.ProfilePosition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CompanyTable, CompanyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCompanyWith applies the HasEdge predicate on the "company" edge with a given conditions (other predicates).
+func HasCompanyWith(preds ...predicate.Company) predicate.ProfilePosition {
+	return predicate.ProfilePosition(func(s *sql.Selector) {
+		step := newCompanyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProfilePosition) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProfilePosition) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProfilePosition) predicate.ProfilePosition {
+	return predicate.ProfilePosition(sql.NotPredicates(p))
+}
@@ -0,0 +1,1466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/company"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfilePositionCreate is the builder for creating a ProfilePosition entity.
+type ProfilePositionCreate struct {
+	config
+	mutation *ProfilePositionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPositionIndex sets the "position_index" field.
+func (ppc *ProfilePositionCreate) SetPositionIndex(i int) *ProfilePositionCreate {
+	ppc.mutation.SetPositionIndex(i)
+	return ppc
+}
+
+// SetCompanyName sets the "company_name" field.
+func (ppc *ProfilePositionCreate) SetCompanyName(s string) *ProfilePositionCreate {
+	ppc.mutation.SetCompanyName(s)
+	return ppc
+}
+
+// SetNillableCompanyName sets the "company_name" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableCompanyName(s *string) *ProfilePositionCreate {
+	if s != nil {
+		ppc.SetCompanyName(*s)
+	}
+	return ppc
+}
+
+// SetCompanyUrn sets the "company_urn" field.
+func (ppc *ProfilePositionCreate) SetCompanyUrn(s string) *ProfilePositionCreate {
+	ppc.mutation.SetCompanyUrn(s)
+	return ppc
+}
+
+// SetNillableCompanyUrn sets the "company_urn" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableCompanyUrn(s *string) *ProfilePositionCreate {
+	if s != nil {
+		ppc.SetCompanyUrn(*s)
+	}
+	return ppc
+}
+
+// SetCompanyUsername sets the "company_username" field.
+func (ppc *ProfilePositionCreate) SetCompanyUsername(s string) *ProfilePositionCreate {
+	ppc.mutation.SetCompanyUsername(s)
+	return ppc
+}
+
+// SetNillableCompanyUsername sets the "company_username" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableCompanyUsername(s *string) *ProfilePositionCreate {
+	if s != nil {
+		ppc.SetCompanyUsername(*s)
+	}
+	return ppc
+}
+
+// SetTitle sets the "title" field.
+func (ppc *ProfilePositionCreate) SetTitle(s string) *ProfilePositionCreate {
+	ppc.mutation.SetTitle(s)
+	return ppc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableTitle(s *string) *ProfilePositionCreate {
+	if s != nil {
+		ppc.SetTitle(*s)
+	}
+	return ppc
+}
+
+// SetLocation sets the "location" field.
+func (ppc *ProfilePositionCreate) SetLocation(s string) *ProfilePositionCreate {
+	ppc.mutation.SetLocation(s)
+	return ppc
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableLocation(s *string) *ProfilePositionCreate {
+	if s != nil {
+		ppc.SetLocation(*s)
+	}
+	return ppc
+}
+
+// SetEmploymentType sets the "employment_type" field.
+func (ppc *ProfilePositionCreate) SetEmploymentType(s string) *ProfilePositionCreate {
+	ppc.mutation.SetEmploymentType(s)
+	return ppc
+}
+
+// SetNillableEmploymentType sets the "employment_type" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableEmploymentType(s *string) *ProfilePositionCreate {
+	if s != nil {
+		ppc.SetEmploymentType(*s)
+	}
+	return ppc
+}
+
+// SetDescription sets the "description" field.
+func (ppc *ProfilePositionCreate) SetDescription(s string) *ProfilePositionCreate {
+	ppc.mutation.SetDescription(s)
+	return ppc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableDescription(s *string) *ProfilePositionCreate {
+	if s != nil {
+		ppc.SetDescription(*s)
+	}
+	return ppc
+}
+
+// SetStartDate sets the "start_date" field.
+func (ppc *ProfilePositionCreate) SetStartDate(t time.Time) *ProfilePositionCreate {
+	ppc.mutation.SetStartDate(t)
+	return ppc
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableStartDate(t *time.Time) *ProfilePositionCreate {
+	if t != nil {
+		ppc.SetStartDate(*t)
+	}
+	return ppc
+}
+
+// SetEndDate sets the "end_date" field.
+func (ppc *ProfilePositionCreate) SetEndDate(t time.Time) *ProfilePositionCreate {
+	ppc.mutation.SetEndDate(t)
+	return ppc
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableEndDate(t *time.Time) *ProfilePositionCreate {
+	if t != nil {
+		ppc.SetEndDate(*t)
+	}
+	return ppc
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (ppc *ProfilePositionCreate) SetIsCurrent(b bool) *ProfilePositionCreate {
+	ppc.mutation.SetIsCurrent(b)
+	return ppc
+}
+
+// SetNillableIsCurrent sets the "is_current" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableIsCurrent(b *bool) *ProfilePositionCreate {
+	if b != nil {
+		ppc.SetIsCurrent(*b)
+	}
+	return ppc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ppc *ProfilePositionCreate) SetCreatedAt(t time.Time) *ProfilePositionCreate {
+	ppc.mutation.SetCreatedAt(t)
+	return ppc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableCreatedAt(t *time.Time) *ProfilePositionCreate {
+	if t != nil {
+		ppc.SetCreatedAt(*t)
+	}
+	return ppc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ppc *ProfilePositionCreate) SetUpdatedAt(t time.Time) *ProfilePositionCreate {
+	ppc.mutation.SetUpdatedAt(t)
+	return ppc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableUpdatedAt(t *time.Time) *ProfilePositionCreate {
+	if t != nil {
+		ppc.SetUpdatedAt(*t)
+	}
+	return ppc
+}
+
+// SetID sets the "id" field.
+func (ppc *ProfilePositionCreate) SetID(u ulid.ID) *ProfilePositionCreate {
+	ppc.mutation.SetID(u)
+	return ppc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableID(u *ulid.ID) *ProfilePositionCreate {
+	if u != nil {
+		ppc.SetID(*u)
+	}
+	return ppc
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (ppc *ProfilePositionCreate) SetProfileID(id ulid.ID) *ProfilePositionCreate {
+	ppc.mutation.SetProfileID(id)
+	return ppc
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (ppc *ProfilePositionCreate) SetProfile(p *Profile) *ProfilePositionCreate {
+	return ppc.SetProfileID(p.ID)
+}
+
+// SetCompanyID sets the "company" edge to the Company entity by ID.
+func (ppc *ProfilePositionCreate) SetCompanyID(id ulid.ID) *ProfilePositionCreate {
+	ppc.mutation.SetCompanyID(id)
+	return ppc
+}
+
+// SetNillableCompanyID sets the "company" edge to the Company entity by ID if the given value is not nil.
+func (ppc *ProfilePositionCreate) SetNillableCompanyID(id *ulid.ID) *ProfilePositionCreate {
+	if id != nil {
+		ppc = ppc.SetCompanyID(*id)
+	}
+	return ppc
+}
+
+// SetCompany sets the "company" edge to the Company entity.
+func (ppc *ProfilePositionCreate) SetCompany(c *Company) *ProfilePositionCreate {
+	return ppc.SetCompanyID(c.ID)
+}
+
+// Mutation returns the ProfilePositionMutation object of the builder.
+func (ppc *ProfilePositionCreate) Mutation() *ProfilePositionMutation {
+	return ppc.mutation
+}
+
+// Save creates the ProfilePosition in the database.
+func (ppc *ProfilePositionCreate) Save(ctx context.Context) (*ProfilePosition, error) {
+	ppc.defaults()
+	return withHooks(ctx, ppc.sqlSave, ppc.mutation, ppc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ppc *ProfilePositionCreate) SaveX(ctx context.Context) *ProfilePosition {
+	v, err := ppc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppc *ProfilePositionCreate) Exec(ctx context.Context) error {
+	_, err := ppc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppc *ProfilePositionCreate) ExecX(ctx context.Context) {
+	if err := ppc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppc *ProfilePositionCreate) defaults() {
+	if _, ok := ppc.mutation.IsCurrent(); !ok {
+		v := profileposition.DefaultIsCurrent
+		ppc.mutation.SetIsCurrent(v)
+	}
+	if _, ok := ppc.mutation.CreatedAt(); !ok {
+		v := profileposition.DefaultCreatedAt()
+		ppc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ppc.mutation.UpdatedAt(); !ok {
+		v := profileposition.DefaultUpdatedAt()
+		ppc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ppc.mutation.ID(); !ok {
+		v := profileposition.DefaultID()
+		ppc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppc *ProfilePositionCreate) check() error {
+	if _, ok := ppc.mutation.PositionIndex(); !ok {
+		return &ValidationError{Name: "position_index", err: errors.New(`ent: missing required field "ProfilePosition.position_index"`)}
+	}
+	if v, ok := ppc.mutation.PositionIndex(); ok {
+		if err := profileposition.PositionIndexValidator(v); err != nil {
+			return &ValidationError{Name: "position_index", err: fmt.Errorf(`ent: validator failed for field "ProfilePosition.position_index": %w`, err)}
+		}
+	}
+	if _, ok := ppc.mutation.IsCurrent(); !ok {
+		return &ValidationError{Name: "is_current", err: errors.New(`ent: missing required field "ProfilePosition.is_current"`)}
+	}
+	if _, ok := ppc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProfilePosition.created_at"`)}
+	}
+	if _, ok := ppc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProfilePosition.updated_at"`)}
+	}
+	if len(ppc.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "ProfilePosition.profile"`)}
+	}
+	return nil
+}
+
+func (ppc *ProfilePositionCreate) sqlSave(ctx context.Context) (*ProfilePosition, error) {
+	if err := ppc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ppc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ppc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*ulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ppc.mutation.id = &_node.ID
+	ppc.mutation.done = true
+	return _node, nil
+}
+
+func (ppc *ProfilePositionCreate) createSpec() (*ProfilePosition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProfilePosition{config: ppc.config}
+		_spec = sqlgraph.NewCreateSpec(profileposition.Table, sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = ppc.conflict
+	if id, ok := ppc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ppc.mutation.PositionIndex(); ok {
+		_spec.SetField(profileposition.FieldPositionIndex, field.TypeInt, value)
+		_node.PositionIndex = value
+	}
+	if value, ok := ppc.mutation.CompanyName(); ok {
+		_spec.SetField(profileposition.FieldCompanyName, field.TypeString, value)
+		_node.CompanyName = value
+	}
+	if value, ok := ppc.mutation.CompanyUrn(); ok {
+		_spec.SetField(profileposition.FieldCompanyUrn, field.TypeString, value)
+		_node.CompanyUrn = value
+	}
+	if value, ok := ppc.mutation.CompanyUsername(); ok {
+		_spec.SetField(profileposition.FieldCompanyUsername, field.TypeString, value)
+		_node.CompanyUsername = value
+	}
+	if value, ok := ppc.mutation.Title(); ok {
+		_spec.SetField(profileposition.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := ppc.mutation.Location(); ok {
+		_spec.SetField(profileposition.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := ppc.mutation.EmploymentType(); ok {
+		_spec.SetField(profileposition.FieldEmploymentType, field.TypeString, value)
+		_node.EmploymentType = value
+	}
+	if value, ok := ppc.mutation.Description(); ok {
+		_spec.SetField(profileposition.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := ppc.mutation.StartDate(); ok {
+		_spec.SetField(profileposition.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = &value
+	}
+	if value, ok := ppc.mutation.EndDate(); ok {
+		_spec.SetField(profileposition.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = &value
+	}
+	if value, ok := ppc.mutation.IsCurrent(); ok {
+		_spec.SetField(profileposition.FieldIsCurrent, field.TypeBool, value)
+		_node.IsCurrent = value
+	}
+	if value, ok := ppc.mutation.CreatedAt(); ok {
+		_spec.SetField(profileposition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ppc.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileposition.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ppc.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileposition.ProfileTable,
+			Columns: []string{profileposition.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.profile_profile_positions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.CompanyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileposition.CompanyTable,
+			Columns: []string{profileposition.CompanyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.company_positions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfilePosition.Create().
+//		SetPositionIndex(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfilePositionUpsert) {
+//			SetPositionIndex(v+v).
+//		}).
+//		Exec(ctx)
+func (ppc *ProfilePositionCreate) OnConflict(opts ...sql.ConflictOption) *ProfilePositionUpsertOne {
+	ppc.conflict = opts
+	return &ProfilePositionUpsertOne{
+		create: ppc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfilePosition.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ppc *ProfilePositionCreate) OnConflictColumns(columns ...string) *ProfilePositionUpsertOne {
+	ppc.conflict = append(ppc.conflict, sql.ConflictColumns(columns...))
+	return &ProfilePositionUpsertOne{
+		create: ppc,
+	}
+}
+
+type (
+	// ProfilePositionUpsertOne is the builder for "upsert"-ing
+	//  one ProfilePosition node.
+	ProfilePositionUpsertOne struct {
+		create *ProfilePositionCreate
+	}
+
+	// ProfilePositionUpsert is the "OnConflict" setter.
+	ProfilePositionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPositionIndex sets the "position_index" field.
+func (u *ProfilePositionUpsert) SetPositionIndex(v int) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldPositionIndex, v)
+	return u
+}
+
+// UpdatePositionIndex sets the "position_index" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdatePositionIndex() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldPositionIndex)
+	return u
+}
+
+// AddPositionIndex adds v to the "position_index" field.
+func (u *ProfilePositionUpsert) AddPositionIndex(v int) *ProfilePositionUpsert {
+	u.Add(profileposition.FieldPositionIndex, v)
+	return u
+}
+
+// SetCompanyName sets the "company_name" field.
+func (u *ProfilePositionUpsert) SetCompanyName(v string) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldCompanyName, v)
+	return u
+}
+
+// UpdateCompanyName sets the "company_name" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdateCompanyName() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldCompanyName)
+	return u
+}
+
+// ClearCompanyName clears the value of the "company_name" field.
+func (u *ProfilePositionUpsert) ClearCompanyName() *ProfilePositionUpsert {
+	u.SetNull(profileposition.FieldCompanyName)
+	return u
+}
+
+// SetCompanyUrn sets the "company_urn" field.
+func (u *ProfilePositionUpsert) SetCompanyUrn(v string) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldCompanyUrn, v)
+	return u
+}
+
+// UpdateCompanyUrn sets the "company_urn" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdateCompanyUrn() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldCompanyUrn)
+	return u
+}
+
+// ClearCompanyUrn clears the value of the "company_urn" field.
+func (u *ProfilePositionUpsert) ClearCompanyUrn() *ProfilePositionUpsert {
+	u.SetNull(profileposition.FieldCompanyUrn)
+	return u
+}
+
+// SetCompanyUsername sets the "company_username" field.
+func (u *ProfilePositionUpsert) SetCompanyUsername(v string) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldCompanyUsername, v)
+	return u
+}
+
+// UpdateCompanyUsername sets the "company_username" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdateCompanyUsername() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldCompanyUsername)
+	return u
+}
+
+// ClearCompanyUsername clears the value of the "company_username" field.
+func (u *ProfilePositionUpsert) ClearCompanyUsername() *ProfilePositionUpsert {
+	u.SetNull(profileposition.FieldCompanyUsername)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ProfilePositionUpsert) SetTitle(v string) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdateTitle() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldTitle)
+	return u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ProfilePositionUpsert) ClearTitle() *ProfilePositionUpsert {
+	u.SetNull(profileposition.FieldTitle)
+	return u
+}
+
+// SetLocation sets the "location" field.
+func (u *ProfilePositionUpsert) SetLocation(v string) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldLocation, v)
+	return u
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdateLocation() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldLocation)
+	return u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (u *ProfilePositionUpsert) ClearLocation() *ProfilePositionUpsert {
+	u.SetNull(profileposition.FieldLocation)
+	return u
+}
+
+// SetEmploymentType sets the "employment_type" field.
+func (u *ProfilePositionUpsert) SetEmploymentType(v string) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldEmploymentType, v)
+	return u
+}
+
+// UpdateEmploymentType sets the "employment_type" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdateEmploymentType() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldEmploymentType)
+	return u
+}
+
+// ClearEmploymentType clears the value of the "employment_type" field.
+func (u *ProfilePositionUpsert) ClearEmploymentType() *ProfilePositionUpsert {
+	u.SetNull(profileposition.FieldEmploymentType)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ProfilePositionUpsert) SetDescription(v string) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdateDescription() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ProfilePositionUpsert) ClearDescription() *ProfilePositionUpsert {
+	u.SetNull(profileposition.FieldDescription)
+	return u
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *ProfilePositionUpsert) SetStartDate(v time.Time) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldStartDate, v)
+	return u
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdateStartDate() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldStartDate)
+	return u
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (u *ProfilePositionUpsert) ClearStartDate() *ProfilePositionUpsert {
+	u.SetNull(profileposition.FieldStartDate)
+	return u
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *ProfilePositionUpsert) SetEndDate(v time.Time) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldEndDate, v)
+	return u
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdateEndDate() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldEndDate)
+	return u
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *ProfilePositionUpsert) ClearEndDate() *ProfilePositionUpsert {
+	u.SetNull(profileposition.FieldEndDate)
+	return u
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (u *ProfilePositionUpsert) SetIsCurrent(v bool) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldIsCurrent, v)
+	return u
+}
+
+// UpdateIsCurrent sets the "is_current" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdateIsCurrent() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldIsCurrent)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfilePositionUpsert) SetUpdatedAt(v time.Time) *ProfilePositionUpsert {
+	u.Set(profileposition.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfilePositionUpsert) UpdateUpdatedAt() *ProfilePositionUpsert {
+	u.SetExcluded(profileposition.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProfilePosition.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profileposition.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfilePositionUpsertOne) UpdateNewValues() *ProfilePositionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(profileposition.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(profileposition.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfilePosition.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProfilePositionUpsertOne) Ignore() *ProfilePositionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfilePositionUpsertOne) DoNothing() *ProfilePositionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfilePositionCreate.OnConflict
+// documentation for more info.
+func (u *ProfilePositionUpsertOne) Update(set func(*ProfilePositionUpsert)) *ProfilePositionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfilePositionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPositionIndex sets the "position_index" field.
+func (u *ProfilePositionUpsertOne) SetPositionIndex(v int) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetPositionIndex(v)
+	})
+}
+
+// AddPositionIndex adds v to the "position_index" field.
+func (u *ProfilePositionUpsertOne) AddPositionIndex(v int) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.AddPositionIndex(v)
+	})
+}
+
+// UpdatePositionIndex sets the "position_index" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdatePositionIndex() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdatePositionIndex()
+	})
+}
+
+// SetCompanyName sets the "company_name" field.
+func (u *ProfilePositionUpsertOne) SetCompanyName(v string) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetCompanyName(v)
+	})
+}
+
+// UpdateCompanyName sets the "company_name" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdateCompanyName() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateCompanyName()
+	})
+}
+
+// ClearCompanyName clears the value of the "company_name" field.
+func (u *ProfilePositionUpsertOne) ClearCompanyName() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearCompanyName()
+	})
+}
+
+// SetCompanyUrn sets the "company_urn" field.
+func (u *ProfilePositionUpsertOne) SetCompanyUrn(v string) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetCompanyUrn(v)
+	})
+}
+
+// UpdateCompanyUrn sets the "company_urn" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdateCompanyUrn() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateCompanyUrn()
+	})
+}
+
+// ClearCompanyUrn clears the value of the "company_urn" field.
+func (u *ProfilePositionUpsertOne) ClearCompanyUrn() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearCompanyUrn()
+	})
+}
+
+// SetCompanyUsername sets the "company_username" field.
+func (u *ProfilePositionUpsertOne) SetCompanyUsername(v string) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetCompanyUsername(v)
+	})
+}
+
+// UpdateCompanyUsername sets the "company_username" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdateCompanyUsername() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateCompanyUsername()
+	})
+}
+
+// ClearCompanyUsername clears the value of the "company_username" field.
+func (u *ProfilePositionUpsertOne) ClearCompanyUsername() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearCompanyUsername()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ProfilePositionUpsertOne) SetTitle(v string) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdateTitle() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ProfilePositionUpsertOne) ClearTitle() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetLocation sets the "location" field.
+func (u *ProfilePositionUpsertOne) SetLocation(v string) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetLocation(v)
+	})
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdateLocation() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateLocation()
+	})
+}
+
+// ClearLocation clears the value of the "location" field.
+func (u *ProfilePositionUpsertOne) ClearLocation() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearLocation()
+	})
+}
+
+// SetEmploymentType sets the "employment_type" field.
+func (u *ProfilePositionUpsertOne) SetEmploymentType(v string) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetEmploymentType(v)
+	})
+}
+
+// UpdateEmploymentType sets the "employment_type" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdateEmploymentType() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateEmploymentType()
+	})
+}
+
+// ClearEmploymentType clears the value of the "employment_type" field.
+func (u *ProfilePositionUpsertOne) ClearEmploymentType() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearEmploymentType()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ProfilePositionUpsertOne) SetDescription(v string) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdateDescription() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ProfilePositionUpsertOne) ClearDescription() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *ProfilePositionUpsertOne) SetStartDate(v time.Time) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdateStartDate() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (u *ProfilePositionUpsertOne) ClearStartDate() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *ProfilePositionUpsertOne) SetEndDate(v time.Time) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdateEndDate() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *ProfilePositionUpsertOne) ClearEndDate() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearEndDate()
+	})
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (u *ProfilePositionUpsertOne) SetIsCurrent(v bool) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetIsCurrent(v)
+	})
+}
+
+// UpdateIsCurrent sets the "is_current" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdateIsCurrent() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateIsCurrent()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfilePositionUpsertOne) SetUpdatedAt(v time.Time) *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfilePositionUpsertOne) UpdateUpdatedAt() *ProfilePositionUpsertOne {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfilePositionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfilePositionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfilePositionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProfilePositionUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProfilePositionUpsertOne.ID is not supported by MySQL driver. Use ProfilePositionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProfilePositionUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProfilePositionCreateBulk is the builder for creating many ProfilePosition entities in bulk.
+type ProfilePositionCreateBulk struct {
+	config
+	err      error
+	builders []*ProfilePositionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProfilePosition entities in the database.
+func (ppcb *ProfilePositionCreateBulk) Save(ctx context.Context) ([]*ProfilePosition, error) {
+	if ppcb.err != nil {
+		return nil, ppcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ppcb.builders))
+	nodes := make([]*ProfilePosition, len(ppcb.builders))
+	mutators := make([]Mutator, len(ppcb.builders))
+	for i := range ppcb.builders {
+		func(i int, root context.Context) {
+			builder := ppcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProfilePositionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ppcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ppcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ppcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ppcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppcb *ProfilePositionCreateBulk) SaveX(ctx context.Context) []*ProfilePosition {
+	v, err := ppcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppcb *ProfilePositionCreateBulk) Exec(ctx context.Context) error {
+	_, err := ppcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppcb *ProfilePositionCreateBulk) ExecX(ctx context.Context) {
+	if err := ppcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfilePosition.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfilePositionUpsert) {
+//			SetPositionIndex(v+v).
+//		}).
+//		Exec(ctx)
+func (ppcb *ProfilePositionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProfilePositionUpsertBulk {
+	ppcb.conflict = opts
+	return &ProfilePositionUpsertBulk{
+		create: ppcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfilePosition.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ppcb *ProfilePositionCreateBulk) OnConflictColumns(columns ...string) *ProfilePositionUpsertBulk {
+	ppcb.conflict = append(ppcb.conflict, sql.ConflictColumns(columns...))
+	return &ProfilePositionUpsertBulk{
+		create: ppcb,
+	}
+}
+
+// ProfilePositionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProfilePosition nodes.
+type ProfilePositionUpsertBulk struct {
+	create *ProfilePositionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProfilePosition.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profileposition.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfilePositionUpsertBulk) UpdateNewValues() *ProfilePositionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(profileposition.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(profileposition.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfilePosition.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProfilePositionUpsertBulk) Ignore() *ProfilePositionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfilePositionUpsertBulk) DoNothing() *ProfilePositionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfilePositionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProfilePositionUpsertBulk) Update(set func(*ProfilePositionUpsert)) *ProfilePositionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfilePositionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPositionIndex sets the "position_index" field.
+func (u *ProfilePositionUpsertBulk) SetPositionIndex(v int) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetPositionIndex(v)
+	})
+}
+
+// AddPositionIndex adds v to the "position_index" field.
+func (u *ProfilePositionUpsertBulk) AddPositionIndex(v int) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.AddPositionIndex(v)
+	})
+}
+
+// UpdatePositionIndex sets the "position_index" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdatePositionIndex() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdatePositionIndex()
+	})
+}
+
+// SetCompanyName sets the "company_name" field.
+func (u *ProfilePositionUpsertBulk) SetCompanyName(v string) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetCompanyName(v)
+	})
+}
+
+// UpdateCompanyName sets the "company_name" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdateCompanyName() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateCompanyName()
+	})
+}
+
+// ClearCompanyName clears the value of the "company_name" field.
+func (u *ProfilePositionUpsertBulk) ClearCompanyName() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearCompanyName()
+	})
+}
+
+// SetCompanyUrn sets the "company_urn" field.
+func (u *ProfilePositionUpsertBulk) SetCompanyUrn(v string) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetCompanyUrn(v)
+	})
+}
+
+// UpdateCompanyUrn sets the "company_urn" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdateCompanyUrn() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateCompanyUrn()
+	})
+}
+
+// ClearCompanyUrn clears the value of the "company_urn" field.
+func (u *ProfilePositionUpsertBulk) ClearCompanyUrn() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearCompanyUrn()
+	})
+}
+
+// SetCompanyUsername sets the "company_username" field.
+func (u *ProfilePositionUpsertBulk) SetCompanyUsername(v string) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetCompanyUsername(v)
+	})
+}
+
+// UpdateCompanyUsername sets the "company_username" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdateCompanyUsername() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateCompanyUsername()
+	})
+}
+
+// ClearCompanyUsername clears the value of the "company_username" field.
+func (u *ProfilePositionUpsertBulk) ClearCompanyUsername() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearCompanyUsername()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ProfilePositionUpsertBulk) SetTitle(v string) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdateTitle() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *ProfilePositionUpsertBulk) ClearTitle() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetLocation sets the "location" field.
+func (u *ProfilePositionUpsertBulk) SetLocation(v string) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetLocation(v)
+	})
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdateLocation() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateLocation()
+	})
+}
+
+// ClearLocation clears the value of the "location" field.
+func (u *ProfilePositionUpsertBulk) ClearLocation() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearLocation()
+	})
+}
+
+// SetEmploymentType sets the "employment_type" field.
+func (u *ProfilePositionUpsertBulk) SetEmploymentType(v string) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetEmploymentType(v)
+	})
+}
+
+// UpdateEmploymentType sets the "employment_type" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdateEmploymentType() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateEmploymentType()
+	})
+}
+
+// ClearEmploymentType clears the value of the "employment_type" field.
+func (u *ProfilePositionUpsertBulk) ClearEmploymentType() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearEmploymentType()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ProfilePositionUpsertBulk) SetDescription(v string) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdateDescription() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ProfilePositionUpsertBulk) ClearDescription() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *ProfilePositionUpsertBulk) SetStartDate(v time.Time) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdateStartDate() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (u *ProfilePositionUpsertBulk) ClearStartDate() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *ProfilePositionUpsertBulk) SetEndDate(v time.Time) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdateEndDate() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *ProfilePositionUpsertBulk) ClearEndDate() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.ClearEndDate()
+	})
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (u *ProfilePositionUpsertBulk) SetIsCurrent(v bool) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetIsCurrent(v)
+	})
+}
+
+// UpdateIsCurrent sets the "is_current" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdateIsCurrent() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateIsCurrent()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfilePositionUpsertBulk) SetUpdatedAt(v time.Time) *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfilePositionUpsertBulk) UpdateUpdatedAt() *ProfilePositionUpsertBulk {
+	return u.Update(func(s *ProfilePositionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfilePositionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProfilePositionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfilePositionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfilePositionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
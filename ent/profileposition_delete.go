@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profileposition"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfilePositionDelete is the builder for deleting a ProfilePosition entity.
+type ProfilePositionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProfilePositionMutation
+}
+
+// Where appends a list predicates to the ProfilePositionDelete builder.
+func (ppd *ProfilePositionDelete) Where(ps ...predicate.ProfilePosition) *ProfilePositionDelete {
+	ppd.mutation.Where(ps...)
+	return ppd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ppd *ProfilePositionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ppd.sqlExec, ppd.mutation, ppd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppd *ProfilePositionDelete) ExecX(ctx context.Context) int {
+	n, err := ppd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ppd *ProfilePositionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(profileposition.Table, sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString))
+	if ps := ppd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ppd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ppd.mutation.done = true
+	return affected, err
+}
+
+// ProfilePositionDeleteOne is the builder for deleting a single ProfilePosition entity.
+type ProfilePositionDeleteOne struct {
+	ppd *ProfilePositionDelete
+}
+
+// Where appends a list predicates to the ProfilePositionDelete builder.
+func (ppdo *ProfilePositionDeleteOne) Where(ps ...predicate.ProfilePosition) *ProfilePositionDeleteOne {
+	ppdo.ppd.mutation.Where(ps...)
+	return ppdo
+}
+
+// Exec executes the deletion query.
+func (ppdo *ProfilePositionDeleteOne) Exec(ctx context.Context) error {
+	n, err := ppdo.ppd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{profileposition.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppdo *ProfilePositionDeleteOne) ExecX(ctx context.Context) {
+	if err := ppdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,733 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sheng-go-backend/ent/company"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/ent/schema/ulid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfilePositionQuery is the builder for querying ProfilePosition entities.
+type ProfilePositionQuery struct {
+	config
+	ctx         *QueryContext
+	order       []profileposition.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ProfilePosition
+	withProfile *ProfileQuery
+	withCompany *CompanyQuery
+	withFKs     bool
+	loadTotal   []func(context.Context, []*ProfilePosition) error
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProfilePositionQuery builder.
+func (ppq *ProfilePositionQuery) Where(ps ...predicate.ProfilePosition) *ProfilePositionQuery {
+	ppq.predicates = append(ppq.predicates, ps...)
+	return ppq
+}
+
+// Limit the number of records to be returned by this query.
+func (ppq *ProfilePositionQuery) Limit(limit int) *ProfilePositionQuery {
+	ppq.ctx.Limit = &limit
+	return ppq
+}
+
+// Offset to start from.
+func (ppq *ProfilePositionQuery) Offset(offset int) *ProfilePositionQuery {
+	ppq.ctx.Offset = &offset
+	return ppq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ppq *ProfilePositionQuery) Unique(unique bool) *ProfilePositionQuery {
+	ppq.ctx.Unique = &unique
+	return ppq
+}
+
+// Order specifies how the records should be ordered.
+func (ppq *ProfilePositionQuery) Order(o ...profileposition.OrderOption) *ProfilePositionQuery {
+	ppq.order = append(ppq.order, o...)
+	return ppq
+}
+
+// QueryProfile chains the current query on the "profile" edge.
+func (ppq *ProfilePositionQuery) QueryProfile() *ProfileQuery {
+	query := (&ProfileClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profileposition.Table, profileposition.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profileposition.ProfileTable, profileposition.ProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCompany chains the current query on the "company" edge.
+func (ppq *ProfilePositionQuery) QueryCompany() *CompanyQuery {
+	query := (&CompanyClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profileposition.Table, profileposition.FieldID, selector),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profileposition.CompanyTable, profileposition.CompanyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProfilePosition entity from the query.
+// Returns a *NotFoundError when no ProfilePosition was found.
+func (ppq *ProfilePositionQuery) First(ctx context.Context) (*ProfilePosition, error) {
+	nodes, err := ppq.Limit(1).All(setContextOp(ctx, ppq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{profileposition.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ppq *ProfilePositionQuery) FirstX(ctx context.Context) *ProfilePosition {
+	node, err := ppq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProfilePosition ID from the query.
+// Returns a *NotFoundError when no ProfilePosition ID was found.
+func (ppq *ProfilePositionQuery) FirstID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = ppq.Limit(1).IDs(setContextOp(ctx, ppq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{profileposition.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ppq *ProfilePositionQuery) FirstIDX(ctx context.Context) ulid.ID {
+	id, err := ppq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProfilePosition entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProfilePosition entity is found.
+// Returns a *NotFoundError when no ProfilePosition entities are found.
+func (ppq *ProfilePositionQuery) Only(ctx context.Context) (*ProfilePosition, error) {
+	nodes, err := ppq.Limit(2).All(setContextOp(ctx, ppq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{profileposition.Label}
+	default:
+		return nil, &NotSingularError{profileposition.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ppq *ProfilePositionQuery) OnlyX(ctx context.Context) *ProfilePosition {
+	node, err := ppq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProfilePosition ID in the query.
+// Returns a *NotSingularError when more than one ProfilePosition ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ppq *ProfilePositionQuery) OnlyID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = ppq.Limit(2).IDs(setContextOp(ctx, ppq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{profileposition.Label}
+	default:
+		err = &NotSingularError{profileposition.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ppq *ProfilePositionQuery) OnlyIDX(ctx context.Context) ulid.ID {
+	id, err := ppq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProfilePositions.
+func (ppq *ProfilePositionQuery) All(ctx context.Context) ([]*ProfilePosition, error) {
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryAll)
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProfilePosition, *ProfilePositionQuery]()
+	return withInterceptors[[]*ProfilePosition](ctx, ppq, qr, ppq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ppq *ProfilePositionQuery) AllX(ctx context.Context) []*ProfilePosition {
+	nodes, err := ppq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProfilePosition IDs.
+func (ppq *ProfilePositionQuery) IDs(ctx context.Context) (ids []ulid.ID, err error) {
+	if ppq.ctx.Unique == nil && ppq.path != nil {
+		ppq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryIDs)
+	if err = ppq.Select(profileposition.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ppq *ProfilePositionQuery) IDsX(ctx context.Context) []ulid.ID {
+	ids, err := ppq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ppq *ProfilePositionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryCount)
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ppq, querierCount[*ProfilePositionQuery](), ppq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ppq *ProfilePositionQuery) CountX(ctx context.Context) int {
+	count, err := ppq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ppq *ProfilePositionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryExist)
+	switch _, err := ppq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ppq *ProfilePositionQuery) ExistX(ctx context.Context) bool {
+	exist, err := ppq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProfilePositionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ppq *ProfilePositionQuery) Clone() *ProfilePositionQuery {
+	if ppq == nil {
+		return nil
+	}
+	return &ProfilePositionQuery{
+		config:      ppq.config,
+		ctx:         ppq.ctx.Clone(),
+		order:       append([]profileposition.OrderOption{}, ppq.order...),
+		inters:      append([]Interceptor{}, ppq.inters...),
+		predicates:  append([]predicate.ProfilePosition{}, ppq.predicates...),
+		withProfile: ppq.withProfile.Clone(),
+		withCompany: ppq.withCompany.Clone(),
+		// clone intermediate query.
+		sql:  ppq.sql.Clone(),
+		path: ppq.path,
+	}
+}
+
+// WithProfile tells the query-builder to eager-load the nodes that are connected to
+// the "profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProfilePositionQuery) WithProfile(opts ...func(*ProfileQuery)) *ProfilePositionQuery {
+	query := (&ProfileClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withProfile = query
+	return ppq
+}
+
+// WithCompany tells the query-builder to eager-load the nodes that are connected to
+// the "company" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProfilePositionQuery) WithCompany(opts ...func(*CompanyQuery)) *ProfilePositionQuery {
+	query := (&CompanyClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withCompany = query
+	return ppq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PositionIndex int `json:"position_index,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProfilePosition.Query().
+//		GroupBy(profileposition.FieldPositionIndex).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ppq *ProfilePositionQuery) GroupBy(field string, fields ...string) *ProfilePositionGroupBy {
+	ppq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProfilePositionGroupBy{build: ppq}
+	grbuild.flds = &ppq.ctx.Fields
+	grbuild.label = profileposition.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PositionIndex int `json:"position_index,omitempty"`
+//	}
+//
+//	client.ProfilePosition.Query().
+//		Select(profileposition.FieldPositionIndex).
+//		Scan(ctx, &v)
+func (ppq *ProfilePositionQuery) Select(fields ...string) *ProfilePositionSelect {
+	ppq.ctx.Fields = append(ppq.ctx.Fields, fields...)
+	sbuild := &ProfilePositionSelect{ProfilePositionQuery: ppq}
+	sbuild.label = profileposition.Label
+	sbuild.flds, sbuild.scan = &ppq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProfilePositionSelect configured with the given aggregations.
+func (ppq *ProfilePositionQuery) Aggregate(fns ...AggregateFunc) *ProfilePositionSelect {
+	return ppq.Select().Aggregate(fns...)
+}
+
+func (ppq *ProfilePositionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ppq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ppq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ppq.ctx.Fields {
+		if !profileposition.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ppq.path != nil {
+		prev, err := ppq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ppq.sql = prev
+	}
+	return nil
+}
+
+func (ppq *ProfilePositionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProfilePosition, error) {
+	var (
+		nodes       = []*ProfilePosition{}
+		withFKs     = ppq.withFKs
+		_spec       = ppq.querySpec()
+		loadedTypes = [2]bool{
+			ppq.withProfile != nil,
+			ppq.withCompany != nil,
+		}
+	)
+	if ppq.withProfile != nil || ppq.withCompany != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, profileposition.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProfilePosition).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProfilePosition{config: ppq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ppq.modifiers) > 0 {
+		_spec.Modifiers = ppq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ppq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ppq.withProfile; query != nil {
+		if err := ppq.loadProfile(ctx, query, nodes, nil,
+			func(n *ProfilePosition, e *Profile) { n.Edges.Profile = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ppq.withCompany; query != nil {
+		if err := ppq.loadCompany(ctx, query, nodes, nil,
+			func(n *ProfilePosition, e *Company) { n.Edges.Company = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range ppq.loadTotal {
+		if err := ppq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ppq *ProfilePositionQuery) loadProfile(ctx context.Context, query *ProfileQuery, nodes []*ProfilePosition, init func(*ProfilePosition), assign func(*ProfilePosition, *Profile)) error {
+	ids := make([]ulid.ID, 0, len(nodes))
+	nodeids := make(map[ulid.ID][]*ProfilePosition)
+	for i := range nodes {
+		if nodes[i].profile_profile_positions == nil {
+			continue
+		}
+		fk := *nodes[i].profile_profile_positions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(profile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "profile_profile_positions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ppq *ProfilePositionQuery) loadCompany(ctx context.Context, query *CompanyQuery, nodes []*ProfilePosition, init func(*ProfilePosition), assign func(*ProfilePosition, *Company)) error {
+	ids := make([]ulid.ID, 0, len(nodes))
+	nodeids := make(map[ulid.ID][]*ProfilePosition)
+	for i := range nodes {
+		if nodes[i].company_positions == nil {
+			continue
+		}
+		fk := *nodes[i].company_positions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(company.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "company_positions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ppq *ProfilePositionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ppq.querySpec()
+	if len(ppq.modifiers) > 0 {
+		_spec.Modifiers = ppq.modifiers
+	}
+	_spec.Node.Columns = ppq.ctx.Fields
+	if len(ppq.ctx.Fields) > 0 {
+		_spec.Unique = ppq.ctx.Unique != nil && *ppq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ppq.driver, _spec)
+}
+
+func (ppq *ProfilePositionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(profileposition.Table, profileposition.Columns, sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString))
+	_spec.From = ppq.sql
+	if unique := ppq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ppq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ppq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profileposition.FieldID)
+		for i := range fields {
+			if fields[i] != profileposition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ppq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ppq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ppq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ppq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ppq *ProfilePositionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ppq.driver.Dialect())
+	t1 := builder.Table(profileposition.Table)
+	columns := ppq.ctx.Fields
+	if len(columns) == 0 {
+		columns = profileposition.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ppq.sql != nil {
+		selector = ppq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ppq.ctx.Unique != nil && *ppq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ppq.modifiers {
+		m(selector)
+	}
+	for _, p := range ppq.predicates {
+		p(selector)
+	}
+	for _, p := range ppq.order {
+		p(selector)
+	}
+	if offset := ppq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ppq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ppq *ProfilePositionQuery) ForUpdate(opts ...sql.LockOption) *ProfilePositionQuery {
+	if ppq.driver.Dialect() == dialect.Postgres {
+		ppq.Unique(false)
+	}
+	ppq.modifiers = append(ppq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ppq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ppq *ProfilePositionQuery) ForShare(opts ...sql.LockOption) *ProfilePositionQuery {
+	if ppq.driver.Dialect() == dialect.Postgres {
+		ppq.Unique(false)
+	}
+	ppq.modifiers = append(ppq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ppq
+}
+
+// ProfilePositionGroupBy is the group-by builder for ProfilePosition entities.
+type ProfilePositionGroupBy struct {
+	selector
+	build *ProfilePositionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ppgb *ProfilePositionGroupBy) Aggregate(fns ...AggregateFunc) *ProfilePositionGroupBy {
+	ppgb.fns = append(ppgb.fns, fns...)
+	return ppgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ppgb *ProfilePositionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ppgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ppgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfilePositionQuery, *ProfilePositionGroupBy](ctx, ppgb.build, ppgb, ppgb.build.inters, v)
+}
+
+func (ppgb *ProfilePositionGroupBy) sqlScan(ctx context.Context, root *ProfilePositionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ppgb.fns))
+	for _, fn := range ppgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ppgb.flds)+len(ppgb.fns))
+		for _, f := range *ppgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ppgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ppgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProfilePositionSelect is the builder for selecting fields of ProfilePosition entities.
+type ProfilePositionSelect struct {
+	*ProfilePositionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pps *ProfilePositionSelect) Aggregate(fns ...AggregateFunc) *ProfilePositionSelect {
+	pps.fns = append(pps.fns, fns...)
+	return pps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pps *ProfilePositionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pps.ctx, ent.OpQuerySelect)
+	if err := pps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfilePositionQuery, *ProfilePositionSelect](ctx, pps.ProfilePositionQuery, pps, pps.inters, v)
+}
+
+func (pps *ProfilePositionSelect) sqlScan(ctx context.Context, root *ProfilePositionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pps.fns))
+	for _, fn := range pps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1003 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/company"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfilePositionUpdate is the builder for updating ProfilePosition entities.
+type ProfilePositionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProfilePositionMutation
+}
+
+// Where appends a list predicates to the ProfilePositionUpdate builder.
+func (ppu *ProfilePositionUpdate) Where(ps ...predicate.ProfilePosition) *ProfilePositionUpdate {
+	ppu.mutation.Where(ps...)
+	return ppu
+}
+
+// SetPositionIndex sets the "position_index" field.
+func (ppu *ProfilePositionUpdate) SetPositionIndex(i int) *ProfilePositionUpdate {
+	ppu.mutation.ResetPositionIndex()
+	ppu.mutation.SetPositionIndex(i)
+	return ppu
+}
+
+// SetNillablePositionIndex sets the "position_index" field if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillablePositionIndex(i *int) *ProfilePositionUpdate {
+	if i != nil {
+		ppu.SetPositionIndex(*i)
+	}
+	return ppu
+}
+
+// AddPositionIndex adds i to the "position_index" field.
+func (ppu *ProfilePositionUpdate) AddPositionIndex(i int) *ProfilePositionUpdate {
+	ppu.mutation.AddPositionIndex(i)
+	return ppu
+}
+
+// SetCompanyName sets the "company_name" field.
+func (ppu *ProfilePositionUpdate) SetCompanyName(s string) *ProfilePositionUpdate {
+	ppu.mutation.SetCompanyName(s)
+	return ppu
+}
+
+// SetNillableCompanyName sets the "company_name" field if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillableCompanyName(s *string) *ProfilePositionUpdate {
+	if s != nil {
+		ppu.SetCompanyName(*s)
+	}
+	return ppu
+}
+
+// ClearCompanyName clears the value of the "company_name" field.
+func (ppu *ProfilePositionUpdate) ClearCompanyName() *ProfilePositionUpdate {
+	ppu.mutation.ClearCompanyName()
+	return ppu
+}
+
+// SetCompanyUrn sets the "company_urn" field.
+func (ppu *ProfilePositionUpdate) SetCompanyUrn(s string) *ProfilePositionUpdate {
+	ppu.mutation.SetCompanyUrn(s)
+	return ppu
+}
+
+// SetNillableCompanyUrn sets the "company_urn" field if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillableCompanyUrn(s *string) *ProfilePositionUpdate {
+	if s != nil {
+		ppu.SetCompanyUrn(*s)
+	}
+	return ppu
+}
+
+// ClearCompanyUrn clears the value of the "company_urn" field.
+func (ppu *ProfilePositionUpdate) ClearCompanyUrn() *ProfilePositionUpdate {
+	ppu.mutation.ClearCompanyUrn()
+	return ppu
+}
+
+// SetCompanyUsername sets the "company_username" field.
+func (ppu *ProfilePositionUpdate) SetCompanyUsername(s string) *ProfilePositionUpdate {
+	ppu.mutation.SetCompanyUsername(s)
+	return ppu
+}
+
+// SetNillableCompanyUsername sets the "company_username" field if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillableCompanyUsername(s *string) *ProfilePositionUpdate {
+	if s != nil {
+		ppu.SetCompanyUsername(*s)
+	}
+	return ppu
+}
+
+// ClearCompanyUsername clears the value of the "company_username" field.
+func (ppu *ProfilePositionUpdate) ClearCompanyUsername() *ProfilePositionUpdate {
+	ppu.mutation.ClearCompanyUsername()
+	return ppu
+}
+
+// SetTitle sets the "title" field.
+func (ppu *ProfilePositionUpdate) SetTitle(s string) *ProfilePositionUpdate {
+	ppu.mutation.SetTitle(s)
+	return ppu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillableTitle(s *string) *ProfilePositionUpdate {
+	if s != nil {
+		ppu.SetTitle(*s)
+	}
+	return ppu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (ppu *ProfilePositionUpdate) ClearTitle() *ProfilePositionUpdate {
+	ppu.mutation.ClearTitle()
+	return ppu
+}
+
+// SetLocation sets the "location" field.
+func (ppu *ProfilePositionUpdate) SetLocation(s string) *ProfilePositionUpdate {
+	ppu.mutation.SetLocation(s)
+	return ppu
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillableLocation(s *string) *ProfilePositionUpdate {
+	if s != nil {
+		ppu.SetLocation(*s)
+	}
+	return ppu
+}
+
+// ClearLocation clears the value of the "location" field.
+func (ppu *ProfilePositionUpdate) ClearLocation() *ProfilePositionUpdate {
+	ppu.mutation.ClearLocation()
+	return ppu
+}
+
+// SetEmploymentType sets the "employment_type" field.
+func (ppu *ProfilePositionUpdate) SetEmploymentType(s string) *ProfilePositionUpdate {
+	ppu.mutation.SetEmploymentType(s)
+	return ppu
+}
+
+// SetNillableEmploymentType sets the "employment_type" field if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillableEmploymentType(s *string) *ProfilePositionUpdate {
+	if s != nil {
+		ppu.SetEmploymentType(*s)
+	}
+	return ppu
+}
+
+// ClearEmploymentType clears the value of the "employment_type" field.
+func (ppu *ProfilePositionUpdate) ClearEmploymentType() *ProfilePositionUpdate {
+	ppu.mutation.ClearEmploymentType()
+	return ppu
+}
+
+// SetDescription sets the "description" field.
+func (ppu *ProfilePositionUpdate) SetDescription(s string) *ProfilePositionUpdate {
+	ppu.mutation.SetDescription(s)
+	return ppu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillableDescription(s *string) *ProfilePositionUpdate {
+	if s != nil {
+		ppu.SetDescription(*s)
+	}
+	return ppu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ppu *ProfilePositionUpdate) ClearDescription() *ProfilePositionUpdate {
+	ppu.mutation.ClearDescription()
+	return ppu
+}
+
+// SetStartDate sets the "start_date" field.
+func (ppu *ProfilePositionUpdate) SetStartDate(t time.Time) *ProfilePositionUpdate {
+	ppu.mutation.SetStartDate(t)
+	return ppu
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillableStartDate(t *time.Time) *ProfilePositionUpdate {
+	if t != nil {
+		ppu.SetStartDate(*t)
+	}
+	return ppu
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (ppu *ProfilePositionUpdate) ClearStartDate() *ProfilePositionUpdate {
+	ppu.mutation.ClearStartDate()
+	return ppu
+}
+
+// SetEndDate sets the "end_date" field.
+func (ppu *ProfilePositionUpdate) SetEndDate(t time.Time) *ProfilePositionUpdate {
+	ppu.mutation.SetEndDate(t)
+	return ppu
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillableEndDate(t *time.Time) *ProfilePositionUpdate {
+	if t != nil {
+		ppu.SetEndDate(*t)
+	}
+	return ppu
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (ppu *ProfilePositionUpdate) ClearEndDate() *ProfilePositionUpdate {
+	ppu.mutation.ClearEndDate()
+	return ppu
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (ppu *ProfilePositionUpdate) SetIsCurrent(b bool) *ProfilePositionUpdate {
+	ppu.mutation.SetIsCurrent(b)
+	return ppu
+}
+
+// SetNillableIsCurrent sets the "is_current" field if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillableIsCurrent(b *bool) *ProfilePositionUpdate {
+	if b != nil {
+		ppu.SetIsCurrent(*b)
+	}
+	return ppu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ppu *ProfilePositionUpdate) SetUpdatedAt(t time.Time) *ProfilePositionUpdate {
+	ppu.mutation.SetUpdatedAt(t)
+	return ppu
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (ppu *ProfilePositionUpdate) SetProfileID(id ulid.ID) *ProfilePositionUpdate {
+	ppu.mutation.SetProfileID(id)
+	return ppu
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (ppu *ProfilePositionUpdate) SetProfile(p *Profile) *ProfilePositionUpdate {
+	return ppu.SetProfileID(p.ID)
+}
+
+// SetCompanyID sets the "company" edge to the Company entity by ID.
+func (ppu *ProfilePositionUpdate) SetCompanyID(id ulid.ID) *ProfilePositionUpdate {
+	ppu.mutation.SetCompanyID(id)
+	return ppu
+}
+
+// SetNillableCompanyID sets the "company" edge to the Company entity by ID if the given value is not nil.
+func (ppu *ProfilePositionUpdate) SetNillableCompanyID(id *ulid.ID) *ProfilePositionUpdate {
+	if id != nil {
+		ppu = ppu.SetCompanyID(*id)
+	}
+	return ppu
+}
+
+// SetCompany sets the "company" edge to the Company entity.
+func (ppu *ProfilePositionUpdate) SetCompany(c *Company) *ProfilePositionUpdate {
+	return ppu.SetCompanyID(c.ID)
+}
+
+// Mutation returns the ProfilePositionMutation object of the builder.
+func (ppu *ProfilePositionUpdate) Mutation() *ProfilePositionMutation {
+	return ppu.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (ppu *ProfilePositionUpdate) ClearProfile() *ProfilePositionUpdate {
+	ppu.mutation.ClearProfile()
+	return ppu
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (ppu *ProfilePositionUpdate) ClearCompany() *ProfilePositionUpdate {
+	ppu.mutation.ClearCompany()
+	return ppu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ppu *ProfilePositionUpdate) Save(ctx context.Context) (int, error) {
+	ppu.defaults()
+	return withHooks(ctx, ppu.sqlSave, ppu.mutation, ppu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppu *ProfilePositionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ppu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ppu *ProfilePositionUpdate) Exec(ctx context.Context) error {
+	_, err := ppu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppu *ProfilePositionUpdate) ExecX(ctx context.Context) {
+	if err := ppu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppu *ProfilePositionUpdate) defaults() {
+	if _, ok := ppu.mutation.UpdatedAt(); !ok {
+		v := profileposition.UpdateDefaultUpdatedAt()
+		ppu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppu *ProfilePositionUpdate) check() error {
+	if v, ok := ppu.mutation.PositionIndex(); ok {
+		if err := profileposition.PositionIndexValidator(v); err != nil {
+			return &ValidationError{Name: "position_index", err: fmt.Errorf(`ent: validator failed for field "ProfilePosition.position_index": %w`, err)}
+		}
+	}
+	if ppu.mutation.ProfileCleared() && len(ppu.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProfilePosition.profile"`)
+	}
+	return nil
+}
+
+func (ppu *ProfilePositionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ppu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profileposition.Table, profileposition.Columns, sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString))
+	if ps := ppu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppu.mutation.PositionIndex(); ok {
+		_spec.SetField(profileposition.FieldPositionIndex, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.AddedPositionIndex(); ok {
+		_spec.AddField(profileposition.FieldPositionIndex, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.CompanyName(); ok {
+		_spec.SetField(profileposition.FieldCompanyName, field.TypeString, value)
+	}
+	if ppu.mutation.CompanyNameCleared() {
+		_spec.ClearField(profileposition.FieldCompanyName, field.TypeString)
+	}
+	if value, ok := ppu.mutation.CompanyUrn(); ok {
+		_spec.SetField(profileposition.FieldCompanyUrn, field.TypeString, value)
+	}
+	if ppu.mutation.CompanyUrnCleared() {
+		_spec.ClearField(profileposition.FieldCompanyUrn, field.TypeString)
+	}
+	if value, ok := ppu.mutation.CompanyUsername(); ok {
+		_spec.SetField(profileposition.FieldCompanyUsername, field.TypeString, value)
+	}
+	if ppu.mutation.CompanyUsernameCleared() {
+		_spec.ClearField(profileposition.FieldCompanyUsername, field.TypeString)
+	}
+	if value, ok := ppu.mutation.Title(); ok {
+		_spec.SetField(profileposition.FieldTitle, field.TypeString, value)
+	}
+	if ppu.mutation.TitleCleared() {
+		_spec.ClearField(profileposition.FieldTitle, field.TypeString)
+	}
+	if value, ok := ppu.mutation.Location(); ok {
+		_spec.SetField(profileposition.FieldLocation, field.TypeString, value)
+	}
+	if ppu.mutation.LocationCleared() {
+		_spec.ClearField(profileposition.FieldLocation, field.TypeString)
+	}
+	if value, ok := ppu.mutation.EmploymentType(); ok {
+		_spec.SetField(profileposition.FieldEmploymentType, field.TypeString, value)
+	}
+	if ppu.mutation.EmploymentTypeCleared() {
+		_spec.ClearField(profileposition.FieldEmploymentType, field.TypeString)
+	}
+	if value, ok := ppu.mutation.Description(); ok {
+		_spec.SetField(profileposition.FieldDescription, field.TypeString, value)
+	}
+	if ppu.mutation.DescriptionCleared() {
+		_spec.ClearField(profileposition.FieldDescription, field.TypeString)
+	}
+	if value, ok := ppu.mutation.StartDate(); ok {
+		_spec.SetField(profileposition.FieldStartDate, field.TypeTime, value)
+	}
+	if ppu.mutation.StartDateCleared() {
+		_spec.ClearField(profileposition.FieldStartDate, field.TypeTime)
+	}
+	if value, ok := ppu.mutation.EndDate(); ok {
+		_spec.SetField(profileposition.FieldEndDate, field.TypeTime, value)
+	}
+	if ppu.mutation.EndDateCleared() {
+		_spec.ClearField(profileposition.FieldEndDate, field.TypeTime)
+	}
+	if value, ok := ppu.mutation.IsCurrent(); ok {
+		_spec.SetField(profileposition.FieldIsCurrent, field.TypeBool, value)
+	}
+	if value, ok := ppu.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileposition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ppu.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileposition.ProfileTable,
+			Columns: []string{profileposition.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileposition.ProfileTable,
+			Columns: []string{profileposition.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppu.mutation.CompanyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileposition.CompanyTable,
+			Columns: []string{profileposition.CompanyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.CompanyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileposition.CompanyTable,
+			Columns: []string{profileposition.CompanyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profileposition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ppu.mutation.done = true
+	return n, nil
+}
+
+// ProfilePositionUpdateOne is the builder for updating a single ProfilePosition entity.
+type ProfilePositionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProfilePositionMutation
+}
+
+// SetPositionIndex sets the "position_index" field.
+func (ppuo *ProfilePositionUpdateOne) SetPositionIndex(i int) *ProfilePositionUpdateOne {
+	ppuo.mutation.ResetPositionIndex()
+	ppuo.mutation.SetPositionIndex(i)
+	return ppuo
+}
+
+// SetNillablePositionIndex sets the "position_index" field if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillablePositionIndex(i *int) *ProfilePositionUpdateOne {
+	if i != nil {
+		ppuo.SetPositionIndex(*i)
+	}
+	return ppuo
+}
+
+// AddPositionIndex adds i to the "position_index" field.
+func (ppuo *ProfilePositionUpdateOne) AddPositionIndex(i int) *ProfilePositionUpdateOne {
+	ppuo.mutation.AddPositionIndex(i)
+	return ppuo
+}
+
+// SetCompanyName sets the "company_name" field.
+func (ppuo *ProfilePositionUpdateOne) SetCompanyName(s string) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetCompanyName(s)
+	return ppuo
+}
+
+// SetNillableCompanyName sets the "company_name" field if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillableCompanyName(s *string) *ProfilePositionUpdateOne {
+	if s != nil {
+		ppuo.SetCompanyName(*s)
+	}
+	return ppuo
+}
+
+// ClearCompanyName clears the value of the "company_name" field.
+func (ppuo *ProfilePositionUpdateOne) ClearCompanyName() *ProfilePositionUpdateOne {
+	ppuo.mutation.ClearCompanyName()
+	return ppuo
+}
+
+// SetCompanyUrn sets the "company_urn" field.
+func (ppuo *ProfilePositionUpdateOne) SetCompanyUrn(s string) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetCompanyUrn(s)
+	return ppuo
+}
+
+// SetNillableCompanyUrn sets the "company_urn" field if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillableCompanyUrn(s *string) *ProfilePositionUpdateOne {
+	if s != nil {
+		ppuo.SetCompanyUrn(*s)
+	}
+	return ppuo
+}
+
+// ClearCompanyUrn clears the value of the "company_urn" field.
+func (ppuo *ProfilePositionUpdateOne) ClearCompanyUrn() *ProfilePositionUpdateOne {
+	ppuo.mutation.ClearCompanyUrn()
+	return ppuo
+}
+
+// SetCompanyUsername sets the "company_username" field.
+func (ppuo *ProfilePositionUpdateOne) SetCompanyUsername(s string) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetCompanyUsername(s)
+	return ppuo
+}
+
+// SetNillableCompanyUsername sets the "company_username" field if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillableCompanyUsername(s *string) *ProfilePositionUpdateOne {
+	if s != nil {
+		ppuo.SetCompanyUsername(*s)
+	}
+	return ppuo
+}
+
+// ClearCompanyUsername clears the value of the "company_username" field.
+func (ppuo *ProfilePositionUpdateOne) ClearCompanyUsername() *ProfilePositionUpdateOne {
+	ppuo.mutation.ClearCompanyUsername()
+	return ppuo
+}
+
+// SetTitle sets the "title" field.
+func (ppuo *ProfilePositionUpdateOne) SetTitle(s string) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetTitle(s)
+	return ppuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillableTitle(s *string) *ProfilePositionUpdateOne {
+	if s != nil {
+		ppuo.SetTitle(*s)
+	}
+	return ppuo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (ppuo *ProfilePositionUpdateOne) ClearTitle() *ProfilePositionUpdateOne {
+	ppuo.mutation.ClearTitle()
+	return ppuo
+}
+
+// SetLocation sets the "location" field.
+func (ppuo *ProfilePositionUpdateOne) SetLocation(s string) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetLocation(s)
+	return ppuo
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillableLocation(s *string) *ProfilePositionUpdateOne {
+	if s != nil {
+		ppuo.SetLocation(*s)
+	}
+	return ppuo
+}
+
+// ClearLocation clears the value of the "location" field.
+func (ppuo *ProfilePositionUpdateOne) ClearLocation() *ProfilePositionUpdateOne {
+	ppuo.mutation.ClearLocation()
+	return ppuo
+}
+
+// SetEmploymentType sets the "employment_type" field.
+func (ppuo *ProfilePositionUpdateOne) SetEmploymentType(s string) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetEmploymentType(s)
+	return ppuo
+}
+
+// SetNillableEmploymentType sets the "employment_type" field if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillableEmploymentType(s *string) *ProfilePositionUpdateOne {
+	if s != nil {
+		ppuo.SetEmploymentType(*s)
+	}
+	return ppuo
+}
+
+// ClearEmploymentType clears the value of the "employment_type" field.
+func (ppuo *ProfilePositionUpdateOne) ClearEmploymentType() *ProfilePositionUpdateOne {
+	ppuo.mutation.ClearEmploymentType()
+	return ppuo
+}
+
+// SetDescription sets the "description" field.
+func (ppuo *ProfilePositionUpdateOne) SetDescription(s string) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetDescription(s)
+	return ppuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillableDescription(s *string) *ProfilePositionUpdateOne {
+	if s != nil {
+		ppuo.SetDescription(*s)
+	}
+	return ppuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ppuo *ProfilePositionUpdateOne) ClearDescription() *ProfilePositionUpdateOne {
+	ppuo.mutation.ClearDescription()
+	return ppuo
+}
+
+// SetStartDate sets the "start_date" field.
+func (ppuo *ProfilePositionUpdateOne) SetStartDate(t time.Time) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetStartDate(t)
+	return ppuo
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillableStartDate(t *time.Time) *ProfilePositionUpdateOne {
+	if t != nil {
+		ppuo.SetStartDate(*t)
+	}
+	return ppuo
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (ppuo *ProfilePositionUpdateOne) ClearStartDate() *ProfilePositionUpdateOne {
+	ppuo.mutation.ClearStartDate()
+	return ppuo
+}
+
+// SetEndDate sets the "end_date" field.
+func (ppuo *ProfilePositionUpdateOne) SetEndDate(t time.Time) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetEndDate(t)
+	return ppuo
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillableEndDate(t *time.Time) *ProfilePositionUpdateOne {
+	if t != nil {
+		ppuo.SetEndDate(*t)
+	}
+	return ppuo
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (ppuo *ProfilePositionUpdateOne) ClearEndDate() *ProfilePositionUpdateOne {
+	ppuo.mutation.ClearEndDate()
+	return ppuo
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (ppuo *ProfilePositionUpdateOne) SetIsCurrent(b bool) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetIsCurrent(b)
+	return ppuo
+}
+
+// SetNillableIsCurrent sets the "is_current" field if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillableIsCurrent(b *bool) *ProfilePositionUpdateOne {
+	if b != nil {
+		ppuo.SetIsCurrent(*b)
+	}
+	return ppuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ppuo *ProfilePositionUpdateOne) SetUpdatedAt(t time.Time) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetUpdatedAt(t)
+	return ppuo
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (ppuo *ProfilePositionUpdateOne) SetProfileID(id ulid.ID) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetProfileID(id)
+	return ppuo
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (ppuo *ProfilePositionUpdateOne) SetProfile(p *Profile) *ProfilePositionUpdateOne {
+	return ppuo.SetProfileID(p.ID)
+}
+
+// SetCompanyID sets the "company" edge to the Company entity by ID.
+func (ppuo *ProfilePositionUpdateOne) SetCompanyID(id ulid.ID) *ProfilePositionUpdateOne {
+	ppuo.mutation.SetCompanyID(id)
+	return ppuo
+}
+
+// SetNillableCompanyID sets the "company" edge to the Company entity by ID if the given value is not nil.
+func (ppuo *ProfilePositionUpdateOne) SetNillableCompanyID(id *ulid.ID) *ProfilePositionUpdateOne {
+	if id != nil {
+		ppuo = ppuo.SetCompanyID(*id)
+	}
+	return ppuo
+}
+
+// SetCompany sets the "company" edge to the Company entity.
+func (ppuo *ProfilePositionUpdateOne) SetCompany(c *Company) *ProfilePositionUpdateOne {
+	return ppuo.SetCompanyID(c.ID)
+}
+
+// Mutation returns the ProfilePositionMutation object of the builder.
+func (ppuo *ProfilePositionUpdateOne) Mutation() *ProfilePositionMutation {
+	return ppuo.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (ppuo *ProfilePositionUpdateOne) ClearProfile() *ProfilePositionUpdateOne {
+	ppuo.mutation.ClearProfile()
+	return ppuo
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (ppuo *ProfilePositionUpdateOne) ClearCompany() *ProfilePositionUpdateOne {
+	ppuo.mutation.ClearCompany()
+	return ppuo
+}
+
+// Where appends a list predicates to the ProfilePositionUpdate builder.
+func (ppuo *ProfilePositionUpdateOne) Where(ps ...predicate.ProfilePosition) *ProfilePositionUpdateOne {
+	ppuo.mutation.Where(ps...)
+	return ppuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ppuo *ProfilePositionUpdateOne) Select(field string, fields ...string) *ProfilePositionUpdateOne {
+	ppuo.fields = append([]string{field}, fields...)
+	return ppuo
+}
+
+// Save executes the query and returns the updated ProfilePosition entity.
+func (ppuo *ProfilePositionUpdateOne) Save(ctx context.Context) (*ProfilePosition, error) {
+	ppuo.defaults()
+	return withHooks(ctx, ppuo.sqlSave, ppuo.mutation, ppuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppuo *ProfilePositionUpdateOne) SaveX(ctx context.Context) *ProfilePosition {
+	node, err := ppuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ppuo *ProfilePositionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ppuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppuo *ProfilePositionUpdateOne) ExecX(ctx context.Context) {
+	if err := ppuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppuo *ProfilePositionUpdateOne) defaults() {
+	if _, ok := ppuo.mutation.UpdatedAt(); !ok {
+		v := profileposition.UpdateDefaultUpdatedAt()
+		ppuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppuo *ProfilePositionUpdateOne) check() error {
+	if v, ok := ppuo.mutation.PositionIndex(); ok {
+		if err := profileposition.PositionIndexValidator(v); err != nil {
+			return &ValidationError{Name: "position_index", err: fmt.Errorf(`ent: validator failed for field "ProfilePosition.position_index": %w`, err)}
+		}
+	}
+	if ppuo.mutation.ProfileCleared() && len(ppuo.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProfilePosition.profile"`)
+	}
+	return nil
+}
+
+func (ppuo *ProfilePositionUpdateOne) sqlSave(ctx context.Context) (_node *ProfilePosition, err error) {
+	if err := ppuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profileposition.Table, profileposition.Columns, sqlgraph.NewFieldSpec(profileposition.FieldID, field.TypeString))
+	id, ok := ppuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProfilePosition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ppuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profileposition.FieldID)
+		for _, f := range fields {
+			if !profileposition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != profileposition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ppuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppuo.mutation.PositionIndex(); ok {
+		_spec.SetField(profileposition.FieldPositionIndex, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.AddedPositionIndex(); ok {
+		_spec.AddField(profileposition.FieldPositionIndex, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.CompanyName(); ok {
+		_spec.SetField(profileposition.FieldCompanyName, field.TypeString, value)
+	}
+	if ppuo.mutation.CompanyNameCleared() {
+		_spec.ClearField(profileposition.FieldCompanyName, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.CompanyUrn(); ok {
+		_spec.SetField(profileposition.FieldCompanyUrn, field.TypeString, value)
+	}
+	if ppuo.mutation.CompanyUrnCleared() {
+		_spec.ClearField(profileposition.FieldCompanyUrn, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.CompanyUsername(); ok {
+		_spec.SetField(profileposition.FieldCompanyUsername, field.TypeString, value)
+	}
+	if ppuo.mutation.CompanyUsernameCleared() {
+		_spec.ClearField(profileposition.FieldCompanyUsername, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.Title(); ok {
+		_spec.SetField(profileposition.FieldTitle, field.TypeString, value)
+	}
+	if ppuo.mutation.TitleCleared() {
+		_spec.ClearField(profileposition.FieldTitle, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.Location(); ok {
+		_spec.SetField(profileposition.FieldLocation, field.TypeString, value)
+	}
+	if ppuo.mutation.LocationCleared() {
+		_spec.ClearField(profileposition.FieldLocation, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.EmploymentType(); ok {
+		_spec.SetField(profileposition.FieldEmploymentType, field.TypeString, value)
+	}
+	if ppuo.mutation.EmploymentTypeCleared() {
+		_spec.ClearField(profileposition.FieldEmploymentType, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.Description(); ok {
+		_spec.SetField(profileposition.FieldDescription, field.TypeString, value)
+	}
+	if ppuo.mutation.DescriptionCleared() {
+		_spec.ClearField(profileposition.FieldDescription, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.StartDate(); ok {
+		_spec.SetField(profileposition.FieldStartDate, field.TypeTime, value)
+	}
+	if ppuo.mutation.StartDateCleared() {
+		_spec.ClearField(profileposition.FieldStartDate, field.TypeTime)
+	}
+	if value, ok := ppuo.mutation.EndDate(); ok {
+		_spec.SetField(profileposition.FieldEndDate, field.TypeTime, value)
+	}
+	if ppuo.mutation.EndDateCleared() {
+		_spec.ClearField(profileposition.FieldEndDate, field.TypeTime)
+	}
+	if value, ok := ppuo.mutation.IsCurrent(); ok {
+		_spec.SetField(profileposition.FieldIsCurrent, field.TypeBool, value)
+	}
+	if value, ok := ppuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileposition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ppuo.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileposition.ProfileTable,
+			Columns: []string{profileposition.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileposition.ProfileTable,
+			Columns: []string{profileposition.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppuo.mutation.CompanyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileposition.CompanyTable,
+			Columns: []string{profileposition.CompanyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.CompanyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileposition.CompanyTable,
+			Columns: []string{profileposition.CompanyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProfilePosition{config: ppuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ppuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profileposition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ppuo.mutation.done = true
+	return _node, nil
+}
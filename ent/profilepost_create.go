@@ -11,6 +11,8 @@ import (
 	"sheng-go-backend/ent/schema/ulid"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +22,7 @@ type ProfilePostCreate struct {
 	config
 	mutation *ProfilePostMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetProfileUsername sets the "profile_username" field.
@@ -240,6 +243,7 @@ func (ppc *ProfilePostCreate) createSpec() (*ProfilePost, *sqlgraph.CreateSpec)
 		_node = &ProfilePost{config: ppc.config}
 		_spec = sqlgraph.NewCreateSpec(profilepost.Table, sqlgraph.NewFieldSpec(profilepost.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = ppc.conflict
 	if id, ok := ppc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -287,11 +291,306 @@ func (ppc *ProfilePostCreate) createSpec() (*ProfilePost, *sqlgraph.CreateSpec)
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfilePost.Create().
+//		SetProfileUsername(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfilePostUpsert) {
+//			SetProfileUsername(v+v).
+//		}).
+//		Exec(ctx)
+func (ppc *ProfilePostCreate) OnConflict(opts ...sql.ConflictOption) *ProfilePostUpsertOne {
+	ppc.conflict = opts
+	return &ProfilePostUpsertOne{
+		create: ppc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfilePost.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ppc *ProfilePostCreate) OnConflictColumns(columns ...string) *ProfilePostUpsertOne {
+	ppc.conflict = append(ppc.conflict, sql.ConflictColumns(columns...))
+	return &ProfilePostUpsertOne{
+		create: ppc,
+	}
+}
+
+type (
+	// ProfilePostUpsertOne is the builder for "upsert"-ing
+	//  one ProfilePost node.
+	ProfilePostUpsertOne struct {
+		create *ProfilePostCreate
+	}
+
+	// ProfilePostUpsert is the "OnConflict" setter.
+	ProfilePostUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProfileUsername sets the "profile_username" field.
+func (u *ProfilePostUpsert) SetProfileUsername(v string) *ProfilePostUpsert {
+	u.Set(profilepost.FieldProfileUsername, v)
+	return u
+}
+
+// UpdateProfileUsername sets the "profile_username" field to the value that was provided on create.
+func (u *ProfilePostUpsert) UpdateProfileUsername() *ProfilePostUpsert {
+	u.SetExcluded(profilepost.FieldProfileUsername)
+	return u
+}
+
+// SetFetchStatus sets the "fetch_status" field.
+func (u *ProfilePostUpsert) SetFetchStatus(v profilepost.FetchStatus) *ProfilePostUpsert {
+	u.Set(profilepost.FieldFetchStatus, v)
+	return u
+}
+
+// UpdateFetchStatus sets the "fetch_status" field to the value that was provided on create.
+func (u *ProfilePostUpsert) UpdateFetchStatus() *ProfilePostUpsert {
+	u.SetExcluded(profilepost.FieldFetchStatus)
+	return u
+}
+
+// SetS3Key sets the "s3_key" field.
+func (u *ProfilePostUpsert) SetS3Key(v string) *ProfilePostUpsert {
+	u.Set(profilepost.FieldS3Key, v)
+	return u
+}
+
+// UpdateS3Key sets the "s3_key" field to the value that was provided on create.
+func (u *ProfilePostUpsert) UpdateS3Key() *ProfilePostUpsert {
+	u.SetExcluded(profilepost.FieldS3Key)
+	return u
+}
+
+// ClearS3Key clears the value of the "s3_key" field.
+func (u *ProfilePostUpsert) ClearS3Key() *ProfilePostUpsert {
+	u.SetNull(profilepost.FieldS3Key)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *ProfilePostUpsert) SetErrorMessage(v string) *ProfilePostUpsert {
+	u.Set(profilepost.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *ProfilePostUpsert) UpdateErrorMessage() *ProfilePostUpsert {
+	u.SetExcluded(profilepost.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *ProfilePostUpsert) ClearErrorMessage() *ProfilePostUpsert {
+	u.SetNull(profilepost.FieldErrorMessage)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfilePostUpsert) SetUpdatedAt(v time.Time) *ProfilePostUpsert {
+	u.Set(profilepost.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfilePostUpsert) UpdateUpdatedAt() *ProfilePostUpsert {
+	u.SetExcluded(profilepost.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProfilePost.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profilepost.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfilePostUpsertOne) UpdateNewValues() *ProfilePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(profilepost.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(profilepost.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfilePost.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProfilePostUpsertOne) Ignore() *ProfilePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfilePostUpsertOne) DoNothing() *ProfilePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfilePostCreate.OnConflict
+// documentation for more info.
+func (u *ProfilePostUpsertOne) Update(set func(*ProfilePostUpsert)) *ProfilePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfilePostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileUsername sets the "profile_username" field.
+func (u *ProfilePostUpsertOne) SetProfileUsername(v string) *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.SetProfileUsername(v)
+	})
+}
+
+// UpdateProfileUsername sets the "profile_username" field to the value that was provided on create.
+func (u *ProfilePostUpsertOne) UpdateProfileUsername() *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.UpdateProfileUsername()
+	})
+}
+
+// SetFetchStatus sets the "fetch_status" field.
+func (u *ProfilePostUpsertOne) SetFetchStatus(v profilepost.FetchStatus) *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.SetFetchStatus(v)
+	})
+}
+
+// UpdateFetchStatus sets the "fetch_status" field to the value that was provided on create.
+func (u *ProfilePostUpsertOne) UpdateFetchStatus() *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.UpdateFetchStatus()
+	})
+}
+
+// SetS3Key sets the "s3_key" field.
+func (u *ProfilePostUpsertOne) SetS3Key(v string) *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.SetS3Key(v)
+	})
+}
+
+// UpdateS3Key sets the "s3_key" field to the value that was provided on create.
+func (u *ProfilePostUpsertOne) UpdateS3Key() *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.UpdateS3Key()
+	})
+}
+
+// ClearS3Key clears the value of the "s3_key" field.
+func (u *ProfilePostUpsertOne) ClearS3Key() *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.ClearS3Key()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *ProfilePostUpsertOne) SetErrorMessage(v string) *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *ProfilePostUpsertOne) UpdateErrorMessage() *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *ProfilePostUpsertOne) ClearErrorMessage() *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfilePostUpsertOne) SetUpdatedAt(v time.Time) *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfilePostUpsertOne) UpdateUpdatedAt() *ProfilePostUpsertOne {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfilePostUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfilePostCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfilePostUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProfilePostUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProfilePostUpsertOne.ID is not supported by MySQL driver. Use ProfilePostUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProfilePostUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ProfilePostCreateBulk is the builder for creating many ProfilePost entities in bulk.
 type ProfilePostCreateBulk struct {
 	config
 	err      error
 	builders []*ProfilePostCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the ProfilePost entities in the database.
@@ -321,6 +620,7 @@ func (ppcb *ProfilePostCreateBulk) Save(ctx context.Context) ([]*ProfilePost, er
 					_, err = mutators[i+1].Mutate(root, ppcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ppcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ppcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -370,3 +670,204 @@ func (ppcb *ProfilePostCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfilePost.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfilePostUpsert) {
+//			SetProfileUsername(v+v).
+//		}).
+//		Exec(ctx)
+func (ppcb *ProfilePostCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProfilePostUpsertBulk {
+	ppcb.conflict = opts
+	return &ProfilePostUpsertBulk{
+		create: ppcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfilePost.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ppcb *ProfilePostCreateBulk) OnConflictColumns(columns ...string) *ProfilePostUpsertBulk {
+	ppcb.conflict = append(ppcb.conflict, sql.ConflictColumns(columns...))
+	return &ProfilePostUpsertBulk{
+		create: ppcb,
+	}
+}
+
+// ProfilePostUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProfilePost nodes.
+type ProfilePostUpsertBulk struct {
+	create *ProfilePostCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProfilePost.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profilepost.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfilePostUpsertBulk) UpdateNewValues() *ProfilePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(profilepost.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(profilepost.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfilePost.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProfilePostUpsertBulk) Ignore() *ProfilePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfilePostUpsertBulk) DoNothing() *ProfilePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfilePostCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProfilePostUpsertBulk) Update(set func(*ProfilePostUpsert)) *ProfilePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfilePostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileUsername sets the "profile_username" field.
+func (u *ProfilePostUpsertBulk) SetProfileUsername(v string) *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.SetProfileUsername(v)
+	})
+}
+
+// UpdateProfileUsername sets the "profile_username" field to the value that was provided on create.
+func (u *ProfilePostUpsertBulk) UpdateProfileUsername() *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.UpdateProfileUsername()
+	})
+}
+
+// SetFetchStatus sets the "fetch_status" field.
+func (u *ProfilePostUpsertBulk) SetFetchStatus(v profilepost.FetchStatus) *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.SetFetchStatus(v)
+	})
+}
+
+// UpdateFetchStatus sets the "fetch_status" field to the value that was provided on create.
+func (u *ProfilePostUpsertBulk) UpdateFetchStatus() *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.UpdateFetchStatus()
+	})
+}
+
+// SetS3Key sets the "s3_key" field.
+func (u *ProfilePostUpsertBulk) SetS3Key(v string) *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.SetS3Key(v)
+	})
+}
+
+// UpdateS3Key sets the "s3_key" field to the value that was provided on create.
+func (u *ProfilePostUpsertBulk) UpdateS3Key() *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.UpdateS3Key()
+	})
+}
+
+// ClearS3Key clears the value of the "s3_key" field.
+func (u *ProfilePostUpsertBulk) ClearS3Key() *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.ClearS3Key()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *ProfilePostUpsertBulk) SetErrorMessage(v string) *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *ProfilePostUpsertBulk) UpdateErrorMessage() *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *ProfilePostUpsertBulk) ClearErrorMessage() *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfilePostUpsertBulk) SetUpdatedAt(v time.Time) *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfilePostUpsertBulk) UpdateUpdatedAt() *ProfilePostUpsertBulk {
+	return u.Update(func(s *ProfilePostUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfilePostUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProfilePostCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfilePostCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfilePostUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"sheng-go-backend/ent/schema/ulid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,8 +27,8 @@ type ProfilePostQuery struct {
 	inters         []Interceptor
 	predicates     []predicate.ProfilePost
 	withItems      *ProfilePostItemQuery
-	modifiers      []func(*sql.Selector)
 	loadTotal      []func(context.Context, []*ProfilePost) error
+	modifiers      []func(*sql.Selector)
 	withNamedItems map[string]*ProfilePostItemQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -521,6 +522,9 @@ func (ppq *ProfilePostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ppq.ctx.Unique != nil && *ppq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ppq.modifiers {
+		m(selector)
+	}
 	for _, p := range ppq.predicates {
 		p(selector)
 	}
@@ -538,6 +542,32 @@ func (ppq *ProfilePostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ppq *ProfilePostQuery) ForUpdate(opts ...sql.LockOption) *ProfilePostQuery {
+	if ppq.driver.Dialect() == dialect.Postgres {
+		ppq.Unique(false)
+	}
+	ppq.modifiers = append(ppq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ppq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ppq *ProfilePostQuery) ForShare(opts ...sql.LockOption) *ProfilePostQuery {
+	if ppq.driver.Dialect() == dialect.Postgres {
+		ppq.Unique(false)
+	}
+	ppq.modifiers = append(ppq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ppq
+}
+
 // WithNamedItems tells the query-builder to eager-load the nodes that are connected to the "items"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (ppq *ProfilePostQuery) WithNamedItems(name string, opts ...func(*ProfilePostItemQuery)) *ProfilePostQuery {
@@ -11,6 +11,8 @@ import (
 	"sheng-go-backend/ent/schema/ulid"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +22,7 @@ type ProfilePostItemCreate struct {
 	config
 	mutation *ProfilePostItemMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetProfileUsername sets the "profile_username" field.
@@ -490,6 +493,7 @@ func (ppic *ProfilePostItemCreate) createSpec() (*ProfilePostItem, *sqlgraph.Cre
 		_node = &ProfilePostItem{config: ppic.config}
 		_spec = sqlgraph.NewCreateSpec(profilepostitem.Table, sqlgraph.NewFieldSpec(profilepostitem.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = ppic.conflict
 	if id, ok := ppic.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -586,11 +590,774 @@ func (ppic *ProfilePostItemCreate) createSpec() (*ProfilePostItem, *sqlgraph.Cre
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfilePostItem.Create().
+//		SetProfileUsername(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfilePostItemUpsert) {
+//			SetProfileUsername(v+v).
+//		}).
+//		Exec(ctx)
+func (ppic *ProfilePostItemCreate) OnConflict(opts ...sql.ConflictOption) *ProfilePostItemUpsertOne {
+	ppic.conflict = opts
+	return &ProfilePostItemUpsertOne{
+		create: ppic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfilePostItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ppic *ProfilePostItemCreate) OnConflictColumns(columns ...string) *ProfilePostItemUpsertOne {
+	ppic.conflict = append(ppic.conflict, sql.ConflictColumns(columns...))
+	return &ProfilePostItemUpsertOne{
+		create: ppic,
+	}
+}
+
+type (
+	// ProfilePostItemUpsertOne is the builder for "upsert"-ing
+	//  one ProfilePostItem node.
+	ProfilePostItemUpsertOne struct {
+		create *ProfilePostItemCreate
+	}
+
+	// ProfilePostItemUpsert is the "OnConflict" setter.
+	ProfilePostItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProfileUsername sets the "profile_username" field.
+func (u *ProfilePostItemUpsert) SetProfileUsername(v string) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldProfileUsername, v)
+	return u
+}
+
+// UpdateProfileUsername sets the "profile_username" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateProfileUsername() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldProfileUsername)
+	return u
+}
+
+// SetPostUrn sets the "post_urn" field.
+func (u *ProfilePostItemUpsert) SetPostUrn(v string) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldPostUrn, v)
+	return u
+}
+
+// UpdatePostUrn sets the "post_urn" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdatePostUrn() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldPostUrn)
+	return u
+}
+
+// ClearPostUrn clears the value of the "post_urn" field.
+func (u *ProfilePostItemUpsert) ClearPostUrn() *ProfilePostItemUpsert {
+	u.SetNull(profilepostitem.FieldPostUrn)
+	return u
+}
+
+// SetPostURL sets the "post_url" field.
+func (u *ProfilePostItemUpsert) SetPostURL(v string) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldPostURL, v)
+	return u
+}
+
+// UpdatePostURL sets the "post_url" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdatePostURL() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldPostURL)
+	return u
+}
+
+// ClearPostURL clears the value of the "post_url" field.
+func (u *ProfilePostItemUpsert) ClearPostURL() *ProfilePostItemUpsert {
+	u.SetNull(profilepostitem.FieldPostURL)
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *ProfilePostItemUpsert) SetText(v string) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateText() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldText)
+	return u
+}
+
+// ClearText clears the value of the "text" field.
+func (u *ProfilePostItemUpsert) ClearText() *ProfilePostItemUpsert {
+	u.SetNull(profilepostitem.FieldText)
+	return u
+}
+
+// SetContentType sets the "content_type" field.
+func (u *ProfilePostItemUpsert) SetContentType(v string) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldContentType, v)
+	return u
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateContentType() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldContentType)
+	return u
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (u *ProfilePostItemUpsert) ClearContentType() *ProfilePostItemUpsert {
+	u.SetNull(profilepostitem.FieldContentType)
+	return u
+}
+
+// SetIsRepost sets the "is_repost" field.
+func (u *ProfilePostItemUpsert) SetIsRepost(v bool) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldIsRepost, v)
+	return u
+}
+
+// UpdateIsRepost sets the "is_repost" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateIsRepost() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldIsRepost)
+	return u
+}
+
+// SetTotalReactions sets the "total_reactions" field.
+func (u *ProfilePostItemUpsert) SetTotalReactions(v int) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldTotalReactions, v)
+	return u
+}
+
+// UpdateTotalReactions sets the "total_reactions" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateTotalReactions() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldTotalReactions)
+	return u
+}
+
+// AddTotalReactions adds v to the "total_reactions" field.
+func (u *ProfilePostItemUpsert) AddTotalReactions(v int) *ProfilePostItemUpsert {
+	u.Add(profilepostitem.FieldTotalReactions, v)
+	return u
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *ProfilePostItemUpsert) SetLikeCount(v int) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldLikeCount, v)
+	return u
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateLikeCount() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldLikeCount)
+	return u
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *ProfilePostItemUpsert) AddLikeCount(v int) *ProfilePostItemUpsert {
+	u.Add(profilepostitem.FieldLikeCount, v)
+	return u
+}
+
+// SetCommentsCount sets the "comments_count" field.
+func (u *ProfilePostItemUpsert) SetCommentsCount(v int) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldCommentsCount, v)
+	return u
+}
+
+// UpdateCommentsCount sets the "comments_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateCommentsCount() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldCommentsCount)
+	return u
+}
+
+// AddCommentsCount adds v to the "comments_count" field.
+func (u *ProfilePostItemUpsert) AddCommentsCount(v int) *ProfilePostItemUpsert {
+	u.Add(profilepostitem.FieldCommentsCount, v)
+	return u
+}
+
+// SetRepostsCount sets the "reposts_count" field.
+func (u *ProfilePostItemUpsert) SetRepostsCount(v int) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldRepostsCount, v)
+	return u
+}
+
+// UpdateRepostsCount sets the "reposts_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateRepostsCount() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldRepostsCount)
+	return u
+}
+
+// AddRepostsCount adds v to the "reposts_count" field.
+func (u *ProfilePostItemUpsert) AddRepostsCount(v int) *ProfilePostItemUpsert {
+	u.Add(profilepostitem.FieldRepostsCount, v)
+	return u
+}
+
+// SetEmpathyCount sets the "empathy_count" field.
+func (u *ProfilePostItemUpsert) SetEmpathyCount(v int) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldEmpathyCount, v)
+	return u
+}
+
+// UpdateEmpathyCount sets the "empathy_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateEmpathyCount() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldEmpathyCount)
+	return u
+}
+
+// AddEmpathyCount adds v to the "empathy_count" field.
+func (u *ProfilePostItemUpsert) AddEmpathyCount(v int) *ProfilePostItemUpsert {
+	u.Add(profilepostitem.FieldEmpathyCount, v)
+	return u
+}
+
+// SetPraiseCount sets the "praise_count" field.
+func (u *ProfilePostItemUpsert) SetPraiseCount(v int) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldPraiseCount, v)
+	return u
+}
+
+// UpdatePraiseCount sets the "praise_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdatePraiseCount() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldPraiseCount)
+	return u
+}
+
+// AddPraiseCount adds v to the "praise_count" field.
+func (u *ProfilePostItemUpsert) AddPraiseCount(v int) *ProfilePostItemUpsert {
+	u.Add(profilepostitem.FieldPraiseCount, v)
+	return u
+}
+
+// SetFunnyCount sets the "funny_count" field.
+func (u *ProfilePostItemUpsert) SetFunnyCount(v int) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldFunnyCount, v)
+	return u
+}
+
+// UpdateFunnyCount sets the "funny_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateFunnyCount() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldFunnyCount)
+	return u
+}
+
+// AddFunnyCount adds v to the "funny_count" field.
+func (u *ProfilePostItemUpsert) AddFunnyCount(v int) *ProfilePostItemUpsert {
+	u.Add(profilepostitem.FieldFunnyCount, v)
+	return u
+}
+
+// SetInterestCount sets the "interest_count" field.
+func (u *ProfilePostItemUpsert) SetInterestCount(v int) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldInterestCount, v)
+	return u
+}
+
+// UpdateInterestCount sets the "interest_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateInterestCount() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldInterestCount)
+	return u
+}
+
+// AddInterestCount adds v to the "interest_count" field.
+func (u *ProfilePostItemUpsert) AddInterestCount(v int) *ProfilePostItemUpsert {
+	u.Add(profilepostitem.FieldInterestCount, v)
+	return u
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (u *ProfilePostItemUpsert) SetPostedAt(v time.Time) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldPostedAt, v)
+	return u
+}
+
+// UpdatePostedAt sets the "posted_at" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdatePostedAt() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldPostedAt)
+	return u
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (u *ProfilePostItemUpsert) ClearPostedAt() *ProfilePostItemUpsert {
+	u.SetNull(profilepostitem.FieldPostedAt)
+	return u
+}
+
+// SetRawData sets the "raw_data" field.
+func (u *ProfilePostItemUpsert) SetRawData(v map[string]interface{}) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldRawData, v)
+	return u
+}
+
+// UpdateRawData sets the "raw_data" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateRawData() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldRawData)
+	return u
+}
+
+// ClearRawData clears the value of the "raw_data" field.
+func (u *ProfilePostItemUpsert) ClearRawData() *ProfilePostItemUpsert {
+	u.SetNull(profilepostitem.FieldRawData)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfilePostItemUpsert) SetUpdatedAt(v time.Time) *ProfilePostItemUpsert {
+	u.Set(profilepostitem.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfilePostItemUpsert) UpdateUpdatedAt() *ProfilePostItemUpsert {
+	u.SetExcluded(profilepostitem.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProfilePostItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profilepostitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfilePostItemUpsertOne) UpdateNewValues() *ProfilePostItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(profilepostitem.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(profilepostitem.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfilePostItem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProfilePostItemUpsertOne) Ignore() *ProfilePostItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfilePostItemUpsertOne) DoNothing() *ProfilePostItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfilePostItemCreate.OnConflict
+// documentation for more info.
+func (u *ProfilePostItemUpsertOne) Update(set func(*ProfilePostItemUpsert)) *ProfilePostItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfilePostItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileUsername sets the "profile_username" field.
+func (u *ProfilePostItemUpsertOne) SetProfileUsername(v string) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetProfileUsername(v)
+	})
+}
+
+// UpdateProfileUsername sets the "profile_username" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateProfileUsername() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateProfileUsername()
+	})
+}
+
+// SetPostUrn sets the "post_urn" field.
+func (u *ProfilePostItemUpsertOne) SetPostUrn(v string) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetPostUrn(v)
+	})
+}
+
+// UpdatePostUrn sets the "post_urn" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdatePostUrn() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdatePostUrn()
+	})
+}
+
+// ClearPostUrn clears the value of the "post_urn" field.
+func (u *ProfilePostItemUpsertOne) ClearPostUrn() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearPostUrn()
+	})
+}
+
+// SetPostURL sets the "post_url" field.
+func (u *ProfilePostItemUpsertOne) SetPostURL(v string) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetPostURL(v)
+	})
+}
+
+// UpdatePostURL sets the "post_url" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdatePostURL() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdatePostURL()
+	})
+}
+
+// ClearPostURL clears the value of the "post_url" field.
+func (u *ProfilePostItemUpsertOne) ClearPostURL() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearPostURL()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *ProfilePostItemUpsertOne) SetText(v string) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateText() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateText()
+	})
+}
+
+// ClearText clears the value of the "text" field.
+func (u *ProfilePostItemUpsertOne) ClearText() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearText()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *ProfilePostItemUpsertOne) SetContentType(v string) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateContentType() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (u *ProfilePostItemUpsertOne) ClearContentType() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearContentType()
+	})
+}
+
+// SetIsRepost sets the "is_repost" field.
+func (u *ProfilePostItemUpsertOne) SetIsRepost(v bool) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetIsRepost(v)
+	})
+}
+
+// UpdateIsRepost sets the "is_repost" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateIsRepost() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateIsRepost()
+	})
+}
+
+// SetTotalReactions sets the "total_reactions" field.
+func (u *ProfilePostItemUpsertOne) SetTotalReactions(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetTotalReactions(v)
+	})
+}
+
+// AddTotalReactions adds v to the "total_reactions" field.
+func (u *ProfilePostItemUpsertOne) AddTotalReactions(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddTotalReactions(v)
+	})
+}
+
+// UpdateTotalReactions sets the "total_reactions" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateTotalReactions() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateTotalReactions()
+	})
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *ProfilePostItemUpsertOne) SetLikeCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetLikeCount(v)
+	})
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *ProfilePostItemUpsertOne) AddLikeCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddLikeCount(v)
+	})
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateLikeCount() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateLikeCount()
+	})
+}
+
+// SetCommentsCount sets the "comments_count" field.
+func (u *ProfilePostItemUpsertOne) SetCommentsCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetCommentsCount(v)
+	})
+}
+
+// AddCommentsCount adds v to the "comments_count" field.
+func (u *ProfilePostItemUpsertOne) AddCommentsCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddCommentsCount(v)
+	})
+}
+
+// UpdateCommentsCount sets the "comments_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateCommentsCount() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateCommentsCount()
+	})
+}
+
+// SetRepostsCount sets the "reposts_count" field.
+func (u *ProfilePostItemUpsertOne) SetRepostsCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetRepostsCount(v)
+	})
+}
+
+// AddRepostsCount adds v to the "reposts_count" field.
+func (u *ProfilePostItemUpsertOne) AddRepostsCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddRepostsCount(v)
+	})
+}
+
+// UpdateRepostsCount sets the "reposts_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateRepostsCount() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateRepostsCount()
+	})
+}
+
+// SetEmpathyCount sets the "empathy_count" field.
+func (u *ProfilePostItemUpsertOne) SetEmpathyCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetEmpathyCount(v)
+	})
+}
+
+// AddEmpathyCount adds v to the "empathy_count" field.
+func (u *ProfilePostItemUpsertOne) AddEmpathyCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddEmpathyCount(v)
+	})
+}
+
+// UpdateEmpathyCount sets the "empathy_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateEmpathyCount() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateEmpathyCount()
+	})
+}
+
+// SetPraiseCount sets the "praise_count" field.
+func (u *ProfilePostItemUpsertOne) SetPraiseCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetPraiseCount(v)
+	})
+}
+
+// AddPraiseCount adds v to the "praise_count" field.
+func (u *ProfilePostItemUpsertOne) AddPraiseCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddPraiseCount(v)
+	})
+}
+
+// UpdatePraiseCount sets the "praise_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdatePraiseCount() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdatePraiseCount()
+	})
+}
+
+// SetFunnyCount sets the "funny_count" field.
+func (u *ProfilePostItemUpsertOne) SetFunnyCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetFunnyCount(v)
+	})
+}
+
+// AddFunnyCount adds v to the "funny_count" field.
+func (u *ProfilePostItemUpsertOne) AddFunnyCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddFunnyCount(v)
+	})
+}
+
+// UpdateFunnyCount sets the "funny_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateFunnyCount() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateFunnyCount()
+	})
+}
+
+// SetInterestCount sets the "interest_count" field.
+func (u *ProfilePostItemUpsertOne) SetInterestCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetInterestCount(v)
+	})
+}
+
+// AddInterestCount adds v to the "interest_count" field.
+func (u *ProfilePostItemUpsertOne) AddInterestCount(v int) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddInterestCount(v)
+	})
+}
+
+// UpdateInterestCount sets the "interest_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateInterestCount() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateInterestCount()
+	})
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (u *ProfilePostItemUpsertOne) SetPostedAt(v time.Time) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetPostedAt(v)
+	})
+}
+
+// UpdatePostedAt sets the "posted_at" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdatePostedAt() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdatePostedAt()
+	})
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (u *ProfilePostItemUpsertOne) ClearPostedAt() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearPostedAt()
+	})
+}
+
+// SetRawData sets the "raw_data" field.
+func (u *ProfilePostItemUpsertOne) SetRawData(v map[string]interface{}) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetRawData(v)
+	})
+}
+
+// UpdateRawData sets the "raw_data" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateRawData() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateRawData()
+	})
+}
+
+// ClearRawData clears the value of the "raw_data" field.
+func (u *ProfilePostItemUpsertOne) ClearRawData() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearRawData()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfilePostItemUpsertOne) SetUpdatedAt(v time.Time) *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertOne) UpdateUpdatedAt() *ProfilePostItemUpsertOne {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfilePostItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfilePostItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfilePostItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProfilePostItemUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProfilePostItemUpsertOne.ID is not supported by MySQL driver. Use ProfilePostItemUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProfilePostItemUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ProfilePostItemCreateBulk is the builder for creating many ProfilePostItem entities in bulk.
 type ProfilePostItemCreateBulk struct {
 	config
 	err      error
 	builders []*ProfilePostItemCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the ProfilePostItem entities in the database.
@@ -620,6 +1387,7 @@ func (ppicb *ProfilePostItemCreateBulk) Save(ctx context.Context) ([]*ProfilePos
 					_, err = mutators[i+1].Mutate(root, ppicb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ppicb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ppicb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -669,3 +1437,456 @@ func (ppicb *ProfilePostItemCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfilePostItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfilePostItemUpsert) {
+//			SetProfileUsername(v+v).
+//		}).
+//		Exec(ctx)
+func (ppicb *ProfilePostItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProfilePostItemUpsertBulk {
+	ppicb.conflict = opts
+	return &ProfilePostItemUpsertBulk{
+		create: ppicb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfilePostItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ppicb *ProfilePostItemCreateBulk) OnConflictColumns(columns ...string) *ProfilePostItemUpsertBulk {
+	ppicb.conflict = append(ppicb.conflict, sql.ConflictColumns(columns...))
+	return &ProfilePostItemUpsertBulk{
+		create: ppicb,
+	}
+}
+
+// ProfilePostItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProfilePostItem nodes.
+type ProfilePostItemUpsertBulk struct {
+	create *ProfilePostItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProfilePostItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profilepostitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfilePostItemUpsertBulk) UpdateNewValues() *ProfilePostItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(profilepostitem.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(profilepostitem.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfilePostItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProfilePostItemUpsertBulk) Ignore() *ProfilePostItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfilePostItemUpsertBulk) DoNothing() *ProfilePostItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfilePostItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProfilePostItemUpsertBulk) Update(set func(*ProfilePostItemUpsert)) *ProfilePostItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfilePostItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileUsername sets the "profile_username" field.
+func (u *ProfilePostItemUpsertBulk) SetProfileUsername(v string) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetProfileUsername(v)
+	})
+}
+
+// UpdateProfileUsername sets the "profile_username" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateProfileUsername() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateProfileUsername()
+	})
+}
+
+// SetPostUrn sets the "post_urn" field.
+func (u *ProfilePostItemUpsertBulk) SetPostUrn(v string) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetPostUrn(v)
+	})
+}
+
+// UpdatePostUrn sets the "post_urn" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdatePostUrn() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdatePostUrn()
+	})
+}
+
+// ClearPostUrn clears the value of the "post_urn" field.
+func (u *ProfilePostItemUpsertBulk) ClearPostUrn() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearPostUrn()
+	})
+}
+
+// SetPostURL sets the "post_url" field.
+func (u *ProfilePostItemUpsertBulk) SetPostURL(v string) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetPostURL(v)
+	})
+}
+
+// UpdatePostURL sets the "post_url" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdatePostURL() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdatePostURL()
+	})
+}
+
+// ClearPostURL clears the value of the "post_url" field.
+func (u *ProfilePostItemUpsertBulk) ClearPostURL() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearPostURL()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *ProfilePostItemUpsertBulk) SetText(v string) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateText() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateText()
+	})
+}
+
+// ClearText clears the value of the "text" field.
+func (u *ProfilePostItemUpsertBulk) ClearText() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearText()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *ProfilePostItemUpsertBulk) SetContentType(v string) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateContentType() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (u *ProfilePostItemUpsertBulk) ClearContentType() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearContentType()
+	})
+}
+
+// SetIsRepost sets the "is_repost" field.
+func (u *ProfilePostItemUpsertBulk) SetIsRepost(v bool) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetIsRepost(v)
+	})
+}
+
+// UpdateIsRepost sets the "is_repost" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateIsRepost() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateIsRepost()
+	})
+}
+
+// SetTotalReactions sets the "total_reactions" field.
+func (u *ProfilePostItemUpsertBulk) SetTotalReactions(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetTotalReactions(v)
+	})
+}
+
+// AddTotalReactions adds v to the "total_reactions" field.
+func (u *ProfilePostItemUpsertBulk) AddTotalReactions(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddTotalReactions(v)
+	})
+}
+
+// UpdateTotalReactions sets the "total_reactions" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateTotalReactions() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateTotalReactions()
+	})
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *ProfilePostItemUpsertBulk) SetLikeCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetLikeCount(v)
+	})
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *ProfilePostItemUpsertBulk) AddLikeCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddLikeCount(v)
+	})
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateLikeCount() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateLikeCount()
+	})
+}
+
+// SetCommentsCount sets the "comments_count" field.
+func (u *ProfilePostItemUpsertBulk) SetCommentsCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetCommentsCount(v)
+	})
+}
+
+// AddCommentsCount adds v to the "comments_count" field.
+func (u *ProfilePostItemUpsertBulk) AddCommentsCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddCommentsCount(v)
+	})
+}
+
+// UpdateCommentsCount sets the "comments_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateCommentsCount() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateCommentsCount()
+	})
+}
+
+// SetRepostsCount sets the "reposts_count" field.
+func (u *ProfilePostItemUpsertBulk) SetRepostsCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetRepostsCount(v)
+	})
+}
+
+// AddRepostsCount adds v to the "reposts_count" field.
+func (u *ProfilePostItemUpsertBulk) AddRepostsCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddRepostsCount(v)
+	})
+}
+
+// UpdateRepostsCount sets the "reposts_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateRepostsCount() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateRepostsCount()
+	})
+}
+
+// SetEmpathyCount sets the "empathy_count" field.
+func (u *ProfilePostItemUpsertBulk) SetEmpathyCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetEmpathyCount(v)
+	})
+}
+
+// AddEmpathyCount adds v to the "empathy_count" field.
+func (u *ProfilePostItemUpsertBulk) AddEmpathyCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddEmpathyCount(v)
+	})
+}
+
+// UpdateEmpathyCount sets the "empathy_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateEmpathyCount() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateEmpathyCount()
+	})
+}
+
+// SetPraiseCount sets the "praise_count" field.
+func (u *ProfilePostItemUpsertBulk) SetPraiseCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetPraiseCount(v)
+	})
+}
+
+// AddPraiseCount adds v to the "praise_count" field.
+func (u *ProfilePostItemUpsertBulk) AddPraiseCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddPraiseCount(v)
+	})
+}
+
+// UpdatePraiseCount sets the "praise_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdatePraiseCount() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdatePraiseCount()
+	})
+}
+
+// SetFunnyCount sets the "funny_count" field.
+func (u *ProfilePostItemUpsertBulk) SetFunnyCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetFunnyCount(v)
+	})
+}
+
+// AddFunnyCount adds v to the "funny_count" field.
+func (u *ProfilePostItemUpsertBulk) AddFunnyCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddFunnyCount(v)
+	})
+}
+
+// UpdateFunnyCount sets the "funny_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateFunnyCount() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateFunnyCount()
+	})
+}
+
+// SetInterestCount sets the "interest_count" field.
+func (u *ProfilePostItemUpsertBulk) SetInterestCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetInterestCount(v)
+	})
+}
+
+// AddInterestCount adds v to the "interest_count" field.
+func (u *ProfilePostItemUpsertBulk) AddInterestCount(v int) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.AddInterestCount(v)
+	})
+}
+
+// UpdateInterestCount sets the "interest_count" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateInterestCount() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateInterestCount()
+	})
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (u *ProfilePostItemUpsertBulk) SetPostedAt(v time.Time) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetPostedAt(v)
+	})
+}
+
+// UpdatePostedAt sets the "posted_at" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdatePostedAt() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdatePostedAt()
+	})
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (u *ProfilePostItemUpsertBulk) ClearPostedAt() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearPostedAt()
+	})
+}
+
+// SetRawData sets the "raw_data" field.
+func (u *ProfilePostItemUpsertBulk) SetRawData(v map[string]interface{}) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetRawData(v)
+	})
+}
+
+// UpdateRawData sets the "raw_data" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateRawData() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateRawData()
+	})
+}
+
+// ClearRawData clears the value of the "raw_data" field.
+func (u *ProfilePostItemUpsertBulk) ClearRawData() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.ClearRawData()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfilePostItemUpsertBulk) SetUpdatedAt(v time.Time) *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfilePostItemUpsertBulk) UpdateUpdatedAt() *ProfilePostItemUpsertBulk {
+	return u.Update(func(s *ProfilePostItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfilePostItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProfilePostItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfilePostItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfilePostItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"sheng-go-backend/ent/schema/ulid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,8 +27,8 @@ type ProfilePostItemQuery struct {
 	predicates      []predicate.ProfilePostItem
 	withProfilePost *ProfilePostQuery
 	withFKs         bool
-	modifiers       []func(*sql.Selector)
 	loadTotal       []func(context.Context, []*ProfilePostItem) error
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -520,6 +521,9 @@ func (ppiq *ProfilePostItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ppiq.ctx.Unique != nil && *ppiq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ppiq.modifiers {
+		m(selector)
+	}
 	for _, p := range ppiq.predicates {
 		p(selector)
 	}
@@ -537,6 +541,32 @@ func (ppiq *ProfilePostItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ppiq *ProfilePostItemQuery) ForUpdate(opts ...sql.LockOption) *ProfilePostItemQuery {
+	if ppiq.driver.Dialect() == dialect.Postgres {
+		ppiq.Unique(false)
+	}
+	ppiq.modifiers = append(ppiq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ppiq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ppiq *ProfilePostItemQuery) ForShare(opts ...sql.LockOption) *ProfilePostItemQuery {
+	if ppiq.driver.Dialect() == dialect.Postgres {
+		ppiq.Unique(false)
+	}
+	ppiq.modifiers = append(ppiq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ppiq
+}
+
 // ProfilePostItemGroupBy is the group-by builder for ProfilePostItem entities.
 type ProfilePostItemGroupBy struct {
 	selector
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/schema/ulid"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProfileSkill is the model entity for the ProfileSkill schema.
+type ProfileSkill struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID ulid.ID `json:"id,omitempty"`
+	// Skill name
+	Name string `json:"name,omitempty"`
+	// Number of endorsements
+	EndorsementCount int `json:"endorsement_count,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProfileSkillQuery when eager-loading is set.
+	Edges                  ProfileSkillEdges `json:"edges"`
+	profile_profile_skills *ulid.ID
+	selectValues           sql.SelectValues
+}
+
+// ProfileSkillEdges holds the relations/edges for other nodes in the graph.
+type ProfileSkillEdges struct {
+	// Profile this skill belongs to
+	Profile *Profile `json:"profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProfileSkillEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProfileSkill) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case profileskill.FieldEndorsementCount:
+			values[i] = new(sql.NullInt64)
+		case profileskill.FieldName:
+			values[i] = new(sql.NullString)
+		case profileskill.FieldCreatedAt, profileskill.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case profileskill.FieldID:
+			values[i] = new(ulid.ID)
+		case profileskill.ForeignKeys[0]: // profile_profile_skills
+			values[i] = &sql.NullScanner{S: new(ulid.ID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProfileSkill fields.
+func (ps *ProfileSkill) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case profileskill.FieldID:
+			if value, ok := values[i].(*ulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ps.ID = *value
+			}
+		case profileskill.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ps.Name = value.String
+			}
+		case profileskill.FieldEndorsementCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field endorsement_count", values[i])
+			} else if value.Valid {
+				ps.EndorsementCount = int(value.Int64)
+			}
+		case profileskill.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ps.CreatedAt = value.Time
+			}
+		case profileskill.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ps.UpdatedAt = value.Time
+			}
+		case profileskill.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_profile_skills", values[i])
+			} else if value.Valid {
+				ps.profile_profile_skills = new(ulid.ID)
+				*ps.profile_profile_skills = *value.S.(*ulid.ID)
+			}
+		default:
+			ps.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProfileSkill.
+// This includes values selected through modifiers, order, etc.
+func (ps *ProfileSkill) Value(name string) (ent.Value, error) {
+	return ps.selectValues.Get(name)
+}
+
+// QueryProfile queries the "profile" edge of the ProfileSkill entity.
+func (ps *ProfileSkill) QueryProfile() *ProfileQuery {
+	return NewProfileSkillClient(ps.config).QueryProfile(ps)
+}
+
+// Update returns a builder for updating this ProfileSkill.
+// Note that you need to call ProfileSkill.Unwrap() before calling this method if this ProfileSkill
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ps *ProfileSkill) Update() *ProfileSkillUpdateOne {
+	return NewProfileSkillClient(ps.config).UpdateOne(ps)
+}
+
+// Unwrap unwraps the ProfileSkill entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ps *ProfileSkill) Unwrap() *ProfileSkill {
+	_tx, ok := ps.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProfileSkill is not a transactional entity")
+	}
+	ps.config.driver = _tx.drv
+	return ps
+}
+
+// String implements the fmt.Stringer.
+func (ps *ProfileSkill) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProfileSkill(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ps.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ps.Name)
+	builder.WriteString(", ")
+	builder.WriteString("endorsement_count=")
+	builder.WriteString(fmt.Sprintf("%v", ps.EndorsementCount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ps.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ps.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProfileSkills is a parsable slice of ProfileSkill.
+type ProfileSkills []*ProfileSkill
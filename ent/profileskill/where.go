@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profileskill
+
+import (
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id ulid.ID) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id ulid.ID) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id ulid.ID) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...ulid.ID) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...ulid.ID) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id ulid.ID) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id ulid.ID) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id ulid.ID) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id ulid.ID) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldEQ(FieldName, v))
+}
+
+// EndorsementCount applies equality check predicate on the "endorsement_count" field. It's identical to EndorsementCountEQ.
+func EndorsementCount(v int) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldEQ(FieldEndorsementCount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldContainsFold(FieldName, v))
+}
+
+// EndorsementCountEQ applies the EQ predicate on the "endorsement_count" field.
+func EndorsementCountEQ(v int) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldEQ(FieldEndorsementCount, v))
+}
+
+// EndorsementCountNEQ applies the NEQ predicate on the "endorsement_count" field.
+func EndorsementCountNEQ(v int) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldNEQ(FieldEndorsementCount, v))
+}
+
+// EndorsementCountIn applies the In predicate on the "endorsement_count" field.
+func EndorsementCountIn(vs ...int) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldIn(FieldEndorsementCount, vs...))
+}
+
+// EndorsementCountNotIn applies the NotIn predicate on the "endorsement_count" field.
+func EndorsementCountNotIn(vs ...int) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldNotIn(FieldEndorsementCount, vs...))
+}
+
+// EndorsementCountGT applies the GT predicate on the "endorsement_count" field.
+func EndorsementCountGT(v int) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldGT(FieldEndorsementCount, v))
+}
+
+// EndorsementCountGTE applies the GTE predicate on the "endorsement_count" field.
+func EndorsementCountGTE(v int) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldGTE(FieldEndorsementCount, v))
+}
+
+// EndorsementCountLT applies the LT predicate on the "endorsement_count" field.
+func EndorsementCountLT(v int) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldLT(FieldEndorsementCount, v))
+}
+
+// EndorsementCountLTE applies the LTE predicate on the "endorsement_count" field.
+func EndorsementCountLTE(v int) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldLTE(FieldEndorsementCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.ProfileSkill {
+	return predicate.ProfileSkill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.ProfileSkill {
+	return predicate.ProfileSkill(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProfileSkill) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProfileSkill) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProfileSkill) predicate.ProfileSkill {
+	return predicate.ProfileSkill(sql.NotPredicates(p))
+}
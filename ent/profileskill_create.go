@@ -0,0 +1,727 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileSkillCreate is the builder for creating a ProfileSkill entity.
+type ProfileSkillCreate struct {
+	config
+	mutation *ProfileSkillMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (psc *ProfileSkillCreate) SetName(s string) *ProfileSkillCreate {
+	psc.mutation.SetName(s)
+	return psc
+}
+
+// SetEndorsementCount sets the "endorsement_count" field.
+func (psc *ProfileSkillCreate) SetEndorsementCount(i int) *ProfileSkillCreate {
+	psc.mutation.SetEndorsementCount(i)
+	return psc
+}
+
+// SetNillableEndorsementCount sets the "endorsement_count" field if the given value is not nil.
+func (psc *ProfileSkillCreate) SetNillableEndorsementCount(i *int) *ProfileSkillCreate {
+	if i != nil {
+		psc.SetEndorsementCount(*i)
+	}
+	return psc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (psc *ProfileSkillCreate) SetCreatedAt(t time.Time) *ProfileSkillCreate {
+	psc.mutation.SetCreatedAt(t)
+	return psc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (psc *ProfileSkillCreate) SetNillableCreatedAt(t *time.Time) *ProfileSkillCreate {
+	if t != nil {
+		psc.SetCreatedAt(*t)
+	}
+	return psc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psc *ProfileSkillCreate) SetUpdatedAt(t time.Time) *ProfileSkillCreate {
+	psc.mutation.SetUpdatedAt(t)
+	return psc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (psc *ProfileSkillCreate) SetNillableUpdatedAt(t *time.Time) *ProfileSkillCreate {
+	if t != nil {
+		psc.SetUpdatedAt(*t)
+	}
+	return psc
+}
+
+// SetID sets the "id" field.
+func (psc *ProfileSkillCreate) SetID(u ulid.ID) *ProfileSkillCreate {
+	psc.mutation.SetID(u)
+	return psc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (psc *ProfileSkillCreate) SetNillableID(u *ulid.ID) *ProfileSkillCreate {
+	if u != nil {
+		psc.SetID(*u)
+	}
+	return psc
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (psc *ProfileSkillCreate) SetProfileID(id ulid.ID) *ProfileSkillCreate {
+	psc.mutation.SetProfileID(id)
+	return psc
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (psc *ProfileSkillCreate) SetProfile(p *Profile) *ProfileSkillCreate {
+	return psc.SetProfileID(p.ID)
+}
+
+// Mutation returns the ProfileSkillMutation object of the builder.
+func (psc *ProfileSkillCreate) Mutation() *ProfileSkillMutation {
+	return psc.mutation
+}
+
+// Save creates the ProfileSkill in the database.
+func (psc *ProfileSkillCreate) Save(ctx context.Context) (*ProfileSkill, error) {
+	psc.defaults()
+	return withHooks(ctx, psc.sqlSave, psc.mutation, psc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (psc *ProfileSkillCreate) SaveX(ctx context.Context) *ProfileSkill {
+	v, err := psc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (psc *ProfileSkillCreate) Exec(ctx context.Context) error {
+	_, err := psc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psc *ProfileSkillCreate) ExecX(ctx context.Context) {
+	if err := psc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psc *ProfileSkillCreate) defaults() {
+	if _, ok := psc.mutation.EndorsementCount(); !ok {
+		v := profileskill.DefaultEndorsementCount
+		psc.mutation.SetEndorsementCount(v)
+	}
+	if _, ok := psc.mutation.CreatedAt(); !ok {
+		v := profileskill.DefaultCreatedAt()
+		psc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := psc.mutation.UpdatedAt(); !ok {
+		v := profileskill.DefaultUpdatedAt()
+		psc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := psc.mutation.ID(); !ok {
+		v := profileskill.DefaultID()
+		psc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psc *ProfileSkillCreate) check() error {
+	if _, ok := psc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ProfileSkill.name"`)}
+	}
+	if _, ok := psc.mutation.EndorsementCount(); !ok {
+		return &ValidationError{Name: "endorsement_count", err: errors.New(`ent: missing required field "ProfileSkill.endorsement_count"`)}
+	}
+	if v, ok := psc.mutation.EndorsementCount(); ok {
+		if err := profileskill.EndorsementCountValidator(v); err != nil {
+			return &ValidationError{Name: "endorsement_count", err: fmt.Errorf(`ent: validator failed for field "ProfileSkill.endorsement_count": %w`, err)}
+		}
+	}
+	if _, ok := psc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProfileSkill.created_at"`)}
+	}
+	if _, ok := psc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProfileSkill.updated_at"`)}
+	}
+	if len(psc.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "ProfileSkill.profile"`)}
+	}
+	return nil
+}
+
+func (psc *ProfileSkillCreate) sqlSave(ctx context.Context) (*ProfileSkill, error) {
+	if err := psc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := psc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, psc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*ulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	psc.mutation.id = &_node.ID
+	psc.mutation.done = true
+	return _node, nil
+}
+
+func (psc *ProfileSkillCreate) createSpec() (*ProfileSkill, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProfileSkill{config: psc.config}
+		_spec = sqlgraph.NewCreateSpec(profileskill.Table, sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = psc.conflict
+	if id, ok := psc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := psc.mutation.Name(); ok {
+		_spec.SetField(profileskill.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := psc.mutation.EndorsementCount(); ok {
+		_spec.SetField(profileskill.FieldEndorsementCount, field.TypeInt, value)
+		_node.EndorsementCount = value
+	}
+	if value, ok := psc.mutation.CreatedAt(); ok {
+		_spec.SetField(profileskill.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := psc.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileskill.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := psc.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileskill.ProfileTable,
+			Columns: []string{profileskill.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.profile_profile_skills = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfileSkill.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileSkillUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (psc *ProfileSkillCreate) OnConflict(opts ...sql.ConflictOption) *ProfileSkillUpsertOne {
+	psc.conflict = opts
+	return &ProfileSkillUpsertOne{
+		create: psc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfileSkill.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (psc *ProfileSkillCreate) OnConflictColumns(columns ...string) *ProfileSkillUpsertOne {
+	psc.conflict = append(psc.conflict, sql.ConflictColumns(columns...))
+	return &ProfileSkillUpsertOne{
+		create: psc,
+	}
+}
+
+type (
+	// ProfileSkillUpsertOne is the builder for "upsert"-ing
+	//  one ProfileSkill node.
+	ProfileSkillUpsertOne struct {
+		create *ProfileSkillCreate
+	}
+
+	// ProfileSkillUpsert is the "OnConflict" setter.
+	ProfileSkillUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *ProfileSkillUpsert) SetName(v string) *ProfileSkillUpsert {
+	u.Set(profileskill.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProfileSkillUpsert) UpdateName() *ProfileSkillUpsert {
+	u.SetExcluded(profileskill.FieldName)
+	return u
+}
+
+// SetEndorsementCount sets the "endorsement_count" field.
+func (u *ProfileSkillUpsert) SetEndorsementCount(v int) *ProfileSkillUpsert {
+	u.Set(profileskill.FieldEndorsementCount, v)
+	return u
+}
+
+// UpdateEndorsementCount sets the "endorsement_count" field to the value that was provided on create.
+func (u *ProfileSkillUpsert) UpdateEndorsementCount() *ProfileSkillUpsert {
+	u.SetExcluded(profileskill.FieldEndorsementCount)
+	return u
+}
+
+// AddEndorsementCount adds v to the "endorsement_count" field.
+func (u *ProfileSkillUpsert) AddEndorsementCount(v int) *ProfileSkillUpsert {
+	u.Add(profileskill.FieldEndorsementCount, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileSkillUpsert) SetUpdatedAt(v time.Time) *ProfileSkillUpsert {
+	u.Set(profileskill.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileSkillUpsert) UpdateUpdatedAt() *ProfileSkillUpsert {
+	u.SetExcluded(profileskill.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProfileSkill.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profileskill.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileSkillUpsertOne) UpdateNewValues() *ProfileSkillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(profileskill.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(profileskill.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfileSkill.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProfileSkillUpsertOne) Ignore() *ProfileSkillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileSkillUpsertOne) DoNothing() *ProfileSkillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileSkillCreate.OnConflict
+// documentation for more info.
+func (u *ProfileSkillUpsertOne) Update(set func(*ProfileSkillUpsert)) *ProfileSkillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileSkillUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ProfileSkillUpsertOne) SetName(v string) *ProfileSkillUpsertOne {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProfileSkillUpsertOne) UpdateName() *ProfileSkillUpsertOne {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEndorsementCount sets the "endorsement_count" field.
+func (u *ProfileSkillUpsertOne) SetEndorsementCount(v int) *ProfileSkillUpsertOne {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.SetEndorsementCount(v)
+	})
+}
+
+// AddEndorsementCount adds v to the "endorsement_count" field.
+func (u *ProfileSkillUpsertOne) AddEndorsementCount(v int) *ProfileSkillUpsertOne {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.AddEndorsementCount(v)
+	})
+}
+
+// UpdateEndorsementCount sets the "endorsement_count" field to the value that was provided on create.
+func (u *ProfileSkillUpsertOne) UpdateEndorsementCount() *ProfileSkillUpsertOne {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.UpdateEndorsementCount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileSkillUpsertOne) SetUpdatedAt(v time.Time) *ProfileSkillUpsertOne {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileSkillUpsertOne) UpdateUpdatedAt() *ProfileSkillUpsertOne {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileSkillUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileSkillCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileSkillUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProfileSkillUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProfileSkillUpsertOne.ID is not supported by MySQL driver. Use ProfileSkillUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProfileSkillUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProfileSkillCreateBulk is the builder for creating many ProfileSkill entities in bulk.
+type ProfileSkillCreateBulk struct {
+	config
+	err      error
+	builders []*ProfileSkillCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProfileSkill entities in the database.
+func (pscb *ProfileSkillCreateBulk) Save(ctx context.Context) ([]*ProfileSkill, error) {
+	if pscb.err != nil {
+		return nil, pscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pscb.builders))
+	nodes := make([]*ProfileSkill, len(pscb.builders))
+	mutators := make([]Mutator, len(pscb.builders))
+	for i := range pscb.builders {
+		func(i int, root context.Context) {
+			builder := pscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProfileSkillMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pscb *ProfileSkillCreateBulk) SaveX(ctx context.Context) []*ProfileSkill {
+	v, err := pscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pscb *ProfileSkillCreateBulk) Exec(ctx context.Context) error {
+	_, err := pscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pscb *ProfileSkillCreateBulk) ExecX(ctx context.Context) {
+	if err := pscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfileSkill.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileSkillUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (pscb *ProfileSkillCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProfileSkillUpsertBulk {
+	pscb.conflict = opts
+	return &ProfileSkillUpsertBulk{
+		create: pscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfileSkill.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pscb *ProfileSkillCreateBulk) OnConflictColumns(columns ...string) *ProfileSkillUpsertBulk {
+	pscb.conflict = append(pscb.conflict, sql.ConflictColumns(columns...))
+	return &ProfileSkillUpsertBulk{
+		create: pscb,
+	}
+}
+
+// ProfileSkillUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProfileSkill nodes.
+type ProfileSkillUpsertBulk struct {
+	create *ProfileSkillCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProfileSkill.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profileskill.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileSkillUpsertBulk) UpdateNewValues() *ProfileSkillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(profileskill.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(profileskill.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfileSkill.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProfileSkillUpsertBulk) Ignore() *ProfileSkillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileSkillUpsertBulk) DoNothing() *ProfileSkillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileSkillCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProfileSkillUpsertBulk) Update(set func(*ProfileSkillUpsert)) *ProfileSkillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileSkillUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ProfileSkillUpsertBulk) SetName(v string) *ProfileSkillUpsertBulk {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProfileSkillUpsertBulk) UpdateName() *ProfileSkillUpsertBulk {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEndorsementCount sets the "endorsement_count" field.
+func (u *ProfileSkillUpsertBulk) SetEndorsementCount(v int) *ProfileSkillUpsertBulk {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.SetEndorsementCount(v)
+	})
+}
+
+// AddEndorsementCount adds v to the "endorsement_count" field.
+func (u *ProfileSkillUpsertBulk) AddEndorsementCount(v int) *ProfileSkillUpsertBulk {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.AddEndorsementCount(v)
+	})
+}
+
+// UpdateEndorsementCount sets the "endorsement_count" field to the value that was provided on create.
+func (u *ProfileSkillUpsertBulk) UpdateEndorsementCount() *ProfileSkillUpsertBulk {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.UpdateEndorsementCount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileSkillUpsertBulk) SetUpdatedAt(v time.Time) *ProfileSkillUpsertBulk {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileSkillUpsertBulk) UpdateUpdatedAt() *ProfileSkillUpsertBulk {
+	return u.Update(func(s *ProfileSkillUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileSkillUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProfileSkillCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileSkillCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileSkillUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
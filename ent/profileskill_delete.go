@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profileskill"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileSkillDelete is the builder for deleting a ProfileSkill entity.
+type ProfileSkillDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProfileSkillMutation
+}
+
+// Where appends a list predicates to the ProfileSkillDelete builder.
+func (psd *ProfileSkillDelete) Where(ps ...predicate.ProfileSkill) *ProfileSkillDelete {
+	psd.mutation.Where(ps...)
+	return psd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (psd *ProfileSkillDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, psd.sqlExec, psd.mutation, psd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psd *ProfileSkillDelete) ExecX(ctx context.Context) int {
+	n, err := psd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (psd *ProfileSkillDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(profileskill.Table, sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString))
+	if ps := psd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, psd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	psd.mutation.done = true
+	return affected, err
+}
+
+// ProfileSkillDeleteOne is the builder for deleting a single ProfileSkill entity.
+type ProfileSkillDeleteOne struct {
+	psd *ProfileSkillDelete
+}
+
+// Where appends a list predicates to the ProfileSkillDelete builder.
+func (psdo *ProfileSkillDeleteOne) Where(ps ...predicate.ProfileSkill) *ProfileSkillDeleteOne {
+	psdo.psd.mutation.Where(ps...)
+	return psdo
+}
+
+// Exec executes the deletion query.
+func (psdo *ProfileSkillDeleteOne) Exec(ctx context.Context) error {
+	n, err := psdo.psd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{profileskill.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psdo *ProfileSkillDeleteOne) ExecX(ctx context.Context) {
+	if err := psdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
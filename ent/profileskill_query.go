@@ -0,0 +1,658 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/schema/ulid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileSkillQuery is the builder for querying ProfileSkill entities.
+type ProfileSkillQuery struct {
+	config
+	ctx         *QueryContext
+	order       []profileskill.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ProfileSkill
+	withProfile *ProfileQuery
+	withFKs     bool
+	loadTotal   []func(context.Context, []*ProfileSkill) error
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProfileSkillQuery builder.
+func (psq *ProfileSkillQuery) Where(ps ...predicate.ProfileSkill) *ProfileSkillQuery {
+	psq.predicates = append(psq.predicates, ps...)
+	return psq
+}
+
+// Limit the number of records to be returned by this query.
+func (psq *ProfileSkillQuery) Limit(limit int) *ProfileSkillQuery {
+	psq.ctx.Limit = &limit
+	return psq
+}
+
+// Offset to start from.
+func (psq *ProfileSkillQuery) Offset(offset int) *ProfileSkillQuery {
+	psq.ctx.Offset = &offset
+	return psq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (psq *ProfileSkillQuery) Unique(unique bool) *ProfileSkillQuery {
+	psq.ctx.Unique = &unique
+	return psq
+}
+
+// Order specifies how the records should be ordered.
+func (psq *ProfileSkillQuery) Order(o ...profileskill.OrderOption) *ProfileSkillQuery {
+	psq.order = append(psq.order, o...)
+	return psq
+}
+
+// QueryProfile chains the current query on the "profile" edge.
+func (psq *ProfileSkillQuery) QueryProfile() *ProfileQuery {
+	query := (&ProfileClient{config: psq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := psq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := psq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profileskill.Table, profileskill.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profileskill.ProfileTable, profileskill.ProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(psq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProfileSkill entity from the query.
+// Returns a *NotFoundError when no ProfileSkill was found.
+func (psq *ProfileSkillQuery) First(ctx context.Context) (*ProfileSkill, error) {
+	nodes, err := psq.Limit(1).All(setContextOp(ctx, psq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{profileskill.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (psq *ProfileSkillQuery) FirstX(ctx context.Context) *ProfileSkill {
+	node, err := psq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProfileSkill ID from the query.
+// Returns a *NotFoundError when no ProfileSkill ID was found.
+func (psq *ProfileSkillQuery) FirstID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = psq.Limit(1).IDs(setContextOp(ctx, psq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{profileskill.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (psq *ProfileSkillQuery) FirstIDX(ctx context.Context) ulid.ID {
+	id, err := psq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProfileSkill entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProfileSkill entity is found.
+// Returns a *NotFoundError when no ProfileSkill entities are found.
+func (psq *ProfileSkillQuery) Only(ctx context.Context) (*ProfileSkill, error) {
+	nodes, err := psq.Limit(2).All(setContextOp(ctx, psq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{profileskill.Label}
+	default:
+		return nil, &NotSingularError{profileskill.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (psq *ProfileSkillQuery) OnlyX(ctx context.Context) *ProfileSkill {
+	node, err := psq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProfileSkill ID in the query.
+// Returns a *NotSingularError when more than one ProfileSkill ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (psq *ProfileSkillQuery) OnlyID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = psq.Limit(2).IDs(setContextOp(ctx, psq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{profileskill.Label}
+	default:
+		err = &NotSingularError{profileskill.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (psq *ProfileSkillQuery) OnlyIDX(ctx context.Context) ulid.ID {
+	id, err := psq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProfileSkills.
+func (psq *ProfileSkillQuery) All(ctx context.Context) ([]*ProfileSkill, error) {
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryAll)
+	if err := psq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProfileSkill, *ProfileSkillQuery]()
+	return withInterceptors[[]*ProfileSkill](ctx, psq, qr, psq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (psq *ProfileSkillQuery) AllX(ctx context.Context) []*ProfileSkill {
+	nodes, err := psq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProfileSkill IDs.
+func (psq *ProfileSkillQuery) IDs(ctx context.Context) (ids []ulid.ID, err error) {
+	if psq.ctx.Unique == nil && psq.path != nil {
+		psq.Unique(true)
+	}
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryIDs)
+	if err = psq.Select(profileskill.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (psq *ProfileSkillQuery) IDsX(ctx context.Context) []ulid.ID {
+	ids, err := psq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (psq *ProfileSkillQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryCount)
+	if err := psq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, psq, querierCount[*ProfileSkillQuery](), psq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (psq *ProfileSkillQuery) CountX(ctx context.Context) int {
+	count, err := psq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (psq *ProfileSkillQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryExist)
+	switch _, err := psq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (psq *ProfileSkillQuery) ExistX(ctx context.Context) bool {
+	exist, err := psq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProfileSkillQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (psq *ProfileSkillQuery) Clone() *ProfileSkillQuery {
+	if psq == nil {
+		return nil
+	}
+	return &ProfileSkillQuery{
+		config:      psq.config,
+		ctx:         psq.ctx.Clone(),
+		order:       append([]profileskill.OrderOption{}, psq.order...),
+		inters:      append([]Interceptor{}, psq.inters...),
+		predicates:  append([]predicate.ProfileSkill{}, psq.predicates...),
+		withProfile: psq.withProfile.Clone(),
+		// clone intermediate query.
+		sql:  psq.sql.Clone(),
+		path: psq.path,
+	}
+}
+
+// WithProfile tells the query-builder to eager-load the nodes that are connected to
+// the "profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (psq *ProfileSkillQuery) WithProfile(opts ...func(*ProfileQuery)) *ProfileSkillQuery {
+	query := (&ProfileClient{config: psq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	psq.withProfile = query
+	return psq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProfileSkill.Query().
+//		GroupBy(profileskill.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (psq *ProfileSkillQuery) GroupBy(field string, fields ...string) *ProfileSkillGroupBy {
+	psq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProfileSkillGroupBy{build: psq}
+	grbuild.flds = &psq.ctx.Fields
+	grbuild.label = profileskill.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.ProfileSkill.Query().
+//		Select(profileskill.FieldName).
+//		Scan(ctx, &v)
+func (psq *ProfileSkillQuery) Select(fields ...string) *ProfileSkillSelect {
+	psq.ctx.Fields = append(psq.ctx.Fields, fields...)
+	sbuild := &ProfileSkillSelect{ProfileSkillQuery: psq}
+	sbuild.label = profileskill.Label
+	sbuild.flds, sbuild.scan = &psq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProfileSkillSelect configured with the given aggregations.
+func (psq *ProfileSkillQuery) Aggregate(fns ...AggregateFunc) *ProfileSkillSelect {
+	return psq.Select().Aggregate(fns...)
+}
+
+func (psq *ProfileSkillQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range psq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, psq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range psq.ctx.Fields {
+		if !profileskill.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if psq.path != nil {
+		prev, err := psq.path(ctx)
+		if err != nil {
+			return err
+		}
+		psq.sql = prev
+	}
+	return nil
+}
+
+func (psq *ProfileSkillQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProfileSkill, error) {
+	var (
+		nodes       = []*ProfileSkill{}
+		withFKs     = psq.withFKs
+		_spec       = psq.querySpec()
+		loadedTypes = [1]bool{
+			psq.withProfile != nil,
+		}
+	)
+	if psq.withProfile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, profileskill.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProfileSkill).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProfileSkill{config: psq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(psq.modifiers) > 0 {
+		_spec.Modifiers = psq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, psq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := psq.withProfile; query != nil {
+		if err := psq.loadProfile(ctx, query, nodes, nil,
+			func(n *ProfileSkill, e *Profile) { n.Edges.Profile = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range psq.loadTotal {
+		if err := psq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (psq *ProfileSkillQuery) loadProfile(ctx context.Context, query *ProfileQuery, nodes []*ProfileSkill, init func(*ProfileSkill), assign func(*ProfileSkill, *Profile)) error {
+	ids := make([]ulid.ID, 0, len(nodes))
+	nodeids := make(map[ulid.ID][]*ProfileSkill)
+	for i := range nodes {
+		if nodes[i].profile_profile_skills == nil {
+			continue
+		}
+		fk := *nodes[i].profile_profile_skills
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(profile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "profile_profile_skills" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (psq *ProfileSkillQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := psq.querySpec()
+	if len(psq.modifiers) > 0 {
+		_spec.Modifiers = psq.modifiers
+	}
+	_spec.Node.Columns = psq.ctx.Fields
+	if len(psq.ctx.Fields) > 0 {
+		_spec.Unique = psq.ctx.Unique != nil && *psq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, psq.driver, _spec)
+}
+
+func (psq *ProfileSkillQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(profileskill.Table, profileskill.Columns, sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString))
+	_spec.From = psq.sql
+	if unique := psq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if psq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := psq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profileskill.FieldID)
+		for i := range fields {
+			if fields[i] != profileskill.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := psq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := psq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := psq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := psq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (psq *ProfileSkillQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(psq.driver.Dialect())
+	t1 := builder.Table(profileskill.Table)
+	columns := psq.ctx.Fields
+	if len(columns) == 0 {
+		columns = profileskill.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if psq.sql != nil {
+		selector = psq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if psq.ctx.Unique != nil && *psq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range psq.modifiers {
+		m(selector)
+	}
+	for _, p := range psq.predicates {
+		p(selector)
+	}
+	for _, p := range psq.order {
+		p(selector)
+	}
+	if offset := psq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := psq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (psq *ProfileSkillQuery) ForUpdate(opts ...sql.LockOption) *ProfileSkillQuery {
+	if psq.driver.Dialect() == dialect.Postgres {
+		psq.Unique(false)
+	}
+	psq.modifiers = append(psq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return psq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (psq *ProfileSkillQuery) ForShare(opts ...sql.LockOption) *ProfileSkillQuery {
+	if psq.driver.Dialect() == dialect.Postgres {
+		psq.Unique(false)
+	}
+	psq.modifiers = append(psq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return psq
+}
+
+// ProfileSkillGroupBy is the group-by builder for ProfileSkill entities.
+type ProfileSkillGroupBy struct {
+	selector
+	build *ProfileSkillQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (psgb *ProfileSkillGroupBy) Aggregate(fns ...AggregateFunc) *ProfileSkillGroupBy {
+	psgb.fns = append(psgb.fns, fns...)
+	return psgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (psgb *ProfileSkillGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, psgb.build.ctx, ent.OpQueryGroupBy)
+	if err := psgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfileSkillQuery, *ProfileSkillGroupBy](ctx, psgb.build, psgb, psgb.build.inters, v)
+}
+
+func (psgb *ProfileSkillGroupBy) sqlScan(ctx context.Context, root *ProfileSkillQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(psgb.fns))
+	for _, fn := range psgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*psgb.flds)+len(psgb.fns))
+		for _, f := range *psgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*psgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := psgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProfileSkillSelect is the builder for selecting fields of ProfileSkill entities.
+type ProfileSkillSelect struct {
+	*ProfileSkillQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pss *ProfileSkillSelect) Aggregate(fns ...AggregateFunc) *ProfileSkillSelect {
+	pss.fns = append(pss.fns, fns...)
+	return pss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pss *ProfileSkillSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pss.ctx, ent.OpQuerySelect)
+	if err := pss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfileSkillQuery, *ProfileSkillSelect](ctx, pss.ProfileSkillQuery, pss, pss.inters, v)
+}
+
+func (pss *ProfileSkillSelect) sqlScan(ctx context.Context, root *ProfileSkillQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pss.fns))
+	for _, fn := range pss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileSkillUpdate is the builder for updating ProfileSkill entities.
+type ProfileSkillUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProfileSkillMutation
+}
+
+// Where appends a list predicates to the ProfileSkillUpdate builder.
+func (psu *ProfileSkillUpdate) Where(ps ...predicate.ProfileSkill) *ProfileSkillUpdate {
+	psu.mutation.Where(ps...)
+	return psu
+}
+
+// SetName sets the "name" field.
+func (psu *ProfileSkillUpdate) SetName(s string) *ProfileSkillUpdate {
+	psu.mutation.SetName(s)
+	return psu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (psu *ProfileSkillUpdate) SetNillableName(s *string) *ProfileSkillUpdate {
+	if s != nil {
+		psu.SetName(*s)
+	}
+	return psu
+}
+
+// SetEndorsementCount sets the "endorsement_count" field.
+func (psu *ProfileSkillUpdate) SetEndorsementCount(i int) *ProfileSkillUpdate {
+	psu.mutation.ResetEndorsementCount()
+	psu.mutation.SetEndorsementCount(i)
+	return psu
+}
+
+// SetNillableEndorsementCount sets the "endorsement_count" field if the given value is not nil.
+func (psu *ProfileSkillUpdate) SetNillableEndorsementCount(i *int) *ProfileSkillUpdate {
+	if i != nil {
+		psu.SetEndorsementCount(*i)
+	}
+	return psu
+}
+
+// AddEndorsementCount adds i to the "endorsement_count" field.
+func (psu *ProfileSkillUpdate) AddEndorsementCount(i int) *ProfileSkillUpdate {
+	psu.mutation.AddEndorsementCount(i)
+	return psu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psu *ProfileSkillUpdate) SetUpdatedAt(t time.Time) *ProfileSkillUpdate {
+	psu.mutation.SetUpdatedAt(t)
+	return psu
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (psu *ProfileSkillUpdate) SetProfileID(id ulid.ID) *ProfileSkillUpdate {
+	psu.mutation.SetProfileID(id)
+	return psu
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (psu *ProfileSkillUpdate) SetProfile(p *Profile) *ProfileSkillUpdate {
+	return psu.SetProfileID(p.ID)
+}
+
+// Mutation returns the ProfileSkillMutation object of the builder.
+func (psu *ProfileSkillUpdate) Mutation() *ProfileSkillMutation {
+	return psu.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (psu *ProfileSkillUpdate) ClearProfile() *ProfileSkillUpdate {
+	psu.mutation.ClearProfile()
+	return psu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (psu *ProfileSkillUpdate) Save(ctx context.Context) (int, error) {
+	psu.defaults()
+	return withHooks(ctx, psu.sqlSave, psu.mutation, psu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psu *ProfileSkillUpdate) SaveX(ctx context.Context) int {
+	affected, err := psu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (psu *ProfileSkillUpdate) Exec(ctx context.Context) error {
+	_, err := psu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psu *ProfileSkillUpdate) ExecX(ctx context.Context) {
+	if err := psu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psu *ProfileSkillUpdate) defaults() {
+	if _, ok := psu.mutation.UpdatedAt(); !ok {
+		v := profileskill.UpdateDefaultUpdatedAt()
+		psu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psu *ProfileSkillUpdate) check() error {
+	if v, ok := psu.mutation.EndorsementCount(); ok {
+		if err := profileskill.EndorsementCountValidator(v); err != nil {
+			return &ValidationError{Name: "endorsement_count", err: fmt.Errorf(`ent: validator failed for field "ProfileSkill.endorsement_count": %w`, err)}
+		}
+	}
+	if psu.mutation.ProfileCleared() && len(psu.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProfileSkill.profile"`)
+	}
+	return nil
+}
+
+func (psu *ProfileSkillUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := psu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profileskill.Table, profileskill.Columns, sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString))
+	if ps := psu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psu.mutation.Name(); ok {
+		_spec.SetField(profileskill.FieldName, field.TypeString, value)
+	}
+	if value, ok := psu.mutation.EndorsementCount(); ok {
+		_spec.SetField(profileskill.FieldEndorsementCount, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.AddedEndorsementCount(); ok {
+		_spec.AddField(profileskill.FieldEndorsementCount, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileskill.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if psu.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileskill.ProfileTable,
+			Columns: []string{profileskill.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psu.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileskill.ProfileTable,
+			Columns: []string{profileskill.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, psu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profileskill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	psu.mutation.done = true
+	return n, nil
+}
+
+// ProfileSkillUpdateOne is the builder for updating a single ProfileSkill entity.
+type ProfileSkillUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProfileSkillMutation
+}
+
+// SetName sets the "name" field.
+func (psuo *ProfileSkillUpdateOne) SetName(s string) *ProfileSkillUpdateOne {
+	psuo.mutation.SetName(s)
+	return psuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (psuo *ProfileSkillUpdateOne) SetNillableName(s *string) *ProfileSkillUpdateOne {
+	if s != nil {
+		psuo.SetName(*s)
+	}
+	return psuo
+}
+
+// SetEndorsementCount sets the "endorsement_count" field.
+func (psuo *ProfileSkillUpdateOne) SetEndorsementCount(i int) *ProfileSkillUpdateOne {
+	psuo.mutation.ResetEndorsementCount()
+	psuo.mutation.SetEndorsementCount(i)
+	return psuo
+}
+
+// SetNillableEndorsementCount sets the "endorsement_count" field if the given value is not nil.
+func (psuo *ProfileSkillUpdateOne) SetNillableEndorsementCount(i *int) *ProfileSkillUpdateOne {
+	if i != nil {
+		psuo.SetEndorsementCount(*i)
+	}
+	return psuo
+}
+
+// AddEndorsementCount adds i to the "endorsement_count" field.
+func (psuo *ProfileSkillUpdateOne) AddEndorsementCount(i int) *ProfileSkillUpdateOne {
+	psuo.mutation.AddEndorsementCount(i)
+	return psuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psuo *ProfileSkillUpdateOne) SetUpdatedAt(t time.Time) *ProfileSkillUpdateOne {
+	psuo.mutation.SetUpdatedAt(t)
+	return psuo
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (psuo *ProfileSkillUpdateOne) SetProfileID(id ulid.ID) *ProfileSkillUpdateOne {
+	psuo.mutation.SetProfileID(id)
+	return psuo
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (psuo *ProfileSkillUpdateOne) SetProfile(p *Profile) *ProfileSkillUpdateOne {
+	return psuo.SetProfileID(p.ID)
+}
+
+// Mutation returns the ProfileSkillMutation object of the builder.
+func (psuo *ProfileSkillUpdateOne) Mutation() *ProfileSkillMutation {
+	return psuo.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (psuo *ProfileSkillUpdateOne) ClearProfile() *ProfileSkillUpdateOne {
+	psuo.mutation.ClearProfile()
+	return psuo
+}
+
+// Where appends a list predicates to the ProfileSkillUpdate builder.
+func (psuo *ProfileSkillUpdateOne) Where(ps ...predicate.ProfileSkill) *ProfileSkillUpdateOne {
+	psuo.mutation.Where(ps...)
+	return psuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (psuo *ProfileSkillUpdateOne) Select(field string, fields ...string) *ProfileSkillUpdateOne {
+	psuo.fields = append([]string{field}, fields...)
+	return psuo
+}
+
+// Save executes the query and returns the updated ProfileSkill entity.
+func (psuo *ProfileSkillUpdateOne) Save(ctx context.Context) (*ProfileSkill, error) {
+	psuo.defaults()
+	return withHooks(ctx, psuo.sqlSave, psuo.mutation, psuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psuo *ProfileSkillUpdateOne) SaveX(ctx context.Context) *ProfileSkill {
+	node, err := psuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (psuo *ProfileSkillUpdateOne) Exec(ctx context.Context) error {
+	_, err := psuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psuo *ProfileSkillUpdateOne) ExecX(ctx context.Context) {
+	if err := psuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psuo *ProfileSkillUpdateOne) defaults() {
+	if _, ok := psuo.mutation.UpdatedAt(); !ok {
+		v := profileskill.UpdateDefaultUpdatedAt()
+		psuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psuo *ProfileSkillUpdateOne) check() error {
+	if v, ok := psuo.mutation.EndorsementCount(); ok {
+		if err := profileskill.EndorsementCountValidator(v); err != nil {
+			return &ValidationError{Name: "endorsement_count", err: fmt.Errorf(`ent: validator failed for field "ProfileSkill.endorsement_count": %w`, err)}
+		}
+	}
+	if psuo.mutation.ProfileCleared() && len(psuo.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProfileSkill.profile"`)
+	}
+	return nil
+}
+
+func (psuo *ProfileSkillUpdateOne) sqlSave(ctx context.Context) (_node *ProfileSkill, err error) {
+	if err := psuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profileskill.Table, profileskill.Columns, sqlgraph.NewFieldSpec(profileskill.FieldID, field.TypeString))
+	id, ok := psuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProfileSkill.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := psuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profileskill.FieldID)
+		for _, f := range fields {
+			if !profileskill.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != profileskill.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := psuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psuo.mutation.Name(); ok {
+		_spec.SetField(profileskill.FieldName, field.TypeString, value)
+	}
+	if value, ok := psuo.mutation.EndorsementCount(); ok {
+		_spec.SetField(profileskill.FieldEndorsementCount, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.AddedEndorsementCount(); ok {
+		_spec.AddField(profileskill.FieldEndorsementCount, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileskill.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if psuo.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileskill.ProfileTable,
+			Columns: []string{profileskill.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psuo.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profileskill.ProfileTable,
+			Columns: []string{profileskill.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProfileSkill{config: psuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, psuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profileskill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	psuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilesnapshot"
+	"sheng-go-backend/ent/schema/ulid"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProfileSnapshot is the model entity for the ProfileSnapshot schema.
+type ProfileSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID ulid.ID `json:"id,omitempty"`
+	// Time the profile was fetched
+	FetchedAt time.Time `json:"fetched_at,omitempty"`
+	// S3 path to full RapidAPI response JSON
+	RawDataS3Key *string `json:"raw_data_s3_key,omitempty"`
+	// S3 path to cleaned/extracted profile JSON
+	CleanedDataS3Key *string `json:"cleaned_data_s3_key,omitempty"`
+	// LinkedIn headline/bio
+	Headline *string `json:"headline,omitempty"`
+	// Current job title
+	Title *string `json:"title,omitempty"`
+	// Country name
+	Country *string `json:"country,omitempty"`
+	// City name
+	City *string `json:"city,omitempty"`
+	// Array of position records as returned in fullPositions
+	Positions []map[string]interface{} `json:"positions,omitempty"`
+	// Array of education records
+	Educations []map[string]interface{} `json:"educations,omitempty"`
+	// Array of skills
+	Skills []map[string]interface{} `json:"skills,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProfileSnapshotQuery when eager-loading is set.
+	Edges             ProfileSnapshotEdges `json:"edges"`
+	profile_snapshots *ulid.ID
+	selectValues      sql.SelectValues
+}
+
+// ProfileSnapshotEdges holds the relations/edges for other nodes in the graph.
+type ProfileSnapshotEdges struct {
+	// Profile this snapshot was taken of
+	Profile *Profile `json:"profile,omitempty"`
+	// Change events detected when this snapshot was recorded
+	ChangeEvents []*ProfileChangeEvent `json:"change_events,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedChangeEvents map[string][]*ProfileChangeEvent
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProfileSnapshotEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// ChangeEventsOrErr returns the ChangeEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileSnapshotEdges) ChangeEventsOrErr() ([]*ProfileChangeEvent, error) {
+	if e.loadedTypes[1] {
+		return e.ChangeEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "change_events"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProfileSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case profilesnapshot.FieldPositions, profilesnapshot.FieldEducations, profilesnapshot.FieldSkills:
+			values[i] = new([]byte)
+		case profilesnapshot.FieldRawDataS3Key, profilesnapshot.FieldCleanedDataS3Key, profilesnapshot.FieldHeadline, profilesnapshot.FieldTitle, profilesnapshot.FieldCountry, profilesnapshot.FieldCity:
+			values[i] = new(sql.NullString)
+		case profilesnapshot.FieldFetchedAt, profilesnapshot.FieldCreatedAt, profilesnapshot.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case profilesnapshot.FieldID:
+			values[i] = new(ulid.ID)
+		case profilesnapshot.ForeignKeys[0]: // profile_snapshots
+			values[i] = &sql.NullScanner{S: new(ulid.ID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProfileSnapshot fields.
+func (ps *ProfileSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case profilesnapshot.FieldID:
+			if value, ok := values[i].(*ulid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ps.ID = *value
+			}
+		case profilesnapshot.FieldFetchedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field fetched_at", values[i])
+			} else if value.Valid {
+				ps.FetchedAt = value.Time
+			}
+		case profilesnapshot.FieldRawDataS3Key:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field raw_data_s3_key", values[i])
+			} else if value.Valid {
+				ps.RawDataS3Key = new(string)
+				*ps.RawDataS3Key = value.String
+			}
+		case profilesnapshot.FieldCleanedDataS3Key:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cleaned_data_s3_key", values[i])
+			} else if value.Valid {
+				ps.CleanedDataS3Key = new(string)
+				*ps.CleanedDataS3Key = value.String
+			}
+		case profilesnapshot.FieldHeadline:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field headline", values[i])
+			} else if value.Valid {
+				ps.Headline = new(string)
+				*ps.Headline = value.String
+			}
+		case profilesnapshot.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				ps.Title = new(string)
+				*ps.Title = value.String
+			}
+		case profilesnapshot.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				ps.Country = new(string)
+				*ps.Country = value.String
+			}
+		case profilesnapshot.FieldCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field city", values[i])
+			} else if value.Valid {
+				ps.City = new(string)
+				*ps.City = value.String
+			}
+		case profilesnapshot.FieldPositions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field positions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ps.Positions); err != nil {
+					return fmt.Errorf("unmarshal field positions: %w", err)
+				}
+			}
+		case profilesnapshot.FieldEducations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field educations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ps.Educations); err != nil {
+					return fmt.Errorf("unmarshal field educations: %w", err)
+				}
+			}
+		case profilesnapshot.FieldSkills:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field skills", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ps.Skills); err != nil {
+					return fmt.Errorf("unmarshal field skills: %w", err)
+				}
+			}
+		case profilesnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ps.CreatedAt = value.Time
+			}
+		case profilesnapshot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ps.UpdatedAt = value.Time
+			}
+		case profilesnapshot.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_snapshots", values[i])
+			} else if value.Valid {
+				ps.profile_snapshots = new(ulid.ID)
+				*ps.profile_snapshots = *value.S.(*ulid.ID)
+			}
+		default:
+			ps.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProfileSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (ps *ProfileSnapshot) Value(name string) (ent.Value, error) {
+	return ps.selectValues.Get(name)
+}
+
+// QueryProfile queries the "profile" edge of the ProfileSnapshot entity.
+func (ps *ProfileSnapshot) QueryProfile() *ProfileQuery {
+	return NewProfileSnapshotClient(ps.config).QueryProfile(ps)
+}
+
+// QueryChangeEvents queries the "change_events" edge of the ProfileSnapshot entity.
+func (ps *ProfileSnapshot) QueryChangeEvents() *ProfileChangeEventQuery {
+	return NewProfileSnapshotClient(ps.config).QueryChangeEvents(ps)
+}
+
+// Update returns a builder for updating this ProfileSnapshot.
+// Note that you need to call ProfileSnapshot.Unwrap() before calling this method if this ProfileSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ps *ProfileSnapshot) Update() *ProfileSnapshotUpdateOne {
+	return NewProfileSnapshotClient(ps.config).UpdateOne(ps)
+}
+
+// Unwrap unwraps the ProfileSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ps *ProfileSnapshot) Unwrap() *ProfileSnapshot {
+	_tx, ok := ps.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProfileSnapshot is not a transactional entity")
+	}
+	ps.config.driver = _tx.drv
+	return ps
+}
+
+// String implements the fmt.Stringer.
+func (ps *ProfileSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProfileSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ps.ID))
+	builder.WriteString("fetched_at=")
+	builder.WriteString(ps.FetchedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ps.RawDataS3Key; v != nil {
+		builder.WriteString("raw_data_s3_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ps.CleanedDataS3Key; v != nil {
+		builder.WriteString("cleaned_data_s3_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ps.Headline; v != nil {
+		builder.WriteString("headline=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ps.Title; v != nil {
+		builder.WriteString("title=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ps.Country; v != nil {
+		builder.WriteString("country=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ps.City; v != nil {
+		builder.WriteString("city=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("positions=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Positions))
+	builder.WriteString(", ")
+	builder.WriteString("educations=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Educations))
+	builder.WriteString(", ")
+	builder.WriteString("skills=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Skills))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ps.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ps.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedChangeEvents returns the ChangeEvents named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ps *ProfileSnapshot) NamedChangeEvents(name string) ([]*ProfileChangeEvent, error) {
+	if ps.Edges.namedChangeEvents == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ps.Edges.namedChangeEvents[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ps *ProfileSnapshot) appendNamedChangeEvents(name string, edges ...*ProfileChangeEvent) {
+	if ps.Edges.namedChangeEvents == nil {
+		ps.Edges.namedChangeEvents = make(map[string][]*ProfileChangeEvent)
+	}
+	if len(edges) == 0 {
+		ps.Edges.namedChangeEvents[name] = []*ProfileChangeEvent{}
+	} else {
+		ps.Edges.namedChangeEvents[name] = append(ps.Edges.namedChangeEvents[name], edges...)
+	}
+}
+
+// ProfileSnapshots is a parsable slice of ProfileSnapshot.
+type ProfileSnapshots []*ProfileSnapshot
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profilesnapshot
+
+import (
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the profilesnapshot type in the database.
+	Label = "profile_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFetchedAt holds the string denoting the fetched_at field in the database.
+	FieldFetchedAt = "fetched_at"
+	// FieldRawDataS3Key holds the string denoting the raw_data_s3_key field in the database.
+	FieldRawDataS3Key = "raw_data_s3_key"
+	// FieldCleanedDataS3Key holds the string denoting the cleaned_data_s3_key field in the database.
+	FieldCleanedDataS3Key = "cleaned_data_s3_key"
+	// FieldHeadline holds the string denoting the headline field in the database.
+	FieldHeadline = "headline"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldCountry holds the string denoting the country field in the database.
+	FieldCountry = "country"
+	// FieldCity holds the string denoting the city field in the database.
+	FieldCity = "city"
+	// FieldPositions holds the string denoting the positions field in the database.
+	FieldPositions = "positions"
+	// FieldEducations holds the string denoting the educations field in the database.
+	FieldEducations = "educations"
+	// FieldSkills holds the string denoting the skills field in the database.
+	FieldSkills = "skills"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeProfile holds the string denoting the profile edge name in mutations.
+	EdgeProfile = "profile"
+	// EdgeChangeEvents holds the string denoting the change_events edge name in mutations.
+	EdgeChangeEvents = "change_events"
+	// Table holds the table name of the profilesnapshot in the database.
+	Table = "profile_snapshots"
+	// ProfileTable is the table that holds the profile relation/edge.
+	ProfileTable = "profile_snapshots"
+	// ProfileInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	ProfileInverseTable = "profiles"
+	// ProfileColumn is the table column denoting the profile relation/edge.
+	ProfileColumn = "profile_snapshots"
+	// ChangeEventsTable is the table that holds the change_events relation/edge.
+	ChangeEventsTable = "profile_change_events"
+	// ChangeEventsInverseTable is the table name for the ProfileChangeEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "profilechangeevent" package.
+	ChangeEventsInverseTable = "profile_change_events"
+	// ChangeEventsColumn is the table column denoting the change_events relation/edge.
+	ChangeEventsColumn = "profile_snapshot_change_events"
+)
+
+// Columns holds all SQL columns for profilesnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldFetchedAt,
+	FieldRawDataS3Key,
+	FieldCleanedDataS3Key,
+	FieldHeadline,
+	FieldTitle,
+	FieldCountry,
+	FieldCity,
+	FieldPositions,
+	FieldEducations,
+	FieldSkills,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "profile_snapshots"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"profile_snapshots",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RawDataS3KeyValidator is a validator for the "raw_data_s3_key" field. It is called by the builders before save.
+	RawDataS3KeyValidator func(string) error
+	// CleanedDataS3KeyValidator is a validator for the "cleaned_data_s3_key" field. It is called by the builders before save.
+	CleanedDataS3KeyValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() ulid.ID
+)
+
+// OrderOption defines the ordering options for the ProfileSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFetchedAt orders the results by the fetched_at field.
+func ByFetchedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFetchedAt, opts...).ToFunc()
+}
+
+// ByRawDataS3Key orders the results by the raw_data_s3_key field.
+func ByRawDataS3Key(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRawDataS3Key, opts...).ToFunc()
+}
+
+// ByCleanedDataS3Key orders the results by the cleaned_data_s3_key field.
+func ByCleanedDataS3Key(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCleanedDataS3Key, opts...).ToFunc()
+}
+
+// ByHeadline orders the results by the headline field.
+func ByHeadline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeadline, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByCountry orders the results by the country field.
+func ByCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountry, opts...).ToFunc()
+}
+
+// ByCity orders the results by the city field.
+func ByCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCity, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByProfileField orders the results by profile field.
+func ByProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChangeEventsCount orders the results by change_events count.
+func ByChangeEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChangeEventsStep(), opts...)
+	}
+}
+
+// ByChangeEvents orders the results by change_events terms.
+func ByChangeEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChangeEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+	)
+}
+func newChangeEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChangeEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChangeEventsTable, ChangeEventsColumn),
+	)
+}
@@ -0,0 +1,763 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profilesnapshot
+
+import (
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id ulid.ID) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id ulid.ID) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id ulid.ID) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...ulid.ID) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...ulid.ID) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id ulid.ID) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id ulid.ID) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id ulid.ID) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id ulid.ID) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// FetchedAt applies equality check predicate on the "fetched_at" field. It's identical to FetchedAtEQ.
+func FetchedAt(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldFetchedAt, v))
+}
+
+// RawDataS3Key applies equality check predicate on the "raw_data_s3_key" field. It's identical to RawDataS3KeyEQ.
+func RawDataS3Key(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldRawDataS3Key, v))
+}
+
+// CleanedDataS3Key applies equality check predicate on the "cleaned_data_s3_key" field. It's identical to CleanedDataS3KeyEQ.
+func CleanedDataS3Key(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldCleanedDataS3Key, v))
+}
+
+// Headline applies equality check predicate on the "headline" field. It's identical to HeadlineEQ.
+func Headline(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldHeadline, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldTitle, v))
+}
+
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldCountry, v))
+}
+
+// City applies equality check predicate on the "city" field. It's identical to CityEQ.
+func City(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldCity, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// FetchedAtEQ applies the EQ predicate on the "fetched_at" field.
+func FetchedAtEQ(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldFetchedAt, v))
+}
+
+// FetchedAtNEQ applies the NEQ predicate on the "fetched_at" field.
+func FetchedAtNEQ(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNEQ(FieldFetchedAt, v))
+}
+
+// FetchedAtIn applies the In predicate on the "fetched_at" field.
+func FetchedAtIn(vs ...time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIn(FieldFetchedAt, vs...))
+}
+
+// FetchedAtNotIn applies the NotIn predicate on the "fetched_at" field.
+func FetchedAtNotIn(vs ...time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotIn(FieldFetchedAt, vs...))
+}
+
+// FetchedAtGT applies the GT predicate on the "fetched_at" field.
+func FetchedAtGT(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGT(FieldFetchedAt, v))
+}
+
+// FetchedAtGTE applies the GTE predicate on the "fetched_at" field.
+func FetchedAtGTE(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGTE(FieldFetchedAt, v))
+}
+
+// FetchedAtLT applies the LT predicate on the "fetched_at" field.
+func FetchedAtLT(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLT(FieldFetchedAt, v))
+}
+
+// FetchedAtLTE applies the LTE predicate on the "fetched_at" field.
+func FetchedAtLTE(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLTE(FieldFetchedAt, v))
+}
+
+// RawDataS3KeyEQ applies the EQ predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldRawDataS3Key, v))
+}
+
+// RawDataS3KeyNEQ applies the NEQ predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyNEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNEQ(FieldRawDataS3Key, v))
+}
+
+// RawDataS3KeyIn applies the In predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIn(FieldRawDataS3Key, vs...))
+}
+
+// RawDataS3KeyNotIn applies the NotIn predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyNotIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotIn(FieldRawDataS3Key, vs...))
+}
+
+// RawDataS3KeyGT applies the GT predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyGT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGT(FieldRawDataS3Key, v))
+}
+
+// RawDataS3KeyGTE applies the GTE predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyGTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGTE(FieldRawDataS3Key, v))
+}
+
+// RawDataS3KeyLT applies the LT predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyLT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLT(FieldRawDataS3Key, v))
+}
+
+// RawDataS3KeyLTE applies the LTE predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyLTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLTE(FieldRawDataS3Key, v))
+}
+
+// RawDataS3KeyContains applies the Contains predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyContains(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContains(FieldRawDataS3Key, v))
+}
+
+// RawDataS3KeyHasPrefix applies the HasPrefix predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyHasPrefix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasPrefix(FieldRawDataS3Key, v))
+}
+
+// RawDataS3KeyHasSuffix applies the HasSuffix predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyHasSuffix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasSuffix(FieldRawDataS3Key, v))
+}
+
+// RawDataS3KeyIsNil applies the IsNil predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyIsNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIsNull(FieldRawDataS3Key))
+}
+
+// RawDataS3KeyNotNil applies the NotNil predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyNotNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotNull(FieldRawDataS3Key))
+}
+
+// RawDataS3KeyEqualFold applies the EqualFold predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyEqualFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEqualFold(FieldRawDataS3Key, v))
+}
+
+// RawDataS3KeyContainsFold applies the ContainsFold predicate on the "raw_data_s3_key" field.
+func RawDataS3KeyContainsFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContainsFold(FieldRawDataS3Key, v))
+}
+
+// CleanedDataS3KeyEQ applies the EQ predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldCleanedDataS3Key, v))
+}
+
+// CleanedDataS3KeyNEQ applies the NEQ predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyNEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNEQ(FieldCleanedDataS3Key, v))
+}
+
+// CleanedDataS3KeyIn applies the In predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIn(FieldCleanedDataS3Key, vs...))
+}
+
+// CleanedDataS3KeyNotIn applies the NotIn predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyNotIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotIn(FieldCleanedDataS3Key, vs...))
+}
+
+// CleanedDataS3KeyGT applies the GT predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyGT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGT(FieldCleanedDataS3Key, v))
+}
+
+// CleanedDataS3KeyGTE applies the GTE predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyGTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGTE(FieldCleanedDataS3Key, v))
+}
+
+// CleanedDataS3KeyLT applies the LT predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyLT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLT(FieldCleanedDataS3Key, v))
+}
+
+// CleanedDataS3KeyLTE applies the LTE predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyLTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLTE(FieldCleanedDataS3Key, v))
+}
+
+// CleanedDataS3KeyContains applies the Contains predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyContains(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContains(FieldCleanedDataS3Key, v))
+}
+
+// CleanedDataS3KeyHasPrefix applies the HasPrefix predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyHasPrefix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasPrefix(FieldCleanedDataS3Key, v))
+}
+
+// CleanedDataS3KeyHasSuffix applies the HasSuffix predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyHasSuffix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasSuffix(FieldCleanedDataS3Key, v))
+}
+
+// CleanedDataS3KeyIsNil applies the IsNil predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyIsNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIsNull(FieldCleanedDataS3Key))
+}
+
+// CleanedDataS3KeyNotNil applies the NotNil predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyNotNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotNull(FieldCleanedDataS3Key))
+}
+
+// CleanedDataS3KeyEqualFold applies the EqualFold predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyEqualFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEqualFold(FieldCleanedDataS3Key, v))
+}
+
+// CleanedDataS3KeyContainsFold applies the ContainsFold predicate on the "cleaned_data_s3_key" field.
+func CleanedDataS3KeyContainsFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContainsFold(FieldCleanedDataS3Key, v))
+}
+
+// HeadlineEQ applies the EQ predicate on the "headline" field.
+func HeadlineEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldHeadline, v))
+}
+
+// HeadlineNEQ applies the NEQ predicate on the "headline" field.
+func HeadlineNEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNEQ(FieldHeadline, v))
+}
+
+// HeadlineIn applies the In predicate on the "headline" field.
+func HeadlineIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIn(FieldHeadline, vs...))
+}
+
+// HeadlineNotIn applies the NotIn predicate on the "headline" field.
+func HeadlineNotIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotIn(FieldHeadline, vs...))
+}
+
+// HeadlineGT applies the GT predicate on the "headline" field.
+func HeadlineGT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGT(FieldHeadline, v))
+}
+
+// HeadlineGTE applies the GTE predicate on the "headline" field.
+func HeadlineGTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGTE(FieldHeadline, v))
+}
+
+// HeadlineLT applies the LT predicate on the "headline" field.
+func HeadlineLT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLT(FieldHeadline, v))
+}
+
+// HeadlineLTE applies the LTE predicate on the "headline" field.
+func HeadlineLTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLTE(FieldHeadline, v))
+}
+
+// HeadlineContains applies the Contains predicate on the "headline" field.
+func HeadlineContains(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContains(FieldHeadline, v))
+}
+
+// HeadlineHasPrefix applies the HasPrefix predicate on the "headline" field.
+func HeadlineHasPrefix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasPrefix(FieldHeadline, v))
+}
+
+// HeadlineHasSuffix applies the HasSuffix predicate on the "headline" field.
+func HeadlineHasSuffix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasSuffix(FieldHeadline, v))
+}
+
+// HeadlineIsNil applies the IsNil predicate on the "headline" field.
+func HeadlineIsNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIsNull(FieldHeadline))
+}
+
+// HeadlineNotNil applies the NotNil predicate on the "headline" field.
+func HeadlineNotNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotNull(FieldHeadline))
+}
+
+// HeadlineEqualFold applies the EqualFold predicate on the "headline" field.
+func HeadlineEqualFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEqualFold(FieldHeadline, v))
+}
+
+// HeadlineContainsFold applies the ContainsFold predicate on the "headline" field.
+func HeadlineContainsFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContainsFold(FieldHeadline, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldCountry, v))
+}
+
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNEQ(FieldCountry, v))
+}
+
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIn(FieldCountry, vs...))
+}
+
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotIn(FieldCountry, vs...))
+}
+
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGT(FieldCountry, v))
+}
+
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGTE(FieldCountry, v))
+}
+
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLT(FieldCountry, v))
+}
+
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLTE(FieldCountry, v))
+}
+
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContains(FieldCountry, v))
+}
+
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasPrefix(FieldCountry, v))
+}
+
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasSuffix(FieldCountry, v))
+}
+
+// CountryIsNil applies the IsNil predicate on the "country" field.
+func CountryIsNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIsNull(FieldCountry))
+}
+
+// CountryNotNil applies the NotNil predicate on the "country" field.
+func CountryNotNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotNull(FieldCountry))
+}
+
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEqualFold(FieldCountry, v))
+}
+
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContainsFold(FieldCountry, v))
+}
+
+// CityEQ applies the EQ predicate on the "city" field.
+func CityEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldCity, v))
+}
+
+// CityNEQ applies the NEQ predicate on the "city" field.
+func CityNEQ(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNEQ(FieldCity, v))
+}
+
+// CityIn applies the In predicate on the "city" field.
+func CityIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIn(FieldCity, vs...))
+}
+
+// CityNotIn applies the NotIn predicate on the "city" field.
+func CityNotIn(vs ...string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotIn(FieldCity, vs...))
+}
+
+// CityGT applies the GT predicate on the "city" field.
+func CityGT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGT(FieldCity, v))
+}
+
+// CityGTE applies the GTE predicate on the "city" field.
+func CityGTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGTE(FieldCity, v))
+}
+
+// CityLT applies the LT predicate on the "city" field.
+func CityLT(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLT(FieldCity, v))
+}
+
+// CityLTE applies the LTE predicate on the "city" field.
+func CityLTE(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLTE(FieldCity, v))
+}
+
+// CityContains applies the Contains predicate on the "city" field.
+func CityContains(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContains(FieldCity, v))
+}
+
+// CityHasPrefix applies the HasPrefix predicate on the "city" field.
+func CityHasPrefix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasPrefix(FieldCity, v))
+}
+
+// CityHasSuffix applies the HasSuffix predicate on the "city" field.
+func CityHasSuffix(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldHasSuffix(FieldCity, v))
+}
+
+// CityIsNil applies the IsNil predicate on the "city" field.
+func CityIsNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIsNull(FieldCity))
+}
+
+// CityNotNil applies the NotNil predicate on the "city" field.
+func CityNotNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotNull(FieldCity))
+}
+
+// CityEqualFold applies the EqualFold predicate on the "city" field.
+func CityEqualFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEqualFold(FieldCity, v))
+}
+
+// CityContainsFold applies the ContainsFold predicate on the "city" field.
+func CityContainsFold(v string) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldContainsFold(FieldCity, v))
+}
+
+// PositionsIsNil applies the IsNil predicate on the "positions" field.
+func PositionsIsNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIsNull(FieldPositions))
+}
+
+// PositionsNotNil applies the NotNil predicate on the "positions" field.
+func PositionsNotNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotNull(FieldPositions))
+}
+
+// EducationsIsNil applies the IsNil predicate on the "educations" field.
+func EducationsIsNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIsNull(FieldEducations))
+}
+
+// EducationsNotNil applies the NotNil predicate on the "educations" field.
+func EducationsNotNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotNull(FieldEducations))
+}
+
+// SkillsIsNil applies the IsNil predicate on the "skills" field.
+func SkillsIsNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIsNull(FieldSkills))
+}
+
+// SkillsNotNil applies the NotNil predicate on the "skills" field.
+func SkillsNotNil() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotNull(FieldSkills))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChangeEvents applies the HasEdge predicate on the "change_events" edge.
+func HasChangeEvents() predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChangeEventsTable, ChangeEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChangeEventsWith applies the HasEdge predicate on the "change_events" edge with a given conditions (other predicates).
+func HasChangeEventsWith(preds ...predicate.ProfileChangeEvent) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(func(s *sql.Selector) {
+		step := newChangeEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProfileSnapshot) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProfileSnapshot) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProfileSnapshot) predicate.ProfileSnapshot {
+	return predicate.ProfileSnapshot(sql.NotPredicates(p))
+}
@@ -0,0 +1,837 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profilesnapshot"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileSnapshotCreate is the builder for creating a ProfileSnapshot entity.
+type ProfileSnapshotCreate struct {
+	config
+	mutation *ProfileSnapshotMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (psc *ProfileSnapshotCreate) SetFetchedAt(t time.Time) *ProfileSnapshotCreate {
+	psc.mutation.SetFetchedAt(t)
+	return psc
+}
+
+// SetRawDataS3Key sets the "raw_data_s3_key" field.
+func (psc *ProfileSnapshotCreate) SetRawDataS3Key(s string) *ProfileSnapshotCreate {
+	psc.mutation.SetRawDataS3Key(s)
+	return psc
+}
+
+// SetNillableRawDataS3Key sets the "raw_data_s3_key" field if the given value is not nil.
+func (psc *ProfileSnapshotCreate) SetNillableRawDataS3Key(s *string) *ProfileSnapshotCreate {
+	if s != nil {
+		psc.SetRawDataS3Key(*s)
+	}
+	return psc
+}
+
+// SetCleanedDataS3Key sets the "cleaned_data_s3_key" field.
+func (psc *ProfileSnapshotCreate) SetCleanedDataS3Key(s string) *ProfileSnapshotCreate {
+	psc.mutation.SetCleanedDataS3Key(s)
+	return psc
+}
+
+// SetNillableCleanedDataS3Key sets the "cleaned_data_s3_key" field if the given value is not nil.
+func (psc *ProfileSnapshotCreate) SetNillableCleanedDataS3Key(s *string) *ProfileSnapshotCreate {
+	if s != nil {
+		psc.SetCleanedDataS3Key(*s)
+	}
+	return psc
+}
+
+// SetHeadline sets the "headline" field.
+func (psc *ProfileSnapshotCreate) SetHeadline(s string) *ProfileSnapshotCreate {
+	psc.mutation.SetHeadline(s)
+	return psc
+}
+
+// SetNillableHeadline sets the "headline" field if the given value is not nil.
+func (psc *ProfileSnapshotCreate) SetNillableHeadline(s *string) *ProfileSnapshotCreate {
+	if s != nil {
+		psc.SetHeadline(*s)
+	}
+	return psc
+}
+
+// SetTitle sets the "title" field.
+func (psc *ProfileSnapshotCreate) SetTitle(s string) *ProfileSnapshotCreate {
+	psc.mutation.SetTitle(s)
+	return psc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (psc *ProfileSnapshotCreate) SetNillableTitle(s *string) *ProfileSnapshotCreate {
+	if s != nil {
+		psc.SetTitle(*s)
+	}
+	return psc
+}
+
+// SetCountry sets the "country" field.
+func (psc *ProfileSnapshotCreate) SetCountry(s string) *ProfileSnapshotCreate {
+	psc.mutation.SetCountry(s)
+	return psc
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (psc *ProfileSnapshotCreate) SetNillableCountry(s *string) *ProfileSnapshotCreate {
+	if s != nil {
+		psc.SetCountry(*s)
+	}
+	return psc
+}
+
+// SetCity sets the "city" field.
+func (psc *ProfileSnapshotCreate) SetCity(s string) *ProfileSnapshotCreate {
+	psc.mutation.SetCity(s)
+	return psc
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (psc *ProfileSnapshotCreate) SetNillableCity(s *string) *ProfileSnapshotCreate {
+	if s != nil {
+		psc.SetCity(*s)
+	}
+	return psc
+}
+
+// SetPositions sets the "positions" field.
+func (psc *ProfileSnapshotCreate) SetPositions(m []map[string]interface{}) *ProfileSnapshotCreate {
+	psc.mutation.SetPositions(m)
+	return psc
+}
+
+// SetEducations sets the "educations" field.
+func (psc *ProfileSnapshotCreate) SetEducations(m []map[string]interface{}) *ProfileSnapshotCreate {
+	psc.mutation.SetEducations(m)
+	return psc
+}
+
+// SetSkills sets the "skills" field.
+func (psc *ProfileSnapshotCreate) SetSkills(m []map[string]interface{}) *ProfileSnapshotCreate {
+	psc.mutation.SetSkills(m)
+	return psc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (psc *ProfileSnapshotCreate) SetCreatedAt(t time.Time) *ProfileSnapshotCreate {
+	psc.mutation.SetCreatedAt(t)
+	return psc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (psc *ProfileSnapshotCreate) SetNillableCreatedAt(t *time.Time) *ProfileSnapshotCreate {
+	if t != nil {
+		psc.SetCreatedAt(*t)
+	}
+	return psc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psc *ProfileSnapshotCreate) SetUpdatedAt(t time.Time) *ProfileSnapshotCreate {
+	psc.mutation.SetUpdatedAt(t)
+	return psc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (psc *ProfileSnapshotCreate) SetNillableUpdatedAt(t *time.Time) *ProfileSnapshotCreate {
+	if t != nil {
+		psc.SetUpdatedAt(*t)
+	}
+	return psc
+}
+
+// SetID sets the "id" field.
+func (psc *ProfileSnapshotCreate) SetID(u ulid.ID) *ProfileSnapshotCreate {
+	psc.mutation.SetID(u)
+	return psc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (psc *ProfileSnapshotCreate) SetNillableID(u *ulid.ID) *ProfileSnapshotCreate {
+	if u != nil {
+		psc.SetID(*u)
+	}
+	return psc
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (psc *ProfileSnapshotCreate) SetProfileID(id ulid.ID) *ProfileSnapshotCreate {
+	psc.mutation.SetProfileID(id)
+	return psc
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (psc *ProfileSnapshotCreate) SetProfile(p *Profile) *ProfileSnapshotCreate {
+	return psc.SetProfileID(p.ID)
+}
+
+// AddChangeEventIDs adds the "change_events" edge to the ProfileChangeEvent entity by IDs.
+func (psc *ProfileSnapshotCreate) AddChangeEventIDs(ids ...ulid.ID) *ProfileSnapshotCreate {
+	psc.mutation.AddChangeEventIDs(ids...)
+	return psc
+}
+
+// AddChangeEvents adds the "change_events" edges to the ProfileChangeEvent entity.
+func (psc *ProfileSnapshotCreate) AddChangeEvents(p ...*ProfileChangeEvent) *ProfileSnapshotCreate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return psc.AddChangeEventIDs(ids...)
+}
+
+// Mutation returns the ProfileSnapshotMutation object of the builder.
+func (psc *ProfileSnapshotCreate) Mutation() *ProfileSnapshotMutation {
+	return psc.mutation
+}
+
+// Save creates the ProfileSnapshot in the database.
+func (psc *ProfileSnapshotCreate) Save(ctx context.Context) (*ProfileSnapshot, error) {
+	psc.defaults()
+	return withHooks(ctx, psc.sqlSave, psc.mutation, psc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (psc *ProfileSnapshotCreate) SaveX(ctx context.Context) *ProfileSnapshot {
+	v, err := psc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (psc *ProfileSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := psc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psc *ProfileSnapshotCreate) ExecX(ctx context.Context) {
+	if err := psc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psc *ProfileSnapshotCreate) defaults() {
+	if _, ok := psc.mutation.CreatedAt(); !ok {
+		v := profilesnapshot.DefaultCreatedAt()
+		psc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := psc.mutation.UpdatedAt(); !ok {
+		v := profilesnapshot.DefaultUpdatedAt()
+		psc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := psc.mutation.ID(); !ok {
+		v := profilesnapshot.DefaultID()
+		psc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psc *ProfileSnapshotCreate) check() error {
+	if _, ok := psc.mutation.FetchedAt(); !ok {
+		return &ValidationError{Name: "fetched_at", err: errors.New(`ent: missing required field "ProfileSnapshot.fetched_at"`)}
+	}
+	if v, ok := psc.mutation.RawDataS3Key(); ok {
+		if err := profilesnapshot.RawDataS3KeyValidator(v); err != nil {
+			return &ValidationError{Name: "raw_data_s3_key", err: fmt.Errorf(`ent: validator failed for field "ProfileSnapshot.raw_data_s3_key": %w`, err)}
+		}
+	}
+	if v, ok := psc.mutation.CleanedDataS3Key(); ok {
+		if err := profilesnapshot.CleanedDataS3KeyValidator(v); err != nil {
+			return &ValidationError{Name: "cleaned_data_s3_key", err: fmt.Errorf(`ent: validator failed for field "ProfileSnapshot.cleaned_data_s3_key": %w`, err)}
+		}
+	}
+	if _, ok := psc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProfileSnapshot.created_at"`)}
+	}
+	if _, ok := psc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProfileSnapshot.updated_at"`)}
+	}
+	if len(psc.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "ProfileSnapshot.profile"`)}
+	}
+	return nil
+}
+
+func (psc *ProfileSnapshotCreate) sqlSave(ctx context.Context) (*ProfileSnapshot, error) {
+	if err := psc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := psc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, psc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*ulid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	psc.mutation.id = &_node.ID
+	psc.mutation.done = true
+	return _node, nil
+}
+
+func (psc *ProfileSnapshotCreate) createSpec() (*ProfileSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProfileSnapshot{config: psc.config}
+		_spec = sqlgraph.NewCreateSpec(profilesnapshot.Table, sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = psc.conflict
+	if id, ok := psc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := psc.mutation.FetchedAt(); ok {
+		_spec.SetField(profilesnapshot.FieldFetchedAt, field.TypeTime, value)
+		_node.FetchedAt = value
+	}
+	if value, ok := psc.mutation.RawDataS3Key(); ok {
+		_spec.SetField(profilesnapshot.FieldRawDataS3Key, field.TypeString, value)
+		_node.RawDataS3Key = &value
+	}
+	if value, ok := psc.mutation.CleanedDataS3Key(); ok {
+		_spec.SetField(profilesnapshot.FieldCleanedDataS3Key, field.TypeString, value)
+		_node.CleanedDataS3Key = &value
+	}
+	if value, ok := psc.mutation.Headline(); ok {
+		_spec.SetField(profilesnapshot.FieldHeadline, field.TypeString, value)
+		_node.Headline = &value
+	}
+	if value, ok := psc.mutation.Title(); ok {
+		_spec.SetField(profilesnapshot.FieldTitle, field.TypeString, value)
+		_node.Title = &value
+	}
+	if value, ok := psc.mutation.Country(); ok {
+		_spec.SetField(profilesnapshot.FieldCountry, field.TypeString, value)
+		_node.Country = &value
+	}
+	if value, ok := psc.mutation.City(); ok {
+		_spec.SetField(profilesnapshot.FieldCity, field.TypeString, value)
+		_node.City = &value
+	}
+	if value, ok := psc.mutation.Positions(); ok {
+		_spec.SetField(profilesnapshot.FieldPositions, field.TypeJSON, value)
+		_node.Positions = value
+	}
+	if value, ok := psc.mutation.Educations(); ok {
+		_spec.SetField(profilesnapshot.FieldEducations, field.TypeJSON, value)
+		_node.Educations = value
+	}
+	if value, ok := psc.mutation.Skills(); ok {
+		_spec.SetField(profilesnapshot.FieldSkills, field.TypeJSON, value)
+		_node.Skills = value
+	}
+	if value, ok := psc.mutation.CreatedAt(); ok {
+		_spec.SetField(profilesnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := psc.mutation.UpdatedAt(); ok {
+		_spec.SetField(profilesnapshot.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := psc.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilesnapshot.ProfileTable,
+			Columns: []string{profilesnapshot.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.profile_snapshots = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := psc.mutation.ChangeEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profilesnapshot.ChangeEventsTable,
+			Columns: []string{profilesnapshot.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfileSnapshot.Create().
+//		SetFetchedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileSnapshotUpsert) {
+//			SetFetchedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (psc *ProfileSnapshotCreate) OnConflict(opts ...sql.ConflictOption) *ProfileSnapshotUpsertOne {
+	psc.conflict = opts
+	return &ProfileSnapshotUpsertOne{
+		create: psc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfileSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (psc *ProfileSnapshotCreate) OnConflictColumns(columns ...string) *ProfileSnapshotUpsertOne {
+	psc.conflict = append(psc.conflict, sql.ConflictColumns(columns...))
+	return &ProfileSnapshotUpsertOne{
+		create: psc,
+	}
+}
+
+type (
+	// ProfileSnapshotUpsertOne is the builder for "upsert"-ing
+	//  one ProfileSnapshot node.
+	ProfileSnapshotUpsertOne struct {
+		create *ProfileSnapshotCreate
+	}
+
+	// ProfileSnapshotUpsert is the "OnConflict" setter.
+	ProfileSnapshotUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileSnapshotUpsert) SetUpdatedAt(v time.Time) *ProfileSnapshotUpsert {
+	u.Set(profilesnapshot.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileSnapshotUpsert) UpdateUpdatedAt() *ProfileSnapshotUpsert {
+	u.SetExcluded(profilesnapshot.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProfileSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profilesnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileSnapshotUpsertOne) UpdateNewValues() *ProfileSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(profilesnapshot.FieldID)
+		}
+		if _, exists := u.create.mutation.FetchedAt(); exists {
+			s.SetIgnore(profilesnapshot.FieldFetchedAt)
+		}
+		if _, exists := u.create.mutation.RawDataS3Key(); exists {
+			s.SetIgnore(profilesnapshot.FieldRawDataS3Key)
+		}
+		if _, exists := u.create.mutation.CleanedDataS3Key(); exists {
+			s.SetIgnore(profilesnapshot.FieldCleanedDataS3Key)
+		}
+		if _, exists := u.create.mutation.Headline(); exists {
+			s.SetIgnore(profilesnapshot.FieldHeadline)
+		}
+		if _, exists := u.create.mutation.Title(); exists {
+			s.SetIgnore(profilesnapshot.FieldTitle)
+		}
+		if _, exists := u.create.mutation.Country(); exists {
+			s.SetIgnore(profilesnapshot.FieldCountry)
+		}
+		if _, exists := u.create.mutation.City(); exists {
+			s.SetIgnore(profilesnapshot.FieldCity)
+		}
+		if _, exists := u.create.mutation.Positions(); exists {
+			s.SetIgnore(profilesnapshot.FieldPositions)
+		}
+		if _, exists := u.create.mutation.Educations(); exists {
+			s.SetIgnore(profilesnapshot.FieldEducations)
+		}
+		if _, exists := u.create.mutation.Skills(); exists {
+			s.SetIgnore(profilesnapshot.FieldSkills)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(profilesnapshot.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfileSnapshot.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProfileSnapshotUpsertOne) Ignore() *ProfileSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileSnapshotUpsertOne) DoNothing() *ProfileSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileSnapshotCreate.OnConflict
+// documentation for more info.
+func (u *ProfileSnapshotUpsertOne) Update(set func(*ProfileSnapshotUpsert)) *ProfileSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileSnapshotUpsertOne) SetUpdatedAt(v time.Time) *ProfileSnapshotUpsertOne {
+	return u.Update(func(s *ProfileSnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileSnapshotUpsertOne) UpdateUpdatedAt() *ProfileSnapshotUpsertOne {
+	return u.Update(func(s *ProfileSnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileSnapshotUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileSnapshotCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileSnapshotUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProfileSnapshotUpsertOne) ID(ctx context.Context) (id ulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProfileSnapshotUpsertOne.ID is not supported by MySQL driver. Use ProfileSnapshotUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProfileSnapshotUpsertOne) IDX(ctx context.Context) ulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProfileSnapshotCreateBulk is the builder for creating many ProfileSnapshot entities in bulk.
+type ProfileSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*ProfileSnapshotCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProfileSnapshot entities in the database.
+func (pscb *ProfileSnapshotCreateBulk) Save(ctx context.Context) ([]*ProfileSnapshot, error) {
+	if pscb.err != nil {
+		return nil, pscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pscb.builders))
+	nodes := make([]*ProfileSnapshot, len(pscb.builders))
+	mutators := make([]Mutator, len(pscb.builders))
+	for i := range pscb.builders {
+		func(i int, root context.Context) {
+			builder := pscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProfileSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pscb *ProfileSnapshotCreateBulk) SaveX(ctx context.Context) []*ProfileSnapshot {
+	v, err := pscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pscb *ProfileSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := pscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pscb *ProfileSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := pscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProfileSnapshot.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileSnapshotUpsert) {
+//			SetFetchedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pscb *ProfileSnapshotCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProfileSnapshotUpsertBulk {
+	pscb.conflict = opts
+	return &ProfileSnapshotUpsertBulk{
+		create: pscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProfileSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pscb *ProfileSnapshotCreateBulk) OnConflictColumns(columns ...string) *ProfileSnapshotUpsertBulk {
+	pscb.conflict = append(pscb.conflict, sql.ConflictColumns(columns...))
+	return &ProfileSnapshotUpsertBulk{
+		create: pscb,
+	}
+}
+
+// ProfileSnapshotUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProfileSnapshot nodes.
+type ProfileSnapshotUpsertBulk struct {
+	create *ProfileSnapshotCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProfileSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profilesnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileSnapshotUpsertBulk) UpdateNewValues() *ProfileSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(profilesnapshot.FieldID)
+			}
+			if _, exists := b.mutation.FetchedAt(); exists {
+				s.SetIgnore(profilesnapshot.FieldFetchedAt)
+			}
+			if _, exists := b.mutation.RawDataS3Key(); exists {
+				s.SetIgnore(profilesnapshot.FieldRawDataS3Key)
+			}
+			if _, exists := b.mutation.CleanedDataS3Key(); exists {
+				s.SetIgnore(profilesnapshot.FieldCleanedDataS3Key)
+			}
+			if _, exists := b.mutation.Headline(); exists {
+				s.SetIgnore(profilesnapshot.FieldHeadline)
+			}
+			if _, exists := b.mutation.Title(); exists {
+				s.SetIgnore(profilesnapshot.FieldTitle)
+			}
+			if _, exists := b.mutation.Country(); exists {
+				s.SetIgnore(profilesnapshot.FieldCountry)
+			}
+			if _, exists := b.mutation.City(); exists {
+				s.SetIgnore(profilesnapshot.FieldCity)
+			}
+			if _, exists := b.mutation.Positions(); exists {
+				s.SetIgnore(profilesnapshot.FieldPositions)
+			}
+			if _, exists := b.mutation.Educations(); exists {
+				s.SetIgnore(profilesnapshot.FieldEducations)
+			}
+			if _, exists := b.mutation.Skills(); exists {
+				s.SetIgnore(profilesnapshot.FieldSkills)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(profilesnapshot.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProfileSnapshot.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProfileSnapshotUpsertBulk) Ignore() *ProfileSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileSnapshotUpsertBulk) DoNothing() *ProfileSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileSnapshotCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProfileSnapshotUpsertBulk) Update(set func(*ProfileSnapshotUpsert)) *ProfileSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileSnapshotUpsertBulk) SetUpdatedAt(v time.Time) *ProfileSnapshotUpsertBulk {
+	return u.Update(func(s *ProfileSnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileSnapshotUpsertBulk) UpdateUpdatedAt() *ProfileSnapshotUpsertBulk {
+	return u.Update(func(s *ProfileSnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileSnapshotUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProfileSnapshotCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileSnapshotCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileSnapshotUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
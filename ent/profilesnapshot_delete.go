@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profilesnapshot"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileSnapshotDelete is the builder for deleting a ProfileSnapshot entity.
+type ProfileSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProfileSnapshotMutation
+}
+
+// Where appends a list predicates to the ProfileSnapshotDelete builder.
+func (psd *ProfileSnapshotDelete) Where(ps ...predicate.ProfileSnapshot) *ProfileSnapshotDelete {
+	psd.mutation.Where(ps...)
+	return psd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (psd *ProfileSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, psd.sqlExec, psd.mutation, psd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psd *ProfileSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := psd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (psd *ProfileSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(profilesnapshot.Table, sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString))
+	if ps := psd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, psd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	psd.mutation.done = true
+	return affected, err
+}
+
+// ProfileSnapshotDeleteOne is the builder for deleting a single ProfileSnapshot entity.
+type ProfileSnapshotDeleteOne struct {
+	psd *ProfileSnapshotDelete
+}
+
+// Where appends a list predicates to the ProfileSnapshotDelete builder.
+func (psdo *ProfileSnapshotDeleteOne) Where(ps ...predicate.ProfileSnapshot) *ProfileSnapshotDeleteOne {
+	psdo.psd.mutation.Where(ps...)
+	return psdo
+}
+
+// Exec executes the deletion query.
+func (psdo *ProfileSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := psdo.psd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{profilesnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psdo *ProfileSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := psdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
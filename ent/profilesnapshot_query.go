@@ -0,0 +1,758 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profilesnapshot"
+	"sheng-go-backend/ent/schema/ulid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileSnapshotQuery is the builder for querying ProfileSnapshot entities.
+type ProfileSnapshotQuery struct {
+	config
+	ctx                   *QueryContext
+	order                 []profilesnapshot.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.ProfileSnapshot
+	withProfile           *ProfileQuery
+	withChangeEvents      *ProfileChangeEventQuery
+	withFKs               bool
+	loadTotal             []func(context.Context, []*ProfileSnapshot) error
+	modifiers             []func(*sql.Selector)
+	withNamedChangeEvents map[string]*ProfileChangeEventQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProfileSnapshotQuery builder.
+func (psq *ProfileSnapshotQuery) Where(ps ...predicate.ProfileSnapshot) *ProfileSnapshotQuery {
+	psq.predicates = append(psq.predicates, ps...)
+	return psq
+}
+
+// Limit the number of records to be returned by this query.
+func (psq *ProfileSnapshotQuery) Limit(limit int) *ProfileSnapshotQuery {
+	psq.ctx.Limit = &limit
+	return psq
+}
+
+// Offset to start from.
+func (psq *ProfileSnapshotQuery) Offset(offset int) *ProfileSnapshotQuery {
+	psq.ctx.Offset = &offset
+	return psq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (psq *ProfileSnapshotQuery) Unique(unique bool) *ProfileSnapshotQuery {
+	psq.ctx.Unique = &unique
+	return psq
+}
+
+// Order specifies how the records should be ordered.
+func (psq *ProfileSnapshotQuery) Order(o ...profilesnapshot.OrderOption) *ProfileSnapshotQuery {
+	psq.order = append(psq.order, o...)
+	return psq
+}
+
+// QueryProfile chains the current query on the "profile" edge.
+func (psq *ProfileSnapshotQuery) QueryProfile() *ProfileQuery {
+	query := (&ProfileClient{config: psq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := psq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := psq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profilesnapshot.Table, profilesnapshot.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, profilesnapshot.ProfileTable, profilesnapshot.ProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(psq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChangeEvents chains the current query on the "change_events" edge.
+func (psq *ProfileSnapshotQuery) QueryChangeEvents() *ProfileChangeEventQuery {
+	query := (&ProfileChangeEventClient{config: psq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := psq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := psq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profilesnapshot.Table, profilesnapshot.FieldID, selector),
+			sqlgraph.To(profilechangeevent.Table, profilechangeevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profilesnapshot.ChangeEventsTable, profilesnapshot.ChangeEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(psq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProfileSnapshot entity from the query.
+// Returns a *NotFoundError when no ProfileSnapshot was found.
+func (psq *ProfileSnapshotQuery) First(ctx context.Context) (*ProfileSnapshot, error) {
+	nodes, err := psq.Limit(1).All(setContextOp(ctx, psq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{profilesnapshot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (psq *ProfileSnapshotQuery) FirstX(ctx context.Context) *ProfileSnapshot {
+	node, err := psq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProfileSnapshot ID from the query.
+// Returns a *NotFoundError when no ProfileSnapshot ID was found.
+func (psq *ProfileSnapshotQuery) FirstID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = psq.Limit(1).IDs(setContextOp(ctx, psq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{profilesnapshot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (psq *ProfileSnapshotQuery) FirstIDX(ctx context.Context) ulid.ID {
+	id, err := psq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProfileSnapshot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProfileSnapshot entity is found.
+// Returns a *NotFoundError when no ProfileSnapshot entities are found.
+func (psq *ProfileSnapshotQuery) Only(ctx context.Context) (*ProfileSnapshot, error) {
+	nodes, err := psq.Limit(2).All(setContextOp(ctx, psq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{profilesnapshot.Label}
+	default:
+		return nil, &NotSingularError{profilesnapshot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (psq *ProfileSnapshotQuery) OnlyX(ctx context.Context) *ProfileSnapshot {
+	node, err := psq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProfileSnapshot ID in the query.
+// Returns a *NotSingularError when more than one ProfileSnapshot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (psq *ProfileSnapshotQuery) OnlyID(ctx context.Context) (id ulid.ID, err error) {
+	var ids []ulid.ID
+	if ids, err = psq.Limit(2).IDs(setContextOp(ctx, psq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{profilesnapshot.Label}
+	default:
+		err = &NotSingularError{profilesnapshot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (psq *ProfileSnapshotQuery) OnlyIDX(ctx context.Context) ulid.ID {
+	id, err := psq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProfileSnapshots.
+func (psq *ProfileSnapshotQuery) All(ctx context.Context) ([]*ProfileSnapshot, error) {
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryAll)
+	if err := psq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProfileSnapshot, *ProfileSnapshotQuery]()
+	return withInterceptors[[]*ProfileSnapshot](ctx, psq, qr, psq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (psq *ProfileSnapshotQuery) AllX(ctx context.Context) []*ProfileSnapshot {
+	nodes, err := psq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProfileSnapshot IDs.
+func (psq *ProfileSnapshotQuery) IDs(ctx context.Context) (ids []ulid.ID, err error) {
+	if psq.ctx.Unique == nil && psq.path != nil {
+		psq.Unique(true)
+	}
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryIDs)
+	if err = psq.Select(profilesnapshot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (psq *ProfileSnapshotQuery) IDsX(ctx context.Context) []ulid.ID {
+	ids, err := psq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (psq *ProfileSnapshotQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryCount)
+	if err := psq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, psq, querierCount[*ProfileSnapshotQuery](), psq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (psq *ProfileSnapshotQuery) CountX(ctx context.Context) int {
+	count, err := psq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (psq *ProfileSnapshotQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryExist)
+	switch _, err := psq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (psq *ProfileSnapshotQuery) ExistX(ctx context.Context) bool {
+	exist, err := psq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProfileSnapshotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (psq *ProfileSnapshotQuery) Clone() *ProfileSnapshotQuery {
+	if psq == nil {
+		return nil
+	}
+	return &ProfileSnapshotQuery{
+		config:           psq.config,
+		ctx:              psq.ctx.Clone(),
+		order:            append([]profilesnapshot.OrderOption{}, psq.order...),
+		inters:           append([]Interceptor{}, psq.inters...),
+		predicates:       append([]predicate.ProfileSnapshot{}, psq.predicates...),
+		withProfile:      psq.withProfile.Clone(),
+		withChangeEvents: psq.withChangeEvents.Clone(),
+		// clone intermediate query.
+		sql:  psq.sql.Clone(),
+		path: psq.path,
+	}
+}
+
+// WithProfile tells the query-builder to eager-load the nodes that are connected to
+// the "profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (psq *ProfileSnapshotQuery) WithProfile(opts ...func(*ProfileQuery)) *ProfileSnapshotQuery {
+	query := (&ProfileClient{config: psq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	psq.withProfile = query
+	return psq
+}
+
+// WithChangeEvents tells the query-builder to eager-load the nodes that are connected to
+// the "change_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (psq *ProfileSnapshotQuery) WithChangeEvents(opts ...func(*ProfileChangeEventQuery)) *ProfileSnapshotQuery {
+	query := (&ProfileChangeEventClient{config: psq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	psq.withChangeEvents = query
+	return psq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		FetchedAt time.Time `json:"fetched_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProfileSnapshot.Query().
+//		GroupBy(profilesnapshot.FieldFetchedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (psq *ProfileSnapshotQuery) GroupBy(field string, fields ...string) *ProfileSnapshotGroupBy {
+	psq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProfileSnapshotGroupBy{build: psq}
+	grbuild.flds = &psq.ctx.Fields
+	grbuild.label = profilesnapshot.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		FetchedAt time.Time `json:"fetched_at,omitempty"`
+//	}
+//
+//	client.ProfileSnapshot.Query().
+//		Select(profilesnapshot.FieldFetchedAt).
+//		Scan(ctx, &v)
+func (psq *ProfileSnapshotQuery) Select(fields ...string) *ProfileSnapshotSelect {
+	psq.ctx.Fields = append(psq.ctx.Fields, fields...)
+	sbuild := &ProfileSnapshotSelect{ProfileSnapshotQuery: psq}
+	sbuild.label = profilesnapshot.Label
+	sbuild.flds, sbuild.scan = &psq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProfileSnapshotSelect configured with the given aggregations.
+func (psq *ProfileSnapshotQuery) Aggregate(fns ...AggregateFunc) *ProfileSnapshotSelect {
+	return psq.Select().Aggregate(fns...)
+}
+
+func (psq *ProfileSnapshotQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range psq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, psq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range psq.ctx.Fields {
+		if !profilesnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if psq.path != nil {
+		prev, err := psq.path(ctx)
+		if err != nil {
+			return err
+		}
+		psq.sql = prev
+	}
+	return nil
+}
+
+func (psq *ProfileSnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProfileSnapshot, error) {
+	var (
+		nodes       = []*ProfileSnapshot{}
+		withFKs     = psq.withFKs
+		_spec       = psq.querySpec()
+		loadedTypes = [2]bool{
+			psq.withProfile != nil,
+			psq.withChangeEvents != nil,
+		}
+	)
+	if psq.withProfile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, profilesnapshot.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProfileSnapshot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProfileSnapshot{config: psq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(psq.modifiers) > 0 {
+		_spec.Modifiers = psq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, psq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := psq.withProfile; query != nil {
+		if err := psq.loadProfile(ctx, query, nodes, nil,
+			func(n *ProfileSnapshot, e *Profile) { n.Edges.Profile = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := psq.withChangeEvents; query != nil {
+		if err := psq.loadChangeEvents(ctx, query, nodes,
+			func(n *ProfileSnapshot) { n.Edges.ChangeEvents = []*ProfileChangeEvent{} },
+			func(n *ProfileSnapshot, e *ProfileChangeEvent) {
+				n.Edges.ChangeEvents = append(n.Edges.ChangeEvents, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range psq.withNamedChangeEvents {
+		if err := psq.loadChangeEvents(ctx, query, nodes,
+			func(n *ProfileSnapshot) { n.appendNamedChangeEvents(name) },
+			func(n *ProfileSnapshot, e *ProfileChangeEvent) { n.appendNamedChangeEvents(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range psq.loadTotal {
+		if err := psq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (psq *ProfileSnapshotQuery) loadProfile(ctx context.Context, query *ProfileQuery, nodes []*ProfileSnapshot, init func(*ProfileSnapshot), assign func(*ProfileSnapshot, *Profile)) error {
+	ids := make([]ulid.ID, 0, len(nodes))
+	nodeids := make(map[ulid.ID][]*ProfileSnapshot)
+	for i := range nodes {
+		if nodes[i].profile_snapshots == nil {
+			continue
+		}
+		fk := *nodes[i].profile_snapshots
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(profile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "profile_snapshots" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (psq *ProfileSnapshotQuery) loadChangeEvents(ctx context.Context, query *ProfileChangeEventQuery, nodes []*ProfileSnapshot, init func(*ProfileSnapshot), assign func(*ProfileSnapshot, *ProfileChangeEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[ulid.ID]*ProfileSnapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProfileChangeEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profilesnapshot.ChangeEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.profile_snapshot_change_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "profile_snapshot_change_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_snapshot_change_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (psq *ProfileSnapshotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := psq.querySpec()
+	if len(psq.modifiers) > 0 {
+		_spec.Modifiers = psq.modifiers
+	}
+	_spec.Node.Columns = psq.ctx.Fields
+	if len(psq.ctx.Fields) > 0 {
+		_spec.Unique = psq.ctx.Unique != nil && *psq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, psq.driver, _spec)
+}
+
+func (psq *ProfileSnapshotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(profilesnapshot.Table, profilesnapshot.Columns, sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString))
+	_spec.From = psq.sql
+	if unique := psq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if psq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := psq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profilesnapshot.FieldID)
+		for i := range fields {
+			if fields[i] != profilesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := psq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := psq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := psq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := psq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (psq *ProfileSnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(psq.driver.Dialect())
+	t1 := builder.Table(profilesnapshot.Table)
+	columns := psq.ctx.Fields
+	if len(columns) == 0 {
+		columns = profilesnapshot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if psq.sql != nil {
+		selector = psq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if psq.ctx.Unique != nil && *psq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range psq.modifiers {
+		m(selector)
+	}
+	for _, p := range psq.predicates {
+		p(selector)
+	}
+	for _, p := range psq.order {
+		p(selector)
+	}
+	if offset := psq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := psq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (psq *ProfileSnapshotQuery) ForUpdate(opts ...sql.LockOption) *ProfileSnapshotQuery {
+	if psq.driver.Dialect() == dialect.Postgres {
+		psq.Unique(false)
+	}
+	psq.modifiers = append(psq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return psq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (psq *ProfileSnapshotQuery) ForShare(opts ...sql.LockOption) *ProfileSnapshotQuery {
+	if psq.driver.Dialect() == dialect.Postgres {
+		psq.Unique(false)
+	}
+	psq.modifiers = append(psq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return psq
+}
+
+// WithNamedChangeEvents tells the query-builder to eager-load the nodes that are connected to the "change_events"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (psq *ProfileSnapshotQuery) WithNamedChangeEvents(name string, opts ...func(*ProfileChangeEventQuery)) *ProfileSnapshotQuery {
+	query := (&ProfileChangeEventClient{config: psq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if psq.withNamedChangeEvents == nil {
+		psq.withNamedChangeEvents = make(map[string]*ProfileChangeEventQuery)
+	}
+	psq.withNamedChangeEvents[name] = query
+	return psq
+}
+
+// ProfileSnapshotGroupBy is the group-by builder for ProfileSnapshot entities.
+type ProfileSnapshotGroupBy struct {
+	selector
+	build *ProfileSnapshotQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (psgb *ProfileSnapshotGroupBy) Aggregate(fns ...AggregateFunc) *ProfileSnapshotGroupBy {
+	psgb.fns = append(psgb.fns, fns...)
+	return psgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (psgb *ProfileSnapshotGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, psgb.build.ctx, ent.OpQueryGroupBy)
+	if err := psgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfileSnapshotQuery, *ProfileSnapshotGroupBy](ctx, psgb.build, psgb, psgb.build.inters, v)
+}
+
+func (psgb *ProfileSnapshotGroupBy) sqlScan(ctx context.Context, root *ProfileSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(psgb.fns))
+	for _, fn := range psgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*psgb.flds)+len(psgb.fns))
+		for _, f := range *psgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*psgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := psgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProfileSnapshotSelect is the builder for selecting fields of ProfileSnapshot entities.
+type ProfileSnapshotSelect struct {
+	*ProfileSnapshotQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pss *ProfileSnapshotSelect) Aggregate(fns ...AggregateFunc) *ProfileSnapshotSelect {
+	pss.fns = append(pss.fns, fns...)
+	return pss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pss *ProfileSnapshotSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pss.ctx, ent.OpQuerySelect)
+	if err := pss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfileSnapshotQuery, *ProfileSnapshotSelect](ctx, pss.ProfileSnapshotQuery, pss, pss.inters, v)
+}
+
+func (pss *ProfileSnapshotSelect) sqlScan(ctx context.Context, root *ProfileSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pss.fns))
+	for _, fn := range pss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profilesnapshot"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileSnapshotUpdate is the builder for updating ProfileSnapshot entities.
+type ProfileSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProfileSnapshotMutation
+}
+
+// Where appends a list predicates to the ProfileSnapshotUpdate builder.
+func (psu *ProfileSnapshotUpdate) Where(ps ...predicate.ProfileSnapshot) *ProfileSnapshotUpdate {
+	psu.mutation.Where(ps...)
+	return psu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psu *ProfileSnapshotUpdate) SetUpdatedAt(t time.Time) *ProfileSnapshotUpdate {
+	psu.mutation.SetUpdatedAt(t)
+	return psu
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (psu *ProfileSnapshotUpdate) SetProfileID(id ulid.ID) *ProfileSnapshotUpdate {
+	psu.mutation.SetProfileID(id)
+	return psu
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (psu *ProfileSnapshotUpdate) SetProfile(p *Profile) *ProfileSnapshotUpdate {
+	return psu.SetProfileID(p.ID)
+}
+
+// AddChangeEventIDs adds the "change_events" edge to the ProfileChangeEvent entity by IDs.
+func (psu *ProfileSnapshotUpdate) AddChangeEventIDs(ids ...ulid.ID) *ProfileSnapshotUpdate {
+	psu.mutation.AddChangeEventIDs(ids...)
+	return psu
+}
+
+// AddChangeEvents adds the "change_events" edges to the ProfileChangeEvent entity.
+func (psu *ProfileSnapshotUpdate) AddChangeEvents(p ...*ProfileChangeEvent) *ProfileSnapshotUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return psu.AddChangeEventIDs(ids...)
+}
+
+// Mutation returns the ProfileSnapshotMutation object of the builder.
+func (psu *ProfileSnapshotUpdate) Mutation() *ProfileSnapshotMutation {
+	return psu.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (psu *ProfileSnapshotUpdate) ClearProfile() *ProfileSnapshotUpdate {
+	psu.mutation.ClearProfile()
+	return psu
+}
+
+// ClearChangeEvents clears all "change_events" edges to the ProfileChangeEvent entity.
+func (psu *ProfileSnapshotUpdate) ClearChangeEvents() *ProfileSnapshotUpdate {
+	psu.mutation.ClearChangeEvents()
+	return psu
+}
+
+// RemoveChangeEventIDs removes the "change_events" edge to ProfileChangeEvent entities by IDs.
+func (psu *ProfileSnapshotUpdate) RemoveChangeEventIDs(ids ...ulid.ID) *ProfileSnapshotUpdate {
+	psu.mutation.RemoveChangeEventIDs(ids...)
+	return psu
+}
+
+// RemoveChangeEvents removes "change_events" edges to ProfileChangeEvent entities.
+func (psu *ProfileSnapshotUpdate) RemoveChangeEvents(p ...*ProfileChangeEvent) *ProfileSnapshotUpdate {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return psu.RemoveChangeEventIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (psu *ProfileSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	psu.defaults()
+	return withHooks(ctx, psu.sqlSave, psu.mutation, psu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psu *ProfileSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := psu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (psu *ProfileSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := psu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psu *ProfileSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := psu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psu *ProfileSnapshotUpdate) defaults() {
+	if _, ok := psu.mutation.UpdatedAt(); !ok {
+		v := profilesnapshot.UpdateDefaultUpdatedAt()
+		psu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psu *ProfileSnapshotUpdate) check() error {
+	if psu.mutation.ProfileCleared() && len(psu.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProfileSnapshot.profile"`)
+	}
+	return nil
+}
+
+func (psu *ProfileSnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := psu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profilesnapshot.Table, profilesnapshot.Columns, sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString))
+	if ps := psu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if psu.mutation.RawDataS3KeyCleared() {
+		_spec.ClearField(profilesnapshot.FieldRawDataS3Key, field.TypeString)
+	}
+	if psu.mutation.CleanedDataS3KeyCleared() {
+		_spec.ClearField(profilesnapshot.FieldCleanedDataS3Key, field.TypeString)
+	}
+	if psu.mutation.HeadlineCleared() {
+		_spec.ClearField(profilesnapshot.FieldHeadline, field.TypeString)
+	}
+	if psu.mutation.TitleCleared() {
+		_spec.ClearField(profilesnapshot.FieldTitle, field.TypeString)
+	}
+	if psu.mutation.CountryCleared() {
+		_spec.ClearField(profilesnapshot.FieldCountry, field.TypeString)
+	}
+	if psu.mutation.CityCleared() {
+		_spec.ClearField(profilesnapshot.FieldCity, field.TypeString)
+	}
+	if psu.mutation.PositionsCleared() {
+		_spec.ClearField(profilesnapshot.FieldPositions, field.TypeJSON)
+	}
+	if psu.mutation.EducationsCleared() {
+		_spec.ClearField(profilesnapshot.FieldEducations, field.TypeJSON)
+	}
+	if psu.mutation.SkillsCleared() {
+		_spec.ClearField(profilesnapshot.FieldSkills, field.TypeJSON)
+	}
+	if value, ok := psu.mutation.UpdatedAt(); ok {
+		_spec.SetField(profilesnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if psu.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilesnapshot.ProfileTable,
+			Columns: []string{profilesnapshot.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psu.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilesnapshot.ProfileTable,
+			Columns: []string{profilesnapshot.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if psu.mutation.ChangeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profilesnapshot.ChangeEventsTable,
+			Columns: []string{profilesnapshot.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psu.mutation.RemovedChangeEventsIDs(); len(nodes) > 0 && !psu.mutation.ChangeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profilesnapshot.ChangeEventsTable,
+			Columns: []string{profilesnapshot.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psu.mutation.ChangeEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profilesnapshot.ChangeEventsTable,
+			Columns: []string{profilesnapshot.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, psu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profilesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	psu.mutation.done = true
+	return n, nil
+}
+
+// ProfileSnapshotUpdateOne is the builder for updating a single ProfileSnapshot entity.
+type ProfileSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProfileSnapshotMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psuo *ProfileSnapshotUpdateOne) SetUpdatedAt(t time.Time) *ProfileSnapshotUpdateOne {
+	psuo.mutation.SetUpdatedAt(t)
+	return psuo
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (psuo *ProfileSnapshotUpdateOne) SetProfileID(id ulid.ID) *ProfileSnapshotUpdateOne {
+	psuo.mutation.SetProfileID(id)
+	return psuo
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (psuo *ProfileSnapshotUpdateOne) SetProfile(p *Profile) *ProfileSnapshotUpdateOne {
+	return psuo.SetProfileID(p.ID)
+}
+
+// AddChangeEventIDs adds the "change_events" edge to the ProfileChangeEvent entity by IDs.
+func (psuo *ProfileSnapshotUpdateOne) AddChangeEventIDs(ids ...ulid.ID) *ProfileSnapshotUpdateOne {
+	psuo.mutation.AddChangeEventIDs(ids...)
+	return psuo
+}
+
+// AddChangeEvents adds the "change_events" edges to the ProfileChangeEvent entity.
+func (psuo *ProfileSnapshotUpdateOne) AddChangeEvents(p ...*ProfileChangeEvent) *ProfileSnapshotUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return psuo.AddChangeEventIDs(ids...)
+}
+
+// Mutation returns the ProfileSnapshotMutation object of the builder.
+func (psuo *ProfileSnapshotUpdateOne) Mutation() *ProfileSnapshotMutation {
+	return psuo.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (psuo *ProfileSnapshotUpdateOne) ClearProfile() *ProfileSnapshotUpdateOne {
+	psuo.mutation.ClearProfile()
+	return psuo
+}
+
+// ClearChangeEvents clears all "change_events" edges to the ProfileChangeEvent entity.
+func (psuo *ProfileSnapshotUpdateOne) ClearChangeEvents() *ProfileSnapshotUpdateOne {
+	psuo.mutation.ClearChangeEvents()
+	return psuo
+}
+
+// RemoveChangeEventIDs removes the "change_events" edge to ProfileChangeEvent entities by IDs.
+func (psuo *ProfileSnapshotUpdateOne) RemoveChangeEventIDs(ids ...ulid.ID) *ProfileSnapshotUpdateOne {
+	psuo.mutation.RemoveChangeEventIDs(ids...)
+	return psuo
+}
+
+// RemoveChangeEvents removes "change_events" edges to ProfileChangeEvent entities.
+func (psuo *ProfileSnapshotUpdateOne) RemoveChangeEvents(p ...*ProfileChangeEvent) *ProfileSnapshotUpdateOne {
+	ids := make([]ulid.ID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return psuo.RemoveChangeEventIDs(ids...)
+}
+
+// Where appends a list predicates to the ProfileSnapshotUpdate builder.
+func (psuo *ProfileSnapshotUpdateOne) Where(ps ...predicate.ProfileSnapshot) *ProfileSnapshotUpdateOne {
+	psuo.mutation.Where(ps...)
+	return psuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (psuo *ProfileSnapshotUpdateOne) Select(field string, fields ...string) *ProfileSnapshotUpdateOne {
+	psuo.fields = append([]string{field}, fields...)
+	return psuo
+}
+
+// Save executes the query and returns the updated ProfileSnapshot entity.
+func (psuo *ProfileSnapshotUpdateOne) Save(ctx context.Context) (*ProfileSnapshot, error) {
+	psuo.defaults()
+	return withHooks(ctx, psuo.sqlSave, psuo.mutation, psuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psuo *ProfileSnapshotUpdateOne) SaveX(ctx context.Context) *ProfileSnapshot {
+	node, err := psuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (psuo *ProfileSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := psuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psuo *ProfileSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := psuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psuo *ProfileSnapshotUpdateOne) defaults() {
+	if _, ok := psuo.mutation.UpdatedAt(); !ok {
+		v := profilesnapshot.UpdateDefaultUpdatedAt()
+		psuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psuo *ProfileSnapshotUpdateOne) check() error {
+	if psuo.mutation.ProfileCleared() && len(psuo.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProfileSnapshot.profile"`)
+	}
+	return nil
+}
+
+func (psuo *ProfileSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *ProfileSnapshot, err error) {
+	if err := psuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profilesnapshot.Table, profilesnapshot.Columns, sqlgraph.NewFieldSpec(profilesnapshot.FieldID, field.TypeString))
+	id, ok := psuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProfileSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := psuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profilesnapshot.FieldID)
+		for _, f := range fields {
+			if !profilesnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != profilesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := psuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if psuo.mutation.RawDataS3KeyCleared() {
+		_spec.ClearField(profilesnapshot.FieldRawDataS3Key, field.TypeString)
+	}
+	if psuo.mutation.CleanedDataS3KeyCleared() {
+		_spec.ClearField(profilesnapshot.FieldCleanedDataS3Key, field.TypeString)
+	}
+	if psuo.mutation.HeadlineCleared() {
+		_spec.ClearField(profilesnapshot.FieldHeadline, field.TypeString)
+	}
+	if psuo.mutation.TitleCleared() {
+		_spec.ClearField(profilesnapshot.FieldTitle, field.TypeString)
+	}
+	if psuo.mutation.CountryCleared() {
+		_spec.ClearField(profilesnapshot.FieldCountry, field.TypeString)
+	}
+	if psuo.mutation.CityCleared() {
+		_spec.ClearField(profilesnapshot.FieldCity, field.TypeString)
+	}
+	if psuo.mutation.PositionsCleared() {
+		_spec.ClearField(profilesnapshot.FieldPositions, field.TypeJSON)
+	}
+	if psuo.mutation.EducationsCleared() {
+		_spec.ClearField(profilesnapshot.FieldEducations, field.TypeJSON)
+	}
+	if psuo.mutation.SkillsCleared() {
+		_spec.ClearField(profilesnapshot.FieldSkills, field.TypeJSON)
+	}
+	if value, ok := psuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(profilesnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if psuo.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilesnapshot.ProfileTable,
+			Columns: []string{profilesnapshot.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psuo.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   profilesnapshot.ProfileTable,
+			Columns: []string{profilesnapshot.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if psuo.mutation.ChangeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profilesnapshot.ChangeEventsTable,
+			Columns: []string{profilesnapshot.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psuo.mutation.RemovedChangeEventsIDs(); len(nodes) > 0 && !psuo.mutation.ChangeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profilesnapshot.ChangeEventsTable,
+			Columns: []string{profilesnapshot.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psuo.mutation.ChangeEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profilesnapshot.ChangeEventsTable,
+			Columns: []string{profilesnapshot.ChangeEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profilechangeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProfileSnapshot{config: psuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, psuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profilesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	psuo.mutation.done = true
+	return _node, nil
+}
@@ -3,13 +3,22 @@
 package ent
 
 import (
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/cronjobconfig"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
 	"sheng-go-backend/ent/profilepost"
 	"sheng-go-backend/ent/profilepostitem"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/schema"
 	"sheng-go-backend/ent/schema/ulid"
 	"sheng-go-backend/ent/todo"
@@ -21,6 +30,131 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apicalllogMixin := schema.APICallLog{}.Mixin()
+	apicalllogMixinFields0 := apicalllogMixin[0].Fields()
+	_ = apicalllogMixinFields0
+	apicalllogMixinFields1 := apicalllogMixin[1].Fields()
+	_ = apicalllogMixinFields1
+	apicalllogMixinFields2 := apicalllogMixin[2].Fields()
+	_ = apicalllogMixinFields2
+	apicalllogFields := schema.APICallLog{}.Fields()
+	_ = apicalllogFields
+	// apicalllogDescEndpoint is the schema descriptor for endpoint field.
+	apicalllogDescEndpoint := apicalllogMixinFields1[0].Descriptor()
+	// apicalllog.EndpointValidator is a validator for the "endpoint" field. It is called by the builders before save.
+	apicalllog.EndpointValidator = apicalllogDescEndpoint.Validators[0].(func(string) error)
+	// apicalllogDescCaller is the schema descriptor for caller field.
+	apicalllogDescCaller := apicalllogMixinFields1[2].Descriptor()
+	// apicalllog.DefaultCaller holds the default value on creation for the caller field.
+	apicalllog.DefaultCaller = apicalllogDescCaller.Default.(string)
+	// apicalllogDescLatencyMs is the schema descriptor for latency_ms field.
+	apicalllogDescLatencyMs := apicalllogMixinFields1[6].Descriptor()
+	// apicalllog.DefaultLatencyMs holds the default value on creation for the latency_ms field.
+	apicalllog.DefaultLatencyMs = apicalllogDescLatencyMs.Default.(int)
+	// apicalllog.LatencyMsValidator is a validator for the "latency_ms" field. It is called by the builders before save.
+	apicalllog.LatencyMsValidator = apicalllogDescLatencyMs.Validators[0].(func(int) error)
+	// apicalllogDescBytes is the schema descriptor for bytes field.
+	apicalllogDescBytes := apicalllogMixinFields1[7].Descriptor()
+	// apicalllog.DefaultBytes holds the default value on creation for the bytes field.
+	apicalllog.DefaultBytes = apicalllogDescBytes.Default.(int)
+	// apicalllog.BytesValidator is a validator for the "bytes" field. It is called by the builders before save.
+	apicalllog.BytesValidator = apicalllogDescBytes.Validators[0].(func(int) error)
+	// apicalllogDescCounted is the schema descriptor for counted field.
+	apicalllogDescCounted := apicalllogMixinFields1[8].Descriptor()
+	// apicalllog.DefaultCounted holds the default value on creation for the counted field.
+	apicalllog.DefaultCounted = apicalllogDescCounted.Default.(bool)
+	// apicalllogDescCreatedAt is the schema descriptor for created_at field.
+	apicalllogDescCreatedAt := apicalllogMixinFields2[0].Descriptor()
+	// apicalllog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apicalllog.DefaultCreatedAt = apicalllogDescCreatedAt.Default.(func() time.Time)
+	// apicalllogDescUpdatedAt is the schema descriptor for updated_at field.
+	apicalllogDescUpdatedAt := apicalllogMixinFields2[1].Descriptor()
+	// apicalllog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apicalllog.DefaultUpdatedAt = apicalllogDescUpdatedAt.Default.(func() time.Time)
+	// apicalllog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apicalllog.UpdateDefaultUpdatedAt = apicalllogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apicalllogDescID is the schema descriptor for id field.
+	apicalllogDescID := apicalllogMixinFields0[0].Descriptor()
+	// apicalllog.DefaultID holds the default value on creation for the id field.
+	apicalllog.DefaultID = apicalllogDescID.Default.(func() ulid.ID)
+	apiquotabudgetMixin := schema.APIQuotaBudget{}.Mixin()
+	apiquotabudgetMixinFields0 := apiquotabudgetMixin[0].Fields()
+	_ = apiquotabudgetMixinFields0
+	apiquotabudgetMixinFields1 := apiquotabudgetMixin[1].Fields()
+	_ = apiquotabudgetMixinFields1
+	apiquotabudgetMixinFields2 := apiquotabudgetMixin[2].Fields()
+	_ = apiquotabudgetMixinFields2
+	apiquotabudgetFields := schema.APIQuotaBudget{}.Fields()
+	_ = apiquotabudgetFields
+	// apiquotabudgetDescName is the schema descriptor for name field.
+	apiquotabudgetDescName := apiquotabudgetMixinFields1[0].Descriptor()
+	// apiquotabudget.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apiquotabudget.NameValidator = apiquotabudgetDescName.Validators[0].(func(string) error)
+	// apiquotabudgetDescPercent is the schema descriptor for percent field.
+	apiquotabudgetDescPercent := apiquotabudgetMixinFields1[1].Descriptor()
+	// apiquotabudget.PercentValidator is a validator for the "percent" field. It is called by the builders before save.
+	apiquotabudget.PercentValidator = func() func(float64) error {
+		validators := apiquotabudgetDescPercent.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(percent float64) error {
+			for _, fn := range fns {
+				if err := fn(percent); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apiquotabudgetDescCreatedAt is the schema descriptor for created_at field.
+	apiquotabudgetDescCreatedAt := apiquotabudgetMixinFields2[0].Descriptor()
+	// apiquotabudget.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apiquotabudget.DefaultCreatedAt = apiquotabudgetDescCreatedAt.Default.(func() time.Time)
+	// apiquotabudgetDescUpdatedAt is the schema descriptor for updated_at field.
+	apiquotabudgetDescUpdatedAt := apiquotabudgetMixinFields2[1].Descriptor()
+	// apiquotabudget.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apiquotabudget.DefaultUpdatedAt = apiquotabudgetDescUpdatedAt.Default.(func() time.Time)
+	// apiquotabudget.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apiquotabudget.UpdateDefaultUpdatedAt = apiquotabudgetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apiquotabudgetDescID is the schema descriptor for id field.
+	apiquotabudgetDescID := apiquotabudgetMixinFields0[0].Descriptor()
+	// apiquotabudget.DefaultID holds the default value on creation for the id field.
+	apiquotabudget.DefaultID = apiquotabudgetDescID.Default.(func() ulid.ID)
+	apiquotareservationMixin := schema.APIQuotaReservation{}.Mixin()
+	apiquotareservationMixinFields0 := apiquotareservationMixin[0].Fields()
+	_ = apiquotareservationMixinFields0
+	apiquotareservationMixinFields1 := apiquotareservationMixin[1].Fields()
+	_ = apiquotareservationMixinFields1
+	apiquotareservationMixinFields2 := apiquotareservationMixin[2].Fields()
+	_ = apiquotareservationMixinFields2
+	apiquotareservationFields := schema.APIQuotaReservation{}.Fields()
+	_ = apiquotareservationFields
+	// apiquotareservationDescReserved is the schema descriptor for reserved field.
+	apiquotareservationDescReserved := apiquotareservationMixinFields1[0].Descriptor()
+	// apiquotareservation.ReservedValidator is a validator for the "reserved" field. It is called by the builders before save.
+	apiquotareservation.ReservedValidator = apiquotareservationDescReserved.Validators[0].(func(int) error)
+	// apiquotareservationDescUsed is the schema descriptor for used field.
+	apiquotareservationDescUsed := apiquotareservationMixinFields1[1].Descriptor()
+	// apiquotareservation.DefaultUsed holds the default value on creation for the used field.
+	apiquotareservation.DefaultUsed = apiquotareservationDescUsed.Default.(int)
+	// apiquotareservation.UsedValidator is a validator for the "used" field. It is called by the builders before save.
+	apiquotareservation.UsedValidator = apiquotareservationDescUsed.Validators[0].(func(int) error)
+	// apiquotareservationDescCreatedAt is the schema descriptor for created_at field.
+	apiquotareservationDescCreatedAt := apiquotareservationMixinFields2[0].Descriptor()
+	// apiquotareservation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apiquotareservation.DefaultCreatedAt = apiquotareservationDescCreatedAt.Default.(func() time.Time)
+	// apiquotareservationDescUpdatedAt is the schema descriptor for updated_at field.
+	apiquotareservationDescUpdatedAt := apiquotareservationMixinFields2[1].Descriptor()
+	// apiquotareservation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apiquotareservation.DefaultUpdatedAt = apiquotareservationDescUpdatedAt.Default.(func() time.Time)
+	// apiquotareservation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apiquotareservation.UpdateDefaultUpdatedAt = apiquotareservationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apiquotareservationDescID is the schema descriptor for id field.
+	apiquotareservationDescID := apiquotareservationMixinFields0[0].Descriptor()
+	// apiquotareservation.DefaultID holds the default value on creation for the id field.
+	apiquotareservation.DefaultID = apiquotareservationDescID.Default.(func() ulid.ID)
 	apiquotatrackerMixin := schema.APIQuotaTracker{}.Mixin()
 	apiquotatrackerMixinFields0 := apiquotatrackerMixin[0].Fields()
 	_ = apiquotatrackerMixinFields0
@@ -38,42 +172,103 @@ func init() {
 	apiquotatracker.DefaultUpdatedAt = apiquotatrackerDescUpdatedAt.Default.(func() time.Time)
 	// apiquotatracker.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	apiquotatracker.UpdateDefaultUpdatedAt = apiquotatrackerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apiquotatrackerDescKeyLabel is the schema descriptor for key_label field.
+	apiquotatrackerDescKeyLabel := apiquotatrackerFields[0].Descriptor()
+	// apiquotatracker.DefaultKeyLabel holds the default value on creation for the key_label field.
+	apiquotatracker.DefaultKeyLabel = apiquotatrackerDescKeyLabel.Default.(string)
+	// apiquotatrackerDescBudget is the schema descriptor for budget field.
+	apiquotatrackerDescBudget := apiquotatrackerFields[1].Descriptor()
+	// apiquotatracker.DefaultBudget holds the default value on creation for the budget field.
+	apiquotatracker.DefaultBudget = apiquotatrackerDescBudget.Default.(string)
 	// apiquotatrackerDescMonth is the schema descriptor for month field.
-	apiquotatrackerDescMonth := apiquotatrackerFields[0].Descriptor()
+	apiquotatrackerDescMonth := apiquotatrackerFields[2].Descriptor()
 	// apiquotatracker.MonthValidator is a validator for the "month" field. It is called by the builders before save.
 	apiquotatracker.MonthValidator = apiquotatrackerDescMonth.Validators[0].(func(int) error)
 	// apiquotatrackerDescYear is the schema descriptor for year field.
-	apiquotatrackerDescYear := apiquotatrackerFields[1].Descriptor()
+	apiquotatrackerDescYear := apiquotatrackerFields[3].Descriptor()
 	// apiquotatracker.YearValidator is a validator for the "year" field. It is called by the builders before save.
 	apiquotatracker.YearValidator = apiquotatrackerDescYear.Validators[0].(func(int) error)
 	// apiquotatrackerDescCallCount is the schema descriptor for call_count field.
-	apiquotatrackerDescCallCount := apiquotatrackerFields[2].Descriptor()
+	apiquotatrackerDescCallCount := apiquotatrackerFields[4].Descriptor()
 	// apiquotatracker.DefaultCallCount holds the default value on creation for the call_count field.
 	apiquotatracker.DefaultCallCount = apiquotatrackerDescCallCount.Default.(int)
 	// apiquotatracker.CallCountValidator is a validator for the "call_count" field. It is called by the builders before save.
 	apiquotatracker.CallCountValidator = apiquotatrackerDescCallCount.Validators[0].(func(int) error)
+	// apiquotatrackerDescReservedCount is the schema descriptor for reserved_count field.
+	apiquotatrackerDescReservedCount := apiquotatrackerFields[5].Descriptor()
+	// apiquotatracker.DefaultReservedCount holds the default value on creation for the reserved_count field.
+	apiquotatracker.DefaultReservedCount = apiquotatrackerDescReservedCount.Default.(int)
+	// apiquotatracker.ReservedCountValidator is a validator for the "reserved_count" field. It is called by the builders before save.
+	apiquotatracker.ReservedCountValidator = apiquotatrackerDescReservedCount.Validators[0].(func(int) error)
 	// apiquotatrackerDescQuotaLimit is the schema descriptor for quota_limit field.
-	apiquotatrackerDescQuotaLimit := apiquotatrackerFields[3].Descriptor()
+	apiquotatrackerDescQuotaLimit := apiquotatrackerFields[6].Descriptor()
 	// apiquotatracker.DefaultQuotaLimit holds the default value on creation for the quota_limit field.
 	apiquotatracker.DefaultQuotaLimit = apiquotatrackerDescQuotaLimit.Default.(int)
 	// apiquotatracker.QuotaLimitValidator is a validator for the "quota_limit" field. It is called by the builders before save.
 	apiquotatracker.QuotaLimitValidator = apiquotatrackerDescQuotaLimit.Validators[0].(func(int) error)
+	// apiquotatrackerDescDailyCap is the schema descriptor for daily_cap field.
+	apiquotatrackerDescDailyCap := apiquotatrackerFields[7].Descriptor()
+	// apiquotatracker.DailyCapValidator is a validator for the "daily_cap" field. It is called by the builders before save.
+	apiquotatracker.DailyCapValidator = apiquotatrackerDescDailyCap.Validators[0].(func(int) error)
+	// apiquotatrackerDescHourlyCap is the schema descriptor for hourly_cap field.
+	apiquotatrackerDescHourlyCap := apiquotatrackerFields[8].Descriptor()
+	// apiquotatracker.HourlyCapValidator is a validator for the "hourly_cap" field. It is called by the builders before save.
+	apiquotatracker.HourlyCapValidator = apiquotatrackerDescHourlyCap.Validators[0].(func(int) error)
+	// apiquotatrackerDescEvenPacing is the schema descriptor for even_pacing field.
+	apiquotatrackerDescEvenPacing := apiquotatrackerFields[9].Descriptor()
+	// apiquotatracker.DefaultEvenPacing holds the default value on creation for the even_pacing field.
+	apiquotatracker.DefaultEvenPacing = apiquotatrackerDescEvenPacing.Default.(bool)
 	// apiquotatrackerDescQuotaExceeded is the schema descriptor for quota_exceeded field.
-	apiquotatrackerDescQuotaExceeded := apiquotatrackerFields[4].Descriptor()
+	apiquotatrackerDescQuotaExceeded := apiquotatrackerFields[10].Descriptor()
 	// apiquotatracker.DefaultQuotaExceeded holds the default value on creation for the quota_exceeded field.
 	apiquotatracker.DefaultQuotaExceeded = apiquotatrackerDescQuotaExceeded.Default.(bool)
 	// apiquotatrackerDescOverrideEnabled is the schema descriptor for override_enabled field.
-	apiquotatrackerDescOverrideEnabled := apiquotatrackerFields[5].Descriptor()
+	apiquotatrackerDescOverrideEnabled := apiquotatrackerFields[11].Descriptor()
 	// apiquotatracker.DefaultOverrideEnabled holds the default value on creation for the override_enabled field.
 	apiquotatracker.DefaultOverrideEnabled = apiquotatrackerDescOverrideEnabled.Default.(bool)
 	// apiquotatrackerDescNotificationSent is the schema descriptor for notification_sent field.
-	apiquotatrackerDescNotificationSent := apiquotatrackerFields[6].Descriptor()
+	apiquotatrackerDescNotificationSent := apiquotatrackerFields[12].Descriptor()
 	// apiquotatracker.DefaultNotificationSent holds the default value on creation for the notification_sent field.
 	apiquotatracker.DefaultNotificationSent = apiquotatrackerDescNotificationSent.Default.(bool)
 	// apiquotatrackerDescID is the schema descriptor for id field.
 	apiquotatrackerDescID := apiquotatrackerMixinFields0[0].Descriptor()
 	// apiquotatracker.DefaultID holds the default value on creation for the id field.
 	apiquotatracker.DefaultID = apiquotatrackerDescID.Default.(func() ulid.ID)
+	companyMixin := schema.Company{}.Mixin()
+	companyMixinFields0 := companyMixin[0].Fields()
+	_ = companyMixinFields0
+	companyMixinFields1 := companyMixin[1].Fields()
+	_ = companyMixinFields1
+	companyMixinFields2 := companyMixin[2].Fields()
+	_ = companyMixinFields2
+	companyFields := schema.Company{}.Fields()
+	_ = companyFields
+	// companyDescKey is the schema descriptor for key field.
+	companyDescKey := companyMixinFields1[0].Descriptor()
+	// company.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	company.KeyValidator = companyDescKey.Validators[0].(func(string) error)
+	// companyDescName is the schema descriptor for name field.
+	companyDescName := companyMixinFields1[1].Descriptor()
+	// company.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	company.NameValidator = companyDescName.Validators[0].(func(string) error)
+	// companyDescNormalizedName is the schema descriptor for normalized_name field.
+	companyDescNormalizedName := companyMixinFields1[2].Descriptor()
+	// company.NormalizedNameValidator is a validator for the "normalized_name" field. It is called by the builders before save.
+	company.NormalizedNameValidator = companyDescNormalizedName.Validators[0].(func(string) error)
+	// companyDescCreatedAt is the schema descriptor for created_at field.
+	companyDescCreatedAt := companyMixinFields2[0].Descriptor()
+	// company.DefaultCreatedAt holds the default value on creation for the created_at field.
+	company.DefaultCreatedAt = companyDescCreatedAt.Default.(func() time.Time)
+	// companyDescUpdatedAt is the schema descriptor for updated_at field.
+	companyDescUpdatedAt := companyMixinFields2[1].Descriptor()
+	// company.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	company.DefaultUpdatedAt = companyDescUpdatedAt.Default.(func() time.Time)
+	// company.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	company.UpdateDefaultUpdatedAt = companyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// companyDescID is the schema descriptor for id field.
+	companyDescID := companyMixinFields0[0].Descriptor()
+	// company.DefaultID holds the default value on creation for the id field.
+	company.DefaultID = companyDescID.Default.(func() ulid.ID)
 	cronjobconfigMixin := schema.CronJobConfig{}.Mixin()
 	cronjobconfigMixinFields0 := cronjobconfigMixin[0].Fields()
 	_ = cronjobconfigMixinFields0
@@ -123,12 +318,18 @@ func init() {
 	cronjobconfig.DefaultBatchSize = cronjobconfigDescBatchSize.Default.(int)
 	// cronjobconfig.BatchSizeValidator is a validator for the "batch_size" field. It is called by the builders before save.
 	cronjobconfig.BatchSizeValidator = cronjobconfigDescBatchSize.Validators[0].(func(int) error)
+	// cronjobconfigDescConcurrency is the schema descriptor for concurrency field.
+	cronjobconfigDescConcurrency := cronjobconfigFields[5].Descriptor()
+	// cronjobconfig.DefaultConcurrency holds the default value on creation for the concurrency field.
+	cronjobconfig.DefaultConcurrency = cronjobconfigDescConcurrency.Default.(int)
+	// cronjobconfig.ConcurrencyValidator is a validator for the "concurrency" field. It is called by the builders before save.
+	cronjobconfig.ConcurrencyValidator = cronjobconfigDescConcurrency.Validators[0].(func(int) error)
 	// cronjobconfigDescAdminEmail is the schema descriptor for admin_email field.
-	cronjobconfigDescAdminEmail := cronjobconfigFields[5].Descriptor()
+	cronjobconfigDescAdminEmail := cronjobconfigFields[6].Descriptor()
 	// cronjobconfig.AdminEmailValidator is a validator for the "admin_email" field. It is called by the builders before save.
 	cronjobconfig.AdminEmailValidator = cronjobconfigDescAdminEmail.Validators[0].(func(string) error)
 	// cronjobconfigDescRespectQuota is the schema descriptor for respect_quota field.
-	cronjobconfigDescRespectQuota := cronjobconfigFields[6].Descriptor()
+	cronjobconfigDescRespectQuota := cronjobconfigFields[7].Descriptor()
 	// cronjobconfig.DefaultRespectQuota holds the default value on creation for the respect_quota field.
 	cronjobconfig.DefaultRespectQuota = cronjobconfigDescRespectQuota.Default.(bool)
 	// cronjobconfigDescID is the schema descriptor for id field.
@@ -206,6 +407,10 @@ func init() {
 	jobexecutionhistory.DefaultQuotaRemaining = jobexecutionhistoryDescQuotaRemaining.Default.(int)
 	// jobexecutionhistory.QuotaRemainingValidator is a validator for the "quota_remaining" field. It is called by the builders before save.
 	jobexecutionhistory.QuotaRemainingValidator = jobexecutionhistoryDescQuotaRemaining.Validators[0].(func(int) error)
+	// jobexecutionhistoryDescSchemaDriftDetected is the schema descriptor for schema_drift_detected field.
+	jobexecutionhistoryDescSchemaDriftDetected := jobexecutionhistoryFields[11].Descriptor()
+	// jobexecutionhistory.DefaultSchemaDriftDetected holds the default value on creation for the schema_drift_detected field.
+	jobexecutionhistory.DefaultSchemaDriftDetected = jobexecutionhistoryDescSchemaDriftDetected.Default.(bool)
 	// jobexecutionhistoryDescID is the schema descriptor for id field.
 	jobexecutionhistoryDescID := jobexecutionhistoryMixinFields0[0].Descriptor()
 	// jobexecutionhistory.DefaultID holds the default value on creation for the id field.
@@ -245,6 +450,62 @@ func init() {
 	profileDescID := profileMixinFields0[0].Descriptor()
 	// profile.DefaultID holds the default value on creation for the id field.
 	profile.DefaultID = profileDescID.Default.(func() ulid.ID)
+	profilechangeeventMixin := schema.ProfileChangeEvent{}.Mixin()
+	profilechangeeventMixinFields0 := profilechangeeventMixin[0].Fields()
+	_ = profilechangeeventMixinFields0
+	profilechangeeventMixinFields1 := profilechangeeventMixin[1].Fields()
+	_ = profilechangeeventMixinFields1
+	profilechangeeventMixinFields2 := profilechangeeventMixin[2].Fields()
+	_ = profilechangeeventMixinFields2
+	profilechangeeventFields := schema.ProfileChangeEvent{}.Fields()
+	_ = profilechangeeventFields
+	// profilechangeeventDescDeliveryAttempts is the schema descriptor for delivery_attempts field.
+	profilechangeeventDescDeliveryAttempts := profilechangeeventMixinFields1[5].Descriptor()
+	// profilechangeevent.DefaultDeliveryAttempts holds the default value on creation for the delivery_attempts field.
+	profilechangeevent.DefaultDeliveryAttempts = profilechangeeventDescDeliveryAttempts.Default.(int)
+	// profilechangeevent.DeliveryAttemptsValidator is a validator for the "delivery_attempts" field. It is called by the builders before save.
+	profilechangeevent.DeliveryAttemptsValidator = profilechangeeventDescDeliveryAttempts.Validators[0].(func(int) error)
+	// profilechangeeventDescCreatedAt is the schema descriptor for created_at field.
+	profilechangeeventDescCreatedAt := profilechangeeventMixinFields2[0].Descriptor()
+	// profilechangeevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	profilechangeevent.DefaultCreatedAt = profilechangeeventDescCreatedAt.Default.(func() time.Time)
+	// profilechangeeventDescUpdatedAt is the schema descriptor for updated_at field.
+	profilechangeeventDescUpdatedAt := profilechangeeventMixinFields2[1].Descriptor()
+	// profilechangeevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	profilechangeevent.DefaultUpdatedAt = profilechangeeventDescUpdatedAt.Default.(func() time.Time)
+	// profilechangeevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	profilechangeevent.UpdateDefaultUpdatedAt = profilechangeeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// profilechangeeventDescID is the schema descriptor for id field.
+	profilechangeeventDescID := profilechangeeventMixinFields0[0].Descriptor()
+	// profilechangeevent.DefaultID holds the default value on creation for the id field.
+	profilechangeevent.DefaultID = profilechangeeventDescID.Default.(func() ulid.ID)
+	profileeducationMixin := schema.ProfileEducation{}.Mixin()
+	profileeducationMixinFields0 := profileeducationMixin[0].Fields()
+	_ = profileeducationMixinFields0
+	profileeducationMixinFields1 := profileeducationMixin[1].Fields()
+	_ = profileeducationMixinFields1
+	profileeducationMixinFields2 := profileeducationMixin[2].Fields()
+	_ = profileeducationMixinFields2
+	profileeducationFields := schema.ProfileEducation{}.Fields()
+	_ = profileeducationFields
+	// profileeducationDescEducationIndex is the schema descriptor for education_index field.
+	profileeducationDescEducationIndex := profileeducationMixinFields1[0].Descriptor()
+	// profileeducation.EducationIndexValidator is a validator for the "education_index" field. It is called by the builders before save.
+	profileeducation.EducationIndexValidator = profileeducationDescEducationIndex.Validators[0].(func(int) error)
+	// profileeducationDescCreatedAt is the schema descriptor for created_at field.
+	profileeducationDescCreatedAt := profileeducationMixinFields2[0].Descriptor()
+	// profileeducation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	profileeducation.DefaultCreatedAt = profileeducationDescCreatedAt.Default.(func() time.Time)
+	// profileeducationDescUpdatedAt is the schema descriptor for updated_at field.
+	profileeducationDescUpdatedAt := profileeducationMixinFields2[1].Descriptor()
+	// profileeducation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	profileeducation.DefaultUpdatedAt = profileeducationDescUpdatedAt.Default.(func() time.Time)
+	// profileeducation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	profileeducation.UpdateDefaultUpdatedAt = profileeducationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// profileeducationDescID is the schema descriptor for id field.
+	profileeducationDescID := profileeducationMixinFields0[0].Descriptor()
+	// profileeducation.DefaultID holds the default value on creation for the id field.
+	profileeducation.DefaultID = profileeducationDescID.Default.(func() ulid.ID)
 	profileentryMixin := schema.ProfileEntry{}.Mixin()
 	profileentryMixinFields0 := profileentryMixin[0].Fields()
 	_ = profileentryMixinFields0
@@ -280,10 +541,51 @@ func init() {
 	profileentry.DefaultFetchCount = profileentryDescFetchCount.Default.(int)
 	// profileentry.FetchCountValidator is a validator for the "fetch_count" field. It is called by the builders before save.
 	profileentry.FetchCountValidator = profileentryDescFetchCount.Validators[0].(func(int) error)
+	// profileentryDescAttemptCount is the schema descriptor for attempt_count field.
+	profileentryDescAttemptCount := profileentryFields[9].Descriptor()
+	// profileentry.DefaultAttemptCount holds the default value on creation for the attempt_count field.
+	profileentry.DefaultAttemptCount = profileentryDescAttemptCount.Default.(int)
+	// profileentry.AttemptCountValidator is a validator for the "attempt_count" field. It is called by the builders before save.
+	profileentry.AttemptCountValidator = profileentryDescAttemptCount.Validators[0].(func(int) error)
+	// profileentryDescLeaseOwner is the schema descriptor for lease_owner field.
+	profileentryDescLeaseOwner := profileentryFields[11].Descriptor()
+	// profileentry.LeaseOwnerValidator is a validator for the "lease_owner" field. It is called by the builders before save.
+	profileentry.LeaseOwnerValidator = profileentryDescLeaseOwner.Validators[0].(func(string) error)
 	// profileentryDescID is the schema descriptor for id field.
 	profileentryDescID := profileentryMixinFields0[0].Descriptor()
 	// profileentry.DefaultID holds the default value on creation for the id field.
 	profileentry.DefaultID = profileentryDescID.Default.(func() ulid.ID)
+	profilepositionMixin := schema.ProfilePosition{}.Mixin()
+	profilepositionMixinFields0 := profilepositionMixin[0].Fields()
+	_ = profilepositionMixinFields0
+	profilepositionMixinFields1 := profilepositionMixin[1].Fields()
+	_ = profilepositionMixinFields1
+	profilepositionMixinFields2 := profilepositionMixin[2].Fields()
+	_ = profilepositionMixinFields2
+	profilepositionFields := schema.ProfilePosition{}.Fields()
+	_ = profilepositionFields
+	// profilepositionDescPositionIndex is the schema descriptor for position_index field.
+	profilepositionDescPositionIndex := profilepositionMixinFields1[0].Descriptor()
+	// profileposition.PositionIndexValidator is a validator for the "position_index" field. It is called by the builders before save.
+	profileposition.PositionIndexValidator = profilepositionDescPositionIndex.Validators[0].(func(int) error)
+	// profilepositionDescIsCurrent is the schema descriptor for is_current field.
+	profilepositionDescIsCurrent := profilepositionMixinFields1[10].Descriptor()
+	// profileposition.DefaultIsCurrent holds the default value on creation for the is_current field.
+	profileposition.DefaultIsCurrent = profilepositionDescIsCurrent.Default.(bool)
+	// profilepositionDescCreatedAt is the schema descriptor for created_at field.
+	profilepositionDescCreatedAt := profilepositionMixinFields2[0].Descriptor()
+	// profileposition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	profileposition.DefaultCreatedAt = profilepositionDescCreatedAt.Default.(func() time.Time)
+	// profilepositionDescUpdatedAt is the schema descriptor for updated_at field.
+	profilepositionDescUpdatedAt := profilepositionMixinFields2[1].Descriptor()
+	// profileposition.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	profileposition.DefaultUpdatedAt = profilepositionDescUpdatedAt.Default.(func() time.Time)
+	// profileposition.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	profileposition.UpdateDefaultUpdatedAt = profilepositionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// profilepositionDescID is the schema descriptor for id field.
+	profilepositionDescID := profilepositionMixinFields0[0].Descriptor()
+	// profileposition.DefaultID holds the default value on creation for the id field.
+	profileposition.DefaultID = profilepositionDescID.Default.(func() ulid.ID)
 	profilepostMixin := schema.ProfilePost{}.Mixin()
 	profilepostMixinFields0 := profilepostMixin[0].Fields()
 	_ = profilepostMixinFields0
@@ -394,6 +696,66 @@ func init() {
 	profilepostitemDescID := profilepostitemMixinFields0[0].Descriptor()
 	// profilepostitem.DefaultID holds the default value on creation for the id field.
 	profilepostitem.DefaultID = profilepostitemDescID.Default.(func() ulid.ID)
+	profileskillMixin := schema.ProfileSkill{}.Mixin()
+	profileskillMixinFields0 := profileskillMixin[0].Fields()
+	_ = profileskillMixinFields0
+	profileskillMixinFields1 := profileskillMixin[1].Fields()
+	_ = profileskillMixinFields1
+	profileskillMixinFields2 := profileskillMixin[2].Fields()
+	_ = profileskillMixinFields2
+	profileskillFields := schema.ProfileSkill{}.Fields()
+	_ = profileskillFields
+	// profileskillDescEndorsementCount is the schema descriptor for endorsement_count field.
+	profileskillDescEndorsementCount := profileskillMixinFields1[1].Descriptor()
+	// profileskill.DefaultEndorsementCount holds the default value on creation for the endorsement_count field.
+	profileskill.DefaultEndorsementCount = profileskillDescEndorsementCount.Default.(int)
+	// profileskill.EndorsementCountValidator is a validator for the "endorsement_count" field. It is called by the builders before save.
+	profileskill.EndorsementCountValidator = profileskillDescEndorsementCount.Validators[0].(func(int) error)
+	// profileskillDescCreatedAt is the schema descriptor for created_at field.
+	profileskillDescCreatedAt := profileskillMixinFields2[0].Descriptor()
+	// profileskill.DefaultCreatedAt holds the default value on creation for the created_at field.
+	profileskill.DefaultCreatedAt = profileskillDescCreatedAt.Default.(func() time.Time)
+	// profileskillDescUpdatedAt is the schema descriptor for updated_at field.
+	profileskillDescUpdatedAt := profileskillMixinFields2[1].Descriptor()
+	// profileskill.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	profileskill.DefaultUpdatedAt = profileskillDescUpdatedAt.Default.(func() time.Time)
+	// profileskill.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	profileskill.UpdateDefaultUpdatedAt = profileskillDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// profileskillDescID is the schema descriptor for id field.
+	profileskillDescID := profileskillMixinFields0[0].Descriptor()
+	// profileskill.DefaultID holds the default value on creation for the id field.
+	profileskill.DefaultID = profileskillDescID.Default.(func() ulid.ID)
+	profilesnapshotMixin := schema.ProfileSnapshot{}.Mixin()
+	profilesnapshotMixinFields0 := profilesnapshotMixin[0].Fields()
+	_ = profilesnapshotMixinFields0
+	profilesnapshotMixinFields1 := profilesnapshotMixin[1].Fields()
+	_ = profilesnapshotMixinFields1
+	profilesnapshotMixinFields2 := profilesnapshotMixin[2].Fields()
+	_ = profilesnapshotMixinFields2
+	profilesnapshotFields := schema.ProfileSnapshot{}.Fields()
+	_ = profilesnapshotFields
+	// profilesnapshotDescRawDataS3Key is the schema descriptor for raw_data_s3_key field.
+	profilesnapshotDescRawDataS3Key := profilesnapshotMixinFields1[1].Descriptor()
+	// profilesnapshot.RawDataS3KeyValidator is a validator for the "raw_data_s3_key" field. It is called by the builders before save.
+	profilesnapshot.RawDataS3KeyValidator = profilesnapshotDescRawDataS3Key.Validators[0].(func(string) error)
+	// profilesnapshotDescCleanedDataS3Key is the schema descriptor for cleaned_data_s3_key field.
+	profilesnapshotDescCleanedDataS3Key := profilesnapshotMixinFields1[2].Descriptor()
+	// profilesnapshot.CleanedDataS3KeyValidator is a validator for the "cleaned_data_s3_key" field. It is called by the builders before save.
+	profilesnapshot.CleanedDataS3KeyValidator = profilesnapshotDescCleanedDataS3Key.Validators[0].(func(string) error)
+	// profilesnapshotDescCreatedAt is the schema descriptor for created_at field.
+	profilesnapshotDescCreatedAt := profilesnapshotMixinFields2[0].Descriptor()
+	// profilesnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	profilesnapshot.DefaultCreatedAt = profilesnapshotDescCreatedAt.Default.(func() time.Time)
+	// profilesnapshotDescUpdatedAt is the schema descriptor for updated_at field.
+	profilesnapshotDescUpdatedAt := profilesnapshotMixinFields2[1].Descriptor()
+	// profilesnapshot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	profilesnapshot.DefaultUpdatedAt = profilesnapshotDescUpdatedAt.Default.(func() time.Time)
+	// profilesnapshot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	profilesnapshot.UpdateDefaultUpdatedAt = profilesnapshotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// profilesnapshotDescID is the schema descriptor for id field.
+	profilesnapshotDescID := profilesnapshotMixinFields0[0].Descriptor()
+	// profilesnapshot.DefaultID holds the default value on creation for the id field.
+	profilesnapshot.DefaultID = profilesnapshotDescID.Default.(func() ulid.ID)
 	todoMixin := schema.Todo{}.Mixin()
 	todoMixinFields0 := todoMixin[0].Fields()
 	_ = todoMixinFields0
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"sheng-go-backend/ent/mixin"
+	"sheng-go-backend/pkg/const/globalid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	entMixin "entgo.io/ent/schema/mixin"
+)
+
+// APICallLog is one outbound RapidAPI request, including those rotated away
+// from a rate-limited or exhausted key, so usage can be broken down and
+// reconciled with RapidAPI's billing.
+type APICallLog struct {
+	ent.Schema
+}
+
+// APICallLogMixin defines Fields
+type APICallLogMixin struct {
+	entMixin.Schema
+}
+
+// Fields of the APICallLog.
+func (APICallLogMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("endpoint").
+			NotEmpty().
+			Comment("RapidAPI endpoint: profile, profile_by_url or posts"),
+
+		field.String("key_label").
+			Comment("Label of the RapidAPI key the request was sent with"),
+
+		field.String("caller").
+			Default("").
+			Comment("Caller the request was made for, e.g. its quota budget; empty when unknown"),
+
+		field.String("run_id").
+			Optional().
+			Comment("Job run the request was made in, until the run's execution history is linked"),
+
+		field.String("urn").
+			Optional().
+			Comment("Profile URN, username or URL requested"),
+
+		field.Int("http_status").
+			Optional().
+			Nillable().
+			Comment("HTTP status of the response; unset when no response arrived"),
+
+		field.Int("latency_ms").
+			Default(0).
+			NonNegative().
+			Comment("Time from sending the request to reading the whole response"),
+
+		field.Int("bytes").
+			Default(0).
+			NonNegative().
+			Comment("Size of the response body"),
+
+		field.Bool("counted").
+			Default(false).
+			Comment("Whether the request was counted against the key's monthly quota"),
+	}
+}
+
+// Edges of the APICallLog.
+func (APICallLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("job_execution", JobExecutionHistory.Type).
+			Ref("api_calls").
+			Unique().
+			Comment("Job execution the request was made in"),
+	}
+}
+
+// Indexes of the APICallLog.
+func (APICallLog) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for aggregating over a time range
+		index.Fields("created_at"),
+
+		// Index for linking a run's calls to its execution history
+		index.Fields("run_id"),
+	}
+}
+
+// Mixin of the APICallLog.
+func (APICallLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.NewUlid(globalid.New().APICallLog.Prefix),
+		APICallLogMixin{},
+		mixin.NewDatetime(),
+	}
+}
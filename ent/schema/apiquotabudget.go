@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"sheng-go-backend/ent/mixin"
+	"sheng-go-backend/pkg/const/globalid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	entMixin "entgo.io/ent/schema/mixin"
+)
+
+// APIQuotaBudget is a named share of the monthly API quota reserved for one
+// kind of caller, e.g. "cron" 80%. Its usage is tracked by an APIQuotaTracker
+// per month with the budget's name.
+type APIQuotaBudget struct {
+	ent.Schema
+}
+
+// APIQuotaBudgetMixin defines Fields
+type APIQuotaBudgetMixin struct {
+	entMixin.Schema
+}
+
+// Fields of the APIQuotaBudget.
+func (APIQuotaBudgetMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Budget name callers reserve against, e.g. cron, interactive or posts"),
+
+		field.Float("percent").
+			Positive().
+			Max(100).
+			Comment("Share of the monthly quota limit, in percent"),
+
+		field.String("description").
+			Optional().
+			Nillable().
+			Comment("What the budget is for"),
+	}
+}
+
+// Mixin of the APIQuotaBudget.
+func (APIQuotaBudget) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.NewUlid(globalid.New().APIQuotaBudget.Prefix),
+		APIQuotaBudgetMixin{},
+		mixin.NewDatetime(),
+	}
+}
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"sheng-go-backend/ent/mixin"
+	"sheng-go-backend/pkg/const/globalid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	entMixin "entgo.io/ent/schema/mixin"
+)
+
+// APIQuotaReservation is a block of API calls reserved against a quota
+// tracker before they are made. Calls are committed against it as they
+// happen and the unused rest is released, so parallel callers never reserve
+// more than the tracker's limit.
+type APIQuotaReservation struct {
+	ent.Schema
+}
+
+// APIQuotaReservationMixin defines Fields
+type APIQuotaReservationMixin struct {
+	entMixin.Schema
+}
+
+// Fields of the APIQuotaReservation.
+func (APIQuotaReservationMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("reserved").
+			Positive().
+			Immutable().
+			Comment("Number of calls reserved"),
+
+		field.Int("used").
+			Default(0).
+			NonNegative().
+			Comment("Number of reserved calls committed so far"),
+
+		field.Enum("status").
+			NamedValues(
+				"Open", "OPEN",
+				"Committed", "COMMITTED",
+				"Released", "RELEASED",
+				"Expired", "EXPIRED",
+			).
+			Default("OPEN").
+			Annotations(entgql.Type("APIQuotaReservationStatus")).
+			Comment("OPEN until settled; COMMITTED or RELEASED once the unused rest is returned, EXPIRED if its holder never settled it"),
+
+		field.Time("expires_at").
+			Comment("An open reservation past this time is released by the next reservation"),
+
+		field.Time("settled_at").
+			Optional().
+			Nillable().
+			Comment("Time the unused rest was returned to the tracker"),
+	}
+}
+
+// Edges of the APIQuotaReservation.
+func (APIQuotaReservation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tracker", APIQuotaTracker.Type).
+			Ref("reservations").
+			Unique().
+			Required().
+			Comment("Tracker the calls are reserved against"),
+		edge.From("budget_tracker", APIQuotaTracker.Type).
+			Ref("budget_reservations").
+			Unique().
+			Comment("Budget tracker the calls are also reserved against, if the caller has a budget"),
+	}
+}
+
+// Indexes of the APIQuotaReservation.
+func (APIQuotaReservation) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for finding expired open reservations
+		index.Fields("status", "expires_at"),
+	}
+}
+
+// Mixin of the APIQuotaReservation.
+func (APIQuotaReservation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.NewUlid(globalid.New().APIQuotaReservation.Prefix),
+		APIQuotaReservationMixin{},
+		mixin.NewDatetime(),
+	}
+}
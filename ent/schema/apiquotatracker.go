@@ -5,6 +5,7 @@ import (
 	"sheng-go-backend/pkg/const/globalid"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	entMixin "entgo.io/ent/schema/mixin"
@@ -23,6 +24,15 @@ type APIQuotaTrackerMixin struct {
 // Fields of the APIQuotaTracker.
 func (APIQuotaTracker) Fields() []ent.Field {
 	return []ent.Field{
+		// Key scope
+		field.String("key_label").
+			Default("").
+			Comment("RapidAPI key label; empty for the pool-wide tracker"),
+
+		field.String("budget").
+			Default("").
+			Comment("Quota budget name; empty for the pool-wide and per-key trackers"),
+
 		// Time period
 		field.Int("month").
 			Range(1, 12).
@@ -38,11 +48,33 @@ func (APIQuotaTracker) Fields() []ent.Field {
 			NonNegative().
 			Comment("Number of API calls made this month"),
 
+		field.Int("reserved_count").
+			Default(0).
+			NonNegative().
+			Comment("Calls reserved by open reservations and not yet made"),
+
 		field.Int("quota_limit").
 			Default(50000).
 			Positive().
 			Comment("Monthly API call limit"),
 
+		// Rolling caps and pacing
+		field.Int("daily_cap").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Max calls in any rolling 24 hours; no cap when unset"),
+
+		field.Int("hourly_cap").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Max calls in any rolling hour; no cap when unset"),
+
+		field.Bool("even_pacing").
+			Default(false).
+			Comment("Cap each rolling day at the remaining monthly quota divided by the remaining days"),
+
 		field.Bool("quota_exceeded").
 			Default(false).
 			Comment("Whether quota has been exceeded"),
@@ -55,10 +87,29 @@ func (APIQuotaTracker) Fields() []ent.Field {
 			Default(false).
 			Comment("Whether quota exceeded notification has been sent"),
 
+		field.Ints("alerted_thresholds").
+			Optional().
+			Comment("Usage thresholds, in percent of quota_limit, already alerted this month"),
+
 		field.Time("last_call_at").
 			Optional().
 			Nillable().
 			Comment("Timestamp of last API call"),
+
+		field.Time("rate_limited_until").
+			Optional().
+			Nillable().
+			Comment("Key is cooling down after a 429 until this time"),
+	}
+}
+
+// Edges of the APIQuotaTracker.
+func (APIQuotaTracker) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("reservations", APIQuotaReservation.Type).
+			Comment("Reservation ledger of the tracker"),
+		edge.To("budget_reservations", APIQuotaReservation.Type).
+			Comment("Reservations drawn from this budget tracker"),
 	}
 }
 
@@ -74,8 +125,9 @@ func (APIQuotaTracker) Mixin() []ent.Mixin {
 // Indexes of the APIQuotaTracker.
 func (APIQuotaTracker) Indexes() []ent.Index {
 	return []ent.Index{
-		// Unique constraint on month + year
-		index.Fields("month", "year").Unique(),
+		// One tracker per key or budget per month; the pool-wide tracker has
+		// an empty key_label and budget
+		index.Fields("key_label", "budget", "month", "year").Unique(),
 
 		// Index for finding current/recent trackers
 		index.Fields("year", "month"),
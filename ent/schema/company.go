@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"sheng-go-backend/ent/mixin"
+	"sheng-go-backend/pkg/const/globalid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	entMixin "entgo.io/ent/schema/mixin"
+)
+
+// Company is an employer normalized from the positions of fetched profiles.
+// Companies are keyed by LinkedIn company URN/ID when RapidAPI reports one,
+// otherwise by normalized name.
+type Company struct {
+	ent.Schema
+}
+
+// CompanyMixin defines Fields
+type CompanyMixin struct {
+	entMixin.Schema
+}
+
+// Fields of the Company.
+func (CompanyMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Identity key: urn:<linkedin_urn> or name:<normalized_name>"),
+
+		field.String("name").
+			NotEmpty().
+			Comment("Company name as last seen in a position"),
+
+		field.String("normalized_name").
+			NotEmpty().
+			Comment("Lowercased, whitespace-collapsed name used for matching"),
+
+		field.String("linkedin_urn").
+			Optional().
+			Nillable().
+			Comment("LinkedIn company URN or ID, when present"),
+
+		field.String("linkedin_username").
+			Optional().
+			Nillable().
+			Comment("LinkedIn company slug, when present"),
+	}
+}
+
+// Edges of the Company.
+func (Company) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("positions", ProfilePosition.Type).
+			Comment("Positions held at this company"),
+		edge.From("current_employees", Profile.Type).
+			Ref("current_companies").
+			Comment("Profiles currently working at this company"),
+		edge.From("alumni", Profile.Type).
+			Ref("past_companies").
+			Comment("Profiles that used to work at this company"),
+	}
+}
+
+// Indexes of the Company.
+func (Company) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for name matching and search
+		index.Fields("normalized_name"),
+		index.Fields("linkedin_urn"),
+	}
+}
+
+// Mixin of the Company.
+func (Company) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.NewUlid(globalid.New().Company.Prefix),
+		CompanyMixin{},
+		mixin.NewDatetime(),
+	}
+}
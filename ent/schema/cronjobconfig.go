@@ -35,6 +35,9 @@ func (CronJobConfig) Fields() []ent.Field {
 			NamedValues(
 				"ProfileFetcher", "PROFILE_FETCHER",
 				"QuotaReset", "QUOTA_RESET",
+				"StaleFetchReaper", "STALE_FETCH_REAPER",
+				"ProfileRefresher", "PROFILE_REFRESHER",
+				"ChangeEventWebhook", "CHANGE_EVENT_WEBHOOK",
 			).
 			Annotations(entgql.Type("CronJobType")).
 			Comment("Type of cron job"),
@@ -54,6 +57,11 @@ func (CronJobConfig) Fields() []ent.Field {
 			Positive().
 			Comment("Number of items to process per job run"),
 
+		field.Int("concurrency").
+			Default(1).
+			Positive().
+			Comment("Number of workers processing items in parallel"),
+
 		field.String("admin_email").
 			NotEmpty().
 			Comment("Admin email for notifications"),
@@ -86,6 +86,15 @@ func (JobExecutionHistory) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Summary of errors encountered"),
+
+		// Raw response schema drift
+		field.Bool("schema_drift_detected").
+			Default(false).
+			Comment("Whether provider payloads drifted from the expected field inventory"),
+
+		field.JSON("schema_drift", map[string]interface{}{}).
+			Optional().
+			Comment("Payloads checked and unknown/missing field counts"),
 	}
 }
 
@@ -121,5 +130,7 @@ func (JobExecutionHistory) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("profile_entries", ProfileEntry.Type).
 			Comment("Profile entries processed in this job execution"),
+		edge.To("api_calls", APICallLog.Type).
+			Comment("RapidAPI requests made in this job execution"),
 	}
 }
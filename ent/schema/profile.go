@@ -112,6 +112,20 @@ func (Profile) Edges() []ent.Edge {
 			Ref("profile").
 			Unique().
 			Comment("Associated ProfileEntry that triggered this profile fetch"),
+		edge.To("snapshots", ProfileSnapshot.Type).
+			Comment("Snapshots recorded at each fetch of this profile"),
+		edge.To("change_events", ProfileChangeEvent.Type).
+			Comment("Change events detected between snapshots of this profile"),
+		edge.To("profile_positions", ProfilePosition.Type).
+			Comment("Typed rows of the positions JSON"),
+		edge.To("profile_educations", ProfileEducation.Type).
+			Comment("Typed rows of the educations JSON"),
+		edge.To("profile_skills", ProfileSkill.Type).
+			Comment("Typed rows of the skills JSON"),
+		edge.To("current_companies", Company.Type).
+			Comment("Companies of the profile's current positions"),
+		edge.To("past_companies", Company.Type).
+			Comment("Companies the profile has left"),
 	}
 }
 
@@ -0,0 +1,127 @@
+package schema
+
+import (
+	"sheng-go-backend/ent/mixin"
+	"sheng-go-backend/pkg/const/globalid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	entMixin "entgo.io/ent/schema/mixin"
+)
+
+// ProfileChangeEvent is a typed change detected between two snapshots of a
+// Profile. Events are persisted for the GraphQL feed and pushed to the
+// configured webhook by the change_event_webhook job.
+type ProfileChangeEvent struct {
+	ent.Schema
+}
+
+// ProfileChangeEventMixin defines Fields
+type ProfileChangeEventMixin struct {
+	entMixin.Schema
+}
+
+// Fields of the ProfileChangeEvent.
+func (ProfileChangeEventMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			NamedValues(
+				"JobChange", "JOB_CHANGE",
+				"TitleChange", "TITLE_CHANGE",
+				"LocationChange", "LOCATION_CHANGE",
+				"NewSkill", "NEW_SKILL",
+			).
+			Immutable().
+			Annotations(entgql.Type("ProfileChangeEventType")).
+			Comment("Kind of change"),
+
+		field.Text("old_value").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Value before the change"),
+
+		field.Text("new_value").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Value after the change"),
+
+		field.Time("detected_at").
+			Immutable().
+			Comment("Fetch time of the snapshot that revealed the change"),
+
+		// Webhook delivery
+		field.Enum("delivery_status").
+			NamedValues(
+				"Pending", "PENDING",
+				"Delivered", "DELIVERED",
+				"Failed", "FAILED",
+				"Skipped", "SKIPPED",
+			).
+			Default("PENDING").
+			Annotations(entgql.Type("WebhookDeliveryStatus")).
+			Comment("Webhook delivery state"),
+
+		field.Int("delivery_attempts").
+			Default(0).
+			NonNegative().
+			Comment("Webhook delivery attempts made so far"),
+
+		field.Time("next_delivery_at").
+			Optional().
+			Nillable().
+			Comment("Earliest time the next delivery attempt may run"),
+
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("Time the webhook acknowledged the event"),
+
+		field.Text("last_delivery_error").
+			Optional().
+			Nillable().
+			Comment("Error from the most recent failed delivery attempt"),
+	}
+}
+
+// Edges of the ProfileChangeEvent.
+func (ProfileChangeEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("profile", Profile.Type).
+			Ref("change_events").
+			Unique().
+			Required().
+			Comment("Profile the change was detected on"),
+		edge.From("snapshot", ProfileSnapshot.Type).
+			Ref("change_events").
+			Unique().
+			Comment("Snapshot that revealed the change"),
+	}
+}
+
+// Indexes of the ProfileChangeEvent.
+func (ProfileChangeEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for claiming due webhook deliveries
+		index.Fields("delivery_status", "next_delivery_at"),
+
+		// Index for the feed, newest first
+		index.Fields("detected_at"),
+
+		// Index for filtering by type
+		index.Fields("type"),
+	}
+}
+
+// Mixin of the ProfileChangeEvent.
+func (ProfileChangeEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.NewUlid(globalid.New().ProfileChangeEvent.Prefix),
+		ProfileChangeEventMixin{},
+		mixin.NewDatetime(),
+	}
+}
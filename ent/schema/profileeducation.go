@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"sheng-go-backend/ent/mixin"
+	"sheng-go-backend/pkg/const/globalid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	entMixin "entgo.io/ent/schema/mixin"
+)
+
+// ProfileEducation is one item of Profile.educations with typed columns, so
+// profiles can be filtered by school and degree. Rows are rebuilt from the
+// JSON on every ProfileRepository.Upsert.
+type ProfileEducation struct {
+	ent.Schema
+}
+
+// ProfileEducationMixin defines Fields
+type ProfileEducationMixin struct {
+	entMixin.Schema
+}
+
+// Fields of the ProfileEducation.
+func (ProfileEducationMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("education_index").
+			NonNegative().
+			Comment("Order in educations; 0 is the most recent"),
+
+		field.String("school_name").
+			Comment("School name"),
+
+		field.String("school_id").
+			Optional().
+			Comment("LinkedIn school ID, when present"),
+
+		field.String("degree").
+			Optional().
+			Comment("Degree, e.g. PhD"),
+
+		field.String("field_of_study").
+			Optional().
+			Comment("Field of study"),
+
+		field.Time("start_date").
+			Optional().
+			Nillable().
+			Comment("Start date; unknown month/day default to 1"),
+
+		field.Time("end_date").
+			Optional().
+			Nillable().
+			Comment("End date; unknown month/day default to 1"),
+	}
+}
+
+// Edges of the ProfileEducation.
+func (ProfileEducation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("profile", Profile.Type).
+			Ref("profile_educations").
+			Unique().
+			Required().
+			Comment("Profile this education belongs to"),
+	}
+}
+
+// Indexes of the ProfileEducation.
+func (ProfileEducation) Indexes() []ent.Index {
+	return []ent.Index{
+		// Indexes for school and degree searches
+		index.Fields("school_name"),
+		index.Fields("degree"),
+	}
+}
+
+// Mixin of the ProfileEducation.
+func (ProfileEducation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.NewUlid(globalid.New().ProfileEducation.Prefix),
+		ProfileEducationMixin{},
+		mixin.NewDatetime(),
+	}
+}
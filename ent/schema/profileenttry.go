@@ -77,6 +77,29 @@ func (ProfileEntry) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Error message if fetch failed"),
+
+		// Retry policy
+		field.Int("attempt_count").
+			Default(0).
+			NonNegative().
+			Comment("Number of consecutive failed fetch attempts"),
+
+		field.Time("next_retry_at").
+			Optional().
+			Nillable().
+			Comment("Earliest time a FAILED entry is retried; unset when no retry is scheduled"),
+
+		// Fetch lease
+		field.String("lease_owner").
+			Optional().
+			Nillable().
+			MaxLen(255).
+			Comment("Worker that currently holds the fetch lease"),
+
+		field.Time("lease_expires_at").
+			Optional().
+			Nillable().
+			Comment("Time at which the current fetch lease expires"),
 	}
 }
 
@@ -103,6 +126,12 @@ func (ProfileEntry) Indexes() []ent.Index {
 		// Composite index for status + created_at queries
 		// Useful for "get pending profiles ordered by creation"
 		index.Fields("status", "created_at"),
+
+		// Index for finding FAILED entries whose retry is due
+		index.Fields("status", "next_retry_at"),
+
+		// Index for finding expired leases of in-flight entries
+		index.Fields("status", "lease_expires_at"),
 	}
 }
 
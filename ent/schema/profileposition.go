@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"sheng-go-backend/ent/mixin"
+	"sheng-go-backend/pkg/const/globalid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	entMixin "entgo.io/ent/schema/mixin"
+)
+
+// ProfilePosition is one item of Profile.positions (RapidAPI fullPositions)
+// with typed columns, so profiles can be filtered by employer and title.
+// Rows are rebuilt from the JSON on every ProfileRepository.Upsert.
+type ProfilePosition struct {
+	ent.Schema
+}
+
+// ProfilePositionMixin defines Fields
+type ProfilePositionMixin struct {
+	entMixin.Schema
+}
+
+// Fields of the ProfilePosition.
+func (ProfilePositionMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("position_index").
+			NonNegative().
+			Comment("Order in fullPositions; 0 is the most recent"),
+
+		field.String("company_name").
+			Optional().
+			Comment("Company name"),
+
+		field.String("company_urn").
+			Optional().
+			Comment("LinkedIn company URN or ID, when present"),
+
+		field.String("company_username").
+			Optional().
+			Comment("LinkedIn company slug, when present"),
+
+		field.String("title").
+			Optional().
+			Comment("Job title"),
+
+		field.String("location").
+			Optional().
+			Comment("Position location"),
+
+		field.String("employment_type").
+			Optional().
+			Comment("Employment type, e.g. Full-time"),
+
+		field.Text("description").
+			Optional().
+			Comment("Position description"),
+
+		field.Time("start_date").
+			Optional().
+			Nillable().
+			Comment("Start date; unknown month/day default to 1"),
+
+		field.Time("end_date").
+			Optional().
+			Nillable().
+			Comment("End date; unknown month/day default to 1"),
+
+		field.Bool("is_current").
+			Default(false).
+			Comment("Whether this is a current position"),
+	}
+}
+
+// Edges of the ProfilePosition.
+func (ProfilePosition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("profile", Profile.Type).
+			Ref("profile_positions").
+			Unique().
+			Required().
+			Comment("Profile this position belongs to"),
+		edge.From("company", Company.Type).
+			Ref("positions").
+			Unique().
+			Comment("Company normalized from company_name/company_urn"),
+	}
+}
+
+// Indexes of the ProfilePosition.
+func (ProfilePosition) Indexes() []ent.Index {
+	return []ent.Index{
+		// Indexes for "who worked at X" and title searches
+		index.Fields("company_name"),
+		index.Fields("company_urn"),
+		index.Fields("title"),
+		index.Fields("is_current"),
+	}
+}
+
+// Mixin of the ProfilePosition.
+func (ProfilePosition) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.NewUlid(globalid.New().ProfilePosition.Prefix),
+		ProfilePositionMixin{},
+		mixin.NewDatetime(),
+	}
+}
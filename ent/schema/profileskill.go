@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"sheng-go-backend/ent/mixin"
+	"sheng-go-backend/pkg/const/globalid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	entMixin "entgo.io/ent/schema/mixin"
+)
+
+// ProfileSkill is one item of Profile.skills with typed columns. Rows are
+// rebuilt from the JSON on every ProfileRepository.Upsert.
+type ProfileSkill struct {
+	ent.Schema
+}
+
+// ProfileSkillMixin defines Fields
+type ProfileSkillMixin struct {
+	entMixin.Schema
+}
+
+// Fields of the ProfileSkill.
+func (ProfileSkillMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("Skill name"),
+
+		field.Int("endorsement_count").
+			Default(0).
+			NonNegative().
+			Comment("Number of endorsements"),
+	}
+}
+
+// Edges of the ProfileSkill.
+func (ProfileSkill) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("profile", Profile.Type).
+			Ref("profile_skills").
+			Unique().
+			Required().
+			Comment("Profile this skill belongs to"),
+	}
+}
+
+// Indexes of the ProfileSkill.
+func (ProfileSkill) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for skill searches
+		index.Fields("name"),
+	}
+}
+
+// Mixin of the ProfileSkill.
+func (ProfileSkill) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.NewUlid(globalid.New().ProfileSkill.Prefix),
+		ProfileSkillMixin{},
+		mixin.NewDatetime(),
+	}
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"sheng-go-backend/ent/mixin"
+	"sheng-go-backend/pkg/const/globalid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	entMixin "entgo.io/ent/schema/mixin"
+)
+
+// ProfileSnapshot records the extracted state of a Profile at one fetch, so
+// career history survives ProfileRepository.Upsert overwriting the profile.
+type ProfileSnapshot struct {
+	ent.Schema
+}
+
+// ProfileSnapshotMixin defines Fields
+type ProfileSnapshotMixin struct {
+	entMixin.Schema
+}
+
+// Fields of the ProfileSnapshot.
+func (ProfileSnapshotMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("fetched_at").
+			Immutable().
+			Comment("Time the profile was fetched"),
+
+		// S3 storage keys of this fetch
+		field.String("raw_data_s3_key").
+			Optional().
+			Nillable().
+			Immutable().
+			MaxLen(500).
+			Comment("S3 path to full RapidAPI response JSON"),
+
+		field.String("cleaned_data_s3_key").
+			Optional().
+			Nillable().
+			Immutable().
+			MaxLen(500).
+			Comment("S3 path to cleaned/extracted profile JSON"),
+
+		// Extracted fields
+		field.String("headline").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("LinkedIn headline/bio"),
+
+		field.String("title").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Current job title"),
+
+		field.String("country").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Country name"),
+
+		field.String("city").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("City name"),
+
+		field.JSON("positions", []map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Array of position records as returned in fullPositions"),
+
+		field.JSON("educations", []map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Array of education records"),
+
+		field.JSON("skills", []map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Array of skills"),
+	}
+}
+
+// Edges of the ProfileSnapshot.
+func (ProfileSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("profile", Profile.Type).
+			Ref("snapshots").
+			Unique().
+			Required().
+			Comment("Profile this snapshot was taken of"),
+		edge.To("change_events", ProfileChangeEvent.Type).
+			Comment("Change events detected when this snapshot was recorded"),
+	}
+}
+
+// Indexes of the ProfileSnapshot.
+func (ProfileSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for time-based queries
+		index.Fields("fetched_at"),
+	}
+}
+
+// Mixin of the ProfileSnapshot.
+func (ProfileSnapshot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.NewUlid(globalid.New().ProfileSnapshot.Prefix),
+		ProfileSnapshotMixin{},
+		mixin.NewDatetime(),
+	}
+}
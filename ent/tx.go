@@ -12,20 +12,38 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APICallLog is the client for interacting with the APICallLog builders.
+	APICallLog *APICallLogClient
+	// APIQuotaBudget is the client for interacting with the APIQuotaBudget builders.
+	APIQuotaBudget *APIQuotaBudgetClient
+	// APIQuotaReservation is the client for interacting with the APIQuotaReservation builders.
+	APIQuotaReservation *APIQuotaReservationClient
 	// APIQuotaTracker is the client for interacting with the APIQuotaTracker builders.
 	APIQuotaTracker *APIQuotaTrackerClient
+	// Company is the client for interacting with the Company builders.
+	Company *CompanyClient
 	// CronJobConfig is the client for interacting with the CronJobConfig builders.
 	CronJobConfig *CronJobConfigClient
 	// JobExecutionHistory is the client for interacting with the JobExecutionHistory builders.
 	JobExecutionHistory *JobExecutionHistoryClient
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
+	// ProfileChangeEvent is the client for interacting with the ProfileChangeEvent builders.
+	ProfileChangeEvent *ProfileChangeEventClient
+	// ProfileEducation is the client for interacting with the ProfileEducation builders.
+	ProfileEducation *ProfileEducationClient
 	// ProfileEntry is the client for interacting with the ProfileEntry builders.
 	ProfileEntry *ProfileEntryClient
+	// ProfilePosition is the client for interacting with the ProfilePosition builders.
+	ProfilePosition *ProfilePositionClient
 	// ProfilePost is the client for interacting with the ProfilePost builders.
 	ProfilePost *ProfilePostClient
 	// ProfilePostItem is the client for interacting with the ProfilePostItem builders.
 	ProfilePostItem *ProfilePostItemClient
+	// ProfileSkill is the client for interacting with the ProfileSkill builders.
+	ProfileSkill *ProfileSkillClient
+	// ProfileSnapshot is the client for interacting with the ProfileSnapshot builders.
+	ProfileSnapshot *ProfileSnapshotClient
 	// Todo is the client for interacting with the Todo builders.
 	Todo *TodoClient
 	// User is the client for interacting with the User builders.
@@ -161,13 +179,22 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APICallLog = NewAPICallLogClient(tx.config)
+	tx.APIQuotaBudget = NewAPIQuotaBudgetClient(tx.config)
+	tx.APIQuotaReservation = NewAPIQuotaReservationClient(tx.config)
 	tx.APIQuotaTracker = NewAPIQuotaTrackerClient(tx.config)
+	tx.Company = NewCompanyClient(tx.config)
 	tx.CronJobConfig = NewCronJobConfigClient(tx.config)
 	tx.JobExecutionHistory = NewJobExecutionHistoryClient(tx.config)
 	tx.Profile = NewProfileClient(tx.config)
+	tx.ProfileChangeEvent = NewProfileChangeEventClient(tx.config)
+	tx.ProfileEducation = NewProfileEducationClient(tx.config)
 	tx.ProfileEntry = NewProfileEntryClient(tx.config)
+	tx.ProfilePosition = NewProfilePositionClient(tx.config)
 	tx.ProfilePost = NewProfilePostClient(tx.config)
 	tx.ProfilePostItem = NewProfilePostItemClient(tx.config)
+	tx.ProfileSkill = NewProfileSkillClient(tx.config)
+	tx.ProfileSnapshot = NewProfileSnapshotClient(tx.config)
 	tx.Todo = NewTodoClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
@@ -179,7 +206,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: APIQuotaTracker.QueryXXX(), the query will be executed
+// applies a query, for example: APICallLog.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
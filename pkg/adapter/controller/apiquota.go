@@ -3,14 +3,34 @@ package controller
 import (
 	"context"
 	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/entity/model"
 	"sheng-go-backend/pkg/usecase/usecase/apiquota"
+	"time"
 )
 
 type APIQuota interface {
 	GetCurrent(ctx context.Context) (*ent.APIQuotaTracker, error)
 	GetHistory(ctx context.Context, limit int) ([]*ent.APIQuotaTracker, error)
+	GetKeyUsage(ctx context.Context) ([]*ent.APIQuotaTracker, error)
 	SetOverride(ctx context.Context, enabled bool) (*ent.APIQuotaTracker, error)
 	UpdateLimit(ctx context.Context, limit int) (*ent.APIQuotaTracker, error)
+	UpdateCaps(ctx context.Context, input model.UpdateQuotaCapsInput) (*ent.APIQuotaTracker, error)
+	GetPace(ctx context.Context) (*model.QuotaPace, error)
+	GetForecast(ctx context.Context, tracker *ent.APIQuotaTracker) (*model.QuotaForecast, error)
+	ListCalls(
+		ctx context.Context,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *ent.APICallLogWhereInput,
+	) (*ent.APICallLogConnection, error)
+	GetCallStats(ctx context.Context, groupBy model.APICallGroupBy, from *time.Time, to *time.Time) ([]*model.APICallStat, error)
+	ListBudgets(ctx context.Context) ([]*ent.APIQuotaBudget, error)
+	GetBudgetUsage(ctx context.Context) ([]*ent.APIQuotaTracker, error)
+	CreateBudget(ctx context.Context, input ent.CreateAPIQuotaBudgetInput) (*ent.APIQuotaBudget, error)
+	UpdateBudget(ctx context.Context, name string, input ent.UpdateAPIQuotaBudgetInput) (*ent.APIQuotaBudget, error)
+	DeleteBudget(ctx context.Context, name string) (*ent.APIQuotaBudget, error)
 }
 
 type apiQuotaController struct {
@@ -29,6 +49,10 @@ func (c *apiQuotaController) GetHistory(ctx context.Context, limit int) ([]*ent.
 	return c.quotaManager.GetHistory(ctx, limit)
 }
 
+func (c *apiQuotaController) GetKeyUsage(ctx context.Context) ([]*ent.APIQuotaTracker, error) {
+	return c.quotaManager.GetKeyUsage(ctx)
+}
+
 func (c *apiQuotaController) SetOverride(ctx context.Context, enabled bool) (*ent.APIQuotaTracker, error) {
 	err := c.quotaManager.SetQuotaOverride(ctx, enabled)
 	if err != nil {
@@ -40,3 +64,55 @@ func (c *apiQuotaController) SetOverride(ctx context.Context, enabled bool) (*en
 func (c *apiQuotaController) UpdateLimit(ctx context.Context, limit int) (*ent.APIQuotaTracker, error) {
 	return c.quotaManager.UpdateLimit(ctx, limit)
 }
+
+func (c *apiQuotaController) UpdateCaps(ctx context.Context, input model.UpdateQuotaCapsInput) (*ent.APIQuotaTracker, error) {
+	return c.quotaManager.UpdateCaps(ctx, input)
+}
+
+func (c *apiQuotaController) GetPace(ctx context.Context) (*model.QuotaPace, error) {
+	return c.quotaManager.GetPace(ctx)
+}
+
+func (c *apiQuotaController) GetForecast(ctx context.Context, tracker *ent.APIQuotaTracker) (*model.QuotaForecast, error) {
+	return c.quotaManager.GetForecast(ctx, tracker)
+}
+
+func (c *apiQuotaController) ListCalls(
+	ctx context.Context,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *ent.APICallLogWhereInput,
+) (*ent.APICallLogConnection, error) {
+	return c.quotaManager.ListCalls(ctx, after, first, before, last, where)
+}
+
+func (c *apiQuotaController) GetCallStats(
+	ctx context.Context,
+	groupBy model.APICallGroupBy,
+	from *time.Time,
+	to *time.Time,
+) ([]*model.APICallStat, error) {
+	return c.quotaManager.GetCallStats(ctx, groupBy, from, to)
+}
+
+func (c *apiQuotaController) ListBudgets(ctx context.Context) ([]*ent.APIQuotaBudget, error) {
+	return c.quotaManager.ListBudgets(ctx)
+}
+
+func (c *apiQuotaController) GetBudgetUsage(ctx context.Context) ([]*ent.APIQuotaTracker, error) {
+	return c.quotaManager.GetBudgetUsage(ctx)
+}
+
+func (c *apiQuotaController) CreateBudget(ctx context.Context, input ent.CreateAPIQuotaBudgetInput) (*ent.APIQuotaBudget, error) {
+	return c.quotaManager.CreateBudget(ctx, input)
+}
+
+func (c *apiQuotaController) UpdateBudget(ctx context.Context, name string, input ent.UpdateAPIQuotaBudgetInput) (*ent.APIQuotaBudget, error) {
+	return c.quotaManager.UpdateBudget(ctx, name, input)
+}
+
+func (c *apiQuotaController) DeleteBudget(ctx context.Context, name string) (*ent.APIQuotaBudget, error) {
+	return c.quotaManager.DeleteBudget(ctx, name)
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"context"
+	"sheng-go-backend/pkg/entity/model"
+	usecase "sheng-go-backend/pkg/usecase/usecase/company"
+)
+
+type Company interface {
+	Get(ctx context.Context, id model.ID) (*model.Company, error)
+	List(ctx context.Context,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *model.CompanyWhereInput,
+	) (*model.CompanyConnection, error)
+	CurrentEmployees(ctx context.Context,
+		companyID model.ID,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *model.ProfileWhereInput,
+	) (*model.ProfileConnection, error)
+	Alumni(ctx context.Context,
+		companyID model.ID,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *model.ProfileWhereInput,
+	) (*model.ProfileConnection, error)
+	HeadcountByTitle(
+		ctx context.Context,
+		companyID model.ID,
+		alumni bool,
+		minCount int,
+	) ([]*model.ProfileTitleGroup, error)
+}
+
+type companyController struct {
+	companyUseCase usecase.Company
+}
+
+func NewCompanyController(cu usecase.Company) Company {
+	return &companyController{companyUseCase: cu}
+}
+
+func (cc *companyController) Get(ctx context.Context, id model.ID) (*model.Company, error) {
+	return cc.companyUseCase.Get(ctx, id)
+}
+
+func (cc *companyController) List(
+	ctx context.Context,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *model.CompanyWhereInput,
+) (*model.CompanyConnection, error) {
+	return cc.companyUseCase.List(ctx, after, first, before, last, where)
+}
+
+func (cc *companyController) CurrentEmployees(
+	ctx context.Context,
+	companyID model.ID,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *model.ProfileWhereInput,
+) (*model.ProfileConnection, error) {
+	return cc.companyUseCase.CurrentEmployees(ctx, companyID, after, first, before, last, where)
+}
+
+func (cc *companyController) Alumni(
+	ctx context.Context,
+	companyID model.ID,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *model.ProfileWhereInput,
+) (*model.ProfileConnection, error) {
+	return cc.companyUseCase.Alumni(ctx, companyID, after, first, before, last, where)
+}
+
+func (cc *companyController) HeadcountByTitle(
+	ctx context.Context,
+	companyID model.ID,
+	alumni bool,
+	minCount int,
+) ([]*model.ProfileTitleGroup, error) {
+	return cc.companyUseCase.HeadcountByTitle(ctx, companyID, alumni, minCount)
+}
@@ -56,6 +56,9 @@ func (c *cronJobController) Update(ctx context.Context, jobName string, input en
 	if input.BatchSize != nil {
 		updates["batch_size"] = *input.BatchSize
 	}
+	if input.Concurrency != nil {
+		updates["concurrency"] = *input.Concurrency
+	}
 	if input.AdminEmail != nil {
 		updates["admin_email"] = *input.AdminEmail
 	}
@@ -9,6 +9,7 @@ import (
 	"sheng-go-backend/pkg/adapter/repository/profileentryrepository"
 	"sheng-go-backend/pkg/entity/model"
 	"sheng-go-backend/pkg/usecase/usecase/apiquota"
+	"sheng-go-backend/pkg/usecase/usecase/profilefetcher"
 )
 
 type Dashboard interface {
@@ -20,6 +21,7 @@ type dashboardController struct {
 	profileEntryRepo profileentryrepository.ProfileEntryRepository
 	cronJobRepo      *cronjobconfigrepository.CronJobConfigRepository
 	jobHistoryRepo   *jobexecutionhistoryrepository.JobExecutionHistoryRepository
+	profileFetcher   *profilefetcher.ProfileFetcher
 }
 
 func NewDashboardController(
@@ -27,12 +29,14 @@ func NewDashboardController(
 	profileEntryRepo profileentryrepository.ProfileEntryRepository,
 	cronJobRepo *cronjobconfigrepository.CronJobConfigRepository,
 	jobHistoryRepo *jobexecutionhistoryrepository.JobExecutionHistoryRepository,
+	profileFetcher *profilefetcher.ProfileFetcher,
 ) Dashboard {
 	return &dashboardController{
 		quotaManager:     quotaManager,
 		profileEntryRepo: profileEntryRepo,
 		cronJobRepo:      cronJobRepo,
 		jobHistoryRepo:   jobHistoryRepo,
+		profileFetcher:   profileFetcher,
 	}
 }
 
@@ -43,6 +47,18 @@ func (c *dashboardController) GetOverview(ctx context.Context) (*model.Dashboard
 		return nil, fmt.Errorf("failed to get quota status: %w", err)
 	}
 
+	// Get per-key usage
+	keyUsage, err := c.quotaManager.GetKeyUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key usage: %w", err)
+	}
+
+	// Get pace against plan and the rolling caps
+	pace, err := c.quotaManager.GetPace(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quota pace: %w", err)
+	}
+
 	// Get pending profiles count
 	pendingCount, err := c.profileEntryRepo.CountByStatus(ctx, profileentry.StatusPending)
 	if err != nil {
@@ -67,11 +83,20 @@ func (c *dashboardController) GetOverview(ctx context.Context) (*model.Dashboard
 		return nil, fmt.Errorf("failed to get profile entry stats: %w", err)
 	}
 
+	// Get profile provider circuit breaker state
+	var providerStatus *model.ProfileProviderStatus
+	if c.profileFetcher != nil {
+		providerStatus = c.profileFetcher.ProviderStatus()
+	}
+
 	return &model.DashboardOverview{
 		QuotaStatus:          quotaStatus,
+		APIKeyUsage:          keyUsage,
+		QuotaPace:            pace,
 		PendingProfilesCount: pendingCount,
 		RecentJobExecutions:  recentJobs,
 		CronJobsStatus:       cronJobs,
 		ProfileEntryStats:    stats,
+		ProviderStatus:       providerStatus,
 	}, nil
 }
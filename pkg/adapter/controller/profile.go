@@ -20,6 +20,23 @@ type Profile interface {
 		searchTerm *string,
 		minCount int,
 	) ([]*model.ProfileTitleGroup, error)
+	Snapshots(ctx context.Context, profileID model.ID) ([]*model.ProfileSnapshot, error)
+	Changes(ctx context.Context, profileID model.ID) ([]*model.ProfileChange, error)
+	Positions(
+		ctx context.Context,
+		profileID model.ID,
+		where *model.ProfilePositionWhereInput,
+	) ([]*model.ProfilePosition, error)
+	Educations(
+		ctx context.Context,
+		profileID model.ID,
+		where *model.ProfileEducationWhereInput,
+	) ([]*model.ProfileEducation, error)
+	Skills(
+		ctx context.Context,
+		profileID model.ID,
+		where *model.ProfileSkillWhereInput,
+	) ([]*model.ProfileSkill, error)
 }
 
 type profileController struct {
@@ -68,3 +85,41 @@ func (pc *profileController) GroupByTitle(
 ) ([]*model.ProfileTitleGroup, error) {
 	return pc.profileUseCase.GroupByTitle(ctx, searchTerm, minCount)
 }
+
+func (pc *profileController) Snapshots(
+	ctx context.Context,
+	profileID model.ID,
+) ([]*model.ProfileSnapshot, error) {
+	return pc.profileUseCase.Snapshots(ctx, profileID)
+}
+
+func (pc *profileController) Changes(
+	ctx context.Context,
+	profileID model.ID,
+) ([]*model.ProfileChange, error) {
+	return pc.profileUseCase.Changes(ctx, profileID)
+}
+
+func (pc *profileController) Positions(
+	ctx context.Context,
+	profileID model.ID,
+	where *model.ProfilePositionWhereInput,
+) ([]*model.ProfilePosition, error) {
+	return pc.profileUseCase.Positions(ctx, profileID, where)
+}
+
+func (pc *profileController) Educations(
+	ctx context.Context,
+	profileID model.ID,
+	where *model.ProfileEducationWhereInput,
+) ([]*model.ProfileEducation, error) {
+	return pc.profileUseCase.Educations(ctx, profileID, where)
+}
+
+func (pc *profileController) Skills(
+	ctx context.Context,
+	profileID model.ID,
+	where *model.ProfileSkillWhereInput,
+) ([]*model.ProfileSkill, error) {
+	return pc.profileUseCase.Skills(ctx, profileID, where)
+}
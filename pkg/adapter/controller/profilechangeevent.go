@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"context"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/usecase/usecase/profilechangeevent"
+)
+
+type ProfileChangeEvent interface {
+	List(ctx context.Context,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *ent.ProfileChangeEventWhereInput,
+	) (*ent.ProfileChangeEventConnection, error)
+}
+
+type profileChangeEventController struct {
+	usecase profilechangeevent.UseCase
+}
+
+func NewProfileChangeEventController(usecase profilechangeevent.UseCase) ProfileChangeEvent {
+	return &profileChangeEventController{usecase: usecase}
+}
+
+func (c *profileChangeEventController) List(
+	ctx context.Context,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *ent.ProfileChangeEventWhereInput,
+) (*ent.ProfileChangeEventConnection, error) {
+	return c.usecase.List(ctx, after, first, before, last, where)
+}
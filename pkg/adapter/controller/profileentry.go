@@ -19,8 +19,16 @@ type ProfileEntry interface {
 		before *model.Cursor,
 		last *int, where *model.ProfileEntryWhereInput) (*model.ProfileEntryConnection, error)
 	GetStats(ctx context.Context) (*model.ProfileEntryStats, error)
-	FetchProfileEntry(ctx context.Context, id model.ID) error
-	FetchProfileByURL(ctx context.Context, url string) (json.RawMessage, error)
+	Requeue(
+		ctx context.Context,
+		input model.RequeueProfileEntriesInput,
+	) (*model.BulkProfileEntryResult, error)
+	FetchProfileEntry(ctx context.Context, id model.ID, opts *model.ProfileFetchOptions) error
+	FetchProfileByURL(
+		ctx context.Context,
+		url string,
+		opts *model.ProfileFetchOptions,
+	) (json.RawMessage, error)
 }
 
 type profileEntryController struct {
@@ -79,16 +87,25 @@ func (pc *profileEntryController) GetStats(
 	return pc.profileEntryUseCase.GetStats(ctx)
 }
 
+func (pc *profileEntryController) Requeue(
+	ctx context.Context,
+	input model.RequeueProfileEntriesInput,
+) (*model.BulkProfileEntryResult, error) {
+	return pc.profileEntryUseCase.Requeue(ctx, input)
+}
+
 func (pc *profileEntryController) FetchProfileEntry(
 	ctx context.Context,
 	id model.ID,
+	opts *model.ProfileFetchOptions,
 ) error {
-	return pc.profilefetcherUserCase.FetchSinglEntry(ctx, id)
+	return pc.profilefetcherUserCase.FetchSinglEntry(ctx, id, opts)
 }
 
 func (pc *profileEntryController) FetchProfileByURL(
 	ctx context.Context,
 	url string,
+	opts *model.ProfileFetchOptions,
 ) (json.RawMessage, error) {
-	return pc.profilefetcherUserCase.FetchProfileByURL(ctx, url)
+	return pc.profilefetcherUserCase.FetchProfileByURL(ctx, url, opts)
 }
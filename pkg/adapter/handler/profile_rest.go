@@ -7,7 +7,7 @@ import (
 
 	"sheng-go-backend/pkg/adapter/controller"
 	"sheng-go-backend/pkg/entity/model"
-	"sheng-go-backend/pkg/infrastructure/external/rapidapi"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
 	routerhandler "sheng-go-backend/pkg/infrastructure/router/handler"
 
 	"github.com/labstack/echo/v4"
@@ -25,12 +25,17 @@ func NewProfileRESTHandler(profileEntry controller.ProfileEntry) *ProfileRESTHan
 
 type fetchProfileRequest struct {
 	LinkedinURL string `json:"linkedinUrl"`
+	// MaxAgeSeconds and ForceRefresh control the response cache; see
+	// model.ProfileFetchOptions.
+	MaxAgeSeconds *int `json:"maxAgeSeconds"`
+	ForceRefresh  bool `json:"forceRefresh"`
 }
 
 // Fetch handles POST /api/profiles/fetch.
 //
 // It accepts a LinkedIn profile URL and returns the raw RapidAPI profile JSON,
-// fetching it on demand when it has not been fetched yet.
+// fetching it on demand unless it was fetched within maxAgeSeconds (default
+// profileProvider.cacheTTLMinutes) and forceRefresh is not set.
 func (h *ProfileRESTHandler) Fetch(c echo.Context) error {
 	var req fetchProfileRequest
 	if err := c.Bind(&req); err != nil {
@@ -41,7 +46,14 @@ func (h *ProfileRESTHandler) Fetch(c echo.Context) error {
 		return routerhandler.HandleError(c, model.NewInvalidParamError("linkedinUrl is required"))
 	}
 
-	raw, err := h.profileEntry.FetchProfileByURL(c.Request().Context(), req.LinkedinURL)
+	raw, err := h.profileEntry.FetchProfileByURL(
+		c.Request().Context(),
+		req.LinkedinURL,
+		&model.ProfileFetchOptions{
+			MaxAgeSeconds: req.MaxAgeSeconds,
+			ForceRefresh:  req.ForceRefresh,
+		},
+	)
 	if err != nil {
 		return routerhandler.HandleError(c, toRESTError(err))
 	}
@@ -50,6 +62,25 @@ func (h *ProfileRESTHandler) Fetch(c echo.Context) error {
 	return c.JSONBlob(http.StatusOK, raw)
 }
 
+// Requeue handles POST /api/profile-entries/requeue.
+//
+// It moves every profile entry matching the request filter back to PENDING and
+// returns the affected count. With dryRun set it only counts and previews the
+// matching entries.
+func (h *ProfileRESTHandler) Requeue(c echo.Context) error {
+	var req model.RequeueProfileEntriesInput
+	if err := c.Bind(&req); err != nil {
+		return routerhandler.HandleError(c, model.NewInvalidParamError(err.Error()))
+	}
+
+	result, err := h.profileEntry.Requeue(c.Request().Context(), req)
+	if err != nil {
+		return routerhandler.HandleError(c, toRESTError(err))
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // toRESTError normalises errors from the fetch flow into model errors that the
 // shared HandleError helper understands.
 func toRESTError(err error) error {
@@ -59,11 +90,33 @@ func toRESTError(err error) error {
 		return err
 	}
 
-	// Profile not found upstream at RapidAPI.
-	var notFound *rapidapi.NotFoundError
+	// Profile not found upstream at the profile provider.
+	var notFound *profileprovider.NotFoundError
 	if errors.As(err, &notFound) {
 		return model.NewNotFoundError(err, notFound.URN)
 	}
 
+	var rateLimited *profileprovider.RateLimitError
+	if errors.As(err, &rateLimited) {
+		return model.NewRateLimitError(err)
+	}
+
+	// The provider cannot serve any request until quota resets or the
+	// breaker closes.
+	var quotaErr *profileprovider.QuotaExhaustedError
+	var openErr *profileprovider.CircuitOpenError
+	if errors.As(err, &quotaErr) || errors.As(err, &openErr) {
+		return model.NewServiceUnavailableError(err)
+	}
+
+	// A bad API key is our misconfiguration, not the caller's, so it is
+	// reported as an upstream failure rather than 401.
+	var authErr *profileprovider.UnauthorizedError
+	var invalidErr *profileprovider.InvalidResponseError
+	var upstreamErr *profileprovider.UpstreamError
+	if errors.As(err, &authErr) || errors.As(err, &invalidErr) || errors.As(err, &upstreamErr) {
+		return model.NewUpstreamError(err)
+	}
+
 	return model.NewInternalServerError(err)
 }
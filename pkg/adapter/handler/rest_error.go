@@ -9,12 +9,15 @@ import (
 )
 
 var codeToStatusMap = map[string]int{
-	model.NotFoundError:       http.StatusUnprocessableEntity,
-	model.DBError:             http.StatusInternalServerError,
-	model.ValidationError:     http.StatusBadRequest,
-	model.BadRequestError:     http.StatusBadRequest,
-	model.AuthError:           http.StatusUnauthorized,
-	model.InternalServerError: http.StatusInternalServerError,
+	model.NotFoundError:           http.StatusUnprocessableEntity,
+	model.DBError:                 http.StatusInternalServerError,
+	model.ValidationError:         http.StatusBadRequest,
+	model.BadRequestError:         http.StatusBadRequest,
+	model.AuthError:               http.StatusUnauthorized,
+	model.InternalServerError:     http.StatusInternalServerError,
+	model.RateLimitError:          http.StatusTooManyRequests,
+	model.UpstreamError:           http.StatusBadGateway,
+	model.ServiceUnavailableError: http.StatusServiceUnavailable,
 }
 
 func mapErrorCodeToHTTPStatus(c string) int {
@@ -0,0 +1,77 @@
+package apicalllogrepository
+
+import (
+	"context"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/schema/ulid"
+	"sheng-go-backend/pkg/entity/model"
+)
+
+type APICallLogRepository struct {
+	client *ent.Client
+}
+
+func NewAPICallLogRepository(client *ent.Client) *APICallLogRepository {
+	return &APICallLogRepository{client: client}
+}
+
+// Create records an API call
+func (r *APICallLogRepository) Create(ctx context.Context, input *ent.APICallLog) (*ent.APICallLog, error) {
+	return r.client.APICallLog.
+		Create().
+		SetEndpoint(input.Endpoint).
+		SetKeyLabel(input.KeyLabel).
+		SetCaller(input.Caller).
+		SetRunID(input.RunID).
+		SetUrn(input.Urn).
+		SetNillableHTTPStatus(input.HTTPStatus).
+		SetLatencyMs(input.LatencyMs).
+		SetBytes(input.Bytes).
+		SetCounted(input.Counted).
+		Save(ctx)
+}
+
+// LinkRun links the calls made in a job run to the run's execution history
+func (r *APICallLogRepository) LinkRun(ctx context.Context, runID string, executionID ulid.ID) (int, error) {
+	return r.client.APICallLog.
+		Update().
+		Where(
+			apicalllog.RunID(runID),
+			apicalllog.Not(apicalllog.HasJobExecution()),
+		).
+		SetJobExecutionID(executionID).
+		Save(ctx)
+}
+
+// List retrieves API calls with pagination and filtering
+func (r *APICallLogRepository) List(
+	ctx context.Context,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *ent.APICallLogWhereInput,
+) (*ent.APICallLogConnection, error) {
+	conn, err := r.client.APICallLog.Query().
+		Paginate(ctx, after, first, before, last, ent.WithAPICallLogFilter(where.Filter))
+	if err != nil {
+		return nil, model.NewDBError(err)
+	}
+
+	return conn, nil
+}
+
+// Count counts the API calls matching where
+func (r *APICallLogRepository) Count(ctx context.Context, where *ent.APICallLogWhereInput) (int, error) {
+	query, err := where.Filter(r.client.APICallLog.Query())
+	if err != nil {
+		return 0, model.NewDBError(err)
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, model.NewDBError(err)
+	}
+	return count, nil
+}
@@ -0,0 +1,128 @@
+package apicalllogrepository
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/pkg/entity/model"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// statRow is one group of an aggregate query. Only the column grouped by is
+// set among Day, Endpoint, HTTPStatus, Caller and KeyLabel.
+type statRow struct {
+	Day          string   `json:"day"`
+	Endpoint     string   `json:"endpoint"`
+	HTTPStatus   *int     `json:"http_status"`
+	Caller       string   `json:"caller"`
+	KeyLabel     string   `json:"key_label"`
+	Calls        int      `json:"calls"`
+	CountedCalls int      `json:"counted_calls"`
+	FailedCalls  int      `json:"failed_calls"`
+	Bytes        int      `json:"bytes"`
+	AvgLatencyMs *float64 `json:"avg_latency_ms"`
+}
+
+// Stats aggregates the calls made in [from, to) per value of groupBy in the
+// database. Days are calendar days in the database session's time zone.
+func (r *APICallLogRepository) Stats(
+	ctx context.Context,
+	groupBy model.APICallGroupBy,
+	from, to time.Time,
+) ([]*model.APICallStat, error) {
+	query := r.client.APICallLog.
+		Query().
+		Where(
+			apicalllog.CreatedAtGTE(from),
+			apicalllog.CreatedAtLT(to),
+		)
+	aggregates := []ent.AggregateFunc{
+		ent.As(ent.Count(), "calls"),
+		ent.As(countWhere(func(s *sql.Selector) string {
+			return s.C(apicalllog.FieldCounted)
+		}), "counted_calls"),
+		ent.As(countWhere(func(s *sql.Selector) string {
+			status := s.C(apicalllog.FieldHTTPStatus)
+			return fmt.Sprintf("%s IS NULL OR %s < 200 OR %s >= 300", status, status, status)
+		}), "failed_calls"),
+		ent.As(ent.Sum(apicalllog.FieldBytes), "bytes"),
+		ent.As(ent.Mean(apicalllog.FieldLatencyMs), "avg_latency_ms"),
+	}
+
+	var rows []statRow
+	var err error
+	if groupBy == model.APICallGroupByDay {
+		// GroupBy only takes columns; group on the day of created_at instead
+		day := func(s *sql.Selector) string {
+			expr := fmt.Sprintf("to_char(%s, 'YYYY-MM-DD')", s.C(apicalllog.FieldCreatedAt))
+			s.GroupBy(expr)
+			return sql.As(expr, "day")
+		}
+		err = query.Aggregate(append([]ent.AggregateFunc{day}, aggregates...)...).Scan(ctx, &rows)
+	} else {
+		err = query.GroupBy(groupColumn(groupBy)).Aggregate(aggregates...).Scan(ctx, &rows)
+	}
+	if err != nil {
+		return nil, model.NewDBError(err)
+	}
+
+	stats := make([]*model.APICallStat, 0, len(rows))
+	for _, row := range rows {
+		stat := &model.APICallStat{
+			Group:        statGroup(row, groupBy),
+			Calls:        row.Calls,
+			CountedCalls: row.CountedCalls,
+			FailedCalls:  row.FailedCalls,
+			Bytes:        row.Bytes,
+		}
+		if row.AvgLatencyMs != nil {
+			stat.AvgLatencyMs = *row.AvgLatencyMs
+		}
+		stats = append(stats, stat)
+	}
+	return stats, nil
+}
+
+// countWhere counts the rows matching the condition cond builds
+func countWhere(cond func(*sql.Selector) string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		return fmt.Sprintf("COUNT(CASE WHEN %s THEN 1 END)", cond(s))
+	}
+}
+
+// groupColumn returns the column grouped on for groupBy, other than DAY
+func groupColumn(groupBy model.APICallGroupBy) string {
+	switch groupBy {
+	case model.APICallGroupByEndpoint:
+		return apicalllog.FieldEndpoint
+	case model.APICallGroupByStatus:
+		return apicalllog.FieldHTTPStatus
+	case model.APICallGroupByCaller:
+		return apicalllog.FieldCaller
+	default:
+		return apicalllog.FieldKeyLabel
+	}
+}
+
+// statGroup returns the group value of row
+func statGroup(row statRow, groupBy model.APICallGroupBy) string {
+	switch groupBy {
+	case model.APICallGroupByDay:
+		return row.Day
+	case model.APICallGroupByEndpoint:
+		return row.Endpoint
+	case model.APICallGroupByStatus:
+		if row.HTTPStatus == nil {
+			return "NONE"
+		}
+		return strconv.Itoa(*row.HTTPStatus)
+	case model.APICallGroupByCaller:
+		return row.Caller
+	default:
+		return row.KeyLabel
+	}
+}
@@ -0,0 +1,25 @@
+package apicalllogrepository
+
+import (
+	"sheng-go-backend/pkg/entity/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatGroup(t *testing.T) {
+	ok := 200
+	row := statRow{Day: "2025-06-11", Endpoint: "profile", HTTPStatus: &ok, Caller: "cron", KeyLabel: "main"}
+
+	t.Run("Should read the grouped column", func(t *testing.T) {
+		assert.Equal(t, "2025-06-11", statGroup(row, model.APICallGroupByDay))
+		assert.Equal(t, "profile", statGroup(row, model.APICallGroupByEndpoint))
+		assert.Equal(t, "200", statGroup(row, model.APICallGroupByStatus))
+		assert.Equal(t, "cron", statGroup(row, model.APICallGroupByCaller))
+		assert.Equal(t, "main", statGroup(row, model.APICallGroupByKey))
+	})
+
+	t.Run("Should group calls without a response under NONE", func(t *testing.T) {
+		assert.Equal(t, "NONE", statGroup(statRow{}, model.APICallGroupByStatus))
+	})
+}
@@ -0,0 +1,55 @@
+package apiquotabudgetrepository
+
+import (
+	"context"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/schema/ulid"
+)
+
+type APIQuotaBudgetRepository struct {
+	client *ent.Client
+}
+
+func NewAPIQuotaBudgetRepository(client *ent.Client) *APIQuotaBudgetRepository {
+	return &APIQuotaBudgetRepository{client: client}
+}
+
+// GetByName retrieves a quota budget by name
+func (r *APIQuotaBudgetRepository) GetByName(ctx context.Context, name string) (*ent.APIQuotaBudget, error) {
+	return r.client.APIQuotaBudget.
+		Query().
+		Where(apiquotabudget.Name(name)).
+		Only(ctx)
+}
+
+// List retrieves all quota budgets ordered by name
+func (r *APIQuotaBudgetRepository) List(ctx context.Context) ([]*ent.APIQuotaBudget, error) {
+	return r.client.APIQuotaBudget.
+		Query().
+		Order(ent.Asc(apiquotabudget.FieldName)).
+		All(ctx)
+}
+
+// Create creates a new quota budget
+func (r *APIQuotaBudgetRepository) Create(ctx context.Context, input ent.CreateAPIQuotaBudgetInput) (*ent.APIQuotaBudget, error) {
+	return r.client.APIQuotaBudget.
+		Create().
+		SetInput(input).
+		Save(ctx)
+}
+
+// Update updates a quota budget
+func (r *APIQuotaBudgetRepository) Update(ctx context.Context, id string, input ent.UpdateAPIQuotaBudgetInput) (*ent.APIQuotaBudget, error) {
+	return r.client.APIQuotaBudget.
+		UpdateOneID(ulid.ID(id)).
+		SetInput(input).
+		Save(ctx)
+}
+
+// Delete deletes a quota budget
+func (r *APIQuotaBudgetRepository) Delete(ctx context.Context, id string) error {
+	return r.client.APIQuotaBudget.
+		DeleteOneID(ulid.ID(id)).
+		Exec(ctx)
+}
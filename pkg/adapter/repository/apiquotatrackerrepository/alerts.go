@@ -0,0 +1,59 @@
+package apiquotatrackerrepository
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/ent"
+	"slices"
+)
+
+// ClaimThresholdAlerts marks the thresholds as alerted on the tracker and
+// returns the ones that were not already, so each threshold is alerted once
+// even when several workers cross it together.
+func (r *APIQuotaTrackerRepository) ClaimThresholdAlerts(ctx context.Context, id string, thresholds []int) ([]int, error) {
+	var claimed []int
+	_, err := r.withLockedTracker(ctx, id, func(tx *ent.Tx, tracker *ent.APIQuotaTracker) error {
+		for _, threshold := range thresholds {
+			if !slices.Contains(tracker.AlertedThresholds, threshold) {
+				claimed = append(claimed, threshold)
+			}
+		}
+		if len(claimed) == 0 {
+			return nil
+		}
+
+		alerted := append(slices.Clone(tracker.AlertedThresholds), claimed...)
+		slices.Sort(alerted)
+		err := tx.APIQuotaTracker.
+			UpdateOneID(tracker.ID).
+			SetAlertedThresholds(alerted).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to mark quota thresholds alerted: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// ReleaseThresholdAlerts unmarks thresholds claimed by ClaimThresholdAlerts
+// whose alert could not be sent, so the next crossing tries again.
+func (r *APIQuotaTrackerRepository) ReleaseThresholdAlerts(ctx context.Context, id string, thresholds []int) error {
+	_, err := r.withLockedTracker(ctx, id, func(tx *ent.Tx, tracker *ent.APIQuotaTracker) error {
+		alerted := slices.DeleteFunc(slices.Clone(tracker.AlertedThresholds), func(threshold int) bool {
+			return slices.Contains(thresholds, threshold)
+		})
+		err := tx.APIQuotaTracker.
+			UpdateOneID(tracker.ID).
+			SetAlertedThresholds(alerted).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to unmark quota thresholds alerted: %w", err)
+		}
+		return nil
+	})
+	return err
+}
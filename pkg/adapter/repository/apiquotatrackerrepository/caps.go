@@ -0,0 +1,154 @@
+package apiquotatrackerrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/apiquotareservation"
+	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ErrCapReached is returned by Reserve when the rolling hourly or daily cap
+// leaves no calls to reserve.
+var ErrCapReached = errors.New("API call cap for the rolling hour or day reached")
+
+// WindowCaps limits the calls reserved or made over rolling windows. Zero
+// means no cap.
+type WindowCaps struct {
+	Hourly int
+	Daily  int
+}
+
+// UpdateCaps sets the tracker's rolling caps and pacing mode. A nil cap
+// removes it.
+func (r *APIQuotaTrackerRepository) UpdateCaps(
+	ctx context.Context,
+	id string,
+	dailyCap *int,
+	hourlyCap *int,
+	evenPacing bool,
+) (*ent.APIQuotaTracker, error) {
+	update := r.client.APIQuotaTracker.
+		UpdateOneID(ulid.ID(id)).
+		SetNillableDailyCap(dailyCap).
+		SetNillableHourlyCap(hourlyCap).
+		SetEvenPacing(evenPacing)
+	if dailyCap == nil {
+		update.ClearDailyCap()
+	}
+	if hourlyCap == nil {
+		update.ClearHourlyCap()
+	}
+	return update.Save(ctx)
+}
+
+// WindowUsage returns the calls made since since plus the calls the pool-wide
+// trackers' open reservations hold.
+func (r *APIQuotaTrackerRepository) WindowUsage(ctx context.Context, since time.Time) (int, error) {
+	return windowUsage(ctx, r.client.APICallLog, r.client.APIQuotaReservation, since)
+}
+
+// RecordCalls counts count calls made without a reservation on the tracker
+// and adds them to the reservation ledger as a settled entry.
+func (r *APIQuotaTrackerRepository) RecordCalls(ctx context.Context, id string, count int) (*ent.APIQuotaTracker, error) {
+	return r.withLockedTracker(ctx, id, func(tx *ent.Tx, tracker *ent.APIQuotaTracker) error {
+		if err := addCalls(ctx, tx, tracker, count, 0); err != nil {
+			return err
+		}
+		return recordSettled(ctx, tx, tracker, nil, count)
+	})
+}
+
+// recordSettled adds count calls made outside any reservation's grant to the
+// ledger as a settled entry, so the ledger's used calls add up to call_count.
+func recordSettled(ctx context.Context, tx *ent.Tx, tracker, budget *ent.APIQuotaTracker, count int) error {
+	if count <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	create := tx.APIQuotaReservation.
+		Create().
+		SetTrackerID(tracker.ID).
+		SetReserved(count).
+		SetUsed(count).
+		SetStatus(apiquotareservation.StatusCommitted).
+		SetExpiresAt(now).
+		SetSettledAt(now)
+	if budget != nil {
+		create.SetBudgetTrackerID(budget.ID)
+	}
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record calls: %w", err)
+	}
+	return nil
+}
+
+// capRoom returns how many more calls caps allow at now. The caller holds the
+// pool-wide tracker's lock, so the ledger cannot change underneath.
+func capRoom(ctx context.Context, tx *ent.Tx, caps WindowCaps, now time.Time) (int, error) {
+	room := math.MaxInt
+	for _, window := range []struct {
+		cap    int
+		length time.Duration
+	}{
+		{caps.Hourly, time.Hour},
+		{caps.Daily, 24 * time.Hour},
+	} {
+		if window.cap <= 0 {
+			continue
+		}
+		used, err := windowUsage(ctx, tx.APICallLog, tx.APIQuotaReservation, now.Add(-window.length))
+		if err != nil {
+			return 0, err
+		}
+		room = min(room, window.cap-used)
+	}
+	return max(0, room), nil
+}
+
+// windowUsage returns the calls counted since since plus the calls open
+// reservations still hold. Calls are taken from the API call log, keyed on
+// when they were made, so a reservation committing calls spread over hours
+// counts each in its own window. A call made but not yet committed is counted
+// twice until its commit, which errs on the side of the caps.
+func windowUsage(ctx context.Context, calls *ent.APICallLogClient, reservations *ent.APIQuotaReservationClient, since time.Time) (int, error) {
+	made, err := calls.
+		Query().
+		Where(
+			apicalllog.Counted(true),
+			apicalllog.CreatedAtGTE(since),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count API calls since %s: %w", since.Format(time.RFC3339), err)
+	}
+
+	held, err := reservations.
+		Query().
+		Where(
+			apiquotareservation.HasTrackerWith(
+				apiquotatracker.KeyLabel(""),
+				apiquotatracker.Budget(""),
+			),
+			apiquotareservation.StatusEQ(apiquotareservation.StatusOpen),
+		).
+		Aggregate(func(s *sql.Selector) string {
+			return sql.As(fmt.Sprintf("COALESCE(SUM(%s - %s), 0)",
+				s.C(apiquotareservation.FieldReserved),
+				s.C(apiquotareservation.FieldUsed),
+			), "held")
+		}).
+		Int(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum reserved API calls: %w", err)
+	}
+	return made + held, nil
+}
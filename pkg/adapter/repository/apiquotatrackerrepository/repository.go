@@ -16,11 +16,18 @@ func NewAPIQuotaTrackerRepository(client *ent.Client) *APIQuotaTrackerRepository
 	return &APIQuotaTrackerRepository{client: client}
 }
 
-// GetByMonthYear retrieves the quota tracker for a specific month/year
+// GetByMonthYear retrieves the pool-wide quota tracker for a specific month/year
 func (r *APIQuotaTrackerRepository) GetByMonthYear(ctx context.Context, month, year int) (*ent.APIQuotaTracker, error) {
+	return r.GetKeyTracker(ctx, "", month, year)
+}
+
+// GetKeyTracker retrieves the quota tracker of one API key for a specific month/year
+func (r *APIQuotaTrackerRepository) GetKeyTracker(ctx context.Context, keyLabel string, month, year int) (*ent.APIQuotaTracker, error) {
 	return r.client.APIQuotaTracker.
 		Query().
 		Where(
+			apiquotatracker.KeyLabel(keyLabel),
+			apiquotatracker.Budget(""),
 			apiquotatracker.Month(month),
 			apiquotatracker.Year(year),
 		).
@@ -33,10 +40,16 @@ func (r *APIQuotaTrackerRepository) GetCurrent(ctx context.Context) (*ent.APIQuo
 	return r.GetByMonthYear(ctx, int(now.Month()), now.Year())
 }
 
-// Create creates a new quota tracker
+// Create creates a new pool-wide quota tracker
 func (r *APIQuotaTrackerRepository) Create(ctx context.Context, month, year, quotaLimit int) (*ent.APIQuotaTracker, error) {
+	return r.CreateKeyTracker(ctx, "", month, year, quotaLimit)
+}
+
+// CreateKeyTracker creates a new quota tracker for one API key
+func (r *APIQuotaTrackerRepository) CreateKeyTracker(ctx context.Context, keyLabel string, month, year, quotaLimit int) (*ent.APIQuotaTracker, error) {
 	return r.client.APIQuotaTracker.
 		Create().
+		SetKeyLabel(keyLabel).
 		SetMonth(month).
 		SetYear(year).
 		SetCallCount(0).
@@ -47,22 +60,13 @@ func (r *APIQuotaTrackerRepository) Create(ctx context.Context, month, year, quo
 		Save(ctx)
 }
 
-// IncrementCallCount increments the API call count
+// IncrementCallCount atomically adds count calls made outside a reservation.
+// The tracker row is locked so quota_exceeded matches the new count even
+// under concurrent increments.
 func (r *APIQuotaTrackerRepository) IncrementCallCount(ctx context.Context, id string, count int) (*ent.APIQuotaTracker, error) {
-	tracker, err := r.client.APIQuotaTracker.Get(ctx, ulid.ID(id))
-	if err != nil {
-		return nil, err
-	}
-
-	newCount := tracker.CallCount + count
-	quotaExceeded := newCount >= tracker.QuotaLimit
-
-	return r.client.APIQuotaTracker.
-		UpdateOneID(ulid.ID(id)).
-		SetCallCount(newCount).
-		SetQuotaExceeded(quotaExceeded).
-		SetLastCallAt(time.Now()).
-		Save(ctx)
+	return r.withLockedTracker(ctx, id, func(tx *ent.Tx, tracker *ent.APIQuotaTracker) error {
+		return addCalls(ctx, tx, tracker, count, 0)
+	})
 }
 
 // SetOverride sets the quota override flag
@@ -81,6 +85,74 @@ func (r *APIQuotaTrackerRepository) SetNotificationSent(ctx context.Context, id
 		Save(ctx)
 }
 
+// SetQuotaExceeded flags the tracker as exhausted, e.g. when upstream reports
+// the key's plan quota is used up before our own count reaches the limit.
+func (r *APIQuotaTrackerRepository) SetQuotaExceeded(ctx context.Context, id string, exceeded bool) (*ent.APIQuotaTracker, error) {
+	return r.client.APIQuotaTracker.
+		UpdateOneID(ulid.ID(id)).
+		SetQuotaExceeded(exceeded).
+		Save(ctx)
+}
+
+// SetRateLimitedUntil records when a key's 429 cooldown ends
+func (r *APIQuotaTrackerRepository) SetRateLimitedUntil(ctx context.Context, id string, until time.Time) (*ent.APIQuotaTracker, error) {
+	return r.client.APIQuotaTracker.
+		UpdateOneID(ulid.ID(id)).
+		SetRateLimitedUntil(until).
+		Save(ctx)
+}
+
+// ListKeyTrackers retrieves the per-key trackers for a specific month/year
+func (r *APIQuotaTrackerRepository) ListKeyTrackers(ctx context.Context, month, year int) ([]*ent.APIQuotaTracker, error) {
+	return r.client.APIQuotaTracker.
+		Query().
+		Where(
+			apiquotatracker.KeyLabelNEQ(""),
+			apiquotatracker.Budget(""),
+			apiquotatracker.Month(month),
+			apiquotatracker.Year(year),
+		).
+		Order(ent.Asc(apiquotatracker.FieldKeyLabel)).
+		All(ctx)
+}
+
+// GetBudgetTracker retrieves the quota tracker of one budget for a specific month/year
+func (r *APIQuotaTrackerRepository) GetBudgetTracker(ctx context.Context, budget string, month, year int) (*ent.APIQuotaTracker, error) {
+	return r.client.APIQuotaTracker.
+		Query().
+		Where(
+			apiquotatracker.KeyLabel(""),
+			apiquotatracker.Budget(budget),
+			apiquotatracker.Month(month),
+			apiquotatracker.Year(year),
+		).
+		Only(ctx)
+}
+
+// CreateBudgetTracker creates a new quota tracker for one budget
+func (r *APIQuotaTrackerRepository) CreateBudgetTracker(ctx context.Context, budget string, month, year, quotaLimit int) (*ent.APIQuotaTracker, error) {
+	return r.client.APIQuotaTracker.
+		Create().
+		SetBudget(budget).
+		SetMonth(month).
+		SetYear(year).
+		SetQuotaLimit(quotaLimit).
+		Save(ctx)
+}
+
+// ListBudgetTrackers retrieves the per-budget trackers for a specific month/year
+func (r *APIQuotaTrackerRepository) ListBudgetTrackers(ctx context.Context, month, year int) ([]*ent.APIQuotaTracker, error) {
+	return r.client.APIQuotaTracker.
+		Query().
+		Where(
+			apiquotatracker.BudgetNEQ(""),
+			apiquotatracker.Month(month),
+			apiquotatracker.Year(year),
+		).
+		Order(ent.Asc(apiquotatracker.FieldBudget)).
+		All(ctx)
+}
+
 // UpdateQuotaLimit updates the monthly quota limit
 func (r *APIQuotaTrackerRepository) UpdateQuotaLimit(ctx context.Context, id string, newLimit int) (*ent.APIQuotaTracker, error) {
 	return r.client.APIQuotaTracker.
@@ -89,10 +161,11 @@ func (r *APIQuotaTrackerRepository) UpdateQuotaLimit(ctx context.Context, id str
 		Save(ctx)
 }
 
-// ListHistory retrieves quota history for past months
+// ListHistory retrieves pool-wide quota history for past months
 func (r *APIQuotaTrackerRepository) ListHistory(ctx context.Context, limit int) ([]*ent.APIQuotaTracker, error) {
 	return r.client.APIQuotaTracker.
 		Query().
+		Where(apiquotatracker.KeyLabel(""), apiquotatracker.Budget("")).
 		Order(ent.Desc(apiquotatracker.FieldYear), ent.Desc(apiquotatracker.FieldMonth)).
 		Limit(limit).
 		All(ctx)
@@ -0,0 +1,435 @@
+package apiquotatrackerrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/apiquotareservation"
+	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/schema/ulid"
+	"time"
+)
+
+// ErrInsufficientQuota is returned by Reserve when the tracker has no calls
+// left to reserve.
+var ErrInsufficientQuota = errors.New("insufficient API quota remaining")
+
+// Reserve reserves up to count calls against the tracker, and against the
+// budget tracker when budgetTrackerID is set, and records the grant in the
+// reservation ledger. The grant also stays within caps over the rolling
+// windows. The tracker rows are locked with SELECT ... FOR UPDATE for the
+// whole transaction, so concurrent reservations are serialized and together
+// never exceed either tracker's quota_limit - call_count or the caps. Open
+// reservations past their expiry are released first. Admin override on the
+// pool-wide tracker grants the full count.
+func (r *APIQuotaTrackerRepository) Reserve(
+	ctx context.Context,
+	trackerID string,
+	budgetTrackerID string,
+	count int,
+	ttl time.Duration,
+	caps WindowCaps,
+) (*ent.APIQuotaReservation, error) {
+	var reservation *ent.APIQuotaReservation
+	capped := false
+	_, err := r.withLockedTrackers(ctx, trackerID, budgetTrackerID, func(tx *ent.Tx, tracker, budget *ent.APIQuotaTracker) error {
+		now := time.Now()
+		if err := releaseExpired(ctx, tx, tracker, now); err != nil {
+			return err
+		}
+
+		// Reload the counts the expired releases changed
+		tracker, budget, err := reloadTrackers(ctx, tx, tracker, budget)
+		if err != nil {
+			return err
+		}
+
+		granted := grantable(tracker, count)
+		if !tracker.OverrideEnabled {
+			if budget != nil {
+				granted = min(granted, grantable(budget, count))
+			}
+			room, err := capRoom(ctx, tx, caps, now)
+			if err != nil {
+				return err
+			}
+			if room < granted {
+				granted = room
+				capped = true
+			}
+		}
+		if granted <= 0 {
+			// Keep the expired releases; there is just nothing to reserve
+			return nil
+		}
+
+		create := tx.APIQuotaReservation.
+			Create().
+			SetTrackerID(tracker.ID).
+			SetReserved(granted).
+			SetExpiresAt(now.Add(ttl))
+		for _, t := range []*ent.APIQuotaTracker{tracker, budget} {
+			if t == nil {
+				continue
+			}
+			err := tx.APIQuotaTracker.
+				UpdateOneID(t.ID).
+				AddReservedCount(granted).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to reserve calls: %w", err)
+			}
+		}
+		if budget != nil {
+			create.SetBudgetTrackerID(budget.ID)
+		}
+
+		reservation, err = create.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to record reservation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		if capped {
+			return nil, ErrCapReached
+		}
+		return nil, ErrInsufficientQuota
+	}
+	return reservation.Unwrap(), nil
+}
+
+// CommitReservation counts count calls made under the reservation on its
+// tracker and budget tracker. Calls covered by the reservation move from
+// reserved_count to call_count; calls beyond it, or made after it expired,
+// are counted directly and recorded in the ledger as a settled entry of their
+// own. It returns the pool-wide tracker. Calls beyond the reservation were
+// made without a grant: when they use up what either tracker's quota or caps
+// had left, they are still counted and the tracker is returned with
+// ErrInsufficientQuota or ErrCapReached, so the caller stops making calls.
+func (r *APIQuotaTrackerRepository) CommitReservation(
+	ctx context.Context,
+	id string,
+	count int,
+	caps WindowCaps,
+) (*ent.APIQuotaTracker, error) {
+	trackerID, budgetTrackerID, err := r.reservationTrackerIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var overrun error
+	tracker, err := r.withLockedTrackers(ctx, trackerID, budgetTrackerID, func(tx *ent.Tx, tracker, budget *ent.APIQuotaTracker) error {
+		reservation, err := tx.APIQuotaReservation.Get(ctx, ulid.ID(id))
+		if err != nil {
+			return err
+		}
+
+		fromReserved := 0
+		if reservation.Status == apiquotareservation.StatusOpen {
+			fromReserved = min(count, reservation.Reserved-reservation.Used)
+		}
+		if fromReserved > 0 {
+			err = tx.APIQuotaReservation.
+				UpdateOneID(reservation.ID).
+				AddUsed(fromReserved).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to commit reservation: %w", err)
+			}
+		}
+
+		extra := count - fromReserved
+		if extra > 0 && !tracker.OverrideEnabled {
+			// The reservation's own calls are used up, so whatever room is
+			// left beyond the extra calls is all further calls could get
+			switch {
+			case grantable(tracker, extra+1) <= extra,
+				budget != nil && grantable(budget, extra+1) <= extra:
+				overrun = ErrInsufficientQuota
+			default:
+				// The call log already holds the extra calls
+				room, err := capRoom(ctx, tx, caps, time.Now())
+				if err != nil {
+					return err
+				}
+				if room == 0 {
+					overrun = ErrCapReached
+				}
+			}
+		}
+
+		if budget != nil {
+			if err := addCalls(ctx, tx, budget, count, fromReserved); err != nil {
+				return err
+			}
+		}
+		if err := addCalls(ctx, tx, tracker, count, fromReserved); err != nil {
+			return err
+		}
+		return recordSettled(ctx, tx, tracker, budget, extra)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tracker, overrun
+}
+
+// ReleaseReservation settles the reservation and returns its unused calls to
+// its trackers. Settling an already settled reservation is a no-op.
+func (r *APIQuotaTrackerRepository) ReleaseReservation(
+	ctx context.Context,
+	id string,
+) (*ent.APIQuotaReservation, error) {
+	trackerID, budgetTrackerID, err := r.reservationTrackerIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var reservation *ent.APIQuotaReservation
+	_, err = r.withLockedTrackers(ctx, trackerID, budgetTrackerID, func(tx *ent.Tx, tracker, budget *ent.APIQuotaTracker) error {
+		reservation, err = tx.APIQuotaReservation.Get(ctx, ulid.ID(id))
+		if err != nil {
+			return err
+		}
+		if reservation.Status != apiquotareservation.StatusOpen {
+			return nil
+		}
+
+		status := apiquotareservation.StatusReleased
+		if reservation.Used > 0 {
+			status = apiquotareservation.StatusCommitted
+		}
+		reservation, err = tx.APIQuotaReservation.
+			UpdateOneID(reservation.ID).
+			SetStatus(status).
+			SetSettledAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to settle reservation: %w", err)
+		}
+
+		unused := reservation.Reserved - reservation.Used
+		for _, t := range []*ent.APIQuotaTracker{tracker, budget} {
+			if t == nil || unused <= 0 {
+				continue
+			}
+			err = tx.APIQuotaTracker.
+				UpdateOneID(t.ID).
+				AddReservedCount(-unused).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to release reserved calls: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservation.Unwrap(), nil
+}
+
+// withLockedTracker runs fn in a transaction holding the tracker's row lock
+// and returns the tracker as fn left it.
+func (r *APIQuotaTrackerRepository) withLockedTracker(
+	ctx context.Context,
+	id string,
+	fn func(tx *ent.Tx, tracker *ent.APIQuotaTracker) error,
+) (*ent.APIQuotaTracker, error) {
+	return r.withLockedTrackers(ctx, id, "", func(tx *ent.Tx, tracker, _ *ent.APIQuotaTracker) error {
+		return fn(tx, tracker)
+	})
+}
+
+// withLockedTrackers runs fn in a transaction holding the row locks of the
+// tracker and, when budgetID is set, the budget tracker (nil otherwise). It
+// returns the tracker as fn left it. Every change to call_count,
+// reserved_count or the reservation ledger goes through here, and the
+// pool-wide tracker is always locked first, so changes are serialized per
+// tracker without deadlocks.
+func (r *APIQuotaTrackerRepository) withLockedTrackers(
+	ctx context.Context,
+	id string,
+	budgetID string,
+	fn func(tx *ent.Tx, tracker, budget *ent.APIQuotaTracker) error,
+) (*ent.APIQuotaTracker, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start quota transaction: %w", err)
+	}
+
+	tracker, err := lockTracker(ctx, tx, id)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	var budget *ent.APIQuotaTracker
+	if budgetID != "" {
+		if budget, err = lockTracker(ctx, tx, budgetID); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err := fn(tx, tracker, budget); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	tracker, err = tx.APIQuotaTracker.Get(ctx, tracker.ID)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit quota transaction: %w", err)
+	}
+	return tracker.Unwrap(), nil
+}
+
+func lockTracker(ctx context.Context, tx *ent.Tx, id string) (*ent.APIQuotaTracker, error) {
+	return tx.APIQuotaTracker.
+		Query().
+		Where(apiquotatracker.ID(ulid.ID(id))).
+		ForUpdate().
+		Only(ctx)
+}
+
+// reloadTrackers re-reads the locked trackers inside the transaction
+func reloadTrackers(
+	ctx context.Context,
+	tx *ent.Tx,
+	tracker, budget *ent.APIQuotaTracker,
+) (*ent.APIQuotaTracker, *ent.APIQuotaTracker, error) {
+	tracker, err := tx.APIQuotaTracker.Get(ctx, tracker.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if budget != nil {
+		if budget, err = tx.APIQuotaTracker.Get(ctx, budget.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+	return tracker, budget, nil
+}
+
+// reservationTrackerIDs returns the IDs of the reservation's tracker and
+// budget tracker (empty without a budget).
+func (r *APIQuotaTrackerRepository) reservationTrackerIDs(ctx context.Context, id string) (string, string, error) {
+	reservation, err := r.client.APIQuotaReservation.
+		Query().
+		Where(apiquotareservation.ID(ulid.ID(id))).
+		WithTracker().
+		WithBudgetTracker().
+		Only(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get trackers of reservation %s: %w", id, err)
+	}
+	budgetTrackerID := ""
+	if reservation.Edges.BudgetTracker != nil {
+		budgetTrackerID = string(reservation.Edges.BudgetTracker.ID)
+	}
+	return string(reservation.Edges.Tracker.ID), budgetTrackerID, nil
+}
+
+// releaseExpired marks the tracker's open reservations past their expiry as
+// EXPIRED and returns their unused calls to the tracker and their budget
+// trackers. Budget trackers are only written while their pool-wide tracker is
+// locked, which the caller holds.
+func releaseExpired(
+	ctx context.Context,
+	tx *ent.Tx,
+	tracker *ent.APIQuotaTracker,
+	now time.Time,
+) error {
+	expired, err := tx.APIQuotaReservation.
+		Query().
+		Where(
+			apiquotareservation.HasTrackerWith(apiquotatracker.ID(tracker.ID)),
+			apiquotareservation.StatusEQ(apiquotareservation.StatusOpen),
+			apiquotareservation.ExpiresAtLT(now),
+		).
+		WithBudgetTracker().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find expired reservations: %w", err)
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	released := map[ulid.ID]int{}
+	ids := make([]ulid.ID, len(expired))
+	for i, reservation := range expired {
+		unused := reservation.Reserved - reservation.Used
+		released[tracker.ID] += unused
+		if budget := reservation.Edges.BudgetTracker; budget != nil {
+			released[budget.ID] += unused
+		}
+		ids[i] = reservation.ID
+	}
+
+	err = tx.APIQuotaReservation.
+		Update().
+		Where(apiquotareservation.IDIn(ids...)).
+		SetStatus(apiquotareservation.StatusExpired).
+		SetSettledAt(now).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to expire reservations: %w", err)
+	}
+
+	for id, calls := range released {
+		if calls <= 0 {
+			continue
+		}
+		err = tx.APIQuotaTracker.
+			UpdateOneID(id).
+			AddReservedCount(-calls).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to release expired reservations: %w", err)
+		}
+	}
+	return nil
+}
+
+// addCalls counts count calls against the locked tracker, fromReserved of
+// which were already reserved.
+func addCalls(
+	ctx context.Context,
+	tx *ent.Tx,
+	tracker *ent.APIQuotaTracker,
+	count int,
+	fromReserved int,
+) error {
+	update := tx.APIQuotaTracker.
+		UpdateOneID(tracker.ID).
+		AddCallCount(count).
+		SetQuotaExceeded(tracker.CallCount+count >= tracker.QuotaLimit).
+		SetLastCallAt(time.Now())
+	if fromReserved > 0 {
+		update.AddReservedCount(-fromReserved)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to increment call count: %w", err)
+	}
+	return nil
+}
+
+// grantable returns how many of count calls can still be reserved on
+// tracker next to its open reservations.
+func grantable(tracker *ent.APIQuotaTracker, count int) int {
+	if tracker.OverrideEnabled {
+		return count
+	}
+	return max(0, min(count, tracker.QuotaLimit-tracker.CallCount-tracker.ReservedCount))
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return err
+}
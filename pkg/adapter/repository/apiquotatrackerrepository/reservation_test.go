@@ -0,0 +1,34 @@
+package apiquotatrackerrepository
+
+import (
+	"sheng-go-backend/ent"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantable(t *testing.T) {
+	cases := []struct {
+		name     string
+		reserved int
+		count    int
+		want     int
+	}{
+		{name: "Should grant the full count when quota is left", reserved: 0, count: 25, want: 25},
+		{name: "Should grant only what open reservations leave", reserved: 30, count: 25, want: 10},
+		{name: "Should grant nothing when everything is reserved", reserved: 40, count: 25, want: 0},
+		{name: "Should never grant a negative count", reserved: 50, count: 25, want: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tracker := &ent.APIQuotaTracker{QuotaLimit: 100, CallCount: 60, ReservedCount: tc.reserved}
+			assert.Equal(t, tc.want, grantable(tracker, tc.count))
+		})
+	}
+
+	t.Run("Should grant the full count with admin override", func(t *testing.T) {
+		overridden := &ent.APIQuotaTracker{QuotaLimit: 100, CallCount: 120, ReservedCount: 40, OverrideEnabled: true}
+		assert.Equal(t, 25, grantable(overridden, 25))
+	})
+}
@@ -0,0 +1,147 @@
+package companyrepository
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/company"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/pkg/entity/model"
+	ur "sheng-go-backend/pkg/usecase/repository"
+	"sort"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type companyRepository struct {
+	client *ent.Client
+}
+
+func NewCompanyRepository(client *ent.Client) ur.Company {
+	return &companyRepository{client}
+}
+
+func (r *companyRepository) Get(ctx context.Context, id model.ID) (*model.Company, error) {
+	c, err := r.client.Company.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, model.NewDBError(err)
+	}
+	return c, nil
+}
+
+func (r *companyRepository) List(
+	ctx context.Context,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *model.CompanyWhereInput,
+) (*model.CompanyConnection, error) {
+	cc, err := r.client.Company.Query().
+		Paginate(ctx, after, first, before, last, ent.WithCompanyFilter(where.Filter))
+	if err != nil {
+		return nil, model.NewDBError(err)
+	}
+	return cc, nil
+}
+
+// ListCurrentEmployees returns the profiles with a current position at the company.
+func (r *companyRepository) ListCurrentEmployees(
+	ctx context.Context,
+	companyID model.ID,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *model.ProfileWhereInput,
+) (*model.ProfileConnection, error) {
+	pc, err := r.client.Profile.Query().
+		Where(profile.HasCurrentCompaniesWith(company.ID(companyID))).
+		Paginate(ctx, after, first, before, last, ent.WithProfileFilter(where.Filter))
+	if err != nil {
+		return nil, model.NewDBError(err)
+	}
+	return pc, nil
+}
+
+// ListAlumni returns the profiles that used to work at the company and no
+// longer do.
+func (r *companyRepository) ListAlumni(
+	ctx context.Context,
+	companyID model.ID,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *model.ProfileWhereInput,
+) (*model.ProfileConnection, error) {
+	pc, err := r.client.Profile.Query().
+		Where(profile.HasPastCompaniesWith(company.ID(companyID))).
+		Paginate(ctx, after, first, before, last, ent.WithProfileFilter(where.Filter))
+	if err != nil {
+		return nil, model.NewDBError(err)
+	}
+	return pc, nil
+}
+
+// HeadcountByTitle counts the people holding each title at the company,
+// ignoring case, for current employees or, with alumni set, for alumni. A
+// person with several positions under one title is counted once, and each
+// title is spelled as its lowest spelling in byte order. Groups below
+// minCount are dropped; the result is sorted by count descending.
+func (r *companyRepository) HeadcountByTitle(
+	ctx context.Context,
+	companyID model.ID,
+	alumni bool,
+	minCount int,
+) ([]*model.ProfileTitleGroup, error) {
+	query := r.client.ProfilePosition.
+		Query().
+		Where(profileposition.HasCompanyWith(company.ID(companyID)))
+	if alumni {
+		query = query.Where(
+			profileposition.IsCurrent(false),
+			profileposition.HasProfileWith(profile.HasPastCompaniesWith(company.ID(companyID))),
+		)
+	} else {
+		query = query.Where(profileposition.IsCurrent(true))
+	}
+
+	// GroupBy only takes columns; group on the trimmed title ignoring case
+	// instead
+	title := func(s *sql.Selector) string {
+		s.GroupBy(fmt.Sprintf("LOWER(TRIM(%s))", s.C(profileposition.FieldTitle)))
+		return sql.As(fmt.Sprintf("MIN(TRIM(%s))", s.C(profileposition.FieldTitle)), "title")
+	}
+	headcount := func(s *sql.Selector) string {
+		return sql.As(fmt.Sprintf("COUNT(DISTINCT %s)", s.C(profileposition.ProfileColumn)), "count")
+	}
+
+	var results []struct {
+		Title string `json:"title"`
+		Count int    `json:"count"`
+	}
+	if err := query.Aggregate(title, headcount).Scan(ctx, &results); err != nil {
+		return nil, model.NewDBError(err)
+	}
+
+	groups := []*model.ProfileTitleGroup{}
+	for _, result := range results {
+		if result.Count >= minCount {
+			groups = append(groups, &model.ProfileTitleGroup{Title: result.Title, Count: result.Count})
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].Title < groups[j].Title
+	})
+
+	return groups, nil
+}
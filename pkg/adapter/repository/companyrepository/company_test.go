@@ -0,0 +1,56 @@
+package companyrepository
+
+import (
+	"context"
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/testutil"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompanyRepository_HeadcountByTitle(t *testing.T) {
+	client := testutil.NewSqlListeDBClient(t)
+	defer client.Close()
+	ctx := context.Background()
+
+	acme := client.Company.Create().
+		SetKey("acme").
+		SetName("Acme").
+		SetNormalizedName("acme").
+		SaveX(ctx)
+
+	// addPositions gives a new profile a current position at acme per title
+	addPositions := func(urn string, titles ...string) {
+		p := client.Profile.Create().SetUrn(urn).SaveX(ctx)
+		for i, title := range titles {
+			client.ProfilePosition.Create().
+				SetProfile(p).
+				SetCompany(acme).
+				SetPositionIndex(i).
+				SetTitle(title).
+				SetIsCurrent(true).
+				ExecX(ctx)
+		}
+	}
+	// Jane was promoted in place and holds the title twice
+	addPositions("jane", "Engineer", "engineer ")
+	addPositions("john", "Engineer", "Manager")
+
+	t.Run("Should count a person once per title", func(t *testing.T) {
+		groups, err := NewCompanyRepository(client).HeadcountByTitle(ctx, acme.ID, false, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ProfileTitleGroup{
+			{Title: "Engineer", Count: 2},
+			{Title: "Manager", Count: 1},
+		}, groups)
+	})
+
+	t.Run("Should drop titles below the minimum count", func(t *testing.T) {
+		groups, err := NewCompanyRepository(client).HeadcountByTitle(ctx, acme.ID, false, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ProfileTitleGroup{{Title: "Engineer", Count: 2}}, groups)
+	})
+}
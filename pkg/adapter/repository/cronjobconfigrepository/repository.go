@@ -48,6 +48,7 @@ func (r *CronJobConfigRepository) Create(ctx context.Context, input *ent.CronJob
 		SetSchedule(input.Schedule).
 		SetEnabled(input.Enabled).
 		SetBatchSize(input.BatchSize).
+		SetConcurrency(input.Concurrency).
 		SetAdminEmail(input.AdminEmail).
 		SetRespectQuota(input.RespectQuota).
 		Save(ctx)
@@ -66,6 +67,9 @@ func (r *CronJobConfigRepository) Update(ctx context.Context, id string, updates
 	if batchSize, ok := updates["batch_size"].(int); ok {
 		updateQuery = updateQuery.SetBatchSize(batchSize)
 	}
+	if concurrency, ok := updates["concurrency"].(int); ok {
+		updateQuery = updateQuery.SetConcurrency(concurrency)
+	}
 	if adminEmail, ok := updates["admin_email"].(string); ok {
 		updateQuery = updateQuery.SetAdminEmail(adminEmail)
 	}
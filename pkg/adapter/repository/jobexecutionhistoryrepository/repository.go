@@ -33,7 +33,8 @@ func (r *JobExecutionHistoryRepository) Create(
 		SetFailedCount(input.FailedCount).
 		SetAPICallsMade(input.APICallsMade).
 		SetQuotaRemaining(input.QuotaRemaining).
-		SetDurationSeconds(input.DurationSeconds)
+		SetDurationSeconds(input.DurationSeconds).
+		SetSchemaDriftDetected(input.SchemaDriftDetected)
 
 	if input.CompletedAt != nil {
 		builder = builder.SetCompletedAt(*input.CompletedAt)
@@ -41,6 +42,9 @@ func (r *JobExecutionHistoryRepository) Create(
 	if input.ErrorSummary != nil {
 		builder = builder.SetErrorSummary(*input.ErrorSummary)
 	}
+	if input.SchemaDrift != nil {
+		builder = builder.SetSchemaDrift(input.SchemaDrift)
+	}
 	if len(profileEntryIDs) > 0 {
 		builder = builder.AddProfileEntryIDs(profileEntryIDs...)
 	}
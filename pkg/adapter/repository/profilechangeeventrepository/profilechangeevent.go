@@ -0,0 +1,148 @@
+package profilechangeeventrepository
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/pkg/entity/model"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type ProfileChangeEventRepository struct {
+	client *ent.Client
+}
+
+func NewProfileChangeEventRepository(client *ent.Client) *ProfileChangeEventRepository {
+	return &ProfileChangeEventRepository{client: client}
+}
+
+// ClaimDue claims up to limit PENDING events whose next delivery time has
+// passed, oldest first, and pushes their next_delivery_at out by lease so a
+// concurrent run does not deliver them twice. Rows locked by another run are
+// skipped. The profile edge is loaded for the webhook payload.
+func (r *ProfileChangeEventRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]*ent.ProfileChangeEvent, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start claim transaction: %w", err)
+	}
+
+	events, err := tx.ProfileChangeEvent.
+		Query().
+		Where(
+			profilechangeevent.DeliveryStatusEQ(profilechangeevent.DeliveryStatusPending),
+			profilechangeevent.Or(
+				profilechangeevent.NextDeliveryAtIsNil(),
+				profilechangeevent.NextDeliveryAtLTE(now),
+			),
+		).
+		Order(ent.Asc(profilechangeevent.FieldDetectedAt)).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if len(events) == 0 {
+		return nil, tx.Commit()
+	}
+
+	ids := make([]model.ID, len(events))
+	for i, e := range events {
+		ids[i] = e.ID
+	}
+
+	if err := tx.ProfileChangeEvent.
+		Update().
+		Where(profilechangeevent.IDIn(ids...)).
+		SetNextDeliveryAt(now.Add(lease)).
+		Exec(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit claim transaction: %w", err)
+	}
+
+	return r.client.ProfileChangeEvent.
+		Query().
+		Where(profilechangeevent.IDIn(ids...)).
+		WithProfile().
+		Order(ent.Asc(profilechangeevent.FieldDetectedAt)).
+		All(ctx)
+}
+
+// MarkDelivered records a successful webhook delivery.
+func (r *ProfileChangeEventRepository) MarkDelivered(
+	ctx context.Context,
+	id model.ID,
+	attempts int,
+) error {
+	return r.client.ProfileChangeEvent.
+		UpdateOneID(id).
+		SetDeliveryStatus(profilechangeevent.DeliveryStatusDelivered).
+		SetDeliveryAttempts(attempts).
+		SetDeliveredAt(time.Now()).
+		ClearNextDeliveryAt().
+		ClearLastDeliveryError().
+		Exec(ctx)
+}
+
+// MarkAttemptFailed records a failed webhook delivery. The event stays PENDING
+// until nextDeliveryAt; a nil nextDeliveryAt means retries are exhausted and
+// the event is marked FAILED.
+func (r *ProfileChangeEventRepository) MarkAttemptFailed(
+	ctx context.Context,
+	id model.ID,
+	attempts int,
+	errorMsg string,
+	nextDeliveryAt *time.Time,
+) error {
+	update := r.client.ProfileChangeEvent.
+		UpdateOneID(id).
+		SetDeliveryAttempts(attempts).
+		SetLastDeliveryError(errorMsg)
+
+	if nextDeliveryAt == nil {
+		update = update.
+			SetDeliveryStatus(profilechangeevent.DeliveryStatusFailed).
+			ClearNextDeliveryAt()
+	} else {
+		update = update.SetNextDeliveryAt(*nextDeliveryAt)
+	}
+
+	return update.Exec(ctx)
+}
+
+// List returns a connection of change events with optional filters.
+func (r *ProfileChangeEventRepository) List(
+	ctx context.Context,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *ent.ProfileChangeEventWhereInput,
+) (*ent.ProfileChangeEventConnection, error) {
+	conn, err := r.client.ProfileChangeEvent.Query().
+		Paginate(ctx, after, first, before, last, ent.WithProfileChangeEventFilter(where.Filter))
+	if err != nil {
+		return nil, model.NewDBError(err)
+	}
+
+	return conn, nil
+}
+
+// rollback aborts tx and folds any rollback failure into err.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return err
+}
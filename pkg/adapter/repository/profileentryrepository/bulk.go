@@ -0,0 +1,264 @@
+package profileentryrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/schema/ulid"
+	"sheng-go-backend/pkg/entity/model"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// requeueBatchSize is the number of rows updated per transaction by Requeue.
+	requeueBatchSize = 500
+	// requeuePreviewSize is the number of matching entries returned on a dry run.
+	requeuePreviewSize = 20
+)
+
+// terminalStatuses are never fetched again by the scheduler, so Requeue only
+// moves them back to PENDING when the filter names them.
+var terminalStatuses = []profileentry.Status{
+	profileentry.StatusCOMPLETED,
+	profileentry.StatusNotFound,
+}
+
+// Requeue moves every entry matching input back to PENDING and resets its
+// retry state, error message and lease. Entries currently held under an active
+// fetch lease are never touched. Updates run in batches of requeueBatchSize
+// rows, each in its own transaction. On a dry run nothing is updated; the
+// result carries the match count and a preview of the first matching entries.
+func (r *profileentryRepository) Requeue(
+	ctx context.Context,
+	input model.RequeueProfileEntriesInput,
+) (*model.BulkProfileEntryResult, error) {
+	preds, err := requeuePredicates(input, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if input.DryRun {
+		count, err := r.client.ProfileEntry.Query().Where(preds...).Count(ctx)
+		if err != nil {
+			return nil, model.NewDBError(err)
+		}
+		preview, err := r.client.ProfileEntry.
+			Query().
+			Where(preds...).
+			Order(ent.Asc(profileentry.FieldCreatedAt)).
+			Limit(requeuePreviewSize).
+			All(ctx)
+		if err != nil {
+			return nil, model.NewDBError(err)
+		}
+		return &model.BulkProfileEntryResult{
+			AffectedCount: count,
+			DryRun:        true,
+			Preview:       preview,
+		}, nil
+	}
+
+	affected := 0
+	var lastID ulid.ID
+	for {
+		ids, err := r.requeueBatch(ctx, preds, lastID)
+		if err != nil {
+			return nil, model.NewDBError(err)
+		}
+		affected += len(ids)
+		if len(ids) < requeueBatchSize {
+			break
+		}
+		lastID = ids[len(ids)-1]
+	}
+
+	return &model.BulkProfileEntryResult{
+		AffectedCount: affected,
+		Preview:       []*model.ProfileEntry{},
+	}, nil
+}
+
+// requeueBatch requeues the next batch of matching entries with an ID greater
+// than after and returns their IDs. Walking the IDs in order keeps the loop
+// finite even when the filter also matches PENDING entries.
+func (r *profileentryRepository) requeueBatch(
+	ctx context.Context,
+	preds []predicate.ProfileEntry,
+	after ulid.ID,
+) ([]ulid.ID, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start requeue transaction: %w", err)
+	}
+
+	query := tx.ProfileEntry.Query().Where(preds...)
+	if after != "" {
+		query = query.Where(profileentry.IDGT(after))
+	}
+	ids, err := query.
+		Order(ent.Asc(profileentry.FieldID)).
+		Limit(requeueBatchSize).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		IDs(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to lock entries to requeue: %w", err))
+	}
+	if len(ids) == 0 {
+		return ids, tx.Commit()
+	}
+
+	err = tx.ProfileEntry.
+		Update().
+		Where(profileentry.IDIn(ids...)).
+		SetStatus(profileentry.StatusPending).
+		SetAttemptCount(0).
+		ClearNextRetryAt().
+		ClearErrorMessage().
+		ClearLeaseOwner().
+		ClearLeaseExpiresAt().
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to requeue entries: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit requeue transaction: %w", err)
+	}
+	return ids, nil
+}
+
+// requeuePredicates translates input into query predicates, excluding entries
+// that are being fetched under an unexpired lease and terminal entries the
+// filter does not name. An empty filter is rejected unless input.All is set.
+func requeuePredicates(
+	input model.RequeueProfileEntriesInput,
+	now time.Time,
+) ([]predicate.ProfileEntry, error) {
+	preds := []predicate.ProfileEntry{
+		profileentry.Not(profileentry.And(
+			profileentry.StatusEQ(profileentry.StatusFetching),
+			profileentry.LeaseExpiresAtGTE(now),
+		)),
+	}
+
+	filtered := false
+	if input.Where != nil {
+		p, err := input.Where.P()
+		switch {
+		case errors.Is(err, ent.ErrEmptyProfileEntryWhereInput):
+		case err != nil:
+			return nil, model.NewInvalidParamError(err.Error())
+		default:
+			preds = append(preds, p)
+			filtered = true
+		}
+	}
+
+	if input.OlderThanDays != nil {
+		if *input.OlderThanDays < 0 {
+			return nil, model.NewInvalidParamError("olderThanDays must not be negative")
+		}
+		preds = append(
+			preds,
+			profileentry.LastFetchedAtLT(now.AddDate(0, 0, -*input.OlderThanDays)),
+		)
+		filtered = true
+	}
+
+	if !filtered && !input.All {
+		return nil, model.NewInvalidParamError("an empty filter matches every entry; set all to requeue them")
+	}
+
+	var excluded []profileentry.Status
+	for _, status := range terminalStatuses {
+		if !namesStatus(input.Where, status) {
+			excluded = append(excluded, status)
+		}
+	}
+	if len(excluded) > 0 {
+		preds = append(preds, profileentry.StatusNotIn(excluded...))
+	}
+
+	return preds, nil
+}
+
+// namesStatus reports whether where, or any filter it combines with and/or,
+// selects status by status or statusIn.
+func namesStatus(where *model.ProfileEntryWhereInput, status profileentry.Status) bool {
+	if where == nil {
+		return false
+	}
+	if where.Status != nil && *where.Status == status {
+		return true
+	}
+	for _, s := range where.StatusIn {
+		if s == status {
+			return true
+		}
+	}
+	for _, w := range where.And {
+		if namesStatus(w, status) {
+			return true
+		}
+	}
+	for _, w := range where.Or {
+		if namesStatus(w, status) {
+			return true
+		}
+	}
+	return false
+}
+
+// RequeueStaleCompleted moves up to limit COMPLETED entries last fetched
+// before fetchedBefore back to PENDING, least recently fetched first, and
+// returns their IDs.
+func (r *profileentryRepository) RequeueStaleCompleted(
+	ctx context.Context,
+	fetchedBefore time.Time,
+	limit int,
+) ([]ulid.ID, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start refresh transaction: %w", err)
+	}
+
+	ids, err := tx.ProfileEntry.
+		Query().
+		Where(
+			profileentry.StatusEQ(profileentry.StatusCOMPLETED),
+			profileentry.LastFetchedAtLT(fetchedBefore),
+		).
+		Order(ent.Asc(profileentry.FieldLastFetchedAt)).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		IDs(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to lock stale completed entries: %w", err))
+	}
+	if len(ids) == 0 {
+		return ids, tx.Commit()
+	}
+
+	err = tx.ProfileEntry.
+		Update().
+		Where(profileentry.IDIn(ids...)).
+		SetStatus(profileentry.StatusPending).
+		SetAttemptCount(0).
+		ClearNextRetryAt().
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to requeue stale completed entries: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit refresh transaction: %w", err)
+	}
+	return ids, nil
+}
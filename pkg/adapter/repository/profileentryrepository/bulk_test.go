@@ -0,0 +1,69 @@
+package profileentryrepository
+
+import (
+	"context"
+	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/testutil"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileEntryRepository_Requeue(t *testing.T) {
+	client := testutil.NewSqlListeDBClient(t)
+	defer client.Close()
+	ctx := context.Background()
+
+	for urn, status := range map[string]profileentry.Status{
+		"pending":   profileentry.StatusPending,
+		"failed":    profileentry.StatusFAILED,
+		"completed": profileentry.StatusCOMPLETED,
+		"not-found": profileentry.StatusNotFound,
+	} {
+		client.ProfileEntry.Create().SetLinkedinUrn(urn).SetStatus(status).ExecX(ctx)
+	}
+	repo := NewprofileentryRepository(client)
+
+	// dryRun counts the matches without requeueing them
+	dryRun := func(t *testing.T, input model.RequeueProfileEntriesInput) int {
+		t.Helper()
+		input.DryRun = true
+		result, err := repo.Requeue(ctx, input)
+		require.NoError(t, err)
+		return result.AffectedCount
+	}
+
+	t.Run("Should reject an empty filter", func(t *testing.T) {
+		_, err := repo.Requeue(ctx, model.RequeueProfileEntriesInput{
+			Where:  &model.ProfileEntryWhereInput{},
+			DryRun: true,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Should skip terminal entries when all is set", func(t *testing.T) {
+		assert.Equal(t, 2, dryRun(t, model.RequeueProfileEntriesInput{All: true}))
+	})
+
+	t.Run("Should skip terminal entries the filter does not name", func(t *testing.T) {
+		pending := profileentry.StatusPending
+		assert.Equal(t, 1, dryRun(t, model.RequeueProfileEntriesInput{
+			Where: &model.ProfileEntryWhereInput{StatusNEQ: &pending},
+		}))
+	})
+
+	t.Run("Should requeue terminal entries the filter names", func(t *testing.T) {
+		completed := profileentry.StatusCOMPLETED
+		assert.Equal(t, 1, dryRun(t, model.RequeueProfileEntriesInput{
+			Where: &model.ProfileEntryWhereInput{Status: &completed},
+		}))
+		assert.Equal(t, 2, dryRun(t, model.RequeueProfileEntriesInput{
+			Where: &model.ProfileEntryWhereInput{
+				StatusIn: []profileentry.Status{profileentry.StatusFAILED, profileentry.StatusNotFound},
+			},
+		}))
+	})
+}
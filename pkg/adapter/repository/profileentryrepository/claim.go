@@ -0,0 +1,239 @@
+package profileentryrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/predicate"
+	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/schema/ulid"
+	"sheng-go-backend/pkg/entity/model"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ErrLeaseLost is returned by writes made on behalf of a lease owner that no
+// longer holds the entry's fetch lease, because it expired and the entry was
+// reset or claimed again.
+var ErrLeaseLost = errors.New("profile entry fetch lease is no longer held by this worker")
+
+// claimOrder is the order due entries are claimed in
+var claimOrder = []profileentry.OrderOption{
+	profileentry.ByLastFetchedAt(sql.OrderNullsFirst()),
+	profileentry.ByCreatedAt(),
+}
+
+// ClaimPendingBatch atomically claims up to limit entries due for fetching
+// (PENDING, or FAILED with a due retry) for owner.
+// Candidate rows are locked with SELECT ... FOR UPDATE SKIP LOCKED, so runs
+// claiming at the same time never receive the same entry. Claimed entries are
+// moved to FETCHING with a lease that expires after leaseDuration. Entries
+// never fetched come first, oldest first, then re-queued ones least recently
+// fetched first, so refreshes never starve new work.
+func (r *profileentryRepository) ClaimPendingBatch(
+	ctx context.Context,
+	owner string,
+	limit int,
+	leaseDuration time.Duration,
+) ([]*ent.ProfileEntry, error) {
+	now := time.Now()
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start claim transaction: %w", err)
+	}
+
+	ids, err := tx.ProfileEntry.
+		Query().
+		Where(duePredicate(now)).
+		Order(claimOrder...).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		IDs(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to lock pending entries: %w", err))
+	}
+	if len(ids) == 0 {
+		return []*ent.ProfileEntry{}, tx.Commit()
+	}
+
+	err = tx.ProfileEntry.
+		Update().
+		Where(profileentry.IDIn(ids...)).
+		SetStatus(profileentry.StatusFetching).
+		SetLeaseOwner(owner).
+		SetLeaseExpiresAt(now.Add(leaseDuration)).
+		SetUpdatedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to lease pending entries: %w", err))
+	}
+
+	entries, err := tx.ProfileEntry.
+		Query().
+		Where(profileentry.IDIn(ids...)).
+		Order(claimOrder...).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to load claimed entries: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit claim transaction: %w", err)
+	}
+	return entries, nil
+}
+
+// ClaimByID claims a single entry for owner, unless another worker already
+// holds an unexpired lease on it.
+func (r *profileentryRepository) ClaimByID(
+	ctx context.Context,
+	id string,
+	owner string,
+	leaseDuration time.Duration,
+) (*ent.ProfileEntry, error) {
+	now := time.Now()
+	claimed, err := r.client.ProfileEntry.
+		Update().
+		Where(
+			profileentry.ID(ulid.ID(id)),
+			profileentry.Or(
+				profileentry.StatusNEQ(profileentry.StatusFetching),
+				profileentry.LeaseExpiresAtIsNil(),
+				profileentry.LeaseExpiresAtLT(now),
+			),
+		).
+		SetStatus(profileentry.StatusFetching).
+		SetLeaseOwner(owner).
+		SetLeaseExpiresAt(now.Add(leaseDuration)).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, model.NewDBError(err)
+	}
+	if claimed == 0 {
+		return nil, model.NewValidationError(
+			fmt.Errorf("profile entry %s is already being fetched by another worker", id),
+		)
+	}
+
+	return r.client.ProfileEntry.Get(ctx, ulid.ID(id))
+}
+
+// RenewLease restarts the fetch lease owner holds on entry id so it expires
+// leaseDuration from now. It returns ErrLeaseLost when owner no longer holds
+// the lease.
+func (r *profileentryRepository) RenewLease(
+	ctx context.Context,
+	id string,
+	owner string,
+	leaseDuration time.Duration,
+) error {
+	now := time.Now()
+	_, err := leased(r.client.ProfileEntry.
+		UpdateOneID(ulid.ID(id)).
+		Where(
+			profileentry.StatusEQ(profileentry.StatusFetching),
+			profileentry.LeaseOwner(owner),
+		).
+		SetLeaseExpiresAt(now.Add(leaseDuration)).
+		SetUpdatedAt(now).
+		Save(ctx))
+	return err
+}
+
+// ResetStaleFetching moves up to limit stuck FETCHING entries to status and
+// releases their leases. An entry is stuck when its lease has expired, or when
+// it has no lease and was last updated before staleBefore. It returns the IDs
+// of the entries that were reset.
+func (r *profileentryRepository) ResetStaleFetching(
+	ctx context.Context,
+	staleBefore time.Time,
+	status profileentry.Status,
+	errorMsg *string,
+	limit int,
+) ([]ulid.ID, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start reset transaction: %w", err)
+	}
+
+	ids, err := tx.ProfileEntry.
+		Query().
+		Where(stalePredicate(time.Now(), staleBefore)).
+		Order(ent.Asc(profileentry.FieldUpdatedAt)).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		IDs(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to lock stale entries: %w", err))
+	}
+	if len(ids) == 0 {
+		return []ulid.ID{}, tx.Commit()
+	}
+
+	updateBuilder := tx.ProfileEntry.
+		Update().
+		Where(profileentry.IDIn(ids...)).
+		SetStatus(status).
+		ClearNextRetryAt().
+		ClearLeaseOwner().
+		ClearLeaseExpiresAt().
+		SetUpdatedAt(time.Now())
+	if errorMsg != nil {
+		updateBuilder = updateBuilder.SetErrorMessage(*errorMsg)
+	}
+	if err := updateBuilder.Exec(ctx); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to reset stale entries: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit reset transaction: %w", err)
+	}
+	return ids, nil
+}
+
+// duePredicate matches entries due for fetching at now: PENDING entries and
+// FAILED entries whose scheduled retry is due.
+func duePredicate(now time.Time) predicate.ProfileEntry {
+	return profileentry.Or(
+		profileentry.StatusEQ(profileentry.StatusPending),
+		profileentry.And(
+			profileentry.StatusEQ(profileentry.StatusFAILED),
+			profileentry.NextRetryAtLTE(now),
+		),
+	)
+}
+
+// stalePredicate matches FETCHING entries whose lease expired before now, or
+// that carry no lease and were last updated before staleBefore.
+func stalePredicate(now, staleBefore time.Time) predicate.ProfileEntry {
+	return profileentry.And(
+		profileentry.StatusEQ(profileentry.StatusFetching),
+		profileentry.Or(
+			profileentry.LeaseExpiresAtLT(now),
+			profileentry.And(
+				profileentry.LeaseExpiresAtIsNil(),
+				profileentry.UpdatedAtLT(staleBefore),
+			),
+		),
+	)
+}
+
+// leased maps the not-found error of a write conditioned on the lease owner to
+// ErrLeaseLost.
+func leased(entry *ent.ProfileEntry, err error) (*ent.ProfileEntry, error) {
+	if ent.IsNotFound(err) {
+		return nil, ErrLeaseLost
+	}
+	return entry, err
+}
+
+// rollback aborts tx and folds any rollback failure into err.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return err
+}
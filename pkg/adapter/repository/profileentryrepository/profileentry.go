@@ -13,15 +13,55 @@ import (
 // ProfileEntryRepository interface for profile entry operations
 type ProfileEntryRepository interface {
 	GetPendingBatch(ctx context.Context, limit int) ([]*ent.ProfileEntry, error)
+	ClaimPendingBatch(
+		ctx context.Context,
+		owner string,
+		limit int,
+		leaseDuration time.Duration,
+	) ([]*ent.ProfileEntry, error)
+	ClaimByID(
+		ctx context.Context,
+		id string,
+		owner string,
+		leaseDuration time.Duration,
+	) (*ent.ProfileEntry, error)
+	RequeueStaleCompleted(
+		ctx context.Context,
+		fetchedBefore time.Time,
+		limit int,
+	) ([]ulid.ID, error)
+	ResetStaleFetching(
+		ctx context.Context,
+		staleBefore time.Time,
+		status profileentry.Status,
+		errorMsg *string,
+		limit int,
+	) ([]ulid.ID, error)
+	RenewLease(
+		ctx context.Context,
+		id string,
+		owner string,
+		leaseDuration time.Duration,
+	) error
 	UpdateStatus(
 		ctx context.Context,
 		id string,
+		owner string,
 		status profileentry.Status,
 		errorMsg *string,
 	) (*ent.ProfileEntry, error)
+	MarkFailed(
+		ctx context.Context,
+		id string,
+		owner string,
+		errorMsg string,
+		attemptCount int,
+		nextRetryAt *time.Time,
+	) (*ent.ProfileEntry, error)
 	UpdateAfterFetch(
 		ctx context.Context,
 		id string,
+		owner string,
 		rawS3Key, cleanedS3Key string,
 	) (*ent.ProfileEntry, error)
 	IncrementFetchCount(ctx context.Context, id string) error
@@ -50,53 +90,105 @@ func NewProfileEntryRepository(client *ent.Client) ProfileEntryRepository {
 	return &profileentryRepository{client}
 }
 
-// GetPendingBatch retrieves a batch of pending profile entries
+// GetPendingBatch retrieves a batch of entries due for fetching: PENDING
+// entries and FAILED entries whose scheduled retry is due.
 func (r *profileentryRepository) GetPendingBatch(
 	ctx context.Context,
 	limit int,
 ) ([]*ent.ProfileEntry, error) {
 	return r.client.ProfileEntry.
 		Query().
-		Where(profileentry.StatusEQ(profileentry.StatusPending)).
+		Where(duePredicate(time.Now())).
 		Order(ent.Asc(profileentry.FieldCreatedAt)).
 		Limit(limit).
 		All(ctx)
 }
 
-// UpdateStatus updates the status of a profile entry
+// UpdateStatus updates the status of a profile entry leased to owner.
+// Moving an entry out of FETCHING releases its fetch lease. Any scheduled
+// retry is cancelled; use MarkFailed to fail an entry under the retry policy.
+// It returns ErrLeaseLost when owner no longer holds the lease.
 func (r *profileentryRepository) UpdateStatus(
 	ctx context.Context,
 	id string,
+	owner string,
 	status profileentry.Status,
 	errorMsg *string,
 ) (*ent.ProfileEntry, error) {
 	updateBuilder := r.client.ProfileEntry.
 		UpdateOneID(ulid.ID(id)).
+		Where(profileentry.LeaseOwner(owner)).
 		SetStatus(status).
+		ClearNextRetryAt().
 		SetUpdatedAt(time.Now())
 
+	if status != profileentry.StatusFetching {
+		updateBuilder = updateBuilder.
+			ClearLeaseOwner().
+			ClearLeaseExpiresAt()
+	}
+
 	if errorMsg != nil {
 		updateBuilder = updateBuilder.SetErrorMessage(*errorMsg)
 	}
 
-	return updateBuilder.Save(ctx)
+	return leased(updateBuilder.Save(ctx))
+}
+
+// MarkFailed marks an entry leased to owner FAILED after a failed fetch
+// attempt, records the attempt count and releases its lease. A nil
+// nextRetryAt leaves the entry terminally FAILED; otherwise it becomes due
+// again at nextRetryAt. It returns ErrLeaseLost when owner no longer holds the
+// lease.
+func (r *profileentryRepository) MarkFailed(
+	ctx context.Context,
+	id string,
+	owner string,
+	errorMsg string,
+	attemptCount int,
+	nextRetryAt *time.Time,
+) (*ent.ProfileEntry, error) {
+	updateBuilder := r.client.ProfileEntry.
+		UpdateOneID(ulid.ID(id)).
+		Where(profileentry.LeaseOwner(owner)).
+		SetStatus(profileentry.StatusFAILED).
+		SetErrorMessage(errorMsg).
+		SetAttemptCount(attemptCount).
+		ClearLeaseOwner().
+		ClearLeaseExpiresAt().
+		SetUpdatedAt(time.Now())
+
+	if nextRetryAt != nil {
+		updateBuilder = updateBuilder.SetNextRetryAt(*nextRetryAt)
+	} else {
+		updateBuilder = updateBuilder.ClearNextRetryAt()
+	}
+
+	return leased(updateBuilder.Save(ctx))
 }
 
-// UpdateAfterFetch updates profile entry after successful fetch
+// UpdateAfterFetch updates profile entry leased to owner after successful
+// fetch, resets its retry state and releases its lease. It returns
+// ErrLeaseLost when owner no longer holds the lease.
 func (r *profileentryRepository) UpdateAfterFetch(
 	ctx context.Context,
 	id string,
+	owner string,
 	rawS3Key, cleanedS3Key string,
 ) (*ent.ProfileEntry, error) {
-	entry := r.client.ProfileEntry.Query().Where(profileentry.ID(ulid.ID(id))).OnlyX(ctx)
-	return r.client.ProfileEntry.
+	return leased(r.client.ProfileEntry.
 		UpdateOneID(ulid.ID(id)).
+		Where(profileentry.LeaseOwner(owner)).
 		SetStatus(profileentry.StatusCOMPLETED).
 		SetRawResponseS3Key(rawS3Key).
 		SetTemplateJSONS3Key(cleanedS3Key).
-		SetFetchCount(entry.FetchCount + 1).
+		AddFetchCount(1).
 		SetLastFetchedAt(time.Now()).
-		Save(ctx)
+		SetAttemptCount(0).
+		ClearNextRetryAt().
+		ClearLeaseOwner().
+		ClearLeaseExpiresAt().
+		Save(ctx))
 }
 
 // IncrementFetchCount increments the fetch count
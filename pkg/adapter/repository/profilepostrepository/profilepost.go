@@ -0,0 +1,45 @@
+package profilepostrepository
+
+import (
+	"context"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/profilepostitem"
+)
+
+type ProfilePostRepository struct {
+	client *ent.Client
+}
+
+func NewProfilePostRepository(client *ent.Client) *ProfilePostRepository {
+	return &ProfilePostRepository{client: client}
+}
+
+// ExistingPostURNs returns the subset of urns already stored as
+// ProfilePostItem rows for username.
+func (r *ProfilePostRepository) ExistingPostURNs(
+	ctx context.Context,
+	username string,
+	urns []string,
+) (map[string]bool, error) {
+	existing := make(map[string]bool, len(urns))
+	if len(urns) == 0 {
+		return existing, nil
+	}
+
+	stored, err := r.client.ProfilePostItem.
+		Query().
+		Where(
+			profilepostitem.ProfileUsernameEQ(username),
+			profilepostitem.PostUrnIn(urns...),
+		).
+		Select(profilepostitem.FieldPostUrn).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, urn := range stored {
+		existing[urn] = true
+	}
+	return existing, nil
+}
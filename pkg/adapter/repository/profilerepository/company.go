@@ -0,0 +1,109 @@
+package profilerepository
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/company"
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/util/profiledata"
+)
+
+// companyKey returns the identity key of the company of pos: its LinkedIn
+// URN/ID when present, otherwise its normalized name. It is empty when the
+// position names no company.
+func companyKey(pos profiledata.Position) string {
+	if pos.CompanyURN != "" {
+		return "urn:" + pos.CompanyURN
+	}
+	if name := profiledata.Normalize(pos.CompanyName); name != "" {
+		return "name:" + name
+	}
+	return ""
+}
+
+// resolveCompany returns the ID of the company of pos, creating it if needed.
+// A position without a URN reuses any company with the same normalized name,
+// preferring one identified by URN, so name-only positions attach to the
+// same company as positions that carry the URN.
+func resolveCompany(
+	ctx context.Context,
+	client *ent.Client,
+	pos profiledata.Position,
+) (model.ID, error) {
+	key := companyKey(pos)
+	if key == "" {
+		return "", nil
+	}
+	normalized := profiledata.Normalize(pos.CompanyName)
+
+	if pos.CompanyURN == "" {
+		// Descending key order puts "urn:" keys before "name:" keys.
+		existing, err := client.Company.
+			Query().
+			Where(company.NormalizedName(normalized)).
+			Order(ent.Desc(company.FieldKey)).
+			First(ctx)
+		if err == nil {
+			return existing.ID, nil
+		}
+		if !ent.IsNotFound(err) {
+			return "", err
+		}
+	}
+
+	name := pos.CompanyName
+	if name == "" {
+		name = pos.CompanyURN
+		normalized = profiledata.Normalize(name)
+	}
+
+	create := client.Company.
+		Create().
+		SetKey(key).
+		SetName(name).
+		SetNormalizedName(normalized)
+	if pos.CompanyURN != "" {
+		create = create.SetLinkedinUrn(pos.CompanyURN)
+	}
+	if pos.CompanyUsername != "" {
+		create = create.SetLinkedinUsername(pos.CompanyUsername)
+	}
+
+	// Concurrent fetches may see the same company first; the unique key
+	// makes the insert idempotent and keeps the latest name.
+	if err := create.
+		OnConflictColumns(company.FieldKey).
+		UpdateNewValues().
+		Exec(ctx); err != nil {
+		return "", fmt.Errorf("failed to upsert company %q: %w", key, err)
+	}
+
+	return client.Company.
+		Query().
+		Where(company.Key(key)).
+		OnlyID(ctx)
+}
+
+// companyLinks splits the companies of a profile's positions into current
+// and past. A company the profile still works at is never past.
+func companyLinks(positions []profiledata.Position, companyIDs []model.ID) (current, past []model.ID) {
+	currentSet := make(map[model.ID]bool)
+	for i, pos := range positions {
+		if companyIDs[i] != "" && pos.IsCurrent && !currentSet[companyIDs[i]] {
+			currentSet[companyIDs[i]] = true
+			current = append(current, companyIDs[i])
+		}
+	}
+
+	pastSet := make(map[model.ID]bool)
+	for i, pos := range positions {
+		id := companyIDs[i]
+		if id == "" || pos.IsCurrent || currentSet[id] || pastSet[id] {
+			continue
+		}
+		pastSet[id] = true
+		past = append(past, id)
+	}
+	return current, past
+}
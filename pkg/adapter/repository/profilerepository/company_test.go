@@ -0,0 +1,42 @@
+package profilerepository
+
+import (
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/util/profiledata"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompanyKey(t *testing.T) {
+	t.Run("Should prefer the LinkedIn company URN", func(t *testing.T) {
+		assert.Equal(t, "urn:1441", companyKey(profiledata.Position{CompanyName: "Google", CompanyURN: "1441"}))
+	})
+
+	t.Run("Should fall back to the normalized name", func(t *testing.T) {
+		assert.Equal(t, "name:acme corp", companyKey(profiledata.Position{CompanyName: "  ACME   Corp "}))
+	})
+
+	t.Run("Should be empty without a company", func(t *testing.T) {
+		assert.Empty(t, companyKey(profiledata.Position{Title: "Freelancer"}))
+	})
+}
+
+func TestCompanyLinks(t *testing.T) {
+	t.Run("Should split current and past companies without duplicates", func(t *testing.T) {
+		positions := []profiledata.Position{
+			{CompanyName: "Acme", IsCurrent: true},
+			{CompanyName: "Acme"},
+			{CompanyName: "Globex"},
+			{CompanyName: "Globex"},
+			{Title: "Freelancer"},
+			{CompanyName: "Initech", IsCurrent: true},
+		}
+		ids := []model.ID{"acme", "acme", "globex", "globex", "", "initech"}
+
+		current, past := companyLinks(positions, ids)
+
+		assert.Equal(t, []model.ID{"acme", "initech"}, current)
+		assert.Equal(t, []model.ID{"globex"}, past)
+	})
+}
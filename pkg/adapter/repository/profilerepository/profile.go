@@ -2,8 +2,10 @@ package profilerepository
 
 import (
 	"context"
+	"fmt"
 	"sheng-go-backend/ent"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/pkg/entity/model"
 	ur "sheng-go-backend/pkg/usecase/repository"
 )
 
@@ -11,9 +13,14 @@ import (
 type ProfileRepository interface {
 	GetByURN(ctx context.Context, urn string) (*ent.Profile, error)
 	GetByURNOrUsername(ctx context.Context, value string) (*ent.Profile, error)
-	Upsert(ctx context.Context, p *ent.Profile) (*ent.Profile, error)
+	Upsert(ctx context.Context, p *ent.Profile, changeEvents ChangeEventsFunc) (*ent.Profile, error)
+	BackfillRecords(ctx context.Context, batchSize int) (int, error)
 }
 
+// ChangeEventsFunc returns the unsaved change events between a profile's
+// previous snapshot and the one Upsert just recorded.
+type ChangeEventsFunc func(previous, latest *model.ProfileSnapshot) []*ent.ProfileChangeEvent
+
 type profileRepository struct {
 	client *ent.Client
 }
@@ -49,13 +56,60 @@ func (r *profileRepository) GetByURNOrUsername(
 		First(ctx)
 }
 
-// Upsert creates or updates a profile
-func (r *profileRepository) Upsert(ctx context.Context, p *ent.Profile) (*ent.Profile, error) {
+// Upsert creates or updates a profile, rebuilds its position, education and
+// skill rows, records a snapshot of the fetched state and the change events
+// changeEvents finds against the previous snapshot, in one transaction. A
+// profile's first snapshot has nothing to compare against and records no
+// events; a nil changeEvents records none either.
+func (r *profileRepository) Upsert(
+	ctx context.Context,
+	p *ent.Profile,
+	changeEvents ChangeEventsFunc,
+) (*ent.Profile, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start upsert transaction: %w", err)
+	}
+
+	saved, err := upsertProfile(ctx, tx.Client(), p)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := replaceRecords(ctx, tx.Client(), saved.ID, p); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	previous, err := latestSnapshot(ctx, tx.Client(), saved.ID)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to load previous profile snapshot: %w", err))
+	}
+
+	latest, err := createSnapshot(ctx, tx.Client(), saved.ID, p)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to record profile snapshot: %w", err))
+	}
+
+	if previous != nil && changeEvents != nil {
+		events := changeEvents(previous, latest)
+		if err := createChangeEvents(ctx, tx.Client(), saved.ID, latest.ID, events); err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to record change events: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit upsert transaction: %w", err)
+	}
+	return saved, nil
+}
+
+// upsertProfile creates or updates a profile
+func upsertProfile(ctx context.Context, client *ent.Client, p *ent.Profile) (*ent.Profile, error) {
 	// Try to find existing profile by URN or username (if provided)
 	var existing *ent.Profile
 	var err error
 	if p.Username != nil && *p.Username != "" {
-		existing, err = r.client.Profile.
+		existing, err = client.Profile.
 			Query().
 			Where(
 				profile.Or(
@@ -65,7 +119,7 @@ func (r *profileRepository) Upsert(ctx context.Context, p *ent.Profile) (*ent.Pr
 			).
 			First(ctx)
 	} else {
-		existing, err = r.client.Profile.
+		existing, err = client.Profile.
 			Query().
 			Where(profile.UrnEQ(p.Urn)).
 			First(ctx)
@@ -77,7 +131,7 @@ func (r *profileRepository) Upsert(ctx context.Context, p *ent.Profile) (*ent.Pr
 
 	// If profile exists, update it
 	if existing != nil {
-		updateBuilder := r.client.Profile.UpdateOneID(existing.ID)
+		updateBuilder := client.Profile.UpdateOneID(existing.ID)
 
 		if p.Username != nil {
 			updateBuilder = updateBuilder.SetUsername(*p.Username)
@@ -123,7 +177,7 @@ func (r *profileRepository) Upsert(ctx context.Context, p *ent.Profile) (*ent.Pr
 	}
 
 	// Create new profile
-	createBuilder := r.client.Profile.
+	createBuilder := client.Profile.
 		Create().
 		SetUrn(p.Urn)
 
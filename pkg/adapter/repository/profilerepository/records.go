@@ -0,0 +1,264 @@
+package profilerepository
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profileeducation"
+	"sheng-go-backend/ent/profileposition"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/util/profiledata"
+)
+
+// BackfillRecords rebuilds the position, education and skill rows and the
+// company links of every profile from its JSON columns, batchSize profiles per transaction, and
+// returns the number of profiles processed. It is idempotent.
+func (r *profileRepository) BackfillRecords(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = 200
+	}
+
+	processed := 0
+	var lastID model.ID
+	for {
+		query := r.client.Profile.
+			Query().
+			Order(ent.Asc(profile.FieldID)).
+			Limit(batchSize)
+		if lastID != "" {
+			query = query.Where(profile.IDGT(lastID))
+		}
+		profiles, err := query.All(ctx)
+		if err != nil {
+			return processed, model.NewDBError(err)
+		}
+		if len(profiles) == 0 {
+			return processed, nil
+		}
+
+		tx, err := r.client.Tx(ctx)
+		if err != nil {
+			return processed, fmt.Errorf("failed to start backfill transaction: %w", err)
+		}
+		for _, p := range profiles {
+			if err := replaceRecords(ctx, tx.Client(), p.ID, p); err != nil {
+				return processed, rollback(tx, fmt.Errorf("profile %s: %w", p.ID, err))
+			}
+		}
+		if err := tx.Commit(); err != nil {
+			return processed, fmt.Errorf("failed to commit backfill transaction: %w", err)
+		}
+
+		processed += len(profiles)
+		lastID = profiles[len(profiles)-1].ID
+	}
+}
+
+// nillableID returns nil for an empty ID.
+func nillableID(id model.ID) *model.ID {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
+
+// replaceRecords rebuilds the typed rows and company links of profileID from
+// the JSON columns of p. Like Upsert, a nil column is left untouched, so only the arrays present
+// in p are replaced.
+func replaceRecords(ctx context.Context, client *ent.Client, profileID model.ID, p *ent.Profile) error {
+	if p.Positions != nil {
+		if err := replacePositions(ctx, client, profileID, profiledata.Positions(p.Positions)); err != nil {
+			return fmt.Errorf("failed to store positions: %w", err)
+		}
+	}
+	if p.Educations != nil {
+		if err := replaceEducations(ctx, client, profileID, profiledata.Educations(p.Educations)); err != nil {
+			return fmt.Errorf("failed to store educations: %w", err)
+		}
+	}
+	if p.Skills != nil {
+		if err := replaceSkills(ctx, client, profileID, profiledata.Skills(p.Skills)); err != nil {
+			return fmt.Errorf("failed to store skills: %w", err)
+		}
+	}
+	return nil
+}
+
+func replacePositions(
+	ctx context.Context,
+	client *ent.Client,
+	profileID model.ID,
+	positions []profiledata.Position,
+) error {
+	if _, err := client.ProfilePosition.
+		Delete().
+		Where(profileposition.HasProfileWith(profile.ID(profileID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	companyIDs := make([]model.ID, len(positions))
+	for i, pos := range positions {
+		id, err := resolveCompany(ctx, client, pos)
+		if err != nil {
+			return err
+		}
+		companyIDs[i] = id
+	}
+
+	current, past := companyLinks(positions, companyIDs)
+	if err := client.Profile.
+		UpdateOneID(profileID).
+		ClearCurrentCompanies().
+		AddCurrentCompanyIDs(current...).
+		ClearPastCompanies().
+		AddPastCompanyIDs(past...).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to link companies: %w", err)
+	}
+
+	if len(positions) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.ProfilePositionCreate, len(positions))
+	for i, pos := range positions {
+		builders[i] = client.ProfilePosition.
+			Create().
+			SetProfileID(profileID).
+			SetNillableCompanyID(nillableID(companyIDs[i])).
+			SetPositionIndex(i).
+			SetCompanyName(pos.CompanyName).
+			SetCompanyUrn(pos.CompanyURN).
+			SetCompanyUsername(pos.CompanyUsername).
+			SetTitle(pos.Title).
+			SetLocation(pos.Location).
+			SetEmploymentType(pos.EmploymentType).
+			SetDescription(pos.Description).
+			SetNillableStartDate(pos.Start.Time()).
+			SetNillableEndDate(pos.End.Time()).
+			SetIsCurrent(pos.IsCurrent)
+	}
+	return client.ProfilePosition.CreateBulk(builders...).Exec(ctx)
+}
+
+func replaceEducations(
+	ctx context.Context,
+	client *ent.Client,
+	profileID model.ID,
+	educations []profiledata.Education,
+) error {
+	if _, err := client.ProfileEducation.
+		Delete().
+		Where(profileeducation.HasProfileWith(profile.ID(profileID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if len(educations) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.ProfileEducationCreate, len(educations))
+	for i, edu := range educations {
+		builders[i] = client.ProfileEducation.
+			Create().
+			SetProfileID(profileID).
+			SetEducationIndex(i).
+			SetSchoolName(edu.SchoolName).
+			SetSchoolID(edu.SchoolID).
+			SetDegree(edu.Degree).
+			SetFieldOfStudy(edu.FieldOfStudy).
+			SetNillableStartDate(edu.Start.Time()).
+			SetNillableEndDate(edu.End.Time())
+	}
+	return client.ProfileEducation.CreateBulk(builders...).Exec(ctx)
+}
+
+func replaceSkills(
+	ctx context.Context,
+	client *ent.Client,
+	profileID model.ID,
+	skills []profiledata.Skill,
+) error {
+	if _, err := client.ProfileSkill.
+		Delete().
+		Where(profileskill.HasProfileWith(profile.ID(profileID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if len(skills) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.ProfileSkillCreate, len(skills))
+	for i, skill := range skills {
+		builders[i] = client.ProfileSkill.
+			Create().
+			SetProfileID(profileID).
+			SetName(skill.Name).
+			SetEndorsementCount(skill.EndorsementCount)
+	}
+	return client.ProfileSkill.CreateBulk(builders...).Exec(ctx)
+}
+
+// ListPositions returns the positions of a profile matching where, most recent first.
+func (r *profileRepository) ListPositions(
+	ctx context.Context,
+	profileID model.ID,
+	where *model.ProfilePositionWhereInput,
+) ([]*model.ProfilePosition, error) {
+	query, err := where.Filter(r.client.ProfilePosition.Query())
+	if err != nil {
+		return nil, model.NewValidationError(err)
+	}
+	positions, err := query.
+		Where(profileposition.HasProfileWith(profile.ID(profileID))).
+		Order(ent.Asc(profileposition.FieldPositionIndex)).
+		All(ctx)
+	if err != nil {
+		return nil, model.NewDBError(err)
+	}
+	return positions, nil
+}
+
+// ListEducations returns the educations of a profile matching where, most recent first.
+func (r *profileRepository) ListEducations(
+	ctx context.Context,
+	profileID model.ID,
+	where *model.ProfileEducationWhereInput,
+) ([]*model.ProfileEducation, error) {
+	query, err := where.Filter(r.client.ProfileEducation.Query())
+	if err != nil {
+		return nil, model.NewValidationError(err)
+	}
+	educations, err := query.
+		Where(profileeducation.HasProfileWith(profile.ID(profileID))).
+		Order(ent.Asc(profileeducation.FieldEducationIndex)).
+		All(ctx)
+	if err != nil {
+		return nil, model.NewDBError(err)
+	}
+	return educations, nil
+}
+
+// ListSkills returns the skills of a profile matching where, most endorsed first.
+func (r *profileRepository) ListSkills(
+	ctx context.Context,
+	profileID model.ID,
+	where *model.ProfileSkillWhereInput,
+) ([]*model.ProfileSkill, error) {
+	query, err := where.Filter(r.client.ProfileSkill.Query())
+	if err != nil {
+		return nil, model.NewValidationError(err)
+	}
+	skills, err := query.
+		Where(profileskill.HasProfileWith(profile.ID(profileID))).
+		Order(ent.Desc(profileskill.FieldEndorsementCount), ent.Asc(profileskill.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, model.NewDBError(err)
+	}
+	return skills, nil
+}
@@ -0,0 +1,105 @@
+package profilerepository
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilesnapshot"
+	"sheng-go-backend/pkg/entity/model"
+	"time"
+)
+
+// ListSnapshots returns the snapshots of a profile, oldest first.
+func (r *profileRepository) ListSnapshots(
+	ctx context.Context,
+	profileID model.ID,
+) ([]*model.ProfileSnapshot, error) {
+	snapshots, err := r.client.ProfileSnapshot.
+		Query().
+		Where(profilesnapshot.HasProfileWith(profile.ID(profileID))).
+		Order(ent.Asc(profilesnapshot.FieldFetchedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, model.NewDBError(err)
+	}
+	return snapshots, nil
+}
+
+// latestSnapshot returns the newest snapshot of the profile profileID, or nil
+// when it has none.
+func latestSnapshot(
+	ctx context.Context,
+	client *ent.Client,
+	profileID model.ID,
+) (*model.ProfileSnapshot, error) {
+	snapshot, err := client.ProfileSnapshot.
+		Query().
+		Where(profilesnapshot.HasProfileWith(profile.ID(profileID))).
+		Order(ent.Desc(profilesnapshot.FieldFetchedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return snapshot, err
+}
+
+// createSnapshot records the fetched state p of the profile profileID.
+func createSnapshot(
+	ctx context.Context,
+	client *ent.Client,
+	profileID model.ID,
+	p *ent.Profile,
+) (*model.ProfileSnapshot, error) {
+	return client.ProfileSnapshot.
+		Create().
+		SetProfileID(profileID).
+		SetFetchedAt(time.Now()).
+		SetNillableRawDataS3Key(p.RawDataS3Key).
+		SetNillableCleanedDataS3Key(p.CleanedDataS3Key).
+		SetNillableHeadline(p.Headline).
+		SetNillableTitle(p.Title).
+		SetNillableCountry(p.Country).
+		SetNillableCity(p.City).
+		SetPositions(p.Positions).
+		SetEducations(p.Educations).
+		SetSkills(p.Skills).
+		Save(ctx)
+}
+
+// createChangeEvents records the change events detected on the profile
+// profileID when the snapshot snapshotID was taken. Type, values, detection
+// time and delivery status are read from events.
+func createChangeEvents(
+	ctx context.Context,
+	client *ent.Client,
+	profileID model.ID,
+	snapshotID model.ID,
+	events []*ent.ProfileChangeEvent,
+) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.ProfileChangeEventCreate, len(events))
+	for i, e := range events {
+		builders[i] = client.ProfileChangeEvent.
+			Create().
+			SetProfileID(profileID).
+			SetSnapshotID(snapshotID).
+			SetType(e.Type).
+			SetNillableOldValue(e.OldValue).
+			SetNillableNewValue(e.NewValue).
+			SetDetectedAt(e.DetectedAt).
+			SetDeliveryStatus(e.DeliveryStatus)
+	}
+	return client.ProfileChangeEvent.CreateBulk(builders...).Exec(ctx)
+}
+
+// rollback aborts tx and folds any rollback failure into err.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return err
+}
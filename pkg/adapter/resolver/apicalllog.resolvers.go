@@ -0,0 +1,34 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/schema/ulid"
+	"sheng-go-backend/pkg/adapter/handler"
+	"sheng-go-backend/pkg/entity/model"
+	"time"
+
+	"entgo.io/contrib/entgql"
+)
+
+// APICallLogs is the resolver for the apiCallLogs field.
+func (r *queryResolver) APICallLogs(ctx context.Context, after *entgql.Cursor[ulid.ID], first *int, before *entgql.Cursor[ulid.ID], last *int, where *ent.APICallLogWhereInput) (*ent.APICallLogConnection, error) {
+	conn, err := r.controller.APIQuota.ListCalls(ctx, after, first, before, last, where)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return conn, nil
+}
+
+// APICallStats is the resolver for the apiCallStats field.
+func (r *queryResolver) APICallStats(ctx context.Context, groupBy model.APICallGroupBy, from *time.Time, to *time.Time) ([]*model.APICallStat, error) {
+	stats, err := r.controller.APIQuota.GetCallStats(ctx, groupBy, from, to)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return stats, nil
+}
@@ -8,8 +8,19 @@ import (
 	"context"
 	"fmt"
 	"sheng-go-backend/ent"
+	"sheng-go-backend/graph/generated"
+	"sheng-go-backend/pkg/entity/model"
 )
 
+// Forecast is the resolver for the forecast field.
+func (r *aPIQuotaTrackerResolver) Forecast(ctx context.Context, obj *ent.APIQuotaTracker) (*model.QuotaForecast, error) {
+	forecast, err := r.controller.APIQuota.GetForecast(ctx, obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to forecast quota usage: %w", err)
+	}
+	return forecast, nil
+}
+
 // SetQuotaOverride is the resolver for the setQuotaOverride field.
 func (r *mutationResolver) SetQuotaOverride(ctx context.Context, enabled bool) (*ent.APIQuotaTracker, error) {
 	tracker, err := r.controller.APIQuota.SetOverride(ctx, enabled)
@@ -28,6 +39,42 @@ func (r *mutationResolver) UpdateQuotaLimit(ctx context.Context, limit int) (*en
 	return tracker, nil
 }
 
+// UpdateQuotaCaps is the resolver for the updateQuotaCaps field.
+func (r *mutationResolver) UpdateQuotaCaps(ctx context.Context, input model.UpdateQuotaCapsInput) (*ent.APIQuotaTracker, error) {
+	tracker, err := r.controller.APIQuota.UpdateCaps(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update quota caps: %w", err)
+	}
+	return tracker, nil
+}
+
+// CreateQuotaBudget is the resolver for the createQuotaBudget field.
+func (r *mutationResolver) CreateQuotaBudget(ctx context.Context, input ent.CreateAPIQuotaBudgetInput) (*ent.APIQuotaBudget, error) {
+	budget, err := r.controller.APIQuota.CreateBudget(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create quota budget: %w", err)
+	}
+	return budget, nil
+}
+
+// UpdateQuotaBudget is the resolver for the updateQuotaBudget field.
+func (r *mutationResolver) UpdateQuotaBudget(ctx context.Context, name string, input ent.UpdateAPIQuotaBudgetInput) (*ent.APIQuotaBudget, error) {
+	budget, err := r.controller.APIQuota.UpdateBudget(ctx, name, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update quota budget: %w", err)
+	}
+	return budget, nil
+}
+
+// DeleteQuotaBudget is the resolver for the deleteQuotaBudget field.
+func (r *mutationResolver) DeleteQuotaBudget(ctx context.Context, name string) (*ent.APIQuotaBudget, error) {
+	budget, err := r.controller.APIQuota.DeleteBudget(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete quota budget: %w", err)
+	}
+	return budget, nil
+}
+
 // CurrentQuotaStatus is the resolver for the currentQuotaStatus field.
 func (r *queryResolver) CurrentQuotaStatus(ctx context.Context) (*ent.APIQuotaTracker, error) {
 	tracker, err := r.controller.APIQuota.GetCurrent(ctx)
@@ -50,3 +97,46 @@ func (r *queryResolver) QuotaHistory(ctx context.Context, limit *int) ([]*ent.AP
 	}
 	return history, nil
 }
+
+// APIKeyUsage is the resolver for the apiKeyUsage field.
+func (r *queryResolver) APIKeyUsage(ctx context.Context) ([]*ent.APIQuotaTracker, error) {
+	usage, err := r.controller.APIQuota.GetKeyUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key usage: %w", err)
+	}
+	return usage, nil
+}
+
+// QuotaBudgets is the resolver for the quotaBudgets field.
+func (r *queryResolver) QuotaBudgets(ctx context.Context) ([]*ent.APIQuotaBudget, error) {
+	budgets, err := r.controller.APIQuota.ListBudgets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quota budgets: %w", err)
+	}
+	return budgets, nil
+}
+
+// QuotaBudgetUsage is the resolver for the quotaBudgetUsage field.
+func (r *queryResolver) QuotaBudgetUsage(ctx context.Context) ([]*ent.APIQuotaTracker, error) {
+	usage, err := r.controller.APIQuota.GetBudgetUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quota budget usage: %w", err)
+	}
+	return usage, nil
+}
+
+// QuotaPace is the resolver for the quotaPace field.
+func (r *queryResolver) QuotaPace(ctx context.Context) (*model.QuotaPace, error) {
+	pace, err := r.controller.APIQuota.GetPace(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quota pace: %w", err)
+	}
+	return pace, nil
+}
+
+// APIQuotaTracker returns generated.APIQuotaTrackerResolver implementation.
+func (r *Resolver) APIQuotaTracker() generated.APIQuotaTrackerResolver {
+	return &aPIQuotaTrackerResolver{r}
+}
+
+type aPIQuotaTrackerResolver struct{ *Resolver }
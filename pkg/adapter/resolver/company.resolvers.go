@@ -0,0 +1,70 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/schema/ulid"
+	"sheng-go-backend/graph/generated"
+	"sheng-go-backend/pkg/adapter/handler"
+	"sheng-go-backend/pkg/entity/model"
+
+	"entgo.io/contrib/entgql"
+)
+
+// CurrentEmployees is the resolver for the currentEmployees field.
+func (r *companyResolver) CurrentEmployees(ctx context.Context, obj *ent.Company, after *entgql.Cursor[ulid.ID], first *int, before *entgql.Cursor[ulid.ID], last *int, where *ent.ProfileWhereInput) (*ent.ProfileConnection, error) {
+	employees, err := r.controller.Company.CurrentEmployees(ctx, obj.ID, after, first, before, last, where)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return employees, nil
+}
+
+// Alumni is the resolver for the alumni field.
+func (r *companyResolver) Alumni(ctx context.Context, obj *ent.Company, after *entgql.Cursor[ulid.ID], first *int, before *entgql.Cursor[ulid.ID], last *int, where *ent.ProfileWhereInput) (*ent.ProfileConnection, error) {
+	alumni, err := r.controller.Company.Alumni(ctx, obj.ID, after, first, before, last, where)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return alumni, nil
+}
+
+// HeadcountByTitle is the resolver for the headcountByTitle field.
+func (r *companyResolver) HeadcountByTitle(ctx context.Context, obj *ent.Company, alumni *bool, minCount *int) ([]*model.ProfileTitleGroup, error) {
+	threshold := 1
+	if minCount != nil {
+		threshold = *minCount
+	}
+	groups, err := r.controller.Company.HeadcountByTitle(ctx, obj.ID, alumni != nil && *alumni, threshold)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return groups, nil
+}
+
+// Company is the resolver for the company field.
+func (r *queryResolver) Company(ctx context.Context, id ulid.ID) (*ent.Company, error) {
+	company, err := r.controller.Company.Get(ctx, id)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return company, nil
+}
+
+// Companies is the resolver for the companies field.
+func (r *queryResolver) Companies(ctx context.Context, after *entgql.Cursor[ulid.ID], first *int, before *entgql.Cursor[ulid.ID], last *int, where *ent.CompanyWhereInput) (*ent.CompanyConnection, error) {
+	cc, err := r.controller.Company.List(ctx, after, first, before, last, where)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return cc, nil
+}
+
+// Company returns generated.CompanyResolver implementation.
+func (r *Resolver) Company() generated.CompanyResolver { return &companyResolver{r} }
+
+type companyResolver struct{ *Resolver }
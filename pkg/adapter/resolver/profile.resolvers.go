@@ -55,6 +55,51 @@ func (r *profileResolver) Skills(ctx context.Context, obj *ent.Profile) (map[str
 	return map[string]any{"items": obj.Skills}, nil
 }
 
+// Snapshots is the resolver for the snapshots field.
+func (r *profileResolver) Snapshots(ctx context.Context, obj *ent.Profile) ([]*ent.ProfileSnapshot, error) {
+	snapshots, err := r.controller.Profile.Snapshots(ctx, obj.ID)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return snapshots, nil
+}
+
+// Changes is the resolver for the changes field.
+func (r *profileResolver) Changes(ctx context.Context, obj *ent.Profile) ([]*model.ProfileChange, error) {
+	changes, err := r.controller.Profile.Changes(ctx, obj.ID)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return changes, nil
+}
+
+// ProfilePositions is the resolver for the profilePositions field.
+func (r *profileResolver) ProfilePositions(ctx context.Context, obj *ent.Profile, where *ent.ProfilePositionWhereInput) ([]*ent.ProfilePosition, error) {
+	positions, err := r.controller.Profile.Positions(ctx, obj.ID, where)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return positions, nil
+}
+
+// ProfileEducations is the resolver for the profileEducations field.
+func (r *profileResolver) ProfileEducations(ctx context.Context, obj *ent.Profile, where *ent.ProfileEducationWhereInput) ([]*ent.ProfileEducation, error) {
+	educations, err := r.controller.Profile.Educations(ctx, obj.ID, where)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return educations, nil
+}
+
+// ProfileSkills is the resolver for the profileSkills field.
+func (r *profileResolver) ProfileSkills(ctx context.Context, obj *ent.Profile, where *ent.ProfileSkillWhereInput) ([]*ent.ProfileSkill, error) {
+	skills, err := r.controller.Profile.Skills(ctx, obj.ID, where)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return skills, nil
+}
+
 // CreatedAt is the resolver for the createdAt field.
 func (r *profileResolver) CreatedAt(ctx context.Context, obj *ent.Profile) (string, error) {
 	return datetime.FormatDate(obj.CreatedAt), nil
@@ -0,0 +1,23 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/schema/ulid"
+	"sheng-go-backend/pkg/adapter/handler"
+
+	"entgo.io/contrib/entgql"
+)
+
+// ProfileChangeEvents is the resolver for the profileChangeEvents field.
+func (r *queryResolver) ProfileChangeEvents(ctx context.Context, after *entgql.Cursor[ulid.ID], first *int, before *entgql.Cursor[ulid.ID], last *int, where *ent.ProfileChangeEventWhereInput) (*ent.ProfileChangeEventConnection, error) {
+	conn, err := r.controller.ProfileChangeEvent.List(ctx, after, first, before, last, where)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return conn, nil
+}
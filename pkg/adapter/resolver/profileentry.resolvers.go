@@ -9,6 +9,7 @@ import (
 	"sheng-go-backend/ent"
 	"sheng-go-backend/ent/schema/ulid"
 	"sheng-go-backend/pkg/adapter/handler"
+	"sheng-go-backend/pkg/entity/model"
 
 	"entgo.io/contrib/entgql"
 )
@@ -41,8 +42,8 @@ func (r *mutationResolver) DeleteProfileEntry(ctx context.Context, id ulid.ID) (
 }
 
 // FetchProfileEntry is the resolver for the fetchProfileEntry field.
-func (r *mutationResolver) FetchProfileEntry(ctx context.Context, id ulid.ID) (bool, error) {
-	err := r.controller.ProfileEntry.FetchProfileEntry(ctx, id)
+func (r *mutationResolver) FetchProfileEntry(ctx context.Context, id ulid.ID, options *model.ProfileFetchOptions) (bool, error) {
+	err := r.controller.ProfileEntry.FetchProfileEntry(ctx, id, options)
 	if err != nil {
 		return false, err
 	}
@@ -50,6 +51,15 @@ func (r *mutationResolver) FetchProfileEntry(ctx context.Context, id ulid.ID) (b
 	return true, nil
 }
 
+// RequeueProfileEntries is the resolver for the requeueProfileEntries field.
+func (r *mutationResolver) RequeueProfileEntries(ctx context.Context, input model.RequeueProfileEntriesInput) (*model.BulkProfileEntryResult, error) {
+	result, err := r.controller.ProfileEntry.Requeue(ctx, input)
+	if err != nil {
+		return nil, handler.HandleGraphQLError(ctx, err)
+	}
+	return result, nil
+}
+
 // ProfileEntry is the resolver for the profileEntry field.
 func (r *queryResolver) ProfileEntry(ctx context.Context, id ulid.ID) (*ent.ProfileEntry, error) {
 	profile, err := r.controller.ProfileEntry.Get(ctx, &id)
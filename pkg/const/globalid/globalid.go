@@ -4,13 +4,22 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"sheng-go-backend/ent/apicalllog"
+	"sheng-go-backend/ent/apiquotabudget"
+	"sheng-go-backend/ent/apiquotareservation"
 	"sheng-go-backend/ent/apiquotatracker"
+	"sheng-go-backend/ent/company"
 	"sheng-go-backend/ent/cronjobconfig"
 	"sheng-go-backend/ent/jobexecutionhistory"
 	"sheng-go-backend/ent/profile"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/ent/profileeducation"
 	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/profileposition"
 	"sheng-go-backend/ent/profilepost"
 	"sheng-go-backend/ent/profilepostitem"
+	"sheng-go-backend/ent/profileskill"
+	"sheng-go-backend/ent/profilesnapshot"
 	"sheng-go-backend/ent/todo"
 	"sheng-go-backend/ent/user"
 )
@@ -22,15 +31,24 @@ type field struct {
 
 // GlobalIDs maps unique string to tables names.
 type GlobalIDs struct {
-	User                 field
-	Todo                 field
-	Profile              field
-	ProfileEntry         field
-	APIQuotaTracker      field
-	CronJobConfig        field
-	JobExecutionHistory  field
-	ProfilePost          field
-	ProfilePostItem      field
+	User                field
+	Todo                field
+	Profile             field
+	ProfileEntry        field
+	APIQuotaTracker     field
+	APIQuotaReservation field
+	APIQuotaBudget      field
+	APICallLog          field
+	CronJobConfig       field
+	JobExecutionHistory field
+	ProfilePost         field
+	ProfilePostItem     field
+	ProfileSnapshot     field
+	ProfileChangeEvent  field
+	ProfilePosition     field
+	ProfileEducation    field
+	ProfileSkill        field
+	Company             field
 }
 
 // New generates a map object that is intended to be used as global identification for node interface query.
@@ -73,6 +91,42 @@ func New() GlobalIDs {
 			Prefix: "0AI",
 			Table:  profilepostitem.Table,
 		},
+		ProfileSnapshot: field{
+			Prefix: "0AJ",
+			Table:  profilesnapshot.Table,
+		},
+		ProfileChangeEvent: field{
+			Prefix: "0AK",
+			Table:  profilechangeevent.Table,
+		},
+		ProfilePosition: field{
+			Prefix: "0AL",
+			Table:  profileposition.Table,
+		},
+		ProfileEducation: field{
+			Prefix: "0AM",
+			Table:  profileeducation.Table,
+		},
+		ProfileSkill: field{
+			Prefix: "0AN",
+			Table:  profileskill.Table,
+		},
+		Company: field{
+			Prefix: "0AO",
+			Table:  company.Table,
+		},
+		APIQuotaReservation: field{
+			Prefix: "0AP",
+			Table:  apiquotareservation.Table,
+		},
+		APIQuotaBudget: field{
+			Prefix: "0AQ",
+			Table:  apiquotabudget.Table,
+		},
+		APICallLog: field{
+			Prefix: "0AR",
+			Table:  apicalllog.Table,
+		},
 	}
 }
 
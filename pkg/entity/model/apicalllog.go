@@ -0,0 +1,72 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"sheng-go-backend/ent"
+	"strconv"
+)
+
+type APICallLog = ent.APICallLog
+
+type APICallLogConnection = ent.APICallLogConnection
+
+type APICallLogWhereInput = ent.APICallLogWhereInput
+
+// APICallGroupBy selects what API call stats are grouped by.
+type APICallGroupBy string
+
+// APICallGroupBy values.
+const (
+	// APICallGroupByDay groups calls by local calendar day (YYYY-MM-DD).
+	APICallGroupByDay APICallGroupBy = "DAY"
+	// APICallGroupByEndpoint groups calls by RapidAPI endpoint.
+	APICallGroupByEndpoint APICallGroupBy = "ENDPOINT"
+	// APICallGroupByStatus groups calls by HTTP status; NONE when no
+	// response arrived.
+	APICallGroupByStatus APICallGroupBy = "STATUS"
+	// APICallGroupByCaller groups calls by caller; empty when unknown.
+	APICallGroupByCaller APICallGroupBy = "CALLER"
+	// APICallGroupByKey groups calls by RapidAPI key label.
+	APICallGroupByKey APICallGroupBy = "KEY"
+)
+
+// IsValid reports whether g is a known grouping.
+func (g APICallGroupBy) IsValid() bool {
+	switch g {
+	case APICallGroupByDay, APICallGroupByEndpoint, APICallGroupByStatus, APICallGroupByCaller, APICallGroupByKey:
+		return true
+	}
+	return false
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (g APICallGroupBy) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(string(g)))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (g *APICallGroupBy) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*g = APICallGroupBy(str)
+	if !g.IsValid() {
+		return fmt.Errorf("%s is not a valid APICallGroupBy", str)
+	}
+	return nil
+}
+
+// APICallStat aggregates the API calls sharing one group value.
+type APICallStat struct {
+	// Group is the day, endpoint, status, caller or key label
+	Group string `json:"group"`
+	Calls int    `json:"calls"`
+	// CountedCalls were counted against their key's quota
+	CountedCalls int `json:"countedCalls"`
+	// FailedCalls got no response or a non-2xx status
+	FailedCalls  int     `json:"failedCalls"`
+	Bytes        int     `json:"bytes"`
+	AvgLatencyMs float64 `json:"avgLatencyMs"`
+}
@@ -1,6 +1,9 @@
 package model
 
-import "sheng-go-backend/ent"
+import (
+	"sheng-go-backend/ent"
+	"time"
+)
 
 type APIQuotaTracker = ent.APIQuotaTracker
 
@@ -11,3 +14,47 @@ type UpdateAPIQuotaTrackerInput = ent.UpdateAPIQuotaTrackerInput
 type APIQuotaTrackerWhereInput = ent.APIQuotaTrackerWhereInput
 
 type APIQuotaTrackerConnection = ent.APIQuotaTrackerConnection
+
+// UpdateQuotaCapsInput sets the current month's rolling caps and pacing mode.
+// Omitted fields are left unchanged; a cap of 0 removes it.
+type UpdateQuotaCapsInput struct {
+	DailyCap   *int  `json:"dailyCap"`
+	HourlyCap  *int  `json:"hourlyCap"`
+	EvenPacing *bool `json:"evenPacing"`
+}
+
+// QuotaPace compares the current month's usage with an even spread of the
+// limit over the month, and the rolling windows with their caps.
+type QuotaPace struct {
+	EvenPacing bool `json:"evenPacing"`
+	// HourlyCap and DailyCap are the caps in force; DailyCap is the lower of
+	// the configured cap and the even-pacing share. Nil means no cap.
+	HourlyCap *int `json:"hourlyCap"`
+	HourUsed  int  `json:"hourUsed"`
+	DailyCap  *int `json:"dailyCap"`
+	DayUsed   int  `json:"dayUsed"`
+	// PlannedDaily is the remaining quota divided by the remaining days.
+	PlannedDaily int `json:"plannedDaily"`
+	MonthUsed    int `json:"monthUsed"`
+	QuotaLimit   int `json:"quotaLimit"`
+	// PlannedByNow is the share of the limit an even pace would have used
+	// by now.
+	PlannedByNow int `json:"plannedByNow"`
+	// PaceRatio is MonthUsed / PlannedByNow; above 1 is ahead of plan.
+	PaceRatio float64 `json:"paceRatio"`
+}
+
+// QuotaForecast projects the current month's usage from the recent call rate
+type QuotaForecast struct {
+	// CallsPerDay is the jobs' rate over the last week plus the month's
+	// average rate of calls made outside jobs.
+	CallsPerDay    float64 `json:"callsPerDay"`
+	JobCallsPerDay float64 `json:"jobCallsPerDay"`
+	// ProjectedMonthEnd is the call count the month ends with at this rate
+	ProjectedMonthEnd int `json:"projectedMonthEnd"`
+	// ExhaustionDate is when the quota runs out at this rate, or ran out if
+	// it already has. Nil when nothing is being used.
+	ExhaustionDate      *time.Time `json:"exhaustionDate"`
+	ExhaustsBeforeReset bool       `json:"exhaustsBeforeReset"`
+	LastCallAt          *time.Time `json:"lastCallAt"`
+}
@@ -0,0 +1,9 @@
+package model
+
+import "sheng-go-backend/ent"
+
+type Company = ent.Company
+
+type CompanyWhereInput = ent.CompanyWhereInput
+
+type CompanyConnection = ent.CompanyConnection
@@ -1,6 +1,12 @@
 package model
 
-import "sheng-go-backend/ent"
+import (
+	"fmt"
+	"io"
+	"sheng-go-backend/ent"
+	"strconv"
+	"time"
+)
 
 // ProfileEntryStats represents statistics about profile entries
 type ProfileEntryStats struct {
@@ -23,8 +29,63 @@ type JobStats struct {
 // DashboardOverview provides a complete dashboard overview
 type DashboardOverview struct {
 	QuotaStatus           *ent.APIQuotaTracker       `json:"quotaStatus"`
+	APIKeyUsage           []*ent.APIQuotaTracker     `json:"apiKeyUsage"`
+	QuotaPace             *QuotaPace                 `json:"quotaPace"`
 	PendingProfilesCount  int                        `json:"pendingProfilesCount"`
 	RecentJobExecutions   []*ent.JobExecutionHistory `json:"recentJobExecutions"`
 	CronJobsStatus        []*ent.CronJobConfig       `json:"cronJobsStatus"`
 	ProfileEntryStats     *ProfileEntryStats         `json:"profileEntryStats"`
+	ProviderStatus        *ProfileProviderStatus     `json:"providerStatus"`
+}
+
+// CircuitBreakerState is the state of the profile provider's circuit breaker.
+type CircuitBreakerState string
+
+// CircuitBreakerState values.
+const (
+	// CircuitBreakerStateClosed means calls go through.
+	CircuitBreakerStateClosed CircuitBreakerState = "CLOSED"
+	// CircuitBreakerStateOpen means calls are rejected until RetryAt.
+	CircuitBreakerStateOpen CircuitBreakerState = "OPEN"
+	// CircuitBreakerStateHalfOpen means a probe call is testing recovery.
+	CircuitBreakerStateHalfOpen CircuitBreakerState = "HALF_OPEN"
+)
+
+// IsValid reports whether s is a known breaker state.
+func (s CircuitBreakerState) IsValid() bool {
+	switch s {
+	case CircuitBreakerStateClosed, CircuitBreakerStateOpen, CircuitBreakerStateHalfOpen:
+		return true
+	}
+	return false
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (s CircuitBreakerState) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(string(s)))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (s *CircuitBreakerState) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*s = CircuitBreakerState(str)
+	if !s.IsValid() {
+		return fmt.Errorf("%s is not a valid CircuitBreakerState", str)
+	}
+	return nil
+}
+
+// ProfileProviderStatus reports the profile provider and its circuit breaker.
+// ErrorRate and WindowRequests cover the sliding window while closed and the
+// window that tripped the breaker otherwise.
+type ProfileProviderStatus struct {
+	Name           string              `json:"name"`
+	BreakerState   CircuitBreakerState `json:"breakerState"`
+	ErrorRate      float64             `json:"errorRate"`
+	WindowRequests int                 `json:"windowRequests"`
+	OpenedAt       *time.Time          `json:"openedAt"`
+	RetryAt        *time.Time          `json:"retryAt"`
 }
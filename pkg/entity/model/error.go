@@ -23,6 +23,14 @@ const (
 
 	// Auth Error
 	AuthError = "AUTH_ERROR"
+
+	// RateLimitError is error code of an upstream provider throttling us
+	RateLimitError = "RATE_LIMIT_ERROR"
+	// UpstreamError is error code of an upstream provider failure
+	UpstreamError = "UPSTREAM_ERROR"
+	// ServiceUnavailableError is error code of an upstream provider that is
+	// temporarily unusable (quota exhausted, circuit open)
+	ServiceUnavailableError = "SERVICE_UNAVAILABLE_ERROR"
 )
 
 // StackTrace is used to check to see if the error has already been wrapped by errors.WithStack
@@ -123,6 +131,43 @@ func NewAuthError(e error) error {
 	)
 }
 
+// NewRateLimitError returns error message related upstream rate limits
+func NewRateLimitError(e error) error {
+	return newError(
+		RateLimitError,
+		fmt.Sprintf("%s", e.Error()),
+		map[string]interface{}{
+			"code": RateLimitError,
+		},
+		e,
+	)
+}
+
+// NewUpstreamError returns error message related upstream provider failures
+func NewUpstreamError(e error) error {
+	return newError(
+		UpstreamError,
+		fmt.Sprintf("%s", e.Error()),
+		map[string]interface{}{
+			"code": UpstreamError,
+		},
+		e,
+	)
+}
+
+// NewServiceUnavailableError returns error message related an unusable
+// upstream provider
+func NewServiceUnavailableError(e error) error {
+	return newError(
+		ServiceUnavailableError,
+		fmt.Sprintf("%s", e.Error()),
+		map[string]interface{}{
+			"code": ServiceUnavailableError,
+		},
+		e,
+	)
+}
+
 type err struct {
 	err        error
 	code       string
@@ -1,7 +1,11 @@
 package model
 
 import (
+	"fmt"
+	"io"
 	"sheng-go-backend/ent"
+	"strconv"
+	"time"
 )
 
 type Profile = ent.Profile
@@ -18,3 +22,94 @@ type ProfileTitleGroup struct {
 	Title string
 	Count int
 }
+
+type ProfileSnapshot = ent.ProfileSnapshot
+
+type ProfilePosition = ent.ProfilePosition
+
+type ProfilePositionWhereInput = ent.ProfilePositionWhereInput
+
+type ProfileEducation = ent.ProfileEducation
+
+type ProfileEducationWhereInput = ent.ProfileEducationWhereInput
+
+type ProfileSkill = ent.ProfileSkill
+
+type ProfileSkillWhereInput = ent.ProfileSkillWhereInput
+
+// ProfileChangeType classifies a change detected between two profile snapshots.
+type ProfileChangeType string
+
+// ProfileChangeType values.
+const (
+	// ProfileChangeTypeJobChange means the current company changed.
+	ProfileChangeTypeJobChange ProfileChangeType = "JOB_CHANGE"
+	// ProfileChangeTypeTitleChange means the current title changed at the same company.
+	ProfileChangeTypeTitleChange ProfileChangeType = "TITLE_CHANGE"
+	// ProfileChangeTypeLocationChange means the city or country changed.
+	ProfileChangeTypeLocationChange ProfileChangeType = "LOCATION_CHANGE"
+	// ProfileChangeTypeHeadlineChange means the headline changed.
+	ProfileChangeTypeHeadlineChange ProfileChangeType = "HEADLINE_CHANGE"
+	// ProfileChangeTypeNewPosition means a position was added.
+	ProfileChangeTypeNewPosition ProfileChangeType = "NEW_POSITION"
+	// ProfileChangeTypePositionEnded means a current position ended or was removed.
+	ProfileChangeTypePositionEnded ProfileChangeType = "POSITION_ENDED"
+	// ProfileChangeTypeNewEducation means an education was added.
+	ProfileChangeTypeNewEducation ProfileChangeType = "NEW_EDUCATION"
+	// ProfileChangeTypeNewSkill means a skill was added.
+	ProfileChangeTypeNewSkill ProfileChangeType = "NEW_SKILL"
+	// ProfileChangeTypeSkillRemoved means a skill was removed.
+	ProfileChangeTypeSkillRemoved ProfileChangeType = "SKILL_REMOVED"
+)
+
+// ProfileChangeTypes lists every ProfileChangeType.
+var ProfileChangeTypes = []ProfileChangeType{
+	ProfileChangeTypeJobChange,
+	ProfileChangeTypeTitleChange,
+	ProfileChangeTypeLocationChange,
+	ProfileChangeTypeHeadlineChange,
+	ProfileChangeTypeNewPosition,
+	ProfileChangeTypePositionEnded,
+	ProfileChangeTypeNewEducation,
+	ProfileChangeTypeNewSkill,
+	ProfileChangeTypeSkillRemoved,
+}
+
+// IsValid reports whether t is a known change type.
+func (t ProfileChangeType) IsValid() bool {
+	for _, v := range ProfileChangeTypes {
+		if t == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (t ProfileChangeType) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(string(t)))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (t *ProfileChangeType) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*t = ProfileChangeType(str)
+	if !t.IsValid() {
+		return fmt.Errorf("%s is not a valid ProfileChangeType", str)
+	}
+	return nil
+}
+
+// ProfileChange is a field-level change detected between two consecutive
+// snapshots of a profile.
+type ProfileChange struct {
+	Type       ProfileChangeType `json:"type"`
+	Field      string            `json:"field"`
+	OldValue   *string           `json:"oldValue,omitempty"`
+	NewValue   *string           `json:"newValue,omitempty"`
+	DetectedAt time.Time         `json:"detectedAt"`
+	SnapshotID ID                `json:"snapshotId"`
+}
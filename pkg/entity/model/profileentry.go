@@ -16,3 +16,34 @@ type ProfileEntryWhereInput = ent.ProfileEntryWhereInput
 type ProfileEntryConnection = ent.ProfileEntryConnection
 
 type ProfileEntryStatus = profileentry.Status
+
+// RequeueProfileEntriesInput selects profile entries to move back to PENDING in bulk.
+type RequeueProfileEntriesInput struct {
+	// Where filters the entries to requeue. COMPLETED and NOT_FOUND entries
+	// only match when it names their status.
+	Where *ProfileEntryWhereInput `json:"where,omitempty"`
+	// OlderThanDays additionally restricts the match to entries last fetched
+	// more than this many days ago.
+	OlderThanDays *int `json:"olderThanDays,omitempty"`
+	// DryRun only counts and previews the matching entries.
+	DryRun bool `json:"dryRun,omitempty"`
+	// All must be set to requeue with an empty filter.
+	All bool `json:"all,omitempty"`
+}
+
+// BulkProfileEntryResult reports the outcome of a bulk profile entry operation.
+type BulkProfileEntryResult struct {
+	AffectedCount int             `json:"affectedCount"`
+	DryRun        bool            `json:"dryRun"`
+	Preview       []*ProfileEntry `json:"preview"`
+}
+
+// ProfileFetchOptions controls whether an on-demand fetch may be served from
+// the response cache.
+type ProfileFetchOptions struct {
+	// MaxAgeSeconds accepts a cached response fetched at most this many
+	// seconds ago. Defaults to profileProvider.cacheTTLMinutes.
+	MaxAgeSeconds *int `json:"maxAgeSeconds,omitempty"`
+	// ForceRefresh always calls the profile provider.
+	ForceRefresh bool `json:"forceRefresh,omitempty"`
+}
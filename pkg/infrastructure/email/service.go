@@ -61,16 +61,69 @@ func (s *EmailService) SendQuotaExceededAlert(callCount, quotaLimit int, month,
 	return s.sendHTML(s.adminEmail, subject, body)
 }
 
-// SendJobCompletionSummary sends a summary email after job execution
+// SendQuotaThresholdAlert sends an email when API usage crosses threshold
+// percent of the monthly quota. exhaustionDate is the forecast date the quota
+// runs out, if any.
+func (s *EmailService) SendQuotaThresholdAlert(
+	threshold int,
+	callCount, quotaLimit int,
+	month, year int,
+	exhaustionDate *time.Time,
+) error {
+	subject := fmt.Sprintf("⚠️ RapidAPI Quota %d%% Used (%d/%d)", threshold, callCount, quotaLimit)
+
+	forecast := "<p>At the current rate the quota will last the month.</p>"
+	if exhaustionDate != nil {
+		forecast = fmt.Sprintf(
+			"<p>At the current rate the quota runs out on <strong>%s</strong>.</p>",
+			exhaustionDate.Format("Jan 02, 2006 at 15:04 MST"),
+		)
+	}
+
+	body := fmt.Sprintf(`
+<html>
+<body>
+<h2>API Quota Threshold Reached</h2>
+<p>Hi Admin,</p>
+
+<p>Your RapidAPI usage has passed %d%% of the monthly quota:</p>
+<ul>
+  <li><strong>Current Calls:</strong> %d / %d</li>
+  <li><strong>Month:</strong> %d/%d</li>
+</ul>
+
+%s
+
+<p>To slow usage down, set daily/hourly caps or even pacing from your dashboard.</p>
+
+<p>Best regards,<br/>Sheng System</p>
+</body>
+</html>
+	`, threshold, callCount, quotaLimit, month, year, forecast)
+
+	return s.sendHTML(s.adminEmail, subject, body)
+}
+
+// SendJobCompletionSummary sends a summary email after job execution. A
+// non-empty schemaDrift is highlighted as a warning.
 func (s *EmailService) SendJobCompletionSummary(
 	jobName string,
 	durationSeconds int,
 	totalProcessed, successful, failed int,
 	apiCallsMade, quotaRemaining int,
 	errors []string,
+	schemaDrift string,
 	nextRunTime time.Time,
 ) error {
 	subject := fmt.Sprintf("✅ %s Job Completed", jobName)
+	driftWarning := ""
+	if schemaDrift != "" {
+		subject = fmt.Sprintf("⚠️ %s Job Completed (schema drift)", jobName)
+		driftWarning = fmt.Sprintf(
+			"<h3>⚠️ Schema Drift Detected</h3><p>The profile provider's response format no longer matches the expected fields: %s</p>",
+			schemaDrift,
+		)
+	}
 
 	errorList := ""
 	if len(errors) > 0 {
@@ -95,6 +148,7 @@ func (s *EmailService) SendJobCompletionSummary(
   <li><strong>Quota Remaining:</strong> %d / 50,000</li>
 </ul>
 
+%s
 %s
 
 <p><strong>Next scheduled run:</strong> %s</p>
@@ -102,7 +156,7 @@ func (s *EmailService) SendJobCompletionSummary(
 <p>Best regards,<br/>Sheng System</p>
 </body>
 </html>
-	`, jobName, durationSeconds, totalProcessed, successful, failed, apiCallsMade, quotaRemaining, errorList, nextRunTime.Format("Jan 02, 2006 at 15:04 MST"))
+	`, jobName, durationSeconds, totalProcessed, successful, failed, apiCallsMade, quotaRemaining, driftWarning, errorList, nextRunTime.Format("Jan 02, 2006 at 15:04 MST"))
 
 	return s.sendHTML(s.adminEmail, subject, body)
 }
@@ -0,0 +1,324 @@
+package profileprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/config"
+	"sync"
+	"time"
+)
+
+// BreakerState is the state of a CircuitBreaker.
+type BreakerState string
+
+// BreakerState values.
+const (
+	// BreakerClosed passes calls through and tracks their outcome.
+	BreakerClosed BreakerState = "CLOSED"
+	// BreakerOpen rejects calls until the open period has passed.
+	BreakerOpen BreakerState = "OPEN"
+	// BreakerHalfOpen lets a single probe call through to test recovery.
+	BreakerHalfOpen BreakerState = "HALF_OPEN"
+)
+
+// Defaults used when profileProvider.breaker* is not configured.
+const (
+	defaultBreakerWindow      = time.Minute
+	defaultBreakerMinRequests = 10
+	defaultBreakerErrorRate   = 0.5
+	defaultBreakerOpenPeriod  = 30 * time.Second
+)
+
+// CircuitOpenError is returned without calling the provider while the
+// breaker is open.
+type CircuitOpenError struct {
+	ErrorRate float64
+	Requests  int
+	Window    time.Duration
+	RetryAt   time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf(
+		"profile provider circuit breaker open: %.0f%% of %d calls failed in the last %v, retry after %s",
+		e.ErrorRate*100,
+		e.Requests,
+		e.Window,
+		e.RetryAt.Format(time.RFC3339),
+	)
+}
+
+// BreakerStatus is a snapshot of a CircuitBreaker for dashboards.
+type BreakerStatus struct {
+	Provider  string
+	State     BreakerState
+	ErrorRate float64
+	Requests  int
+	OpenedAt  *time.Time
+	RetryAt   *time.Time
+}
+
+type callOutcome struct {
+	at     time.Time
+	failed bool
+}
+
+// CircuitBreaker wraps a ProfileProvider and stops calling it while it is
+// degraded. It opens when at least minRequests calls in the sliding window
+// have an error rate of errorRate or more, rejects calls with
+// *CircuitOpenError for openPeriod, then lets one probe through: success
+// closes it, failure opens it again. Not-found answers do not count as
+// failures; rate limits and calls the caller cancelled or timed out are not
+// counted at all, and a probe ending with one leaves the next call to probe
+// again. A transport timeout with the caller still waiting is a failure.
+type CircuitBreaker struct {
+	inner ProfileProvider
+
+	window      time.Duration
+	minRequests int
+	errorRate   float64
+	openPeriod  time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	state    BreakerState
+	outcomes []callOutcome
+	openedAt time.Time
+	tripRate float64
+	tripN    int
+	probing  bool
+}
+
+var _ ProfileProvider = (*CircuitBreaker)(nil)
+
+// NewCircuitBreaker wraps inner using the profileProvider.breaker* settings.
+func NewCircuitBreaker(inner ProfileProvider) *CircuitBreaker {
+	cfg := config.C.ProfileProvider
+
+	cb := &CircuitBreaker{
+		inner:       inner,
+		window:      time.Duration(cfg.BreakerWindowSeconds) * time.Second,
+		minRequests: cfg.BreakerMinRequests,
+		errorRate:   cfg.BreakerErrorRate,
+		openPeriod:  time.Duration(cfg.BreakerOpenSeconds) * time.Second,
+		now:         time.Now,
+		state:       BreakerClosed,
+	}
+	if cb.window <= 0 {
+		cb.window = defaultBreakerWindow
+	}
+	if cb.minRequests <= 0 {
+		cb.minRequests = defaultBreakerMinRequests
+	}
+	if cb.errorRate <= 0 || cb.errorRate > 1 {
+		cb.errorRate = defaultBreakerErrorRate
+	}
+	if cb.openPeriod <= 0 {
+		cb.openPeriod = defaultBreakerOpenPeriod
+	}
+	return cb
+}
+
+// Name returns the wrapped provider's name.
+func (cb *CircuitBreaker) Name() string {
+	return cb.inner.Name()
+}
+
+// FetchProfileByURN calls the wrapped provider unless the breaker is open.
+func (cb *CircuitBreaker) FetchProfileByURN(ctx context.Context, urn string) (*LinkedInProfile, []byte, error) {
+	if err := cb.allow(); err != nil {
+		return nil, nil, err
+	}
+	profile, raw, err := cb.inner.FetchProfileByURN(ctx, urn)
+	cb.record(ctx, err)
+	return profile, raw, err
+}
+
+// FetchProfileByUsername calls the wrapped provider unless the breaker is open.
+func (cb *CircuitBreaker) FetchProfileByUsername(ctx context.Context, username string) (*LinkedInProfile, []byte, error) {
+	if err := cb.allow(); err != nil {
+		return nil, nil, err
+	}
+	profile, raw, err := cb.inner.FetchProfileByUsername(ctx, username)
+	cb.record(ctx, err)
+	return profile, raw, err
+}
+
+// FetchProfileByURL calls the wrapped provider unless the breaker is open.
+func (cb *CircuitBreaker) FetchProfileByURL(ctx context.Context, profileURL string) (*LinkedInProfile, []byte, error) {
+	if err := cb.allow(); err != nil {
+		return nil, nil, err
+	}
+	profile, raw, err := cb.inner.FetchProfileByURL(ctx, profileURL)
+	cb.record(ctx, err)
+	return profile, raw, err
+}
+
+// FetchProfilePosts calls the wrapped provider unless the breaker is open.
+func (cb *CircuitBreaker) FetchProfilePosts(ctx context.Context, username string, start int) ([]map[string]interface{}, []byte, error) {
+	if err := cb.allow(); err != nil {
+		return nil, nil, err
+	}
+	posts, raw, err := cb.inner.FetchProfilePosts(ctx, username, start)
+	cb.record(ctx, err)
+	return posts, raw, err
+}
+
+// Status returns the breaker's current state and sliding-window error rate.
+func (cb *CircuitBreaker) Status() BreakerStatus {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	now := cb.now()
+	cb.advance(now)
+	status := BreakerStatus{
+		Provider: cb.inner.Name(),
+		State:    cb.state,
+	}
+	if cb.state == BreakerClosed {
+		status.ErrorRate, status.Requests = cb.windowRate(now)
+		return status
+	}
+
+	openedAt := cb.openedAt
+	retryAt := cb.openedAt.Add(cb.openPeriod)
+	status.ErrorRate, status.Requests = cb.tripRate, cb.tripN
+	status.OpenedAt, status.RetryAt = &openedAt, &retryAt
+	return status
+}
+
+// allow reports whether a call may go through, moving an expired open breaker
+// to half-open and admitting one probe at a time.
+func (cb *CircuitBreaker) allow() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.advance(cb.now())
+	switch cb.state {
+	case BreakerOpen:
+		return cb.openError()
+	case BreakerHalfOpen:
+		if cb.probing {
+			return cb.openError()
+		}
+		cb.probing = true
+	}
+	return nil
+}
+
+// record counts the outcome of a call and changes state when needed.
+func (cb *CircuitBreaker) record(ctx context.Context, err error) {
+	failed := isBreakerFailure(ctx, err)
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if isUncounted(ctx, err) {
+		// Neither healthy nor degraded; let another call probe
+		cb.probing = false
+		return
+	}
+
+	now := cb.now()
+	if cb.state == BreakerHalfOpen {
+		cb.probing = false
+		if failed {
+			cb.trip(now, cb.tripRate, cb.tripN)
+			return
+		}
+		cb.state = BreakerClosed
+		cb.outcomes = nil
+		return
+	}
+	if cb.state == BreakerOpen {
+		// A call admitted before the breaker opened; ignore its outcome.
+		return
+	}
+
+	cb.outcomes = append(cb.outcomes, callOutcome{at: now, failed: failed})
+	rate, n := cb.windowRate(now)
+	if n >= cb.minRequests && rate >= cb.errorRate {
+		cb.trip(now, rate, n)
+	}
+}
+
+// advance moves an open breaker whose period has passed to half-open. It must
+// be called with mu held.
+func (cb *CircuitBreaker) advance(now time.Time) {
+	if cb.state == BreakerOpen && !now.Before(cb.openedAt.Add(cb.openPeriod)) {
+		cb.state = BreakerHalfOpen
+		cb.probing = false
+	}
+}
+
+// trip opens the breaker. It must be called with mu held.
+func (cb *CircuitBreaker) trip(now time.Time, rate float64, n int) {
+	cb.state = BreakerOpen
+	cb.openedAt = now
+	cb.tripRate = rate
+	cb.tripN = n
+	cb.outcomes = nil
+}
+
+// windowRate prunes outcomes older than the window and returns the error
+// rate and number of calls left in it. It must be called with mu held.
+func (cb *CircuitBreaker) windowRate(now time.Time) (float64, int) {
+	cutoff := now.Add(-cb.window)
+	i := 0
+	for i < len(cb.outcomes) && cb.outcomes[i].at.Before(cutoff) {
+		i++
+	}
+	cb.outcomes = cb.outcomes[i:]
+
+	if len(cb.outcomes) == 0 {
+		return 0, 0
+	}
+	failures := 0
+	for _, o := range cb.outcomes {
+		if o.failed {
+			failures++
+		}
+	}
+	return float64(failures) / float64(len(cb.outcomes)), len(cb.outcomes)
+}
+
+// openError must be called with mu held.
+func (cb *CircuitBreaker) openError() error {
+	return &CircuitOpenError{
+		ErrorRate: cb.tripRate,
+		Requests:  cb.tripN,
+		Window:    cb.window,
+		RetryAt:   cb.openedAt.Add(cb.openPeriod),
+	}
+}
+
+// isBreakerFailure reports whether err, returned for a call made with ctx,
+// means the provider is degraded.
+func isBreakerFailure(ctx context.Context, err error) bool {
+	if err == nil {
+		return false
+	}
+	// Missing profiles, rejected credentials and exhausted quota say nothing
+	// about the provider's health.
+	var notFound *NotFoundError
+	var unauthorized *UnauthorizedError
+	var quotaExhausted *QuotaExhaustedError
+	if errors.As(err, &notFound) || errors.As(err, &unauthorized) || errors.As(err, &quotaExhausted) {
+		return false
+	}
+	return !isUncounted(ctx, err)
+}
+
+// isUncounted reports whether err says nothing about the provider either way:
+// the caller gave up, or we sent more than our plan allows. HTTP client
+// timeouts also match context.DeadlineExceeded, so a context error only
+// counts as the caller giving up when ctx itself is done.
+func isUncounted(ctx context.Context, err error) bool {
+	var rateLimited *RateLimitError
+	if errors.As(err, &rateLimited) {
+		return true
+	}
+	return ctx.Err() != nil &&
+		(errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded))
+}
@@ -0,0 +1,229 @@
+package profileprovider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubProvider returns err from every call and counts the calls.
+type stubProvider struct {
+	err   error
+	calls int
+}
+
+func (s *stubProvider) Name() string { return "stub" }
+
+func (s *stubProvider) FetchProfileByURN(context.Context, string) (*LinkedInProfile, []byte, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, nil, s.err
+	}
+	return &LinkedInProfile{}, nil, nil
+}
+
+func (s *stubProvider) FetchProfileByUsername(ctx context.Context, username string) (*LinkedInProfile, []byte, error) {
+	return s.FetchProfileByURN(ctx, username)
+}
+
+func (s *stubProvider) FetchProfileByURL(ctx context.Context, profileURL string) (*LinkedInProfile, []byte, error) {
+	return s.FetchProfileByURN(ctx, profileURL)
+}
+
+func (s *stubProvider) FetchProfilePosts(context.Context, string, int) ([]map[string]interface{}, []byte, error) {
+	s.calls++
+	return nil, nil, s.err
+}
+
+// httpProvider fetches every profile from url with client.
+type httpProvider struct {
+	stubProvider
+	url    string
+	client *http.Client
+}
+
+func (p *httpProvider) FetchProfileByURN(ctx context.Context, _ string) (*LinkedInProfile, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	return &LinkedInProfile{}, nil, nil
+}
+
+func newTestBreaker(inner ProfileProvider, now *time.Time) *CircuitBreaker {
+	return &CircuitBreaker{
+		inner:       inner,
+		window:      time.Minute,
+		minRequests: 4,
+		errorRate:   0.5,
+		openPeriod:  30 * time.Second,
+		now:         func() time.Time { return *now },
+		state:       BreakerClosed,
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Should open once the window error rate reaches the threshold", func(t *testing.T) {
+		now := time.Now()
+		inner := &stubProvider{err: errors.New("upstream 503")}
+		cb := newTestBreaker(inner, &now)
+
+		for i := 0; i < 4; i++ {
+			_, _, err := cb.FetchProfileByURN(ctx, "urn")
+			assert.EqualError(t, err, "upstream 503")
+		}
+		assert.Equal(t, BreakerOpen, cb.Status().State)
+
+		_, _, err := cb.FetchProfileByURN(ctx, "urn")
+		var openErr *CircuitOpenError
+		assert.True(t, errors.As(err, &openErr))
+		assert.Equal(t, 1.0, openErr.ErrorRate)
+		assert.Equal(t, 4, inner.calls)
+	})
+
+	t.Run("Should not count not-found answers as failures", func(t *testing.T) {
+		now := time.Now()
+		inner := &stubProvider{err: &NotFoundError{URN: "urn"}}
+		cb := newTestBreaker(inner, &now)
+
+		for i := 0; i < 10; i++ {
+			_, _, _ = cb.FetchProfileByURN(ctx, "urn")
+		}
+		status := cb.Status()
+		assert.Equal(t, BreakerClosed, status.State)
+		assert.Equal(t, 0.0, status.ErrorRate)
+		assert.Equal(t, 10, status.Requests)
+	})
+
+	t.Run("Should not count credential or quota errors as failures", func(t *testing.T) {
+		now := time.Now()
+		inner := &stubProvider{err: &UnauthorizedError{StatusCode: 401}}
+		cb := newTestBreaker(inner, &now)
+
+		for i := 0; i < 5; i++ {
+			_, _, _ = cb.FetchProfileByURN(ctx, "urn")
+		}
+		inner.err = &QuotaExhaustedError{StatusCode: 429}
+		for i := 0; i < 5; i++ {
+			_, _, _ = cb.FetchProfileByURN(ctx, "urn")
+		}
+		assert.Equal(t, BreakerClosed, cb.Status().State)
+		assert.Equal(t, 0.0, cb.Status().ErrorRate)
+	})
+
+	t.Run("Should not count rate limits", func(t *testing.T) {
+		now := time.Now()
+		inner := &stubProvider{err: &RateLimitError{StatusCode: 429}}
+		cb := newTestBreaker(inner, &now)
+
+		for i := 0; i < 10; i++ {
+			_, _, _ = cb.FetchProfileByURN(ctx, "urn")
+		}
+		status := cb.Status()
+		assert.Equal(t, BreakerClosed, status.State)
+		assert.Zero(t, status.Requests)
+	})
+
+	t.Run("Should count transport timeouts as failures", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+		}))
+		defer server.Close()
+
+		now := time.Now()
+		inner := &httpProvider{url: server.URL, client: &http.Client{Timeout: 50 * time.Millisecond}}
+		cb := newTestBreaker(inner, &now)
+
+		for i := 0; i < 4; i++ {
+			_, _, err := cb.FetchProfileByURN(ctx, "urn")
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		}
+		assert.Equal(t, BreakerOpen, cb.Status().State)
+	})
+
+	t.Run("Should forget failures that leave the sliding window", func(t *testing.T) {
+		now := time.Now()
+		inner := &stubProvider{err: errors.New("boom")}
+		cb := newTestBreaker(inner, &now)
+
+		for i := 0; i < 3; i++ {
+			_, _, _ = cb.FetchProfileByURN(ctx, "urn")
+		}
+		now = now.Add(2 * time.Minute)
+		_, _, _ = cb.FetchProfileByURN(ctx, "urn")
+
+		assert.Equal(t, BreakerClosed, cb.Status().State)
+	})
+
+	t.Run("Should close after a successful half-open probe", func(t *testing.T) {
+		now := time.Now()
+		inner := &stubProvider{err: errors.New("boom")}
+		cb := newTestBreaker(inner, &now)
+		for i := 0; i < 4; i++ {
+			_, _, _ = cb.FetchProfileByURN(ctx, "urn")
+		}
+
+		now = now.Add(31 * time.Second)
+		assert.Equal(t, BreakerHalfOpen, cb.Status().State)
+
+		inner.err = nil
+		_, _, err := cb.FetchProfileByURN(ctx, "urn")
+		assert.NoError(t, err)
+		assert.Equal(t, BreakerClosed, cb.Status().State)
+	})
+
+	t.Run("Should stay half-open after a cancelled probe", func(t *testing.T) {
+		now := time.Now()
+		inner := &stubProvider{err: errors.New("boom")}
+		cb := newTestBreaker(inner, &now)
+		for i := 0; i < 4; i++ {
+			_, _, _ = cb.FetchProfileByURN(ctx, "urn")
+		}
+
+		now = now.Add(31 * time.Second)
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		inner.err = context.Canceled
+		_, _, err := cb.FetchProfileByURN(cancelled, "urn")
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, BreakerHalfOpen, cb.Status().State)
+
+		// The next call probes again
+		inner.err = errors.New("boom")
+		_, _, err = cb.FetchProfileByURN(ctx, "urn")
+		assert.EqualError(t, err, "boom")
+		assert.Equal(t, BreakerOpen, cb.Status().State)
+	})
+
+	t.Run("Should reopen after a failed half-open probe", func(t *testing.T) {
+		now := time.Now()
+		inner := &stubProvider{err: errors.New("boom")}
+		cb := newTestBreaker(inner, &now)
+		for i := 0; i < 4; i++ {
+			_, _, _ = cb.FetchProfileByURN(ctx, "urn")
+		}
+
+		now = now.Add(31 * time.Second)
+		_, _, err := cb.FetchProfileByURN(ctx, "urn")
+		assert.EqualError(t, err, "boom")
+
+		status := cb.Status()
+		assert.Equal(t, BreakerOpen, status.State)
+		assert.Equal(t, now.Add(30*time.Second), *status.RetryAt)
+	})
+}
@@ -0,0 +1,25 @@
+package profileprovider
+
+import "context"
+
+type callerKey struct{}
+
+// Caller identifies who provider calls are made for, so per-call usage can
+// be broken down by caller and job run.
+type Caller struct {
+	// Name is the kind of caller, e.g. its quota budget
+	Name string
+	// RunID groups the calls of one job run; empty outside jobs
+	RunID string
+}
+
+// WithCaller returns a copy of ctx whose provider calls are made for caller.
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns the caller set by WithCaller, or the zero Caller.
+func CallerFromContext(ctx context.Context) Caller {
+	caller, _ := ctx.Value(callerKey{}).(Caller)
+	return caller
+}
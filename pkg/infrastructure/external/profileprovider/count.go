@@ -0,0 +1,35 @@
+package profileprovider
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type callCountKey struct{}
+
+// CallCount counts the provider calls billed while serving a context, so
+// callers charge their quota exactly what the vendor counted, retries and
+// failed responses included.
+type CallCount struct {
+	n atomic.Int64
+}
+
+// WithCallCount returns a copy of ctx whose billed provider calls are counted
+// in the returned CallCount.
+func WithCallCount(ctx context.Context) (context.Context, *CallCount) {
+	count := &CallCount{}
+	return context.WithValue(ctx, callCountKey{}, count), count
+}
+
+// Calls returns the number of calls counted so far.
+func (c *CallCount) Calls() int {
+	return int(c.n.Load())
+}
+
+// CountCall adds one billed call to the CallCount set on ctx, if any.
+// Providers call it for every request the vendor answered.
+func CountCall(ctx context.Context) {
+	if count, ok := ctx.Value(callCountKey{}).(*CallCount); ok {
+		count.n.Add(1)
+	}
+}
@@ -0,0 +1,88 @@
+package profileprovider
+
+import (
+	"fmt"
+	"time"
+)
+
+// RateLimitError represents a provider rate limit response (e.g., HTTP 429).
+// The call can be retried after RetryAfter.
+type RateLimitError struct {
+	RetryAfter time.Duration
+	StatusCode int
+	Message    string
+}
+
+func (e *RateLimitError) Error() string {
+	if e == nil {
+		return ""
+	}
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limited (status=%d, retry after %v): %s", e.StatusCode, e.RetryAfter, e.Message)
+	}
+	return fmt.Sprintf("rate limited (status=%d): %s", e.StatusCode, e.Message)
+}
+
+// NotFoundError represents a missing profile (e.g., HTTP 404)
+type NotFoundError struct {
+	URN     string
+	Message string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("profile not found for URN %s: %s", e.URN, e.Message)
+}
+
+// UnauthorizedError means the provider rejected our credentials (e.g., HTTP
+// 401/403 for a bad or unsubscribed API key). Retrying will not help.
+type UnauthorizedError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *UnauthorizedError) Error() string {
+	return fmt.Sprintf("provider rejected credentials (status=%d): %s", e.StatusCode, e.Message)
+}
+
+// QuotaExhaustedError means the provider plan quota is used up, so no call
+// will succeed until it resets.
+type QuotaExhaustedError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *QuotaExhaustedError) Error() string {
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("provider quota exhausted: %s", e.Message)
+	}
+	return fmt.Sprintf("provider quota exhausted (status=%d): %s", e.StatusCode, e.Message)
+}
+
+// InvalidResponseError means the provider answered successfully but the body
+// could not be understood.
+type InvalidResponseError struct {
+	Message string
+	Err     error
+}
+
+func (e *InvalidResponseError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("invalid provider response: %s: %v", e.Message, e.Err)
+	}
+	return fmt.Sprintf("invalid provider response: %s", e.Message)
+}
+
+func (e *InvalidResponseError) Unwrap() error {
+	return e.Err
+}
+
+// UpstreamError is a provider-side failure: a 5xx, an unexpected status, or
+// an error reported in a success wrapper. It may be transient.
+type UpstreamError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *UpstreamError) Error() string {
+	return fmt.Sprintf("provider error (status=%d): %s", e.StatusCode, e.Message)
+}
@@ -0,0 +1,46 @@
+// Package profileprovider defines the vendor-neutral contract ProfileFetcher
+// uses to fetch LinkedIn data, so data vendors (RapidAPI today) and local
+// fakes can be swapped via config without touching the fetch workflow.
+package profileprovider
+
+import (
+	"context"
+)
+
+// ProfileProvider fetches LinkedIn profiles and posts from a data vendor.
+// Implementations report failures with the error types in errors.go so
+// callers can act on each category. The raw response body is returned
+// alongside the parsed result (and with errors, when available) so it can be
+// archived.
+type ProfileProvider interface {
+	// Name identifies the provider in logs, e.g. "rapidapi".
+	Name() string
+	FetchProfileByURN(ctx context.Context, urn string) (*LinkedInProfile, []byte, error)
+	FetchProfileByUsername(ctx context.Context, username string) (*LinkedInProfile, []byte, error)
+	FetchProfileByURL(ctx context.Context, profileURL string) (*LinkedInProfile, []byte, error)
+	// FetchProfilePosts returns one page of posts starting at offset start.
+	FetchProfilePosts(ctx context.Context, username string, start int) ([]map[string]interface{}, []byte, error)
+}
+
+// LinkedInProfile represents a full LinkedIn profile
+type LinkedInProfile struct {
+	URN           string                   `json:"urn"`
+	Username      string                   `json:"username"`
+	FirstName     string                   `json:"firstName"`
+	LastName      string                   `json:"lastName"`
+	Headline      string                   `json:"headline"`
+	Geo           *GeoData                 `json:"geo"`
+	Educations    []map[string]interface{} `json:"educations"`
+	FullPositions []map[string]interface{} `json:"fullPositions"`
+	Skills        []map[string]interface{} `json:"skills"`
+}
+
+// GeoData represents geographic information
+type GeoData struct {
+	Country     string `json:"country"`
+	City        string `json:"city"`
+	Full        string `json:"full"`
+	CountryCode string `json:"countryCode"`
+	CountryName string `json:"country_name"` // Keep for backward compatibility
+	CityName    string `json:"city_name"`    // Keep for backward compatibility
+}
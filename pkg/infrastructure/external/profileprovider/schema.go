@@ -0,0 +1,221 @@
+package profileprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sheng-go-backend/config"
+	"sort"
+	"strings"
+)
+
+// defaultSchemaDriftMinShare is the share of a run's payloads a field must be
+// unknown or missing in before the run is flagged, when
+// profileProvider.schemaDriftMinShare is not set.
+const defaultSchemaDriftMinShare = 0.5
+
+// envelopeFields are the keys of the wrapped {success, message, data} format.
+var envelopeFields = map[string]bool{"success": true, "message": true, "data": true}
+
+// optionalProfileFields are profile fields the vendor is known to send that
+// we do not parse. Extend with profileProvider.schemaKnownFields.
+var optionalProfileFields = []string{
+	"id", "isCreator", "isOpenToWork", "isHiring", "isPremium", "isTopVoice",
+	"profilePicture", "profilePictures", "backgroundImage", "summary",
+	"languages", "position", "certifications", "honors", "projects",
+	"volunteering", "courses", "publications", "patents",
+	"supportedLocales", "multiLocaleFirstName", "multiLocaleLastName",
+	"multiLocaleHeadline", "givenRecommendation", "givenRecommendationCount",
+	"receivedRecommendation", "receivedRecommendationCount",
+}
+
+// SchemaCheck is how one raw profile payload differs from the expected field
+// inventory.
+type SchemaCheck struct {
+	// Unknown lists fields the inventory does not know, e.g. "geo.region".
+	Unknown []string
+	// Missing lists parsed fields that are absent or null.
+	Missing []string
+}
+
+// Drifted reports whether the payload has any unknown or missing field.
+func (c SchemaCheck) Drifted() bool {
+	return len(c.Unknown) > 0 || len(c.Missing) > 0
+}
+
+// CheckProfileSchema checks a raw profile payload, wrapped or direct, against
+// the fields LinkedInProfile parses (required) plus the known optional ones.
+func CheckProfileSchema(raw []byte) SchemaCheck {
+	var check SchemaCheck
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		check.Missing = append(check.Missing, "data")
+		return check
+	}
+
+	// Wrapped format: check the envelope, then the profile in data
+	if _, wrapped := payload["success"]; wrapped {
+		for key := range payload {
+			if !envelopeFields[key] {
+				check.Unknown = append(check.Unknown, "envelope."+key)
+			}
+		}
+		data := payload["data"]
+		payload = nil
+		if err := json.Unmarshal(data, &payload); err != nil || payload == nil {
+			check.Missing = append(check.Missing, "data")
+			sort.Strings(check.Unknown)
+			return check
+		}
+	}
+
+	known := knownProfileFields()
+	for key := range payload {
+		if !known[key] {
+			check.Unknown = append(check.Unknown, key)
+		}
+	}
+	for _, field := range jsonFields(reflect.TypeOf(LinkedInProfile{})) {
+		if isNull(payload[field]) {
+			check.Missing = append(check.Missing, field)
+		}
+	}
+
+	// geo is the only nested object we parse field by field
+	var geo map[string]json.RawMessage
+	if json.Unmarshal(payload["geo"], &geo) == nil {
+		geoFields := map[string]bool{}
+		for _, field := range jsonFields(reflect.TypeOf(GeoData{})) {
+			geoFields[field] = true
+		}
+		for key := range geo {
+			if !geoFields[key] {
+				check.Unknown = append(check.Unknown, "geo."+key)
+			}
+		}
+	}
+
+	sort.Strings(check.Unknown)
+	sort.Strings(check.Missing)
+	return check
+}
+
+// SchemaDriftStats counts unknown and missing fields over a run's payloads.
+// It is not safe for concurrent use.
+type SchemaDriftStats struct {
+	Checked int
+	Drifted int
+	Unknown map[string]int
+	Missing map[string]int
+}
+
+// Record adds one payload's check to the counts.
+func (s *SchemaDriftStats) Record(c SchemaCheck) {
+	if s.Unknown == nil {
+		s.Unknown = map[string]int{}
+		s.Missing = map[string]int{}
+	}
+	s.Checked++
+	if c.Drifted() {
+		s.Drifted++
+	}
+	for _, field := range c.Unknown {
+		s.Unknown[field]++
+	}
+	for _, field := range c.Missing {
+		s.Missing[field]++
+	}
+}
+
+// Detected reports whether some field was unknown or missing in at least
+// profileProvider.schemaDriftMinShare of the checked payloads.
+func (s *SchemaDriftStats) Detected() bool {
+	if s.Checked == 0 {
+		return false
+	}
+	minShare := config.C.ProfileProvider.SchemaDriftMinShare
+	if minShare <= 0 {
+		minShare = defaultSchemaDriftMinShare
+	}
+	for _, counts := range []map[string]int{s.Unknown, s.Missing} {
+		for _, n := range counts {
+			if float64(n)/float64(s.Checked) >= minShare {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Summary describes the drift in one line, e.g.
+// "schema drift in 40/40 payloads: missing geo (40); unknown location (40)".
+func (s *SchemaDriftStats) Summary() string {
+	parts := []string{}
+	if len(s.Missing) > 0 {
+		parts = append(parts, "missing "+formatCounts(s.Missing))
+	}
+	if len(s.Unknown) > 0 {
+		parts = append(parts, "unknown "+formatCounts(s.Unknown))
+	}
+	return fmt.Sprintf("schema drift in %d/%d payloads: %s", s.Drifted, s.Checked, strings.Join(parts, "; "))
+}
+
+// Map returns the counts for storing as JSON.
+func (s *SchemaDriftStats) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"checked": s.Checked,
+		"drifted": s.Drifted,
+		"unknown": s.Unknown,
+		"missing": s.Missing,
+	}
+}
+
+func knownProfileFields() map[string]bool {
+	known := map[string]bool{}
+	for _, field := range jsonFields(reflect.TypeOf(LinkedInProfile{})) {
+		known[field] = true
+	}
+	for _, field := range optionalProfileFields {
+		known[field] = true
+	}
+	for _, field := range config.C.ProfileProvider.SchemaKnownFields {
+		known[field] = true
+	}
+	return known
+}
+
+// jsonFields returns the JSON names of t's fields.
+func jsonFields(t reflect.Type) []string {
+	fields := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
+func isNull(v json.RawMessage) bool {
+	return len(v) == 0 || string(v) == "null"
+}
+
+// formatCounts renders counts as "a (3), b (1)", most frequent first.
+func formatCounts(counts map[string]int) string {
+	fields := make([]string, 0, len(counts))
+	for field := range counts {
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		if counts[fields[i]] != counts[fields[j]] {
+			return counts[fields[i]] > counts[fields[j]]
+		}
+		return fields[i] < fields[j]
+	})
+	parts := make([]string, len(fields))
+	for i, field := range fields {
+		parts[i] = fmt.Sprintf("%s (%d)", field, counts[field])
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,83 @@
+package profileprovider
+
+import (
+	"sheng-go-backend/config"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const fullProfile = `{
+	"urn": "ACoAA1", "username": "jane", "firstName": "Jane", "lastName": "Doe",
+	"headline": "Engineer", "geo": {"country": "US", "city": "Austin", "full": "Austin, US"},
+	"educations": [], "fullPositions": [], "skills": [], "summary": "hi"
+}`
+
+func TestCheckProfileSchema(t *testing.T) {
+	t.Run("Should accept the wrapped and direct formats", func(t *testing.T) {
+		assert.False(t, CheckProfileSchema([]byte(fullProfile)).Drifted())
+		assert.False(t, CheckProfileSchema([]byte(`{"success":true,"message":"","data":`+fullProfile+`}`)).Drifted())
+	})
+
+	t.Run("Should report unknown fields", func(t *testing.T) {
+		raw := `{"success":true,"message":"","data":{` +
+			`"urn":"u","username":"jane","firstName":"J","lastName":"D","headline":"h",` +
+			`"geo":{"city":"Austin","region":"TX"},"educations":[],"fullPositions":[],"skills":[],` +
+			`"experience":[]},"meta":{}}`
+
+		check := CheckProfileSchema([]byte(raw))
+
+		assert.Equal(t, []string{"envelope.meta", "experience", "geo.region"}, check.Unknown)
+		assert.Empty(t, check.Missing)
+	})
+
+	t.Run("Should report missing and null fields", func(t *testing.T) {
+		raw := `{"urn":"u","username":"jane","firstName":"J","lastName":"D","headline":null,` +
+			`"educations":[],"skills":[]}`
+
+		check := CheckProfileSchema([]byte(raw))
+
+		assert.Equal(t, []string{"fullPositions", "geo", "headline"}, check.Missing)
+	})
+
+	t.Run("Should report missing data in the wrapped format", func(t *testing.T) {
+		check := CheckProfileSchema([]byte(`{"success":true,"message":"","data":null}`))
+		assert.Equal(t, []string{"data"}, check.Missing)
+
+		check = CheckProfileSchema([]byte(`<html>`))
+		assert.Equal(t, []string{"data"}, check.Missing)
+	})
+
+	t.Run("Should accept configured extra fields", func(t *testing.T) {
+		prev := config.C.ProfileProvider.SchemaKnownFields
+		config.C.ProfileProvider.SchemaKnownFields = []string{"experience"}
+		defer func() { config.C.ProfileProvider.SchemaKnownFields = prev }()
+
+		raw := fullProfile[:len(fullProfile)-1] + `, "experience": []}`
+		assert.False(t, CheckProfileSchema([]byte(raw)).Drifted())
+	})
+}
+
+func TestSchemaDriftStats(t *testing.T) {
+	t.Run("Should flag drift once a field reaches the share threshold", func(t *testing.T) {
+		var stats SchemaDriftStats
+		stats.Record(SchemaCheck{})
+		stats.Record(SchemaCheck{})
+		stats.Record(SchemaCheck{Missing: []string{"geo"}})
+		assert.False(t, stats.Detected())
+
+		stats.Record(SchemaCheck{Missing: []string{"geo"}, Unknown: []string{"location"}})
+		assert.True(t, stats.Detected())
+		assert.Equal(t, 4, stats.Checked)
+		assert.Equal(t, 2, stats.Drifted)
+		assert.Equal(t,
+			"schema drift in 2/4 payloads: missing geo (2); unknown location (1)",
+			stats.Summary(),
+		)
+	})
+
+	t.Run("Should not flag an empty run", func(t *testing.T) {
+		var stats SchemaDriftStats
+		assert.False(t, stats.Detected())
+	})
+}
@@ -0,0 +1,33 @@
+// Package external wires the third-party data vendors used by the backend.
+package external
+
+import (
+	"fmt"
+	"sheng-go-backend/config"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
+	"sheng-go-backend/pkg/infrastructure/external/rapidapi"
+	"strings"
+)
+
+// NewProfileProvider returns the circuit-broken provider named by profileProvider.name, RapidAPI by default.
+// The rolling caps count calls from the call log, so calls must be recorded when caps are configured.
+func NewProfileProvider(keyQuota rapidapi.KeyQuota, calls rapidapi.CallRecorder) (profileprovider.ProfileProvider, error) {
+	name := strings.ToLower(strings.TrimSpace(config.C.ProfileProvider.Name))
+	switch name {
+	case "", rapidapi.ProviderName:
+		cfg := config.C.RapidAPI
+		if calls == nil && (cfg.DailyCap > 0 || cfg.HourlyCap > 0 || cfg.EvenPacing) {
+			return nil, fmt.Errorf("rapidapi caps are configured but no call recorder was given to count calls against them")
+		}
+		client := rapidapi.NewLinkedInClient()
+		if keyQuota != nil {
+			client.SetKeyQuota(keyQuota)
+		}
+		if calls != nil {
+			client.SetCallRecorder(calls)
+		}
+		return profileprovider.NewCircuitBreaker(client), nil
+	default:
+		return nil, fmt.Errorf("unknown profile provider %q", config.C.ProfileProvider.Name)
+	}
+}
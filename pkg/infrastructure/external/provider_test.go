@@ -0,0 +1,49 @@
+package external
+
+import (
+	"sheng-go-backend/config"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
+	"sheng-go-backend/pkg/infrastructure/external/rapidapi"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProfileProvider(t *testing.T) {
+	original := config.C.ProfileProvider.Name
+	originalCaps := config.C.RapidAPI.DailyCap
+	t.Cleanup(func() {
+		config.C.ProfileProvider.Name = original
+		config.C.RapidAPI.DailyCap = originalCaps
+	})
+
+	t.Run("Should default to RapidAPI when no provider is configured", func(t *testing.T) {
+		config.C.ProfileProvider.Name = ""
+		provider, err := NewProfileProvider(nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, rapidapi.ProviderName, provider.Name())
+	})
+
+	t.Run("Should select RapidAPI by name", func(t *testing.T) {
+		config.C.ProfileProvider.Name = " RapidAPI "
+		provider, err := NewProfileProvider(nil, nil)
+		assert.NoError(t, err)
+		assert.IsType(t, &profileprovider.CircuitBreaker{}, provider)
+		assert.Equal(t, rapidapi.ProviderName, provider.Name())
+	})
+
+	t.Run("Should require a call recorder when caps are configured", func(t *testing.T) {
+		config.C.ProfileProvider.Name = ""
+		config.C.RapidAPI.DailyCap = 100
+		provider, err := NewProfileProvider(nil, nil)
+		assert.Error(t, err)
+		assert.Nil(t, provider)
+	})
+
+	t.Run("Should reject an unknown provider", func(t *testing.T) {
+		config.C.ProfileProvider.Name = "proxycurl"
+		provider, err := NewProfileProvider(nil, nil)
+		assert.Error(t, err)
+		assert.Nil(t, provider)
+	})
+}
@@ -0,0 +1,51 @@
+package rapidapi
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// CallRecorder keeps a ledger of every request sent to RapidAPI.
+// apiquota.QuotaManager implements it; without one requests are only logged.
+type CallRecorder interface {
+	RecordCall(ctx context.Context, call Call) error
+}
+
+// Call is one request sent to RapidAPI with one key.
+type Call struct {
+	Endpoint string
+	KeyLabel string
+	// ID is the profile URN, username or URL requested
+	ID string
+	// StatusCode is 0 when no response arrived
+	StatusCode int
+	Latency    time.Duration
+	Bytes      int
+	// Counted reports whether the call counted against the quota, which
+	// every answered call does: the pool through profileprovider.CountCall
+	// and the key through KeyQuota
+	Counted bool
+}
+
+// SetCallRecorder makes the client record every request it sends.
+func (c *LinkedInClient) SetCallRecorder(r CallRecorder) {
+	c.calls = r
+}
+
+// recordCallTimeout bounds how long recording a call may take once the
+// request's own context is gone.
+const recordCallTimeout = 10 * time.Second
+
+func (c *LinkedInClient) recordCall(ctx context.Context, call Call) {
+	if c.calls == nil {
+		return
+	}
+	// The rolling caps count calls from this ledger, so record the call even
+	// when the caller gave up on it
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recordCallTimeout)
+	defer cancel()
+	if err := c.calls.RecordCall(ctx, call); err != nil {
+		log.Printf("Failed to record RapidAPI call to %s with key %s: %v", call.Endpoint, call.KeyLabel, err)
+	}
+}
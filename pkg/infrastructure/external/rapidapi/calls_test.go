@@ -0,0 +1,114 @@
+package rapidapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCallRecorder keeps recorded calls in memory, failing like a database
+// write would when the context is done.
+type fakeCallRecorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (f *fakeCallRecorder) RecordCall(ctx context.Context, call Call) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, call)
+	return nil
+}
+
+func TestLinkedInClient_RecordCall(t *testing.T) {
+	t.Run("Should record every request including rotated ones", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-RapidAPI-Key") == "k1" {
+				w.Header().Set("Retry-After", "30")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Write([]byte(`{"username":"jane"}`))
+		}))
+		defer server.Close()
+
+		recorder := &fakeCallRecorder{}
+		client := newTestClient(server.URL, "a=k1", "b=k2")
+		client.SetKeyQuota(newFakeKeyQuota())
+		client.SetCallRecorder(recorder)
+
+		_, _, err := client.FetchProfileByURN(context.Background(), "urn")
+		assert.NoError(t, err)
+
+		if assert.Len(t, recorder.calls, 2) {
+			first, second := recorder.calls[0], recorder.calls[1]
+			assert.Equal(t, EndpointProfile, first.Endpoint)
+			assert.Equal(t, "a", first.KeyLabel)
+			assert.Equal(t, "urn", first.ID)
+			assert.Equal(t, http.StatusTooManyRequests, first.StatusCode)
+			assert.True(t, first.Counted)
+			assert.Equal(t, "b", second.KeyLabel)
+			assert.True(t, second.Counted)
+			assert.Equal(t, http.StatusOK, second.StatusCode)
+			assert.Equal(t, len(`{"username":"jane"}`), second.Bytes)
+		}
+	})
+
+	t.Run("Should count answered calls without per-key tracking", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"username":"jane"}`))
+		}))
+		defer server.Close()
+
+		recorder := &fakeCallRecorder{}
+		client := newTestClient(server.URL, "a=k1")
+		client.SetCallRecorder(recorder)
+
+		_, _, err := client.FetchProfileByURN(context.Background(), "urn")
+		assert.NoError(t, err)
+		if assert.Len(t, recorder.calls, 1) {
+			assert.True(t, recorder.calls[0].Counted)
+		}
+	})
+
+	t.Run("Should record a call the caller gave up on", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cancel()
+			w.Write([]byte(`{"username":"jane"}`))
+		}))
+		defer server.Close()
+
+		recorder := &fakeCallRecorder{}
+		client := newTestClient(server.URL, "a=k1")
+		client.SetCallRecorder(recorder)
+
+		_, _, _ = client.FetchProfileByURN(ctx, "urn")
+		assert.Len(t, recorder.calls, 1)
+	})
+
+	t.Run("Should record a request that got no response as uncounted", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+		server.Close()
+
+		recorder := &fakeCallRecorder{}
+		client := newTestClient(server.URL, "a=k1")
+		client.SetKeyQuota(newFakeKeyQuota())
+		client.SetCallRecorder(recorder)
+
+		_, _, err := client.FetchProfileByURN(context.Background(), "urn")
+		assert.Error(t, err)
+
+		if assert.Len(t, recorder.calls, 1) {
+			assert.Zero(t, recorder.calls[0].StatusCode)
+			assert.False(t, recorder.calls[0].Counted)
+		}
+	})
+}
@@ -3,38 +3,38 @@ package rapidapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"sheng-go-backend/config"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
 	"strconv"
 	"strings"
 	"time"
 )
 
-// LinkedInProfile represents the full LinkedIn profile response from RapidAPI
-type LinkedInProfile struct {
-	URN           string                   `json:"urn"`
-	Username      string                   `json:"username"`
-	FirstName     string                   `json:"firstName"`
-	LastName      string                   `json:"lastName"`
-	Headline      string                   `json:"headline"`
-	Geo           *GeoData                 `json:"geo"`
-	Educations    []map[string]interface{} `json:"educations"`
-	FullPositions []map[string]interface{} `json:"fullPositions"`
-	Skills        []map[string]interface{} `json:"skills"`
-}
+// Profile data types and errors are shared by all profile providers.
+type (
+	LinkedInProfile      = profileprovider.LinkedInProfile
+	GeoData              = profileprovider.GeoData
+	RateLimitError       = profileprovider.RateLimitError
+	NotFoundError        = profileprovider.NotFoundError
+	UnauthorizedError    = profileprovider.UnauthorizedError
+	QuotaExhaustedError  = profileprovider.QuotaExhaustedError
+	InvalidResponseError = profileprovider.InvalidResponseError
+	UpstreamError        = profileprovider.UpstreamError
+)
 
-// GeoData represents geographic information
-type GeoData struct {
-	Country     string `json:"country"`
-	City        string `json:"city"`
-	Full        string `json:"full"`
-	CountryCode string `json:"countryCode"`
-	CountryName string `json:"country_name"` // Keep for backward compatibility
-	CityName    string `json:"city_name"`    // Keep for backward compatibility
-}
+// ProviderName selects this package as the profile provider in config.
+const ProviderName = "rapidapi"
+
+// defaultHost and defaultPostsURL are used when rapidapi.baseURL is not set.
+const (
+	defaultHost     = "real-time-people-company-data.p.rapidapi.com"
+	defaultPostsURL = "https://" + defaultHost + "/get-profile-posts"
+)
 
 // APIResponse represents the wrapper response from RapidAPI
 type APIResponse struct {
@@ -50,36 +50,13 @@ type APIErrorResponse struct {
 	Data    interface{} `json:"data"`
 }
 
-// RateLimitError represents a RapidAPI rate limit response (e.g., HTTP 429)
-type RateLimitError struct {
-	RetryAfter time.Duration
-	StatusCode int
-	Message    string
-}
-
-func (e *RateLimitError) Error() string {
-	if e == nil {
-		return ""
-	}
-	if e.RetryAfter > 0 {
-		return fmt.Sprintf("rate limited (status=%d, retry after %v): %s", e.StatusCode, e.RetryAfter, e.Message)
-	}
-	return fmt.Sprintf("rate limited (status=%d): %s", e.StatusCode, e.Message)
-}
-
-// NotFoundError represents a 404 response from RapidAPI (profile not found)
-type NotFoundError struct {
-	URN     string
-	Message string
-}
-
-func (e *NotFoundError) Error() string {
-	return fmt.Sprintf("profile not found for URN %s: %s", e.URN, e.Message)
-}
-
-// LinkedInClient handles RapidAPI LinkedIn requests
+// LinkedInClient handles RapidAPI LinkedIn requests. It implements
+// profileprovider.ProfileProvider.
 type LinkedInClient struct {
-	apiKey     string
+	keys       *keyPool
+	keyQuota   KeyQuota
+	calls      CallRecorder
+	limiters   *limiterSet
 	baseURL    string
 	httpClient *http.Client
 }
@@ -93,13 +70,44 @@ func NewLinkedInClient() *LinkedInClient {
 		timeout = 60 * time.Second // Increased default timeout to 60 seconds
 	}
 
-	return &LinkedInClient{
-		apiKey:  cfg.APIKey,
-		baseURL: cfg.BaseURL,
+	client := &LinkedInClient{
+		keys:     newKeyPool(cfg.APIKeys, cfg.APIKey),
+		limiters: sharedLimiters,
+		baseURL:  cfg.BaseURL,
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
 	}
+
+	// Record or replay HTTP fixtures (rapidapi.fixtureMode)
+	if cfg.FixtureMode != "" {
+		transport, err := NewFixtureTransport(cfg.FixtureMode, cfg.FixtureDir, nil)
+		if err != nil {
+			log.Fatalf("invalid rapidapi fixture config: %v", err)
+		}
+		client.SetTransport(transport)
+		log.Printf("RapidAPI fixtures: mode=%s dir=%s", cfg.FixtureMode, cfg.FixtureDir)
+	}
+
+	return client
+}
+
+// SetTransport replaces the HTTP transport, e.g. with a FixtureTransport.
+func (c *LinkedInClient) SetTransport(rt http.RoundTripper) {
+	c.httpClient.Transport = rt
+}
+
+var _ profileprovider.ProfileProvider = (*LinkedInClient)(nil)
+
+// SetKeyQuota makes the client track usage per key and skip keys whose
+// monthly quota is exhausted.
+func (c *LinkedInClient) SetKeyQuota(q KeyQuota) {
+	c.keyQuota = q
+}
+
+// Name returns the provider name used in config and logs.
+func (c *LinkedInClient) Name() string {
+	return ProviderName
 }
 
 // parseAPIResponse handles the different response formats from RapidAPI
@@ -126,18 +134,24 @@ func parseAPIResponse(body []byte) (*LinkedInProfile, []byte, error) {
 						Message: apiResp.Message,
 					}
 				}
-				return nil, body, fmt.Errorf("API error: %s", apiResp.Message)
+				return nil, body, &UpstreamError{
+					StatusCode: http.StatusOK,
+					Message:    apiResp.Message,
+				}
 			}
 
 			// Success response with data wrapper
 			if apiResp.Data == nil {
-				return nil, body, fmt.Errorf("API error: success=true but data is null")
+				return nil, body, &InvalidResponseError{Message: "success=true but data is null"}
 			}
 
 			// Parse the profile from the data field
 			var profile LinkedInProfile
 			if err := json.Unmarshal(*apiResp.Data, &profile); err != nil {
-				return nil, body, fmt.Errorf("failed to parse profile from data field: %w", err)
+				return nil, body, &InvalidResponseError{
+					Message: "failed to parse profile from data field",
+					Err:     err,
+				}
 			}
 
 			log.Printf("RapidAPI Success Response: parsed profile with data wrapper, username=%s", profile.Username)
@@ -148,7 +162,10 @@ func parseAPIResponse(body []byte) (*LinkedInProfile, []byte, error) {
 	// If not a wrapped response, try to parse directly as profile data
 	var profile LinkedInProfile
 	if err := json.Unmarshal(body, &profile); err != nil {
-		return nil, body, fmt.Errorf("failed to parse response as profile: %w", err)
+		return nil, body, &InvalidResponseError{
+			Message: "failed to parse response as profile",
+			Err:     err,
+		}
 	}
 
 	log.Printf("RapidAPI Success Response: parsed direct profile data, username=%s", profile.Username)
@@ -160,80 +177,7 @@ func (c *LinkedInClient) FetchProfileByURN(
 	ctx context.Context,
 	urn string,
 ) (*LinkedInProfile, []byte, error) {
-	// Construct the API URL - adjust based on actual RapidAPI endpoint
-
-	// Create request
-	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL, nil)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Add query parameters
-	q := req.URL.Query()
-	q.Add("username", urn)
-	req.URL.RawQuery = q.Encode()
-
-	// Add headers
-	req.Header.Set("X-RapidAPI-Key", c.apiKey)
-	req.Header.Set("X-RapidAPI-Host", "real-time-people-company-data.p.rapidapi.com")
-
-	// Log the request details
-	log.Printf("RapidAPI Request: %s %s", req.Method, req.URL.String())
-	log.Printf(
-		"RapidAPI Headers: Host=%s, Key=%s...",
-		req.Header.Get("X-RapidAPI-Host"),
-		c.apiKey[:10],
-	)
-
-	// Execute request
-	startTime := time.Now()
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		log.Printf("RapidAPI Error after %v: %v", time.Since(startTime), err)
-		return nil, nil, fmt.Errorf("failed to execute request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	log.Printf(
-		"RapidAPI Response received after %v: Status=%d",
-		time.Since(startTime),
-		resp.StatusCode,
-	)
-
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	log.Printf("RapidAPI Response body length: %d bytes", len(body))
-
-	// Check status code
-	if resp.StatusCode == http.StatusTooManyRequests {
-		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
-		log.Printf("RapidAPI rate limit response: status=429 retryAfter=%v body=%s", retryAfter, string(body))
-		return nil, body, &RateLimitError{
-			RetryAfter: retryAfter,
-			StatusCode: resp.StatusCode,
-			Message:    string(body),
-		}
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		log.Printf("RapidAPI profile not found: URN=%s body=%s", urn, string(body))
-		return nil, body, &NotFoundError{
-			URN:     urn,
-			Message: string(body),
-		}
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		log.Printf("RapidAPI Error response: %s", string(body))
-		return nil, body, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
-	}
-
-	// Parse response using the multi-format handler
-	return parseAPIResponse(body)
+	return c.fetchProfile(ctx, EndpointProfile, c.baseURL, url.Values{"username": {urn}}, urn)
 }
 
 // FetchProfileByUsername fetches a LinkedIn profile by username from RapidAPI
@@ -241,41 +185,7 @@ func (c *LinkedInClient) FetchProfileByUsername(
 	ctx context.Context,
 	username string,
 ) (*LinkedInProfile, []byte, error) {
-	// Construct the API URL - base URL with username query parameter
-	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL, nil)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Add query parameters
-	q := req.URL.Query()
-	q.Add("username", username)
-	req.URL.RawQuery = q.Encode()
-
-	// Add headers
-	req.Header.Set("X-RapidAPI-Key", c.apiKey)
-	req.Header.Set("X-RapidAPI-Host", "linkedin-api8.p.rapidapi.com")
-
-	// Execute request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to execute request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	// Check status code
-	if resp.StatusCode != http.StatusOK {
-		return nil, body, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
-	}
-
-	// Parse response using the multi-format handler
-	return parseAPIResponse(body)
+	return c.fetchProfile(ctx, EndpointProfile, c.baseURL, url.Values{"username": {username}}, username)
 }
 
 // FetchProfileByURL fetches a LinkedIn profile by URL from RapidAPI (alternative method)
@@ -283,37 +193,31 @@ func (c *LinkedInClient) FetchProfileByURL(
 	ctx context.Context,
 	profileURL string,
 ) (*LinkedInProfile, []byte, error) {
-	url := fmt.Sprintf("%s/get-profile-data-by-url", c.baseURL)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	q := req.URL.Query()
-	q.Add("url", profileURL)
-	req.URL.RawQuery = q.Encode()
-
-	req.Header.Set("X-RapidAPI-Key", c.apiKey)
-	req.Header.Set("X-RapidAPI-Host", "linkedin-api8.p.rapidapi.com")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to execute request: %w", err)
-	}
-	defer resp.Body.Close()
+	endpoint := fmt.Sprintf("%s/get-profile-data-by-url", c.baseURL)
+	return c.fetchProfile(ctx, EndpointProfileByURL, endpoint, url.Values{"url": {profileURL}}, profileURL)
+}
 
-	body, err := io.ReadAll(resp.Body)
+// fetchProfile runs a profile request through the shared pipeline and parses
+// the body.
+func (c *LinkedInClient) fetchProfile(
+	ctx context.Context,
+	endpoint string,
+	rawURL string,
+	query url.Values,
+	id string,
+) (*LinkedInProfile, []byte, error) {
+	body, err := c.get(ctx, endpoint, rawURL, query, id)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, body, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		return nil, body, err
 	}
 
 	// Parse response using the multi-format handler
-	return parseAPIResponse(body)
+	profile, body, err := parseAPIResponse(body)
+	var notFoundErr *NotFoundError
+	if errors.As(err, &notFoundErr) && notFoundErr.URN == "" {
+		notFoundErr.URN = id
+	}
+	return profile, body, err
 }
 
 // FetchProfilePosts fetches up to 5 posts for a LinkedIn profile username.
@@ -323,65 +227,117 @@ func (c *LinkedInClient) FetchProfilePosts(
 	username string,
 	start int,
 ) ([]map[string]interface{}, []byte, error) {
-	url := "https://real-time-people-company-data.p.rapidapi.com/get-profile-posts"
+	query := url.Values{
+		"username": {username},
+		"start":    {strconv.Itoa(start)},
+	}
+	body, err := c.get(ctx, EndpointPosts, c.postsURL(), query, username)
+	if err != nil {
+		return nil, body, err
+	}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	// Parse response: try wrapped format first, then direct array/object
+	posts, err := parsePostsResponse(body)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+		var notFoundErr *NotFoundError
+		if errors.As(err, &notFoundErr) && notFoundErr.URN == "" {
+			notFoundErr.URN = username
+		}
+		return nil, body, err
 	}
 
-	q := req.URL.Query()
-	q.Add("username", username)
-	q.Add("start", strconv.Itoa(start))
-	req.URL.RawQuery = q.Encode()
+	return posts, body, nil
+}
+
+// get is the request/response pipeline shared by every endpoint: it builds
+// the GET request, sends it through the key pool and rate limiter, and maps
+// non-200 responses to the profileprovider error types. id names the
+// requested profile in NotFoundError.
+func (c *LinkedInClient) get(
+	ctx context.Context,
+	endpoint string,
+	rawURL string,
+	query url.Values,
+	id string,
+) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.URL.RawQuery = query.Encode()
 
-	req.Header.Set("x-rapidapi-key", c.apiKey)
-	req.Header.Set("x-rapidapi-host", "real-time-people-company-data.p.rapidapi.com")
+	// Add headers; the key is set by send
+	req.Header.Set("X-RapidAPI-Host", c.apiHost())
 	req.Header.Set("Content-Type", "application/json")
 
-	log.Printf("FetchProfilePosts: GET %s", req.URL.String())
+	log.Printf("RapidAPI Request: %s %s", req.Method, req.URL.String())
 
+	// Execute request, rotating keys on 429 or quota exhaustion
 	startTime := time.Now()
-	resp, err := c.httpClient.Do(req)
+	resp, body, err := c.send(req, endpoint, id)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to execute request: %w", err)
+		log.Printf("RapidAPI Error after %v: %v", time.Since(startTime), err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	log.Printf("FetchProfilePosts: status=%d elapsed=%v", resp.StatusCode, time.Since(startTime))
+	log.Printf(
+		"RapidAPI Response received after %v: Status=%d, %d bytes",
+		time.Since(startTime),
+		resp.StatusCode,
+		len(body),
+	)
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+	if err := classifyResponse(resp, body, id); err != nil {
+		log.Printf("RapidAPI Error response: %v", err)
+		return body, err
 	}
+	return body, nil
+}
 
-	if resp.StatusCode == http.StatusTooManyRequests {
-		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
-		return nil, body, &RateLimitError{
-			RetryAfter: retryAfter,
-			StatusCode: resp.StatusCode,
+// classifyResponse maps a non-200 response to its error category. 429s and
+// quota messages normally never get here because send rotates keys on them.
+func classifyResponse(resp *http.Response, body []byte, id string) error {
+	status := resp.StatusCode
+	switch {
+	case status == http.StatusOK:
+		return nil
+	case status == http.StatusNotFound:
+		return &NotFoundError{URN: id, Message: string(body)}
+	case isQuotaExhausted(status, body):
+		return &QuotaExhaustedError{StatusCode: status, Message: string(body)}
+	case status == http.StatusTooManyRequests:
+		return &RateLimitError{
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+			StatusCode: status,
 			Message:    string(body),
 		}
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return &UnauthorizedError{StatusCode: status, Message: string(body)}
+	default:
+		return &UpstreamError{StatusCode: status, Message: string(body)}
 	}
+}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, body, &NotFoundError{
-			URN:     username,
-			Message: string(body),
-		}
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, body, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+// apiHost returns the X-RapidAPI-Host header value: the host of
+// rapidapi.baseURL, or the real-time-people-company-data host when no base
+// URL is configured.
+func (c *LinkedInClient) apiHost() string {
+	base, err := url.Parse(c.baseURL)
+	if err != nil || base.Host == "" {
+		return defaultHost
 	}
+	return base.Host
+}
 
-	// Parse response: try wrapped format first, then direct array/object
-	posts, err := parsePostsResponse(body)
-	if err != nil {
-		return nil, body, err
+// postsURL returns the get-profile-posts endpoint on the host of
+// rapidapi.baseURL, so a local fake server serves posts too. It falls back to
+// the real-time-people-company-data host when no base URL is configured.
+func (c *LinkedInClient) postsURL() string {
+	base, err := url.Parse(c.baseURL)
+	if err != nil || base.Host == "" {
+		return defaultPostsURL
 	}
-
-	return posts, body, nil
+	return base.Scheme + "://" + base.Host + "/get-profile-posts"
 }
 
 // parsePostsResponse extracts the posts array from the API response.
@@ -402,7 +358,7 @@ func parsePostsResponse(body []byte) ([]map[string]interface{}, error) {
 				if strings.Contains(msgLower, "not found") || strings.Contains(msgLower, "not valid") {
 					return nil, &NotFoundError{Message: wrapped.Message}
 				}
-				return nil, fmt.Errorf("API error: %s", wrapped.Message)
+				return nil, &UpstreamError{StatusCode: http.StatusOK, Message: wrapped.Message}
 			}
 			return wrapped.Data, nil
 		}
@@ -417,7 +373,7 @@ func parsePostsResponse(body []byte) ([]map[string]interface{}, error) {
 	// Try object with a "posts" key
 	var obj map[string]interface{}
 	if err := json.Unmarshal(body, &obj); err != nil {
-		return nil, fmt.Errorf("failed to parse posts response: %w", err)
+		return nil, &InvalidResponseError{Message: "failed to parse posts response", Err: err}
 	}
 	for _, key := range []string{"posts", "data", "items", "results"} {
 		if v, ok := obj[key]; ok {
@@ -433,7 +389,7 @@ func parsePostsResponse(body []byte) ([]map[string]interface{}, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("could not extract posts array from response")
+	return nil, &InvalidResponseError{Message: "could not extract posts array from response"}
 }
 
 func parseRetryAfter(value string) time.Duration {
@@ -0,0 +1,139 @@
+package rapidapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkedInClient_ErrorTaxonomy(t *testing.T) {
+	respond := func(status int, body string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(body))
+		}))
+	}
+
+	type fetch func(c *LinkedInClient) error
+	endpoints := map[string]fetch{
+		"FetchProfileByURN": func(c *LinkedInClient) error {
+			_, _, err := c.FetchProfileByURN(context.Background(), "ACoAA1")
+			return err
+		},
+		"FetchProfileByUsername": func(c *LinkedInClient) error {
+			_, _, err := c.FetchProfileByUsername(context.Background(), "ACoAA1")
+			return err
+		},
+		"FetchProfileByURL": func(c *LinkedInClient) error {
+			_, _, err := c.FetchProfileByURL(context.Background(), "ACoAA1")
+			return err
+		},
+		"FetchProfilePosts": func(c *LinkedInClient) error {
+			_, _, err := c.FetchProfilePosts(context.Background(), "ACoAA1", 0)
+			return err
+		},
+	}
+
+	cases := []struct {
+		name   string
+		status int
+		body   string
+		check  func(t *testing.T, err error)
+	}{
+		{
+			name:   "Should return a NotFoundError on 404",
+			status: http.StatusNotFound,
+			body:   `{"message":"missing"}`,
+			check: func(t *testing.T, err error) {
+				var notFoundErr *NotFoundError
+				require.True(t, errors.As(err, &notFoundErr))
+				assert.Equal(t, "ACoAA1", notFoundErr.URN)
+			},
+		},
+		{
+			name:   "Should return an UnauthorizedError on 401",
+			status: http.StatusUnauthorized,
+			body:   `{"message":"Invalid API key"}`,
+			check: func(t *testing.T, err error) {
+				var authErr *UnauthorizedError
+				require.True(t, errors.As(err, &authErr))
+				assert.Equal(t, http.StatusUnauthorized, authErr.StatusCode)
+			},
+		},
+		{
+			name:   "Should return an UnauthorizedError on 403 without a quota message",
+			status: http.StatusForbidden,
+			body:   `{"message":"You are not subscribed to this API."}`,
+			check: func(t *testing.T, err error) {
+				var authErr *UnauthorizedError
+				assert.True(t, errors.As(err, &authErr))
+			},
+		},
+		{
+			name:   "Should return a QuotaExhaustedError when the only key is out of quota",
+			status: http.StatusForbidden,
+			body:   `{"message":"You have exceeded the MONTHLY quota"}`,
+			check: func(t *testing.T, err error) {
+				var quotaErr *QuotaExhaustedError
+				assert.True(t, errors.As(err, &quotaErr))
+			},
+		},
+		{
+			name:   "Should return an UpstreamError on 5xx",
+			status: http.StatusBadGateway,
+			body:   "bad gateway",
+			check: func(t *testing.T, err error) {
+				var upstreamErr *UpstreamError
+				require.True(t, errors.As(err, &upstreamErr))
+				assert.Equal(t, http.StatusBadGateway, upstreamErr.StatusCode)
+			},
+		},
+		{
+			name:   "Should return an InvalidResponseError on an unparseable body",
+			status: http.StatusOK,
+			body:   "<html>oops</html>",
+			check: func(t *testing.T, err error) {
+				var invalidErr *InvalidResponseError
+				assert.True(t, errors.As(err, &invalidErr))
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := respond(tc.status, tc.body)
+			defer server.Close()
+
+			for name, fn := range endpoints {
+				err := fn(newTestClient(server.URL, "k1"))
+				require.Error(t, err, name)
+				tc.check(t, err)
+			}
+		})
+	}
+
+	t.Run("Should send the same RapidAPI host header on every endpoint", func(t *testing.T) {
+		var hosts []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hosts = append(hosts, r.Header.Get("X-RapidAPI-Host"))
+			w.Write([]byte(`{"success":true,"message":"","data":[]}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(server.URL, "k1")
+		for _, fn := range endpoints {
+			fn(client)
+		}
+
+		require.Len(t, hosts, len(endpoints))
+		for _, host := range hosts {
+			assert.Equal(t, strings.TrimPrefix(server.URL, "http://"), host)
+		}
+	})
+}
@@ -0,0 +1,235 @@
+package rapidapi
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Fixture modes for rapidapi.fixtureMode.
+const (
+	// FixtureModeRecord passes requests through and saves each response.
+	FixtureModeRecord = "record"
+	// FixtureModeReplay serves saved responses and never touches the network.
+	FixtureModeReplay = "replay"
+)
+
+// redacted replaces API keys in saved fixtures.
+const redacted = "REDACTED"
+
+// ErrFixtureNotFound is returned in replay mode for a request with no fixture.
+var ErrFixtureNotFound = errors.New("rapidapi fixture not found")
+
+// Fixture is one recorded request/response pair as stored on disk.
+type Fixture struct {
+	Request  FixtureRequest  `json:"request"`
+	Response FixtureResponse `json:"response"`
+}
+
+// FixtureRequest identifies the recorded request.
+type FixtureRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+}
+
+// FixtureResponse is the recorded response. Body holds the raw JSON when the
+// response is JSON, keeping fixtures readable and hand-editable.
+type FixtureResponse struct {
+	StatusCode int             `json:"statusCode"`
+	Header     http.Header     `json:"header,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	BodyText   string          `json:"bodyText,omitempty"`
+}
+
+// FixtureTransport records RapidAPI responses to dir or replays them from it.
+// Fixtures are named after the request's host, path and sorted query, with a
+// sequence number so repeated identical requests replay in recorded order;
+// once a sequence runs out the last response is served again. API keys are
+// redacted before anything is written.
+type FixtureTransport struct {
+	mode string
+	dir  string
+	next http.RoundTripper
+
+	mu   sync.Mutex
+	seen map[string]int
+}
+
+// NewFixtureTransport creates a transport in the given mode. next performs
+// real requests in record mode and defaults to http.DefaultTransport.
+func NewFixtureTransport(mode, dir string, next http.RoundTripper) (*FixtureTransport, error) {
+	if mode != FixtureModeRecord && mode != FixtureModeReplay {
+		return nil, fmt.Errorf("unknown rapidapi fixture mode %q", mode)
+	}
+	if dir == "" {
+		return nil, errors.New("rapidapi fixture dir is required")
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &FixtureTransport{mode: mode, dir: dir, next: next, seen: map[string]int{}}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *FixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	name := fixtureName(req)
+	t.mu.Lock()
+	seq := t.seen[name]
+	t.seen[name]++
+	t.mu.Unlock()
+
+	if t.mode == FixtureModeReplay {
+		return t.replay(req, name, seq)
+	}
+	return t.record(req, name, seq)
+}
+
+func (t *FixtureTransport) record(req *http.Request, name string, seq int) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	secret := req.Header.Get("X-RapidAPI-Key")
+	fixture := Fixture{
+		Request: FixtureRequest{
+			Method: req.Method,
+			URL:    redact(req.URL.String(), secret),
+			Header: redactHeader(req.Header, secret),
+		},
+		Response: FixtureResponse{
+			StatusCode: resp.StatusCode,
+			Header:     redactHeader(resp.Header, secret),
+		},
+	}
+	body = []byte(redact(string(body), secret))
+	if json.Valid(body) {
+		fixture.Response.Body = body
+	} else {
+		fixture.Response.BodyText = string(body)
+	}
+
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(t.dir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(t.dir, fixtureFile(name, seq)), data, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write rapidapi fixture: %w", err)
+	}
+	return resp, nil
+}
+
+func (t *FixtureTransport) replay(req *http.Request, name string, seq int) (*http.Response, error) {
+	data, err := os.ReadFile(filepath.Join(t.dir, fixtureFile(name, seq)))
+	for errors.Is(err, os.ErrNotExist) && seq > 0 {
+		seq--
+		data, err = os.ReadFile(filepath.Join(t.dir, fixtureFile(name, seq)))
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrFixtureNotFound, fixtureFile(name, 0))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("invalid rapidapi fixture %s: %w", fixtureFile(name, seq), err)
+	}
+
+	body := []byte(fixture.Response.Body)
+	if len(body) == 0 {
+		body = []byte(fixture.Response.BodyText)
+	}
+	header := fixture.Response.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fixture.Response.StatusCode, http.StatusText(fixture.Response.StatusCode)),
+		StatusCode:    fixture.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+var unsafeFixtureChars = regexp.MustCompile(`[^A-Za-z0-9._=-]+`)
+
+// fixtureName derives a stable file-system safe name from the request's
+// method, host, path and sorted query.
+func fixtureName(req *http.Request) string {
+	query := req.URL.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := []string{strings.ToLower(req.Method), req.URL.Host}
+	if path := strings.Trim(req.URL.Path, "/"); path != "" {
+		parts = append(parts, path)
+	}
+	for _, k := range keys {
+		for _, v := range query[k] {
+			parts = append(parts, k+"="+v)
+		}
+	}
+
+	name := strings.Trim(unsafeFixtureChars.ReplaceAllString(strings.Join(parts, "_"), "_"), "_")
+	if len(name) > 120 {
+		sum := sha1.Sum([]byte(name))
+		name = name[:100] + "_" + hex.EncodeToString(sum[:])[:12]
+	}
+	return name
+}
+
+func fixtureFile(name string, seq int) string {
+	return fmt.Sprintf("%s.%d.json", name, seq)
+}
+
+func redact(s, secret string) string {
+	if secret == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, secret, redacted)
+}
+
+// redactHeader copies h, masking RapidAPI key headers and the key anywhere else.
+func redactHeader(h http.Header, secret string) http.Header {
+	out := make(http.Header, len(h))
+	for k, values := range h {
+		if strings.EqualFold(k, "X-RapidAPI-Key") {
+			out[k] = []string{redacted}
+			continue
+		}
+		for _, v := range values {
+			out[k] = append(out[k], redact(v, secret))
+		}
+	}
+	return out
+}
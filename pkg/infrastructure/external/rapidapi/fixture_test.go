@@ -0,0 +1,122 @@
+package rapidapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fixtureBaseURL = "https://real-time-people-company-data.p.rapidapi.com"
+
+func newReplayClient(t *testing.T, dir string) *LinkedInClient {
+	t.Helper()
+	transport, err := NewFixtureTransport(FixtureModeReplay, dir, nil)
+	require.NoError(t, err)
+
+	client := newTestClient(fixtureBaseURL, "test=secret-key")
+	client.httpClient = &http.Client{}
+	client.SetTransport(transport)
+	return client
+}
+
+func TestFixtureTransport_Replay(t *testing.T) {
+	client := newReplayClient(t, filepath.Join("testdata", "fixtures"))
+	ctx := context.Background()
+
+	t.Run("Should replay a wrapped profile response end to end", func(t *testing.T) {
+		profile, raw, err := client.FetchProfileByURN(ctx, "ACoAAFixture1")
+		require.NoError(t, err)
+		assert.NotEmpty(t, raw)
+		assert.Equal(t, "jane-fixture", profile.Username)
+		assert.Equal(t, "Jane", profile.FirstName)
+		assert.Equal(t, "Austin, Texas", profile.Geo.City)
+		assert.Len(t, profile.FullPositions, 2)
+		assert.Len(t, profile.Educations, 1)
+		assert.Len(t, profile.Skills, 2)
+	})
+
+	t.Run("Should replay an inaccessible profile as not found", func(t *testing.T) {
+		_, _, err := client.FetchProfileByURN(ctx, "ACoAAMissing")
+		var notFound *NotFoundError
+		assert.True(t, errors.As(err, &notFound))
+	})
+
+	t.Run("Should replay posts", func(t *testing.T) {
+		posts, _, err := client.FetchProfilePosts(ctx, "jane-fixture", 0)
+		require.NoError(t, err)
+		require.Len(t, posts, 2)
+		assert.Equal(t, "7200000000000000001", posts[0]["urn"])
+	})
+
+	t.Run("Should fail without touching the network when no fixture matches", func(t *testing.T) {
+		_, _, err := client.FetchProfileByURN(ctx, "ACoAAUnknown")
+		assert.ErrorIs(t, err, ErrFixtureNotFound)
+	})
+}
+
+func TestFixtureTransport_Record(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"username":"call-%d","echo":%q}`, calls, r.Header.Get("X-RapidAPI-Key"))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	transport, err := NewFixtureTransport(FixtureModeRecord, dir, nil)
+	require.NoError(t, err)
+	client := newTestClient(server.URL, "test=secret-key")
+	client.httpClient = &http.Client{}
+	client.SetTransport(transport)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, _, err := client.FetchProfileByURN(ctx, "ACoAARecorded")
+		require.NoError(t, err)
+	}
+
+	t.Run("Should save one redacted fixture per call", func(t *testing.T) {
+		files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		require.NoError(t, err)
+		require.Len(t, files, 2)
+		for _, f := range files {
+			data, err := os.ReadFile(f)
+			require.NoError(t, err)
+			assert.NotContains(t, string(data), "secret-key")
+			assert.Contains(t, string(data), redacted)
+		}
+	})
+
+	t.Run("Should replay recorded calls in order and repeat the last one", func(t *testing.T) {
+		replay := newReplayClient(t, dir)
+		replay.baseURL = server.URL
+		server.Close()
+
+		for _, want := range []string{"call-1", "call-2", "call-2"} {
+			profile, _, err := replay.FetchProfileByURN(ctx, "ACoAARecorded")
+			require.NoError(t, err)
+			assert.Equal(t, want, profile.Username)
+		}
+	})
+}
+
+func TestNewFixtureTransport(t *testing.T) {
+	t.Run("Should reject an unknown mode", func(t *testing.T) {
+		_, err := NewFixtureTransport("rewind", t.TempDir(), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should require a directory", func(t *testing.T) {
+		_, err := NewFixtureTransport(FixtureModeReplay, "", nil)
+		assert.Error(t, err)
+	})
+}
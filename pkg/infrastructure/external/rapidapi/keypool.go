@@ -0,0 +1,324 @@
+package rapidapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultKeyCooldown is how long a key rests after a 429 without Retry-After.
+const defaultKeyCooldown = time.Minute
+
+// keyStateTTL is how long the pool trusts its view of a key's quota before
+// reading the shared tracker again.
+const keyStateTTL = time.Minute
+
+// KeyQuota tracks per-key monthly usage. apiquota.QuotaManager implements it;
+// without one the pool only keeps in-memory rate-limit state.
+type KeyQuota interface {
+	KeyExhausted(ctx context.Context, label string) (bool, error)
+	// RecordKeyCall counts one call and reports whether the key is now out
+	// of quota.
+	RecordKeyCall(ctx context.Context, label string) (bool, error)
+	MarkKeyExhausted(ctx context.Context, label string) error
+	MarkKeyRateLimited(ctx context.Context, label string, until time.Time) error
+}
+
+// apiKey is one RapidAPI subscription and its rate-limit state.
+type apiKey struct {
+	label          string
+	value          string
+	cooldownUntil  time.Time
+	exhaustedUntil time.Time
+	// checkedAt is when the key's shared quota state was last read
+	checkedAt time.Time
+}
+
+// keyPool hands out RapidAPI keys. It sticks with the current key and rotates
+// to the next healthy one when the current key is rate limited or exhausted.
+type keyPool struct {
+	mu      sync.Mutex
+	keys    []*apiKey
+	current int
+}
+
+// newKeyPool builds a pool from rapidapi.apiKeys entries ("label=key" or a
+// bare key, labelled key-N by position) and falls back to rapidapi.apiKey.
+func newKeyPool(entries []string, fallback string) *keyPool {
+	pool := &keyPool{}
+	for i, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		label, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			label, value = fmt.Sprintf("key-%d", i+1), entry
+		}
+		pool.keys = append(pool.keys, &apiKey{
+			label: strings.TrimSpace(label),
+			value: strings.TrimSpace(value),
+		})
+	}
+	if len(pool.keys) == 0 {
+		pool.keys = append(pool.keys, &apiKey{label: "default", value: fallback})
+	}
+	return pool
+}
+
+// acquire returns the first key, starting at the current one, that is neither
+// cooling down nor exhausted and for which usable reports true. When no key
+// qualifies it returns nil and the shortest remaining cooldown, which is zero
+// when every key is exhausted.
+func (p *keyPool) acquire(now time.Time, usable func(*apiKey) bool) (*apiKey, time.Duration) {
+	for _, k := range p.candidates(now) {
+		if usable != nil && !usable(k) {
+			continue
+		}
+		p.setCurrent(k)
+		return k, 0
+	}
+	return nil, p.wait(now)
+}
+
+// candidates lists the keys that are currently usable, current key first.
+func (p *keyPool) candidates(now time.Time) []*apiKey {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var keys []*apiKey
+	for i := range p.keys {
+		k := p.keys[(p.current+i)%len(p.keys)]
+		if now.Before(k.cooldownUntil) || now.Before(k.exhaustedUntil) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// wait returns the shortest cooldown among keys that are not exhausted.
+func (p *keyPool) wait(now time.Time) time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var wait time.Duration
+	for _, k := range p.keys {
+		if now.Before(k.exhaustedUntil) {
+			continue
+		}
+		if remaining := k.cooldownUntil.Sub(now); remaining > 0 && (wait == 0 || remaining < wait) {
+			wait = remaining
+		}
+	}
+	return wait
+}
+
+// cooldown rests the key until the given time and moves on to the next key.
+func (p *keyPool) cooldown(k *apiKey, until time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	k.cooldownUntil = until
+	p.advancePast(k)
+}
+
+// exhaust takes the key out of rotation until the given time and moves on to
+// the next key.
+func (p *keyPool) exhaust(k *apiKey, until time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	k.exhaustedUntil = until
+	p.advancePast(k)
+}
+
+// suspend takes the key out of rotation for keyStateTTL, after which its
+// shared quota state is read again before it is used.
+func (p *keyPool) suspend(k *apiKey, now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	k.exhaustedUntil = now.Add(keyStateTTL)
+	k.checkedAt = time.Time{}
+	p.advancePast(k)
+}
+
+// needsCheck reports whether the key's shared quota state was last read
+// keyStateTTL or more ago, and marks it read at now if so.
+func (p *keyPool) needsCheck(k *apiKey, now time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if now.Sub(k.checkedAt) < keyStateTTL {
+		return false
+	}
+	k.checkedAt = now
+	return true
+}
+
+// unavailableError reports why no key could serve a request.
+func (p *keyPool) unavailableError(now time.Time) error {
+	if wait := p.wait(now); wait > 0 {
+		return &RateLimitError{
+			RetryAfter: wait,
+			StatusCode: http.StatusTooManyRequests,
+			Message:    "all RapidAPI keys are rate limited",
+		}
+	}
+	return &QuotaExhaustedError{Message: "all RapidAPI keys are out of quota"}
+}
+
+func (p *keyPool) setCurrent(k *apiKey) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, key := range p.keys {
+		if key == k {
+			p.current = i
+			return
+		}
+	}
+}
+
+// advancePast must be called with mu held.
+func (p *keyPool) advancePast(k *apiKey) {
+	if p.keys[p.current] == k {
+		p.current = (p.current + 1) % len(p.keys)
+	}
+}
+
+// isQuotaExhausted reports whether a 429/403 body is RapidAPI's "exceeded the
+// MONTHLY quota" message rather than a per-second throttle.
+func isQuotaExhausted(status int, body []byte) bool {
+	if status != http.StatusTooManyRequests && status != http.StatusForbidden {
+		return false
+	}
+	return strings.Contains(strings.ToLower(string(body)), "quota")
+}
+
+// send executes req with the current healthy key, rotating to the next key
+// on 429 or quota exhaustion. Every attempt first waits on the endpoint's
+// token bucket and reports the response back to it, and every request sent
+// is recorded as a Call for id. Every response counts against the key and,
+// through profileprovider.CountCall, against the caller's quota, so both
+// agree. The response body is returned already read.
+// When no key can serve the request it returns a *RateLimitError if a key
+// will come off cooldown, or a *QuotaExhaustedError.
+func (c *LinkedInClient) send(req *http.Request, endpoint string, id string) (*http.Response, []byte, error) {
+	ctx := req.Context()
+	limiter := c.limiters.get(endpoint)
+
+	for attempt := 0; attempt < len(c.keys.keys); attempt++ {
+		key, _ := c.keys.acquire(time.Now(), func(k *apiKey) bool {
+			return !c.keyExhausted(ctx, k)
+		})
+		if key == nil {
+			break
+		}
+
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, nil, err
+		}
+
+		req.Header.Set("X-RapidAPI-Key", key.value)
+		call := Call{Endpoint: endpoint, KeyLabel: key.label, ID: id}
+		sentAt := time.Now()
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			call.Latency = time.Since(sentAt)
+			c.recordCall(ctx, call)
+			return nil, nil, fmt.Errorf("failed to execute request: %w", err)
+		}
+		limiter.Observe(time.Now(), resp.StatusCode, resp.Header)
+		profileprovider.CountCall(ctx)
+		c.recordKeyCall(ctx, key)
+		call.Counted = true
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		call.StatusCode = resp.StatusCode
+		call.Latency = time.Since(sentAt)
+		call.Bytes = len(body)
+		c.recordCall(ctx, call)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		switch {
+		case isQuotaExhausted(resp.StatusCode, body):
+			log.Printf("RapidAPI key %s is out of quota, rotating", key.label)
+			c.keys.exhaust(key, nextMonth(time.Now()))
+			c.markKeyExhausted(ctx, key)
+		case resp.StatusCode == http.StatusTooManyRequests:
+			cooldown := parseRetryAfter(resp.Header.Get("Retry-After"))
+			if cooldown <= 0 {
+				cooldown = defaultKeyCooldown
+			}
+			until := time.Now().Add(cooldown)
+			log.Printf("RapidAPI key %s rate limited until %s, rotating", key.label, until.Format(time.RFC3339))
+			c.keys.cooldown(key, until)
+			c.markKeyRateLimited(ctx, key, until)
+		default:
+			return resp, body, nil
+		}
+	}
+
+	return nil, nil, c.keys.unavailableError(time.Now())
+}
+
+// nextMonth returns the 1st of the month after now, when key quotas reset.
+func nextMonth(now time.Time) time.Time {
+	return time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, now.Location())
+}
+
+// keyExhausted reports whether the shared tracker has k out of quota, e.g.
+// because another process used it up. The tracker is read at most once per
+// keyStateTTL; in between the pool's own state stands in for it.
+func (c *LinkedInClient) keyExhausted(ctx context.Context, k *apiKey) bool {
+	now := time.Now()
+	if c.keyQuota == nil || !c.keys.needsCheck(k, now) {
+		return false
+	}
+	exhausted, err := c.keyQuota.KeyExhausted(ctx, k.label)
+	if err != nil {
+		log.Printf("Failed to check quota for RapidAPI key %s: %v", k.label, err)
+		return false
+	}
+	if exhausted {
+		// Not for the month: an admin override can put it back in use
+		c.keys.suspend(k, now)
+	}
+	return exhausted
+}
+
+func (c *LinkedInClient) recordKeyCall(ctx context.Context, k *apiKey) {
+	if c.keyQuota == nil {
+		return
+	}
+	exhausted, err := c.keyQuota.RecordKeyCall(ctx, k.label)
+	if err != nil {
+		log.Printf("Failed to record call for RapidAPI key %s: %v", k.label, err)
+		return
+	}
+	if exhausted {
+		log.Printf("RapidAPI key %s reached its monthly limit, rotating", k.label)
+		c.keys.suspend(k, time.Now())
+	}
+}
+
+func (c *LinkedInClient) markKeyExhausted(ctx context.Context, k *apiKey) {
+	if c.keyQuota == nil {
+		return
+	}
+	if err := c.keyQuota.MarkKeyExhausted(ctx, k.label); err != nil {
+		log.Printf("Failed to mark RapidAPI key %s exhausted: %v", k.label, err)
+	}
+}
+
+func (c *LinkedInClient) markKeyRateLimited(ctx context.Context, k *apiKey, until time.Time) {
+	if c.keyQuota == nil {
+		return
+	}
+	if err := c.keyQuota.MarkKeyRateLimited(ctx, k.label, until); err != nil {
+		log.Printf("Failed to record rate limit for RapidAPI key %s: %v", k.label, err)
+	}
+}
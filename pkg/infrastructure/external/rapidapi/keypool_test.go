@@ -0,0 +1,233 @@
+package rapidapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeKeyQuota records per-key calls in memory. A key with a limit is
+// exhausted once its calls reach it.
+type fakeKeyQuota struct {
+	mu        sync.Mutex
+	calls     map[string]int
+	checks    map[string]int
+	limits    map[string]int
+	exhausted map[string]bool
+}
+
+func newFakeKeyQuota() *fakeKeyQuota {
+	return &fakeKeyQuota{
+		calls:     map[string]int{},
+		checks:    map[string]int{},
+		limits:    map[string]int{},
+		exhausted: map[string]bool{},
+	}
+}
+
+func (f *fakeKeyQuota) KeyExhausted(_ context.Context, label string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.checks[label]++
+	return f.exhausted[label], nil
+}
+
+func (f *fakeKeyQuota) RecordKeyCall(_ context.Context, label string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls[label]++
+	if limit := f.limits[label]; limit > 0 && f.calls[label] >= limit {
+		f.exhausted[label] = true
+	}
+	return f.exhausted[label], nil
+}
+
+func (f *fakeKeyQuota) MarkKeyExhausted(_ context.Context, label string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.exhausted[label] = true
+	return nil
+}
+
+func (f *fakeKeyQuota) MarkKeyRateLimited(context.Context, string, time.Time) error {
+	return nil
+}
+
+func newTestClient(url string, keys ...string) *LinkedInClient {
+	return &LinkedInClient{
+		keys:       newKeyPool(keys, ""),
+		limiters:   unlimitedLimiters(),
+		baseURL:    url,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// unlimitedLimiters returns buckets fast enough not to slow tests down.
+func unlimitedLimiters() *limiterSet {
+	set := &limiterSet{buckets: map[string]*tokenBucket{}}
+	for _, endpoint := range []string{EndpointProfile, EndpointProfileByURL, EndpointPosts} {
+		set.buckets[endpoint] = newTokenBucket(1000, 100)
+	}
+	return set
+}
+
+func TestNewKeyPool(t *testing.T) {
+	t.Run("Should parse labelled and bare keys", func(t *testing.T) {
+		pool := newKeyPool([]string{"main=abc", " def ", ""}, "fallback")
+		assert.Len(t, pool.keys, 2)
+		assert.Equal(t, "main", pool.keys[0].label)
+		assert.Equal(t, "abc", pool.keys[0].value)
+		assert.Equal(t, "key-2", pool.keys[1].label)
+		assert.Equal(t, "def", pool.keys[1].value)
+	})
+
+	t.Run("Should fall back to the single configured key", func(t *testing.T) {
+		pool := newKeyPool(nil, "fallback")
+		assert.Len(t, pool.keys, 1)
+		assert.Equal(t, "default", pool.keys[0].label)
+		assert.Equal(t, "fallback", pool.keys[0].value)
+	})
+}
+
+func TestLinkedInClient_Send(t *testing.T) {
+	t.Run("Should rotate to the next key on 429 and stick with it", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-RapidAPI-Key") == "k1" {
+				w.Header().Set("Retry-After", "30")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Write([]byte(`{"username":"jane"}`))
+		}))
+		defer server.Close()
+
+		quota := newFakeKeyQuota()
+		client := newTestClient(server.URL, "a=k1", "b=k2")
+		client.SetKeyQuota(quota)
+
+		for i := 0; i < 2; i++ {
+			profile, _, err := client.FetchProfileByURN(context.Background(), "urn")
+			assert.NoError(t, err)
+			assert.Equal(t, "jane", profile.Username)
+		}
+		assert.Equal(t, 1, quota.calls["a"])
+		assert.Equal(t, 2, quota.calls["b"])
+	})
+
+	t.Run("Should skip a key that upstream reports out of quota", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-RapidAPI-Key") == "k1" {
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"message":"You have exceeded the MONTHLY quota for Requests on your current plan"}`))
+				return
+			}
+			w.Write([]byte(`{"username":"jane"}`))
+		}))
+		defer server.Close()
+
+		quota := newFakeKeyQuota()
+		client := newTestClient(server.URL, "a=k1", "b=k2")
+		client.SetKeyQuota(quota)
+
+		_, _, err := client.FetchProfileByURN(context.Background(), "urn")
+		assert.NoError(t, err)
+		assert.True(t, quota.exhausted["a"])
+	})
+
+	t.Run("Should return a rate limit error when every key is cooling down", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client := newTestClient(server.URL, "a=k1", "b=k2")
+		_, _, err := client.FetchProfileByURN(context.Background(), "urn")
+
+		var rateErr *RateLimitError
+		assert.True(t, errors.As(err, &rateErr))
+		assert.Greater(t, rateErr.RetryAfter, 25*time.Second)
+	})
+
+	t.Run("Should read a key's shared state once per TTL", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"username":"jane"}`))
+		}))
+		defer server.Close()
+
+		quota := newFakeKeyQuota()
+		client := newTestClient(server.URL, "a=k1")
+		client.SetKeyQuota(quota)
+
+		for i := 0; i < 3; i++ {
+			_, _, err := client.FetchProfileByURN(context.Background(), "urn")
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, 1, quota.checks["a"])
+		assert.Equal(t, 3, quota.calls["a"])
+	})
+
+	t.Run("Should rotate once a key's own count reaches its limit", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"username":"jane"}`))
+		}))
+		defer server.Close()
+
+		quota := newFakeKeyQuota()
+		quota.limits["a"] = 2
+		client := newTestClient(server.URL, "a=k1", "b=k2")
+		client.SetKeyQuota(quota)
+
+		for i := 0; i < 3; i++ {
+			_, _, err := client.FetchProfileByURN(context.Background(), "urn")
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, 2, quota.calls["a"])
+		assert.Equal(t, 1, quota.calls["b"])
+	})
+
+	t.Run("Should count every response on the context's call count", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-RapidAPI-Key") == "k1" {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		quota := newFakeKeyQuota()
+		client := newTestClient(server.URL, "a=k1", "b=k2")
+		client.SetKeyQuota(quota)
+
+		ctx, count := profileprovider.WithCallCount(context.Background())
+		_, _, err := client.FetchProfileByURN(ctx, "urn")
+		assert.Error(t, err)
+		assert.Equal(t, 2, count.Calls())
+		assert.Equal(t, quota.calls["a"]+quota.calls["b"], count.Calls())
+	})
+
+	t.Run("Should return a QuotaExhaustedError when every key is out of quota", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("no request expected")
+		}))
+		defer server.Close()
+
+		quota := newFakeKeyQuota()
+		quota.exhausted["a"] = true
+		quota.exhausted["b"] = true
+		client := newTestClient(server.URL, "a=k1", "b=k2")
+		client.SetKeyQuota(quota)
+
+		_, _, err := client.FetchProfileByURN(context.Background(), "urn")
+		var quotaErr *QuotaExhaustedError
+		assert.True(t, errors.As(err, &quotaErr))
+	})
+}
@@ -0,0 +1,195 @@
+package rapidapi
+
+import (
+	"context"
+	"net/http"
+	"sheng-go-backend/config"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Endpoints with their own rate limit, configured under rapidapi.rateLimits.
+const (
+	EndpointProfile      = "profile"
+	EndpointProfileByURL = "profile_by_url"
+	EndpointPosts        = "posts"
+)
+
+const (
+	// defaultRequestInterval is the spacing between calls to an endpoint with no
+	// rapidapi.rateLimits entry when rapidapi.requestIntervalMs is not set.
+	defaultRequestInterval = time.Second
+
+	// minRateFactor bounds how far 429s can slow a bucket below its configured rate.
+	minRateFactor = 0.1
+
+	// recoverFactor is the share of the configured rate regained per call
+	// answered without rate-limit pressure.
+	recoverFactor = 0.1
+
+	// maxHeaderWindow ignores rate-limit headers whose reset is further away,
+	// such as the monthly plan quota headers.
+	maxHeaderWindow = time.Minute
+)
+
+// tokenBucket is a proactive limiter for one endpoint. Callers reserve a
+// token before each call; the rate backs off when upstream signals pressure
+// and recovers towards the configured rate afterwards.
+type tokenBucket struct {
+	mu       sync.Mutex
+	baseRate float64 // configured tokens per second
+	rate     float64 // current tokens per second
+	burst    float64
+	tokens   float64
+	last     time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		baseRate: rate,
+		rate:     rate,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait for it.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token when the caller gave up waiting.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens++
+}
+
+// refill must be called with mu held.
+func (b *tokenBucket) refill(now time.Time) {
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+}
+
+// Wait blocks until a token is available or ctx is cancelled.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	wait := b.reserve(time.Now())
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
+
+// Observe adapts the rate to the response: a 429 halves it, a short
+// X-RateLimit-Remaining/Reset window caps it to what the window allows, and
+// any other response lets it recover. Retry-After itself is honoured by
+// resting the key that got it (see keyPool.cooldown), so the other keys in the
+// pool keep drawing from the bucket.
+func (b *tokenBucket) Observe(now time.Time, status int, header http.Header) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	floor := b.baseRate * minRateFactor
+
+	if status == http.StatusTooManyRequests {
+		b.rate = max(b.rate/2, floor)
+		return
+	}
+
+	remaining, okRemaining := headerInt(header, "X-RateLimit-Remaining")
+	reset, okReset := headerInt(header, "X-RateLimit-Reset")
+	if okRemaining && okReset && reset > 0 && time.Duration(reset)*time.Second <= maxHeaderWindow {
+		allowed := float64(remaining) / float64(reset)
+		if allowed < b.rate {
+			b.rate = max(allowed, floor)
+			return
+		}
+	}
+
+	b.rate = min(b.rate+b.baseRate*recoverFactor, b.baseRate)
+}
+
+// Rate returns the current tokens per second.
+func (b *tokenBucket) Rate() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.rate
+}
+
+func headerInt(header http.Header, key string) (int, bool) {
+	v := header.Get(key)
+	if v == "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// limiterSet holds one bucket per endpoint.
+type limiterSet struct {
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// sharedLimiters is used by every LinkedInClient in the process, so the
+// fetcher, FetchProfileByURL and scripts draw from the same buckets.
+var sharedLimiters = &limiterSet{buckets: map[string]*tokenBucket{}}
+
+// get returns the endpoint's bucket, creating it from config on first use.
+func (s *limiterSet) get(endpoint string) *tokenBucket {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if b, ok := s.buckets[endpoint]; ok {
+		return b
+	}
+	rate, burst := endpointLimit(endpoint)
+	b := newTokenBucket(rate, burst)
+	s.buckets[endpoint] = b
+	return b
+}
+
+// endpointLimit reads rapidapi.rateLimits.<endpoint>, defaulting to one call
+// per rapidapi.requestIntervalMs with no burst.
+func endpointLimit(endpoint string) (float64, int) {
+	cfg := config.C.RapidAPI
+	if limit, ok := cfg.RateLimits[endpoint]; ok && limit.RequestsPerSecond > 0 {
+		return limit.RequestsPerSecond, limit.Burst
+	}
+
+	interval := time.Duration(cfg.RequestIntervalMs) * time.Millisecond
+	if interval <= 0 {
+		interval = defaultRequestInterval
+	}
+	return float64(time.Second) / float64(interval), 1
+}
@@ -0,0 +1,85 @@
+package rapidapi
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket_Wait(t *testing.T) {
+	t.Run("Should allow a burst and then space calls by the rate", func(t *testing.T) {
+		bucket := newTokenBucket(50, 2)
+		start := time.Now()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, bucket.Wait(context.Background()))
+			}()
+		}
+		wg.Wait()
+
+		// Two calls use the burst, the remaining three wait 20ms each.
+		assert.GreaterOrEqual(t, time.Since(start), 60*time.Millisecond)
+	})
+
+	t.Run("Should return the context error and give the token back when cancelled", func(t *testing.T) {
+		bucket := newTokenBucket(0.001, 1)
+		assert.NoError(t, bucket.Wait(context.Background()))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, bucket.Wait(ctx), context.Canceled)
+		assert.InDelta(t, 0, bucket.tokens, 0.01)
+	})
+}
+
+func TestTokenBucket_Observe(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Should halve the rate on 429 and recover on later successes", func(t *testing.T) {
+		bucket := newTokenBucket(10, 1)
+
+		bucket.Observe(now, http.StatusTooManyRequests, http.Header{})
+		assert.Equal(t, 5.0, bucket.Rate())
+
+		for i := 0; i < 10; i++ {
+			bucket.Observe(now, http.StatusOK, http.Header{})
+		}
+		assert.Equal(t, 10.0, bucket.Rate())
+	})
+
+	t.Run("Should not slow below the floor", func(t *testing.T) {
+		bucket := newTokenBucket(10, 1)
+		for i := 0; i < 10; i++ {
+			bucket.Observe(now, http.StatusTooManyRequests, http.Header{})
+		}
+		assert.InDelta(t, 1.0, bucket.Rate(), 0.0001)
+	})
+
+	t.Run("Should cap the rate to a short rate-limit window", func(t *testing.T) {
+		bucket := newTokenBucket(10, 1)
+		header := http.Header{}
+		header.Set("X-RateLimit-Remaining", "20")
+		header.Set("X-RateLimit-Reset", "10")
+
+		bucket.Observe(now, http.StatusOK, header)
+		assert.Equal(t, 2.0, bucket.Rate())
+	})
+
+	t.Run("Should ignore monthly quota windows", func(t *testing.T) {
+		bucket := newTokenBucket(10, 1)
+		header := http.Header{}
+		header.Set("X-RateLimit-Remaining", "20")
+		header.Set("X-RateLimit-Reset", "86400")
+
+		bucket.Observe(now, http.StatusOK, header)
+		assert.Equal(t, 10.0, bucket.Rate())
+	})
+}
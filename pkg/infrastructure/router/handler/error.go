@@ -3,19 +3,22 @@ package handler
 import (
 	"errors"
 	"fmt"
-	"sheng-go-backend/pkg/entity/model"
 	"net/http"
+	"sheng-go-backend/pkg/entity/model"
 
 	"github.com/labstack/echo/v4"
 )
 
 var codeToStatusMap = map[string]int{
-	model.NotFoundError:       http.StatusUnprocessableEntity,
-	model.DBError:             http.StatusInternalServerError,
-	model.ValidationError:     http.StatusBadRequest,
-	model.BadRequestError:     http.StatusBadRequest,
-	model.AuthError:           http.StatusUnauthorized,
-	model.InternalServerError: http.StatusInternalServerError,
+	model.NotFoundError:           http.StatusUnprocessableEntity,
+	model.DBError:                 http.StatusInternalServerError,
+	model.ValidationError:         http.StatusBadRequest,
+	model.BadRequestError:         http.StatusBadRequest,
+	model.AuthError:               http.StatusUnauthorized,
+	model.InternalServerError:     http.StatusInternalServerError,
+	model.RateLimitError:          http.StatusTooManyRequests,
+	model.UpstreamError:           http.StatusBadGateway,
+	model.ServiceUnavailableError: http.StatusServiceUnavailable,
 }
 
 func mapErrorCodeToHTTPStatus(c string) int {
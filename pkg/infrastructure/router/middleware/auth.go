@@ -34,6 +34,14 @@ func Auth(opts AuthOptions) echo.MiddlewareFunc {
 				return handler.HandleError(c, model.NewAuthError(err))
 			}
 
+			isExpired, err := auth.IsJWTExpired(accessToken)
+			if err != nil {
+				return handler.HandleError(c, err)
+			}
+			if isExpired {
+				return handler.HandleError(c, model.NewAuthError(errors.New("Token Expired")))
+			}
+
 			ctx, err = auth.SetTokenToContext(ctx, accessToken)
 			if err != nil {
 				return handler.HandleError(c, model.NewAuthError(err))
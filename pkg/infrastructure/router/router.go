@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	resthandler "sheng-go-backend/pkg/adapter/handler"
+	authmiddleware "sheng-go-backend/pkg/infrastructure/router/middleware"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -57,6 +58,12 @@ func New(srv *handler.Server, profileRESTHandler *resthandler.ProfileRESTHandler
 
 	// REST endpoints
 	e.POST(apiPath+"/profiles/fetch", profileRESTHandler.Fetch)
+	// Requeue is an admin operation and needs a token, like @auth mutations
+	e.POST(
+		apiPath+"/profile-entries/requeue",
+		profileRESTHandler.Requeue,
+		authmiddleware.Auth(authmiddleware.AuthOptions{}),
+	)
 
 	return e
 }
@@ -14,6 +14,21 @@ import (
 	"github.com/robfig/cron/v3"
 )
 
+const (
+	// defaultReaperSchedule runs the stale fetch reaper every 15 minutes.
+	defaultReaperSchedule = "*/15 * * * *"
+	// defaultReaperBatchSize caps how many stuck entries one reaper run resets.
+	defaultReaperBatchSize = 500
+	// defaultRefresherSchedule runs the profile refresher daily at 1 AM.
+	defaultRefresherSchedule = "0 1 * * *"
+	// defaultRefreshBudget caps how many completed entries one refresher run re-queues.
+	defaultRefreshBudget = 50
+	// defaultChangeEventSchedule delivers due change events every minute.
+	defaultChangeEventSchedule = "* * * * *"
+	// defaultChangeEventBatchSize caps how many change events one delivery run posts.
+	defaultChangeEventBatchSize = 100
+)
+
 // Scheduler manages cron jobs
 type Scheduler struct {
 	cron           *cron.Cron
@@ -92,6 +107,18 @@ func (s *Scheduler) registerJob(ctx context.Context, job *ent.CronJobConfig) err
 		entryID, err = s.cron.AddFunc(job.Schedule, func() {
 			s.runQuotaResetJob(context.Background())
 		})
+	case cronjobconfig.JobTypeStaleFetchReaper:
+		entryID, err = s.cron.AddFunc(job.Schedule, func() {
+			s.runStaleFetchReaperJob(context.Background())
+		})
+	case cronjobconfig.JobTypeProfileRefresher:
+		entryID, err = s.cron.AddFunc(job.Schedule, func() {
+			s.runProfileRefresherJob(context.Background())
+		})
+	case cronjobconfig.JobTypeChangeEventWebhook:
+		entryID, err = s.cron.AddFunc(job.Schedule, func() {
+			s.runChangeEventWebhookJob(context.Background())
+		})
 	default:
 		return fmt.Errorf("unknown job type: %s", job.JobType)
 	}
@@ -144,6 +171,64 @@ func (s *Scheduler) runQuotaResetJob(ctx context.Context) {
 	log.Println("Quota reset job completed successfully")
 }
 
+// runStaleFetchReaperJob resets profile entries stuck in FETCHING
+func (s *Scheduler) runStaleFetchReaperJob(ctx context.Context) {
+	log.Println("Running stale fetch reaper job...")
+
+	// Update last run time
+	if err := s.updateLastRun(ctx, profilefetcher.StaleFetchReaperJobName); err != nil {
+		log.Printf("Warning: Failed to update last run time: %v", err)
+	}
+
+	// Execute the job
+	history, err := s.profileFetcher.ReapStaleEntries(ctx)
+	if err != nil {
+		log.Printf("Stale fetch reaper job failed: %v", err)
+		return
+	}
+
+	log.Printf("Stale fetch reaper job completed: %d entries reset", history.TotalProcessed)
+}
+
+// runProfileRefresherJob re-queues completed profiles that are due for a refresh
+func (s *Scheduler) runProfileRefresherJob(ctx context.Context) {
+	log.Println("Running profile refresher job...")
+
+	// Update last run time
+	if err := s.updateLastRun(ctx, profilefetcher.ProfileRefresherJobName); err != nil {
+		log.Printf("Warning: Failed to update last run time: %v", err)
+	}
+
+	// Execute the job
+	history, err := s.profileFetcher.RefreshStaleProfiles(ctx)
+	if err != nil {
+		log.Printf("Profile refresher job failed: %v", err)
+		return
+	}
+
+	log.Printf("Profile refresher job completed: %d entries re-queued", history.TotalProcessed)
+}
+
+// runChangeEventWebhookJob delivers pending profile change events to the webhook
+func (s *Scheduler) runChangeEventWebhookJob(ctx context.Context) {
+	log.Println("Running change event webhook job...")
+
+	// Update last run time
+	if err := s.updateLastRun(ctx, profilefetcher.ChangeEventWebhookJobName); err != nil {
+		log.Printf("Warning: Failed to update last run time: %v", err)
+	}
+
+	// Execute the job
+	history, err := s.profileFetcher.DeliverChangeEvents(ctx)
+	if err != nil {
+		log.Printf("Change event webhook job failed: %v", err)
+		return
+	}
+
+	log.Printf("Change event webhook job completed: %d delivered, %d failed",
+		history.SuccessfulCount, history.FailedCount)
+}
+
 // updateLastRun updates the last run timestamp for a job
 func (s *Scheduler) updateLastRun(ctx context.Context, jobName string) error {
 	job, err := s.cronRepo.GetByName(ctx, jobName)
@@ -163,12 +248,17 @@ func (s *Scheduler) initializeDefaultConfigs(ctx context.Context) error {
 	_, err := s.cronRepo.GetByName(ctx, "profile_fetcher")
 	if err != nil && ent.IsNotFound(err) {
 		log.Println("Creating default profile_fetcher job config...")
+		concurrency := cfg.Cron.Concurrency
+		if concurrency <= 0 {
+			concurrency = 1
+		}
 		_, err = s.cronRepo.Create(ctx, &ent.CronJobConfig{
 			JobName:      "profile_fetcher",
 			JobType:      cronjobconfig.JobTypeProfileFetcher,
 			Schedule:     cfg.Cron.ProfileFetcherSchedule,
 			Enabled:      true,
 			BatchSize:    cfg.Cron.BatchSize,
+			Concurrency:  concurrency,
 			AdminEmail:   cfg.Email.AdminEmail,
 			RespectQuota: true,
 		})
@@ -187,6 +277,7 @@ func (s *Scheduler) initializeDefaultConfigs(ctx context.Context) error {
 			Schedule:     cfg.Cron.QuotaResetSchedule,
 			Enabled:      true,
 			BatchSize:    1,
+			Concurrency:  1,
 			AdminEmail:   cfg.Email.AdminEmail,
 			RespectQuota: false,
 		})
@@ -195,6 +286,79 @@ func (s *Scheduler) initializeDefaultConfigs(ctx context.Context) error {
 		}
 	}
 
+	// Stale Fetch Reaper Job
+	_, err = s.cronRepo.GetByName(ctx, profilefetcher.StaleFetchReaperJobName)
+	if err != nil && ent.IsNotFound(err) {
+		log.Println("Creating default stale_fetch_reaper job config...")
+		schedule := cfg.Cron.ReaperSchedule
+		if schedule == "" {
+			schedule = defaultReaperSchedule
+		}
+		_, err = s.cronRepo.Create(ctx, &ent.CronJobConfig{
+			JobName:      profilefetcher.StaleFetchReaperJobName,
+			JobType:      cronjobconfig.JobTypeStaleFetchReaper,
+			Schedule:     schedule,
+			Enabled:      true,
+			BatchSize:    defaultReaperBatchSize,
+			Concurrency:  1,
+			AdminEmail:   cfg.Email.AdminEmail,
+			RespectQuota: false,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create stale_fetch_reaper config: %w", err)
+		}
+	}
+
+	// Profile Refresher Job (disabled by default: every refresh spends API quota)
+	_, err = s.cronRepo.GetByName(ctx, profilefetcher.ProfileRefresherJobName)
+	if err != nil && ent.IsNotFound(err) {
+		log.Println("Creating default profile_refresher job config...")
+		schedule := cfg.Cron.RefresherSchedule
+		if schedule == "" {
+			schedule = defaultRefresherSchedule
+		}
+		budget := cfg.Cron.RefreshBudget
+		if budget <= 0 {
+			budget = defaultRefreshBudget
+		}
+		_, err = s.cronRepo.Create(ctx, &ent.CronJobConfig{
+			JobName:      profilefetcher.ProfileRefresherJobName,
+			JobType:      cronjobconfig.JobTypeProfileRefresher,
+			Schedule:     schedule,
+			Enabled:      false,
+			BatchSize:    budget,
+			Concurrency:  1,
+			AdminEmail:   cfg.Email.AdminEmail,
+			RespectQuota: true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create profile_refresher config: %w", err)
+		}
+	}
+
+	// Change Event Webhook Job (a no-op until webhook.url is configured)
+	_, err = s.cronRepo.GetByName(ctx, profilefetcher.ChangeEventWebhookJobName)
+	if err != nil && ent.IsNotFound(err) {
+		log.Println("Creating default change_event_webhook job config...")
+		schedule := cfg.Cron.ChangeEventSchedule
+		if schedule == "" {
+			schedule = defaultChangeEventSchedule
+		}
+		_, err = s.cronRepo.Create(ctx, &ent.CronJobConfig{
+			JobName:      profilefetcher.ChangeEventWebhookJobName,
+			JobType:      cronjobconfig.JobTypeChangeEventWebhook,
+			Schedule:     schedule,
+			Enabled:      true,
+			BatchSize:    defaultChangeEventBatchSize,
+			Concurrency:  1,
+			AdminEmail:   cfg.Email.AdminEmail,
+			RespectQuota: false,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create change_event_webhook config: %w", err)
+		}
+	}
+
 	return nil
 }
 
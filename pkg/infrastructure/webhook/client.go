@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sheng-go-backend/config"
+	"time"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the request body, keyed by
+// webhook.secret, so receivers can verify the sender.
+const SignatureHeader = "X-Sheng-Signature"
+
+// EventHeader carries the event type of the delivered payload.
+const EventHeader = "X-Sheng-Event"
+
+// Client posts JSON events to the configured webhook endpoint
+type Client struct {
+	url        string
+	secret     string
+	httpClient *http.Client
+}
+
+// NewClient creates a new webhook client from the webhook config section
+func NewClient() *Client {
+	cfg := config.C.Webhook
+
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &Client{
+		url:    cfg.URL,
+		secret: cfg.Secret,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// Enabled reports whether a webhook URL is configured
+func (c *Client) Enabled() bool {
+	return c != nil && c.url != ""
+}
+
+// DeliveryError is returned when the endpoint answers with a non-2xx status
+type DeliveryError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("webhook responded with status %d: %s", e.StatusCode, e.Body)
+}
+
+// Send posts payload as JSON. Any 2xx response counts as delivered.
+func (c *Client) Send(ctx context.Context, eventType string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, eventType)
+	if c.secret != "" {
+		req.Header.Set(SignatureHeader, Sign(c.secret, body))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &DeliveryError{StatusCode: resp.StatusCode, Body: string(snippet)}
+	}
+	return nil
+}
+
+// Sign returns the hex HMAC-SHA256 of body keyed by secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,14 @@
+package registry
+
+import (
+	"sheng-go-backend/pkg/adapter/controller"
+	companyrepository "sheng-go-backend/pkg/adapter/repository/companyrepository"
+	usecase "sheng-go-backend/pkg/usecase/usecase/company"
+)
+
+func (r *registry) NewCompanyController() controller.Company {
+	repo := companyrepository.NewCompanyRepository(r.client)
+	u := usecase.NewCompanyUseCase(repo)
+
+	return controller.NewCompanyController(u)
+}
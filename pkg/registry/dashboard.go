@@ -10,5 +10,6 @@ func (r *registry) NewDashboardController() controller.Dashboard {
 		r.profileEntryRepo,
 		r.cronConfigRepo,
 		r.jobHistoryRepo,
+		r.profileFetcher,
 	)
 }
@@ -0,0 +1,14 @@
+package registry
+
+import (
+	"sheng-go-backend/pkg/adapter/controller"
+	"sheng-go-backend/pkg/adapter/repository/profilechangeeventrepository"
+	profilechangeeventusecase "sheng-go-backend/pkg/usecase/usecase/profilechangeevent"
+)
+
+func (r *registry) NewProfileChangeEventController() controller.ProfileChangeEvent {
+	repo := profilechangeeventrepository.NewProfileChangeEventRepository(r.client)
+	usecase := profilechangeeventusecase.New(repo)
+
+	return controller.NewProfileChangeEventController(usecase)
+}
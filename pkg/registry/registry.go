@@ -12,13 +12,13 @@ import (
 )
 
 type registry struct {
-	client               *ent.Client
-	quotaManager         *apiquota.QuotaManager
-	scheduler            *scheduler.Scheduler
-	profileFetcher       *profilefetcher.ProfileFetcher
-	profileEntryRepo     profileentryrepository.ProfileEntryRepository
-	cronConfigRepo       *cronjobconfigrepository.CronJobConfigRepository
-	jobHistoryRepo       *jobexecutionhistoryrepository.JobExecutionHistoryRepository
+	client           *ent.Client
+	quotaManager     *apiquota.QuotaManager
+	scheduler        *scheduler.Scheduler
+	profileFetcher   *profilefetcher.ProfileFetcher
+	profileEntryRepo profileentryrepository.ProfileEntryRepository
+	cronConfigRepo   *cronjobconfigrepository.CronJobConfigRepository
+	jobHistoryRepo   *jobexecutionhistoryrepository.JobExecutionHistoryRepository
 }
 
 // Registry is an interface of registry
@@ -57,14 +57,16 @@ func NewWithOptions(client *ent.Client, opts RegistryOptions) Registry {
 // NewController generates controllers
 func (r *registry) NewController() controller.Controller {
 	return controller.Controller{
-		User:         r.NewUserController(),
-		Todo:         r.NewTodoController(),
-		Profile:      r.NewProfileController(),
-		Auth:         r.NewAuthController(),
-		ProfileEntry: r.NewProfileEntryController(),
-		APIQuota:     r.NewAPIQuotaController(),
-		CronJob:      r.NewCronJobController(),
-		JobExecution: r.NewJobExecutionController(),
-		Dashboard:    r.NewDashboardController(),
+		User:               r.NewUserController(),
+		Todo:               r.NewTodoController(),
+		Profile:            r.NewProfileController(),
+		Auth:               r.NewAuthController(),
+		ProfileEntry:       r.NewProfileEntryController(),
+		APIQuota:           r.NewAPIQuotaController(),
+		CronJob:            r.NewCronJobController(),
+		JobExecution:       r.NewJobExecutionController(),
+		Dashboard:          r.NewDashboardController(),
+		ProfileChangeEvent: r.NewProfileChangeEventController(),
+		Company:            r.NewCompanyController(),
 	}
 }
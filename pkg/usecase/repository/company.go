@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"sheng-go-backend/pkg/entity/model"
+)
+
+type Company interface {
+	Get(ctx context.Context, id model.ID) (*model.Company, error)
+	List(ctx context.Context,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *model.CompanyWhereInput,
+	) (*model.CompanyConnection, error)
+	ListCurrentEmployees(ctx context.Context,
+		companyID model.ID,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *model.ProfileWhereInput,
+	) (*model.ProfileConnection, error)
+	ListAlumni(ctx context.Context,
+		companyID model.ID,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *model.ProfileWhereInput,
+	) (*model.ProfileConnection, error)
+	HeadcountByTitle(
+		ctx context.Context,
+		companyID model.ID,
+		alumni bool,
+		minCount int,
+	) ([]*model.ProfileTitleGroup, error)
+}
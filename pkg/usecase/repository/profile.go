@@ -20,4 +20,20 @@ type Profile interface {
 		searchTerm *string,
 		minCount int,
 	) ([]*model.ProfileTitleGroup, error)
+	ListSnapshots(ctx context.Context, profileID model.ID) ([]*model.ProfileSnapshot, error)
+	ListPositions(
+		ctx context.Context,
+		profileID model.ID,
+		where *model.ProfilePositionWhereInput,
+	) ([]*model.ProfilePosition, error)
+	ListEducations(
+		ctx context.Context,
+		profileID model.ID,
+		where *model.ProfileEducationWhereInput,
+	) ([]*model.ProfileEducation, error)
+	ListSkills(
+		ctx context.Context,
+		profileID model.ID,
+		where *model.ProfileSkillWhereInput,
+	) ([]*model.ProfileSkill, error)
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/entity/model"
+)
+
+type ProfileChangeEvent interface {
+	List(ctx context.Context,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *ent.ProfileChangeEventWhereInput,
+	) (*ent.ProfileChangeEventConnection, error)
+}
@@ -17,4 +17,8 @@ type ProfileEntry interface {
 		before *model.Cursor,
 		last *int, where *model.ProfileEntryWhereInput) (*model.ProfileEntryConnection, error)
 	GetStats(ctx context.Context) (*model.ProfileEntryStats, error)
+	Requeue(
+		ctx context.Context,
+		input model.RequeueProfileEntriesInput,
+	) (*model.BulkProfileEntryResult, error)
 }
@@ -0,0 +1,192 @@
+package apiquota
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/entity/model"
+)
+
+// Budget names the built-in callers reserve against. A caller whose budget
+// has not been created only draws from the pool-wide quota.
+const (
+	BudgetCron        = "cron"
+	BudgetInteractive = "interactive"
+	BudgetPosts       = "posts"
+)
+
+// ListBudgets retrieves every quota budget
+func (qm *QuotaManager) ListBudgets(ctx context.Context) ([]*ent.APIQuotaBudget, error) {
+	budgets, err := qm.budgetRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quota budgets: %w", err)
+	}
+	return budgets, nil
+}
+
+// CreateBudget creates a quota budget. Budgets may not share out more than
+// the whole quota.
+func (qm *QuotaManager) CreateBudget(ctx context.Context, input ent.CreateAPIQuotaBudgetInput) (*ent.APIQuotaBudget, error) {
+	if err := qm.validateBudgetShare(ctx, input.Name, input.Percent); err != nil {
+		return nil, err
+	}
+
+	budget, err := qm.budgetRepo.Create(ctx, input)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, model.NewValidationError(fmt.Errorf("quota budget %s already exists", input.Name))
+		}
+		return nil, fmt.Errorf("failed to create quota budget: %w", err)
+	}
+	return budget, nil
+}
+
+// UpdateBudget updates the quota budget with the given name
+func (qm *QuotaManager) UpdateBudget(ctx context.Context, name string, input ent.UpdateAPIQuotaBudgetInput) (*ent.APIQuotaBudget, error) {
+	budget, err := qm.getBudget(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if input.Percent != nil {
+		if err := qm.validateBudgetShare(ctx, name, *input.Percent); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := qm.budgetRepo.Update(ctx, string(budget.ID), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update quota budget: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteBudget deletes the quota budget with the given name. Its callers go
+// back to drawing from the pool-wide quota only; past usage is kept.
+func (qm *QuotaManager) DeleteBudget(ctx context.Context, name string) (*ent.APIQuotaBudget, error) {
+	budget, err := qm.getBudget(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := qm.budgetRepo.Delete(ctx, string(budget.ID)); err != nil {
+		return nil, fmt.Errorf("failed to delete quota budget: %w", err)
+	}
+	return budget, nil
+}
+
+// GetBudgetUsage retrieves the current month's tracker of every budget
+func (qm *QuotaManager) GetBudgetUsage(ctx context.Context) ([]*ent.APIQuotaTracker, error) {
+	tracker, err := qm.getOrCreateCurrentTracker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quota tracker: %w", err)
+	}
+	budgets, err := qm.ListBudgets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make([]*ent.APIQuotaTracker, 0, len(budgets))
+	for _, budget := range budgets {
+		budgetTracker, err := qm.getOrCreateBudgetTracker(ctx, tracker, budget)
+		if err != nil {
+			return nil, err
+		}
+		usage = append(usage, budgetTracker)
+	}
+	return usage, nil
+}
+
+// budgetTracker returns the current month's tracker of the named budget, or
+// nil when name is empty or no such budget exists.
+func (qm *QuotaManager) budgetTracker(
+	ctx context.Context,
+	tracker *ent.APIQuotaTracker,
+	name string,
+) (*ent.APIQuotaTracker, error) {
+	if name == "" {
+		return nil, nil
+	}
+	budget, err := qm.budgetRepo.GetByName(ctx, name)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get quota budget %s: %w", name, err)
+	}
+	return qm.getOrCreateBudgetTracker(ctx, tracker, budget)
+}
+
+// getOrCreateBudgetTracker gets or creates the budget's tracker for the
+// month of the pool-wide tracker, keeping its limit at the budget's share of
+// the pool-wide limit.
+func (qm *QuotaManager) getOrCreateBudgetTracker(
+	ctx context.Context,
+	tracker *ent.APIQuotaTracker,
+	budget *ent.APIQuotaBudget,
+) (*ent.APIQuotaTracker, error) {
+	limit := budgetLimit(tracker.QuotaLimit, budget.Percent)
+
+	budgetTracker, err := qm.repo.GetBudgetTracker(ctx, budget.Name, tracker.Month, tracker.Year)
+	if ent.IsNotFound(err) {
+		budgetTracker, err = qm.repo.CreateBudgetTracker(ctx, budget.Name, tracker.Month, tracker.Year, limit)
+		// Another worker may have created it first.
+		if ent.IsConstraintError(err) {
+			budgetTracker, err = qm.repo.GetBudgetTracker(ctx, budget.Name, tracker.Month, tracker.Year)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quota tracker for budget %s: %w", budget.Name, err)
+	}
+
+	// Follow changes to the budget's share or the pool-wide limit
+	if budgetTracker.QuotaLimit != limit {
+		budgetTracker, err = qm.repo.UpdateQuotaLimit(ctx, string(budgetTracker.ID), limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update quota limit for budget %s: %w", budget.Name, err)
+		}
+	}
+	return budgetTracker, nil
+}
+
+func (qm *QuotaManager) getBudget(ctx context.Context, name string) (*ent.APIQuotaBudget, error) {
+	budget, err := qm.budgetRepo.GetByName(ctx, name)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, model.NewNotFoundError(fmt.Errorf("quota budget %s not found", name), name)
+		}
+		return nil, fmt.Errorf("failed to get quota budget %s: %w", name, err)
+	}
+	return budget, nil
+}
+
+// validateBudgetShare checks that giving the named budget percent keeps the
+// budgets' total within 100%.
+func (qm *QuotaManager) validateBudgetShare(ctx context.Context, name string, percent float64) error {
+	budgets, err := qm.ListBudgets(ctx)
+	if err != nil {
+		return err
+	}
+	return checkBudgetShare(budgets, name, percent)
+}
+
+// checkBudgetShare reports an error when budgets, with the named one set to
+// percent, add up to more than 100%.
+func checkBudgetShare(budgets []*ent.APIQuotaBudget, name string, percent float64) error {
+	total := percent
+	for _, budget := range budgets {
+		if budget.Name != name {
+			total += budget.Percent
+		}
+	}
+	if total > 100+1e-9 {
+		return model.NewValidationError(
+			fmt.Errorf("quota budgets would add up to %.2f%% of the quota; the total may not exceed 100%%", total),
+		)
+	}
+	return nil
+}
+
+// budgetLimit returns a budget's share of the pool-wide limit, at least one
+// call.
+func budgetLimit(poolLimit int, percent float64) int {
+	return max(1, int(float64(poolLimit)*percent/100))
+}
@@ -0,0 +1,34 @@
+package apiquota
+
+import (
+	"sheng-go-backend/ent"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBudgetShare(t *testing.T) {
+	budgets := []*ent.APIQuotaBudget{
+		{Name: BudgetCron, Percent: 80},
+		{Name: BudgetInteractive, Percent: 15},
+	}
+
+	t.Run("Should allow budgets that add up to the whole quota", func(t *testing.T) {
+		assert.NoError(t, checkBudgetShare(budgets, BudgetPosts, 5))
+	})
+
+	t.Run("Should reject budgets that add up to more than the quota", func(t *testing.T) {
+		assert.Error(t, checkBudgetShare(budgets, BudgetPosts, 5.5))
+	})
+
+	t.Run("Should replace the share of the budget being updated", func(t *testing.T) {
+		assert.NoError(t, checkBudgetShare(budgets, BudgetCron, 85))
+		assert.Error(t, checkBudgetShare(budgets, BudgetCron, 86))
+	})
+}
+
+func TestBudgetLimit(t *testing.T) {
+	assert.Equal(t, 40000, budgetLimit(50000, 80))
+	assert.Equal(t, 7500, budgetLimit(50000, 15))
+	assert.Equal(t, 1, budgetLimit(50, 0.5))
+}
@@ -0,0 +1,91 @@
+package apiquota
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/schema/ulid"
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
+	"sheng-go-backend/pkg/infrastructure/external/rapidapi"
+	"slices"
+	"time"
+)
+
+// RecordCall adds a RapidAPI request to the call log, attributed to the
+// caller set on ctx with profileprovider.WithCaller. It implements
+// rapidapi.CallRecorder.
+func (qm *QuotaManager) RecordCall(ctx context.Context, call rapidapi.Call) error {
+	caller := profileprovider.CallerFromContext(ctx)
+	log := &ent.APICallLog{
+		Endpoint:  call.Endpoint,
+		KeyLabel:  call.KeyLabel,
+		Caller:    caller.Name,
+		RunID:     caller.RunID,
+		Urn:       call.ID,
+		LatencyMs: int(call.Latency.Milliseconds()),
+		Bytes:     call.Bytes,
+		Counted:   call.Counted,
+	}
+	if call.StatusCode != 0 {
+		log.HTTPStatus = &call.StatusCode
+	}
+
+	if _, err := qm.callLogRepo.Create(ctx, log); err != nil {
+		return fmt.Errorf("failed to record API call: %w", err)
+	}
+	return nil
+}
+
+// LinkRunCalls links the calls made in job run runID to its execution history
+func (qm *QuotaManager) LinkRunCalls(ctx context.Context, runID string, executionID ulid.ID) error {
+	if _, err := qm.callLogRepo.LinkRun(ctx, runID, executionID); err != nil {
+		return fmt.Errorf("failed to link API calls to job execution: %w", err)
+	}
+	return nil
+}
+
+// ListCalls retrieves logged API calls with pagination and filtering
+func (qm *QuotaManager) ListCalls(
+	ctx context.Context,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *ent.APICallLogWhereInput,
+) (*ent.APICallLogConnection, error) {
+	return qm.callLogRepo.List(ctx, after, first, before, last, where)
+}
+
+// GetCallStats aggregates the API calls made in [from, to) by groupBy,
+// ordered by group value. from defaults to the start of the current month and
+// to to now.
+func (qm *QuotaManager) GetCallStats(
+	ctx context.Context,
+	groupBy model.APICallGroupBy,
+	from *time.Time,
+	to *time.Time,
+) ([]*model.APICallStat, error) {
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	end := now
+	if from != nil {
+		start = *from
+	}
+	if to != nil {
+		end = *to
+	}
+	if !end.After(start) {
+		return nil, model.NewValidationError(fmt.Errorf("API call stats range must end after it starts"))
+	}
+
+	stats, err := qm.callLogRepo.Stats(ctx, groupBy, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate API calls: %w", err)
+	}
+	slices.SortFunc(stats, func(a, b *model.APICallStat) int {
+		return cmp.Compare(a.Group, b.Group)
+	})
+	return stats, nil
+}
@@ -0,0 +1,165 @@
+package apiquota
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/config"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/adapter/repository/apiquotatrackerrepository"
+	"sheng-go-backend/pkg/entity/model"
+	"time"
+)
+
+// UpdateCaps updates the current month's rolling caps and pacing mode
+func (qm *QuotaManager) UpdateCaps(ctx context.Context, input model.UpdateQuotaCapsInput) (*ent.APIQuotaTracker, error) {
+	tracker, err := qm.getOrCreateCurrentTracker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quota tracker: %w", err)
+	}
+
+	dailyCap, hourlyCap, evenPacing := tracker.DailyCap, tracker.HourlyCap, tracker.EvenPacing
+	if input.DailyCap != nil {
+		if *input.DailyCap < 0 {
+			return nil, model.NewValidationError(fmt.Errorf("daily cap may not be negative"))
+		}
+		dailyCap = capOrNil(*input.DailyCap)
+	}
+	if input.HourlyCap != nil {
+		if *input.HourlyCap < 0 {
+			return nil, model.NewValidationError(fmt.Errorf("hourly cap may not be negative"))
+		}
+		hourlyCap = capOrNil(*input.HourlyCap)
+	}
+	if input.EvenPacing != nil {
+		evenPacing = *input.EvenPacing
+	}
+
+	updatedTracker, err := qm.repo.UpdateCaps(ctx, string(tracker.ID), dailyCap, hourlyCap, evenPacing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update quota caps: %w", err)
+	}
+	return updatedTracker, nil
+}
+
+// GetPace compares the current month's usage with an even spread of the
+// limit and reports the rolling windows against their caps.
+func (qm *QuotaManager) GetPace(ctx context.Context) (*model.QuotaPace, error) {
+	tracker, err := qm.getOrCreateCurrentTracker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quota tracker: %w", err)
+	}
+
+	now := time.Now()
+	hourUsed, err := qm.repo.WindowUsage(ctx, now.Add(-time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	dayUsed, err := qm.repo.WindowUsage(ctx, now.Add(-24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+
+	caps := windowCaps(tracker, dayUsed, now)
+	planned := plannedByNow(tracker.QuotaLimit, now)
+	pace := &model.QuotaPace{
+		EvenPacing:   tracker.EvenPacing,
+		HourlyCap:    capOrNil(caps.Hourly),
+		HourUsed:     hourUsed,
+		DailyCap:     capOrNil(caps.Daily),
+		DayUsed:      dayUsed,
+		PlannedDaily: pacedDailyCap(tracker.QuotaLimit, usedBeforeWindow(tracker, dayUsed), now),
+		MonthUsed:    tracker.CallCount,
+		QuotaLimit:   tracker.QuotaLimit,
+		PlannedByNow: planned,
+	}
+	if planned > 0 {
+		pace.PaceRatio = float64(tracker.CallCount) / float64(planned)
+	}
+	return pace, nil
+}
+
+// currentCaps returns the caps Reserve enforces on tracker at now
+func (qm *QuotaManager) currentCaps(ctx context.Context, tracker *ent.APIQuotaTracker, now time.Time) (apiquotatrackerrepository.WindowCaps, error) {
+	if !tracker.EvenPacing {
+		return windowCaps(tracker, 0, now), nil
+	}
+	// Only even pacing depends on what the last day used
+	dayUsed, err := qm.repo.WindowUsage(ctx, now.Add(-24*time.Hour))
+	if err != nil {
+		return apiquotatrackerrepository.WindowCaps{}, err
+	}
+	return windowCaps(tracker, dayUsed, now), nil
+}
+
+// applyDefaultCaps gives a newly created pool-wide tracker the caps and
+// pacing mode from config.
+func (qm *QuotaManager) applyDefaultCaps(ctx context.Context, tracker *ent.APIQuotaTracker) (*ent.APIQuotaTracker, error) {
+	cfg := config.C.RapidAPI
+	if cfg.DailyCap <= 0 && cfg.HourlyCap <= 0 && !cfg.EvenPacing {
+		return tracker, nil
+	}
+	updatedTracker, err := qm.repo.UpdateCaps(ctx, string(tracker.ID), capOrNil(cfg.DailyCap), capOrNil(cfg.HourlyCap), cfg.EvenPacing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default quota caps: %w", err)
+	}
+	return updatedTracker, nil
+}
+
+// windowCaps returns the tracker's rolling caps at now, dayUsed being the
+// calls of the last 24 hours. With even pacing the daily cap is the lower of
+// the configured cap and the even share of what is left of the month.
+func windowCaps(tracker *ent.APIQuotaTracker, dayUsed int, now time.Time) apiquotatrackerrepository.WindowCaps {
+	var caps apiquotatrackerrepository.WindowCaps
+	if tracker.HourlyCap != nil {
+		caps.Hourly = *tracker.HourlyCap
+	}
+	if tracker.DailyCap != nil {
+		caps.Daily = *tracker.DailyCap
+	}
+	if tracker.EvenPacing {
+		// A paced share of 0 must still cap, so keep it at least one call
+		paced := max(1, pacedDailyCap(tracker.QuotaLimit, usedBeforeWindow(tracker, dayUsed), now))
+		if caps.Daily == 0 || paced < caps.Daily {
+			caps.Daily = paced
+		}
+	}
+	return caps
+}
+
+// usedBeforeWindow returns the month's calls, made or reserved, that fall
+// before the last 24 hours.
+func usedBeforeWindow(tracker *ent.APIQuotaTracker, dayUsed int) int {
+	return max(0, tracker.CallCount+tracker.ReservedCount-dayUsed)
+}
+
+// pacedDailyCap spreads what is left of limit after used evenly over the rest
+// of the month, today included, rounding up.
+func pacedDailyCap(limit, used int, now time.Time) int {
+	remaining := limit - used
+	if remaining <= 0 {
+		return 0
+	}
+	days := daysIn(now) - now.Day() + 1
+	return (remaining + days - 1) / days
+}
+
+// plannedByNow returns the part of limit an even pace would have used by now
+func plannedByNow(limit int, now time.Time) int {
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	end := start.AddDate(0, 1, 0)
+	elapsed := now.Sub(start).Seconds() / end.Sub(start).Seconds()
+	return int(float64(limit) * elapsed)
+}
+
+// daysIn returns the number of days in t's month
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+// capOrNil returns n as a pointer, or nil when it is not positive
+func capOrNil(n int) *int {
+	if n <= 0 {
+		return nil
+	}
+	return &n
+}
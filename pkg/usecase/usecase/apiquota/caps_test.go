@@ -0,0 +1,69 @@
+package apiquota
+
+import (
+	"sheng-go-backend/ent"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPacedDailyCap(t *testing.T) {
+	// 30-day month; the 21st leaves 10 days including today
+	now := time.Date(2025, time.June, 21, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Should spread the remaining quota over the remaining days", func(t *testing.T) {
+		assert.Equal(t, 1000, pacedDailyCap(30000, 20000, now))
+	})
+
+	t.Run("Should round up", func(t *testing.T) {
+		assert.Equal(t, 1001, pacedDailyCap(30000, 19991, now))
+	})
+
+	t.Run("Should give the whole rest on the last day", func(t *testing.T) {
+		last := time.Date(2025, time.June, 30, 23, 0, 0, 0, time.UTC)
+		assert.Equal(t, 750, pacedDailyCap(30000, 29250, last))
+	})
+
+	t.Run("Should return 0 when the quota is used up", func(t *testing.T) {
+		assert.Equal(t, 0, pacedDailyCap(30000, 31000, now))
+	})
+}
+
+func TestPlannedByNow(t *testing.T) {
+	assert.Equal(t, 0, plannedByNow(30000, time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 15000, plannedByNow(30000, time.Date(2025, time.June, 16, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestWindowCaps(t *testing.T) {
+	now := time.Date(2025, time.June, 21, 12, 0, 0, 0, time.UTC)
+	hourly, daily := 100, 800
+
+	t.Run("Should use the configured caps", func(t *testing.T) {
+		tracker := &ent.APIQuotaTracker{QuotaLimit: 30000, HourlyCap: &hourly, DailyCap: &daily}
+		caps := windowCaps(tracker, 0, now)
+		assert.Equal(t, 100, caps.Hourly)
+		assert.Equal(t, 800, caps.Daily)
+	})
+
+	t.Run("Should not cap without caps or pacing", func(t *testing.T) {
+		caps := windowCaps(&ent.APIQuotaTracker{QuotaLimit: 30000}, 0, now)
+		assert.Zero(t, caps.Hourly)
+		assert.Zero(t, caps.Daily)
+	})
+
+	t.Run("Should pace from usage before the last day", func(t *testing.T) {
+		tracker := &ent.APIQuotaTracker{QuotaLimit: 30000, CallCount: 20500, EvenPacing: true}
+		assert.Equal(t, 1000, windowCaps(tracker, 500, now).Daily)
+	})
+
+	t.Run("Should keep the lower of the configured and paced caps", func(t *testing.T) {
+		tracker := &ent.APIQuotaTracker{QuotaLimit: 30000, CallCount: 20000, EvenPacing: true, DailyCap: &daily}
+		assert.Equal(t, 800, windowCaps(tracker, 0, now).Daily)
+	})
+
+	t.Run("Should still cap once the paced share runs out", func(t *testing.T) {
+		tracker := &ent.APIQuotaTracker{QuotaLimit: 30000, CallCount: 30000, EvenPacing: true}
+		assert.Equal(t, 1, windowCaps(tracker, 0, now).Daily)
+	})
+}
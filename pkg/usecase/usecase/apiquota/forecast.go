@@ -0,0 +1,190 @@
+package apiquota
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/config"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/entity/model"
+	"slices"
+	"time"
+)
+
+// forecastWindow is how far back the jobs' call rate is measured
+const forecastWindow = 7 * 24 * time.Hour
+
+// defaultAlertThresholds are the usage alerts, in percent of the monthly
+// quota, sent when rapidapi.alertThresholds is not set.
+var defaultAlertThresholds = []int{50, 80, 95}
+
+// GetForecast projects the month's usage of tracker. Only the current
+// month's pool-wide tracker has a forecast; it returns nil for the others.
+func (qm *QuotaManager) GetForecast(ctx context.Context, tracker *ent.APIQuotaTracker) (*model.QuotaForecast, error) {
+	now := time.Now()
+	if tracker.KeyLabel != "" || tracker.Budget != "" ||
+		tracker.Month != int(now.Month()) || tracker.Year != now.Year() {
+		return nil, nil
+	}
+
+	monthStart := time.Date(tracker.Year, time.Month(tracker.Month), 1, 0, 0, 0, 0, now.Location())
+	windowStart := now.Add(-forecastWindow)
+	if windowStart.Before(monthStart) {
+		windowStart = monthStart
+	}
+
+	// Measure in counted calls, the unit of the tracker's call_count
+	counted := true
+	cron := BudgetCron
+	monthOtherCalls, err := qm.callLogRepo.Count(ctx, &ent.APICallLogWhereInput{
+		Counted:      &counted,
+		CreatedAtGTE: &monthStart,
+		CallerNEQ:    &cron,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count API calls made outside jobs: %w", err)
+	}
+	windowJobCalls, err := qm.callLogRepo.Count(ctx, &ent.APICallLogWhereInput{
+		Counted:      &counted,
+		CreatedAtGTE: &windowStart,
+		Caller:       &cron,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count job API calls: %w", err)
+	}
+
+	return forecastUsage(tracker, monthOtherCalls, windowJobCalls, windowStart, now), nil
+}
+
+// notifyUsage sends the alerts due after the last count calls on the
+// pool-wide tracker: each usage threshold once, and the exceeded alert when
+// they took it over its limit.
+func (qm *QuotaManager) notifyUsage(ctx context.Context, tracker *ent.APIQuotaTracker, count int) {
+	qm.notifyThresholds(ctx, tracker)
+
+	if !tracker.QuotaExceeded || tracker.CallCount-count >= tracker.QuotaLimit {
+		return
+	}
+	// Just exceeded, send notification
+	if err := qm.sendQuotaExceededNotification(ctx, tracker); err != nil {
+		fmt.Printf("Failed to send quota exceeded notification: %v\n", err)
+	}
+}
+
+// notifyThresholds sends one alert for the highest usage threshold the
+// tracker has newly crossed. Every crossed threshold is marked so none is
+// alerted twice.
+func (qm *QuotaManager) notifyThresholds(ctx context.Context, tracker *ent.APIQuotaTracker) {
+	crossed := crossedThresholds(tracker, alertThresholds())
+	if len(crossed) == 0 {
+		return
+	}
+
+	claimed, err := qm.repo.ClaimThresholdAlerts(ctx, string(tracker.ID), crossed)
+	if err != nil {
+		fmt.Printf("Failed to mark quota thresholds alerted: %v\n", err)
+		return
+	}
+	if len(claimed) == 0 {
+		// Another worker alerted them first
+		return
+	}
+
+	var exhaustionDate *time.Time
+	forecast, err := qm.GetForecast(ctx, tracker)
+	if err != nil {
+		fmt.Printf("Failed to forecast quota usage: %v\n", err)
+	} else if forecast != nil && forecast.ExhaustsBeforeReset {
+		exhaustionDate = forecast.ExhaustionDate
+	}
+
+	err = qm.emailService.SendQuotaThresholdAlert(
+		slices.Max(claimed),
+		tracker.CallCount,
+		tracker.QuotaLimit,
+		tracker.Month,
+		tracker.Year,
+		exhaustionDate,
+	)
+	if err != nil {
+		fmt.Printf("Failed to send quota threshold notification: %v\n", err)
+		// Let the next call try again
+		if err := qm.repo.ReleaseThresholdAlerts(ctx, string(tracker.ID), claimed); err != nil {
+			fmt.Printf("Failed to unmark quota thresholds alerted: %v\n", err)
+		}
+	}
+}
+
+// forecastUsage projects tracker's month at now. The call rate is the jobs'
+// calls since windowStart per day plus the month's average daily rate of the
+// monthOtherCalls made outside jobs.
+func forecastUsage(
+	tracker *ent.APIQuotaTracker,
+	monthOtherCalls, windowJobCalls int,
+	windowStart, now time.Time,
+) *model.QuotaForecast {
+	forecast := &model.QuotaForecast{LastCallAt: tracker.LastCallAt}
+	monthStart := time.Date(tracker.Year, time.Month(tracker.Month), 1, 0, 0, 0, 0, now.Location())
+	monthEnd := monthStart.AddDate(0, 1, 0)
+
+	if tracker.CallCount >= tracker.QuotaLimit {
+		// Already used up; the last call is when it ran out
+		forecast.ExhaustionDate = tracker.LastCallAt
+		forecast.ExhaustsBeforeReset = true
+	}
+	if tracker.LastCallAt == nil {
+		forecast.ProjectedMonthEnd = tracker.CallCount
+		return forecast
+	}
+
+	// Measure over at least an hour so a fresh month does not spike the rate
+	days := func(d time.Duration) float64 { return max(d.Hours(), 1) / 24 }
+	forecast.JobCallsPerDay = float64(windowJobCalls) / days(now.Sub(windowStart))
+	forecast.CallsPerDay = forecast.JobCallsPerDay + float64(monthOtherCalls)/days(now.Sub(monthStart))
+
+	daysLeft := max(0, monthEnd.Sub(now).Hours()/24)
+	forecast.ProjectedMonthEnd = tracker.CallCount + int(forecast.CallsPerDay*daysLeft)
+
+	remaining := tracker.QuotaLimit - tracker.CallCount
+	if remaining <= 0 || forecast.CallsPerDay <= 0 {
+		return forecast
+	}
+	// Beyond a year out the date means nothing and the duration overflows
+	daysToExhaustion := float64(remaining) / forecast.CallsPerDay
+	if daysToExhaustion < 366 {
+		at := now.Add(time.Duration(daysToExhaustion * 24 * float64(time.Hour)))
+		forecast.ExhaustionDate = &at
+		forecast.ExhaustsBeforeReset = at.Before(monthEnd)
+	}
+	return forecast
+}
+
+// crossedThresholds returns the thresholds tracker's usage has reached that
+// it has not been alerted about yet.
+func crossedThresholds(tracker *ent.APIQuotaTracker, thresholds []int) []int {
+	var crossed []int
+	for _, threshold := range thresholds {
+		if tracker.CallCount*100 >= threshold*tracker.QuotaLimit &&
+			!slices.Contains(tracker.AlertedThresholds, threshold) {
+			crossed = append(crossed, threshold)
+		}
+	}
+	return crossed
+}
+
+// alertThresholds returns the configured usage alert thresholds, sorted and
+// limited to 1-99%; 100% is the exceeded alert.
+func alertThresholds() []int {
+	configured := config.C.RapidAPI.AlertThresholds
+	if len(configured) == 0 {
+		return defaultAlertThresholds
+	}
+
+	thresholds := make([]int, 0, len(configured))
+	for _, threshold := range configured {
+		if threshold > 0 && threshold < 100 {
+			thresholds = append(thresholds, threshold)
+		}
+	}
+	slices.Sort(thresholds)
+	return slices.Compact(thresholds)
+}
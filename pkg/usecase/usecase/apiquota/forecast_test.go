@@ -0,0 +1,74 @@
+package apiquota
+
+import (
+	"sheng-go-backend/ent"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForecastUsage(t *testing.T) {
+	// June has 30 days; 10 days have passed
+	now := time.Date(2025, time.June, 11, 0, 0, 0, 0, time.UTC)
+	windowStart := now.Add(-forecastWindow)
+	lastCall := now.Add(-time.Hour)
+
+	t.Run("Should forecast from the jobs' recent rate and other calls' average", func(t *testing.T) {
+		tracker := &ent.APIQuotaTracker{Month: 6, Year: 2025, QuotaLimit: 30000, CallCount: 12000, LastCallAt: &lastCall}
+		// 7000 job calls over the last week, 2000 outside jobs this month
+		forecast := forecastUsage(tracker, 2000, 7000, windowStart, now)
+
+		assert.InDelta(t, 1000, forecast.JobCallsPerDay, 1e-9)
+		assert.InDelta(t, 1200, forecast.CallsPerDay, 1e-9)
+		assert.Equal(t, 36000, forecast.ProjectedMonthEnd)
+		if assert.NotNil(t, forecast.ExhaustionDate) {
+			assert.Equal(t, now.AddDate(0, 0, 15), *forecast.ExhaustionDate)
+		}
+		assert.True(t, forecast.ExhaustsBeforeReset)
+	})
+
+	t.Run("Should not exhaust before reset at a slow rate", func(t *testing.T) {
+		tracker := &ent.APIQuotaTracker{Month: 6, Year: 2025, QuotaLimit: 30000, CallCount: 3000, LastCallAt: &lastCall}
+		forecast := forecastUsage(tracker, 0, 2100, windowStart, now)
+
+		assert.Equal(t, 9000, forecast.ProjectedMonthEnd)
+		assert.False(t, forecast.ExhaustsBeforeReset)
+	})
+
+	t.Run("Should have no exhaustion date without calls", func(t *testing.T) {
+		tracker := &ent.APIQuotaTracker{Month: 6, Year: 2025, QuotaLimit: 30000}
+		forecast := forecastUsage(tracker, 0, 0, windowStart, now)
+
+		assert.Zero(t, forecast.CallsPerDay)
+		assert.Nil(t, forecast.ExhaustionDate)
+		assert.False(t, forecast.ExhaustsBeforeReset)
+	})
+
+	t.Run("Should report the last call once exhausted", func(t *testing.T) {
+		tracker := &ent.APIQuotaTracker{Month: 6, Year: 2025, QuotaLimit: 30000, CallCount: 30000, LastCallAt: &lastCall}
+		forecast := forecastUsage(tracker, 0, 21000, windowStart, now)
+
+		assert.Equal(t, &lastCall, forecast.ExhaustionDate)
+		assert.True(t, forecast.ExhaustsBeforeReset)
+	})
+}
+
+func TestCrossedThresholds(t *testing.T) {
+	thresholds := []int{50, 80, 95}
+
+	t.Run("Should return every threshold reached", func(t *testing.T) {
+		tracker := &ent.APIQuotaTracker{QuotaLimit: 1000, CallCount: 800}
+		assert.Equal(t, []int{50, 80}, crossedThresholds(tracker, thresholds))
+	})
+
+	t.Run("Should skip thresholds already alerted", func(t *testing.T) {
+		tracker := &ent.APIQuotaTracker{QuotaLimit: 1000, CallCount: 960, AlertedThresholds: []int{50, 80}}
+		assert.Equal(t, []int{95}, crossedThresholds(tracker, thresholds))
+	})
+
+	t.Run("Should return nothing below the lowest threshold", func(t *testing.T) {
+		tracker := &ent.APIQuotaTracker{QuotaLimit: 1000, CallCount: 499}
+		assert.Empty(t, crossedThresholds(tracker, thresholds))
+	})
+}
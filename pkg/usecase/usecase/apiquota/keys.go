@@ -0,0 +1,121 @@
+package apiquota
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/config"
+	"sheng-go-backend/ent"
+	"time"
+)
+
+// KeyExhausted reports whether the key's tracker for the current month has
+// reached its limit. Admin override on the key's tracker bypasses the check.
+func (qm *QuotaManager) KeyExhausted(ctx context.Context, label string) (bool, error) {
+	tracker, err := qm.getOrCreateKeyTracker(ctx, label)
+	if err != nil {
+		return false, err
+	}
+	return tracker.QuotaExceeded && !tracker.OverrideEnabled, nil
+}
+
+// RecordKeyCall counts one upstream call against the key's tracker and
+// reports whether the key is now out of quota.
+func (qm *QuotaManager) RecordKeyCall(ctx context.Context, label string) (bool, error) {
+	id, err := qm.keyTrackerID(ctx, label)
+	if err != nil {
+		return false, err
+	}
+	tracker, err := qm.repo.IncrementCallCount(ctx, id, 1)
+	if err != nil {
+		return false, fmt.Errorf("failed to increment call count for key %s: %w", label, err)
+	}
+	return tracker.QuotaExceeded && !tracker.OverrideEnabled, nil
+}
+
+// MarkKeyExhausted flags the key as out of quota for the current month.
+func (qm *QuotaManager) MarkKeyExhausted(ctx context.Context, label string) error {
+	id, err := qm.keyTrackerID(ctx, label)
+	if err != nil {
+		return err
+	}
+	if _, err := qm.repo.SetQuotaExceeded(ctx, id, true); err != nil {
+		return fmt.Errorf("failed to mark key %s exhausted: %w", label, err)
+	}
+	return nil
+}
+
+// MarkKeyRateLimited records the end of the key's 429 cooldown so the
+// dashboard can show it.
+func (qm *QuotaManager) MarkKeyRateLimited(ctx context.Context, label string, until time.Time) error {
+	id, err := qm.keyTrackerID(ctx, label)
+	if err != nil {
+		return err
+	}
+	if _, err := qm.repo.SetRateLimitedUntil(ctx, id, until); err != nil {
+		return fmt.Errorf("failed to record rate limit for key %s: %w", label, err)
+	}
+	return nil
+}
+
+// GetKeyUsage retrieves the current month's tracker of every key that has
+// been used.
+func (qm *QuotaManager) GetKeyUsage(ctx context.Context) ([]*ent.APIQuotaTracker, error) {
+	now := time.Now()
+	trackers, err := qm.repo.ListKeyTrackers(ctx, int(now.Month()), now.Year())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key usage: %w", err)
+	}
+	return trackers, nil
+}
+
+// keyTrackerID returns the ID of the key's tracker for the current month,
+// creating the tracker on first use. IDs are cached so counting a call is a
+// single update.
+func (qm *QuotaManager) keyTrackerID(ctx context.Context, label string) (string, error) {
+	now := time.Now()
+	cacheKey := fmt.Sprintf("%s/%d-%d", label, now.Year(), now.Month())
+	if id, ok := qm.keyTrackers.Load(cacheKey); ok {
+		return id.(string), nil
+	}
+
+	tracker, err := qm.getOrCreateKeyTracker(ctx, label)
+	if err != nil {
+		return "", err
+	}
+	qm.keyTrackers.Store(cacheKey, string(tracker.ID))
+	return string(tracker.ID), nil
+}
+
+// getOrCreateKeyTracker gets or creates the key's tracker for current month
+func (qm *QuotaManager) getOrCreateKeyTracker(ctx context.Context, label string) (*ent.APIQuotaTracker, error) {
+	now := time.Now()
+	tracker, err := qm.repo.GetKeyTracker(ctx, label, int(now.Month()), now.Year())
+	if err == nil {
+		return tracker, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get quota tracker for key %s: %w", label, err)
+	}
+
+	tracker, err = qm.repo.CreateKeyTracker(ctx, label, int(now.Month()), now.Year(), keyQuotaLimit())
+	if err != nil {
+		// Another worker may have created it first.
+		if ent.IsConstraintError(err) {
+			return qm.repo.GetKeyTracker(ctx, label, int(now.Month()), now.Year())
+		}
+		return nil, fmt.Errorf("failed to create quota tracker for key %s: %w", label, err)
+	}
+	return tracker, nil
+}
+
+// keyQuotaLimit returns the monthly limit of a single key, falling back to
+// rapidapi.monthlyQuota.
+func keyQuotaLimit() int {
+	if limit := config.C.RapidAPI.KeyMonthlyQuota; limit > 0 {
+		return limit
+	}
+	if limit := config.C.RapidAPI.MonthlyQuota; limit > 0 {
+		return limit
+	}
+	return 50000
+}
@@ -2,37 +2,59 @@ package apiquota
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sheng-go-backend/config"
 	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/adapter/repository/apicalllogrepository"
+	"sheng-go-backend/pkg/adapter/repository/apiquotabudgetrepository"
 	"sheng-go-backend/pkg/adapter/repository/apiquotatrackerrepository"
 	"sheng-go-backend/pkg/infrastructure/email"
+	"sync"
 	"time"
 )
 
 // QuotaManager handles API quota tracking and enforcement
 type QuotaManager struct {
 	repo         *apiquotatrackerrepository.APIQuotaTrackerRepository
+	budgetRepo   *apiquotabudgetrepository.APIQuotaBudgetRepository
+	callLogRepo  *apicalllogrepository.APICallLogRepository
 	emailService *email.EmailService
+	// keyTrackers caches key tracker IDs by label and month
+	keyTrackers sync.Map
 }
 
 // NewQuotaManager creates a new QuotaManager
 func NewQuotaManager(
 	repo *apiquotatrackerrepository.APIQuotaTrackerRepository,
+	budgetRepo *apiquotabudgetrepository.APIQuotaBudgetRepository,
+	callLogRepo *apicalllogrepository.APICallLogRepository,
 	emailService *email.EmailService,
 ) *QuotaManager {
 	return &QuotaManager{
 		repo:         repo,
+		budgetRepo:   budgetRepo,
+		callLogRepo:  callLogRepo,
 		emailService: emailService,
 	}
 }
 
-// CheckAndReserveQuota checks if quota is available and reserves it for the batch
-func (qm *QuotaManager) CheckAndReserveQuota(ctx context.Context, batchSize int) (int, error) {
+// defaultReservationTTL is how long a reservation holds its calls when
+// rapidapi.reservationTTLMinutes is not set. A holder that dies without
+// settling gives them back once it passes.
+const defaultReservationTTL = 30 * time.Minute
+
+// CheckAndReserveQuota reserves up to batchSize calls against the current
+// month's quota and, if a budget with that name exists, against the caller's
+// budget, within the rolling hourly and daily caps. The grant may be smaller
+// than batchSize when little quota or cap room is left.
+// Callers count the calls they make with CommitCalls and must return the rest
+// with ReleaseQuota.
+func (qm *QuotaManager) CheckAndReserveQuota(ctx context.Context, budget string, batchSize int) (*ent.APIQuotaReservation, error) {
 	// Get or create current month tracker
 	tracker, err := qm.getOrCreateCurrentTracker(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get quota tracker: %w", err)
+		return nil, fmt.Errorf("failed to get quota tracker: %w", err)
 	}
 
 	// Check if quota is exceeded and override is not enabled
@@ -44,46 +66,102 @@ func (qm *QuotaManager) CheckAndReserveQuota(ctx context.Context, batchSize int)
 				fmt.Printf("Failed to send quota exceeded notification: %v\n", err)
 			}
 		}
-		return 0, fmt.Errorf("API quota exceeded (%d/%d). Will auto-resume on 1st of next month. Admin can enable override.",
-			tracker.CallCount, tracker.QuotaLimit)
+		return nil, fmt.Errorf("%w (%d/%d). Will auto-resume on 1st of next month. Admin can enable override.",
+			apiquotatrackerrepository.ErrInsufficientQuota, tracker.CallCount, tracker.QuotaLimit)
+	}
+
+	budgetTracker, err := qm.budgetTracker(ctx, tracker, budget)
+	if err != nil {
+		return nil, err
+	}
+	budgetTrackerID := ""
+	if budgetTracker != nil {
+		budgetTrackerID = string(budgetTracker.ID)
 	}
 
-	// Calculate remaining quota
-	remaining := tracker.QuotaLimit - tracker.CallCount
+	now := time.Now()
+	caps, err := qm.currentCaps(ctx, tracker, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quota caps: %w", err)
+	}
 
-	// Determine allowed batch size
-	allowedBatchSize := batchSize
-	if remaining < batchSize {
-		allowedBatchSize = remaining
-		if allowedBatchSize <= 0 {
-			return 0, fmt.Errorf("insufficient API quota remaining")
+	reservation, err := qm.repo.Reserve(ctx, string(tracker.ID), budgetTrackerID, batchSize, reservationTTL(), caps)
+	if err != nil {
+		if errors.Is(err, apiquotatrackerrepository.ErrCapReached) {
+			return nil, fmt.Errorf("%w (hourly cap %d, daily cap %d; 0 means none). Will resume as the window rolls on.",
+				err, caps.Hourly, caps.Daily)
 		}
+		if !errors.Is(err, apiquotatrackerrepository.ErrInsufficientQuota) {
+			return nil, fmt.Errorf("failed to reserve quota: %w", err)
+		}
+		if budgetTracker != nil {
+			return nil, fmt.Errorf("%w (budget %s: %d/%d used)",
+				err, budget, budgetTracker.CallCount, budgetTracker.QuotaLimit)
+		}
+		return nil, err
 	}
 
-	return allowedBatchSize, nil
+	return reservation, nil
 }
 
-// IncrementCallCount increments the API call count
-func (qm *QuotaManager) IncrementCallCount(ctx context.Context, count int) error {
+// CommitCalls counts count calls made under reservation. A nil reservation,
+// e.g. a run that ignores quota, counts them with IncrementCallCount.
+// Calls beyond the reservation are counted too; when they use up the quota or
+// the rolling caps it returns an error wrapping
+// apiquotatrackerrepository.ErrInsufficientQuota or ErrCapReached, and the
+// caller must stop making calls.
+func (qm *QuotaManager) CommitCalls(ctx context.Context, reservation *ent.APIQuotaReservation, count int) error {
+	if reservation == nil {
+		return qm.IncrementCallCount(ctx, count)
+	}
+
 	tracker, err := qm.getOrCreateCurrentTracker(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get quota tracker: %w", err)
 	}
+	caps, err := qm.currentCaps(ctx, tracker, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to get quota caps: %w", err)
+	}
 
-	// Increment the call count
-	updatedTracker, err := qm.repo.IncrementCallCount(ctx, string(tracker.ID), count)
+	updatedTracker, err := qm.repo.CommitReservation(ctx, string(reservation.ID), count, caps)
+	if updatedTracker == nil {
+		return fmt.Errorf("failed to commit reserved calls: %w", err)
+	}
+
+	qm.notifyUsage(ctx, updatedTracker, count)
 	if err != nil {
-		return fmt.Errorf("failed to increment call count: %w", err)
+		return fmt.Errorf("calls beyond the reservation used up the quota: %w", err)
 	}
+	return nil
+}
 
-	// Check if quota just got exceeded
-	if updatedTracker.QuotaExceeded && !tracker.QuotaExceeded {
-		// Just exceeded, send notification
-		if err := qm.sendQuotaExceededNotification(ctx, updatedTracker); err != nil {
-			fmt.Printf("Failed to send quota exceeded notification: %v\n", err)
-		}
+// ReleaseQuota returns the reservation's unused calls to the quota. It is
+// safe to call with a nil or already released reservation.
+func (qm *QuotaManager) ReleaseQuota(ctx context.Context, reservation *ent.APIQuotaReservation) error {
+	if reservation == nil {
+		return nil
+	}
+	if _, err := qm.repo.ReleaseReservation(ctx, string(reservation.ID)); err != nil {
+		return fmt.Errorf("failed to release reserved calls: %w", err)
+	}
+	return nil
+}
+
+// IncrementCallCount counts count calls made without a reservation
+func (qm *QuotaManager) IncrementCallCount(ctx context.Context, count int) error {
+	tracker, err := qm.getOrCreateCurrentTracker(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get quota tracker: %w", err)
+	}
+
+	// Increment the call count, recording the calls for the rolling caps
+	updatedTracker, err := qm.repo.RecordCalls(ctx, string(tracker.ID), count)
+	if err != nil {
+		return fmt.Errorf("failed to increment call count: %w", err)
 	}
 
+	qm.notifyUsage(ctx, updatedTracker, count)
 	return nil
 }
 
@@ -116,12 +194,14 @@ func (qm *QuotaManager) ResetMonthlyQuota(ctx context.Context) error {
 	}
 
 	// Create new tracker for current month
-	_, err := qm.repo.Create(ctx, int(now.Month()), now.Year(), quotaLimit)
+	tracker, err := qm.repo.Create(ctx, int(now.Month()), now.Year(), quotaLimit)
 	if err != nil {
 		// Might already exist if called multiple times
 		if !ent.IsConstraintError(err) {
 			return fmt.Errorf("failed to create new quota tracker: %w", err)
 		}
+	} else if _, err := qm.applyDefaultCaps(ctx, tracker); err != nil {
+		return err
 	}
 
 	// Send reset notification
@@ -153,6 +233,7 @@ func (qm *QuotaManager) getOrCreateCurrentTracker(ctx context.Context) (*ent.API
 			if err != nil {
 				return nil, fmt.Errorf("failed to create quota tracker: %w", err)
 			}
+			return qm.applyDefaultCaps(ctx, tracker)
 		} else {
 			return nil, fmt.Errorf("failed to get quota tracker: %w", err)
 		}
@@ -201,3 +282,12 @@ func (qm *QuotaManager) sendQuotaExceededNotification(ctx context.Context, track
 	_, err := qm.repo.SetNotificationSent(ctx, string(tracker.ID), true)
 	return err
 }
+
+// reservationTTL returns how long a reservation holds its calls
+func reservationTTL() time.Duration {
+	ttl := time.Duration(config.C.RapidAPI.ReservationTTLMinutes) * time.Minute
+	if ttl <= 0 {
+		return defaultReservationTTL
+	}
+	return ttl
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/usecase/repository"
+)
+
+type companyUseCase struct {
+	companyRepository repository.Company
+}
+
+type Company interface {
+	Get(ctx context.Context, id model.ID) (*model.Company, error)
+	List(ctx context.Context,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *model.CompanyWhereInput,
+	) (*model.CompanyConnection, error)
+	CurrentEmployees(ctx context.Context,
+		companyID model.ID,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *model.ProfileWhereInput,
+	) (*model.ProfileConnection, error)
+	Alumni(ctx context.Context,
+		companyID model.ID,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *model.ProfileWhereInput,
+	) (*model.ProfileConnection, error)
+	HeadcountByTitle(
+		ctx context.Context,
+		companyID model.ID,
+		alumni bool,
+		minCount int,
+	) ([]*model.ProfileTitleGroup, error)
+}
+
+func NewCompanyUseCase(r repository.Company) Company {
+	return &companyUseCase{companyRepository: r}
+}
+
+func (c *companyUseCase) Get(ctx context.Context, id model.ID) (*model.Company, error) {
+	return c.companyRepository.Get(ctx, id)
+}
+
+func (c *companyUseCase) List(
+	ctx context.Context,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *model.CompanyWhereInput,
+) (*model.CompanyConnection, error) {
+	return c.companyRepository.List(ctx, after, first, before, last, where)
+}
+
+func (c *companyUseCase) CurrentEmployees(
+	ctx context.Context,
+	companyID model.ID,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *model.ProfileWhereInput,
+) (*model.ProfileConnection, error) {
+	return c.companyRepository.ListCurrentEmployees(ctx, companyID, after, first, before, last, where)
+}
+
+func (c *companyUseCase) Alumni(
+	ctx context.Context,
+	companyID model.ID,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *model.ProfileWhereInput,
+) (*model.ProfileConnection, error) {
+	return c.companyRepository.ListAlumni(ctx, companyID, after, first, before, last, where)
+}
+
+func (c *companyUseCase) HeadcountByTitle(
+	ctx context.Context,
+	companyID model.ID,
+	alumni bool,
+	minCount int,
+) ([]*model.ProfileTitleGroup, error) {
+	return c.companyRepository.HeadcountByTitle(ctx, companyID, alumni, minCount)
+}
@@ -0,0 +1,318 @@
+package posthistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/adapter/repository/apiquotatrackerrepository"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
+	"time"
+)
+
+// defaultMaxPages caps a walk when Options.MaxPages is not set, so a feed
+// that never ends cannot drain the quota.
+const defaultMaxPages = 100
+
+// PostsFetcher fetches one page of a profile's posts starting at offset
+// start. profileprovider.ProfileProvider implements it.
+type PostsFetcher interface {
+	FetchProfilePosts(
+		ctx context.Context,
+		username string,
+		start int,
+	) ([]map[string]interface{}, []byte, error)
+}
+
+// Quota reserves, records and releases provider calls.
+// apiquota.QuotaManager implements it.
+type Quota interface {
+	CheckAndReserveQuota(ctx context.Context, budget string, batchSize int) (*ent.APIQuotaReservation, error)
+	CommitCalls(ctx context.Context, reservation *ent.APIQuotaReservation, count int) error
+	ReleaseQuota(ctx context.Context, reservation *ent.APIQuotaReservation) error
+}
+
+// KnownPosts reports which post URNs are already stored for a profile.
+// profilepostrepository.ProfilePostRepository implements it.
+type KnownPosts interface {
+	ExistingPostURNs(ctx context.Context, username string, urns []string) (map[string]bool, error)
+}
+
+// Options bound a walk through a profile's post history. Zero values mean
+// no limit.
+type Options struct {
+	// Since ends the walk at the first post published before it. Older
+	// posts at the top of a page, like pinned posts, are skipped instead.
+	Since time.Time
+	// MaxPosts ends the walk once this many new posts have been returned.
+	MaxPosts int
+	// MaxPages caps the number of provider calls. Defaults to 100.
+	MaxPages int
+	// PageSize is the provider's page size. When set, a shorter page ends
+	// the walk without spending a call on an empty page.
+	PageSize int
+	// Budget is the quota budget pages are reserved against, e.g.
+	// apiquota.BudgetPosts. Empty draws from the pool-wide quota only.
+	Budget string
+}
+
+// StopReason tells why a walk ended.
+type StopReason string
+
+const (
+	// StopEndOfFeed means the provider has no older posts.
+	StopEndOfFeed StopReason = "END_OF_FEED"
+	// StopCutoff means a post older than Options.Since was reached.
+	StopCutoff StopReason = "CUTOFF"
+	// StopMaxPosts means Options.MaxPosts new posts were returned.
+	StopMaxPosts StopReason = "MAX_POSTS"
+	// StopMaxPages means Options.MaxPages pages were fetched.
+	StopMaxPages StopReason = "MAX_PAGES"
+	// StopQuota means the quota or the rolling caps have no room for
+	// another page.
+	StopQuota StopReason = "QUOTA"
+	// StopError means a fetch or lookup failed; see Iterator.Err.
+	StopError StopReason = "ERROR"
+)
+
+// Page is one provider page of posts.
+type Page struct {
+	// Start is the offset the page was requested at.
+	Start int
+	// Posts are the page's posts that are neither stored already, seen
+	// earlier in the walk, nor older than the cutoff.
+	Posts []map[string]interface{}
+	// Raw is the provider response body.
+	Raw []byte
+	// Fetched is the number of posts the provider returned.
+	Fetched int
+	// Duplicates is the number of returned posts skipped as already known.
+	Duplicates int
+}
+
+// Iterator pages through a profile's posts, newest first. Each page costs
+// one provider call, which is reserved against the quota before it is made.
+//
+//	it := posthistory.NewIterator(provider, quotaManager, postRepo, username, opts)
+//	for it.Next(ctx) {
+//		save(it.Page().Posts)
+//	}
+//	if err := it.Err(); err != nil { ... }
+type Iterator struct {
+	fetcher  PostsFetcher
+	quota    Quota
+	known    KnownPosts
+	username string
+	opts     Options
+
+	start    int
+	pages    int
+	returned int
+	seen     map[string]bool
+	page     *Page
+	stop     StopReason
+	err      error
+}
+
+// NewIterator creates an Iterator over username's posts. quota and known
+// may be nil to skip quota accounting or deduplication against stored posts.
+func NewIterator(
+	fetcher PostsFetcher,
+	quota Quota,
+	known KnownPosts,
+	username string,
+	opts Options,
+) *Iterator {
+	if opts.MaxPages <= 0 {
+		opts.MaxPages = defaultMaxPages
+	}
+	return &Iterator{
+		fetcher:  fetcher,
+		quota:    quota,
+		known:    known,
+		username: username,
+		opts:     opts,
+		seen:     map[string]bool{},
+	}
+}
+
+// Next fetches the next page and reports whether one is available through
+// Page. It returns false once the walk has stopped; Stop tells why.
+func (it *Iterator) Next(ctx context.Context) bool {
+	it.page = nil
+	if it.stop != "" {
+		return false
+	}
+	if it.pages >= it.opts.MaxPages {
+		it.stop = StopMaxPages
+		return false
+	}
+
+	var reservation *ent.APIQuotaReservation
+	if it.quota != nil {
+		var err error
+		reservation, err = it.quota.CheckAndReserveQuota(ctx, it.opts.Budget, 1)
+		if errors.Is(err, apiquotatrackerrepository.ErrInsufficientQuota) ||
+			errors.Is(err, apiquotatrackerrepository.ErrCapReached) {
+			log.Printf("Post history for %s stopped by quota: %v", it.username, err)
+			it.stop = StopQuota
+			return false
+		}
+		if err != nil {
+			return it.fail(fmt.Errorf("reserve quota for %s: %w", it.username, err))
+		}
+	}
+
+	callCtx, calls := profileprovider.WithCallCount(ctx)
+	posts, raw, err := it.fetcher.FetchProfilePosts(callCtx, it.username, it.start)
+	if it.quota != nil {
+		// Failed and retried calls are billed too
+		if n := calls.Calls(); n > 0 {
+			if err := it.quota.CommitCalls(ctx, reservation, n); err != nil {
+				log.Printf("Failed to increment quota count: %v", err)
+			}
+		}
+		it.releaseQuota(ctx, reservation)
+	}
+	if err != nil {
+		return it.fail(fmt.Errorf("fetch posts for %s at %d: %w", it.username, it.start, err))
+	}
+	it.pages++
+
+	if len(posts) == 0 {
+		it.stop = StopEndOfFeed
+		return false
+	}
+
+	page := &Page{Start: it.start, Raw: raw, Fetched: len(posts)}
+	it.start += len(posts)
+
+	known, err := it.knownURNs(ctx, posts)
+	if err != nil {
+		return it.fail(fmt.Errorf("look up stored posts for %s: %w", it.username, err))
+	}
+
+	// Pinned posts sit at the top of a page whatever their age, so an old
+	// post only ends the walk once a newer one has been seen on the page
+	inWindow := false
+	for _, post := range posts {
+		if it.beforeCutoff(post) {
+			if inWindow {
+				it.stop = StopCutoff
+				break
+			}
+			continue
+		}
+		inWindow = true
+
+		urn := PostURN(post)
+		if urn != "" && (known[urn] || it.seen[urn]) {
+			page.Duplicates++
+			continue
+		}
+		if urn != "" {
+			it.seen[urn] = true
+		}
+
+		page.Posts = append(page.Posts, post)
+		it.returned++
+		if it.opts.MaxPosts > 0 && it.returned >= it.opts.MaxPosts {
+			it.stop = StopMaxPosts
+			break
+		}
+	}
+
+	if !inWindow {
+		it.stop = StopCutoff
+	}
+	if it.stop == "" && it.opts.PageSize > 0 && len(posts) < it.opts.PageSize {
+		it.stop = StopEndOfFeed
+	}
+
+	it.page = page
+	return true
+}
+
+// Page returns the page fetched by the last successful call to Next.
+func (it *Iterator) Page() *Page {
+	return it.page
+}
+
+// Err returns the error that ended the walk, if any. Running out of quota
+// is a normal stop, not an error.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Stop returns why the walk ended, or "" while it is still running.
+func (it *Iterator) Stop() StopReason {
+	return it.stop
+}
+
+// Pages returns the number of provider calls made so far.
+func (it *Iterator) Pages() int {
+	return it.pages
+}
+
+// Returned returns the number of new posts returned so far.
+func (it *Iterator) Returned() int {
+	return it.returned
+}
+
+// releaseQuota returns the unused part of a page's reservation
+func (it *Iterator) releaseQuota(ctx context.Context, reservation *ent.APIQuotaReservation) {
+	if it.quota == nil {
+		return
+	}
+	if err := it.quota.ReleaseQuota(ctx, reservation); err != nil {
+		log.Printf("Failed to release reserved quota: %v", err)
+	}
+}
+
+func (it *Iterator) fail(err error) bool {
+	it.err = err
+	it.stop = StopError
+	return false
+}
+
+// beforeCutoff reports whether post was published before Options.Since
+func (it *Iterator) beforeCutoff(post map[string]interface{}) bool {
+	posted, ok := PostedAt(post)
+	return ok && !it.opts.Since.IsZero() && posted.Before(it.opts.Since)
+}
+
+func (it *Iterator) knownURNs(ctx context.Context, posts []map[string]interface{}) (map[string]bool, error) {
+	if it.known == nil {
+		return nil, nil
+	}
+	urns := make([]string, 0, len(posts))
+	for _, post := range posts {
+		if urn := PostURN(post); urn != "" {
+			urns = append(urns, urn)
+		}
+	}
+	return it.known.ExistingPostURNs(ctx, it.username, urns)
+}
+
+// PostURN returns the post's "urn", or "" when it has none.
+func PostURN(post map[string]interface{}) string {
+	urn, _ := post["urn"].(string)
+	return urn
+}
+
+// PostedAt returns when the post was published, from postedDateTimestamp
+// (unix ms).
+func PostedAt(post map[string]interface{}) (time.Time, bool) {
+	switch ts := post["postedDateTimestamp"].(type) {
+	case float64:
+		if ts > 0 {
+			return time.UnixMilli(int64(ts)), true
+		}
+	case int64:
+		if ts > 0 {
+			return time.UnixMilli(ts), true
+		}
+	}
+	return time.Time{}, false
+}
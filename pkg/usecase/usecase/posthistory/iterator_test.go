@@ -0,0 +1,266 @@
+package posthistory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/adapter/repository/apiquotatrackerrepository"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
+	"sheng-go-backend/testutil/fakerapidapi"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubFetcher serves the fake server's synthetic history, decoded as JSON so
+// numbers are float64 like real responses.
+type stubFetcher struct {
+	total    int
+	pageSize int
+	starts   []int
+}
+
+func (f *stubFetcher) FetchProfilePosts(
+	ctx context.Context,
+	username string,
+	start int,
+) ([]map[string]interface{}, []byte, error) {
+	profileprovider.CountCall(ctx)
+	f.starts = append(f.starts, start)
+	raw, _ := json.Marshal(fakerapidapi.Posts(username, start, f.pageSize, f.total))
+	var posts []map[string]interface{}
+	if err := json.Unmarshal(raw, &posts); err != nil {
+		return nil, nil, err
+	}
+	return posts, raw, nil
+}
+
+// failingFetcher is billed for every page but never returns one.
+type failingFetcher struct{}
+
+func (failingFetcher) FetchProfilePosts(ctx context.Context, _ string, _ int) ([]map[string]interface{}, []byte, error) {
+	profileprovider.CountCall(ctx)
+	return nil, nil, errors.New("upstream error")
+}
+
+// pagedFetcher serves fixed pages, one per call.
+type pagedFetcher [][]map[string]interface{}
+
+func (f *pagedFetcher) FetchProfilePosts(ctx context.Context, _ string, _ int) ([]map[string]interface{}, []byte, error) {
+	profileprovider.CountCall(ctx)
+	if len(*f) == 0 {
+		return nil, nil, nil
+	}
+	page := (*f)[0]
+	*f = (*f)[1:]
+	return page, nil, nil
+}
+
+func post(urn string, posted time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"urn":                 urn,
+		"postedDateTimestamp": float64(posted.UnixMilli()),
+	}
+}
+
+type stubQuota struct {
+	remaining int
+	used      int
+	reserved  int
+	err       error
+}
+
+func (q *stubQuota) CheckAndReserveQuota(_ context.Context, _ string, n int) (*ent.APIQuotaReservation, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	if q.remaining-q.used-q.reserved < n {
+		return nil, apiquotatrackerrepository.ErrInsufficientQuota
+	}
+	q.reserved += n
+	return &ent.APIQuotaReservation{Reserved: n}, nil
+}
+
+func (q *stubQuota) CommitCalls(_ context.Context, r *ent.APIQuotaReservation, n int) error {
+	q.used += n
+	q.reserved -= n
+	r.Used += n
+	return nil
+}
+
+func (q *stubQuota) ReleaseQuota(_ context.Context, r *ent.APIQuotaReservation) error {
+	q.reserved -= r.Reserved - r.Used
+	r.Reserved = r.Used
+	return nil
+}
+
+type stubKnown map[string]bool
+
+func (k stubKnown) ExistingPostURNs(_ context.Context, _ string, urns []string) (map[string]bool, error) {
+	found := map[string]bool{}
+	for _, urn := range urns {
+		if k[urn] {
+			found[urn] = true
+		}
+	}
+	return found, nil
+}
+
+func collect(ctx context.Context, it *Iterator) []map[string]interface{} {
+	var posts []map[string]interface{}
+	for it.Next(ctx) {
+		posts = append(posts, it.Page().Posts...)
+	}
+	return posts
+}
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Should page through the whole feed", func(t *testing.T) {
+		fetcher := &stubFetcher{total: 12, pageSize: 5}
+		it := NewIterator(fetcher, nil, nil, "jane", Options{})
+
+		posts := collect(ctx, it)
+
+		require.NoError(t, it.Err())
+		assert.Len(t, posts, 12)
+		assert.Equal(t, StopEndOfFeed, it.Stop())
+		assert.Equal(t, []int{0, 5, 10, 12}, fetcher.starts)
+	})
+
+	t.Run("Should skip the empty page when the page size is known", func(t *testing.T) {
+		fetcher := &stubFetcher{total: 12, pageSize: 5}
+		it := NewIterator(fetcher, nil, nil, "jane", Options{PageSize: 5})
+
+		assert.Len(t, collect(ctx, it), 12)
+		assert.Equal(t, StopEndOfFeed, it.Stop())
+		assert.Equal(t, 3, it.Pages())
+	})
+
+	t.Run("Should stop at the date cutoff", func(t *testing.T) {
+		fetcher := &stubFetcher{total: 50, pageSize: 5}
+		// Synthetic posts are weekly from 2024-06-01 backwards
+		since := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC).Add(-7 * 7 * 24 * time.Hour)
+		it := NewIterator(fetcher, nil, nil, "jane", Options{Since: since})
+
+		posts := collect(ctx, it)
+
+		assert.Len(t, posts, 8)
+		assert.Equal(t, StopCutoff, it.Stop())
+		assert.Equal(t, 2, it.Pages())
+		for _, post := range posts {
+			posted, ok := PostedAt(post)
+			require.True(t, ok)
+			assert.False(t, posted.Before(since))
+		}
+	})
+
+	t.Run("Should skip old pinned posts at the top of a page", func(t *testing.T) {
+		since := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		day := 24 * time.Hour
+		fetcher := &pagedFetcher{
+			{post("pinned", since.Add(-90*day)), post("a", since.Add(20*day)), post("b", since.Add(10*day))},
+			{post("c", since.Add(5*day)), post("d", since.Add(-day)), post("e", since.Add(-2*day))},
+		}
+		it := NewIterator(fetcher, nil, nil, "jane", Options{Since: since})
+
+		posts := collect(ctx, it)
+
+		require.NoError(t, it.Err())
+		assert.Equal(t, StopCutoff, it.Stop())
+		assert.Equal(t, 2, it.Pages())
+		var urns []string
+		for _, p := range posts {
+			urns = append(urns, PostURN(p))
+		}
+		assert.Equal(t, []string{"a", "b", "c"}, urns)
+	})
+
+	t.Run("Should stop at a page older than the cutoff", func(t *testing.T) {
+		since := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		fetcher := &pagedFetcher{
+			{post("a", since.Add(-time.Hour)), post("b", since.Add(-2*time.Hour))},
+			{post("c", since.Add(-3*time.Hour))},
+		}
+		it := NewIterator(fetcher, nil, nil, "jane", Options{Since: since})
+
+		assert.Empty(t, collect(ctx, it))
+		assert.Equal(t, StopCutoff, it.Stop())
+		assert.Equal(t, 1, it.Pages())
+	})
+
+	t.Run("Should stop at the max post count", func(t *testing.T) {
+		fetcher := &stubFetcher{total: 50, pageSize: 5}
+		it := NewIterator(fetcher, nil, nil, "jane", Options{MaxPosts: 7})
+
+		assert.Len(t, collect(ctx, it), 7)
+		assert.Equal(t, StopMaxPosts, it.Stop())
+		assert.Equal(t, 2, it.Pages())
+	})
+
+	t.Run("Should skip posts that are already stored", func(t *testing.T) {
+		fetcher := &stubFetcher{total: 10, pageSize: 5}
+		first := fakerapidapi.Posts("jane", 0, 2, 10)
+		known := stubKnown{}
+		for _, p := range first {
+			known[p.(map[string]interface{})["urn"].(string)] = true
+		}
+		it := NewIterator(fetcher, nil, known, "jane", Options{PageSize: 5})
+
+		require.True(t, it.Next(ctx))
+		assert.Equal(t, 5, it.Page().Fetched)
+		assert.Equal(t, 2, it.Page().Duplicates)
+		assert.Len(t, it.Page().Posts, 3)
+
+		assert.Len(t, collect(ctx, it), 5)
+		assert.Equal(t, 8, it.Returned())
+	})
+
+	t.Run("Should reserve quota per page and stop when it runs out", func(t *testing.T) {
+		fetcher := &stubFetcher{total: 50, pageSize: 5}
+		quota := &stubQuota{remaining: 3}
+		it := NewIterator(fetcher, quota, nil, "jane", Options{})
+
+		assert.Len(t, collect(ctx, it), 15)
+		require.NoError(t, it.Err())
+		assert.Equal(t, StopQuota, it.Stop())
+		assert.Equal(t, 3, quota.used)
+		assert.Zero(t, quota.reserved)
+	})
+
+	t.Run("Should stop with QUOTA when the rolling cap is reached", func(t *testing.T) {
+		fetcher := &stubFetcher{total: 50, pageSize: 5}
+		quota := &stubQuota{err: fmt.Errorf("%w (hourly cap 10)", apiquotatrackerrepository.ErrCapReached)}
+		it := NewIterator(fetcher, quota, nil, "jane", Options{})
+
+		assert.Empty(t, collect(ctx, it))
+		require.NoError(t, it.Err())
+		assert.Equal(t, StopQuota, it.Stop())
+	})
+
+	t.Run("Should fail when the quota cannot be checked", func(t *testing.T) {
+		fetcher := &stubFetcher{total: 50, pageSize: 5}
+		quota := &stubQuota{err: errors.New("connection refused")}
+		it := NewIterator(fetcher, quota, nil, "jane", Options{})
+
+		assert.Empty(t, collect(ctx, it))
+		assert.ErrorContains(t, it.Err(), "connection refused")
+		assert.Equal(t, StopError, it.Stop())
+		assert.Empty(t, fetcher.starts)
+	})
+
+	t.Run("Should count the calls billed for a failed page", func(t *testing.T) {
+		quota := &stubQuota{remaining: 3}
+		it := NewIterator(failingFetcher{}, quota, nil, "jane", Options{})
+
+		assert.Empty(t, collect(ctx, it))
+		assert.Error(t, it.Err())
+		assert.Equal(t, 1, quota.used)
+		assert.Zero(t, quota.reserved)
+	})
+}
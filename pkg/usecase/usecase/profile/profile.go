@@ -4,6 +4,7 @@ import (
 	"context"
 	"sheng-go-backend/pkg/entity/model"
 	"sheng-go-backend/pkg/usecase/repository"
+	"sheng-go-backend/pkg/usecase/usecase/profilediff"
 )
 
 type profileUseCase struct {
@@ -24,6 +25,23 @@ type Profile interface {
 		searchTerm *string,
 		minCount int,
 	) ([]*model.ProfileTitleGroup, error)
+	Snapshots(ctx context.Context, profileID model.ID) ([]*model.ProfileSnapshot, error)
+	Changes(ctx context.Context, profileID model.ID) ([]*model.ProfileChange, error)
+	Positions(
+		ctx context.Context,
+		profileID model.ID,
+		where *model.ProfilePositionWhereInput,
+	) ([]*model.ProfilePosition, error)
+	Educations(
+		ctx context.Context,
+		profileID model.ID,
+		where *model.ProfileEducationWhereInput,
+	) ([]*model.ProfileEducation, error)
+	Skills(
+		ctx context.Context,
+		profileID model.ID,
+		where *model.ProfileSkillWhereInput,
+	) ([]*model.ProfileSkill, error)
 }
 
 func NewProfileUseCase(r repository.Profile) Profile {
@@ -68,3 +86,51 @@ func (p *profileUseCase) GroupByTitle(
 ) ([]*model.ProfileTitleGroup, error) {
 	return p.profileRepository.GroupByTitle(ctx, searchTerm, minCount)
 }
+
+// Snapshots returns the snapshots of a profile, oldest first.
+func (p *profileUseCase) Snapshots(
+	ctx context.Context,
+	profileID model.ID,
+) ([]*model.ProfileSnapshot, error) {
+	return p.profileRepository.ListSnapshots(ctx, profileID)
+}
+
+// Changes returns the field-level changes between consecutive snapshots of a
+// profile, newest first.
+func (p *profileUseCase) Changes(
+	ctx context.Context,
+	profileID model.ID,
+) ([]*model.ProfileChange, error) {
+	snapshots, err := p.profileRepository.ListSnapshots(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	return profilediff.History(snapshots), nil
+}
+
+// Positions returns the positions of a profile matching where, most recent first.
+func (p *profileUseCase) Positions(
+	ctx context.Context,
+	profileID model.ID,
+	where *model.ProfilePositionWhereInput,
+) ([]*model.ProfilePosition, error) {
+	return p.profileRepository.ListPositions(ctx, profileID, where)
+}
+
+// Educations returns the educations of a profile matching where, most recent first.
+func (p *profileUseCase) Educations(
+	ctx context.Context,
+	profileID model.ID,
+	where *model.ProfileEducationWhereInput,
+) ([]*model.ProfileEducation, error) {
+	return p.profileRepository.ListEducations(ctx, profileID, where)
+}
+
+// Skills returns the skills of a profile matching where, most endorsed first.
+func (p *profileUseCase) Skills(
+	ctx context.Context,
+	profileID model.ID,
+	where *model.ProfileSkillWhereInput,
+) ([]*model.ProfileSkill, error) {
+	return p.profileRepository.ListSkills(ctx, profileID, where)
+}
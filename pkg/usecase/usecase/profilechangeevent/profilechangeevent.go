@@ -0,0 +1,37 @@
+package profilechangeevent
+
+import (
+	"context"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/usecase/repository"
+)
+
+type useCase struct {
+	repo repository.ProfileChangeEvent
+}
+
+type UseCase interface {
+	List(ctx context.Context,
+		after *model.Cursor,
+		first *int,
+		before *model.Cursor,
+		last *int,
+		where *ent.ProfileChangeEventWhereInput,
+	) (*ent.ProfileChangeEventConnection, error)
+}
+
+func New(repo repository.ProfileChangeEvent) UseCase {
+	return &useCase{repo: repo}
+}
+
+func (u *useCase) List(
+	ctx context.Context,
+	after *model.Cursor,
+	first *int,
+	before *model.Cursor,
+	last *int,
+	where *ent.ProfileChangeEventWhereInput,
+) (*ent.ProfileChangeEventConnection, error) {
+	return u.repo.List(ctx, after, first, before, last, where)
+}
@@ -0,0 +1,180 @@
+// Package profilediff computes field-level changes between profile snapshots.
+package profilediff
+
+import (
+	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/util/profiledata"
+	"strings"
+)
+
+// State is the part of a profile the diff engine compares.
+type State struct {
+	Headline   string
+	Country    string
+	City       string
+	Positions  []profiledata.Position
+	Educations []profiledata.Education
+	Skills     []profiledata.Skill
+}
+
+// FromSnapshot extracts the comparable state of a snapshot.
+func FromSnapshot(s *ent.ProfileSnapshot) State {
+	return State{
+		Headline:   deref(s.Headline),
+		Country:    deref(s.Country),
+		City:       deref(s.City),
+		Positions:  profiledata.Positions(s.Positions),
+		Educations: profiledata.Educations(s.Educations),
+		Skills:     profiledata.Skills(s.Skills),
+	}
+}
+
+// Change is a single difference between two states.
+type Change struct {
+	Type     model.ProfileChangeType
+	Field    string
+	OldValue *string
+	NewValue *string
+}
+
+// Diff returns the changes from prev to next. Strings are compared ignoring
+// case and whitespace, so cosmetic edits do not produce changes.
+func Diff(prev, next State) []Change {
+	var changes []Change
+	changes = append(changes, diffCurrentPosition(prev, next)...)
+	changes = append(changes, diffPositions(prev, next)...)
+
+	if profiledata.Normalize(prev.City) != profiledata.Normalize(next.City) ||
+		profiledata.Normalize(prev.Country) != profiledata.Normalize(next.Country) {
+		changes = append(changes, newChange(model.ProfileChangeTypeLocationChange, "location", location(prev), location(next)))
+	}
+	if profiledata.Normalize(prev.Headline) != profiledata.Normalize(next.Headline) {
+		changes = append(changes, newChange(model.ProfileChangeTypeHeadlineChange, "headline", prev.Headline, next.Headline))
+	}
+
+	changes = append(changes, diffEducations(prev, next)...)
+	changes = append(changes, diffSkills(prev, next)...)
+	return changes
+}
+
+// diffCurrentPosition reports a JOB_CHANGE when the current company changed
+// and a TITLE_CHANGE when only the title at the current company changed.
+func diffCurrentPosition(prev, next State) []Change {
+	prevCur, hadPrev := profiledata.CurrentPosition(prev.Positions)
+	nextCur, hasNext := profiledata.CurrentPosition(next.Positions)
+	if !hasNext {
+		// Leaving a job without a new one is reported as POSITION_ENDED.
+		return nil
+	}
+
+	if !hadPrev || profiledata.Normalize(prevCur.CompanyName) != profiledata.Normalize(nextCur.CompanyName) {
+		oldValue := ""
+		if hadPrev {
+			oldValue = prevCur.String()
+		}
+		return []Change{newChange(model.ProfileChangeTypeJobChange, "positions", oldValue, nextCur.String())}
+	}
+
+	if profiledata.Normalize(prevCur.Title) != profiledata.Normalize(nextCur.Title) {
+		return []Change{newChange(model.ProfileChangeTypeTitleChange, "title", prevCur.Title, nextCur.Title)}
+	}
+	return nil
+}
+
+// diffPositions reports added positions and current positions that ended.
+func diffPositions(prev, next State) []Change {
+	var changes []Change
+
+	prevByKey := make(map[string]profiledata.Position, len(prev.Positions))
+	for _, p := range prev.Positions {
+		prevByKey[p.Key()] = p
+	}
+	nextByKey := make(map[string]profiledata.Position, len(next.Positions))
+	for _, p := range next.Positions {
+		nextByKey[p.Key()] = p
+		if _, ok := prevByKey[p.Key()]; !ok {
+			changes = append(changes, newChange(model.ProfileChangeTypeNewPosition, "positions", "", p.String()))
+		}
+	}
+
+	for _, p := range prev.Positions {
+		if !p.IsCurrent {
+			continue
+		}
+		if n, ok := nextByKey[p.Key()]; !ok || !n.IsCurrent {
+			changes = append(changes, newChange(model.ProfileChangeTypePositionEnded, "positions", p.String(), ""))
+		}
+	}
+	return changes
+}
+
+// diffEducations reports added educations.
+func diffEducations(prev, next State) []Change {
+	seen := make(map[string]bool, len(prev.Educations))
+	for _, e := range prev.Educations {
+		seen[e.Key()] = true
+	}
+
+	var changes []Change
+	for _, e := range next.Educations {
+		if !seen[e.Key()] {
+			changes = append(changes, newChange(model.ProfileChangeTypeNewEducation, "educations", "", e.String()))
+		}
+	}
+	return changes
+}
+
+// diffSkills reports added and removed skills.
+func diffSkills(prev, next State) []Change {
+	prevNames := make(map[string]bool, len(prev.Skills))
+	for _, s := range prev.Skills {
+		prevNames[profiledata.Normalize(s.Name)] = true
+	}
+	nextNames := make(map[string]bool, len(next.Skills))
+
+	var changes []Change
+	for _, s := range next.Skills {
+		name := profiledata.Normalize(s.Name)
+		nextNames[name] = true
+		if !prevNames[name] {
+			changes = append(changes, newChange(model.ProfileChangeTypeNewSkill, "skills", "", s.Name))
+		}
+	}
+	for _, s := range prev.Skills {
+		if !nextNames[profiledata.Normalize(s.Name)] {
+			changes = append(changes, newChange(model.ProfileChangeTypeSkillRemoved, "skills", s.Name, ""))
+		}
+	}
+	return changes
+}
+
+// location renders a state's location as "City, Country".
+func location(s State) string {
+	parts := make([]string, 0, 2)
+	for _, v := range []string{strings.TrimSpace(s.City), strings.TrimSpace(s.Country)} {
+		if v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// newChange builds a Change, mapping empty values to nil.
+func newChange(t model.ProfileChangeType, field, oldValue, newValue string) Change {
+	return Change{Type: t, Field: field, OldValue: optional(oldValue), NewValue: optional(newValue)}
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
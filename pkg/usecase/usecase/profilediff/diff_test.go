@@ -0,0 +1,109 @@
+package profilediff_test
+
+import (
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/usecase/usecase/profilediff"
+	"sheng-go-backend/pkg/util/profiledata"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func position(company, title string, current bool) profiledata.Position {
+	return profiledata.Position{CompanyName: company, Title: title, IsCurrent: current}
+}
+
+func changeTypes(changes []profilediff.Change) []model.ProfileChangeType {
+	types := make([]model.ProfileChangeType, 0, len(changes))
+	for _, c := range changes {
+		types = append(types, c.Type)
+	}
+	return types
+}
+
+func TestDiff(t *testing.T) {
+	base := profilediff.State{
+		Headline:  "Engineer at Acme",
+		Country:   "Germany",
+		City:      "Berlin",
+		Positions: []profiledata.Position{position("Acme", "Engineer", true)},
+		Skills:    []profiledata.Skill{{Name: "Go"}},
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(s *profilediff.State)
+		want   []model.ProfileChangeType
+	}{
+		{
+			name:   "Should report nothing for identical states",
+			mutate: func(s *profilediff.State) {},
+			want:   []model.ProfileChangeType{},
+		},
+		{
+			name: "Should ignore case and whitespace differences",
+			mutate: func(s *profilediff.State) {
+				s.City = " berlin "
+				s.Positions = []profiledata.Position{position("ACME", "engineer", true)}
+			},
+			want: []model.ProfileChangeType{},
+		},
+		{
+			name: "Should report a job change when the current company changes",
+			mutate: func(s *profilediff.State) {
+				s.Positions = []profiledata.Position{
+					position("Globex", "Engineer", true),
+					position("Acme", "Engineer", false),
+				}
+			},
+			want: []model.ProfileChangeType{
+				model.ProfileChangeTypeJobChange,
+				model.ProfileChangeTypeNewPosition,
+				model.ProfileChangeTypePositionEnded,
+			},
+		},
+		{
+			name: "Should report a title change at the same company",
+			mutate: func(s *profilediff.State) {
+				s.Positions = []profiledata.Position{position("Acme", "Senior Engineer", true)}
+			},
+			want: []model.ProfileChangeType{
+				model.ProfileChangeTypeTitleChange,
+				model.ProfileChangeTypeNewPosition,
+				model.ProfileChangeTypePositionEnded,
+			},
+		},
+		{
+			name: "Should report relocation, headline and skill changes",
+			mutate: func(s *profilediff.State) {
+				s.City = "Munich"
+				s.Headline = "Staff Engineer"
+				s.Skills = []profiledata.Skill{{Name: "Rust"}}
+			},
+			want: []model.ProfileChangeType{
+				model.ProfileChangeTypeLocationChange,
+				model.ProfileChangeTypeHeadlineChange,
+				model.ProfileChangeTypeNewSkill,
+				model.ProfileChangeTypeSkillRemoved,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := base
+			tt.mutate(&next)
+			assert.Equal(t, tt.want, changeTypes(profilediff.Diff(base, next)))
+		})
+	}
+
+	t.Run("Should carry old and new values", func(t *testing.T) {
+		next := base
+		next.City = "Munich"
+		changes := profilediff.Diff(base, next)
+		if assert.Len(t, changes, 1) {
+			assert.Equal(t, "Berlin, Germany", *changes[0].OldValue)
+			assert.Equal(t, "Munich, Germany", *changes[0].NewValue)
+		}
+	})
+}
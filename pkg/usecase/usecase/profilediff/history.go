@@ -0,0 +1,33 @@
+package profilediff
+
+import (
+	"sheng-go-backend/ent"
+	"sheng-go-backend/pkg/entity/model"
+	"sort"
+)
+
+// History diffs each snapshot against the one before it and returns the
+// changes newest first. The oldest snapshot is the baseline and yields none.
+func History(snapshots []*ent.ProfileSnapshot) []*model.ProfileChange {
+	ordered := make([]*ent.ProfileSnapshot, len(snapshots))
+	copy(ordered, snapshots)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].FetchedAt.Before(ordered[j].FetchedAt)
+	})
+
+	changes := []*model.ProfileChange{}
+	for i := len(ordered) - 1; i > 0; i-- {
+		prev, next := ordered[i-1], ordered[i]
+		for _, c := range Diff(FromSnapshot(prev), FromSnapshot(next)) {
+			changes = append(changes, &model.ProfileChange{
+				Type:       c.Type,
+				Field:      c.Field,
+				OldValue:   c.OldValue,
+				NewValue:   c.NewValue,
+				DetectedAt: next.FetchedAt,
+				SnapshotID: next.ID,
+			})
+		}
+	}
+	return changes
+}
@@ -21,6 +21,10 @@ type ProfileEntry interface {
 		before *model.Cursor,
 		last *int, where *model.ProfileEntryWhereInput) (*model.ProfileEntryConnection, error)
 	GetStats(ctx context.Context) (*model.ProfileEntryStats, error)
+	Requeue(
+		ctx context.Context,
+		input model.RequeueProfileEntriesInput,
+	) (*model.BulkProfileEntryResult, error)
 }
 
 func NewProfileEntryUseCase(r repository.ProfileEntry) ProfileEntry {
@@ -70,3 +74,10 @@ func (p *profileUseCase) GetStats(
 ) (*model.ProfileEntryStats, error) {
 	return p.profileRepository.GetStats(ctx)
 }
+
+func (p *profileUseCase) Requeue(
+	ctx context.Context,
+	input model.RequeueProfileEntriesInput,
+) (*model.BulkProfileEntryResult, error) {
+	return p.profileRepository.Requeue(ctx, input)
+}
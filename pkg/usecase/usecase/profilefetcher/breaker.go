@@ -0,0 +1,27 @@
+package profilefetcher
+
+import (
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
+)
+
+// ProviderStatus reports the profile provider's circuit breaker. It returns
+// nil when the provider is not wrapped in a breaker.
+func (pf *ProfileFetcher) ProviderStatus() *model.ProfileProviderStatus {
+	breaker, ok := pf.provider.(interface {
+		Status() profileprovider.BreakerStatus
+	})
+	if !ok {
+		return nil
+	}
+
+	status := breaker.Status()
+	return &model.ProfileProviderStatus{
+		Name:           status.Provider,
+		BreakerState:   model.CircuitBreakerState(status.State),
+		ErrorRate:      status.ErrorRate,
+		WindowRequests: status.Requests,
+		OpenedAt:       status.OpenedAt,
+		RetryAt:        status.RetryAt,
+	}
+}
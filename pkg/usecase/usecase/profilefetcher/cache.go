@@ -0,0 +1,85 @@
+package profilefetcher
+
+import (
+	"context"
+	"sheng-go-backend/config"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/pkg/entity/model"
+	"time"
+)
+
+// cacheTTL returns how old a fetched profile may be and still be served
+// without calling the provider: profileProvider.cacheTTLMinutes, or the
+// refresher's age so a profile counts as fresh until it would be refreshed.
+func cacheTTL() time.Duration {
+	ttl := time.Duration(config.C.ProfileProvider.CacheTTLMinutes) * time.Minute
+	if ttl <= 0 {
+		return refreshAfter()
+	}
+	return ttl
+}
+
+// cacheMaxAge returns the cache age a request accepts. Zero means the
+// request must go to the provider.
+func cacheMaxAge(opts *model.ProfileFetchOptions) time.Duration {
+	if opts == nil {
+		return cacheTTL()
+	}
+	if opts.ForceRefresh {
+		return 0
+	}
+	if opts.MaxAgeSeconds != nil {
+		if *opts.MaxAgeSeconds <= 0 {
+			return 0
+		}
+		return time.Duration(*opts.MaxAgeSeconds) * time.Second
+	}
+	return cacheTTL()
+}
+
+// fetchedWithin reports whether a fetch made at fetchedAt is at most maxAge
+// old at now.
+func fetchedWithin(fetchedAt *time.Time, maxAge time.Duration, now time.Time) bool {
+	return maxAge > 0 && fetchedAt != nil && now.Sub(*fetchedAt) <= maxAge
+}
+
+// entryIsCached reports whether entry holds a completed fetch fresh enough
+// for maxAge.
+func entryIsCached(entry *ent.ProfileEntry, maxAge time.Duration, now time.Time) bool {
+	return entry.Status == profileentry.StatusCOMPLETED &&
+		fetchedWithin(entry.LastFetchedAt, maxAge, now)
+}
+
+// profileFetchedAt returns when profile was last fetched from the provider.
+// It uses the matching entry's last_fetched_at, looked up by the requested
+// identifier and then by the profile's URN, and falls back to the profile's
+// updated_at for profiles imported without an entry.
+func (pf *ProfileFetcher) profileFetchedAt(
+	ctx context.Context,
+	profile *ent.Profile,
+	key string,
+) *time.Time {
+	if entry := pf.entryForProfile(ctx, profile, key); entry != nil && entry.LastFetchedAt != nil {
+		return entry.LastFetchedAt
+	}
+	return &profile.UpdatedAt
+}
+
+// entryForProfile returns the profile entry stored under key or under the
+// profile's URN, or nil.
+func (pf *ProfileFetcher) entryForProfile(
+	ctx context.Context,
+	profile *ent.Profile,
+	key string,
+) *ent.ProfileEntry {
+	for _, urn := range []string{key, profile.Urn} {
+		if urn == "" {
+			continue
+		}
+		if entry, err := pf.profileEntryRepo.GetByURN(ctx, urn); err == nil {
+			return entry
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package profilefetcher
+
+import (
+	"sheng-go-backend/config"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/pkg/entity/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheMaxAge(t *testing.T) {
+	prev := config.C.ProfileProvider.CacheTTLMinutes
+	config.C.ProfileProvider.CacheTTLMinutes = 60
+	defer func() { config.C.ProfileProvider.CacheTTLMinutes = prev }()
+
+	ten := 10
+	zero := 0
+
+	cases := []struct {
+		name string
+		opts *model.ProfileFetchOptions
+		want time.Duration
+	}{
+		{name: "Should use the configured TTL without options", opts: nil, want: time.Hour},
+		{name: "Should use the configured TTL when no max age is given", opts: &model.ProfileFetchOptions{}, want: time.Hour},
+		{name: "Should use the requested max age", opts: &model.ProfileFetchOptions{MaxAgeSeconds: &ten}, want: 10 * time.Second},
+		{name: "Should bypass the cache for a zero max age", opts: &model.ProfileFetchOptions{MaxAgeSeconds: &zero}, want: 0},
+		{name: "Should bypass the cache on force refresh", opts: &model.ProfileFetchOptions{MaxAgeSeconds: &ten, ForceRefresh: true}, want: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, cacheMaxAge(tc.opts))
+		})
+	}
+
+	t.Run("Should default to the refresher age", func(t *testing.T) {
+		config.C.ProfileProvider.CacheTTLMinutes = 0
+		assert.Equal(t, refreshAfter(), cacheTTL())
+	})
+}
+
+func TestEntryIsCached(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	fetchedAt := now.Add(-30 * time.Minute)
+
+	t.Run("Should serve a completed entry fetched within the max age", func(t *testing.T) {
+		entry := &ent.ProfileEntry{Status: profileentry.StatusCOMPLETED, LastFetchedAt: &fetchedAt}
+		assert.True(t, entryIsCached(entry, time.Hour, now))
+	})
+
+	t.Run("Should refetch an entry older than the max age", func(t *testing.T) {
+		entry := &ent.ProfileEntry{Status: profileentry.StatusCOMPLETED, LastFetchedAt: &fetchedAt}
+		assert.False(t, entryIsCached(entry, 10*time.Minute, now))
+	})
+
+	t.Run("Should refetch when the cache is bypassed", func(t *testing.T) {
+		entry := &ent.ProfileEntry{Status: profileentry.StatusCOMPLETED, LastFetchedAt: &fetchedAt}
+		assert.False(t, entryIsCached(entry, 0, now))
+	})
+
+	t.Run("Should refetch entries that are not completed", func(t *testing.T) {
+		entry := &ent.ProfileEntry{Status: profileentry.StatusFAILED, LastFetchedAt: &fetchedAt}
+		assert.False(t, entryIsCached(entry, time.Hour, now))
+
+		entry = &ent.ProfileEntry{Status: profileentry.StatusCOMPLETED}
+		assert.False(t, entryIsCached(entry, time.Hour, now))
+	})
+}
@@ -0,0 +1,218 @@
+package profilefetcher
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/config"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/jobexecutionhistory"
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/usecase/usecase/profilediff"
+	"strings"
+	"time"
+)
+
+// ChangeEventWebhookJobName is the cron job config name of the change event
+// webhook delivery job.
+const ChangeEventWebhookJobName = "change_event_webhook"
+
+// Defaults for webhook delivery when webhook.* is not configured.
+const (
+	defaultDeliveryMaxAttempts = 8
+	defaultDeliveryBackoff     = time.Minute
+	defaultDeliveryBackoffMax  = 6 * time.Hour
+
+	// deliveryLease is how long a claimed event is hidden from other runs
+	// while it is being delivered.
+	deliveryLease = 5 * time.Minute
+)
+
+// changeEventTypes maps the diff changes that downstream consumers subscribe
+// to onto persisted event types. Other change types are not emitted.
+var changeEventTypes = map[model.ProfileChangeType]profilechangeevent.Type{
+	model.ProfileChangeTypeJobChange:      profilechangeevent.TypeJobChange,
+	model.ProfileChangeTypeTitleChange:    profilechangeevent.TypeTitleChange,
+	model.ProfileChangeTypeLocationChange: profilechangeevent.TypeLocationChange,
+	model.ProfileChangeTypeNewSkill:       profilechangeevent.TypeNewSkill,
+}
+
+// changeEventPayload is the JSON body posted to the webhook for one event.
+type changeEventPayload struct {
+	ID         model.ID  `json:"id"`
+	Type       string    `json:"type"`
+	ProfileID  model.ID  `json:"profileId"`
+	ProfileURN string    `json:"profileUrn,omitempty"`
+	Username   string    `json:"username,omitempty"`
+	OldValue   *string   `json:"oldValue"`
+	NewValue   *string   `json:"newValue"`
+	DetectedAt time.Time `json:"detectedAt"`
+}
+
+// newDeliveryPolicy builds the webhook retry schedule from webhook.maxAttempts
+// and webhook.backoffSeconds. It reuses the fetch retry backoff shape.
+func newDeliveryPolicy() retryPolicy {
+	p := retryPolicy{
+		maxAttempts: config.C.Webhook.MaxAttempts,
+		baseBackoff: time.Duration(config.C.Webhook.BackoffSeconds) * time.Second,
+		maxBackoff:  defaultDeliveryBackoffMax,
+	}
+	if p.maxAttempts <= 0 {
+		p.maxAttempts = defaultDeliveryMaxAttempts
+	}
+	if p.baseBackoff <= 0 {
+		p.baseBackoff = defaultDeliveryBackoff
+	}
+	return p
+}
+
+// eventsFromChanges keeps the changes that have an event type and converts
+// them into unsaved events with the given detection time and delivery status.
+func eventsFromChanges(
+	changes []profilediff.Change,
+	detectedAt time.Time,
+	status profilechangeevent.DeliveryStatus,
+) []*ent.ProfileChangeEvent {
+	var events []*ent.ProfileChangeEvent
+	for _, c := range changes {
+		eventType, ok := changeEventTypes[c.Type]
+		if !ok {
+			continue
+		}
+		events = append(events, &ent.ProfileChangeEvent{
+			Type:           eventType,
+			OldValue:       c.OldValue,
+			NewValue:       c.NewValue,
+			DetectedAt:     detectedAt,
+			DeliveryStatus: status,
+		})
+	}
+	return events
+}
+
+// changeEvents diffs the snapshot Upsert just recorded against the previous
+// one into unsaved change events, which Upsert saves in the same transaction.
+func (pf *ProfileFetcher) changeEvents(previous, latest *model.ProfileSnapshot) []*ent.ProfileChangeEvent {
+	// Without a webhook the events are still kept for the GraphQL feed.
+	status := profilechangeevent.DeliveryStatusSkipped
+	if pf.webhookClient.Enabled() {
+		status = profilechangeevent.DeliveryStatusPending
+	}
+
+	changes := profilediff.Diff(profilediff.FromSnapshot(previous), profilediff.FromSnapshot(latest))
+	return eventsFromChanges(changes, latest.FetchedAt, status)
+}
+
+// DeliverChangeEvents posts due PENDING change events to the configured
+// webhook, at most batch_size per run. Failed deliveries are retried with
+// exponential backoff until webhook.maxAttempts is reached, after which the
+// event is marked FAILED. Runs that deliver anything are recorded in job
+// execution history.
+func (pf *ProfileFetcher) DeliverChangeEvents(ctx context.Context) (*ent.JobExecutionHistory, error) {
+	startTime := time.Now()
+
+	jobConfig, err := pf.cronConfigRepo.GetByName(ctx, ChangeEventWebhookJobName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job config: %w", err)
+	}
+
+	history := &ent.JobExecutionHistory{
+		JobName:   ChangeEventWebhookJobName,
+		Status:    jobexecutionhistory.StatusSuccess,
+		StartedAt: startTime,
+	}
+
+	if !pf.webhookClient.Enabled() {
+		return history, nil
+	}
+
+	events, err := pf.changeEventRepo.ClaimDue(ctx, startTime, deliveryLease, jobConfig.BatchSize)
+	if err != nil {
+		completedAt := time.Now()
+		history.Status = jobexecutionhistory.StatusFailed
+		history.CompletedAt = &completedAt
+		history.ErrorSummary = ptr(err.Error())
+		if _, herr := pf.jobHistoryRepo.Create(ctx, history, nil); herr != nil {
+			pf.logger.Warnw("failed to create job history", "error", herr)
+		}
+		return history, err
+	}
+
+	var errMsgs []string
+	for _, event := range events {
+		if err := pf.deliverChangeEvent(ctx, event); err != nil {
+			history.FailedCount++
+			errMsgs = append(errMsgs, fmt.Sprintf("event %s: %v", event.ID, err))
+			continue
+		}
+		history.SuccessfulCount++
+	}
+
+	completedAt := time.Now()
+	history.CompletedAt = &completedAt
+	history.TotalProcessed = len(events)
+	history.DurationSeconds = int(completedAt.Sub(startTime).Seconds())
+
+	switch {
+	case history.FailedCount == 0:
+		history.Status = jobexecutionhistory.StatusSuccess
+	case history.SuccessfulCount == 0:
+		history.Status = jobexecutionhistory.StatusFailed
+	default:
+		history.Status = jobexecutionhistory.StatusPartial
+	}
+
+	summary := fmt.Sprintf(
+		"Delivered %d of %d change events to webhook",
+		history.SuccessfulCount,
+		len(events),
+	)
+	if len(errMsgs) > 0 {
+		summary += "\n" + strings.Join(errMsgs, "\n")
+	}
+
+	return pf.saveRunSummary(ctx, history, summary, nil), nil
+}
+
+// deliverChangeEvent posts one event and records the outcome on it.
+func (pf *ProfileFetcher) deliverChangeEvent(ctx context.Context, event *ent.ProfileChangeEvent) error {
+	payload := changeEventPayload{
+		ID:         event.ID,
+		Type:       event.Type.String(),
+		OldValue:   event.OldValue,
+		NewValue:   event.NewValue,
+		DetectedAt: event.DetectedAt,
+	}
+	if p := event.Edges.Profile; p != nil {
+		payload.ProfileID = p.ID
+		payload.ProfileURN = p.Urn
+		if p.Username != nil {
+			payload.Username = *p.Username
+		}
+	}
+
+	attempt := event.DeliveryAttempts + 1
+	sendErr := pf.webhookClient.Send(ctx, payload.Type, payload)
+	if sendErr == nil {
+		if err := pf.changeEventRepo.MarkDelivered(ctx, event.ID, attempt); err != nil {
+			pf.logger.Warnw("failed to mark change event delivered", "event", event.ID, "error", err)
+		}
+		return nil
+	}
+
+	nextDeliveryAt := pf.deliveryPolicy.nextRetryAt(attempt, time.Now())
+	if err := pf.changeEventRepo.MarkAttemptFailed(
+		ctx,
+		event.ID,
+		attempt,
+		sendErr.Error(),
+		nextDeliveryAt,
+	); err != nil {
+		pf.logger.Warnw("failed to record change event delivery failure", "event", event.ID, "error", err)
+	}
+
+	if nextDeliveryAt == nil {
+		pf.logger.Warnf("Change event %s failed %d deliveries; giving up", event.ID, attempt)
+	}
+	return sendErr
+}
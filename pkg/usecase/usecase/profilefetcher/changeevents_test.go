@@ -0,0 +1,54 @@
+package profilefetcher
+
+import (
+	"sheng-go-backend/ent/profilechangeevent"
+	"sheng-go-backend/pkg/entity/model"
+	"sheng-go-backend/pkg/usecase/usecase/profilediff"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventsFromChanges(t *testing.T) {
+	detectedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	oldTitle, newTitle := "Engineer @ Acme", "Engineer @ Globex"
+	oldCity, newCity := "Berlin, Germany", "Paris, France"
+	skill := "Go"
+
+	changes := []profilediff.Change{
+		{Type: model.ProfileChangeTypeJobChange, Field: "currentPosition", OldValue: &oldTitle, NewValue: &newTitle},
+		{Type: model.ProfileChangeTypeNewPosition, Field: "positions", NewValue: &newTitle},
+		{Type: model.ProfileChangeTypeLocationChange, Field: "location", OldValue: &oldCity, NewValue: &newCity},
+		{Type: model.ProfileChangeTypeHeadlineChange, Field: "headline"},
+		{Type: model.ProfileChangeTypeNewSkill, Field: "skills", NewValue: &skill},
+	}
+
+	t.Run("Should keep only subscribed change types", func(t *testing.T) {
+		events := eventsFromChanges(changes, detectedAt, profilechangeevent.DeliveryStatusPending)
+
+		types := make([]profilechangeevent.Type, len(events))
+		for i, e := range events {
+			types[i] = e.Type
+		}
+		assert.Equal(t, []profilechangeevent.Type{
+			profilechangeevent.TypeJobChange,
+			profilechangeevent.TypeLocationChange,
+			profilechangeevent.TypeNewSkill,
+		}, types)
+	})
+
+	t.Run("Should carry values, detection time and delivery status", func(t *testing.T) {
+		events := eventsFromChanges(changes[:1], detectedAt, profilechangeevent.DeliveryStatusSkipped)
+
+		assert.Len(t, events, 1)
+		assert.Equal(t, &oldTitle, events[0].OldValue)
+		assert.Equal(t, &newTitle, events[0].NewValue)
+		assert.Equal(t, detectedAt, events[0].DetectedAt)
+		assert.Equal(t, profilechangeevent.DeliveryStatusSkipped, events[0].DeliveryStatus)
+	})
+
+	t.Run("Should return nothing when no change is subscribed", func(t *testing.T) {
+		assert.Empty(t, eventsFromChanges(changes[3:4], detectedAt, profilechangeevent.DeliveryStatusPending))
+	})
+}
@@ -15,12 +15,14 @@ import (
 	"sheng-go-backend/ent/schema/ulid"
 	"sheng-go-backend/pkg/adapter/repository/cronjobconfigrepository"
 	"sheng-go-backend/pkg/adapter/repository/jobexecutionhistoryrepository"
+	"sheng-go-backend/pkg/adapter/repository/profilechangeeventrepository"
 	"sheng-go-backend/pkg/adapter/repository/profileentryrepository"
 	"sheng-go-backend/pkg/adapter/repository/profilerepository"
 	"sheng-go-backend/pkg/entity/model"
 	"sheng-go-backend/pkg/infrastructure/email"
-	"sheng-go-backend/pkg/infrastructure/external/rapidapi"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
 	"sheng-go-backend/pkg/infrastructure/storage"
+	"sheng-go-backend/pkg/infrastructure/webhook"
 	"sheng-go-backend/pkg/usecase/usecase/apiquota"
 	"strings"
 	"time"
@@ -29,16 +31,29 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+// quotaLedger is the part of apiquota.QuotaManager the fetcher draws on
+type quotaLedger interface {
+	CheckAndReserveQuota(ctx context.Context, budget string, batchSize int) (*ent.APIQuotaReservation, error)
+	CommitCalls(ctx context.Context, reservation *ent.APIQuotaReservation, count int) error
+	ReleaseQuota(ctx context.Context, reservation *ent.APIQuotaReservation) error
+	GetCurrentQuotaStatus(ctx context.Context) (*ent.APIQuotaTracker, error)
+	LinkRunCalls(ctx context.Context, runID string, executionID ulid.ID) error
+}
+
 // ProfileFetcher handles the profile fetching workflow
 type ProfileFetcher struct {
 	profileEntryRepo profileentryrepository.ProfileEntryRepository
 	profileRepo      profilerepository.ProfileRepository
 	cronConfigRepo   *cronjobconfigrepository.CronJobConfigRepository
 	jobHistoryRepo   *jobexecutionhistoryrepository.JobExecutionHistoryRepository
-	linkedinClient   *rapidapi.LinkedInClient
+	provider         profileprovider.ProfileProvider
 	s3Service        *storage.S3Service
 	emailService     *email.EmailService
-	quotaManager     *apiquota.QuotaManager
+	quotaManager     quotaLedger
+	changeEventRepo  *profilechangeeventrepository.ProfileChangeEventRepository
+	webhookClient    *webhook.Client
+	retryPolicy      retryPolicy
+	deliveryPolicy   retryPolicy
 	logger           *zap.SugaredLogger
 }
 
@@ -48,20 +63,26 @@ func NewProfileFetcher(
 	profileRepo profilerepository.ProfileRepository,
 	cronConfigRepo *cronjobconfigrepository.CronJobConfigRepository,
 	jobHistoryRepo *jobexecutionhistoryrepository.JobExecutionHistoryRepository,
-	linkedinClient *rapidapi.LinkedInClient,
+	provider profileprovider.ProfileProvider,
 	s3Service *storage.S3Service,
 	emailService *email.EmailService,
 	quotaManager *apiquota.QuotaManager,
+	changeEventRepo *profilechangeeventrepository.ProfileChangeEventRepository,
+	webhookClient *webhook.Client,
 ) *ProfileFetcher {
 	return &ProfileFetcher{
 		profileEntryRepo: profileEntryRepo,
 		profileRepo:      profileRepo,
 		cronConfigRepo:   cronConfigRepo,
 		jobHistoryRepo:   jobHistoryRepo,
-		linkedinClient:   linkedinClient,
+		provider:         provider,
 		s3Service:        s3Service,
 		emailService:     emailService,
 		quotaManager:     quotaManager,
+		changeEventRepo:  changeEventRepo,
+		webhookClient:    webhookClient,
+		retryPolicy:      newRetryPolicy(),
+		deliveryPolicy:   newDeliveryPolicy(),
 		logger:           newProfileFetcherLogger(),
 	}
 }
@@ -88,21 +109,31 @@ func (pf *ProfileFetcher) ExecuteFetchJob(ctx context.Context) (*ent.JobExecutio
 		return nil, fmt.Errorf("failed to get job config: %w", err)
 	}
 
+	concurrency := jobConfig.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	leaseOwner := newLeaseOwner()
+	// Log the run's API calls under the cron caller and this run
+	ctx = profileprovider.WithCaller(ctx, profileprovider.Caller{Name: apiquota.BudgetCron, RunID: leaseOwner})
+
 	// Check quota
 	// Initialize tracking
-	successCount := 0
-	failedCount := 0
-	apiCallsMade := 0
+	stats := &fetchJobStats{}
 	totalProcessed := 0
 	quotaLimited := false
-	var processedEntryIDs []ulid.ID
-	var errMsgs []string
+	halted := false
 	batchNumber := 0
 
 	for {
 		batchNumber++
-		allowedBatchSize, err := pf.quotaManager.CheckAndReserveQuota(ctx, jobConfig.BatchSize)
-		if err != nil {
+		// Reserve the batch's calls up front so concurrent runs and on-demand
+		// fetches cannot overshoot the monthly quota together
+		allowedBatchSize := jobConfig.BatchSize
+		reservation, err := pf.quotaManager.CheckAndReserveQuota(ctx, apiquota.BudgetCron, jobConfig.BatchSize)
+		if err == nil {
+			allowedBatchSize = reservation.Reserved
+		} else {
 			if totalProcessed == 0 && jobConfig.RespectQuota {
 				pf.logger.Warnw("quota exceeded before processing", "error", err)
 				// Create history record for quota exceeded
@@ -126,7 +157,7 @@ func (pf *ProfileFetcher) ExecuteFetchJob(ctx context.Context) (*ent.JobExecutio
 
 			if jobConfig.RespectQuota {
 				quotaLimited = true
-				errMsgs = append(errMsgs, fmt.Sprintf("Stopped due to quota: %v", err))
+				stats.addError(fmt.Sprintf("Stopped due to quota: %v", err))
 				pf.logger.Warnw("stopping due to quota mid-run", "error", err)
 				break
 			}
@@ -137,22 +168,29 @@ func (pf *ProfileFetcher) ExecuteFetchJob(ctx context.Context) (*ent.JobExecutio
 				"error",
 				err,
 			)
-			allowedBatchSize = jobConfig.BatchSize
 		}
 
 		pf.logger.Infow("quota check passed", "allowed_batch_size", allowedBatchSize)
 
-		// Get pending profile entries for this batch
+		// Claim pending profile entries for this batch; claimed entries are
+		// already FETCHING and leased to this run.
 		pf.logger.Infof(
-			"%s[%s] Fetching from DB: getting pending profile entries (batch size: %d)%s",
+			"%s[%s] Fetching from DB: claiming pending profile entries (batch size: %d, lease owner: %s)%s",
 			colorCyan,
 			time.Now().Format("2006-01-02 15:04:05"),
 			allowedBatchSize,
+			leaseOwner,
 			colorReset,
 		)
-		pendingEntries, err := pf.profileEntryRepo.GetPendingBatch(ctx, allowedBatchSize)
+		pendingEntries, err := pf.profileEntryRepo.ClaimPendingBatch(
+			ctx,
+			leaseOwner,
+			allowedBatchSize,
+			leaseDuration(),
+		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get pending entries: %w", err)
+			pf.releaseQuota(reservation)
+			return nil, fmt.Errorf("failed to claim pending entries: %w", err)
 		}
 		pf.logger.Infof(
 			"%s[%s] Fetched from DB: total to process = %d, fetched = %d%s",
@@ -164,249 +202,53 @@ func (pf *ProfileFetcher) ExecuteFetchJob(ctx context.Context) (*ent.JobExecutio
 		)
 
 		if len(pendingEntries) == 0 {
+			pf.releaseQuota(reservation)
 			pf.logger.Info("no pending profile entries, exiting")
 			break
 		}
 
-		// Process each entry in the batch
-		for i, entry := range pendingEntries {
-			pf.logger.Infof(
-				"%s[%s] Processing entry %d/%d - URN: %s%s",
-				colorCyan,
-				time.Now().Format("2006-01-02 15:04:05"),
-				i+1,
-				len(pendingEntries),
-				entry.LinkedinUrn,
-				colorReset,
-			)
-			// Update status to FETCHING
-			pf.logger.Infof(
-				"%s[%s] Updating DB: setting status to FETCHING for entry %s%s",
-				colorYellow,
-				time.Now().Format("2006-01-02 15:04:05"),
-				entry.LinkedinUrn,
-				colorReset,
-			)
-			_, _ = pf.profileEntryRepo.UpdateStatus(
-				ctx,
-				string(entry.ID),
-				profileentry.StatusFetching,
-				nil,
-			)
-
-			// Fetch profile from RapidAPI
-			profile, rawData, attempts, err := pf.fetchProfileWithRetry(ctx, entry.LinkedinUrn)
-			apiCallsMade += attempts
-
-			if err != nil {
-				// Check if this is a profile-not-found error
-				var notFoundErr *rapidapi.NotFoundError
-				if errors.As(err, &notFoundErr) {
-					errMsg := fmt.Sprintf("Profile not found: %s", notFoundErr.Message)
-					pf.logger.Warnf("%s[NOT FOUND]%s URN: %s - %s",
-						colorRed, colorReset, entry.LinkedinUrn, errMsg)
-					pf.logger.Infof(
-						"%s[%s] Updating DB: setting status to NOT_FOUND for entry %s%s",
-						colorRed,
-						time.Now().Format("2006-01-02 15:04:05"),
-						entry.LinkedinUrn,
-						colorReset,
-					)
-					_, _ = pf.profileEntryRepo.UpdateStatus(
-						ctx,
-						string(entry.ID),
-						profileentry.StatusNotFound,
-						&errMsg,
-					)
-					errMsgs = append(
-						errMsgs,
-						fmt.Sprintf(
-							"URN %s: NOT FOUND - %s",
-							entry.LinkedinUrn,
-							notFoundErr.Message,
-						),
-					)
-					failedCount++
-					continue
-				}
-
-				// Handle other errors as FAILED
-				errMsg := err.Error()
-				pf.logger.Infof(
-					"%s[%s] Updating DB: setting status to FAILED for entry %s%s",
-					colorRed,
-					time.Now().Format("2006-01-02 15:04:05"),
-					entry.LinkedinUrn,
-					colorReset,
-				)
-				_, _ = pf.profileEntryRepo.UpdateStatus(
-					ctx,
-					string(entry.ID),
-					profileentry.StatusFAILED,
-					&errMsg,
-				)
-				errMsgs = append(errMsgs, fmt.Sprintf("URN %s: %s", entry.LinkedinUrn, err.Error()))
-				failedCount++
-				continue
-			}
-
-			pf.logger.Infow(
-				"fetched profile",
-				"urn",
-				entry.LinkedinUrn,
-				"username",
-				profile.Username,
-			)
-
-			// Increment quota
-			if err := pf.quotaManager.IncrementCallCount(ctx, 1); err != nil {
-				pf.logger.Warnw("failed to increment quota", "error", err)
-			}
-
-			// Generate S3 keys with batch folder organization (max 900 files per folder)
-			timestamp := time.Now().Unix()
-			folder := (totalProcessed + i) / 900
-			rawS3Key := fmt.Sprintf(
-				"profiles/batch-%d/%s-%d-raw.json",
-				folder,
-				entry.LinkedinUrn,
-				timestamp,
-			)
-			cleanedS3Key := fmt.Sprintf(
-				"profiles/batch-%d/%s-%d-cleaned.json",
-				folder,
-				entry.LinkedinUrn,
-				timestamp,
-			)
-
-			// Upload raw JSON to S3
-			if err := pf.s3Service.UploadJSON(ctx, rawS3Key, rawData); err != nil {
-				errMsg := fmt.Sprintf("S3 upload failed: %v", err)
-				_, _ = pf.profileEntryRepo.UpdateStatus(
-					ctx,
-					string(entry.ID),
-					profileentry.StatusFAILED,
-					&errMsg,
-				)
-				errMsgs = append(errMsgs, fmt.Sprintf("URN %s: %s", entry.LinkedinUrn, errMsg))
-				failedCount++
-				pf.logger.Errorw(
-					"failed to upload raw json to s3",
-					"urn",
-					entry.LinkedinUrn,
-					"error",
-					err,
-				)
-				continue
-			}
+		// Fan the batch out to the worker pool
+		pf.logger.Infof(
+			"%s[%s] Batch #%d: processing %d entries with %d workers%s",
+			colorCyan,
+			time.Now().Format("2006-01-02 15:04:05"),
+			batchNumber,
+			len(pendingEntries),
+			concurrency,
+			colorReset,
+		)
+		pf.processBatch(ctx, pendingEntries, totalProcessed, concurrency, reservation, stats)
+		pf.releaseQuota(reservation)
 
-			// Extract and clean data
-			cleanedData := pf.extractProfileData(profile)
-			cleanedJSON, _ := json.Marshal(cleanedData)
-
-			// Upload cleaned JSON to S3
-			if err := pf.s3Service.UploadJSON(ctx, cleanedS3Key, cleanedJSON); err != nil {
-				errMsg := fmt.Sprintf("S3 upload failed: %v", err)
-				_, _ = pf.profileEntryRepo.UpdateStatus(
-					ctx,
-					string(entry.ID),
-					profileentry.StatusFAILED,
-					&errMsg,
-				)
-				errMsgs = append(errMsgs, fmt.Sprintf("URN %s: %s", entry.LinkedinUrn, errMsg))
-				failedCount++
-				pf.logger.Errorw(
-					"failed to upload cleaned json to s3",
-					"urn",
-					entry.LinkedinUrn,
-					"error",
-					err,
-				)
-				continue
-			}
+		totalProcessed += len(pendingEntries)
 
-			// Upsert profile in database
-			pf.logger.Infof(
-				"%s[%s] Inserting/Updating DB: upserting profile for URN %s%s",
-				colorYellow,
-				time.Now().Format("2006-01-02 15:04:05"),
-				entry.LinkedinUrn,
-				colorReset,
-			)
-			dbProfile := pf.convertToDBProfile(profile, rawS3Key, cleanedS3Key)
-			if _, err := pf.profileRepo.Upsert(ctx, dbProfile); err != nil {
-				errMsg := fmt.Sprintf("DB upsert failed: %v", err)
-				pf.logger.Infof(
-					"%s[%s] Updating DB: setting status to FAILED for entry %s%s",
-					colorRed,
-					time.Now().Format("2006-01-02 15:04:05"),
-					entry.LinkedinUrn,
-					colorReset,
-				)
-				_, _ = pf.profileEntryRepo.UpdateStatus(
-					ctx,
-					string(entry.ID),
-					profileentry.StatusFAILED,
-					&errMsg,
-				)
-				errMsgs = append(errMsgs, fmt.Sprintf("URN %s: %s", entry.LinkedinUrn, errMsg))
-				failedCount++
-				pf.logger.Errorw("failed to upsert profile", "urn", entry.LinkedinUrn, "error", err)
-				continue
+		if haltErr := stats.halted(); haltErr != nil {
+			var quotaErr *profileprovider.QuotaExhaustedError
+			if errors.As(haltErr, &quotaErr) {
+				quotaLimited = true
 			}
-			pf.logger.Infof("%s[%s] DB operation complete: profile upserted successfully%s",
-				colorGreen, time.Now().Format("2006-01-02 15:04:05"), colorReset)
+			halted = true
+			stats.addError(fmt.Sprintf("Stopped early: %v", haltErr))
+			break
+		}
 
-			// Update profile entry as completed
-			pf.logger.Infof(
-				"%s[%s] Updating DB: setting status to COMPLETED for entry %s%s",
-				colorYellow,
-				time.Now().Format("2006-01-02 15:04:05"),
-				entry.LinkedinUrn,
+		if ctx.Err() != nil {
+			pf.logger.Warnf(
+				"%s[CANCELLED]%s Context cancelled, stopping after batch #%d",
+				colorRed,
 				colorReset,
-			)
-			if _, err := pf.profileEntryRepo.UpdateAfterFetch(ctx, string(entry.ID), rawS3Key, cleanedS3Key); err != nil {
-				pf.logger.Warnw(
-					"failed to update profile entry after fetch",
-					"urn",
-					entry.LinkedinUrn,
-					"error",
-					err,
-				)
-			}
-
-			processedEntryIDs = append(processedEntryIDs, entry.ID)
-			successCount++
-			pf.logger.Infof(
-				"%s[%s] Batch #%d progress: %d/%d entries processed (success: %d, failed: %d)%s",
-				colorGreen,
-				time.Now().Format("2006-01-02 15:04:05"),
 				batchNumber,
-				i+1,
-				len(pendingEntries),
-				successCount,
-				failedCount,
-				colorReset,
 			)
-
-			// Add 5s delay between profile fetch calls to avoid hitting RapidAPI rate limits
-			if i < len(pendingEntries)-1 {
-				pf.logger.Infof("%s[%s] Waiting 1 seconds before next fetch...%s",
-					colorMagenta, time.Now().Format("2006-01-02 15:04:05"), colorReset)
-				if err := sleepWithContext(ctx, 1*time.Second); err != nil {
-					pf.logger.Warnf(
-						"%s[CANCELLED]%s Context cancelled during inter-fetch delay",
-						colorRed,
-						colorReset,
-					)
-					break
-				}
-			}
+			break
 		}
-
-		totalProcessed += len(pendingEntries)
 	}
 
+	successCount := stats.successCount
+	failedCount := stats.failedCount
+	apiCallsMade := stats.apiCallsMade
+	processedEntryIDs := stats.processedEntryIDs
+	errMsgs := stats.errMsgs
+
 	// Get current quota status
 	quotaStatus, _ := pf.quotaManager.GetCurrentQuotaStatus(ctx)
 	quotaRemaining := 0
@@ -421,7 +263,7 @@ func (pf *ProfileFetcher) ExecuteFetchJob(ctx context.Context) (*ent.JobExecutio
 	status := jobexecutionhistory.StatusSuccess
 	if failedCount > 0 && successCount == 0 {
 		status = jobexecutionhistory.StatusFailed
-	} else if failedCount > 0 || quotaLimited {
+	} else if failedCount > 0 || quotaLimited || halted {
 		status = jobexecutionhistory.StatusPartial
 	}
 
@@ -438,6 +280,17 @@ func (pf *ProfileFetcher) ExecuteFetchJob(ctx context.Context) (*ent.JobExecutio
 		DurationSeconds: duration,
 	}
 
+	// Flag vendor format changes before they silently empty the data
+	schemaDrift := ""
+	if stats.schemaDrift.Checked > 0 {
+		history.SchemaDrift = stats.schemaDrift.Map()
+	}
+	if stats.schemaDrift.Detected() {
+		history.SchemaDriftDetected = true
+		schemaDrift = stats.schemaDrift.Summary()
+		pf.logger.Warnf("%s[SCHEMA DRIFT]%s %s", colorYellow, colorReset, schemaDrift)
+	}
+
 	if len(errMsgs) > 0 {
 		errorSummary := strings.Join(errMsgs, "; ")
 		history.ErrorSummary = &errorSummary
@@ -454,6 +307,9 @@ func (pf *ProfileFetcher) ExecuteFetchJob(ctx context.Context) (*ent.JobExecutio
 		// Return in-memory history even if persistence failed
 		return history, nil
 	}
+	if err := pf.quotaManager.LinkRunCalls(ctx, leaseOwner, savedHistory.ID); err != nil {
+		pf.logger.Warnw("failed to link API calls to job history", "error", err)
+	}
 
 	// Send email summary
 	nextRunTime := time.Now().Add(24 * time.Hour) // Placeholder
@@ -466,6 +322,7 @@ func (pf *ProfileFetcher) ExecuteFetchJob(ctx context.Context) (*ent.JobExecutio
 		apiCallsMade,
 		quotaRemaining,
 		errMsgs,
+		schemaDrift,
 		nextRunTime,
 	); err != nil {
 		pf.logger.Warnw("failed to send job completion email", "error", err)
@@ -474,6 +331,244 @@ func (pf *ProfileFetcher) ExecuteFetchJob(ctx context.Context) (*ent.JobExecutio
 	return savedHistory, nil
 }
 
+// processEntry runs the fetch, S3 upload and upsert workflow for one claimed
+// entry and records the outcome in stats. seq is the entry's position within
+// the run and determines its S3 batch folder. It is safe to call from
+// multiple workers concurrently.
+func (pf *ProfileFetcher) processEntry(
+	ctx context.Context,
+	entry *ent.ProfileEntry,
+	seq int,
+	reservation *ent.APIQuotaReservation,
+	stats *fetchJobStats,
+) {
+	pf.logger.Infof(
+		"%s[%s] Processing entry #%d - URN: %s%s",
+		colorCyan,
+		time.Now().Format("2006-01-02 15:04:05"),
+		seq+1,
+		entry.LinkedinUrn,
+		colorReset,
+	)
+
+	// The lease was taken when the batch was claimed; restart it now the
+	// entry is dispatched so entries late in a long batch don't outlive it
+	if err := pf.profileEntryRepo.RenewLease(
+		ctx,
+		string(entry.ID),
+		leaseOwnerOf(entry),
+		leaseDuration(),
+	); err != nil {
+		if errors.Is(err, profileentryrepository.ErrLeaseLost) {
+			pf.logger.Warnw("skipping entry whose lease was lost", "urn", entry.LinkedinUrn)
+			return
+		}
+		pf.logger.Warnw("failed to renew entry lease", "urn", entry.LinkedinUrn, "error", err)
+	}
+
+	// Fetch profile from RapidAPI
+	profile, rawData, calls, err := pf.fetchProfileWithRetry(ctx, entry.LinkedinUrn)
+	stats.addAPICalls(calls)
+	// Failed and not-found calls are billed too. Calls beyond the batch's
+	// reservation may use up the quota; finish this entry, but dispatch no more
+	if err := pf.commitCalls(reservation, calls); err != nil && stats.recordHalt(err) {
+		pf.logger.Warnf("%s[RUN HALTED]%s %v - stopping run", colorRed, colorReset, err)
+	}
+
+	if err != nil {
+		// The provider cannot serve anyone right now: hand the entry back
+		// and stop the run
+		if haltsRun(err) {
+			pf.releaseClaims([]*ent.ProfileEntry{entry})
+			if stats.recordHalt(err) {
+				pf.logger.Warnf("%s[RUN HALTED]%s %v - stopping run",
+					colorRed, colorReset, err)
+			}
+			return
+		}
+
+		// A cancelled run says nothing about the entry
+		if pf.releaseIfCancelled(ctx, entry, err) {
+			return
+		}
+
+		// Check if this is a profile-not-found error
+		var notFoundErr *profileprovider.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			errMsg := fmt.Sprintf("Profile not found: %s", notFoundErr.Message)
+			pf.logger.Warnf("%s[NOT FOUND]%s URN: %s - %s",
+				colorRed, colorReset, entry.LinkedinUrn, errMsg)
+			pf.logger.Infof(
+				"%s[%s] Updating DB: setting status to NOT_FOUND for entry %s%s",
+				colorRed,
+				time.Now().Format("2006-01-02 15:04:05"),
+				entry.LinkedinUrn,
+				colorReset,
+			)
+			_, _ = pf.profileEntryRepo.UpdateStatus(
+				ctx,
+				string(entry.ID),
+				leaseOwnerOf(entry),
+				profileentry.StatusNotFound,
+				&errMsg,
+			)
+			stats.recordFailure(
+				fmt.Sprintf("URN %s: NOT FOUND - %s", entry.LinkedinUrn, notFoundErr.Message),
+			)
+			return
+		}
+
+		// Handle other errors as FAILED
+		errMsg := err.Error()
+		pf.logger.Infof(
+			"%s[%s] Updating DB: setting status to FAILED for entry %s%s",
+			colorRed,
+			time.Now().Format("2006-01-02 15:04:05"),
+			entry.LinkedinUrn,
+			colorReset,
+		)
+		pf.markFailed(entry, errMsg)
+		stats.recordFailure(fmt.Sprintf("URN %s: %s", entry.LinkedinUrn, err.Error()))
+		return
+	}
+
+	pf.logger.Infow(
+		"fetched profile",
+		"urn",
+		entry.LinkedinUrn,
+		"username",
+		profile.Username,
+	)
+
+	// Check the payload against the expected field inventory
+	stats.recordSchema(profileprovider.CheckProfileSchema(rawData))
+
+	// Generate S3 keys with batch folder organization (max 900 files per folder)
+	timestamp := time.Now().Unix()
+	folder := seq / 900
+	rawS3Key := fmt.Sprintf(
+		"profiles/batch-%d/%s-%d-raw.json",
+		folder,
+		entry.LinkedinUrn,
+		timestamp,
+	)
+	cleanedS3Key := fmt.Sprintf(
+		"profiles/batch-%d/%s-%d-cleaned.json",
+		folder,
+		entry.LinkedinUrn,
+		timestamp,
+	)
+
+	// Upload raw JSON to S3
+	if err := pf.s3Service.UploadJSON(ctx, rawS3Key, rawData); err != nil {
+		if pf.releaseIfCancelled(ctx, entry, err) {
+			return
+		}
+		errMsg := fmt.Sprintf("S3 upload failed: %v", err)
+		pf.markFailed(entry, errMsg)
+		stats.recordFailure(fmt.Sprintf("URN %s: %s", entry.LinkedinUrn, errMsg))
+		pf.logger.Errorw(
+			"failed to upload raw json to s3",
+			"urn",
+			entry.LinkedinUrn,
+			"error",
+			err,
+		)
+		return
+	}
+
+	// Extract and clean data
+	cleanedData := pf.extractProfileData(profile)
+	cleanedJSON, _ := json.Marshal(cleanedData)
+
+	// Upload cleaned JSON to S3
+	if err := pf.s3Service.UploadJSON(ctx, cleanedS3Key, cleanedJSON); err != nil {
+		if pf.releaseIfCancelled(ctx, entry, err) {
+			return
+		}
+		errMsg := fmt.Sprintf("S3 upload failed: %v", err)
+		pf.markFailed(entry, errMsg)
+		stats.recordFailure(fmt.Sprintf("URN %s: %s", entry.LinkedinUrn, errMsg))
+		pf.logger.Errorw(
+			"failed to upload cleaned json to s3",
+			"urn",
+			entry.LinkedinUrn,
+			"error",
+			err,
+		)
+		return
+	}
+
+	// Upsert profile in database
+	pf.logger.Infof(
+		"%s[%s] Inserting/Updating DB: upserting profile for URN %s%s",
+		colorYellow,
+		time.Now().Format("2006-01-02 15:04:05"),
+		entry.LinkedinUrn,
+		colorReset,
+	)
+	dbProfile := pf.convertToDBProfile(profile, rawS3Key, cleanedS3Key)
+	_, err = pf.profileRepo.Upsert(ctx, dbProfile, pf.changeEvents)
+	if err != nil {
+		if pf.releaseIfCancelled(ctx, entry, err) {
+			return
+		}
+		errMsg := fmt.Sprintf("DB upsert failed: %v", err)
+		pf.logger.Infof(
+			"%s[%s] Updating DB: setting status to FAILED for entry %s%s",
+			colorRed,
+			time.Now().Format("2006-01-02 15:04:05"),
+			entry.LinkedinUrn,
+			colorReset,
+		)
+		pf.markFailed(entry, errMsg)
+		stats.recordFailure(fmt.Sprintf("URN %s: %s", entry.LinkedinUrn, errMsg))
+		pf.logger.Errorw("failed to upsert profile", "urn", entry.LinkedinUrn, "error", err)
+		return
+	}
+	pf.logger.Infof("%s[%s] DB operation complete: profile upserted successfully%s",
+		colorGreen, time.Now().Format("2006-01-02 15:04:05"), colorReset)
+
+	// Update profile entry as completed
+	pf.logger.Infof(
+		"%s[%s] Updating DB: setting status to COMPLETED for entry %s%s",
+		colorYellow,
+		time.Now().Format("2006-01-02 15:04:05"),
+		entry.LinkedinUrn,
+		colorReset,
+	)
+	if _, err := pf.profileEntryRepo.UpdateAfterFetch(
+		ctx,
+		string(entry.ID),
+		leaseOwnerOf(entry),
+		rawS3Key,
+		cleanedS3Key,
+	); err != nil {
+		pf.logger.Warnw(
+			"failed to update profile entry after fetch",
+			"urn",
+			entry.LinkedinUrn,
+			"error",
+			err,
+		)
+		// Another worker holds the entry now; the outcome is theirs to record
+		if errors.Is(err, profileentryrepository.ErrLeaseLost) {
+			return
+		}
+	}
+
+	successCount, failedCount := stats.recordSuccess(entry.ID)
+	pf.logger.Infof(
+		"%s[%s] Run progress: %d entries processed (success: %d, failed: %d)%s",
+		colorGreen,
+		time.Now().Format("2006-01-02 15:04:05"),
+		successCount+failedCount,
+		successCount,
+		failedCount,
+		colorReset,
+	)
+}
+
 // ANSI color codes for logging
 const (
 	colorReset   = "\033[0m"
@@ -484,10 +579,26 @@ const (
 	colorRed     = "\033[31m" // Error/Failed
 )
 
+// fetchProfileWithRetry fetches urn with retryFetchProfile and returns the
+// number of calls the provider billed for it, failed and retried ones
+// included.
 func (pf *ProfileFetcher) fetchProfileWithRetry(
 	ctx context.Context,
 	urn string,
-) (*rapidapi.LinkedInProfile, []byte, int, error) {
+) (*profileprovider.LinkedInProfile, []byte, int, error) {
+	ctx, calls := profileprovider.WithCallCount(ctx)
+	profile, rawData, err := pf.retryFetchProfile(ctx, urn)
+	return profile, rawData, calls.Calls(), err
+}
+
+// maxRateLimitRetries caps how often one fetch is retried on rate limits; the
+// entry then fails and the retry policy schedules it for a later run.
+const maxRateLimitRetries = 10
+
+func (pf *ProfileFetcher) retryFetchProfile(
+	ctx context.Context,
+	urn string,
+) (*profileprovider.LinkedInProfile, []byte, error) {
 	cfg := config.C.RapidAPI
 
 	// maxRetries applies to non-rate-limit errors only
@@ -506,16 +617,18 @@ func (pf *ProfileFetcher) fetchProfileWithRetry(
 
 	attempts := 0
 	nonRateLimitAttempts := 0
+	rateLimitRetries := 0
 	var lastErr error
 
-	// Infinite loop - only exits on success, context cancellation, or non-rate-limit error after maxRetries
+	// Exits on success, context cancellation, a non-retryable error, or once
+	// either retry cap is reached
 	for {
 		attempts++
 
 		// Log fetching attempt
 		pf.logger.Infof("%s[FETCHING]%s URN: %s (attempt %d)", colorCyan, colorReset, urn, attempts)
 
-		profile, rawData, err := pf.linkedinClient.FetchProfileByURN(ctx, urn)
+		profile, rawData, err := pf.provider.FetchProfileByURN(ctx, urn)
 		if err == nil {
 			pf.logger.Infof(
 				"%s[SUCCESS]%s URN: %s fetched successfully after %d attempts",
@@ -524,15 +637,30 @@ func (pf *ProfileFetcher) fetchProfileWithRetry(
 				urn,
 				attempts,
 			)
-			return profile, rawData, attempts, nil
+			return profile, rawData, nil
 		}
 
 		lastErr = err
 
+		// The circuit breaker is open - retrying would only hammer the provider
+		var openErr *profileprovider.CircuitOpenError
+		if errors.As(err, &openErr) {
+			return nil, nil, openErr
+		}
+
+		// Bad credentials, exhausted quota or an unparseable body will not
+		// change on retry
+		var invalidErr *profileprovider.InvalidResponseError
+		if haltsRun(err) || errors.As(err, &invalidErr) {
+			pf.logger.Errorf("%s[ERROR]%s URN: %s - %v, skipping retries",
+				colorRed, colorReset, urn, err)
+			return nil, nil, err
+		}
+
 		// Check if this is a rate limit error
-		var rateErr *rapidapi.RateLimitError
+		var rateErr *profileprovider.RateLimitError
 		if errors.As(err, &rateErr) {
-			// Rate limit error - ALWAYS retry, never give up
+			// Rate limit error - back off and retry, up to maxRateLimitRetries
 			pf.logger.Warnf(
 				"%s[RATE LIMIT]%s URN: %s - API rate limit hit (attempt %d)",
 				colorYellow,
@@ -541,6 +669,13 @@ func (pf *ProfileFetcher) fetchProfileWithRetry(
 				attempts,
 			)
 
+			rateLimitRetries++
+			if rateLimitRetries > maxRateLimitRetries {
+				pf.logger.Errorf("%s[FAILED]%s URN: %s - still rate limited after %d retries",
+					colorRed, colorReset, urn, maxRateLimitRetries)
+				return nil, nil, err
+			}
+
 			sleep := backoff
 			if rateErr.RetryAfter > 0 && rateErr.RetryAfter > sleep {
 				sleep = rateErr.RetryAfter
@@ -564,7 +699,7 @@ func (pf *ProfileFetcher) fetchProfileWithRetry(
 					colorReset,
 					urn,
 				)
-				return nil, nil, attempts, err
+				return nil, nil, err
 			}
 
 			// Exponential backoff, capped at maxBackoff
@@ -583,7 +718,7 @@ func (pf *ProfileFetcher) fetchProfileWithRetry(
 		}
 
 		// Check if URN was not found - no point retrying
-		var notFoundErr *rapidapi.NotFoundError
+		var notFoundErr *profileprovider.NotFoundError
 		if errors.As(err, &notFoundErr) {
 			pf.logger.Warnf(
 				"%s[NOT FOUND]%s URN: %s - profile not found, skipping retries",
@@ -591,10 +726,10 @@ func (pf *ProfileFetcher) fetchProfileWithRetry(
 				colorReset,
 				urn,
 			)
-			return nil, nil, attempts, notFoundErr
+			return nil, nil, notFoundErr
 		}
 
-		// Non-rate-limit error - apply limited retries
+		// Upstream 5xx or transport error - apply limited retries
 		nonRateLimitAttempts++
 		pf.logger.Errorf("%s[ERROR]%s URN: %s - non-rate-limit error: %v (attempt %d/%d)",
 			colorRed, colorReset, urn, err, nonRateLimitAttempts, maxRetries)
@@ -602,7 +737,7 @@ func (pf *ProfileFetcher) fetchProfileWithRetry(
 		if nonRateLimitAttempts >= maxRetries {
 			pf.logger.Errorf("%s[FAILED]%s URN: %s - giving up after %d non-rate-limit errors",
 				colorRed, colorReset, urn, nonRateLimitAttempts)
-			return nil, nil, attempts, lastErr
+			return nil, nil, lastErr
 		}
 
 		// Brief wait before retrying non-rate-limit errors
@@ -613,14 +748,14 @@ func (pf *ProfileFetcher) fetchProfileWithRetry(
 			urn,
 		)
 		if err := sleepWithContext(ctx, time.Second); err != nil {
-			return nil, nil, attempts, err
+			return nil, nil, err
 		}
 	}
 }
 
 // extractProfileData extracts relevant fields from RapidAPI profile
 func (pf *ProfileFetcher) extractProfileData(
-	profile *rapidapi.LinkedInProfile,
+	profile *profileprovider.LinkedInProfile,
 ) map[string]interface{} {
 	return map[string]interface{}{
 		"urn":           profile.URN,
@@ -637,7 +772,7 @@ func (pf *ProfileFetcher) extractProfileData(
 
 // convertToDBProfile converts RapidAPI profile to database profile
 func (pf *ProfileFetcher) convertToDBProfile(
-	profile *rapidapi.LinkedInProfile,
+	profile *profileprovider.LinkedInProfile,
 	rawS3Key, cleanedS3Key string,
 ) *ent.Profile {
 	p := &ent.Profile{
@@ -687,14 +822,44 @@ func (pf *ProfileFetcher) fetchSingleProfileEntry(
 	ctx context.Context,
 	entry *model.ProfileEntry,
 ) error {
-	// Update status to FETCHING
-	_, _ = pf.profileEntryRepo.UpdateStatus(ctx, string(entry.ID), profileentry.StatusFetching, nil)
+	ctx = profileprovider.WithCaller(ctx, profileprovider.Caller{Name: apiquota.BudgetInteractive})
+
+	// Ad-hoc fetches draw from the interactive budget so they cannot starve
+	// the cron fetcher
+	reservation, err := pf.quotaManager.CheckAndReserveQuota(ctx, apiquota.BudgetInteractive, 1)
+	if err != nil {
+		return model.NewRateLimitError(fmt.Errorf("no API quota for on-demand fetches: %w", err))
+	}
+	defer pf.releaseQuota(reservation)
+
+	// Claim the entry (status FETCHING) so no other worker fetches it
+	// concurrently; the claimed entry carries the lease every later write
+	// checks
+	entry, err = pf.profileEntryRepo.ClaimByID(
+		ctx,
+		string(entry.ID),
+		newLeaseOwner(),
+		leaseDuration(),
+	)
+	if err != nil {
+		return err
+	}
 
 	// Fetch profile from RapidAPI
-	profile, rawData, _, err := pf.fetchProfileWithRetry(ctx, entry.LinkedinUrn)
+	profile, rawData, calls, err := pf.fetchProfileWithRetry(ctx, entry.LinkedinUrn)
+	// Count every call against the interactive reservation, failed or not.
+	// The fetch is over, so a used-up quota only stops later ones
+	_ = pf.commitCalls(reservation, calls)
 	if err != nil {
+		// The provider cannot serve anyone right now: leave the entry for a
+		// later run
+		if haltsRun(err) {
+			pf.releaseClaims([]*ent.ProfileEntry{entry})
+			return err
+		}
+
 		// Check if this is a profile-not-found error
-		var notFoundErr *rapidapi.NotFoundError
+		var notFoundErr *profileprovider.NotFoundError
 		if errors.As(err, &notFoundErr) {
 			errMsg := fmt.Sprintf("Profile not found: %s", notFoundErr.Message)
 			pf.logger.Warnf("%s[NOT FOUND]%s URN: %s - %s",
@@ -702,6 +867,7 @@ func (pf *ProfileFetcher) fetchSingleProfileEntry(
 			_, _ = pf.profileEntryRepo.UpdateStatus(
 				ctx,
 				string(entry.ID),
+				leaseOwnerOf(entry),
 				profileentry.StatusNotFound,
 				&errMsg,
 			)
@@ -710,21 +876,11 @@ func (pf *ProfileFetcher) fetchSingleProfileEntry(
 
 		// Handle other errors as FAILED
 		errMsg := err.Error()
-		_, _ = pf.profileEntryRepo.UpdateStatus(
-			ctx,
-			string(entry.ID),
-			profileentry.StatusFAILED,
-			&errMsg,
-		)
+		pf.markFailed(entry, errMsg)
 
 		return err
 	}
 
-	// Increment quota
-	if err := pf.quotaManager.IncrementCallCount(ctx, 1); err != nil {
-		pf.logger.Warnw("failed to increment quota", "urn", entry.LinkedinUrn, "error", err)
-	}
-
 	// Generate S3 keys with batch folder organization (max 900 files per folder)
 	timestamp := time.Now().Unix()
 	rawS3Key := fmt.Sprintf("profiles/batch-0/%s-%d-raw.json", entry.LinkedinUrn, timestamp)
@@ -733,12 +889,7 @@ func (pf *ProfileFetcher) fetchSingleProfileEntry(
 	// Upload raw JSON to S3
 	if err := pf.s3Service.UploadJSON(ctx, rawS3Key, rawData); err != nil {
 		errMsg := fmt.Sprintf("S3 upload failed: %v", err)
-		_, _ = pf.profileEntryRepo.UpdateStatus(
-			ctx,
-			string(entry.ID),
-			profileentry.StatusFAILED,
-			&errMsg,
-		)
+		pf.markFailed(entry, errMsg)
 		pf.logger.Errorw("failed to upload raw json to s3", "urn", entry.LinkedinUrn, "error", err)
 		return err
 	}
@@ -750,12 +901,7 @@ func (pf *ProfileFetcher) fetchSingleProfileEntry(
 	// Upload cleaned JSON to S3
 	if err := pf.s3Service.UploadJSON(ctx, cleanedS3Key, cleanedJSON); err != nil {
 		errMsg := fmt.Sprintf("S3 upload failed: %v", err)
-		_, _ = pf.profileEntryRepo.UpdateStatus(
-			ctx,
-			string(entry.ID),
-			profileentry.StatusFAILED,
-			&errMsg,
-		)
+		pf.markFailed(entry, errMsg)
 		pf.logger.Errorw(
 			"failed to upload cleaned json to s3",
 			"urn",
@@ -768,20 +914,22 @@ func (pf *ProfileFetcher) fetchSingleProfileEntry(
 
 	// Upsert profile in database
 	dbProfile := pf.convertToDBProfile(profile, rawS3Key, cleanedS3Key)
-	if _, err := pf.profileRepo.Upsert(ctx, dbProfile); err != nil {
+	_, err = pf.profileRepo.Upsert(ctx, dbProfile, pf.changeEvents)
+	if err != nil {
 		errMsg := fmt.Sprintf("DB upsert failed: %v", err)
-		_, _ = pf.profileEntryRepo.UpdateStatus(
-			ctx,
-			string(entry.ID),
-			profileentry.StatusFAILED,
-			&errMsg,
-		)
+		pf.markFailed(entry, errMsg)
 		pf.logger.Errorw("failed to upsert profile", "urn", entry.LinkedinUrn, "error", err)
 		return err
 	}
 
 	// Update profile entry as completed
-	if _, err := pf.profileEntryRepo.UpdateAfterFetch(ctx, string(entry.ID), rawS3Key, cleanedS3Key); err != nil {
+	if _, err := pf.profileEntryRepo.UpdateAfterFetch(
+		ctx,
+		string(entry.ID),
+		leaseOwnerOf(entry),
+		rawS3Key,
+		cleanedS3Key,
+	); err != nil {
 		pf.logger.Warnw(
 			"failed to update profile entry after fetch",
 			"urn",
@@ -789,11 +937,38 @@ func (pf *ProfileFetcher) fetchSingleProfileEntry(
 			"error",
 			err,
 		)
+		// Another worker holds the entry now; the outcome is theirs to record
+		if errors.Is(err, profileentryrepository.ErrLeaseLost) {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// defaultLeaseDuration is how long a claimed entry stays leased to a run when
+// cron.leaseMinutes is not configured.
+const defaultLeaseDuration = 30 * time.Minute
+
+// leaseDuration returns the configured fetch lease duration.
+func leaseDuration() time.Duration {
+	d := time.Duration(config.C.Cron.LeaseMinutes) * time.Minute
+	if d <= 0 {
+		return defaultLeaseDuration
+	}
+	return d
+}
+
+// newLeaseOwner returns an identifier for the process and run holding a lease,
+// e.g. "worker-1:4242:01J...".
+func newLeaseOwner() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s:%d:%s", host, os.Getpid(), ulid.MustNew(""))
+}
+
 func sleepWithContext(ctx context.Context, d time.Duration) error {
 	if d <= 0 {
 		return nil
@@ -885,12 +1060,23 @@ func createJobLogFile() (*os.File, *zap.SugaredLogger, error) {
 	return logFile, logger, nil
 }
 
-func (pf *ProfileFetcher) FetchSinglEntry(ctx context.Context, entryId model.ID) error {
+func (pf *ProfileFetcher) FetchSinglEntry(
+	ctx context.Context,
+	entryId model.ID,
+	opts *model.ProfileFetchOptions,
+) error {
 	profileEntry, err := pf.profileEntryRepo.GetById(ctx, entryId)
 	if err != nil {
 		return err
 	}
 
+	// Served from cache: the last fetch is recent enough
+	if entryIsCached(profileEntry, cacheMaxAge(opts), time.Now()) {
+		pf.logger.Infof("Entry %s fetched at %s, serving from cache",
+			profileEntry.LinkedinUrn, profileEntry.LastFetchedAt.Format(time.RFC3339))
+		return nil
+	}
+
 	err = pf.fetchSingleProfileEntry(ctx, profileEntry)
 	if err != nil {
 		return err
@@ -915,23 +1101,31 @@ const defaultGender = "OTHER"
 func (pf *ProfileFetcher) FetchProfileByURL(
 	ctx context.Context,
 	linkedinURL string,
+	opts *model.ProfileFetchOptions,
 ) (json.RawMessage, error) {
 	urn, err := usernameFromURL(linkedinURL)
 	if err != nil {
 		return nil, model.NewValidationError(err)
 	}
 
-	// 1. Profile already fetched -> return its raw JSON from S3.
-	// The slug is stored as the profile's username (the urn column holds the
-	// RapidAPI URN), so match on either column.
+	// 1. Profile fetched within the accepted cache age -> return its raw
+	// JSON from S3. The slug is stored as the profile's username (the urn
+	// column holds the RapidAPI URN), so match on either column.
+	var entry *ent.ProfileEntry
 	if profile, err := pf.profileRepo.GetByURNOrUsername(ctx, urn); err == nil {
-		return pf.rawProfileJSON(ctx, profile)
+		if fetchedWithin(pf.profileFetchedAt(ctx, profile, urn), cacheMaxAge(opts), time.Now()) {
+			return pf.rawProfileJSON(ctx, profile)
+		}
+		// Stale: refetch through the entry that fetched it, if any
+		entry = pf.entryForProfile(ctx, profile, urn)
 	} else if !ent.IsNotFound(err) {
 		return nil, err
 	}
 
 	// 2. Resolve the profile entry: reuse an existing one or create it.
-	entry, err := pf.profileEntryRepo.GetByURN(ctx, urn)
+	if entry == nil {
+		entry, err = pf.profileEntryRepo.GetByURN(ctx, urn)
+	}
 	if err != nil {
 		if !ent.IsNotFound(err) {
 			return nil, err
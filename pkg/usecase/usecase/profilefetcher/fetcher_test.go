@@ -0,0 +1,177 @@
+package profilefetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/pkg/adapter/repository/apiquotatrackerrepository"
+	"sheng-go-backend/pkg/adapter/repository/profileentryrepository"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// failingProvider fails every profile fetch with err, billing a call unless
+// the circuit is open.
+type failingProvider struct {
+	profileprovider.ProfileProvider
+	err error
+}
+
+func (p *failingProvider) FetchProfileByURN(ctx context.Context, _ string) (*profileprovider.LinkedInProfile, []byte, error) {
+	var openErr *profileprovider.CircuitOpenError
+	if !errors.As(p.err, &openErr) {
+		profileprovider.CountCall(ctx)
+	}
+	return nil, nil, p.err
+}
+
+// fakeQuotaLedger records the calls committed against each reservation and
+// answers every commit with err.
+type fakeQuotaLedger struct {
+	quotaLedger
+	mu        sync.Mutex
+	committed map[*ent.APIQuotaReservation]int
+	err       error
+}
+
+func (l *fakeQuotaLedger) CommitCalls(_ context.Context, reservation *ent.APIQuotaReservation, count int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.committed == nil {
+		l.committed = map[*ent.APIQuotaReservation]int{}
+	}
+	l.committed[reservation] += count
+	return l.err
+}
+
+// fakeEntryRepo records the status entries are left in. renewErr is returned
+// when a lease is renewed.
+type fakeEntryRepo struct {
+	profileentryrepository.ProfileEntryRepository
+	status   profileentry.Status
+	renewErr error
+}
+
+func (r *fakeEntryRepo) RenewLease(context.Context, string, string, time.Duration) error {
+	return r.renewErr
+}
+
+func (r *fakeEntryRepo) UpdateStatus(_ context.Context, _ string, _ string, status profileentry.Status, _ *string) (*ent.ProfileEntry, error) {
+	r.status = status
+	return &ent.ProfileEntry{Status: status}, nil
+}
+
+func (r *fakeEntryRepo) MarkFailed(context.Context, string, string, string, int, *time.Time) (*ent.ProfileEntry, error) {
+	r.status = profileentry.StatusFAILED
+	return &ent.ProfileEntry{Status: profileentry.StatusFAILED}, nil
+}
+
+func TestProcessEntry_CommitsFailedAttempts(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status profileentry.Status
+	}{
+		{name: "Should count a failed fetch", err: &profileprovider.InvalidResponseError{}, status: profileentry.StatusFAILED},
+		{name: "Should count a not-found fetch", err: &profileprovider.NotFoundError{}, status: profileentry.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ledger := &fakeQuotaLedger{}
+			entries := &fakeEntryRepo{}
+			pf := &ProfileFetcher{
+				profileEntryRepo: entries,
+				provider:         &failingProvider{err: tc.err},
+				quotaManager:     ledger,
+				retryPolicy:      newRetryPolicy(),
+				logger:           zap.NewNop().Sugar(),
+			}
+			reservation := &ent.APIQuotaReservation{Reserved: 5}
+			stats := &fetchJobStats{}
+
+			pf.processEntry(context.Background(), &ent.ProfileEntry{LinkedinUrn: "ACoAAtest"}, 0, reservation, stats)
+
+			assert.Equal(t, 1, ledger.committed[reservation])
+			assert.Equal(t, 1, stats.apiCallsMade)
+			assert.Equal(t, tc.status, entries.status)
+		})
+	}
+
+	t.Run("Should not count a fetch refused by an open circuit", func(t *testing.T) {
+		ledger := &fakeQuotaLedger{}
+		pf := &ProfileFetcher{
+			profileEntryRepo: &fakeEntryRepo{},
+			provider:         &failingProvider{err: &profileprovider.CircuitOpenError{}},
+			quotaManager:     ledger,
+			retryPolicy:      newRetryPolicy(),
+			logger:           zap.NewNop().Sugar(),
+		}
+		reservation := &ent.APIQuotaReservation{Reserved: 5}
+
+		pf.processEntry(context.Background(), &ent.ProfileEntry{LinkedinUrn: "ACoAAtest"}, 0, reservation, &fetchJobStats{})
+
+		assert.Zero(t, ledger.committed[reservation])
+	})
+	t.Run("Should hand back an entry without counting the attempt when the run is cancelled", func(t *testing.T) {
+		entries := &fakeEntryRepo{}
+		pf := &ProfileFetcher{
+			profileEntryRepo: entries,
+			provider:         &failingProvider{err: context.Canceled},
+			quotaManager:     &fakeQuotaLedger{},
+			retryPolicy:      newRetryPolicy(),
+			logger:           zap.NewNop().Sugar(),
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		stats := &fetchJobStats{}
+
+		pf.processEntry(ctx, &ent.ProfileEntry{LinkedinUrn: "ACoAAtest"}, 0, &ent.APIQuotaReservation{Reserved: 5}, stats)
+
+		assert.Equal(t, profileentry.StatusPending, entries.status)
+		assert.Zero(t, stats.failedCount)
+	})
+	t.Run("Should halt the run when calls beyond the reservation use up the quota", func(t *testing.T) {
+		entries := &fakeEntryRepo{}
+		pf := &ProfileFetcher{
+			profileEntryRepo: entries,
+			provider:         &failingProvider{err: &profileprovider.InvalidResponseError{}},
+			quotaManager:     &fakeQuotaLedger{err: fmt.Errorf("commit: %w", apiquotatrackerrepository.ErrInsufficientQuota)},
+			retryPolicy:      newRetryPolicy(),
+			logger:           zap.NewNop().Sugar(),
+		}
+		stats := &fetchJobStats{}
+
+		pf.processEntry(context.Background(), &ent.ProfileEntry{LinkedinUrn: "ACoAAtest"}, 0, &ent.APIQuotaReservation{}, stats)
+
+		var quotaErr *profileprovider.QuotaExhaustedError
+		assert.ErrorAs(t, stats.halted(), &quotaErr)
+		assert.Equal(t, profileentry.StatusFAILED, entries.status)
+	})
+	t.Run("Should skip an entry whose lease was lost", func(t *testing.T) {
+		ledger := &fakeQuotaLedger{}
+		entries := &fakeEntryRepo{renewErr: profileentryrepository.ErrLeaseLost}
+		pf := &ProfileFetcher{
+			profileEntryRepo: entries,
+			provider:         &failingProvider{err: &profileprovider.InvalidResponseError{}},
+			quotaManager:     ledger,
+			retryPolicy:      newRetryPolicy(),
+			logger:           zap.NewNop().Sugar(),
+		}
+		reservation := &ent.APIQuotaReservation{Reserved: 5}
+		stats := &fetchJobStats{}
+
+		pf.processEntry(context.Background(), &ent.ProfileEntry{LinkedinUrn: "ACoAAtest"}, 0, reservation, stats)
+
+		assert.Zero(t, ledger.committed[reservation])
+		assert.Empty(t, entries.status)
+		assert.Zero(t, stats.failedCount)
+	})
+}
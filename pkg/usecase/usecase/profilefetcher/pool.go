@@ -0,0 +1,234 @@
+package profilefetcher
+
+import (
+	"context"
+	"errors"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/profileentry"
+	"sheng-go-backend/ent/schema/ulid"
+	"sheng-go-backend/pkg/adapter/repository/apiquotatrackerrepository"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
+	"sync"
+	"time"
+)
+
+// fetchJobStats aggregates the per-entry outcomes reported by concurrent workers
+// during a single ExecuteFetchJob run.
+type fetchJobStats struct {
+	mu                sync.Mutex
+	successCount      int
+	failedCount       int
+	apiCallsMade      int
+	processedEntryIDs []ulid.ID
+	errMsgs           []string
+	haltErr           error
+	schemaDrift       profileprovider.SchemaDriftStats
+}
+
+// addAPICalls adds n RapidAPI attempts to the run total.
+func (s *fetchJobStats) addAPICalls(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.apiCallsMade += n
+}
+
+// addError records a run-level error message without counting a failed entry.
+func (s *fetchJobStats) addError(msg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errMsgs = append(s.errMsgs, msg)
+}
+
+// recordHalt remembers the first run-halting error so the run stops
+// dispatching entries. It reports whether this call recorded it.
+func (s *fetchJobStats) recordHalt(err error) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.haltErr != nil {
+		return false
+	}
+	s.haltErr = err
+	return true
+}
+
+// halted returns the error that stopped the run, if any.
+func (s *fetchJobStats) halted() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.haltErr
+}
+
+// haltsRun reports whether err means no other entry can succeed in this run:
+// the circuit breaker is open, the provider rejected our credentials, or the
+// provider quota is exhausted.
+func haltsRun(err error) bool {
+	var openErr *profileprovider.CircuitOpenError
+	var authErr *profileprovider.UnauthorizedError
+	var quotaErr *profileprovider.QuotaExhaustedError
+	return errors.As(err, &openErr) || errors.As(err, &authErr) || errors.As(err, &quotaErr)
+}
+
+// recordSchema adds a raw payload's schema check to the run's drift counts.
+func (s *fetchJobStats) recordSchema(check profileprovider.SchemaCheck) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.schemaDrift.Record(check)
+}
+
+// recordSuccess marks an entry as processed and returns the updated counters.
+func (s *fetchJobStats) recordSuccess(id ulid.ID) (successCount, failedCount int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.successCount++
+	s.processedEntryIDs = append(s.processedEntryIDs, id)
+	return s.successCount, s.failedCount
+}
+
+// recordFailure counts a failed entry and keeps its error message for the summary.
+func (s *fetchJobStats) recordFailure(msg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failedCount++
+	s.errMsgs = append(s.errMsgs, msg)
+}
+
+// processBatch fans entries out to a pool of workers and blocks until every
+// dispatched entry has been handled. offset is the number of entries handled
+// by earlier batches of the same run. Entries not yet dispatched when ctx is
+// cancelled or a run-halting provider error occurs are released back to
+// PENDING so another run can claim them.
+func (pf *ProfileFetcher) processBatch(
+	ctx context.Context,
+	entries []*ent.ProfileEntry,
+	offset int,
+	workers int,
+	reservation *ent.APIQuotaReservation,
+	stats *fetchJobStats,
+) {
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > len(entries) {
+		workers = len(entries)
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				pf.processEntry(ctx, entries[i], offset+i, reservation, stats)
+			}
+		}()
+	}
+
+	dispatched := 0
+dispatch:
+	for i := range entries {
+		if stats.halted() != nil {
+			break
+		}
+		select {
+		case jobs <- i:
+			dispatched++
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	pf.releaseClaims(entries[dispatched:])
+}
+
+// releaseClaims hands claimed but unprocessed entries back to PENDING. It uses
+// a fresh context because it typically runs after the job context is cancelled.
+func (pf *ProfileFetcher) releaseClaims(entries []*ent.ProfileEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for _, entry := range entries {
+		if _, err := pf.profileEntryRepo.UpdateStatus(
+			ctx,
+			string(entry.ID),
+			leaseOwnerOf(entry),
+			profileentry.StatusPending,
+			nil,
+		); err != nil {
+			pf.logger.Warnf("Failed to release claim on entry %s: %v", entry.ID, err)
+		}
+	}
+}
+
+// leaseOwnerOf returns the owner of entry's fetch lease as loaded when it was
+// claimed. Every write that ends a fetch is conditioned on it, so a worker
+// whose lease expired cannot overwrite the entry's new holder.
+func leaseOwnerOf(entry *ent.ProfileEntry) string {
+	if entry.LeaseOwner == nil {
+		return ""
+	}
+	return *entry.LeaseOwner
+}
+
+// saveRunSummary saves the history of a housekeeping run with summary, and ids
+// linked to it, unless the run processed nothing: such runs are not persisted,
+// to keep history free of noise. The summary goes in ErrorSummary, the only
+// free-text column on history. It returns the saved history, or history as is
+// when it was not saved.
+func (pf *ProfileFetcher) saveRunSummary(
+	ctx context.Context,
+	history *ent.JobExecutionHistory,
+	summary string,
+	ids []ulid.ID,
+) *ent.JobExecutionHistory {
+	if history.TotalProcessed == 0 {
+		return history
+	}
+
+	history.ErrorSummary = &summary
+	savedHistory, err := pf.jobHistoryRepo.Create(ctx, history, ids)
+	if err != nil {
+		pf.logger.Warnw("failed to create job history", "error", err)
+		return history
+	}
+	return savedHistory
+}
+
+// commitCalls counts calls made against reservation. Like releaseClaims it
+// uses a fresh context so calls made before a cancellation are still counted.
+// It returns a QuotaExhaustedError, which halts the run, when calls beyond the
+// reservation used up the quota or the rolling caps.
+func (pf *ProfileFetcher) commitCalls(reservation *ent.APIQuotaReservation, calls int) error {
+	if calls <= 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err := pf.quotaManager.CommitCalls(ctx, reservation, calls)
+	if errors.Is(err, apiquotatrackerrepository.ErrInsufficientQuota) ||
+		errors.Is(err, apiquotatrackerrepository.ErrCapReached) {
+		return &profileprovider.QuotaExhaustedError{Message: err.Error()}
+	}
+	if err != nil {
+		pf.logger.Warnw("failed to increment quota", "error", err)
+	}
+	return nil
+}
+
+// releaseQuota returns the unused calls of a batch's reservation. Like
+// releaseClaims it uses a fresh context so a cancelled run still gives them
+// back.
+func (pf *ProfileFetcher) releaseQuota(reservation *ent.APIQuotaReservation) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := pf.quotaManager.ReleaseQuota(ctx, reservation); err != nil {
+		pf.logger.Warnw("failed to release reserved quota", "error", err)
+	}
+}
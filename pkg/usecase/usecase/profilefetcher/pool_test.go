@@ -0,0 +1,64 @@
+package profilefetcher
+
+import (
+	"fmt"
+	"sheng-go-backend/ent/schema/ulid"
+	"sheng-go-backend/pkg/infrastructure/external/profileprovider"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchJobStats(t *testing.T) {
+	t.Run("Should aggregate counters reported by concurrent workers", func(t *testing.T) {
+		stats := &fetchJobStats{}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				stats.addAPICalls(2)
+				if i%5 == 0 {
+					stats.recordFailure(fmt.Sprintf("entry %d failed", i))
+					return
+				}
+				stats.recordSuccess(ulid.ID(fmt.Sprintf("0AD%d", i)))
+			}(i)
+		}
+		wg.Wait()
+
+		assert.Equal(t, 40, stats.successCount)
+		assert.Equal(t, 10, stats.failedCount)
+		assert.Equal(t, 100, stats.apiCallsMade)
+		assert.Len(t, stats.processedEntryIDs, 40)
+		assert.Len(t, stats.errMsgs, 10)
+	})
+}
+
+func TestFetchJobStats_RecordHalt(t *testing.T) {
+	t.Run("Should keep the first halting error only", func(t *testing.T) {
+		stats := &fetchJobStats{}
+		first := &profileprovider.CircuitOpenError{Requests: 10}
+		second := &profileprovider.UnauthorizedError{StatusCode: 401}
+
+		assert.Nil(t, stats.halted())
+		assert.True(t, stats.recordHalt(first))
+		assert.False(t, stats.recordHalt(second))
+		assert.Same(t, first, stats.halted())
+	})
+}
+
+func TestHaltsRun(t *testing.T) {
+	t.Run("Should halt on provider-wide errors only", func(t *testing.T) {
+		assert.True(t, haltsRun(&profileprovider.CircuitOpenError{}))
+		assert.True(t, haltsRun(fmt.Errorf("wrapped: %w", &profileprovider.UnauthorizedError{})))
+		assert.True(t, haltsRun(&profileprovider.QuotaExhaustedError{}))
+
+		assert.False(t, haltsRun(&profileprovider.NotFoundError{}))
+		assert.False(t, haltsRun(&profileprovider.RateLimitError{}))
+		assert.False(t, haltsRun(&profileprovider.UpstreamError{StatusCode: 502}))
+		assert.False(t, haltsRun(&profileprovider.InvalidResponseError{}))
+	})
+}
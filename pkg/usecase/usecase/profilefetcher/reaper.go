@@ -0,0 +1,110 @@
+package profilefetcher
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/config"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/jobexecutionhistory"
+	"sheng-go-backend/ent/profileentry"
+	"strings"
+	"time"
+)
+
+// StaleFetchReaperJobName is the cron job config name of the stale fetch reaper.
+const StaleFetchReaperJobName = "stale_fetch_reaper"
+
+const (
+	// defaultReaperStaleAfter is how long an entry without a lease may stay in
+	// FETCHING before it is considered stuck, when cron.reaperStaleMinutes is not set.
+	defaultReaperStaleAfter = time.Hour
+
+	// ReaperPolicyPending puts stuck entries back in the queue.
+	ReaperPolicyPending = "pending"
+	// ReaperPolicyFailed marks stuck entries as FAILED.
+	ReaperPolicyFailed = "failed"
+)
+
+// reaperStaleAfter returns the configured stale threshold.
+func reaperStaleAfter() time.Duration {
+	d := time.Duration(config.C.Cron.ReaperStaleMinutes) * time.Minute
+	if d <= 0 {
+		return defaultReaperStaleAfter
+	}
+	return d
+}
+
+// reaperTargetStatus maps a reaper policy (cron.reaperPolicy) to the status
+// stuck entries are reset to. Unknown or empty policies fall back to PENDING.
+func reaperTargetStatus(policy string) profileentry.Status {
+	if strings.EqualFold(strings.TrimSpace(policy), ReaperPolicyFailed) {
+		return profileentry.StatusFAILED
+	}
+	return profileentry.StatusPending
+}
+
+// ReapStaleEntries resets profile entries stuck in FETCHING, e.g. after the
+// process was killed mid-run. An entry is stuck when its fetch lease has
+// expired, or when it has no lease and was not updated for
+// cron.reaperStaleMinutes. Entries are reset to PENDING or FAILED depending on
+// cron.reaperPolicy, at most batch_size per run. Runs that reset anything are
+// recorded in job execution history with the reset entries attached.
+func (pf *ProfileFetcher) ReapStaleEntries(ctx context.Context) (*ent.JobExecutionHistory, error) {
+	startTime := time.Now()
+
+	jobConfig, err := pf.cronConfigRepo.GetByName(ctx, StaleFetchReaperJobName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job config: %w", err)
+	}
+
+	staleAfter := reaperStaleAfter()
+	targetStatus := reaperTargetStatus(config.C.Cron.ReaperPolicy)
+
+	var errorMsg *string
+	if targetStatus == profileentry.StatusFAILED {
+		errorMsg = ptr(fmt.Sprintf(
+			"fetch abandoned: entry stuck in FETCHING for more than %s",
+			staleAfter,
+		))
+	}
+
+	resetIDs, err := pf.profileEntryRepo.ResetStaleFetching(
+		ctx,
+		startTime.Add(-staleAfter),
+		targetStatus,
+		errorMsg,
+		jobConfig.BatchSize,
+	)
+
+	completedAt := time.Now()
+	history := &ent.JobExecutionHistory{
+		JobName:         StaleFetchReaperJobName,
+		Status:          jobexecutionhistory.StatusSuccess,
+		StartedAt:       startTime,
+		CompletedAt:     &completedAt,
+		TotalProcessed:  len(resetIDs),
+		SuccessfulCount: len(resetIDs),
+		DurationSeconds: int(completedAt.Sub(startTime).Seconds()),
+	}
+
+	if err != nil {
+		history.Status = jobexecutionhistory.StatusFailed
+		history.ErrorSummary = ptr(err.Error())
+		if _, herr := pf.jobHistoryRepo.Create(ctx, history, nil); herr != nil {
+			pf.logger.Warnw("failed to create job history", "error", herr)
+		}
+		return history, err
+	}
+
+	summary := fmt.Sprintf(
+		"Reset %d entries stuck in FETCHING (lease expired or idle > %s) to %s",
+		len(resetIDs),
+		staleAfter,
+		targetStatus,
+	)
+	if len(resetIDs) > 0 {
+		pf.logger.Warn(summary)
+	}
+
+	return pf.saveRunSummary(ctx, history, summary, resetIDs), nil
+}
@@ -0,0 +1,27 @@
+package profilefetcher
+
+import (
+	"sheng-go-backend/ent/profileentry"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReaperTargetStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		policy string
+		want   profileentry.Status
+	}{
+		{name: "Should default to PENDING when policy is empty", policy: "", want: profileentry.StatusPending},
+		{name: "Should reset to PENDING for the pending policy", policy: "pending", want: profileentry.StatusPending},
+		{name: "Should mark FAILED for the failed policy", policy: " FAILED ", want: profileentry.StatusFAILED},
+		{name: "Should fall back to PENDING for unknown policies", policy: "drop", want: profileentry.StatusPending},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, reaperTargetStatus(tc.policy))
+		})
+	}
+}
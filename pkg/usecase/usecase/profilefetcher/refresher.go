@@ -0,0 +1,104 @@
+package profilefetcher
+
+import (
+	"context"
+	"fmt"
+	"sheng-go-backend/config"
+	"sheng-go-backend/ent"
+	"sheng-go-backend/ent/jobexecutionhistory"
+	"sheng-go-backend/pkg/usecase/usecase/apiquota"
+	"time"
+)
+
+// ProfileRefresherJobName is the cron job config name of the profile refresher.
+const ProfileRefresherJobName = "profile_refresher"
+
+// defaultRefreshAfter is how old a completed fetch must be before it is
+// refreshed, when cron.refreshAfterDays is not set.
+const defaultRefreshAfter = 30 * 24 * time.Hour
+
+// refreshAfter returns the configured refresh age.
+func refreshAfter() time.Duration {
+	d := time.Duration(config.C.Cron.RefreshAfterDays) * 24 * time.Hour
+	if d <= 0 {
+		return defaultRefreshAfter
+	}
+	return d
+}
+
+// RefreshStaleProfiles re-queues COMPLETED entries last fetched more than
+// cron.refreshAfterDays ago so the profile fetcher fetches them again. Each run
+// re-queues at most the job's batch_size entries (the refresh budget), least
+// recently fetched first; the fetcher claims never-fetched entries ahead of
+// them. With respect_quota set, the budget is also limited to the calls the
+// cron budget can still reserve. Runs that re-queue anything are recorded in
+// job execution history with the re-queued entries attached.
+func (pf *ProfileFetcher) RefreshStaleProfiles(ctx context.Context) (*ent.JobExecutionHistory, error) {
+	startTime := time.Now()
+
+	jobConfig, err := pf.cronConfigRepo.GetByName(ctx, ProfileRefresherJobName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job config: %w", err)
+	}
+
+	budget := jobConfig.BatchSize
+	if jobConfig.RespectQuota {
+		// Only requeue what the fetcher can afford; it reserves the calls
+		// itself when it picks the entries up
+		reservation, err := pf.quotaManager.CheckAndReserveQuota(ctx, apiquota.BudgetCron, budget)
+		if err != nil {
+			pf.logger.Warnw("quota exceeded before refreshing", "error", err)
+			history := &ent.JobExecutionHistory{
+				JobName:         ProfileRefresherJobName,
+				Status:          jobexecutionhistory.StatusQuotaExceeded,
+				StartedAt:       startTime,
+				CompletedAt:     ptr(time.Now()),
+				DurationSeconds: int(time.Since(startTime).Seconds()),
+				ErrorSummary:    ptr(err.Error()),
+			}
+			savedHistory, _ := pf.jobHistoryRepo.Create(ctx, history, nil)
+			return savedHistory, err
+		}
+		budget = reservation.Reserved
+		pf.releaseQuota(reservation)
+	}
+
+	age := refreshAfter()
+	requeuedIDs, err := pf.profileEntryRepo.RequeueStaleCompleted(
+		ctx,
+		startTime.Add(-age),
+		budget,
+	)
+
+	completedAt := time.Now()
+	history := &ent.JobExecutionHistory{
+		JobName:         ProfileRefresherJobName,
+		Status:          jobexecutionhistory.StatusSuccess,
+		StartedAt:       startTime,
+		CompletedAt:     &completedAt,
+		TotalProcessed:  len(requeuedIDs),
+		SuccessfulCount: len(requeuedIDs),
+		DurationSeconds: int(completedAt.Sub(startTime).Seconds()),
+	}
+
+	if err != nil {
+		history.Status = jobexecutionhistory.StatusFailed
+		history.ErrorSummary = ptr(err.Error())
+		if _, herr := pf.jobHistoryRepo.Create(ctx, history, nil); herr != nil {
+			pf.logger.Warnw("failed to create job history", "error", herr)
+		}
+		return history, err
+	}
+
+	summary := fmt.Sprintf(
+		"Re-queued %d completed entries last fetched more than %d days ago (budget %d)",
+		len(requeuedIDs),
+		int(age.Hours()/24),
+		budget,
+	)
+	if len(requeuedIDs) > 0 {
+		pf.logger.Info(summary)
+	}
+
+	return pf.saveRunSummary(ctx, history, summary, requeuedIDs), nil
+}
@@ -0,0 +1,110 @@
+package profilefetcher
+
+import (
+	"context"
+	"errors"
+	"sheng-go-backend/config"
+	"sheng-go-backend/ent"
+	"time"
+)
+
+// Defaults for the cross-run retry policy when cron.retry* is not configured.
+const (
+	defaultRetryMaxAttempts = 5
+	defaultRetryBackoff     = 15 * time.Minute
+	defaultRetryBackoffMax  = 24 * time.Hour
+)
+
+// retryPolicy decides when a FAILED entry is retried by a later run.
+type retryPolicy struct {
+	maxAttempts int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+}
+
+// newRetryPolicy builds the policy from cron.retryMaxAttempts,
+// cron.retryBackoffMinutes and cron.retryBackoffMaxMinutes.
+func newRetryPolicy() retryPolicy {
+	p := retryPolicy{
+		maxAttempts: config.C.Cron.RetryMaxAttempts,
+		baseBackoff: time.Duration(config.C.Cron.RetryBackoffMinutes) * time.Minute,
+		maxBackoff:  time.Duration(config.C.Cron.RetryBackoffMaxMinutes) * time.Minute,
+	}
+	if p.maxAttempts <= 0 {
+		p.maxAttempts = defaultRetryMaxAttempts
+	}
+	if p.baseBackoff <= 0 {
+		p.baseBackoff = defaultRetryBackoff
+	}
+	if p.maxBackoff <= 0 {
+		p.maxBackoff = defaultRetryBackoffMax
+	}
+	return p
+}
+
+// nextRetryAt returns when an entry that has failed attempt times in a row
+// should be retried, doubling the backoff after every failure. It returns nil
+// once maxAttempts is reached, leaving the entry terminally FAILED.
+func (p retryPolicy) nextRetryAt(attempt int, now time.Time) *time.Time {
+	if attempt >= p.maxAttempts {
+		return nil
+	}
+
+	backoff := p.baseBackoff
+	for i := 1; i < attempt && backoff < p.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.maxBackoff {
+		backoff = p.maxBackoff
+	}
+
+	next := now.Add(backoff)
+	return &next
+}
+
+// releaseIfCancelled hands entry back as PENDING, without counting an
+// attempt, when err only means the run was cancelled or shut down. It reports
+// whether it did.
+func (pf *ProfileFetcher) releaseIfCancelled(ctx context.Context, entry *ent.ProfileEntry, err error) bool {
+	if ctx.Err() == nil ||
+		!(errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		return false
+	}
+	pf.releaseClaims([]*ent.ProfileEntry{entry})
+	return true
+}
+
+// markFailed records a failed fetch attempt for entry and schedules its retry
+// according to the retry policy. Like releaseClaims it uses a fresh context so
+// the failure is recorded even when the run's context is cancelled.
+func (pf *ProfileFetcher) markFailed(entry *ent.ProfileEntry, errMsg string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	attempt := entry.AttemptCount + 1
+	nextRetryAt := pf.retryPolicy.nextRetryAt(attempt, time.Now())
+
+	if _, err := pf.profileEntryRepo.MarkFailed(
+		ctx,
+		string(entry.ID),
+		leaseOwnerOf(entry),
+		errMsg,
+		attempt,
+		nextRetryAt,
+	); err != nil {
+		pf.logger.Warnw("failed to mark entry as failed", "urn", entry.LinkedinUrn, "error", err)
+		return
+	}
+
+	if nextRetryAt == nil {
+		pf.logger.Warnf("Entry %s failed %d times; giving up", entry.LinkedinUrn, attempt)
+		return
+	}
+	pf.logger.Infof(
+		"Entry %s failed (attempt %d/%d); retry scheduled at %s",
+		entry.LinkedinUrn,
+		attempt,
+		pf.retryPolicy.maxAttempts,
+		nextRetryAt.Format(time.RFC3339),
+	)
+}
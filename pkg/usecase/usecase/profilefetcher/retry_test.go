@@ -0,0 +1,41 @@
+package profilefetcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_NextRetryAt(t *testing.T) {
+	policy := retryPolicy{
+		maxAttempts: 4,
+		baseBackoff: 10 * time.Minute,
+		maxBackoff:  30 * time.Minute,
+	}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name    string
+		attempt int
+		want    time.Duration
+	}{
+		{name: "Should wait the base backoff after the first failure", attempt: 1, want: 10 * time.Minute},
+		{name: "Should double the backoff after every failure", attempt: 2, want: 20 * time.Minute},
+		{name: "Should cap the backoff at the maximum", attempt: 3, want: 30 * time.Minute},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := policy.nextRetryAt(tc.attempt, now)
+			if assert.NotNil(t, next) {
+				assert.Equal(t, now.Add(tc.want), *next)
+			}
+		})
+	}
+
+	t.Run("Should stop retrying once max attempts is reached", func(t *testing.T) {
+		assert.Nil(t, policy.nextRetryAt(4, now))
+		assert.Nil(t, policy.nextRetryAt(5, now))
+	})
+}
@@ -0,0 +1,241 @@
+// Package profiledata gives typed access to the RapidAPI profile arrays that
+// are stored as JSON (fullPositions, educations and skills).
+package profiledata
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Date is a partial date as returned by RapidAPI; zero parts are unknown.
+type Date struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+// Time converts d to a time, defaulting unknown month/day to 1. It returns
+// nil when the year is unknown.
+func (d *Date) Time() *time.Time {
+	if d == nil || d.Year == 0 {
+		return nil
+	}
+	month, day := d.Month, d.Day
+	if month == 0 {
+		month = 1
+	}
+	if day == 0 {
+		day = 1
+	}
+	t := time.Date(d.Year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+// Position is one fullPositions item.
+type Position struct {
+	CompanyName     string
+	CompanyURN      string
+	CompanyUsername string
+	Title           string
+	Location        string
+	Description     string
+	EmploymentType  string
+	Start           *Date
+	End             *Date
+	IsCurrent       bool
+}
+
+// Key identifies a position by company and title, ignoring case and spacing.
+func (p Position) Key() string {
+	return Normalize(p.CompanyName) + "|" + Normalize(p.Title)
+}
+
+// String renders the position as "Title at Company".
+func (p Position) String() string {
+	switch {
+	case p.Title == "":
+		return p.CompanyName
+	case p.CompanyName == "":
+		return p.Title
+	default:
+		return p.Title + " at " + p.CompanyName
+	}
+}
+
+// Education is one educations item.
+type Education struct {
+	SchoolName   string
+	SchoolID     string
+	Degree       string
+	FieldOfStudy string
+	Start        *Date
+	End          *Date
+}
+
+// Key identifies an education by school, degree and field, ignoring case and spacing.
+func (e Education) Key() string {
+	return Normalize(e.SchoolName) + "|" + Normalize(e.Degree) + "|" + Normalize(e.FieldOfStudy)
+}
+
+// String renders the education as "Degree, Field - School".
+func (e Education) String() string {
+	parts := make([]string, 0, 2)
+	for _, s := range []string{e.Degree, e.FieldOfStudy} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	if len(parts) == 0 {
+		return e.SchoolName
+	}
+	return strings.Join(parts, ", ") + " - " + e.SchoolName
+}
+
+// Skill is one skills item.
+type Skill struct {
+	Name             string
+	EndorsementCount int
+}
+
+// Positions parses fullPositions items, skipping items without company and title.
+func Positions(items []map[string]interface{}) []Position {
+	positions := make([]Position, 0, len(items))
+	for _, item := range items {
+		p := Position{
+			CompanyName:     stringValue(item, "companyName"),
+			CompanyURN:      firstNonEmpty(stringValue(item, "companyURN"), stringValue(item, "companyId")),
+			CompanyUsername: stringValue(item, "companyUsername"),
+			Title:           stringValue(item, "title"),
+			Location:        stringValue(item, "location"),
+			Description:     stringValue(item, "description"),
+			EmploymentType:  stringValue(item, "employmentType"),
+			Start:           dateValue(item, "start"),
+			End:             dateValue(item, "end"),
+		}
+		if p.CompanyName == "" && p.Title == "" {
+			continue
+		}
+		if current, ok := item["isCurrent"].(bool); ok {
+			p.IsCurrent = current
+		} else {
+			p.IsCurrent = p.End == nil || p.End.Year == 0
+		}
+		positions = append(positions, p)
+	}
+	return positions
+}
+
+// Educations parses educations items, skipping items without a school.
+func Educations(items []map[string]interface{}) []Education {
+	educations := make([]Education, 0, len(items))
+	for _, item := range items {
+		e := Education{
+			SchoolName:   stringValue(item, "schoolName"),
+			SchoolID:     stringValue(item, "schoolId"),
+			Degree:       stringValue(item, "degree"),
+			FieldOfStudy: stringValue(item, "fieldOfStudy"),
+			Start:        dateValue(item, "start"),
+			End:          dateValue(item, "end"),
+		}
+		if e.SchoolName == "" {
+			continue
+		}
+		educations = append(educations, e)
+	}
+	return educations
+}
+
+// Skills parses skills items, skipping items without a name.
+func Skills(items []map[string]interface{}) []Skill {
+	skills := make([]Skill, 0, len(items))
+	for _, item := range items {
+		s := Skill{
+			Name: stringValue(item, "name"),
+			EndorsementCount: intValue(item["endorsementsCount"]) +
+				intValue(item["endorsementCount"]),
+		}
+		if s.Name == "" {
+			continue
+		}
+		skills = append(skills, s)
+	}
+	return skills
+}
+
+// CurrentPosition returns the first current position; RapidAPI lists the
+// most recent positions first.
+func CurrentPosition(positions []Position) (Position, bool) {
+	for _, p := range positions {
+		if p.IsCurrent {
+			return p, true
+		}
+	}
+	return Position{}, false
+}
+
+// Normalize lowercases s and collapses whitespace for comparisons.
+func Normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+func stringValue(item map[string]interface{}, key string) string {
+	switch v := item[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	default:
+		return strings.TrimSpace(fmt.Sprint(v))
+	}
+}
+
+func intValue(v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case int64:
+		return int(n)
+	case float64:
+		return int(n)
+	case json.Number:
+		i, _ := n.Int64()
+		return int(i)
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	default:
+		return 0
+	}
+}
+
+func dateValue(item map[string]interface{}, key string) *Date {
+	m, ok := item[key].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	d := &Date{
+		Year:  intValue(m["year"]),
+		Month: intValue(m["month"]),
+		Day:   intValue(m["day"]),
+	}
+	if d.Year == 0 && d.Month == 0 && d.Day == 0 {
+		return nil
+	}
+	return d
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
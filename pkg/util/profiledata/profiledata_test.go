@@ -0,0 +1,51 @@
+package profiledata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositions(t *testing.T) {
+	t.Run("Should infer isCurrent from a missing end date", func(t *testing.T) {
+		positions := Positions([]map[string]interface{}{
+			{"companyName": "Acme", "title": "Engineer", "start": map[string]interface{}{"year": 2021.0, "month": 3.0}},
+			{"companyName": "Globex", "title": "Intern", "end": map[string]interface{}{"year": 2020.0}},
+			{"description": "no company or title"},
+		})
+
+		assert.Len(t, positions, 2)
+		assert.True(t, positions[0].IsCurrent)
+		assert.False(t, positions[1].IsCurrent)
+	})
+
+	t.Run("Should prefer an explicit isCurrent flag", func(t *testing.T) {
+		positions := Positions([]map[string]interface{}{
+			{"companyName": "Acme", "isCurrent": false},
+		})
+
+		assert.False(t, positions[0].IsCurrent)
+	})
+
+	t.Run("Should fall back to companyId for the company URN", func(t *testing.T) {
+		positions := Positions([]map[string]interface{}{
+			{"companyName": "Acme", "companyId": 1441.0},
+		})
+
+		assert.Equal(t, "1441", positions[0].CompanyURN)
+	})
+}
+
+func TestDate_Time(t *testing.T) {
+	t.Run("Should default unknown month and day to 1", func(t *testing.T) {
+		d := &Date{Year: 2019}
+		assert.Equal(t, time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), *d.Time())
+	})
+
+	t.Run("Should return nil without a year", func(t *testing.T) {
+		var d *Date
+		assert.Nil(t, d.Time())
+		assert.Nil(t, (&Date{Month: 4}).Time())
+	})
+}
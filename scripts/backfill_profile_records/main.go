@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"sheng-go-backend/config"
+	"sheng-go-backend/pkg/adapter/repository/profilerepository"
+	"sheng-go-backend/pkg/infrastructure/datastore"
+	"time"
+)
+
+// Rebuilds the profile_positions, profile_educations and profile_skills rows
+// of every profile from its positions/educations/skills JSON. Safe to re-run.
+func main() {
+	batchSize := flag.Int("batch", 200, "Profiles per transaction")
+	flag.Parse()
+
+	config.ReadConfig(config.ReadConfigOption{})
+
+	client, err := datastore.NewClient()
+	if err != nil {
+		log.Fatalf("failed to open db connection: %v", err)
+	}
+	defer client.Close()
+
+	startTime := time.Now()
+	repo := profilerepository.NewProfileRepo(client)
+	processed, err := repo.BackfillRecords(context.Background(), *batchSize)
+	if err != nil {
+		log.Fatalf("backfill failed after %d profiles: %v", processed, err)
+	}
+
+	log.Printf("Backfilled records for %d profiles in %s", processed, time.Since(startTime).Round(time.Second))
+}
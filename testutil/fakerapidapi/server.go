@@ -0,0 +1,362 @@
+// Package fakerapidapi is a local stand-in for the RapidAPI LinkedIn
+// endpoints. It serves deterministic synthetic profiles and posts, and can
+// inject 404, 429 with Retry-After, 5xx and slow responses, so the fetcher
+// and scheduler can run without spending quota. Point rapidapi.baseURL at it.
+package fakerapidapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Identifier prefixes that force a fault for a given username, URN or URL,
+// regardless of the configured rates.
+const (
+	NotFoundPrefix    = "notfound-"
+	RateLimitPrefix   = "ratelimit-"
+	ServerErrorPrefix = "error-"
+	SlowPrefix        = "slow-"
+)
+
+// Options configures the fake server. Rates are probabilities in [0, 1]
+// drawn from a generator seeded with Seed, so runs are reproducible.
+type Options struct {
+	// APIKey, when set, is required in X-RapidAPI-Key (401 otherwise).
+	APIKey string
+
+	NotFoundRate    float64
+	RateLimitRate   float64
+	ServerErrorRate float64
+	SlowRate        float64
+
+	// RetryAfter is sent with 429 responses. Defaults to 1s.
+	RetryAfter time.Duration
+	// SlowDelay is how long slow responses take. Defaults to 2s.
+	SlowDelay time.Duration
+
+	// PostsPerProfile is the size of each synthetic post history. Defaults to 12.
+	PostsPerProfile int
+	// PostsPageSize is the number of posts per page. Defaults to 5.
+	PostsPageSize int
+
+	Seed int64
+}
+
+// Stats counts the responses served, by kind.
+type Stats struct {
+	Requests     int
+	Profiles     int
+	Posts        int
+	NotFound     int
+	RateLimited  int
+	ServerErrors int
+	Slow         int
+	Unauthorized int
+}
+
+// Server is an http.Handler faking the RapidAPI profile, by-URL and posts
+// endpoints.
+type Server struct {
+	opts Options
+	mux  *http.ServeMux
+
+	mu    sync.Mutex
+	rng   *rand.Rand
+	stats Stats
+}
+
+// New creates a fake server.
+func New(opts Options) *Server {
+	if opts.RetryAfter <= 0 {
+		opts.RetryAfter = time.Second
+	}
+	if opts.SlowDelay <= 0 {
+		opts.SlowDelay = 2 * time.Second
+	}
+	if opts.PostsPerProfile <= 0 {
+		opts.PostsPerProfile = 12
+	}
+	if opts.PostsPageSize <= 0 {
+		opts.PostsPageSize = 5
+	}
+
+	s := &Server{opts: opts, rng: rand.New(rand.NewSource(opts.Seed))}
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("/get-profile-data-by-url", s.handleProfileByURL)
+	s.mux.HandleFunc("/get-profile-posts", s.handlePosts)
+	s.mux.HandleFunc("/", s.handleProfile)
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.stats.Requests++
+	s.mu.Unlock()
+
+	if s.opts.APIKey != "" && r.Header.Get("X-RapidAPI-Key") != s.opts.APIKey {
+		s.count(func(st *Stats) { st.Unauthorized++ })
+		writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+			"message": "You are not subscribed to this API.",
+		})
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// Stats returns the responses served so far.
+func (s *Server) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+func (s *Server) handleProfile(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("username")
+	if id == "" {
+		writeJSON(w, http.StatusBadRequest, wrapped(false, "username is required", nil))
+		return
+	}
+	if s.fault(w, r, id) {
+		return
+	}
+	s.count(func(st *Stats) { st.Profiles++ })
+	writeJSON(w, http.StatusOK, wrapped(true, "", Profile(id)))
+}
+
+func (s *Server) handleProfileByURL(w http.ResponseWriter, r *http.Request) {
+	profileURL := r.URL.Query().Get("url")
+	username := usernameFromURL(profileURL)
+	if username == "" {
+		writeJSON(w, http.StatusOK, wrapped(false, "This is not valid LinkedIn profile URL", nil))
+		return
+	}
+	if s.fault(w, r, username) {
+		return
+	}
+	s.count(func(st *Stats) { st.Profiles++ })
+	writeJSON(w, http.StatusOK, wrapped(true, "", Profile(username)))
+}
+
+func (s *Server) handlePosts(w http.ResponseWriter, r *http.Request) {
+	username := r.URL.Query().Get("username")
+	if username == "" {
+		writeJSON(w, http.StatusBadRequest, wrapped(false, "username is required", nil))
+		return
+	}
+	if s.fault(w, r, username) {
+		return
+	}
+	start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+	s.count(func(st *Stats) { st.Posts++ })
+	writeJSON(w, http.StatusOK, wrapped(true, "", Posts(username, start, s.opts.PostsPageSize, s.opts.PostsPerProfile)))
+}
+
+// fault writes an injected error response and reports whether it did. Slow
+// responses delay and then let the request through.
+func (s *Server) fault(w http.ResponseWriter, r *http.Request, id string) bool {
+	s.mu.Lock()
+	notFound := strings.HasPrefix(id, NotFoundPrefix) || s.roll(s.opts.NotFoundRate)
+	rateLimited := strings.HasPrefix(id, RateLimitPrefix) || s.roll(s.opts.RateLimitRate)
+	serverError := strings.HasPrefix(id, ServerErrorPrefix) || s.roll(s.opts.ServerErrorRate)
+	slow := strings.HasPrefix(id, SlowPrefix) || s.roll(s.opts.SlowRate)
+	s.mu.Unlock()
+
+	if slow {
+		s.count(func(st *Stats) { st.Slow++ })
+		select {
+		case <-time.After(s.opts.SlowDelay):
+		case <-r.Context().Done():
+			return true
+		}
+	}
+
+	switch {
+	case rateLimited:
+		s.count(func(st *Stats) { st.RateLimited++ })
+		w.Header().Set("Retry-After", strconv.Itoa(int(s.opts.RetryAfter.Round(time.Second)/time.Second)))
+		writeJSON(w, http.StatusTooManyRequests, map[string]interface{}{
+			"message": "Too many requests",
+		})
+	case serverError:
+		s.count(func(st *Stats) { st.ServerErrors++ })
+		writeJSON(w, http.StatusBadGateway, map[string]interface{}{
+			"messages": "The API is unreachable, please contact the API provider",
+			"info":     "Your Client (working) ---> Gateway (working) ---> API (not working)",
+		})
+	case notFound:
+		s.count(func(st *Stats) { st.NotFound++ })
+		writeJSON(w, http.StatusNotFound, wrapped(false, "profile not found", nil))
+	default:
+		return false
+	}
+	return true
+}
+
+// roll must be called with mu held.
+func (s *Server) roll(rate float64) bool {
+	return rate > 0 && s.rng.Float64() < rate
+}
+
+func (s *Server) count(f func(*Stats)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(&s.stats)
+}
+
+func wrapped(success bool, message string, data interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"success": success,
+		"message": message,
+		"data":    data,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// usernameFromURL extracts the vanity name from a linkedin.com/in/<name> URL.
+func usernameFromURL(profileURL string) string {
+	u, err := url.Parse(profileURL)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] != "in" {
+		return ""
+	}
+	return parts[1]
+}
+
+var (
+	firstNames = []string{"Ada", "Grace", "Alan", "Linus", "Margaret", "Dennis", "Barbara", "Ken"}
+	lastNames  = []string{"Lovelace", "Hopper", "Turing", "Torvalds", "Hamilton", "Ritchie", "Liskov", "Thompson"}
+	companies  = []string{"Example Corp", "Sample Labs", "Acme Data", "Initech", "Globex", "Umbrella Analytics"}
+	titles     = []string{"Software Engineer", "Data Scientist", "Engineering Manager", "Product Manager", "Staff Engineer"}
+	schools    = []string{"Example University", "Sample Institute of Technology", "State College"}
+	skills     = []string{"Go", "Python", "SQL", "Kubernetes", "Machine Learning", "GraphQL", "AWS"}
+	cities     = [][2]string{{"Austin, Texas", "United States"}, {"London", "United Kingdom"}, {"Berlin", "Germany"}, {"Sydney", "Australia"}}
+)
+
+// seeded returns a generator seeded from id so the same id always yields the
+// same synthetic data.
+func seeded(id string) *rand.Rand {
+	h := fnv.New64a()
+	h.Write([]byte(id))
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+func pick(rng *rand.Rand, values []string) string {
+	return values[rng.Intn(len(values))]
+}
+
+func date(year, month int) map[string]interface{} {
+	return map[string]interface{}{"year": year, "month": month, "day": 0}
+}
+
+// Profile returns the synthetic profile for a username or URN. It uses the
+// field names of the real profile response.
+func Profile(id string) map[string]interface{} {
+	rng := seeded(id)
+	urn, username := id, id
+	if strings.HasPrefix(id, "ACoA") {
+		username = fmt.Sprintf("user-%08x", rng.Uint32())
+	} else {
+		urn = fmt.Sprintf("ACoAA%016X", rng.Uint64())
+	}
+
+	city := cities[rng.Intn(len(cities))]
+	year := 2024 - rng.Intn(4)
+	positionCount := 1 + rng.Intn(3)
+	positions := make([]interface{}, 0, positionCount)
+	for i := 0; i < positionCount; i++ {
+		company := pick(rng, companies)
+		end := date(0, 0)
+		if i > 0 {
+			end = date(year, 1+rng.Intn(12))
+		}
+		start := year - 1 - rng.Intn(3)
+		positions = append(positions, map[string]interface{}{
+			"companyName":     company,
+			"companyURN":      fmt.Sprintf("urn:li:fsd_company:%d", 1000000+fnvIndex(company)),
+			"companyUsername": strings.ToLower(strings.ReplaceAll(company, " ", "-")),
+			"title":           pick(rng, titles),
+			"location":        city[0],
+			"employmentType":  "Full-time",
+			"start":           date(start, 1+rng.Intn(12)),
+			"end":             end,
+		})
+		year = start
+	}
+
+	skillList := make([]interface{}, 0, 3)
+	for _, i := range rng.Perm(len(skills))[:3] {
+		skillList = append(skillList, map[string]interface{}{
+			"name":              skills[i],
+			"endorsementsCount": rng.Intn(50),
+		})
+	}
+
+	return map[string]interface{}{
+		"urn":       urn,
+		"username":  username,
+		"firstName": pick(rng, firstNames),
+		"lastName":  pick(rng, lastNames),
+		"headline":  fmt.Sprintf("%s at %s", positions[0].(map[string]interface{})["title"], positions[0].(map[string]interface{})["companyName"]),
+		"geo": map[string]interface{}{
+			"country":     city[1],
+			"city":        city[0],
+			"full":        city[0] + ", " + city[1],
+			"countryCode": strings.ToLower(city[1][:2]),
+		},
+		"educations": []interface{}{
+			map[string]interface{}{
+				"schoolName":   pick(rng, schools),
+				"degree":       "BSc",
+				"fieldOfStudy": "Computer Science",
+				"start":        date(year-4, 9),
+				"end":          date(year, 6),
+			},
+		},
+		"fullPositions": positions,
+		"skills":        skillList,
+	}
+}
+
+// Posts returns one page of the synthetic post history of a username, newest
+// first, one post a week. The page is empty past the end of the history.
+func Posts(username string, start, pageSize, total int) []interface{} {
+	posts := []interface{}{}
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	seed := fnvIndex(username)
+	for i := start; i < start+pageSize && i < total; i++ {
+		posted := base.Add(-time.Duration(i) * 7 * 24 * time.Hour)
+		posts = append(posts, map[string]interface{}{
+			"urn":                 fmt.Sprintf("7%09d%09d", seed%1000000000, i),
+			"text":                fmt.Sprintf("Synthetic post %d from %s", i+1, username),
+			"postedDate":          posted.Format("2006-01-02 15:04:05.000 -0700 MST"),
+			"postedDateTimestamp": posted.UnixMilli(),
+			"totalReactionCount":  (seed + i*7) % 200,
+			"commentsCount":       (seed + i*3) % 20,
+			"repostsCount":        (seed + i) % 5,
+		})
+	}
+	return posts
+}
+
+func fnvIndex(s string) int {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return int(h.Sum32() % 1000000)
+}
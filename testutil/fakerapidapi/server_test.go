@@ -0,0 +1,119 @@
+package fakerapidapi
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"sheng-go-backend/config"
+	"sheng-go-backend/pkg/infrastructure/external/rapidapi"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T, server *Server) *rapidapi.LinkedInClient {
+	t.Helper()
+	srv := httptest.NewServer(server)
+	t.Cleanup(srv.Close)
+
+	original := config.C.RapidAPI
+	t.Cleanup(func() { config.C.RapidAPI = original })
+	config.C.RapidAPI.BaseURL = srv.URL
+	config.C.RapidAPI.APIKey = "fake-key"
+	config.C.RapidAPI.RequestIntervalMs = 1
+	config.C.RapidAPI.TimeoutSeconds = 5
+	return rapidapi.NewLinkedInClient()
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Should serve the same synthetic profile for the same URN", func(t *testing.T) {
+		client := newClient(t, New(Options{}))
+
+		first, _, err := client.FetchProfileByURN(ctx, "ACoAAExample")
+		require.NoError(t, err)
+		second, _, err := client.FetchProfileByURN(ctx, "ACoAAExample")
+		require.NoError(t, err)
+
+		assert.Equal(t, "ACoAAExample", first.URN)
+		assert.NotEmpty(t, first.FullPositions)
+		assert.NotNil(t, first.Geo)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("Should serve profiles by URL", func(t *testing.T) {
+		client := newClient(t, New(Options{}))
+
+		profile, _, err := client.FetchProfileByURL(ctx, "https://www.linkedin.com/in/jane-doe/")
+		require.NoError(t, err)
+		assert.Equal(t, "jane-doe", profile.Username)
+	})
+
+	t.Run("Should page through posts until the history ends", func(t *testing.T) {
+		client := newClient(t, New(Options{PostsPerProfile: 7, PostsPageSize: 5}))
+
+		page, _, err := client.FetchProfilePosts(ctx, "jane-doe", 0)
+		require.NoError(t, err)
+		assert.Len(t, page, 5)
+
+		page, _, err = client.FetchProfilePosts(ctx, "jane-doe", 5)
+		require.NoError(t, err)
+		assert.Len(t, page, 2)
+
+		page, _, err = client.FetchProfilePosts(ctx, "jane-doe", 10)
+		require.NoError(t, err)
+		assert.Empty(t, page)
+	})
+
+	t.Run("Should answer 404 for not-found identifiers", func(t *testing.T) {
+		server := New(Options{})
+		client := newClient(t, server)
+
+		_, _, err := client.FetchProfileByURN(ctx, NotFoundPrefix+"ACoAA")
+		var notFound *rapidapi.NotFoundError
+		assert.True(t, errors.As(err, &notFound))
+		assert.Equal(t, 1, server.Stats().NotFound)
+	})
+
+	t.Run("Should answer 429 with Retry-After for rate-limited identifiers", func(t *testing.T) {
+		server := New(Options{RetryAfter: 7 * time.Second})
+		client := newClient(t, server)
+
+		_, _, err := client.FetchProfileByURN(ctx, RateLimitPrefix+"ACoAA")
+		var rateErr *rapidapi.RateLimitError
+		require.True(t, errors.As(err, &rateErr))
+		assert.Greater(t, rateErr.RetryAfter, 5*time.Second)
+		assert.Equal(t, 1, server.Stats().RateLimited)
+	})
+
+	t.Run("Should answer 5xx for error identifiers", func(t *testing.T) {
+		server := New(Options{})
+		client := newClient(t, server)
+
+		_, _, err := client.FetchProfileByURN(ctx, ServerErrorPrefix+"ACoAA")
+		assert.Error(t, err)
+		assert.Equal(t, 1, server.Stats().ServerErrors)
+	})
+
+	t.Run("Should delay slow identifiers", func(t *testing.T) {
+		server := New(Options{SlowDelay: 50 * time.Millisecond})
+		client := newClient(t, server)
+
+		start := time.Now()
+		_, _, err := client.FetchProfileByURN(ctx, SlowPrefix+"ACoAA")
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("Should reject requests without the configured key", func(t *testing.T) {
+		server := New(Options{APIKey: "other-key"})
+		client := newClient(t, server)
+
+		_, _, err := client.FetchProfileByURN(ctx, "ACoAAExample")
+		assert.Error(t, err)
+		assert.Equal(t, 1, server.Stats().Unauthorized)
+	})
+}